@@ -16,6 +16,7 @@ import (
 	"github.com/go-tangra/go-tangra-hr/cmd/server/assets"
 	hrCnf "github.com/go-tangra/go-tangra-hr/internal/conf"
 	"github.com/go-tangra/go-tangra-hr/internal/event"
+	"github.com/go-tangra/go-tangra-hr/internal/job"
 )
 
 var (
@@ -29,12 +30,24 @@ var (
 // Global references for cleanup
 var globalRegHelper *registration.RegistrationHelper
 var globalEventSubscriber *event.Subscriber
+var globalAccrualJob *job.AccrualJob
+var globalRolloverJob *job.RolloverJob
+var globalEscalationJob *job.EscalationJob
+var globalOutboxRelayJob *job.OutboxRelayJob
+var globalSigningReconcileJob *job.SigningReconcileJob
+var globalWebhookDeliveryJob *job.WebhookDeliveryJob
 
 func newApp(
 	ctx *bootstrap.Context,
 	gs *grpc.Server,
 	hs *kratosHttp.Server,
 	eventSubscriber *event.Subscriber,
+	accrualJob *job.AccrualJob,
+	rolloverJob *job.RolloverJob,
+	escalationJob *job.EscalationJob,
+	outboxRelayJob *job.OutboxRelayJob,
+	signingReconcileJob *job.SigningReconcileJob,
+	webhookDeliveryJob *job.WebhookDeliveryJob,
 	regClient *registration.Client,
 ) *kratos.App {
 	// Start the event subscriber and store reference for cleanup
@@ -45,6 +58,54 @@ func newApp(
 		}
 	}
 
+	// Start the allowance accrual job
+	globalAccrualJob = accrualJob
+	if accrualJob != nil {
+		if err := accrualJob.Start(); err != nil {
+			log.Warnf("Failed to start accrual job: %v", err)
+		}
+	}
+
+	// Start the year-end rollover job
+	globalRolloverJob = rolloverJob
+	if rolloverJob != nil {
+		if err := rolloverJob.Start(); err != nil {
+			log.Warnf("Failed to start rollover job: %v", err)
+		}
+	}
+
+	// Start the stale request escalation job
+	globalEscalationJob = escalationJob
+	if escalationJob != nil {
+		if err := escalationJob.Start(); err != nil {
+			log.Warnf("Failed to start escalation job: %v", err)
+		}
+	}
+
+	// Start the outbox relay job
+	globalOutboxRelayJob = outboxRelayJob
+	if outboxRelayJob != nil {
+		if err := outboxRelayJob.Start(); err != nil {
+			log.Warnf("Failed to start outbox relay job: %v", err)
+		}
+	}
+
+	// Start the signing reconciliation job
+	globalSigningReconcileJob = signingReconcileJob
+	if signingReconcileJob != nil {
+		if err := signingReconcileJob.Start(); err != nil {
+			log.Warnf("Failed to start signing reconciliation job: %v", err)
+		}
+	}
+
+	// Start the webhook delivery job
+	globalWebhookDeliveryJob = webhookDeliveryJob
+	if webhookDeliveryJob != nil {
+		if err := webhookDeliveryJob.Start(); err != nil {
+			log.Warnf("Failed to start webhook delivery job: %v", err)
+		}
+	}
+
 	if regClient != nil {
 		// Populate the full registration config on the pre-created client
 		regClient.SetConfig(&registration.Config{
@@ -72,6 +133,36 @@ func stopServices() {
 			log.Warnf("Failed to stop event subscriber: %v", err)
 		}
 	}
+	if globalAccrualJob != nil {
+		if err := globalAccrualJob.Stop(); err != nil {
+			log.Warnf("Failed to stop accrual job: %v", err)
+		}
+	}
+	if globalRolloverJob != nil {
+		if err := globalRolloverJob.Stop(); err != nil {
+			log.Warnf("Failed to stop rollover job: %v", err)
+		}
+	}
+	if globalEscalationJob != nil {
+		if err := globalEscalationJob.Stop(); err != nil {
+			log.Warnf("Failed to stop escalation job: %v", err)
+		}
+	}
+	if globalOutboxRelayJob != nil {
+		if err := globalOutboxRelayJob.Stop(); err != nil {
+			log.Warnf("Failed to stop outbox relay job: %v", err)
+		}
+	}
+	if globalSigningReconcileJob != nil {
+		if err := globalSigningReconcileJob.Stop(); err != nil {
+			log.Warnf("Failed to stop signing reconciliation job: %v", err)
+		}
+	}
+	if globalWebhookDeliveryJob != nil {
+		if err := globalWebhookDeliveryJob.Stop(); err != nil {
+			log.Warnf("Failed to stop webhook delivery job: %v", err)
+		}
+	}
 }
 
 func runApp() error {
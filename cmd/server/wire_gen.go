@@ -12,6 +12,7 @@ import (
 	"github.com/go-tangra/go-tangra-hr/internal/client"
 	"github.com/go-tangra/go-tangra-hr/internal/data"
 	"github.com/go-tangra/go-tangra-hr/internal/event"
+	"github.com/go-tangra/go-tangra-hr/internal/job"
 	"github.com/go-tangra/go-tangra-hr/internal/metrics"
 	"github.com/go-tangra/go-tangra-hr/internal/server"
 	"github.com/go-tangra/go-tangra-hr/internal/service"
@@ -33,8 +34,6 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 	auditLogRepo := data.NewAuditLogRepo(context, entClient)
 	absenceTypeRepo := data.NewAbsenceTypeRepo(context, entClient)
 	leaveRequestRepo := data.NewLeaveRequestRepo(context, entClient)
-	absenceTypeService := service.NewAbsenceTypeService(context, absenceTypeRepo)
-	leaveAllowanceRepo := data.NewLeaveAllowanceRepo(context, entClient)
 	registrationClient, err := client.NewRegistrationClient(context)
 	if err != nil {
 		cleanup()
@@ -46,29 +45,43 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 		cleanup()
 		return nil, nil, err
 	}
-	adminClient, cleanup3, err := client.NewAdminClient(context, certManager)
+	systemService := service.NewSystemService(context, absenceTypeRepo, leaveRequestRepo, signingClient)
+	absenceTypeService := service.NewAbsenceTypeService(context, absenceTypeRepo)
+	leaveAmendmentRepo := data.NewLeaveAmendmentRepo(context, entClient)
+	leaveAllowanceRepo := data.NewLeaveAllowanceRepo(context, entClient)
+	holidayCalendarRepo := data.NewHolidayCalendarRepo(context, entClient)
+	holidayRepo := data.NewHolidayRepo(context, entClient)
+	workScheduleAssignmentRepo := data.NewWorkScheduleAssignmentRepo(context, entClient)
+	approvalDelegationRepo := data.NewApprovalDelegationRepo(context, entClient)
+	leavePolicyRepo := data.NewLeavePolicyRepo(context, entClient)
+	blackoutPeriodRepo := data.NewBlackoutPeriodRepo(context, entClient)
+	coverageRuleRepo := data.NewCoverageRuleRepo(context, entClient)
+	leaveAttachmentRepo := data.NewLeaveAttachmentRepo(context, entClient)
+	backend, err := data.NewAttachmentStore(context)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	notificationClient, cleanup4, err := client.NewNotificationClient(context, moduleDialer)
+	leaveCommentRepo := data.NewLeaveCommentRepo(context, entClient)
+	redisClient, cleanup3, err := data.NewRedisClient(context)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	webhookSubscriptionRepo := data.NewWebhookSubscriptionRepo(context, entClient)
+	webhookDeliveryRepo := data.NewWebhookDeliveryRepo(context, entClient, webhookSubscriptionRepo)
+	publisher := event.NewPublisher(context, redisClient, leaveAllowanceRepo, webhookDeliveryRepo)
+	outboxRepo := data.NewOutboxRepo(context, entClient)
+	adminClient, cleanup4, err := client.NewAdminClient(context, certManager)
 	if err != nil {
 		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	systemService := service.NewSystemService(context, absenceTypeRepo, leaveRequestRepo, signingClient)
-	leaveService := service.NewLeaveService(context, leaveRequestRepo, leaveAllowanceRepo, absenceTypeRepo, signingClient, adminClient, notificationClient)
-	allowancePoolRepo := data.NewAllowancePoolRepo(context, entClient)
-	allowanceService := service.NewAllowanceService(context, leaveAllowanceRepo, absenceTypeRepo, allowancePoolRepo)
-	allowancePoolService := service.NewAllowancePoolService(context, allowancePoolRepo, absenceTypeRepo)
-	userService := service.NewUserService(context, adminClient)
-	backupService := service.NewBackupService(context, entClient)
-	grpcServer := server.NewGRPCServer(context, certManager, collector, auditLogRepo, systemService, absenceTypeService, leaveService, allowanceService, allowancePoolService, userService, backupService)
-	httpServer := server.NewHTTPServer(context)
-	redisClient, cleanup5, err := data.NewRedisClient(context)
+	notificationClient, cleanup5, err := client.NewNotificationClient(context, moduleDialer)
 	if err != nil {
 		cleanup4()
 		cleanup3()
@@ -76,9 +89,36 @@ func initApp(context *bootstrap.Context) (*kratos.App, func(), error) {
 		cleanup()
 		return nil, nil, err
 	}
-	handler := event.NewHandler(context, leaveRequestRepo, leaveAllowanceRepo, absenceTypeRepo)
-	subscriber := event.NewSubscriber(context, redisClient, handler)
-	app := newApp(context, grpcServer, httpServer, subscriber, registrationClient)
+	leaveService := service.NewLeaveService(context, leaveRequestRepo, leaveAmendmentRepo, leaveAllowanceRepo, absenceTypeRepo, holidayCalendarRepo, holidayRepo, workScheduleAssignmentRepo, approvalDelegationRepo, leavePolicyRepo, blackoutPeriodRepo, coverageRuleRepo, leaveAttachmentRepo, backend, leaveCommentRepo, publisher, outboxRepo, signingClient, adminClient, notificationClient)
+	allowancePoolRepo := data.NewAllowancePoolRepo(context, entClient)
+	allowanceTransactionRepo := data.NewAllowanceTransactionRepo(context, entClient)
+	employmentRepo := data.NewEmploymentRepo(context, entClient)
+	allowanceService := service.NewAllowanceService(context, leaveAllowanceRepo, absenceTypeRepo, allowancePoolRepo, allowanceTransactionRepo, employmentRepo)
+	allowancePoolService := service.NewAllowancePoolService(context, allowancePoolRepo, absenceTypeRepo)
+	holidayService := service.NewHolidayService(context, holidayCalendarRepo, holidayRepo)
+	workScheduleRepo := data.NewWorkScheduleRepo(context, entClient)
+	workScheduleService := service.NewWorkScheduleService(context, workScheduleRepo, workScheduleAssignmentRepo)
+	employmentService := service.NewEmploymentService(context, employmentRepo, leaveAllowanceRepo)
+	approvalDelegationService := service.NewApprovalDelegationService(context, approvalDelegationRepo, absenceTypeRepo)
+	leavePolicyService := service.NewLeavePolicyService(context, leavePolicyRepo, blackoutPeriodRepo, absenceTypeRepo)
+	coverageService := service.NewCoverageService(context, coverageRuleRepo, absenceTypeRepo)
+	leaveAttachmentService := service.NewLeaveAttachmentService(context, leaveAttachmentRepo, leaveRequestRepo, backend)
+	userService := service.NewUserService(context, adminClient)
+	backupService := service.NewBackupService(context, entClient)
+	outboxService := service.NewOutboxService(context, outboxRepo)
+	webhookService := service.NewWebhookService(context, webhookSubscriptionRepo, webhookDeliveryRepo)
+	grpcServer := server.NewGRPCServer(context, certManager, collector, auditLogRepo, systemService, absenceTypeService, leaveService, allowanceService, allowancePoolService, holidayService, workScheduleService, employmentService, approvalDelegationService, leavePolicyService, coverageService, leaveAttachmentService, userService, backupService, outboxService, webhookService)
+	httpServer := server.NewHTTPServer(context)
+	handler := event.NewHandler(context, leaveRequestRepo, leaveAmendmentRepo, leaveAllowanceRepo, absenceTypeRepo, holidayRepo, workScheduleAssignmentRepo, outboxRepo)
+	processedEventRepo := data.NewProcessedEventRepo(context, entClient)
+	subscriber := event.NewSubscriber(context, redisClient, handler, processedEventRepo)
+	accrualJob := job.NewAccrualJob(context, leaveAllowanceRepo)
+	rolloverJob := job.NewRolloverJob(context, leaveAllowanceRepo)
+	escalationJob := job.NewEscalationJob(context, leaveService)
+	outboxRelayJob := job.NewOutboxRelayJob(context, outboxRepo, leaveService)
+	signingReconcileJob := job.NewSigningReconcileJob(context, leaveRequestRepo, leaveAmendmentRepo, processedEventRepo, signingClient, handler)
+	webhookDeliveryJob := job.NewWebhookDeliveryJob(context, webhookDeliveryRepo, webhookSubscriptionRepo)
+	app := newApp(context, grpcServer, httpServer, subscriber, accrualJob, rolloverJob, escalationJob, outboxRelayJob, signingReconcileJob, webhookDeliveryJob, registrationClient)
 	return app, func() {
 		cleanup5()
 		cleanup4()
@@ -27,6 +27,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// AbsenceUnit is the unit leave of an absence type is booked in
+type AbsenceUnit int32
+
+const (
+	AbsenceUnit_ABSENCE_UNIT_UNSPECIFIED AbsenceUnit = 0
+	AbsenceUnit_ABSENCE_UNIT_DAYS        AbsenceUnit = 1 // Whole or half days
+	AbsenceUnit_ABSENCE_UNIT_HOURS       AbsenceUnit = 2 // Hours within a single day
+)
+
+// Enum value maps for AbsenceUnit.
+var (
+	AbsenceUnit_name = map[int32]string{
+		0: "ABSENCE_UNIT_UNSPECIFIED",
+		1: "ABSENCE_UNIT_DAYS",
+		2: "ABSENCE_UNIT_HOURS",
+	}
+	AbsenceUnit_value = map[string]int32{
+		"ABSENCE_UNIT_UNSPECIFIED": 0,
+		"ABSENCE_UNIT_DAYS":        1,
+		"ABSENCE_UNIT_HOURS":       2,
+	}
+)
+
+func (x AbsenceUnit) Enum() *AbsenceUnit {
+	p := new(AbsenceUnit)
+	*p = x
+	return p
+}
+
+func (x AbsenceUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AbsenceUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_hr_service_v1_absence_type_proto_enumTypes[0].Descriptor()
+}
+
+func (AbsenceUnit) Type() protoreflect.EnumType {
+	return &file_hr_service_v1_absence_type_proto_enumTypes[0]
+}
+
+func (x AbsenceUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AbsenceUnit.Descriptor instead.
+func (AbsenceUnit) EnumDescriptor() ([]byte, []int) {
+	return file_hr_service_v1_absence_type_proto_rawDescGZIP(), []int{0}
+}
+
 // AbsenceType represents a configurable type of absence
 type AbsenceType struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
@@ -44,6 +94,12 @@ type AbsenceType struct {
 	RequiresSigning      *bool                  `protobuf:"varint,12,opt,name=requires_signing,json=requiresSigning,proto3,oneof" json:"requires_signing,omitempty"`
 	SigningTemplateId    *string                `protobuf:"bytes,13,opt,name=signing_template_id,json=signingTemplateId,proto3,oneof" json:"signing_template_id,omitempty"`
 	AllowancePoolId      *string                `protobuf:"bytes,14,opt,name=allowance_pool_id,json=allowancePoolId,proto3,oneof" json:"allowance_pool_id,omitempty"`
+	Unit                 *AbsenceUnit           `protobuf:"varint,15,opt,name=unit,proto3,enum=hr.service.v1.AbsenceUnit,oneof" json:"unit,omitempty"`
+	AccrualPolicy        *AccrualPolicy         `protobuf:"bytes,16,opt,name=accrual_policy,json=accrualPolicy,proto3,oneof" json:"accrual_policy,omitempty"`
+	RolloverPolicy       *RolloverPolicy        `protobuf:"bytes,17,opt,name=rollover_policy,json=rolloverPolicy,proto3,oneof" json:"rollover_policy,omitempty"`
+	ApprovalChain        *ApprovalChain         `protobuf:"bytes,18,opt,name=approval_chain,json=approvalChain,proto3,oneof" json:"approval_chain,omitempty"`
+	EscalationPolicy     *EscalationPolicy      `protobuf:"bytes,19,opt,name=escalation_policy,json=escalationPolicy,proto3,oneof" json:"escalation_policy,omitempty"`
+	RequiresAttachment   *AttachmentRequirement `protobuf:"bytes,24,opt,name=requires_attachment,json=requiresAttachment,proto3,oneof" json:"requires_attachment,omitempty"`
 	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
 	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
 	CreatedBy            *uint32                `protobuf:"varint,22,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
@@ -180,6 +236,48 @@ func (x *AbsenceType) GetAllowancePoolId() string {
 	return ""
 }
 
+func (x *AbsenceType) GetUnit() AbsenceUnit {
+	if x != nil && x.Unit != nil {
+		return *x.Unit
+	}
+	return AbsenceUnit_ABSENCE_UNIT_UNSPECIFIED
+}
+
+func (x *AbsenceType) GetAccrualPolicy() *AccrualPolicy {
+	if x != nil {
+		return x.AccrualPolicy
+	}
+	return nil
+}
+
+func (x *AbsenceType) GetRolloverPolicy() *RolloverPolicy {
+	if x != nil {
+		return x.RolloverPolicy
+	}
+	return nil
+}
+
+func (x *AbsenceType) GetApprovalChain() *ApprovalChain {
+	if x != nil {
+		return x.ApprovalChain
+	}
+	return nil
+}
+
+func (x *AbsenceType) GetEscalationPolicy() *EscalationPolicy {
+	if x != nil {
+		return x.EscalationPolicy
+	}
+	return nil
+}
+
+func (x *AbsenceType) GetRequiresAttachment() *AttachmentRequirement {
+	if x != nil {
+		return x.RequiresAttachment
+	}
+	return nil
+}
+
 func (x *AbsenceType) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -223,6 +321,12 @@ type CreateAbsenceTypeRequest struct {
 	RequiresSigning      *bool                  `protobuf:"varint,11,opt,name=requires_signing,json=requiresSigning,proto3,oneof" json:"requires_signing,omitempty"`
 	SigningTemplateId    *string                `protobuf:"bytes,12,opt,name=signing_template_id,json=signingTemplateId,proto3,oneof" json:"signing_template_id,omitempty"`
 	AllowancePoolId      *string                `protobuf:"bytes,13,opt,name=allowance_pool_id,json=allowancePoolId,proto3,oneof" json:"allowance_pool_id,omitempty"`
+	Unit                 *AbsenceUnit           `protobuf:"varint,14,opt,name=unit,proto3,enum=hr.service.v1.AbsenceUnit,oneof" json:"unit,omitempty"`
+	AccrualPolicy        *AccrualPolicy         `protobuf:"bytes,15,opt,name=accrual_policy,json=accrualPolicy,proto3,oneof" json:"accrual_policy,omitempty"`
+	RolloverPolicy       *RolloverPolicy        `protobuf:"bytes,16,opt,name=rollover_policy,json=rolloverPolicy,proto3,oneof" json:"rollover_policy,omitempty"`
+	ApprovalChain        *ApprovalChain         `protobuf:"bytes,17,opt,name=approval_chain,json=approvalChain,proto3,oneof" json:"approval_chain,omitempty"`
+	EscalationPolicy     *EscalationPolicy      `protobuf:"bytes,18,opt,name=escalation_policy,json=escalationPolicy,proto3,oneof" json:"escalation_policy,omitempty"`
+	RequiresAttachment   *AttachmentRequirement `protobuf:"bytes,19,opt,name=requires_attachment,json=requiresAttachment,proto3,oneof" json:"requires_attachment,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -348,6 +452,48 @@ func (x *CreateAbsenceTypeRequest) GetAllowancePoolId() string {
 	return ""
 }
 
+func (x *CreateAbsenceTypeRequest) GetUnit() AbsenceUnit {
+	if x != nil && x.Unit != nil {
+		return *x.Unit
+	}
+	return AbsenceUnit_ABSENCE_UNIT_UNSPECIFIED
+}
+
+func (x *CreateAbsenceTypeRequest) GetAccrualPolicy() *AccrualPolicy {
+	if x != nil {
+		return x.AccrualPolicy
+	}
+	return nil
+}
+
+func (x *CreateAbsenceTypeRequest) GetRolloverPolicy() *RolloverPolicy {
+	if x != nil {
+		return x.RolloverPolicy
+	}
+	return nil
+}
+
+func (x *CreateAbsenceTypeRequest) GetApprovalChain() *ApprovalChain {
+	if x != nil {
+		return x.ApprovalChain
+	}
+	return nil
+}
+
+func (x *CreateAbsenceTypeRequest) GetEscalationPolicy() *EscalationPolicy {
+	if x != nil {
+		return x.EscalationPolicy
+	}
+	return nil
+}
+
+func (x *CreateAbsenceTypeRequest) GetRequiresAttachment() *AttachmentRequirement {
+	if x != nil {
+		return x.RequiresAttachment
+	}
+	return nil
+}
+
 type CreateAbsenceTypeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AbsenceType   *AbsenceType           `protobuf:"bytes,1,opt,name=absence_type,json=absenceType,proto3" json:"absence_type,omitempty"`
@@ -760,7 +906,7 @@ var File_hr_service_v1_absence_type_proto protoreflect.FileDescriptor
 
 const file_hr_service_v1_absence_type_proto_rawDesc = "" +
 	"\n" +
-	" hr/service/v1/absence_type.proto\x12\rhr.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x8c\b\n" +
+	" hr/service/v1/absence_type.proto\x12\rhr.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1bhr/service/v1/accrual.proto\x1a\x1chr/service/v1/approval.proto\x1a\x1ehr/service/v1/attachment.proto\x1a\x1ehr/service/v1/escalation.proto\x1a\x1chr/service/v1/rollover.proto\"\xc2\f\n" +
 	"\vAbsenceType\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12 \n" +
 	"\ttenant_id\x18\x02 \x01(\rH\x01R\btenantId\x88\x01\x01\x12\x17\n" +
@@ -778,15 +924,21 @@ const file_hr_service_v1_absence_type_proto_rawDesc = "" +
 	"\x10requires_signing\x18\f \x01(\bH\n" +
 	"R\x0frequiresSigning\x88\x01\x01\x123\n" +
 	"\x13signing_template_id\x18\r \x01(\tH\vR\x11signingTemplateId\x88\x01\x01\x12/\n" +
-	"\x11allowance_pool_id\x18\x0e \x01(\tH\fR\x0fallowancePoolId\x88\x01\x01\x12>\n" +
+	"\x11allowance_pool_id\x18\x0e \x01(\tH\fR\x0fallowancePoolId\x88\x01\x01\x123\n" +
+	"\x04unit\x18\x0f \x01(\x0e2\x1a.hr.service.v1.AbsenceUnitH\rR\x04unit\x88\x01\x01\x12H\n" +
+	"\x0eaccrual_policy\x18\x10 \x01(\v2\x1c.hr.service.v1.AccrualPolicyH\x0eR\raccrualPolicy\x88\x01\x01\x12K\n" +
+	"\x0frollover_policy\x18\x11 \x01(\v2\x1d.hr.service.v1.RolloverPolicyH\x0fR\x0erolloverPolicy\x88\x01\x01\x12H\n" +
+	"\x0eapproval_chain\x18\x12 \x01(\v2\x1c.hr.service.v1.ApprovalChainH\x10R\rapprovalChain\x88\x01\x01\x12Q\n" +
+	"\x11escalation_policy\x18\x13 \x01(\v2\x1f.hr.service.v1.EscalationPolicyH\x11R\x10escalationPolicy\x88\x01\x01\x12Z\n" +
+	"\x13requires_attachment\x18\x18 \x01(\v2$.hr.service.v1.AttachmentRequirementH\x12R\x12requiresAttachment\x88\x01\x01\x12>\n" +
 	"\n" +
-	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\rR\tcreatedAt\x88\x01\x01\x12>\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\x13R\tcreatedAt\x88\x01\x01\x12>\n" +
 	"\n" +
-	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\x0eR\tupdatedAt\x88\x01\x01\x12\"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\x14R\tupdatedAt\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"created_by\x18\x16 \x01(\rH\x0fR\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"created_by\x18\x16 \x01(\rH\x15R\tcreatedBy\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"updated_by\x18\x17 \x01(\rH\x10R\tupdatedBy\x88\x01\x01B\x05\n" +
+	"updated_by\x18\x17 \x01(\rH\x16R\tupdatedBy\x88\x01\x01B\x05\n" +
 	"\x03_idB\f\n" +
 	"\n" +
 	"_tenant_idB\a\n" +
@@ -801,11 +953,18 @@ const file_hr_service_v1_absence_type_proto_rawDesc = "" +
 	"\v_sort_orderB\x13\n" +
 	"\x11_requires_signingB\x16\n" +
 	"\x14_signing_template_idB\x14\n" +
-	"\x12_allowance_pool_idB\r\n" +
+	"\x12_allowance_pool_idB\a\n" +
+	"\x05_unitB\x11\n" +
+	"\x0f_accrual_policyB\x12\n" +
+	"\x10_rollover_policyB\x11\n" +
+	"\x0f_approval_chainB\x14\n" +
+	"\x12_escalation_policyB\x16\n" +
+	"\x14_requires_attachmentB\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\r\n" +
 	"\v_created_byB\r\n" +
-	"\v_updated_by\"\x8d\x06\n" +
+	"\v_updated_by\"\xc3\n" +
+	"\n" +
 	"\x18CreateAbsenceTypeRequest\x12%\n" +
 	"\ttenant_id\x18\x01 \x01(\rB\x03\xe0A\x02H\x00R\btenantId\x88\x01\x01\x12&\n" +
 	"\x04name\x18\x02 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01H\x01R\x04name\x88\x01\x01\x12%\n" +
@@ -822,7 +981,13 @@ const file_hr_service_v1_absence_type_proto_rawDesc = "" +
 	"\x10requires_signing\x18\v \x01(\bH\tR\x0frequiresSigning\x88\x01\x01\x123\n" +
 	"\x13signing_template_id\x18\f \x01(\tH\n" +
 	"R\x11signingTemplateId\x88\x01\x01\x12/\n" +
-	"\x11allowance_pool_id\x18\r \x01(\tH\vR\x0fallowancePoolId\x88\x01\x01B\f\n" +
+	"\x11allowance_pool_id\x18\r \x01(\tH\vR\x0fallowancePoolId\x88\x01\x01\x123\n" +
+	"\x04unit\x18\x0e \x01(\x0e2\x1a.hr.service.v1.AbsenceUnitH\fR\x04unit\x88\x01\x01\x12H\n" +
+	"\x0eaccrual_policy\x18\x0f \x01(\v2\x1c.hr.service.v1.AccrualPolicyH\rR\raccrualPolicy\x88\x01\x01\x12K\n" +
+	"\x0frollover_policy\x18\x10 \x01(\v2\x1d.hr.service.v1.RolloverPolicyH\x0eR\x0erolloverPolicy\x88\x01\x01\x12H\n" +
+	"\x0eapproval_chain\x18\x11 \x01(\v2\x1c.hr.service.v1.ApprovalChainH\x0fR\rapprovalChain\x88\x01\x01\x12Q\n" +
+	"\x11escalation_policy\x18\x12 \x01(\v2\x1f.hr.service.v1.EscalationPolicyH\x10R\x10escalationPolicy\x88\x01\x01\x12Z\n" +
+	"\x13requires_attachment\x18\x13 \x01(\v2$.hr.service.v1.AttachmentRequirementH\x11R\x12requiresAttachment\x88\x01\x01B\f\n" +
 	"\n" +
 	"_tenant_idB\a\n" +
 	"\x05_nameB\x0e\n" +
@@ -836,7 +1001,13 @@ const file_hr_service_v1_absence_type_proto_rawDesc = "" +
 	"\v_sort_orderB\x13\n" +
 	"\x11_requires_signingB\x16\n" +
 	"\x14_signing_template_idB\x14\n" +
-	"\x12_allowance_pool_id\"Z\n" +
+	"\x12_allowance_pool_idB\a\n" +
+	"\x05_unitB\x11\n" +
+	"\x0f_accrual_policyB\x12\n" +
+	"\x10_rollover_policyB\x11\n" +
+	"\x0f_approval_chainB\x14\n" +
+	"\x12_escalation_policyB\x16\n" +
+	"\x14_requires_attachment\"Z\n" +
 	"\x19CreateAbsenceTypeResponse\x12=\n" +
 	"\fabsence_type\x18\x01 \x01(\v2\x1a.hr.service.v1.AbsenceTypeR\vabsenceType\"3\n" +
 	"\x15GetAbsenceTypeRequest\x12\x1a\n" +
@@ -873,7 +1044,11 @@ const file_hr_service_v1_absence_type_proto_rawDesc = "" +
 	"\fabsence_type\x18\x01 \x01(\v2\x1a.hr.service.v1.AbsenceTypeR\vabsenceType\"6\n" +
 	"\x18DeleteAbsenceTypeRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id2\x9e\x05\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id*Z\n" +
+	"\vAbsenceUnit\x12\x1c\n" +
+	"\x18ABSENCE_UNIT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11ABSENCE_UNIT_DAYS\x10\x01\x12\x16\n" +
+	"\x12ABSENCE_UNIT_HOURS\x10\x022\x9e\x05\n" +
 	"\x14HrAbsenceTypeService\x12\x84\x01\n" +
 	"\x11CreateAbsenceType\x12'.hr.service.v1.CreateAbsenceTypeRequest\x1a(.hr.service.v1.CreateAbsenceTypeResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/absence-types\x12}\n" +
 	"\x0eGetAbsenceType\x12$.hr.service.v1.GetAbsenceTypeRequest\x1a%.hr.service.v1.GetAbsenceTypeResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/absence-types/{id}\x12~\n" +
@@ -894,49 +1069,68 @@ func file_hr_service_v1_absence_type_proto_rawDescGZIP() []byte {
 	return file_hr_service_v1_absence_type_proto_rawDescData
 }
 
+var file_hr_service_v1_absence_type_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_hr_service_v1_absence_type_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_hr_service_v1_absence_type_proto_goTypes = []any{
-	(*AbsenceType)(nil),               // 0: hr.service.v1.AbsenceType
-	(*CreateAbsenceTypeRequest)(nil),  // 1: hr.service.v1.CreateAbsenceTypeRequest
-	(*CreateAbsenceTypeResponse)(nil), // 2: hr.service.v1.CreateAbsenceTypeResponse
-	(*GetAbsenceTypeRequest)(nil),     // 3: hr.service.v1.GetAbsenceTypeRequest
-	(*GetAbsenceTypeResponse)(nil),    // 4: hr.service.v1.GetAbsenceTypeResponse
-	(*ListAbsenceTypesRequest)(nil),   // 5: hr.service.v1.ListAbsenceTypesRequest
-	(*ListAbsenceTypesResponse)(nil),  // 6: hr.service.v1.ListAbsenceTypesResponse
-	(*UpdateAbsenceTypeRequest)(nil),  // 7: hr.service.v1.UpdateAbsenceTypeRequest
-	(*UpdateAbsenceTypeResponse)(nil), // 8: hr.service.v1.UpdateAbsenceTypeResponse
-	(*DeleteAbsenceTypeRequest)(nil),  // 9: hr.service.v1.DeleteAbsenceTypeRequest
-	(*structpb.Struct)(nil),           // 10: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),     // 11: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),     // 12: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),             // 13: google.protobuf.Empty
+	(AbsenceUnit)(0),                  // 0: hr.service.v1.AbsenceUnit
+	(*AbsenceType)(nil),               // 1: hr.service.v1.AbsenceType
+	(*CreateAbsenceTypeRequest)(nil),  // 2: hr.service.v1.CreateAbsenceTypeRequest
+	(*CreateAbsenceTypeResponse)(nil), // 3: hr.service.v1.CreateAbsenceTypeResponse
+	(*GetAbsenceTypeRequest)(nil),     // 4: hr.service.v1.GetAbsenceTypeRequest
+	(*GetAbsenceTypeResponse)(nil),    // 5: hr.service.v1.GetAbsenceTypeResponse
+	(*ListAbsenceTypesRequest)(nil),   // 6: hr.service.v1.ListAbsenceTypesRequest
+	(*ListAbsenceTypesResponse)(nil),  // 7: hr.service.v1.ListAbsenceTypesResponse
+	(*UpdateAbsenceTypeRequest)(nil),  // 8: hr.service.v1.UpdateAbsenceTypeRequest
+	(*UpdateAbsenceTypeResponse)(nil), // 9: hr.service.v1.UpdateAbsenceTypeResponse
+	(*DeleteAbsenceTypeRequest)(nil),  // 10: hr.service.v1.DeleteAbsenceTypeRequest
+	(*structpb.Struct)(nil),           // 11: google.protobuf.Struct
+	(*AccrualPolicy)(nil),             // 12: hr.service.v1.AccrualPolicy
+	(*RolloverPolicy)(nil),            // 13: hr.service.v1.RolloverPolicy
+	(*ApprovalChain)(nil),             // 14: hr.service.v1.ApprovalChain
+	(*EscalationPolicy)(nil),          // 15: hr.service.v1.EscalationPolicy
+	(*AttachmentRequirement)(nil),     // 16: hr.service.v1.AttachmentRequirement
+	(*timestamppb.Timestamp)(nil),     // 17: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),     // 18: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),             // 19: google.protobuf.Empty
 }
 var file_hr_service_v1_absence_type_proto_depIdxs = []int32{
-	10, // 0: hr.service.v1.AbsenceType.metadata:type_name -> google.protobuf.Struct
-	11, // 1: hr.service.v1.AbsenceType.created_at:type_name -> google.protobuf.Timestamp
-	11, // 2: hr.service.v1.AbsenceType.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 3: hr.service.v1.CreateAbsenceTypeRequest.metadata:type_name -> google.protobuf.Struct
-	0,  // 4: hr.service.v1.CreateAbsenceTypeResponse.absence_type:type_name -> hr.service.v1.AbsenceType
-	0,  // 5: hr.service.v1.GetAbsenceTypeResponse.absence_type:type_name -> hr.service.v1.AbsenceType
-	0,  // 6: hr.service.v1.ListAbsenceTypesResponse.items:type_name -> hr.service.v1.AbsenceType
-	0,  // 7: hr.service.v1.UpdateAbsenceTypeRequest.data:type_name -> hr.service.v1.AbsenceType
-	12, // 8: hr.service.v1.UpdateAbsenceTypeRequest.update_mask:type_name -> google.protobuf.FieldMask
-	0,  // 9: hr.service.v1.UpdateAbsenceTypeResponse.absence_type:type_name -> hr.service.v1.AbsenceType
-	1,  // 10: hr.service.v1.HrAbsenceTypeService.CreateAbsenceType:input_type -> hr.service.v1.CreateAbsenceTypeRequest
-	3,  // 11: hr.service.v1.HrAbsenceTypeService.GetAbsenceType:input_type -> hr.service.v1.GetAbsenceTypeRequest
-	5,  // 12: hr.service.v1.HrAbsenceTypeService.ListAbsenceTypes:input_type -> hr.service.v1.ListAbsenceTypesRequest
-	7,  // 13: hr.service.v1.HrAbsenceTypeService.UpdateAbsenceType:input_type -> hr.service.v1.UpdateAbsenceTypeRequest
-	9,  // 14: hr.service.v1.HrAbsenceTypeService.DeleteAbsenceType:input_type -> hr.service.v1.DeleteAbsenceTypeRequest
-	2,  // 15: hr.service.v1.HrAbsenceTypeService.CreateAbsenceType:output_type -> hr.service.v1.CreateAbsenceTypeResponse
-	4,  // 16: hr.service.v1.HrAbsenceTypeService.GetAbsenceType:output_type -> hr.service.v1.GetAbsenceTypeResponse
-	6,  // 17: hr.service.v1.HrAbsenceTypeService.ListAbsenceTypes:output_type -> hr.service.v1.ListAbsenceTypesResponse
-	8,  // 18: hr.service.v1.HrAbsenceTypeService.UpdateAbsenceType:output_type -> hr.service.v1.UpdateAbsenceTypeResponse
-	13, // 19: hr.service.v1.HrAbsenceTypeService.DeleteAbsenceType:output_type -> google.protobuf.Empty
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	11, // 0: hr.service.v1.AbsenceType.metadata:type_name -> google.protobuf.Struct
+	0,  // 1: hr.service.v1.AbsenceType.unit:type_name -> hr.service.v1.AbsenceUnit
+	12, // 2: hr.service.v1.AbsenceType.accrual_policy:type_name -> hr.service.v1.AccrualPolicy
+	13, // 3: hr.service.v1.AbsenceType.rollover_policy:type_name -> hr.service.v1.RolloverPolicy
+	14, // 4: hr.service.v1.AbsenceType.approval_chain:type_name -> hr.service.v1.ApprovalChain
+	15, // 5: hr.service.v1.AbsenceType.escalation_policy:type_name -> hr.service.v1.EscalationPolicy
+	16, // 6: hr.service.v1.AbsenceType.requires_attachment:type_name -> hr.service.v1.AttachmentRequirement
+	17, // 7: hr.service.v1.AbsenceType.created_at:type_name -> google.protobuf.Timestamp
+	17, // 8: hr.service.v1.AbsenceType.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 9: hr.service.v1.CreateAbsenceTypeRequest.metadata:type_name -> google.protobuf.Struct
+	0,  // 10: hr.service.v1.CreateAbsenceTypeRequest.unit:type_name -> hr.service.v1.AbsenceUnit
+	12, // 11: hr.service.v1.CreateAbsenceTypeRequest.accrual_policy:type_name -> hr.service.v1.AccrualPolicy
+	13, // 12: hr.service.v1.CreateAbsenceTypeRequest.rollover_policy:type_name -> hr.service.v1.RolloverPolicy
+	14, // 13: hr.service.v1.CreateAbsenceTypeRequest.approval_chain:type_name -> hr.service.v1.ApprovalChain
+	15, // 14: hr.service.v1.CreateAbsenceTypeRequest.escalation_policy:type_name -> hr.service.v1.EscalationPolicy
+	16, // 15: hr.service.v1.CreateAbsenceTypeRequest.requires_attachment:type_name -> hr.service.v1.AttachmentRequirement
+	1,  // 16: hr.service.v1.CreateAbsenceTypeResponse.absence_type:type_name -> hr.service.v1.AbsenceType
+	1,  // 17: hr.service.v1.GetAbsenceTypeResponse.absence_type:type_name -> hr.service.v1.AbsenceType
+	1,  // 18: hr.service.v1.ListAbsenceTypesResponse.items:type_name -> hr.service.v1.AbsenceType
+	1,  // 19: hr.service.v1.UpdateAbsenceTypeRequest.data:type_name -> hr.service.v1.AbsenceType
+	18, // 20: hr.service.v1.UpdateAbsenceTypeRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 21: hr.service.v1.UpdateAbsenceTypeResponse.absence_type:type_name -> hr.service.v1.AbsenceType
+	2,  // 22: hr.service.v1.HrAbsenceTypeService.CreateAbsenceType:input_type -> hr.service.v1.CreateAbsenceTypeRequest
+	4,  // 23: hr.service.v1.HrAbsenceTypeService.GetAbsenceType:input_type -> hr.service.v1.GetAbsenceTypeRequest
+	6,  // 24: hr.service.v1.HrAbsenceTypeService.ListAbsenceTypes:input_type -> hr.service.v1.ListAbsenceTypesRequest
+	8,  // 25: hr.service.v1.HrAbsenceTypeService.UpdateAbsenceType:input_type -> hr.service.v1.UpdateAbsenceTypeRequest
+	10, // 26: hr.service.v1.HrAbsenceTypeService.DeleteAbsenceType:input_type -> hr.service.v1.DeleteAbsenceTypeRequest
+	3,  // 27: hr.service.v1.HrAbsenceTypeService.CreateAbsenceType:output_type -> hr.service.v1.CreateAbsenceTypeResponse
+	5,  // 28: hr.service.v1.HrAbsenceTypeService.GetAbsenceType:output_type -> hr.service.v1.GetAbsenceTypeResponse
+	7,  // 29: hr.service.v1.HrAbsenceTypeService.ListAbsenceTypes:output_type -> hr.service.v1.ListAbsenceTypesResponse
+	9,  // 30: hr.service.v1.HrAbsenceTypeService.UpdateAbsenceType:output_type -> hr.service.v1.UpdateAbsenceTypeResponse
+	19, // 31: hr.service.v1.HrAbsenceTypeService.DeleteAbsenceType:output_type -> google.protobuf.Empty
+	27, // [27:32] is the sub-list for method output_type
+	22, // [22:27] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_hr_service_v1_absence_type_proto_init() }
@@ -944,6 +1138,11 @@ func file_hr_service_v1_absence_type_proto_init() {
 	if File_hr_service_v1_absence_type_proto != nil {
 		return
 	}
+	file_hr_service_v1_accrual_proto_init()
+	file_hr_service_v1_approval_proto_init()
+	file_hr_service_v1_attachment_proto_init()
+	file_hr_service_v1_escalation_proto_init()
+	file_hr_service_v1_rollover_proto_init()
 	file_hr_service_v1_absence_type_proto_msgTypes[0].OneofWrappers = []any{}
 	file_hr_service_v1_absence_type_proto_msgTypes[1].OneofWrappers = []any{}
 	file_hr_service_v1_absence_type_proto_msgTypes[5].OneofWrappers = []any{}
@@ -954,13 +1153,14 @@ func file_hr_service_v1_absence_type_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hr_service_v1_absence_type_proto_rawDesc), len(file_hr_service_v1_absence_type_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_hr_service_v1_absence_type_proto_goTypes,
 		DependencyIndexes: file_hr_service_v1_absence_type_proto_depIdxs,
+		EnumInfos:         file_hr_service_v1_absence_type_proto_enumTypes,
 		MessageInfos:      file_hr_service_v1_absence_type_proto_msgTypes,
 	}.Build()
 	File_hr_service_v1_absence_type_proto = out.File
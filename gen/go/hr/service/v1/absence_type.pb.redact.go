@@ -139,6 +139,18 @@ func (x *AbsenceType) Redact() string {
 
 	// Safe field: AllowancePoolId
 
+	// Safe field: Unit
+
+	// Safe field: AccrualPolicy
+
+	// Safe field: RolloverPolicy
+
+	// Safe field: ApprovalChain
+
+	// Safe field: EscalationPolicy
+
+	// Safe field: RequiresAttachment
+
 	// Safe field: CreatedAt
 
 	// Safe field: UpdatedAt
@@ -180,6 +192,18 @@ func (x *CreateAbsenceTypeRequest) Redact() string {
 	// Safe field: SigningTemplateId
 
 	// Safe field: AllowancePoolId
+
+	// Safe field: Unit
+
+	// Safe field: AccrualPolicy
+
+	// Safe field: RolloverPolicy
+
+	// Safe field: ApprovalChain
+
+	// Safe field: EscalationPolicy
+
+	// Safe field: RequiresAttachment
 	return x.String()
 }
 
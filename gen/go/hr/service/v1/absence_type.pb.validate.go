@@ -138,6 +138,175 @@ func (m *AbsenceType) validate(all bool) error {
 		// no validation rules for AllowancePoolId
 	}
 
+	if m.Unit != nil {
+		// no validation rules for Unit
+	}
+
+	if m.AccrualPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetAccrualPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AbsenceTypeValidationError{
+						field:  "AccrualPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AbsenceTypeValidationError{
+						field:  "AccrualPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccrualPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AbsenceTypeValidationError{
+					field:  "AccrualPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RolloverPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRolloverPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AbsenceTypeValidationError{
+						field:  "RolloverPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AbsenceTypeValidationError{
+						field:  "RolloverPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRolloverPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AbsenceTypeValidationError{
+					field:  "RolloverPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ApprovalChain != nil {
+
+		if all {
+			switch v := interface{}(m.GetApprovalChain()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AbsenceTypeValidationError{
+						field:  "ApprovalChain",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AbsenceTypeValidationError{
+						field:  "ApprovalChain",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetApprovalChain()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AbsenceTypeValidationError{
+					field:  "ApprovalChain",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.EscalationPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetEscalationPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AbsenceTypeValidationError{
+						field:  "EscalationPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AbsenceTypeValidationError{
+						field:  "EscalationPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEscalationPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AbsenceTypeValidationError{
+					field:  "EscalationPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RequiresAttachment != nil {
+
+		if all {
+			switch v := interface{}(m.GetRequiresAttachment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AbsenceTypeValidationError{
+						field:  "RequiresAttachment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AbsenceTypeValidationError{
+						field:  "RequiresAttachment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRequiresAttachment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AbsenceTypeValidationError{
+					field:  "RequiresAttachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if m.CreatedAt != nil {
 
 		if all {
@@ -388,6 +557,175 @@ func (m *CreateAbsenceTypeRequest) validate(all bool) error {
 		// no validation rules for AllowancePoolId
 	}
 
+	if m.Unit != nil {
+		// no validation rules for Unit
+	}
+
+	if m.AccrualPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetAccrualPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAbsenceTypeRequestValidationError{
+						field:  "AccrualPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAbsenceTypeRequestValidationError{
+						field:  "AccrualPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccrualPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAbsenceTypeRequestValidationError{
+					field:  "AccrualPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RolloverPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRolloverPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAbsenceTypeRequestValidationError{
+						field:  "RolloverPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAbsenceTypeRequestValidationError{
+						field:  "RolloverPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRolloverPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAbsenceTypeRequestValidationError{
+					field:  "RolloverPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ApprovalChain != nil {
+
+		if all {
+			switch v := interface{}(m.GetApprovalChain()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAbsenceTypeRequestValidationError{
+						field:  "ApprovalChain",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAbsenceTypeRequestValidationError{
+						field:  "ApprovalChain",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetApprovalChain()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAbsenceTypeRequestValidationError{
+					field:  "ApprovalChain",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.EscalationPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetEscalationPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAbsenceTypeRequestValidationError{
+						field:  "EscalationPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAbsenceTypeRequestValidationError{
+						field:  "EscalationPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEscalationPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAbsenceTypeRequestValidationError{
+					field:  "EscalationPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RequiresAttachment != nil {
+
+		if all {
+			switch v := interface{}(m.GetRequiresAttachment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAbsenceTypeRequestValidationError{
+						field:  "RequiresAttachment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAbsenceTypeRequestValidationError{
+						field:  "RequiresAttachment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRequiresAttachment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAbsenceTypeRequestValidationError{
+					field:  "RequiresAttachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateAbsenceTypeRequestMultiError(errors)
 	}
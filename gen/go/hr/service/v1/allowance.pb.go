@@ -26,6 +26,118 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// AllowanceTransactionKind is the type of change recorded in the allowance ledger
+type AllowanceTransactionKind int32
+
+const (
+	AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_UNSPECIFIED AllowanceTransactionKind = 0
+	AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_GRANT       AllowanceTransactionKind = 1
+	AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_DEDUCTION   AllowanceTransactionKind = 2
+	AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_REFUND      AllowanceTransactionKind = 3
+	AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_CARRY_OVER  AllowanceTransactionKind = 4
+	AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_ADJUSTMENT  AllowanceTransactionKind = 5
+)
+
+// Enum value maps for AllowanceTransactionKind.
+var (
+	AllowanceTransactionKind_name = map[int32]string{
+		0: "ALLOWANCE_TRANSACTION_KIND_UNSPECIFIED",
+		1: "ALLOWANCE_TRANSACTION_KIND_GRANT",
+		2: "ALLOWANCE_TRANSACTION_KIND_DEDUCTION",
+		3: "ALLOWANCE_TRANSACTION_KIND_REFUND",
+		4: "ALLOWANCE_TRANSACTION_KIND_CARRY_OVER",
+		5: "ALLOWANCE_TRANSACTION_KIND_ADJUSTMENT",
+	}
+	AllowanceTransactionKind_value = map[string]int32{
+		"ALLOWANCE_TRANSACTION_KIND_UNSPECIFIED": 0,
+		"ALLOWANCE_TRANSACTION_KIND_GRANT":       1,
+		"ALLOWANCE_TRANSACTION_KIND_DEDUCTION":   2,
+		"ALLOWANCE_TRANSACTION_KIND_REFUND":      3,
+		"ALLOWANCE_TRANSACTION_KIND_CARRY_OVER":  4,
+		"ALLOWANCE_TRANSACTION_KIND_ADJUSTMENT":  5,
+	}
+)
+
+func (x AllowanceTransactionKind) Enum() *AllowanceTransactionKind {
+	p := new(AllowanceTransactionKind)
+	*p = x
+	return p
+}
+
+func (x AllowanceTransactionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AllowanceTransactionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_hr_service_v1_allowance_proto_enumTypes[0].Descriptor()
+}
+
+func (AllowanceTransactionKind) Type() protoreflect.EnumType {
+	return &file_hr_service_v1_allowance_proto_enumTypes[0]
+}
+
+func (x AllowanceTransactionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AllowanceTransactionKind.Descriptor instead.
+func (AllowanceTransactionKind) EnumDescriptor() ([]byte, []int) {
+	return file_hr_service_v1_allowance_proto_rawDescGZIP(), []int{0}
+}
+
+// RolloverAction is what a rollover does for one allowance
+type RolloverAction int32
+
+const (
+	RolloverAction_ROLLOVER_ACTION_UNSPECIFIED RolloverAction = 0
+	RolloverAction_ROLLOVER_ACTION_CREATE      RolloverAction = 1 // Create the next year's allowance
+	RolloverAction_ROLLOVER_ACTION_UPDATE      RolloverAction = 2 // Carry days over into an existing next year's allowance
+	RolloverAction_ROLLOVER_ACTION_SKIP        RolloverAction = 3 // Leave the next year untouched (see skip_reason)
+)
+
+// Enum value maps for RolloverAction.
+var (
+	RolloverAction_name = map[int32]string{
+		0: "ROLLOVER_ACTION_UNSPECIFIED",
+		1: "ROLLOVER_ACTION_CREATE",
+		2: "ROLLOVER_ACTION_UPDATE",
+		3: "ROLLOVER_ACTION_SKIP",
+	}
+	RolloverAction_value = map[string]int32{
+		"ROLLOVER_ACTION_UNSPECIFIED": 0,
+		"ROLLOVER_ACTION_CREATE":      1,
+		"ROLLOVER_ACTION_UPDATE":      2,
+		"ROLLOVER_ACTION_SKIP":        3,
+	}
+)
+
+func (x RolloverAction) Enum() *RolloverAction {
+	p := new(RolloverAction)
+	*p = x
+	return p
+}
+
+func (x RolloverAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RolloverAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_hr_service_v1_allowance_proto_enumTypes[1].Descriptor()
+}
+
+func (RolloverAction) Type() protoreflect.EnumType {
+	return &file_hr_service_v1_allowance_proto_enumTypes[1]
+}
+
+func (x RolloverAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RolloverAction.Descriptor instead.
+func (RolloverAction) EnumDescriptor() ([]byte, []int) {
+	return file_hr_service_v1_allowance_proto_rawDescGZIP(), []int{1}
+}
+
 // LeaveAllowance represents a user's leave allowance for a specific type and year
 type LeaveAllowance struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -39,6 +151,16 @@ type LeaveAllowance struct {
 	CarriedOver     *float64               `protobuf:"fixed64,8,opt,name=carried_over,json=carriedOver,proto3,oneof" json:"carried_over,omitempty"`
 	Notes           *string                `protobuf:"bytes,9,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
 	AllowancePoolId *string                `protobuf:"bytes,10,opt,name=allowance_pool_id,json=allowancePoolId,proto3,oneof" json:"allowance_pool_id,omitempty"`
+	// Days posted by accrual so far (included in total_days)
+	AccruedDays *float64 `protobuf:"fixed64,11,opt,name=accrued_days,json=accruedDays,proto3,oneof" json:"accrued_days,omitempty"`
+	// Date the user starts accruing; defaults to 1 January of the year
+	AccrualStart *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=accrual_start,json=accrualStart,proto3,oneof" json:"accrual_start,omitempty"`
+	// Last day carried-over days can be used; unused carried-over days lapse afterwards
+	CarryOverExpiry *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=carry_over_expiry,json=carryOverExpiry,proto3,oneof" json:"carry_over_expiry,omitempty"`
+	// Full-year entitlement total_days was pro-rated from; unset when not pro-rated
+	AnnualDays *float64 `protobuf:"fixed64,14,opt,name=annual_days,json=annualDays,proto3,oneof" json:"annual_days,omitempty"`
+	// Days a leaver used beyond their pro-rated entitlement, to be settled in final pay
+	OverconsumedDays *float64 `protobuf:"fixed64,15,opt,name=overconsumed_days,json=overconsumedDays,proto3,oneof" json:"overconsumed_days,omitempty"`
 	// Denormalized for display
 	AbsenceTypeName   *string                `protobuf:"bytes,30,opt,name=absence_type_name,json=absenceTypeName,proto3,oneof" json:"absence_type_name,omitempty"`
 	UserName          *string                `protobuf:"bytes,31,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty"`
@@ -151,6 +273,41 @@ func (x *LeaveAllowance) GetAllowancePoolId() string {
 	return ""
 }
 
+func (x *LeaveAllowance) GetAccruedDays() float64 {
+	if x != nil && x.AccruedDays != nil {
+		return *x.AccruedDays
+	}
+	return 0
+}
+
+func (x *LeaveAllowance) GetAccrualStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AccrualStart
+	}
+	return nil
+}
+
+func (x *LeaveAllowance) GetCarryOverExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CarryOverExpiry
+	}
+	return nil
+}
+
+func (x *LeaveAllowance) GetAnnualDays() float64 {
+	if x != nil && x.AnnualDays != nil {
+		return *x.AnnualDays
+	}
+	return 0
+}
+
+func (x *LeaveAllowance) GetOverconsumedDays() float64 {
+	if x != nil && x.OverconsumedDays != nil {
+		return *x.OverconsumedDays
+	}
+	return 0
+}
+
 func (x *LeaveAllowance) GetAbsenceTypeName() string {
 	if x != nil && x.AbsenceTypeName != nil {
 		return *x.AbsenceTypeName
@@ -212,8 +369,16 @@ type CreateAllowanceRequest struct {
 	CarriedOver     *float64 `protobuf:"fixed64,6,opt,name=carried_over,json=carriedOver,proto3,oneof" json:"carried_over,omitempty"`
 	Notes           *string  `protobuf:"bytes,7,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
 	UserName        *string  `protobuf:"bytes,8,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Date the user starts accruing, for absence types or pools with an accrual policy
+	AccrualStart *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=accrual_start,json=accrualStart,proto3,oneof" json:"accrual_start,omitempty"`
+	// Last day the carried-over days can be used
+	CarryOverExpiry *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=carry_over_expiry,json=carryOverExpiry,proto3,oneof" json:"carry_over_expiry,omitempty"`
+	// Pro-rate total_days, given as the full-year entitlement, to the part of the year the user
+	// is employed according to their employment dates
+	Prorate       *bool             `protobuf:"varint,12,opt,name=prorate,proto3,oneof" json:"prorate,omitempty"`
+	Rounding      ProrationRounding `protobuf:"varint,13,opt,name=rounding,proto3,enum=hr.service.v1.ProrationRounding" json:"rounding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateAllowanceRequest) Reset() {
@@ -309,6 +474,34 @@ func (x *CreateAllowanceRequest) GetUserName() string {
 	return ""
 }
 
+func (x *CreateAllowanceRequest) GetAccrualStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AccrualStart
+	}
+	return nil
+}
+
+func (x *CreateAllowanceRequest) GetCarryOverExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CarryOverExpiry
+	}
+	return nil
+}
+
+func (x *CreateAllowanceRequest) GetProrate() bool {
+	if x != nil && x.Prorate != nil {
+		return *x.Prorate
+	}
+	return false
+}
+
+func (x *CreateAllowanceRequest) GetRounding() ProrationRounding {
+	if x != nil {
+		return x.Rounding
+	}
+	return ProrationRounding_PRORATION_ROUNDING_UNSPECIFIED
+}
+
 type CreateAllowanceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Allowance     *LeaveAllowance        `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
@@ -451,6 +644,8 @@ type ListAllowancesRequest struct {
 	UserId        *uint32 `protobuf:"varint,10,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
 	Year          *int32  `protobuf:"varint,11,opt,name=year,proto3,oneof" json:"year,omitempty"`
 	AbsenceTypeId *string `protobuf:"bytes,12,opt,name=absence_type_id,json=absenceTypeId,proto3,oneof" json:"absence_type_id,omitempty"`
+	// Only allowances flagged as over-consumed by a leaver
+	Overconsumed  *bool `protobuf:"varint,13,opt,name=overconsumed,proto3,oneof" json:"overconsumed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -534,6 +729,13 @@ func (x *ListAllowancesRequest) GetAbsenceTypeId() string {
 	return ""
 }
 
+func (x *ListAllowancesRequest) GetOverconsumed() bool {
+	if x != nil && x.Overconsumed != nil {
+		return *x.Overconsumed
+	}
+	return false
+}
+
 type ListAllowancesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*LeaveAllowance      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -587,10 +789,12 @@ func (x *ListAllowancesResponse) GetTotal() int32 {
 }
 
 type UpdateAllowanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Data          *LeaveAllowance        `protobuf:"bytes,2,opt,name=data,proto3,oneof" json:"data,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data       *LeaveAllowance        `protobuf:"bytes,2,opt,name=data,proto3,oneof" json:"data,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Recorded on the ledger entries for changes to total or carried-over days
+	Reason        *string `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -646,6 +850,13 @@ func (x *UpdateAllowanceRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+func (x *UpdateAllowanceRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 type UpdateAllowanceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Allowance     *LeaveAllowance        `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
@@ -749,8 +960,17 @@ type BalanceEntry struct {
 	AllowancePoolName *string `protobuf:"bytes,9,opt,name=allowance_pool_name,json=allowancePoolName,proto3,oneof" json:"allowance_pool_name,omitempty"`
 	// Absence types that share this pool (populated for pool entries)
 	MemberAbsenceTypeIds []string `protobuf:"bytes,10,rep,name=member_absence_type_ids,json=memberAbsenceTypeIds,proto3" json:"member_absence_type_ids,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Accrual figures (set when the absence type or pool has an accrual policy)
+	// Days earned so far this year
+	AccruedToDate *float64 `protobuf:"fixed64,11,opt,name=accrued_to_date,json=accruedToDate,proto3,oneof" json:"accrued_to_date,omitempty"`
+	// Remaining days expected at year end once the rest of the year has been earned
+	ProjectedYearEnd *float64 `protobuf:"fixed64,12,opt,name=projected_year_end,json=projectedYearEnd,proto3,oneof" json:"projected_year_end,omitempty"`
+	// Last day carried-over days can be used
+	CarryOverExpiry *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=carry_over_expiry,json=carryOverExpiry,proto3,oneof" json:"carry_over_expiry,omitempty"`
+	// Days a leaver used beyond their pro-rated entitlement
+	OverconsumedDays *float64 `protobuf:"fixed64,14,opt,name=overconsumed_days,json=overconsumedDays,proto3,oneof" json:"overconsumed_days,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *BalanceEntry) Reset() {
@@ -853,6 +1073,34 @@ func (x *BalanceEntry) GetMemberAbsenceTypeIds() []string {
 	return nil
 }
 
+func (x *BalanceEntry) GetAccruedToDate() float64 {
+	if x != nil && x.AccruedToDate != nil {
+		return *x.AccruedToDate
+	}
+	return 0
+}
+
+func (x *BalanceEntry) GetProjectedYearEnd() float64 {
+	if x != nil && x.ProjectedYearEnd != nil {
+		return *x.ProjectedYearEnd
+	}
+	return 0
+}
+
+func (x *BalanceEntry) GetCarryOverExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CarryOverExpiry
+	}
+	return nil
+}
+
+func (x *BalanceEntry) GetOverconsumedDays() float64 {
+	if x != nil && x.OverconsumedDays != nil {
+		return *x.OverconsumedDays
+	}
+	return 0
+}
+
 type GetUserBalanceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -965,153 +1213,927 @@ func (x *GetUserBalanceResponse) GetEntries() []*BalanceEntry {
 	return nil
 }
 
-var File_hr_service_v1_allowance_proto protoreflect.FileDescriptor
+// AllowanceTransaction is an immutable ledger entry recording a change to an allowance
+type AllowanceTransaction struct {
+	state       protoimpl.MessageState   `protogen:"open.v1"`
+	Id          string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId    *uint32                  `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	AllowanceId string                   `protobuf:"bytes,3,opt,name=allowance_id,json=allowanceId,proto3" json:"allowance_id,omitempty"`
+	UserId      uint32                   `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Year        int32                    `protobuf:"varint,5,opt,name=year,proto3" json:"year,omitempty"`
+	Kind        AllowanceTransactionKind `protobuf:"varint,6,opt,name=kind,proto3,enum=hr.service.v1.AllowanceTransactionKind" json:"kind,omitempty"`
+	// Signed number of days changed
+	Days float64 `protobuf:"fixed64,7,opt,name=days,proto3" json:"days,omitempty"`
+	// Remaining days after the entry was applied
+	BalanceAfter   float64 `protobuf:"fixed64,8,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	LeaveRequestId *string `protobuf:"bytes,9,opt,name=leave_request_id,json=leaveRequestId,proto3,oneof" json:"leave_request_id,omitempty"`
+	// User who caused the entry; 0 for system actions
+	ActorId       uint32                 `protobuf:"varint,10,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorName     string                 `protobuf:"bytes,11,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
+	Note          *string                `protobuf:"bytes,12,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_hr_service_v1_allowance_proto_rawDesc = "" +
-	"\n" +
-	"\x1dhr/service/v1/allowance.proto\x12\rhr.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xb9\a\n" +
-	"\x0eLeaveAllowance\x12\x13\n" +
-	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12 \n" +
-	"\ttenant_id\x18\x02 \x01(\rH\x01R\btenantId\x88\x01\x01\x12\x1c\n" +
-	"\auser_id\x18\x03 \x01(\rH\x02R\x06userId\x88\x01\x01\x12+\n" +
-	"\x0fabsence_type_id\x18\x04 \x01(\tH\x03R\rabsenceTypeId\x88\x01\x01\x12\x17\n" +
-	"\x04year\x18\x05 \x01(\x05H\x04R\x04year\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"total_days\x18\x06 \x01(\x01H\x05R\ttotalDays\x88\x01\x01\x12 \n" +
-	"\tused_days\x18\a \x01(\x01H\x06R\busedDays\x88\x01\x01\x12&\n" +
-	"\fcarried_over\x18\b \x01(\x01H\aR\vcarriedOver\x88\x01\x01\x12\x19\n" +
-	"\x05notes\x18\t \x01(\tH\bR\x05notes\x88\x01\x01\x12/\n" +
-	"\x11allowance_pool_id\x18\n" +
-	" \x01(\tH\tR\x0fallowancePoolId\x88\x01\x01\x12/\n" +
-	"\x11absence_type_name\x18\x1e \x01(\tH\n" +
-	"R\x0fabsenceTypeName\x88\x01\x01\x12 \n" +
-	"\tuser_name\x18\x1f \x01(\tH\vR\buserName\x88\x01\x01\x123\n" +
-	"\x13allowance_pool_name\x18  \x01(\tH\fR\x11allowancePoolName\x88\x01\x01\x12>\n" +
-	"\n" +
-	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\rR\tcreatedAt\x88\x01\x01\x12>\n" +
-	"\n" +
-	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\x0eR\tupdatedAt\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"created_by\x18\x16 \x01(\rH\x0fR\tcreatedBy\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"updated_by\x18\x17 \x01(\rH\x10R\tupdatedBy\x88\x01\x01B\x05\n" +
-	"\x03_idB\f\n" +
-	"\n" +
-	"_tenant_idB\n" +
-	"\n" +
-	"\b_user_idB\x12\n" +
-	"\x10_absence_type_idB\a\n" +
-	"\x05_yearB\r\n" +
-	"\v_total_daysB\f\n" +
-	"\n" +
-	"_used_daysB\x0f\n" +
-	"\r_carried_overB\b\n" +
-	"\x06_notesB\x14\n" +
-	"\x12_allowance_pool_idB\x14\n" +
-	"\x12_absence_type_nameB\f\n" +
-	"\n" +
-	"_user_nameB\x16\n" +
-	"\x14_allowance_pool_nameB\r\n" +
-	"\v_created_atB\r\n" +
-	"\v_updated_atB\r\n" +
-	"\v_created_byB\r\n" +
-	"\v_updated_by\"\x93\x04\n" +
-	"\x16CreateAllowanceRequest\x12%\n" +
-	"\ttenant_id\x18\x01 \x01(\rB\x03\xe0A\x02H\x00R\btenantId\x88\x01\x01\x12!\n" +
-	"\auser_id\x18\x02 \x01(\rB\x03\xe0A\x02H\x01R\x06userId\x88\x01\x01\x12+\n" +
-	"\x0fabsence_type_id\x18\x03 \x01(\tH\x02R\rabsenceTypeId\x88\x01\x01\x12/\n" +
-	"\x11allowance_pool_id\x18\t \x01(\tH\x03R\x0fallowancePoolId\x88\x01\x01\x12'\n" +
-	"\x04year\x18\x04 \x01(\x05B\x0e\xe0A\x02\xbaH\b\x1a\x06\x18\xb3\x10(\xd0\x0fH\x04R\x04year\x88\x01\x01\x12>\n" +
-	"\n" +
-	"total_days\x18\x05 \x01(\x01B\x1a\xe0A\x02\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\xd0v@!\x00\x00\x00\x00\x00\x00\x00\x00H\x05R\ttotalDays\x88\x01\x01\x12&\n" +
-	"\fcarried_over\x18\x06 \x01(\x01H\x06R\vcarriedOver\x88\x01\x01\x12\x19\n" +
-	"\x05notes\x18\a \x01(\tH\aR\x05notes\x88\x01\x01\x12 \n" +
-	"\tuser_name\x18\b \x01(\tH\bR\buserName\x88\x01\x01B\f\n" +
-	"\n" +
-	"_tenant_idB\n" +
-	"\n" +
-	"\b_user_idB\x12\n" +
-	"\x10_absence_type_idB\x14\n" +
-	"\x12_allowance_pool_idB\a\n" +
-	"\x05_yearB\r\n" +
-	"\v_total_daysB\x0f\n" +
-	"\r_carried_overB\b\n" +
-	"\x06_notesB\f\n" +
-	"\n" +
-	"_user_name\"V\n" +
-	"\x17CreateAllowanceResponse\x12;\n" +
-	"\tallowance\x18\x01 \x01(\v2\x1d.hr.service.v1.LeaveAllowanceR\tallowance\"1\n" +
-	"\x13GetAllowanceRequest\x12\x1a\n" +
-	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\"S\n" +
-	"\x14GetAllowanceResponse\x12;\n" +
-	"\tallowance\x18\x01 \x01(\v2\x1d.hr.service.v1.LeaveAllowanceR\tallowance\"\xe3\x02\n" +
-	"\x15ListAllowancesRequest\x12 \n" +
-	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12\x17\n" +
-	"\x04page\x18\x02 \x01(\x05H\x01R\x04page\x88\x01\x01\x12 \n" +
-	"\tpage_size\x18\x03 \x01(\x05H\x02R\bpageSize\x88\x01\x01\x12 \n" +
-	"\tno_paging\x18\x04 \x01(\bH\x03R\bnoPaging\x88\x01\x01\x12\x1c\n" +
-	"\auser_id\x18\n" +
-	" \x01(\rH\x04R\x06userId\x88\x01\x01\x12$\n" +
-	"\x04year\x18\v \x01(\x05B\v\xbaH\b\x1a\x06\x18\xb3\x10(\xd0\x0fH\x05R\x04year\x88\x01\x01\x12+\n" +
-	"\x0fabsence_type_id\x18\f \x01(\tH\x06R\rabsenceTypeId\x88\x01\x01B\f\n" +
-	"\n" +
-	"_tenant_idB\a\n" +
-	"\x05_pageB\f\n" +
-	"\n" +
-	"_page_sizeB\f\n" +
-	"\n" +
-	"_no_pagingB\n" +
-	"\n" +
-	"\b_user_idB\a\n" +
-	"\x05_yearB\x12\n" +
-	"\x10_absence_type_id\"r\n" +
-	"\x16ListAllowancesResponse\x123\n" +
-	"\x05items\x18\x01 \x03(\v2\x1d.hr.service.v1.LeaveAllowanceR\x05items\x12\x19\n" +
-	"\x05total\x18\x02 \x01(\x05H\x00R\x05total\x88\x01\x01B\b\n" +
-	"\x06_total\"\xb2\x01\n" +
-	"\x16UpdateAllowanceRequest\x12\x1a\n" +
-	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\x126\n" +
-	"\x04data\x18\x02 \x01(\v2\x1d.hr.service.v1.LeaveAllowanceH\x00R\x04data\x88\x01\x01\x12;\n" +
-	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMaskB\a\n" +
-	"\x05_data\"V\n" +
-	"\x17UpdateAllowanceResponse\x12;\n" +
-	"\tallowance\x18\x01 \x01(\v2\x1d.hr.service.v1.LeaveAllowanceR\tallowance\"4\n" +
-	"\x16DeleteAllowanceRequest\x12\x1a\n" +
-	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\"\xc9\x03\n" +
-	"\fBalanceEntry\x12&\n" +
-	"\x0fabsence_type_id\x18\x01 \x01(\tR\rabsenceTypeId\x12*\n" +
-	"\x11absence_type_name\x18\x02 \x01(\tR\x0fabsenceTypeName\x12\x14\n" +
-	"\x05color\x18\x03 \x01(\tR\x05color\x12\x1d\n" +
-	"\n" +
-	"total_days\x18\x04 \x01(\x01R\ttotalDays\x12\x1b\n" +
-	"\tused_days\x18\x05 \x01(\x01R\busedDays\x12!\n" +
-	"\fcarried_over\x18\x06 \x01(\x01R\vcarriedOver\x12%\n" +
-	"\x0eremaining_days\x18\a \x01(\x01R\rremainingDays\x12/\n" +
-	"\x11allowance_pool_id\x18\b \x01(\tH\x00R\x0fallowancePoolId\x88\x01\x01\x123\n" +
-	"\x13allowance_pool_name\x18\t \x01(\tH\x01R\x11allowancePoolName\x88\x01\x01\x125\n" +
-	"\x17member_absence_type_ids\x18\n" +
-	" \x03(\tR\x14memberAbsenceTypeIdsB\x14\n" +
-	"\x12_allowance_pool_idB\x16\n" +
-	"\x14_allowance_pool_name\"d\n" +
-	"\x15GetUserBalanceRequest\x12\x1c\n" +
-	"\auser_id\x18\x01 \x01(\rB\x03\xe0A\x02R\x06userId\x12$\n" +
-	"\x04year\x18\x02 \x01(\x05B\v\xbaH\b\x1a\x06\x18\xb3\x10(\xd0\x0fH\x00R\x04year\x88\x01\x01B\a\n" +
-	"\x05_year\"|\n" +
-	"\x16GetUserBalanceResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x12\n" +
-	"\x04year\x18\x02 \x01(\x05R\x04year\x125\n" +
-	"\aentries\x18\x03 \x03(\v2\x1b.hr.service.v1.BalanceEntryR\aentries2\xf5\x05\n" +
+func (x *AllowanceTransaction) Reset() {
+	*x = AllowanceTransaction{}
+	mi := &file_hr_service_v1_allowance_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllowanceTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowanceTransaction) ProtoMessage() {}
+
+func (x *AllowanceTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_allowance_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowanceTransaction.ProtoReflect.Descriptor instead.
+func (*AllowanceTransaction) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_allowance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AllowanceTransaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AllowanceTransaction) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *AllowanceTransaction) GetAllowanceId() string {
+	if x != nil {
+		return x.AllowanceId
+	}
+	return ""
+}
+
+func (x *AllowanceTransaction) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AllowanceTransaction) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *AllowanceTransaction) GetKind() AllowanceTransactionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_UNSPECIFIED
+}
+
+func (x *AllowanceTransaction) GetDays() float64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *AllowanceTransaction) GetBalanceAfter() float64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *AllowanceTransaction) GetLeaveRequestId() string {
+	if x != nil && x.LeaveRequestId != nil {
+		return *x.LeaveRequestId
+	}
+	return ""
+}
+
+func (x *AllowanceTransaction) GetActorId() uint32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *AllowanceTransaction) GetActorName() string {
+	if x != nil {
+		return x.ActorName
+	}
+	return ""
+}
+
+func (x *AllowanceTransaction) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *AllowanceTransaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListAllowanceTransactionsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId *uint32                `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	Page     *int32                 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *int32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	NoPaging *bool                  `protobuf:"varint,4,opt,name=no_paging,json=noPaging,proto3,oneof" json:"no_paging,omitempty"`
+	// Filters
+	AllowanceId    *string                   `protobuf:"bytes,10,opt,name=allowance_id,json=allowanceId,proto3,oneof" json:"allowance_id,omitempty"`
+	UserId         *uint32                   `protobuf:"varint,11,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Year           *int32                    `protobuf:"varint,12,opt,name=year,proto3,oneof" json:"year,omitempty"`
+	LeaveRequestId *string                   `protobuf:"bytes,13,opt,name=leave_request_id,json=leaveRequestId,proto3,oneof" json:"leave_request_id,omitempty"`
+	Kind           *AllowanceTransactionKind `protobuf:"varint,14,opt,name=kind,proto3,enum=hr.service.v1.AllowanceTransactionKind,oneof" json:"kind,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAllowanceTransactionsRequest) Reset() {
+	*x = ListAllowanceTransactionsRequest{}
+	mi := &file_hr_service_v1_allowance_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllowanceTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllowanceTransactionsRequest) ProtoMessage() {}
+
+func (x *ListAllowanceTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_allowance_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllowanceTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllowanceTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_allowance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListAllowanceTransactionsRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *ListAllowanceTransactionsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListAllowanceTransactionsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAllowanceTransactionsRequest) GetNoPaging() bool {
+	if x != nil && x.NoPaging != nil {
+		return *x.NoPaging
+	}
+	return false
+}
+
+func (x *ListAllowanceTransactionsRequest) GetAllowanceId() string {
+	if x != nil && x.AllowanceId != nil {
+		return *x.AllowanceId
+	}
+	return ""
+}
+
+func (x *ListAllowanceTransactionsRequest) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *ListAllowanceTransactionsRequest) GetYear() int32 {
+	if x != nil && x.Year != nil {
+		return *x.Year
+	}
+	return 0
+}
+
+func (x *ListAllowanceTransactionsRequest) GetLeaveRequestId() string {
+	if x != nil && x.LeaveRequestId != nil {
+		return *x.LeaveRequestId
+	}
+	return ""
+}
+
+func (x *ListAllowanceTransactionsRequest) GetKind() AllowanceTransactionKind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_UNSPECIFIED
+}
+
+type ListAllowanceTransactionsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Items         []*AllowanceTransaction `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         *int32                  `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllowanceTransactionsResponse) Reset() {
+	*x = ListAllowanceTransactionsResponse{}
+	mi := &file_hr_service_v1_allowance_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllowanceTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllowanceTransactionsResponse) ProtoMessage() {}
+
+func (x *ListAllowanceTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_allowance_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllowanceTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllowanceTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_allowance_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAllowanceTransactionsResponse) GetItems() []*AllowanceTransaction {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListAllowanceTransactionsResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+type RolloverAllowancesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId *uint32                `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Year whose allowances are rolled over into the following year
+	FromYear int32 `protobuf:"varint,2,opt,name=from_year,json=fromYear,proto3" json:"from_year,omitempty"`
+	// Return the per-user preview without changing anything
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Filters
+	UserId          *uint32 `protobuf:"varint,10,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	AbsenceTypeId   *string `protobuf:"bytes,11,opt,name=absence_type_id,json=absenceTypeId,proto3,oneof" json:"absence_type_id,omitempty"`
+	AllowancePoolId *string `protobuf:"bytes,12,opt,name=allowance_pool_id,json=allowancePoolId,proto3,oneof" json:"allowance_pool_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RolloverAllowancesRequest) Reset() {
+	*x = RolloverAllowancesRequest{}
+	mi := &file_hr_service_v1_allowance_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloverAllowancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloverAllowancesRequest) ProtoMessage() {}
+
+func (x *RolloverAllowancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_allowance_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloverAllowancesRequest.ProtoReflect.Descriptor instead.
+func (*RolloverAllowancesRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_allowance_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RolloverAllowancesRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *RolloverAllowancesRequest) GetFromYear() int32 {
+	if x != nil {
+		return x.FromYear
+	}
+	return 0
+}
+
+func (x *RolloverAllowancesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RolloverAllowancesRequest) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *RolloverAllowancesRequest) GetAbsenceTypeId() string {
+	if x != nil && x.AbsenceTypeId != nil {
+		return *x.AbsenceTypeId
+	}
+	return ""
+}
+
+func (x *RolloverAllowancesRequest) GetAllowancePoolId() string {
+	if x != nil && x.AllowancePoolId != nil {
+		return *x.AllowancePoolId
+	}
+	return ""
+}
+
+// RolloverItem is the rollover of one allowance into the next year
+type RolloverItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName          string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	AbsenceTypeId     *string                `protobuf:"bytes,3,opt,name=absence_type_id,json=absenceTypeId,proto3,oneof" json:"absence_type_id,omitempty"`
+	AllowancePoolId   *string                `protobuf:"bytes,4,opt,name=allowance_pool_id,json=allowancePoolId,proto3,oneof" json:"allowance_pool_id,omitempty"`
+	Name              string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	SourceAllowanceId string                 `protobuf:"bytes,6,opt,name=source_allowance_id,json=sourceAllowanceId,proto3" json:"source_allowance_id,omitempty"`
+	// Next year's allowance; unset when it is still to be created
+	TargetAllowanceId *string        `protobuf:"bytes,7,opt,name=target_allowance_id,json=targetAllowanceId,proto3,oneof" json:"target_allowance_id,omitempty"`
+	Action            RolloverAction `protobuf:"varint,8,opt,name=action,proto3,enum=hr.service.v1.RolloverAction" json:"action,omitempty"`
+	SkipReason        *string        `protobuf:"bytes,9,opt,name=skip_reason,json=skipReason,proto3,oneof" json:"skip_reason,omitempty"`
+	// Unused days of the source allowance
+	RemainingDays float64 `protobuf:"fixed64,10,opt,name=remaining_days,json=remainingDays,proto3" json:"remaining_days,omitempty"`
+	// Total days of the next year's allowance
+	TotalDays   float64 `protobuf:"fixed64,11,opt,name=total_days,json=totalDays,proto3" json:"total_days,omitempty"`
+	CarriedOver float64 `protobuf:"fixed64,12,opt,name=carried_over,json=carriedOver,proto3" json:"carried_over,omitempty"`
+	// Unused days above the carry-over cap, or all unused days when nothing is carried over
+	ForfeitedDays   float64                `protobuf:"fixed64,13,opt,name=forfeited_days,json=forfeitedDays,proto3" json:"forfeited_days,omitempty"`
+	CarryOverExpiry *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=carry_over_expiry,json=carryOverExpiry,proto3,oneof" json:"carry_over_expiry,omitempty"`
+	// Full-year entitlement total_days was pro-rated from
+	AnnualDays    *float64 `protobuf:"fixed64,15,opt,name=annual_days,json=annualDays,proto3,oneof" json:"annual_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloverItem) Reset() {
+	*x = RolloverItem{}
+	mi := &file_hr_service_v1_allowance_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloverItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloverItem) ProtoMessage() {}
+
+func (x *RolloverItem) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_allowance_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloverItem.ProtoReflect.Descriptor instead.
+func (*RolloverItem) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_allowance_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RolloverItem) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RolloverItem) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *RolloverItem) GetAbsenceTypeId() string {
+	if x != nil && x.AbsenceTypeId != nil {
+		return *x.AbsenceTypeId
+	}
+	return ""
+}
+
+func (x *RolloverItem) GetAllowancePoolId() string {
+	if x != nil && x.AllowancePoolId != nil {
+		return *x.AllowancePoolId
+	}
+	return ""
+}
+
+func (x *RolloverItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RolloverItem) GetSourceAllowanceId() string {
+	if x != nil {
+		return x.SourceAllowanceId
+	}
+	return ""
+}
+
+func (x *RolloverItem) GetTargetAllowanceId() string {
+	if x != nil && x.TargetAllowanceId != nil {
+		return *x.TargetAllowanceId
+	}
+	return ""
+}
+
+func (x *RolloverItem) GetAction() RolloverAction {
+	if x != nil {
+		return x.Action
+	}
+	return RolloverAction_ROLLOVER_ACTION_UNSPECIFIED
+}
+
+func (x *RolloverItem) GetSkipReason() string {
+	if x != nil && x.SkipReason != nil {
+		return *x.SkipReason
+	}
+	return ""
+}
+
+func (x *RolloverItem) GetRemainingDays() float64 {
+	if x != nil {
+		return x.RemainingDays
+	}
+	return 0
+}
+
+func (x *RolloverItem) GetTotalDays() float64 {
+	if x != nil {
+		return x.TotalDays
+	}
+	return 0
+}
+
+func (x *RolloverItem) GetCarriedOver() float64 {
+	if x != nil {
+		return x.CarriedOver
+	}
+	return 0
+}
+
+func (x *RolloverItem) GetForfeitedDays() float64 {
+	if x != nil {
+		return x.ForfeitedDays
+	}
+	return 0
+}
+
+func (x *RolloverItem) GetCarryOverExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CarryOverExpiry
+	}
+	return nil
+}
+
+func (x *RolloverItem) GetAnnualDays() float64 {
+	if x != nil && x.AnnualDays != nil {
+		return *x.AnnualDays
+	}
+	return 0
+}
+
+type RolloverAllowancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*RolloverItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Created       int32                  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	Skipped       int32                  `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloverAllowancesResponse) Reset() {
+	*x = RolloverAllowancesResponse{}
+	mi := &file_hr_service_v1_allowance_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloverAllowancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloverAllowancesResponse) ProtoMessage() {}
+
+func (x *RolloverAllowancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_allowance_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloverAllowancesResponse.ProtoReflect.Descriptor instead.
+func (*RolloverAllowancesResponse) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_allowance_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RolloverAllowancesResponse) GetItems() []*RolloverItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RolloverAllowancesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RolloverAllowancesResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *RolloverAllowancesResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *RolloverAllowancesResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+var File_hr_service_v1_allowance_proto protoreflect.FileDescriptor
+
+const file_hr_service_v1_allowance_proto_rawDesc = "" +
+	"\n" +
+	"\x1dhr/service/v1/allowance.proto\x12\rhr.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1dhr/service/v1/proration.proto\"\xab\n" +
+	"\n" +
+	"\x0eLeaveAllowance\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x02 \x01(\rH\x01R\btenantId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\rH\x02R\x06userId\x88\x01\x01\x12+\n" +
+	"\x0fabsence_type_id\x18\x04 \x01(\tH\x03R\rabsenceTypeId\x88\x01\x01\x12\x17\n" +
+	"\x04year\x18\x05 \x01(\x05H\x04R\x04year\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"total_days\x18\x06 \x01(\x01H\x05R\ttotalDays\x88\x01\x01\x12 \n" +
+	"\tused_days\x18\a \x01(\x01H\x06R\busedDays\x88\x01\x01\x12&\n" +
+	"\fcarried_over\x18\b \x01(\x01H\aR\vcarriedOver\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18\t \x01(\tH\bR\x05notes\x88\x01\x01\x12/\n" +
+	"\x11allowance_pool_id\x18\n" +
+	" \x01(\tH\tR\x0fallowancePoolId\x88\x01\x01\x12&\n" +
+	"\faccrued_days\x18\v \x01(\x01H\n" +
+	"R\vaccruedDays\x88\x01\x01\x12D\n" +
+	"\raccrual_start\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\vR\faccrualStart\x88\x01\x01\x12K\n" +
+	"\x11carry_over_expiry\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\fR\x0fcarryOverExpiry\x88\x01\x01\x12$\n" +
+	"\vannual_days\x18\x0e \x01(\x01H\rR\n" +
+	"annualDays\x88\x01\x01\x120\n" +
+	"\x11overconsumed_days\x18\x0f \x01(\x01H\x0eR\x10overconsumedDays\x88\x01\x01\x12/\n" +
+	"\x11absence_type_name\x18\x1e \x01(\tH\x0fR\x0fabsenceTypeName\x88\x01\x01\x12 \n" +
+	"\tuser_name\x18\x1f \x01(\tH\x10R\buserName\x88\x01\x01\x123\n" +
+	"\x13allowance_pool_name\x18  \x01(\tH\x11R\x11allowancePoolName\x88\x01\x01\x12>\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\x12R\tcreatedAt\x88\x01\x01\x12>\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\x13R\tupdatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\x16 \x01(\rH\x14R\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_by\x18\x17 \x01(\rH\x15R\tupdatedBy\x88\x01\x01B\x05\n" +
+	"\x03_idB\f\n" +
+	"\n" +
+	"_tenant_idB\n" +
+	"\n" +
+	"\b_user_idB\x12\n" +
+	"\x10_absence_type_idB\a\n" +
+	"\x05_yearB\r\n" +
+	"\v_total_daysB\f\n" +
+	"\n" +
+	"_used_daysB\x0f\n" +
+	"\r_carried_overB\b\n" +
+	"\x06_notesB\x14\n" +
+	"\x12_allowance_pool_idB\x0f\n" +
+	"\r_accrued_daysB\x10\n" +
+	"\x0e_accrual_startB\x14\n" +
+	"\x12_carry_over_expiryB\x0e\n" +
+	"\f_annual_daysB\x14\n" +
+	"\x12_overconsumed_daysB\x14\n" +
+	"\x12_absence_type_nameB\f\n" +
+	"\n" +
+	"_user_nameB\x16\n" +
+	"\x14_allowance_pool_nameB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\r\n" +
+	"\v_created_byB\r\n" +
+	"\v_updated_by\"\xb7\x06\n" +
+	"\x16CreateAllowanceRequest\x12%\n" +
+	"\ttenant_id\x18\x01 \x01(\rB\x03\xe0A\x02H\x00R\btenantId\x88\x01\x01\x12!\n" +
+	"\auser_id\x18\x02 \x01(\rB\x03\xe0A\x02H\x01R\x06userId\x88\x01\x01\x12+\n" +
+	"\x0fabsence_type_id\x18\x03 \x01(\tH\x02R\rabsenceTypeId\x88\x01\x01\x12/\n" +
+	"\x11allowance_pool_id\x18\t \x01(\tH\x03R\x0fallowancePoolId\x88\x01\x01\x12'\n" +
+	"\x04year\x18\x04 \x01(\x05B\x0e\xe0A\x02\xbaH\b\x1a\x06\x18\xb3\x10(\xd0\x0fH\x04R\x04year\x88\x01\x01\x12>\n" +
+	"\n" +
+	"total_days\x18\x05 \x01(\x01B\x1a\xe0A\x02\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\xd0v@!\x00\x00\x00\x00\x00\x00\x00\x00H\x05R\ttotalDays\x88\x01\x01\x12&\n" +
+	"\fcarried_over\x18\x06 \x01(\x01H\x06R\vcarriedOver\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18\a \x01(\tH\aR\x05notes\x88\x01\x01\x12 \n" +
+	"\tuser_name\x18\b \x01(\tH\bR\buserName\x88\x01\x01\x12D\n" +
+	"\raccrual_start\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\tR\faccrualStart\x88\x01\x01\x12K\n" +
+	"\x11carry_over_expiry\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\n" +
+	"R\x0fcarryOverExpiry\x88\x01\x01\x12\x1d\n" +
+	"\aprorate\x18\f \x01(\bH\vR\aprorate\x88\x01\x01\x12<\n" +
+	"\brounding\x18\r \x01(\x0e2 .hr.service.v1.ProrationRoundingR\broundingB\f\n" +
+	"\n" +
+	"_tenant_idB\n" +
+	"\n" +
+	"\b_user_idB\x12\n" +
+	"\x10_absence_type_idB\x14\n" +
+	"\x12_allowance_pool_idB\a\n" +
+	"\x05_yearB\r\n" +
+	"\v_total_daysB\x0f\n" +
+	"\r_carried_overB\b\n" +
+	"\x06_notesB\f\n" +
+	"\n" +
+	"_user_nameB\x10\n" +
+	"\x0e_accrual_startB\x14\n" +
+	"\x12_carry_over_expiryB\n" +
+	"\n" +
+	"\b_prorate\"V\n" +
+	"\x17CreateAllowanceResponse\x12;\n" +
+	"\tallowance\x18\x01 \x01(\v2\x1d.hr.service.v1.LeaveAllowanceR\tallowance\"1\n" +
+	"\x13GetAllowanceRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\"S\n" +
+	"\x14GetAllowanceResponse\x12;\n" +
+	"\tallowance\x18\x01 \x01(\v2\x1d.hr.service.v1.LeaveAllowanceR\tallowance\"\x9d\x03\n" +
+	"\x15ListAllowancesRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x02 \x01(\x05H\x01R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\x05H\x02R\bpageSize\x88\x01\x01\x12 \n" +
+	"\tno_paging\x18\x04 \x01(\bH\x03R\bnoPaging\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\n" +
+	" \x01(\rH\x04R\x06userId\x88\x01\x01\x12$\n" +
+	"\x04year\x18\v \x01(\x05B\v\xbaH\b\x1a\x06\x18\xb3\x10(\xd0\x0fH\x05R\x04year\x88\x01\x01\x12+\n" +
+	"\x0fabsence_type_id\x18\f \x01(\tH\x06R\rabsenceTypeId\x88\x01\x01\x12'\n" +
+	"\foverconsumed\x18\r \x01(\bH\aR\foverconsumed\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\f\n" +
+	"\n" +
+	"_no_pagingB\n" +
+	"\n" +
+	"\b_user_idB\a\n" +
+	"\x05_yearB\x12\n" +
+	"\x10_absence_type_idB\x0f\n" +
+	"\r_overconsumed\"r\n" +
+	"\x16ListAllowancesResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.hr.service.v1.LeaveAllowanceR\x05items\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x00R\x05total\x88\x01\x01B\b\n" +
+	"\x06_total\"\xda\x01\n" +
+	"\x16UpdateAllowanceRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\x126\n" +
+	"\x04data\x18\x02 \x01(\v2\x1d.hr.service.v1.LeaveAllowanceH\x00R\x04data\x88\x01\x01\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x1b\n" +
+	"\x06reason\x18\x04 \x01(\tH\x01R\x06reason\x88\x01\x01B\a\n" +
+	"\x05_dataB\t\n" +
+	"\a_reason\"V\n" +
+	"\x17UpdateAllowanceResponse\x12;\n" +
+	"\tallowance\x18\x01 \x01(\v2\x1d.hr.service.v1.LeaveAllowanceR\tallowance\"4\n" +
+	"\x16DeleteAllowanceRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\"\xff\x05\n" +
+	"\fBalanceEntry\x12&\n" +
+	"\x0fabsence_type_id\x18\x01 \x01(\tR\rabsenceTypeId\x12*\n" +
+	"\x11absence_type_name\x18\x02 \x01(\tR\x0fabsenceTypeName\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x1d\n" +
+	"\n" +
+	"total_days\x18\x04 \x01(\x01R\ttotalDays\x12\x1b\n" +
+	"\tused_days\x18\x05 \x01(\x01R\busedDays\x12!\n" +
+	"\fcarried_over\x18\x06 \x01(\x01R\vcarriedOver\x12%\n" +
+	"\x0eremaining_days\x18\a \x01(\x01R\rremainingDays\x12/\n" +
+	"\x11allowance_pool_id\x18\b \x01(\tH\x00R\x0fallowancePoolId\x88\x01\x01\x123\n" +
+	"\x13allowance_pool_name\x18\t \x01(\tH\x01R\x11allowancePoolName\x88\x01\x01\x125\n" +
+	"\x17member_absence_type_ids\x18\n" +
+	" \x03(\tR\x14memberAbsenceTypeIds\x12+\n" +
+	"\x0faccrued_to_date\x18\v \x01(\x01H\x02R\raccruedToDate\x88\x01\x01\x121\n" +
+	"\x12projected_year_end\x18\f \x01(\x01H\x03R\x10projectedYearEnd\x88\x01\x01\x12K\n" +
+	"\x11carry_over_expiry\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\x04R\x0fcarryOverExpiry\x88\x01\x01\x120\n" +
+	"\x11overconsumed_days\x18\x0e \x01(\x01H\x05R\x10overconsumedDays\x88\x01\x01B\x14\n" +
+	"\x12_allowance_pool_idB\x16\n" +
+	"\x14_allowance_pool_nameB\x12\n" +
+	"\x10_accrued_to_dateB\x15\n" +
+	"\x13_projected_year_endB\x14\n" +
+	"\x12_carry_over_expiryB\x14\n" +
+	"\x12_overconsumed_days\"d\n" +
+	"\x15GetUserBalanceRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\rB\x03\xe0A\x02R\x06userId\x12$\n" +
+	"\x04year\x18\x02 \x01(\x05B\v\xbaH\b\x1a\x06\x18\xb3\x10(\xd0\x0fH\x00R\x04year\x88\x01\x01B\a\n" +
+	"\x05_year\"|\n" +
+	"\x16GetUserBalanceResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x125\n" +
+	"\aentries\x18\x03 \x03(\v2\x1b.hr.service.v1.BalanceEntryR\aentries\"\x8b\x04\n" +
+	"\x14AllowanceTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\ttenant_id\x18\x02 \x01(\rH\x00R\btenantId\x88\x01\x01\x12!\n" +
+	"\fallowance_id\x18\x03 \x01(\tR\vallowanceId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04year\x18\x05 \x01(\x05R\x04year\x12;\n" +
+	"\x04kind\x18\x06 \x01(\x0e2'.hr.service.v1.AllowanceTransactionKindR\x04kind\x12\x12\n" +
+	"\x04days\x18\a \x01(\x01R\x04days\x12#\n" +
+	"\rbalance_after\x18\b \x01(\x01R\fbalanceAfter\x12-\n" +
+	"\x10leave_request_id\x18\t \x01(\tH\x01R\x0eleaveRequestId\x88\x01\x01\x12\x19\n" +
+	"\bactor_id\x18\n" +
+	" \x01(\rR\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_name\x18\v \x01(\tR\tactorName\x12\x17\n" +
+	"\x04note\x18\f \x01(\tH\x02R\x04note\x88\x01\x01\x12>\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\tcreatedAt\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\x13\n" +
+	"\x11_leave_request_idB\a\n" +
+	"\x05_noteB\r\n" +
+	"\v_created_at\"\xf5\x03\n" +
+	" ListAllowanceTransactionsRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x02 \x01(\x05H\x01R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\x05H\x02R\bpageSize\x88\x01\x01\x12 \n" +
+	"\tno_paging\x18\x04 \x01(\bH\x03R\bnoPaging\x88\x01\x01\x12&\n" +
+	"\fallowance_id\x18\n" +
+	" \x01(\tH\x04R\vallowanceId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\v \x01(\rH\x05R\x06userId\x88\x01\x01\x12$\n" +
+	"\x04year\x18\f \x01(\x05B\v\xbaH\b\x1a\x06\x18\xb3\x10(\xd0\x0fH\x06R\x04year\x88\x01\x01\x12-\n" +
+	"\x10leave_request_id\x18\r \x01(\tH\aR\x0eleaveRequestId\x88\x01\x01\x12@\n" +
+	"\x04kind\x18\x0e \x01(\x0e2'.hr.service.v1.AllowanceTransactionKindH\bR\x04kind\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\f\n" +
+	"\n" +
+	"_no_pagingB\x0f\n" +
+	"\r_allowance_idB\n" +
+	"\n" +
+	"\b_user_idB\a\n" +
+	"\x05_yearB\x13\n" +
+	"\x11_leave_request_idB\a\n" +
+	"\x05_kind\"\x83\x01\n" +
+	"!ListAllowanceTransactionsResponse\x129\n" +
+	"\x05items\x18\x01 \x03(\v2#.hr.service.v1.AllowanceTransactionR\x05items\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x00R\x05total\x88\x01\x01B\b\n" +
+	"\x06_total\"\xc3\x02\n" +
+	"\x19RolloverAllowancesRequest\x12 \n" +
+	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12+\n" +
+	"\tfrom_year\x18\x02 \x01(\x05B\x0e\xe0A\x02\xbaH\b\x1a\x06\x18\xb2\x10(\xd0\x0fR\bfromYear\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x1c\n" +
+	"\auser_id\x18\n" +
+	" \x01(\rH\x01R\x06userId\x88\x01\x01\x12+\n" +
+	"\x0fabsence_type_id\x18\v \x01(\tH\x02R\rabsenceTypeId\x88\x01\x01\x12/\n" +
+	"\x11allowance_pool_id\x18\f \x01(\tH\x03R\x0fallowancePoolId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\n" +
+	"\n" +
+	"\b_user_idB\x12\n" +
+	"\x10_absence_type_idB\x14\n" +
+	"\x12_allowance_pool_id\"\xf3\x05\n" +
+	"\fRolloverItem\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12+\n" +
+	"\x0fabsence_type_id\x18\x03 \x01(\tH\x00R\rabsenceTypeId\x88\x01\x01\x12/\n" +
+	"\x11allowance_pool_id\x18\x04 \x01(\tH\x01R\x0fallowancePoolId\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12.\n" +
+	"\x13source_allowance_id\x18\x06 \x01(\tR\x11sourceAllowanceId\x123\n" +
+	"\x13target_allowance_id\x18\a \x01(\tH\x02R\x11targetAllowanceId\x88\x01\x01\x125\n" +
+	"\x06action\x18\b \x01(\x0e2\x1d.hr.service.v1.RolloverActionR\x06action\x12$\n" +
+	"\vskip_reason\x18\t \x01(\tH\x03R\n" +
+	"skipReason\x88\x01\x01\x12%\n" +
+	"\x0eremaining_days\x18\n" +
+	" \x01(\x01R\rremainingDays\x12\x1d\n" +
+	"\n" +
+	"total_days\x18\v \x01(\x01R\ttotalDays\x12!\n" +
+	"\fcarried_over\x18\f \x01(\x01R\vcarriedOver\x12%\n" +
+	"\x0eforfeited_days\x18\r \x01(\x01R\rforfeitedDays\x12K\n" +
+	"\x11carry_over_expiry\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x04R\x0fcarryOverExpiry\x88\x01\x01\x12$\n" +
+	"\vannual_days\x18\x0f \x01(\x01H\x05R\n" +
+	"annualDays\x88\x01\x01B\x12\n" +
+	"\x10_absence_type_idB\x14\n" +
+	"\x12_allowance_pool_idB\x16\n" +
+	"\x14_target_allowance_idB\x0e\n" +
+	"\f_skip_reasonB\x14\n" +
+	"\x12_carry_over_expiryB\x0e\n" +
+	"\f_annual_days\"\xb6\x01\n" +
+	"\x1aRolloverAllowancesResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.hr.service.v1.RolloverItemR\x05items\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x04 \x01(\x05R\aupdated\x12\x18\n" +
+	"\askipped\x18\x05 \x01(\x05R\askipped*\x93\x02\n" +
+	"\x18AllowanceTransactionKind\x12*\n" +
+	"&ALLOWANCE_TRANSACTION_KIND_UNSPECIFIED\x10\x00\x12$\n" +
+	" ALLOWANCE_TRANSACTION_KIND_GRANT\x10\x01\x12(\n" +
+	"$ALLOWANCE_TRANSACTION_KIND_DEDUCTION\x10\x02\x12%\n" +
+	"!ALLOWANCE_TRANSACTION_KIND_REFUND\x10\x03\x12)\n" +
+	"%ALLOWANCE_TRANSACTION_KIND_CARRY_OVER\x10\x04\x12)\n" +
+	"%ALLOWANCE_TRANSACTION_KIND_ADJUSTMENT\x10\x05*\x83\x01\n" +
+	"\x0eRolloverAction\x12\x1f\n" +
+	"\x1bROLLOVER_ACTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ROLLOVER_ACTION_CREATE\x10\x01\x12\x1a\n" +
+	"\x16ROLLOVER_ACTION_UPDATE\x10\x02\x12\x18\n" +
+	"\x14ROLLOVER_ACTION_SKIP\x10\x032\xaa\b\n" +
 	"\x12HrAllowanceService\x12{\n" +
 	"\x0fCreateAllowance\x12%.hr.service.v1.CreateAllowanceRequest\x1a&.hr.service.v1.CreateAllowanceResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/allowances\x12t\n" +
 	"\fGetAllowance\x12\".hr.service.v1.GetAllowanceRequest\x1a#.hr.service.v1.GetAllowanceResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/allowances/{id}\x12u\n" +
 	"\x0eListAllowances\x12$.hr.service.v1.ListAllowancesRequest\x1a%.hr.service.v1.ListAllowancesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/allowances\x12\x80\x01\n" +
 	"\x0fUpdateAllowance\x12%.hr.service.v1.UpdateAllowanceRequest\x1a&.hr.service.v1.UpdateAllowanceResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/allowances/{id}\x12m\n" +
 	"\x0fDeleteAllowance\x12%.hr.service.v1.DeleteAllowanceRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/allowances/{id}\x12\x82\x01\n" +
-	"\x0eGetUserBalance\x12$.hr.service.v1.GetUserBalanceRequest\x1a%.hr.service.v1.GetUserBalanceResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/users/{user_id}/balanceB\xb6\x01\n" +
+	"\x0eGetUserBalance\x12$.hr.service.v1.GetUserBalanceRequest\x1a%.hr.service.v1.GetUserBalanceResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/users/{user_id}/balance\x12\xa2\x01\n" +
+	"\x19ListAllowanceTransactions\x12/.hr.service.v1.ListAllowanceTransactionsRequest\x1a0.hr.service.v1.ListAllowanceTransactionsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/allowance-transactions\x12\x8d\x01\n" +
+	"\x12RolloverAllowances\x12(.hr.service.v1.RolloverAllowancesRequest\x1a).hr.service.v1.RolloverAllowancesResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/allowances/rolloverB\xb6\x01\n" +
 	"\x11com.hr.service.v1B\x0eAllowanceProtoP\x01Z;github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1;hrpb\xa2\x02\x03HSX\xaa\x02\rHr.Service.V1\xca\x02\rHr\\Service\\V1\xe2\x02\x19Hr\\Service\\V1\\GPBMetadata\xea\x02\x0fHr::Service::V1b\x06proto3"
 
 var (
@@ -1126,52 +2148,79 @@ func file_hr_service_v1_allowance_proto_rawDescGZIP() []byte {
 	return file_hr_service_v1_allowance_proto_rawDescData
 }
 
-var file_hr_service_v1_allowance_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_hr_service_v1_allowance_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_hr_service_v1_allowance_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_hr_service_v1_allowance_proto_goTypes = []any{
-	(*LeaveAllowance)(nil),          // 0: hr.service.v1.LeaveAllowance
-	(*CreateAllowanceRequest)(nil),  // 1: hr.service.v1.CreateAllowanceRequest
-	(*CreateAllowanceResponse)(nil), // 2: hr.service.v1.CreateAllowanceResponse
-	(*GetAllowanceRequest)(nil),     // 3: hr.service.v1.GetAllowanceRequest
-	(*GetAllowanceResponse)(nil),    // 4: hr.service.v1.GetAllowanceResponse
-	(*ListAllowancesRequest)(nil),   // 5: hr.service.v1.ListAllowancesRequest
-	(*ListAllowancesResponse)(nil),  // 6: hr.service.v1.ListAllowancesResponse
-	(*UpdateAllowanceRequest)(nil),  // 7: hr.service.v1.UpdateAllowanceRequest
-	(*UpdateAllowanceResponse)(nil), // 8: hr.service.v1.UpdateAllowanceResponse
-	(*DeleteAllowanceRequest)(nil),  // 9: hr.service.v1.DeleteAllowanceRequest
-	(*BalanceEntry)(nil),            // 10: hr.service.v1.BalanceEntry
-	(*GetUserBalanceRequest)(nil),   // 11: hr.service.v1.GetUserBalanceRequest
-	(*GetUserBalanceResponse)(nil),  // 12: hr.service.v1.GetUserBalanceResponse
-	(*timestamppb.Timestamp)(nil),   // 13: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),   // 14: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),           // 15: google.protobuf.Empty
+	(AllowanceTransactionKind)(0),             // 0: hr.service.v1.AllowanceTransactionKind
+	(RolloverAction)(0),                       // 1: hr.service.v1.RolloverAction
+	(*LeaveAllowance)(nil),                    // 2: hr.service.v1.LeaveAllowance
+	(*CreateAllowanceRequest)(nil),            // 3: hr.service.v1.CreateAllowanceRequest
+	(*CreateAllowanceResponse)(nil),           // 4: hr.service.v1.CreateAllowanceResponse
+	(*GetAllowanceRequest)(nil),               // 5: hr.service.v1.GetAllowanceRequest
+	(*GetAllowanceResponse)(nil),              // 6: hr.service.v1.GetAllowanceResponse
+	(*ListAllowancesRequest)(nil),             // 7: hr.service.v1.ListAllowancesRequest
+	(*ListAllowancesResponse)(nil),            // 8: hr.service.v1.ListAllowancesResponse
+	(*UpdateAllowanceRequest)(nil),            // 9: hr.service.v1.UpdateAllowanceRequest
+	(*UpdateAllowanceResponse)(nil),           // 10: hr.service.v1.UpdateAllowanceResponse
+	(*DeleteAllowanceRequest)(nil),            // 11: hr.service.v1.DeleteAllowanceRequest
+	(*BalanceEntry)(nil),                      // 12: hr.service.v1.BalanceEntry
+	(*GetUserBalanceRequest)(nil),             // 13: hr.service.v1.GetUserBalanceRequest
+	(*GetUserBalanceResponse)(nil),            // 14: hr.service.v1.GetUserBalanceResponse
+	(*AllowanceTransaction)(nil),              // 15: hr.service.v1.AllowanceTransaction
+	(*ListAllowanceTransactionsRequest)(nil),  // 16: hr.service.v1.ListAllowanceTransactionsRequest
+	(*ListAllowanceTransactionsResponse)(nil), // 17: hr.service.v1.ListAllowanceTransactionsResponse
+	(*RolloverAllowancesRequest)(nil),         // 18: hr.service.v1.RolloverAllowancesRequest
+	(*RolloverItem)(nil),                      // 19: hr.service.v1.RolloverItem
+	(*RolloverAllowancesResponse)(nil),        // 20: hr.service.v1.RolloverAllowancesResponse
+	(*timestamppb.Timestamp)(nil),             // 21: google.protobuf.Timestamp
+	(ProrationRounding)(0),                    // 22: hr.service.v1.ProrationRounding
+	(*fieldmaskpb.FieldMask)(nil),             // 23: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                     // 24: google.protobuf.Empty
 }
 var file_hr_service_v1_allowance_proto_depIdxs = []int32{
-	13, // 0: hr.service.v1.LeaveAllowance.created_at:type_name -> google.protobuf.Timestamp
-	13, // 1: hr.service.v1.LeaveAllowance.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: hr.service.v1.CreateAllowanceResponse.allowance:type_name -> hr.service.v1.LeaveAllowance
-	0,  // 3: hr.service.v1.GetAllowanceResponse.allowance:type_name -> hr.service.v1.LeaveAllowance
-	0,  // 4: hr.service.v1.ListAllowancesResponse.items:type_name -> hr.service.v1.LeaveAllowance
-	0,  // 5: hr.service.v1.UpdateAllowanceRequest.data:type_name -> hr.service.v1.LeaveAllowance
-	14, // 6: hr.service.v1.UpdateAllowanceRequest.update_mask:type_name -> google.protobuf.FieldMask
-	0,  // 7: hr.service.v1.UpdateAllowanceResponse.allowance:type_name -> hr.service.v1.LeaveAllowance
-	10, // 8: hr.service.v1.GetUserBalanceResponse.entries:type_name -> hr.service.v1.BalanceEntry
-	1,  // 9: hr.service.v1.HrAllowanceService.CreateAllowance:input_type -> hr.service.v1.CreateAllowanceRequest
-	3,  // 10: hr.service.v1.HrAllowanceService.GetAllowance:input_type -> hr.service.v1.GetAllowanceRequest
-	5,  // 11: hr.service.v1.HrAllowanceService.ListAllowances:input_type -> hr.service.v1.ListAllowancesRequest
-	7,  // 12: hr.service.v1.HrAllowanceService.UpdateAllowance:input_type -> hr.service.v1.UpdateAllowanceRequest
-	9,  // 13: hr.service.v1.HrAllowanceService.DeleteAllowance:input_type -> hr.service.v1.DeleteAllowanceRequest
-	11, // 14: hr.service.v1.HrAllowanceService.GetUserBalance:input_type -> hr.service.v1.GetUserBalanceRequest
-	2,  // 15: hr.service.v1.HrAllowanceService.CreateAllowance:output_type -> hr.service.v1.CreateAllowanceResponse
-	4,  // 16: hr.service.v1.HrAllowanceService.GetAllowance:output_type -> hr.service.v1.GetAllowanceResponse
-	6,  // 17: hr.service.v1.HrAllowanceService.ListAllowances:output_type -> hr.service.v1.ListAllowancesResponse
-	8,  // 18: hr.service.v1.HrAllowanceService.UpdateAllowance:output_type -> hr.service.v1.UpdateAllowanceResponse
-	15, // 19: hr.service.v1.HrAllowanceService.DeleteAllowance:output_type -> google.protobuf.Empty
-	12, // 20: hr.service.v1.HrAllowanceService.GetUserBalance:output_type -> hr.service.v1.GetUserBalanceResponse
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	21, // 0: hr.service.v1.LeaveAllowance.accrual_start:type_name -> google.protobuf.Timestamp
+	21, // 1: hr.service.v1.LeaveAllowance.carry_over_expiry:type_name -> google.protobuf.Timestamp
+	21, // 2: hr.service.v1.LeaveAllowance.created_at:type_name -> google.protobuf.Timestamp
+	21, // 3: hr.service.v1.LeaveAllowance.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 4: hr.service.v1.CreateAllowanceRequest.accrual_start:type_name -> google.protobuf.Timestamp
+	21, // 5: hr.service.v1.CreateAllowanceRequest.carry_over_expiry:type_name -> google.protobuf.Timestamp
+	22, // 6: hr.service.v1.CreateAllowanceRequest.rounding:type_name -> hr.service.v1.ProrationRounding
+	2,  // 7: hr.service.v1.CreateAllowanceResponse.allowance:type_name -> hr.service.v1.LeaveAllowance
+	2,  // 8: hr.service.v1.GetAllowanceResponse.allowance:type_name -> hr.service.v1.LeaveAllowance
+	2,  // 9: hr.service.v1.ListAllowancesResponse.items:type_name -> hr.service.v1.LeaveAllowance
+	2,  // 10: hr.service.v1.UpdateAllowanceRequest.data:type_name -> hr.service.v1.LeaveAllowance
+	23, // 11: hr.service.v1.UpdateAllowanceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 12: hr.service.v1.UpdateAllowanceResponse.allowance:type_name -> hr.service.v1.LeaveAllowance
+	21, // 13: hr.service.v1.BalanceEntry.carry_over_expiry:type_name -> google.protobuf.Timestamp
+	12, // 14: hr.service.v1.GetUserBalanceResponse.entries:type_name -> hr.service.v1.BalanceEntry
+	0,  // 15: hr.service.v1.AllowanceTransaction.kind:type_name -> hr.service.v1.AllowanceTransactionKind
+	21, // 16: hr.service.v1.AllowanceTransaction.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 17: hr.service.v1.ListAllowanceTransactionsRequest.kind:type_name -> hr.service.v1.AllowanceTransactionKind
+	15, // 18: hr.service.v1.ListAllowanceTransactionsResponse.items:type_name -> hr.service.v1.AllowanceTransaction
+	1,  // 19: hr.service.v1.RolloverItem.action:type_name -> hr.service.v1.RolloverAction
+	21, // 20: hr.service.v1.RolloverItem.carry_over_expiry:type_name -> google.protobuf.Timestamp
+	19, // 21: hr.service.v1.RolloverAllowancesResponse.items:type_name -> hr.service.v1.RolloverItem
+	3,  // 22: hr.service.v1.HrAllowanceService.CreateAllowance:input_type -> hr.service.v1.CreateAllowanceRequest
+	5,  // 23: hr.service.v1.HrAllowanceService.GetAllowance:input_type -> hr.service.v1.GetAllowanceRequest
+	7,  // 24: hr.service.v1.HrAllowanceService.ListAllowances:input_type -> hr.service.v1.ListAllowancesRequest
+	9,  // 25: hr.service.v1.HrAllowanceService.UpdateAllowance:input_type -> hr.service.v1.UpdateAllowanceRequest
+	11, // 26: hr.service.v1.HrAllowanceService.DeleteAllowance:input_type -> hr.service.v1.DeleteAllowanceRequest
+	13, // 27: hr.service.v1.HrAllowanceService.GetUserBalance:input_type -> hr.service.v1.GetUserBalanceRequest
+	16, // 28: hr.service.v1.HrAllowanceService.ListAllowanceTransactions:input_type -> hr.service.v1.ListAllowanceTransactionsRequest
+	18, // 29: hr.service.v1.HrAllowanceService.RolloverAllowances:input_type -> hr.service.v1.RolloverAllowancesRequest
+	4,  // 30: hr.service.v1.HrAllowanceService.CreateAllowance:output_type -> hr.service.v1.CreateAllowanceResponse
+	6,  // 31: hr.service.v1.HrAllowanceService.GetAllowance:output_type -> hr.service.v1.GetAllowanceResponse
+	8,  // 32: hr.service.v1.HrAllowanceService.ListAllowances:output_type -> hr.service.v1.ListAllowancesResponse
+	10, // 33: hr.service.v1.HrAllowanceService.UpdateAllowance:output_type -> hr.service.v1.UpdateAllowanceResponse
+	24, // 34: hr.service.v1.HrAllowanceService.DeleteAllowance:output_type -> google.protobuf.Empty
+	14, // 35: hr.service.v1.HrAllowanceService.GetUserBalance:output_type -> hr.service.v1.GetUserBalanceResponse
+	17, // 36: hr.service.v1.HrAllowanceService.ListAllowanceTransactions:output_type -> hr.service.v1.ListAllowanceTransactionsResponse
+	20, // 37: hr.service.v1.HrAllowanceService.RolloverAllowances:output_type -> hr.service.v1.RolloverAllowancesResponse
+	30, // [30:38] is the sub-list for method output_type
+	22, // [22:30] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_hr_service_v1_allowance_proto_init() }
@@ -1179,6 +2228,7 @@ func file_hr_service_v1_allowance_proto_init() {
 	if File_hr_service_v1_allowance_proto != nil {
 		return
 	}
+	file_hr_service_v1_proration_proto_init()
 	file_hr_service_v1_allowance_proto_msgTypes[0].OneofWrappers = []any{}
 	file_hr_service_v1_allowance_proto_msgTypes[1].OneofWrappers = []any{}
 	file_hr_service_v1_allowance_proto_msgTypes[5].OneofWrappers = []any{}
@@ -1186,18 +2236,24 @@ func file_hr_service_v1_allowance_proto_init() {
 	file_hr_service_v1_allowance_proto_msgTypes[7].OneofWrappers = []any{}
 	file_hr_service_v1_allowance_proto_msgTypes[10].OneofWrappers = []any{}
 	file_hr_service_v1_allowance_proto_msgTypes[11].OneofWrappers = []any{}
+	file_hr_service_v1_allowance_proto_msgTypes[13].OneofWrappers = []any{}
+	file_hr_service_v1_allowance_proto_msgTypes[14].OneofWrappers = []any{}
+	file_hr_service_v1_allowance_proto_msgTypes[15].OneofWrappers = []any{}
+	file_hr_service_v1_allowance_proto_msgTypes[16].OneofWrappers = []any{}
+	file_hr_service_v1_allowance_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hr_service_v1_allowance_proto_rawDesc), len(file_hr_service_v1_allowance_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      2,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_hr_service_v1_allowance_proto_goTypes,
 		DependencyIndexes: file_hr_service_v1_allowance_proto_depIdxs,
+		EnumInfos:         file_hr_service_v1_allowance_proto_enumTypes,
 		MessageInfos:      file_hr_service_v1_allowance_proto_msgTypes,
 	}.Build()
 	File_hr_service_v1_allowance_proto = out.File
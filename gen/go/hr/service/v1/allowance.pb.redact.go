@@ -114,6 +114,28 @@ func (s *redactedHrAllowanceServiceServer) GetUserBalance(ctx context.Context, i
 	return res, err
 }
 
+// ListAllowanceTransactions is the redacted wrapper for the actual HrAllowanceServiceServer.ListAllowanceTransactions method
+// Unary RPC
+func (s *redactedHrAllowanceServiceServer) ListAllowanceTransactions(ctx context.Context, in *ListAllowanceTransactionsRequest) (*ListAllowanceTransactionsResponse, error) {
+	res, err := s.srv.ListAllowanceTransactions(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RolloverAllowances is the redacted wrapper for the actual HrAllowanceServiceServer.RolloverAllowances method
+// Unary RPC
+func (s *redactedHrAllowanceServiceServer) RolloverAllowances(ctx context.Context, in *RolloverAllowancesRequest) (*RolloverAllowancesResponse, error) {
+	res, err := s.srv.RolloverAllowances(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for LeaveAllowance
 func (x *LeaveAllowance) Redact() string {
 	if x == nil {
@@ -140,6 +162,16 @@ func (x *LeaveAllowance) Redact() string {
 
 	// Safe field: AllowancePoolId
 
+	// Safe field: AccruedDays
+
+	// Safe field: AccrualStart
+
+	// Safe field: CarryOverExpiry
+
+	// Safe field: AnnualDays
+
+	// Safe field: OverconsumedDays
+
 	// Safe field: AbsenceTypeName
 
 	// Safe field: UserName
@@ -179,6 +211,14 @@ func (x *CreateAllowanceRequest) Redact() string {
 	// Safe field: Notes
 
 	// Safe field: UserName
+
+	// Safe field: AccrualStart
+
+	// Safe field: CarryOverExpiry
+
+	// Safe field: Prorate
+
+	// Safe field: Rounding
 	return x.String()
 }
 
@@ -231,6 +271,8 @@ func (x *ListAllowancesRequest) Redact() string {
 	// Safe field: Year
 
 	// Safe field: AbsenceTypeId
+
+	// Safe field: Overconsumed
 	return x.String()
 }
 
@@ -257,6 +299,8 @@ func (x *UpdateAllowanceRequest) Redact() string {
 	// Safe field: Data
 
 	// Safe field: UpdateMask
+
+	// Safe field: Reason
 	return x.String()
 }
 
@@ -305,6 +349,14 @@ func (x *BalanceEntry) Redact() string {
 	// Safe field: AllowancePoolName
 
 	// Safe field: MemberAbsenceTypeIds
+
+	// Safe field: AccruedToDate
+
+	// Safe field: ProjectedYearEnd
+
+	// Safe field: CarryOverExpiry
+
+	// Safe field: OverconsumedDays
 	return x.String()
 }
 
@@ -333,3 +385,151 @@ func (x *GetUserBalanceResponse) Redact() string {
 	// Safe field: Entries
 	return x.String()
 }
+
+// Redact method implementation for AllowanceTransaction
+func (x *AllowanceTransaction) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: TenantId
+
+	// Safe field: AllowanceId
+
+	// Safe field: UserId
+
+	// Safe field: Year
+
+	// Safe field: Kind
+
+	// Safe field: Days
+
+	// Safe field: BalanceAfter
+
+	// Safe field: LeaveRequestId
+
+	// Safe field: ActorId
+
+	// Safe field: ActorName
+
+	// Safe field: Note
+
+	// Safe field: CreatedAt
+	return x.String()
+}
+
+// Redact method implementation for ListAllowanceTransactionsRequest
+func (x *ListAllowanceTransactionsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+
+	// Safe field: NoPaging
+
+	// Safe field: AllowanceId
+
+	// Safe field: UserId
+
+	// Safe field: Year
+
+	// Safe field: LeaveRequestId
+
+	// Safe field: Kind
+	return x.String()
+}
+
+// Redact method implementation for ListAllowanceTransactionsResponse
+func (x *ListAllowanceTransactionsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Items
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for RolloverAllowancesRequest
+func (x *RolloverAllowancesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: FromYear
+
+	// Safe field: DryRun
+
+	// Safe field: UserId
+
+	// Safe field: AbsenceTypeId
+
+	// Safe field: AllowancePoolId
+	return x.String()
+}
+
+// Redact method implementation for RolloverItem
+func (x *RolloverItem) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: UserId
+
+	// Safe field: UserName
+
+	// Safe field: AbsenceTypeId
+
+	// Safe field: AllowancePoolId
+
+	// Safe field: Name
+
+	// Safe field: SourceAllowanceId
+
+	// Safe field: TargetAllowanceId
+
+	// Safe field: Action
+
+	// Safe field: SkipReason
+
+	// Safe field: RemainingDays
+
+	// Safe field: TotalDays
+
+	// Safe field: CarriedOver
+
+	// Safe field: ForfeitedDays
+
+	// Safe field: CarryOverExpiry
+
+	// Safe field: AnnualDays
+	return x.String()
+}
+
+// Redact method implementation for RolloverAllowancesResponse
+func (x *RolloverAllowancesResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Items
+
+	// Safe field: DryRun
+
+	// Safe field: Created
+
+	// Safe field: Updated
+
+	// Safe field: Skipped
+	return x.String()
+}
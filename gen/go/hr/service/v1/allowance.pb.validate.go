@@ -97,6 +97,84 @@ func (m *LeaveAllowance) validate(all bool) error {
 		// no validation rules for AllowancePoolId
 	}
 
+	if m.AccruedDays != nil {
+		// no validation rules for AccruedDays
+	}
+
+	if m.AccrualStart != nil {
+
+		if all {
+			switch v := interface{}(m.GetAccrualStart()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveAllowanceValidationError{
+						field:  "AccrualStart",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveAllowanceValidationError{
+						field:  "AccrualStart",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccrualStart()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveAllowanceValidationError{
+					field:  "AccrualStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CarryOverExpiry != nil {
+
+		if all {
+			switch v := interface{}(m.GetCarryOverExpiry()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveAllowanceValidationError{
+						field:  "CarryOverExpiry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveAllowanceValidationError{
+						field:  "CarryOverExpiry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCarryOverExpiry()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveAllowanceValidationError{
+					field:  "CarryOverExpiry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.AnnualDays != nil {
+		// no validation rules for AnnualDays
+	}
+
+	if m.OverconsumedDays != nil {
+		// no validation rules for OverconsumedDays
+	}
+
 	if m.AbsenceTypeName != nil {
 		// no validation rules for AbsenceTypeName
 	}
@@ -283,6 +361,8 @@ func (m *CreateAllowanceRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for Rounding
+
 	if m.TenantId != nil {
 		// no validation rules for TenantId
 	}
@@ -319,6 +399,76 @@ func (m *CreateAllowanceRequest) validate(all bool) error {
 		// no validation rules for UserName
 	}
 
+	if m.AccrualStart != nil {
+
+		if all {
+			switch v := interface{}(m.GetAccrualStart()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAllowanceRequestValidationError{
+						field:  "AccrualStart",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAllowanceRequestValidationError{
+						field:  "AccrualStart",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccrualStart()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAllowanceRequestValidationError{
+					field:  "AccrualStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CarryOverExpiry != nil {
+
+		if all {
+			switch v := interface{}(m.GetCarryOverExpiry()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAllowanceRequestValidationError{
+						field:  "CarryOverExpiry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAllowanceRequestValidationError{
+						field:  "CarryOverExpiry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCarryOverExpiry()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAllowanceRequestValidationError{
+					field:  "CarryOverExpiry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Prorate != nil {
+		// no validation rules for Prorate
+	}
+
 	if len(errors) > 0 {
 		return CreateAllowanceRequestMultiError(errors)
 	}
@@ -815,6 +965,10 @@ func (m *ListAllowancesRequest) validate(all bool) error {
 		// no validation rules for AbsenceTypeId
 	}
 
+	if m.Overconsumed != nil {
+		// no validation rules for Overconsumed
+	}
+
 	if len(errors) > 0 {
 		return ListAllowancesRequestMultiError(errors)
 	}
@@ -1121,6 +1275,10 @@ func (m *UpdateAllowanceRequest) validate(all bool) error {
 
 	}
 
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
 	if len(errors) > 0 {
 		return UpdateAllowanceRequestMultiError(errors)
 	}
@@ -1480,6 +1638,51 @@ func (m *BalanceEntry) validate(all bool) error {
 		// no validation rules for AllowancePoolName
 	}
 
+	if m.AccruedToDate != nil {
+		// no validation rules for AccruedToDate
+	}
+
+	if m.ProjectedYearEnd != nil {
+		// no validation rules for ProjectedYearEnd
+	}
+
+	if m.CarryOverExpiry != nil {
+
+		if all {
+			switch v := interface{}(m.GetCarryOverExpiry()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BalanceEntryValidationError{
+						field:  "CarryOverExpiry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BalanceEntryValidationError{
+						field:  "CarryOverExpiry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCarryOverExpiry()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BalanceEntryValidationError{
+					field:  "CarryOverExpiry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.OverconsumedDays != nil {
+		// no validation rules for OverconsumedDays
+	}
+
 	if len(errors) > 0 {
 		return BalanceEntryMultiError(errors)
 	}
@@ -1804,3 +2007,888 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetUserBalanceResponseValidationError{}
+
+// Validate checks the field values on AllowanceTransaction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AllowanceTransaction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AllowanceTransaction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AllowanceTransactionMultiError, or nil if none found.
+func (m *AllowanceTransaction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AllowanceTransaction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AllowanceId
+
+	// no validation rules for UserId
+
+	// no validation rules for Year
+
+	// no validation rules for Kind
+
+	// no validation rules for Days
+
+	// no validation rules for BalanceAfter
+
+	// no validation rules for ActorId
+
+	// no validation rules for ActorName
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.LeaveRequestId != nil {
+		// no validation rules for LeaveRequestId
+	}
+
+	if m.Note != nil {
+		// no validation rules for Note
+	}
+
+	if m.CreatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetCreatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AllowanceTransactionValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AllowanceTransactionValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AllowanceTransactionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AllowanceTransactionMultiError(errors)
+	}
+
+	return nil
+}
+
+// AllowanceTransactionMultiError is an error wrapping multiple validation
+// errors returned by AllowanceTransaction.ValidateAll() if the designated
+// constraints aren't met.
+type AllowanceTransactionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AllowanceTransactionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AllowanceTransactionMultiError) AllErrors() []error { return m }
+
+// AllowanceTransactionValidationError is the validation error returned by
+// AllowanceTransaction.Validate if the designated constraints aren't met.
+type AllowanceTransactionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AllowanceTransactionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AllowanceTransactionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AllowanceTransactionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AllowanceTransactionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AllowanceTransactionValidationError) ErrorName() string {
+	return "AllowanceTransactionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AllowanceTransactionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAllowanceTransaction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AllowanceTransactionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AllowanceTransactionValidationError{}
+
+// Validate checks the field values on ListAllowanceTransactionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListAllowanceTransactionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAllowanceTransactionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListAllowanceTransactionsRequestMultiError, or nil if none found.
+func (m *ListAllowanceTransactionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAllowanceTransactionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.NoPaging != nil {
+		// no validation rules for NoPaging
+	}
+
+	if m.AllowanceId != nil {
+		// no validation rules for AllowanceId
+	}
+
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
+
+	if m.Year != nil {
+		// no validation rules for Year
+	}
+
+	if m.LeaveRequestId != nil {
+		// no validation rules for LeaveRequestId
+	}
+
+	if m.Kind != nil {
+		// no validation rules for Kind
+	}
+
+	if len(errors) > 0 {
+		return ListAllowanceTransactionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAllowanceTransactionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListAllowanceTransactionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAllowanceTransactionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAllowanceTransactionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAllowanceTransactionsRequestMultiError) AllErrors() []error { return m }
+
+// ListAllowanceTransactionsRequestValidationError is the validation error
+// returned by ListAllowanceTransactionsRequest.Validate if the designated
+// constraints aren't met.
+type ListAllowanceTransactionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAllowanceTransactionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAllowanceTransactionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAllowanceTransactionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAllowanceTransactionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAllowanceTransactionsRequestValidationError) ErrorName() string {
+	return "ListAllowanceTransactionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAllowanceTransactionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAllowanceTransactionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAllowanceTransactionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAllowanceTransactionsRequestValidationError{}
+
+// Validate checks the field values on ListAllowanceTransactionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListAllowanceTransactionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAllowanceTransactionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListAllowanceTransactionsResponseMultiError, or nil if none found.
+func (m *ListAllowanceTransactionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAllowanceTransactionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAllowanceTransactionsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAllowanceTransactionsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAllowanceTransactionsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Total != nil {
+		// no validation rules for Total
+	}
+
+	if len(errors) > 0 {
+		return ListAllowanceTransactionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAllowanceTransactionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListAllowanceTransactionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAllowanceTransactionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAllowanceTransactionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAllowanceTransactionsResponseMultiError) AllErrors() []error { return m }
+
+// ListAllowanceTransactionsResponseValidationError is the validation error
+// returned by ListAllowanceTransactionsResponse.Validate if the designated
+// constraints aren't met.
+type ListAllowanceTransactionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAllowanceTransactionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAllowanceTransactionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAllowanceTransactionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAllowanceTransactionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAllowanceTransactionsResponseValidationError) ErrorName() string {
+	return "ListAllowanceTransactionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAllowanceTransactionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAllowanceTransactionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAllowanceTransactionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAllowanceTransactionsResponseValidationError{}
+
+// Validate checks the field values on RolloverAllowancesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RolloverAllowancesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RolloverAllowancesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RolloverAllowancesRequestMultiError, or nil if none found.
+func (m *RolloverAllowancesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RolloverAllowancesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromYear
+
+	// no validation rules for DryRun
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
+
+	if m.AbsenceTypeId != nil {
+		// no validation rules for AbsenceTypeId
+	}
+
+	if m.AllowancePoolId != nil {
+		// no validation rules for AllowancePoolId
+	}
+
+	if len(errors) > 0 {
+		return RolloverAllowancesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RolloverAllowancesRequestMultiError is an error wrapping multiple validation
+// errors returned by RolloverAllowancesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RolloverAllowancesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RolloverAllowancesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RolloverAllowancesRequestMultiError) AllErrors() []error { return m }
+
+// RolloverAllowancesRequestValidationError is the validation error returned by
+// RolloverAllowancesRequest.Validate if the designated constraints aren't met.
+type RolloverAllowancesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RolloverAllowancesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RolloverAllowancesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RolloverAllowancesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RolloverAllowancesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RolloverAllowancesRequestValidationError) ErrorName() string {
+	return "RolloverAllowancesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RolloverAllowancesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRolloverAllowancesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RolloverAllowancesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RolloverAllowancesRequestValidationError{}
+
+// Validate checks the field values on RolloverItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RolloverItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RolloverItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RolloverItemMultiError, or
+// nil if none found.
+func (m *RolloverItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RolloverItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for UserName
+
+	// no validation rules for Name
+
+	// no validation rules for SourceAllowanceId
+
+	// no validation rules for Action
+
+	// no validation rules for RemainingDays
+
+	// no validation rules for TotalDays
+
+	// no validation rules for CarriedOver
+
+	// no validation rules for ForfeitedDays
+
+	if m.AbsenceTypeId != nil {
+		// no validation rules for AbsenceTypeId
+	}
+
+	if m.AllowancePoolId != nil {
+		// no validation rules for AllowancePoolId
+	}
+
+	if m.TargetAllowanceId != nil {
+		// no validation rules for TargetAllowanceId
+	}
+
+	if m.SkipReason != nil {
+		// no validation rules for SkipReason
+	}
+
+	if m.CarryOverExpiry != nil {
+
+		if all {
+			switch v := interface{}(m.GetCarryOverExpiry()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RolloverItemValidationError{
+						field:  "CarryOverExpiry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RolloverItemValidationError{
+						field:  "CarryOverExpiry",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCarryOverExpiry()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RolloverItemValidationError{
+					field:  "CarryOverExpiry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.AnnualDays != nil {
+		// no validation rules for AnnualDays
+	}
+
+	if len(errors) > 0 {
+		return RolloverItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// RolloverItemMultiError is an error wrapping multiple validation errors
+// returned by RolloverItem.ValidateAll() if the designated constraints aren't met.
+type RolloverItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RolloverItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RolloverItemMultiError) AllErrors() []error { return m }
+
+// RolloverItemValidationError is the validation error returned by
+// RolloverItem.Validate if the designated constraints aren't met.
+type RolloverItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RolloverItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RolloverItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RolloverItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RolloverItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RolloverItemValidationError) ErrorName() string { return "RolloverItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RolloverItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRolloverItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RolloverItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RolloverItemValidationError{}
+
+// Validate checks the field values on RolloverAllowancesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RolloverAllowancesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RolloverAllowancesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RolloverAllowancesResponseMultiError, or nil if none found.
+func (m *RolloverAllowancesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RolloverAllowancesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RolloverAllowancesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RolloverAllowancesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RolloverAllowancesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DryRun
+
+	// no validation rules for Created
+
+	// no validation rules for Updated
+
+	// no validation rules for Skipped
+
+	if len(errors) > 0 {
+		return RolloverAllowancesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RolloverAllowancesResponseMultiError is an error wrapping multiple
+// validation errors returned by RolloverAllowancesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RolloverAllowancesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RolloverAllowancesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RolloverAllowancesResponseMultiError) AllErrors() []error { return m }
+
+// RolloverAllowancesResponseValidationError is the validation error returned
+// by RolloverAllowancesResponse.Validate if the designated constraints aren't met.
+type RolloverAllowancesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RolloverAllowancesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RolloverAllowancesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RolloverAllowancesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RolloverAllowancesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RolloverAllowancesResponseValidationError) ErrorName() string {
+	return "RolloverAllowancesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RolloverAllowancesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRolloverAllowancesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RolloverAllowancesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RolloverAllowancesResponseValidationError{}
@@ -20,12 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	HrAllowanceService_CreateAllowance_FullMethodName = "/hr.service.v1.HrAllowanceService/CreateAllowance"
-	HrAllowanceService_GetAllowance_FullMethodName    = "/hr.service.v1.HrAllowanceService/GetAllowance"
-	HrAllowanceService_ListAllowances_FullMethodName  = "/hr.service.v1.HrAllowanceService/ListAllowances"
-	HrAllowanceService_UpdateAllowance_FullMethodName = "/hr.service.v1.HrAllowanceService/UpdateAllowance"
-	HrAllowanceService_DeleteAllowance_FullMethodName = "/hr.service.v1.HrAllowanceService/DeleteAllowance"
-	HrAllowanceService_GetUserBalance_FullMethodName  = "/hr.service.v1.HrAllowanceService/GetUserBalance"
+	HrAllowanceService_CreateAllowance_FullMethodName           = "/hr.service.v1.HrAllowanceService/CreateAllowance"
+	HrAllowanceService_GetAllowance_FullMethodName              = "/hr.service.v1.HrAllowanceService/GetAllowance"
+	HrAllowanceService_ListAllowances_FullMethodName            = "/hr.service.v1.HrAllowanceService/ListAllowances"
+	HrAllowanceService_UpdateAllowance_FullMethodName           = "/hr.service.v1.HrAllowanceService/UpdateAllowance"
+	HrAllowanceService_DeleteAllowance_FullMethodName           = "/hr.service.v1.HrAllowanceService/DeleteAllowance"
+	HrAllowanceService_GetUserBalance_FullMethodName            = "/hr.service.v1.HrAllowanceService/GetUserBalance"
+	HrAllowanceService_ListAllowanceTransactions_FullMethodName = "/hr.service.v1.HrAllowanceService/ListAllowanceTransactions"
+	HrAllowanceService_RolloverAllowances_FullMethodName        = "/hr.service.v1.HrAllowanceService/RolloverAllowances"
 )
 
 // HrAllowanceServiceClient is the client API for HrAllowanceService service.
@@ -40,6 +42,11 @@ type HrAllowanceServiceClient interface {
 	UpdateAllowance(ctx context.Context, in *UpdateAllowanceRequest, opts ...grpc.CallOption) (*UpdateAllowanceResponse, error)
 	DeleteAllowance(ctx context.Context, in *DeleteAllowanceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetUserBalance(ctx context.Context, in *GetUserBalanceRequest, opts ...grpc.CallOption) (*GetUserBalanceResponse, error)
+	// Lists the ledger entries behind allowance balances, newest first
+	ListAllowanceTransactions(ctx context.Context, in *ListAllowanceTransactionsRequest, opts ...grpc.CallOption) (*ListAllowanceTransactionsResponse, error)
+	// Creates next year's allowances and carries unused days over according to the rollover
+	// policies of the absence types and pools
+	RolloverAllowances(ctx context.Context, in *RolloverAllowancesRequest, opts ...grpc.CallOption) (*RolloverAllowancesResponse, error)
 }
 
 type hrAllowanceServiceClient struct {
@@ -110,6 +117,26 @@ func (c *hrAllowanceServiceClient) GetUserBalance(ctx context.Context, in *GetUs
 	return out, nil
 }
 
+func (c *hrAllowanceServiceClient) ListAllowanceTransactions(ctx context.Context, in *ListAllowanceTransactionsRequest, opts ...grpc.CallOption) (*ListAllowanceTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAllowanceTransactionsResponse)
+	err := c.cc.Invoke(ctx, HrAllowanceService_ListAllowanceTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrAllowanceServiceClient) RolloverAllowances(ctx context.Context, in *RolloverAllowancesRequest, opts ...grpc.CallOption) (*RolloverAllowancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RolloverAllowancesResponse)
+	err := c.cc.Invoke(ctx, HrAllowanceService_RolloverAllowances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HrAllowanceServiceServer is the server API for HrAllowanceService service.
 // All implementations must embed UnimplementedHrAllowanceServiceServer
 // for forward compatibility.
@@ -122,6 +149,11 @@ type HrAllowanceServiceServer interface {
 	UpdateAllowance(context.Context, *UpdateAllowanceRequest) (*UpdateAllowanceResponse, error)
 	DeleteAllowance(context.Context, *DeleteAllowanceRequest) (*emptypb.Empty, error)
 	GetUserBalance(context.Context, *GetUserBalanceRequest) (*GetUserBalanceResponse, error)
+	// Lists the ledger entries behind allowance balances, newest first
+	ListAllowanceTransactions(context.Context, *ListAllowanceTransactionsRequest) (*ListAllowanceTransactionsResponse, error)
+	// Creates next year's allowances and carries unused days over according to the rollover
+	// policies of the absence types and pools
+	RolloverAllowances(context.Context, *RolloverAllowancesRequest) (*RolloverAllowancesResponse, error)
 	mustEmbedUnimplementedHrAllowanceServiceServer()
 }
 
@@ -150,6 +182,12 @@ func (UnimplementedHrAllowanceServiceServer) DeleteAllowance(context.Context, *D
 func (UnimplementedHrAllowanceServiceServer) GetUserBalance(context.Context, *GetUserBalanceRequest) (*GetUserBalanceResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUserBalance not implemented")
 }
+func (UnimplementedHrAllowanceServiceServer) ListAllowanceTransactions(context.Context, *ListAllowanceTransactionsRequest) (*ListAllowanceTransactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAllowanceTransactions not implemented")
+}
+func (UnimplementedHrAllowanceServiceServer) RolloverAllowances(context.Context, *RolloverAllowancesRequest) (*RolloverAllowancesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RolloverAllowances not implemented")
+}
 func (UnimplementedHrAllowanceServiceServer) mustEmbedUnimplementedHrAllowanceServiceServer() {}
 func (UnimplementedHrAllowanceServiceServer) testEmbeddedByValue()                            {}
 
@@ -279,6 +317,42 @@ func _HrAllowanceService_GetUserBalance_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HrAllowanceService_ListAllowanceTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllowanceTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrAllowanceServiceServer).ListAllowanceTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrAllowanceService_ListAllowanceTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrAllowanceServiceServer).ListAllowanceTransactions(ctx, req.(*ListAllowanceTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrAllowanceService_RolloverAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloverAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrAllowanceServiceServer).RolloverAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrAllowanceService_RolloverAllowances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrAllowanceServiceServer).RolloverAllowances(ctx, req.(*RolloverAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HrAllowanceService_ServiceDesc is the grpc.ServiceDesc for HrAllowanceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -310,6 +384,14 @@ var HrAllowanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserBalance",
 			Handler:    _HrAllowanceService_GetUserBalance_Handler,
 		},
+		{
+			MethodName: "ListAllowanceTransactions",
+			Handler:    _HrAllowanceService_ListAllowanceTransactions_Handler,
+		},
+		{
+			MethodName: "RolloverAllowances",
+			Handler:    _HrAllowanceService_RolloverAllowances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hr/service/v1/allowance.proto",
@@ -24,7 +24,9 @@ const OperationHrAllowanceServiceCreateAllowance = "/hr.service.v1.HrAllowanceSe
 const OperationHrAllowanceServiceDeleteAllowance = "/hr.service.v1.HrAllowanceService/DeleteAllowance"
 const OperationHrAllowanceServiceGetAllowance = "/hr.service.v1.HrAllowanceService/GetAllowance"
 const OperationHrAllowanceServiceGetUserBalance = "/hr.service.v1.HrAllowanceService/GetUserBalance"
+const OperationHrAllowanceServiceListAllowanceTransactions = "/hr.service.v1.HrAllowanceService/ListAllowanceTransactions"
 const OperationHrAllowanceServiceListAllowances = "/hr.service.v1.HrAllowanceService/ListAllowances"
+const OperationHrAllowanceServiceRolloverAllowances = "/hr.service.v1.HrAllowanceService/RolloverAllowances"
 const OperationHrAllowanceServiceUpdateAllowance = "/hr.service.v1.HrAllowanceService/UpdateAllowance"
 
 type HrAllowanceServiceHTTPServer interface {
@@ -32,7 +34,12 @@ type HrAllowanceServiceHTTPServer interface {
 	DeleteAllowance(context.Context, *DeleteAllowanceRequest) (*emptypb.Empty, error)
 	GetAllowance(context.Context, *GetAllowanceRequest) (*GetAllowanceResponse, error)
 	GetUserBalance(context.Context, *GetUserBalanceRequest) (*GetUserBalanceResponse, error)
+	// ListAllowanceTransactions Lists the ledger entries behind allowance balances, newest first
+	ListAllowanceTransactions(context.Context, *ListAllowanceTransactionsRequest) (*ListAllowanceTransactionsResponse, error)
 	ListAllowances(context.Context, *ListAllowancesRequest) (*ListAllowancesResponse, error)
+	// RolloverAllowances Creates next year's allowances and carries unused days over according to the rollover
+	// policies of the absence types and pools
+	RolloverAllowances(context.Context, *RolloverAllowancesRequest) (*RolloverAllowancesResponse, error)
 	UpdateAllowance(context.Context, *UpdateAllowanceRequest) (*UpdateAllowanceResponse, error)
 }
 
@@ -44,6 +51,8 @@ func RegisterHrAllowanceServiceHTTPServer(s *http.Server, srv HrAllowanceService
 	r.PUT("/v1/allowances/{id}", _HrAllowanceService_UpdateAllowance0_HTTP_Handler(srv))
 	r.DELETE("/v1/allowances/{id}", _HrAllowanceService_DeleteAllowance0_HTTP_Handler(srv))
 	r.GET("/v1/users/{user_id}/balance", _HrAllowanceService_GetUserBalance0_HTTP_Handler(srv))
+	r.GET("/v1/allowance-transactions", _HrAllowanceService_ListAllowanceTransactions0_HTTP_Handler(srv))
+	r.POST("/v1/allowances/rollover", _HrAllowanceService_RolloverAllowances0_HTTP_Handler(srv))
 }
 
 func _HrAllowanceService_CreateAllowance0_HTTP_Handler(srv HrAllowanceServiceHTTPServer) func(ctx http.Context) error {
@@ -178,12 +187,58 @@ func _HrAllowanceService_GetUserBalance0_HTTP_Handler(srv HrAllowanceServiceHTTP
 	}
 }
 
+func _HrAllowanceService_ListAllowanceTransactions0_HTTP_Handler(srv HrAllowanceServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAllowanceTransactionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrAllowanceServiceListAllowanceTransactions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAllowanceTransactions(ctx, req.(*ListAllowanceTransactionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAllowanceTransactionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrAllowanceService_RolloverAllowances0_HTTP_Handler(srv HrAllowanceServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RolloverAllowancesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrAllowanceServiceRolloverAllowances)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RolloverAllowances(ctx, req.(*RolloverAllowancesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RolloverAllowancesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type HrAllowanceServiceHTTPClient interface {
 	CreateAllowance(ctx context.Context, req *CreateAllowanceRequest, opts ...http.CallOption) (rsp *CreateAllowanceResponse, err error)
 	DeleteAllowance(ctx context.Context, req *DeleteAllowanceRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	GetAllowance(ctx context.Context, req *GetAllowanceRequest, opts ...http.CallOption) (rsp *GetAllowanceResponse, err error)
 	GetUserBalance(ctx context.Context, req *GetUserBalanceRequest, opts ...http.CallOption) (rsp *GetUserBalanceResponse, err error)
+	// ListAllowanceTransactions Lists the ledger entries behind allowance balances, newest first
+	ListAllowanceTransactions(ctx context.Context, req *ListAllowanceTransactionsRequest, opts ...http.CallOption) (rsp *ListAllowanceTransactionsResponse, err error)
 	ListAllowances(ctx context.Context, req *ListAllowancesRequest, opts ...http.CallOption) (rsp *ListAllowancesResponse, err error)
+	// RolloverAllowances Creates next year's allowances and carries unused days over according to the rollover
+	// policies of the absence types and pools
+	RolloverAllowances(ctx context.Context, req *RolloverAllowancesRequest, opts ...http.CallOption) (rsp *RolloverAllowancesResponse, err error)
 	UpdateAllowance(ctx context.Context, req *UpdateAllowanceRequest, opts ...http.CallOption) (rsp *UpdateAllowanceResponse, err error)
 }
 
@@ -247,6 +302,20 @@ func (c *HrAllowanceServiceHTTPClientImpl) GetUserBalance(ctx context.Context, i
 	return &out, nil
 }
 
+// ListAllowanceTransactions Lists the ledger entries behind allowance balances, newest first
+func (c *HrAllowanceServiceHTTPClientImpl) ListAllowanceTransactions(ctx context.Context, in *ListAllowanceTransactionsRequest, opts ...http.CallOption) (*ListAllowanceTransactionsResponse, error) {
+	var out ListAllowanceTransactionsResponse
+	pattern := "/v1/allowance-transactions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationHrAllowanceServiceListAllowanceTransactions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *HrAllowanceServiceHTTPClientImpl) ListAllowances(ctx context.Context, in *ListAllowancesRequest, opts ...http.CallOption) (*ListAllowancesResponse, error) {
 	var out ListAllowancesResponse
 	pattern := "/v1/allowances"
@@ -260,6 +329,21 @@ func (c *HrAllowanceServiceHTTPClientImpl) ListAllowances(ctx context.Context, i
 	return &out, nil
 }
 
+// RolloverAllowances Creates next year's allowances and carries unused days over according to the rollover
+// policies of the absence types and pools
+func (c *HrAllowanceServiceHTTPClientImpl) RolloverAllowances(ctx context.Context, in *RolloverAllowancesRequest, opts ...http.CallOption) (*RolloverAllowancesResponse, error) {
+	var out RolloverAllowancesResponse
+	pattern := "/v1/allowances/rollover"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHrAllowanceServiceRolloverAllowances))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *HrAllowanceServiceHTTPClientImpl) UpdateAllowance(ctx context.Context, in *UpdateAllowanceRequest, opts ...http.CallOption) (*UpdateAllowanceResponse, error) {
 	var out UpdateAllowanceResponse
 	pattern := "/v1/allowances/{id}"
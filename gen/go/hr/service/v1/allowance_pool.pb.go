@@ -28,13 +28,15 @@ const (
 
 // AllowancePool groups multiple absence types to share a single leave allowance budget
 type AllowancePool struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	Id          *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	TenantId    *uint32                `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
-	Name        *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Color       *string                `protobuf:"bytes,5,opt,name=color,proto3,oneof" json:"color,omitempty"`
-	Icon        *string                `protobuf:"bytes,6,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	TenantId       *uint32                `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	Name           *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description    *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Color          *string                `protobuf:"bytes,5,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	Icon           *string                `protobuf:"bytes,6,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	AccrualPolicy  *AccrualPolicy         `protobuf:"bytes,7,opt,name=accrual_policy,json=accrualPolicy,proto3,oneof" json:"accrual_policy,omitempty"`
+	RolloverPolicy *RolloverPolicy        `protobuf:"bytes,8,opt,name=rollover_policy,json=rolloverPolicy,proto3,oneof" json:"rollover_policy,omitempty"`
 	// IDs of absence types that belong to this pool (read-only, populated on get/list)
 	AbsenceTypeIds []string               `protobuf:"bytes,10,rep,name=absence_type_ids,json=absenceTypeIds,proto3" json:"absence_type_ids,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
@@ -117,6 +119,20 @@ func (x *AllowancePool) GetIcon() string {
 	return ""
 }
 
+func (x *AllowancePool) GetAccrualPolicy() *AccrualPolicy {
+	if x != nil {
+		return x.AccrualPolicy
+	}
+	return nil
+}
+
+func (x *AllowancePool) GetRolloverPolicy() *RolloverPolicy {
+	if x != nil {
+		return x.RolloverPolicy
+	}
+	return nil
+}
+
 func (x *AllowancePool) GetAbsenceTypeIds() []string {
 	if x != nil {
 		return x.AbsenceTypeIds
@@ -159,7 +175,9 @@ type CreateAllowancePoolRequest struct {
 	Color       *string                `protobuf:"bytes,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
 	Icon        *string                `protobuf:"bytes,4,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
 	// Absence type IDs to add to this pool
-	AbsenceTypeIds []string `protobuf:"bytes,5,rep,name=absence_type_ids,json=absenceTypeIds,proto3" json:"absence_type_ids,omitempty"`
+	AbsenceTypeIds []string        `protobuf:"bytes,5,rep,name=absence_type_ids,json=absenceTypeIds,proto3" json:"absence_type_ids,omitempty"`
+	AccrualPolicy  *AccrualPolicy  `protobuf:"bytes,6,opt,name=accrual_policy,json=accrualPolicy,proto3,oneof" json:"accrual_policy,omitempty"`
+	RolloverPolicy *RolloverPolicy `protobuf:"bytes,7,opt,name=rollover_policy,json=rolloverPolicy,proto3,oneof" json:"rollover_policy,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -229,6 +247,20 @@ func (x *CreateAllowancePoolRequest) GetAbsenceTypeIds() []string {
 	return nil
 }
 
+func (x *CreateAllowancePoolRequest) GetAccrualPolicy() *AccrualPolicy {
+	if x != nil {
+		return x.AccrualPolicy
+	}
+	return nil
+}
+
+func (x *CreateAllowancePoolRequest) GetRolloverPolicy() *RolloverPolicy {
+	if x != nil {
+		return x.RolloverPolicy
+	}
+	return nil
+}
+
 type CreateAllowancePoolResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pool          *AllowancePool         `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
@@ -633,45 +665,54 @@ var File_hr_service_v1_allowance_pool_proto protoreflect.FileDescriptor
 
 const file_hr_service_v1_allowance_pool_proto_rawDesc = "" +
 	"\n" +
-	"\"hr/service/v1/allowance_pool.proto\x12\rhr.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xa9\x04\n" +
+	"\"hr/service/v1/allowance_pool.proto\x12\rhr.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1bhr/service/v1/accrual.proto\x1a\x1chr/service/v1/rollover.proto\"\xe7\x05\n" +
 	"\rAllowancePool\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12 \n" +
 	"\ttenant_id\x18\x02 \x01(\rH\x01R\btenantId\x88\x01\x01\x12\x17\n" +
 	"\x04name\x18\x03 \x01(\tH\x02R\x04name\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x04 \x01(\tH\x03R\vdescription\x88\x01\x01\x12\x19\n" +
 	"\x05color\x18\x05 \x01(\tH\x04R\x05color\x88\x01\x01\x12\x17\n" +
-	"\x04icon\x18\x06 \x01(\tH\x05R\x04icon\x88\x01\x01\x12(\n" +
+	"\x04icon\x18\x06 \x01(\tH\x05R\x04icon\x88\x01\x01\x12H\n" +
+	"\x0eaccrual_policy\x18\a \x01(\v2\x1c.hr.service.v1.AccrualPolicyH\x06R\raccrualPolicy\x88\x01\x01\x12K\n" +
+	"\x0frollover_policy\x18\b \x01(\v2\x1d.hr.service.v1.RolloverPolicyH\aR\x0erolloverPolicy\x88\x01\x01\x12(\n" +
 	"\x10absence_type_ids\x18\n" +
 	" \x03(\tR\x0eabsenceTypeIds\x12>\n" +
 	"\n" +
-	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\x06R\tcreatedAt\x88\x01\x01\x12>\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\bR\tcreatedAt\x88\x01\x01\x12>\n" +
 	"\n" +
-	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\aR\tupdatedAt\x88\x01\x01\x12\"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\tR\tupdatedAt\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"created_by\x18\x16 \x01(\rH\bR\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"created_by\x18\x16 \x01(\rH\n" +
+	"R\tcreatedBy\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"updated_by\x18\x17 \x01(\rH\tR\tupdatedBy\x88\x01\x01B\x05\n" +
+	"updated_by\x18\x17 \x01(\rH\vR\tupdatedBy\x88\x01\x01B\x05\n" +
 	"\x03_idB\f\n" +
 	"\n" +
 	"_tenant_idB\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\b\n" +
 	"\x06_colorB\a\n" +
-	"\x05_iconB\r\n" +
+	"\x05_iconB\x11\n" +
+	"\x0f_accrual_policyB\x12\n" +
+	"\x10_rollover_policyB\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\r\n" +
 	"\v_created_byB\r\n" +
-	"\v_updated_by\"\xf5\x01\n" +
+	"\v_updated_by\"\xb3\x03\n" +
 	"\x1aCreateAllowancePoolRequest\x12&\n" +
 	"\x04name\x18\x01 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x02 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x19\n" +
 	"\x05color\x18\x03 \x01(\tH\x02R\x05color\x88\x01\x01\x12\x17\n" +
 	"\x04icon\x18\x04 \x01(\tH\x03R\x04icon\x88\x01\x01\x12(\n" +
-	"\x10absence_type_ids\x18\x05 \x03(\tR\x0eabsenceTypeIdsB\a\n" +
+	"\x10absence_type_ids\x18\x05 \x03(\tR\x0eabsenceTypeIds\x12H\n" +
+	"\x0eaccrual_policy\x18\x06 \x01(\v2\x1c.hr.service.v1.AccrualPolicyH\x04R\raccrualPolicy\x88\x01\x01\x12K\n" +
+	"\x0frollover_policy\x18\a \x01(\v2\x1d.hr.service.v1.RolloverPolicyH\x05R\x0erolloverPolicy\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\b\n" +
 	"\x06_colorB\a\n" +
-	"\x05_icon\"O\n" +
+	"\x05_iconB\x11\n" +
+	"\x0f_accrual_policyB\x12\n" +
+	"\x10_rollover_policy\"O\n" +
 	"\x1bCreateAllowancePoolResponse\x120\n" +
 	"\x04pool\x18\x01 \x01(\v2\x1c.hr.service.v1.AllowancePoolR\x04pool\"5\n" +
 	"\x17GetAllowancePoolRequest\x12\x1a\n" +
@@ -738,34 +779,40 @@ var file_hr_service_v1_allowance_pool_proto_goTypes = []any{
 	(*UpdateAllowancePoolRequest)(nil),  // 7: hr.service.v1.UpdateAllowancePoolRequest
 	(*UpdateAllowancePoolResponse)(nil), // 8: hr.service.v1.UpdateAllowancePoolResponse
 	(*DeleteAllowancePoolRequest)(nil),  // 9: hr.service.v1.DeleteAllowancePoolRequest
-	(*timestamppb.Timestamp)(nil),       // 10: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),       // 11: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),               // 12: google.protobuf.Empty
+	(*AccrualPolicy)(nil),               // 10: hr.service.v1.AccrualPolicy
+	(*RolloverPolicy)(nil),              // 11: hr.service.v1.RolloverPolicy
+	(*timestamppb.Timestamp)(nil),       // 12: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 13: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),               // 14: google.protobuf.Empty
 }
 var file_hr_service_v1_allowance_pool_proto_depIdxs = []int32{
-	10, // 0: hr.service.v1.AllowancePool.created_at:type_name -> google.protobuf.Timestamp
-	10, // 1: hr.service.v1.AllowancePool.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: hr.service.v1.CreateAllowancePoolResponse.pool:type_name -> hr.service.v1.AllowancePool
-	0,  // 3: hr.service.v1.GetAllowancePoolResponse.pool:type_name -> hr.service.v1.AllowancePool
-	0,  // 4: hr.service.v1.ListAllowancePoolsResponse.items:type_name -> hr.service.v1.AllowancePool
-	0,  // 5: hr.service.v1.UpdateAllowancePoolRequest.data:type_name -> hr.service.v1.AllowancePool
-	11, // 6: hr.service.v1.UpdateAllowancePoolRequest.update_mask:type_name -> google.protobuf.FieldMask
-	0,  // 7: hr.service.v1.UpdateAllowancePoolResponse.pool:type_name -> hr.service.v1.AllowancePool
-	1,  // 8: hr.service.v1.HrAllowancePoolService.CreateAllowancePool:input_type -> hr.service.v1.CreateAllowancePoolRequest
-	3,  // 9: hr.service.v1.HrAllowancePoolService.GetAllowancePool:input_type -> hr.service.v1.GetAllowancePoolRequest
-	5,  // 10: hr.service.v1.HrAllowancePoolService.ListAllowancePools:input_type -> hr.service.v1.ListAllowancePoolsRequest
-	7,  // 11: hr.service.v1.HrAllowancePoolService.UpdateAllowancePool:input_type -> hr.service.v1.UpdateAllowancePoolRequest
-	9,  // 12: hr.service.v1.HrAllowancePoolService.DeleteAllowancePool:input_type -> hr.service.v1.DeleteAllowancePoolRequest
-	2,  // 13: hr.service.v1.HrAllowancePoolService.CreateAllowancePool:output_type -> hr.service.v1.CreateAllowancePoolResponse
-	4,  // 14: hr.service.v1.HrAllowancePoolService.GetAllowancePool:output_type -> hr.service.v1.GetAllowancePoolResponse
-	6,  // 15: hr.service.v1.HrAllowancePoolService.ListAllowancePools:output_type -> hr.service.v1.ListAllowancePoolsResponse
-	8,  // 16: hr.service.v1.HrAllowancePoolService.UpdateAllowancePool:output_type -> hr.service.v1.UpdateAllowancePoolResponse
-	12, // 17: hr.service.v1.HrAllowancePoolService.DeleteAllowancePool:output_type -> google.protobuf.Empty
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	10, // 0: hr.service.v1.AllowancePool.accrual_policy:type_name -> hr.service.v1.AccrualPolicy
+	11, // 1: hr.service.v1.AllowancePool.rollover_policy:type_name -> hr.service.v1.RolloverPolicy
+	12, // 2: hr.service.v1.AllowancePool.created_at:type_name -> google.protobuf.Timestamp
+	12, // 3: hr.service.v1.AllowancePool.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 4: hr.service.v1.CreateAllowancePoolRequest.accrual_policy:type_name -> hr.service.v1.AccrualPolicy
+	11, // 5: hr.service.v1.CreateAllowancePoolRequest.rollover_policy:type_name -> hr.service.v1.RolloverPolicy
+	0,  // 6: hr.service.v1.CreateAllowancePoolResponse.pool:type_name -> hr.service.v1.AllowancePool
+	0,  // 7: hr.service.v1.GetAllowancePoolResponse.pool:type_name -> hr.service.v1.AllowancePool
+	0,  // 8: hr.service.v1.ListAllowancePoolsResponse.items:type_name -> hr.service.v1.AllowancePool
+	0,  // 9: hr.service.v1.UpdateAllowancePoolRequest.data:type_name -> hr.service.v1.AllowancePool
+	13, // 10: hr.service.v1.UpdateAllowancePoolRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 11: hr.service.v1.UpdateAllowancePoolResponse.pool:type_name -> hr.service.v1.AllowancePool
+	1,  // 12: hr.service.v1.HrAllowancePoolService.CreateAllowancePool:input_type -> hr.service.v1.CreateAllowancePoolRequest
+	3,  // 13: hr.service.v1.HrAllowancePoolService.GetAllowancePool:input_type -> hr.service.v1.GetAllowancePoolRequest
+	5,  // 14: hr.service.v1.HrAllowancePoolService.ListAllowancePools:input_type -> hr.service.v1.ListAllowancePoolsRequest
+	7,  // 15: hr.service.v1.HrAllowancePoolService.UpdateAllowancePool:input_type -> hr.service.v1.UpdateAllowancePoolRequest
+	9,  // 16: hr.service.v1.HrAllowancePoolService.DeleteAllowancePool:input_type -> hr.service.v1.DeleteAllowancePoolRequest
+	2,  // 17: hr.service.v1.HrAllowancePoolService.CreateAllowancePool:output_type -> hr.service.v1.CreateAllowancePoolResponse
+	4,  // 18: hr.service.v1.HrAllowancePoolService.GetAllowancePool:output_type -> hr.service.v1.GetAllowancePoolResponse
+	6,  // 19: hr.service.v1.HrAllowancePoolService.ListAllowancePools:output_type -> hr.service.v1.ListAllowancePoolsResponse
+	8,  // 20: hr.service.v1.HrAllowancePoolService.UpdateAllowancePool:output_type -> hr.service.v1.UpdateAllowancePoolResponse
+	14, // 21: hr.service.v1.HrAllowancePoolService.DeleteAllowancePool:output_type -> google.protobuf.Empty
+	17, // [17:22] is the sub-list for method output_type
+	12, // [12:17] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_hr_service_v1_allowance_pool_proto_init() }
@@ -773,6 +820,8 @@ func file_hr_service_v1_allowance_pool_proto_init() {
 	if File_hr_service_v1_allowance_pool_proto != nil {
 		return
 	}
+	file_hr_service_v1_accrual_proto_init()
+	file_hr_service_v1_rollover_proto_init()
 	file_hr_service_v1_allowance_pool_proto_msgTypes[0].OneofWrappers = []any{}
 	file_hr_service_v1_allowance_pool_proto_msgTypes[1].OneofWrappers = []any{}
 	file_hr_service_v1_allowance_pool_proto_msgTypes[5].OneofWrappers = []any{}
@@ -121,6 +121,10 @@ func (x *AllowancePool) Redact() string {
 
 	// Safe field: Icon
 
+	// Safe field: AccrualPolicy
+
+	// Safe field: RolloverPolicy
+
 	// Safe field: AbsenceTypeIds
 
 	// Safe field: CreatedAt
@@ -148,6 +152,10 @@ func (x *CreateAllowancePoolRequest) Redact() string {
 	// Safe field: Icon
 
 	// Safe field: AbsenceTypeIds
+
+	// Safe field: AccrualPolicy
+
+	// Safe field: RolloverPolicy
 	return x.String()
 }
 
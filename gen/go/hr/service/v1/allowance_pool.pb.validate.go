@@ -81,6 +81,72 @@ func (m *AllowancePool) validate(all bool) error {
 		// no validation rules for Icon
 	}
 
+	if m.AccrualPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetAccrualPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AllowancePoolValidationError{
+						field:  "AccrualPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AllowancePoolValidationError{
+						field:  "AccrualPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccrualPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AllowancePoolValidationError{
+					field:  "AccrualPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RolloverPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRolloverPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AllowancePoolValidationError{
+						field:  "RolloverPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AllowancePoolValidationError{
+						field:  "RolloverPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRolloverPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AllowancePoolValidationError{
+					field:  "RolloverPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if m.CreatedAt != nil {
 
 		if all {
@@ -271,6 +337,72 @@ func (m *CreateAllowancePoolRequest) validate(all bool) error {
 		// no validation rules for Icon
 	}
 
+	if m.AccrualPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetAccrualPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAllowancePoolRequestValidationError{
+						field:  "AccrualPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAllowancePoolRequestValidationError{
+						field:  "AccrualPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccrualPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAllowancePoolRequestValidationError{
+					field:  "AccrualPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RolloverPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRolloverPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAllowancePoolRequestValidationError{
+						field:  "RolloverPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAllowancePoolRequestValidationError{
+						field:  "RolloverPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRolloverPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAllowancePoolRequestValidationError{
+					field:  "RolloverPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateAllowancePoolRequestMultiError(errors)
 	}
@@ -30,17 +30,36 @@ const (
 	HrErrorReason_VALIDATION_FAILED      HrErrorReason = 1 // Validation failed
 	HrErrorReason_INVALID_DATE_RANGE     HrErrorReason = 2 // Invalid date range
 	HrErrorReason_INSUFFICIENT_ALLOWANCE HrErrorReason = 3 // Insufficient leave allowance
+	HrErrorReason_POLICY_VIOLATION       HrErrorReason = 4 // Request breaks leave policy rules
+	HrErrorReason_ATTACHMENT_REQUIRED    HrErrorReason = 5 // Request lacks a required supporting document
 	// 404
-	HrErrorReason_NOT_FOUND                HrErrorReason = 100 // Resource not found
-	HrErrorReason_ABSENCE_TYPE_NOT_FOUND   HrErrorReason = 102 // Absence type not found
-	HrErrorReason_LEAVE_REQUEST_NOT_FOUND  HrErrorReason = 103 // Leave request not found
-	HrErrorReason_ALLOWANCE_NOT_FOUND      HrErrorReason = 104 // Leave allowance not found
-	HrErrorReason_ALLOWANCE_POOL_NOT_FOUND HrErrorReason = 105 // Allowance pool not found
+	HrErrorReason_NOT_FOUND                          HrErrorReason = 100 // Resource not found
+	HrErrorReason_ABSENCE_TYPE_NOT_FOUND             HrErrorReason = 102 // Absence type not found
+	HrErrorReason_LEAVE_REQUEST_NOT_FOUND            HrErrorReason = 103 // Leave request not found
+	HrErrorReason_ALLOWANCE_NOT_FOUND                HrErrorReason = 104 // Leave allowance not found
+	HrErrorReason_ALLOWANCE_POOL_NOT_FOUND           HrErrorReason = 105 // Allowance pool not found
+	HrErrorReason_HOLIDAY_CALENDAR_NOT_FOUND         HrErrorReason = 106 // Holiday calendar not found
+	HrErrorReason_HOLIDAY_NOT_FOUND                  HrErrorReason = 107 // Holiday not found
+	HrErrorReason_WORK_SCHEDULE_NOT_FOUND            HrErrorReason = 108 // Work schedule not found
+	HrErrorReason_WORK_SCHEDULE_ASSIGNMENT_NOT_FOUND HrErrorReason = 109 // Work schedule assignment not found
+	HrErrorReason_EMPLOYMENT_NOT_FOUND               HrErrorReason = 110 // Employment not found
+	HrErrorReason_APPROVAL_DELEGATION_NOT_FOUND      HrErrorReason = 111 // Approval delegation not found
+	HrErrorReason_LEAVE_AMENDMENT_NOT_FOUND          HrErrorReason = 112 // Leave amendment not found
+	HrErrorReason_LEAVE_POLICY_NOT_FOUND             HrErrorReason = 113 // Leave policy not found
+	HrErrorReason_BLACKOUT_PERIOD_NOT_FOUND          HrErrorReason = 114 // Blackout period not found
+	HrErrorReason_COVERAGE_RULE_NOT_FOUND            HrErrorReason = 115 // Coverage rule not found
+	HrErrorReason_LEAVE_ATTACHMENT_NOT_FOUND         HrErrorReason = 116 // Leave attachment not found
+	HrErrorReason_LEAVE_COMMENT_NOT_FOUND            HrErrorReason = 117 // Leave comment not found
+	HrErrorReason_OUTBOX_MESSAGE_NOT_FOUND           HrErrorReason = 118 // Outbox message not found
+	HrErrorReason_WEBHOOK_SUBSCRIPTION_NOT_FOUND     HrErrorReason = 119 // Webhook subscription not found
+	HrErrorReason_WEBHOOK_DELIVERY_NOT_FOUND         HrErrorReason = 120 // Webhook delivery not found
 	// 409
 	HrErrorReason_ALREADY_EXISTS        HrErrorReason = 200 // Resource already exists
 	HrErrorReason_OVERLAP_EXISTS        HrErrorReason = 201 // Overlapping leave request exists
 	HrErrorReason_ABSENCE_TYPE_IN_USE   HrErrorReason = 203 // Absence type is in use
 	HrErrorReason_ALLOWANCE_POOL_IN_USE HrErrorReason = 204 // Allowance pool is in use
+	HrErrorReason_WORK_SCHEDULE_IN_USE  HrErrorReason = 205 // Work schedule is in use
+	HrErrorReason_COVERAGE_CONFLICT     HrErrorReason = 206 // Too few team members would be at work
 	// 500
 	HrErrorReason_INTERNAL_SERVER_ERROR HrErrorReason = 300 // Internal server error
 )
@@ -52,32 +71,70 @@ var (
 		1:   "VALIDATION_FAILED",
 		2:   "INVALID_DATE_RANGE",
 		3:   "INSUFFICIENT_ALLOWANCE",
+		4:   "POLICY_VIOLATION",
+		5:   "ATTACHMENT_REQUIRED",
 		100: "NOT_FOUND",
 		102: "ABSENCE_TYPE_NOT_FOUND",
 		103: "LEAVE_REQUEST_NOT_FOUND",
 		104: "ALLOWANCE_NOT_FOUND",
 		105: "ALLOWANCE_POOL_NOT_FOUND",
+		106: "HOLIDAY_CALENDAR_NOT_FOUND",
+		107: "HOLIDAY_NOT_FOUND",
+		108: "WORK_SCHEDULE_NOT_FOUND",
+		109: "WORK_SCHEDULE_ASSIGNMENT_NOT_FOUND",
+		110: "EMPLOYMENT_NOT_FOUND",
+		111: "APPROVAL_DELEGATION_NOT_FOUND",
+		112: "LEAVE_AMENDMENT_NOT_FOUND",
+		113: "LEAVE_POLICY_NOT_FOUND",
+		114: "BLACKOUT_PERIOD_NOT_FOUND",
+		115: "COVERAGE_RULE_NOT_FOUND",
+		116: "LEAVE_ATTACHMENT_NOT_FOUND",
+		117: "LEAVE_COMMENT_NOT_FOUND",
+		118: "OUTBOX_MESSAGE_NOT_FOUND",
+		119: "WEBHOOK_SUBSCRIPTION_NOT_FOUND",
+		120: "WEBHOOK_DELIVERY_NOT_FOUND",
 		200: "ALREADY_EXISTS",
 		201: "OVERLAP_EXISTS",
 		203: "ABSENCE_TYPE_IN_USE",
 		204: "ALLOWANCE_POOL_IN_USE",
+		205: "WORK_SCHEDULE_IN_USE",
+		206: "COVERAGE_CONFLICT",
 		300: "INTERNAL_SERVER_ERROR",
 	}
 	HrErrorReason_value = map[string]int32{
-		"BAD_REQUEST":              0,
-		"VALIDATION_FAILED":        1,
-		"INVALID_DATE_RANGE":       2,
-		"INSUFFICIENT_ALLOWANCE":   3,
-		"NOT_FOUND":                100,
-		"ABSENCE_TYPE_NOT_FOUND":   102,
-		"LEAVE_REQUEST_NOT_FOUND":  103,
-		"ALLOWANCE_NOT_FOUND":      104,
-		"ALLOWANCE_POOL_NOT_FOUND": 105,
-		"ALREADY_EXISTS":           200,
-		"OVERLAP_EXISTS":           201,
-		"ABSENCE_TYPE_IN_USE":      203,
-		"ALLOWANCE_POOL_IN_USE":    204,
-		"INTERNAL_SERVER_ERROR":    300,
+		"BAD_REQUEST":                        0,
+		"VALIDATION_FAILED":                  1,
+		"INVALID_DATE_RANGE":                 2,
+		"INSUFFICIENT_ALLOWANCE":             3,
+		"POLICY_VIOLATION":                   4,
+		"ATTACHMENT_REQUIRED":                5,
+		"NOT_FOUND":                          100,
+		"ABSENCE_TYPE_NOT_FOUND":             102,
+		"LEAVE_REQUEST_NOT_FOUND":            103,
+		"ALLOWANCE_NOT_FOUND":                104,
+		"ALLOWANCE_POOL_NOT_FOUND":           105,
+		"HOLIDAY_CALENDAR_NOT_FOUND":         106,
+		"HOLIDAY_NOT_FOUND":                  107,
+		"WORK_SCHEDULE_NOT_FOUND":            108,
+		"WORK_SCHEDULE_ASSIGNMENT_NOT_FOUND": 109,
+		"EMPLOYMENT_NOT_FOUND":               110,
+		"APPROVAL_DELEGATION_NOT_FOUND":      111,
+		"LEAVE_AMENDMENT_NOT_FOUND":          112,
+		"LEAVE_POLICY_NOT_FOUND":             113,
+		"BLACKOUT_PERIOD_NOT_FOUND":          114,
+		"COVERAGE_RULE_NOT_FOUND":            115,
+		"LEAVE_ATTACHMENT_NOT_FOUND":         116,
+		"LEAVE_COMMENT_NOT_FOUND":            117,
+		"OUTBOX_MESSAGE_NOT_FOUND":           118,
+		"WEBHOOK_SUBSCRIPTION_NOT_FOUND":     119,
+		"WEBHOOK_DELIVERY_NOT_FOUND":         120,
+		"ALREADY_EXISTS":                     200,
+		"OVERLAP_EXISTS":                     201,
+		"ABSENCE_TYPE_IN_USE":                203,
+		"ALLOWANCE_POOL_IN_USE":              204,
+		"WORK_SCHEDULE_IN_USE":               205,
+		"COVERAGE_CONFLICT":                  206,
+		"INTERNAL_SERVER_ERROR":              300,
 	}
 )
 
@@ -112,21 +169,40 @@ var File_hr_service_v1_hr_error_proto protoreflect.FileDescriptor
 
 const file_hr_service_v1_hr_error_proto_rawDesc = "" +
 	"\n" +
-	"\x1chr/service/v1/hr_error.proto\x12\rhr.service.v1\x1a\x13errors/errors.proto*\xc0\x03\n" +
+	"\x1chr/service/v1/hr_error.proto\x12\rhr.service.v1\x1a\x13errors/errors.proto*\xe3\b\n" +
 	"\rHrErrorReason\x12\x15\n" +
 	"\vBAD_REQUEST\x10\x00\x1a\x04\xa8E\x90\x03\x12\x1b\n" +
 	"\x11VALIDATION_FAILED\x10\x01\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
 	"\x12INVALID_DATE_RANGE\x10\x02\x1a\x04\xa8E\x90\x03\x12 \n" +
-	"\x16INSUFFICIENT_ALLOWANCE\x10\x03\x1a\x04\xa8E\x90\x03\x12\x13\n" +
+	"\x16INSUFFICIENT_ALLOWANCE\x10\x03\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
+	"\x10POLICY_VIOLATION\x10\x04\x1a\x04\xa8E\x90\x03\x12\x1d\n" +
+	"\x13ATTACHMENT_REQUIRED\x10\x05\x1a\x04\xa8E\x90\x03\x12\x13\n" +
 	"\tNOT_FOUND\x10d\x1a\x04\xa8E\x94\x03\x12 \n" +
 	"\x16ABSENCE_TYPE_NOT_FOUND\x10f\x1a\x04\xa8E\x94\x03\x12!\n" +
 	"\x17LEAVE_REQUEST_NOT_FOUND\x10g\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
 	"\x13ALLOWANCE_NOT_FOUND\x10h\x1a\x04\xa8E\x94\x03\x12\"\n" +
-	"\x18ALLOWANCE_POOL_NOT_FOUND\x10i\x1a\x04\xa8E\x94\x03\x12\x19\n" +
+	"\x18ALLOWANCE_POOL_NOT_FOUND\x10i\x1a\x04\xa8E\x94\x03\x12$\n" +
+	"\x1aHOLIDAY_CALENDAR_NOT_FOUND\x10j\x1a\x04\xa8E\x94\x03\x12\x1b\n" +
+	"\x11HOLIDAY_NOT_FOUND\x10k\x1a\x04\xa8E\x94\x03\x12!\n" +
+	"\x17WORK_SCHEDULE_NOT_FOUND\x10l\x1a\x04\xa8E\x94\x03\x12,\n" +
+	"\"WORK_SCHEDULE_ASSIGNMENT_NOT_FOUND\x10m\x1a\x04\xa8E\x94\x03\x12\x1e\n" +
+	"\x14EMPLOYMENT_NOT_FOUND\x10n\x1a\x04\xa8E\x94\x03\x12'\n" +
+	"\x1dAPPROVAL_DELEGATION_NOT_FOUND\x10o\x1a\x04\xa8E\x94\x03\x12#\n" +
+	"\x19LEAVE_AMENDMENT_NOT_FOUND\x10p\x1a\x04\xa8E\x94\x03\x12 \n" +
+	"\x16LEAVE_POLICY_NOT_FOUND\x10q\x1a\x04\xa8E\x94\x03\x12#\n" +
+	"\x19BLACKOUT_PERIOD_NOT_FOUND\x10r\x1a\x04\xa8E\x94\x03\x12!\n" +
+	"\x17COVERAGE_RULE_NOT_FOUND\x10s\x1a\x04\xa8E\x94\x03\x12$\n" +
+	"\x1aLEAVE_ATTACHMENT_NOT_FOUND\x10t\x1a\x04\xa8E\x94\x03\x12!\n" +
+	"\x17LEAVE_COMMENT_NOT_FOUND\x10u\x1a\x04\xa8E\x94\x03\x12\"\n" +
+	"\x18OUTBOX_MESSAGE_NOT_FOUND\x10v\x1a\x04\xa8E\x94\x03\x12(\n" +
+	"\x1eWEBHOOK_SUBSCRIPTION_NOT_FOUND\x10w\x1a\x04\xa8E\x94\x03\x12$\n" +
+	"\x1aWEBHOOK_DELIVERY_NOT_FOUND\x10x\x1a\x04\xa8E\x94\x03\x12\x19\n" +
 	"\x0eALREADY_EXISTS\x10\xc8\x01\x1a\x04\xa8E\x99\x03\x12\x19\n" +
 	"\x0eOVERLAP_EXISTS\x10\xc9\x01\x1a\x04\xa8E\x99\x03\x12\x1e\n" +
 	"\x13ABSENCE_TYPE_IN_USE\x10\xcb\x01\x1a\x04\xa8E\x99\x03\x12 \n" +
-	"\x15ALLOWANCE_POOL_IN_USE\x10\xcc\x01\x1a\x04\xa8E\x99\x03\x12 \n" +
+	"\x15ALLOWANCE_POOL_IN_USE\x10\xcc\x01\x1a\x04\xa8E\x99\x03\x12\x1f\n" +
+	"\x14WORK_SCHEDULE_IN_USE\x10\xcd\x01\x1a\x04\xa8E\x99\x03\x12\x1c\n" +
+	"\x11COVERAGE_CONFLICT\x10\xce\x01\x1a\x04\xa8E\x99\x03\x12 \n" +
 	"\x15INTERNAL_SERVER_ERROR\x10\xac\x02\x1a\x04\xa8E\xf4\x03\x1a\x04\xa0E\xf4\x03B\xb4\x01\n" +
 	"\x11com.hr.service.v1B\fHrErrorProtoP\x01Z;github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1;hrpb\xa2\x02\x03HSX\xaa\x02\rHr.Service.V1\xca\x02\rHr\\Service\\V1\xe2\x02\x19Hr\\Service\\V1\\GPBMetadata\xea\x02\x0fHr::Service::V1b\x06proto3"
 
@@ -67,6 +67,34 @@ func ErrorInsufficientAllowance(format string, args ...interface{}) *errors.Erro
 	return errors.New(400, HrErrorReason_INSUFFICIENT_ALLOWANCE.String(), fmt.Sprintf(format, args...))
 }
 
+// Request breaks leave policy rules
+func IsPolicyViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_POLICY_VIOLATION.String() && e.Code == 400
+}
+
+// Request breaks leave policy rules
+func ErrorPolicyViolation(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, HrErrorReason_POLICY_VIOLATION.String(), fmt.Sprintf(format, args...))
+}
+
+// Request lacks a required supporting document
+func IsAttachmentRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_ATTACHMENT_REQUIRED.String() && e.Code == 400
+}
+
+// Request lacks a required supporting document
+func ErrorAttachmentRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, HrErrorReason_ATTACHMENT_REQUIRED.String(), fmt.Sprintf(format, args...))
+}
+
 // 404
 func IsNotFound(err error) bool {
 	if err == nil {
@@ -137,6 +165,216 @@ func ErrorAllowancePoolNotFound(format string, args ...interface{}) *errors.Erro
 	return errors.New(404, HrErrorReason_ALLOWANCE_POOL_NOT_FOUND.String(), fmt.Sprintf(format, args...))
 }
 
+// Holiday calendar not found
+func IsHolidayCalendarNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_HOLIDAY_CALENDAR_NOT_FOUND.String() && e.Code == 404
+}
+
+// Holiday calendar not found
+func ErrorHolidayCalendarNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_HOLIDAY_CALENDAR_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// Holiday not found
+func IsHolidayNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_HOLIDAY_NOT_FOUND.String() && e.Code == 404
+}
+
+// Holiday not found
+func ErrorHolidayNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_HOLIDAY_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// Work schedule not found
+func IsWorkScheduleNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_WORK_SCHEDULE_NOT_FOUND.String() && e.Code == 404
+}
+
+// Work schedule not found
+func ErrorWorkScheduleNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_WORK_SCHEDULE_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// Work schedule assignment not found
+func IsWorkScheduleAssignmentNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_WORK_SCHEDULE_ASSIGNMENT_NOT_FOUND.String() && e.Code == 404
+}
+
+// Work schedule assignment not found
+func ErrorWorkScheduleAssignmentNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_WORK_SCHEDULE_ASSIGNMENT_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// Employment not found
+func IsEmploymentNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_EMPLOYMENT_NOT_FOUND.String() && e.Code == 404
+}
+
+// Employment not found
+func ErrorEmploymentNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_EMPLOYMENT_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// Approval delegation not found
+func IsApprovalDelegationNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_APPROVAL_DELEGATION_NOT_FOUND.String() && e.Code == 404
+}
+
+// Approval delegation not found
+func ErrorApprovalDelegationNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_APPROVAL_DELEGATION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// Leave amendment not found
+func IsLeaveAmendmentNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_LEAVE_AMENDMENT_NOT_FOUND.String() && e.Code == 404
+}
+
+// Leave amendment not found
+func ErrorLeaveAmendmentNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_LEAVE_AMENDMENT_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// Leave policy not found
+func IsLeavePolicyNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_LEAVE_POLICY_NOT_FOUND.String() && e.Code == 404
+}
+
+// Leave policy not found
+func ErrorLeavePolicyNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_LEAVE_POLICY_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// Blackout period not found
+func IsBlackoutPeriodNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_BLACKOUT_PERIOD_NOT_FOUND.String() && e.Code == 404
+}
+
+// Blackout period not found
+func ErrorBlackoutPeriodNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_BLACKOUT_PERIOD_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// Coverage rule not found
+func IsCoverageRuleNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_COVERAGE_RULE_NOT_FOUND.String() && e.Code == 404
+}
+
+// Coverage rule not found
+func ErrorCoverageRuleNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_COVERAGE_RULE_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// Leave attachment not found
+func IsLeaveAttachmentNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_LEAVE_ATTACHMENT_NOT_FOUND.String() && e.Code == 404
+}
+
+// Leave attachment not found
+func ErrorLeaveAttachmentNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_LEAVE_ATTACHMENT_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// Leave comment not found
+func IsLeaveCommentNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_LEAVE_COMMENT_NOT_FOUND.String() && e.Code == 404
+}
+
+// Leave comment not found
+func ErrorLeaveCommentNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_LEAVE_COMMENT_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// Outbox message not found
+func IsOutboxMessageNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_OUTBOX_MESSAGE_NOT_FOUND.String() && e.Code == 404
+}
+
+// Outbox message not found
+func ErrorOutboxMessageNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_OUTBOX_MESSAGE_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// Webhook subscription not found
+func IsWebhookSubscriptionNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_WEBHOOK_SUBSCRIPTION_NOT_FOUND.String() && e.Code == 404
+}
+
+// Webhook subscription not found
+func ErrorWebhookSubscriptionNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_WEBHOOK_SUBSCRIPTION_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// Webhook delivery not found
+func IsWebhookDeliveryNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_WEBHOOK_DELIVERY_NOT_FOUND.String() && e.Code == 404
+}
+
+// Webhook delivery not found
+func ErrorWebhookDeliveryNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, HrErrorReason_WEBHOOK_DELIVERY_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
 // 409
 func IsAlreadyExists(err error) bool {
 	if err == nil {
@@ -193,6 +431,34 @@ func ErrorAllowancePoolInUse(format string, args ...interface{}) *errors.Error {
 	return errors.New(409, HrErrorReason_ALLOWANCE_POOL_IN_USE.String(), fmt.Sprintf(format, args...))
 }
 
+// Work schedule is in use
+func IsWorkScheduleInUse(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_WORK_SCHEDULE_IN_USE.String() && e.Code == 409
+}
+
+// Work schedule is in use
+func ErrorWorkScheduleInUse(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, HrErrorReason_WORK_SCHEDULE_IN_USE.String(), fmt.Sprintf(format, args...))
+}
+
+// Too few team members would be at work
+func IsCoverageConflict(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == HrErrorReason_COVERAGE_CONFLICT.String() && e.Code == 409
+}
+
+// Too few team members would be at work
+func ErrorCoverageConflict(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, HrErrorReason_COVERAGE_CONFLICT.String(), fmt.Sprintf(format, args...))
+}
+
 // 500
 func IsInternalServerError(err error) bool {
 	if err == nil {
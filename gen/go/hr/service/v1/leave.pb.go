@@ -89,6 +89,284 @@ func (LeaveRequestStatus) EnumDescriptor() ([]byte, []int) {
 	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{0}
 }
 
+// SigningOutcome is how the signing submission of a leave request ended
+type SigningOutcome int32
+
+const (
+	SigningOutcome_SIGNING_OUTCOME_UNSPECIFIED SigningOutcome = 0
+	SigningOutcome_SIGNING_OUTCOME_COMPLETED   SigningOutcome = 1 // Signed by all submitters; the request was approved
+	SigningOutcome_SIGNING_OUTCOME_DECLINED    SigningOutcome = 2 // A submitter declined to sign; the request was rejected
+	SigningOutcome_SIGNING_OUTCOME_EXPIRED     SigningOutcome = 3 // Not signed in time; the request awaits a decision again
+	SigningOutcome_SIGNING_OUTCOME_CANCELLED   SigningOutcome = 4 // Cancelled in the signing service; the request awaits a decision again
+)
+
+// Enum value maps for SigningOutcome.
+var (
+	SigningOutcome_name = map[int32]string{
+		0: "SIGNING_OUTCOME_UNSPECIFIED",
+		1: "SIGNING_OUTCOME_COMPLETED",
+		2: "SIGNING_OUTCOME_DECLINED",
+		3: "SIGNING_OUTCOME_EXPIRED",
+		4: "SIGNING_OUTCOME_CANCELLED",
+	}
+	SigningOutcome_value = map[string]int32{
+		"SIGNING_OUTCOME_UNSPECIFIED": 0,
+		"SIGNING_OUTCOME_COMPLETED":   1,
+		"SIGNING_OUTCOME_DECLINED":    2,
+		"SIGNING_OUTCOME_EXPIRED":     3,
+		"SIGNING_OUTCOME_CANCELLED":   4,
+	}
+)
+
+func (x SigningOutcome) Enum() *SigningOutcome {
+	p := new(SigningOutcome)
+	*p = x
+	return p
+}
+
+func (x SigningOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SigningOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_hr_service_v1_leave_proto_enumTypes[1].Descriptor()
+}
+
+func (SigningOutcome) Type() protoreflect.EnumType {
+	return &file_hr_service_v1_leave_proto_enumTypes[1]
+}
+
+func (x SigningOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SigningOutcome.Descriptor instead.
+func (SigningOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{1}
+}
+
+// DayPart is a half of a day
+type DayPart int32
+
+const (
+	DayPart_DAY_PART_UNSPECIFIED DayPart = 0
+	DayPart_DAY_PART_AM          DayPart = 1
+	DayPart_DAY_PART_PM          DayPart = 2
+)
+
+// Enum value maps for DayPart.
+var (
+	DayPart_name = map[int32]string{
+		0: "DAY_PART_UNSPECIFIED",
+		1: "DAY_PART_AM",
+		2: "DAY_PART_PM",
+	}
+	DayPart_value = map[string]int32{
+		"DAY_PART_UNSPECIFIED": 0,
+		"DAY_PART_AM":          1,
+		"DAY_PART_PM":          2,
+	}
+)
+
+func (x DayPart) Enum() *DayPart {
+	p := new(DayPart)
+	*p = x
+	return p
+}
+
+func (x DayPart) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DayPart) Descriptor() protoreflect.EnumDescriptor {
+	return file_hr_service_v1_leave_proto_enumTypes[2].Descriptor()
+}
+
+func (DayPart) Type() protoreflect.EnumType {
+	return &file_hr_service_v1_leave_proto_enumTypes[2]
+}
+
+func (x DayPart) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DayPart.Descriptor instead.
+func (DayPart) EnumDescriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{2}
+}
+
+// LeaveAmendmentStatus represents the status of a change to a leave request's dates
+type LeaveAmendmentStatus int32
+
+const (
+	LeaveAmendmentStatus_LEAVE_AMENDMENT_STATUS_UNSPECIFIED      LeaveAmendmentStatus = 0
+	LeaveAmendmentStatus_LEAVE_AMENDMENT_STATUS_PENDING          LeaveAmendmentStatus = 1
+	LeaveAmendmentStatus_LEAVE_AMENDMENT_STATUS_AWAITING_SIGNING LeaveAmendmentStatus = 2
+	LeaveAmendmentStatus_LEAVE_AMENDMENT_STATUS_APPLIED          LeaveAmendmentStatus = 3
+	LeaveAmendmentStatus_LEAVE_AMENDMENT_STATUS_REJECTED         LeaveAmendmentStatus = 4
+	LeaveAmendmentStatus_LEAVE_AMENDMENT_STATUS_CANCELLED        LeaveAmendmentStatus = 5
+)
+
+// Enum value maps for LeaveAmendmentStatus.
+var (
+	LeaveAmendmentStatus_name = map[int32]string{
+		0: "LEAVE_AMENDMENT_STATUS_UNSPECIFIED",
+		1: "LEAVE_AMENDMENT_STATUS_PENDING",
+		2: "LEAVE_AMENDMENT_STATUS_AWAITING_SIGNING",
+		3: "LEAVE_AMENDMENT_STATUS_APPLIED",
+		4: "LEAVE_AMENDMENT_STATUS_REJECTED",
+		5: "LEAVE_AMENDMENT_STATUS_CANCELLED",
+	}
+	LeaveAmendmentStatus_value = map[string]int32{
+		"LEAVE_AMENDMENT_STATUS_UNSPECIFIED":      0,
+		"LEAVE_AMENDMENT_STATUS_PENDING":          1,
+		"LEAVE_AMENDMENT_STATUS_AWAITING_SIGNING": 2,
+		"LEAVE_AMENDMENT_STATUS_APPLIED":          3,
+		"LEAVE_AMENDMENT_STATUS_REJECTED":         4,
+		"LEAVE_AMENDMENT_STATUS_CANCELLED":        5,
+	}
+)
+
+func (x LeaveAmendmentStatus) Enum() *LeaveAmendmentStatus {
+	p := new(LeaveAmendmentStatus)
+	*p = x
+	return p
+}
+
+func (x LeaveAmendmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaveAmendmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_hr_service_v1_leave_proto_enumTypes[3].Descriptor()
+}
+
+func (LeaveAmendmentStatus) Type() protoreflect.EnumType {
+	return &file_hr_service_v1_leave_proto_enumTypes[3]
+}
+
+func (x LeaveAmendmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaveAmendmentStatus.Descriptor instead.
+func (LeaveAmendmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{3}
+}
+
+// LeaveAmendmentKind tells what a leave amendment changes
+type LeaveAmendmentKind int32
+
+const (
+	LeaveAmendmentKind_LEAVE_AMENDMENT_KIND_UNSPECIFIED LeaveAmendmentKind = 0
+	// New dates for a pending or approved request
+	LeaveAmendmentKind_LEAVE_AMENDMENT_KIND_DATE_CHANGE LeaveAmendmentKind = 1
+	// An earlier end of an approved request, e.g. on an early return
+	LeaveAmendmentKind_LEAVE_AMENDMENT_KIND_SHORTEN LeaveAmendmentKind = 2
+)
+
+// Enum value maps for LeaveAmendmentKind.
+var (
+	LeaveAmendmentKind_name = map[int32]string{
+		0: "LEAVE_AMENDMENT_KIND_UNSPECIFIED",
+		1: "LEAVE_AMENDMENT_KIND_DATE_CHANGE",
+		2: "LEAVE_AMENDMENT_KIND_SHORTEN",
+	}
+	LeaveAmendmentKind_value = map[string]int32{
+		"LEAVE_AMENDMENT_KIND_UNSPECIFIED": 0,
+		"LEAVE_AMENDMENT_KIND_DATE_CHANGE": 1,
+		"LEAVE_AMENDMENT_KIND_SHORTEN":     2,
+	}
+)
+
+func (x LeaveAmendmentKind) Enum() *LeaveAmendmentKind {
+	p := new(LeaveAmendmentKind)
+	*p = x
+	return p
+}
+
+func (x LeaveAmendmentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaveAmendmentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_hr_service_v1_leave_proto_enumTypes[4].Descriptor()
+}
+
+func (LeaveAmendmentKind) Type() protoreflect.EnumType {
+	return &file_hr_service_v1_leave_proto_enumTypes[4]
+}
+
+func (x LeaveAmendmentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaveAmendmentKind.Descriptor instead.
+func (LeaveAmendmentKind) EnumDescriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{4}
+}
+
+// LeaveDeduction is the part of a leave request deducted from one allowance
+type LeaveDeduction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllowanceId   string                 `protobuf:"bytes,1,opt,name=allowance_id,json=allowanceId,proto3" json:"allowance_id,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Days          float64                `protobuf:"fixed64,3,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveDeduction) Reset() {
+	*x = LeaveDeduction{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveDeduction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveDeduction) ProtoMessage() {}
+
+func (x *LeaveDeduction) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveDeduction.ProtoReflect.Descriptor instead.
+func (*LeaveDeduction) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LeaveDeduction) GetAllowanceId() string {
+	if x != nil {
+		return x.AllowanceId
+	}
+	return ""
+}
+
+func (x *LeaveDeduction) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *LeaveDeduction) GetDays() float64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
 // LeaveRequest represents a user's leave/absence request
 type LeaveRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -107,24 +385,69 @@ type LeaveRequest struct {
 	Notes         *string                `protobuf:"bytes,13,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
 	Metadata      *structpb.Struct       `protobuf:"bytes,14,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// Denormalized fields for display
-	UserName         *string                `protobuf:"bytes,30,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty"`
-	UserEmail        *string                `protobuf:"bytes,36,opt,name=user_email,json=userEmail,proto3,oneof" json:"user_email,omitempty"`
-	AbsenceTypeName  *string                `protobuf:"bytes,31,opt,name=absence_type_name,json=absenceTypeName,proto3,oneof" json:"absence_type_name,omitempty"`
-	AbsenceTypeColor *string                `protobuf:"bytes,32,opt,name=absence_type_color,json=absenceTypeColor,proto3,oneof" json:"absence_type_color,omitempty"`
-	ReviewerName     *string                `protobuf:"bytes,33,opt,name=reviewer_name,json=reviewerName,proto3,oneof" json:"reviewer_name,omitempty"`
-	OrgUnitName      *string                `protobuf:"bytes,34,opt,name=org_unit_name,json=orgUnitName,proto3,oneof" json:"org_unit_name,omitempty"`
-	SigningRequestId *string                `protobuf:"bytes,35,opt,name=signing_request_id,json=signingRequestId,proto3,oneof" json:"signing_request_id,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
-	CreatedBy        *uint32                `protobuf:"varint,22,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
-	UpdatedBy        *uint32                `protobuf:"varint,23,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	UserName         *string `protobuf:"bytes,30,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty"`
+	UserEmail        *string `protobuf:"bytes,36,opt,name=user_email,json=userEmail,proto3,oneof" json:"user_email,omitempty"`
+	AbsenceTypeName  *string `protobuf:"bytes,31,opt,name=absence_type_name,json=absenceTypeName,proto3,oneof" json:"absence_type_name,omitempty"`
+	AbsenceTypeColor *string `protobuf:"bytes,32,opt,name=absence_type_color,json=absenceTypeColor,proto3,oneof" json:"absence_type_color,omitempty"`
+	ReviewerName     *string `protobuf:"bytes,33,opt,name=reviewer_name,json=reviewerName,proto3,oneof" json:"reviewer_name,omitempty"`
+	OrgUnitName      *string `protobuf:"bytes,34,opt,name=org_unit_name,json=orgUnitName,proto3,oneof" json:"org_unit_name,omitempty"`
+	SigningRequestId *string `protobuf:"bytes,35,opt,name=signing_request_id,json=signingRequestId,proto3,oneof" json:"signing_request_id,omitempty"`
+	// Holiday calendar used to calculate days (empty when days were entered manually)
+	HolidayCalendarId *string `protobuf:"bytes,15,opt,name=holiday_calendar_id,json=holidayCalendarId,proto3,oneof" json:"holiday_calendar_id,omitempty"`
+	// Half of the first day the absence starts in, and of the last day it ends in.
+	// AM to PM covers whole days.
+	StartDayPart *DayPart `protobuf:"varint,16,opt,name=start_day_part,json=startDayPart,proto3,enum=hr.service.v1.DayPart,oneof" json:"start_day_part,omitempty"`
+	EndDayPart   *DayPart `protobuf:"varint,17,opt,name=end_day_part,json=endDayPart,proto3,enum=hr.service.v1.DayPart,oneof" json:"end_day_part,omitempty"`
+	// Requested hours for hour-based absence types
+	Hours *float64 `protobuf:"fixed64,18,opt,name=hours,proto3,oneof" json:"hours,omitempty"`
+	// Allowances deducted for this request; requests crossing a year boundary
+	// are deducted from each year's allowance
+	Deductions []*LeaveDeduction `protobuf:"bytes,19,rep,name=deductions,proto3" json:"deductions,omitempty"`
+	// Approval chain steps and their decisions; empty when a single approval suffices.
+	// The request stays pending until the last step is approved.
+	Approvals []*LeaveApproval `protobuf:"bytes,24,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// Index of the step awaiting a decision
+	ApprovalStep *int32 `protobuf:"varint,25,opt,name=approval_step,json=approvalStep,proto3,oneof" json:"approval_step,omitempty"`
+	// Approver the request (or its current approval step) is assigned to, by default the
+	// requester's line manager; unset when any approver may decide it
+	ApproverId *uint32 `protobuf:"varint,26,opt,name=approver_id,json=approverId,proto3,oneof" json:"approver_id,omitempty"`
+	// Approver for whom the reviewer decided the request as their delegate
+	OnBehalfOf     *uint32 `protobuf:"varint,27,opt,name=on_behalf_of,json=onBehalfOf,proto3,oneof" json:"on_behalf_of,omitempty"`
+	OnBehalfOfName *string `protobuf:"bytes,28,opt,name=on_behalf_of_name,json=onBehalfOfName,proto3,oneof" json:"on_behalf_of_name,omitempty"`
+	// When the request started waiting for its current approval step or signatures
+	AwaitingSince *timestamppb.Timestamp `protobuf:"bytes,37,opt,name=awaiting_since,json=awaitingSince,proto3,oneof" json:"awaiting_since,omitempty"`
+	// How far the wait has been followed up: 0 not yet, 1 approver reminded, 2 escalated
+	EscalationLevel *int32 `protobuf:"varint,38,opt,name=escalation_level,json=escalationLevel,proto3,oneof" json:"escalation_level,omitempty"`
+	// Leave policy rules an HR admin overrode to book the request
+	PolicyOverride *PolicyOverride `protobuf:"bytes,39,opt,name=policy_override,json=policyOverride,proto3,oneof" json:"policy_override,omitempty"`
+	// Number of supporting documents attached
+	AttachmentCount *int32 `protobuf:"varint,40,opt,name=attachment_count,json=attachmentCount,proto3,oneof" json:"attachment_count,omitempty"`
+	// Whether the absence type requires a supporting document for the request
+	AttachmentRequired *bool `protobuf:"varint,41,opt,name=attachment_required,json=attachmentRequired,proto3,oneof" json:"attachment_required,omitempty"`
+	// Last day to attach a required document; unset when it is needed before approval
+	AttachmentDueDate *timestamppb.Timestamp `protobuf:"bytes,42,opt,name=attachment_due_date,json=attachmentDueDate,proto3,oneof" json:"attachment_due_date,omitempty"`
+	// A required document is not attached and its grace period has passed
+	AttachmentMissing *bool `protobuf:"varint,43,opt,name=attachment_missing,json=attachmentMissing,proto3,oneof" json:"attachment_missing,omitempty"`
+	// Series of recurring requests the request is an occurrence of
+	SeriesId *string `protobuf:"bytes,44,opt,name=series_id,json=seriesId,proto3,oneof" json:"series_id,omitempty"`
+	// Recurrence rule of the series
+	Recurrence *RecurrenceRule `protobuf:"bytes,45,opt,name=recurrence,proto3,oneof" json:"recurrence,omitempty"`
+	// How the last signing submission of the request ended
+	SigningOutcome *SigningOutcome `protobuf:"varint,46,opt,name=signing_outcome,json=signingOutcome,proto3,enum=hr.service.v1.SigningOutcome,oneof" json:"signing_outcome,omitempty"`
+	// Reason given for a submission that was declined, expired or was cancelled
+	SigningOutcomeReason *string                `protobuf:"bytes,47,opt,name=signing_outcome_reason,json=signingOutcomeReason,proto3,oneof" json:"signing_outcome_reason,omitempty"`
+	SigningEndedAt       *timestamppb.Timestamp `protobuf:"bytes,48,opt,name=signing_ended_at,json=signingEndedAt,proto3,oneof" json:"signing_ended_at,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	CreatedBy            *uint32                `protobuf:"varint,22,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	UpdatedBy            *uint32                `protobuf:"varint,23,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *LeaveRequest) Reset() {
 	*x = LeaveRequest{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[0]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -136,7 +459,7 @@ func (x *LeaveRequest) String() string {
 func (*LeaveRequest) ProtoMessage() {}
 
 func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[0]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -149,7 +472,7 @@ func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveRequest.ProtoReflect.Descriptor instead.
 func (*LeaveRequest) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{0}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *LeaveRequest) GetId() string {
@@ -299,6 +622,160 @@ func (x *LeaveRequest) GetSigningRequestId() string {
 	return ""
 }
 
+func (x *LeaveRequest) GetHolidayCalendarId() string {
+	if x != nil && x.HolidayCalendarId != nil {
+		return *x.HolidayCalendarId
+	}
+	return ""
+}
+
+func (x *LeaveRequest) GetStartDayPart() DayPart {
+	if x != nil && x.StartDayPart != nil {
+		return *x.StartDayPart
+	}
+	return DayPart_DAY_PART_UNSPECIFIED
+}
+
+func (x *LeaveRequest) GetEndDayPart() DayPart {
+	if x != nil && x.EndDayPart != nil {
+		return *x.EndDayPart
+	}
+	return DayPart_DAY_PART_UNSPECIFIED
+}
+
+func (x *LeaveRequest) GetHours() float64 {
+	if x != nil && x.Hours != nil {
+		return *x.Hours
+	}
+	return 0
+}
+
+func (x *LeaveRequest) GetDeductions() []*LeaveDeduction {
+	if x != nil {
+		return x.Deductions
+	}
+	return nil
+}
+
+func (x *LeaveRequest) GetApprovals() []*LeaveApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *LeaveRequest) GetApprovalStep() int32 {
+	if x != nil && x.ApprovalStep != nil {
+		return *x.ApprovalStep
+	}
+	return 0
+}
+
+func (x *LeaveRequest) GetApproverId() uint32 {
+	if x != nil && x.ApproverId != nil {
+		return *x.ApproverId
+	}
+	return 0
+}
+
+func (x *LeaveRequest) GetOnBehalfOf() uint32 {
+	if x != nil && x.OnBehalfOf != nil {
+		return *x.OnBehalfOf
+	}
+	return 0
+}
+
+func (x *LeaveRequest) GetOnBehalfOfName() string {
+	if x != nil && x.OnBehalfOfName != nil {
+		return *x.OnBehalfOfName
+	}
+	return ""
+}
+
+func (x *LeaveRequest) GetAwaitingSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AwaitingSince
+	}
+	return nil
+}
+
+func (x *LeaveRequest) GetEscalationLevel() int32 {
+	if x != nil && x.EscalationLevel != nil {
+		return *x.EscalationLevel
+	}
+	return 0
+}
+
+func (x *LeaveRequest) GetPolicyOverride() *PolicyOverride {
+	if x != nil {
+		return x.PolicyOverride
+	}
+	return nil
+}
+
+func (x *LeaveRequest) GetAttachmentCount() int32 {
+	if x != nil && x.AttachmentCount != nil {
+		return *x.AttachmentCount
+	}
+	return 0
+}
+
+func (x *LeaveRequest) GetAttachmentRequired() bool {
+	if x != nil && x.AttachmentRequired != nil {
+		return *x.AttachmentRequired
+	}
+	return false
+}
+
+func (x *LeaveRequest) GetAttachmentDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AttachmentDueDate
+	}
+	return nil
+}
+
+func (x *LeaveRequest) GetAttachmentMissing() bool {
+	if x != nil && x.AttachmentMissing != nil {
+		return *x.AttachmentMissing
+	}
+	return false
+}
+
+func (x *LeaveRequest) GetSeriesId() string {
+	if x != nil && x.SeriesId != nil {
+		return *x.SeriesId
+	}
+	return ""
+}
+
+func (x *LeaveRequest) GetRecurrence() *RecurrenceRule {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *LeaveRequest) GetSigningOutcome() SigningOutcome {
+	if x != nil && x.SigningOutcome != nil {
+		return *x.SigningOutcome
+	}
+	return SigningOutcome_SIGNING_OUTCOME_UNSPECIFIED
+}
+
+func (x *LeaveRequest) GetSigningOutcomeReason() string {
+	if x != nil && x.SigningOutcomeReason != nil {
+		return *x.SigningOutcomeReason
+	}
+	return ""
+}
+
+func (x *LeaveRequest) GetSigningEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SigningEndedAt
+	}
+	return nil
+}
+
 func (x *LeaveRequest) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -341,13 +818,28 @@ type CreateLeaveRequestRequest struct {
 	UserName      *string                `protobuf:"bytes,10,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty"`
 	UserEmail     *string                `protobuf:"bytes,12,opt,name=user_email,json=userEmail,proto3,oneof" json:"user_email,omitempty"`
 	OrgUnitName   *string                `protobuf:"bytes,11,opt,name=org_unit_name,json=orgUnitName,proto3,oneof" json:"org_unit_name,omitempty"`
+	// Holiday calendar to exclude from the day count. Defaults to the calendar
+	// assigned to the org unit, then the tenant's default calendar.
+	HolidayCalendarId *string `protobuf:"bytes,13,opt,name=holiday_calendar_id,json=holidayCalendarId,proto3,oneof" json:"holiday_calendar_id,omitempty"`
+	// PM starts the absence in the afternoon of the first day; AM ends it at midday
+	// of the last day. Ignored for hour-based absence types, whose start and end
+	// dates carry the time of day instead.
+	StartDayPart *DayPart `protobuf:"varint,14,opt,name=start_day_part,json=startDayPart,proto3,enum=hr.service.v1.DayPart,oneof" json:"start_day_part,omitempty"`
+	EndDayPart   *DayPart `protobuf:"varint,15,opt,name=end_day_part,json=endDayPart,proto3,enum=hr.service.v1.DayPart,oneof" json:"end_day_part,omitempty"`
+	// Justification for booking the request despite the leave policy rules it breaks;
+	// only HR admins may override policies
+	PolicyOverrideReason *string `protobuf:"bytes,16,opt,name=policy_override_reason,json=policyOverrideReason,proto3,oneof" json:"policy_override_reason,omitempty"`
+	// Repeats the request as a series, e.g. every other Friday. Each occurrence becomes a request
+	// of its own, with the same length as the first, checked for overlaps, allowance and policies
+	// on its own.
+	Recurrence    *RecurrenceRule `protobuf:"bytes,17,opt,name=recurrence,proto3,oneof" json:"recurrence,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateLeaveRequestRequest) Reset() {
 	*x = CreateLeaveRequestRequest{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[1]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +851,7 @@ func (x *CreateLeaveRequestRequest) String() string {
 func (*CreateLeaveRequestRequest) ProtoMessage() {}
 
 func (x *CreateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[1]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +864,7 @@ func (x *CreateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLeaveRequestRequest.ProtoReflect.Descriptor instead.
 func (*CreateLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{1}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateLeaveRequestRequest) GetTenantId() uint32 {
@@ -459,16 +951,56 @@ func (x *CreateLeaveRequestRequest) GetOrgUnitName() string {
 	return ""
 }
 
-type CreateLeaveRequestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+func (x *CreateLeaveRequestRequest) GetHolidayCalendarId() string {
+	if x != nil && x.HolidayCalendarId != nil {
+		return *x.HolidayCalendarId
+	}
+	return ""
+}
+
+func (x *CreateLeaveRequestRequest) GetStartDayPart() DayPart {
+	if x != nil && x.StartDayPart != nil {
+		return *x.StartDayPart
+	}
+	return DayPart_DAY_PART_UNSPECIFIED
+}
+
+func (x *CreateLeaveRequestRequest) GetEndDayPart() DayPart {
+	if x != nil && x.EndDayPart != nil {
+		return *x.EndDayPart
+	}
+	return DayPart_DAY_PART_UNSPECIFIED
+}
+
+func (x *CreateLeaveRequestRequest) GetPolicyOverrideReason() string {
+	if x != nil && x.PolicyOverrideReason != nil {
+		return *x.PolicyOverrideReason
+	}
+	return ""
+}
+
+func (x *CreateLeaveRequestRequest) GetRecurrence() *RecurrenceRule {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+type CreateLeaveRequestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The request, or the first occurrence booked of a series
+	LeaveRequest *LeaveRequest `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	// Coverage rules of the requester's org units the request breaks without blocking it
+	CoverageWarnings []*CoverageConflict `protobuf:"bytes,2,rep,name=coverage_warnings,json=coverageWarnings,proto3" json:"coverage_warnings,omitempty"`
+	// Outcome per occurrence of a series; occurrences that fail their checks are not booked
+	Occurrences   []*LeaveOccurrenceResult `protobuf:"bytes,3,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateLeaveRequestResponse) Reset() {
 	*x = CreateLeaveRequestResponse{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[2]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -480,7 +1012,7 @@ func (x *CreateLeaveRequestResponse) String() string {
 func (*CreateLeaveRequestResponse) ProtoMessage() {}
 
 func (x *CreateLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[2]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +1025,7 @@ func (x *CreateLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLeaveRequestResponse.ProtoReflect.Descriptor instead.
 func (*CreateLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{2}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
@@ -503,6 +1035,107 @@ func (x *CreateLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
 	return nil
 }
 
+func (x *CreateLeaveRequestResponse) GetCoverageWarnings() []*CoverageConflict {
+	if x != nil {
+		return x.CoverageWarnings
+	}
+	return nil
+}
+
+func (x *CreateLeaveRequestResponse) GetOccurrences() []*LeaveOccurrenceResult {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+// LeaveOccurrenceResult is the outcome of booking one occurrence of a series
+type LeaveOccurrenceResult struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	Success   bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// The booked request, when it succeeded
+	LeaveRequest *LeaveRequest `protobuf:"bytes,3,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	// Reason and message of the error, e.g. OVERLAP_EXISTS, when it failed
+	ErrorReason      string              `protobuf:"bytes,4,opt,name=error_reason,json=errorReason,proto3" json:"error_reason,omitempty"`
+	ErrorMessage     string              `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CoverageWarnings []*CoverageConflict `protobuf:"bytes,6,rep,name=coverage_warnings,json=coverageWarnings,proto3" json:"coverage_warnings,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeaveOccurrenceResult) Reset() {
+	*x = LeaveOccurrenceResult{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveOccurrenceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveOccurrenceResult) ProtoMessage() {}
+
+func (x *LeaveOccurrenceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveOccurrenceResult.ProtoReflect.Descriptor instead.
+func (*LeaveOccurrenceResult) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LeaveOccurrenceResult) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *LeaveOccurrenceResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LeaveOccurrenceResult) GetLeaveRequest() *LeaveRequest {
+	if x != nil {
+		return x.LeaveRequest
+	}
+	return nil
+}
+
+func (x *LeaveOccurrenceResult) GetErrorReason() string {
+	if x != nil {
+		return x.ErrorReason
+	}
+	return ""
+}
+
+func (x *LeaveOccurrenceResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *LeaveOccurrenceResult) GetCoverageWarnings() []*CoverageConflict {
+	if x != nil {
+		return x.CoverageWarnings
+	}
+	return nil
+}
+
 type GetLeaveRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -512,7 +1145,7 @@ type GetLeaveRequestRequest struct {
 
 func (x *GetLeaveRequestRequest) Reset() {
 	*x = GetLeaveRequestRequest{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[3]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -524,7 +1157,7 @@ func (x *GetLeaveRequestRequest) String() string {
 func (*GetLeaveRequestRequest) ProtoMessage() {}
 
 func (x *GetLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[3]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +1170,7 @@ func (x *GetLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeaveRequestRequest.ProtoReflect.Descriptor instead.
 func (*GetLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{3}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetLeaveRequestRequest) GetId() string {
@@ -556,7 +1189,7 @@ type GetLeaveRequestResponse struct {
 
 func (x *GetLeaveRequestResponse) Reset() {
 	*x = GetLeaveRequestResponse{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[4]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -568,7 +1201,7 @@ func (x *GetLeaveRequestResponse) String() string {
 func (*GetLeaveRequestResponse) ProtoMessage() {}
 
 func (x *GetLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[4]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,7 +1214,7 @@ func (x *GetLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeaveRequestResponse.ProtoReflect.Descriptor instead.
 func (*GetLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{4}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
@@ -604,13 +1237,14 @@ type ListLeaveRequestsRequest struct {
 	Status        *LeaveRequestStatus `protobuf:"varint,12,opt,name=status,proto3,enum=hr.service.v1.LeaveRequestStatus,oneof" json:"status,omitempty"`
 	StartDate     *string             `protobuf:"bytes,13,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
 	EndDate       *string             `protobuf:"bytes,14,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	SeriesId      *string             `protobuf:"bytes,15,opt,name=series_id,json=seriesId,proto3,oneof" json:"series_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListLeaveRequestsRequest) Reset() {
 	*x = ListLeaveRequestsRequest{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[5]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -622,7 +1256,7 @@ func (x *ListLeaveRequestsRequest) String() string {
 func (*ListLeaveRequestsRequest) ProtoMessage() {}
 
 func (x *ListLeaveRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[5]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,7 +1269,7 @@ func (x *ListLeaveRequestsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLeaveRequestsRequest.ProtoReflect.Descriptor instead.
 func (*ListLeaveRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{5}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListLeaveRequestsRequest) GetTenantId() uint32 {
@@ -708,6 +1342,13 @@ func (x *ListLeaveRequestsRequest) GetEndDate() string {
 	return ""
 }
 
+func (x *ListLeaveRequestsRequest) GetSeriesId() string {
+	if x != nil && x.SeriesId != nil {
+		return *x.SeriesId
+	}
+	return ""
+}
+
 type ListLeaveRequestsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*LeaveRequest        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -718,7 +1359,7 @@ type ListLeaveRequestsResponse struct {
 
 func (x *ListLeaveRequestsResponse) Reset() {
 	*x = ListLeaveRequestsResponse{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[6]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -730,7 +1371,7 @@ func (x *ListLeaveRequestsResponse) String() string {
 func (*ListLeaveRequestsResponse) ProtoMessage() {}
 
 func (x *ListLeaveRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[6]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,7 +1384,7 @@ func (x *ListLeaveRequestsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLeaveRequestsResponse.ProtoReflect.Descriptor instead.
 func (*ListLeaveRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{6}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListLeaveRequestsResponse) GetItems() []*LeaveRequest {
@@ -760,6 +1401,120 @@ func (x *ListLeaveRequestsResponse) GetTotal() int32 {
 	return 0
 }
 
+// ListAssignedApprovalsRequest lists the pending requests awaiting the caller's decision,
+// including those of approvers the caller is a delegate for
+type ListAssignedApprovalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	NoPaging      *bool                  `protobuf:"varint,3,opt,name=no_paging,json=noPaging,proto3,oneof" json:"no_paging,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssignedApprovalsRequest) Reset() {
+	*x = ListAssignedApprovalsRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignedApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignedApprovalsRequest) ProtoMessage() {}
+
+func (x *ListAssignedApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignedApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListAssignedApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListAssignedApprovalsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListAssignedApprovalsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAssignedApprovalsRequest) GetNoPaging() bool {
+	if x != nil && x.NoPaging != nil {
+		return *x.NoPaging
+	}
+	return false
+}
+
+type ListAssignedApprovalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*LeaveRequest        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssignedApprovalsResponse) Reset() {
+	*x = ListAssignedApprovalsResponse{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignedApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignedApprovalsResponse) ProtoMessage() {}
+
+func (x *ListAssignedApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignedApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListAssignedApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAssignedApprovalsResponse) GetItems() []*LeaveRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListAssignedApprovalsResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
 type UpdateLeaveRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -771,7 +1526,7 @@ type UpdateLeaveRequestRequest struct {
 
 func (x *UpdateLeaveRequestRequest) Reset() {
 	*x = UpdateLeaveRequestRequest{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[7]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -783,7 +1538,7 @@ func (x *UpdateLeaveRequestRequest) String() string {
 func (*UpdateLeaveRequestRequest) ProtoMessage() {}
 
 func (x *UpdateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[7]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -796,7 +1551,7 @@ func (x *UpdateLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLeaveRequestRequest.ProtoReflect.Descriptor instead.
 func (*UpdateLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{7}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateLeaveRequestRequest) GetId() string {
@@ -829,7 +1584,7 @@ type UpdateLeaveRequestResponse struct {
 
 func (x *UpdateLeaveRequestResponse) Reset() {
 	*x = UpdateLeaveRequestResponse{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[8]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -841,7 +1596,7 @@ func (x *UpdateLeaveRequestResponse) String() string {
 func (*UpdateLeaveRequestResponse) ProtoMessage() {}
 
 func (x *UpdateLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[8]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,7 +1609,7 @@ func (x *UpdateLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLeaveRequestResponse.ProtoReflect.Descriptor instead.
 func (*UpdateLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{8}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
@@ -873,7 +1628,7 @@ type DeleteLeaveRequestRequest struct {
 
 func (x *DeleteLeaveRequestRequest) Reset() {
 	*x = DeleteLeaveRequestRequest{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[9]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -885,7 +1640,7 @@ func (x *DeleteLeaveRequestRequest) String() string {
 func (*DeleteLeaveRequestRequest) ProtoMessage() {}
 
 func (x *DeleteLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[9]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,7 +1653,7 @@ func (x *DeleteLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLeaveRequestRequest.ProtoReflect.Descriptor instead.
 func (*DeleteLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{9}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteLeaveRequestRequest) GetId() string {
@@ -908,7 +1663,8 @@ func (x *DeleteLeaveRequestRequest) GetId() string {
 	return ""
 }
 
-// ApproveLeaveRequestRequest approves a pending leave request
+// ApproveLeaveRequestRequest approves a pending leave request. Requests with an approval
+// chain are approved one step at a time; only the last step approves the request.
 type ApproveLeaveRequestRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -922,7 +1678,7 @@ type ApproveLeaveRequestRequest struct {
 
 func (x *ApproveLeaveRequestRequest) Reset() {
 	*x = ApproveLeaveRequestRequest{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[10]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -934,7 +1690,7 @@ func (x *ApproveLeaveRequestRequest) String() string {
 func (*ApproveLeaveRequestRequest) ProtoMessage() {}
 
 func (x *ApproveLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[10]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -947,7 +1703,7 @@ func (x *ApproveLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveLeaveRequestRequest.ProtoReflect.Descriptor instead.
 func (*ApproveLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{10}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ApproveLeaveRequestRequest) GetId() string {
@@ -986,15 +1742,17 @@ func (x *ApproveLeaveRequestRequest) GetRequesterEmail() string {
 }
 
 type ApproveLeaveRequestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	LeaveRequest *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	// Coverage rules of the requester's org units the request breaks without blocking it
+	CoverageWarnings []*CoverageConflict `protobuf:"bytes,2,rep,name=coverage_warnings,json=coverageWarnings,proto3" json:"coverage_warnings,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ApproveLeaveRequestResponse) Reset() {
 	*x = ApproveLeaveRequestResponse{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[11]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1006,7 +1764,7 @@ func (x *ApproveLeaveRequestResponse) String() string {
 func (*ApproveLeaveRequestResponse) ProtoMessage() {}
 
 func (x *ApproveLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[11]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,7 +1777,7 @@ func (x *ApproveLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveLeaveRequestResponse.ProtoReflect.Descriptor instead.
 func (*ApproveLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{11}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ApproveLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
@@ -1029,6 +1787,13 @@ func (x *ApproveLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
 	return nil
 }
 
+func (x *ApproveLeaveRequestResponse) GetCoverageWarnings() []*CoverageConflict {
+	if x != nil {
+		return x.CoverageWarnings
+	}
+	return nil
+}
+
 // RejectLeaveRequestRequest rejects a pending leave request
 type RejectLeaveRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1040,7 +1805,7 @@ type RejectLeaveRequestRequest struct {
 
 func (x *RejectLeaveRequestRequest) Reset() {
 	*x = RejectLeaveRequestRequest{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[12]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1052,7 +1817,7 @@ func (x *RejectLeaveRequestRequest) String() string {
 func (*RejectLeaveRequestRequest) ProtoMessage() {}
 
 func (x *RejectLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[12]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1065,7 +1830,7 @@ func (x *RejectLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectLeaveRequestRequest.ProtoReflect.Descriptor instead.
 func (*RejectLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{12}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RejectLeaveRequestRequest) GetId() string {
@@ -1091,7 +1856,7 @@ type RejectLeaveRequestResponse struct {
 
 func (x *RejectLeaveRequestResponse) Reset() {
 	*x = RejectLeaveRequestResponse{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[13]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1103,7 +1868,7 @@ func (x *RejectLeaveRequestResponse) String() string {
 func (*RejectLeaveRequestResponse) ProtoMessage() {}
 
 func (x *RejectLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[13]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,7 +1881,7 @@ func (x *RejectLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectLeaveRequestResponse.ProtoReflect.Descriptor instead.
 func (*RejectLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{13}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RejectLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
@@ -1136,7 +1901,7 @@ type CancelLeaveRequestRequest struct {
 
 func (x *CancelLeaveRequestRequest) Reset() {
 	*x = CancelLeaveRequestRequest{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[14]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1148,7 +1913,7 @@ func (x *CancelLeaveRequestRequest) String() string {
 func (*CancelLeaveRequestRequest) ProtoMessage() {}
 
 func (x *CancelLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[14]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1161,7 +1926,7 @@ func (x *CancelLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelLeaveRequestRequest.ProtoReflect.Descriptor instead.
 func (*CancelLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{14}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CancelLeaveRequestRequest) GetId() string {
@@ -1180,7 +1945,7 @@ type CancelLeaveRequestResponse struct {
 
 func (x *CancelLeaveRequestResponse) Reset() {
 	*x = CancelLeaveRequestResponse{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[15]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1192,7 +1957,7 @@ func (x *CancelLeaveRequestResponse) String() string {
 func (*CancelLeaveRequestResponse) ProtoMessage() {}
 
 func (x *CancelLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[15]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,7 +1970,7 @@ func (x *CancelLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelLeaveRequestResponse.ProtoReflect.Descriptor instead.
 func (*CancelLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{15}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CancelLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
@@ -1226,7 +1991,7 @@ type RevokeLeaveRequestRequest struct {
 
 func (x *RevokeLeaveRequestRequest) Reset() {
 	*x = RevokeLeaveRequestRequest{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[16]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1238,7 +2003,7 @@ func (x *RevokeLeaveRequestRequest) String() string {
 func (*RevokeLeaveRequestRequest) ProtoMessage() {}
 
 func (x *RevokeLeaveRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[16]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1251,7 +2016,7 @@ func (x *RevokeLeaveRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeLeaveRequestRequest.ProtoReflect.Descriptor instead.
 func (*RevokeLeaveRequestRequest) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{16}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RevokeLeaveRequestRequest) GetId() string {
@@ -1277,7 +2042,7 @@ type RevokeLeaveRequestResponse struct {
 
 func (x *RevokeLeaveRequestResponse) Reset() {
 	*x = RevokeLeaveRequestResponse{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[17]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1289,7 +2054,7 @@ func (x *RevokeLeaveRequestResponse) String() string {
 func (*RevokeLeaveRequestResponse) ProtoMessage() {}
 
 func (x *RevokeLeaveRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[17]
+	mi := &file_hr_service_v1_leave_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1302,7 +2067,7 @@ func (x *RevokeLeaveRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeLeaveRequestResponse.ProtoReflect.Descriptor instead.
 func (*RevokeLeaveRequestResponse) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{17}
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RevokeLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
@@ -1312,39 +2077,1729 @@ func (x *RevokeLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
 	return nil
 }
 
-// CalendarEvent represents a leave request displayed on calendar
-type CalendarEvent struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId          uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserName        string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	AbsenceTypeId   string                 `protobuf:"bytes,4,opt,name=absence_type_id,json=absenceTypeId,proto3" json:"absence_type_id,omitempty"`
-	AbsenceTypeName string                 `protobuf:"bytes,5,opt,name=absence_type_name,json=absenceTypeName,proto3" json:"absence_type_name,omitempty"`
-	Color           string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
-	StartDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Days            float64                `protobuf:"fixed64,9,opt,name=days,proto3" json:"days,omitempty"`
-	Status          LeaveRequestStatus     `protobuf:"varint,10,opt,name=status,proto3,enum=hr.service.v1.LeaveRequestStatus" json:"status,omitempty"`
-	OrgUnitName     string                 `protobuf:"bytes,11,opt,name=org_unit_name,json=orgUnitName,proto3" json:"org_unit_name,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// LeaveRequestSelection selects the requests of a bulk operation: either by ID, or by a filter
+// on the requests of the caller's tenant. Filters are restricted to HR; the status is implied by
+// the operation. At most 200 requests are processed at once.
+type LeaveRequestSelection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// Filters
+	UserId        *uint32 `protobuf:"varint,10,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	AbsenceTypeId *string `protobuf:"bytes,11,opt,name=absence_type_id,json=absenceTypeId,proto3,oneof" json:"absence_type_id,omitempty"`
+	// Requests within the period, RFC 3339
+	StartDate     *string `protobuf:"bytes,12,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	EndDate       *string `protobuf:"bytes,13,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalendarEvent) Reset() {
-	*x = CalendarEvent{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[18]
+func (x *LeaveRequestSelection) Reset() {
+	*x = LeaveRequestSelection{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalendarEvent) String() string {
+func (x *LeaveRequestSelection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalendarEvent) ProtoMessage() {}
+func (*LeaveRequestSelection) ProtoMessage() {}
 
-func (x *CalendarEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[18]
+func (x *LeaveRequestSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveRequestSelection.ProtoReflect.Descriptor instead.
+func (*LeaveRequestSelection) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *LeaveRequestSelection) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *LeaveRequestSelection) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *LeaveRequestSelection) GetAbsenceTypeId() string {
+	if x != nil && x.AbsenceTypeId != nil {
+		return *x.AbsenceTypeId
+	}
+	return ""
+}
+
+func (x *LeaveRequestSelection) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *LeaveRequestSelection) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+type BulkApproveLeaveRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selection     *LeaveRequestSelection `protobuf:"bytes,1,opt,name=selection,proto3" json:"selection,omitempty"`
+	ReviewNotes   *string                `protobuf:"bytes,2,opt,name=review_notes,json=reviewNotes,proto3,oneof" json:"review_notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkApproveLeaveRequestsRequest) Reset() {
+	*x = BulkApproveLeaveRequestsRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkApproveLeaveRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkApproveLeaveRequestsRequest) ProtoMessage() {}
+
+func (x *BulkApproveLeaveRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkApproveLeaveRequestsRequest.ProtoReflect.Descriptor instead.
+func (*BulkApproveLeaveRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BulkApproveLeaveRequestsRequest) GetSelection() *LeaveRequestSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+func (x *BulkApproveLeaveRequestsRequest) GetReviewNotes() string {
+	if x != nil && x.ReviewNotes != nil {
+		return *x.ReviewNotes
+	}
+	return ""
+}
+
+type BulkRejectLeaveRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selection     *LeaveRequestSelection `protobuf:"bytes,1,opt,name=selection,proto3" json:"selection,omitempty"`
+	ReviewNotes   *string                `protobuf:"bytes,2,opt,name=review_notes,json=reviewNotes,proto3,oneof" json:"review_notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkRejectLeaveRequestsRequest) Reset() {
+	*x = BulkRejectLeaveRequestsRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkRejectLeaveRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkRejectLeaveRequestsRequest) ProtoMessage() {}
+
+func (x *BulkRejectLeaveRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkRejectLeaveRequestsRequest.ProtoReflect.Descriptor instead.
+func (*BulkRejectLeaveRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BulkRejectLeaveRequestsRequest) GetSelection() *LeaveRequestSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+func (x *BulkRejectLeaveRequestsRequest) GetReviewNotes() string {
+	if x != nil && x.ReviewNotes != nil {
+		return *x.ReviewNotes
+	}
+	return ""
+}
+
+type BulkRevokeLeaveRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selection     *LeaveRequestSelection `protobuf:"bytes,1,opt,name=selection,proto3" json:"selection,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkRevokeLeaveRequestsRequest) Reset() {
+	*x = BulkRevokeLeaveRequestsRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkRevokeLeaveRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkRevokeLeaveRequestsRequest) ProtoMessage() {}
+
+func (x *BulkRevokeLeaveRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkRevokeLeaveRequestsRequest.ProtoReflect.Descriptor instead.
+func (*BulkRevokeLeaveRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BulkRevokeLeaveRequestsRequest) GetSelection() *LeaveRequestSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+func (x *BulkRevokeLeaveRequestsRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+// BulkLeaveRequestResult is the outcome of a bulk operation on one request
+type BulkLeaveRequestResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// The request after the operation, when it succeeded
+	LeaveRequest *LeaveRequest `protobuf:"bytes,3,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	// Reason and message of the error, e.g. INSUFFICIENT_ALLOWANCE, when it failed
+	ErrorReason  string `protobuf:"bytes,4,opt,name=error_reason,json=errorReason,proto3" json:"error_reason,omitempty"`
+	ErrorMessage string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// Coverage rules the approved request breaks without blocking it
+	CoverageWarnings []*CoverageConflict `protobuf:"bytes,6,rep,name=coverage_warnings,json=coverageWarnings,proto3" json:"coverage_warnings,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BulkLeaveRequestResult) Reset() {
+	*x = BulkLeaveRequestResult{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkLeaveRequestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkLeaveRequestResult) ProtoMessage() {}
+
+func (x *BulkLeaveRequestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkLeaveRequestResult.ProtoReflect.Descriptor instead.
+func (*BulkLeaveRequestResult) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BulkLeaveRequestResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BulkLeaveRequestResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BulkLeaveRequestResult) GetLeaveRequest() *LeaveRequest {
+	if x != nil {
+		return x.LeaveRequest
+	}
+	return nil
+}
+
+func (x *BulkLeaveRequestResult) GetErrorReason() string {
+	if x != nil {
+		return x.ErrorReason
+	}
+	return ""
+}
+
+func (x *BulkLeaveRequestResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *BulkLeaveRequestResult) GetCoverageWarnings() []*CoverageConflict {
+	if x != nil {
+		return x.CoverageWarnings
+	}
+	return nil
+}
+
+// BulkLeaveRequestsResponse reports the outcome of a bulk operation per request. Requests are
+// processed one at a time, so that the failure of one does not undo the others.
+type BulkLeaveRequestsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Results       []*BulkLeaveRequestResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     int32                     `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                     `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkLeaveRequestsResponse) Reset() {
+	*x = BulkLeaveRequestsResponse{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkLeaveRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkLeaveRequestsResponse) ProtoMessage() {}
+
+func (x *BulkLeaveRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkLeaveRequestsResponse.ProtoReflect.Descriptor instead.
+func (*BulkLeaveRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BulkLeaveRequestsResponse) GetResults() []*BulkLeaveRequestResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkLeaveRequestsResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BulkLeaveRequestsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+// CancelFollowingOccurrencesRequest cancels an occurrence of a series and the occurrences after it
+type CancelFollowingOccurrencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFollowingOccurrencesRequest) Reset() {
+	*x = CancelFollowingOccurrencesRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFollowingOccurrencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFollowingOccurrencesRequest) ProtoMessage() {}
+
+func (x *CancelFollowingOccurrencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFollowingOccurrencesRequest.ProtoReflect.Descriptor instead.
+func (*CancelFollowingOccurrencesRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CancelFollowingOccurrencesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateFollowingOccurrencesRequest edits an occurrence of a series and the occurrences after
+// it. A new recurrence rule splits the series: the occurrences from this one on are cancelled
+// and rebooked as a new series with the rule, starting on the date of this occurrence.
+type UpdateFollowingOccurrencesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason     *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	Notes      *string                `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	Metadata   *structpb.Struct       `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Recurrence *RecurrenceRule        `protobuf:"bytes,5,opt,name=recurrence,proto3,oneof" json:"recurrence,omitempty"`
+	// Justification for rebooking the split series despite the leave policy rules it breaks;
+	// only HR admins may override policies
+	PolicyOverrideReason *string `protobuf:"bytes,6,opt,name=policy_override_reason,json=policyOverrideReason,proto3,oneof" json:"policy_override_reason,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateFollowingOccurrencesRequest) Reset() {
+	*x = UpdateFollowingOccurrencesRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFollowingOccurrencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFollowingOccurrencesRequest) ProtoMessage() {}
+
+func (x *UpdateFollowingOccurrencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFollowingOccurrencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFollowingOccurrencesRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateFollowingOccurrencesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFollowingOccurrencesRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateFollowingOccurrencesRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateFollowingOccurrencesRequest) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UpdateFollowingOccurrencesRequest) GetRecurrence() *RecurrenceRule {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *UpdateFollowingOccurrencesRequest) GetPolicyOverrideReason() string {
+	if x != nil && x.PolicyOverrideReason != nil {
+		return *x.PolicyOverrideReason
+	}
+	return ""
+}
+
+type UpdateFollowingOccurrencesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Outcome per occurrence edited, or cancelled when the series was split
+	Results []*BulkLeaveRequestResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Outcome per occurrence of the new series, when the series was split; empty when an occurrence
+	// could not be cancelled, in which case nothing is rebooked
+	Occurrences   []*LeaveOccurrenceResult `protobuf:"bytes,2,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFollowingOccurrencesResponse) Reset() {
+	*x = UpdateFollowingOccurrencesResponse{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFollowingOccurrencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFollowingOccurrencesResponse) ProtoMessage() {}
+
+func (x *UpdateFollowingOccurrencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFollowingOccurrencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFollowingOccurrencesResponse) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateFollowingOccurrencesResponse) GetResults() []*BulkLeaveRequestResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *UpdateFollowingOccurrencesResponse) GetOccurrences() []*LeaveOccurrenceResult {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+// ApproveLeaveSeriesRequest approves the pending occurrences of a series
+type ApproveLeaveSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId      string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	ReviewNotes   *string                `protobuf:"bytes,2,opt,name=review_notes,json=reviewNotes,proto3,oneof" json:"review_notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveLeaveSeriesRequest) Reset() {
+	*x = ApproveLeaveSeriesRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveLeaveSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveLeaveSeriesRequest) ProtoMessage() {}
+
+func (x *ApproveLeaveSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveLeaveSeriesRequest.ProtoReflect.Descriptor instead.
+func (*ApproveLeaveSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ApproveLeaveSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *ApproveLeaveSeriesRequest) GetReviewNotes() string {
+	if x != nil && x.ReviewNotes != nil {
+		return *x.ReviewNotes
+	}
+	return ""
+}
+
+// RejectLeaveSeriesRequest rejects the pending occurrences of a series
+type RejectLeaveSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId      string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	ReviewNotes   *string                `protobuf:"bytes,2,opt,name=review_notes,json=reviewNotes,proto3,oneof" json:"review_notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectLeaveSeriesRequest) Reset() {
+	*x = RejectLeaveSeriesRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectLeaveSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectLeaveSeriesRequest) ProtoMessage() {}
+
+func (x *RejectLeaveSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectLeaveSeriesRequest.ProtoReflect.Descriptor instead.
+func (*RejectLeaveSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RejectLeaveSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *RejectLeaveSeriesRequest) GetReviewNotes() string {
+	if x != nil && x.ReviewNotes != nil {
+		return *x.ReviewNotes
+	}
+	return ""
+}
+
+// CalendarEvent represents a leave request displayed on calendar
+type CalendarEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName        string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	AbsenceTypeId   string                 `protobuf:"bytes,4,opt,name=absence_type_id,json=absenceTypeId,proto3" json:"absence_type_id,omitempty"`
+	AbsenceTypeName string                 `protobuf:"bytes,5,opt,name=absence_type_name,json=absenceTypeName,proto3" json:"absence_type_name,omitempty"`
+	Color           string                 `protobuf:"bytes,6,opt,name=color,proto3" json:"color,omitempty"`
+	StartDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Days            float64                `protobuf:"fixed64,9,opt,name=days,proto3" json:"days,omitempty"`
+	Status          LeaveRequestStatus     `protobuf:"varint,10,opt,name=status,proto3,enum=hr.service.v1.LeaveRequestStatus" json:"status,omitempty"`
+	OrgUnitName     string                 `protobuf:"bytes,11,opt,name=org_unit_name,json=orgUnitName,proto3" json:"org_unit_name,omitempty"`
+	StartDayPart    DayPart                `protobuf:"varint,12,opt,name=start_day_part,json=startDayPart,proto3,enum=hr.service.v1.DayPart" json:"start_day_part,omitempty"`
+	EndDayPart      DayPart                `protobuf:"varint,13,opt,name=end_day_part,json=endDayPart,proto3,enum=hr.service.v1.DayPart" json:"end_day_part,omitempty"`
+	Hours           float64                `protobuf:"fixed64,14,opt,name=hours,proto3" json:"hours,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CalendarEvent) Reset() {
+	*x = CalendarEvent{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarEvent) ProtoMessage() {}
+
+func (x *CalendarEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarEvent.ProtoReflect.Descriptor instead.
+func (*CalendarEvent) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CalendarEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CalendarEvent) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CalendarEvent) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *CalendarEvent) GetAbsenceTypeId() string {
+	if x != nil {
+		return x.AbsenceTypeId
+	}
+	return ""
+}
+
+func (x *CalendarEvent) GetAbsenceTypeName() string {
+	if x != nil {
+		return x.AbsenceTypeName
+	}
+	return ""
+}
+
+func (x *CalendarEvent) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *CalendarEvent) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CalendarEvent) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CalendarEvent) GetDays() float64 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *CalendarEvent) GetStatus() LeaveRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LeaveRequestStatus_LEAVE_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *CalendarEvent) GetOrgUnitName() string {
+	if x != nil {
+		return x.OrgUnitName
+	}
+	return ""
+}
+
+func (x *CalendarEvent) GetStartDayPart() DayPart {
+	if x != nil {
+		return x.StartDayPart
+	}
+	return DayPart_DAY_PART_UNSPECIFIED
+}
+
+func (x *CalendarEvent) GetEndDayPart() DayPart {
+	if x != nil {
+		return x.EndDayPart
+	}
+	return DayPart_DAY_PART_UNSPECIFIED
+}
+
+func (x *CalendarEvent) GetHours() float64 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+// GetSignedDocumentUrlRequest returns a download URL for the signed document
+// Only allowed for participants (request owner or reviewer) or admins
+type GetSignedDocumentUrlRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LeaveRequestId string                 `protobuf:"bytes,1,opt,name=leave_request_id,json=leaveRequestId,proto3" json:"leave_request_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSignedDocumentUrlRequest) Reset() {
+	*x = GetSignedDocumentUrlRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignedDocumentUrlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignedDocumentUrlRequest) ProtoMessage() {}
+
+func (x *GetSignedDocumentUrlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignedDocumentUrlRequest.ProtoReflect.Descriptor instead.
+func (*GetSignedDocumentUrlRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetSignedDocumentUrlRequest) GetLeaveRequestId() string {
+	if x != nil {
+		return x.LeaveRequestId
+	}
+	return ""
+}
+
+type GetSignedDocumentUrlResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSignedDocumentUrlResponse) Reset() {
+	*x = GetSignedDocumentUrlResponse{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignedDocumentUrlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignedDocumentUrlResponse) ProtoMessage() {}
+
+func (x *GetSignedDocumentUrlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignedDocumentUrlResponse.ProtoReflect.Descriptor instead.
+func (*GetSignedDocumentUrlResponse) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetSignedDocumentUrlResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetCalendarEventsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TenantId          *uint32                `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	StartDate         *string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	EndDate           *string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	OrgUnitName       *string                `protobuf:"bytes,4,opt,name=org_unit_name,json=orgUnitName,proto3,oneof" json:"org_unit_name,omitempty"`
+	UserId            *uint32                `protobuf:"varint,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	HolidayCalendarId *string                `protobuf:"bytes,6,opt,name=holiday_calendar_id,json=holidayCalendarId,proto3,oneof" json:"holiday_calendar_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetCalendarEventsRequest) Reset() {
+	*x = GetCalendarEventsRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarEventsRequest) ProtoMessage() {}
+
+func (x *GetCalendarEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarEventsRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetCalendarEventsRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *GetCalendarEventsRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *GetCalendarEventsRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *GetCalendarEventsRequest) GetOrgUnitName() string {
+	if x != nil && x.OrgUnitName != nil {
+		return *x.OrgUnitName
+	}
+	return ""
+}
+
+func (x *GetCalendarEventsRequest) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *GetCalendarEventsRequest) GetHolidayCalendarId() string {
+	if x != nil && x.HolidayCalendarId != nil {
+		return *x.HolidayCalendarId
+	}
+	return ""
+}
+
+// CalendarHoliday is a public holiday occurrence shown on the calendar
+type CalendarHoliday struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HolidayId     string                 `protobuf:"bytes,1,opt,name=holiday_id,json=holidayId,proto3" json:"holiday_id,omitempty"`
+	CalendarId    string                 `protobuf:"bytes,2,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarHoliday) Reset() {
+	*x = CalendarHoliday{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarHoliday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarHoliday) ProtoMessage() {}
+
+func (x *CalendarHoliday) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarHoliday.ProtoReflect.Descriptor instead.
+func (*CalendarHoliday) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CalendarHoliday) GetHolidayId() string {
+	if x != nil {
+		return x.HolidayId
+	}
+	return ""
+}
+
+func (x *CalendarHoliday) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *CalendarHoliday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CalendarHoliday) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type GetCalendarEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*CalendarEvent       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Holidays      []*CalendarHoliday     `protobuf:"bytes,2,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarEventsResponse) Reset() {
+	*x = GetCalendarEventsResponse{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarEventsResponse) ProtoMessage() {}
+
+func (x *GetCalendarEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarEventsResponse) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetCalendarEventsResponse) GetEvents() []*CalendarEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetCalendarEventsResponse) GetHolidays() []*CalendarHoliday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+// LeaveAmendment is a change to the dates of a leave request. Changes to pending requests are
+// applied at once; changes to approved requests are applied once approved and, when the absence
+// type requires signing, signed. Shortened requests are applied at once.
+type LeaveAmendment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	TenantId       *uint32                `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	LeaveRequestId *string                `protobuf:"bytes,3,opt,name=leave_request_id,json=leaveRequestId,proto3,oneof" json:"leave_request_id,omitempty"`
+	UserId         *uint32                `protobuf:"varint,4,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Status         *LeaveAmendmentStatus  `protobuf:"varint,5,opt,name=status,proto3,enum=hr.service.v1.LeaveAmendmentStatus,oneof" json:"status,omitempty"`
+	Kind           *LeaveAmendmentKind    `protobuf:"varint,28,opt,name=kind,proto3,enum=hr.service.v1.LeaveAmendmentKind,oneof" json:"kind,omitempty"`
+	// The dates of the request before the amendment
+	PreviousStartDate    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=previous_start_date,json=previousStartDate,proto3,oneof" json:"previous_start_date,omitempty"`
+	PreviousEndDate      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=previous_end_date,json=previousEndDate,proto3,oneof" json:"previous_end_date,omitempty"`
+	PreviousStartDayPart *DayPart               `protobuf:"varint,8,opt,name=previous_start_day_part,json=previousStartDayPart,proto3,enum=hr.service.v1.DayPart,oneof" json:"previous_start_day_part,omitempty"`
+	PreviousEndDayPart   *DayPart               `protobuf:"varint,9,opt,name=previous_end_day_part,json=previousEndDayPart,proto3,enum=hr.service.v1.DayPart,oneof" json:"previous_end_day_part,omitempty"`
+	PreviousDays         *float64               `protobuf:"fixed64,10,opt,name=previous_days,json=previousDays,proto3,oneof" json:"previous_days,omitempty"`
+	// The new dates of the request
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	StartDayPart      *DayPart               `protobuf:"varint,13,opt,name=start_day_part,json=startDayPart,proto3,enum=hr.service.v1.DayPart,oneof" json:"start_day_part,omitempty"`
+	EndDayPart        *DayPart               `protobuf:"varint,14,opt,name=end_day_part,json=endDayPart,proto3,enum=hr.service.v1.DayPart,oneof" json:"end_day_part,omitempty"`
+	Hours             *float64               `protobuf:"fixed64,15,opt,name=hours,proto3,oneof" json:"hours,omitempty"`
+	Days              *float64               `protobuf:"fixed64,16,opt,name=days,proto3,oneof" json:"days,omitempty"`
+	HolidayCalendarId *string                `protobuf:"bytes,17,opt,name=holiday_calendar_id,json=holidayCalendarId,proto3,oneof" json:"holiday_calendar_id,omitempty"`
+	Reason            *string                `protobuf:"bytes,18,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	ReviewedBy        *uint32                `protobuf:"varint,19,opt,name=reviewed_by,json=reviewedBy,proto3,oneof" json:"reviewed_by,omitempty"`
+	ReviewerName      *string                `protobuf:"bytes,20,opt,name=reviewer_name,json=reviewerName,proto3,oneof" json:"reviewer_name,omitempty"`
+	ReviewedAt        *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=reviewed_at,json=reviewedAt,proto3,oneof" json:"reviewed_at,omitempty"`
+	ReviewNotes       *string                `protobuf:"bytes,22,opt,name=review_notes,json=reviewNotes,proto3,oneof" json:"review_notes,omitempty"`
+	SigningRequestId  *string                `protobuf:"bytes,23,opt,name=signing_request_id,json=signingRequestId,proto3,oneof" json:"signing_request_id,omitempty"`
+	// Leave policy rules an HR admin overrode to change the dates
+	PolicyOverride *PolicyOverride        `protobuf:"bytes,29,opt,name=policy_override,json=policyOverride,proto3,oneof" json:"policy_override,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	CreatedBy      *uint32                `protobuf:"varint,26,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`
+	UpdatedBy      *uint32                `protobuf:"varint,27,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LeaveAmendment) Reset() {
+	*x = LeaveAmendment{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveAmendment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveAmendment) ProtoMessage() {}
+
+func (x *LeaveAmendment) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveAmendment.ProtoReflect.Descriptor instead.
+func (*LeaveAmendment) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LeaveAmendment) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *LeaveAmendment) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *LeaveAmendment) GetLeaveRequestId() string {
+	if x != nil && x.LeaveRequestId != nil {
+		return *x.LeaveRequestId
+	}
+	return ""
+}
+
+func (x *LeaveAmendment) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *LeaveAmendment) GetStatus() LeaveAmendmentStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return LeaveAmendmentStatus_LEAVE_AMENDMENT_STATUS_UNSPECIFIED
+}
+
+func (x *LeaveAmendment) GetKind() LeaveAmendmentKind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return LeaveAmendmentKind_LEAVE_AMENDMENT_KIND_UNSPECIFIED
+}
+
+func (x *LeaveAmendment) GetPreviousStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousStartDate
+	}
+	return nil
+}
+
+func (x *LeaveAmendment) GetPreviousEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousEndDate
+	}
+	return nil
+}
+
+func (x *LeaveAmendment) GetPreviousStartDayPart() DayPart {
+	if x != nil && x.PreviousStartDayPart != nil {
+		return *x.PreviousStartDayPart
+	}
+	return DayPart_DAY_PART_UNSPECIFIED
+}
+
+func (x *LeaveAmendment) GetPreviousEndDayPart() DayPart {
+	if x != nil && x.PreviousEndDayPart != nil {
+		return *x.PreviousEndDayPart
+	}
+	return DayPart_DAY_PART_UNSPECIFIED
+}
+
+func (x *LeaveAmendment) GetPreviousDays() float64 {
+	if x != nil && x.PreviousDays != nil {
+		return *x.PreviousDays
+	}
+	return 0
+}
+
+func (x *LeaveAmendment) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *LeaveAmendment) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *LeaveAmendment) GetStartDayPart() DayPart {
+	if x != nil && x.StartDayPart != nil {
+		return *x.StartDayPart
+	}
+	return DayPart_DAY_PART_UNSPECIFIED
+}
+
+func (x *LeaveAmendment) GetEndDayPart() DayPart {
+	if x != nil && x.EndDayPart != nil {
+		return *x.EndDayPart
+	}
+	return DayPart_DAY_PART_UNSPECIFIED
+}
+
+func (x *LeaveAmendment) GetHours() float64 {
+	if x != nil && x.Hours != nil {
+		return *x.Hours
+	}
+	return 0
+}
+
+func (x *LeaveAmendment) GetDays() float64 {
+	if x != nil && x.Days != nil {
+		return *x.Days
+	}
+	return 0
+}
+
+func (x *LeaveAmendment) GetHolidayCalendarId() string {
+	if x != nil && x.HolidayCalendarId != nil {
+		return *x.HolidayCalendarId
+	}
+	return ""
+}
+
+func (x *LeaveAmendment) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *LeaveAmendment) GetReviewedBy() uint32 {
+	if x != nil && x.ReviewedBy != nil {
+		return *x.ReviewedBy
+	}
+	return 0
+}
+
+func (x *LeaveAmendment) GetReviewerName() string {
+	if x != nil && x.ReviewerName != nil {
+		return *x.ReviewerName
+	}
+	return ""
+}
+
+func (x *LeaveAmendment) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *LeaveAmendment) GetReviewNotes() string {
+	if x != nil && x.ReviewNotes != nil {
+		return *x.ReviewNotes
+	}
+	return ""
+}
+
+func (x *LeaveAmendment) GetSigningRequestId() string {
+	if x != nil && x.SigningRequestId != nil {
+		return *x.SigningRequestId
+	}
+	return ""
+}
+
+func (x *LeaveAmendment) GetPolicyOverride() *PolicyOverride {
+	if x != nil {
+		return x.PolicyOverride
+	}
+	return nil
+}
+
+func (x *LeaveAmendment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LeaveAmendment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *LeaveAmendment) GetCreatedBy() uint32 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *LeaveAmendment) GetUpdatedBy() uint32 {
+	if x != nil && x.UpdatedBy != nil {
+		return *x.UpdatedBy
+	}
+	return 0
+}
+
+// ChangeLeaveDatesRequest moves a pending or approved leave request to new dates
+type ChangeLeaveDatesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Half of the first and last day, as on creation
+	StartDayPart *DayPart `protobuf:"varint,4,opt,name=start_day_part,json=startDayPart,proto3,enum=hr.service.v1.DayPart,oneof" json:"start_day_part,omitempty"`
+	EndDayPart   *DayPart `protobuf:"varint,5,opt,name=end_day_part,json=endDayPart,proto3,enum=hr.service.v1.DayPart,oneof" json:"end_day_part,omitempty"`
+	// Days of the new dates; calculated when unset
+	Days   *float64 `protobuf:"fixed64,6,opt,name=days,proto3,oneof" json:"days,omitempty"`
+	Reason *string  `protobuf:"bytes,7,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// Justification for the new dates despite the leave policy rules they break;
+	// only HR admins may override policies
+	PolicyOverrideReason *string `protobuf:"bytes,8,opt,name=policy_override_reason,json=policyOverrideReason,proto3,oneof" json:"policy_override_reason,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ChangeLeaveDatesRequest) Reset() {
+	*x = ChangeLeaveDatesRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeLeaveDatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeLeaveDatesRequest) ProtoMessage() {}
+
+func (x *ChangeLeaveDatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeLeaveDatesRequest.ProtoReflect.Descriptor instead.
+func (*ChangeLeaveDatesRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ChangeLeaveDatesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChangeLeaveDatesRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ChangeLeaveDatesRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ChangeLeaveDatesRequest) GetStartDayPart() DayPart {
+	if x != nil && x.StartDayPart != nil {
+		return *x.StartDayPart
+	}
+	return DayPart_DAY_PART_UNSPECIFIED
+}
+
+func (x *ChangeLeaveDatesRequest) GetEndDayPart() DayPart {
+	if x != nil && x.EndDayPart != nil {
+		return *x.EndDayPart
+	}
+	return DayPart_DAY_PART_UNSPECIFIED
+}
+
+func (x *ChangeLeaveDatesRequest) GetDays() float64 {
+	if x != nil && x.Days != nil {
+		return *x.Days
+	}
+	return 0
+}
+
+func (x *ChangeLeaveDatesRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ChangeLeaveDatesRequest) GetPolicyOverrideReason() string {
+	if x != nil && x.PolicyOverrideReason != nil {
+		return *x.PolicyOverrideReason
+	}
+	return ""
+}
+
+type ChangeLeaveDatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	Amendment     *LeaveAmendment        `protobuf:"bytes,2,opt,name=amendment,proto3" json:"amendment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeLeaveDatesResponse) Reset() {
+	*x = ChangeLeaveDatesResponse{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeLeaveDatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeLeaveDatesResponse) ProtoMessage() {}
+
+func (x *ChangeLeaveDatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeLeaveDatesResponse.ProtoReflect.Descriptor instead.
+func (*ChangeLeaveDatesResponse) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ChangeLeaveDatesResponse) GetLeaveRequest() *LeaveRequest {
+	if x != nil {
+		return x.LeaveRequest
+	}
+	return nil
+}
+
+func (x *ChangeLeaveDatesResponse) GetAmendment() *LeaveAmendment {
+	if x != nil {
+		return x.Amendment
+	}
+	return nil
+}
+
+type ListLeaveAmendmentsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LeaveRequestId string                 `protobuf:"bytes,1,opt,name=leave_request_id,json=leaveRequestId,proto3" json:"leave_request_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListLeaveAmendmentsRequest) Reset() {
+	*x = ListLeaveAmendmentsRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeaveAmendmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeaveAmendmentsRequest) ProtoMessage() {}
+
+func (x *ListLeaveAmendmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeaveAmendmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListLeaveAmendmentsRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListLeaveAmendmentsRequest) GetLeaveRequestId() string {
+	if x != nil {
+		return x.LeaveRequestId
+	}
+	return ""
+}
+
+type ListLeaveAmendmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*LeaveAmendment      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeaveAmendmentsResponse) Reset() {
+	*x = ListLeaveAmendmentsResponse{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeaveAmendmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeaveAmendmentsResponse) ProtoMessage() {}
+
+func (x *ListLeaveAmendmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeaveAmendmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListLeaveAmendmentsResponse) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListLeaveAmendmentsResponse) GetItems() []*LeaveAmendment {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ApproveLeaveAmendmentRequest approves a change to the dates of an approved leave request
+type ApproveLeaveAmendmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewNotes   *string                `protobuf:"bytes,2,opt,name=review_notes,json=reviewNotes,proto3,oneof" json:"review_notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveLeaveAmendmentRequest) Reset() {
+	*x = ApproveLeaveAmendmentRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveLeaveAmendmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveLeaveAmendmentRequest) ProtoMessage() {}
+
+func (x *ApproveLeaveAmendmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveLeaveAmendmentRequest.ProtoReflect.Descriptor instead.
+func (*ApproveLeaveAmendmentRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ApproveLeaveAmendmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveLeaveAmendmentRequest) GetReviewNotes() string {
+	if x != nil && x.ReviewNotes != nil {
+		return *x.ReviewNotes
+	}
+	return ""
+}
+
+type ApproveLeaveAmendmentResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	LeaveRequest *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	Amendment    *LeaveAmendment        `protobuf:"bytes,2,opt,name=amendment,proto3" json:"amendment,omitempty"`
+	// Coverage rules the new dates break without blocking them
+	CoverageWarnings []*CoverageConflict `protobuf:"bytes,3,rep,name=coverage_warnings,json=coverageWarnings,proto3" json:"coverage_warnings,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ApproveLeaveAmendmentResponse) Reset() {
+	*x = ApproveLeaveAmendmentResponse{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveLeaveAmendmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveLeaveAmendmentResponse) ProtoMessage() {}
+
+func (x *ApproveLeaveAmendmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1355,112 +3810,108 @@ func (x *CalendarEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalendarEvent.ProtoReflect.Descriptor instead.
-func (*CalendarEvent) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ApproveLeaveAmendmentResponse.ProtoReflect.Descriptor instead.
+func (*ApproveLeaveAmendmentResponse) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *CalendarEvent) GetId() string {
+func (x *ApproveLeaveAmendmentResponse) GetLeaveRequest() *LeaveRequest {
 	if x != nil {
-		return x.Id
+		return x.LeaveRequest
 	}
-	return ""
+	return nil
 }
 
-func (x *CalendarEvent) GetUserId() uint32 {
+func (x *ApproveLeaveAmendmentResponse) GetAmendment() *LeaveAmendment {
 	if x != nil {
-		return x.UserId
+		return x.Amendment
 	}
-	return 0
+	return nil
 }
 
-func (x *CalendarEvent) GetUserName() string {
+func (x *ApproveLeaveAmendmentResponse) GetCoverageWarnings() []*CoverageConflict {
 	if x != nil {
-		return x.UserName
+		return x.CoverageWarnings
 	}
-	return ""
+	return nil
 }
 
-func (x *CalendarEvent) GetAbsenceTypeId() string {
-	if x != nil {
-		return x.AbsenceTypeId
-	}
-	return ""
+// RejectLeaveAmendmentRequest rejects a change to the dates of an approved leave request; the
+// request keeps its dates
+type RejectLeaveAmendmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewNotes   *string                `protobuf:"bytes,2,opt,name=review_notes,json=reviewNotes,proto3,oneof" json:"review_notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalendarEvent) GetAbsenceTypeName() string {
-	if x != nil {
-		return x.AbsenceTypeName
-	}
-	return ""
+func (x *RejectLeaveAmendmentRequest) Reset() {
+	*x = RejectLeaveAmendmentRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalendarEvent) GetColor() string {
-	if x != nil {
-		return x.Color
-	}
-	return ""
+func (x *RejectLeaveAmendmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CalendarEvent) GetStartDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartDate
-	}
-	return nil
-}
+func (*RejectLeaveAmendmentRequest) ProtoMessage() {}
 
-func (x *CalendarEvent) GetEndDate() *timestamppb.Timestamp {
+func (x *RejectLeaveAmendmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[46]
 	if x != nil {
-		return x.EndDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CalendarEvent) GetDays() float64 {
-	if x != nil {
-		return x.Days
-	}
-	return 0
+// Deprecated: Use RejectLeaveAmendmentRequest.ProtoReflect.Descriptor instead.
+func (*RejectLeaveAmendmentRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CalendarEvent) GetStatus() LeaveRequestStatus {
+func (x *RejectLeaveAmendmentRequest) GetId() string {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return LeaveRequestStatus_LEAVE_REQUEST_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *CalendarEvent) GetOrgUnitName() string {
-	if x != nil {
-		return x.OrgUnitName
+func (x *RejectLeaveAmendmentRequest) GetReviewNotes() string {
+	if x != nil && x.ReviewNotes != nil {
+		return *x.ReviewNotes
 	}
 	return ""
 }
 
-// GetSignedDocumentUrlRequest returns a download URL for the signed document
-// Only allowed for participants (request owner or reviewer) or admins
-type GetSignedDocumentUrlRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	LeaveRequestId string                 `protobuf:"bytes,1,opt,name=leave_request_id,json=leaveRequestId,proto3" json:"leave_request_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type RejectLeaveAmendmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amendment     *LeaveAmendment        `protobuf:"bytes,1,opt,name=amendment,proto3" json:"amendment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignedDocumentUrlRequest) Reset() {
-	*x = GetSignedDocumentUrlRequest{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[19]
+func (x *RejectLeaveAmendmentResponse) Reset() {
+	*x = RejectLeaveAmendmentResponse{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignedDocumentUrlRequest) String() string {
+func (x *RejectLeaveAmendmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignedDocumentUrlRequest) ProtoMessage() {}
+func (*RejectLeaveAmendmentResponse) ProtoMessage() {}
 
-func (x *GetSignedDocumentUrlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[19]
+func (x *RejectLeaveAmendmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1471,40 +3922,47 @@ func (x *GetSignedDocumentUrlRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignedDocumentUrlRequest.ProtoReflect.Descriptor instead.
-func (*GetSignedDocumentUrlRequest) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RejectLeaveAmendmentResponse.ProtoReflect.Descriptor instead.
+func (*RejectLeaveAmendmentResponse) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetSignedDocumentUrlRequest) GetLeaveRequestId() string {
+func (x *RejectLeaveAmendmentResponse) GetAmendment() *LeaveAmendment {
 	if x != nil {
-		return x.LeaveRequestId
+		return x.Amendment
 	}
-	return ""
+	return nil
 }
 
-type GetSignedDocumentUrlResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+// ShortenLeaveRequestRequest ends an approved leave request early, e.g. when the employee returns
+// early. Only the days no longer taken are refunded.
+type ShortenLeaveRequestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New last day of the absence; for hour-based types the new end time
+	EndDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Half of the new last day the absence ends in
+	EndDayPart    *DayPart `protobuf:"varint,3,opt,name=end_day_part,json=endDayPart,proto3,enum=hr.service.v1.DayPart,oneof" json:"end_day_part,omitempty"`
+	Reason        *string  `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignedDocumentUrlResponse) Reset() {
-	*x = GetSignedDocumentUrlResponse{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[20]
+func (x *ShortenLeaveRequestRequest) Reset() {
+	*x = ShortenLeaveRequestRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignedDocumentUrlResponse) String() string {
+func (x *ShortenLeaveRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignedDocumentUrlResponse) ProtoMessage() {}
+func (*ShortenLeaveRequestRequest) ProtoMessage() {}
 
-func (x *GetSignedDocumentUrlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[20]
+func (x *ShortenLeaveRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1515,44 +3973,62 @@ func (x *GetSignedDocumentUrlResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignedDocumentUrlResponse.ProtoReflect.Descriptor instead.
-func (*GetSignedDocumentUrlResponse) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ShortenLeaveRequestRequest.ProtoReflect.Descriptor instead.
+func (*ShortenLeaveRequestRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetSignedDocumentUrlResponse) GetUrl() string {
+func (x *ShortenLeaveRequestRequest) GetId() string {
 	if x != nil {
-		return x.Url
+		return x.Id
 	}
 	return ""
 }
 
-type GetCalendarEventsRequest struct {
+func (x *ShortenLeaveRequestRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ShortenLeaveRequestRequest) GetEndDayPart() DayPart {
+	if x != nil && x.EndDayPart != nil {
+		return *x.EndDayPart
+	}
+	return DayPart_DAY_PART_UNSPECIFIED
+}
+
+func (x *ShortenLeaveRequestRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type ShortenLeaveRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      *uint32                `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
-	StartDate     *string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
-	EndDate       *string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
-	OrgUnitName   *string                `protobuf:"bytes,4,opt,name=org_unit_name,json=orgUnitName,proto3,oneof" json:"org_unit_name,omitempty"`
-	UserId        *uint32                `protobuf:"varint,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	LeaveRequest  *LeaveRequest          `protobuf:"bytes,1,opt,name=leave_request,json=leaveRequest,proto3" json:"leave_request,omitempty"`
+	Amendment     *LeaveAmendment        `protobuf:"bytes,2,opt,name=amendment,proto3" json:"amendment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCalendarEventsRequest) Reset() {
-	*x = GetCalendarEventsRequest{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[21]
+func (x *ShortenLeaveRequestResponse) Reset() {
+	*x = ShortenLeaveRequestResponse{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCalendarEventsRequest) String() string {
+func (x *ShortenLeaveRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCalendarEventsRequest) ProtoMessage() {}
+func (*ShortenLeaveRequestResponse) ProtoMessage() {}
 
-func (x *GetCalendarEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[21]
+func (x *ShortenLeaveRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1563,68 +4039,92 @@ func (x *GetCalendarEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCalendarEventsRequest.ProtoReflect.Descriptor instead.
-func (*GetCalendarEventsRequest) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ShortenLeaveRequestResponse.ProtoReflect.Descriptor instead.
+func (*ShortenLeaveRequestResponse) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetCalendarEventsRequest) GetTenantId() uint32 {
-	if x != nil && x.TenantId != nil {
-		return *x.TenantId
+func (x *ShortenLeaveRequestResponse) GetLeaveRequest() *LeaveRequest {
+	if x != nil {
+		return x.LeaveRequest
 	}
-	return 0
+	return nil
 }
 
-func (x *GetCalendarEventsRequest) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
+func (x *ShortenLeaveRequestResponse) GetAmendment() *LeaveAmendment {
+	if x != nil {
+		return x.Amendment
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCalendarEventsRequest) GetEndDate() string {
-	if x != nil && x.EndDate != nil {
-		return *x.EndDate
-	}
-	return ""
+type GetLeaveCoverageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCalendarEventsRequest) GetOrgUnitName() string {
-	if x != nil && x.OrgUnitName != nil {
-		return *x.OrgUnitName
+func (x *GetLeaveCoverageRequest) Reset() {
+	*x = GetLeaveCoverageRequest{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaveCoverageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaveCoverageRequest) ProtoMessage() {}
+
+func (x *GetLeaveCoverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetCalendarEventsRequest) GetUserId() uint32 {
-	if x != nil && x.UserId != nil {
-		return *x.UserId
+// Deprecated: Use GetLeaveCoverageRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaveCoverageRequest) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetLeaveCoverageRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type GetCalendarEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*CalendarEvent       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+type GetLeaveCoverageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Coverage rules the request breaks, both warning and blocking
+	Conflicts     []*CoverageConflict `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCalendarEventsResponse) Reset() {
-	*x = GetCalendarEventsResponse{}
-	mi := &file_hr_service_v1_leave_proto_msgTypes[22]
+func (x *GetLeaveCoverageResponse) Reset() {
+	*x = GetLeaveCoverageResponse{}
+	mi := &file_hr_service_v1_leave_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCalendarEventsResponse) String() string {
+func (x *GetLeaveCoverageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCalendarEventsResponse) ProtoMessage() {}
+func (*GetLeaveCoverageResponse) ProtoMessage() {}
 
-func (x *GetCalendarEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hr_service_v1_leave_proto_msgTypes[22]
+func (x *GetLeaveCoverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hr_service_v1_leave_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,14 +4135,14 @@ func (x *GetCalendarEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCalendarEventsResponse.ProtoReflect.Descriptor instead.
-func (*GetCalendarEventsResponse) Descriptor() ([]byte, []int) {
-	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetLeaveCoverageResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaveCoverageResponse) Descriptor() ([]byte, []int) {
+	return file_hr_service_v1_leave_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetCalendarEventsResponse) GetEvents() []*CalendarEvent {
+func (x *GetLeaveCoverageResponse) GetConflicts() []*CoverageConflict {
 	if x != nil {
-		return x.Events
+		return x.Conflicts
 	}
 	return nil
 }
@@ -1651,7 +4151,11 @@ var File_hr_service_v1_leave_proto protoreflect.FileDescriptor
 
 const file_hr_service_v1_leave_proto_rawDesc = "" +
 	"\n" +
-	"\x19hr/service/v1/leave.proto\x12\rhr.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xc6\v\n" +
+	"\x19hr/service/v1/leave.proto\x12\rhr.service.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1chr/service/v1/approval.proto\x1a\x1bhr/service/v1/comment.proto\x1a\x1chr/service/v1/coverage.proto\x1a\x1ahr/service/v1/policy.proto\x1a\x1ehr/service/v1/recurrence.proto\"[\n" +
+	"\x0eLeaveDeduction\x12!\n" +
+	"\fallowance_id\x18\x01 \x01(\tR\vallowanceId\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x12\n" +
+	"\x04days\x18\x03 \x01(\x01R\x04days\"\xab\x18\n" +
 	"\fLeaveRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12 \n" +
 	"\ttenant_id\x18\x02 \x01(\rH\x01R\btenantId\x88\x01\x01\x12\x1c\n" +
@@ -1679,15 +4183,44 @@ const file_hr_service_v1_leave_proto_rawDesc = "" +
 	"\x12absence_type_color\x18  \x01(\tH\x10R\x10absenceTypeColor\x88\x01\x01\x12(\n" +
 	"\rreviewer_name\x18! \x01(\tH\x11R\freviewerName\x88\x01\x01\x12'\n" +
 	"\rorg_unit_name\x18\" \x01(\tH\x12R\vorgUnitName\x88\x01\x01\x121\n" +
-	"\x12signing_request_id\x18# \x01(\tH\x13R\x10signingRequestId\x88\x01\x01\x12>\n" +
+	"\x12signing_request_id\x18# \x01(\tH\x13R\x10signingRequestId\x88\x01\x01\x123\n" +
+	"\x13holiday_calendar_id\x18\x0f \x01(\tH\x14R\x11holidayCalendarId\x88\x01\x01\x12A\n" +
+	"\x0estart_day_part\x18\x10 \x01(\x0e2\x16.hr.service.v1.DayPartH\x15R\fstartDayPart\x88\x01\x01\x12=\n" +
+	"\fend_day_part\x18\x11 \x01(\x0e2\x16.hr.service.v1.DayPartH\x16R\n" +
+	"endDayPart\x88\x01\x01\x12\x19\n" +
+	"\x05hours\x18\x12 \x01(\x01H\x17R\x05hours\x88\x01\x01\x12=\n" +
+	"\n" +
+	"deductions\x18\x13 \x03(\v2\x1d.hr.service.v1.LeaveDeductionR\n" +
+	"deductions\x12:\n" +
+	"\tapprovals\x18\x18 \x03(\v2\x1c.hr.service.v1.LeaveApprovalR\tapprovals\x12(\n" +
+	"\rapproval_step\x18\x19 \x01(\x05H\x18R\fapprovalStep\x88\x01\x01\x12$\n" +
+	"\vapprover_id\x18\x1a \x01(\rH\x19R\n" +
+	"approverId\x88\x01\x01\x12%\n" +
+	"\fon_behalf_of\x18\x1b \x01(\rH\x1aR\n" +
+	"onBehalfOf\x88\x01\x01\x12.\n" +
+	"\x11on_behalf_of_name\x18\x1c \x01(\tH\x1bR\x0eonBehalfOfName\x88\x01\x01\x12F\n" +
+	"\x0eawaiting_since\x18% \x01(\v2\x1a.google.protobuf.TimestampH\x1cR\rawaitingSince\x88\x01\x01\x12.\n" +
+	"\x10escalation_level\x18& \x01(\x05H\x1dR\x0fescalationLevel\x88\x01\x01\x12K\n" +
+	"\x0fpolicy_override\x18' \x01(\v2\x1d.hr.service.v1.PolicyOverrideH\x1eR\x0epolicyOverride\x88\x01\x01\x12.\n" +
+	"\x10attachment_count\x18( \x01(\x05H\x1fR\x0fattachmentCount\x88\x01\x01\x124\n" +
+	"\x13attachment_required\x18) \x01(\bH R\x12attachmentRequired\x88\x01\x01\x12O\n" +
+	"\x13attachment_due_date\x18* \x01(\v2\x1a.google.protobuf.TimestampH!R\x11attachmentDueDate\x88\x01\x01\x122\n" +
+	"\x12attachment_missing\x18+ \x01(\bH\"R\x11attachmentMissing\x88\x01\x01\x12 \n" +
+	"\tseries_id\x18, \x01(\tH#R\bseriesId\x88\x01\x01\x12B\n" +
+	"\n" +
+	"recurrence\x18- \x01(\v2\x1d.hr.service.v1.RecurrenceRuleH$R\n" +
+	"recurrence\x88\x01\x01\x12K\n" +
+	"\x0fsigning_outcome\x18. \x01(\x0e2\x1d.hr.service.v1.SigningOutcomeH%R\x0esigningOutcome\x88\x01\x01\x129\n" +
+	"\x16signing_outcome_reason\x18/ \x01(\tH&R\x14signingOutcomeReason\x88\x01\x01\x12I\n" +
+	"\x10signing_ended_at\x180 \x01(\v2\x1a.google.protobuf.TimestampH'R\x0esigningEndedAt\x88\x01\x01\x12>\n" +
 	"\n" +
-	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\x14R\tcreatedAt\x88\x01\x01\x12>\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH(R\tcreatedAt\x88\x01\x01\x12>\n" +
 	"\n" +
-	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\x15R\tupdatedAt\x88\x01\x01\x12\"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH)R\tupdatedAt\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"created_by\x18\x16 \x01(\rH\x16R\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"created_by\x18\x16 \x01(\rH*R\tcreatedBy\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"updated_by\x18\x17 \x01(\rH\x17R\tupdatedBy\x88\x01\x01B\x05\n" +
+	"updated_by\x18\x17 \x01(\rH+R\tupdatedBy\x88\x01\x01B\x05\n" +
 	"\x03_idB\f\n" +
 	"\n" +
 	"_tenant_idB\n" +
@@ -1710,11 +4243,32 @@ const file_hr_service_v1_leave_proto_rawDesc = "" +
 	"\x13_absence_type_colorB\x10\n" +
 	"\x0e_reviewer_nameB\x10\n" +
 	"\x0e_org_unit_nameB\x15\n" +
-	"\x13_signing_request_idB\r\n" +
+	"\x13_signing_request_idB\x16\n" +
+	"\x14_holiday_calendar_idB\x11\n" +
+	"\x0f_start_day_partB\x0f\n" +
+	"\r_end_day_partB\b\n" +
+	"\x06_hoursB\x10\n" +
+	"\x0e_approval_stepB\x0e\n" +
+	"\f_approver_idB\x0f\n" +
+	"\r_on_behalf_ofB\x14\n" +
+	"\x12_on_behalf_of_nameB\x11\n" +
+	"\x0f_awaiting_sinceB\x13\n" +
+	"\x11_escalation_levelB\x12\n" +
+	"\x10_policy_overrideB\x13\n" +
+	"\x11_attachment_countB\x16\n" +
+	"\x14_attachment_requiredB\x16\n" +
+	"\x14_attachment_due_dateB\x15\n" +
+	"\x13_attachment_missingB\f\n" +
+	"\n" +
+	"_series_idB\r\n" +
+	"\v_recurrenceB\x12\n" +
+	"\x10_signing_outcomeB\x19\n" +
+	"\x17_signing_outcome_reasonB\x13\n" +
+	"\x11_signing_ended_atB\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\r\n" +
 	"\v_created_byB\r\n" +
-	"\v_updated_by\"\xc9\x05\n" +
+	"\v_updated_by\"\xe5\b\n" +
 	"\x19CreateLeaveRequestRequest\x12%\n" +
 	"\ttenant_id\x18\x01 \x01(\rB\x03\xe0A\x02H\x00R\btenantId\x88\x01\x01\x12!\n" +
 	"\auser_id\x18\x02 \x01(\rB\x03\xe0A\x02H\x01R\x06userId\x88\x01\x01\x127\n" +
@@ -1732,7 +4286,15 @@ const file_hr_service_v1_leave_proto_rawDesc = "" +
 	"\n" +
 	"user_email\x18\f \x01(\tH\tR\tuserEmail\x88\x01\x01\x12'\n" +
 	"\rorg_unit_name\x18\v \x01(\tH\n" +
-	"R\vorgUnitName\x88\x01\x01B\f\n" +
+	"R\vorgUnitName\x88\x01\x01\x123\n" +
+	"\x13holiday_calendar_id\x18\r \x01(\tH\vR\x11holidayCalendarId\x88\x01\x01\x12A\n" +
+	"\x0estart_day_part\x18\x0e \x01(\x0e2\x16.hr.service.v1.DayPartH\fR\fstartDayPart\x88\x01\x01\x12=\n" +
+	"\fend_day_part\x18\x0f \x01(\x0e2\x16.hr.service.v1.DayPartH\rR\n" +
+	"endDayPart\x88\x01\x01\x129\n" +
+	"\x16policy_override_reason\x18\x10 \x01(\tH\x0eR\x14policyOverrideReason\x88\x01\x01\x12B\n" +
+	"\n" +
+	"recurrence\x18\x11 \x01(\v2\x1d.hr.service.v1.RecurrenceRuleH\x0fR\n" +
+	"recurrence\x88\x01\x01B\f\n" +
 	"\n" +
 	"_tenant_idB\n" +
 	"\n" +
@@ -1746,14 +4308,29 @@ const file_hr_service_v1_leave_proto_rawDesc = "" +
 	"\n" +
 	"_user_nameB\r\n" +
 	"\v_user_emailB\x10\n" +
-	"\x0e_org_unit_name\"^\n" +
+	"\x0e_org_unit_nameB\x16\n" +
+	"\x14_holiday_calendar_idB\x11\n" +
+	"\x0f_start_day_partB\x0f\n" +
+	"\r_end_day_partB\x19\n" +
+	"\x17_policy_override_reasonB\r\n" +
+	"\v_recurrence\"\xf4\x01\n" +
 	"\x1aCreateLeaveRequestResponse\x12@\n" +
-	"\rleave_request\x18\x01 \x01(\v2\x1b.hr.service.v1.LeaveRequestR\fleaveRequest\"4\n" +
+	"\rleave_request\x18\x01 \x01(\v2\x1b.hr.service.v1.LeaveRequestR\fleaveRequest\x12L\n" +
+	"\x11coverage_warnings\x18\x02 \x03(\v2\x1f.hr.service.v1.CoverageConflictR\x10coverageWarnings\x12F\n" +
+	"\voccurrences\x18\x03 \x03(\v2$.hr.service.v1.LeaveOccurrenceResultR\voccurrences\"\xc4\x02\n" +
+	"\x15LeaveOccurrenceResult\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12@\n" +
+	"\rleave_request\x18\x03 \x01(\v2\x1b.hr.service.v1.LeaveRequestR\fleaveRequest\x12!\n" +
+	"\ferror_reason\x18\x04 \x01(\tR\verrorReason\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x12L\n" +
+	"\x11coverage_warnings\x18\x06 \x03(\v2\x1f.hr.service.v1.CoverageConflictR\x10coverageWarnings\"4\n" +
 	"\x16GetLeaveRequestRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\"[\n" +
 	"\x17GetLeaveRequestResponse\x12@\n" +
-	"\rleave_request\x18\x01 \x01(\v2\x1b.hr.service.v1.LeaveRequestR\fleaveRequest\"\x87\x04\n" +
+	"\rleave_request\x18\x01 \x01(\v2\x1b.hr.service.v1.LeaveRequestR\fleaveRequest\"\xb7\x04\n" +
 	"\x18ListLeaveRequestsRequest\x12 \n" +
 	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12\x17\n" +
 	"\x04page\x18\x02 \x01(\x05H\x01R\x04page\x88\x01\x01\x12 \n" +
@@ -1766,7 +4343,9 @@ const file_hr_service_v1_leave_proto_rawDesc = "" +
 	"\x06status\x18\f \x01(\x0e2!.hr.service.v1.LeaveRequestStatusH\aR\x06status\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"start_date\x18\r \x01(\tH\bR\tstartDate\x88\x01\x01\x12\x1e\n" +
-	"\bend_date\x18\x0e \x01(\tH\tR\aendDate\x88\x01\x01B\f\n" +
+	"\bend_date\x18\x0e \x01(\tH\tR\aendDate\x88\x01\x01\x12 \n" +
+	"\tseries_id\x18\x0f \x01(\tH\n" +
+	"R\bseriesId\x88\x01\x01B\f\n" +
 	"\n" +
 	"_tenant_idB\a\n" +
 	"\x05_pageB\f\n" +
@@ -1780,10 +4359,25 @@ const file_hr_service_v1_leave_proto_rawDesc = "" +
 	"\x10_absence_type_idB\t\n" +
 	"\a_statusB\r\n" +
 	"\v_start_dateB\v\n" +
-	"\t_end_date\"s\n" +
+	"\t_end_dateB\f\n" +
+	"\n" +
+	"_series_id\"s\n" +
 	"\x19ListLeaveRequestsResponse\x121\n" +
 	"\x05items\x18\x01 \x03(\v2\x1b.hr.service.v1.LeaveRequestR\x05items\x12\x19\n" +
 	"\x05total\x18\x02 \x01(\x05H\x00R\x05total\x88\x01\x01B\b\n" +
+	"\x06_total\"\xa0\x01\n" +
+	"\x1cListAssignedApprovalsRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12 \n" +
+	"\tno_paging\x18\x03 \x01(\bH\x02R\bnoPaging\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\f\n" +
+	"\n" +
+	"_no_paging\"w\n" +
+	"\x1dListAssignedApprovalsResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.hr.service.v1.LeaveRequestR\x05items\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x05H\x00R\x05total\x88\x01\x01B\b\n" +
 	"\x06_total\"\xb3\x01\n" +
 	"\x19UpdateLeaveRequestRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
@@ -1807,9 +4401,10 @@ const file_hr_service_v1_leave_proto_rawDesc = "" +
 	"\r_review_notesB\x11\n" +
 	"\x0f_approver_emailB\x10\n" +
 	"\x0e_approver_nameB\x12\n" +
-	"\x10_requester_email\"_\n" +
+	"\x10_requester_email\"\xad\x01\n" +
 	"\x1bApproveLeaveRequestResponse\x12@\n" +
-	"\rleave_request\x18\x01 \x01(\v2\x1b.hr.service.v1.LeaveRequestR\fleaveRequest\"p\n" +
+	"\rleave_request\x18\x01 \x01(\v2\x1b.hr.service.v1.LeaveRequestR\fleaveRequest\x12L\n" +
+	"\x11coverage_warnings\x18\x02 \x03(\v2\x1f.hr.service.v1.CoverageConflictR\x10coverageWarnings\"p\n" +
 	"\x19RejectLeaveRequestRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\x12&\n" +
@@ -1828,7 +4423,73 @@ const file_hr_service_v1_leave_proto_rawDesc = "" +
 	"\x06reason\x18\x02 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
 	"\a_reason\"^\n" +
 	"\x1aRevokeLeaveRequestResponse\x12@\n" +
-	"\rleave_request\x18\x01 \x01(\v2\x1b.hr.service.v1.LeaveRequestR\fleaveRequest\"\xa4\x03\n" +
+	"\rleave_request\x18\x01 \x01(\v2\x1b.hr.service.v1.LeaveRequestR\fleaveRequest\"\xff\x01\n" +
+	"\x15LeaveRequestSelection\x12\x1b\n" +
+	"\x03ids\x18\x01 \x03(\tB\t\xbaH\x06\x92\x01\x03\x10\xc8\x01R\x03ids\x12\x1c\n" +
+	"\auser_id\x18\n" +
+	" \x01(\rH\x00R\x06userId\x88\x01\x01\x12+\n" +
+	"\x0fabsence_type_id\x18\v \x01(\tH\x01R\rabsenceTypeId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_date\x18\f \x01(\tH\x02R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\r \x01(\tH\x03R\aendDate\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\x12\n" +
+	"\x10_absence_type_idB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_date\"\xa3\x01\n" +
+	"\x1fBulkApproveLeaveRequestsRequest\x12G\n" +
+	"\tselection\x18\x01 \x01(\v2$.hr.service.v1.LeaveRequestSelectionB\x03\xe0A\x02R\tselection\x12&\n" +
+	"\freview_notes\x18\x02 \x01(\tH\x00R\vreviewNotes\x88\x01\x01B\x0f\n" +
+	"\r_review_notes\"\xa2\x01\n" +
+	"\x1eBulkRejectLeaveRequestsRequest\x12G\n" +
+	"\tselection\x18\x01 \x01(\v2$.hr.service.v1.LeaveRequestSelectionB\x03\xe0A\x02R\tselection\x12&\n" +
+	"\freview_notes\x18\x02 \x01(\tH\x00R\vreviewNotes\x88\x01\x01B\x0f\n" +
+	"\r_review_notes\"\x91\x01\n" +
+	"\x1eBulkRevokeLeaveRequestsRequest\x12G\n" +
+	"\tselection\x18\x01 \x01(\v2$.hr.service.v1.LeaveRequestSelectionB\x03\xe0A\x02R\tselection\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"\x9a\x02\n" +
+	"\x16BulkLeaveRequestResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12@\n" +
+	"\rleave_request\x18\x03 \x01(\v2\x1b.hr.service.v1.LeaveRequestR\fleaveRequest\x12!\n" +
+	"\ferror_reason\x18\x04 \x01(\tR\verrorReason\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x12L\n" +
+	"\x11coverage_warnings\x18\x06 \x03(\v2\x1f.hr.service.v1.CoverageConflictR\x10coverageWarnings\"\x92\x01\n" +
+	"\x19BulkLeaveRequestsResponse\x12?\n" +
+	"\aresults\x18\x01 \x03(\v2%.hr.service.v1.BulkLeaveRequestResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"?\n" +
+	"!CancelFollowingOccurrencesRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\"\xea\x02\n" +
+	"!UpdateFollowingOccurrencesRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tH\x00R\x06reason\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18\x03 \x01(\tH\x01R\x05notes\x88\x01\x01\x123\n" +
+	"\bmetadata\x18\x04 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12B\n" +
+	"\n" +
+	"recurrence\x18\x05 \x01(\v2\x1d.hr.service.v1.RecurrenceRuleH\x02R\n" +
+	"recurrence\x88\x01\x01\x129\n" +
+	"\x16policy_override_reason\x18\x06 \x01(\tH\x03R\x14policyOverrideReason\x88\x01\x01B\t\n" +
+	"\a_reasonB\b\n" +
+	"\x06_notesB\r\n" +
+	"\v_recurrenceB\x19\n" +
+	"\x17_policy_override_reason\"\xad\x01\n" +
+	"\"UpdateFollowingOccurrencesResponse\x12?\n" +
+	"\aresults\x18\x01 \x03(\v2%.hr.service.v1.BulkLeaveRequestResultR\aresults\x12F\n" +
+	"\voccurrences\x18\x02 \x03(\v2$.hr.service.v1.LeaveOccurrenceResultR\voccurrences\"}\n" +
+	"\x19ApproveLeaveSeriesRequest\x12'\n" +
+	"\tseries_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\bseriesId\x12&\n" +
+	"\freview_notes\x18\x02 \x01(\tH\x00R\vreviewNotes\x88\x01\x01B\x0f\n" +
+	"\r_review_notes\"|\n" +
+	"\x18RejectLeaveSeriesRequest\x12'\n" +
+	"\tseries_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\bseriesId\x12&\n" +
+	"\freview_notes\x18\x02 \x01(\tH\x00R\vreviewNotes\x88\x01\x01B\x0f\n" +
+	"\r_review_notes\"\xb2\x04\n" +
 	"\rCalendarEvent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x1b\n" +
@@ -1842,28 +4503,171 @@ const file_hr_service_v1_leave_proto_rawDesc = "" +
 	"\x04days\x18\t \x01(\x01R\x04days\x129\n" +
 	"\x06status\x18\n" +
 	" \x01(\x0e2!.hr.service.v1.LeaveRequestStatusR\x06status\x12\"\n" +
-	"\rorg_unit_name\x18\v \x01(\tR\vorgUnitName\"S\n" +
+	"\rorg_unit_name\x18\v \x01(\tR\vorgUnitName\x12<\n" +
+	"\x0estart_day_part\x18\f \x01(\x0e2\x16.hr.service.v1.DayPartR\fstartDayPart\x128\n" +
+	"\fend_day_part\x18\r \x01(\x0e2\x16.hr.service.v1.DayPartR\n" +
+	"endDayPart\x12\x14\n" +
+	"\x05hours\x18\x0e \x01(\x01R\x05hours\"S\n" +
 	"\x1bGetSignedDocumentUrlRequest\x124\n" +
 	"\x10leave_request_id\x18\x01 \x01(\tB\n" +
 	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x0eleaveRequestId\"0\n" +
 	"\x1cGetSignedDocumentUrlResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\"\x8f\x02\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xdc\x02\n" +
 	"\x18GetCalendarEventsRequest\x12 \n" +
 	"\ttenant_id\x18\x01 \x01(\rH\x00R\btenantId\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\tH\x01R\tstartDate\x88\x01\x01\x12\x1e\n" +
 	"\bend_date\x18\x03 \x01(\tH\x02R\aendDate\x88\x01\x01\x12'\n" +
 	"\rorg_unit_name\x18\x04 \x01(\tH\x03R\vorgUnitName\x88\x01\x01\x12\x1c\n" +
-	"\auser_id\x18\x05 \x01(\rH\x04R\x06userId\x88\x01\x01B\f\n" +
+	"\auser_id\x18\x05 \x01(\rH\x04R\x06userId\x88\x01\x01\x123\n" +
+	"\x13holiday_calendar_id\x18\x06 \x01(\tH\x05R\x11holidayCalendarId\x88\x01\x01B\f\n" +
 	"\n" +
 	"_tenant_idB\r\n" +
 	"\v_start_dateB\v\n" +
 	"\t_end_dateB\x10\n" +
 	"\x0e_org_unit_nameB\n" +
 	"\n" +
-	"\b_user_id\"Q\n" +
+	"\b_user_idB\x16\n" +
+	"\x14_holiday_calendar_id\"\x95\x01\n" +
+	"\x0fCalendarHoliday\x12\x1d\n" +
+	"\n" +
+	"holiday_id\x18\x01 \x01(\tR\tholidayId\x12\x1f\n" +
+	"\vcalendar_id\x18\x02 \x01(\tR\n" +
+	"calendarId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12.\n" +
+	"\x04date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"\x8d\x01\n" +
 	"\x19GetCalendarEventsResponse\x124\n" +
-	"\x06events\x18\x01 \x03(\v2\x1c.hr.service.v1.CalendarEventR\x06events*\x93\x02\n" +
+	"\x06events\x18\x01 \x03(\v2\x1c.hr.service.v1.CalendarEventR\x06events\x12:\n" +
+	"\bholidays\x18\x02 \x03(\v2\x1e.hr.service.v1.CalendarHolidayR\bholidays\"\xe4\x0f\n" +
+	"\x0eLeaveAmendment\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12 \n" +
+	"\ttenant_id\x18\x02 \x01(\rH\x01R\btenantId\x88\x01\x01\x12-\n" +
+	"\x10leave_request_id\x18\x03 \x01(\tH\x02R\x0eleaveRequestId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x04 \x01(\rH\x03R\x06userId\x88\x01\x01\x12@\n" +
+	"\x06status\x18\x05 \x01(\x0e2#.hr.service.v1.LeaveAmendmentStatusH\x04R\x06status\x88\x01\x01\x12:\n" +
+	"\x04kind\x18\x1c \x01(\x0e2!.hr.service.v1.LeaveAmendmentKindH\x05R\x04kind\x88\x01\x01\x12O\n" +
+	"\x13previous_start_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x06R\x11previousStartDate\x88\x01\x01\x12K\n" +
+	"\x11previous_end_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\aR\x0fpreviousEndDate\x88\x01\x01\x12R\n" +
+	"\x17previous_start_day_part\x18\b \x01(\x0e2\x16.hr.service.v1.DayPartH\bR\x14previousStartDayPart\x88\x01\x01\x12N\n" +
+	"\x15previous_end_day_part\x18\t \x01(\x0e2\x16.hr.service.v1.DayPartH\tR\x12previousEndDayPart\x88\x01\x01\x12(\n" +
+	"\rprevious_days\x18\n" +
+	" \x01(\x01H\n" +
+	"R\fpreviousDays\x88\x01\x01\x12>\n" +
+	"\n" +
+	"start_date\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\vR\tstartDate\x88\x01\x01\x12:\n" +
+	"\bend_date\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\fR\aendDate\x88\x01\x01\x12A\n" +
+	"\x0estart_day_part\x18\r \x01(\x0e2\x16.hr.service.v1.DayPartH\rR\fstartDayPart\x88\x01\x01\x12=\n" +
+	"\fend_day_part\x18\x0e \x01(\x0e2\x16.hr.service.v1.DayPartH\x0eR\n" +
+	"endDayPart\x88\x01\x01\x12\x19\n" +
+	"\x05hours\x18\x0f \x01(\x01H\x0fR\x05hours\x88\x01\x01\x12\x17\n" +
+	"\x04days\x18\x10 \x01(\x01H\x10R\x04days\x88\x01\x01\x123\n" +
+	"\x13holiday_calendar_id\x18\x11 \x01(\tH\x11R\x11holidayCalendarId\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x12 \x01(\tH\x12R\x06reason\x88\x01\x01\x12$\n" +
+	"\vreviewed_by\x18\x13 \x01(\rH\x13R\n" +
+	"reviewedBy\x88\x01\x01\x12(\n" +
+	"\rreviewer_name\x18\x14 \x01(\tH\x14R\freviewerName\x88\x01\x01\x12@\n" +
+	"\vreviewed_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\x15R\n" +
+	"reviewedAt\x88\x01\x01\x12&\n" +
+	"\freview_notes\x18\x16 \x01(\tH\x16R\vreviewNotes\x88\x01\x01\x121\n" +
+	"\x12signing_request_id\x18\x17 \x01(\tH\x17R\x10signingRequestId\x88\x01\x01\x12K\n" +
+	"\x0fpolicy_override\x18\x1d \x01(\v2\x1d.hr.service.v1.PolicyOverrideH\x18R\x0epolicyOverride\x88\x01\x01\x12>\n" +
+	"\n" +
+	"created_at\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampH\x19R\tcreatedAt\x88\x01\x01\x12>\n" +
+	"\n" +
+	"updated_at\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampH\x1aR\tupdatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"created_by\x18\x1a \x01(\rH\x1bR\tcreatedBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_by\x18\x1b \x01(\rH\x1cR\tupdatedBy\x88\x01\x01B\x05\n" +
+	"\x03_idB\f\n" +
+	"\n" +
+	"_tenant_idB\x13\n" +
+	"\x11_leave_request_idB\n" +
+	"\n" +
+	"\b_user_idB\t\n" +
+	"\a_statusB\a\n" +
+	"\x05_kindB\x16\n" +
+	"\x14_previous_start_dateB\x14\n" +
+	"\x12_previous_end_dateB\x1a\n" +
+	"\x18_previous_start_day_partB\x18\n" +
+	"\x16_previous_end_day_partB\x10\n" +
+	"\x0e_previous_daysB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\x11\n" +
+	"\x0f_start_day_partB\x0f\n" +
+	"\r_end_day_partB\b\n" +
+	"\x06_hoursB\a\n" +
+	"\x05_daysB\x16\n" +
+	"\x14_holiday_calendar_idB\t\n" +
+	"\a_reasonB\x0e\n" +
+	"\f_reviewed_byB\x10\n" +
+	"\x0e_reviewer_nameB\x0e\n" +
+	"\f_reviewed_atB\x0f\n" +
+	"\r_review_notesB\x15\n" +
+	"\x13_signing_request_idB\x12\n" +
+	"\x10_policy_overrideB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_atB\r\n" +
+	"\v_created_byB\r\n" +
+	"\v_updated_by\"\xf7\x03\n" +
+	"\x17ChangeLeaveDatesRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\x12>\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\tstartDate\x12:\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\aendDate\x12A\n" +
+	"\x0estart_day_part\x18\x04 \x01(\x0e2\x16.hr.service.v1.DayPartH\x00R\fstartDayPart\x88\x01\x01\x12=\n" +
+	"\fend_day_part\x18\x05 \x01(\x0e2\x16.hr.service.v1.DayPartH\x01R\n" +
+	"endDayPart\x88\x01\x01\x12\x17\n" +
+	"\x04days\x18\x06 \x01(\x01H\x02R\x04days\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\a \x01(\tH\x03R\x06reason\x88\x01\x01\x129\n" +
+	"\x16policy_override_reason\x18\b \x01(\tH\x04R\x14policyOverrideReason\x88\x01\x01B\x11\n" +
+	"\x0f_start_day_partB\x0f\n" +
+	"\r_end_day_partB\a\n" +
+	"\x05_daysB\t\n" +
+	"\a_reasonB\x19\n" +
+	"\x17_policy_override_reason\"\x99\x01\n" +
+	"\x18ChangeLeaveDatesResponse\x12@\n" +
+	"\rleave_request\x18\x01 \x01(\v2\x1b.hr.service.v1.LeaveRequestR\fleaveRequest\x12;\n" +
+	"\tamendment\x18\x02 \x01(\v2\x1d.hr.service.v1.LeaveAmendmentR\tamendment\"R\n" +
+	"\x1aListLeaveAmendmentsRequest\x124\n" +
+	"\x10leave_request_id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x0eleaveRequestId\"R\n" +
+	"\x1bListLeaveAmendmentsResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.hr.service.v1.LeaveAmendmentR\x05items\"s\n" +
+	"\x1cApproveLeaveAmendmentRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\x12&\n" +
+	"\freview_notes\x18\x02 \x01(\tH\x00R\vreviewNotes\x88\x01\x01B\x0f\n" +
+	"\r_review_notes\"\xec\x01\n" +
+	"\x1dApproveLeaveAmendmentResponse\x12@\n" +
+	"\rleave_request\x18\x01 \x01(\v2\x1b.hr.service.v1.LeaveRequestR\fleaveRequest\x12;\n" +
+	"\tamendment\x18\x02 \x01(\v2\x1d.hr.service.v1.LeaveAmendmentR\tamendment\x12L\n" +
+	"\x11coverage_warnings\x18\x03 \x03(\v2\x1f.hr.service.v1.CoverageConflictR\x10coverageWarnings\"r\n" +
+	"\x1bRejectLeaveAmendmentRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\x12&\n" +
+	"\freview_notes\x18\x02 \x01(\tH\x00R\vreviewNotes\x88\x01\x01B\x0f\n" +
+	"\r_review_notes\"[\n" +
+	"\x1cRejectLeaveAmendmentResponse\x12;\n" +
+	"\tamendment\x18\x01 \x01(\v2\x1d.hr.service.v1.LeaveAmendmentR\tamendment\"\xec\x01\n" +
+	"\x1aShortenLeaveRequestRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\x12:\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\aendDate\x12=\n" +
+	"\fend_day_part\x18\x03 \x01(\x0e2\x16.hr.service.v1.DayPartH\x00R\n" +
+	"endDayPart\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x04 \x01(\tH\x01R\x06reason\x88\x01\x01B\x0f\n" +
+	"\r_end_day_partB\t\n" +
+	"\a_reason\"\x9c\x01\n" +
+	"\x1bShortenLeaveRequestResponse\x12@\n" +
+	"\rleave_request\x18\x01 \x01(\v2\x1b.hr.service.v1.LeaveRequestR\fleaveRequest\x12;\n" +
+	"\tamendment\x18\x02 \x01(\v2\x1d.hr.service.v1.LeaveAmendmentR\tamendment\"5\n" +
+	"\x17GetLeaveCoverageRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xe0A\x02\xbaH\x04r\x02\x10\x01R\x02id\"Y\n" +
+	"\x18GetLeaveCoverageResponse\x12=\n" +
+	"\tconflicts\x18\x01 \x03(\v2\x1f.hr.service.v1.CoverageConflictR\tconflicts*\x93\x02\n" +
 	"\x12LeaveRequestStatus\x12$\n" +
 	" LEAVE_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cLEAVE_REQUEST_STATUS_PENDING\x10\x01\x12!\n" +
@@ -1871,19 +4675,57 @@ const file_hr_service_v1_leave_proto_rawDesc = "" +
 	"\x1dLEAVE_REQUEST_STATUS_REJECTED\x10\x03\x12\"\n" +
 	"\x1eLEAVE_REQUEST_STATUS_CANCELLED\x10\x04\x12)\n" +
 	"%LEAVE_REQUEST_STATUS_AWAITING_SIGNING\x10\x05\x12 \n" +
-	"\x1cLEAVE_REQUEST_STATUS_REVOKED\x10\x062\xbc\f\n" +
+	"\x1cLEAVE_REQUEST_STATUS_REVOKED\x10\x06*\xaa\x01\n" +
+	"\x0eSigningOutcome\x12\x1f\n" +
+	"\x1bSIGNING_OUTCOME_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19SIGNING_OUTCOME_COMPLETED\x10\x01\x12\x1c\n" +
+	"\x18SIGNING_OUTCOME_DECLINED\x10\x02\x12\x1b\n" +
+	"\x17SIGNING_OUTCOME_EXPIRED\x10\x03\x12\x1d\n" +
+	"\x19SIGNING_OUTCOME_CANCELLED\x10\x04*E\n" +
+	"\aDayPart\x12\x18\n" +
+	"\x14DAY_PART_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vDAY_PART_AM\x10\x01\x12\x0f\n" +
+	"\vDAY_PART_PM\x10\x02*\xfe\x01\n" +
+	"\x14LeaveAmendmentStatus\x12&\n" +
+	"\"LEAVE_AMENDMENT_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eLEAVE_AMENDMENT_STATUS_PENDING\x10\x01\x12+\n" +
+	"'LEAVE_AMENDMENT_STATUS_AWAITING_SIGNING\x10\x02\x12\"\n" +
+	"\x1eLEAVE_AMENDMENT_STATUS_APPLIED\x10\x03\x12#\n" +
+	"\x1fLEAVE_AMENDMENT_STATUS_REJECTED\x10\x04\x12$\n" +
+	" LEAVE_AMENDMENT_STATUS_CANCELLED\x10\x05*\x82\x01\n" +
+	"\x12LeaveAmendmentKind\x12$\n" +
+	" LEAVE_AMENDMENT_KIND_UNSPECIFIED\x10\x00\x12$\n" +
+	" LEAVE_AMENDMENT_KIND_DATE_CHANGE\x10\x01\x12 \n" +
+	"\x1cLEAVE_AMENDMENT_KIND_SHORTEN\x10\x022\xe3!\n" +
 	"\x0eHrLeaveService\x12\x88\x01\n" +
 	"\x12CreateLeaveRequest\x12(.hr.service.v1.CreateLeaveRequestRequest\x1a).hr.service.v1.CreateLeaveRequestResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/leave-requests\x12\x81\x01\n" +
 	"\x0fGetLeaveRequest\x12%.hr.service.v1.GetLeaveRequestRequest\x1a&.hr.service.v1.GetLeaveRequestResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/leave-requests/{id}\x12\x82\x01\n" +
-	"\x11ListLeaveRequests\x12'.hr.service.v1.ListLeaveRequestsRequest\x1a(.hr.service.v1.ListLeaveRequestsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/leave-requests\x12\x8d\x01\n" +
+	"\x11ListLeaveRequests\x12'.hr.service.v1.ListLeaveRequestsRequest\x1a(.hr.service.v1.ListLeaveRequestsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/leave-requests\x12\x92\x01\n" +
+	"\x15ListAssignedApprovals\x12+.hr.service.v1.ListAssignedApprovalsRequest\x1a,.hr.service.v1.ListAssignedApprovalsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/assigned-approvals\x12\x8d\x01\n" +
 	"\x12UpdateLeaveRequest\x12(.hr.service.v1.UpdateLeaveRequestRequest\x1a).hr.service.v1.UpdateLeaveRequestResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/v1/leave-requests/{id}\x12w\n" +
 	"\x12DeleteLeaveRequest\x12(.hr.service.v1.DeleteLeaveRequestRequest\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/leave-requests/{id}\x12\x98\x01\n" +
 	"\x13ApproveLeaveRequest\x12).hr.service.v1.ApproveLeaveRequestRequest\x1a*.hr.service.v1.ApproveLeaveRequestResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/leave-requests/{id}/approve\x12\x94\x01\n" +
 	"\x12RejectLeaveRequest\x12(.hr.service.v1.RejectLeaveRequestRequest\x1a).hr.service.v1.RejectLeaveRequestResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/leave-requests/{id}/reject\x12\x94\x01\n" +
 	"\x12CancelLeaveRequest\x12(.hr.service.v1.CancelLeaveRequestRequest\x1a).hr.service.v1.CancelLeaveRequestResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/leave-requests/{id}/cancel\x12\x94\x01\n" +
-	"\x12RevokeLeaveRequest\x12(.hr.service.v1.RevokeLeaveRequestRequest\x1a).hr.service.v1.RevokeLeaveRequestResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/leave-requests/{id}/revoke\x12|\n" +
+	"\x12RevokeLeaveRequest\x12(.hr.service.v1.RevokeLeaveRequestRequest\x1a).hr.service.v1.RevokeLeaveRequestResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/leave-requests/{id}/revoke\x12\x9f\x01\n" +
+	"\x18BulkApproveLeaveRequests\x12..hr.service.v1.BulkApproveLeaveRequestsRequest\x1a(.hr.service.v1.BulkLeaveRequestsResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/leave-requests:bulkApprove\x12\x9c\x01\n" +
+	"\x17BulkRejectLeaveRequests\x12-.hr.service.v1.BulkRejectLeaveRequestsRequest\x1a(.hr.service.v1.BulkLeaveRequestsResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/leave-requests:bulkReject\x12\x9c\x01\n" +
+	"\x17BulkRevokeLeaveRequests\x12-.hr.service.v1.BulkRevokeLeaveRequestsRequest\x1a(.hr.service.v1.BulkLeaveRequestsResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/leave-requests:bulkRevoke\x12\xad\x01\n" +
+	"\x1aCancelFollowingOccurrences\x120.hr.service.v1.CancelFollowingOccurrencesRequest\x1a(.hr.service.v1.BulkLeaveRequestsResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/leave-requests/{id}/cancel-following\x12\xaf\x01\n" +
+	"\x1aUpdateFollowingOccurrences\x120.hr.service.v1.UpdateFollowingOccurrencesRequest\x1a1.hr.service.v1.UpdateFollowingOccurrencesResponse\",\x82\xd3\xe4\x93\x02&:\x01*\x1a!/v1/leave-requests/{id}/following\x12\x99\x01\n" +
+	"\x12ApproveLeaveSeries\x12(.hr.service.v1.ApproveLeaveSeriesRequest\x1a(.hr.service.v1.BulkLeaveRequestsResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/leave-series/{series_id}/approve\x12\x96\x01\n" +
+	"\x11RejectLeaveSeries\x12'.hr.service.v1.RejectLeaveSeriesRequest\x1a(.hr.service.v1.BulkLeaveRequestsResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/leave-series/{series_id}/reject\x12|\n" +
 	"\x11GetCalendarEvents\x12'.hr.service.v1.GetCalendarEventsRequest\x1a(.hr.service.v1.GetCalendarEventsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/calendar\x12\xae\x01\n" +
-	"\x14GetSignedDocumentUrl\x12*.hr.service.v1.GetSignedDocumentUrlRequest\x1a+.hr.service.v1.GetSignedDocumentUrlResponse\"=\x82\xd3\xe4\x93\x027\x125/v1/leave-requests/{leave_request_id}/signed-documentB\xb2\x01\n" +
+	"\x14GetSignedDocumentUrl\x12*.hr.service.v1.GetSignedDocumentUrlRequest\x1a+.hr.service.v1.GetSignedDocumentUrlResponse\"=\x82\xd3\xe4\x93\x027\x125/v1/leave-requests/{leave_request_id}/signed-document\x12\x94\x01\n" +
+	"\x10ChangeLeaveDates\x12&.hr.service.v1.ChangeLeaveDatesRequest\x1a'.hr.service.v1.ChangeLeaveDatesResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/leave-requests/{id}/change-dates\x12\xa6\x01\n" +
+	"\x13ListLeaveAmendments\x12).hr.service.v1.ListLeaveAmendmentsRequest\x1a*.hr.service.v1.ListLeaveAmendmentsResponse\"8\x82\xd3\xe4\x93\x022\x120/v1/leave-requests/{leave_request_id}/amendments\x12\xa0\x01\n" +
+	"\x15ApproveLeaveAmendment\x12+.hr.service.v1.ApproveLeaveAmendmentRequest\x1a,.hr.service.v1.ApproveLeaveAmendmentResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/leave-amendments/{id}/approve\x12\x9c\x01\n" +
+	"\x14RejectLeaveAmendment\x12*.hr.service.v1.RejectLeaveAmendmentRequest\x1a+.hr.service.v1.RejectLeaveAmendmentResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/leave-amendments/{id}/reject\x12\x98\x01\n" +
+	"\x13ShortenLeaveRequest\x12).hr.service.v1.ShortenLeaveRequestRequest\x1a*.hr.service.v1.ShortenLeaveRequestResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/leave-requests/{id}/shorten\x12\x8d\x01\n" +
+	"\x10GetLeaveCoverage\x12&.hr.service.v1.GetLeaveCoverageRequest\x1a'.hr.service.v1.GetLeaveCoverageResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/leave-requests/{id}/coverage\x12\x9e\x01\n" +
+	"\x10PostLeaveComment\x12&.hr.service.v1.PostLeaveCommentRequest\x1a'.hr.service.v1.PostLeaveCommentResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./v1/leave-requests/{leave_request_id}/comments\x12\x9e\x01\n" +
+	"\x11ListLeaveComments\x12'.hr.service.v1.ListLeaveCommentsRequest\x1a(.hr.service.v1.ListLeaveCommentsResponse\"6\x82\xd3\xe4\x93\x020\x12./v1/leave-requests/{leave_request_id}/comments\x12\xa9\x01\n" +
+	"\x12UpdateLeaveComment\x12(.hr.service.v1.UpdateLeaveCommentRequest\x1a).hr.service.v1.UpdateLeaveCommentResponse\">\x82\xd3\xe4\x93\x028:\x01*\x1a3/v1/leave-requests/{leave_request_id}/comments/{id}B\xb2\x01\n" +
 	"\x11com.hr.service.v1B\n" +
 	"LeaveProtoP\x01Z;github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1;hrpb\xa2\x02\x03HSX\xaa\x02\rHr.Service.V1\xca\x02\rHr\\Service\\V1\xe2\x02\x19Hr\\Service\\V1\\GPBMetadata\xea\x02\x0fHr::Service::V1b\x06proto3"
 
@@ -1899,91 +4741,232 @@ func file_hr_service_v1_leave_proto_rawDescGZIP() []byte {
 	return file_hr_service_v1_leave_proto_rawDescData
 }
 
-var file_hr_service_v1_leave_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_hr_service_v1_leave_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_hr_service_v1_leave_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_hr_service_v1_leave_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_hr_service_v1_leave_proto_goTypes = []any{
-	(LeaveRequestStatus)(0),              // 0: hr.service.v1.LeaveRequestStatus
-	(*LeaveRequest)(nil),                 // 1: hr.service.v1.LeaveRequest
-	(*CreateLeaveRequestRequest)(nil),    // 2: hr.service.v1.CreateLeaveRequestRequest
-	(*CreateLeaveRequestResponse)(nil),   // 3: hr.service.v1.CreateLeaveRequestResponse
-	(*GetLeaveRequestRequest)(nil),       // 4: hr.service.v1.GetLeaveRequestRequest
-	(*GetLeaveRequestResponse)(nil),      // 5: hr.service.v1.GetLeaveRequestResponse
-	(*ListLeaveRequestsRequest)(nil),     // 6: hr.service.v1.ListLeaveRequestsRequest
-	(*ListLeaveRequestsResponse)(nil),    // 7: hr.service.v1.ListLeaveRequestsResponse
-	(*UpdateLeaveRequestRequest)(nil),    // 8: hr.service.v1.UpdateLeaveRequestRequest
-	(*UpdateLeaveRequestResponse)(nil),   // 9: hr.service.v1.UpdateLeaveRequestResponse
-	(*DeleteLeaveRequestRequest)(nil),    // 10: hr.service.v1.DeleteLeaveRequestRequest
-	(*ApproveLeaveRequestRequest)(nil),   // 11: hr.service.v1.ApproveLeaveRequestRequest
-	(*ApproveLeaveRequestResponse)(nil),  // 12: hr.service.v1.ApproveLeaveRequestResponse
-	(*RejectLeaveRequestRequest)(nil),    // 13: hr.service.v1.RejectLeaveRequestRequest
-	(*RejectLeaveRequestResponse)(nil),   // 14: hr.service.v1.RejectLeaveRequestResponse
-	(*CancelLeaveRequestRequest)(nil),    // 15: hr.service.v1.CancelLeaveRequestRequest
-	(*CancelLeaveRequestResponse)(nil),   // 16: hr.service.v1.CancelLeaveRequestResponse
-	(*RevokeLeaveRequestRequest)(nil),    // 17: hr.service.v1.RevokeLeaveRequestRequest
-	(*RevokeLeaveRequestResponse)(nil),   // 18: hr.service.v1.RevokeLeaveRequestResponse
-	(*CalendarEvent)(nil),                // 19: hr.service.v1.CalendarEvent
-	(*GetSignedDocumentUrlRequest)(nil),  // 20: hr.service.v1.GetSignedDocumentUrlRequest
-	(*GetSignedDocumentUrlResponse)(nil), // 21: hr.service.v1.GetSignedDocumentUrlResponse
-	(*GetCalendarEventsRequest)(nil),     // 22: hr.service.v1.GetCalendarEventsRequest
-	(*GetCalendarEventsResponse)(nil),    // 23: hr.service.v1.GetCalendarEventsResponse
-	(*timestamppb.Timestamp)(nil),        // 24: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),              // 25: google.protobuf.Struct
-	(*fieldmaskpb.FieldMask)(nil),        // 26: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                // 27: google.protobuf.Empty
+	(LeaveRequestStatus)(0),                    // 0: hr.service.v1.LeaveRequestStatus
+	(SigningOutcome)(0),                        // 1: hr.service.v1.SigningOutcome
+	(DayPart)(0),                               // 2: hr.service.v1.DayPart
+	(LeaveAmendmentStatus)(0),                  // 3: hr.service.v1.LeaveAmendmentStatus
+	(LeaveAmendmentKind)(0),                    // 4: hr.service.v1.LeaveAmendmentKind
+	(*LeaveDeduction)(nil),                     // 5: hr.service.v1.LeaveDeduction
+	(*LeaveRequest)(nil),                       // 6: hr.service.v1.LeaveRequest
+	(*CreateLeaveRequestRequest)(nil),          // 7: hr.service.v1.CreateLeaveRequestRequest
+	(*CreateLeaveRequestResponse)(nil),         // 8: hr.service.v1.CreateLeaveRequestResponse
+	(*LeaveOccurrenceResult)(nil),              // 9: hr.service.v1.LeaveOccurrenceResult
+	(*GetLeaveRequestRequest)(nil),             // 10: hr.service.v1.GetLeaveRequestRequest
+	(*GetLeaveRequestResponse)(nil),            // 11: hr.service.v1.GetLeaveRequestResponse
+	(*ListLeaveRequestsRequest)(nil),           // 12: hr.service.v1.ListLeaveRequestsRequest
+	(*ListLeaveRequestsResponse)(nil),          // 13: hr.service.v1.ListLeaveRequestsResponse
+	(*ListAssignedApprovalsRequest)(nil),       // 14: hr.service.v1.ListAssignedApprovalsRequest
+	(*ListAssignedApprovalsResponse)(nil),      // 15: hr.service.v1.ListAssignedApprovalsResponse
+	(*UpdateLeaveRequestRequest)(nil),          // 16: hr.service.v1.UpdateLeaveRequestRequest
+	(*UpdateLeaveRequestResponse)(nil),         // 17: hr.service.v1.UpdateLeaveRequestResponse
+	(*DeleteLeaveRequestRequest)(nil),          // 18: hr.service.v1.DeleteLeaveRequestRequest
+	(*ApproveLeaveRequestRequest)(nil),         // 19: hr.service.v1.ApproveLeaveRequestRequest
+	(*ApproveLeaveRequestResponse)(nil),        // 20: hr.service.v1.ApproveLeaveRequestResponse
+	(*RejectLeaveRequestRequest)(nil),          // 21: hr.service.v1.RejectLeaveRequestRequest
+	(*RejectLeaveRequestResponse)(nil),         // 22: hr.service.v1.RejectLeaveRequestResponse
+	(*CancelLeaveRequestRequest)(nil),          // 23: hr.service.v1.CancelLeaveRequestRequest
+	(*CancelLeaveRequestResponse)(nil),         // 24: hr.service.v1.CancelLeaveRequestResponse
+	(*RevokeLeaveRequestRequest)(nil),          // 25: hr.service.v1.RevokeLeaveRequestRequest
+	(*RevokeLeaveRequestResponse)(nil),         // 26: hr.service.v1.RevokeLeaveRequestResponse
+	(*LeaveRequestSelection)(nil),              // 27: hr.service.v1.LeaveRequestSelection
+	(*BulkApproveLeaveRequestsRequest)(nil),    // 28: hr.service.v1.BulkApproveLeaveRequestsRequest
+	(*BulkRejectLeaveRequestsRequest)(nil),     // 29: hr.service.v1.BulkRejectLeaveRequestsRequest
+	(*BulkRevokeLeaveRequestsRequest)(nil),     // 30: hr.service.v1.BulkRevokeLeaveRequestsRequest
+	(*BulkLeaveRequestResult)(nil),             // 31: hr.service.v1.BulkLeaveRequestResult
+	(*BulkLeaveRequestsResponse)(nil),          // 32: hr.service.v1.BulkLeaveRequestsResponse
+	(*CancelFollowingOccurrencesRequest)(nil),  // 33: hr.service.v1.CancelFollowingOccurrencesRequest
+	(*UpdateFollowingOccurrencesRequest)(nil),  // 34: hr.service.v1.UpdateFollowingOccurrencesRequest
+	(*UpdateFollowingOccurrencesResponse)(nil), // 35: hr.service.v1.UpdateFollowingOccurrencesResponse
+	(*ApproveLeaveSeriesRequest)(nil),          // 36: hr.service.v1.ApproveLeaveSeriesRequest
+	(*RejectLeaveSeriesRequest)(nil),           // 37: hr.service.v1.RejectLeaveSeriesRequest
+	(*CalendarEvent)(nil),                      // 38: hr.service.v1.CalendarEvent
+	(*GetSignedDocumentUrlRequest)(nil),        // 39: hr.service.v1.GetSignedDocumentUrlRequest
+	(*GetSignedDocumentUrlResponse)(nil),       // 40: hr.service.v1.GetSignedDocumentUrlResponse
+	(*GetCalendarEventsRequest)(nil),           // 41: hr.service.v1.GetCalendarEventsRequest
+	(*CalendarHoliday)(nil),                    // 42: hr.service.v1.CalendarHoliday
+	(*GetCalendarEventsResponse)(nil),          // 43: hr.service.v1.GetCalendarEventsResponse
+	(*LeaveAmendment)(nil),                     // 44: hr.service.v1.LeaveAmendment
+	(*ChangeLeaveDatesRequest)(nil),            // 45: hr.service.v1.ChangeLeaveDatesRequest
+	(*ChangeLeaveDatesResponse)(nil),           // 46: hr.service.v1.ChangeLeaveDatesResponse
+	(*ListLeaveAmendmentsRequest)(nil),         // 47: hr.service.v1.ListLeaveAmendmentsRequest
+	(*ListLeaveAmendmentsResponse)(nil),        // 48: hr.service.v1.ListLeaveAmendmentsResponse
+	(*ApproveLeaveAmendmentRequest)(nil),       // 49: hr.service.v1.ApproveLeaveAmendmentRequest
+	(*ApproveLeaveAmendmentResponse)(nil),      // 50: hr.service.v1.ApproveLeaveAmendmentResponse
+	(*RejectLeaveAmendmentRequest)(nil),        // 51: hr.service.v1.RejectLeaveAmendmentRequest
+	(*RejectLeaveAmendmentResponse)(nil),       // 52: hr.service.v1.RejectLeaveAmendmentResponse
+	(*ShortenLeaveRequestRequest)(nil),         // 53: hr.service.v1.ShortenLeaveRequestRequest
+	(*ShortenLeaveRequestResponse)(nil),        // 54: hr.service.v1.ShortenLeaveRequestResponse
+	(*GetLeaveCoverageRequest)(nil),            // 55: hr.service.v1.GetLeaveCoverageRequest
+	(*GetLeaveCoverageResponse)(nil),           // 56: hr.service.v1.GetLeaveCoverageResponse
+	(*timestamppb.Timestamp)(nil),              // 57: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                    // 58: google.protobuf.Struct
+	(*LeaveApproval)(nil),                      // 59: hr.service.v1.LeaveApproval
+	(*PolicyOverride)(nil),                     // 60: hr.service.v1.PolicyOverride
+	(*RecurrenceRule)(nil),                     // 61: hr.service.v1.RecurrenceRule
+	(*CoverageConflict)(nil),                   // 62: hr.service.v1.CoverageConflict
+	(*fieldmaskpb.FieldMask)(nil),              // 63: google.protobuf.FieldMask
+	(*PostLeaveCommentRequest)(nil),            // 64: hr.service.v1.PostLeaveCommentRequest
+	(*ListLeaveCommentsRequest)(nil),           // 65: hr.service.v1.ListLeaveCommentsRequest
+	(*UpdateLeaveCommentRequest)(nil),          // 66: hr.service.v1.UpdateLeaveCommentRequest
+	(*emptypb.Empty)(nil),                      // 67: google.protobuf.Empty
+	(*PostLeaveCommentResponse)(nil),           // 68: hr.service.v1.PostLeaveCommentResponse
+	(*ListLeaveCommentsResponse)(nil),          // 69: hr.service.v1.ListLeaveCommentsResponse
+	(*UpdateLeaveCommentResponse)(nil),         // 70: hr.service.v1.UpdateLeaveCommentResponse
 }
 var file_hr_service_v1_leave_proto_depIdxs = []int32{
-	24, // 0: hr.service.v1.LeaveRequest.start_date:type_name -> google.protobuf.Timestamp
-	24, // 1: hr.service.v1.LeaveRequest.end_date:type_name -> google.protobuf.Timestamp
-	0,  // 2: hr.service.v1.LeaveRequest.status:type_name -> hr.service.v1.LeaveRequestStatus
-	24, // 3: hr.service.v1.LeaveRequest.reviewed_at:type_name -> google.protobuf.Timestamp
-	25, // 4: hr.service.v1.LeaveRequest.metadata:type_name -> google.protobuf.Struct
-	24, // 5: hr.service.v1.LeaveRequest.created_at:type_name -> google.protobuf.Timestamp
-	24, // 6: hr.service.v1.LeaveRequest.updated_at:type_name -> google.protobuf.Timestamp
-	24, // 7: hr.service.v1.CreateLeaveRequestRequest.start_date:type_name -> google.protobuf.Timestamp
-	24, // 8: hr.service.v1.CreateLeaveRequestRequest.end_date:type_name -> google.protobuf.Timestamp
-	25, // 9: hr.service.v1.CreateLeaveRequestRequest.metadata:type_name -> google.protobuf.Struct
-	1,  // 10: hr.service.v1.CreateLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
-	1,  // 11: hr.service.v1.GetLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
-	0,  // 12: hr.service.v1.ListLeaveRequestsRequest.status:type_name -> hr.service.v1.LeaveRequestStatus
-	1,  // 13: hr.service.v1.ListLeaveRequestsResponse.items:type_name -> hr.service.v1.LeaveRequest
-	1,  // 14: hr.service.v1.UpdateLeaveRequestRequest.data:type_name -> hr.service.v1.LeaveRequest
-	26, // 15: hr.service.v1.UpdateLeaveRequestRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 16: hr.service.v1.UpdateLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
-	1,  // 17: hr.service.v1.ApproveLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
-	1,  // 18: hr.service.v1.RejectLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
-	1,  // 19: hr.service.v1.CancelLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
-	1,  // 20: hr.service.v1.RevokeLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
-	24, // 21: hr.service.v1.CalendarEvent.start_date:type_name -> google.protobuf.Timestamp
-	24, // 22: hr.service.v1.CalendarEvent.end_date:type_name -> google.protobuf.Timestamp
-	0,  // 23: hr.service.v1.CalendarEvent.status:type_name -> hr.service.v1.LeaveRequestStatus
-	19, // 24: hr.service.v1.GetCalendarEventsResponse.events:type_name -> hr.service.v1.CalendarEvent
-	2,  // 25: hr.service.v1.HrLeaveService.CreateLeaveRequest:input_type -> hr.service.v1.CreateLeaveRequestRequest
-	4,  // 26: hr.service.v1.HrLeaveService.GetLeaveRequest:input_type -> hr.service.v1.GetLeaveRequestRequest
-	6,  // 27: hr.service.v1.HrLeaveService.ListLeaveRequests:input_type -> hr.service.v1.ListLeaveRequestsRequest
-	8,  // 28: hr.service.v1.HrLeaveService.UpdateLeaveRequest:input_type -> hr.service.v1.UpdateLeaveRequestRequest
-	10, // 29: hr.service.v1.HrLeaveService.DeleteLeaveRequest:input_type -> hr.service.v1.DeleteLeaveRequestRequest
-	11, // 30: hr.service.v1.HrLeaveService.ApproveLeaveRequest:input_type -> hr.service.v1.ApproveLeaveRequestRequest
-	13, // 31: hr.service.v1.HrLeaveService.RejectLeaveRequest:input_type -> hr.service.v1.RejectLeaveRequestRequest
-	15, // 32: hr.service.v1.HrLeaveService.CancelLeaveRequest:input_type -> hr.service.v1.CancelLeaveRequestRequest
-	17, // 33: hr.service.v1.HrLeaveService.RevokeLeaveRequest:input_type -> hr.service.v1.RevokeLeaveRequestRequest
-	22, // 34: hr.service.v1.HrLeaveService.GetCalendarEvents:input_type -> hr.service.v1.GetCalendarEventsRequest
-	20, // 35: hr.service.v1.HrLeaveService.GetSignedDocumentUrl:input_type -> hr.service.v1.GetSignedDocumentUrlRequest
-	3,  // 36: hr.service.v1.HrLeaveService.CreateLeaveRequest:output_type -> hr.service.v1.CreateLeaveRequestResponse
-	5,  // 37: hr.service.v1.HrLeaveService.GetLeaveRequest:output_type -> hr.service.v1.GetLeaveRequestResponse
-	7,  // 38: hr.service.v1.HrLeaveService.ListLeaveRequests:output_type -> hr.service.v1.ListLeaveRequestsResponse
-	9,  // 39: hr.service.v1.HrLeaveService.UpdateLeaveRequest:output_type -> hr.service.v1.UpdateLeaveRequestResponse
-	27, // 40: hr.service.v1.HrLeaveService.DeleteLeaveRequest:output_type -> google.protobuf.Empty
-	12, // 41: hr.service.v1.HrLeaveService.ApproveLeaveRequest:output_type -> hr.service.v1.ApproveLeaveRequestResponse
-	14, // 42: hr.service.v1.HrLeaveService.RejectLeaveRequest:output_type -> hr.service.v1.RejectLeaveRequestResponse
-	16, // 43: hr.service.v1.HrLeaveService.CancelLeaveRequest:output_type -> hr.service.v1.CancelLeaveRequestResponse
-	18, // 44: hr.service.v1.HrLeaveService.RevokeLeaveRequest:output_type -> hr.service.v1.RevokeLeaveRequestResponse
-	23, // 45: hr.service.v1.HrLeaveService.GetCalendarEvents:output_type -> hr.service.v1.GetCalendarEventsResponse
-	21, // 46: hr.service.v1.HrLeaveService.GetSignedDocumentUrl:output_type -> hr.service.v1.GetSignedDocumentUrlResponse
-	36, // [36:47] is the sub-list for method output_type
-	25, // [25:36] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	57,  // 0: hr.service.v1.LeaveRequest.start_date:type_name -> google.protobuf.Timestamp
+	57,  // 1: hr.service.v1.LeaveRequest.end_date:type_name -> google.protobuf.Timestamp
+	0,   // 2: hr.service.v1.LeaveRequest.status:type_name -> hr.service.v1.LeaveRequestStatus
+	57,  // 3: hr.service.v1.LeaveRequest.reviewed_at:type_name -> google.protobuf.Timestamp
+	58,  // 4: hr.service.v1.LeaveRequest.metadata:type_name -> google.protobuf.Struct
+	2,   // 5: hr.service.v1.LeaveRequest.start_day_part:type_name -> hr.service.v1.DayPart
+	2,   // 6: hr.service.v1.LeaveRequest.end_day_part:type_name -> hr.service.v1.DayPart
+	5,   // 7: hr.service.v1.LeaveRequest.deductions:type_name -> hr.service.v1.LeaveDeduction
+	59,  // 8: hr.service.v1.LeaveRequest.approvals:type_name -> hr.service.v1.LeaveApproval
+	57,  // 9: hr.service.v1.LeaveRequest.awaiting_since:type_name -> google.protobuf.Timestamp
+	60,  // 10: hr.service.v1.LeaveRequest.policy_override:type_name -> hr.service.v1.PolicyOverride
+	57,  // 11: hr.service.v1.LeaveRequest.attachment_due_date:type_name -> google.protobuf.Timestamp
+	61,  // 12: hr.service.v1.LeaveRequest.recurrence:type_name -> hr.service.v1.RecurrenceRule
+	1,   // 13: hr.service.v1.LeaveRequest.signing_outcome:type_name -> hr.service.v1.SigningOutcome
+	57,  // 14: hr.service.v1.LeaveRequest.signing_ended_at:type_name -> google.protobuf.Timestamp
+	57,  // 15: hr.service.v1.LeaveRequest.created_at:type_name -> google.protobuf.Timestamp
+	57,  // 16: hr.service.v1.LeaveRequest.updated_at:type_name -> google.protobuf.Timestamp
+	57,  // 17: hr.service.v1.CreateLeaveRequestRequest.start_date:type_name -> google.protobuf.Timestamp
+	57,  // 18: hr.service.v1.CreateLeaveRequestRequest.end_date:type_name -> google.protobuf.Timestamp
+	58,  // 19: hr.service.v1.CreateLeaveRequestRequest.metadata:type_name -> google.protobuf.Struct
+	2,   // 20: hr.service.v1.CreateLeaveRequestRequest.start_day_part:type_name -> hr.service.v1.DayPart
+	2,   // 21: hr.service.v1.CreateLeaveRequestRequest.end_day_part:type_name -> hr.service.v1.DayPart
+	61,  // 22: hr.service.v1.CreateLeaveRequestRequest.recurrence:type_name -> hr.service.v1.RecurrenceRule
+	6,   // 23: hr.service.v1.CreateLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
+	62,  // 24: hr.service.v1.CreateLeaveRequestResponse.coverage_warnings:type_name -> hr.service.v1.CoverageConflict
+	9,   // 25: hr.service.v1.CreateLeaveRequestResponse.occurrences:type_name -> hr.service.v1.LeaveOccurrenceResult
+	57,  // 26: hr.service.v1.LeaveOccurrenceResult.start_date:type_name -> google.protobuf.Timestamp
+	6,   // 27: hr.service.v1.LeaveOccurrenceResult.leave_request:type_name -> hr.service.v1.LeaveRequest
+	62,  // 28: hr.service.v1.LeaveOccurrenceResult.coverage_warnings:type_name -> hr.service.v1.CoverageConflict
+	6,   // 29: hr.service.v1.GetLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
+	0,   // 30: hr.service.v1.ListLeaveRequestsRequest.status:type_name -> hr.service.v1.LeaveRequestStatus
+	6,   // 31: hr.service.v1.ListLeaveRequestsResponse.items:type_name -> hr.service.v1.LeaveRequest
+	6,   // 32: hr.service.v1.ListAssignedApprovalsResponse.items:type_name -> hr.service.v1.LeaveRequest
+	6,   // 33: hr.service.v1.UpdateLeaveRequestRequest.data:type_name -> hr.service.v1.LeaveRequest
+	63,  // 34: hr.service.v1.UpdateLeaveRequestRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6,   // 35: hr.service.v1.UpdateLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
+	6,   // 36: hr.service.v1.ApproveLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
+	62,  // 37: hr.service.v1.ApproveLeaveRequestResponse.coverage_warnings:type_name -> hr.service.v1.CoverageConflict
+	6,   // 38: hr.service.v1.RejectLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
+	6,   // 39: hr.service.v1.CancelLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
+	6,   // 40: hr.service.v1.RevokeLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
+	27,  // 41: hr.service.v1.BulkApproveLeaveRequestsRequest.selection:type_name -> hr.service.v1.LeaveRequestSelection
+	27,  // 42: hr.service.v1.BulkRejectLeaveRequestsRequest.selection:type_name -> hr.service.v1.LeaveRequestSelection
+	27,  // 43: hr.service.v1.BulkRevokeLeaveRequestsRequest.selection:type_name -> hr.service.v1.LeaveRequestSelection
+	6,   // 44: hr.service.v1.BulkLeaveRequestResult.leave_request:type_name -> hr.service.v1.LeaveRequest
+	62,  // 45: hr.service.v1.BulkLeaveRequestResult.coverage_warnings:type_name -> hr.service.v1.CoverageConflict
+	31,  // 46: hr.service.v1.BulkLeaveRequestsResponse.results:type_name -> hr.service.v1.BulkLeaveRequestResult
+	58,  // 47: hr.service.v1.UpdateFollowingOccurrencesRequest.metadata:type_name -> google.protobuf.Struct
+	61,  // 48: hr.service.v1.UpdateFollowingOccurrencesRequest.recurrence:type_name -> hr.service.v1.RecurrenceRule
+	31,  // 49: hr.service.v1.UpdateFollowingOccurrencesResponse.results:type_name -> hr.service.v1.BulkLeaveRequestResult
+	9,   // 50: hr.service.v1.UpdateFollowingOccurrencesResponse.occurrences:type_name -> hr.service.v1.LeaveOccurrenceResult
+	57,  // 51: hr.service.v1.CalendarEvent.start_date:type_name -> google.protobuf.Timestamp
+	57,  // 52: hr.service.v1.CalendarEvent.end_date:type_name -> google.protobuf.Timestamp
+	0,   // 53: hr.service.v1.CalendarEvent.status:type_name -> hr.service.v1.LeaveRequestStatus
+	2,   // 54: hr.service.v1.CalendarEvent.start_day_part:type_name -> hr.service.v1.DayPart
+	2,   // 55: hr.service.v1.CalendarEvent.end_day_part:type_name -> hr.service.v1.DayPart
+	57,  // 56: hr.service.v1.CalendarHoliday.date:type_name -> google.protobuf.Timestamp
+	38,  // 57: hr.service.v1.GetCalendarEventsResponse.events:type_name -> hr.service.v1.CalendarEvent
+	42,  // 58: hr.service.v1.GetCalendarEventsResponse.holidays:type_name -> hr.service.v1.CalendarHoliday
+	3,   // 59: hr.service.v1.LeaveAmendment.status:type_name -> hr.service.v1.LeaveAmendmentStatus
+	4,   // 60: hr.service.v1.LeaveAmendment.kind:type_name -> hr.service.v1.LeaveAmendmentKind
+	57,  // 61: hr.service.v1.LeaveAmendment.previous_start_date:type_name -> google.protobuf.Timestamp
+	57,  // 62: hr.service.v1.LeaveAmendment.previous_end_date:type_name -> google.protobuf.Timestamp
+	2,   // 63: hr.service.v1.LeaveAmendment.previous_start_day_part:type_name -> hr.service.v1.DayPart
+	2,   // 64: hr.service.v1.LeaveAmendment.previous_end_day_part:type_name -> hr.service.v1.DayPart
+	57,  // 65: hr.service.v1.LeaveAmendment.start_date:type_name -> google.protobuf.Timestamp
+	57,  // 66: hr.service.v1.LeaveAmendment.end_date:type_name -> google.protobuf.Timestamp
+	2,   // 67: hr.service.v1.LeaveAmendment.start_day_part:type_name -> hr.service.v1.DayPart
+	2,   // 68: hr.service.v1.LeaveAmendment.end_day_part:type_name -> hr.service.v1.DayPart
+	57,  // 69: hr.service.v1.LeaveAmendment.reviewed_at:type_name -> google.protobuf.Timestamp
+	60,  // 70: hr.service.v1.LeaveAmendment.policy_override:type_name -> hr.service.v1.PolicyOverride
+	57,  // 71: hr.service.v1.LeaveAmendment.created_at:type_name -> google.protobuf.Timestamp
+	57,  // 72: hr.service.v1.LeaveAmendment.updated_at:type_name -> google.protobuf.Timestamp
+	57,  // 73: hr.service.v1.ChangeLeaveDatesRequest.start_date:type_name -> google.protobuf.Timestamp
+	57,  // 74: hr.service.v1.ChangeLeaveDatesRequest.end_date:type_name -> google.protobuf.Timestamp
+	2,   // 75: hr.service.v1.ChangeLeaveDatesRequest.start_day_part:type_name -> hr.service.v1.DayPart
+	2,   // 76: hr.service.v1.ChangeLeaveDatesRequest.end_day_part:type_name -> hr.service.v1.DayPart
+	6,   // 77: hr.service.v1.ChangeLeaveDatesResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
+	44,  // 78: hr.service.v1.ChangeLeaveDatesResponse.amendment:type_name -> hr.service.v1.LeaveAmendment
+	44,  // 79: hr.service.v1.ListLeaveAmendmentsResponse.items:type_name -> hr.service.v1.LeaveAmendment
+	6,   // 80: hr.service.v1.ApproveLeaveAmendmentResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
+	44,  // 81: hr.service.v1.ApproveLeaveAmendmentResponse.amendment:type_name -> hr.service.v1.LeaveAmendment
+	62,  // 82: hr.service.v1.ApproveLeaveAmendmentResponse.coverage_warnings:type_name -> hr.service.v1.CoverageConflict
+	44,  // 83: hr.service.v1.RejectLeaveAmendmentResponse.amendment:type_name -> hr.service.v1.LeaveAmendment
+	57,  // 84: hr.service.v1.ShortenLeaveRequestRequest.end_date:type_name -> google.protobuf.Timestamp
+	2,   // 85: hr.service.v1.ShortenLeaveRequestRequest.end_day_part:type_name -> hr.service.v1.DayPart
+	6,   // 86: hr.service.v1.ShortenLeaveRequestResponse.leave_request:type_name -> hr.service.v1.LeaveRequest
+	44,  // 87: hr.service.v1.ShortenLeaveRequestResponse.amendment:type_name -> hr.service.v1.LeaveAmendment
+	62,  // 88: hr.service.v1.GetLeaveCoverageResponse.conflicts:type_name -> hr.service.v1.CoverageConflict
+	7,   // 89: hr.service.v1.HrLeaveService.CreateLeaveRequest:input_type -> hr.service.v1.CreateLeaveRequestRequest
+	10,  // 90: hr.service.v1.HrLeaveService.GetLeaveRequest:input_type -> hr.service.v1.GetLeaveRequestRequest
+	12,  // 91: hr.service.v1.HrLeaveService.ListLeaveRequests:input_type -> hr.service.v1.ListLeaveRequestsRequest
+	14,  // 92: hr.service.v1.HrLeaveService.ListAssignedApprovals:input_type -> hr.service.v1.ListAssignedApprovalsRequest
+	16,  // 93: hr.service.v1.HrLeaveService.UpdateLeaveRequest:input_type -> hr.service.v1.UpdateLeaveRequestRequest
+	18,  // 94: hr.service.v1.HrLeaveService.DeleteLeaveRequest:input_type -> hr.service.v1.DeleteLeaveRequestRequest
+	19,  // 95: hr.service.v1.HrLeaveService.ApproveLeaveRequest:input_type -> hr.service.v1.ApproveLeaveRequestRequest
+	21,  // 96: hr.service.v1.HrLeaveService.RejectLeaveRequest:input_type -> hr.service.v1.RejectLeaveRequestRequest
+	23,  // 97: hr.service.v1.HrLeaveService.CancelLeaveRequest:input_type -> hr.service.v1.CancelLeaveRequestRequest
+	25,  // 98: hr.service.v1.HrLeaveService.RevokeLeaveRequest:input_type -> hr.service.v1.RevokeLeaveRequestRequest
+	28,  // 99: hr.service.v1.HrLeaveService.BulkApproveLeaveRequests:input_type -> hr.service.v1.BulkApproveLeaveRequestsRequest
+	29,  // 100: hr.service.v1.HrLeaveService.BulkRejectLeaveRequests:input_type -> hr.service.v1.BulkRejectLeaveRequestsRequest
+	30,  // 101: hr.service.v1.HrLeaveService.BulkRevokeLeaveRequests:input_type -> hr.service.v1.BulkRevokeLeaveRequestsRequest
+	33,  // 102: hr.service.v1.HrLeaveService.CancelFollowingOccurrences:input_type -> hr.service.v1.CancelFollowingOccurrencesRequest
+	34,  // 103: hr.service.v1.HrLeaveService.UpdateFollowingOccurrences:input_type -> hr.service.v1.UpdateFollowingOccurrencesRequest
+	36,  // 104: hr.service.v1.HrLeaveService.ApproveLeaveSeries:input_type -> hr.service.v1.ApproveLeaveSeriesRequest
+	37,  // 105: hr.service.v1.HrLeaveService.RejectLeaveSeries:input_type -> hr.service.v1.RejectLeaveSeriesRequest
+	41,  // 106: hr.service.v1.HrLeaveService.GetCalendarEvents:input_type -> hr.service.v1.GetCalendarEventsRequest
+	39,  // 107: hr.service.v1.HrLeaveService.GetSignedDocumentUrl:input_type -> hr.service.v1.GetSignedDocumentUrlRequest
+	45,  // 108: hr.service.v1.HrLeaveService.ChangeLeaveDates:input_type -> hr.service.v1.ChangeLeaveDatesRequest
+	47,  // 109: hr.service.v1.HrLeaveService.ListLeaveAmendments:input_type -> hr.service.v1.ListLeaveAmendmentsRequest
+	49,  // 110: hr.service.v1.HrLeaveService.ApproveLeaveAmendment:input_type -> hr.service.v1.ApproveLeaveAmendmentRequest
+	51,  // 111: hr.service.v1.HrLeaveService.RejectLeaveAmendment:input_type -> hr.service.v1.RejectLeaveAmendmentRequest
+	53,  // 112: hr.service.v1.HrLeaveService.ShortenLeaveRequest:input_type -> hr.service.v1.ShortenLeaveRequestRequest
+	55,  // 113: hr.service.v1.HrLeaveService.GetLeaveCoverage:input_type -> hr.service.v1.GetLeaveCoverageRequest
+	64,  // 114: hr.service.v1.HrLeaveService.PostLeaveComment:input_type -> hr.service.v1.PostLeaveCommentRequest
+	65,  // 115: hr.service.v1.HrLeaveService.ListLeaveComments:input_type -> hr.service.v1.ListLeaveCommentsRequest
+	66,  // 116: hr.service.v1.HrLeaveService.UpdateLeaveComment:input_type -> hr.service.v1.UpdateLeaveCommentRequest
+	8,   // 117: hr.service.v1.HrLeaveService.CreateLeaveRequest:output_type -> hr.service.v1.CreateLeaveRequestResponse
+	11,  // 118: hr.service.v1.HrLeaveService.GetLeaveRequest:output_type -> hr.service.v1.GetLeaveRequestResponse
+	13,  // 119: hr.service.v1.HrLeaveService.ListLeaveRequests:output_type -> hr.service.v1.ListLeaveRequestsResponse
+	15,  // 120: hr.service.v1.HrLeaveService.ListAssignedApprovals:output_type -> hr.service.v1.ListAssignedApprovalsResponse
+	17,  // 121: hr.service.v1.HrLeaveService.UpdateLeaveRequest:output_type -> hr.service.v1.UpdateLeaveRequestResponse
+	67,  // 122: hr.service.v1.HrLeaveService.DeleteLeaveRequest:output_type -> google.protobuf.Empty
+	20,  // 123: hr.service.v1.HrLeaveService.ApproveLeaveRequest:output_type -> hr.service.v1.ApproveLeaveRequestResponse
+	22,  // 124: hr.service.v1.HrLeaveService.RejectLeaveRequest:output_type -> hr.service.v1.RejectLeaveRequestResponse
+	24,  // 125: hr.service.v1.HrLeaveService.CancelLeaveRequest:output_type -> hr.service.v1.CancelLeaveRequestResponse
+	26,  // 126: hr.service.v1.HrLeaveService.RevokeLeaveRequest:output_type -> hr.service.v1.RevokeLeaveRequestResponse
+	32,  // 127: hr.service.v1.HrLeaveService.BulkApproveLeaveRequests:output_type -> hr.service.v1.BulkLeaveRequestsResponse
+	32,  // 128: hr.service.v1.HrLeaveService.BulkRejectLeaveRequests:output_type -> hr.service.v1.BulkLeaveRequestsResponse
+	32,  // 129: hr.service.v1.HrLeaveService.BulkRevokeLeaveRequests:output_type -> hr.service.v1.BulkLeaveRequestsResponse
+	32,  // 130: hr.service.v1.HrLeaveService.CancelFollowingOccurrences:output_type -> hr.service.v1.BulkLeaveRequestsResponse
+	35,  // 131: hr.service.v1.HrLeaveService.UpdateFollowingOccurrences:output_type -> hr.service.v1.UpdateFollowingOccurrencesResponse
+	32,  // 132: hr.service.v1.HrLeaveService.ApproveLeaveSeries:output_type -> hr.service.v1.BulkLeaveRequestsResponse
+	32,  // 133: hr.service.v1.HrLeaveService.RejectLeaveSeries:output_type -> hr.service.v1.BulkLeaveRequestsResponse
+	43,  // 134: hr.service.v1.HrLeaveService.GetCalendarEvents:output_type -> hr.service.v1.GetCalendarEventsResponse
+	40,  // 135: hr.service.v1.HrLeaveService.GetSignedDocumentUrl:output_type -> hr.service.v1.GetSignedDocumentUrlResponse
+	46,  // 136: hr.service.v1.HrLeaveService.ChangeLeaveDates:output_type -> hr.service.v1.ChangeLeaveDatesResponse
+	48,  // 137: hr.service.v1.HrLeaveService.ListLeaveAmendments:output_type -> hr.service.v1.ListLeaveAmendmentsResponse
+	50,  // 138: hr.service.v1.HrLeaveService.ApproveLeaveAmendment:output_type -> hr.service.v1.ApproveLeaveAmendmentResponse
+	52,  // 139: hr.service.v1.HrLeaveService.RejectLeaveAmendment:output_type -> hr.service.v1.RejectLeaveAmendmentResponse
+	54,  // 140: hr.service.v1.HrLeaveService.ShortenLeaveRequest:output_type -> hr.service.v1.ShortenLeaveRequestResponse
+	56,  // 141: hr.service.v1.HrLeaveService.GetLeaveCoverage:output_type -> hr.service.v1.GetLeaveCoverageResponse
+	68,  // 142: hr.service.v1.HrLeaveService.PostLeaveComment:output_type -> hr.service.v1.PostLeaveCommentResponse
+	69,  // 143: hr.service.v1.HrLeaveService.ListLeaveComments:output_type -> hr.service.v1.ListLeaveCommentsResponse
+	70,  // 144: hr.service.v1.HrLeaveService.UpdateLeaveComment:output_type -> hr.service.v1.UpdateLeaveCommentResponse
+	117, // [117:145] is the sub-list for method output_type
+	89,  // [89:117] is the sub-list for method input_type
+	89,  // [89:89] is the sub-list for extension type_name
+	89,  // [89:89] is the sub-list for extension extendee
+	0,   // [0:89] is the sub-list for field type_name
 }
 
 func init() { file_hr_service_v1_leave_proto_init() }
@@ -1991,22 +4974,41 @@ func file_hr_service_v1_leave_proto_init() {
 	if File_hr_service_v1_leave_proto != nil {
 		return
 	}
-	file_hr_service_v1_leave_proto_msgTypes[0].OneofWrappers = []any{}
+	file_hr_service_v1_approval_proto_init()
+	file_hr_service_v1_comment_proto_init()
+	file_hr_service_v1_coverage_proto_init()
+	file_hr_service_v1_policy_proto_init()
+	file_hr_service_v1_recurrence_proto_init()
 	file_hr_service_v1_leave_proto_msgTypes[1].OneofWrappers = []any{}
-	file_hr_service_v1_leave_proto_msgTypes[5].OneofWrappers = []any{}
-	file_hr_service_v1_leave_proto_msgTypes[6].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[2].OneofWrappers = []any{}
 	file_hr_service_v1_leave_proto_msgTypes[7].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[8].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[9].OneofWrappers = []any{}
 	file_hr_service_v1_leave_proto_msgTypes[10].OneofWrappers = []any{}
-	file_hr_service_v1_leave_proto_msgTypes[12].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[11].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[14].OneofWrappers = []any{}
 	file_hr_service_v1_leave_proto_msgTypes[16].OneofWrappers = []any{}
-	file_hr_service_v1_leave_proto_msgTypes[21].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[20].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[22].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[23].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[24].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[25].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[29].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[31].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[32].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[36].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[39].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[40].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[44].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[46].OneofWrappers = []any{}
+	file_hr_service_v1_leave_proto_msgTypes[48].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hr_service_v1_leave_proto_rawDesc), len(file_hr_service_v1_leave_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   23,
+			NumEnums:      5,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
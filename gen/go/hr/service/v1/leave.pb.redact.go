@@ -83,6 +83,17 @@ func (s *redactedHrLeaveServiceServer) ListLeaveRequests(ctx context.Context, in
 	return res, err
 }
 
+// ListAssignedApprovals is the redacted wrapper for the actual HrLeaveServiceServer.ListAssignedApprovals method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) ListAssignedApprovals(ctx context.Context, in *ListAssignedApprovalsRequest) (*ListAssignedApprovalsResponse, error) {
+	res, err := s.srv.ListAssignedApprovals(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // UpdateLeaveRequest is the redacted wrapper for the actual HrLeaveServiceServer.UpdateLeaveRequest method
 // Unary RPC
 func (s *redactedHrLeaveServiceServer) UpdateLeaveRequest(ctx context.Context, in *UpdateLeaveRequestRequest) (*UpdateLeaveRequestResponse, error) {
@@ -149,6 +160,83 @@ func (s *redactedHrLeaveServiceServer) RevokeLeaveRequest(ctx context.Context, i
 	return res, err
 }
 
+// BulkApproveLeaveRequests is the redacted wrapper for the actual HrLeaveServiceServer.BulkApproveLeaveRequests method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) BulkApproveLeaveRequests(ctx context.Context, in *BulkApproveLeaveRequestsRequest) (*BulkLeaveRequestsResponse, error) {
+	res, err := s.srv.BulkApproveLeaveRequests(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// BulkRejectLeaveRequests is the redacted wrapper for the actual HrLeaveServiceServer.BulkRejectLeaveRequests method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) BulkRejectLeaveRequests(ctx context.Context, in *BulkRejectLeaveRequestsRequest) (*BulkLeaveRequestsResponse, error) {
+	res, err := s.srv.BulkRejectLeaveRequests(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// BulkRevokeLeaveRequests is the redacted wrapper for the actual HrLeaveServiceServer.BulkRevokeLeaveRequests method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) BulkRevokeLeaveRequests(ctx context.Context, in *BulkRevokeLeaveRequestsRequest) (*BulkLeaveRequestsResponse, error) {
+	res, err := s.srv.BulkRevokeLeaveRequests(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// CancelFollowingOccurrences is the redacted wrapper for the actual HrLeaveServiceServer.CancelFollowingOccurrences method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) CancelFollowingOccurrences(ctx context.Context, in *CancelFollowingOccurrencesRequest) (*BulkLeaveRequestsResponse, error) {
+	res, err := s.srv.CancelFollowingOccurrences(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// UpdateFollowingOccurrences is the redacted wrapper for the actual HrLeaveServiceServer.UpdateFollowingOccurrences method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) UpdateFollowingOccurrences(ctx context.Context, in *UpdateFollowingOccurrencesRequest) (*UpdateFollowingOccurrencesResponse, error) {
+	res, err := s.srv.UpdateFollowingOccurrences(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ApproveLeaveSeries is the redacted wrapper for the actual HrLeaveServiceServer.ApproveLeaveSeries method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) ApproveLeaveSeries(ctx context.Context, in *ApproveLeaveSeriesRequest) (*BulkLeaveRequestsResponse, error) {
+	res, err := s.srv.ApproveLeaveSeries(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RejectLeaveSeries is the redacted wrapper for the actual HrLeaveServiceServer.RejectLeaveSeries method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) RejectLeaveSeries(ctx context.Context, in *RejectLeaveSeriesRequest) (*BulkLeaveRequestsResponse, error) {
+	res, err := s.srv.RejectLeaveSeries(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // GetCalendarEvents is the redacted wrapper for the actual HrLeaveServiceServer.GetCalendarEvents method
 // Unary RPC
 func (s *redactedHrLeaveServiceServer) GetCalendarEvents(ctx context.Context, in *GetCalendarEventsRequest) (*GetCalendarEventsResponse, error) {
@@ -171,6 +259,119 @@ func (s *redactedHrLeaveServiceServer) GetSignedDocumentUrl(ctx context.Context,
 	return res, err
 }
 
+// ChangeLeaveDates is the redacted wrapper for the actual HrLeaveServiceServer.ChangeLeaveDates method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) ChangeLeaveDates(ctx context.Context, in *ChangeLeaveDatesRequest) (*ChangeLeaveDatesResponse, error) {
+	res, err := s.srv.ChangeLeaveDates(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListLeaveAmendments is the redacted wrapper for the actual HrLeaveServiceServer.ListLeaveAmendments method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) ListLeaveAmendments(ctx context.Context, in *ListLeaveAmendmentsRequest) (*ListLeaveAmendmentsResponse, error) {
+	res, err := s.srv.ListLeaveAmendments(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ApproveLeaveAmendment is the redacted wrapper for the actual HrLeaveServiceServer.ApproveLeaveAmendment method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) ApproveLeaveAmendment(ctx context.Context, in *ApproveLeaveAmendmentRequest) (*ApproveLeaveAmendmentResponse, error) {
+	res, err := s.srv.ApproveLeaveAmendment(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RejectLeaveAmendment is the redacted wrapper for the actual HrLeaveServiceServer.RejectLeaveAmendment method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) RejectLeaveAmendment(ctx context.Context, in *RejectLeaveAmendmentRequest) (*RejectLeaveAmendmentResponse, error) {
+	res, err := s.srv.RejectLeaveAmendment(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ShortenLeaveRequest is the redacted wrapper for the actual HrLeaveServiceServer.ShortenLeaveRequest method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) ShortenLeaveRequest(ctx context.Context, in *ShortenLeaveRequestRequest) (*ShortenLeaveRequestResponse, error) {
+	res, err := s.srv.ShortenLeaveRequest(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetLeaveCoverage is the redacted wrapper for the actual HrLeaveServiceServer.GetLeaveCoverage method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) GetLeaveCoverage(ctx context.Context, in *GetLeaveCoverageRequest) (*GetLeaveCoverageResponse, error) {
+	res, err := s.srv.GetLeaveCoverage(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// PostLeaveComment is the redacted wrapper for the actual HrLeaveServiceServer.PostLeaveComment method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) PostLeaveComment(ctx context.Context, in *PostLeaveCommentRequest) (*PostLeaveCommentResponse, error) {
+	res, err := s.srv.PostLeaveComment(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListLeaveComments is the redacted wrapper for the actual HrLeaveServiceServer.ListLeaveComments method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) ListLeaveComments(ctx context.Context, in *ListLeaveCommentsRequest) (*ListLeaveCommentsResponse, error) {
+	res, err := s.srv.ListLeaveComments(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// UpdateLeaveComment is the redacted wrapper for the actual HrLeaveServiceServer.UpdateLeaveComment method
+// Unary RPC
+func (s *redactedHrLeaveServiceServer) UpdateLeaveComment(ctx context.Context, in *UpdateLeaveCommentRequest) (*UpdateLeaveCommentResponse, error) {
+	res, err := s.srv.UpdateLeaveComment(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Redact method implementation for LeaveDeduction
+func (x *LeaveDeduction) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: AllowanceId
+
+	// Safe field: Year
+
+	// Safe field: Days
+	return x.String()
+}
+
 // Redact method implementation for LeaveRequest
 func (x *LeaveRequest) Redact() string {
 	if x == nil {
@@ -219,6 +420,50 @@ func (x *LeaveRequest) Redact() string {
 
 	// Safe field: SigningRequestId
 
+	// Safe field: HolidayCalendarId
+
+	// Safe field: StartDayPart
+
+	// Safe field: EndDayPart
+
+	// Safe field: Hours
+
+	// Safe field: Deductions
+
+	// Safe field: Approvals
+
+	// Safe field: ApprovalStep
+
+	// Safe field: ApproverId
+
+	// Safe field: OnBehalfOf
+
+	// Safe field: OnBehalfOfName
+
+	// Safe field: AwaitingSince
+
+	// Safe field: EscalationLevel
+
+	// Safe field: PolicyOverride
+
+	// Safe field: AttachmentCount
+
+	// Safe field: AttachmentRequired
+
+	// Safe field: AttachmentDueDate
+
+	// Safe field: AttachmentMissing
+
+	// Safe field: SeriesId
+
+	// Safe field: Recurrence
+
+	// Safe field: SigningOutcome
+
+	// Safe field: SigningOutcomeReason
+
+	// Safe field: SigningEndedAt
+
 	// Safe field: CreatedAt
 
 	// Safe field: UpdatedAt
@@ -258,6 +503,16 @@ func (x *CreateLeaveRequestRequest) Redact() string {
 	// Safe field: UserEmail
 
 	// Safe field: OrgUnitName
+
+	// Safe field: HolidayCalendarId
+
+	// Safe field: StartDayPart
+
+	// Safe field: EndDayPart
+
+	// Safe field: PolicyOverrideReason
+
+	// Safe field: Recurrence
 	return x.String()
 }
 
@@ -268,6 +523,30 @@ func (x *CreateLeaveRequestResponse) Redact() string {
 	}
 
 	// Safe field: LeaveRequest
+
+	// Safe field: CoverageWarnings
+
+	// Safe field: Occurrences
+	return x.String()
+}
+
+// Redact method implementation for LeaveOccurrenceResult
+func (x *LeaveOccurrenceResult) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: StartDate
+
+	// Safe field: Success
+
+	// Safe field: LeaveRequest
+
+	// Safe field: ErrorReason
+
+	// Safe field: ErrorMessage
+
+	// Safe field: CoverageWarnings
 	return x.String()
 }
 
@@ -316,6 +595,8 @@ func (x *ListLeaveRequestsRequest) Redact() string {
 	// Safe field: StartDate
 
 	// Safe field: EndDate
+
+	// Safe field: SeriesId
 	return x.String()
 }
 
@@ -331,6 +612,32 @@ func (x *ListLeaveRequestsResponse) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for ListAssignedApprovalsRequest
+func (x *ListAssignedApprovalsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+
+	// Safe field: NoPaging
+	return x.String()
+}
+
+// Redact method implementation for ListAssignedApprovalsResponse
+func (x *ListAssignedApprovalsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Items
+
+	// Safe field: Total
+	return x.String()
+}
+
 // Redact method implementation for UpdateLeaveRequestRequest
 func (x *UpdateLeaveRequestRequest) Redact() string {
 	if x == nil {
@@ -390,6 +697,8 @@ func (x *ApproveLeaveRequestResponse) Redact() string {
 	}
 
 	// Safe field: LeaveRequest
+
+	// Safe field: CoverageWarnings
 	return x.String()
 }
 
@@ -457,80 +766,478 @@ func (x *RevokeLeaveRequestResponse) Redact() string {
 	return x.String()
 }
 
-// Redact method implementation for CalendarEvent
-func (x *CalendarEvent) Redact() string {
+// Redact method implementation for LeaveRequestSelection
+func (x *LeaveRequestSelection) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: Id
+	// Safe field: Ids
 
 	// Safe field: UserId
 
-	// Safe field: UserName
-
 	// Safe field: AbsenceTypeId
 
-	// Safe field: AbsenceTypeName
-
-	// Safe field: Color
-
 	// Safe field: StartDate
 
 	// Safe field: EndDate
+	return x.String()
+}
 
-	// Safe field: Days
+// Redact method implementation for BulkApproveLeaveRequestsRequest
+func (x *BulkApproveLeaveRequestsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
 
-	// Safe field: Status
+	// Safe field: Selection
 
-	// Safe field: OrgUnitName
+	// Safe field: ReviewNotes
 	return x.String()
 }
 
-// Redact method implementation for GetSignedDocumentUrlRequest
-func (x *GetSignedDocumentUrlRequest) Redact() string {
+// Redact method implementation for BulkRejectLeaveRequestsRequest
+func (x *BulkRejectLeaveRequestsRequest) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: LeaveRequestId
+	// Safe field: Selection
+
+	// Safe field: ReviewNotes
 	return x.String()
 }
 
-// Redact method implementation for GetSignedDocumentUrlResponse
-func (x *GetSignedDocumentUrlResponse) Redact() string {
+// Redact method implementation for BulkRevokeLeaveRequestsRequest
+func (x *BulkRevokeLeaveRequestsRequest) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: Url
+	// Safe field: Selection
+
+	// Safe field: Reason
 	return x.String()
 }
 
-// Redact method implementation for GetCalendarEventsRequest
-func (x *GetCalendarEventsRequest) Redact() string {
+// Redact method implementation for BulkLeaveRequestResult
+func (x *BulkLeaveRequestResult) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: TenantId
+	// Safe field: Id
 
-	// Safe field: StartDate
+	// Safe field: Success
 
-	// Safe field: EndDate
+	// Safe field: LeaveRequest
 
-	// Safe field: OrgUnitName
+	// Safe field: ErrorReason
 
-	// Safe field: UserId
+	// Safe field: ErrorMessage
+
+	// Safe field: CoverageWarnings
 	return x.String()
 }
 
-// Redact method implementation for GetCalendarEventsResponse
-func (x *GetCalendarEventsResponse) Redact() string {
+// Redact method implementation for BulkLeaveRequestsResponse
+func (x *BulkLeaveRequestsResponse) Redact() string {
 	if x == nil {
 		return ""
 	}
 
-	// Safe field: Events
+	// Safe field: Results
+
+	// Safe field: Succeeded
+
+	// Safe field: Failed
+	return x.String()
+}
+
+// Redact method implementation for CancelFollowingOccurrencesRequest
+func (x *CancelFollowingOccurrencesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for UpdateFollowingOccurrencesRequest
+func (x *UpdateFollowingOccurrencesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Reason
+
+	// Safe field: Notes
+
+	// Safe field: Metadata
+
+	// Safe field: Recurrence
+
+	// Safe field: PolicyOverrideReason
+	return x.String()
+}
+
+// Redact method implementation for UpdateFollowingOccurrencesResponse
+func (x *UpdateFollowingOccurrencesResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Results
+
+	// Safe field: Occurrences
+	return x.String()
+}
+
+// Redact method implementation for ApproveLeaveSeriesRequest
+func (x *ApproveLeaveSeriesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SeriesId
+
+	// Safe field: ReviewNotes
+	return x.String()
+}
+
+// Redact method implementation for RejectLeaveSeriesRequest
+func (x *RejectLeaveSeriesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: SeriesId
+
+	// Safe field: ReviewNotes
+	return x.String()
+}
+
+// Redact method implementation for CalendarEvent
+func (x *CalendarEvent) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: UserId
+
+	// Safe field: UserName
+
+	// Safe field: AbsenceTypeId
+
+	// Safe field: AbsenceTypeName
+
+	// Safe field: Color
+
+	// Safe field: StartDate
+
+	// Safe field: EndDate
+
+	// Safe field: Days
+
+	// Safe field: Status
+
+	// Safe field: OrgUnitName
+
+	// Safe field: StartDayPart
+
+	// Safe field: EndDayPart
+
+	// Safe field: Hours
+	return x.String()
+}
+
+// Redact method implementation for GetSignedDocumentUrlRequest
+func (x *GetSignedDocumentUrlRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: LeaveRequestId
+	return x.String()
+}
+
+// Redact method implementation for GetSignedDocumentUrlResponse
+func (x *GetSignedDocumentUrlResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Url
+	return x.String()
+}
+
+// Redact method implementation for GetCalendarEventsRequest
+func (x *GetCalendarEventsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: StartDate
+
+	// Safe field: EndDate
+
+	// Safe field: OrgUnitName
+
+	// Safe field: UserId
+
+	// Safe field: HolidayCalendarId
+	return x.String()
+}
+
+// Redact method implementation for CalendarHoliday
+func (x *CalendarHoliday) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: HolidayId
+
+	// Safe field: CalendarId
+
+	// Safe field: Name
+
+	// Safe field: Date
+	return x.String()
+}
+
+// Redact method implementation for GetCalendarEventsResponse
+func (x *GetCalendarEventsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Events
+
+	// Safe field: Holidays
+	return x.String()
+}
+
+// Redact method implementation for LeaveAmendment
+func (x *LeaveAmendment) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: TenantId
+
+	// Safe field: LeaveRequestId
+
+	// Safe field: UserId
+
+	// Safe field: Status
+
+	// Safe field: Kind
+
+	// Safe field: PreviousStartDate
+
+	// Safe field: PreviousEndDate
+
+	// Safe field: PreviousStartDayPart
+
+	// Safe field: PreviousEndDayPart
+
+	// Safe field: PreviousDays
+
+	// Safe field: StartDate
+
+	// Safe field: EndDate
+
+	// Safe field: StartDayPart
+
+	// Safe field: EndDayPart
+
+	// Safe field: Hours
+
+	// Safe field: Days
+
+	// Safe field: HolidayCalendarId
+
+	// Safe field: Reason
+
+	// Safe field: ReviewedBy
+
+	// Safe field: ReviewerName
+
+	// Safe field: ReviewedAt
+
+	// Safe field: ReviewNotes
+
+	// Safe field: SigningRequestId
+
+	// Safe field: PolicyOverride
+
+	// Safe field: CreatedAt
+
+	// Safe field: UpdatedAt
+
+	// Safe field: CreatedBy
+
+	// Safe field: UpdatedBy
+	return x.String()
+}
+
+// Redact method implementation for ChangeLeaveDatesRequest
+func (x *ChangeLeaveDatesRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: StartDate
+
+	// Safe field: EndDate
+
+	// Safe field: StartDayPart
+
+	// Safe field: EndDayPart
+
+	// Safe field: Days
+
+	// Safe field: Reason
+
+	// Safe field: PolicyOverrideReason
+	return x.String()
+}
+
+// Redact method implementation for ChangeLeaveDatesResponse
+func (x *ChangeLeaveDatesResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: LeaveRequest
+
+	// Safe field: Amendment
+	return x.String()
+}
+
+// Redact method implementation for ListLeaveAmendmentsRequest
+func (x *ListLeaveAmendmentsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: LeaveRequestId
+	return x.String()
+}
+
+// Redact method implementation for ListLeaveAmendmentsResponse
+func (x *ListLeaveAmendmentsResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Items
+	return x.String()
+}
+
+// Redact method implementation for ApproveLeaveAmendmentRequest
+func (x *ApproveLeaveAmendmentRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: ReviewNotes
+	return x.String()
+}
+
+// Redact method implementation for ApproveLeaveAmendmentResponse
+func (x *ApproveLeaveAmendmentResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: LeaveRequest
+
+	// Safe field: Amendment
+
+	// Safe field: CoverageWarnings
+	return x.String()
+}
+
+// Redact method implementation for RejectLeaveAmendmentRequest
+func (x *RejectLeaveAmendmentRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: ReviewNotes
+	return x.String()
+}
+
+// Redact method implementation for RejectLeaveAmendmentResponse
+func (x *RejectLeaveAmendmentResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Amendment
+	return x.String()
+}
+
+// Redact method implementation for ShortenLeaveRequestRequest
+func (x *ShortenLeaveRequestRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: EndDate
+
+	// Safe field: EndDayPart
+
+	// Safe field: Reason
+	return x.String()
+}
+
+// Redact method implementation for ShortenLeaveRequestResponse
+func (x *ShortenLeaveRequestResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: LeaveRequest
+
+	// Safe field: Amendment
+	return x.String()
+}
+
+// Redact method implementation for GetLeaveCoverageRequest
+func (x *GetLeaveCoverageRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for GetLeaveCoverageResponse
+func (x *GetLeaveCoverageResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Conflicts
 	return x.String()
 }
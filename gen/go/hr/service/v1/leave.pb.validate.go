@@ -35,6 +35,112 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on LeaveDeduction with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LeaveDeduction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveDeduction with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LeaveDeductionMultiError,
+// or nil if none found.
+func (m *LeaveDeduction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveDeduction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AllowanceId
+
+	// no validation rules for Year
+
+	// no validation rules for Days
+
+	if len(errors) > 0 {
+		return LeaveDeductionMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveDeductionMultiError is an error wrapping multiple validation errors
+// returned by LeaveDeduction.ValidateAll() if the designated constraints
+// aren't met.
+type LeaveDeductionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveDeductionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveDeductionMultiError) AllErrors() []error { return m }
+
+// LeaveDeductionValidationError is the validation error returned by
+// LeaveDeduction.Validate if the designated constraints aren't met.
+type LeaveDeductionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveDeductionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveDeductionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveDeductionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveDeductionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveDeductionValidationError) ErrorName() string { return "LeaveDeductionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LeaveDeductionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveDeduction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveDeductionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveDeductionValidationError{}
+
 // Validate checks the field values on LeaveRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -86,6 +192,74 @@ func (m *LeaveRequest) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetDeductions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveRequestValidationError{
+						field:  fmt.Sprintf("Deductions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveRequestValidationError{
+						field:  fmt.Sprintf("Deductions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveRequestValidationError{
+					field:  fmt.Sprintf("Deductions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetApprovals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveRequestValidationError{
+						field:  fmt.Sprintf("Approvals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveRequestValidationError{
+						field:  fmt.Sprintf("Approvals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveRequestValidationError{
+					field:  fmt.Sprintf("Approvals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if m.Id != nil {
 		// no validation rules for Id
 	}
@@ -253,14 +427,46 @@ func (m *LeaveRequest) validate(all bool) error {
 		// no validation rules for SigningRequestId
 	}
 
-	if m.CreatedAt != nil {
+	if m.HolidayCalendarId != nil {
+		// no validation rules for HolidayCalendarId
+	}
+
+	if m.StartDayPart != nil {
+		// no validation rules for StartDayPart
+	}
+
+	if m.EndDayPart != nil {
+		// no validation rules for EndDayPart
+	}
+
+	if m.Hours != nil {
+		// no validation rules for Hours
+	}
+
+	if m.ApprovalStep != nil {
+		// no validation rules for ApprovalStep
+	}
+
+	if m.ApproverId != nil {
+		// no validation rules for ApproverId
+	}
+
+	if m.OnBehalfOf != nil {
+		// no validation rules for OnBehalfOf
+	}
+
+	if m.OnBehalfOfName != nil {
+		// no validation rules for OnBehalfOfName
+	}
+
+	if m.AwaitingSince != nil {
 
 		if all {
-			switch v := interface{}(m.GetCreatedAt()).(type) {
+			switch v := interface{}(m.GetAwaitingSince()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, LeaveRequestValidationError{
-						field:  "CreatedAt",
+						field:  "AwaitingSince",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -268,16 +474,16 @@ func (m *LeaveRequest) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, LeaveRequestValidationError{
-						field:  "CreatedAt",
+						field:  "AwaitingSince",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetAwaitingSince()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return LeaveRequestValidationError{
-					field:  "CreatedAt",
+					field:  "AwaitingSince",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -286,14 +492,18 @@ func (m *LeaveRequest) validate(all bool) error {
 
 	}
 
-	if m.UpdatedAt != nil {
+	if m.EscalationLevel != nil {
+		// no validation rules for EscalationLevel
+	}
+
+	if m.PolicyOverride != nil {
 
 		if all {
-			switch v := interface{}(m.GetUpdatedAt()).(type) {
+			switch v := interface{}(m.GetPolicyOverride()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, LeaveRequestValidationError{
-						field:  "UpdatedAt",
+						field:  "PolicyOverride",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -301,16 +511,16 @@ func (m *LeaveRequest) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, LeaveRequestValidationError{
-						field:  "UpdatedAt",
+						field:  "PolicyOverride",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetPolicyOverride()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return LeaveRequestValidationError{
-					field:  "UpdatedAt",
+					field:  "PolicyOverride",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -319,57 +529,246 @@ func (m *LeaveRequest) validate(all bool) error {
 
 	}
 
-	if m.CreatedBy != nil {
-		// no validation rules for CreatedBy
+	if m.AttachmentCount != nil {
+		// no validation rules for AttachmentCount
 	}
 
-	if m.UpdatedBy != nil {
-		// no validation rules for UpdatedBy
+	if m.AttachmentRequired != nil {
+		// no validation rules for AttachmentRequired
 	}
 
-	if len(errors) > 0 {
-		return LeaveRequestMultiError(errors)
-	}
+	if m.AttachmentDueDate != nil {
 
-	return nil
-}
+		if all {
+			switch v := interface{}(m.GetAttachmentDueDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveRequestValidationError{
+						field:  "AttachmentDueDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveRequestValidationError{
+						field:  "AttachmentDueDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAttachmentDueDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveRequestValidationError{
+					field:  "AttachmentDueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// LeaveRequestMultiError is an error wrapping multiple validation errors
-// returned by LeaveRequest.ValidateAll() if the designated constraints aren't met.
-type LeaveRequestMultiError []error
+	}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m LeaveRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if m.AttachmentMissing != nil {
+		// no validation rules for AttachmentMissing
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m LeaveRequestMultiError) AllErrors() []error { return m }
+	if m.SeriesId != nil {
+		// no validation rules for SeriesId
+	}
 
-// LeaveRequestValidationError is the validation error returned by
-// LeaveRequest.Validate if the designated constraints aren't met.
-type LeaveRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+	if m.Recurrence != nil {
 
-// Field function returns field value.
-func (e LeaveRequestValidationError) Field() string { return e.field }
+		if all {
+			switch v := interface{}(m.GetRecurrence()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveRequestValidationError{
+						field:  "Recurrence",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveRequestValidationError{
+						field:  "Recurrence",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRecurrence()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveRequestValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Reason function returns reason value.
-func (e LeaveRequestValidationError) Reason() string { return e.reason }
+	}
 
-// Cause function returns cause value.
-func (e LeaveRequestValidationError) Cause() error { return e.cause }
+	if m.SigningOutcome != nil {
+		// no validation rules for SigningOutcome
+	}
 
-// Key function returns key value.
-func (e LeaveRequestValidationError) Key() bool { return e.key }
+	if m.SigningOutcomeReason != nil {
+		// no validation rules for SigningOutcomeReason
+	}
+
+	if m.SigningEndedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetSigningEndedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveRequestValidationError{
+						field:  "SigningEndedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveRequestValidationError{
+						field:  "SigningEndedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSigningEndedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveRequestValidationError{
+					field:  "SigningEndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CreatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetCreatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveRequestValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveRequestValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveRequestValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.UpdatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetUpdatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveRequestValidationError{
+						field:  "UpdatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveRequestValidationError{
+						field:  "UpdatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveRequestValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if m.UpdatedBy != nil {
+		// no validation rules for UpdatedBy
+	}
+
+	if len(errors) > 0 {
+		return LeaveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveRequestMultiError is an error wrapping multiple validation errors
+// returned by LeaveRequest.ValidateAll() if the designated constraints aren't met.
+type LeaveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveRequestMultiError) AllErrors() []error { return m }
+
+// LeaveRequestValidationError is the validation error returned by
+// LeaveRequest.Validate if the designated constraints aren't met.
+type LeaveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
 func (e LeaveRequestValidationError) ErrorName() string { return "LeaveRequestValidationError" }
@@ -557,6 +956,55 @@ func (m *CreateLeaveRequestRequest) validate(all bool) error {
 		// no validation rules for OrgUnitName
 	}
 
+	if m.HolidayCalendarId != nil {
+		// no validation rules for HolidayCalendarId
+	}
+
+	if m.StartDayPart != nil {
+		// no validation rules for StartDayPart
+	}
+
+	if m.EndDayPart != nil {
+		// no validation rules for EndDayPart
+	}
+
+	if m.PolicyOverrideReason != nil {
+		// no validation rules for PolicyOverrideReason
+	}
+
+	if m.Recurrence != nil {
+
+		if all {
+			switch v := interface{}(m.GetRecurrence()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateLeaveRequestRequestValidationError{
+						field:  "Recurrence",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateLeaveRequestRequestValidationError{
+						field:  "Recurrence",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRecurrence()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateLeaveRequestRequestValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateLeaveRequestRequestMultiError(errors)
 	}
@@ -688,6 +1136,74 @@ func (m *CreateLeaveRequestResponse) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetCoverageWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateLeaveRequestResponseValidationError{
+						field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateLeaveRequestResponseValidationError{
+						field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateLeaveRequestResponseValidationError{
+					field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetOccurrences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateLeaveRequestResponseValidationError{
+						field:  fmt.Sprintf("Occurrences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateLeaveRequestResponseValidationError{
+						field:  fmt.Sprintf("Occurrences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateLeaveRequestResponseValidationError{
+					field:  fmt.Sprintf("Occurrences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateLeaveRequestResponseMultiError(errors)
 	}
@@ -768,43 +1284,243 @@ var _ interface {
 	ErrorName() string
 } = CreateLeaveRequestResponseValidationError{}
 
-// Validate checks the field values on GetLeaveRequestRequest with the rules
+// Validate checks the field values on LeaveOccurrenceResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetLeaveRequestRequest) Validate() error {
+func (m *LeaveOccurrenceResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetLeaveRequestRequest with the rules
+// ValidateAll checks the field values on LeaveOccurrenceResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetLeaveRequestRequestMultiError, or nil if none found.
-func (m *GetLeaveRequestRequest) ValidateAll() error {
+// LeaveOccurrenceResultMultiError, or nil if none found.
+func (m *LeaveOccurrenceResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetLeaveRequestRequest) validate(all bool) error {
+func (m *LeaveOccurrenceResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if len(errors) > 0 {
-		return GetLeaveRequestRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// GetLeaveRequestRequestMultiError is an error wrapping multiple validation
-// errors returned by GetLeaveRequestRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetLeaveRequestRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
+	if all {
+		switch v := interface{}(m.GetStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeaveOccurrenceResultValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeaveOccurrenceResultValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeaveOccurrenceResultValidationError{
+				field:  "StartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Success
+
+	if all {
+		switch v := interface{}(m.GetLeaveRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeaveOccurrenceResultValidationError{
+					field:  "LeaveRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeaveOccurrenceResultValidationError{
+					field:  "LeaveRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeaveRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeaveOccurrenceResultValidationError{
+				field:  "LeaveRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ErrorReason
+
+	// no validation rules for ErrorMessage
+
+	for idx, item := range m.GetCoverageWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveOccurrenceResultValidationError{
+						field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveOccurrenceResultValidationError{
+						field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveOccurrenceResultValidationError{
+					field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LeaveOccurrenceResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveOccurrenceResultMultiError is an error wrapping multiple validation
+// errors returned by LeaveOccurrenceResult.ValidateAll() if the designated
+// constraints aren't met.
+type LeaveOccurrenceResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveOccurrenceResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveOccurrenceResultMultiError) AllErrors() []error { return m }
+
+// LeaveOccurrenceResultValidationError is the validation error returned by
+// LeaveOccurrenceResult.Validate if the designated constraints aren't met.
+type LeaveOccurrenceResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveOccurrenceResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveOccurrenceResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveOccurrenceResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveOccurrenceResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveOccurrenceResultValidationError) ErrorName() string {
+	return "LeaveOccurrenceResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaveOccurrenceResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveOccurrenceResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveOccurrenceResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveOccurrenceResultValidationError{}
+
+// Validate checks the field values on GetLeaveRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLeaveRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLeaveRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLeaveRequestRequestMultiError, or nil if none found.
+func (m *GetLeaveRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLeaveRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetLeaveRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLeaveRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by GetLeaveRequestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetLeaveRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
 func (m GetLeaveRequestRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
@@ -1065,6 +1781,10 @@ func (m *ListLeaveRequestsRequest) validate(all bool) error {
 		// no validation rules for EndDate
 	}
 
+	if m.SeriesId != nil {
+		// no validation rules for SeriesId
+	}
+
 	if len(errors) > 0 {
 		return ListLeaveRequestsRequestMultiError(errors)
 	}
@@ -1285,106 +2005,54 @@ var _ interface {
 	ErrorName() string
 } = ListLeaveRequestsResponseValidationError{}
 
-// Validate checks the field values on UpdateLeaveRequestRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListAssignedApprovalsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateLeaveRequestRequest) Validate() error {
+func (m *ListAssignedApprovalsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateLeaveRequestRequest with the
+// ValidateAll checks the field values on ListAssignedApprovalsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateLeaveRequestRequestMultiError, or nil if none found.
-func (m *UpdateLeaveRequestRequest) ValidateAll() error {
+// ListAssignedApprovalsRequestMultiError, or nil if none found.
+func (m *ListAssignedApprovalsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateLeaveRequestRequest) validate(all bool) error {
+func (m *ListAssignedApprovalsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if all {
-		switch v := interface{}(m.GetUpdateMask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateLeaveRequestRequestValidationError{
-					field:  "UpdateMask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateLeaveRequestRequestValidationError{
-					field:  "UpdateMask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateLeaveRequestRequestValidationError{
-				field:  "UpdateMask",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if m.Page != nil {
+		// no validation rules for Page
 	}
 
-	if m.Data != nil {
-
-		if all {
-			switch v := interface{}(m.GetData()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, UpdateLeaveRequestRequestValidationError{
-						field:  "Data",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, UpdateLeaveRequestRequestValidationError{
-						field:  "Data",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpdateLeaveRequestRequestValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
 
+	if m.NoPaging != nil {
+		// no validation rules for NoPaging
 	}
 
 	if len(errors) > 0 {
-		return UpdateLeaveRequestRequestMultiError(errors)
+		return ListAssignedApprovalsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateLeaveRequestRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateLeaveRequestRequest.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateLeaveRequestRequestMultiError []error
+// ListAssignedApprovalsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListAssignedApprovalsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListAssignedApprovalsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateLeaveRequestRequestMultiError) Error() string {
+func (m ListAssignedApprovalsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1393,11 +2061,12 @@ func (m UpdateLeaveRequestRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateLeaveRequestRequestMultiError) AllErrors() []error { return m }
+func (m ListAssignedApprovalsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateLeaveRequestRequestValidationError is the validation error returned by
-// UpdateLeaveRequestRequest.Validate if the designated constraints aren't met.
-type UpdateLeaveRequestRequestValidationError struct {
+// ListAssignedApprovalsRequestValidationError is the validation error returned
+// by ListAssignedApprovalsRequest.Validate if the designated constraints
+// aren't met.
+type ListAssignedApprovalsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1405,24 +2074,24 @@ type UpdateLeaveRequestRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateLeaveRequestRequestValidationError) Field() string { return e.field }
+func (e ListAssignedApprovalsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateLeaveRequestRequestValidationError) Reason() string { return e.reason }
+func (e ListAssignedApprovalsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateLeaveRequestRequestValidationError) Cause() error { return e.cause }
+func (e ListAssignedApprovalsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateLeaveRequestRequestValidationError) Key() bool { return e.key }
+func (e ListAssignedApprovalsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateLeaveRequestRequestValidationError) ErrorName() string {
-	return "UpdateLeaveRequestRequestValidationError"
+func (e ListAssignedApprovalsRequestValidationError) ErrorName() string {
+	return "ListAssignedApprovalsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateLeaveRequestRequestValidationError) Error() string {
+func (e ListAssignedApprovalsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1434,14 +2103,14 @@ func (e UpdateLeaveRequestRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateLeaveRequestRequest.%s: %s%s",
+		"invalid %sListAssignedApprovalsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateLeaveRequestRequestValidationError{}
+var _ error = ListAssignedApprovalsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1449,73 +2118,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateLeaveRequestRequestValidationError{}
+} = ListAssignedApprovalsRequestValidationError{}
 
-// Validate checks the field values on UpdateLeaveRequestResponse with the
+// Validate checks the field values on ListAssignedApprovalsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateLeaveRequestResponse) Validate() error {
+func (m *ListAssignedApprovalsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateLeaveRequestResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateLeaveRequestResponseMultiError, or nil if none found.
-func (m *UpdateLeaveRequestResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListAssignedApprovalsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListAssignedApprovalsResponseMultiError, or nil if none found.
+func (m *ListAssignedApprovalsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateLeaveRequestResponse) validate(all bool) error {
+func (m *ListAssignedApprovalsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetLeaveRequest()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateLeaveRequestResponseValidationError{
-					field:  "LeaveRequest",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAssignedApprovalsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAssignedApprovalsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateLeaveRequestResponseValidationError{
-					field:  "LeaveRequest",
+				return ListAssignedApprovalsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetLeaveRequest()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateLeaveRequestResponseValidationError{
-				field:  "LeaveRequest",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
+	}
+
+	if m.Total != nil {
+		// no validation rules for Total
 	}
 
 	if len(errors) > 0 {
-		return UpdateLeaveRequestResponseMultiError(errors)
+		return ListAssignedApprovalsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateLeaveRequestResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateLeaveRequestResponse.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateLeaveRequestResponseMultiError []error
+// ListAssignedApprovalsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListAssignedApprovalsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListAssignedApprovalsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateLeaveRequestResponseMultiError) Error() string {
+func (m ListAssignedApprovalsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1524,11 +2202,12 @@ func (m UpdateLeaveRequestResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateLeaveRequestResponseMultiError) AllErrors() []error { return m }
+func (m ListAssignedApprovalsResponseMultiError) AllErrors() []error { return m }
 
-// UpdateLeaveRequestResponseValidationError is the validation error returned
-// by UpdateLeaveRequestResponse.Validate if the designated constraints aren't met.
-type UpdateLeaveRequestResponseValidationError struct {
+// ListAssignedApprovalsResponseValidationError is the validation error
+// returned by ListAssignedApprovalsResponse.Validate if the designated
+// constraints aren't met.
+type ListAssignedApprovalsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1536,24 +2215,24 @@ type UpdateLeaveRequestResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateLeaveRequestResponseValidationError) Field() string { return e.field }
+func (e ListAssignedApprovalsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateLeaveRequestResponseValidationError) Reason() string { return e.reason }
+func (e ListAssignedApprovalsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateLeaveRequestResponseValidationError) Cause() error { return e.cause }
+func (e ListAssignedApprovalsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateLeaveRequestResponseValidationError) Key() bool { return e.key }
+func (e ListAssignedApprovalsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateLeaveRequestResponseValidationError) ErrorName() string {
-	return "UpdateLeaveRequestResponseValidationError"
+func (e ListAssignedApprovalsResponseValidationError) ErrorName() string {
+	return "ListAssignedApprovalsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateLeaveRequestResponseValidationError) Error() string {
+func (e ListAssignedApprovalsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1565,14 +2244,14 @@ func (e UpdateLeaveRequestResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateLeaveRequestResponse.%s: %s%s",
+		"invalid %sListAssignedApprovalsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateLeaveRequestResponseValidationError{}
+var _ error = ListAssignedApprovalsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1580,24 +2259,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateLeaveRequestResponseValidationError{}
+} = ListAssignedApprovalsResponseValidationError{}
 
-// Validate checks the field values on DeleteLeaveRequestRequest with the rules
+// Validate checks the field values on UpdateLeaveRequestRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteLeaveRequestRequest) Validate() error {
+func (m *UpdateLeaveRequestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteLeaveRequestRequest with the
+// ValidateAll checks the field values on UpdateLeaveRequestRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteLeaveRequestRequestMultiError, or nil if none found.
-func (m *DeleteLeaveRequestRequest) ValidateAll() error {
+// UpdateLeaveRequestRequestMultiError, or nil if none found.
+func (m *UpdateLeaveRequestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteLeaveRequestRequest) validate(all bool) error {
+func (m *UpdateLeaveRequestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1606,20 +2285,82 @@ func (m *DeleteLeaveRequestRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateLeaveRequestRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateLeaveRequestRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateLeaveRequestRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Data != nil {
+
+		if all {
+			switch v := interface{}(m.GetData()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateLeaveRequestRequestValidationError{
+						field:  "Data",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateLeaveRequestRequestValidationError{
+						field:  "Data",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateLeaveRequestRequestValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DeleteLeaveRequestRequestMultiError(errors)
+		return UpdateLeaveRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteLeaveRequestRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteLeaveRequestRequest.ValidateAll() if the
+// UpdateLeaveRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateLeaveRequestRequest.ValidateAll() if the
 // designated constraints aren't met.
-type DeleteLeaveRequestRequestMultiError []error
+type UpdateLeaveRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteLeaveRequestRequestMultiError) Error() string {
+func (m UpdateLeaveRequestRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1628,11 +2369,11 @@ func (m DeleteLeaveRequestRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteLeaveRequestRequestMultiError) AllErrors() []error { return m }
+func (m UpdateLeaveRequestRequestMultiError) AllErrors() []error { return m }
 
-// DeleteLeaveRequestRequestValidationError is the validation error returned by
-// DeleteLeaveRequestRequest.Validate if the designated constraints aren't met.
-type DeleteLeaveRequestRequestValidationError struct {
+// UpdateLeaveRequestRequestValidationError is the validation error returned by
+// UpdateLeaveRequestRequest.Validate if the designated constraints aren't met.
+type UpdateLeaveRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1640,24 +2381,24 @@ type DeleteLeaveRequestRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteLeaveRequestRequestValidationError) Field() string { return e.field }
+func (e UpdateLeaveRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteLeaveRequestRequestValidationError) Reason() string { return e.reason }
+func (e UpdateLeaveRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteLeaveRequestRequestValidationError) Cause() error { return e.cause }
+func (e UpdateLeaveRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteLeaveRequestRequestValidationError) Key() bool { return e.key }
+func (e UpdateLeaveRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteLeaveRequestRequestValidationError) ErrorName() string {
-	return "DeleteLeaveRequestRequestValidationError"
+func (e UpdateLeaveRequestRequestValidationError) ErrorName() string {
+	return "UpdateLeaveRequestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteLeaveRequestRequestValidationError) Error() string {
+func (e UpdateLeaveRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1669,14 +2410,14 @@ func (e DeleteLeaveRequestRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteLeaveRequestRequest.%s: %s%s",
+		"invalid %sUpdateLeaveRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteLeaveRequestRequestValidationError{}
+var _ error = UpdateLeaveRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1684,34 +2425,269 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteLeaveRequestRequestValidationError{}
+} = UpdateLeaveRequestRequestValidationError{}
 
-// Validate checks the field values on ApproveLeaveRequestRequest with the
+// Validate checks the field values on UpdateLeaveRequestResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ApproveLeaveRequestRequest) Validate() error {
+func (m *UpdateLeaveRequestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ApproveLeaveRequestRequest with the
+// ValidateAll checks the field values on UpdateLeaveRequestResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ApproveLeaveRequestRequestMultiError, or nil if none found.
-func (m *ApproveLeaveRequestRequest) ValidateAll() error {
+// UpdateLeaveRequestResponseMultiError, or nil if none found.
+func (m *UpdateLeaveRequestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ApproveLeaveRequestRequest) validate(all bool) error {
+func (m *UpdateLeaveRequestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetLeaveRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateLeaveRequestResponseValidationError{
+					field:  "LeaveRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateLeaveRequestResponseValidationError{
+					field:  "LeaveRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeaveRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateLeaveRequestResponseValidationError{
+				field:  "LeaveRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	if m.ReviewNotes != nil {
-		// no validation rules for ReviewNotes
+	if len(errors) > 0 {
+		return UpdateLeaveRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateLeaveRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateLeaveRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateLeaveRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateLeaveRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateLeaveRequestResponseMultiError) AllErrors() []error { return m }
+
+// UpdateLeaveRequestResponseValidationError is the validation error returned
+// by UpdateLeaveRequestResponse.Validate if the designated constraints aren't met.
+type UpdateLeaveRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateLeaveRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateLeaveRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateLeaveRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateLeaveRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateLeaveRequestResponseValidationError) ErrorName() string {
+	return "UpdateLeaveRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateLeaveRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateLeaveRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateLeaveRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateLeaveRequestResponseValidationError{}
+
+// Validate checks the field values on DeleteLeaveRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteLeaveRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteLeaveRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteLeaveRequestRequestMultiError, or nil if none found.
+func (m *DeleteLeaveRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteLeaveRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteLeaveRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteLeaveRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteLeaveRequestRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteLeaveRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteLeaveRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteLeaveRequestRequestMultiError) AllErrors() []error { return m }
+
+// DeleteLeaveRequestRequestValidationError is the validation error returned by
+// DeleteLeaveRequestRequest.Validate if the designated constraints aren't met.
+type DeleteLeaveRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteLeaveRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteLeaveRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteLeaveRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteLeaveRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteLeaveRequestRequestValidationError) ErrorName() string {
+	return "DeleteLeaveRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteLeaveRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteLeaveRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteLeaveRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteLeaveRequestRequestValidationError{}
+
+// Validate checks the field values on ApproveLeaveRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveLeaveRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveLeaveRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveLeaveRequestRequestMultiError, or nil if none found.
+func (m *ApproveLeaveRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveLeaveRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.ReviewNotes != nil {
+		// no validation rules for ReviewNotes
 	}
 
 	if m.ApproverEmail != nil {
@@ -1857,20 +2833,3557 @@ func (m *ApproveLeaveRequestResponse) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetCoverageWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApproveLeaveRequestResponseValidationError{
+						field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApproveLeaveRequestResponseValidationError{
+						field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApproveLeaveRequestResponseValidationError{
+					field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ApproveLeaveRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveLeaveRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by ApproveLeaveRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ApproveLeaveRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveLeaveRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveLeaveRequestResponseMultiError) AllErrors() []error { return m }
+
+// ApproveLeaveRequestResponseValidationError is the validation error returned
+// by ApproveLeaveRequestResponse.Validate if the designated constraints
+// aren't met.
+type ApproveLeaveRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveLeaveRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveLeaveRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveLeaveRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveLeaveRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveLeaveRequestResponseValidationError) ErrorName() string {
+	return "ApproveLeaveRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveLeaveRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveLeaveRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveLeaveRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveLeaveRequestResponseValidationError{}
+
+// Validate checks the field values on RejectLeaveRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectLeaveRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectLeaveRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectLeaveRequestRequestMultiError, or nil if none found.
+func (m *RejectLeaveRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectLeaveRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.ReviewNotes != nil {
+		// no validation rules for ReviewNotes
+	}
+
+	if len(errors) > 0 {
+		return RejectLeaveRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectLeaveRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by RejectLeaveRequestRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RejectLeaveRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectLeaveRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectLeaveRequestRequestMultiError) AllErrors() []error { return m }
+
+// RejectLeaveRequestRequestValidationError is the validation error returned by
+// RejectLeaveRequestRequest.Validate if the designated constraints aren't met.
+type RejectLeaveRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectLeaveRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectLeaveRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectLeaveRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectLeaveRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectLeaveRequestRequestValidationError) ErrorName() string {
+	return "RejectLeaveRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectLeaveRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectLeaveRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectLeaveRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectLeaveRequestRequestValidationError{}
+
+// Validate checks the field values on RejectLeaveRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectLeaveRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectLeaveRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectLeaveRequestResponseMultiError, or nil if none found.
+func (m *RejectLeaveRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectLeaveRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLeaveRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RejectLeaveRequestResponseValidationError{
+					field:  "LeaveRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RejectLeaveRequestResponseValidationError{
+					field:  "LeaveRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeaveRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RejectLeaveRequestResponseValidationError{
+				field:  "LeaveRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RejectLeaveRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectLeaveRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by RejectLeaveRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RejectLeaveRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectLeaveRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectLeaveRequestResponseMultiError) AllErrors() []error { return m }
+
+// RejectLeaveRequestResponseValidationError is the validation error returned
+// by RejectLeaveRequestResponse.Validate if the designated constraints aren't met.
+type RejectLeaveRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectLeaveRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectLeaveRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectLeaveRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectLeaveRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectLeaveRequestResponseValidationError) ErrorName() string {
+	return "RejectLeaveRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectLeaveRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectLeaveRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectLeaveRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectLeaveRequestResponseValidationError{}
+
+// Validate checks the field values on CancelLeaveRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelLeaveRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelLeaveRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelLeaveRequestRequestMultiError, or nil if none found.
+func (m *CancelLeaveRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelLeaveRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CancelLeaveRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelLeaveRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelLeaveRequestRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CancelLeaveRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelLeaveRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelLeaveRequestRequestMultiError) AllErrors() []error { return m }
+
+// CancelLeaveRequestRequestValidationError is the validation error returned by
+// CancelLeaveRequestRequest.Validate if the designated constraints aren't met.
+type CancelLeaveRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelLeaveRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelLeaveRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelLeaveRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelLeaveRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelLeaveRequestRequestValidationError) ErrorName() string {
+	return "CancelLeaveRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelLeaveRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelLeaveRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelLeaveRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelLeaveRequestRequestValidationError{}
+
+// Validate checks the field values on CancelLeaveRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelLeaveRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelLeaveRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelLeaveRequestResponseMultiError, or nil if none found.
+func (m *CancelLeaveRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelLeaveRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLeaveRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelLeaveRequestResponseValidationError{
+					field:  "LeaveRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelLeaveRequestResponseValidationError{
+					field:  "LeaveRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeaveRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelLeaveRequestResponseValidationError{
+				field:  "LeaveRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelLeaveRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelLeaveRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelLeaveRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CancelLeaveRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelLeaveRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelLeaveRequestResponseMultiError) AllErrors() []error { return m }
+
+// CancelLeaveRequestResponseValidationError is the validation error returned
+// by CancelLeaveRequestResponse.Validate if the designated constraints aren't met.
+type CancelLeaveRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelLeaveRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelLeaveRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelLeaveRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelLeaveRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelLeaveRequestResponseValidationError) ErrorName() string {
+	return "CancelLeaveRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelLeaveRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelLeaveRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelLeaveRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelLeaveRequestResponseValidationError{}
+
+// Validate checks the field values on RevokeLeaveRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeLeaveRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeLeaveRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeLeaveRequestRequestMultiError, or nil if none found.
+func (m *RevokeLeaveRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeLeaveRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if len(errors) > 0 {
+		return RevokeLeaveRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeLeaveRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeLeaveRequestRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RevokeLeaveRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeLeaveRequestRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeLeaveRequestRequestMultiError) AllErrors() []error { return m }
+
+// RevokeLeaveRequestRequestValidationError is the validation error returned by
+// RevokeLeaveRequestRequest.Validate if the designated constraints aren't met.
+type RevokeLeaveRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeLeaveRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeLeaveRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeLeaveRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeLeaveRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeLeaveRequestRequestValidationError) ErrorName() string {
+	return "RevokeLeaveRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeLeaveRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeLeaveRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeLeaveRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeLeaveRequestRequestValidationError{}
+
+// Validate checks the field values on RevokeLeaveRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeLeaveRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeLeaveRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeLeaveRequestResponseMultiError, or nil if none found.
+func (m *RevokeLeaveRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeLeaveRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLeaveRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevokeLeaveRequestResponseValidationError{
+					field:  "LeaveRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevokeLeaveRequestResponseValidationError{
+					field:  "LeaveRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeaveRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevokeLeaveRequestResponseValidationError{
+				field:  "LeaveRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RevokeLeaveRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeLeaveRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeLeaveRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeLeaveRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeLeaveRequestResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeLeaveRequestResponseMultiError) AllErrors() []error { return m }
+
+// RevokeLeaveRequestResponseValidationError is the validation error returned
+// by RevokeLeaveRequestResponse.Validate if the designated constraints aren't met.
+type RevokeLeaveRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeLeaveRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeLeaveRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeLeaveRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeLeaveRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeLeaveRequestResponseValidationError) ErrorName() string {
+	return "RevokeLeaveRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeLeaveRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeLeaveRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeLeaveRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeLeaveRequestResponseValidationError{}
+
+// Validate checks the field values on LeaveRequestSelection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LeaveRequestSelection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveRequestSelection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaveRequestSelectionMultiError, or nil if none found.
+func (m *LeaveRequestSelection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveRequestSelection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
+
+	if m.AbsenceTypeId != nil {
+		// no validation rules for AbsenceTypeId
+	}
+
+	if m.StartDate != nil {
+		// no validation rules for StartDate
+	}
+
+	if m.EndDate != nil {
+		// no validation rules for EndDate
+	}
+
+	if len(errors) > 0 {
+		return LeaveRequestSelectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveRequestSelectionMultiError is an error wrapping multiple validation
+// errors returned by LeaveRequestSelection.ValidateAll() if the designated
+// constraints aren't met.
+type LeaveRequestSelectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveRequestSelectionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveRequestSelectionMultiError) AllErrors() []error { return m }
+
+// LeaveRequestSelectionValidationError is the validation error returned by
+// LeaveRequestSelection.Validate if the designated constraints aren't met.
+type LeaveRequestSelectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveRequestSelectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveRequestSelectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveRequestSelectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveRequestSelectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveRequestSelectionValidationError) ErrorName() string {
+	return "LeaveRequestSelectionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaveRequestSelectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveRequestSelection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveRequestSelectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveRequestSelectionValidationError{}
+
+// Validate checks the field values on BulkApproveLeaveRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkApproveLeaveRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkApproveLeaveRequestsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BulkApproveLeaveRequestsRequestMultiError, or nil if none found.
+func (m *BulkApproveLeaveRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkApproveLeaveRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSelection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BulkApproveLeaveRequestsRequestValidationError{
+					field:  "Selection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BulkApproveLeaveRequestsRequestValidationError{
+					field:  "Selection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSelection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkApproveLeaveRequestsRequestValidationError{
+				field:  "Selection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.ReviewNotes != nil {
+		// no validation rules for ReviewNotes
+	}
+
+	if len(errors) > 0 {
+		return BulkApproveLeaveRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkApproveLeaveRequestsRequestMultiError is an error wrapping multiple
+// validation errors returned by BulkApproveLeaveRequestsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type BulkApproveLeaveRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkApproveLeaveRequestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkApproveLeaveRequestsRequestMultiError) AllErrors() []error { return m }
+
+// BulkApproveLeaveRequestsRequestValidationError is the validation error
+// returned by BulkApproveLeaveRequestsRequest.Validate if the designated
+// constraints aren't met.
+type BulkApproveLeaveRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkApproveLeaveRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkApproveLeaveRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkApproveLeaveRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkApproveLeaveRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkApproveLeaveRequestsRequestValidationError) ErrorName() string {
+	return "BulkApproveLeaveRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkApproveLeaveRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkApproveLeaveRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkApproveLeaveRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkApproveLeaveRequestsRequestValidationError{}
+
+// Validate checks the field values on BulkRejectLeaveRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkRejectLeaveRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkRejectLeaveRequestsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BulkRejectLeaveRequestsRequestMultiError, or nil if none found.
+func (m *BulkRejectLeaveRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkRejectLeaveRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSelection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BulkRejectLeaveRequestsRequestValidationError{
+					field:  "Selection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BulkRejectLeaveRequestsRequestValidationError{
+					field:  "Selection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSelection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkRejectLeaveRequestsRequestValidationError{
+				field:  "Selection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.ReviewNotes != nil {
+		// no validation rules for ReviewNotes
+	}
+
+	if len(errors) > 0 {
+		return BulkRejectLeaveRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkRejectLeaveRequestsRequestMultiError is an error wrapping multiple
+// validation errors returned by BulkRejectLeaveRequestsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type BulkRejectLeaveRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkRejectLeaveRequestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkRejectLeaveRequestsRequestMultiError) AllErrors() []error { return m }
+
+// BulkRejectLeaveRequestsRequestValidationError is the validation error
+// returned by BulkRejectLeaveRequestsRequest.Validate if the designated
+// constraints aren't met.
+type BulkRejectLeaveRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkRejectLeaveRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkRejectLeaveRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkRejectLeaveRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkRejectLeaveRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkRejectLeaveRequestsRequestValidationError) ErrorName() string {
+	return "BulkRejectLeaveRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkRejectLeaveRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkRejectLeaveRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkRejectLeaveRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkRejectLeaveRequestsRequestValidationError{}
+
+// Validate checks the field values on BulkRevokeLeaveRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkRevokeLeaveRequestsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkRevokeLeaveRequestsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BulkRevokeLeaveRequestsRequestMultiError, or nil if none found.
+func (m *BulkRevokeLeaveRequestsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkRevokeLeaveRequestsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSelection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BulkRevokeLeaveRequestsRequestValidationError{
+					field:  "Selection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BulkRevokeLeaveRequestsRequestValidationError{
+					field:  "Selection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSelection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkRevokeLeaveRequestsRequestValidationError{
+				field:  "Selection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if len(errors) > 0 {
+		return BulkRevokeLeaveRequestsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkRevokeLeaveRequestsRequestMultiError is an error wrapping multiple
+// validation errors returned by BulkRevokeLeaveRequestsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type BulkRevokeLeaveRequestsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkRevokeLeaveRequestsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkRevokeLeaveRequestsRequestMultiError) AllErrors() []error { return m }
+
+// BulkRevokeLeaveRequestsRequestValidationError is the validation error
+// returned by BulkRevokeLeaveRequestsRequest.Validate if the designated
+// constraints aren't met.
+type BulkRevokeLeaveRequestsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkRevokeLeaveRequestsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkRevokeLeaveRequestsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkRevokeLeaveRequestsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkRevokeLeaveRequestsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkRevokeLeaveRequestsRequestValidationError) ErrorName() string {
+	return "BulkRevokeLeaveRequestsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkRevokeLeaveRequestsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkRevokeLeaveRequestsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkRevokeLeaveRequestsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkRevokeLeaveRequestsRequestValidationError{}
+
+// Validate checks the field values on BulkLeaveRequestResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkLeaveRequestResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkLeaveRequestResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkLeaveRequestResultMultiError, or nil if none found.
+func (m *BulkLeaveRequestResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkLeaveRequestResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Success
+
+	if all {
+		switch v := interface{}(m.GetLeaveRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BulkLeaveRequestResultValidationError{
+					field:  "LeaveRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BulkLeaveRequestResultValidationError{
+					field:  "LeaveRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeaveRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkLeaveRequestResultValidationError{
+				field:  "LeaveRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ErrorReason
+
+	// no validation rules for ErrorMessage
+
+	for idx, item := range m.GetCoverageWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BulkLeaveRequestResultValidationError{
+						field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BulkLeaveRequestResultValidationError{
+						field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BulkLeaveRequestResultValidationError{
+					field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BulkLeaveRequestResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkLeaveRequestResultMultiError is an error wrapping multiple validation
+// errors returned by BulkLeaveRequestResult.ValidateAll() if the designated
+// constraints aren't met.
+type BulkLeaveRequestResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkLeaveRequestResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkLeaveRequestResultMultiError) AllErrors() []error { return m }
+
+// BulkLeaveRequestResultValidationError is the validation error returned by
+// BulkLeaveRequestResult.Validate if the designated constraints aren't met.
+type BulkLeaveRequestResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkLeaveRequestResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkLeaveRequestResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkLeaveRequestResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkLeaveRequestResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkLeaveRequestResultValidationError) ErrorName() string {
+	return "BulkLeaveRequestResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkLeaveRequestResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkLeaveRequestResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkLeaveRequestResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkLeaveRequestResultValidationError{}
+
+// Validate checks the field values on BulkLeaveRequestsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkLeaveRequestsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkLeaveRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkLeaveRequestsResponseMultiError, or nil if none found.
+func (m *BulkLeaveRequestsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkLeaveRequestsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BulkLeaveRequestsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BulkLeaveRequestsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BulkLeaveRequestsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Succeeded
+
+	// no validation rules for Failed
+
+	if len(errors) > 0 {
+		return BulkLeaveRequestsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkLeaveRequestsResponseMultiError is an error wrapping multiple validation
+// errors returned by BulkLeaveRequestsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type BulkLeaveRequestsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkLeaveRequestsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkLeaveRequestsResponseMultiError) AllErrors() []error { return m }
+
+// BulkLeaveRequestsResponseValidationError is the validation error returned by
+// BulkLeaveRequestsResponse.Validate if the designated constraints aren't met.
+type BulkLeaveRequestsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkLeaveRequestsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkLeaveRequestsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkLeaveRequestsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkLeaveRequestsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkLeaveRequestsResponseValidationError) ErrorName() string {
+	return "BulkLeaveRequestsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkLeaveRequestsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkLeaveRequestsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkLeaveRequestsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkLeaveRequestsResponseValidationError{}
+
+// Validate checks the field values on CancelFollowingOccurrencesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CancelFollowingOccurrencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelFollowingOccurrencesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CancelFollowingOccurrencesRequestMultiError, or nil if none found.
+func (m *CancelFollowingOccurrencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelFollowingOccurrencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CancelFollowingOccurrencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelFollowingOccurrencesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CancelFollowingOccurrencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelFollowingOccurrencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelFollowingOccurrencesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelFollowingOccurrencesRequestMultiError) AllErrors() []error { return m }
+
+// CancelFollowingOccurrencesRequestValidationError is the validation error
+// returned by CancelFollowingOccurrencesRequest.Validate if the designated
+// constraints aren't met.
+type CancelFollowingOccurrencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelFollowingOccurrencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelFollowingOccurrencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelFollowingOccurrencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelFollowingOccurrencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelFollowingOccurrencesRequestValidationError) ErrorName() string {
+	return "CancelFollowingOccurrencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelFollowingOccurrencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelFollowingOccurrencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelFollowingOccurrencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelFollowingOccurrencesRequestValidationError{}
+
+// Validate checks the field values on UpdateFollowingOccurrencesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateFollowingOccurrencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFollowingOccurrencesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateFollowingOccurrencesRequestMultiError, or nil if none found.
+func (m *UpdateFollowingOccurrencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFollowingOccurrencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateFollowingOccurrencesRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateFollowingOccurrencesRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateFollowingOccurrencesRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if m.Notes != nil {
+		// no validation rules for Notes
+	}
+
+	if m.Recurrence != nil {
+
+		if all {
+			switch v := interface{}(m.GetRecurrence()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateFollowingOccurrencesRequestValidationError{
+						field:  "Recurrence",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateFollowingOccurrencesRequestValidationError{
+						field:  "Recurrence",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRecurrence()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateFollowingOccurrencesRequestValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.PolicyOverrideReason != nil {
+		// no validation rules for PolicyOverrideReason
+	}
+
+	if len(errors) > 0 {
+		return UpdateFollowingOccurrencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFollowingOccurrencesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateFollowingOccurrencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateFollowingOccurrencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFollowingOccurrencesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFollowingOccurrencesRequestMultiError) AllErrors() []error { return m }
+
+// UpdateFollowingOccurrencesRequestValidationError is the validation error
+// returned by UpdateFollowingOccurrencesRequest.Validate if the designated
+// constraints aren't met.
+type UpdateFollowingOccurrencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFollowingOccurrencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFollowingOccurrencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFollowingOccurrencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFollowingOccurrencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFollowingOccurrencesRequestValidationError) ErrorName() string {
+	return "UpdateFollowingOccurrencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFollowingOccurrencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFollowingOccurrencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFollowingOccurrencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFollowingOccurrencesRequestValidationError{}
+
+// Validate checks the field values on UpdateFollowingOccurrencesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateFollowingOccurrencesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFollowingOccurrencesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateFollowingOccurrencesResponseMultiError, or nil if none found.
+func (m *UpdateFollowingOccurrencesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFollowingOccurrencesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateFollowingOccurrencesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateFollowingOccurrencesResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateFollowingOccurrencesResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetOccurrences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateFollowingOccurrencesResponseValidationError{
+						field:  fmt.Sprintf("Occurrences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateFollowingOccurrencesResponseValidationError{
+						field:  fmt.Sprintf("Occurrences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateFollowingOccurrencesResponseValidationError{
+					field:  fmt.Sprintf("Occurrences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateFollowingOccurrencesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFollowingOccurrencesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateFollowingOccurrencesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateFollowingOccurrencesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFollowingOccurrencesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFollowingOccurrencesResponseMultiError) AllErrors() []error { return m }
+
+// UpdateFollowingOccurrencesResponseValidationError is the validation error
+// returned by UpdateFollowingOccurrencesResponse.Validate if the designated
+// constraints aren't met.
+type UpdateFollowingOccurrencesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFollowingOccurrencesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFollowingOccurrencesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFollowingOccurrencesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFollowingOccurrencesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFollowingOccurrencesResponseValidationError) ErrorName() string {
+	return "UpdateFollowingOccurrencesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFollowingOccurrencesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFollowingOccurrencesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFollowingOccurrencesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFollowingOccurrencesResponseValidationError{}
+
+// Validate checks the field values on ApproveLeaveSeriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveLeaveSeriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveLeaveSeriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveLeaveSeriesRequestMultiError, or nil if none found.
+func (m *ApproveLeaveSeriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveLeaveSeriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SeriesId
+
+	if m.ReviewNotes != nil {
+		// no validation rules for ReviewNotes
+	}
+
+	if len(errors) > 0 {
+		return ApproveLeaveSeriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveLeaveSeriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveLeaveSeriesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ApproveLeaveSeriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveLeaveSeriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveLeaveSeriesRequestMultiError) AllErrors() []error { return m }
+
+// ApproveLeaveSeriesRequestValidationError is the validation error returned by
+// ApproveLeaveSeriesRequest.Validate if the designated constraints aren't met.
+type ApproveLeaveSeriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveLeaveSeriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveLeaveSeriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveLeaveSeriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveLeaveSeriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveLeaveSeriesRequestValidationError) ErrorName() string {
+	return "ApproveLeaveSeriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveLeaveSeriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveLeaveSeriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveLeaveSeriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveLeaveSeriesRequestValidationError{}
+
+// Validate checks the field values on RejectLeaveSeriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectLeaveSeriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectLeaveSeriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectLeaveSeriesRequestMultiError, or nil if none found.
+func (m *RejectLeaveSeriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectLeaveSeriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SeriesId
+
+	if m.ReviewNotes != nil {
+		// no validation rules for ReviewNotes
+	}
+
+	if len(errors) > 0 {
+		return RejectLeaveSeriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectLeaveSeriesRequestMultiError is an error wrapping multiple validation
+// errors returned by RejectLeaveSeriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RejectLeaveSeriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectLeaveSeriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectLeaveSeriesRequestMultiError) AllErrors() []error { return m }
+
+// RejectLeaveSeriesRequestValidationError is the validation error returned by
+// RejectLeaveSeriesRequest.Validate if the designated constraints aren't met.
+type RejectLeaveSeriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectLeaveSeriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectLeaveSeriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectLeaveSeriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectLeaveSeriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectLeaveSeriesRequestValidationError) ErrorName() string {
+	return "RejectLeaveSeriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectLeaveSeriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectLeaveSeriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectLeaveSeriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectLeaveSeriesRequestValidationError{}
+
+// Validate checks the field values on CalendarEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CalendarEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalendarEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CalendarEventMultiError, or
+// nil if none found.
+func (m *CalendarEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalendarEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for UserName
+
+	// no validation rules for AbsenceTypeId
+
+	// no validation rules for AbsenceTypeName
+
+	// no validation rules for Color
+
+	if all {
+		switch v := interface{}(m.GetStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CalendarEventValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CalendarEventValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CalendarEventValidationError{
+				field:  "StartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CalendarEventValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CalendarEventValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CalendarEventValidationError{
+				field:  "EndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Days
+
+	// no validation rules for Status
+
+	// no validation rules for OrgUnitName
+
+	// no validation rules for StartDayPart
+
+	// no validation rules for EndDayPart
+
+	// no validation rules for Hours
+
+	if len(errors) > 0 {
+		return CalendarEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalendarEventMultiError is an error wrapping multiple validation errors
+// returned by CalendarEvent.ValidateAll() if the designated constraints
+// aren't met.
+type CalendarEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalendarEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalendarEventMultiError) AllErrors() []error { return m }
+
+// CalendarEventValidationError is the validation error returned by
+// CalendarEvent.Validate if the designated constraints aren't met.
+type CalendarEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalendarEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalendarEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalendarEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalendarEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalendarEventValidationError) ErrorName() string { return "CalendarEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CalendarEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalendarEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalendarEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalendarEventValidationError{}
+
+// Validate checks the field values on GetSignedDocumentUrlRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSignedDocumentUrlRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSignedDocumentUrlRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSignedDocumentUrlRequestMultiError, or nil if none found.
+func (m *GetSignedDocumentUrlRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSignedDocumentUrlRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LeaveRequestId
+
+	if len(errors) > 0 {
+		return GetSignedDocumentUrlRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSignedDocumentUrlRequestMultiError is an error wrapping multiple
+// validation errors returned by GetSignedDocumentUrlRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetSignedDocumentUrlRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSignedDocumentUrlRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSignedDocumentUrlRequestMultiError) AllErrors() []error { return m }
+
+// GetSignedDocumentUrlRequestValidationError is the validation error returned
+// by GetSignedDocumentUrlRequest.Validate if the designated constraints
+// aren't met.
+type GetSignedDocumentUrlRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSignedDocumentUrlRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSignedDocumentUrlRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSignedDocumentUrlRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSignedDocumentUrlRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSignedDocumentUrlRequestValidationError) ErrorName() string {
+	return "GetSignedDocumentUrlRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSignedDocumentUrlRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSignedDocumentUrlRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSignedDocumentUrlRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSignedDocumentUrlRequestValidationError{}
+
+// Validate checks the field values on GetSignedDocumentUrlResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSignedDocumentUrlResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSignedDocumentUrlResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSignedDocumentUrlResponseMultiError, or nil if none found.
+func (m *GetSignedDocumentUrlResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSignedDocumentUrlResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return GetSignedDocumentUrlResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSignedDocumentUrlResponseMultiError is an error wrapping multiple
+// validation errors returned by GetSignedDocumentUrlResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetSignedDocumentUrlResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSignedDocumentUrlResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSignedDocumentUrlResponseMultiError) AllErrors() []error { return m }
+
+// GetSignedDocumentUrlResponseValidationError is the validation error returned
+// by GetSignedDocumentUrlResponse.Validate if the designated constraints
+// aren't met.
+type GetSignedDocumentUrlResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSignedDocumentUrlResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSignedDocumentUrlResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSignedDocumentUrlResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSignedDocumentUrlResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSignedDocumentUrlResponseValidationError) ErrorName() string {
+	return "GetSignedDocumentUrlResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSignedDocumentUrlResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSignedDocumentUrlResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSignedDocumentUrlResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSignedDocumentUrlResponseValidationError{}
+
+// Validate checks the field values on GetCalendarEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCalendarEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCalendarEventsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCalendarEventsRequestMultiError, or nil if none found.
+func (m *GetCalendarEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCalendarEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.StartDate != nil {
+		// no validation rules for StartDate
+	}
+
+	if m.EndDate != nil {
+		// no validation rules for EndDate
+	}
+
+	if m.OrgUnitName != nil {
+		// no validation rules for OrgUnitName
+	}
+
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
+
+	if m.HolidayCalendarId != nil {
+		// no validation rules for HolidayCalendarId
+	}
+
+	if len(errors) > 0 {
+		return GetCalendarEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCalendarEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCalendarEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCalendarEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCalendarEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCalendarEventsRequestMultiError) AllErrors() []error { return m }
+
+// GetCalendarEventsRequestValidationError is the validation error returned by
+// GetCalendarEventsRequest.Validate if the designated constraints aren't met.
+type GetCalendarEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCalendarEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCalendarEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCalendarEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCalendarEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCalendarEventsRequestValidationError) ErrorName() string {
+	return "GetCalendarEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCalendarEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCalendarEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCalendarEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCalendarEventsRequestValidationError{}
+
+// Validate checks the field values on CalendarHoliday with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CalendarHoliday) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalendarHoliday with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CalendarHolidayMultiError, or nil if none found.
+func (m *CalendarHoliday) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalendarHoliday) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HolidayId
+
+	// no validation rules for CalendarId
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CalendarHolidayValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CalendarHolidayValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CalendarHolidayValidationError{
+				field:  "Date",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CalendarHolidayMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalendarHolidayMultiError is an error wrapping multiple validation errors
+// returned by CalendarHoliday.ValidateAll() if the designated constraints
+// aren't met.
+type CalendarHolidayMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalendarHolidayMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalendarHolidayMultiError) AllErrors() []error { return m }
+
+// CalendarHolidayValidationError is the validation error returned by
+// CalendarHoliday.Validate if the designated constraints aren't met.
+type CalendarHolidayValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalendarHolidayValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalendarHolidayValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalendarHolidayValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalendarHolidayValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalendarHolidayValidationError) ErrorName() string { return "CalendarHolidayValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CalendarHolidayValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalendarHoliday.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalendarHolidayValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalendarHolidayValidationError{}
+
+// Validate checks the field values on GetCalendarEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCalendarEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCalendarEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCalendarEventsResponseMultiError, or nil if none found.
+func (m *GetCalendarEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCalendarEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCalendarEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCalendarEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCalendarEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetHolidays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCalendarEventsResponseValidationError{
+						field:  fmt.Sprintf("Holidays[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCalendarEventsResponseValidationError{
+						field:  fmt.Sprintf("Holidays[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCalendarEventsResponseValidationError{
+					field:  fmt.Sprintf("Holidays[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetCalendarEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCalendarEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCalendarEventsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetCalendarEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCalendarEventsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCalendarEventsResponseMultiError) AllErrors() []error { return m }
+
+// GetCalendarEventsResponseValidationError is the validation error returned by
+// GetCalendarEventsResponse.Validate if the designated constraints aren't met.
+type GetCalendarEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCalendarEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCalendarEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCalendarEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCalendarEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCalendarEventsResponseValidationError) ErrorName() string {
+	return "GetCalendarEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCalendarEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCalendarEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCalendarEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCalendarEventsResponseValidationError{}
+
+// Validate checks the field values on LeaveAmendment with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LeaveAmendment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveAmendment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LeaveAmendmentMultiError,
+// or nil if none found.
+func (m *LeaveAmendment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveAmendment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Id != nil {
+		// no validation rules for Id
+	}
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.LeaveRequestId != nil {
+		// no validation rules for LeaveRequestId
+	}
+
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.Kind != nil {
+		// no validation rules for Kind
+	}
+
+	if m.PreviousStartDate != nil {
+
+		if all {
+			switch v := interface{}(m.GetPreviousStartDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "PreviousStartDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "PreviousStartDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPreviousStartDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveAmendmentValidationError{
+					field:  "PreviousStartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.PreviousEndDate != nil {
+
+		if all {
+			switch v := interface{}(m.GetPreviousEndDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "PreviousEndDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "PreviousEndDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPreviousEndDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveAmendmentValidationError{
+					field:  "PreviousEndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.PreviousStartDayPart != nil {
+		// no validation rules for PreviousStartDayPart
+	}
+
+	if m.PreviousEndDayPart != nil {
+		// no validation rules for PreviousEndDayPart
+	}
+
+	if m.PreviousDays != nil {
+		// no validation rules for PreviousDays
+	}
+
+	if m.StartDate != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "StartDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "StartDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveAmendmentValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.EndDate != nil {
+
+		if all {
+			switch v := interface{}(m.GetEndDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "EndDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "EndDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveAmendmentValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.StartDayPart != nil {
+		// no validation rules for StartDayPart
+	}
+
+	if m.EndDayPart != nil {
+		// no validation rules for EndDayPart
+	}
+
+	if m.Hours != nil {
+		// no validation rules for Hours
+	}
+
+	if m.Days != nil {
+		// no validation rules for Days
+	}
+
+	if m.HolidayCalendarId != nil {
+		// no validation rules for HolidayCalendarId
+	}
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if m.ReviewedBy != nil {
+		// no validation rules for ReviewedBy
+	}
+
+	if m.ReviewerName != nil {
+		// no validation rules for ReviewerName
+	}
+
+	if m.ReviewedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetReviewedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "ReviewedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "ReviewedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReviewedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveAmendmentValidationError{
+					field:  "ReviewedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ReviewNotes != nil {
+		// no validation rules for ReviewNotes
+	}
+
+	if m.SigningRequestId != nil {
+		// no validation rules for SigningRequestId
+	}
+
+	if m.PolicyOverride != nil {
+
+		if all {
+			switch v := interface{}(m.GetPolicyOverride()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "PolicyOverride",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "PolicyOverride",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPolicyOverride()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveAmendmentValidationError{
+					field:  "PolicyOverride",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CreatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetCreatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveAmendmentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.UpdatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetUpdatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "UpdatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LeaveAmendmentValidationError{
+						field:  "UpdatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LeaveAmendmentValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if m.UpdatedBy != nil {
+		// no validation rules for UpdatedBy
+	}
+
 	if len(errors) > 0 {
-		return ApproveLeaveRequestResponseMultiError(errors)
+		return LeaveAmendmentMultiError(errors)
 	}
 
 	return nil
 }
 
-// ApproveLeaveRequestResponseMultiError is an error wrapping multiple
-// validation errors returned by ApproveLeaveRequestResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ApproveLeaveRequestResponseMultiError []error
+// LeaveAmendmentMultiError is an error wrapping multiple validation errors
+// returned by LeaveAmendment.ValidateAll() if the designated constraints
+// aren't met.
+type LeaveAmendmentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ApproveLeaveRequestResponseMultiError) Error() string {
+func (m LeaveAmendmentMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1879,12 +6392,11 @@ func (m ApproveLeaveRequestResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ApproveLeaveRequestResponseMultiError) AllErrors() []error { return m }
+func (m LeaveAmendmentMultiError) AllErrors() []error { return m }
 
-// ApproveLeaveRequestResponseValidationError is the validation error returned
-// by ApproveLeaveRequestResponse.Validate if the designated constraints
-// aren't met.
-type ApproveLeaveRequestResponseValidationError struct {
+// LeaveAmendmentValidationError is the validation error returned by
+// LeaveAmendment.Validate if the designated constraints aren't met.
+type LeaveAmendmentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1892,24 +6404,22 @@ type ApproveLeaveRequestResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApproveLeaveRequestResponseValidationError) Field() string { return e.field }
+func (e LeaveAmendmentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApproveLeaveRequestResponseValidationError) Reason() string { return e.reason }
+func (e LeaveAmendmentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApproveLeaveRequestResponseValidationError) Cause() error { return e.cause }
+func (e LeaveAmendmentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApproveLeaveRequestResponseValidationError) Key() bool { return e.key }
+func (e LeaveAmendmentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApproveLeaveRequestResponseValidationError) ErrorName() string {
-	return "ApproveLeaveRequestResponseValidationError"
-}
+func (e LeaveAmendmentValidationError) ErrorName() string { return "LeaveAmendmentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ApproveLeaveRequestResponseValidationError) Error() string {
+func (e LeaveAmendmentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1921,14 +6431,14 @@ func (e ApproveLeaveRequestResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApproveLeaveRequestResponse.%s: %s%s",
+		"invalid %sLeaveAmendment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApproveLeaveRequestResponseValidationError{}
+var _ error = LeaveAmendmentValidationError{}
 
 var _ interface {
 	Field() string
@@ -1936,24 +6446,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApproveLeaveRequestResponseValidationError{}
+} = LeaveAmendmentValidationError{}
 
-// Validate checks the field values on RejectLeaveRequestRequest with the rules
+// Validate checks the field values on ChangeLeaveDatesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RejectLeaveRequestRequest) Validate() error {
+func (m *ChangeLeaveDatesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RejectLeaveRequestRequest with the
+// ValidateAll checks the field values on ChangeLeaveDatesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RejectLeaveRequestRequestMultiError, or nil if none found.
-func (m *RejectLeaveRequestRequest) ValidateAll() error {
+// ChangeLeaveDatesRequestMultiError, or nil if none found.
+func (m *ChangeLeaveDatesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RejectLeaveRequestRequest) validate(all bool) error {
+func (m *ChangeLeaveDatesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1962,24 +6472,98 @@ func (m *RejectLeaveRequestRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
-	if m.ReviewNotes != nil {
-		// no validation rules for ReviewNotes
+	if all {
+		switch v := interface{}(m.GetStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeLeaveDatesRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChangeLeaveDatesRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeLeaveDatesRequestValidationError{
+				field:  "StartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeLeaveDatesRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChangeLeaveDatesRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeLeaveDatesRequestValidationError{
+				field:  "EndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.StartDayPart != nil {
+		// no validation rules for StartDayPart
+	}
+
+	if m.EndDayPart != nil {
+		// no validation rules for EndDayPart
+	}
+
+	if m.Days != nil {
+		// no validation rules for Days
+	}
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if m.PolicyOverrideReason != nil {
+		// no validation rules for PolicyOverrideReason
 	}
 
 	if len(errors) > 0 {
-		return RejectLeaveRequestRequestMultiError(errors)
+		return ChangeLeaveDatesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RejectLeaveRequestRequestMultiError is an error wrapping multiple validation
-// errors returned by RejectLeaveRequestRequest.ValidateAll() if the
-// designated constraints aren't met.
-type RejectLeaveRequestRequestMultiError []error
+// ChangeLeaveDatesRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangeLeaveDatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeLeaveDatesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RejectLeaveRequestRequestMultiError) Error() string {
+func (m ChangeLeaveDatesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1988,11 +6572,11 @@ func (m RejectLeaveRequestRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RejectLeaveRequestRequestMultiError) AllErrors() []error { return m }
+func (m ChangeLeaveDatesRequestMultiError) AllErrors() []error { return m }
 
-// RejectLeaveRequestRequestValidationError is the validation error returned by
-// RejectLeaveRequestRequest.Validate if the designated constraints aren't met.
-type RejectLeaveRequestRequestValidationError struct {
+// ChangeLeaveDatesRequestValidationError is the validation error returned by
+// ChangeLeaveDatesRequest.Validate if the designated constraints aren't met.
+type ChangeLeaveDatesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2000,24 +6584,24 @@ type RejectLeaveRequestRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RejectLeaveRequestRequestValidationError) Field() string { return e.field }
+func (e ChangeLeaveDatesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RejectLeaveRequestRequestValidationError) Reason() string { return e.reason }
+func (e ChangeLeaveDatesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RejectLeaveRequestRequestValidationError) Cause() error { return e.cause }
+func (e ChangeLeaveDatesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RejectLeaveRequestRequestValidationError) Key() bool { return e.key }
+func (e ChangeLeaveDatesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RejectLeaveRequestRequestValidationError) ErrorName() string {
-	return "RejectLeaveRequestRequestValidationError"
+func (e ChangeLeaveDatesRequestValidationError) ErrorName() string {
+	return "ChangeLeaveDatesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RejectLeaveRequestRequestValidationError) Error() string {
+func (e ChangeLeaveDatesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2029,14 +6613,14 @@ func (e RejectLeaveRequestRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRejectLeaveRequestRequest.%s: %s%s",
+		"invalid %sChangeLeaveDatesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RejectLeaveRequestRequestValidationError{}
+var _ error = ChangeLeaveDatesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2044,24 +6628,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RejectLeaveRequestRequestValidationError{}
+} = ChangeLeaveDatesRequestValidationError{}
 
-// Validate checks the field values on RejectLeaveRequestResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ChangeLeaveDatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RejectLeaveRequestResponse) Validate() error {
+func (m *ChangeLeaveDatesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RejectLeaveRequestResponse with the
+// ValidateAll checks the field values on ChangeLeaveDatesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RejectLeaveRequestResponseMultiError, or nil if none found.
-func (m *RejectLeaveRequestResponse) ValidateAll() error {
+// ChangeLeaveDatesResponseMultiError, or nil if none found.
+func (m *ChangeLeaveDatesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RejectLeaveRequestResponse) validate(all bool) error {
+func (m *ChangeLeaveDatesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2072,7 +6656,7 @@ func (m *RejectLeaveRequestResponse) validate(all bool) error {
 		switch v := interface{}(m.GetLeaveRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RejectLeaveRequestResponseValidationError{
+				errors = append(errors, ChangeLeaveDatesResponseValidationError{
 					field:  "LeaveRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2080,7 +6664,7 @@ func (m *RejectLeaveRequestResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RejectLeaveRequestResponseValidationError{
+				errors = append(errors, ChangeLeaveDatesResponseValidationError{
 					field:  "LeaveRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2089,7 +6673,7 @@ func (m *RejectLeaveRequestResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetLeaveRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RejectLeaveRequestResponseValidationError{
+			return ChangeLeaveDatesResponseValidationError{
 				field:  "LeaveRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2097,20 +6681,49 @@ func (m *RejectLeaveRequestResponse) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetAmendment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeLeaveDatesResponseValidationError{
+					field:  "Amendment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChangeLeaveDatesResponseValidationError{
+					field:  "Amendment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmendment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeLeaveDatesResponseValidationError{
+				field:  "Amendment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return RejectLeaveRequestResponseMultiError(errors)
+		return ChangeLeaveDatesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RejectLeaveRequestResponseMultiError is an error wrapping multiple
-// validation errors returned by RejectLeaveRequestResponse.ValidateAll() if
-// the designated constraints aren't met.
-type RejectLeaveRequestResponseMultiError []error
+// ChangeLeaveDatesResponseMultiError is an error wrapping multiple validation
+// errors returned by ChangeLeaveDatesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeLeaveDatesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RejectLeaveRequestResponseMultiError) Error() string {
+func (m ChangeLeaveDatesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2119,11 +6732,11 @@ func (m RejectLeaveRequestResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RejectLeaveRequestResponseMultiError) AllErrors() []error { return m }
+func (m ChangeLeaveDatesResponseMultiError) AllErrors() []error { return m }
 
-// RejectLeaveRequestResponseValidationError is the validation error returned
-// by RejectLeaveRequestResponse.Validate if the designated constraints aren't met.
-type RejectLeaveRequestResponseValidationError struct {
+// ChangeLeaveDatesResponseValidationError is the validation error returned by
+// ChangeLeaveDatesResponse.Validate if the designated constraints aren't met.
+type ChangeLeaveDatesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2131,24 +6744,24 @@ type RejectLeaveRequestResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RejectLeaveRequestResponseValidationError) Field() string { return e.field }
+func (e ChangeLeaveDatesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RejectLeaveRequestResponseValidationError) Reason() string { return e.reason }
+func (e ChangeLeaveDatesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RejectLeaveRequestResponseValidationError) Cause() error { return e.cause }
+func (e ChangeLeaveDatesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RejectLeaveRequestResponseValidationError) Key() bool { return e.key }
+func (e ChangeLeaveDatesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RejectLeaveRequestResponseValidationError) ErrorName() string {
-	return "RejectLeaveRequestResponseValidationError"
+func (e ChangeLeaveDatesResponseValidationError) ErrorName() string {
+	return "ChangeLeaveDatesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RejectLeaveRequestResponseValidationError) Error() string {
+func (e ChangeLeaveDatesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2160,14 +6773,14 @@ func (e RejectLeaveRequestResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRejectLeaveRequestResponse.%s: %s%s",
+		"invalid %sChangeLeaveDatesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RejectLeaveRequestResponseValidationError{}
+var _ error = ChangeLeaveDatesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2175,46 +6788,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RejectLeaveRequestResponseValidationError{}
+} = ChangeLeaveDatesResponseValidationError{}
 
-// Validate checks the field values on CancelLeaveRequestRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListLeaveAmendmentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelLeaveRequestRequest) Validate() error {
+func (m *ListLeaveAmendmentsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelLeaveRequestRequest with the
+// ValidateAll checks the field values on ListLeaveAmendmentsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelLeaveRequestRequestMultiError, or nil if none found.
-func (m *CancelLeaveRequestRequest) ValidateAll() error {
+// ListLeaveAmendmentsRequestMultiError, or nil if none found.
+func (m *ListLeaveAmendmentsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelLeaveRequestRequest) validate(all bool) error {
+func (m *ListLeaveAmendmentsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for LeaveRequestId
 
 	if len(errors) > 0 {
-		return CancelLeaveRequestRequestMultiError(errors)
+		return ListLeaveAmendmentsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelLeaveRequestRequestMultiError is an error wrapping multiple validation
-// errors returned by CancelLeaveRequestRequest.ValidateAll() if the
-// designated constraints aren't met.
-type CancelLeaveRequestRequestMultiError []error
+// ListLeaveAmendmentsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListLeaveAmendmentsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListLeaveAmendmentsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelLeaveRequestRequestMultiError) Error() string {
+func (m ListLeaveAmendmentsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2223,11 +6836,11 @@ func (m CancelLeaveRequestRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelLeaveRequestRequestMultiError) AllErrors() []error { return m }
+func (m ListLeaveAmendmentsRequestMultiError) AllErrors() []error { return m }
 
-// CancelLeaveRequestRequestValidationError is the validation error returned by
-// CancelLeaveRequestRequest.Validate if the designated constraints aren't met.
-type CancelLeaveRequestRequestValidationError struct {
+// ListLeaveAmendmentsRequestValidationError is the validation error returned
+// by ListLeaveAmendmentsRequest.Validate if the designated constraints aren't met.
+type ListLeaveAmendmentsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2235,24 +6848,24 @@ type CancelLeaveRequestRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelLeaveRequestRequestValidationError) Field() string { return e.field }
+func (e ListLeaveAmendmentsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelLeaveRequestRequestValidationError) Reason() string { return e.reason }
+func (e ListLeaveAmendmentsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelLeaveRequestRequestValidationError) Cause() error { return e.cause }
+func (e ListLeaveAmendmentsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelLeaveRequestRequestValidationError) Key() bool { return e.key }
+func (e ListLeaveAmendmentsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelLeaveRequestRequestValidationError) ErrorName() string {
-	return "CancelLeaveRequestRequestValidationError"
+func (e ListLeaveAmendmentsRequestValidationError) ErrorName() string {
+	return "ListLeaveAmendmentsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelLeaveRequestRequestValidationError) Error() string {
+func (e ListLeaveAmendmentsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2264,14 +6877,14 @@ func (e CancelLeaveRequestRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelLeaveRequestRequest.%s: %s%s",
+		"invalid %sListLeaveAmendmentsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelLeaveRequestRequestValidationError{}
+var _ error = ListLeaveAmendmentsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2279,73 +6892,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelLeaveRequestRequestValidationError{}
+} = ListLeaveAmendmentsRequestValidationError{}
 
-// Validate checks the field values on CancelLeaveRequestResponse with the
+// Validate checks the field values on ListLeaveAmendmentsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelLeaveRequestResponse) Validate() error {
+func (m *ListLeaveAmendmentsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelLeaveRequestResponse with the
+// ValidateAll checks the field values on ListLeaveAmendmentsResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelLeaveRequestResponseMultiError, or nil if none found.
-func (m *CancelLeaveRequestResponse) ValidateAll() error {
+// ListLeaveAmendmentsResponseMultiError, or nil if none found.
+func (m *ListLeaveAmendmentsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelLeaveRequestResponse) validate(all bool) error {
+func (m *ListLeaveAmendmentsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetLeaveRequest()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CancelLeaveRequestResponseValidationError{
-					field:  "LeaveRequest",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CancelLeaveRequestResponseValidationError{
-					field:  "LeaveRequest",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetLeaveRequest()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CancelLeaveRequestResponseValidationError{
-				field:  "LeaveRequest",
-				reason: "embedded message failed validation",
-				cause:  err,
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLeaveAmendmentsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLeaveAmendmentsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLeaveAmendmentsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CancelLeaveRequestResponseMultiError(errors)
+		return ListLeaveAmendmentsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelLeaveRequestResponseMultiError is an error wrapping multiple
-// validation errors returned by CancelLeaveRequestResponse.ValidateAll() if
+// ListLeaveAmendmentsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListLeaveAmendmentsResponse.ValidateAll() if
 // the designated constraints aren't met.
-type CancelLeaveRequestResponseMultiError []error
+type ListLeaveAmendmentsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelLeaveRequestResponseMultiError) Error() string {
+func (m ListLeaveAmendmentsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2354,11 +6972,12 @@ func (m CancelLeaveRequestResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelLeaveRequestResponseMultiError) AllErrors() []error { return m }
+func (m ListLeaveAmendmentsResponseMultiError) AllErrors() []error { return m }
 
-// CancelLeaveRequestResponseValidationError is the validation error returned
-// by CancelLeaveRequestResponse.Validate if the designated constraints aren't met.
-type CancelLeaveRequestResponseValidationError struct {
+// ListLeaveAmendmentsResponseValidationError is the validation error returned
+// by ListLeaveAmendmentsResponse.Validate if the designated constraints
+// aren't met.
+type ListLeaveAmendmentsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2366,24 +6985,24 @@ type CancelLeaveRequestResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelLeaveRequestResponseValidationError) Field() string { return e.field }
+func (e ListLeaveAmendmentsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelLeaveRequestResponseValidationError) Reason() string { return e.reason }
+func (e ListLeaveAmendmentsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelLeaveRequestResponseValidationError) Cause() error { return e.cause }
+func (e ListLeaveAmendmentsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelLeaveRequestResponseValidationError) Key() bool { return e.key }
+func (e ListLeaveAmendmentsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelLeaveRequestResponseValidationError) ErrorName() string {
-	return "CancelLeaveRequestResponseValidationError"
+func (e ListLeaveAmendmentsResponseValidationError) ErrorName() string {
+	return "ListLeaveAmendmentsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelLeaveRequestResponseValidationError) Error() string {
+func (e ListLeaveAmendmentsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2395,14 +7014,14 @@ func (e CancelLeaveRequestResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelLeaveRequestResponse.%s: %s%s",
+		"invalid %sListLeaveAmendmentsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelLeaveRequestResponseValidationError{}
+var _ error = ListLeaveAmendmentsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2410,24 +7029,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelLeaveRequestResponseValidationError{}
+} = ListLeaveAmendmentsResponseValidationError{}
 
-// Validate checks the field values on RevokeLeaveRequestRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ApproveLeaveAmendmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeLeaveRequestRequest) Validate() error {
+func (m *ApproveLeaveAmendmentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeLeaveRequestRequest with the
+// ValidateAll checks the field values on ApproveLeaveAmendmentRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RevokeLeaveRequestRequestMultiError, or nil if none found.
-func (m *RevokeLeaveRequestRequest) ValidateAll() error {
+// ApproveLeaveAmendmentRequestMultiError, or nil if none found.
+func (m *ApproveLeaveAmendmentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeLeaveRequestRequest) validate(all bool) error {
+func (m *ApproveLeaveAmendmentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2436,24 +7055,24 @@ func (m *RevokeLeaveRequestRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
-	if m.Reason != nil {
-		// no validation rules for Reason
+	if m.ReviewNotes != nil {
+		// no validation rules for ReviewNotes
 	}
 
 	if len(errors) > 0 {
-		return RevokeLeaveRequestRequestMultiError(errors)
+		return ApproveLeaveAmendmentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeLeaveRequestRequestMultiError is an error wrapping multiple validation
-// errors returned by RevokeLeaveRequestRequest.ValidateAll() if the
-// designated constraints aren't met.
-type RevokeLeaveRequestRequestMultiError []error
+// ApproveLeaveAmendmentRequestMultiError is an error wrapping multiple
+// validation errors returned by ApproveLeaveAmendmentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ApproveLeaveAmendmentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeLeaveRequestRequestMultiError) Error() string {
+func (m ApproveLeaveAmendmentRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2462,11 +7081,12 @@ func (m RevokeLeaveRequestRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeLeaveRequestRequestMultiError) AllErrors() []error { return m }
+func (m ApproveLeaveAmendmentRequestMultiError) AllErrors() []error { return m }
 
-// RevokeLeaveRequestRequestValidationError is the validation error returned by
-// RevokeLeaveRequestRequest.Validate if the designated constraints aren't met.
-type RevokeLeaveRequestRequestValidationError struct {
+// ApproveLeaveAmendmentRequestValidationError is the validation error returned
+// by ApproveLeaveAmendmentRequest.Validate if the designated constraints
+// aren't met.
+type ApproveLeaveAmendmentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2474,24 +7094,24 @@ type RevokeLeaveRequestRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeLeaveRequestRequestValidationError) Field() string { return e.field }
+func (e ApproveLeaveAmendmentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeLeaveRequestRequestValidationError) Reason() string { return e.reason }
+func (e ApproveLeaveAmendmentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeLeaveRequestRequestValidationError) Cause() error { return e.cause }
+func (e ApproveLeaveAmendmentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeLeaveRequestRequestValidationError) Key() bool { return e.key }
+func (e ApproveLeaveAmendmentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeLeaveRequestRequestValidationError) ErrorName() string {
-	return "RevokeLeaveRequestRequestValidationError"
+func (e ApproveLeaveAmendmentRequestValidationError) ErrorName() string {
+	return "ApproveLeaveAmendmentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeLeaveRequestRequestValidationError) Error() string {
+func (e ApproveLeaveAmendmentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2503,14 +7123,14 @@ func (e RevokeLeaveRequestRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeLeaveRequestRequest.%s: %s%s",
+		"invalid %sApproveLeaveAmendmentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeLeaveRequestRequestValidationError{}
+var _ error = ApproveLeaveAmendmentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2518,24 +7138,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeLeaveRequestRequestValidationError{}
+} = ApproveLeaveAmendmentRequestValidationError{}
 
-// Validate checks the field values on RevokeLeaveRequestResponse with the
+// Validate checks the field values on ApproveLeaveAmendmentResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeLeaveRequestResponse) Validate() error {
+func (m *ApproveLeaveAmendmentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeLeaveRequestResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RevokeLeaveRequestResponseMultiError, or nil if none found.
-func (m *RevokeLeaveRequestResponse) ValidateAll() error {
+// ValidateAll checks the field values on ApproveLeaveAmendmentResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ApproveLeaveAmendmentResponseMultiError, or nil if none found.
+func (m *ApproveLeaveAmendmentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeLeaveRequestResponse) validate(all bool) error {
+func (m *ApproveLeaveAmendmentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2546,7 +7166,7 @@ func (m *RevokeLeaveRequestResponse) validate(all bool) error {
 		switch v := interface{}(m.GetLeaveRequest()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RevokeLeaveRequestResponseValidationError{
+				errors = append(errors, ApproveLeaveAmendmentResponseValidationError{
 					field:  "LeaveRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2554,7 +7174,7 @@ func (m *RevokeLeaveRequestResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RevokeLeaveRequestResponseValidationError{
+				errors = append(errors, ApproveLeaveAmendmentResponseValidationError{
 					field:  "LeaveRequest",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2563,7 +7183,7 @@ func (m *RevokeLeaveRequestResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetLeaveRequest()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RevokeLeaveRequestResponseValidationError{
+			return ApproveLeaveAmendmentResponseValidationError{
 				field:  "LeaveRequest",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2571,20 +7191,83 @@ func (m *RevokeLeaveRequestResponse) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetAmendment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveLeaveAmendmentResponseValidationError{
+					field:  "Amendment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveLeaveAmendmentResponseValidationError{
+					field:  "Amendment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmendment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveLeaveAmendmentResponseValidationError{
+				field:  "Amendment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetCoverageWarnings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApproveLeaveAmendmentResponseValidationError{
+						field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApproveLeaveAmendmentResponseValidationError{
+						field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApproveLeaveAmendmentResponseValidationError{
+					field:  fmt.Sprintf("CoverageWarnings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return RevokeLeaveRequestResponseMultiError(errors)
+		return ApproveLeaveAmendmentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeLeaveRequestResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeLeaveRequestResponse.ValidateAll() if
-// the designated constraints aren't met.
-type RevokeLeaveRequestResponseMultiError []error
+// ApproveLeaveAmendmentResponseMultiError is an error wrapping multiple
+// validation errors returned by ApproveLeaveAmendmentResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ApproveLeaveAmendmentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeLeaveRequestResponseMultiError) Error() string {
+func (m ApproveLeaveAmendmentResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2593,11 +7276,12 @@ func (m RevokeLeaveRequestResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeLeaveRequestResponseMultiError) AllErrors() []error { return m }
+func (m ApproveLeaveAmendmentResponseMultiError) AllErrors() []error { return m }
 
-// RevokeLeaveRequestResponseValidationError is the validation error returned
-// by RevokeLeaveRequestResponse.Validate if the designated constraints aren't met.
-type RevokeLeaveRequestResponseValidationError struct {
+// ApproveLeaveAmendmentResponseValidationError is the validation error
+// returned by ApproveLeaveAmendmentResponse.Validate if the designated
+// constraints aren't met.
+type ApproveLeaveAmendmentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2605,24 +7289,24 @@ type RevokeLeaveRequestResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeLeaveRequestResponseValidationError) Field() string { return e.field }
+func (e ApproveLeaveAmendmentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeLeaveRequestResponseValidationError) Reason() string { return e.reason }
+func (e ApproveLeaveAmendmentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeLeaveRequestResponseValidationError) Cause() error { return e.cause }
+func (e ApproveLeaveAmendmentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeLeaveRequestResponseValidationError) Key() bool { return e.key }
+func (e ApproveLeaveAmendmentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeLeaveRequestResponseValidationError) ErrorName() string {
-	return "RevokeLeaveRequestResponseValidationError"
+func (e ApproveLeaveAmendmentResponseValidationError) ErrorName() string {
+	return "ApproveLeaveAmendmentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeLeaveRequestResponseValidationError) Error() string {
+func (e ApproveLeaveAmendmentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2634,14 +7318,14 @@ func (e RevokeLeaveRequestResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeLeaveRequestResponse.%s: %s%s",
+		"invalid %sApproveLeaveAmendmentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeLeaveRequestResponseValidationError{}
+var _ error = ApproveLeaveAmendmentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2649,24 +7333,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeLeaveRequestResponseValidationError{}
+} = ApproveLeaveAmendmentResponseValidationError{}
 
-// Validate checks the field values on CalendarEvent with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CalendarEvent) Validate() error {
+// Validate checks the field values on RejectLeaveAmendmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectLeaveAmendmentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CalendarEvent with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CalendarEventMultiError, or
-// nil if none found.
-func (m *CalendarEvent) ValidateAll() error {
+// ValidateAll checks the field values on RejectLeaveAmendmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectLeaveAmendmentRequestMultiError, or nil if none found.
+func (m *RejectLeaveAmendmentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CalendarEvent) validate(all bool) error {
+func (m *RejectLeaveAmendmentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2675,94 +7359,156 @@ func (m *CalendarEvent) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for UserId
+	if m.ReviewNotes != nil {
+		// no validation rules for ReviewNotes
+	}
 
-	// no validation rules for UserName
+	if len(errors) > 0 {
+		return RejectLeaveAmendmentRequestMultiError(errors)
+	}
 
-	// no validation rules for AbsenceTypeId
+	return nil
+}
 
-	// no validation rules for AbsenceTypeName
+// RejectLeaveAmendmentRequestMultiError is an error wrapping multiple
+// validation errors returned by RejectLeaveAmendmentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RejectLeaveAmendmentRequestMultiError []error
 
-	// no validation rules for Color
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectLeaveAmendmentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	if all {
-		switch v := interface{}(m.GetStartDate()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CalendarEventValidationError{
-					field:  "StartDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CalendarEventValidationError{
-					field:  "StartDate",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CalendarEventValidationError{
-				field:  "StartDate",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// AllErrors returns a list of validation violation errors.
+func (m RejectLeaveAmendmentRequestMultiError) AllErrors() []error { return m }
+
+// RejectLeaveAmendmentRequestValidationError is the validation error returned
+// by RejectLeaveAmendmentRequest.Validate if the designated constraints
+// aren't met.
+type RejectLeaveAmendmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectLeaveAmendmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectLeaveAmendmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectLeaveAmendmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectLeaveAmendmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectLeaveAmendmentRequestValidationError) ErrorName() string {
+	return "RejectLeaveAmendmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectLeaveAmendmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectLeaveAmendmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectLeaveAmendmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectLeaveAmendmentRequestValidationError{}
+
+// Validate checks the field values on RejectLeaveAmendmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectLeaveAmendmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectLeaveAmendmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectLeaveAmendmentResponseMultiError, or nil if none found.
+func (m *RejectLeaveAmendmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectLeaveAmendmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if all {
-		switch v := interface{}(m.GetEndDate()).(type) {
+		switch v := interface{}(m.GetAmendment()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CalendarEventValidationError{
-					field:  "EndDate",
+				errors = append(errors, RejectLeaveAmendmentResponseValidationError{
+					field:  "Amendment",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CalendarEventValidationError{
-					field:  "EndDate",
+				errors = append(errors, RejectLeaveAmendmentResponseValidationError{
+					field:  "Amendment",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAmendment()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CalendarEventValidationError{
-				field:  "EndDate",
+			return RejectLeaveAmendmentResponseValidationError{
+				field:  "Amendment",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Days
-
-	// no validation rules for Status
-
-	// no validation rules for OrgUnitName
-
 	if len(errors) > 0 {
-		return CalendarEventMultiError(errors)
+		return RejectLeaveAmendmentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CalendarEventMultiError is an error wrapping multiple validation errors
-// returned by CalendarEvent.ValidateAll() if the designated constraints
-// aren't met.
-type CalendarEventMultiError []error
+// RejectLeaveAmendmentResponseMultiError is an error wrapping multiple
+// validation errors returned by RejectLeaveAmendmentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RejectLeaveAmendmentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CalendarEventMultiError) Error() string {
+func (m RejectLeaveAmendmentResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2771,11 +7517,12 @@ func (m CalendarEventMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CalendarEventMultiError) AllErrors() []error { return m }
+func (m RejectLeaveAmendmentResponseMultiError) AllErrors() []error { return m }
 
-// CalendarEventValidationError is the validation error returned by
-// CalendarEvent.Validate if the designated constraints aren't met.
-type CalendarEventValidationError struct {
+// RejectLeaveAmendmentResponseValidationError is the validation error returned
+// by RejectLeaveAmendmentResponse.Validate if the designated constraints
+// aren't met.
+type RejectLeaveAmendmentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2783,22 +7530,24 @@ type CalendarEventValidationError struct {
 }
 
 // Field function returns field value.
-func (e CalendarEventValidationError) Field() string { return e.field }
+func (e RejectLeaveAmendmentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CalendarEventValidationError) Reason() string { return e.reason }
+func (e RejectLeaveAmendmentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CalendarEventValidationError) Cause() error { return e.cause }
+func (e RejectLeaveAmendmentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CalendarEventValidationError) Key() bool { return e.key }
+func (e RejectLeaveAmendmentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CalendarEventValidationError) ErrorName() string { return "CalendarEventValidationError" }
+func (e RejectLeaveAmendmentResponseValidationError) ErrorName() string {
+	return "RejectLeaveAmendmentResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CalendarEventValidationError) Error() string {
+func (e RejectLeaveAmendmentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2810,14 +7559,14 @@ func (e CalendarEventValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCalendarEvent.%s: %s%s",
+		"invalid %sRejectLeaveAmendmentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CalendarEventValidationError{}
+var _ error = RejectLeaveAmendmentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2825,46 +7574,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CalendarEventValidationError{}
+} = RejectLeaveAmendmentResponseValidationError{}
 
-// Validate checks the field values on GetSignedDocumentUrlRequest with the
+// Validate checks the field values on ShortenLeaveRequestRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSignedDocumentUrlRequest) Validate() error {
+func (m *ShortenLeaveRequestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSignedDocumentUrlRequest with the
+// ValidateAll checks the field values on ShortenLeaveRequestRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSignedDocumentUrlRequestMultiError, or nil if none found.
-func (m *GetSignedDocumentUrlRequest) ValidateAll() error {
+// ShortenLeaveRequestRequestMultiError, or nil if none found.
+func (m *ShortenLeaveRequestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSignedDocumentUrlRequest) validate(all bool) error {
+func (m *ShortenLeaveRequestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for LeaveRequestId
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShortenLeaveRequestRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShortenLeaveRequestRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShortenLeaveRequestRequestValidationError{
+				field:  "EndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.EndDayPart != nil {
+		// no validation rules for EndDayPart
+	}
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
 
 	if len(errors) > 0 {
-		return GetSignedDocumentUrlRequestMultiError(errors)
+		return ShortenLeaveRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSignedDocumentUrlRequestMultiError is an error wrapping multiple
-// validation errors returned by GetSignedDocumentUrlRequest.ValidateAll() if
+// ShortenLeaveRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by ShortenLeaveRequestRequest.ValidateAll() if
 // the designated constraints aren't met.
-type GetSignedDocumentUrlRequestMultiError []error
+type ShortenLeaveRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSignedDocumentUrlRequestMultiError) Error() string {
+func (m ShortenLeaveRequestRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2873,12 +7659,11 @@ func (m GetSignedDocumentUrlRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSignedDocumentUrlRequestMultiError) AllErrors() []error { return m }
+func (m ShortenLeaveRequestRequestMultiError) AllErrors() []error { return m }
 
-// GetSignedDocumentUrlRequestValidationError is the validation error returned
-// by GetSignedDocumentUrlRequest.Validate if the designated constraints
-// aren't met.
-type GetSignedDocumentUrlRequestValidationError struct {
+// ShortenLeaveRequestRequestValidationError is the validation error returned
+// by ShortenLeaveRequestRequest.Validate if the designated constraints aren't met.
+type ShortenLeaveRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2886,24 +7671,24 @@ type GetSignedDocumentUrlRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSignedDocumentUrlRequestValidationError) Field() string { return e.field }
+func (e ShortenLeaveRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSignedDocumentUrlRequestValidationError) Reason() string { return e.reason }
+func (e ShortenLeaveRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSignedDocumentUrlRequestValidationError) Cause() error { return e.cause }
+func (e ShortenLeaveRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSignedDocumentUrlRequestValidationError) Key() bool { return e.key }
+func (e ShortenLeaveRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSignedDocumentUrlRequestValidationError) ErrorName() string {
-	return "GetSignedDocumentUrlRequestValidationError"
+func (e ShortenLeaveRequestRequestValidationError) ErrorName() string {
+	return "ShortenLeaveRequestRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSignedDocumentUrlRequestValidationError) Error() string {
+func (e ShortenLeaveRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2915,14 +7700,14 @@ func (e GetSignedDocumentUrlRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSignedDocumentUrlRequest.%s: %s%s",
+		"invalid %sShortenLeaveRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSignedDocumentUrlRequestValidationError{}
+var _ error = ShortenLeaveRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2930,46 +7715,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSignedDocumentUrlRequestValidationError{}
+} = ShortenLeaveRequestRequestValidationError{}
 
-// Validate checks the field values on GetSignedDocumentUrlResponse with the
+// Validate checks the field values on ShortenLeaveRequestResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSignedDocumentUrlResponse) Validate() error {
+func (m *ShortenLeaveRequestResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSignedDocumentUrlResponse with the
+// ValidateAll checks the field values on ShortenLeaveRequestResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetSignedDocumentUrlResponseMultiError, or nil if none found.
-func (m *GetSignedDocumentUrlResponse) ValidateAll() error {
+// ShortenLeaveRequestResponseMultiError, or nil if none found.
+func (m *ShortenLeaveRequestResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSignedDocumentUrlResponse) validate(all bool) error {
+func (m *ShortenLeaveRequestResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Url
+	if all {
+		switch v := interface{}(m.GetLeaveRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShortenLeaveRequestResponseValidationError{
+					field:  "LeaveRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShortenLeaveRequestResponseValidationError{
+					field:  "LeaveRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeaveRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShortenLeaveRequestResponseValidationError{
+				field:  "LeaveRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAmendment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShortenLeaveRequestResponseValidationError{
+					field:  "Amendment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShortenLeaveRequestResponseValidationError{
+					field:  "Amendment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmendment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShortenLeaveRequestResponseValidationError{
+				field:  "Amendment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetSignedDocumentUrlResponseMultiError(errors)
+		return ShortenLeaveRequestResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSignedDocumentUrlResponseMultiError is an error wrapping multiple
-// validation errors returned by GetSignedDocumentUrlResponse.ValidateAll() if
+// ShortenLeaveRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by ShortenLeaveRequestResponse.ValidateAll() if
 // the designated constraints aren't met.
-type GetSignedDocumentUrlResponseMultiError []error
+type ShortenLeaveRequestResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSignedDocumentUrlResponseMultiError) Error() string {
+func (m ShortenLeaveRequestResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2978,12 +7819,12 @@ func (m GetSignedDocumentUrlResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSignedDocumentUrlResponseMultiError) AllErrors() []error { return m }
+func (m ShortenLeaveRequestResponseMultiError) AllErrors() []error { return m }
 
-// GetSignedDocumentUrlResponseValidationError is the validation error returned
-// by GetSignedDocumentUrlResponse.Validate if the designated constraints
+// ShortenLeaveRequestResponseValidationError is the validation error returned
+// by ShortenLeaveRequestResponse.Validate if the designated constraints
 // aren't met.
-type GetSignedDocumentUrlResponseValidationError struct {
+type ShortenLeaveRequestResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2991,24 +7832,24 @@ type GetSignedDocumentUrlResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSignedDocumentUrlResponseValidationError) Field() string { return e.field }
+func (e ShortenLeaveRequestResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSignedDocumentUrlResponseValidationError) Reason() string { return e.reason }
+func (e ShortenLeaveRequestResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSignedDocumentUrlResponseValidationError) Cause() error { return e.cause }
+func (e ShortenLeaveRequestResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSignedDocumentUrlResponseValidationError) Key() bool { return e.key }
+func (e ShortenLeaveRequestResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSignedDocumentUrlResponseValidationError) ErrorName() string {
-	return "GetSignedDocumentUrlResponseValidationError"
+func (e ShortenLeaveRequestResponseValidationError) ErrorName() string {
+	return "ShortenLeaveRequestResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSignedDocumentUrlResponseValidationError) Error() string {
+func (e ShortenLeaveRequestResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3020,14 +7861,14 @@ func (e GetSignedDocumentUrlResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSignedDocumentUrlResponse.%s: %s%s",
+		"invalid %sShortenLeaveRequestResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSignedDocumentUrlResponseValidationError{}
+var _ error = ShortenLeaveRequestResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3035,64 +7876,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSignedDocumentUrlResponseValidationError{}
+} = ShortenLeaveRequestResponseValidationError{}
 
-// Validate checks the field values on GetCalendarEventsRequest with the rules
+// Validate checks the field values on GetLeaveCoverageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCalendarEventsRequest) Validate() error {
+func (m *GetLeaveCoverageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCalendarEventsRequest with the
+// ValidateAll checks the field values on GetLeaveCoverageRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCalendarEventsRequestMultiError, or nil if none found.
-func (m *GetCalendarEventsRequest) ValidateAll() error {
+// GetLeaveCoverageRequestMultiError, or nil if none found.
+func (m *GetLeaveCoverageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCalendarEventsRequest) validate(all bool) error {
+func (m *GetLeaveCoverageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.TenantId != nil {
-		// no validation rules for TenantId
-	}
-
-	if m.StartDate != nil {
-		// no validation rules for StartDate
-	}
-
-	if m.EndDate != nil {
-		// no validation rules for EndDate
-	}
-
-	if m.OrgUnitName != nil {
-		// no validation rules for OrgUnitName
-	}
-
-	if m.UserId != nil {
-		// no validation rules for UserId
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return GetCalendarEventsRequestMultiError(errors)
+		return GetLeaveCoverageRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCalendarEventsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCalendarEventsRequest.ValidateAll() if the designated
+// GetLeaveCoverageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetLeaveCoverageRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetCalendarEventsRequestMultiError []error
+type GetLeaveCoverageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCalendarEventsRequestMultiError) Error() string {
+func (m GetLeaveCoverageRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3101,11 +7924,11 @@ func (m GetCalendarEventsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCalendarEventsRequestMultiError) AllErrors() []error { return m }
+func (m GetLeaveCoverageRequestMultiError) AllErrors() []error { return m }
 
-// GetCalendarEventsRequestValidationError is the validation error returned by
-// GetCalendarEventsRequest.Validate if the designated constraints aren't met.
-type GetCalendarEventsRequestValidationError struct {
+// GetLeaveCoverageRequestValidationError is the validation error returned by
+// GetLeaveCoverageRequest.Validate if the designated constraints aren't met.
+type GetLeaveCoverageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3113,24 +7936,24 @@ type GetCalendarEventsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCalendarEventsRequestValidationError) Field() string { return e.field }
+func (e GetLeaveCoverageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCalendarEventsRequestValidationError) Reason() string { return e.reason }
+func (e GetLeaveCoverageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCalendarEventsRequestValidationError) Cause() error { return e.cause }
+func (e GetLeaveCoverageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCalendarEventsRequestValidationError) Key() bool { return e.key }
+func (e GetLeaveCoverageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCalendarEventsRequestValidationError) ErrorName() string {
-	return "GetCalendarEventsRequestValidationError"
+func (e GetLeaveCoverageRequestValidationError) ErrorName() string {
+	return "GetLeaveCoverageRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCalendarEventsRequestValidationError) Error() string {
+func (e GetLeaveCoverageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3142,14 +7965,14 @@ func (e GetCalendarEventsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCalendarEventsRequest.%s: %s%s",
+		"invalid %sGetLeaveCoverageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCalendarEventsRequestValidationError{}
+var _ error = GetLeaveCoverageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3157,47 +7980,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCalendarEventsRequestValidationError{}
+} = GetLeaveCoverageRequestValidationError{}
 
-// Validate checks the field values on GetCalendarEventsResponse with the rules
+// Validate checks the field values on GetLeaveCoverageResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCalendarEventsResponse) Validate() error {
+func (m *GetLeaveCoverageResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCalendarEventsResponse with the
+// ValidateAll checks the field values on GetLeaveCoverageResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCalendarEventsResponseMultiError, or nil if none found.
-func (m *GetCalendarEventsResponse) ValidateAll() error {
+// GetLeaveCoverageResponseMultiError, or nil if none found.
+func (m *GetLeaveCoverageResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCalendarEventsResponse) validate(all bool) error {
+func (m *GetLeaveCoverageResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetEvents() {
+	for idx, item := range m.GetConflicts() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetCalendarEventsResponseValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
+					errors = append(errors, GetLeaveCoverageResponseValidationError{
+						field:  fmt.Sprintf("Conflicts[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetCalendarEventsResponseValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
+					errors = append(errors, GetLeaveCoverageResponseValidationError{
+						field:  fmt.Sprintf("Conflicts[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3205,8 +8028,8 @@ func (m *GetCalendarEventsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetCalendarEventsResponseValidationError{
-					field:  fmt.Sprintf("Events[%v]", idx),
+				return GetLeaveCoverageResponseValidationError{
+					field:  fmt.Sprintf("Conflicts[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3216,19 +8039,19 @@ func (m *GetCalendarEventsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetCalendarEventsResponseMultiError(errors)
+		return GetLeaveCoverageResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCalendarEventsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetCalendarEventsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetCalendarEventsResponseMultiError []error
+// GetLeaveCoverageResponseMultiError is an error wrapping multiple validation
+// errors returned by GetLeaveCoverageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetLeaveCoverageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCalendarEventsResponseMultiError) Error() string {
+func (m GetLeaveCoverageResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3237,11 +8060,11 @@ func (m GetCalendarEventsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCalendarEventsResponseMultiError) AllErrors() []error { return m }
+func (m GetLeaveCoverageResponseMultiError) AllErrors() []error { return m }
 
-// GetCalendarEventsResponseValidationError is the validation error returned by
-// GetCalendarEventsResponse.Validate if the designated constraints aren't met.
-type GetCalendarEventsResponseValidationError struct {
+// GetLeaveCoverageResponseValidationError is the validation error returned by
+// GetLeaveCoverageResponse.Validate if the designated constraints aren't met.
+type GetLeaveCoverageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3249,24 +8072,24 @@ type GetCalendarEventsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCalendarEventsResponseValidationError) Field() string { return e.field }
+func (e GetLeaveCoverageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCalendarEventsResponseValidationError) Reason() string { return e.reason }
+func (e GetLeaveCoverageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCalendarEventsResponseValidationError) Cause() error { return e.cause }
+func (e GetLeaveCoverageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCalendarEventsResponseValidationError) Key() bool { return e.key }
+func (e GetLeaveCoverageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCalendarEventsResponseValidationError) ErrorName() string {
-	return "GetCalendarEventsResponseValidationError"
+func (e GetLeaveCoverageResponseValidationError) ErrorName() string {
+	return "GetLeaveCoverageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCalendarEventsResponseValidationError) Error() string {
+func (e GetLeaveCoverageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3278,14 +8101,14 @@ func (e GetCalendarEventsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCalendarEventsResponse.%s: %s%s",
+		"invalid %sGetLeaveCoverageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCalendarEventsResponseValidationError{}
+var _ error = GetLeaveCoverageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3293,4 +8116,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCalendarEventsResponseValidationError{}
+} = GetLeaveCoverageResponseValidationError{}
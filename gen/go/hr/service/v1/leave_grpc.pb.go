@@ -20,17 +20,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	HrLeaveService_CreateLeaveRequest_FullMethodName   = "/hr.service.v1.HrLeaveService/CreateLeaveRequest"
-	HrLeaveService_GetLeaveRequest_FullMethodName      = "/hr.service.v1.HrLeaveService/GetLeaveRequest"
-	HrLeaveService_ListLeaveRequests_FullMethodName    = "/hr.service.v1.HrLeaveService/ListLeaveRequests"
-	HrLeaveService_UpdateLeaveRequest_FullMethodName   = "/hr.service.v1.HrLeaveService/UpdateLeaveRequest"
-	HrLeaveService_DeleteLeaveRequest_FullMethodName   = "/hr.service.v1.HrLeaveService/DeleteLeaveRequest"
-	HrLeaveService_ApproveLeaveRequest_FullMethodName  = "/hr.service.v1.HrLeaveService/ApproveLeaveRequest"
-	HrLeaveService_RejectLeaveRequest_FullMethodName   = "/hr.service.v1.HrLeaveService/RejectLeaveRequest"
-	HrLeaveService_CancelLeaveRequest_FullMethodName   = "/hr.service.v1.HrLeaveService/CancelLeaveRequest"
-	HrLeaveService_RevokeLeaveRequest_FullMethodName   = "/hr.service.v1.HrLeaveService/RevokeLeaveRequest"
-	HrLeaveService_GetCalendarEvents_FullMethodName    = "/hr.service.v1.HrLeaveService/GetCalendarEvents"
-	HrLeaveService_GetSignedDocumentUrl_FullMethodName = "/hr.service.v1.HrLeaveService/GetSignedDocumentUrl"
+	HrLeaveService_CreateLeaveRequest_FullMethodName         = "/hr.service.v1.HrLeaveService/CreateLeaveRequest"
+	HrLeaveService_GetLeaveRequest_FullMethodName            = "/hr.service.v1.HrLeaveService/GetLeaveRequest"
+	HrLeaveService_ListLeaveRequests_FullMethodName          = "/hr.service.v1.HrLeaveService/ListLeaveRequests"
+	HrLeaveService_ListAssignedApprovals_FullMethodName      = "/hr.service.v1.HrLeaveService/ListAssignedApprovals"
+	HrLeaveService_UpdateLeaveRequest_FullMethodName         = "/hr.service.v1.HrLeaveService/UpdateLeaveRequest"
+	HrLeaveService_DeleteLeaveRequest_FullMethodName         = "/hr.service.v1.HrLeaveService/DeleteLeaveRequest"
+	HrLeaveService_ApproveLeaveRequest_FullMethodName        = "/hr.service.v1.HrLeaveService/ApproveLeaveRequest"
+	HrLeaveService_RejectLeaveRequest_FullMethodName         = "/hr.service.v1.HrLeaveService/RejectLeaveRequest"
+	HrLeaveService_CancelLeaveRequest_FullMethodName         = "/hr.service.v1.HrLeaveService/CancelLeaveRequest"
+	HrLeaveService_RevokeLeaveRequest_FullMethodName         = "/hr.service.v1.HrLeaveService/RevokeLeaveRequest"
+	HrLeaveService_BulkApproveLeaveRequests_FullMethodName   = "/hr.service.v1.HrLeaveService/BulkApproveLeaveRequests"
+	HrLeaveService_BulkRejectLeaveRequests_FullMethodName    = "/hr.service.v1.HrLeaveService/BulkRejectLeaveRequests"
+	HrLeaveService_BulkRevokeLeaveRequests_FullMethodName    = "/hr.service.v1.HrLeaveService/BulkRevokeLeaveRequests"
+	HrLeaveService_CancelFollowingOccurrences_FullMethodName = "/hr.service.v1.HrLeaveService/CancelFollowingOccurrences"
+	HrLeaveService_UpdateFollowingOccurrences_FullMethodName = "/hr.service.v1.HrLeaveService/UpdateFollowingOccurrences"
+	HrLeaveService_ApproveLeaveSeries_FullMethodName         = "/hr.service.v1.HrLeaveService/ApproveLeaveSeries"
+	HrLeaveService_RejectLeaveSeries_FullMethodName          = "/hr.service.v1.HrLeaveService/RejectLeaveSeries"
+	HrLeaveService_GetCalendarEvents_FullMethodName          = "/hr.service.v1.HrLeaveService/GetCalendarEvents"
+	HrLeaveService_GetSignedDocumentUrl_FullMethodName       = "/hr.service.v1.HrLeaveService/GetSignedDocumentUrl"
+	HrLeaveService_ChangeLeaveDates_FullMethodName           = "/hr.service.v1.HrLeaveService/ChangeLeaveDates"
+	HrLeaveService_ListLeaveAmendments_FullMethodName        = "/hr.service.v1.HrLeaveService/ListLeaveAmendments"
+	HrLeaveService_ApproveLeaveAmendment_FullMethodName      = "/hr.service.v1.HrLeaveService/ApproveLeaveAmendment"
+	HrLeaveService_RejectLeaveAmendment_FullMethodName       = "/hr.service.v1.HrLeaveService/RejectLeaveAmendment"
+	HrLeaveService_ShortenLeaveRequest_FullMethodName        = "/hr.service.v1.HrLeaveService/ShortenLeaveRequest"
+	HrLeaveService_GetLeaveCoverage_FullMethodName           = "/hr.service.v1.HrLeaveService/GetLeaveCoverage"
+	HrLeaveService_PostLeaveComment_FullMethodName           = "/hr.service.v1.HrLeaveService/PostLeaveComment"
+	HrLeaveService_ListLeaveComments_FullMethodName          = "/hr.service.v1.HrLeaveService/ListLeaveComments"
+	HrLeaveService_UpdateLeaveComment_FullMethodName         = "/hr.service.v1.HrLeaveService/UpdateLeaveComment"
 )
 
 // HrLeaveServiceClient is the client API for HrLeaveService service.
@@ -42,14 +59,42 @@ type HrLeaveServiceClient interface {
 	CreateLeaveRequest(ctx context.Context, in *CreateLeaveRequestRequest, opts ...grpc.CallOption) (*CreateLeaveRequestResponse, error)
 	GetLeaveRequest(ctx context.Context, in *GetLeaveRequestRequest, opts ...grpc.CallOption) (*GetLeaveRequestResponse, error)
 	ListLeaveRequests(ctx context.Context, in *ListLeaveRequestsRequest, opts ...grpc.CallOption) (*ListLeaveRequestsResponse, error)
+	ListAssignedApprovals(ctx context.Context, in *ListAssignedApprovalsRequest, opts ...grpc.CallOption) (*ListAssignedApprovalsResponse, error)
 	UpdateLeaveRequest(ctx context.Context, in *UpdateLeaveRequestRequest, opts ...grpc.CallOption) (*UpdateLeaveRequestResponse, error)
 	DeleteLeaveRequest(ctx context.Context, in *DeleteLeaveRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ApproveLeaveRequest(ctx context.Context, in *ApproveLeaveRequestRequest, opts ...grpc.CallOption) (*ApproveLeaveRequestResponse, error)
 	RejectLeaveRequest(ctx context.Context, in *RejectLeaveRequestRequest, opts ...grpc.CallOption) (*RejectLeaveRequestResponse, error)
 	CancelLeaveRequest(ctx context.Context, in *CancelLeaveRequestRequest, opts ...grpc.CallOption) (*CancelLeaveRequestResponse, error)
 	RevokeLeaveRequest(ctx context.Context, in *RevokeLeaveRequestRequest, opts ...grpc.CallOption) (*RevokeLeaveRequestResponse, error)
+	// Approve pending requests, each as ApproveLeaveRequest does
+	BulkApproveLeaveRequests(ctx context.Context, in *BulkApproveLeaveRequestsRequest, opts ...grpc.CallOption) (*BulkLeaveRequestsResponse, error)
+	// Reject pending requests, each as RejectLeaveRequest does
+	BulkRejectLeaveRequests(ctx context.Context, in *BulkRejectLeaveRequestsRequest, opts ...grpc.CallOption) (*BulkLeaveRequestsResponse, error)
+	// Revoke approved requests, each as RevokeLeaveRequest does
+	BulkRevokeLeaveRequests(ctx context.Context, in *BulkRevokeLeaveRequestsRequest, opts ...grpc.CallOption) (*BulkLeaveRequestsResponse, error)
+	// Cancel an occurrence of a series and the occurrences after it, each as CancelLeaveRequest does
+	CancelFollowingOccurrences(ctx context.Context, in *CancelFollowingOccurrencesRequest, opts ...grpc.CallOption) (*BulkLeaveRequestsResponse, error)
+	// Edit an occurrence of a series and the occurrences after it
+	UpdateFollowingOccurrences(ctx context.Context, in *UpdateFollowingOccurrencesRequest, opts ...grpc.CallOption) (*UpdateFollowingOccurrencesResponse, error)
+	// Approve the pending occurrences of a series, each as ApproveLeaveRequest does
+	ApproveLeaveSeries(ctx context.Context, in *ApproveLeaveSeriesRequest, opts ...grpc.CallOption) (*BulkLeaveRequestsResponse, error)
+	// Reject the pending occurrences of a series, each as RejectLeaveRequest does
+	RejectLeaveSeries(ctx context.Context, in *RejectLeaveSeriesRequest, opts ...grpc.CallOption) (*BulkLeaveRequestsResponse, error)
 	GetCalendarEvents(ctx context.Context, in *GetCalendarEventsRequest, opts ...grpc.CallOption) (*GetCalendarEventsResponse, error)
 	GetSignedDocumentUrl(ctx context.Context, in *GetSignedDocumentUrlRequest, opts ...grpc.CallOption) (*GetSignedDocumentUrlResponse, error)
+	ChangeLeaveDates(ctx context.Context, in *ChangeLeaveDatesRequest, opts ...grpc.CallOption) (*ChangeLeaveDatesResponse, error)
+	ListLeaveAmendments(ctx context.Context, in *ListLeaveAmendmentsRequest, opts ...grpc.CallOption) (*ListLeaveAmendmentsResponse, error)
+	ApproveLeaveAmendment(ctx context.Context, in *ApproveLeaveAmendmentRequest, opts ...grpc.CallOption) (*ApproveLeaveAmendmentResponse, error)
+	RejectLeaveAmendment(ctx context.Context, in *RejectLeaveAmendmentRequest, opts ...grpc.CallOption) (*RejectLeaveAmendmentResponse, error)
+	ShortenLeaveRequest(ctx context.Context, in *ShortenLeaveRequestRequest, opts ...grpc.CallOption) (*ShortenLeaveRequestResponse, error)
+	// Check a request against the coverage rules of the requester's org units, e.g. before
+	// approving it
+	GetLeaveCoverage(ctx context.Context, in *GetLeaveCoverageRequest, opts ...grpc.CallOption) (*GetLeaveCoverageResponse, error)
+	// Post a comment on a request; the requester or the approvers are notified of shared comments
+	PostLeaveComment(ctx context.Context, in *PostLeaveCommentRequest, opts ...grpc.CallOption) (*PostLeaveCommentResponse, error)
+	// List the comments on a request the caller may read, oldest first
+	ListLeaveComments(ctx context.Context, in *ListLeaveCommentsRequest, opts ...grpc.CallOption) (*ListLeaveCommentsResponse, error)
+	UpdateLeaveComment(ctx context.Context, in *UpdateLeaveCommentRequest, opts ...grpc.CallOption) (*UpdateLeaveCommentResponse, error)
 }
 
 type hrLeaveServiceClient struct {
@@ -90,6 +135,16 @@ func (c *hrLeaveServiceClient) ListLeaveRequests(ctx context.Context, in *ListLe
 	return out, nil
 }
 
+func (c *hrLeaveServiceClient) ListAssignedApprovals(ctx context.Context, in *ListAssignedApprovalsRequest, opts ...grpc.CallOption) (*ListAssignedApprovalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssignedApprovalsResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_ListAssignedApprovals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *hrLeaveServiceClient) UpdateLeaveRequest(ctx context.Context, in *UpdateLeaveRequestRequest, opts ...grpc.CallOption) (*UpdateLeaveRequestResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateLeaveRequestResponse)
@@ -150,6 +205,76 @@ func (c *hrLeaveServiceClient) RevokeLeaveRequest(ctx context.Context, in *Revok
 	return out, nil
 }
 
+func (c *hrLeaveServiceClient) BulkApproveLeaveRequests(ctx context.Context, in *BulkApproveLeaveRequestsRequest, opts ...grpc.CallOption) (*BulkLeaveRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkLeaveRequestsResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_BulkApproveLeaveRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrLeaveServiceClient) BulkRejectLeaveRequests(ctx context.Context, in *BulkRejectLeaveRequestsRequest, opts ...grpc.CallOption) (*BulkLeaveRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkLeaveRequestsResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_BulkRejectLeaveRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrLeaveServiceClient) BulkRevokeLeaveRequests(ctx context.Context, in *BulkRevokeLeaveRequestsRequest, opts ...grpc.CallOption) (*BulkLeaveRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkLeaveRequestsResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_BulkRevokeLeaveRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrLeaveServiceClient) CancelFollowingOccurrences(ctx context.Context, in *CancelFollowingOccurrencesRequest, opts ...grpc.CallOption) (*BulkLeaveRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkLeaveRequestsResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_CancelFollowingOccurrences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrLeaveServiceClient) UpdateFollowingOccurrences(ctx context.Context, in *UpdateFollowingOccurrencesRequest, opts ...grpc.CallOption) (*UpdateFollowingOccurrencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFollowingOccurrencesResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_UpdateFollowingOccurrences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrLeaveServiceClient) ApproveLeaveSeries(ctx context.Context, in *ApproveLeaveSeriesRequest, opts ...grpc.CallOption) (*BulkLeaveRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkLeaveRequestsResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_ApproveLeaveSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrLeaveServiceClient) RejectLeaveSeries(ctx context.Context, in *RejectLeaveSeriesRequest, opts ...grpc.CallOption) (*BulkLeaveRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkLeaveRequestsResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_RejectLeaveSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *hrLeaveServiceClient) GetCalendarEvents(ctx context.Context, in *GetCalendarEventsRequest, opts ...grpc.CallOption) (*GetCalendarEventsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCalendarEventsResponse)
@@ -170,6 +295,96 @@ func (c *hrLeaveServiceClient) GetSignedDocumentUrl(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *hrLeaveServiceClient) ChangeLeaveDates(ctx context.Context, in *ChangeLeaveDatesRequest, opts ...grpc.CallOption) (*ChangeLeaveDatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeLeaveDatesResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_ChangeLeaveDates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrLeaveServiceClient) ListLeaveAmendments(ctx context.Context, in *ListLeaveAmendmentsRequest, opts ...grpc.CallOption) (*ListLeaveAmendmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLeaveAmendmentsResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_ListLeaveAmendments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrLeaveServiceClient) ApproveLeaveAmendment(ctx context.Context, in *ApproveLeaveAmendmentRequest, opts ...grpc.CallOption) (*ApproveLeaveAmendmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveLeaveAmendmentResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_ApproveLeaveAmendment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrLeaveServiceClient) RejectLeaveAmendment(ctx context.Context, in *RejectLeaveAmendmentRequest, opts ...grpc.CallOption) (*RejectLeaveAmendmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectLeaveAmendmentResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_RejectLeaveAmendment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrLeaveServiceClient) ShortenLeaveRequest(ctx context.Context, in *ShortenLeaveRequestRequest, opts ...grpc.CallOption) (*ShortenLeaveRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShortenLeaveRequestResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_ShortenLeaveRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrLeaveServiceClient) GetLeaveCoverage(ctx context.Context, in *GetLeaveCoverageRequest, opts ...grpc.CallOption) (*GetLeaveCoverageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeaveCoverageResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_GetLeaveCoverage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrLeaveServiceClient) PostLeaveComment(ctx context.Context, in *PostLeaveCommentRequest, opts ...grpc.CallOption) (*PostLeaveCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostLeaveCommentResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_PostLeaveComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrLeaveServiceClient) ListLeaveComments(ctx context.Context, in *ListLeaveCommentsRequest, opts ...grpc.CallOption) (*ListLeaveCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLeaveCommentsResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_ListLeaveComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hrLeaveServiceClient) UpdateLeaveComment(ctx context.Context, in *UpdateLeaveCommentRequest, opts ...grpc.CallOption) (*UpdateLeaveCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLeaveCommentResponse)
+	err := c.cc.Invoke(ctx, HrLeaveService_UpdateLeaveComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HrLeaveServiceServer is the server API for HrLeaveService service.
 // All implementations must embed UnimplementedHrLeaveServiceServer
 // for forward compatibility.
@@ -179,14 +394,42 @@ type HrLeaveServiceServer interface {
 	CreateLeaveRequest(context.Context, *CreateLeaveRequestRequest) (*CreateLeaveRequestResponse, error)
 	GetLeaveRequest(context.Context, *GetLeaveRequestRequest) (*GetLeaveRequestResponse, error)
 	ListLeaveRequests(context.Context, *ListLeaveRequestsRequest) (*ListLeaveRequestsResponse, error)
+	ListAssignedApprovals(context.Context, *ListAssignedApprovalsRequest) (*ListAssignedApprovalsResponse, error)
 	UpdateLeaveRequest(context.Context, *UpdateLeaveRequestRequest) (*UpdateLeaveRequestResponse, error)
 	DeleteLeaveRequest(context.Context, *DeleteLeaveRequestRequest) (*emptypb.Empty, error)
 	ApproveLeaveRequest(context.Context, *ApproveLeaveRequestRequest) (*ApproveLeaveRequestResponse, error)
 	RejectLeaveRequest(context.Context, *RejectLeaveRequestRequest) (*RejectLeaveRequestResponse, error)
 	CancelLeaveRequest(context.Context, *CancelLeaveRequestRequest) (*CancelLeaveRequestResponse, error)
 	RevokeLeaveRequest(context.Context, *RevokeLeaveRequestRequest) (*RevokeLeaveRequestResponse, error)
+	// Approve pending requests, each as ApproveLeaveRequest does
+	BulkApproveLeaveRequests(context.Context, *BulkApproveLeaveRequestsRequest) (*BulkLeaveRequestsResponse, error)
+	// Reject pending requests, each as RejectLeaveRequest does
+	BulkRejectLeaveRequests(context.Context, *BulkRejectLeaveRequestsRequest) (*BulkLeaveRequestsResponse, error)
+	// Revoke approved requests, each as RevokeLeaveRequest does
+	BulkRevokeLeaveRequests(context.Context, *BulkRevokeLeaveRequestsRequest) (*BulkLeaveRequestsResponse, error)
+	// Cancel an occurrence of a series and the occurrences after it, each as CancelLeaveRequest does
+	CancelFollowingOccurrences(context.Context, *CancelFollowingOccurrencesRequest) (*BulkLeaveRequestsResponse, error)
+	// Edit an occurrence of a series and the occurrences after it
+	UpdateFollowingOccurrences(context.Context, *UpdateFollowingOccurrencesRequest) (*UpdateFollowingOccurrencesResponse, error)
+	// Approve the pending occurrences of a series, each as ApproveLeaveRequest does
+	ApproveLeaveSeries(context.Context, *ApproveLeaveSeriesRequest) (*BulkLeaveRequestsResponse, error)
+	// Reject the pending occurrences of a series, each as RejectLeaveRequest does
+	RejectLeaveSeries(context.Context, *RejectLeaveSeriesRequest) (*BulkLeaveRequestsResponse, error)
 	GetCalendarEvents(context.Context, *GetCalendarEventsRequest) (*GetCalendarEventsResponse, error)
 	GetSignedDocumentUrl(context.Context, *GetSignedDocumentUrlRequest) (*GetSignedDocumentUrlResponse, error)
+	ChangeLeaveDates(context.Context, *ChangeLeaveDatesRequest) (*ChangeLeaveDatesResponse, error)
+	ListLeaveAmendments(context.Context, *ListLeaveAmendmentsRequest) (*ListLeaveAmendmentsResponse, error)
+	ApproveLeaveAmendment(context.Context, *ApproveLeaveAmendmentRequest) (*ApproveLeaveAmendmentResponse, error)
+	RejectLeaveAmendment(context.Context, *RejectLeaveAmendmentRequest) (*RejectLeaveAmendmentResponse, error)
+	ShortenLeaveRequest(context.Context, *ShortenLeaveRequestRequest) (*ShortenLeaveRequestResponse, error)
+	// Check a request against the coverage rules of the requester's org units, e.g. before
+	// approving it
+	GetLeaveCoverage(context.Context, *GetLeaveCoverageRequest) (*GetLeaveCoverageResponse, error)
+	// Post a comment on a request; the requester or the approvers are notified of shared comments
+	PostLeaveComment(context.Context, *PostLeaveCommentRequest) (*PostLeaveCommentResponse, error)
+	// List the comments on a request the caller may read, oldest first
+	ListLeaveComments(context.Context, *ListLeaveCommentsRequest) (*ListLeaveCommentsResponse, error)
+	UpdateLeaveComment(context.Context, *UpdateLeaveCommentRequest) (*UpdateLeaveCommentResponse, error)
 	mustEmbedUnimplementedHrLeaveServiceServer()
 }
 
@@ -206,6 +449,9 @@ func (UnimplementedHrLeaveServiceServer) GetLeaveRequest(context.Context, *GetLe
 func (UnimplementedHrLeaveServiceServer) ListLeaveRequests(context.Context, *ListLeaveRequestsRequest) (*ListLeaveRequestsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListLeaveRequests not implemented")
 }
+func (UnimplementedHrLeaveServiceServer) ListAssignedApprovals(context.Context, *ListAssignedApprovalsRequest) (*ListAssignedApprovalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAssignedApprovals not implemented")
+}
 func (UnimplementedHrLeaveServiceServer) UpdateLeaveRequest(context.Context, *UpdateLeaveRequestRequest) (*UpdateLeaveRequestResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateLeaveRequest not implemented")
 }
@@ -224,12 +470,60 @@ func (UnimplementedHrLeaveServiceServer) CancelLeaveRequest(context.Context, *Ca
 func (UnimplementedHrLeaveServiceServer) RevokeLeaveRequest(context.Context, *RevokeLeaveRequestRequest) (*RevokeLeaveRequestResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RevokeLeaveRequest not implemented")
 }
+func (UnimplementedHrLeaveServiceServer) BulkApproveLeaveRequests(context.Context, *BulkApproveLeaveRequestsRequest) (*BulkLeaveRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkApproveLeaveRequests not implemented")
+}
+func (UnimplementedHrLeaveServiceServer) BulkRejectLeaveRequests(context.Context, *BulkRejectLeaveRequestsRequest) (*BulkLeaveRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkRejectLeaveRequests not implemented")
+}
+func (UnimplementedHrLeaveServiceServer) BulkRevokeLeaveRequests(context.Context, *BulkRevokeLeaveRequestsRequest) (*BulkLeaveRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkRevokeLeaveRequests not implemented")
+}
+func (UnimplementedHrLeaveServiceServer) CancelFollowingOccurrences(context.Context, *CancelFollowingOccurrencesRequest) (*BulkLeaveRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelFollowingOccurrences not implemented")
+}
+func (UnimplementedHrLeaveServiceServer) UpdateFollowingOccurrences(context.Context, *UpdateFollowingOccurrencesRequest) (*UpdateFollowingOccurrencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateFollowingOccurrences not implemented")
+}
+func (UnimplementedHrLeaveServiceServer) ApproveLeaveSeries(context.Context, *ApproveLeaveSeriesRequest) (*BulkLeaveRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveLeaveSeries not implemented")
+}
+func (UnimplementedHrLeaveServiceServer) RejectLeaveSeries(context.Context, *RejectLeaveSeriesRequest) (*BulkLeaveRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectLeaveSeries not implemented")
+}
 func (UnimplementedHrLeaveServiceServer) GetCalendarEvents(context.Context, *GetCalendarEventsRequest) (*GetCalendarEventsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCalendarEvents not implemented")
 }
 func (UnimplementedHrLeaveServiceServer) GetSignedDocumentUrl(context.Context, *GetSignedDocumentUrlRequest) (*GetSignedDocumentUrlResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSignedDocumentUrl not implemented")
 }
+func (UnimplementedHrLeaveServiceServer) ChangeLeaveDates(context.Context, *ChangeLeaveDatesRequest) (*ChangeLeaveDatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeLeaveDates not implemented")
+}
+func (UnimplementedHrLeaveServiceServer) ListLeaveAmendments(context.Context, *ListLeaveAmendmentsRequest) (*ListLeaveAmendmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLeaveAmendments not implemented")
+}
+func (UnimplementedHrLeaveServiceServer) ApproveLeaveAmendment(context.Context, *ApproveLeaveAmendmentRequest) (*ApproveLeaveAmendmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveLeaveAmendment not implemented")
+}
+func (UnimplementedHrLeaveServiceServer) RejectLeaveAmendment(context.Context, *RejectLeaveAmendmentRequest) (*RejectLeaveAmendmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectLeaveAmendment not implemented")
+}
+func (UnimplementedHrLeaveServiceServer) ShortenLeaveRequest(context.Context, *ShortenLeaveRequestRequest) (*ShortenLeaveRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShortenLeaveRequest not implemented")
+}
+func (UnimplementedHrLeaveServiceServer) GetLeaveCoverage(context.Context, *GetLeaveCoverageRequest) (*GetLeaveCoverageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLeaveCoverage not implemented")
+}
+func (UnimplementedHrLeaveServiceServer) PostLeaveComment(context.Context, *PostLeaveCommentRequest) (*PostLeaveCommentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PostLeaveComment not implemented")
+}
+func (UnimplementedHrLeaveServiceServer) ListLeaveComments(context.Context, *ListLeaveCommentsRequest) (*ListLeaveCommentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLeaveComments not implemented")
+}
+func (UnimplementedHrLeaveServiceServer) UpdateLeaveComment(context.Context, *UpdateLeaveCommentRequest) (*UpdateLeaveCommentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateLeaveComment not implemented")
+}
 func (UnimplementedHrLeaveServiceServer) mustEmbedUnimplementedHrLeaveServiceServer() {}
 func (UnimplementedHrLeaveServiceServer) testEmbeddedByValue()                        {}
 
@@ -305,6 +599,24 @@ func _HrLeaveService_ListLeaveRequests_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HrLeaveService_ListAssignedApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssignedApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).ListAssignedApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_ListAssignedApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).ListAssignedApprovals(ctx, req.(*ListAssignedApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HrLeaveService_UpdateLeaveRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateLeaveRequestRequest)
 	if err := dec(in); err != nil {
@@ -413,6 +725,132 @@ func _HrLeaveService_RevokeLeaveRequest_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HrLeaveService_BulkApproveLeaveRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkApproveLeaveRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).BulkApproveLeaveRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_BulkApproveLeaveRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).BulkApproveLeaveRequests(ctx, req.(*BulkApproveLeaveRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrLeaveService_BulkRejectLeaveRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRejectLeaveRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).BulkRejectLeaveRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_BulkRejectLeaveRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).BulkRejectLeaveRequests(ctx, req.(*BulkRejectLeaveRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrLeaveService_BulkRevokeLeaveRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRevokeLeaveRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).BulkRevokeLeaveRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_BulkRevokeLeaveRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).BulkRevokeLeaveRequests(ctx, req.(*BulkRevokeLeaveRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrLeaveService_CancelFollowingOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFollowingOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).CancelFollowingOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_CancelFollowingOccurrences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).CancelFollowingOccurrences(ctx, req.(*CancelFollowingOccurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrLeaveService_UpdateFollowingOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFollowingOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).UpdateFollowingOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_UpdateFollowingOccurrences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).UpdateFollowingOccurrences(ctx, req.(*UpdateFollowingOccurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrLeaveService_ApproveLeaveSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveLeaveSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).ApproveLeaveSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_ApproveLeaveSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).ApproveLeaveSeries(ctx, req.(*ApproveLeaveSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrLeaveService_RejectLeaveSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectLeaveSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).RejectLeaveSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_RejectLeaveSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).RejectLeaveSeries(ctx, req.(*RejectLeaveSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HrLeaveService_GetCalendarEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCalendarEventsRequest)
 	if err := dec(in); err != nil {
@@ -449,6 +887,168 @@ func _HrLeaveService_GetSignedDocumentUrl_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HrLeaveService_ChangeLeaveDates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeLeaveDatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).ChangeLeaveDates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_ChangeLeaveDates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).ChangeLeaveDates(ctx, req.(*ChangeLeaveDatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrLeaveService_ListLeaveAmendments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeaveAmendmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).ListLeaveAmendments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_ListLeaveAmendments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).ListLeaveAmendments(ctx, req.(*ListLeaveAmendmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrLeaveService_ApproveLeaveAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveLeaveAmendmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).ApproveLeaveAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_ApproveLeaveAmendment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).ApproveLeaveAmendment(ctx, req.(*ApproveLeaveAmendmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrLeaveService_RejectLeaveAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectLeaveAmendmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).RejectLeaveAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_RejectLeaveAmendment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).RejectLeaveAmendment(ctx, req.(*RejectLeaveAmendmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrLeaveService_ShortenLeaveRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShortenLeaveRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).ShortenLeaveRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_ShortenLeaveRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).ShortenLeaveRequest(ctx, req.(*ShortenLeaveRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrLeaveService_GetLeaveCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaveCoverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).GetLeaveCoverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_GetLeaveCoverage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).GetLeaveCoverage(ctx, req.(*GetLeaveCoverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrLeaveService_PostLeaveComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostLeaveCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).PostLeaveComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_PostLeaveComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).PostLeaveComment(ctx, req.(*PostLeaveCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrLeaveService_ListLeaveComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeaveCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).ListLeaveComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_ListLeaveComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).ListLeaveComments(ctx, req.(*ListLeaveCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HrLeaveService_UpdateLeaveComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLeaveCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HrLeaveServiceServer).UpdateLeaveComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HrLeaveService_UpdateLeaveComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HrLeaveServiceServer).UpdateLeaveComment(ctx, req.(*UpdateLeaveCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HrLeaveService_ServiceDesc is the grpc.ServiceDesc for HrLeaveService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -468,6 +1068,10 @@ var HrLeaveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLeaveRequests",
 			Handler:    _HrLeaveService_ListLeaveRequests_Handler,
 		},
+		{
+			MethodName: "ListAssignedApprovals",
+			Handler:    _HrLeaveService_ListAssignedApprovals_Handler,
+		},
 		{
 			MethodName: "UpdateLeaveRequest",
 			Handler:    _HrLeaveService_UpdateLeaveRequest_Handler,
@@ -492,6 +1096,34 @@ var HrLeaveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeLeaveRequest",
 			Handler:    _HrLeaveService_RevokeLeaveRequest_Handler,
 		},
+		{
+			MethodName: "BulkApproveLeaveRequests",
+			Handler:    _HrLeaveService_BulkApproveLeaveRequests_Handler,
+		},
+		{
+			MethodName: "BulkRejectLeaveRequests",
+			Handler:    _HrLeaveService_BulkRejectLeaveRequests_Handler,
+		},
+		{
+			MethodName: "BulkRevokeLeaveRequests",
+			Handler:    _HrLeaveService_BulkRevokeLeaveRequests_Handler,
+		},
+		{
+			MethodName: "CancelFollowingOccurrences",
+			Handler:    _HrLeaveService_CancelFollowingOccurrences_Handler,
+		},
+		{
+			MethodName: "UpdateFollowingOccurrences",
+			Handler:    _HrLeaveService_UpdateFollowingOccurrences_Handler,
+		},
+		{
+			MethodName: "ApproveLeaveSeries",
+			Handler:    _HrLeaveService_ApproveLeaveSeries_Handler,
+		},
+		{
+			MethodName: "RejectLeaveSeries",
+			Handler:    _HrLeaveService_RejectLeaveSeries_Handler,
+		},
 		{
 			MethodName: "GetCalendarEvents",
 			Handler:    _HrLeaveService_GetCalendarEvents_Handler,
@@ -500,6 +1132,42 @@ var HrLeaveService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSignedDocumentUrl",
 			Handler:    _HrLeaveService_GetSignedDocumentUrl_Handler,
 		},
+		{
+			MethodName: "ChangeLeaveDates",
+			Handler:    _HrLeaveService_ChangeLeaveDates_Handler,
+		},
+		{
+			MethodName: "ListLeaveAmendments",
+			Handler:    _HrLeaveService_ListLeaveAmendments_Handler,
+		},
+		{
+			MethodName: "ApproveLeaveAmendment",
+			Handler:    _HrLeaveService_ApproveLeaveAmendment_Handler,
+		},
+		{
+			MethodName: "RejectLeaveAmendment",
+			Handler:    _HrLeaveService_RejectLeaveAmendment_Handler,
+		},
+		{
+			MethodName: "ShortenLeaveRequest",
+			Handler:    _HrLeaveService_ShortenLeaveRequest_Handler,
+		},
+		{
+			MethodName: "GetLeaveCoverage",
+			Handler:    _HrLeaveService_GetLeaveCoverage_Handler,
+		},
+		{
+			MethodName: "PostLeaveComment",
+			Handler:    _HrLeaveService_PostLeaveComment_Handler,
+		},
+		{
+			MethodName: "ListLeaveComments",
+			Handler:    _HrLeaveService_ListLeaveComments_Handler,
+		},
+		{
+			MethodName: "UpdateLeaveComment",
+			Handler:    _HrLeaveService_UpdateLeaveComment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hr/service/v1/leave.proto",
@@ -20,29 +20,74 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationHrLeaveServiceApproveLeaveAmendment = "/hr.service.v1.HrLeaveService/ApproveLeaveAmendment"
 const OperationHrLeaveServiceApproveLeaveRequest = "/hr.service.v1.HrLeaveService/ApproveLeaveRequest"
+const OperationHrLeaveServiceApproveLeaveSeries = "/hr.service.v1.HrLeaveService/ApproveLeaveSeries"
+const OperationHrLeaveServiceBulkApproveLeaveRequests = "/hr.service.v1.HrLeaveService/BulkApproveLeaveRequests"
+const OperationHrLeaveServiceBulkRejectLeaveRequests = "/hr.service.v1.HrLeaveService/BulkRejectLeaveRequests"
+const OperationHrLeaveServiceBulkRevokeLeaveRequests = "/hr.service.v1.HrLeaveService/BulkRevokeLeaveRequests"
+const OperationHrLeaveServiceCancelFollowingOccurrences = "/hr.service.v1.HrLeaveService/CancelFollowingOccurrences"
 const OperationHrLeaveServiceCancelLeaveRequest = "/hr.service.v1.HrLeaveService/CancelLeaveRequest"
+const OperationHrLeaveServiceChangeLeaveDates = "/hr.service.v1.HrLeaveService/ChangeLeaveDates"
 const OperationHrLeaveServiceCreateLeaveRequest = "/hr.service.v1.HrLeaveService/CreateLeaveRequest"
 const OperationHrLeaveServiceDeleteLeaveRequest = "/hr.service.v1.HrLeaveService/DeleteLeaveRequest"
 const OperationHrLeaveServiceGetCalendarEvents = "/hr.service.v1.HrLeaveService/GetCalendarEvents"
+const OperationHrLeaveServiceGetLeaveCoverage = "/hr.service.v1.HrLeaveService/GetLeaveCoverage"
 const OperationHrLeaveServiceGetLeaveRequest = "/hr.service.v1.HrLeaveService/GetLeaveRequest"
 const OperationHrLeaveServiceGetSignedDocumentUrl = "/hr.service.v1.HrLeaveService/GetSignedDocumentUrl"
+const OperationHrLeaveServiceListAssignedApprovals = "/hr.service.v1.HrLeaveService/ListAssignedApprovals"
+const OperationHrLeaveServiceListLeaveAmendments = "/hr.service.v1.HrLeaveService/ListLeaveAmendments"
+const OperationHrLeaveServiceListLeaveComments = "/hr.service.v1.HrLeaveService/ListLeaveComments"
 const OperationHrLeaveServiceListLeaveRequests = "/hr.service.v1.HrLeaveService/ListLeaveRequests"
+const OperationHrLeaveServicePostLeaveComment = "/hr.service.v1.HrLeaveService/PostLeaveComment"
+const OperationHrLeaveServiceRejectLeaveAmendment = "/hr.service.v1.HrLeaveService/RejectLeaveAmendment"
 const OperationHrLeaveServiceRejectLeaveRequest = "/hr.service.v1.HrLeaveService/RejectLeaveRequest"
+const OperationHrLeaveServiceRejectLeaveSeries = "/hr.service.v1.HrLeaveService/RejectLeaveSeries"
 const OperationHrLeaveServiceRevokeLeaveRequest = "/hr.service.v1.HrLeaveService/RevokeLeaveRequest"
+const OperationHrLeaveServiceShortenLeaveRequest = "/hr.service.v1.HrLeaveService/ShortenLeaveRequest"
+const OperationHrLeaveServiceUpdateFollowingOccurrences = "/hr.service.v1.HrLeaveService/UpdateFollowingOccurrences"
+const OperationHrLeaveServiceUpdateLeaveComment = "/hr.service.v1.HrLeaveService/UpdateLeaveComment"
 const OperationHrLeaveServiceUpdateLeaveRequest = "/hr.service.v1.HrLeaveService/UpdateLeaveRequest"
 
 type HrLeaveServiceHTTPServer interface {
+	ApproveLeaveAmendment(context.Context, *ApproveLeaveAmendmentRequest) (*ApproveLeaveAmendmentResponse, error)
 	ApproveLeaveRequest(context.Context, *ApproveLeaveRequestRequest) (*ApproveLeaveRequestResponse, error)
+	// ApproveLeaveSeries Approve the pending occurrences of a series, each as ApproveLeaveRequest does
+	ApproveLeaveSeries(context.Context, *ApproveLeaveSeriesRequest) (*BulkLeaveRequestsResponse, error)
+	// BulkApproveLeaveRequests Approve pending requests, each as ApproveLeaveRequest does
+	BulkApproveLeaveRequests(context.Context, *BulkApproveLeaveRequestsRequest) (*BulkLeaveRequestsResponse, error)
+	// BulkRejectLeaveRequests Reject pending requests, each as RejectLeaveRequest does
+	BulkRejectLeaveRequests(context.Context, *BulkRejectLeaveRequestsRequest) (*BulkLeaveRequestsResponse, error)
+	// BulkRevokeLeaveRequests Revoke approved requests, each as RevokeLeaveRequest does
+	BulkRevokeLeaveRequests(context.Context, *BulkRevokeLeaveRequestsRequest) (*BulkLeaveRequestsResponse, error)
+	// CancelFollowingOccurrences Cancel an occurrence of a series and the occurrences after it, each as CancelLeaveRequest does
+	CancelFollowingOccurrences(context.Context, *CancelFollowingOccurrencesRequest) (*BulkLeaveRequestsResponse, error)
 	CancelLeaveRequest(context.Context, *CancelLeaveRequestRequest) (*CancelLeaveRequestResponse, error)
+	ChangeLeaveDates(context.Context, *ChangeLeaveDatesRequest) (*ChangeLeaveDatesResponse, error)
 	CreateLeaveRequest(context.Context, *CreateLeaveRequestRequest) (*CreateLeaveRequestResponse, error)
 	DeleteLeaveRequest(context.Context, *DeleteLeaveRequestRequest) (*emptypb.Empty, error)
 	GetCalendarEvents(context.Context, *GetCalendarEventsRequest) (*GetCalendarEventsResponse, error)
+	// GetLeaveCoverage Check a request against the coverage rules of the requester's org units, e.g. before
+	// approving it
+	GetLeaveCoverage(context.Context, *GetLeaveCoverageRequest) (*GetLeaveCoverageResponse, error)
 	GetLeaveRequest(context.Context, *GetLeaveRequestRequest) (*GetLeaveRequestResponse, error)
 	GetSignedDocumentUrl(context.Context, *GetSignedDocumentUrlRequest) (*GetSignedDocumentUrlResponse, error)
+	ListAssignedApprovals(context.Context, *ListAssignedApprovalsRequest) (*ListAssignedApprovalsResponse, error)
+	ListLeaveAmendments(context.Context, *ListLeaveAmendmentsRequest) (*ListLeaveAmendmentsResponse, error)
+	// ListLeaveComments List the comments on a request the caller may read, oldest first
+	ListLeaveComments(context.Context, *ListLeaveCommentsRequest) (*ListLeaveCommentsResponse, error)
 	ListLeaveRequests(context.Context, *ListLeaveRequestsRequest) (*ListLeaveRequestsResponse, error)
+	// PostLeaveComment Post a comment on a request; the requester or the approvers are notified of shared comments
+	PostLeaveComment(context.Context, *PostLeaveCommentRequest) (*PostLeaveCommentResponse, error)
+	RejectLeaveAmendment(context.Context, *RejectLeaveAmendmentRequest) (*RejectLeaveAmendmentResponse, error)
 	RejectLeaveRequest(context.Context, *RejectLeaveRequestRequest) (*RejectLeaveRequestResponse, error)
+	// RejectLeaveSeries Reject the pending occurrences of a series, each as RejectLeaveRequest does
+	RejectLeaveSeries(context.Context, *RejectLeaveSeriesRequest) (*BulkLeaveRequestsResponse, error)
 	RevokeLeaveRequest(context.Context, *RevokeLeaveRequestRequest) (*RevokeLeaveRequestResponse, error)
+	ShortenLeaveRequest(context.Context, *ShortenLeaveRequestRequest) (*ShortenLeaveRequestResponse, error)
+	// UpdateFollowingOccurrences Edit an occurrence of a series and the occurrences after it
+	UpdateFollowingOccurrences(context.Context, *UpdateFollowingOccurrencesRequest) (*UpdateFollowingOccurrencesResponse, error)
+	UpdateLeaveComment(context.Context, *UpdateLeaveCommentRequest) (*UpdateLeaveCommentResponse, error)
 	UpdateLeaveRequest(context.Context, *UpdateLeaveRequestRequest) (*UpdateLeaveRequestResponse, error)
 }
 
@@ -51,14 +96,31 @@ func RegisterHrLeaveServiceHTTPServer(s *http.Server, srv HrLeaveServiceHTTPServ
 	r.POST("/v1/leave-requests", _HrLeaveService_CreateLeaveRequest0_HTTP_Handler(srv))
 	r.GET("/v1/leave-requests/{id}", _HrLeaveService_GetLeaveRequest0_HTTP_Handler(srv))
 	r.GET("/v1/leave-requests", _HrLeaveService_ListLeaveRequests0_HTTP_Handler(srv))
+	r.GET("/v1/assigned-approvals", _HrLeaveService_ListAssignedApprovals0_HTTP_Handler(srv))
 	r.PUT("/v1/leave-requests/{id}", _HrLeaveService_UpdateLeaveRequest0_HTTP_Handler(srv))
 	r.DELETE("/v1/leave-requests/{id}", _HrLeaveService_DeleteLeaveRequest0_HTTP_Handler(srv))
 	r.POST("/v1/leave-requests/{id}/approve", _HrLeaveService_ApproveLeaveRequest0_HTTP_Handler(srv))
 	r.POST("/v1/leave-requests/{id}/reject", _HrLeaveService_RejectLeaveRequest0_HTTP_Handler(srv))
 	r.POST("/v1/leave-requests/{id}/cancel", _HrLeaveService_CancelLeaveRequest0_HTTP_Handler(srv))
 	r.POST("/v1/leave-requests/{id}/revoke", _HrLeaveService_RevokeLeaveRequest0_HTTP_Handler(srv))
+	r.POST("/v1/leave-requests:bulkApprove", _HrLeaveService_BulkApproveLeaveRequests0_HTTP_Handler(srv))
+	r.POST("/v1/leave-requests:bulkReject", _HrLeaveService_BulkRejectLeaveRequests0_HTTP_Handler(srv))
+	r.POST("/v1/leave-requests:bulkRevoke", _HrLeaveService_BulkRevokeLeaveRequests0_HTTP_Handler(srv))
+	r.POST("/v1/leave-requests/{id}/cancel-following", _HrLeaveService_CancelFollowingOccurrences0_HTTP_Handler(srv))
+	r.PUT("/v1/leave-requests/{id}/following", _HrLeaveService_UpdateFollowingOccurrences0_HTTP_Handler(srv))
+	r.POST("/v1/leave-series/{series_id}/approve", _HrLeaveService_ApproveLeaveSeries0_HTTP_Handler(srv))
+	r.POST("/v1/leave-series/{series_id}/reject", _HrLeaveService_RejectLeaveSeries0_HTTP_Handler(srv))
 	r.GET("/v1/calendar", _HrLeaveService_GetCalendarEvents0_HTTP_Handler(srv))
 	r.GET("/v1/leave-requests/{leave_request_id}/signed-document", _HrLeaveService_GetSignedDocumentUrl0_HTTP_Handler(srv))
+	r.POST("/v1/leave-requests/{id}/change-dates", _HrLeaveService_ChangeLeaveDates0_HTTP_Handler(srv))
+	r.GET("/v1/leave-requests/{leave_request_id}/amendments", _HrLeaveService_ListLeaveAmendments0_HTTP_Handler(srv))
+	r.POST("/v1/leave-amendments/{id}/approve", _HrLeaveService_ApproveLeaveAmendment0_HTTP_Handler(srv))
+	r.POST("/v1/leave-amendments/{id}/reject", _HrLeaveService_RejectLeaveAmendment0_HTTP_Handler(srv))
+	r.POST("/v1/leave-requests/{id}/shorten", _HrLeaveService_ShortenLeaveRequest0_HTTP_Handler(srv))
+	r.GET("/v1/leave-requests/{id}/coverage", _HrLeaveService_GetLeaveCoverage0_HTTP_Handler(srv))
+	r.POST("/v1/leave-requests/{leave_request_id}/comments", _HrLeaveService_PostLeaveComment0_HTTP_Handler(srv))
+	r.GET("/v1/leave-requests/{leave_request_id}/comments", _HrLeaveService_ListLeaveComments0_HTTP_Handler(srv))
+	r.PUT("/v1/leave-requests/{leave_request_id}/comments/{id}", _HrLeaveService_UpdateLeaveComment0_HTTP_Handler(srv))
 }
 
 func _HrLeaveService_CreateLeaveRequest0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
@@ -124,6 +186,25 @@ func _HrLeaveService_ListLeaveRequests0_HTTP_Handler(srv HrLeaveServiceHTTPServe
 	}
 }
 
+func _HrLeaveService_ListAssignedApprovals0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAssignedApprovalsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceListAssignedApprovals)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAssignedApprovals(ctx, req.(*ListAssignedApprovalsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAssignedApprovalsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _HrLeaveService_UpdateLeaveRequest0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in UpdateLeaveRequestRequest
@@ -271,6 +352,172 @@ func _HrLeaveService_RevokeLeaveRequest0_HTTP_Handler(srv HrLeaveServiceHTTPServ
 	}
 }
 
+func _HrLeaveService_BulkApproveLeaveRequests0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BulkApproveLeaveRequestsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceBulkApproveLeaveRequests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BulkApproveLeaveRequests(ctx, req.(*BulkApproveLeaveRequestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BulkLeaveRequestsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrLeaveService_BulkRejectLeaveRequests0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BulkRejectLeaveRequestsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceBulkRejectLeaveRequests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BulkRejectLeaveRequests(ctx, req.(*BulkRejectLeaveRequestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BulkLeaveRequestsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrLeaveService_BulkRevokeLeaveRequests0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BulkRevokeLeaveRequestsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceBulkRevokeLeaveRequests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BulkRevokeLeaveRequests(ctx, req.(*BulkRevokeLeaveRequestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BulkLeaveRequestsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrLeaveService_CancelFollowingOccurrences0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CancelFollowingOccurrencesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceCancelFollowingOccurrences)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CancelFollowingOccurrences(ctx, req.(*CancelFollowingOccurrencesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BulkLeaveRequestsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrLeaveService_UpdateFollowingOccurrences0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateFollowingOccurrencesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceUpdateFollowingOccurrences)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateFollowingOccurrences(ctx, req.(*UpdateFollowingOccurrencesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateFollowingOccurrencesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrLeaveService_ApproveLeaveSeries0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApproveLeaveSeriesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceApproveLeaveSeries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApproveLeaveSeries(ctx, req.(*ApproveLeaveSeriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BulkLeaveRequestsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrLeaveService_RejectLeaveSeries0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RejectLeaveSeriesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceRejectLeaveSeries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RejectLeaveSeries(ctx, req.(*RejectLeaveSeriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BulkLeaveRequestsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _HrLeaveService_GetCalendarEvents0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetCalendarEventsRequest
@@ -312,17 +559,261 @@ func _HrLeaveService_GetSignedDocumentUrl0_HTTP_Handler(srv HrLeaveServiceHTTPSe
 	}
 }
 
+func _HrLeaveService_ChangeLeaveDates0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangeLeaveDatesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceChangeLeaveDates)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangeLeaveDates(ctx, req.(*ChangeLeaveDatesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangeLeaveDatesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrLeaveService_ListLeaveAmendments0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListLeaveAmendmentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceListLeaveAmendments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListLeaveAmendments(ctx, req.(*ListLeaveAmendmentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListLeaveAmendmentsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrLeaveService_ApproveLeaveAmendment0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApproveLeaveAmendmentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceApproveLeaveAmendment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApproveLeaveAmendment(ctx, req.(*ApproveLeaveAmendmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ApproveLeaveAmendmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrLeaveService_RejectLeaveAmendment0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RejectLeaveAmendmentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceRejectLeaveAmendment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RejectLeaveAmendment(ctx, req.(*RejectLeaveAmendmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RejectLeaveAmendmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrLeaveService_ShortenLeaveRequest0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ShortenLeaveRequestRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceShortenLeaveRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ShortenLeaveRequest(ctx, req.(*ShortenLeaveRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ShortenLeaveRequestResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrLeaveService_GetLeaveCoverage0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetLeaveCoverageRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceGetLeaveCoverage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetLeaveCoverage(ctx, req.(*GetLeaveCoverageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetLeaveCoverageResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrLeaveService_PostLeaveComment0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PostLeaveCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServicePostLeaveComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PostLeaveComment(ctx, req.(*PostLeaveCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PostLeaveCommentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrLeaveService_ListLeaveComments0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListLeaveCommentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceListLeaveComments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListLeaveComments(ctx, req.(*ListLeaveCommentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListLeaveCommentsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _HrLeaveService_UpdateLeaveComment0_HTTP_Handler(srv HrLeaveServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateLeaveCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationHrLeaveServiceUpdateLeaveComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateLeaveComment(ctx, req.(*UpdateLeaveCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateLeaveCommentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type HrLeaveServiceHTTPClient interface {
+	ApproveLeaveAmendment(ctx context.Context, req *ApproveLeaveAmendmentRequest, opts ...http.CallOption) (rsp *ApproveLeaveAmendmentResponse, err error)
 	ApproveLeaveRequest(ctx context.Context, req *ApproveLeaveRequestRequest, opts ...http.CallOption) (rsp *ApproveLeaveRequestResponse, err error)
+	// ApproveLeaveSeries Approve the pending occurrences of a series, each as ApproveLeaveRequest does
+	ApproveLeaveSeries(ctx context.Context, req *ApproveLeaveSeriesRequest, opts ...http.CallOption) (rsp *BulkLeaveRequestsResponse, err error)
+	// BulkApproveLeaveRequests Approve pending requests, each as ApproveLeaveRequest does
+	BulkApproveLeaveRequests(ctx context.Context, req *BulkApproveLeaveRequestsRequest, opts ...http.CallOption) (rsp *BulkLeaveRequestsResponse, err error)
+	// BulkRejectLeaveRequests Reject pending requests, each as RejectLeaveRequest does
+	BulkRejectLeaveRequests(ctx context.Context, req *BulkRejectLeaveRequestsRequest, opts ...http.CallOption) (rsp *BulkLeaveRequestsResponse, err error)
+	// BulkRevokeLeaveRequests Revoke approved requests, each as RevokeLeaveRequest does
+	BulkRevokeLeaveRequests(ctx context.Context, req *BulkRevokeLeaveRequestsRequest, opts ...http.CallOption) (rsp *BulkLeaveRequestsResponse, err error)
+	// CancelFollowingOccurrences Cancel an occurrence of a series and the occurrences after it, each as CancelLeaveRequest does
+	CancelFollowingOccurrences(ctx context.Context, req *CancelFollowingOccurrencesRequest, opts ...http.CallOption) (rsp *BulkLeaveRequestsResponse, err error)
 	CancelLeaveRequest(ctx context.Context, req *CancelLeaveRequestRequest, opts ...http.CallOption) (rsp *CancelLeaveRequestResponse, err error)
+	ChangeLeaveDates(ctx context.Context, req *ChangeLeaveDatesRequest, opts ...http.CallOption) (rsp *ChangeLeaveDatesResponse, err error)
 	CreateLeaveRequest(ctx context.Context, req *CreateLeaveRequestRequest, opts ...http.CallOption) (rsp *CreateLeaveRequestResponse, err error)
 	DeleteLeaveRequest(ctx context.Context, req *DeleteLeaveRequestRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	GetCalendarEvents(ctx context.Context, req *GetCalendarEventsRequest, opts ...http.CallOption) (rsp *GetCalendarEventsResponse, err error)
+	// GetLeaveCoverage Check a request against the coverage rules of the requester's org units, e.g. before
+	// approving it
+	GetLeaveCoverage(ctx context.Context, req *GetLeaveCoverageRequest, opts ...http.CallOption) (rsp *GetLeaveCoverageResponse, err error)
 	GetLeaveRequest(ctx context.Context, req *GetLeaveRequestRequest, opts ...http.CallOption) (rsp *GetLeaveRequestResponse, err error)
 	GetSignedDocumentUrl(ctx context.Context, req *GetSignedDocumentUrlRequest, opts ...http.CallOption) (rsp *GetSignedDocumentUrlResponse, err error)
+	ListAssignedApprovals(ctx context.Context, req *ListAssignedApprovalsRequest, opts ...http.CallOption) (rsp *ListAssignedApprovalsResponse, err error)
+	ListLeaveAmendments(ctx context.Context, req *ListLeaveAmendmentsRequest, opts ...http.CallOption) (rsp *ListLeaveAmendmentsResponse, err error)
+	// ListLeaveComments List the comments on a request the caller may read, oldest first
+	ListLeaveComments(ctx context.Context, req *ListLeaveCommentsRequest, opts ...http.CallOption) (rsp *ListLeaveCommentsResponse, err error)
 	ListLeaveRequests(ctx context.Context, req *ListLeaveRequestsRequest, opts ...http.CallOption) (rsp *ListLeaveRequestsResponse, err error)
+	// PostLeaveComment Post a comment on a request; the requester or the approvers are notified of shared comments
+	PostLeaveComment(ctx context.Context, req *PostLeaveCommentRequest, opts ...http.CallOption) (rsp *PostLeaveCommentResponse, err error)
+	RejectLeaveAmendment(ctx context.Context, req *RejectLeaveAmendmentRequest, opts ...http.CallOption) (rsp *RejectLeaveAmendmentResponse, err error)
 	RejectLeaveRequest(ctx context.Context, req *RejectLeaveRequestRequest, opts ...http.CallOption) (rsp *RejectLeaveRequestResponse, err error)
+	// RejectLeaveSeries Reject the pending occurrences of a series, each as RejectLeaveRequest does
+	RejectLeaveSeries(ctx context.Context, req *RejectLeaveSeriesRequest, opts ...http.CallOption) (rsp *BulkLeaveRequestsResponse, err error)
 	RevokeLeaveRequest(ctx context.Context, req *RevokeLeaveRequestRequest, opts ...http.CallOption) (rsp *RevokeLeaveRequestResponse, err error)
+	ShortenLeaveRequest(ctx context.Context, req *ShortenLeaveRequestRequest, opts ...http.CallOption) (rsp *ShortenLeaveRequestResponse, err error)
+	// UpdateFollowingOccurrences Edit an occurrence of a series and the occurrences after it
+	UpdateFollowingOccurrences(ctx context.Context, req *UpdateFollowingOccurrencesRequest, opts ...http.CallOption) (rsp *UpdateFollowingOccurrencesResponse, err error)
+	UpdateLeaveComment(ctx context.Context, req *UpdateLeaveCommentRequest, opts ...http.CallOption) (rsp *UpdateLeaveCommentResponse, err error)
 	UpdateLeaveRequest(ctx context.Context, req *UpdateLeaveRequestRequest, opts ...http.CallOption) (rsp *UpdateLeaveRequestResponse, err error)
 }
 
@@ -334,6 +825,19 @@ func NewHrLeaveServiceHTTPClient(client *http.Client) HrLeaveServiceHTTPClient {
 	return &HrLeaveServiceHTTPClientImpl{client}
 }
 
+func (c *HrLeaveServiceHTTPClientImpl) ApproveLeaveAmendment(ctx context.Context, in *ApproveLeaveAmendmentRequest, opts ...http.CallOption) (*ApproveLeaveAmendmentResponse, error) {
+	var out ApproveLeaveAmendmentResponse
+	pattern := "/v1/leave-amendments/{id}/approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceApproveLeaveAmendment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *HrLeaveServiceHTTPClientImpl) ApproveLeaveRequest(ctx context.Context, in *ApproveLeaveRequestRequest, opts ...http.CallOption) (*ApproveLeaveRequestResponse, error) {
 	var out ApproveLeaveRequestResponse
 	pattern := "/v1/leave-requests/{id}/approve"
@@ -347,6 +851,76 @@ func (c *HrLeaveServiceHTTPClientImpl) ApproveLeaveRequest(ctx context.Context,
 	return &out, nil
 }
 
+// ApproveLeaveSeries Approve the pending occurrences of a series, each as ApproveLeaveRequest does
+func (c *HrLeaveServiceHTTPClientImpl) ApproveLeaveSeries(ctx context.Context, in *ApproveLeaveSeriesRequest, opts ...http.CallOption) (*BulkLeaveRequestsResponse, error) {
+	var out BulkLeaveRequestsResponse
+	pattern := "/v1/leave-series/{series_id}/approve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceApproveLeaveSeries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BulkApproveLeaveRequests Approve pending requests, each as ApproveLeaveRequest does
+func (c *HrLeaveServiceHTTPClientImpl) BulkApproveLeaveRequests(ctx context.Context, in *BulkApproveLeaveRequestsRequest, opts ...http.CallOption) (*BulkLeaveRequestsResponse, error) {
+	var out BulkLeaveRequestsResponse
+	pattern := "/v1/leave-requests:bulkApprove"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceBulkApproveLeaveRequests))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BulkRejectLeaveRequests Reject pending requests, each as RejectLeaveRequest does
+func (c *HrLeaveServiceHTTPClientImpl) BulkRejectLeaveRequests(ctx context.Context, in *BulkRejectLeaveRequestsRequest, opts ...http.CallOption) (*BulkLeaveRequestsResponse, error) {
+	var out BulkLeaveRequestsResponse
+	pattern := "/v1/leave-requests:bulkReject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceBulkRejectLeaveRequests))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BulkRevokeLeaveRequests Revoke approved requests, each as RevokeLeaveRequest does
+func (c *HrLeaveServiceHTTPClientImpl) BulkRevokeLeaveRequests(ctx context.Context, in *BulkRevokeLeaveRequestsRequest, opts ...http.CallOption) (*BulkLeaveRequestsResponse, error) {
+	var out BulkLeaveRequestsResponse
+	pattern := "/v1/leave-requests:bulkRevoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceBulkRevokeLeaveRequests))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelFollowingOccurrences Cancel an occurrence of a series and the occurrences after it, each as CancelLeaveRequest does
+func (c *HrLeaveServiceHTTPClientImpl) CancelFollowingOccurrences(ctx context.Context, in *CancelFollowingOccurrencesRequest, opts ...http.CallOption) (*BulkLeaveRequestsResponse, error) {
+	var out BulkLeaveRequestsResponse
+	pattern := "/v1/leave-requests/{id}/cancel-following"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceCancelFollowingOccurrences))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *HrLeaveServiceHTTPClientImpl) CancelLeaveRequest(ctx context.Context, in *CancelLeaveRequestRequest, opts ...http.CallOption) (*CancelLeaveRequestResponse, error) {
 	var out CancelLeaveRequestResponse
 	pattern := "/v1/leave-requests/{id}/cancel"
@@ -360,6 +934,19 @@ func (c *HrLeaveServiceHTTPClientImpl) CancelLeaveRequest(ctx context.Context, i
 	return &out, nil
 }
 
+func (c *HrLeaveServiceHTTPClientImpl) ChangeLeaveDates(ctx context.Context, in *ChangeLeaveDatesRequest, opts ...http.CallOption) (*ChangeLeaveDatesResponse, error) {
+	var out ChangeLeaveDatesResponse
+	pattern := "/v1/leave-requests/{id}/change-dates"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceChangeLeaveDates))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *HrLeaveServiceHTTPClientImpl) CreateLeaveRequest(ctx context.Context, in *CreateLeaveRequestRequest, opts ...http.CallOption) (*CreateLeaveRequestResponse, error) {
 	var out CreateLeaveRequestResponse
 	pattern := "/v1/leave-requests"
@@ -399,6 +986,21 @@ func (c *HrLeaveServiceHTTPClientImpl) GetCalendarEvents(ctx context.Context, in
 	return &out, nil
 }
 
+// GetLeaveCoverage Check a request against the coverage rules of the requester's org units, e.g. before
+// approving it
+func (c *HrLeaveServiceHTTPClientImpl) GetLeaveCoverage(ctx context.Context, in *GetLeaveCoverageRequest, opts ...http.CallOption) (*GetLeaveCoverageResponse, error) {
+	var out GetLeaveCoverageResponse
+	pattern := "/v1/leave-requests/{id}/coverage"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceGetLeaveCoverage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *HrLeaveServiceHTTPClientImpl) GetLeaveRequest(ctx context.Context, in *GetLeaveRequestRequest, opts ...http.CallOption) (*GetLeaveRequestResponse, error) {
 	var out GetLeaveRequestResponse
 	pattern := "/v1/leave-requests/{id}"
@@ -425,6 +1027,46 @@ func (c *HrLeaveServiceHTTPClientImpl) GetSignedDocumentUrl(ctx context.Context,
 	return &out, nil
 }
 
+func (c *HrLeaveServiceHTTPClientImpl) ListAssignedApprovals(ctx context.Context, in *ListAssignedApprovalsRequest, opts ...http.CallOption) (*ListAssignedApprovalsResponse, error) {
+	var out ListAssignedApprovalsResponse
+	pattern := "/v1/assigned-approvals"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceListAssignedApprovals))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HrLeaveServiceHTTPClientImpl) ListLeaveAmendments(ctx context.Context, in *ListLeaveAmendmentsRequest, opts ...http.CallOption) (*ListLeaveAmendmentsResponse, error) {
+	var out ListLeaveAmendmentsResponse
+	pattern := "/v1/leave-requests/{leave_request_id}/amendments"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceListLeaveAmendments))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListLeaveComments List the comments on a request the caller may read, oldest first
+func (c *HrLeaveServiceHTTPClientImpl) ListLeaveComments(ctx context.Context, in *ListLeaveCommentsRequest, opts ...http.CallOption) (*ListLeaveCommentsResponse, error) {
+	var out ListLeaveCommentsResponse
+	pattern := "/v1/leave-requests/{leave_request_id}/comments"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceListLeaveComments))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *HrLeaveServiceHTTPClientImpl) ListLeaveRequests(ctx context.Context, in *ListLeaveRequestsRequest, opts ...http.CallOption) (*ListLeaveRequestsResponse, error) {
 	var out ListLeaveRequestsResponse
 	pattern := "/v1/leave-requests"
@@ -438,6 +1080,33 @@ func (c *HrLeaveServiceHTTPClientImpl) ListLeaveRequests(ctx context.Context, in
 	return &out, nil
 }
 
+// PostLeaveComment Post a comment on a request; the requester or the approvers are notified of shared comments
+func (c *HrLeaveServiceHTTPClientImpl) PostLeaveComment(ctx context.Context, in *PostLeaveCommentRequest, opts ...http.CallOption) (*PostLeaveCommentResponse, error) {
+	var out PostLeaveCommentResponse
+	pattern := "/v1/leave-requests/{leave_request_id}/comments"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHrLeaveServicePostLeaveComment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HrLeaveServiceHTTPClientImpl) RejectLeaveAmendment(ctx context.Context, in *RejectLeaveAmendmentRequest, opts ...http.CallOption) (*RejectLeaveAmendmentResponse, error) {
+	var out RejectLeaveAmendmentResponse
+	pattern := "/v1/leave-amendments/{id}/reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceRejectLeaveAmendment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *HrLeaveServiceHTTPClientImpl) RejectLeaveRequest(ctx context.Context, in *RejectLeaveRequestRequest, opts ...http.CallOption) (*RejectLeaveRequestResponse, error) {
 	var out RejectLeaveRequestResponse
 	pattern := "/v1/leave-requests/{id}/reject"
@@ -451,6 +1120,20 @@ func (c *HrLeaveServiceHTTPClientImpl) RejectLeaveRequest(ctx context.Context, i
 	return &out, nil
 }
 
+// RejectLeaveSeries Reject the pending occurrences of a series, each as RejectLeaveRequest does
+func (c *HrLeaveServiceHTTPClientImpl) RejectLeaveSeries(ctx context.Context, in *RejectLeaveSeriesRequest, opts ...http.CallOption) (*BulkLeaveRequestsResponse, error) {
+	var out BulkLeaveRequestsResponse
+	pattern := "/v1/leave-series/{series_id}/reject"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceRejectLeaveSeries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *HrLeaveServiceHTTPClientImpl) RevokeLeaveRequest(ctx context.Context, in *RevokeLeaveRequestRequest, opts ...http.CallOption) (*RevokeLeaveRequestResponse, error) {
 	var out RevokeLeaveRequestResponse
 	pattern := "/v1/leave-requests/{id}/revoke"
@@ -464,6 +1147,46 @@ func (c *HrLeaveServiceHTTPClientImpl) RevokeLeaveRequest(ctx context.Context, i
 	return &out, nil
 }
 
+func (c *HrLeaveServiceHTTPClientImpl) ShortenLeaveRequest(ctx context.Context, in *ShortenLeaveRequestRequest, opts ...http.CallOption) (*ShortenLeaveRequestResponse, error) {
+	var out ShortenLeaveRequestResponse
+	pattern := "/v1/leave-requests/{id}/shorten"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceShortenLeaveRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateFollowingOccurrences Edit an occurrence of a series and the occurrences after it
+func (c *HrLeaveServiceHTTPClientImpl) UpdateFollowingOccurrences(ctx context.Context, in *UpdateFollowingOccurrencesRequest, opts ...http.CallOption) (*UpdateFollowingOccurrencesResponse, error) {
+	var out UpdateFollowingOccurrencesResponse
+	pattern := "/v1/leave-requests/{id}/following"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceUpdateFollowingOccurrences))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *HrLeaveServiceHTTPClientImpl) UpdateLeaveComment(ctx context.Context, in *UpdateLeaveCommentRequest, opts ...http.CallOption) (*UpdateLeaveCommentResponse, error) {
+	var out UpdateLeaveCommentResponse
+	pattern := "/v1/leave-requests/{leave_request_id}/comments/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationHrLeaveServiceUpdateLeaveComment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *HrLeaveServiceHTTPClientImpl) UpdateLeaveRequest(ctx context.Context, in *UpdateLeaveRequestRequest, opts ...http.CallOption) (*UpdateLeaveRequestResponse, error) {
 	var out UpdateLeaveRequestResponse
 	pattern := "/v1/leave-requests/{id}"
@@ -0,0 +1,98 @@
+// Package accrual computes the leave days earned over a year under an accrual policy.
+package accrual
+
+import (
+	"math"
+	"time"
+)
+
+// Accrual periods.
+const (
+	PeriodMonthly   = "monthly"
+	PeriodQuarterly = "quarterly"
+)
+
+// Accrual timings: days are credited on the first day of a period, or once the period has ended.
+const (
+	TimingStart = "start"
+	TimingEnd   = "end"
+)
+
+// Policy describes how days are earned over a calendar year instead of being granted up front.
+type Policy struct {
+	// Rate is the number of days earned per period.
+	Rate float64 `json:"rate"`
+	// Period is PeriodMonthly or PeriodQuarterly.
+	Period string `json:"period"`
+	// Cap limits the days earned in one year; 0 means no cap.
+	Cap float64 `json:"cap,omitempty"`
+	// Timing is TimingStart or TimingEnd. Defaults to TimingEnd.
+	Timing string `json:"timing,omitempty"`
+	// WaitingPeriods delays accrual by this many periods after the user starts accruing.
+	WaitingPeriods int `json:"waiting_periods,omitempty"`
+}
+
+// Enabled reports whether the policy earns any days. A nil policy is disabled.
+func (p *Policy) Enabled() bool {
+	return p != nil && p.Rate > 0
+}
+
+func (p *Policy) months() int {
+	if p.Period == PeriodQuarterly {
+		return 3
+	}
+	return 1
+}
+
+// Accrued returns the days earned in the given year by the date at, for a user who starts
+// accruing on start. A period in which accrual begins is earned pro rata by calendar time.
+func (p *Policy) Accrued(year int, start, at time.Time) float64 {
+	if !p.Enabled() {
+		return 0
+	}
+
+	step := p.months()
+	loc := start.Location()
+	yearStart := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+
+	begin := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc).AddDate(0, p.WaitingPeriods*step, 0)
+	if begin.Before(yearStart) {
+		begin = yearStart
+	}
+
+	total := 0.0
+	for m := 0; m < 12; m += step {
+		from := yearStart.AddDate(0, m, 0)
+		to := from.AddDate(0, step, 0)
+		if !to.After(begin) {
+			continue // period ended before accrual began
+		}
+
+		credited := to
+		if p.Timing == TimingStart {
+			credited = from
+			if begin.After(from) {
+				credited = begin
+			}
+		}
+		if credited.After(at) {
+			break
+		}
+
+		share := 1.0
+		if begin.After(from) {
+			share = to.Sub(begin).Hours() / to.Sub(from).Hours()
+		}
+		total += p.Rate * share
+	}
+
+	if p.Cap > 0 && total > p.Cap {
+		total = p.Cap
+	}
+	return math.Round(total*100) / 100
+}
+
+// ProjectedYearEnd returns the days that will have been earned by the end of the given year.
+func (p *Policy) ProjectedYearEnd(year int, start time.Time) float64 {
+	return p.Accrued(year, start, time.Date(year+1, time.January, 1, 0, 0, 0, 0, start.Location()))
+}
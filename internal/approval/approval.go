@@ -0,0 +1,50 @@
+// Package approval describes the chains of approvers a leave request passes through.
+package approval
+
+// Decisions on an approval step.
+const (
+	DecisionPending  = "pending"
+	DecisionApproved = "approved"
+	DecisionRejected = "rejected"
+	// DecisionSkipped marks the steps after a rejected step, which are never decided.
+	DecisionSkipped = "skipped"
+)
+
+// Step is one level of an approval chain.
+type Step struct {
+	// Name describes the step, e.g. "Team lead".
+	Name string `json:"name"`
+	// Role is the role code an approver of this step must hold; empty allows anyone who may
+	// approve leave requests.
+	Role string `json:"role,omitempty"`
+	// ApproverIDs restricts the step to the given users; empty allows any holder of Role.
+	ApproverIDs []uint32 `json:"approver_ids,omitempty"`
+	// MinDays applies the step only to requests of at least this many days; 0 always applies it.
+	MinDays float64 `json:"min_days,omitempty"`
+	// Manager assigns the step to the requester's line manager. Without a known manager the step
+	// falls back to Role and ApproverIDs.
+	Manager bool `json:"manager,omitempty"`
+}
+
+// Chain is the ordered list of steps a request must pass before it is approved. Without a chain
+// a single approval suffices.
+type Chain struct {
+	Steps []Step `json:"steps"`
+}
+
+// StepsFor returns the steps, in order, that a request of the given length passes through. A nil
+// chain has no steps.
+func (c *Chain) StepsFor(days float64) []Step {
+	if c == nil {
+		return nil
+	}
+
+	var steps []Step
+	for _, s := range c.Steps {
+		if s.MinDays > 0 && days < s.MinDays {
+			continue
+		}
+		steps = append(steps, s)
+	}
+	return steps
+}
@@ -0,0 +1,42 @@
+package approval
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestChainStepsFor(t *testing.T) {
+	chain := &Chain{Steps: []Step{
+		{Name: "Team lead", Manager: true},
+		{Name: "Head of department", Role: "department-head", MinDays: 5},
+		{Name: "HR", ApproverIDs: []uint32{7}, MinDays: 10.5},
+	}}
+
+	tests := []struct {
+		name  string
+		chain *Chain
+		days  float64
+		want  []string
+	}{
+		{"nil chain", nil, 20, nil},
+		{"no steps", &Chain{}, 20, nil},
+		{"short request", chain, 1, []string{"Team lead"}},
+		{"half day", chain, 0.5, []string{"Team lead"}},
+		{"just below a minimum", chain, 4.5, []string{"Team lead"}},
+		{"at a minimum", chain, 5, []string{"Team lead", "Head of department"}},
+		{"at a fractional minimum", chain, 10.5, []string{"Team lead", "Head of department", "HR"}},
+		{"long request", chain, 30, []string{"Team lead", "Head of department", "HR"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps := tt.chain.StepsFor(tt.days)
+			var got []string
+			for _, s := range steps {
+				got = append(got, s.Name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("StepsFor(%v) = %v, want %v", tt.days, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package approval
+
+import (
+	"sort"
+	"strings"
+)
+
+// DefaultManagerPositions are the position keywords that make a user the manager of their org
+// unit when none are configured.
+var DefaultManagerPositions = []string{"manager", "head", "lead"}
+
+// Member is a user of the organisation as far as approval routing is concerned.
+type Member struct {
+	ID        uint32
+	Name      string
+	OrgUnits  []string
+	Positions []string
+}
+
+// FindManager returns the line manager of the requester: another member of the org unit who
+// holds a manager position. An empty org unit falls back to the requester's own org units.
+// Positions match a keyword case-insensitively; members matching an earlier keyword win, then
+// the member with the lowest ID. Returns nil if the requester has no manager.
+func FindManager(members []Member, requesterID uint32, orgUnit string, keywords []string) *Member {
+	if len(keywords) == 0 {
+		keywords = DefaultManagerPositions
+	}
+
+	units := []string{orgUnit}
+	if orgUnit == "" {
+		for _, m := range members {
+			if m.ID == requesterID {
+				units = m.OrgUnits
+				break
+			}
+		}
+	}
+
+	type candidate struct {
+		member Member
+		rank   int
+	}
+	var candidates []candidate
+	for _, m := range members {
+		if m.ID == requesterID || !shares(m.OrgUnits, units) {
+			continue
+		}
+		if rank := positionRank(m.Positions, keywords); rank >= 0 {
+			candidates = append(candidates, candidate{member: m, rank: rank})
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].rank != candidates[j].rank {
+			return candidates[i].rank < candidates[j].rank
+		}
+		return candidates[i].member.ID < candidates[j].member.ID
+	})
+	return &candidates[0].member
+}
+
+// IsManager reports whether another member has the user as their line manager.
+func IsManager(members []Member, userID uint32, keywords []string) bool {
+	for _, m := range members {
+		if m.ID == userID {
+			continue
+		}
+		if manager := FindManager(members, m.ID, "", keywords); manager != nil && manager.ID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func shares(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x != "" && x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// positionRank returns the index of the first keyword contained in one of the positions, or -1.
+func positionRank(positions []string, keywords []string) int {
+	for i, k := range keywords {
+		k = strings.ToLower(k)
+		for _, p := range positions {
+			if k != "" && strings.Contains(strings.ToLower(p), k) {
+				return i
+			}
+		}
+	}
+	return -1
+}
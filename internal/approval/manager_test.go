@@ -0,0 +1,80 @@
+package approval
+
+import (
+	"testing"
+)
+
+func TestFindManager(t *testing.T) {
+	members := []Member{
+		{ID: 1, Name: "Requester", OrgUnits: []string{"sales"}, Positions: []string{"Account executive"}},
+		{ID: 2, Name: "Team lead", OrgUnits: []string{"sales"}, Positions: []string{"Sales Team Lead"}},
+		{ID: 3, Name: "Sales manager", OrgUnits: []string{"sales"}, Positions: []string{"Sales Manager"}},
+		{ID: 4, Name: "Other manager", OrgUnits: []string{"sales", "marketing"}, Positions: []string{"manager"}},
+		{ID: 5, Name: "Engineering head", OrgUnits: []string{"engineering"}, Positions: []string{"Head of Engineering"}},
+		{ID: 6, Name: "Engineer", OrgUnits: []string{"engineering"}, Positions: []string{"Engineer"}},
+		{ID: 7, Name: "Lone manager", OrgUnits: []string{"legal"}, Positions: []string{"Manager"}},
+		{ID: 8, Name: "No unit", Positions: []string{"Manager"}},
+		{ID: 9, Name: "Two units", OrgUnits: []string{"support", "engineering"}, Positions: []string{"Agent"}},
+	}
+
+	tests := []struct {
+		name        string
+		requesterID uint32
+		orgUnit     string
+		keywords    []string
+		want        uint32
+	}{
+		{"earlier keyword wins", 1, "sales", nil, 3},
+		{"lowest ID among equal ranks", 1, "sales", []string{"manager"}, 3},
+		{"configured keywords", 1, "sales", []string{"lead"}, 2},
+		{"keywords ignore case", 1, "sales", []string{"LEAD"}, 2},
+		{"requester's own org units", 6, "", nil, 5},
+		{"any of the requester's org units", 9, "", nil, 5},
+		{"requester is not their own manager", 3, "sales", nil, 4},
+		{"org unit given", 6, "marketing", nil, 4},
+		{"no manager in the org unit", 7, "", nil, 0},
+		{"requester without org unit", 8, "", nil, 0},
+		{"no matching position", 1, "sales", []string{"director"}, 0},
+		{"unknown requester", 42, "", nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got uint32
+			if m := FindManager(members, tt.requesterID, tt.orgUnit, tt.keywords); m != nil {
+				got = m.ID
+			}
+			if got != tt.want {
+				t.Errorf("FindManager() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsManager(t *testing.T) {
+	members := []Member{
+		{ID: 1, OrgUnits: []string{"sales"}, Positions: []string{"Account executive"}},
+		{ID: 2, OrgUnits: []string{"sales"}, Positions: []string{"Sales Manager"}},
+		{ID: 3, OrgUnits: []string{"sales"}, Positions: []string{"Team Lead"}},
+		{ID: 4, OrgUnits: []string{"legal"}, Positions: []string{"Manager"}},
+		{ID: 5, OrgUnits: []string{"sales"}, Positions: []string{"Office Lead"}},
+	}
+
+	tests := []struct {
+		name   string
+		userID uint32
+		want   bool
+	}{
+		{"manager of the org unit", 2, true},
+		{"manager of the manager", 3, true},
+		{"outranked", 5, false},
+		{"without reports", 4, false},
+		{"not a manager", 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsManager(members, tt.userID, nil); got != tt.want {
+				t.Errorf("IsManager(%d) = %v, want %v", tt.userID, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Package attachment decides when leave requests need supporting documents, e.g. a doctor's
+// certificate for sick leave, and whether a missing document holds up their approval.
+package attachment
+
+import "time"
+
+// How a missing document is enforced once its grace period has passed.
+const (
+	// EnforcementFlag approves the request but flags the missing document.
+	EnforcementFlag = "flag"
+	// EnforcementBlock refuses to approve the request until a document is attached.
+	EnforcementBlock = "block"
+)
+
+// Requirement describes the supporting documents requests of an absence type need. Without a
+// requirement no documents are needed.
+type Requirement struct {
+	// MinDays requires documents only for requests of more than this many days; 0 requires them
+	// for every request.
+	MinDays float64 `json:"min_days,omitempty"`
+	// GraceDays gives the requester this many days from the first day of the absence to attach
+	// a document; 0 requires it before the request is approved.
+	GraceDays int `json:"grace_days,omitempty"`
+	// Enforcement is EnforcementFlag or EnforcementBlock.
+	Enforcement string `json:"enforcement"`
+}
+
+// Status is where a request stands with its supporting documents.
+type Status struct {
+	// Required reports whether the request needs a document.
+	Required bool
+	// DueDate is the last day a document may be attached within the grace period; zero when the
+	// document is needed before approval.
+	DueDate time.Time
+	// Missing reports whether a required document is not attached and its grace period has
+	// passed.
+	Missing bool
+	// Blocking reports whether the missing document prevents the request from being approved.
+	Blocking bool
+}
+
+// Enabled reports whether the requirement asks for documents at all.
+func (r *Requirement) Enabled() bool {
+	return r != nil && (r.Enforcement == EnforcementFlag || r.Enforcement == EnforcementBlock)
+}
+
+// Check returns the status at the time now of a request of days that starts on startDate and
+// has attached documents.
+func (r *Requirement) Check(days float64, startDate time.Time, attached int, now time.Time) Status {
+	if !r.Enabled() || days <= r.MinDays {
+		return Status{}
+	}
+
+	status := Status{Required: true}
+	overdue := true
+	if r.GraceDays > 0 {
+		start := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
+		status.DueDate = start.AddDate(0, 0, r.GraceDays)
+		// The due date itself is still within the grace period
+		overdue = !now.Before(status.DueDate.AddDate(0, 0, 1))
+	}
+	if attached == 0 && overdue {
+		status.Missing = true
+		status.Blocking = r.Enforcement == EnforcementBlock
+	}
+	return status
+}
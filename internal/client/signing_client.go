@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -162,6 +163,40 @@ func (c *SigningClient) CancelSubmission(ctx context.Context, submissionID, reas
 	return nil
 }
 
+// States of a submission, as reported by GetSubmissionState
+const (
+	SubmissionPending   = "pending"
+	SubmissionCompleted = "completed"
+	SubmissionCancelled = "cancelled"
+	SubmissionDeclined  = "declined"
+	SubmissionExpired   = "expired"
+)
+
+// GetSubmissionState returns the state of a submission: completed once all submitters signed,
+// cancelled, declined or expired when it can no longer be completed, and pending otherwise.
+func (c *SigningClient) GetSubmissionState(ctx context.Context, submissionID string) (string, error) {
+	if err := c.resolve(); err != nil {
+		return "", err
+	}
+
+	resp, err := c.submission.GetSubmission(ctx, &signingpb.GetSubmissionRequest{
+		Id: submissionID,
+	})
+	if err != nil {
+		c.log.Errorf("Failed to get submission %s: %v", submissionID, err)
+		return "", fmt.Errorf("get submission %s: %w", submissionID, err)
+	}
+
+	// Statuses read e.g. SUBMISSION_STATUS_COMPLETED
+	status := strings.ToLower(fmt.Sprint(resp.GetSubmission().GetStatus()))
+	for _, state := range []string{SubmissionCompleted, SubmissionCancelled, SubmissionDeclined, SubmissionExpired} {
+		if strings.HasSuffix(status, state) {
+			return state, nil
+		}
+	}
+	return SubmissionPending, nil
+}
+
 // DeleteSubmission deletes a submission and all associated data (submitters, stored documents).
 func (c *SigningClient) DeleteSubmission(ctx context.Context, submissionID string) error {
 	if err := c.resolve(); err != nil {
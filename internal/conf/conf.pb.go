@@ -22,10 +22,19 @@ const (
 )
 
 type HR struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        *EventConfig           `protobuf:"bytes,1,opt,name=events,proto3" json:"events,omitempty"` // Event subscription configuration
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Events           *EventConfig            `protobuf:"bytes,1,opt,name=events,proto3" json:"events,omitempty"`                                             // Event subscription configuration
+	Accrual          *AccrualConfig          `protobuf:"bytes,2,opt,name=accrual,proto3" json:"accrual,omitempty"`                                           // Allowance accrual job configuration
+	Rollover         *RolloverConfig         `protobuf:"bytes,3,opt,name=rollover,proto3" json:"rollover,omitempty"`                                         // Year-end rollover job configuration
+	Approval         *ApprovalConfig         `protobuf:"bytes,4,opt,name=approval,proto3" json:"approval,omitempty"`                                         // Leave request approval routing
+	Escalation       *EscalationConfig       `protobuf:"bytes,5,opt,name=escalation,proto3" json:"escalation,omitempty"`                                     // Stale leave request escalation job configuration
+	Attachments      *AttachmentConfig       `protobuf:"bytes,6,opt,name=attachments,proto3" json:"attachments,omitempty"`                                   // Leave request attachment storage
+	Publish          *PublishConfig          `protobuf:"bytes,7,opt,name=publish,proto3" json:"publish,omitempty"`                                           // Outbound domain event configuration
+	Outbox           *OutboxConfig           `protobuf:"bytes,8,opt,name=outbox,proto3" json:"outbox,omitempty"`                                             // Outbox relay job configuration
+	SigningReconcile *SigningReconcileConfig `protobuf:"bytes,9,opt,name=signing_reconcile,json=signingReconcile,proto3" json:"signing_reconcile,omitempty"` // Signing reconciliation job configuration
+	Webhooks         *WebhookConfig          `protobuf:"bytes,10,opt,name=webhooks,proto3" json:"webhooks,omitempty"`                                        // Webhook delivery job configuration
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *HR) Reset() {
@@ -65,14 +74,90 @@ func (x *HR) GetEvents() *EventConfig {
 	return nil
 }
 
+func (x *HR) GetAccrual() *AccrualConfig {
+	if x != nil {
+		return x.Accrual
+	}
+	return nil
+}
+
+func (x *HR) GetRollover() *RolloverConfig {
+	if x != nil {
+		return x.Rollover
+	}
+	return nil
+}
+
+func (x *HR) GetApproval() *ApprovalConfig {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+func (x *HR) GetEscalation() *EscalationConfig {
+	if x != nil {
+		return x.Escalation
+	}
+	return nil
+}
+
+func (x *HR) GetAttachments() *AttachmentConfig {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *HR) GetPublish() *PublishConfig {
+	if x != nil {
+		return x.Publish
+	}
+	return nil
+}
+
+func (x *HR) GetOutbox() *OutboxConfig {
+	if x != nil {
+		return x.Outbox
+	}
+	return nil
+}
+
+func (x *HR) GetSigningReconcile() *SigningReconcileConfig {
+	if x != nil {
+		return x.SigningReconcile
+	}
+	return nil
+}
+
+func (x *HR) GetWebhooks() *WebhookConfig {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
 // Configuration for event subscriptions via Redis pub/sub
 type EventConfig struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Enabled         bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                       // Enable/disable event subscriptions
 	TopicPrefix     string                 `protobuf:"bytes,2,opt,name=topic_prefix,json=topicPrefix,proto3" json:"topic_prefix,omitempty"`             // Prefix for event topics (default: "signing")
 	SubscribeEvents []string               `protobuf:"bytes,3,rep,name=subscribe_events,json=subscribeEvents,proto3" json:"subscribe_events,omitempty"` // Events to subscribe to
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// How events are received: "pubsub" subscribes to the channels, losing the events published
+	// while the service is down; "streams" reads the streams of the same names as a consumer group,
+	// acknowledging each event once handled (default: "pubsub")
+	Transport     string `protobuf:"bytes,4,opt,name=transport,proto3" json:"transport,omitempty"`
+	ConsumerGroup string `protobuf:"bytes,5,opt,name=consumer_group,json=consumerGroup,proto3" json:"consumer_group,omitempty"` // Consumer group of the streams transport (default: "hr-service")
+	ConsumerName  string `protobuf:"bytes,6,opt,name=consumer_name,json=consumerName,proto3" json:"consumer_name,omitempty"`    // Consumer name of this instance in the group (default: the host name)
+	// How long an event may stay unacknowledged by a consumer before another consumer reclaims it,
+	// as a Go duration (default: "1m")
+	ClaimIdle string `protobuf:"bytes,7,opt,name=claim_idle,json=claimIdle,proto3" json:"claim_idle,omitempty"`
+	// How many times an event of the streams transport is delivered before it is moved to the
+	// dead-letter stream "<stream>.dead" and acknowledged, so that an event whose handling keeps
+	// failing is not reclaimed forever (default: 10)
+	MaxDeliveries int32 `protobuf:"varint,8,opt,name=max_deliveries,json=maxDeliveries,proto3" json:"max_deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EventConfig) Reset() {
@@ -126,18 +211,652 @@ func (x *EventConfig) GetSubscribeEvents() []string {
 	return nil
 }
 
+func (x *EventConfig) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
+}
+
+func (x *EventConfig) GetConsumerGroup() string {
+	if x != nil {
+		return x.ConsumerGroup
+	}
+	return ""
+}
+
+func (x *EventConfig) GetConsumerName() string {
+	if x != nil {
+		return x.ConsumerName
+	}
+	return ""
+}
+
+func (x *EventConfig) GetClaimIdle() string {
+	if x != nil {
+		return x.ClaimIdle
+	}
+	return ""
+}
+
+func (x *EventConfig) GetMaxDeliveries() int32 {
+	if x != nil {
+		return x.MaxDeliveries
+	}
+	return 0
+}
+
+// Configuration for the domain events the service publishes via Redis pub/sub, e.g. leave.approved
+type PublishConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                           // Enable/disable publishing events
+	TopicPrefix   string                 `protobuf:"bytes,2,opt,name=topic_prefix,json=topicPrefix,proto3" json:"topic_prefix,omitempty"` // Prefix for event topics (default: "hr")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishConfig) Reset() {
+	*x = PublishConfig{}
+	mi := &file_internal_conf_conf_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishConfig) ProtoMessage() {}
+
+func (x *PublishConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishConfig.ProtoReflect.Descriptor instead.
+func (*PublishConfig) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PublishConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PublishConfig) GetTopicPrefix() string {
+	if x != nil {
+		return x.TopicPrefix
+	}
+	return ""
+}
+
+// Configuration for the background job that posts accrued allowance days
+type AccrualConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`  // Enable/disable the accrual job
+	Interval      string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"` // Time between runs as a Go duration (default: "1h")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccrualConfig) Reset() {
+	*x = AccrualConfig{}
+	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccrualConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccrualConfig) ProtoMessage() {}
+
+func (x *AccrualConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccrualConfig.ProtoReflect.Descriptor instead.
+func (*AccrualConfig) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AccrualConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AccrualConfig) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+// Configuration for the background job that rolls allowances over into the new year and lapses
+// expired carried-over days
+type RolloverConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`  // Enable/disable the rollover job
+	Interval      string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"` // Time between runs as a Go duration (default: "6h")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolloverConfig) Reset() {
+	*x = RolloverConfig{}
+	mi := &file_internal_conf_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloverConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloverConfig) ProtoMessage() {}
+
+func (x *RolloverConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloverConfig.ProtoReflect.Descriptor instead.
+func (*RolloverConfig) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RolloverConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *RolloverConfig) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+// Configuration for routing leave requests to the requester's line manager
+type ApprovalConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Keywords of position names that make a user the manager of their org unit, most senior
+	// first (default: "manager", "head", "lead")
+	ManagerPositions []string `protobuf:"bytes,1,rep,name=manager_positions,json=managerPositions,proto3" json:"manager_positions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ApprovalConfig) Reset() {
+	*x = ApprovalConfig{}
+	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalConfig) ProtoMessage() {}
+
+func (x *ApprovalConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalConfig.ProtoReflect.Descriptor instead.
+func (*ApprovalConfig) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApprovalConfig) GetManagerPositions() []string {
+	if x != nil {
+		return x.ManagerPositions
+	}
+	return nil
+}
+
+// Configuration for the background job that reminds, escalates and automatically decides leave
+// requests awaiting a decision for too long
+type EscalationConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`  // Enable/disable the escalation job
+	Interval      string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"` // Time between runs as a Go duration (default: "1h")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EscalationConfig) Reset() {
+	*x = EscalationConfig{}
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationConfig) ProtoMessage() {}
+
+func (x *EscalationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationConfig.ProtoReflect.Descriptor instead.
+func (*EscalationConfig) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EscalationConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *EscalationConfig) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+// Configuration for storing the supporting documents attached to leave requests
+type AttachmentConfig struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Backend      string                 `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`                                  // Blob storage backend (default: "local")
+	LocalDir     string                 `protobuf:"bytes,2,opt,name=local_dir,json=localDir,proto3" json:"local_dir,omitempty"`                // Directory of the local backend (default: "./data/attachments")
+	MaxSizeBytes int64                  `protobuf:"varint,3,opt,name=max_size_bytes,json=maxSizeBytes,proto3" json:"max_size_bytes,omitempty"` // Largest file accepted (default: 10 MiB)
+	// Content types accepted, detected from the file contents (default: "application/pdf",
+	// "image/png", "image/jpeg")
+	ContentTypes  []string `protobuf:"bytes,4,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentConfig) Reset() {
+	*x = AttachmentConfig{}
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentConfig) ProtoMessage() {}
+
+func (x *AttachmentConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentConfig.ProtoReflect.Descriptor instead.
+func (*AttachmentConfig) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AttachmentConfig) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *AttachmentConfig) GetLocalDir() string {
+	if x != nil {
+		return x.LocalDir
+	}
+	return ""
+}
+
+func (x *AttachmentConfig) GetMaxSizeBytes() int64 {
+	if x != nil {
+		return x.MaxSizeBytes
+	}
+	return 0
+}
+
+func (x *AttachmentConfig) GetContentTypes() []string {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+// Configuration for the background job that delivers the outbox: the events, emails and signing
+// cancellations written alongside leave request transitions
+type OutboxConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                            // Enable/disable the outbox relay job
+	Interval      string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`                           // Time between runs as a Go duration (default: "10s")
+	BatchSize     int32                  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`       // Messages delivered per run at most (default: 50)
+	MaxAttempts   int32                  `protobuf:"varint,4,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"` // Failed deliveries after which a message is dead (default: 8)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutboxConfig) Reset() {
+	*x = OutboxConfig{}
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutboxConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxConfig) ProtoMessage() {}
+
+func (x *OutboxConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxConfig.ProtoReflect.Descriptor instead.
+func (*OutboxConfig) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OutboxConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *OutboxConfig) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *OutboxConfig) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *OutboxConfig) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+// Configuration for the background job that asks the signing service for the state of the
+// submissions of leave requests and amendments still awaiting signatures, catching up on missed
+// completion events
+type SigningReconcileConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`  // Enable/disable the reconciliation job
+	Interval      string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"` // Time between runs as a Go duration (default: "15m")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SigningReconcileConfig) Reset() {
+	*x = SigningReconcileConfig{}
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SigningReconcileConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SigningReconcileConfig) ProtoMessage() {}
+
+func (x *SigningReconcileConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SigningReconcileConfig.ProtoReflect.Descriptor instead.
+func (*SigningReconcileConfig) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SigningReconcileConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SigningReconcileConfig) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+// Configuration for the background job that delivers leave and allowance events to the webhook
+// subscriptions of tenants
+type WebhookConfig struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Enabled     bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                            // Enable/disable the webhook delivery job
+	Interval    string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`                           // Time between runs as a Go duration (default: "10s")
+	BatchSize   int32                  `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`       // Deliveries attempted per run at most (default: 50)
+	MaxAttempts int32                  `protobuf:"varint,4,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"` // Attempts at delivering an event before giving up (default: 8)
+	Timeout     string                 `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`                             // Time a subscriber has to answer as a Go duration (default: "10s")
+	// Networks in CIDR notation webhooks may be delivered to although they are loopback, private or
+	// link-local, e.g. "10.20.0.0/16" for internal tools; none by default
+	AllowedNetworks []string `protobuf:"bytes,6,rep,name=allowed_networks,json=allowedNetworks,proto3" json:"allowed_networks,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WebhookConfig) Reset() {
+	*x = WebhookConfig{}
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookConfig) ProtoMessage() {}
+
+func (x *WebhookConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookConfig.ProtoReflect.Descriptor instead.
+func (*WebhookConfig) Descriptor() ([]byte, []int) {
+	return file_internal_conf_conf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WebhookConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WebhookConfig) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *WebhookConfig) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *WebhookConfig) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *WebhookConfig) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+func (x *WebhookConfig) GetAllowedNetworks() []string {
+	if x != nil {
+		return x.AllowedNetworks
+	}
+	return nil
+}
+
 var File_internal_conf_conf_proto protoreflect.FileDescriptor
 
 const file_internal_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x18internal/conf/conf.proto\x12\n" +
-	"kratos.api\"5\n" +
+	"kratos.api\"\xc7\x04\n" +
 	"\x02HR\x12/\n" +
-	"\x06events\x18\x01 \x01(\v2\x17.kratos.api.EventConfigR\x06events\"u\n" +
+	"\x06events\x18\x01 \x01(\v2\x17.kratos.api.EventConfigR\x06events\x123\n" +
+	"\aaccrual\x18\x02 \x01(\v2\x19.kratos.api.AccrualConfigR\aaccrual\x126\n" +
+	"\brollover\x18\x03 \x01(\v2\x1a.kratos.api.RolloverConfigR\brollover\x126\n" +
+	"\bapproval\x18\x04 \x01(\v2\x1a.kratos.api.ApprovalConfigR\bapproval\x12<\n" +
+	"\n" +
+	"escalation\x18\x05 \x01(\v2\x1c.kratos.api.EscalationConfigR\n" +
+	"escalation\x12>\n" +
+	"\vattachments\x18\x06 \x01(\v2\x1c.kratos.api.AttachmentConfigR\vattachments\x123\n" +
+	"\apublish\x18\a \x01(\v2\x19.kratos.api.PublishConfigR\apublish\x120\n" +
+	"\x06outbox\x18\b \x01(\v2\x18.kratos.api.OutboxConfigR\x06outbox\x12O\n" +
+	"\x11signing_reconcile\x18\t \x01(\v2\".kratos.api.SigningReconcileConfigR\x10signingReconcile\x125\n" +
+	"\bwebhooks\x18\n" +
+	" \x01(\v2\x19.kratos.api.WebhookConfigR\bwebhooks\"\xa5\x02\n" +
 	"\vEventConfig\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12!\n" +
 	"\ftopic_prefix\x18\x02 \x01(\tR\vtopicPrefix\x12)\n" +
-	"\x10subscribe_events\x18\x03 \x03(\tR\x0fsubscribeEventsB6Z4github.com/go-tangra/go-tangra-hr/internal/conf;confb\x06proto3"
+	"\x10subscribe_events\x18\x03 \x03(\tR\x0fsubscribeEvents\x12\x1c\n" +
+	"\ttransport\x18\x04 \x01(\tR\ttransport\x12%\n" +
+	"\x0econsumer_group\x18\x05 \x01(\tR\rconsumerGroup\x12#\n" +
+	"\rconsumer_name\x18\x06 \x01(\tR\fconsumerName\x12\x1d\n" +
+	"\n" +
+	"claim_idle\x18\a \x01(\tR\tclaimIdle\x12%\n" +
+	"\x0emax_deliveries\x18\b \x01(\x05R\rmaxDeliveries\"L\n" +
+	"\rPublishConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12!\n" +
+	"\ftopic_prefix\x18\x02 \x01(\tR\vtopicPrefix\"E\n" +
+	"\rAccrualConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\"F\n" +
+	"\x0eRolloverConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\"=\n" +
+	"\x0eApprovalConfig\x12+\n" +
+	"\x11manager_positions\x18\x01 \x03(\tR\x10managerPositions\"H\n" +
+	"\x10EscalationConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\"\x94\x01\n" +
+	"\x10AttachmentConfig\x12\x18\n" +
+	"\abackend\x18\x01 \x01(\tR\abackend\x12\x1b\n" +
+	"\tlocal_dir\x18\x02 \x01(\tR\blocalDir\x12$\n" +
+	"\x0emax_size_bytes\x18\x03 \x01(\x03R\fmaxSizeBytes\x12#\n" +
+	"\rcontent_types\x18\x04 \x03(\tR\fcontentTypes\"\x86\x01\n" +
+	"\fOutboxConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x12!\n" +
+	"\fmax_attempts\x18\x04 \x01(\x05R\vmaxAttempts\"N\n" +
+	"\x16SigningReconcileConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\"\xcc\x01\n" +
+	"\rWebhookConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x03 \x01(\x05R\tbatchSize\x12!\n" +
+	"\fmax_attempts\x18\x04 \x01(\x05R\vmaxAttempts\x12\x18\n" +
+	"\atimeout\x18\x05 \x01(\tR\atimeout\x12)\n" +
+	"\x10allowed_networks\x18\x06 \x03(\tR\x0fallowedNetworksB6Z4github.com/go-tangra/go-tangra-hr/internal/conf;confb\x06proto3"
 
 var (
 	file_internal_conf_conf_proto_rawDescOnce sync.Once
@@ -151,18 +870,36 @@ func file_internal_conf_conf_proto_rawDescGZIP() []byte {
 	return file_internal_conf_conf_proto_rawDescData
 }
 
-var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_internal_conf_conf_proto_goTypes = []any{
-	(*HR)(nil),          // 0: kratos.api.HR
-	(*EventConfig)(nil), // 1: kratos.api.EventConfig
+	(*HR)(nil),                     // 0: kratos.api.HR
+	(*EventConfig)(nil),            // 1: kratos.api.EventConfig
+	(*PublishConfig)(nil),          // 2: kratos.api.PublishConfig
+	(*AccrualConfig)(nil),          // 3: kratos.api.AccrualConfig
+	(*RolloverConfig)(nil),         // 4: kratos.api.RolloverConfig
+	(*ApprovalConfig)(nil),         // 5: kratos.api.ApprovalConfig
+	(*EscalationConfig)(nil),       // 6: kratos.api.EscalationConfig
+	(*AttachmentConfig)(nil),       // 7: kratos.api.AttachmentConfig
+	(*OutboxConfig)(nil),           // 8: kratos.api.OutboxConfig
+	(*SigningReconcileConfig)(nil), // 9: kratos.api.SigningReconcileConfig
+	(*WebhookConfig)(nil),          // 10: kratos.api.WebhookConfig
 }
 var file_internal_conf_conf_proto_depIdxs = []int32{
-	1, // 0: kratos.api.HR.events:type_name -> kratos.api.EventConfig
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: kratos.api.HR.events:type_name -> kratos.api.EventConfig
+	3,  // 1: kratos.api.HR.accrual:type_name -> kratos.api.AccrualConfig
+	4,  // 2: kratos.api.HR.rollover:type_name -> kratos.api.RolloverConfig
+	5,  // 3: kratos.api.HR.approval:type_name -> kratos.api.ApprovalConfig
+	6,  // 4: kratos.api.HR.escalation:type_name -> kratos.api.EscalationConfig
+	7,  // 5: kratos.api.HR.attachments:type_name -> kratos.api.AttachmentConfig
+	2,  // 6: kratos.api.HR.publish:type_name -> kratos.api.PublishConfig
+	8,  // 7: kratos.api.HR.outbox:type_name -> kratos.api.OutboxConfig
+	9,  // 8: kratos.api.HR.signing_reconcile:type_name -> kratos.api.SigningReconcileConfig
+	10, // 9: kratos.api.HR.webhooks:type_name -> kratos.api.WebhookConfig
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_internal_conf_conf_proto_init() }
@@ -176,7 +913,7 @@ func file_internal_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_conf_conf_proto_rawDesc), len(file_internal_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
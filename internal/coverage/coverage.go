@@ -0,0 +1,247 @@
+// Package coverage checks that enough of a team stays at work while one of its members is
+// absent. Rules either cap the members of an org unit absent at once or require a number of
+// members holding a position to be present.
+package coverage
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-tangra/go-tangra-hr/internal/approval"
+	"github.com/go-tangra/go-tangra-hr/internal/workday"
+)
+
+// Rule kinds.
+const (
+	// KindMaxAbsent allows at most Count members of the org unit to be absent at once.
+	KindMaxAbsent = "max_absent"
+	// KindMinPresent requires at least Count members holding Position to be present.
+	KindMinPresent = "min_present"
+)
+
+// How a broken rule is enforced.
+const (
+	EnforcementWarn  = "warn"
+	EnforcementBlock = "block"
+)
+
+// Rule is a coverage rule of an org unit.
+type Rule struct {
+	ID          string
+	Name        string
+	OrgUnit     string
+	Kind        string
+	Count       int
+	Position    string
+	Enforcement string
+}
+
+// Absence is a colleague's leave that counts against coverage.
+type Absence struct {
+	UserID         uint32
+	UserName       string
+	LeaveRequestID string
+	Span           workday.Span
+}
+
+// Conflict is a rule an absence breaks, with the colleagues absent at the same time.
+type Conflict struct {
+	RuleID      string      `json:"rule_id"`
+	RuleName    string      `json:"rule_name"`
+	Enforcement string      `json:"enforcement"`
+	FirstDate   time.Time   `json:"first_date"`
+	LastDate    time.Time   `json:"last_date"`
+	Message     string      `json:"message"`
+	Colleagues  []Colleague `json:"colleagues"`
+}
+
+// Colleague is a member of the team absent while a rule is broken.
+type Colleague struct {
+	UserID         uint32    `json:"user_id"`
+	UserName       string    `json:"user_name,omitempty"`
+	LeaveRequestID string    `json:"leave_request_id"`
+	StartDate      time.Time `json:"start_date"`
+	EndDate        time.Time `json:"end_date"`
+}
+
+// Blocking reports whether the conflict prevents the absence from being booked.
+func (c Conflict) Blocking() bool {
+	return c.Enforcement == EnforcementBlock
+}
+
+// Evaluate checks the absence of the requester over the span against the rules. Members are
+// the users of the organisation; the team of a rule are the members of its org unit. Each half
+// day of the span is checked on its own, so that a morning and an afternoon absence do not
+// conflict. Returns the broken rules.
+func Evaluate(rules []Rule, members []approval.Member, requesterID uint32, span workday.Span, absences []Absence) []Conflict {
+	var conflicts []Conflict
+	for _, rule := range rules {
+		if c := evaluateRule(rule, members, requesterID, span, absences); c != nil {
+			conflicts = append(conflicts, *c)
+		}
+	}
+	return conflicts
+}
+
+func evaluateRule(rule Rule, members []approval.Member, requesterID uint32, span workday.Span, absences []Absence) *Conflict {
+	team := make(map[uint32]approval.Member)
+	for _, m := range members {
+		if contains(m.OrgUnits, rule.OrgUnit) {
+			team[m.ID] = m
+		}
+	}
+
+	// Only the absences of holders of the position matter to a minimum presence, and only
+	// when the requester holds it too
+	var holders map[uint32]bool
+	if rule.Kind == KindMinPresent {
+		holders = make(map[uint32]bool)
+		for id, m := range team {
+			if holdsPosition(m.Positions, rule.Position) {
+				holders[id] = true
+			}
+		}
+		if !holders[requesterID] {
+			return nil
+		}
+	}
+
+	var (
+		first, last time.Time
+		worst       = -1
+		colleagues  = make(map[string]Colleague)
+	)
+	for _, slot := range halfDays(span) {
+		var absent []Absence
+		absentIDs := map[uint32]bool{requesterID: true}
+		for _, a := range absences {
+			if a.UserID == requesterID || absentIDs[a.UserID] {
+				continue
+			}
+			if _, ok := team[a.UserID]; !ok || !a.Span.Overlaps(slot) {
+				continue
+			}
+			if holders != nil && !holders[a.UserID] {
+				continue
+			}
+			absentIDs[a.UserID] = true
+			absent = append(absent, a)
+		}
+
+		var broken bool
+		var value int
+		switch rule.Kind {
+		case KindMaxAbsent:
+			value = len(absentIDs)
+			broken = value > rule.Count
+			if broken && value > worst {
+				worst = value
+			}
+		case KindMinPresent:
+			value = len(holders) - len(absentIDs)
+			broken = value < rule.Count
+			if broken && (worst < 0 || value < worst) {
+				worst = value
+			}
+		}
+		if !broken {
+			continue
+		}
+
+		day := dateOf(slot.Start)
+		if first.IsZero() {
+			first = day
+		}
+		last = day
+		for _, a := range absent {
+			colleagues[a.LeaveRequestID] = Colleague{
+				UserID:         a.UserID,
+				UserName:       a.UserName,
+				LeaveRequestID: a.LeaveRequestID,
+				StartDate:      a.Span.Start,
+				EndDate:        a.Span.End,
+			}
+		}
+	}
+	if first.IsZero() {
+		return nil
+	}
+
+	conflict := &Conflict{
+		RuleID:      rule.ID,
+		RuleName:    rule.Name,
+		Enforcement: rule.Enforcement,
+		FirstDate:   first,
+		LastDate:    last,
+	}
+	switch rule.Kind {
+	case KindMaxAbsent:
+		conflict.Message = fmt.Sprintf("%d members of %s would be absent at once, at most %d may be (%s)", worst, rule.OrgUnit, rule.Count, rule.Name)
+	case KindMinPresent:
+		conflict.Message = fmt.Sprintf("only %d members of %s holding %q would be present, at least %d must be (%s)", worst, rule.OrgUnit, rule.Position, rule.Count, rule.Name)
+	}
+	for _, c := range colleagues {
+		conflict.Colleagues = append(conflict.Colleagues, c)
+	}
+	sort.Slice(conflict.Colleagues, func(i, j int) bool {
+		a, b := conflict.Colleagues[i], conflict.Colleagues[j]
+		if !a.StartDate.Equal(b.StartDate) {
+			return a.StartDate.Before(b.StartDate)
+		}
+		return a.UserID < b.UserID
+	})
+	return conflict
+}
+
+// Summary joins the messages of the conflicts into one line.
+func Summary(conflicts []Conflict) string {
+	messages := make([]string, len(conflicts))
+	for i, c := range conflicts {
+		messages[i] = c.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// halfDays returns the morning and afternoon halves of each day the span covers.
+func halfDays(span workday.Span) []workday.Span {
+	from, to := span.Interval()
+	var slots []workday.Span
+	for day := dateOf(from); day.Before(to); day = day.AddDate(0, 0, 1) {
+		for _, slot := range []workday.Span{
+			{Start: day, End: day, EndsAtMidday: true},
+			{Start: day, End: day, StartsAfternoon: true},
+		} {
+			if slot.Overlaps(span) {
+				slots = append(slots, slot)
+			}
+		}
+	}
+	return slots
+}
+
+// holdsPosition reports whether one of the positions contains the position keyword,
+// case-insensitively.
+func holdsPosition(positions []string, position string) bool {
+	position = strings.ToLower(position)
+	for _, p := range positions {
+		if position != "" && strings.Contains(strings.ToLower(p), position) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v != "" && v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
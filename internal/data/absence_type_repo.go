@@ -9,8 +9,13 @@ import (
 	entCrud "github.com/tx7do/go-crud/entgo"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
+	"github.com/go-tangra/go-tangra-hr/internal/accrual"
+	"github.com/go-tangra/go-tangra-hr/internal/approval"
+	"github.com/go-tangra/go-tangra-hr/internal/attachment"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
+	"github.com/go-tangra/go-tangra-hr/internal/escalation"
+	"github.com/go-tangra/go-tangra-hr/internal/rollover"
 	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
 )
 
@@ -124,6 +129,44 @@ func (r *AbsenceTypeRepo) Update(ctx context.Context, id string, updates map[str
 	if signingTemplateID, ok := updates["signing_template_id"].(string); ok {
 		update = update.SetSigningTemplateID(signingTemplateID)
 	}
+	if unit, ok := updates["unit"].(string); ok {
+		update = update.SetUnit(absencetype.Unit(unit))
+	}
+	if policy, ok := updates["accrual_policy"].(*accrual.Policy); ok {
+		if policy.Enabled() {
+			update = update.SetAccrualPolicy(policy)
+		} else {
+			update = update.ClearAccrualPolicy()
+		}
+	}
+	if policy, ok := updates["rollover_policy"].(*rollover.Policy); ok {
+		if policy != nil {
+			update = update.SetRolloverPolicy(policy)
+		} else {
+			update = update.ClearRolloverPolicy()
+		}
+	}
+	if chain, ok := updates["approval_chain"].(*approval.Chain); ok {
+		if chain != nil {
+			update = update.SetApprovalChain(chain)
+		} else {
+			update = update.ClearApprovalChain()
+		}
+	}
+	if policy, ok := updates["escalation_policy"].(*escalation.Policy); ok {
+		if policy != nil {
+			update = update.SetEscalationPolicy(policy)
+		} else {
+			update = update.ClearEscalationPolicy()
+		}
+	}
+	if requirement, ok := updates["requires_attachment"].(*attachment.Requirement); ok {
+		if requirement != nil {
+			update = update.SetRequiresAttachment(requirement)
+		} else {
+			update = update.ClearRequiresAttachment()
+		}
+	}
 	if poolID, ok := updates["allowance_pool_id"].(string); ok {
 		if poolID == "" {
 			update = update.ClearAllowancePoolID()
@@ -183,6 +226,19 @@ func (r *AbsenceTypeRepo) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// ListWithEscalation returns the absence types of all tenants that follow up requests awaiting a
+// decision.
+func (r *AbsenceTypeRepo) ListWithEscalation(ctx context.Context) ([]*ent.AbsenceType, error) {
+	entities, err := r.entClient.Client().AbsenceType.Query().
+		Where(absencetype.EscalationPolicyNotNil()).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list absence types with escalation failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list absence types failed")
+	}
+	return entities, nil
+}
+
 func (r *AbsenceTypeRepo) Count(ctx context.Context, tenantID uint32) (int, error) {
 	return r.entClient.Client().AbsenceType.Query().Where(absencetype.TenantID(tenantID)).Count(ctx)
 }
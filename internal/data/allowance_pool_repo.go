@@ -9,8 +9,10 @@ import (
 	entCrud "github.com/tx7do/go-crud/entgo"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
+	"github.com/go-tangra/go-tangra-hr/internal/accrual"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancepool"
+	"github.com/go-tangra/go-tangra-hr/internal/rollover"
 	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
 )
 
@@ -108,6 +110,20 @@ func (r *AllowancePoolRepo) Update(ctx context.Context, id string, updates map[s
 	if icon, ok := updates["icon"].(string); ok {
 		update = update.SetIcon(icon)
 	}
+	if policy, ok := updates["accrual_policy"].(*accrual.Policy); ok {
+		if policy.Enabled() {
+			update = update.SetAccrualPolicy(policy)
+		} else {
+			update = update.ClearAccrualPolicy()
+		}
+	}
+	if policy, ok := updates["rollover_policy"].(*rollover.Policy); ok {
+		if policy != nil {
+			update = update.SetRolloverPolicy(policy)
+		} else {
+			update = update.ClearRolloverPolicy()
+		}
+	}
 
 	update = update.SetUpdateTime(time.Now())
 
@@ -0,0 +1,210 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancetransaction"
+)
+
+// LedgerRef describes who and what caused an allowance change. It is recorded on every
+// ledger entry written for the change.
+type LedgerRef struct {
+	LeaveRequestID string
+	ActorID        uint32
+	ActorName      string
+	Note           string
+}
+
+// AllowanceBalance is the balance of an allowance as derived from its ledger entries.
+type AllowanceBalance struct {
+	TotalDays   float64
+	CarriedOver float64
+	UsedDays    float64
+}
+
+// Remaining returns the days still available.
+func (b AllowanceBalance) Remaining() float64 {
+	return b.TotalDays + b.CarriedOver - b.UsedDays
+}
+
+func (b *AllowanceBalance) apply(kind allowancetransaction.Kind, days float64) {
+	switch kind {
+	case allowancetransaction.KindGrant, allowancetransaction.KindAdjustment:
+		b.TotalDays += days
+	case allowancetransaction.KindCarryOver:
+		b.CarriedOver += days
+	case allowancetransaction.KindDeduction:
+		b.UsedDays += days
+	case allowancetransaction.KindRefund:
+		b.UsedDays -= days
+	}
+}
+
+// ledgerChange is a change to one component of an allowance balance.
+type ledgerChange struct {
+	kind allowancetransaction.Kind
+	days float64
+}
+
+// storedBalance returns the balance stored on the allowance row.
+func storedBalance(a *ent.LeaveAllowance) AllowanceBalance {
+	return AllowanceBalance{TotalDays: a.TotalDays, CarriedOver: a.CarriedOver, UsedDays: a.UsedDays}
+}
+
+type AllowanceTransactionRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewAllowanceTransactionRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *AllowanceTransactionRepo {
+	return &AllowanceTransactionRepo{
+		log:       ctx.NewLoggerHelper("hr/allowance_transaction/repo"),
+		entClient: entClient,
+	}
+}
+
+func (r *AllowanceTransactionRepo) List(ctx context.Context, tenantID uint32, page, pageSize int, filters map[string]interface{}) ([]*ent.AllowanceTransaction, int, error) {
+	query := r.entClient.Client().AllowanceTransaction.Query().
+		Where(allowancetransaction.TenantID(tenantID))
+
+	if allowanceID, ok := filters["allowance_id"].(string); ok && allowanceID != "" {
+		query = query.Where(allowancetransaction.AllowanceID(allowanceID))
+	}
+	if userID, ok := filters["user_id"].(uint32); ok && userID > 0 {
+		query = query.Where(allowancetransaction.UserID(userID))
+	}
+	if year, ok := filters["year"].(int); ok && year > 0 {
+		query = query.Where(allowancetransaction.Year(year))
+	}
+	if leaveRequestID, ok := filters["leave_request_id"].(string); ok && leaveRequestID != "" {
+		query = query.Where(allowancetransaction.LeaveRequestID(leaveRequestID))
+	}
+	if kind, ok := filters["kind"].(string); ok && kind != "" {
+		query = query.Where(allowancetransaction.KindEQ(allowancetransaction.Kind(kind)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count allowance transactions failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list allowance transactions failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	entities, err := query.Order(ent.Desc(allowancetransaction.FieldCreateTime)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list allowance transactions failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list allowance transactions failed")
+	}
+
+	return entities, total, nil
+}
+
+// sumLedger derives the balance of each allowance from its ledger entries. Allowances
+// without entries are absent from the result.
+func sumLedger(ctx context.Context, client *ent.Client, allowanceIDs ...string) (map[string]AllowanceBalance, error) {
+	var rows []struct {
+		AllowanceID string                    `json:"allowance_id"`
+		Kind        allowancetransaction.Kind `json:"kind"`
+		Sum         float64                   `json:"sum"`
+	}
+	err := client.AllowanceTransaction.Query().
+		Where(allowancetransaction.AllowanceIDIn(allowanceIDs...)).
+		GroupBy(allowancetransaction.FieldAllowanceID, allowancetransaction.FieldKind).
+		Aggregate(ent.Sum(allowancetransaction.FieldDays)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[string]AllowanceBalance)
+	for _, row := range rows {
+		b := balances[row.AllowanceID]
+		b.apply(row.Kind, row.Sum)
+		balances[row.AllowanceID] = b
+	}
+	return balances, nil
+}
+
+// ledgerBalance returns the balance of a locked allowance from its ledger. Allowances created
+// before the ledger existed have no entries yet; their stored totals are recorded as opening
+// entries first so that the ledger accounts for the whole balance.
+func ledgerBalance(ctx context.Context, tx *ent.Tx, allowance *ent.LeaveAllowance) (AllowanceBalance, error) {
+	balances, err := sumLedger(ctx, tx.Client(), allowance.ID)
+	if err != nil {
+		return AllowanceBalance{}, err
+	}
+	if b, ok := balances[allowance.ID]; ok {
+		return b, nil
+	}
+
+	opening := LedgerRef{Note: "opening balance"}
+	var b AllowanceBalance
+	for _, e := range []ledgerChange{
+		{allowancetransaction.KindGrant, allowance.TotalDays},
+		{allowancetransaction.KindCarryOver, allowance.CarriedOver},
+		{allowancetransaction.KindDeduction, allowance.UsedDays},
+	} {
+		if e.days == 0 && e.kind != allowancetransaction.KindGrant {
+			continue
+		}
+		b.apply(e.kind, e.days)
+		if err := createEntry(ctx, tx, allowance, e.kind, e.days, b, opening); err != nil {
+			return AllowanceBalance{}, err
+		}
+	}
+	return b, nil
+}
+
+// appendEntry records a change to a locked allowance and stores the resulting balance on the
+// allowance row. Returns the balance after the change.
+func appendEntry(ctx context.Context, tx *ent.Tx, allowance *ent.LeaveAllowance, balance AllowanceBalance, kind allowancetransaction.Kind, days float64, ref LedgerRef) (AllowanceBalance, error) {
+	balance.apply(kind, days)
+
+	if err := createEntry(ctx, tx, allowance, kind, days, balance, ref); err != nil {
+		return balance, err
+	}
+
+	_, err := tx.LeaveAllowance.UpdateOneID(allowance.ID).
+		SetTotalDays(balance.TotalDays).
+		SetCarriedOver(balance.CarriedOver).
+		SetUsedDays(balance.UsedDays).
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	return balance, err
+}
+
+func createEntry(ctx context.Context, tx *ent.Tx, allowance *ent.LeaveAllowance, kind allowancetransaction.Kind, days float64, after AllowanceBalance, ref LedgerRef) error {
+	create := tx.AllowanceTransaction.Create().
+		SetID(uuid.New().String()).
+		SetNillableTenantID(allowance.TenantID).
+		SetAllowanceID(allowance.ID).
+		SetUserID(allowance.UserID).
+		SetYear(allowance.Year).
+		SetKind(kind).
+		SetDays(days).
+		SetBalanceAfter(after.Remaining()).
+		SetActorID(ref.ActorID).
+		SetActorName(ref.ActorName).
+		SetCreateTime(time.Now())
+
+	if ref.LeaveRequestID != "" {
+		create = create.SetLeaveRequestID(ref.LeaveRequestID)
+	}
+	if ref.Note != "" {
+		create = create.SetNote(ref.Note)
+	}
+
+	_, err := create.Save(ctx)
+	return err
+}
@@ -0,0 +1,212 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/approvaldelegation"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+type ApprovalDelegationRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewApprovalDelegationRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ApprovalDelegationRepo {
+	return &ApprovalDelegationRepo{
+		log:       ctx.NewLoggerHelper("hr/approval_delegation/repo"),
+		entClient: entClient,
+	}
+}
+
+func (r *ApprovalDelegationRepo) Create(ctx context.Context, tenantID uint32, delegatorID, delegateID uint32, startDate, endDate time.Time, opts ...func(*ent.ApprovalDelegationCreate)) (*ent.ApprovalDelegation, error) {
+	id := uuid.New().String()
+
+	create := r.entClient.Client().ApprovalDelegation.Create().
+		SetID(id).
+		SetTenantID(tenantID).
+		SetDelegatorID(delegatorID).
+		SetDelegateID(delegateID).
+		SetStartDate(startDate).
+		SetEndDate(endDate).
+		SetCreateTime(time.Now())
+
+	for _, opt := range opts {
+		opt(create)
+	}
+
+	entity, err := create.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create approval delegation failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create approval delegation failed")
+	}
+	return entity, nil
+}
+
+func (r *ApprovalDelegationRepo) GetByID(ctx context.Context, id string) (*ent.ApprovalDelegation, error) {
+	entity, err := r.entClient.Client().ApprovalDelegation.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get approval delegation failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get approval delegation failed")
+	}
+	return entity, nil
+}
+
+func (r *ApprovalDelegationRepo) List(ctx context.Context, tenantID uint32, page, pageSize int, filters map[string]interface{}) ([]*ent.ApprovalDelegation, int, error) {
+	query := r.entClient.Client().ApprovalDelegation.Query().
+		Where(approvaldelegation.TenantID(tenantID))
+
+	if delegatorID, ok := filters["delegator_id"].(uint32); ok && delegatorID > 0 {
+		query = query.Where(approvaldelegation.DelegatorID(delegatorID))
+	}
+	if delegateID, ok := filters["delegate_id"].(uint32); ok && delegateID > 0 {
+		query = query.Where(approvaldelegation.DelegateID(delegateID))
+	}
+	// Delegations the caller takes part in, as delegator or delegate
+	if participantID, ok := filters["participant_id"].(uint32); ok && participantID > 0 {
+		query = query.Where(approvaldelegation.Or(
+			approvaldelegation.DelegatorID(participantID),
+			approvaldelegation.DelegateID(participantID),
+		))
+	}
+	if at, ok := filters["active_on"].(time.Time); ok {
+		query = query.Where(activeOn(at)...)
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count approval delegations failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list approval delegations failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	entities, err := query.Order(ent.Desc(approvaldelegation.FieldStartDate)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list approval delegations failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list approval delegations failed")
+	}
+
+	return entities, total, nil
+}
+
+func (r *ApprovalDelegationRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().ApprovalDelegation.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorApprovalDelegationNotFound("approval delegation not found")
+		}
+		r.log.Errorf("delete approval delegation failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete approval delegation failed")
+	}
+	return nil
+}
+
+// DeleteByLeaveRequest removes the delegations created for an approver's leave request.
+func (r *ApprovalDelegationRepo) DeleteByLeaveRequest(ctx context.Context, leaveRequestID string) error {
+	_, err := r.entClient.Client().ApprovalDelegation.Delete().
+		Where(approvaldelegation.LeaveRequestID(leaveRequestID)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete approval delegations of leave request failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete approval delegations failed")
+	}
+	return nil
+}
+
+// ListActive returns the delegations to the delegate in effect at the time at.
+func (r *ApprovalDelegationRepo) ListActive(ctx context.Context, tenantID uint32, delegateID uint32, at time.Time) ([]*ent.ApprovalDelegation, error) {
+	entities, err := r.entClient.Client().ApprovalDelegation.Query().
+		Where(
+			approvaldelegation.TenantID(tenantID),
+			approvaldelegation.DelegateID(delegateID),
+		).
+		Where(activeOn(at)...).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list active approval delegations failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list approval delegations failed")
+	}
+	return entities, nil
+}
+
+// Find returns a delegation in effect at the time at that lets the delegate decide requests of
+// the absence type for one of the approvers, or nil if there is none.
+func (r *ApprovalDelegationRepo) Find(ctx context.Context, tenantID uint32, approverIDs []uint32, delegateID uint32, absenceTypeID string, at time.Time) (*ent.ApprovalDelegation, error) {
+	if len(approverIDs) == 0 {
+		return nil, nil
+	}
+
+	entities, err := r.entClient.Client().ApprovalDelegation.Query().
+		Where(
+			approvaldelegation.TenantID(tenantID),
+			approvaldelegation.DelegatorIDIn(approverIDs...),
+			approvaldelegation.DelegateID(delegateID),
+		).
+		Where(activeOn(at)...).
+		Order(ent.Asc(approvaldelegation.FieldStartDate)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("find approval delegation failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("find approval delegation failed")
+	}
+
+	for _, e := range entities {
+		if CoversAbsenceType(e, absenceTypeID) {
+			return e, nil
+		}
+	}
+	return nil, nil
+}
+
+// HasOverlap reports whether the approver has delegated any day from start to end.
+func (r *ApprovalDelegationRepo) HasOverlap(ctx context.Context, tenantID uint32, delegatorID uint32, startDate, endDate time.Time) (bool, error) {
+	exists, err := r.entClient.Client().ApprovalDelegation.Query().
+		Where(
+			approvaldelegation.TenantID(tenantID),
+			approvaldelegation.DelegatorID(delegatorID),
+			approvaldelegation.StartDateLTE(endDate),
+			approvaldelegation.EndDateGTE(startDate),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("check approval delegation overlap failed: %s", err.Error())
+		return false, hrV1.ErrorInternalServerError("check approval delegation overlap failed")
+	}
+	return exists, nil
+}
+
+// CoversAbsenceType reports whether the delegation applies to requests of the absence type.
+func CoversAbsenceType(e *ent.ApprovalDelegation, absenceTypeID string) bool {
+	if len(e.AbsenceTypeIds) == 0 {
+		return true
+	}
+	for _, id := range e.AbsenceTypeIds {
+		if id == absenceTypeID {
+			return true
+		}
+	}
+	return false
+}
+
+// activeOn selects delegations whose first day has begun and whose last day has not passed at
+// the time at.
+func activeOn(at time.Time) []predicate.ApprovalDelegation {
+	return []predicate.ApprovalDelegation{
+		approvaldelegation.StartDateLTE(at),
+		approvaldelegation.EndDateGT(at.AddDate(0, 0, -1)),
+	}
+}
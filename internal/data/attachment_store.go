@@ -0,0 +1,20 @@
+package data
+
+import (
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-hr/internal/conf"
+	"github.com/go-tangra/go-tangra-hr/internal/storage"
+)
+
+// NewAttachmentStore creates the blob backend that keeps the contents of leave request
+// attachments, as configured under hr.attachments.
+func NewAttachmentStore(ctx *bootstrap.Context) (storage.Backend, error) {
+	var attachmentCfg *conf.AttachmentConfig
+	if cfg, ok := ctx.GetCustomConfig("hr"); ok && cfg != nil {
+		if hrCfg, ok := cfg.(*conf.HR); ok {
+			attachmentCfg = hrCfg.Attachments
+		}
+	}
+	return storage.New(attachmentCfg)
+}
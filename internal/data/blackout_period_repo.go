@@ -0,0 +1,172 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/blackoutperiod"
+)
+
+type BlackoutPeriodRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewBlackoutPeriodRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *BlackoutPeriodRepo {
+	return &BlackoutPeriodRepo{
+		log:       ctx.NewLoggerHelper("hr/blackout_period/repo"),
+		entClient: entClient,
+	}
+}
+
+func (r *BlackoutPeriodRepo) Create(ctx context.Context, tenantID uint32, name string, startDate, endDate time.Time, opts ...func(*ent.BlackoutPeriodCreate)) (*ent.BlackoutPeriod, error) {
+	id := uuid.New().String()
+
+	create := r.entClient.Client().BlackoutPeriod.Create().
+		SetID(id).
+		SetTenantID(tenantID).
+		SetName(name).
+		SetStartDate(startDate).
+		SetEndDate(endDate).
+		SetCreateTime(time.Now())
+
+	for _, opt := range opts {
+		opt(create)
+	}
+
+	entity, err := create.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create blackout period failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create blackout period failed")
+	}
+	return entity, nil
+}
+
+func (r *BlackoutPeriodRepo) GetByID(ctx context.Context, id string) (*ent.BlackoutPeriod, error) {
+	entity, err := r.entClient.Client().BlackoutPeriod.Query().
+		Where(blackoutperiod.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get blackout period failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get blackout period failed")
+	}
+	return entity, nil
+}
+
+func (r *BlackoutPeriodRepo) List(ctx context.Context, tenantID uint32, page, pageSize int, filters map[string]interface{}) ([]*ent.BlackoutPeriod, int, error) {
+	query := r.entClient.Client().BlackoutPeriod.Query().
+		Where(blackoutperiod.TenantID(tenantID))
+
+	if fromDate, ok := filters["from_date"].(time.Time); ok {
+		query = query.Where(blackoutperiod.EndDateGTE(fromDate))
+	}
+	if toDate, ok := filters["to_date"].(time.Time); ok {
+		query = query.Where(blackoutperiod.StartDateLTE(toDate))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count blackout periods failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list blackout periods failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	entities, err := query.Order(ent.Asc(blackoutperiod.FieldStartDate)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list blackout periods failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list blackout periods failed")
+	}
+
+	return entities, total, nil
+}
+
+// ListOverlapping returns the blackout periods that overlap the dates and apply to requests of
+// an absence type by a member of an org unit.
+func (r *BlackoutPeriodRepo) ListOverlapping(ctx context.Context, tenantID uint32, absenceTypeID, orgUnitName string, startDate, endDate time.Time) ([]*ent.BlackoutPeriod, error) {
+	entities, err := r.entClient.Client().BlackoutPeriod.Query().
+		Where(
+			blackoutperiod.TenantID(tenantID),
+			blackoutperiod.StartDateLTE(endDate),
+			blackoutperiod.EndDateGTE(startDate),
+		).
+		Order(ent.Asc(blackoutperiod.FieldStartDate)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list overlapping blackout periods failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list blackout periods failed")
+	}
+
+	// Scopes are JSON arrays, so they are matched here rather than in the query
+	var periods []*ent.BlackoutPeriod
+	for _, p := range entities {
+		if len(p.AbsenceTypeIds) > 0 && !slices.Contains(p.AbsenceTypeIds, absenceTypeID) {
+			continue
+		}
+		if len(p.OrgUnitNames) > 0 && !slices.Contains(p.OrgUnitNames, orgUnitName) {
+			continue
+		}
+		periods = append(periods, p)
+	}
+	return periods, nil
+}
+
+func (r *BlackoutPeriodRepo) Update(ctx context.Context, id string, updates map[string]interface{}) (*ent.BlackoutPeriod, error) {
+	update := r.entClient.Client().BlackoutPeriod.UpdateOneID(id)
+
+	if name, ok := updates["name"].(string); ok {
+		update = update.SetName(name)
+	}
+	if startDate, ok := updates["start_date"].(time.Time); ok {
+		update = update.SetStartDate(startDate)
+	}
+	if endDate, ok := updates["end_date"].(time.Time); ok {
+		update = update.SetEndDate(endDate)
+	}
+	if absenceTypeIDs, ok := updates["absence_type_ids"].([]string); ok {
+		update = update.SetAbsenceTypeIds(absenceTypeIDs)
+	}
+	if orgUnitNames, ok := updates["org_unit_names"].([]string); ok {
+		update = update.SetOrgUnitNames(orgUnitNames)
+	}
+	if notes, ok := updates["notes"].(string); ok {
+		update = update.SetNotes(notes)
+	}
+
+	update = update.SetUpdateTime(time.Now())
+
+	entity, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorBlackoutPeriodNotFound("blackout period not found")
+		}
+		r.log.Errorf("update blackout period failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update blackout period failed")
+	}
+	return entity, nil
+}
+
+func (r *BlackoutPeriodRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().BlackoutPeriod.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorBlackoutPeriodNotFound("blackout period not found")
+		}
+		r.log.Errorf("delete blackout period failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete blackout period failed")
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/coveragerule"
+)
+
+type CoverageRuleRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewCoverageRuleRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *CoverageRuleRepo {
+	return &CoverageRuleRepo{
+		log:       ctx.NewLoggerHelper("hr/coverage_rule/repo"),
+		entClient: entClient,
+	}
+}
+
+func (r *CoverageRuleRepo) Create(ctx context.Context, tenantID uint32, name, orgUnitName, kind string, count int, opts ...func(*ent.CoverageRuleCreate)) (*ent.CoverageRule, error) {
+	id := uuid.New().String()
+
+	create := r.entClient.Client().CoverageRule.Create().
+		SetID(id).
+		SetTenantID(tenantID).
+		SetName(name).
+		SetOrgUnitName(orgUnitName).
+		SetKind(coveragerule.Kind(kind)).
+		SetCount(count).
+		SetCreateTime(time.Now())
+
+	for _, opt := range opts {
+		opt(create)
+	}
+
+	entity, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, hrV1.ErrorAlreadyExists("coverage rule already exists with this name")
+		}
+		r.log.Errorf("create coverage rule failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create coverage rule failed")
+	}
+	return entity, nil
+}
+
+func (r *CoverageRuleRepo) GetByID(ctx context.Context, id string) (*ent.CoverageRule, error) {
+	entity, err := r.entClient.Client().CoverageRule.Query().
+		Where(coveragerule.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get coverage rule failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get coverage rule failed")
+	}
+	return entity, nil
+}
+
+func (r *CoverageRuleRepo) List(ctx context.Context, tenantID uint32, page, pageSize int, filters map[string]interface{}) ([]*ent.CoverageRule, int, error) {
+	query := r.entClient.Client().CoverageRule.Query().
+		Where(coveragerule.TenantID(tenantID))
+
+	if q, ok := filters["query"].(string); ok && q != "" {
+		query = query.Where(coveragerule.NameContains(q))
+	}
+	if orgUnitName, ok := filters["org_unit_name"].(string); ok && orgUnitName != "" {
+		query = query.Where(coveragerule.OrgUnitName(orgUnitName))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count coverage rules failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list coverage rules failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	entities, err := query.Order(ent.Asc(coveragerule.FieldName)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list coverage rules failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list coverage rules failed")
+	}
+
+	return entities, total, nil
+}
+
+// ListApplicable returns the enabled rules of the org units that check requests of the absence
+// type.
+func (r *CoverageRuleRepo) ListApplicable(ctx context.Context, tenantID uint32, orgUnitNames []string, absenceTypeID string) ([]*ent.CoverageRule, error) {
+	if len(orgUnitNames) == 0 {
+		return nil, nil
+	}
+
+	entities, err := r.entClient.Client().CoverageRule.Query().
+		Where(
+			coveragerule.TenantID(tenantID),
+			coveragerule.Enabled(true),
+			coveragerule.OrgUnitNameIn(orgUnitNames...),
+		).
+		Order(ent.Asc(coveragerule.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list applicable coverage rules failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list coverage rules failed")
+	}
+
+	// Absence types are a JSON array, so they are matched here rather than in the query
+	var rules []*ent.CoverageRule
+	for _, e := range entities {
+		if len(e.AbsenceTypeIds) > 0 && !slices.Contains(e.AbsenceTypeIds, absenceTypeID) {
+			continue
+		}
+		rules = append(rules, e)
+	}
+	return rules, nil
+}
+
+func (r *CoverageRuleRepo) Update(ctx context.Context, id string, updates map[string]interface{}) (*ent.CoverageRule, error) {
+	update := r.entClient.Client().CoverageRule.UpdateOneID(id)
+
+	if name, ok := updates["name"].(string); ok {
+		update = update.SetName(name)
+	}
+	if description, ok := updates["description"].(string); ok {
+		update = update.SetDescription(description)
+	}
+	if orgUnitName, ok := updates["org_unit_name"].(string); ok {
+		update = update.SetOrgUnitName(orgUnitName)
+	}
+	if kind, ok := updates["kind"].(string); ok {
+		update = update.SetKind(coveragerule.Kind(kind))
+	}
+	if count, ok := updates["count"].(int); ok {
+		update = update.SetCount(count)
+	}
+	if position, ok := updates["position"].(string); ok {
+		update = update.SetPosition(position)
+	}
+	if enforcement, ok := updates["enforcement"].(string); ok {
+		update = update.SetEnforcement(coveragerule.Enforcement(enforcement))
+	}
+	if absenceTypeIDs, ok := updates["absence_type_ids"].([]string); ok {
+		update = update.SetAbsenceTypeIds(absenceTypeIDs)
+	}
+	if enabled, ok := updates["enabled"].(bool); ok {
+		update = update.SetEnabled(enabled)
+	}
+
+	update = update.SetUpdateTime(time.Now())
+
+	entity, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorCoverageRuleNotFound("coverage rule not found")
+		}
+		if ent.IsConstraintError(err) {
+			return nil, hrV1.ErrorAlreadyExists("coverage rule already exists with this name")
+		}
+		r.log.Errorf("update coverage rule failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update coverage rule failed")
+	}
+	return entity, nil
+}
+
+func (r *CoverageRuleRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().CoverageRule.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorCoverageRuleNotFound("coverage rule not found")
+		}
+		r.log.Errorf("delete coverage rule failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete coverage rule failed")
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/employment"
+)
+
+type EmploymentRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewEmploymentRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *EmploymentRepo {
+	return &EmploymentRepo{
+		log:       ctx.NewLoggerHelper("hr/employment/repo"),
+		entClient: entClient,
+	}
+}
+
+func (r *EmploymentRepo) Create(ctx context.Context, tenantID uint32, userID uint32, startDate time.Time, opts ...func(*ent.EmploymentCreate)) (*ent.Employment, error) {
+	id := uuid.New().String()
+
+	create := r.entClient.Client().Employment.Create().
+		SetID(id).
+		SetTenantID(tenantID).
+		SetUserID(userID).
+		SetStartDate(startDate).
+		SetCreateTime(time.Now())
+
+	for _, opt := range opts {
+		opt(create)
+	}
+
+	entity, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, hrV1.ErrorAlreadyExists("employment already exists for this user")
+		}
+		r.log.Errorf("create employment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create employment failed")
+	}
+	return entity, nil
+}
+
+func (r *EmploymentRepo) GetByUser(ctx context.Context, tenantID uint32, userID uint32) (*ent.Employment, error) {
+	entity, err := r.entClient.Client().Employment.Query().
+		Where(
+			employment.TenantID(tenantID),
+			employment.UserID(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get employment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get employment failed")
+	}
+	return entity, nil
+}
+
+func (r *EmploymentRepo) List(ctx context.Context, tenantID uint32, page, pageSize int, filters map[string]interface{}) ([]*ent.Employment, int, error) {
+	query := r.entClient.Client().Employment.Query().
+		Where(employment.TenantID(tenantID))
+
+	if userID, ok := filters["user_id"].(uint32); ok && userID > 0 {
+		query = query.Where(employment.UserID(userID))
+	}
+	if year, ok := filters["leaving_year"].(int); ok && year > 0 {
+		query = query.Where(
+			employment.EndDateGTE(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			employment.EndDateLT(time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		)
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count employments failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list employments failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	entities, err := query.Order(ent.Asc(employment.FieldUserName)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list employments failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list employments failed")
+	}
+
+	return entities, total, nil
+}
+
+// Update applies the updates to the employment. An end_date of nil clears the end date.
+func (r *EmploymentRepo) Update(ctx context.Context, id string, updates map[string]interface{}) (*ent.Employment, error) {
+	update := r.entClient.Client().Employment.UpdateOneID(id)
+
+	if userName, ok := updates["user_name"].(string); ok {
+		update = update.SetUserName(userName)
+	}
+	if startDate, ok := updates["start_date"].(time.Time); ok {
+		update = update.SetStartDate(startDate)
+	}
+	if endDate, ok := updates["end_date"].(*time.Time); ok {
+		if endDate != nil {
+			update = update.SetEndDate(*endDate)
+		} else {
+			update = update.ClearEndDate()
+		}
+	}
+	if notes, ok := updates["notes"].(string); ok {
+		update = update.SetNotes(notes)
+	}
+
+	update = update.SetUpdateTime(time.Now())
+
+	entity, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorEmploymentNotFound("employment not found")
+		}
+		r.log.Errorf("update employment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update employment failed")
+	}
+	return entity, nil
+}
+
+func (r *EmploymentRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().Employment.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorEmploymentNotFound("employment not found")
+		}
+		r.log.Errorf("delete employment failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete employment failed")
+	}
+	return nil
+}
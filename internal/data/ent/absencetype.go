@@ -10,8 +10,13 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/accrual"
+	"github.com/go-tangra/go-tangra-hr/internal/approval"
+	"github.com/go-tangra/go-tangra-hr/internal/attachment"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancepool"
+	"github.com/go-tangra/go-tangra-hr/internal/escalation"
+	"github.com/go-tangra/go-tangra-hr/internal/rollover"
 )
 
 // AbsenceType is the model entity for the AbsenceType schema.
@@ -56,6 +61,18 @@ type AbsenceType struct {
 	SigningTemplateID string `json:"signing_template_id,omitempty"`
 	// FK to AllowancePool — types sharing a pool share one allowance budget
 	AllowancePoolID string `json:"allowance_pool_id,omitempty"`
+	// Whether requests are booked in (half) days or in hours
+	Unit absencetype.Unit `json:"unit,omitempty"`
+	// How allowance days are earned over the year; unset when granted up front
+	AccrualPolicy *accrual.Policy `json:"accrual_policy,omitempty"`
+	// How allowances are renewed and unused days carried over at year end
+	RolloverPolicy *rollover.Policy `json:"rollover_policy,omitempty"`
+	// Approval steps requests pass through; unset when a single approval suffices
+	ApprovalChain *approval.Chain `json:"approval_chain,omitempty"`
+	// How requests awaiting a decision for too long are followed up; unset when they wait indefinitely
+	EscalationPolicy *escalation.Policy `json:"escalation_policy,omitempty"`
+	// Supporting documents requests need, e.g. a doctor's certificate; unset when none are needed
+	RequiresAttachment *attachment.Requirement `json:"requires_attachment,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AbsenceTypeQuery when eager-loading is set.
 	Edges        AbsenceTypeEdges `json:"edges"`
@@ -109,13 +126,13 @@ func (*AbsenceType) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case absencetype.FieldMetadata:
+		case absencetype.FieldMetadata, absencetype.FieldAccrualPolicy, absencetype.FieldRolloverPolicy, absencetype.FieldApprovalChain, absencetype.FieldEscalationPolicy, absencetype.FieldRequiresAttachment:
 			values[i] = new([]byte)
 		case absencetype.FieldDeductsFromAllowance, absencetype.FieldRequiresApproval, absencetype.FieldIsActive, absencetype.FieldRequiresSigning:
 			values[i] = new(sql.NullBool)
 		case absencetype.FieldCreateBy, absencetype.FieldUpdateBy, absencetype.FieldTenantID, absencetype.FieldSortOrder:
 			values[i] = new(sql.NullInt64)
-		case absencetype.FieldID, absencetype.FieldName, absencetype.FieldDescription, absencetype.FieldColor, absencetype.FieldIcon, absencetype.FieldSigningTemplateID, absencetype.FieldAllowancePoolID:
+		case absencetype.FieldID, absencetype.FieldName, absencetype.FieldDescription, absencetype.FieldColor, absencetype.FieldIcon, absencetype.FieldSigningTemplateID, absencetype.FieldAllowancePoolID, absencetype.FieldUnit:
 			values[i] = new(sql.NullString)
 		case absencetype.FieldCreateTime, absencetype.FieldUpdateTime, absencetype.FieldDeleteTime:
 			values[i] = new(sql.NullTime)
@@ -256,6 +273,52 @@ func (_m *AbsenceType) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.AllowancePoolID = value.String
 			}
+		case absencetype.FieldUnit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unit", values[i])
+			} else if value.Valid {
+				_m.Unit = absencetype.Unit(value.String)
+			}
+		case absencetype.FieldAccrualPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field accrual_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AccrualPolicy); err != nil {
+					return fmt.Errorf("unmarshal field accrual_policy: %w", err)
+				}
+			}
+		case absencetype.FieldRolloverPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rollover_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RolloverPolicy); err != nil {
+					return fmt.Errorf("unmarshal field rollover_policy: %w", err)
+				}
+			}
+		case absencetype.FieldApprovalChain:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field approval_chain", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ApprovalChain); err != nil {
+					return fmt.Errorf("unmarshal field approval_chain: %w", err)
+				}
+			}
+		case absencetype.FieldEscalationPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field escalation_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EscalationPolicy); err != nil {
+					return fmt.Errorf("unmarshal field escalation_policy: %w", err)
+				}
+			}
+		case absencetype.FieldRequiresAttachment:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field requires_attachment", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RequiresAttachment); err != nil {
+					return fmt.Errorf("unmarshal field requires_attachment: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -372,6 +435,24 @@ func (_m *AbsenceType) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("allowance_pool_id=")
 	builder.WriteString(_m.AllowancePoolID)
+	builder.WriteString(", ")
+	builder.WriteString("unit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Unit))
+	builder.WriteString(", ")
+	builder.WriteString("accrual_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccrualPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("rollover_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RolloverPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("approval_chain=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ApprovalChain))
+	builder.WriteString(", ")
+	builder.WriteString("escalation_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EscalationPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("requires_attachment=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequiresAttachment))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package absencetype
 
 import (
+	"fmt"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -49,6 +51,18 @@ const (
 	FieldSigningTemplateID = "signing_template_id"
 	// FieldAllowancePoolID holds the string denoting the allowance_pool_id field in the database.
 	FieldAllowancePoolID = "allowance_pool_id"
+	// FieldUnit holds the string denoting the unit field in the database.
+	FieldUnit = "unit"
+	// FieldAccrualPolicy holds the string denoting the accrual_policy field in the database.
+	FieldAccrualPolicy = "accrual_policy"
+	// FieldRolloverPolicy holds the string denoting the rollover_policy field in the database.
+	FieldRolloverPolicy = "rollover_policy"
+	// FieldApprovalChain holds the string denoting the approval_chain field in the database.
+	FieldApprovalChain = "approval_chain"
+	// FieldEscalationPolicy holds the string denoting the escalation_policy field in the database.
+	FieldEscalationPolicy = "escalation_policy"
+	// FieldRequiresAttachment holds the string denoting the requires_attachment field in the database.
+	FieldRequiresAttachment = "requires_attachment"
 	// EdgeLeaveAllowances holds the string denoting the leave_allowances edge name in mutations.
 	EdgeLeaveAllowances = "leave_allowances"
 	// EdgeLeaveRequests holds the string denoting the leave_requests edge name in mutations.
@@ -101,6 +115,12 @@ var Columns = []string{
 	FieldRequiresSigning,
 	FieldSigningTemplateID,
 	FieldAllowancePoolID,
+	FieldUnit,
+	FieldAccrualPolicy,
+	FieldRolloverPolicy,
+	FieldApprovalChain,
+	FieldEscalationPolicy,
+	FieldRequiresAttachment,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -143,6 +163,32 @@ var (
 	IDValidator func(string) error
 )
 
+// Unit defines the type for the "unit" enum field.
+type Unit string
+
+// UnitDays is the default value of the Unit enum.
+const DefaultUnit = UnitDays
+
+// Unit values.
+const (
+	UnitDays  Unit = "days"
+	UnitHours Unit = "hours"
+)
+
+func (u Unit) String() string {
+	return string(u)
+}
+
+// UnitValidator is a validator for the "unit" field enum values. It is called by the builders before save.
+func UnitValidator(u Unit) error {
+	switch u {
+	case UnitDays, UnitHours:
+		return nil
+	default:
+		return fmt.Errorf("absencetype: invalid enum value for unit field: %q", u)
+	}
+}
+
 // OrderOption defines the ordering options for the AbsenceType queries.
 type OrderOption func(*sql.Selector)
 
@@ -236,6 +282,11 @@ func ByAllowancePoolID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAllowancePoolID, opts...).ToFunc()
 }
 
+// ByUnit orders the results by the unit field.
+func ByUnit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnit, opts...).ToFunc()
+}
+
 // ByLeaveAllowancesCount orders the results by leave_allowances count.
 func ByLeaveAllowancesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
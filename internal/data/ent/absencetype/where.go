@@ -980,6 +980,76 @@ func AllowancePoolIDContainsFold(v string) predicate.AbsenceType {
 	return predicate.AbsenceType(sql.FieldContainsFold(FieldAllowancePoolID, v))
 }
 
+// UnitEQ applies the EQ predicate on the "unit" field.
+func UnitEQ(v Unit) predicate.AbsenceType {
+	return predicate.AbsenceType(sql.FieldEQ(FieldUnit, v))
+}
+
+// UnitNEQ applies the NEQ predicate on the "unit" field.
+func UnitNEQ(v Unit) predicate.AbsenceType {
+	return predicate.AbsenceType(sql.FieldNEQ(FieldUnit, v))
+}
+
+// UnitIn applies the In predicate on the "unit" field.
+func UnitIn(vs ...Unit) predicate.AbsenceType {
+	return predicate.AbsenceType(sql.FieldIn(FieldUnit, vs...))
+}
+
+// UnitNotIn applies the NotIn predicate on the "unit" field.
+func UnitNotIn(vs ...Unit) predicate.AbsenceType {
+	return predicate.AbsenceType(sql.FieldNotIn(FieldUnit, vs...))
+}
+
+// AccrualPolicyIsNil applies the IsNil predicate on the "accrual_policy" field.
+func AccrualPolicyIsNil() predicate.AbsenceType {
+	return predicate.AbsenceType(sql.FieldIsNull(FieldAccrualPolicy))
+}
+
+// AccrualPolicyNotNil applies the NotNil predicate on the "accrual_policy" field.
+func AccrualPolicyNotNil() predicate.AbsenceType {
+	return predicate.AbsenceType(sql.FieldNotNull(FieldAccrualPolicy))
+}
+
+// RolloverPolicyIsNil applies the IsNil predicate on the "rollover_policy" field.
+func RolloverPolicyIsNil() predicate.AbsenceType {
+	return predicate.AbsenceType(sql.FieldIsNull(FieldRolloverPolicy))
+}
+
+// RolloverPolicyNotNil applies the NotNil predicate on the "rollover_policy" field.
+func RolloverPolicyNotNil() predicate.AbsenceType {
+	return predicate.AbsenceType(sql.FieldNotNull(FieldRolloverPolicy))
+}
+
+// ApprovalChainIsNil applies the IsNil predicate on the "approval_chain" field.
+func ApprovalChainIsNil() predicate.AbsenceType {
+	return predicate.AbsenceType(sql.FieldIsNull(FieldApprovalChain))
+}
+
+// ApprovalChainNotNil applies the NotNil predicate on the "approval_chain" field.
+func ApprovalChainNotNil() predicate.AbsenceType {
+	return predicate.AbsenceType(sql.FieldNotNull(FieldApprovalChain))
+}
+
+// EscalationPolicyIsNil applies the IsNil predicate on the "escalation_policy" field.
+func EscalationPolicyIsNil() predicate.AbsenceType {
+	return predicate.AbsenceType(sql.FieldIsNull(FieldEscalationPolicy))
+}
+
+// EscalationPolicyNotNil applies the NotNil predicate on the "escalation_policy" field.
+func EscalationPolicyNotNil() predicate.AbsenceType {
+	return predicate.AbsenceType(sql.FieldNotNull(FieldEscalationPolicy))
+}
+
+// RequiresAttachmentIsNil applies the IsNil predicate on the "requires_attachment" field.
+func RequiresAttachmentIsNil() predicate.AbsenceType {
+	return predicate.AbsenceType(sql.FieldIsNull(FieldRequiresAttachment))
+}
+
+// RequiresAttachmentNotNil applies the NotNil predicate on the "requires_attachment" field.
+func RequiresAttachmentNotNil() predicate.AbsenceType {
+	return predicate.AbsenceType(sql.FieldNotNull(FieldRequiresAttachment))
+}
+
 // HasLeaveAllowances applies the HasEdge predicate on the "leave_allowances" edge.
 func HasLeaveAllowances() predicate.AbsenceType {
 	return predicate.AbsenceType(func(s *sql.Selector) {
@@ -12,10 +12,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/accrual"
+	"github.com/go-tangra/go-tangra-hr/internal/approval"
+	"github.com/go-tangra/go-tangra-hr/internal/attachment"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancepool"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveallowance"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/escalation"
+	"github.com/go-tangra/go-tangra-hr/internal/rollover"
 )
 
 // AbsenceTypeCreate is the builder for creating a AbsenceType entity.
@@ -262,6 +267,50 @@ func (_c *AbsenceTypeCreate) SetNillableAllowancePoolID(v *string) *AbsenceTypeC
 	return _c
 }
 
+// SetUnit sets the "unit" field.
+func (_c *AbsenceTypeCreate) SetUnit(v absencetype.Unit) *AbsenceTypeCreate {
+	_c.mutation.SetUnit(v)
+	return _c
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_c *AbsenceTypeCreate) SetNillableUnit(v *absencetype.Unit) *AbsenceTypeCreate {
+	if v != nil {
+		_c.SetUnit(*v)
+	}
+	return _c
+}
+
+// SetAccrualPolicy sets the "accrual_policy" field.
+func (_c *AbsenceTypeCreate) SetAccrualPolicy(v *accrual.Policy) *AbsenceTypeCreate {
+	_c.mutation.SetAccrualPolicy(v)
+	return _c
+}
+
+// SetRolloverPolicy sets the "rollover_policy" field.
+func (_c *AbsenceTypeCreate) SetRolloverPolicy(v *rollover.Policy) *AbsenceTypeCreate {
+	_c.mutation.SetRolloverPolicy(v)
+	return _c
+}
+
+// SetApprovalChain sets the "approval_chain" field.
+func (_c *AbsenceTypeCreate) SetApprovalChain(v *approval.Chain) *AbsenceTypeCreate {
+	_c.mutation.SetApprovalChain(v)
+	return _c
+}
+
+// SetEscalationPolicy sets the "escalation_policy" field.
+func (_c *AbsenceTypeCreate) SetEscalationPolicy(v *escalation.Policy) *AbsenceTypeCreate {
+	_c.mutation.SetEscalationPolicy(v)
+	return _c
+}
+
+// SetRequiresAttachment sets the "requires_attachment" field.
+func (_c *AbsenceTypeCreate) SetRequiresAttachment(v *attachment.Requirement) *AbsenceTypeCreate {
+	_c.mutation.SetRequiresAttachment(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AbsenceTypeCreate) SetID(v string) *AbsenceTypeCreate {
 	_c.mutation.SetID(v)
@@ -364,6 +413,10 @@ func (_c *AbsenceTypeCreate) defaults() error {
 		v := absencetype.DefaultRequiresSigning
 		_c.mutation.SetRequiresSigning(v)
 	}
+	if _, ok := _c.mutation.Unit(); !ok {
+		v := absencetype.DefaultUnit
+		_c.mutation.SetUnit(v)
+	}
 	return nil
 }
 
@@ -402,6 +455,14 @@ func (_c *AbsenceTypeCreate) check() error {
 	if _, ok := _c.mutation.RequiresSigning(); !ok {
 		return &ValidationError{Name: "requires_signing", err: errors.New(`ent: missing required field "AbsenceType.requires_signing"`)}
 	}
+	if _, ok := _c.mutation.Unit(); !ok {
+		return &ValidationError{Name: "unit", err: errors.New(`ent: missing required field "AbsenceType.unit"`)}
+	}
+	if v, ok := _c.mutation.Unit(); ok {
+		if err := absencetype.UnitValidator(v); err != nil {
+			return &ValidationError{Name: "unit", err: fmt.Errorf(`ent: validator failed for field "AbsenceType.unit": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := absencetype.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AbsenceType.id": %w`, err)}
@@ -511,6 +572,30 @@ func (_c *AbsenceTypeCreate) createSpec() (*AbsenceType, *sqlgraph.CreateSpec) {
 		_spec.SetField(absencetype.FieldSigningTemplateID, field.TypeString, value)
 		_node.SigningTemplateID = value
 	}
+	if value, ok := _c.mutation.Unit(); ok {
+		_spec.SetField(absencetype.FieldUnit, field.TypeEnum, value)
+		_node.Unit = value
+	}
+	if value, ok := _c.mutation.AccrualPolicy(); ok {
+		_spec.SetField(absencetype.FieldAccrualPolicy, field.TypeJSON, value)
+		_node.AccrualPolicy = value
+	}
+	if value, ok := _c.mutation.RolloverPolicy(); ok {
+		_spec.SetField(absencetype.FieldRolloverPolicy, field.TypeJSON, value)
+		_node.RolloverPolicy = value
+	}
+	if value, ok := _c.mutation.ApprovalChain(); ok {
+		_spec.SetField(absencetype.FieldApprovalChain, field.TypeJSON, value)
+		_node.ApprovalChain = value
+	}
+	if value, ok := _c.mutation.EscalationPolicy(); ok {
+		_spec.SetField(absencetype.FieldEscalationPolicy, field.TypeJSON, value)
+		_node.EscalationPolicy = value
+	}
+	if value, ok := _c.mutation.RequiresAttachment(); ok {
+		_spec.SetField(absencetype.FieldRequiresAttachment, field.TypeJSON, value)
+		_node.RequiresAttachment = value
+	}
 	if nodes := _c.mutation.LeaveAllowancesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -882,6 +967,108 @@ func (u *AbsenceTypeUpsert) ClearAllowancePoolID() *AbsenceTypeUpsert {
 	return u
 }
 
+// SetUnit sets the "unit" field.
+func (u *AbsenceTypeUpsert) SetUnit(v absencetype.Unit) *AbsenceTypeUpsert {
+	u.Set(absencetype.FieldUnit, v)
+	return u
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *AbsenceTypeUpsert) UpdateUnit() *AbsenceTypeUpsert {
+	u.SetExcluded(absencetype.FieldUnit)
+	return u
+}
+
+// SetAccrualPolicy sets the "accrual_policy" field.
+func (u *AbsenceTypeUpsert) SetAccrualPolicy(v *accrual.Policy) *AbsenceTypeUpsert {
+	u.Set(absencetype.FieldAccrualPolicy, v)
+	return u
+}
+
+// UpdateAccrualPolicy sets the "accrual_policy" field to the value that was provided on create.
+func (u *AbsenceTypeUpsert) UpdateAccrualPolicy() *AbsenceTypeUpsert {
+	u.SetExcluded(absencetype.FieldAccrualPolicy)
+	return u
+}
+
+// ClearAccrualPolicy clears the value of the "accrual_policy" field.
+func (u *AbsenceTypeUpsert) ClearAccrualPolicy() *AbsenceTypeUpsert {
+	u.SetNull(absencetype.FieldAccrualPolicy)
+	return u
+}
+
+// SetRolloverPolicy sets the "rollover_policy" field.
+func (u *AbsenceTypeUpsert) SetRolloverPolicy(v *rollover.Policy) *AbsenceTypeUpsert {
+	u.Set(absencetype.FieldRolloverPolicy, v)
+	return u
+}
+
+// UpdateRolloverPolicy sets the "rollover_policy" field to the value that was provided on create.
+func (u *AbsenceTypeUpsert) UpdateRolloverPolicy() *AbsenceTypeUpsert {
+	u.SetExcluded(absencetype.FieldRolloverPolicy)
+	return u
+}
+
+// ClearRolloverPolicy clears the value of the "rollover_policy" field.
+func (u *AbsenceTypeUpsert) ClearRolloverPolicy() *AbsenceTypeUpsert {
+	u.SetNull(absencetype.FieldRolloverPolicy)
+	return u
+}
+
+// SetApprovalChain sets the "approval_chain" field.
+func (u *AbsenceTypeUpsert) SetApprovalChain(v *approval.Chain) *AbsenceTypeUpsert {
+	u.Set(absencetype.FieldApprovalChain, v)
+	return u
+}
+
+// UpdateApprovalChain sets the "approval_chain" field to the value that was provided on create.
+func (u *AbsenceTypeUpsert) UpdateApprovalChain() *AbsenceTypeUpsert {
+	u.SetExcluded(absencetype.FieldApprovalChain)
+	return u
+}
+
+// ClearApprovalChain clears the value of the "approval_chain" field.
+func (u *AbsenceTypeUpsert) ClearApprovalChain() *AbsenceTypeUpsert {
+	u.SetNull(absencetype.FieldApprovalChain)
+	return u
+}
+
+// SetEscalationPolicy sets the "escalation_policy" field.
+func (u *AbsenceTypeUpsert) SetEscalationPolicy(v *escalation.Policy) *AbsenceTypeUpsert {
+	u.Set(absencetype.FieldEscalationPolicy, v)
+	return u
+}
+
+// UpdateEscalationPolicy sets the "escalation_policy" field to the value that was provided on create.
+func (u *AbsenceTypeUpsert) UpdateEscalationPolicy() *AbsenceTypeUpsert {
+	u.SetExcluded(absencetype.FieldEscalationPolicy)
+	return u
+}
+
+// ClearEscalationPolicy clears the value of the "escalation_policy" field.
+func (u *AbsenceTypeUpsert) ClearEscalationPolicy() *AbsenceTypeUpsert {
+	u.SetNull(absencetype.FieldEscalationPolicy)
+	return u
+}
+
+// SetRequiresAttachment sets the "requires_attachment" field.
+func (u *AbsenceTypeUpsert) SetRequiresAttachment(v *attachment.Requirement) *AbsenceTypeUpsert {
+	u.Set(absencetype.FieldRequiresAttachment, v)
+	return u
+}
+
+// UpdateRequiresAttachment sets the "requires_attachment" field to the value that was provided on create.
+func (u *AbsenceTypeUpsert) UpdateRequiresAttachment() *AbsenceTypeUpsert {
+	u.SetExcluded(absencetype.FieldRequiresAttachment)
+	return u
+}
+
+// ClearRequiresAttachment clears the value of the "requires_attachment" field.
+func (u *AbsenceTypeUpsert) ClearRequiresAttachment() *AbsenceTypeUpsert {
+	u.SetNull(absencetype.FieldRequiresAttachment)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1251,6 +1438,125 @@ func (u *AbsenceTypeUpsertOne) ClearAllowancePoolID() *AbsenceTypeUpsertOne {
 	})
 }
 
+// SetUnit sets the "unit" field.
+func (u *AbsenceTypeUpsertOne) SetUnit(v absencetype.Unit) *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.SetUnit(v)
+	})
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *AbsenceTypeUpsertOne) UpdateUnit() *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.UpdateUnit()
+	})
+}
+
+// SetAccrualPolicy sets the "accrual_policy" field.
+func (u *AbsenceTypeUpsertOne) SetAccrualPolicy(v *accrual.Policy) *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.SetAccrualPolicy(v)
+	})
+}
+
+// UpdateAccrualPolicy sets the "accrual_policy" field to the value that was provided on create.
+func (u *AbsenceTypeUpsertOne) UpdateAccrualPolicy() *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.UpdateAccrualPolicy()
+	})
+}
+
+// ClearAccrualPolicy clears the value of the "accrual_policy" field.
+func (u *AbsenceTypeUpsertOne) ClearAccrualPolicy() *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.ClearAccrualPolicy()
+	})
+}
+
+// SetRolloverPolicy sets the "rollover_policy" field.
+func (u *AbsenceTypeUpsertOne) SetRolloverPolicy(v *rollover.Policy) *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.SetRolloverPolicy(v)
+	})
+}
+
+// UpdateRolloverPolicy sets the "rollover_policy" field to the value that was provided on create.
+func (u *AbsenceTypeUpsertOne) UpdateRolloverPolicy() *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.UpdateRolloverPolicy()
+	})
+}
+
+// ClearRolloverPolicy clears the value of the "rollover_policy" field.
+func (u *AbsenceTypeUpsertOne) ClearRolloverPolicy() *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.ClearRolloverPolicy()
+	})
+}
+
+// SetApprovalChain sets the "approval_chain" field.
+func (u *AbsenceTypeUpsertOne) SetApprovalChain(v *approval.Chain) *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.SetApprovalChain(v)
+	})
+}
+
+// UpdateApprovalChain sets the "approval_chain" field to the value that was provided on create.
+func (u *AbsenceTypeUpsertOne) UpdateApprovalChain() *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.UpdateApprovalChain()
+	})
+}
+
+// ClearApprovalChain clears the value of the "approval_chain" field.
+func (u *AbsenceTypeUpsertOne) ClearApprovalChain() *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.ClearApprovalChain()
+	})
+}
+
+// SetEscalationPolicy sets the "escalation_policy" field.
+func (u *AbsenceTypeUpsertOne) SetEscalationPolicy(v *escalation.Policy) *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.SetEscalationPolicy(v)
+	})
+}
+
+// UpdateEscalationPolicy sets the "escalation_policy" field to the value that was provided on create.
+func (u *AbsenceTypeUpsertOne) UpdateEscalationPolicy() *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.UpdateEscalationPolicy()
+	})
+}
+
+// ClearEscalationPolicy clears the value of the "escalation_policy" field.
+func (u *AbsenceTypeUpsertOne) ClearEscalationPolicy() *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.ClearEscalationPolicy()
+	})
+}
+
+// SetRequiresAttachment sets the "requires_attachment" field.
+func (u *AbsenceTypeUpsertOne) SetRequiresAttachment(v *attachment.Requirement) *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.SetRequiresAttachment(v)
+	})
+}
+
+// UpdateRequiresAttachment sets the "requires_attachment" field to the value that was provided on create.
+func (u *AbsenceTypeUpsertOne) UpdateRequiresAttachment() *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.UpdateRequiresAttachment()
+	})
+}
+
+// ClearRequiresAttachment clears the value of the "requires_attachment" field.
+func (u *AbsenceTypeUpsertOne) ClearRequiresAttachment() *AbsenceTypeUpsertOne {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.ClearRequiresAttachment()
+	})
+}
+
 // Exec executes the query.
 func (u *AbsenceTypeUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1787,6 +2093,125 @@ func (u *AbsenceTypeUpsertBulk) ClearAllowancePoolID() *AbsenceTypeUpsertBulk {
 	})
 }
 
+// SetUnit sets the "unit" field.
+func (u *AbsenceTypeUpsertBulk) SetUnit(v absencetype.Unit) *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.SetUnit(v)
+	})
+}
+
+// UpdateUnit sets the "unit" field to the value that was provided on create.
+func (u *AbsenceTypeUpsertBulk) UpdateUnit() *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.UpdateUnit()
+	})
+}
+
+// SetAccrualPolicy sets the "accrual_policy" field.
+func (u *AbsenceTypeUpsertBulk) SetAccrualPolicy(v *accrual.Policy) *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.SetAccrualPolicy(v)
+	})
+}
+
+// UpdateAccrualPolicy sets the "accrual_policy" field to the value that was provided on create.
+func (u *AbsenceTypeUpsertBulk) UpdateAccrualPolicy() *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.UpdateAccrualPolicy()
+	})
+}
+
+// ClearAccrualPolicy clears the value of the "accrual_policy" field.
+func (u *AbsenceTypeUpsertBulk) ClearAccrualPolicy() *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.ClearAccrualPolicy()
+	})
+}
+
+// SetRolloverPolicy sets the "rollover_policy" field.
+func (u *AbsenceTypeUpsertBulk) SetRolloverPolicy(v *rollover.Policy) *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.SetRolloverPolicy(v)
+	})
+}
+
+// UpdateRolloverPolicy sets the "rollover_policy" field to the value that was provided on create.
+func (u *AbsenceTypeUpsertBulk) UpdateRolloverPolicy() *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.UpdateRolloverPolicy()
+	})
+}
+
+// ClearRolloverPolicy clears the value of the "rollover_policy" field.
+func (u *AbsenceTypeUpsertBulk) ClearRolloverPolicy() *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.ClearRolloverPolicy()
+	})
+}
+
+// SetApprovalChain sets the "approval_chain" field.
+func (u *AbsenceTypeUpsertBulk) SetApprovalChain(v *approval.Chain) *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.SetApprovalChain(v)
+	})
+}
+
+// UpdateApprovalChain sets the "approval_chain" field to the value that was provided on create.
+func (u *AbsenceTypeUpsertBulk) UpdateApprovalChain() *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.UpdateApprovalChain()
+	})
+}
+
+// ClearApprovalChain clears the value of the "approval_chain" field.
+func (u *AbsenceTypeUpsertBulk) ClearApprovalChain() *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.ClearApprovalChain()
+	})
+}
+
+// SetEscalationPolicy sets the "escalation_policy" field.
+func (u *AbsenceTypeUpsertBulk) SetEscalationPolicy(v *escalation.Policy) *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.SetEscalationPolicy(v)
+	})
+}
+
+// UpdateEscalationPolicy sets the "escalation_policy" field to the value that was provided on create.
+func (u *AbsenceTypeUpsertBulk) UpdateEscalationPolicy() *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.UpdateEscalationPolicy()
+	})
+}
+
+// ClearEscalationPolicy clears the value of the "escalation_policy" field.
+func (u *AbsenceTypeUpsertBulk) ClearEscalationPolicy() *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.ClearEscalationPolicy()
+	})
+}
+
+// SetRequiresAttachment sets the "requires_attachment" field.
+func (u *AbsenceTypeUpsertBulk) SetRequiresAttachment(v *attachment.Requirement) *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.SetRequiresAttachment(v)
+	})
+}
+
+// UpdateRequiresAttachment sets the "requires_attachment" field to the value that was provided on create.
+func (u *AbsenceTypeUpsertBulk) UpdateRequiresAttachment() *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.UpdateRequiresAttachment()
+	})
+}
+
+// ClearRequiresAttachment clears the value of the "requires_attachment" field.
+func (u *AbsenceTypeUpsertBulk) ClearRequiresAttachment() *AbsenceTypeUpsertBulk {
+	return u.Update(func(s *AbsenceTypeUpsert) {
+		s.ClearRequiresAttachment()
+	})
+}
+
 // Exec executes the query.
 func (u *AbsenceTypeUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -11,11 +11,16 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/accrual"
+	"github.com/go-tangra/go-tangra-hr/internal/approval"
+	"github.com/go-tangra/go-tangra-hr/internal/attachment"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancepool"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveallowance"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-hr/internal/escalation"
+	"github.com/go-tangra/go-tangra-hr/internal/rollover"
 )
 
 // AbsenceTypeUpdate is the builder for updating AbsenceType entities.
@@ -329,6 +334,80 @@ func (_u *AbsenceTypeUpdate) ClearAllowancePoolID() *AbsenceTypeUpdate {
 	return _u
 }
 
+// SetUnit sets the "unit" field.
+func (_u *AbsenceTypeUpdate) SetUnit(v absencetype.Unit) *AbsenceTypeUpdate {
+	_u.mutation.SetUnit(v)
+	return _u
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_u *AbsenceTypeUpdate) SetNillableUnit(v *absencetype.Unit) *AbsenceTypeUpdate {
+	if v != nil {
+		_u.SetUnit(*v)
+	}
+	return _u
+}
+
+// SetAccrualPolicy sets the "accrual_policy" field.
+func (_u *AbsenceTypeUpdate) SetAccrualPolicy(v *accrual.Policy) *AbsenceTypeUpdate {
+	_u.mutation.SetAccrualPolicy(v)
+	return _u
+}
+
+// ClearAccrualPolicy clears the value of the "accrual_policy" field.
+func (_u *AbsenceTypeUpdate) ClearAccrualPolicy() *AbsenceTypeUpdate {
+	_u.mutation.ClearAccrualPolicy()
+	return _u
+}
+
+// SetRolloverPolicy sets the "rollover_policy" field.
+func (_u *AbsenceTypeUpdate) SetRolloverPolicy(v *rollover.Policy) *AbsenceTypeUpdate {
+	_u.mutation.SetRolloverPolicy(v)
+	return _u
+}
+
+// ClearRolloverPolicy clears the value of the "rollover_policy" field.
+func (_u *AbsenceTypeUpdate) ClearRolloverPolicy() *AbsenceTypeUpdate {
+	_u.mutation.ClearRolloverPolicy()
+	return _u
+}
+
+// SetApprovalChain sets the "approval_chain" field.
+func (_u *AbsenceTypeUpdate) SetApprovalChain(v *approval.Chain) *AbsenceTypeUpdate {
+	_u.mutation.SetApprovalChain(v)
+	return _u
+}
+
+// ClearApprovalChain clears the value of the "approval_chain" field.
+func (_u *AbsenceTypeUpdate) ClearApprovalChain() *AbsenceTypeUpdate {
+	_u.mutation.ClearApprovalChain()
+	return _u
+}
+
+// SetEscalationPolicy sets the "escalation_policy" field.
+func (_u *AbsenceTypeUpdate) SetEscalationPolicy(v *escalation.Policy) *AbsenceTypeUpdate {
+	_u.mutation.SetEscalationPolicy(v)
+	return _u
+}
+
+// ClearEscalationPolicy clears the value of the "escalation_policy" field.
+func (_u *AbsenceTypeUpdate) ClearEscalationPolicy() *AbsenceTypeUpdate {
+	_u.mutation.ClearEscalationPolicy()
+	return _u
+}
+
+// SetRequiresAttachment sets the "requires_attachment" field.
+func (_u *AbsenceTypeUpdate) SetRequiresAttachment(v *attachment.Requirement) *AbsenceTypeUpdate {
+	_u.mutation.SetRequiresAttachment(v)
+	return _u
+}
+
+// ClearRequiresAttachment clears the value of the "requires_attachment" field.
+func (_u *AbsenceTypeUpdate) ClearRequiresAttachment() *AbsenceTypeUpdate {
+	_u.mutation.ClearRequiresAttachment()
+	return _u
+}
+
 // AddLeaveAllowanceIDs adds the "leave_allowances" edge to the LeaveAllowance entity by IDs.
 func (_u *AbsenceTypeUpdate) AddLeaveAllowanceIDs(ids ...string) *AbsenceTypeUpdate {
 	_u.mutation.AddLeaveAllowanceIDs(ids...)
@@ -461,6 +540,11 @@ func (_u *AbsenceTypeUpdate) check() error {
 			return &ValidationError{Name: "icon", err: fmt.Errorf(`ent: validator failed for field "AbsenceType.icon": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Unit(); ok {
+		if err := absencetype.UnitValidator(v); err != nil {
+			return &ValidationError{Name: "unit", err: fmt.Errorf(`ent: validator failed for field "AbsenceType.unit": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -569,6 +653,39 @@ func (_u *AbsenceTypeUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.SigningTemplateIDCleared() {
 		_spec.ClearField(absencetype.FieldSigningTemplateID, field.TypeString)
 	}
+	if value, ok := _u.mutation.Unit(); ok {
+		_spec.SetField(absencetype.FieldUnit, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AccrualPolicy(); ok {
+		_spec.SetField(absencetype.FieldAccrualPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.AccrualPolicyCleared() {
+		_spec.ClearField(absencetype.FieldAccrualPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RolloverPolicy(); ok {
+		_spec.SetField(absencetype.FieldRolloverPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.RolloverPolicyCleared() {
+		_spec.ClearField(absencetype.FieldRolloverPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ApprovalChain(); ok {
+		_spec.SetField(absencetype.FieldApprovalChain, field.TypeJSON, value)
+	}
+	if _u.mutation.ApprovalChainCleared() {
+		_spec.ClearField(absencetype.FieldApprovalChain, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EscalationPolicy(); ok {
+		_spec.SetField(absencetype.FieldEscalationPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.EscalationPolicyCleared() {
+		_spec.ClearField(absencetype.FieldEscalationPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RequiresAttachment(); ok {
+		_spec.SetField(absencetype.FieldRequiresAttachment, field.TypeJSON, value)
+	}
+	if _u.mutation.RequiresAttachmentCleared() {
+		_spec.ClearField(absencetype.FieldRequiresAttachment, field.TypeJSON)
+	}
 	if _u.mutation.LeaveAllowancesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1007,6 +1124,80 @@ func (_u *AbsenceTypeUpdateOne) ClearAllowancePoolID() *AbsenceTypeUpdateOne {
 	return _u
 }
 
+// SetUnit sets the "unit" field.
+func (_u *AbsenceTypeUpdateOne) SetUnit(v absencetype.Unit) *AbsenceTypeUpdateOne {
+	_u.mutation.SetUnit(v)
+	return _u
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_u *AbsenceTypeUpdateOne) SetNillableUnit(v *absencetype.Unit) *AbsenceTypeUpdateOne {
+	if v != nil {
+		_u.SetUnit(*v)
+	}
+	return _u
+}
+
+// SetAccrualPolicy sets the "accrual_policy" field.
+func (_u *AbsenceTypeUpdateOne) SetAccrualPolicy(v *accrual.Policy) *AbsenceTypeUpdateOne {
+	_u.mutation.SetAccrualPolicy(v)
+	return _u
+}
+
+// ClearAccrualPolicy clears the value of the "accrual_policy" field.
+func (_u *AbsenceTypeUpdateOne) ClearAccrualPolicy() *AbsenceTypeUpdateOne {
+	_u.mutation.ClearAccrualPolicy()
+	return _u
+}
+
+// SetRolloverPolicy sets the "rollover_policy" field.
+func (_u *AbsenceTypeUpdateOne) SetRolloverPolicy(v *rollover.Policy) *AbsenceTypeUpdateOne {
+	_u.mutation.SetRolloverPolicy(v)
+	return _u
+}
+
+// ClearRolloverPolicy clears the value of the "rollover_policy" field.
+func (_u *AbsenceTypeUpdateOne) ClearRolloverPolicy() *AbsenceTypeUpdateOne {
+	_u.mutation.ClearRolloverPolicy()
+	return _u
+}
+
+// SetApprovalChain sets the "approval_chain" field.
+func (_u *AbsenceTypeUpdateOne) SetApprovalChain(v *approval.Chain) *AbsenceTypeUpdateOne {
+	_u.mutation.SetApprovalChain(v)
+	return _u
+}
+
+// ClearApprovalChain clears the value of the "approval_chain" field.
+func (_u *AbsenceTypeUpdateOne) ClearApprovalChain() *AbsenceTypeUpdateOne {
+	_u.mutation.ClearApprovalChain()
+	return _u
+}
+
+// SetEscalationPolicy sets the "escalation_policy" field.
+func (_u *AbsenceTypeUpdateOne) SetEscalationPolicy(v *escalation.Policy) *AbsenceTypeUpdateOne {
+	_u.mutation.SetEscalationPolicy(v)
+	return _u
+}
+
+// ClearEscalationPolicy clears the value of the "escalation_policy" field.
+func (_u *AbsenceTypeUpdateOne) ClearEscalationPolicy() *AbsenceTypeUpdateOne {
+	_u.mutation.ClearEscalationPolicy()
+	return _u
+}
+
+// SetRequiresAttachment sets the "requires_attachment" field.
+func (_u *AbsenceTypeUpdateOne) SetRequiresAttachment(v *attachment.Requirement) *AbsenceTypeUpdateOne {
+	_u.mutation.SetRequiresAttachment(v)
+	return _u
+}
+
+// ClearRequiresAttachment clears the value of the "requires_attachment" field.
+func (_u *AbsenceTypeUpdateOne) ClearRequiresAttachment() *AbsenceTypeUpdateOne {
+	_u.mutation.ClearRequiresAttachment()
+	return _u
+}
+
 // AddLeaveAllowanceIDs adds the "leave_allowances" edge to the LeaveAllowance entity by IDs.
 func (_u *AbsenceTypeUpdateOne) AddLeaveAllowanceIDs(ids ...string) *AbsenceTypeUpdateOne {
 	_u.mutation.AddLeaveAllowanceIDs(ids...)
@@ -1152,6 +1343,11 @@ func (_u *AbsenceTypeUpdateOne) check() error {
 			return &ValidationError{Name: "icon", err: fmt.Errorf(`ent: validator failed for field "AbsenceType.icon": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Unit(); ok {
+		if err := absencetype.UnitValidator(v); err != nil {
+			return &ValidationError{Name: "unit", err: fmt.Errorf(`ent: validator failed for field "AbsenceType.unit": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1277,6 +1473,39 @@ func (_u *AbsenceTypeUpdateOne) sqlSave(ctx context.Context) (_node *AbsenceType
 	if _u.mutation.SigningTemplateIDCleared() {
 		_spec.ClearField(absencetype.FieldSigningTemplateID, field.TypeString)
 	}
+	if value, ok := _u.mutation.Unit(); ok {
+		_spec.SetField(absencetype.FieldUnit, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AccrualPolicy(); ok {
+		_spec.SetField(absencetype.FieldAccrualPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.AccrualPolicyCleared() {
+		_spec.ClearField(absencetype.FieldAccrualPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RolloverPolicy(); ok {
+		_spec.SetField(absencetype.FieldRolloverPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.RolloverPolicyCleared() {
+		_spec.ClearField(absencetype.FieldRolloverPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ApprovalChain(); ok {
+		_spec.SetField(absencetype.FieldApprovalChain, field.TypeJSON, value)
+	}
+	if _u.mutation.ApprovalChainCleared() {
+		_spec.ClearField(absencetype.FieldApprovalChain, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EscalationPolicy(); ok {
+		_spec.SetField(absencetype.FieldEscalationPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.EscalationPolicyCleared() {
+		_spec.ClearField(absencetype.FieldEscalationPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RequiresAttachment(); ok {
+		_spec.SetField(absencetype.FieldRequiresAttachment, field.TypeJSON, value)
+	}
+	if _u.mutation.RequiresAttachmentCleared() {
+		_spec.ClearField(absencetype.FieldRequiresAttachment, field.TypeJSON)
+	}
 	if _u.mutation.LeaveAllowancesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -3,13 +3,16 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/accrual"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancepool"
+	"github.com/go-tangra/go-tangra-hr/internal/rollover"
 )
 
 // AllowancePool is the model entity for the AllowancePool schema.
@@ -38,6 +41,10 @@ type AllowancePool struct {
 	Color string `json:"color,omitempty"`
 	// Lucide icon name
 	Icon string `json:"icon,omitempty"`
+	// How pool allowance days are earned over the year; unset when granted up front
+	AccrualPolicy *accrual.Policy `json:"accrual_policy,omitempty"`
+	// How pool allowances are renewed and unused days carried over at year end
+	RolloverPolicy *rollover.Policy `json:"rollover_policy,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AllowancePoolQuery when eager-loading is set.
 	Edges        AllowancePoolEdges `json:"edges"`
@@ -78,6 +85,8 @@ func (*AllowancePool) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case allowancepool.FieldAccrualPolicy, allowancepool.FieldRolloverPolicy:
+			values[i] = new([]byte)
 		case allowancepool.FieldCreateBy, allowancepool.FieldUpdateBy, allowancepool.FieldTenantID:
 			values[i] = new(sql.NullInt64)
 		case allowancepool.FieldID, allowancepool.FieldName, allowancepool.FieldDescription, allowancepool.FieldColor, allowancepool.FieldIcon:
@@ -171,6 +180,22 @@ func (_m *AllowancePool) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Icon = value.String
 			}
+		case allowancepool.FieldAccrualPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field accrual_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AccrualPolicy); err != nil {
+					return fmt.Errorf("unmarshal field accrual_policy: %w", err)
+				}
+			}
+		case allowancepool.FieldRolloverPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rollover_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RolloverPolicy); err != nil {
+					return fmt.Errorf("unmarshal field rollover_policy: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -258,6 +283,12 @@ func (_m *AllowancePool) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("icon=")
 	builder.WriteString(_m.Icon)
+	builder.WriteString(", ")
+	builder.WriteString("accrual_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccrualPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("rollover_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RolloverPolicy))
 	builder.WriteByte(')')
 	return builder.String()
 }
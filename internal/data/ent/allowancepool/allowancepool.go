@@ -33,6 +33,10 @@ const (
 	FieldColor = "color"
 	// FieldIcon holds the string denoting the icon field in the database.
 	FieldIcon = "icon"
+	// FieldAccrualPolicy holds the string denoting the accrual_policy field in the database.
+	FieldAccrualPolicy = "accrual_policy"
+	// FieldRolloverPolicy holds the string denoting the rollover_policy field in the database.
+	FieldRolloverPolicy = "rollover_policy"
 	// EdgeAbsenceTypes holds the string denoting the absence_types edge name in mutations.
 	EdgeAbsenceTypes = "absence_types"
 	// EdgeLeaveAllowances holds the string denoting the leave_allowances edge name in mutations.
@@ -68,6 +72,8 @@ var Columns = []string{
 	FieldDescription,
 	FieldColor,
 	FieldIcon,
+	FieldAccrualPolicy,
+	FieldRolloverPolicy,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
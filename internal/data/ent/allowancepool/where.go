@@ -705,6 +705,26 @@ func IconContainsFold(v string) predicate.AllowancePool {
 	return predicate.AllowancePool(sql.FieldContainsFold(FieldIcon, v))
 }
 
+// AccrualPolicyIsNil applies the IsNil predicate on the "accrual_policy" field.
+func AccrualPolicyIsNil() predicate.AllowancePool {
+	return predicate.AllowancePool(sql.FieldIsNull(FieldAccrualPolicy))
+}
+
+// AccrualPolicyNotNil applies the NotNil predicate on the "accrual_policy" field.
+func AccrualPolicyNotNil() predicate.AllowancePool {
+	return predicate.AllowancePool(sql.FieldNotNull(FieldAccrualPolicy))
+}
+
+// RolloverPolicyIsNil applies the IsNil predicate on the "rollover_policy" field.
+func RolloverPolicyIsNil() predicate.AllowancePool {
+	return predicate.AllowancePool(sql.FieldIsNull(FieldRolloverPolicy))
+}
+
+// RolloverPolicyNotNil applies the NotNil predicate on the "rollover_policy" field.
+func RolloverPolicyNotNil() predicate.AllowancePool {
+	return predicate.AllowancePool(sql.FieldNotNull(FieldRolloverPolicy))
+}
+
 // HasAbsenceTypes applies the HasEdge predicate on the "absence_types" edge.
 func HasAbsenceTypes() predicate.AllowancePool {
 	return predicate.AllowancePool(func(s *sql.Selector) {
@@ -12,9 +12,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/accrual"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancepool"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveallowance"
+	"github.com/go-tangra/go-tangra-hr/internal/rollover"
 )
 
 // AllowancePoolCreate is the builder for creating a AllowancePool entity.
@@ -157,6 +159,18 @@ func (_c *AllowancePoolCreate) SetNillableIcon(v *string) *AllowancePoolCreate {
 	return _c
 }
 
+// SetAccrualPolicy sets the "accrual_policy" field.
+func (_c *AllowancePoolCreate) SetAccrualPolicy(v *accrual.Policy) *AllowancePoolCreate {
+	_c.mutation.SetAccrualPolicy(v)
+	return _c
+}
+
+// SetRolloverPolicy sets the "rollover_policy" field.
+func (_c *AllowancePoolCreate) SetRolloverPolicy(v *rollover.Policy) *AllowancePoolCreate {
+	_c.mutation.SetRolloverPolicy(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AllowancePoolCreate) SetID(v string) *AllowancePoolCreate {
 	_c.mutation.SetID(v)
@@ -338,6 +352,14 @@ func (_c *AllowancePoolCreate) createSpec() (*AllowancePool, *sqlgraph.CreateSpe
 		_spec.SetField(allowancepool.FieldIcon, field.TypeString, value)
 		_node.Icon = value
 	}
+	if value, ok := _c.mutation.AccrualPolicy(); ok {
+		_spec.SetField(allowancepool.FieldAccrualPolicy, field.TypeJSON, value)
+		_node.AccrualPolicy = value
+	}
+	if value, ok := _c.mutation.RolloverPolicy(); ok {
+		_spec.SetField(allowancepool.FieldRolloverPolicy, field.TypeJSON, value)
+		_node.RolloverPolicy = value
+	}
 	if nodes := _c.mutation.AbsenceTypesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -572,6 +594,42 @@ func (u *AllowancePoolUpsert) ClearIcon() *AllowancePoolUpsert {
 	return u
 }
 
+// SetAccrualPolicy sets the "accrual_policy" field.
+func (u *AllowancePoolUpsert) SetAccrualPolicy(v *accrual.Policy) *AllowancePoolUpsert {
+	u.Set(allowancepool.FieldAccrualPolicy, v)
+	return u
+}
+
+// UpdateAccrualPolicy sets the "accrual_policy" field to the value that was provided on create.
+func (u *AllowancePoolUpsert) UpdateAccrualPolicy() *AllowancePoolUpsert {
+	u.SetExcluded(allowancepool.FieldAccrualPolicy)
+	return u
+}
+
+// ClearAccrualPolicy clears the value of the "accrual_policy" field.
+func (u *AllowancePoolUpsert) ClearAccrualPolicy() *AllowancePoolUpsert {
+	u.SetNull(allowancepool.FieldAccrualPolicy)
+	return u
+}
+
+// SetRolloverPolicy sets the "rollover_policy" field.
+func (u *AllowancePoolUpsert) SetRolloverPolicy(v *rollover.Policy) *AllowancePoolUpsert {
+	u.Set(allowancepool.FieldRolloverPolicy, v)
+	return u
+}
+
+// UpdateRolloverPolicy sets the "rollover_policy" field to the value that was provided on create.
+func (u *AllowancePoolUpsert) UpdateRolloverPolicy() *AllowancePoolUpsert {
+	u.SetExcluded(allowancepool.FieldRolloverPolicy)
+	return u
+}
+
+// ClearRolloverPolicy clears the value of the "rollover_policy" field.
+func (u *AllowancePoolUpsert) ClearRolloverPolicy() *AllowancePoolUpsert {
+	u.SetNull(allowancepool.FieldRolloverPolicy)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -801,6 +859,48 @@ func (u *AllowancePoolUpsertOne) ClearIcon() *AllowancePoolUpsertOne {
 	})
 }
 
+// SetAccrualPolicy sets the "accrual_policy" field.
+func (u *AllowancePoolUpsertOne) SetAccrualPolicy(v *accrual.Policy) *AllowancePoolUpsertOne {
+	return u.Update(func(s *AllowancePoolUpsert) {
+		s.SetAccrualPolicy(v)
+	})
+}
+
+// UpdateAccrualPolicy sets the "accrual_policy" field to the value that was provided on create.
+func (u *AllowancePoolUpsertOne) UpdateAccrualPolicy() *AllowancePoolUpsertOne {
+	return u.Update(func(s *AllowancePoolUpsert) {
+		s.UpdateAccrualPolicy()
+	})
+}
+
+// ClearAccrualPolicy clears the value of the "accrual_policy" field.
+func (u *AllowancePoolUpsertOne) ClearAccrualPolicy() *AllowancePoolUpsertOne {
+	return u.Update(func(s *AllowancePoolUpsert) {
+		s.ClearAccrualPolicy()
+	})
+}
+
+// SetRolloverPolicy sets the "rollover_policy" field.
+func (u *AllowancePoolUpsertOne) SetRolloverPolicy(v *rollover.Policy) *AllowancePoolUpsertOne {
+	return u.Update(func(s *AllowancePoolUpsert) {
+		s.SetRolloverPolicy(v)
+	})
+}
+
+// UpdateRolloverPolicy sets the "rollover_policy" field to the value that was provided on create.
+func (u *AllowancePoolUpsertOne) UpdateRolloverPolicy() *AllowancePoolUpsertOne {
+	return u.Update(func(s *AllowancePoolUpsert) {
+		s.UpdateRolloverPolicy()
+	})
+}
+
+// ClearRolloverPolicy clears the value of the "rollover_policy" field.
+func (u *AllowancePoolUpsertOne) ClearRolloverPolicy() *AllowancePoolUpsertOne {
+	return u.Update(func(s *AllowancePoolUpsert) {
+		s.ClearRolloverPolicy()
+	})
+}
+
 // Exec executes the query.
 func (u *AllowancePoolUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1197,6 +1297,48 @@ func (u *AllowancePoolUpsertBulk) ClearIcon() *AllowancePoolUpsertBulk {
 	})
 }
 
+// SetAccrualPolicy sets the "accrual_policy" field.
+func (u *AllowancePoolUpsertBulk) SetAccrualPolicy(v *accrual.Policy) *AllowancePoolUpsertBulk {
+	return u.Update(func(s *AllowancePoolUpsert) {
+		s.SetAccrualPolicy(v)
+	})
+}
+
+// UpdateAccrualPolicy sets the "accrual_policy" field to the value that was provided on create.
+func (u *AllowancePoolUpsertBulk) UpdateAccrualPolicy() *AllowancePoolUpsertBulk {
+	return u.Update(func(s *AllowancePoolUpsert) {
+		s.UpdateAccrualPolicy()
+	})
+}
+
+// ClearAccrualPolicy clears the value of the "accrual_policy" field.
+func (u *AllowancePoolUpsertBulk) ClearAccrualPolicy() *AllowancePoolUpsertBulk {
+	return u.Update(func(s *AllowancePoolUpsert) {
+		s.ClearAccrualPolicy()
+	})
+}
+
+// SetRolloverPolicy sets the "rollover_policy" field.
+func (u *AllowancePoolUpsertBulk) SetRolloverPolicy(v *rollover.Policy) *AllowancePoolUpsertBulk {
+	return u.Update(func(s *AllowancePoolUpsert) {
+		s.SetRolloverPolicy(v)
+	})
+}
+
+// UpdateRolloverPolicy sets the "rollover_policy" field to the value that was provided on create.
+func (u *AllowancePoolUpsertBulk) UpdateRolloverPolicy() *AllowancePoolUpsertBulk {
+	return u.Update(func(s *AllowancePoolUpsert) {
+		s.UpdateRolloverPolicy()
+	})
+}
+
+// ClearRolloverPolicy clears the value of the "rollover_policy" field.
+func (u *AllowancePoolUpsertBulk) ClearRolloverPolicy() *AllowancePoolUpsertBulk {
+	return u.Update(func(s *AllowancePoolUpsert) {
+		s.ClearRolloverPolicy()
+	})
+}
+
 // Exec executes the query.
 func (u *AllowancePoolUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -11,10 +11,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/accrual"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancepool"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveallowance"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-hr/internal/rollover"
 )
 
 // AllowancePoolUpdate is the builder for updating AllowancePool entities.
@@ -199,6 +201,30 @@ func (_u *AllowancePoolUpdate) ClearIcon() *AllowancePoolUpdate {
 	return _u
 }
 
+// SetAccrualPolicy sets the "accrual_policy" field.
+func (_u *AllowancePoolUpdate) SetAccrualPolicy(v *accrual.Policy) *AllowancePoolUpdate {
+	_u.mutation.SetAccrualPolicy(v)
+	return _u
+}
+
+// ClearAccrualPolicy clears the value of the "accrual_policy" field.
+func (_u *AllowancePoolUpdate) ClearAccrualPolicy() *AllowancePoolUpdate {
+	_u.mutation.ClearAccrualPolicy()
+	return _u
+}
+
+// SetRolloverPolicy sets the "rollover_policy" field.
+func (_u *AllowancePoolUpdate) SetRolloverPolicy(v *rollover.Policy) *AllowancePoolUpdate {
+	_u.mutation.SetRolloverPolicy(v)
+	return _u
+}
+
+// ClearRolloverPolicy clears the value of the "rollover_policy" field.
+func (_u *AllowancePoolUpdate) ClearRolloverPolicy() *AllowancePoolUpdate {
+	_u.mutation.ClearRolloverPolicy()
+	return _u
+}
+
 // AddAbsenceTypeIDs adds the "absence_types" edge to the AbsenceType entity by IDs.
 func (_u *AllowancePoolUpdate) AddAbsenceTypeIDs(ids ...string) *AllowancePoolUpdate {
 	_u.mutation.AddAbsenceTypeIDs(ids...)
@@ -398,6 +424,18 @@ func (_u *AllowancePoolUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.IconCleared() {
 		_spec.ClearField(allowancepool.FieldIcon, field.TypeString)
 	}
+	if value, ok := _u.mutation.AccrualPolicy(); ok {
+		_spec.SetField(allowancepool.FieldAccrualPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.AccrualPolicyCleared() {
+		_spec.ClearField(allowancepool.FieldAccrualPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RolloverPolicy(); ok {
+		_spec.SetField(allowancepool.FieldRolloverPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.RolloverPolicyCleared() {
+		_spec.ClearField(allowancepool.FieldRolloverPolicy, field.TypeJSON)
+	}
 	if _u.mutation.AbsenceTypesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -678,6 +716,30 @@ func (_u *AllowancePoolUpdateOne) ClearIcon() *AllowancePoolUpdateOne {
 	return _u
 }
 
+// SetAccrualPolicy sets the "accrual_policy" field.
+func (_u *AllowancePoolUpdateOne) SetAccrualPolicy(v *accrual.Policy) *AllowancePoolUpdateOne {
+	_u.mutation.SetAccrualPolicy(v)
+	return _u
+}
+
+// ClearAccrualPolicy clears the value of the "accrual_policy" field.
+func (_u *AllowancePoolUpdateOne) ClearAccrualPolicy() *AllowancePoolUpdateOne {
+	_u.mutation.ClearAccrualPolicy()
+	return _u
+}
+
+// SetRolloverPolicy sets the "rollover_policy" field.
+func (_u *AllowancePoolUpdateOne) SetRolloverPolicy(v *rollover.Policy) *AllowancePoolUpdateOne {
+	_u.mutation.SetRolloverPolicy(v)
+	return _u
+}
+
+// ClearRolloverPolicy clears the value of the "rollover_policy" field.
+func (_u *AllowancePoolUpdateOne) ClearRolloverPolicy() *AllowancePoolUpdateOne {
+	_u.mutation.ClearRolloverPolicy()
+	return _u
+}
+
 // AddAbsenceTypeIDs adds the "absence_types" edge to the AbsenceType entity by IDs.
 func (_u *AllowancePoolUpdateOne) AddAbsenceTypeIDs(ids ...string) *AllowancePoolUpdateOne {
 	_u.mutation.AddAbsenceTypeIDs(ids...)
@@ -907,6 +969,18 @@ func (_u *AllowancePoolUpdateOne) sqlSave(ctx context.Context) (_node *Allowance
 	if _u.mutation.IconCleared() {
 		_spec.ClearField(allowancepool.FieldIcon, field.TypeString)
 	}
+	if value, ok := _u.mutation.AccrualPolicy(); ok {
+		_spec.SetField(allowancepool.FieldAccrualPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.AccrualPolicyCleared() {
+		_spec.ClearField(allowancepool.FieldAccrualPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RolloverPolicy(); ok {
+		_spec.SetField(allowancepool.FieldRolloverPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.RolloverPolicyCleared() {
+		_spec.ClearField(allowancepool.FieldRolloverPolicy, field.TypeJSON)
+	}
 	if _u.mutation.AbsenceTypesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
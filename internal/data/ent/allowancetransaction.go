@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancetransaction"
+)
+
+// AllowanceTransaction is the model entity for the AllowanceTransaction schema.
+type AllowanceTransaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique identifier
+	ID string `json:"id,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// LeaveAllowance the entry applies to (kept after the allowance is deleted)
+	AllowanceID string `json:"allowance_id,omitempty"`
+	// Owner of the allowance
+	UserID uint32 `json:"user_id,omitempty"`
+	// Allowance year
+	Year int `json:"year,omitempty"`
+	// Type of change
+	Kind allowancetransaction.Kind `json:"kind,omitempty"`
+	// Signed number of days changed
+	Days float64 `json:"days,omitempty"`
+	// Remaining days after the entry was applied
+	BalanceAfter float64 `json:"balance_after,omitempty"`
+	// Leave request that caused the entry
+	LeaveRequestID *string `json:"leave_request_id,omitempty"`
+	// User who caused the entry; 0 for system actions
+	ActorID uint32 `json:"actor_id,omitempty"`
+	// Denormalized actor display name
+	ActorName string `json:"actor_name,omitempty"`
+	// Reason for the entry
+	Note         string `json:"note,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AllowanceTransaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case allowancetransaction.FieldDays, allowancetransaction.FieldBalanceAfter:
+			values[i] = new(sql.NullFloat64)
+		case allowancetransaction.FieldTenantID, allowancetransaction.FieldUserID, allowancetransaction.FieldYear, allowancetransaction.FieldActorID:
+			values[i] = new(sql.NullInt64)
+		case allowancetransaction.FieldID, allowancetransaction.FieldAllowanceID, allowancetransaction.FieldKind, allowancetransaction.FieldLeaveRequestID, allowancetransaction.FieldActorName, allowancetransaction.FieldNote:
+			values[i] = new(sql.NullString)
+		case allowancetransaction.FieldCreateTime, allowancetransaction.FieldUpdateTime, allowancetransaction.FieldDeleteTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AllowanceTransaction fields.
+func (_m *AllowanceTransaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case allowancetransaction.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case allowancetransaction.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case allowancetransaction.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case allowancetransaction.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case allowancetransaction.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case allowancetransaction.FieldAllowanceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field allowance_id", values[i])
+			} else if value.Valid {
+				_m.AllowanceID = value.String
+			}
+		case allowancetransaction.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = uint32(value.Int64)
+			}
+		case allowancetransaction.FieldYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field year", values[i])
+			} else if value.Valid {
+				_m.Year = int(value.Int64)
+			}
+		case allowancetransaction.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = allowancetransaction.Kind(value.String)
+			}
+		case allowancetransaction.FieldDays:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field days", values[i])
+			} else if value.Valid {
+				_m.Days = value.Float64
+			}
+		case allowancetransaction.FieldBalanceAfter:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_after", values[i])
+			} else if value.Valid {
+				_m.BalanceAfter = value.Float64
+			}
+		case allowancetransaction.FieldLeaveRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field leave_request_id", values[i])
+			} else if value.Valid {
+				_m.LeaveRequestID = new(string)
+				*_m.LeaveRequestID = value.String
+			}
+		case allowancetransaction.FieldActorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				_m.ActorID = uint32(value.Int64)
+			}
+		case allowancetransaction.FieldActorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_name", values[i])
+			} else if value.Valid {
+				_m.ActorName = value.String
+			}
+		case allowancetransaction.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AllowanceTransaction.
+// This includes values selected through modifiers, order, etc.
+func (_m *AllowanceTransaction) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AllowanceTransaction.
+// Note that you need to call AllowanceTransaction.Unwrap() before calling this method if this AllowanceTransaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AllowanceTransaction) Update() *AllowanceTransactionUpdateOne {
+	return NewAllowanceTransactionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AllowanceTransaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AllowanceTransaction) Unwrap() *AllowanceTransaction {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AllowanceTransaction is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AllowanceTransaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("AllowanceTransaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("allowance_id=")
+	builder.WriteString(_m.AllowanceID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("year=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Year))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Days))
+	builder.WriteString(", ")
+	builder.WriteString("balance_after=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BalanceAfter))
+	builder.WriteString(", ")
+	if v := _m.LeaveRequestID; v != nil {
+		builder.WriteString("leave_request_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("actor_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ActorID))
+	builder.WriteString(", ")
+	builder.WriteString("actor_name=")
+	builder.WriteString(_m.ActorName)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AllowanceTransactions is a parsable slice of AllowanceTransaction.
+type AllowanceTransactions []*AllowanceTransaction
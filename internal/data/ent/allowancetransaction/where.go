@@ -0,0 +1,865 @@
+// Code generated by ent, DO NOT EDIT.
+
+package allowancetransaction
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldTenantID, v))
+}
+
+// AllowanceID applies equality check predicate on the "allowance_id" field. It's identical to AllowanceIDEQ.
+func AllowanceID(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldAllowanceID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldUserID, v))
+}
+
+// Year applies equality check predicate on the "year" field. It's identical to YearEQ.
+func Year(v int) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldYear, v))
+}
+
+// Days applies equality check predicate on the "days" field. It's identical to DaysEQ.
+func Days(v float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldDays, v))
+}
+
+// BalanceAfter applies equality check predicate on the "balance_after" field. It's identical to BalanceAfterEQ.
+func BalanceAfter(v float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldBalanceAfter, v))
+}
+
+// LeaveRequestID applies equality check predicate on the "leave_request_id" field. It's identical to LeaveRequestIDEQ.
+func LeaveRequestID(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldLeaveRequestID, v))
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorName applies equality check predicate on the "actor_name" field. It's identical to ActorNameEQ.
+func ActorName(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldActorName, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldNote, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotNull(FieldTenantID))
+}
+
+// AllowanceIDEQ applies the EQ predicate on the "allowance_id" field.
+func AllowanceIDEQ(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldAllowanceID, v))
+}
+
+// AllowanceIDNEQ applies the NEQ predicate on the "allowance_id" field.
+func AllowanceIDNEQ(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldAllowanceID, v))
+}
+
+// AllowanceIDIn applies the In predicate on the "allowance_id" field.
+func AllowanceIDIn(vs ...string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldAllowanceID, vs...))
+}
+
+// AllowanceIDNotIn applies the NotIn predicate on the "allowance_id" field.
+func AllowanceIDNotIn(vs ...string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldAllowanceID, vs...))
+}
+
+// AllowanceIDGT applies the GT predicate on the "allowance_id" field.
+func AllowanceIDGT(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGT(FieldAllowanceID, v))
+}
+
+// AllowanceIDGTE applies the GTE predicate on the "allowance_id" field.
+func AllowanceIDGTE(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGTE(FieldAllowanceID, v))
+}
+
+// AllowanceIDLT applies the LT predicate on the "allowance_id" field.
+func AllowanceIDLT(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLT(FieldAllowanceID, v))
+}
+
+// AllowanceIDLTE applies the LTE predicate on the "allowance_id" field.
+func AllowanceIDLTE(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLTE(FieldAllowanceID, v))
+}
+
+// AllowanceIDContains applies the Contains predicate on the "allowance_id" field.
+func AllowanceIDContains(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldContains(FieldAllowanceID, v))
+}
+
+// AllowanceIDHasPrefix applies the HasPrefix predicate on the "allowance_id" field.
+func AllowanceIDHasPrefix(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldHasPrefix(FieldAllowanceID, v))
+}
+
+// AllowanceIDHasSuffix applies the HasSuffix predicate on the "allowance_id" field.
+func AllowanceIDHasSuffix(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldHasSuffix(FieldAllowanceID, v))
+}
+
+// AllowanceIDEqualFold applies the EqualFold predicate on the "allowance_id" field.
+func AllowanceIDEqualFold(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEqualFold(FieldAllowanceID, v))
+}
+
+// AllowanceIDContainsFold applies the ContainsFold predicate on the "allowance_id" field.
+func AllowanceIDContainsFold(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldContainsFold(FieldAllowanceID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLTE(FieldUserID, v))
+}
+
+// YearEQ applies the EQ predicate on the "year" field.
+func YearEQ(v int) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldYear, v))
+}
+
+// YearNEQ applies the NEQ predicate on the "year" field.
+func YearNEQ(v int) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldYear, v))
+}
+
+// YearIn applies the In predicate on the "year" field.
+func YearIn(vs ...int) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldYear, vs...))
+}
+
+// YearNotIn applies the NotIn predicate on the "year" field.
+func YearNotIn(vs ...int) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldYear, vs...))
+}
+
+// YearGT applies the GT predicate on the "year" field.
+func YearGT(v int) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGT(FieldYear, v))
+}
+
+// YearGTE applies the GTE predicate on the "year" field.
+func YearGTE(v int) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGTE(FieldYear, v))
+}
+
+// YearLT applies the LT predicate on the "year" field.
+func YearLT(v int) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLT(FieldYear, v))
+}
+
+// YearLTE applies the LTE predicate on the "year" field.
+func YearLTE(v int) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLTE(FieldYear, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// DaysEQ applies the EQ predicate on the "days" field.
+func DaysEQ(v float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldDays, v))
+}
+
+// DaysNEQ applies the NEQ predicate on the "days" field.
+func DaysNEQ(v float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldDays, v))
+}
+
+// DaysIn applies the In predicate on the "days" field.
+func DaysIn(vs ...float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldDays, vs...))
+}
+
+// DaysNotIn applies the NotIn predicate on the "days" field.
+func DaysNotIn(vs ...float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldDays, vs...))
+}
+
+// DaysGT applies the GT predicate on the "days" field.
+func DaysGT(v float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGT(FieldDays, v))
+}
+
+// DaysGTE applies the GTE predicate on the "days" field.
+func DaysGTE(v float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGTE(FieldDays, v))
+}
+
+// DaysLT applies the LT predicate on the "days" field.
+func DaysLT(v float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLT(FieldDays, v))
+}
+
+// DaysLTE applies the LTE predicate on the "days" field.
+func DaysLTE(v float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLTE(FieldDays, v))
+}
+
+// BalanceAfterEQ applies the EQ predicate on the "balance_after" field.
+func BalanceAfterEQ(v float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldBalanceAfter, v))
+}
+
+// BalanceAfterNEQ applies the NEQ predicate on the "balance_after" field.
+func BalanceAfterNEQ(v float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldBalanceAfter, v))
+}
+
+// BalanceAfterIn applies the In predicate on the "balance_after" field.
+func BalanceAfterIn(vs ...float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldBalanceAfter, vs...))
+}
+
+// BalanceAfterNotIn applies the NotIn predicate on the "balance_after" field.
+func BalanceAfterNotIn(vs ...float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldBalanceAfter, vs...))
+}
+
+// BalanceAfterGT applies the GT predicate on the "balance_after" field.
+func BalanceAfterGT(v float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGT(FieldBalanceAfter, v))
+}
+
+// BalanceAfterGTE applies the GTE predicate on the "balance_after" field.
+func BalanceAfterGTE(v float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGTE(FieldBalanceAfter, v))
+}
+
+// BalanceAfterLT applies the LT predicate on the "balance_after" field.
+func BalanceAfterLT(v float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLT(FieldBalanceAfter, v))
+}
+
+// BalanceAfterLTE applies the LTE predicate on the "balance_after" field.
+func BalanceAfterLTE(v float64) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLTE(FieldBalanceAfter, v))
+}
+
+// LeaveRequestIDEQ applies the EQ predicate on the "leave_request_id" field.
+func LeaveRequestIDEQ(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDNEQ applies the NEQ predicate on the "leave_request_id" field.
+func LeaveRequestIDNEQ(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDIn applies the In predicate on the "leave_request_id" field.
+func LeaveRequestIDIn(vs ...string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldLeaveRequestID, vs...))
+}
+
+// LeaveRequestIDNotIn applies the NotIn predicate on the "leave_request_id" field.
+func LeaveRequestIDNotIn(vs ...string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldLeaveRequestID, vs...))
+}
+
+// LeaveRequestIDGT applies the GT predicate on the "leave_request_id" field.
+func LeaveRequestIDGT(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGT(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDGTE applies the GTE predicate on the "leave_request_id" field.
+func LeaveRequestIDGTE(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGTE(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDLT applies the LT predicate on the "leave_request_id" field.
+func LeaveRequestIDLT(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLT(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDLTE applies the LTE predicate on the "leave_request_id" field.
+func LeaveRequestIDLTE(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLTE(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDContains applies the Contains predicate on the "leave_request_id" field.
+func LeaveRequestIDContains(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldContains(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDHasPrefix applies the HasPrefix predicate on the "leave_request_id" field.
+func LeaveRequestIDHasPrefix(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldHasPrefix(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDHasSuffix applies the HasSuffix predicate on the "leave_request_id" field.
+func LeaveRequestIDHasSuffix(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldHasSuffix(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDIsNil applies the IsNil predicate on the "leave_request_id" field.
+func LeaveRequestIDIsNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIsNull(FieldLeaveRequestID))
+}
+
+// LeaveRequestIDNotNil applies the NotNil predicate on the "leave_request_id" field.
+func LeaveRequestIDNotNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotNull(FieldLeaveRequestID))
+}
+
+// LeaveRequestIDEqualFold applies the EqualFold predicate on the "leave_request_id" field.
+func LeaveRequestIDEqualFold(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEqualFold(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDContainsFold applies the ContainsFold predicate on the "leave_request_id" field.
+func LeaveRequestIDContainsFold(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldContainsFold(FieldLeaveRequestID, v))
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldActorID, v))
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldActorID, vs...))
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldActorID, vs...))
+}
+
+// ActorIDGT applies the GT predicate on the "actor_id" field.
+func ActorIDGT(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGT(FieldActorID, v))
+}
+
+// ActorIDGTE applies the GTE predicate on the "actor_id" field.
+func ActorIDGTE(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGTE(FieldActorID, v))
+}
+
+// ActorIDLT applies the LT predicate on the "actor_id" field.
+func ActorIDLT(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLT(FieldActorID, v))
+}
+
+// ActorIDLTE applies the LTE predicate on the "actor_id" field.
+func ActorIDLTE(v uint32) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLTE(FieldActorID, v))
+}
+
+// ActorIDIsNil applies the IsNil predicate on the "actor_id" field.
+func ActorIDIsNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIsNull(FieldActorID))
+}
+
+// ActorIDNotNil applies the NotNil predicate on the "actor_id" field.
+func ActorIDNotNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotNull(FieldActorID))
+}
+
+// ActorNameEQ applies the EQ predicate on the "actor_name" field.
+func ActorNameEQ(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldActorName, v))
+}
+
+// ActorNameNEQ applies the NEQ predicate on the "actor_name" field.
+func ActorNameNEQ(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldActorName, v))
+}
+
+// ActorNameIn applies the In predicate on the "actor_name" field.
+func ActorNameIn(vs ...string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldActorName, vs...))
+}
+
+// ActorNameNotIn applies the NotIn predicate on the "actor_name" field.
+func ActorNameNotIn(vs ...string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldActorName, vs...))
+}
+
+// ActorNameGT applies the GT predicate on the "actor_name" field.
+func ActorNameGT(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGT(FieldActorName, v))
+}
+
+// ActorNameGTE applies the GTE predicate on the "actor_name" field.
+func ActorNameGTE(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGTE(FieldActorName, v))
+}
+
+// ActorNameLT applies the LT predicate on the "actor_name" field.
+func ActorNameLT(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLT(FieldActorName, v))
+}
+
+// ActorNameLTE applies the LTE predicate on the "actor_name" field.
+func ActorNameLTE(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLTE(FieldActorName, v))
+}
+
+// ActorNameContains applies the Contains predicate on the "actor_name" field.
+func ActorNameContains(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldContains(FieldActorName, v))
+}
+
+// ActorNameHasPrefix applies the HasPrefix predicate on the "actor_name" field.
+func ActorNameHasPrefix(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldHasPrefix(FieldActorName, v))
+}
+
+// ActorNameHasSuffix applies the HasSuffix predicate on the "actor_name" field.
+func ActorNameHasSuffix(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldHasSuffix(FieldActorName, v))
+}
+
+// ActorNameIsNil applies the IsNil predicate on the "actor_name" field.
+func ActorNameIsNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIsNull(FieldActorName))
+}
+
+// ActorNameNotNil applies the NotNil predicate on the "actor_name" field.
+func ActorNameNotNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotNull(FieldActorName))
+}
+
+// ActorNameEqualFold applies the EqualFold predicate on the "actor_name" field.
+func ActorNameEqualFold(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEqualFold(FieldActorName, v))
+}
+
+// ActorNameContainsFold applies the ContainsFold predicate on the "actor_name" field.
+func ActorNameContainsFold(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldContainsFold(FieldActorName, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.FieldContainsFold(FieldNote, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AllowanceTransaction) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AllowanceTransaction) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AllowanceTransaction) predicate.AllowanceTransaction {
+	return predicate.AllowanceTransaction(sql.NotPredicates(p))
+}
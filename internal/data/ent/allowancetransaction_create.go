@@ -0,0 +1,887 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancetransaction"
+)
+
+// AllowanceTransactionCreate is the builder for creating a AllowanceTransaction entity.
+type AllowanceTransactionCreate struct {
+	config
+	mutation *AllowanceTransactionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *AllowanceTransactionCreate) SetCreateTime(v time.Time) *AllowanceTransactionCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *AllowanceTransactionCreate) SetNillableCreateTime(v *time.Time) *AllowanceTransactionCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *AllowanceTransactionCreate) SetUpdateTime(v time.Time) *AllowanceTransactionCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *AllowanceTransactionCreate) SetNillableUpdateTime(v *time.Time) *AllowanceTransactionCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *AllowanceTransactionCreate) SetDeleteTime(v time.Time) *AllowanceTransactionCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *AllowanceTransactionCreate) SetNillableDeleteTime(v *time.Time) *AllowanceTransactionCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *AllowanceTransactionCreate) SetTenantID(v uint32) *AllowanceTransactionCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *AllowanceTransactionCreate) SetNillableTenantID(v *uint32) *AllowanceTransactionCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetAllowanceID sets the "allowance_id" field.
+func (_c *AllowanceTransactionCreate) SetAllowanceID(v string) *AllowanceTransactionCreate {
+	_c.mutation.SetAllowanceID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AllowanceTransactionCreate) SetUserID(v uint32) *AllowanceTransactionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetYear sets the "year" field.
+func (_c *AllowanceTransactionCreate) SetYear(v int) *AllowanceTransactionCreate {
+	_c.mutation.SetYear(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *AllowanceTransactionCreate) SetKind(v allowancetransaction.Kind) *AllowanceTransactionCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetDays sets the "days" field.
+func (_c *AllowanceTransactionCreate) SetDays(v float64) *AllowanceTransactionCreate {
+	_c.mutation.SetDays(v)
+	return _c
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (_c *AllowanceTransactionCreate) SetBalanceAfter(v float64) *AllowanceTransactionCreate {
+	_c.mutation.SetBalanceAfter(v)
+	return _c
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (_c *AllowanceTransactionCreate) SetLeaveRequestID(v string) *AllowanceTransactionCreate {
+	_c.mutation.SetLeaveRequestID(v)
+	return _c
+}
+
+// SetNillableLeaveRequestID sets the "leave_request_id" field if the given value is not nil.
+func (_c *AllowanceTransactionCreate) SetNillableLeaveRequestID(v *string) *AllowanceTransactionCreate {
+	if v != nil {
+		_c.SetLeaveRequestID(*v)
+	}
+	return _c
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *AllowanceTransactionCreate) SetActorID(v uint32) *AllowanceTransactionCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_c *AllowanceTransactionCreate) SetNillableActorID(v *uint32) *AllowanceTransactionCreate {
+	if v != nil {
+		_c.SetActorID(*v)
+	}
+	return _c
+}
+
+// SetActorName sets the "actor_name" field.
+func (_c *AllowanceTransactionCreate) SetActorName(v string) *AllowanceTransactionCreate {
+	_c.mutation.SetActorName(v)
+	return _c
+}
+
+// SetNillableActorName sets the "actor_name" field if the given value is not nil.
+func (_c *AllowanceTransactionCreate) SetNillableActorName(v *string) *AllowanceTransactionCreate {
+	if v != nil {
+		_c.SetActorName(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *AllowanceTransactionCreate) SetNote(v string) *AllowanceTransactionCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *AllowanceTransactionCreate) SetNillableNote(v *string) *AllowanceTransactionCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AllowanceTransactionCreate) SetID(v string) *AllowanceTransactionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the AllowanceTransactionMutation object of the builder.
+func (_c *AllowanceTransactionCreate) Mutation() *AllowanceTransactionMutation {
+	return _c.mutation
+}
+
+// Save creates the AllowanceTransaction in the database.
+func (_c *AllowanceTransactionCreate) Save(ctx context.Context) (*AllowanceTransaction, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AllowanceTransactionCreate) SaveX(ctx context.Context) *AllowanceTransaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AllowanceTransactionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AllowanceTransactionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AllowanceTransactionCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := allowancetransaction.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.ActorID(); !ok {
+		v := allowancetransaction.DefaultActorID
+		_c.mutation.SetActorID(v)
+	}
+	if _, ok := _c.mutation.ActorName(); !ok {
+		v := allowancetransaction.DefaultActorName
+		_c.mutation.SetActorName(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AllowanceTransactionCreate) check() error {
+	if _, ok := _c.mutation.AllowanceID(); !ok {
+		return &ValidationError{Name: "allowance_id", err: errors.New(`ent: missing required field "AllowanceTransaction.allowance_id"`)}
+	}
+	if v, ok := _c.mutation.AllowanceID(); ok {
+		if err := allowancetransaction.AllowanceIDValidator(v); err != nil {
+			return &ValidationError{Name: "allowance_id", err: fmt.Errorf(`ent: validator failed for field "AllowanceTransaction.allowance_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AllowanceTransaction.user_id"`)}
+	}
+	if _, ok := _c.mutation.Year(); !ok {
+		return &ValidationError{Name: "year", err: errors.New(`ent: missing required field "AllowanceTransaction.year"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "AllowanceTransaction.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := allowancetransaction.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "AllowanceTransaction.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Days(); !ok {
+		return &ValidationError{Name: "days", err: errors.New(`ent: missing required field "AllowanceTransaction.days"`)}
+	}
+	if _, ok := _c.mutation.BalanceAfter(); !ok {
+		return &ValidationError{Name: "balance_after", err: errors.New(`ent: missing required field "AllowanceTransaction.balance_after"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := allowancetransaction.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AllowanceTransaction.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *AllowanceTransactionCreate) sqlSave(ctx context.Context) (*AllowanceTransaction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AllowanceTransaction.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AllowanceTransactionCreate) createSpec() (*AllowanceTransaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AllowanceTransaction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(allowancetransaction.Table, sqlgraph.NewFieldSpec(allowancetransaction.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(allowancetransaction.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(allowancetransaction.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(allowancetransaction.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(allowancetransaction.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.AllowanceID(); ok {
+		_spec.SetField(allowancetransaction.FieldAllowanceID, field.TypeString, value)
+		_node.AllowanceID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(allowancetransaction.FieldUserID, field.TypeUint32, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Year(); ok {
+		_spec.SetField(allowancetransaction.FieldYear, field.TypeInt, value)
+		_node.Year = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(allowancetransaction.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Days(); ok {
+		_spec.SetField(allowancetransaction.FieldDays, field.TypeFloat64, value)
+		_node.Days = value
+	}
+	if value, ok := _c.mutation.BalanceAfter(); ok {
+		_spec.SetField(allowancetransaction.FieldBalanceAfter, field.TypeFloat64, value)
+		_node.BalanceAfter = value
+	}
+	if value, ok := _c.mutation.LeaveRequestID(); ok {
+		_spec.SetField(allowancetransaction.FieldLeaveRequestID, field.TypeString, value)
+		_node.LeaveRequestID = &value
+	}
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(allowancetransaction.FieldActorID, field.TypeUint32, value)
+		_node.ActorID = value
+	}
+	if value, ok := _c.mutation.ActorName(); ok {
+		_spec.SetField(allowancetransaction.FieldActorName, field.TypeString, value)
+		_node.ActorName = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(allowancetransaction.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AllowanceTransaction.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AllowanceTransactionUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AllowanceTransactionCreate) OnConflict(opts ...sql.ConflictOption) *AllowanceTransactionUpsertOne {
+	_c.conflict = opts
+	return &AllowanceTransactionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AllowanceTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AllowanceTransactionCreate) OnConflictColumns(columns ...string) *AllowanceTransactionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AllowanceTransactionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AllowanceTransactionUpsertOne is the builder for "upsert"-ing
+	//  one AllowanceTransaction node.
+	AllowanceTransactionUpsertOne struct {
+		create *AllowanceTransactionCreate
+	}
+
+	// AllowanceTransactionUpsert is the "OnConflict" setter.
+	AllowanceTransactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AllowanceTransactionUpsert) SetUpdateTime(v time.Time) *AllowanceTransactionUpsert {
+	u.Set(allowancetransaction.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AllowanceTransactionUpsert) UpdateUpdateTime() *AllowanceTransactionUpsert {
+	u.SetExcluded(allowancetransaction.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *AllowanceTransactionUpsert) ClearUpdateTime() *AllowanceTransactionUpsert {
+	u.SetNull(allowancetransaction.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *AllowanceTransactionUpsert) SetDeleteTime(v time.Time) *AllowanceTransactionUpsert {
+	u.Set(allowancetransaction.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *AllowanceTransactionUpsert) UpdateDeleteTime() *AllowanceTransactionUpsert {
+	u.SetExcluded(allowancetransaction.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *AllowanceTransactionUpsert) ClearDeleteTime() *AllowanceTransactionUpsert {
+	u.SetNull(allowancetransaction.FieldDeleteTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AllowanceTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(allowancetransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AllowanceTransactionUpsertOne) UpdateNewValues() *AllowanceTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(allowancetransaction.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(allowancetransaction.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(allowancetransaction.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.AllowanceID(); exists {
+			s.SetIgnore(allowancetransaction.FieldAllowanceID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(allowancetransaction.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Year(); exists {
+			s.SetIgnore(allowancetransaction.FieldYear)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(allowancetransaction.FieldKind)
+		}
+		if _, exists := u.create.mutation.Days(); exists {
+			s.SetIgnore(allowancetransaction.FieldDays)
+		}
+		if _, exists := u.create.mutation.BalanceAfter(); exists {
+			s.SetIgnore(allowancetransaction.FieldBalanceAfter)
+		}
+		if _, exists := u.create.mutation.LeaveRequestID(); exists {
+			s.SetIgnore(allowancetransaction.FieldLeaveRequestID)
+		}
+		if _, exists := u.create.mutation.ActorID(); exists {
+			s.SetIgnore(allowancetransaction.FieldActorID)
+		}
+		if _, exists := u.create.mutation.ActorName(); exists {
+			s.SetIgnore(allowancetransaction.FieldActorName)
+		}
+		if _, exists := u.create.mutation.Note(); exists {
+			s.SetIgnore(allowancetransaction.FieldNote)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AllowanceTransaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AllowanceTransactionUpsertOne) Ignore() *AllowanceTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AllowanceTransactionUpsertOne) DoNothing() *AllowanceTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AllowanceTransactionCreate.OnConflict
+// documentation for more info.
+func (u *AllowanceTransactionUpsertOne) Update(set func(*AllowanceTransactionUpsert)) *AllowanceTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AllowanceTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AllowanceTransactionUpsertOne) SetUpdateTime(v time.Time) *AllowanceTransactionUpsertOne {
+	return u.Update(func(s *AllowanceTransactionUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AllowanceTransactionUpsertOne) UpdateUpdateTime() *AllowanceTransactionUpsertOne {
+	return u.Update(func(s *AllowanceTransactionUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *AllowanceTransactionUpsertOne) ClearUpdateTime() *AllowanceTransactionUpsertOne {
+	return u.Update(func(s *AllowanceTransactionUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *AllowanceTransactionUpsertOne) SetDeleteTime(v time.Time) *AllowanceTransactionUpsertOne {
+	return u.Update(func(s *AllowanceTransactionUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *AllowanceTransactionUpsertOne) UpdateDeleteTime() *AllowanceTransactionUpsertOne {
+	return u.Update(func(s *AllowanceTransactionUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *AllowanceTransactionUpsertOne) ClearDeleteTime() *AllowanceTransactionUpsertOne {
+	return u.Update(func(s *AllowanceTransactionUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// Exec executes the query.
+func (u *AllowanceTransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AllowanceTransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AllowanceTransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AllowanceTransactionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AllowanceTransactionUpsertOne.ID is not supported by MySQL driver. Use AllowanceTransactionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AllowanceTransactionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AllowanceTransactionCreateBulk is the builder for creating many AllowanceTransaction entities in bulk.
+type AllowanceTransactionCreateBulk struct {
+	config
+	err      error
+	builders []*AllowanceTransactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AllowanceTransaction entities in the database.
+func (_c *AllowanceTransactionCreateBulk) Save(ctx context.Context) ([]*AllowanceTransaction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AllowanceTransaction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AllowanceTransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AllowanceTransactionCreateBulk) SaveX(ctx context.Context) []*AllowanceTransaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AllowanceTransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AllowanceTransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AllowanceTransaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AllowanceTransactionUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AllowanceTransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *AllowanceTransactionUpsertBulk {
+	_c.conflict = opts
+	return &AllowanceTransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AllowanceTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AllowanceTransactionCreateBulk) OnConflictColumns(columns ...string) *AllowanceTransactionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AllowanceTransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// AllowanceTransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of AllowanceTransaction nodes.
+type AllowanceTransactionUpsertBulk struct {
+	create *AllowanceTransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AllowanceTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(allowancetransaction.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AllowanceTransactionUpsertBulk) UpdateNewValues() *AllowanceTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(allowancetransaction.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(allowancetransaction.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(allowancetransaction.FieldTenantID)
+			}
+			if _, exists := b.mutation.AllowanceID(); exists {
+				s.SetIgnore(allowancetransaction.FieldAllowanceID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(allowancetransaction.FieldUserID)
+			}
+			if _, exists := b.mutation.Year(); exists {
+				s.SetIgnore(allowancetransaction.FieldYear)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(allowancetransaction.FieldKind)
+			}
+			if _, exists := b.mutation.Days(); exists {
+				s.SetIgnore(allowancetransaction.FieldDays)
+			}
+			if _, exists := b.mutation.BalanceAfter(); exists {
+				s.SetIgnore(allowancetransaction.FieldBalanceAfter)
+			}
+			if _, exists := b.mutation.LeaveRequestID(); exists {
+				s.SetIgnore(allowancetransaction.FieldLeaveRequestID)
+			}
+			if _, exists := b.mutation.ActorID(); exists {
+				s.SetIgnore(allowancetransaction.FieldActorID)
+			}
+			if _, exists := b.mutation.ActorName(); exists {
+				s.SetIgnore(allowancetransaction.FieldActorName)
+			}
+			if _, exists := b.mutation.Note(); exists {
+				s.SetIgnore(allowancetransaction.FieldNote)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AllowanceTransaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AllowanceTransactionUpsertBulk) Ignore() *AllowanceTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AllowanceTransactionUpsertBulk) DoNothing() *AllowanceTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AllowanceTransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *AllowanceTransactionUpsertBulk) Update(set func(*AllowanceTransactionUpsert)) *AllowanceTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AllowanceTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *AllowanceTransactionUpsertBulk) SetUpdateTime(v time.Time) *AllowanceTransactionUpsertBulk {
+	return u.Update(func(s *AllowanceTransactionUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *AllowanceTransactionUpsertBulk) UpdateUpdateTime() *AllowanceTransactionUpsertBulk {
+	return u.Update(func(s *AllowanceTransactionUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *AllowanceTransactionUpsertBulk) ClearUpdateTime() *AllowanceTransactionUpsertBulk {
+	return u.Update(func(s *AllowanceTransactionUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *AllowanceTransactionUpsertBulk) SetDeleteTime(v time.Time) *AllowanceTransactionUpsertBulk {
+	return u.Update(func(s *AllowanceTransactionUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *AllowanceTransactionUpsertBulk) UpdateDeleteTime() *AllowanceTransactionUpsertBulk {
+	return u.Update(func(s *AllowanceTransactionUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *AllowanceTransactionUpsertBulk) ClearDeleteTime() *AllowanceTransactionUpsertBulk {
+	return u.Update(func(s *AllowanceTransactionUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// Exec executes the query.
+func (u *AllowanceTransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AllowanceTransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AllowanceTransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AllowanceTransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancetransaction"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// AllowanceTransactionUpdate is the builder for updating AllowanceTransaction entities.
+type AllowanceTransactionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AllowanceTransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AllowanceTransactionUpdate builder.
+func (_u *AllowanceTransactionUpdate) Where(ps ...predicate.AllowanceTransaction) *AllowanceTransactionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *AllowanceTransactionUpdate) SetUpdateTime(v time.Time) *AllowanceTransactionUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *AllowanceTransactionUpdate) SetNillableUpdateTime(v *time.Time) *AllowanceTransactionUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *AllowanceTransactionUpdate) ClearUpdateTime() *AllowanceTransactionUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *AllowanceTransactionUpdate) SetDeleteTime(v time.Time) *AllowanceTransactionUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *AllowanceTransactionUpdate) SetNillableDeleteTime(v *time.Time) *AllowanceTransactionUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *AllowanceTransactionUpdate) ClearDeleteTime() *AllowanceTransactionUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// Mutation returns the AllowanceTransactionMutation object of the builder.
+func (_u *AllowanceTransactionUpdate) Mutation() *AllowanceTransactionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AllowanceTransactionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AllowanceTransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AllowanceTransactionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AllowanceTransactionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AllowanceTransactionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AllowanceTransactionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AllowanceTransactionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(allowancetransaction.Table, allowancetransaction.Columns, sqlgraph.NewFieldSpec(allowancetransaction.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(allowancetransaction.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(allowancetransaction.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(allowancetransaction.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(allowancetransaction.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(allowancetransaction.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(allowancetransaction.FieldTenantID, field.TypeUint32)
+	}
+	if _u.mutation.LeaveRequestIDCleared() {
+		_spec.ClearField(allowancetransaction.FieldLeaveRequestID, field.TypeString)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(allowancetransaction.FieldActorID, field.TypeUint32)
+	}
+	if _u.mutation.ActorNameCleared() {
+		_spec.ClearField(allowancetransaction.FieldActorName, field.TypeString)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(allowancetransaction.FieldNote, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{allowancetransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AllowanceTransactionUpdateOne is the builder for updating a single AllowanceTransaction entity.
+type AllowanceTransactionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AllowanceTransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *AllowanceTransactionUpdateOne) SetUpdateTime(v time.Time) *AllowanceTransactionUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *AllowanceTransactionUpdateOne) SetNillableUpdateTime(v *time.Time) *AllowanceTransactionUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *AllowanceTransactionUpdateOne) ClearUpdateTime() *AllowanceTransactionUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *AllowanceTransactionUpdateOne) SetDeleteTime(v time.Time) *AllowanceTransactionUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *AllowanceTransactionUpdateOne) SetNillableDeleteTime(v *time.Time) *AllowanceTransactionUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *AllowanceTransactionUpdateOne) ClearDeleteTime() *AllowanceTransactionUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// Mutation returns the AllowanceTransactionMutation object of the builder.
+func (_u *AllowanceTransactionUpdateOne) Mutation() *AllowanceTransactionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AllowanceTransactionUpdate builder.
+func (_u *AllowanceTransactionUpdateOne) Where(ps ...predicate.AllowanceTransaction) *AllowanceTransactionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AllowanceTransactionUpdateOne) Select(field string, fields ...string) *AllowanceTransactionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AllowanceTransaction entity.
+func (_u *AllowanceTransactionUpdateOne) Save(ctx context.Context) (*AllowanceTransaction, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AllowanceTransactionUpdateOne) SaveX(ctx context.Context) *AllowanceTransaction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AllowanceTransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AllowanceTransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AllowanceTransactionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AllowanceTransactionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AllowanceTransactionUpdateOne) sqlSave(ctx context.Context) (_node *AllowanceTransaction, err error) {
+	_spec := sqlgraph.NewUpdateSpec(allowancetransaction.Table, allowancetransaction.Columns, sqlgraph.NewFieldSpec(allowancetransaction.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AllowanceTransaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, allowancetransaction.FieldID)
+		for _, f := range fields {
+			if !allowancetransaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != allowancetransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(allowancetransaction.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(allowancetransaction.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(allowancetransaction.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(allowancetransaction.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(allowancetransaction.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(allowancetransaction.FieldTenantID, field.TypeUint32)
+	}
+	if _u.mutation.LeaveRequestIDCleared() {
+		_spec.ClearField(allowancetransaction.FieldLeaveRequestID, field.TypeString)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(allowancetransaction.FieldActorID, field.TypeUint32)
+	}
+	if _u.mutation.ActorNameCleared() {
+		_spec.ClearField(allowancetransaction.FieldActorName, field.TypeString)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(allowancetransaction.FieldNote, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &AllowanceTransaction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{allowancetransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/approvaldelegation"
+)
+
+// ApprovalDelegation is the model entity for the ApprovalDelegation schema.
+type ApprovalDelegation struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique identifier
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 更新者ID
+	UpdateBy *uint32 `json:"update_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// User ID of the approver who delegates
+	DelegatorID uint32 `json:"delegator_id,omitempty"`
+	// Denormalized approver display name
+	DelegatorName string `json:"delegator_name,omitempty"`
+	// User ID of the user who decides in place of the approver
+	DelegateID uint32 `json:"delegate_id,omitempty"`
+	// Denormalized delegate display name
+	DelegateName string `json:"delegate_name,omitempty"`
+	// First day of the delegation
+	StartDate time.Time `json:"start_date,omitempty"`
+	// Last day of the delegation
+	EndDate time.Time `json:"end_date,omitempty"`
+	// Absence types the delegation covers; empty covers all types
+	AbsenceTypeIds []string `json:"absence_type_ids,omitempty"`
+	// Leave request of the approver the delegation was created for; empty when created manually
+	LeaveRequestID string `json:"leave_request_id,omitempty"`
+	// Notes
+	Notes        string `json:"notes,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ApprovalDelegation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case approvaldelegation.FieldAbsenceTypeIds:
+			values[i] = new([]byte)
+		case approvaldelegation.FieldCreateBy, approvaldelegation.FieldUpdateBy, approvaldelegation.FieldTenantID, approvaldelegation.FieldDelegatorID, approvaldelegation.FieldDelegateID:
+			values[i] = new(sql.NullInt64)
+		case approvaldelegation.FieldID, approvaldelegation.FieldDelegatorName, approvaldelegation.FieldDelegateName, approvaldelegation.FieldLeaveRequestID, approvaldelegation.FieldNotes:
+			values[i] = new(sql.NullString)
+		case approvaldelegation.FieldCreateTime, approvaldelegation.FieldUpdateTime, approvaldelegation.FieldDeleteTime, approvaldelegation.FieldStartDate, approvaldelegation.FieldEndDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ApprovalDelegation fields.
+func (_m *ApprovalDelegation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case approvaldelegation.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case approvaldelegation.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case approvaldelegation.FieldUpdateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_by", values[i])
+			} else if value.Valid {
+				_m.UpdateBy = new(uint32)
+				*_m.UpdateBy = uint32(value.Int64)
+			}
+		case approvaldelegation.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case approvaldelegation.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case approvaldelegation.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case approvaldelegation.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case approvaldelegation.FieldDelegatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delegator_id", values[i])
+			} else if value.Valid {
+				_m.DelegatorID = uint32(value.Int64)
+			}
+		case approvaldelegation.FieldDelegatorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delegator_name", values[i])
+			} else if value.Valid {
+				_m.DelegatorName = value.String
+			}
+		case approvaldelegation.FieldDelegateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delegate_id", values[i])
+			} else if value.Valid {
+				_m.DelegateID = uint32(value.Int64)
+			}
+		case approvaldelegation.FieldDelegateName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delegate_name", values[i])
+			} else if value.Valid {
+				_m.DelegateName = value.String
+			}
+		case approvaldelegation.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				_m.StartDate = value.Time
+			}
+		case approvaldelegation.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				_m.EndDate = value.Time
+			}
+		case approvaldelegation.FieldAbsenceTypeIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field absence_type_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AbsenceTypeIds); err != nil {
+					return fmt.Errorf("unmarshal field absence_type_ids: %w", err)
+				}
+			}
+		case approvaldelegation.FieldLeaveRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field leave_request_id", values[i])
+			} else if value.Valid {
+				_m.LeaveRequestID = value.String
+			}
+		case approvaldelegation.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ApprovalDelegation.
+// This includes values selected through modifiers, order, etc.
+func (_m *ApprovalDelegation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ApprovalDelegation.
+// Note that you need to call ApprovalDelegation.Unwrap() before calling this method if this ApprovalDelegation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ApprovalDelegation) Update() *ApprovalDelegationUpdateOne {
+	return NewApprovalDelegationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ApprovalDelegation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ApprovalDelegation) Unwrap() *ApprovalDelegation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ApprovalDelegation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ApprovalDelegation) String() string {
+	var builder strings.Builder
+	builder.WriteString("ApprovalDelegation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateBy; v != nil {
+		builder.WriteString("update_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("delegator_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DelegatorID))
+	builder.WriteString(", ")
+	builder.WriteString("delegator_name=")
+	builder.WriteString(_m.DelegatorName)
+	builder.WriteString(", ")
+	builder.WriteString("delegate_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DelegateID))
+	builder.WriteString(", ")
+	builder.WriteString("delegate_name=")
+	builder.WriteString(_m.DelegateName)
+	builder.WriteString(", ")
+	builder.WriteString("start_date=")
+	builder.WriteString(_m.StartDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_date=")
+	builder.WriteString(_m.EndDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("absence_type_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AbsenceTypeIds))
+	builder.WriteString(", ")
+	builder.WriteString("leave_request_id=")
+	builder.WriteString(_m.LeaveRequestID)
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(_m.Notes)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ApprovalDelegations is a parsable slice of ApprovalDelegation.
+type ApprovalDelegations []*ApprovalDelegation
@@ -0,0 +1,920 @@
+// Code generated by ent, DO NOT EDIT.
+
+package approvaldelegation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// UpdateBy applies equality check predicate on the "update_by" field. It's identical to UpdateByEQ.
+func UpdateBy(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldTenantID, v))
+}
+
+// DelegatorID applies equality check predicate on the "delegator_id" field. It's identical to DelegatorIDEQ.
+func DelegatorID(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldDelegatorID, v))
+}
+
+// DelegatorName applies equality check predicate on the "delegator_name" field. It's identical to DelegatorNameEQ.
+func DelegatorName(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldDelegatorName, v))
+}
+
+// DelegateID applies equality check predicate on the "delegate_id" field. It's identical to DelegateIDEQ.
+func DelegateID(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldDelegateID, v))
+}
+
+// DelegateName applies equality check predicate on the "delegate_name" field. It's identical to DelegateNameEQ.
+func DelegateName(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldDelegateName, v))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldStartDate, v))
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldEndDate, v))
+}
+
+// LeaveRequestID applies equality check predicate on the "leave_request_id" field. It's identical to LeaveRequestIDEQ.
+func LeaveRequestID(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldLeaveRequestID, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldNotes, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotNull(FieldCreateBy))
+}
+
+// UpdateByEQ applies the EQ predicate on the "update_by" field.
+func UpdateByEQ(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// UpdateByNEQ applies the NEQ predicate on the "update_by" field.
+func UpdateByNEQ(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldUpdateBy, v))
+}
+
+// UpdateByIn applies the In predicate on the "update_by" field.
+func UpdateByIn(vs ...uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByNotIn applies the NotIn predicate on the "update_by" field.
+func UpdateByNotIn(vs ...uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByGT applies the GT predicate on the "update_by" field.
+func UpdateByGT(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldUpdateBy, v))
+}
+
+// UpdateByGTE applies the GTE predicate on the "update_by" field.
+func UpdateByGTE(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldUpdateBy, v))
+}
+
+// UpdateByLT applies the LT predicate on the "update_by" field.
+func UpdateByLT(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldUpdateBy, v))
+}
+
+// UpdateByLTE applies the LTE predicate on the "update_by" field.
+func UpdateByLTE(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldUpdateBy, v))
+}
+
+// UpdateByIsNil applies the IsNil predicate on the "update_by" field.
+func UpdateByIsNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIsNull(FieldUpdateBy))
+}
+
+// UpdateByNotNil applies the NotNil predicate on the "update_by" field.
+func UpdateByNotNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotNull(FieldUpdateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotNull(FieldTenantID))
+}
+
+// DelegatorIDEQ applies the EQ predicate on the "delegator_id" field.
+func DelegatorIDEQ(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldDelegatorID, v))
+}
+
+// DelegatorIDNEQ applies the NEQ predicate on the "delegator_id" field.
+func DelegatorIDNEQ(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldDelegatorID, v))
+}
+
+// DelegatorIDIn applies the In predicate on the "delegator_id" field.
+func DelegatorIDIn(vs ...uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldDelegatorID, vs...))
+}
+
+// DelegatorIDNotIn applies the NotIn predicate on the "delegator_id" field.
+func DelegatorIDNotIn(vs ...uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldDelegatorID, vs...))
+}
+
+// DelegatorIDGT applies the GT predicate on the "delegator_id" field.
+func DelegatorIDGT(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldDelegatorID, v))
+}
+
+// DelegatorIDGTE applies the GTE predicate on the "delegator_id" field.
+func DelegatorIDGTE(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldDelegatorID, v))
+}
+
+// DelegatorIDLT applies the LT predicate on the "delegator_id" field.
+func DelegatorIDLT(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldDelegatorID, v))
+}
+
+// DelegatorIDLTE applies the LTE predicate on the "delegator_id" field.
+func DelegatorIDLTE(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldDelegatorID, v))
+}
+
+// DelegatorNameEQ applies the EQ predicate on the "delegator_name" field.
+func DelegatorNameEQ(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldDelegatorName, v))
+}
+
+// DelegatorNameNEQ applies the NEQ predicate on the "delegator_name" field.
+func DelegatorNameNEQ(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldDelegatorName, v))
+}
+
+// DelegatorNameIn applies the In predicate on the "delegator_name" field.
+func DelegatorNameIn(vs ...string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldDelegatorName, vs...))
+}
+
+// DelegatorNameNotIn applies the NotIn predicate on the "delegator_name" field.
+func DelegatorNameNotIn(vs ...string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldDelegatorName, vs...))
+}
+
+// DelegatorNameGT applies the GT predicate on the "delegator_name" field.
+func DelegatorNameGT(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldDelegatorName, v))
+}
+
+// DelegatorNameGTE applies the GTE predicate on the "delegator_name" field.
+func DelegatorNameGTE(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldDelegatorName, v))
+}
+
+// DelegatorNameLT applies the LT predicate on the "delegator_name" field.
+func DelegatorNameLT(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldDelegatorName, v))
+}
+
+// DelegatorNameLTE applies the LTE predicate on the "delegator_name" field.
+func DelegatorNameLTE(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldDelegatorName, v))
+}
+
+// DelegatorNameContains applies the Contains predicate on the "delegator_name" field.
+func DelegatorNameContains(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldContains(FieldDelegatorName, v))
+}
+
+// DelegatorNameHasPrefix applies the HasPrefix predicate on the "delegator_name" field.
+func DelegatorNameHasPrefix(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldHasPrefix(FieldDelegatorName, v))
+}
+
+// DelegatorNameHasSuffix applies the HasSuffix predicate on the "delegator_name" field.
+func DelegatorNameHasSuffix(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldHasSuffix(FieldDelegatorName, v))
+}
+
+// DelegatorNameIsNil applies the IsNil predicate on the "delegator_name" field.
+func DelegatorNameIsNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIsNull(FieldDelegatorName))
+}
+
+// DelegatorNameNotNil applies the NotNil predicate on the "delegator_name" field.
+func DelegatorNameNotNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotNull(FieldDelegatorName))
+}
+
+// DelegatorNameEqualFold applies the EqualFold predicate on the "delegator_name" field.
+func DelegatorNameEqualFold(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEqualFold(FieldDelegatorName, v))
+}
+
+// DelegatorNameContainsFold applies the ContainsFold predicate on the "delegator_name" field.
+func DelegatorNameContainsFold(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldContainsFold(FieldDelegatorName, v))
+}
+
+// DelegateIDEQ applies the EQ predicate on the "delegate_id" field.
+func DelegateIDEQ(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldDelegateID, v))
+}
+
+// DelegateIDNEQ applies the NEQ predicate on the "delegate_id" field.
+func DelegateIDNEQ(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldDelegateID, v))
+}
+
+// DelegateIDIn applies the In predicate on the "delegate_id" field.
+func DelegateIDIn(vs ...uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldDelegateID, vs...))
+}
+
+// DelegateIDNotIn applies the NotIn predicate on the "delegate_id" field.
+func DelegateIDNotIn(vs ...uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldDelegateID, vs...))
+}
+
+// DelegateIDGT applies the GT predicate on the "delegate_id" field.
+func DelegateIDGT(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldDelegateID, v))
+}
+
+// DelegateIDGTE applies the GTE predicate on the "delegate_id" field.
+func DelegateIDGTE(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldDelegateID, v))
+}
+
+// DelegateIDLT applies the LT predicate on the "delegate_id" field.
+func DelegateIDLT(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldDelegateID, v))
+}
+
+// DelegateIDLTE applies the LTE predicate on the "delegate_id" field.
+func DelegateIDLTE(v uint32) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldDelegateID, v))
+}
+
+// DelegateNameEQ applies the EQ predicate on the "delegate_name" field.
+func DelegateNameEQ(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldDelegateName, v))
+}
+
+// DelegateNameNEQ applies the NEQ predicate on the "delegate_name" field.
+func DelegateNameNEQ(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldDelegateName, v))
+}
+
+// DelegateNameIn applies the In predicate on the "delegate_name" field.
+func DelegateNameIn(vs ...string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldDelegateName, vs...))
+}
+
+// DelegateNameNotIn applies the NotIn predicate on the "delegate_name" field.
+func DelegateNameNotIn(vs ...string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldDelegateName, vs...))
+}
+
+// DelegateNameGT applies the GT predicate on the "delegate_name" field.
+func DelegateNameGT(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldDelegateName, v))
+}
+
+// DelegateNameGTE applies the GTE predicate on the "delegate_name" field.
+func DelegateNameGTE(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldDelegateName, v))
+}
+
+// DelegateNameLT applies the LT predicate on the "delegate_name" field.
+func DelegateNameLT(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldDelegateName, v))
+}
+
+// DelegateNameLTE applies the LTE predicate on the "delegate_name" field.
+func DelegateNameLTE(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldDelegateName, v))
+}
+
+// DelegateNameContains applies the Contains predicate on the "delegate_name" field.
+func DelegateNameContains(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldContains(FieldDelegateName, v))
+}
+
+// DelegateNameHasPrefix applies the HasPrefix predicate on the "delegate_name" field.
+func DelegateNameHasPrefix(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldHasPrefix(FieldDelegateName, v))
+}
+
+// DelegateNameHasSuffix applies the HasSuffix predicate on the "delegate_name" field.
+func DelegateNameHasSuffix(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldHasSuffix(FieldDelegateName, v))
+}
+
+// DelegateNameIsNil applies the IsNil predicate on the "delegate_name" field.
+func DelegateNameIsNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIsNull(FieldDelegateName))
+}
+
+// DelegateNameNotNil applies the NotNil predicate on the "delegate_name" field.
+func DelegateNameNotNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotNull(FieldDelegateName))
+}
+
+// DelegateNameEqualFold applies the EqualFold predicate on the "delegate_name" field.
+func DelegateNameEqualFold(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEqualFold(FieldDelegateName, v))
+}
+
+// DelegateNameContainsFold applies the ContainsFold predicate on the "delegate_name" field.
+func DelegateNameContainsFold(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldContainsFold(FieldDelegateName, v))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldStartDate, v))
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldEndDate, v))
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldEndDate, vs...))
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldEndDate, vs...))
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldEndDate, v))
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldEndDate, v))
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldEndDate, v))
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldEndDate, v))
+}
+
+// AbsenceTypeIdsIsNil applies the IsNil predicate on the "absence_type_ids" field.
+func AbsenceTypeIdsIsNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIsNull(FieldAbsenceTypeIds))
+}
+
+// AbsenceTypeIdsNotNil applies the NotNil predicate on the "absence_type_ids" field.
+func AbsenceTypeIdsNotNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotNull(FieldAbsenceTypeIds))
+}
+
+// LeaveRequestIDEQ applies the EQ predicate on the "leave_request_id" field.
+func LeaveRequestIDEQ(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDNEQ applies the NEQ predicate on the "leave_request_id" field.
+func LeaveRequestIDNEQ(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDIn applies the In predicate on the "leave_request_id" field.
+func LeaveRequestIDIn(vs ...string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldLeaveRequestID, vs...))
+}
+
+// LeaveRequestIDNotIn applies the NotIn predicate on the "leave_request_id" field.
+func LeaveRequestIDNotIn(vs ...string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldLeaveRequestID, vs...))
+}
+
+// LeaveRequestIDGT applies the GT predicate on the "leave_request_id" field.
+func LeaveRequestIDGT(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDGTE applies the GTE predicate on the "leave_request_id" field.
+func LeaveRequestIDGTE(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDLT applies the LT predicate on the "leave_request_id" field.
+func LeaveRequestIDLT(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDLTE applies the LTE predicate on the "leave_request_id" field.
+func LeaveRequestIDLTE(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDContains applies the Contains predicate on the "leave_request_id" field.
+func LeaveRequestIDContains(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldContains(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDHasPrefix applies the HasPrefix predicate on the "leave_request_id" field.
+func LeaveRequestIDHasPrefix(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldHasPrefix(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDHasSuffix applies the HasSuffix predicate on the "leave_request_id" field.
+func LeaveRequestIDHasSuffix(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldHasSuffix(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDIsNil applies the IsNil predicate on the "leave_request_id" field.
+func LeaveRequestIDIsNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIsNull(FieldLeaveRequestID))
+}
+
+// LeaveRequestIDNotNil applies the NotNil predicate on the "leave_request_id" field.
+func LeaveRequestIDNotNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotNull(FieldLeaveRequestID))
+}
+
+// LeaveRequestIDEqualFold applies the EqualFold predicate on the "leave_request_id" field.
+func LeaveRequestIDEqualFold(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEqualFold(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDContainsFold applies the ContainsFold predicate on the "leave_request_id" field.
+func LeaveRequestIDContainsFold(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldContainsFold(FieldLeaveRequestID, v))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ApprovalDelegation) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ApprovalDelegation) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ApprovalDelegation) predicate.ApprovalDelegation {
+	return predicate.ApprovalDelegation(sql.NotPredicates(p))
+}
@@ -0,0 +1,1501 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/approvaldelegation"
+)
+
+// ApprovalDelegationCreate is the builder for creating a ApprovalDelegation entity.
+type ApprovalDelegationCreate struct {
+	config
+	mutation *ApprovalDelegationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *ApprovalDelegationCreate) SetCreateBy(v uint32) *ApprovalDelegationCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *ApprovalDelegationCreate) SetNillableCreateBy(v *uint32) *ApprovalDelegationCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *ApprovalDelegationCreate) SetUpdateBy(v uint32) *ApprovalDelegationCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *ApprovalDelegationCreate) SetNillableUpdateBy(v *uint32) *ApprovalDelegationCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ApprovalDelegationCreate) SetCreateTime(v time.Time) *ApprovalDelegationCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ApprovalDelegationCreate) SetNillableCreateTime(v *time.Time) *ApprovalDelegationCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ApprovalDelegationCreate) SetUpdateTime(v time.Time) *ApprovalDelegationCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ApprovalDelegationCreate) SetNillableUpdateTime(v *time.Time) *ApprovalDelegationCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ApprovalDelegationCreate) SetDeleteTime(v time.Time) *ApprovalDelegationCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ApprovalDelegationCreate) SetNillableDeleteTime(v *time.Time) *ApprovalDelegationCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ApprovalDelegationCreate) SetTenantID(v uint32) *ApprovalDelegationCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ApprovalDelegationCreate) SetNillableTenantID(v *uint32) *ApprovalDelegationCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetDelegatorID sets the "delegator_id" field.
+func (_c *ApprovalDelegationCreate) SetDelegatorID(v uint32) *ApprovalDelegationCreate {
+	_c.mutation.SetDelegatorID(v)
+	return _c
+}
+
+// SetDelegatorName sets the "delegator_name" field.
+func (_c *ApprovalDelegationCreate) SetDelegatorName(v string) *ApprovalDelegationCreate {
+	_c.mutation.SetDelegatorName(v)
+	return _c
+}
+
+// SetNillableDelegatorName sets the "delegator_name" field if the given value is not nil.
+func (_c *ApprovalDelegationCreate) SetNillableDelegatorName(v *string) *ApprovalDelegationCreate {
+	if v != nil {
+		_c.SetDelegatorName(*v)
+	}
+	return _c
+}
+
+// SetDelegateID sets the "delegate_id" field.
+func (_c *ApprovalDelegationCreate) SetDelegateID(v uint32) *ApprovalDelegationCreate {
+	_c.mutation.SetDelegateID(v)
+	return _c
+}
+
+// SetDelegateName sets the "delegate_name" field.
+func (_c *ApprovalDelegationCreate) SetDelegateName(v string) *ApprovalDelegationCreate {
+	_c.mutation.SetDelegateName(v)
+	return _c
+}
+
+// SetNillableDelegateName sets the "delegate_name" field if the given value is not nil.
+func (_c *ApprovalDelegationCreate) SetNillableDelegateName(v *string) *ApprovalDelegationCreate {
+	if v != nil {
+		_c.SetDelegateName(*v)
+	}
+	return _c
+}
+
+// SetStartDate sets the "start_date" field.
+func (_c *ApprovalDelegationCreate) SetStartDate(v time.Time) *ApprovalDelegationCreate {
+	_c.mutation.SetStartDate(v)
+	return _c
+}
+
+// SetEndDate sets the "end_date" field.
+func (_c *ApprovalDelegationCreate) SetEndDate(v time.Time) *ApprovalDelegationCreate {
+	_c.mutation.SetEndDate(v)
+	return _c
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (_c *ApprovalDelegationCreate) SetAbsenceTypeIds(v []string) *ApprovalDelegationCreate {
+	_c.mutation.SetAbsenceTypeIds(v)
+	return _c
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (_c *ApprovalDelegationCreate) SetLeaveRequestID(v string) *ApprovalDelegationCreate {
+	_c.mutation.SetLeaveRequestID(v)
+	return _c
+}
+
+// SetNillableLeaveRequestID sets the "leave_request_id" field if the given value is not nil.
+func (_c *ApprovalDelegationCreate) SetNillableLeaveRequestID(v *string) *ApprovalDelegationCreate {
+	if v != nil {
+		_c.SetLeaveRequestID(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *ApprovalDelegationCreate) SetNotes(v string) *ApprovalDelegationCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *ApprovalDelegationCreate) SetNillableNotes(v *string) *ApprovalDelegationCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ApprovalDelegationCreate) SetID(v string) *ApprovalDelegationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ApprovalDelegationMutation object of the builder.
+func (_c *ApprovalDelegationCreate) Mutation() *ApprovalDelegationMutation {
+	return _c.mutation
+}
+
+// Save creates the ApprovalDelegation in the database.
+func (_c *ApprovalDelegationCreate) Save(ctx context.Context) (*ApprovalDelegation, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ApprovalDelegationCreate) SaveX(ctx context.Context) *ApprovalDelegation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ApprovalDelegationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ApprovalDelegationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ApprovalDelegationCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := approvaldelegation.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.DelegatorName(); !ok {
+		v := approvaldelegation.DefaultDelegatorName
+		_c.mutation.SetDelegatorName(v)
+	}
+	if _, ok := _c.mutation.DelegateName(); !ok {
+		v := approvaldelegation.DefaultDelegateName
+		_c.mutation.SetDelegateName(v)
+	}
+	if _, ok := _c.mutation.LeaveRequestID(); !ok {
+		v := approvaldelegation.DefaultLeaveRequestID
+		_c.mutation.SetLeaveRequestID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ApprovalDelegationCreate) check() error {
+	if _, ok := _c.mutation.DelegatorID(); !ok {
+		return &ValidationError{Name: "delegator_id", err: errors.New(`ent: missing required field "ApprovalDelegation.delegator_id"`)}
+	}
+	if _, ok := _c.mutation.DelegateID(); !ok {
+		return &ValidationError{Name: "delegate_id", err: errors.New(`ent: missing required field "ApprovalDelegation.delegate_id"`)}
+	}
+	if _, ok := _c.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "ApprovalDelegation.start_date"`)}
+	}
+	if _, ok := _c.mutation.EndDate(); !ok {
+		return &ValidationError{Name: "end_date", err: errors.New(`ent: missing required field "ApprovalDelegation.end_date"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := approvaldelegation.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ApprovalDelegation.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ApprovalDelegationCreate) sqlSave(ctx context.Context) (*ApprovalDelegation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ApprovalDelegation.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ApprovalDelegationCreate) createSpec() (*ApprovalDelegation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ApprovalDelegation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(approvaldelegation.Table, sqlgraph.NewFieldSpec(approvaldelegation.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(approvaldelegation.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(approvaldelegation.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(approvaldelegation.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(approvaldelegation.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(approvaldelegation.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(approvaldelegation.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.DelegatorID(); ok {
+		_spec.SetField(approvaldelegation.FieldDelegatorID, field.TypeUint32, value)
+		_node.DelegatorID = value
+	}
+	if value, ok := _c.mutation.DelegatorName(); ok {
+		_spec.SetField(approvaldelegation.FieldDelegatorName, field.TypeString, value)
+		_node.DelegatorName = value
+	}
+	if value, ok := _c.mutation.DelegateID(); ok {
+		_spec.SetField(approvaldelegation.FieldDelegateID, field.TypeUint32, value)
+		_node.DelegateID = value
+	}
+	if value, ok := _c.mutation.DelegateName(); ok {
+		_spec.SetField(approvaldelegation.FieldDelegateName, field.TypeString, value)
+		_node.DelegateName = value
+	}
+	if value, ok := _c.mutation.StartDate(); ok {
+		_spec.SetField(approvaldelegation.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = value
+	}
+	if value, ok := _c.mutation.EndDate(); ok {
+		_spec.SetField(approvaldelegation.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = value
+	}
+	if value, ok := _c.mutation.AbsenceTypeIds(); ok {
+		_spec.SetField(approvaldelegation.FieldAbsenceTypeIds, field.TypeJSON, value)
+		_node.AbsenceTypeIds = value
+	}
+	if value, ok := _c.mutation.LeaveRequestID(); ok {
+		_spec.SetField(approvaldelegation.FieldLeaveRequestID, field.TypeString, value)
+		_node.LeaveRequestID = value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(approvaldelegation.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ApprovalDelegation.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ApprovalDelegationUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ApprovalDelegationCreate) OnConflict(opts ...sql.ConflictOption) *ApprovalDelegationUpsertOne {
+	_c.conflict = opts
+	return &ApprovalDelegationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ApprovalDelegation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ApprovalDelegationCreate) OnConflictColumns(columns ...string) *ApprovalDelegationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ApprovalDelegationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ApprovalDelegationUpsertOne is the builder for "upsert"-ing
+	//  one ApprovalDelegation node.
+	ApprovalDelegationUpsertOne struct {
+		create *ApprovalDelegationCreate
+	}
+
+	// ApprovalDelegationUpsert is the "OnConflict" setter.
+	ApprovalDelegationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *ApprovalDelegationUpsert) SetCreateBy(v uint32) *ApprovalDelegationUpsert {
+	u.Set(approvaldelegation.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsert) UpdateCreateBy() *ApprovalDelegationUpsert {
+	u.SetExcluded(approvaldelegation.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ApprovalDelegationUpsert) AddCreateBy(v uint32) *ApprovalDelegationUpsert {
+	u.Add(approvaldelegation.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ApprovalDelegationUpsert) ClearCreateBy() *ApprovalDelegationUpsert {
+	u.SetNull(approvaldelegation.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ApprovalDelegationUpsert) SetUpdateBy(v uint32) *ApprovalDelegationUpsert {
+	u.Set(approvaldelegation.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsert) UpdateUpdateBy() *ApprovalDelegationUpsert {
+	u.SetExcluded(approvaldelegation.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ApprovalDelegationUpsert) AddUpdateBy(v uint32) *ApprovalDelegationUpsert {
+	u.Add(approvaldelegation.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ApprovalDelegationUpsert) ClearUpdateBy() *ApprovalDelegationUpsert {
+	u.SetNull(approvaldelegation.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ApprovalDelegationUpsert) SetUpdateTime(v time.Time) *ApprovalDelegationUpsert {
+	u.Set(approvaldelegation.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsert) UpdateUpdateTime() *ApprovalDelegationUpsert {
+	u.SetExcluded(approvaldelegation.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ApprovalDelegationUpsert) ClearUpdateTime() *ApprovalDelegationUpsert {
+	u.SetNull(approvaldelegation.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ApprovalDelegationUpsert) SetDeleteTime(v time.Time) *ApprovalDelegationUpsert {
+	u.Set(approvaldelegation.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsert) UpdateDeleteTime() *ApprovalDelegationUpsert {
+	u.SetExcluded(approvaldelegation.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ApprovalDelegationUpsert) ClearDeleteTime() *ApprovalDelegationUpsert {
+	u.SetNull(approvaldelegation.FieldDeleteTime)
+	return u
+}
+
+// SetDelegatorID sets the "delegator_id" field.
+func (u *ApprovalDelegationUpsert) SetDelegatorID(v uint32) *ApprovalDelegationUpsert {
+	u.Set(approvaldelegation.FieldDelegatorID, v)
+	return u
+}
+
+// UpdateDelegatorID sets the "delegator_id" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsert) UpdateDelegatorID() *ApprovalDelegationUpsert {
+	u.SetExcluded(approvaldelegation.FieldDelegatorID)
+	return u
+}
+
+// AddDelegatorID adds v to the "delegator_id" field.
+func (u *ApprovalDelegationUpsert) AddDelegatorID(v uint32) *ApprovalDelegationUpsert {
+	u.Add(approvaldelegation.FieldDelegatorID, v)
+	return u
+}
+
+// SetDelegatorName sets the "delegator_name" field.
+func (u *ApprovalDelegationUpsert) SetDelegatorName(v string) *ApprovalDelegationUpsert {
+	u.Set(approvaldelegation.FieldDelegatorName, v)
+	return u
+}
+
+// UpdateDelegatorName sets the "delegator_name" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsert) UpdateDelegatorName() *ApprovalDelegationUpsert {
+	u.SetExcluded(approvaldelegation.FieldDelegatorName)
+	return u
+}
+
+// ClearDelegatorName clears the value of the "delegator_name" field.
+func (u *ApprovalDelegationUpsert) ClearDelegatorName() *ApprovalDelegationUpsert {
+	u.SetNull(approvaldelegation.FieldDelegatorName)
+	return u
+}
+
+// SetDelegateID sets the "delegate_id" field.
+func (u *ApprovalDelegationUpsert) SetDelegateID(v uint32) *ApprovalDelegationUpsert {
+	u.Set(approvaldelegation.FieldDelegateID, v)
+	return u
+}
+
+// UpdateDelegateID sets the "delegate_id" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsert) UpdateDelegateID() *ApprovalDelegationUpsert {
+	u.SetExcluded(approvaldelegation.FieldDelegateID)
+	return u
+}
+
+// AddDelegateID adds v to the "delegate_id" field.
+func (u *ApprovalDelegationUpsert) AddDelegateID(v uint32) *ApprovalDelegationUpsert {
+	u.Add(approvaldelegation.FieldDelegateID, v)
+	return u
+}
+
+// SetDelegateName sets the "delegate_name" field.
+func (u *ApprovalDelegationUpsert) SetDelegateName(v string) *ApprovalDelegationUpsert {
+	u.Set(approvaldelegation.FieldDelegateName, v)
+	return u
+}
+
+// UpdateDelegateName sets the "delegate_name" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsert) UpdateDelegateName() *ApprovalDelegationUpsert {
+	u.SetExcluded(approvaldelegation.FieldDelegateName)
+	return u
+}
+
+// ClearDelegateName clears the value of the "delegate_name" field.
+func (u *ApprovalDelegationUpsert) ClearDelegateName() *ApprovalDelegationUpsert {
+	u.SetNull(approvaldelegation.FieldDelegateName)
+	return u
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *ApprovalDelegationUpsert) SetStartDate(v time.Time) *ApprovalDelegationUpsert {
+	u.Set(approvaldelegation.FieldStartDate, v)
+	return u
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsert) UpdateStartDate() *ApprovalDelegationUpsert {
+	u.SetExcluded(approvaldelegation.FieldStartDate)
+	return u
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *ApprovalDelegationUpsert) SetEndDate(v time.Time) *ApprovalDelegationUpsert {
+	u.Set(approvaldelegation.FieldEndDate, v)
+	return u
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsert) UpdateEndDate() *ApprovalDelegationUpsert {
+	u.SetExcluded(approvaldelegation.FieldEndDate)
+	return u
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (u *ApprovalDelegationUpsert) SetAbsenceTypeIds(v []string) *ApprovalDelegationUpsert {
+	u.Set(approvaldelegation.FieldAbsenceTypeIds, v)
+	return u
+}
+
+// UpdateAbsenceTypeIds sets the "absence_type_ids" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsert) UpdateAbsenceTypeIds() *ApprovalDelegationUpsert {
+	u.SetExcluded(approvaldelegation.FieldAbsenceTypeIds)
+	return u
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (u *ApprovalDelegationUpsert) ClearAbsenceTypeIds() *ApprovalDelegationUpsert {
+	u.SetNull(approvaldelegation.FieldAbsenceTypeIds)
+	return u
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (u *ApprovalDelegationUpsert) SetLeaveRequestID(v string) *ApprovalDelegationUpsert {
+	u.Set(approvaldelegation.FieldLeaveRequestID, v)
+	return u
+}
+
+// UpdateLeaveRequestID sets the "leave_request_id" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsert) UpdateLeaveRequestID() *ApprovalDelegationUpsert {
+	u.SetExcluded(approvaldelegation.FieldLeaveRequestID)
+	return u
+}
+
+// ClearLeaveRequestID clears the value of the "leave_request_id" field.
+func (u *ApprovalDelegationUpsert) ClearLeaveRequestID() *ApprovalDelegationUpsert {
+	u.SetNull(approvaldelegation.FieldLeaveRequestID)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *ApprovalDelegationUpsert) SetNotes(v string) *ApprovalDelegationUpsert {
+	u.Set(approvaldelegation.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsert) UpdateNotes() *ApprovalDelegationUpsert {
+	u.SetExcluded(approvaldelegation.FieldNotes)
+	return u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *ApprovalDelegationUpsert) ClearNotes() *ApprovalDelegationUpsert {
+	u.SetNull(approvaldelegation.FieldNotes)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ApprovalDelegation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(approvaldelegation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ApprovalDelegationUpsertOne) UpdateNewValues() *ApprovalDelegationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(approvaldelegation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(approvaldelegation.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(approvaldelegation.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ApprovalDelegation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ApprovalDelegationUpsertOne) Ignore() *ApprovalDelegationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ApprovalDelegationUpsertOne) DoNothing() *ApprovalDelegationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ApprovalDelegationCreate.OnConflict
+// documentation for more info.
+func (u *ApprovalDelegationUpsertOne) Update(set func(*ApprovalDelegationUpsert)) *ApprovalDelegationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ApprovalDelegationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ApprovalDelegationUpsertOne) SetCreateBy(v uint32) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ApprovalDelegationUpsertOne) AddCreateBy(v uint32) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertOne) UpdateCreateBy() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ApprovalDelegationUpsertOne) ClearCreateBy() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ApprovalDelegationUpsertOne) SetUpdateBy(v uint32) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ApprovalDelegationUpsertOne) AddUpdateBy(v uint32) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertOne) UpdateUpdateBy() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ApprovalDelegationUpsertOne) ClearUpdateBy() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ApprovalDelegationUpsertOne) SetUpdateTime(v time.Time) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertOne) UpdateUpdateTime() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ApprovalDelegationUpsertOne) ClearUpdateTime() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ApprovalDelegationUpsertOne) SetDeleteTime(v time.Time) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertOne) UpdateDeleteTime() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ApprovalDelegationUpsertOne) ClearDeleteTime() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetDelegatorID sets the "delegator_id" field.
+func (u *ApprovalDelegationUpsertOne) SetDelegatorID(v uint32) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetDelegatorID(v)
+	})
+}
+
+// AddDelegatorID adds v to the "delegator_id" field.
+func (u *ApprovalDelegationUpsertOne) AddDelegatorID(v uint32) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.AddDelegatorID(v)
+	})
+}
+
+// UpdateDelegatorID sets the "delegator_id" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertOne) UpdateDelegatorID() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateDelegatorID()
+	})
+}
+
+// SetDelegatorName sets the "delegator_name" field.
+func (u *ApprovalDelegationUpsertOne) SetDelegatorName(v string) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetDelegatorName(v)
+	})
+}
+
+// UpdateDelegatorName sets the "delegator_name" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertOne) UpdateDelegatorName() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateDelegatorName()
+	})
+}
+
+// ClearDelegatorName clears the value of the "delegator_name" field.
+func (u *ApprovalDelegationUpsertOne) ClearDelegatorName() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearDelegatorName()
+	})
+}
+
+// SetDelegateID sets the "delegate_id" field.
+func (u *ApprovalDelegationUpsertOne) SetDelegateID(v uint32) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetDelegateID(v)
+	})
+}
+
+// AddDelegateID adds v to the "delegate_id" field.
+func (u *ApprovalDelegationUpsertOne) AddDelegateID(v uint32) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.AddDelegateID(v)
+	})
+}
+
+// UpdateDelegateID sets the "delegate_id" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertOne) UpdateDelegateID() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateDelegateID()
+	})
+}
+
+// SetDelegateName sets the "delegate_name" field.
+func (u *ApprovalDelegationUpsertOne) SetDelegateName(v string) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetDelegateName(v)
+	})
+}
+
+// UpdateDelegateName sets the "delegate_name" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertOne) UpdateDelegateName() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateDelegateName()
+	})
+}
+
+// ClearDelegateName clears the value of the "delegate_name" field.
+func (u *ApprovalDelegationUpsertOne) ClearDelegateName() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearDelegateName()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *ApprovalDelegationUpsertOne) SetStartDate(v time.Time) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertOne) UpdateStartDate() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *ApprovalDelegationUpsertOne) SetEndDate(v time.Time) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertOne) UpdateEndDate() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (u *ApprovalDelegationUpsertOne) SetAbsenceTypeIds(v []string) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetAbsenceTypeIds(v)
+	})
+}
+
+// UpdateAbsenceTypeIds sets the "absence_type_ids" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertOne) UpdateAbsenceTypeIds() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateAbsenceTypeIds()
+	})
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (u *ApprovalDelegationUpsertOne) ClearAbsenceTypeIds() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearAbsenceTypeIds()
+	})
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (u *ApprovalDelegationUpsertOne) SetLeaveRequestID(v string) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetLeaveRequestID(v)
+	})
+}
+
+// UpdateLeaveRequestID sets the "leave_request_id" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertOne) UpdateLeaveRequestID() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateLeaveRequestID()
+	})
+}
+
+// ClearLeaveRequestID clears the value of the "leave_request_id" field.
+func (u *ApprovalDelegationUpsertOne) ClearLeaveRequestID() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearLeaveRequestID()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *ApprovalDelegationUpsertOne) SetNotes(v string) *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertOne) UpdateNotes() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *ApprovalDelegationUpsertOne) ClearNotes() *ApprovalDelegationUpsertOne {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// Exec executes the query.
+func (u *ApprovalDelegationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ApprovalDelegationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ApprovalDelegationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ApprovalDelegationUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ApprovalDelegationUpsertOne.ID is not supported by MySQL driver. Use ApprovalDelegationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ApprovalDelegationUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ApprovalDelegationCreateBulk is the builder for creating many ApprovalDelegation entities in bulk.
+type ApprovalDelegationCreateBulk struct {
+	config
+	err      error
+	builders []*ApprovalDelegationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ApprovalDelegation entities in the database.
+func (_c *ApprovalDelegationCreateBulk) Save(ctx context.Context) ([]*ApprovalDelegation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ApprovalDelegation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ApprovalDelegationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ApprovalDelegationCreateBulk) SaveX(ctx context.Context) []*ApprovalDelegation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ApprovalDelegationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ApprovalDelegationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ApprovalDelegation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ApprovalDelegationUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ApprovalDelegationCreateBulk) OnConflict(opts ...sql.ConflictOption) *ApprovalDelegationUpsertBulk {
+	_c.conflict = opts
+	return &ApprovalDelegationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ApprovalDelegation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ApprovalDelegationCreateBulk) OnConflictColumns(columns ...string) *ApprovalDelegationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ApprovalDelegationUpsertBulk{
+		create: _c,
+	}
+}
+
+// ApprovalDelegationUpsertBulk is the builder for "upsert"-ing
+// a bulk of ApprovalDelegation nodes.
+type ApprovalDelegationUpsertBulk struct {
+	create *ApprovalDelegationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ApprovalDelegation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(approvaldelegation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ApprovalDelegationUpsertBulk) UpdateNewValues() *ApprovalDelegationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(approvaldelegation.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(approvaldelegation.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(approvaldelegation.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ApprovalDelegation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ApprovalDelegationUpsertBulk) Ignore() *ApprovalDelegationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ApprovalDelegationUpsertBulk) DoNothing() *ApprovalDelegationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ApprovalDelegationCreateBulk.OnConflict
+// documentation for more info.
+func (u *ApprovalDelegationUpsertBulk) Update(set func(*ApprovalDelegationUpsert)) *ApprovalDelegationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ApprovalDelegationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *ApprovalDelegationUpsertBulk) SetCreateBy(v uint32) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *ApprovalDelegationUpsertBulk) AddCreateBy(v uint32) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertBulk) UpdateCreateBy() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *ApprovalDelegationUpsertBulk) ClearCreateBy() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *ApprovalDelegationUpsertBulk) SetUpdateBy(v uint32) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *ApprovalDelegationUpsertBulk) AddUpdateBy(v uint32) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertBulk) UpdateUpdateBy() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *ApprovalDelegationUpsertBulk) ClearUpdateBy() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ApprovalDelegationUpsertBulk) SetUpdateTime(v time.Time) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertBulk) UpdateUpdateTime() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ApprovalDelegationUpsertBulk) ClearUpdateTime() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ApprovalDelegationUpsertBulk) SetDeleteTime(v time.Time) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertBulk) UpdateDeleteTime() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ApprovalDelegationUpsertBulk) ClearDeleteTime() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetDelegatorID sets the "delegator_id" field.
+func (u *ApprovalDelegationUpsertBulk) SetDelegatorID(v uint32) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetDelegatorID(v)
+	})
+}
+
+// AddDelegatorID adds v to the "delegator_id" field.
+func (u *ApprovalDelegationUpsertBulk) AddDelegatorID(v uint32) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.AddDelegatorID(v)
+	})
+}
+
+// UpdateDelegatorID sets the "delegator_id" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertBulk) UpdateDelegatorID() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateDelegatorID()
+	})
+}
+
+// SetDelegatorName sets the "delegator_name" field.
+func (u *ApprovalDelegationUpsertBulk) SetDelegatorName(v string) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetDelegatorName(v)
+	})
+}
+
+// UpdateDelegatorName sets the "delegator_name" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertBulk) UpdateDelegatorName() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateDelegatorName()
+	})
+}
+
+// ClearDelegatorName clears the value of the "delegator_name" field.
+func (u *ApprovalDelegationUpsertBulk) ClearDelegatorName() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearDelegatorName()
+	})
+}
+
+// SetDelegateID sets the "delegate_id" field.
+func (u *ApprovalDelegationUpsertBulk) SetDelegateID(v uint32) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetDelegateID(v)
+	})
+}
+
+// AddDelegateID adds v to the "delegate_id" field.
+func (u *ApprovalDelegationUpsertBulk) AddDelegateID(v uint32) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.AddDelegateID(v)
+	})
+}
+
+// UpdateDelegateID sets the "delegate_id" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertBulk) UpdateDelegateID() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateDelegateID()
+	})
+}
+
+// SetDelegateName sets the "delegate_name" field.
+func (u *ApprovalDelegationUpsertBulk) SetDelegateName(v string) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetDelegateName(v)
+	})
+}
+
+// UpdateDelegateName sets the "delegate_name" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertBulk) UpdateDelegateName() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateDelegateName()
+	})
+}
+
+// ClearDelegateName clears the value of the "delegate_name" field.
+func (u *ApprovalDelegationUpsertBulk) ClearDelegateName() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearDelegateName()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *ApprovalDelegationUpsertBulk) SetStartDate(v time.Time) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertBulk) UpdateStartDate() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *ApprovalDelegationUpsertBulk) SetEndDate(v time.Time) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertBulk) UpdateEndDate() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (u *ApprovalDelegationUpsertBulk) SetAbsenceTypeIds(v []string) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetAbsenceTypeIds(v)
+	})
+}
+
+// UpdateAbsenceTypeIds sets the "absence_type_ids" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertBulk) UpdateAbsenceTypeIds() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateAbsenceTypeIds()
+	})
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (u *ApprovalDelegationUpsertBulk) ClearAbsenceTypeIds() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearAbsenceTypeIds()
+	})
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (u *ApprovalDelegationUpsertBulk) SetLeaveRequestID(v string) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetLeaveRequestID(v)
+	})
+}
+
+// UpdateLeaveRequestID sets the "leave_request_id" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertBulk) UpdateLeaveRequestID() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateLeaveRequestID()
+	})
+}
+
+// ClearLeaveRequestID clears the value of the "leave_request_id" field.
+func (u *ApprovalDelegationUpsertBulk) ClearLeaveRequestID() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearLeaveRequestID()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *ApprovalDelegationUpsertBulk) SetNotes(v string) *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *ApprovalDelegationUpsertBulk) UpdateNotes() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *ApprovalDelegationUpsertBulk) ClearNotes() *ApprovalDelegationUpsertBulk {
+	return u.Update(func(s *ApprovalDelegationUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// Exec executes the query.
+func (u *ApprovalDelegationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ApprovalDelegationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ApprovalDelegationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ApprovalDelegationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/approvaldelegation"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// ApprovalDelegationDelete is the builder for deleting a ApprovalDelegation entity.
+type ApprovalDelegationDelete struct {
+	config
+	hooks    []Hook
+	mutation *ApprovalDelegationMutation
+}
+
+// Where appends a list predicates to the ApprovalDelegationDelete builder.
+func (_d *ApprovalDelegationDelete) Where(ps ...predicate.ApprovalDelegation) *ApprovalDelegationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ApprovalDelegationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ApprovalDelegationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ApprovalDelegationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(approvaldelegation.Table, sqlgraph.NewFieldSpec(approvaldelegation.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ApprovalDelegationDeleteOne is the builder for deleting a single ApprovalDelegation entity.
+type ApprovalDelegationDeleteOne struct {
+	_d *ApprovalDelegationDelete
+}
+
+// Where appends a list predicates to the ApprovalDelegationDelete builder.
+func (_d *ApprovalDelegationDeleteOne) Where(ps ...predicate.ApprovalDelegation) *ApprovalDelegationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ApprovalDelegationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{approvaldelegation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ApprovalDelegationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
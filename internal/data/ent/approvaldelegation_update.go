@@ -0,0 +1,895 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/approvaldelegation"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// ApprovalDelegationUpdate is the builder for updating ApprovalDelegation entities.
+type ApprovalDelegationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ApprovalDelegationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ApprovalDelegationUpdate builder.
+func (_u *ApprovalDelegationUpdate) Where(ps ...predicate.ApprovalDelegation) *ApprovalDelegationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ApprovalDelegationUpdate) SetCreateBy(v uint32) *ApprovalDelegationUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdate) SetNillableCreateBy(v *uint32) *ApprovalDelegationUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ApprovalDelegationUpdate) AddCreateBy(v int32) *ApprovalDelegationUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ApprovalDelegationUpdate) ClearCreateBy() *ApprovalDelegationUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *ApprovalDelegationUpdate) SetUpdateBy(v uint32) *ApprovalDelegationUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdate) SetNillableUpdateBy(v *uint32) *ApprovalDelegationUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *ApprovalDelegationUpdate) AddUpdateBy(v int32) *ApprovalDelegationUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *ApprovalDelegationUpdate) ClearUpdateBy() *ApprovalDelegationUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ApprovalDelegationUpdate) SetUpdateTime(v time.Time) *ApprovalDelegationUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdate) SetNillableUpdateTime(v *time.Time) *ApprovalDelegationUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ApprovalDelegationUpdate) ClearUpdateTime() *ApprovalDelegationUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ApprovalDelegationUpdate) SetDeleteTime(v time.Time) *ApprovalDelegationUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdate) SetNillableDeleteTime(v *time.Time) *ApprovalDelegationUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ApprovalDelegationUpdate) ClearDeleteTime() *ApprovalDelegationUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetDelegatorID sets the "delegator_id" field.
+func (_u *ApprovalDelegationUpdate) SetDelegatorID(v uint32) *ApprovalDelegationUpdate {
+	_u.mutation.ResetDelegatorID()
+	_u.mutation.SetDelegatorID(v)
+	return _u
+}
+
+// SetNillableDelegatorID sets the "delegator_id" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdate) SetNillableDelegatorID(v *uint32) *ApprovalDelegationUpdate {
+	if v != nil {
+		_u.SetDelegatorID(*v)
+	}
+	return _u
+}
+
+// AddDelegatorID adds value to the "delegator_id" field.
+func (_u *ApprovalDelegationUpdate) AddDelegatorID(v int32) *ApprovalDelegationUpdate {
+	_u.mutation.AddDelegatorID(v)
+	return _u
+}
+
+// SetDelegatorName sets the "delegator_name" field.
+func (_u *ApprovalDelegationUpdate) SetDelegatorName(v string) *ApprovalDelegationUpdate {
+	_u.mutation.SetDelegatorName(v)
+	return _u
+}
+
+// SetNillableDelegatorName sets the "delegator_name" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdate) SetNillableDelegatorName(v *string) *ApprovalDelegationUpdate {
+	if v != nil {
+		_u.SetDelegatorName(*v)
+	}
+	return _u
+}
+
+// ClearDelegatorName clears the value of the "delegator_name" field.
+func (_u *ApprovalDelegationUpdate) ClearDelegatorName() *ApprovalDelegationUpdate {
+	_u.mutation.ClearDelegatorName()
+	return _u
+}
+
+// SetDelegateID sets the "delegate_id" field.
+func (_u *ApprovalDelegationUpdate) SetDelegateID(v uint32) *ApprovalDelegationUpdate {
+	_u.mutation.ResetDelegateID()
+	_u.mutation.SetDelegateID(v)
+	return _u
+}
+
+// SetNillableDelegateID sets the "delegate_id" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdate) SetNillableDelegateID(v *uint32) *ApprovalDelegationUpdate {
+	if v != nil {
+		_u.SetDelegateID(*v)
+	}
+	return _u
+}
+
+// AddDelegateID adds value to the "delegate_id" field.
+func (_u *ApprovalDelegationUpdate) AddDelegateID(v int32) *ApprovalDelegationUpdate {
+	_u.mutation.AddDelegateID(v)
+	return _u
+}
+
+// SetDelegateName sets the "delegate_name" field.
+func (_u *ApprovalDelegationUpdate) SetDelegateName(v string) *ApprovalDelegationUpdate {
+	_u.mutation.SetDelegateName(v)
+	return _u
+}
+
+// SetNillableDelegateName sets the "delegate_name" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdate) SetNillableDelegateName(v *string) *ApprovalDelegationUpdate {
+	if v != nil {
+		_u.SetDelegateName(*v)
+	}
+	return _u
+}
+
+// ClearDelegateName clears the value of the "delegate_name" field.
+func (_u *ApprovalDelegationUpdate) ClearDelegateName() *ApprovalDelegationUpdate {
+	_u.mutation.ClearDelegateName()
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *ApprovalDelegationUpdate) SetStartDate(v time.Time) *ApprovalDelegationUpdate {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdate) SetNillableStartDate(v *time.Time) *ApprovalDelegationUpdate {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *ApprovalDelegationUpdate) SetEndDate(v time.Time) *ApprovalDelegationUpdate {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdate) SetNillableEndDate(v *time.Time) *ApprovalDelegationUpdate {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (_u *ApprovalDelegationUpdate) SetAbsenceTypeIds(v []string) *ApprovalDelegationUpdate {
+	_u.mutation.SetAbsenceTypeIds(v)
+	return _u
+}
+
+// AppendAbsenceTypeIds appends value to the "absence_type_ids" field.
+func (_u *ApprovalDelegationUpdate) AppendAbsenceTypeIds(v []string) *ApprovalDelegationUpdate {
+	_u.mutation.AppendAbsenceTypeIds(v)
+	return _u
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (_u *ApprovalDelegationUpdate) ClearAbsenceTypeIds() *ApprovalDelegationUpdate {
+	_u.mutation.ClearAbsenceTypeIds()
+	return _u
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (_u *ApprovalDelegationUpdate) SetLeaveRequestID(v string) *ApprovalDelegationUpdate {
+	_u.mutation.SetLeaveRequestID(v)
+	return _u
+}
+
+// SetNillableLeaveRequestID sets the "leave_request_id" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdate) SetNillableLeaveRequestID(v *string) *ApprovalDelegationUpdate {
+	if v != nil {
+		_u.SetLeaveRequestID(*v)
+	}
+	return _u
+}
+
+// ClearLeaveRequestID clears the value of the "leave_request_id" field.
+func (_u *ApprovalDelegationUpdate) ClearLeaveRequestID() *ApprovalDelegationUpdate {
+	_u.mutation.ClearLeaveRequestID()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *ApprovalDelegationUpdate) SetNotes(v string) *ApprovalDelegationUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdate) SetNillableNotes(v *string) *ApprovalDelegationUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *ApprovalDelegationUpdate) ClearNotes() *ApprovalDelegationUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// Mutation returns the ApprovalDelegationMutation object of the builder.
+func (_u *ApprovalDelegationUpdate) Mutation() *ApprovalDelegationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ApprovalDelegationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ApprovalDelegationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ApprovalDelegationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ApprovalDelegationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ApprovalDelegationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ApprovalDelegationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ApprovalDelegationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(approvaldelegation.Table, approvaldelegation.Columns, sqlgraph.NewFieldSpec(approvaldelegation.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(approvaldelegation.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(approvaldelegation.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(approvaldelegation.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(approvaldelegation.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(approvaldelegation.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(approvaldelegation.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(approvaldelegation.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(approvaldelegation.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(approvaldelegation.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(approvaldelegation.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(approvaldelegation.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(approvaldelegation.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DelegatorID(); ok {
+		_spec.SetField(approvaldelegation.FieldDelegatorID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDelegatorID(); ok {
+		_spec.AddField(approvaldelegation.FieldDelegatorID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.DelegatorName(); ok {
+		_spec.SetField(approvaldelegation.FieldDelegatorName, field.TypeString, value)
+	}
+	if _u.mutation.DelegatorNameCleared() {
+		_spec.ClearField(approvaldelegation.FieldDelegatorName, field.TypeString)
+	}
+	if value, ok := _u.mutation.DelegateID(); ok {
+		_spec.SetField(approvaldelegation.FieldDelegateID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDelegateID(); ok {
+		_spec.AddField(approvaldelegation.FieldDelegateID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.DelegateName(); ok {
+		_spec.SetField(approvaldelegation.FieldDelegateName, field.TypeString, value)
+	}
+	if _u.mutation.DelegateNameCleared() {
+		_spec.ClearField(approvaldelegation.FieldDelegateName, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(approvaldelegation.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(approvaldelegation.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AbsenceTypeIds(); ok {
+		_spec.SetField(approvaldelegation.FieldAbsenceTypeIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAbsenceTypeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, approvaldelegation.FieldAbsenceTypeIds, value)
+		})
+	}
+	if _u.mutation.AbsenceTypeIdsCleared() {
+		_spec.ClearField(approvaldelegation.FieldAbsenceTypeIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LeaveRequestID(); ok {
+		_spec.SetField(approvaldelegation.FieldLeaveRequestID, field.TypeString, value)
+	}
+	if _u.mutation.LeaveRequestIDCleared() {
+		_spec.ClearField(approvaldelegation.FieldLeaveRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(approvaldelegation.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(approvaldelegation.FieldNotes, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{approvaldelegation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ApprovalDelegationUpdateOne is the builder for updating a single ApprovalDelegation entity.
+type ApprovalDelegationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ApprovalDelegationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *ApprovalDelegationUpdateOne) SetCreateBy(v uint32) *ApprovalDelegationUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdateOne) SetNillableCreateBy(v *uint32) *ApprovalDelegationUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *ApprovalDelegationUpdateOne) AddCreateBy(v int32) *ApprovalDelegationUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *ApprovalDelegationUpdateOne) ClearCreateBy() *ApprovalDelegationUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *ApprovalDelegationUpdateOne) SetUpdateBy(v uint32) *ApprovalDelegationUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdateOne) SetNillableUpdateBy(v *uint32) *ApprovalDelegationUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *ApprovalDelegationUpdateOne) AddUpdateBy(v int32) *ApprovalDelegationUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *ApprovalDelegationUpdateOne) ClearUpdateBy() *ApprovalDelegationUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ApprovalDelegationUpdateOne) SetUpdateTime(v time.Time) *ApprovalDelegationUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdateOne) SetNillableUpdateTime(v *time.Time) *ApprovalDelegationUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *ApprovalDelegationUpdateOne) ClearUpdateTime() *ApprovalDelegationUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *ApprovalDelegationUpdateOne) SetDeleteTime(v time.Time) *ApprovalDelegationUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdateOne) SetNillableDeleteTime(v *time.Time) *ApprovalDelegationUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *ApprovalDelegationUpdateOne) ClearDeleteTime() *ApprovalDelegationUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetDelegatorID sets the "delegator_id" field.
+func (_u *ApprovalDelegationUpdateOne) SetDelegatorID(v uint32) *ApprovalDelegationUpdateOne {
+	_u.mutation.ResetDelegatorID()
+	_u.mutation.SetDelegatorID(v)
+	return _u
+}
+
+// SetNillableDelegatorID sets the "delegator_id" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdateOne) SetNillableDelegatorID(v *uint32) *ApprovalDelegationUpdateOne {
+	if v != nil {
+		_u.SetDelegatorID(*v)
+	}
+	return _u
+}
+
+// AddDelegatorID adds value to the "delegator_id" field.
+func (_u *ApprovalDelegationUpdateOne) AddDelegatorID(v int32) *ApprovalDelegationUpdateOne {
+	_u.mutation.AddDelegatorID(v)
+	return _u
+}
+
+// SetDelegatorName sets the "delegator_name" field.
+func (_u *ApprovalDelegationUpdateOne) SetDelegatorName(v string) *ApprovalDelegationUpdateOne {
+	_u.mutation.SetDelegatorName(v)
+	return _u
+}
+
+// SetNillableDelegatorName sets the "delegator_name" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdateOne) SetNillableDelegatorName(v *string) *ApprovalDelegationUpdateOne {
+	if v != nil {
+		_u.SetDelegatorName(*v)
+	}
+	return _u
+}
+
+// ClearDelegatorName clears the value of the "delegator_name" field.
+func (_u *ApprovalDelegationUpdateOne) ClearDelegatorName() *ApprovalDelegationUpdateOne {
+	_u.mutation.ClearDelegatorName()
+	return _u
+}
+
+// SetDelegateID sets the "delegate_id" field.
+func (_u *ApprovalDelegationUpdateOne) SetDelegateID(v uint32) *ApprovalDelegationUpdateOne {
+	_u.mutation.ResetDelegateID()
+	_u.mutation.SetDelegateID(v)
+	return _u
+}
+
+// SetNillableDelegateID sets the "delegate_id" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdateOne) SetNillableDelegateID(v *uint32) *ApprovalDelegationUpdateOne {
+	if v != nil {
+		_u.SetDelegateID(*v)
+	}
+	return _u
+}
+
+// AddDelegateID adds value to the "delegate_id" field.
+func (_u *ApprovalDelegationUpdateOne) AddDelegateID(v int32) *ApprovalDelegationUpdateOne {
+	_u.mutation.AddDelegateID(v)
+	return _u
+}
+
+// SetDelegateName sets the "delegate_name" field.
+func (_u *ApprovalDelegationUpdateOne) SetDelegateName(v string) *ApprovalDelegationUpdateOne {
+	_u.mutation.SetDelegateName(v)
+	return _u
+}
+
+// SetNillableDelegateName sets the "delegate_name" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdateOne) SetNillableDelegateName(v *string) *ApprovalDelegationUpdateOne {
+	if v != nil {
+		_u.SetDelegateName(*v)
+	}
+	return _u
+}
+
+// ClearDelegateName clears the value of the "delegate_name" field.
+func (_u *ApprovalDelegationUpdateOne) ClearDelegateName() *ApprovalDelegationUpdateOne {
+	_u.mutation.ClearDelegateName()
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *ApprovalDelegationUpdateOne) SetStartDate(v time.Time) *ApprovalDelegationUpdateOne {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdateOne) SetNillableStartDate(v *time.Time) *ApprovalDelegationUpdateOne {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *ApprovalDelegationUpdateOne) SetEndDate(v time.Time) *ApprovalDelegationUpdateOne {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdateOne) SetNillableEndDate(v *time.Time) *ApprovalDelegationUpdateOne {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (_u *ApprovalDelegationUpdateOne) SetAbsenceTypeIds(v []string) *ApprovalDelegationUpdateOne {
+	_u.mutation.SetAbsenceTypeIds(v)
+	return _u
+}
+
+// AppendAbsenceTypeIds appends value to the "absence_type_ids" field.
+func (_u *ApprovalDelegationUpdateOne) AppendAbsenceTypeIds(v []string) *ApprovalDelegationUpdateOne {
+	_u.mutation.AppendAbsenceTypeIds(v)
+	return _u
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (_u *ApprovalDelegationUpdateOne) ClearAbsenceTypeIds() *ApprovalDelegationUpdateOne {
+	_u.mutation.ClearAbsenceTypeIds()
+	return _u
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (_u *ApprovalDelegationUpdateOne) SetLeaveRequestID(v string) *ApprovalDelegationUpdateOne {
+	_u.mutation.SetLeaveRequestID(v)
+	return _u
+}
+
+// SetNillableLeaveRequestID sets the "leave_request_id" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdateOne) SetNillableLeaveRequestID(v *string) *ApprovalDelegationUpdateOne {
+	if v != nil {
+		_u.SetLeaveRequestID(*v)
+	}
+	return _u
+}
+
+// ClearLeaveRequestID clears the value of the "leave_request_id" field.
+func (_u *ApprovalDelegationUpdateOne) ClearLeaveRequestID() *ApprovalDelegationUpdateOne {
+	_u.mutation.ClearLeaveRequestID()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *ApprovalDelegationUpdateOne) SetNotes(v string) *ApprovalDelegationUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *ApprovalDelegationUpdateOne) SetNillableNotes(v *string) *ApprovalDelegationUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *ApprovalDelegationUpdateOne) ClearNotes() *ApprovalDelegationUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// Mutation returns the ApprovalDelegationMutation object of the builder.
+func (_u *ApprovalDelegationUpdateOne) Mutation() *ApprovalDelegationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ApprovalDelegationUpdate builder.
+func (_u *ApprovalDelegationUpdateOne) Where(ps ...predicate.ApprovalDelegation) *ApprovalDelegationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ApprovalDelegationUpdateOne) Select(field string, fields ...string) *ApprovalDelegationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ApprovalDelegation entity.
+func (_u *ApprovalDelegationUpdateOne) Save(ctx context.Context) (*ApprovalDelegation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ApprovalDelegationUpdateOne) SaveX(ctx context.Context) *ApprovalDelegation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ApprovalDelegationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ApprovalDelegationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ApprovalDelegationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ApprovalDelegationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ApprovalDelegationUpdateOne) sqlSave(ctx context.Context) (_node *ApprovalDelegation, err error) {
+	_spec := sqlgraph.NewUpdateSpec(approvaldelegation.Table, approvaldelegation.Columns, sqlgraph.NewFieldSpec(approvaldelegation.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ApprovalDelegation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, approvaldelegation.FieldID)
+		for _, f := range fields {
+			if !approvaldelegation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != approvaldelegation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(approvaldelegation.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(approvaldelegation.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(approvaldelegation.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(approvaldelegation.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(approvaldelegation.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(approvaldelegation.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(approvaldelegation.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(approvaldelegation.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(approvaldelegation.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(approvaldelegation.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(approvaldelegation.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(approvaldelegation.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DelegatorID(); ok {
+		_spec.SetField(approvaldelegation.FieldDelegatorID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDelegatorID(); ok {
+		_spec.AddField(approvaldelegation.FieldDelegatorID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.DelegatorName(); ok {
+		_spec.SetField(approvaldelegation.FieldDelegatorName, field.TypeString, value)
+	}
+	if _u.mutation.DelegatorNameCleared() {
+		_spec.ClearField(approvaldelegation.FieldDelegatorName, field.TypeString)
+	}
+	if value, ok := _u.mutation.DelegateID(); ok {
+		_spec.SetField(approvaldelegation.FieldDelegateID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDelegateID(); ok {
+		_spec.AddField(approvaldelegation.FieldDelegateID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.DelegateName(); ok {
+		_spec.SetField(approvaldelegation.FieldDelegateName, field.TypeString, value)
+	}
+	if _u.mutation.DelegateNameCleared() {
+		_spec.ClearField(approvaldelegation.FieldDelegateName, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(approvaldelegation.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(approvaldelegation.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AbsenceTypeIds(); ok {
+		_spec.SetField(approvaldelegation.FieldAbsenceTypeIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAbsenceTypeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, approvaldelegation.FieldAbsenceTypeIds, value)
+		})
+	}
+	if _u.mutation.AbsenceTypeIdsCleared() {
+		_spec.ClearField(approvaldelegation.FieldAbsenceTypeIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.LeaveRequestID(); ok {
+		_spec.SetField(approvaldelegation.FieldLeaveRequestID, field.TypeString, value)
+	}
+	if _u.mutation.LeaveRequestIDCleared() {
+		_spec.ClearField(approvaldelegation.FieldLeaveRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(approvaldelegation.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(approvaldelegation.FieldNotes, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ApprovalDelegation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{approvaldelegation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/blackoutperiod"
+)
+
+// BlackoutPeriod is the model entity for the BlackoutPeriod schema.
+type BlackoutPeriod struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique identifier
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 更新者ID
+	UpdateBy *uint32 `json:"update_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Blackout period name
+	Name string `json:"name,omitempty"`
+	// First day of the period
+	StartDate time.Time `json:"start_date,omitempty"`
+	// Last day of the period
+	EndDate time.Time `json:"end_date,omitempty"`
+	// Absence types the period applies to; empty for all absence types
+	AbsenceTypeIds []string `json:"absence_type_ids,omitempty"`
+	// Org units the period applies to; empty for all org units
+	OrgUnitNames []string `json:"org_unit_names,omitempty"`
+	// Notes
+	Notes        string `json:"notes,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BlackoutPeriod) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case blackoutperiod.FieldAbsenceTypeIds, blackoutperiod.FieldOrgUnitNames:
+			values[i] = new([]byte)
+		case blackoutperiod.FieldCreateBy, blackoutperiod.FieldUpdateBy, blackoutperiod.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case blackoutperiod.FieldID, blackoutperiod.FieldName, blackoutperiod.FieldNotes:
+			values[i] = new(sql.NullString)
+		case blackoutperiod.FieldCreateTime, blackoutperiod.FieldUpdateTime, blackoutperiod.FieldDeleteTime, blackoutperiod.FieldStartDate, blackoutperiod.FieldEndDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BlackoutPeriod fields.
+func (_m *BlackoutPeriod) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case blackoutperiod.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case blackoutperiod.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case blackoutperiod.FieldUpdateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_by", values[i])
+			} else if value.Valid {
+				_m.UpdateBy = new(uint32)
+				*_m.UpdateBy = uint32(value.Int64)
+			}
+		case blackoutperiod.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case blackoutperiod.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case blackoutperiod.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case blackoutperiod.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case blackoutperiod.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case blackoutperiod.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				_m.StartDate = value.Time
+			}
+		case blackoutperiod.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				_m.EndDate = value.Time
+			}
+		case blackoutperiod.FieldAbsenceTypeIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field absence_type_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AbsenceTypeIds); err != nil {
+					return fmt.Errorf("unmarshal field absence_type_ids: %w", err)
+				}
+			}
+		case blackoutperiod.FieldOrgUnitNames:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field org_unit_names", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.OrgUnitNames); err != nil {
+					return fmt.Errorf("unmarshal field org_unit_names: %w", err)
+				}
+			}
+		case blackoutperiod.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BlackoutPeriod.
+// This includes values selected through modifiers, order, etc.
+func (_m *BlackoutPeriod) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BlackoutPeriod.
+// Note that you need to call BlackoutPeriod.Unwrap() before calling this method if this BlackoutPeriod
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BlackoutPeriod) Update() *BlackoutPeriodUpdateOne {
+	return NewBlackoutPeriodClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BlackoutPeriod entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BlackoutPeriod) Unwrap() *BlackoutPeriod {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlackoutPeriod is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BlackoutPeriod) String() string {
+	var builder strings.Builder
+	builder.WriteString("BlackoutPeriod(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateBy; v != nil {
+		builder.WriteString("update_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("start_date=")
+	builder.WriteString(_m.StartDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_date=")
+	builder.WriteString(_m.EndDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("absence_type_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AbsenceTypeIds))
+	builder.WriteString(", ")
+	builder.WriteString("org_unit_names=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrgUnitNames))
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(_m.Notes)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BlackoutPeriods is a parsable slice of BlackoutPeriod.
+type BlackoutPeriods []*BlackoutPeriod
@@ -0,0 +1,670 @@
+// Code generated by ent, DO NOT EDIT.
+
+package blackoutperiod
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// UpdateBy applies equality check predicate on the "update_by" field. It's identical to UpdateByEQ.
+func UpdateBy(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldName, v))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldStartDate, v))
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldEndDate, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldNotes, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotNull(FieldCreateBy))
+}
+
+// UpdateByEQ applies the EQ predicate on the "update_by" field.
+func UpdateByEQ(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// UpdateByNEQ applies the NEQ predicate on the "update_by" field.
+func UpdateByNEQ(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNEQ(FieldUpdateBy, v))
+}
+
+// UpdateByIn applies the In predicate on the "update_by" field.
+func UpdateByIn(vs ...uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByNotIn applies the NotIn predicate on the "update_by" field.
+func UpdateByNotIn(vs ...uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByGT applies the GT predicate on the "update_by" field.
+func UpdateByGT(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGT(FieldUpdateBy, v))
+}
+
+// UpdateByGTE applies the GTE predicate on the "update_by" field.
+func UpdateByGTE(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGTE(FieldUpdateBy, v))
+}
+
+// UpdateByLT applies the LT predicate on the "update_by" field.
+func UpdateByLT(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLT(FieldUpdateBy, v))
+}
+
+// UpdateByLTE applies the LTE predicate on the "update_by" field.
+func UpdateByLTE(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLTE(FieldUpdateBy, v))
+}
+
+// UpdateByIsNil applies the IsNil predicate on the "update_by" field.
+func UpdateByIsNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIsNull(FieldUpdateBy))
+}
+
+// UpdateByNotNil applies the NotNil predicate on the "update_by" field.
+func UpdateByNotNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotNull(FieldUpdateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotNull(FieldTenantID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldContainsFold(FieldName, v))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLTE(FieldStartDate, v))
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNEQ(FieldEndDate, v))
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIn(FieldEndDate, vs...))
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotIn(FieldEndDate, vs...))
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGT(FieldEndDate, v))
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGTE(FieldEndDate, v))
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLT(FieldEndDate, v))
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLTE(FieldEndDate, v))
+}
+
+// AbsenceTypeIdsIsNil applies the IsNil predicate on the "absence_type_ids" field.
+func AbsenceTypeIdsIsNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIsNull(FieldAbsenceTypeIds))
+}
+
+// AbsenceTypeIdsNotNil applies the NotNil predicate on the "absence_type_ids" field.
+func AbsenceTypeIdsNotNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotNull(FieldAbsenceTypeIds))
+}
+
+// OrgUnitNamesIsNil applies the IsNil predicate on the "org_unit_names" field.
+func OrgUnitNamesIsNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIsNull(FieldOrgUnitNames))
+}
+
+// OrgUnitNamesNotNil applies the NotNil predicate on the "org_unit_names" field.
+func OrgUnitNamesNotNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotNull(FieldOrgUnitNames))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BlackoutPeriod) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BlackoutPeriod) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BlackoutPeriod) predicate.BlackoutPeriod {
+	return predicate.BlackoutPeriod(sql.NotPredicates(p))
+}
@@ -0,0 +1,1237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/blackoutperiod"
+)
+
+// BlackoutPeriodCreate is the builder for creating a BlackoutPeriod entity.
+type BlackoutPeriodCreate struct {
+	config
+	mutation *BlackoutPeriodMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *BlackoutPeriodCreate) SetCreateBy(v uint32) *BlackoutPeriodCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *BlackoutPeriodCreate) SetNillableCreateBy(v *uint32) *BlackoutPeriodCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *BlackoutPeriodCreate) SetUpdateBy(v uint32) *BlackoutPeriodCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *BlackoutPeriodCreate) SetNillableUpdateBy(v *uint32) *BlackoutPeriodCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *BlackoutPeriodCreate) SetCreateTime(v time.Time) *BlackoutPeriodCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *BlackoutPeriodCreate) SetNillableCreateTime(v *time.Time) *BlackoutPeriodCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *BlackoutPeriodCreate) SetUpdateTime(v time.Time) *BlackoutPeriodCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *BlackoutPeriodCreate) SetNillableUpdateTime(v *time.Time) *BlackoutPeriodCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *BlackoutPeriodCreate) SetDeleteTime(v time.Time) *BlackoutPeriodCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *BlackoutPeriodCreate) SetNillableDeleteTime(v *time.Time) *BlackoutPeriodCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *BlackoutPeriodCreate) SetTenantID(v uint32) *BlackoutPeriodCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *BlackoutPeriodCreate) SetNillableTenantID(v *uint32) *BlackoutPeriodCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BlackoutPeriodCreate) SetName(v string) *BlackoutPeriodCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetStartDate sets the "start_date" field.
+func (_c *BlackoutPeriodCreate) SetStartDate(v time.Time) *BlackoutPeriodCreate {
+	_c.mutation.SetStartDate(v)
+	return _c
+}
+
+// SetEndDate sets the "end_date" field.
+func (_c *BlackoutPeriodCreate) SetEndDate(v time.Time) *BlackoutPeriodCreate {
+	_c.mutation.SetEndDate(v)
+	return _c
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (_c *BlackoutPeriodCreate) SetAbsenceTypeIds(v []string) *BlackoutPeriodCreate {
+	_c.mutation.SetAbsenceTypeIds(v)
+	return _c
+}
+
+// SetOrgUnitNames sets the "org_unit_names" field.
+func (_c *BlackoutPeriodCreate) SetOrgUnitNames(v []string) *BlackoutPeriodCreate {
+	_c.mutation.SetOrgUnitNames(v)
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *BlackoutPeriodCreate) SetNotes(v string) *BlackoutPeriodCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *BlackoutPeriodCreate) SetNillableNotes(v *string) *BlackoutPeriodCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BlackoutPeriodCreate) SetID(v string) *BlackoutPeriodCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the BlackoutPeriodMutation object of the builder.
+func (_c *BlackoutPeriodCreate) Mutation() *BlackoutPeriodMutation {
+	return _c.mutation
+}
+
+// Save creates the BlackoutPeriod in the database.
+func (_c *BlackoutPeriodCreate) Save(ctx context.Context) (*BlackoutPeriod, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BlackoutPeriodCreate) SaveX(ctx context.Context) *BlackoutPeriod {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BlackoutPeriodCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BlackoutPeriodCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BlackoutPeriodCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := blackoutperiod.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BlackoutPeriodCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "BlackoutPeriod.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := blackoutperiod.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BlackoutPeriod.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "BlackoutPeriod.start_date"`)}
+	}
+	if _, ok := _c.mutation.EndDate(); !ok {
+		return &ValidationError{Name: "end_date", err: errors.New(`ent: missing required field "BlackoutPeriod.end_date"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := blackoutperiod.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "BlackoutPeriod.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BlackoutPeriodCreate) sqlSave(ctx context.Context) (*BlackoutPeriod, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected BlackoutPeriod.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BlackoutPeriodCreate) createSpec() (*BlackoutPeriod, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BlackoutPeriod{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(blackoutperiod.Table, sqlgraph.NewFieldSpec(blackoutperiod.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(blackoutperiod.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(blackoutperiod.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(blackoutperiod.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(blackoutperiod.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(blackoutperiod.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(blackoutperiod.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(blackoutperiod.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.StartDate(); ok {
+		_spec.SetField(blackoutperiod.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = value
+	}
+	if value, ok := _c.mutation.EndDate(); ok {
+		_spec.SetField(blackoutperiod.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = value
+	}
+	if value, ok := _c.mutation.AbsenceTypeIds(); ok {
+		_spec.SetField(blackoutperiod.FieldAbsenceTypeIds, field.TypeJSON, value)
+		_node.AbsenceTypeIds = value
+	}
+	if value, ok := _c.mutation.OrgUnitNames(); ok {
+		_spec.SetField(blackoutperiod.FieldOrgUnitNames, field.TypeJSON, value)
+		_node.OrgUnitNames = value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(blackoutperiod.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BlackoutPeriod.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlackoutPeriodUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BlackoutPeriodCreate) OnConflict(opts ...sql.ConflictOption) *BlackoutPeriodUpsertOne {
+	_c.conflict = opts
+	return &BlackoutPeriodUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BlackoutPeriod.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BlackoutPeriodCreate) OnConflictColumns(columns ...string) *BlackoutPeriodUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BlackoutPeriodUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BlackoutPeriodUpsertOne is the builder for "upsert"-ing
+	//  one BlackoutPeriod node.
+	BlackoutPeriodUpsertOne struct {
+		create *BlackoutPeriodCreate
+	}
+
+	// BlackoutPeriodUpsert is the "OnConflict" setter.
+	BlackoutPeriodUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *BlackoutPeriodUpsert) SetCreateBy(v uint32) *BlackoutPeriodUpsert {
+	u.Set(blackoutperiod.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsert) UpdateCreateBy() *BlackoutPeriodUpsert {
+	u.SetExcluded(blackoutperiod.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *BlackoutPeriodUpsert) AddCreateBy(v uint32) *BlackoutPeriodUpsert {
+	u.Add(blackoutperiod.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *BlackoutPeriodUpsert) ClearCreateBy() *BlackoutPeriodUpsert {
+	u.SetNull(blackoutperiod.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *BlackoutPeriodUpsert) SetUpdateBy(v uint32) *BlackoutPeriodUpsert {
+	u.Set(blackoutperiod.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsert) UpdateUpdateBy() *BlackoutPeriodUpsert {
+	u.SetExcluded(blackoutperiod.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *BlackoutPeriodUpsert) AddUpdateBy(v uint32) *BlackoutPeriodUpsert {
+	u.Add(blackoutperiod.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *BlackoutPeriodUpsert) ClearUpdateBy() *BlackoutPeriodUpsert {
+	u.SetNull(blackoutperiod.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *BlackoutPeriodUpsert) SetUpdateTime(v time.Time) *BlackoutPeriodUpsert {
+	u.Set(blackoutperiod.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsert) UpdateUpdateTime() *BlackoutPeriodUpsert {
+	u.SetExcluded(blackoutperiod.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *BlackoutPeriodUpsert) ClearUpdateTime() *BlackoutPeriodUpsert {
+	u.SetNull(blackoutperiod.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *BlackoutPeriodUpsert) SetDeleteTime(v time.Time) *BlackoutPeriodUpsert {
+	u.Set(blackoutperiod.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsert) UpdateDeleteTime() *BlackoutPeriodUpsert {
+	u.SetExcluded(blackoutperiod.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *BlackoutPeriodUpsert) ClearDeleteTime() *BlackoutPeriodUpsert {
+	u.SetNull(blackoutperiod.FieldDeleteTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *BlackoutPeriodUpsert) SetName(v string) *BlackoutPeriodUpsert {
+	u.Set(blackoutperiod.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsert) UpdateName() *BlackoutPeriodUpsert {
+	u.SetExcluded(blackoutperiod.FieldName)
+	return u
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *BlackoutPeriodUpsert) SetStartDate(v time.Time) *BlackoutPeriodUpsert {
+	u.Set(blackoutperiod.FieldStartDate, v)
+	return u
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsert) UpdateStartDate() *BlackoutPeriodUpsert {
+	u.SetExcluded(blackoutperiod.FieldStartDate)
+	return u
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *BlackoutPeriodUpsert) SetEndDate(v time.Time) *BlackoutPeriodUpsert {
+	u.Set(blackoutperiod.FieldEndDate, v)
+	return u
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsert) UpdateEndDate() *BlackoutPeriodUpsert {
+	u.SetExcluded(blackoutperiod.FieldEndDate)
+	return u
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (u *BlackoutPeriodUpsert) SetAbsenceTypeIds(v []string) *BlackoutPeriodUpsert {
+	u.Set(blackoutperiod.FieldAbsenceTypeIds, v)
+	return u
+}
+
+// UpdateAbsenceTypeIds sets the "absence_type_ids" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsert) UpdateAbsenceTypeIds() *BlackoutPeriodUpsert {
+	u.SetExcluded(blackoutperiod.FieldAbsenceTypeIds)
+	return u
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (u *BlackoutPeriodUpsert) ClearAbsenceTypeIds() *BlackoutPeriodUpsert {
+	u.SetNull(blackoutperiod.FieldAbsenceTypeIds)
+	return u
+}
+
+// SetOrgUnitNames sets the "org_unit_names" field.
+func (u *BlackoutPeriodUpsert) SetOrgUnitNames(v []string) *BlackoutPeriodUpsert {
+	u.Set(blackoutperiod.FieldOrgUnitNames, v)
+	return u
+}
+
+// UpdateOrgUnitNames sets the "org_unit_names" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsert) UpdateOrgUnitNames() *BlackoutPeriodUpsert {
+	u.SetExcluded(blackoutperiod.FieldOrgUnitNames)
+	return u
+}
+
+// ClearOrgUnitNames clears the value of the "org_unit_names" field.
+func (u *BlackoutPeriodUpsert) ClearOrgUnitNames() *BlackoutPeriodUpsert {
+	u.SetNull(blackoutperiod.FieldOrgUnitNames)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *BlackoutPeriodUpsert) SetNotes(v string) *BlackoutPeriodUpsert {
+	u.Set(blackoutperiod.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsert) UpdateNotes() *BlackoutPeriodUpsert {
+	u.SetExcluded(blackoutperiod.FieldNotes)
+	return u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *BlackoutPeriodUpsert) ClearNotes() *BlackoutPeriodUpsert {
+	u.SetNull(blackoutperiod.FieldNotes)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BlackoutPeriod.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(blackoutperiod.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BlackoutPeriodUpsertOne) UpdateNewValues() *BlackoutPeriodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(blackoutperiod.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(blackoutperiod.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(blackoutperiod.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BlackoutPeriod.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BlackoutPeriodUpsertOne) Ignore() *BlackoutPeriodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlackoutPeriodUpsertOne) DoNothing() *BlackoutPeriodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlackoutPeriodCreate.OnConflict
+// documentation for more info.
+func (u *BlackoutPeriodUpsertOne) Update(set func(*BlackoutPeriodUpsert)) *BlackoutPeriodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlackoutPeriodUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *BlackoutPeriodUpsertOne) SetCreateBy(v uint32) *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *BlackoutPeriodUpsertOne) AddCreateBy(v uint32) *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertOne) UpdateCreateBy() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *BlackoutPeriodUpsertOne) ClearCreateBy() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *BlackoutPeriodUpsertOne) SetUpdateBy(v uint32) *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *BlackoutPeriodUpsertOne) AddUpdateBy(v uint32) *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertOne) UpdateUpdateBy() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *BlackoutPeriodUpsertOne) ClearUpdateBy() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *BlackoutPeriodUpsertOne) SetUpdateTime(v time.Time) *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertOne) UpdateUpdateTime() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *BlackoutPeriodUpsertOne) ClearUpdateTime() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *BlackoutPeriodUpsertOne) SetDeleteTime(v time.Time) *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertOne) UpdateDeleteTime() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *BlackoutPeriodUpsertOne) ClearDeleteTime() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *BlackoutPeriodUpsertOne) SetName(v string) *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertOne) UpdateName() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *BlackoutPeriodUpsertOne) SetStartDate(v time.Time) *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertOne) UpdateStartDate() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *BlackoutPeriodUpsertOne) SetEndDate(v time.Time) *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertOne) UpdateEndDate() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (u *BlackoutPeriodUpsertOne) SetAbsenceTypeIds(v []string) *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetAbsenceTypeIds(v)
+	})
+}
+
+// UpdateAbsenceTypeIds sets the "absence_type_ids" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertOne) UpdateAbsenceTypeIds() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateAbsenceTypeIds()
+	})
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (u *BlackoutPeriodUpsertOne) ClearAbsenceTypeIds() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.ClearAbsenceTypeIds()
+	})
+}
+
+// SetOrgUnitNames sets the "org_unit_names" field.
+func (u *BlackoutPeriodUpsertOne) SetOrgUnitNames(v []string) *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetOrgUnitNames(v)
+	})
+}
+
+// UpdateOrgUnitNames sets the "org_unit_names" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertOne) UpdateOrgUnitNames() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateOrgUnitNames()
+	})
+}
+
+// ClearOrgUnitNames clears the value of the "org_unit_names" field.
+func (u *BlackoutPeriodUpsertOne) ClearOrgUnitNames() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.ClearOrgUnitNames()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *BlackoutPeriodUpsertOne) SetNotes(v string) *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertOne) UpdateNotes() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *BlackoutPeriodUpsertOne) ClearNotes() *BlackoutPeriodUpsertOne {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// Exec executes the query.
+func (u *BlackoutPeriodUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlackoutPeriodCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlackoutPeriodUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BlackoutPeriodUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BlackoutPeriodUpsertOne.ID is not supported by MySQL driver. Use BlackoutPeriodUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BlackoutPeriodUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BlackoutPeriodCreateBulk is the builder for creating many BlackoutPeriod entities in bulk.
+type BlackoutPeriodCreateBulk struct {
+	config
+	err      error
+	builders []*BlackoutPeriodCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BlackoutPeriod entities in the database.
+func (_c *BlackoutPeriodCreateBulk) Save(ctx context.Context) ([]*BlackoutPeriod, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BlackoutPeriod, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlackoutPeriodMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BlackoutPeriodCreateBulk) SaveX(ctx context.Context) []*BlackoutPeriod {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BlackoutPeriodCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BlackoutPeriodCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BlackoutPeriod.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlackoutPeriodUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BlackoutPeriodCreateBulk) OnConflict(opts ...sql.ConflictOption) *BlackoutPeriodUpsertBulk {
+	_c.conflict = opts
+	return &BlackoutPeriodUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BlackoutPeriod.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BlackoutPeriodCreateBulk) OnConflictColumns(columns ...string) *BlackoutPeriodUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BlackoutPeriodUpsertBulk{
+		create: _c,
+	}
+}
+
+// BlackoutPeriodUpsertBulk is the builder for "upsert"-ing
+// a bulk of BlackoutPeriod nodes.
+type BlackoutPeriodUpsertBulk struct {
+	create *BlackoutPeriodCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BlackoutPeriod.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(blackoutperiod.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BlackoutPeriodUpsertBulk) UpdateNewValues() *BlackoutPeriodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(blackoutperiod.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(blackoutperiod.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(blackoutperiod.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BlackoutPeriod.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BlackoutPeriodUpsertBulk) Ignore() *BlackoutPeriodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlackoutPeriodUpsertBulk) DoNothing() *BlackoutPeriodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlackoutPeriodCreateBulk.OnConflict
+// documentation for more info.
+func (u *BlackoutPeriodUpsertBulk) Update(set func(*BlackoutPeriodUpsert)) *BlackoutPeriodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlackoutPeriodUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *BlackoutPeriodUpsertBulk) SetCreateBy(v uint32) *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *BlackoutPeriodUpsertBulk) AddCreateBy(v uint32) *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertBulk) UpdateCreateBy() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *BlackoutPeriodUpsertBulk) ClearCreateBy() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *BlackoutPeriodUpsertBulk) SetUpdateBy(v uint32) *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *BlackoutPeriodUpsertBulk) AddUpdateBy(v uint32) *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertBulk) UpdateUpdateBy() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *BlackoutPeriodUpsertBulk) ClearUpdateBy() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *BlackoutPeriodUpsertBulk) SetUpdateTime(v time.Time) *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertBulk) UpdateUpdateTime() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *BlackoutPeriodUpsertBulk) ClearUpdateTime() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *BlackoutPeriodUpsertBulk) SetDeleteTime(v time.Time) *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertBulk) UpdateDeleteTime() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *BlackoutPeriodUpsertBulk) ClearDeleteTime() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *BlackoutPeriodUpsertBulk) SetName(v string) *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertBulk) UpdateName() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *BlackoutPeriodUpsertBulk) SetStartDate(v time.Time) *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertBulk) UpdateStartDate() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *BlackoutPeriodUpsertBulk) SetEndDate(v time.Time) *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertBulk) UpdateEndDate() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (u *BlackoutPeriodUpsertBulk) SetAbsenceTypeIds(v []string) *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetAbsenceTypeIds(v)
+	})
+}
+
+// UpdateAbsenceTypeIds sets the "absence_type_ids" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertBulk) UpdateAbsenceTypeIds() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateAbsenceTypeIds()
+	})
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (u *BlackoutPeriodUpsertBulk) ClearAbsenceTypeIds() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.ClearAbsenceTypeIds()
+	})
+}
+
+// SetOrgUnitNames sets the "org_unit_names" field.
+func (u *BlackoutPeriodUpsertBulk) SetOrgUnitNames(v []string) *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetOrgUnitNames(v)
+	})
+}
+
+// UpdateOrgUnitNames sets the "org_unit_names" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertBulk) UpdateOrgUnitNames() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateOrgUnitNames()
+	})
+}
+
+// ClearOrgUnitNames clears the value of the "org_unit_names" field.
+func (u *BlackoutPeriodUpsertBulk) ClearOrgUnitNames() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.ClearOrgUnitNames()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *BlackoutPeriodUpsertBulk) SetNotes(v string) *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *BlackoutPeriodUpsertBulk) UpdateNotes() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *BlackoutPeriodUpsertBulk) ClearNotes() *BlackoutPeriodUpsertBulk {
+	return u.Update(func(s *BlackoutPeriodUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// Exec executes the query.
+func (u *BlackoutPeriodUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BlackoutPeriodCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlackoutPeriodCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlackoutPeriodUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/blackoutperiod"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// BlackoutPeriodDelete is the builder for deleting a BlackoutPeriod entity.
+type BlackoutPeriodDelete struct {
+	config
+	hooks    []Hook
+	mutation *BlackoutPeriodMutation
+}
+
+// Where appends a list predicates to the BlackoutPeriodDelete builder.
+func (_d *BlackoutPeriodDelete) Where(ps ...predicate.BlackoutPeriod) *BlackoutPeriodDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BlackoutPeriodDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BlackoutPeriodDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BlackoutPeriodDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(blackoutperiod.Table, sqlgraph.NewFieldSpec(blackoutperiod.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BlackoutPeriodDeleteOne is the builder for deleting a single BlackoutPeriod entity.
+type BlackoutPeriodDeleteOne struct {
+	_d *BlackoutPeriodDelete
+}
+
+// Where appends a list predicates to the BlackoutPeriodDelete builder.
+func (_d *BlackoutPeriodDeleteOne) Where(ps ...predicate.BlackoutPeriod) *BlackoutPeriodDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BlackoutPeriodDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{blackoutperiod.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BlackoutPeriodDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
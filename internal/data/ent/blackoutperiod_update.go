@@ -0,0 +1,749 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/blackoutperiod"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// BlackoutPeriodUpdate is the builder for updating BlackoutPeriod entities.
+type BlackoutPeriodUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BlackoutPeriodMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BlackoutPeriodUpdate builder.
+func (_u *BlackoutPeriodUpdate) Where(ps ...predicate.BlackoutPeriod) *BlackoutPeriodUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *BlackoutPeriodUpdate) SetCreateBy(v uint32) *BlackoutPeriodUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdate) SetNillableCreateBy(v *uint32) *BlackoutPeriodUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *BlackoutPeriodUpdate) AddCreateBy(v int32) *BlackoutPeriodUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *BlackoutPeriodUpdate) ClearCreateBy() *BlackoutPeriodUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *BlackoutPeriodUpdate) SetUpdateBy(v uint32) *BlackoutPeriodUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdate) SetNillableUpdateBy(v *uint32) *BlackoutPeriodUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *BlackoutPeriodUpdate) AddUpdateBy(v int32) *BlackoutPeriodUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *BlackoutPeriodUpdate) ClearUpdateBy() *BlackoutPeriodUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *BlackoutPeriodUpdate) SetUpdateTime(v time.Time) *BlackoutPeriodUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdate) SetNillableUpdateTime(v *time.Time) *BlackoutPeriodUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *BlackoutPeriodUpdate) ClearUpdateTime() *BlackoutPeriodUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *BlackoutPeriodUpdate) SetDeleteTime(v time.Time) *BlackoutPeriodUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdate) SetNillableDeleteTime(v *time.Time) *BlackoutPeriodUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *BlackoutPeriodUpdate) ClearDeleteTime() *BlackoutPeriodUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BlackoutPeriodUpdate) SetName(v string) *BlackoutPeriodUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdate) SetNillableName(v *string) *BlackoutPeriodUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *BlackoutPeriodUpdate) SetStartDate(v time.Time) *BlackoutPeriodUpdate {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdate) SetNillableStartDate(v *time.Time) *BlackoutPeriodUpdate {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *BlackoutPeriodUpdate) SetEndDate(v time.Time) *BlackoutPeriodUpdate {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdate) SetNillableEndDate(v *time.Time) *BlackoutPeriodUpdate {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (_u *BlackoutPeriodUpdate) SetAbsenceTypeIds(v []string) *BlackoutPeriodUpdate {
+	_u.mutation.SetAbsenceTypeIds(v)
+	return _u
+}
+
+// AppendAbsenceTypeIds appends value to the "absence_type_ids" field.
+func (_u *BlackoutPeriodUpdate) AppendAbsenceTypeIds(v []string) *BlackoutPeriodUpdate {
+	_u.mutation.AppendAbsenceTypeIds(v)
+	return _u
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (_u *BlackoutPeriodUpdate) ClearAbsenceTypeIds() *BlackoutPeriodUpdate {
+	_u.mutation.ClearAbsenceTypeIds()
+	return _u
+}
+
+// SetOrgUnitNames sets the "org_unit_names" field.
+func (_u *BlackoutPeriodUpdate) SetOrgUnitNames(v []string) *BlackoutPeriodUpdate {
+	_u.mutation.SetOrgUnitNames(v)
+	return _u
+}
+
+// AppendOrgUnitNames appends value to the "org_unit_names" field.
+func (_u *BlackoutPeriodUpdate) AppendOrgUnitNames(v []string) *BlackoutPeriodUpdate {
+	_u.mutation.AppendOrgUnitNames(v)
+	return _u
+}
+
+// ClearOrgUnitNames clears the value of the "org_unit_names" field.
+func (_u *BlackoutPeriodUpdate) ClearOrgUnitNames() *BlackoutPeriodUpdate {
+	_u.mutation.ClearOrgUnitNames()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *BlackoutPeriodUpdate) SetNotes(v string) *BlackoutPeriodUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdate) SetNillableNotes(v *string) *BlackoutPeriodUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *BlackoutPeriodUpdate) ClearNotes() *BlackoutPeriodUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// Mutation returns the BlackoutPeriodMutation object of the builder.
+func (_u *BlackoutPeriodUpdate) Mutation() *BlackoutPeriodMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BlackoutPeriodUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlackoutPeriodUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BlackoutPeriodUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlackoutPeriodUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BlackoutPeriodUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := blackoutperiod.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BlackoutPeriod.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BlackoutPeriodUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlackoutPeriodUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BlackoutPeriodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blackoutperiod.Table, blackoutperiod.Columns, sqlgraph.NewFieldSpec(blackoutperiod.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(blackoutperiod.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(blackoutperiod.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(blackoutperiod.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(blackoutperiod.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(blackoutperiod.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(blackoutperiod.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(blackoutperiod.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(blackoutperiod.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(blackoutperiod.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(blackoutperiod.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(blackoutperiod.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(blackoutperiod.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(blackoutperiod.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(blackoutperiod.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(blackoutperiod.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AbsenceTypeIds(); ok {
+		_spec.SetField(blackoutperiod.FieldAbsenceTypeIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAbsenceTypeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, blackoutperiod.FieldAbsenceTypeIds, value)
+		})
+	}
+	if _u.mutation.AbsenceTypeIdsCleared() {
+		_spec.ClearField(blackoutperiod.FieldAbsenceTypeIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OrgUnitNames(); ok {
+		_spec.SetField(blackoutperiod.FieldOrgUnitNames, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOrgUnitNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, blackoutperiod.FieldOrgUnitNames, value)
+		})
+	}
+	if _u.mutation.OrgUnitNamesCleared() {
+		_spec.ClearField(blackoutperiod.FieldOrgUnitNames, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(blackoutperiod.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(blackoutperiod.FieldNotes, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blackoutperiod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BlackoutPeriodUpdateOne is the builder for updating a single BlackoutPeriod entity.
+type BlackoutPeriodUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BlackoutPeriodMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *BlackoutPeriodUpdateOne) SetCreateBy(v uint32) *BlackoutPeriodUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdateOne) SetNillableCreateBy(v *uint32) *BlackoutPeriodUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *BlackoutPeriodUpdateOne) AddCreateBy(v int32) *BlackoutPeriodUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *BlackoutPeriodUpdateOne) ClearCreateBy() *BlackoutPeriodUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *BlackoutPeriodUpdateOne) SetUpdateBy(v uint32) *BlackoutPeriodUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdateOne) SetNillableUpdateBy(v *uint32) *BlackoutPeriodUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *BlackoutPeriodUpdateOne) AddUpdateBy(v int32) *BlackoutPeriodUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *BlackoutPeriodUpdateOne) ClearUpdateBy() *BlackoutPeriodUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *BlackoutPeriodUpdateOne) SetUpdateTime(v time.Time) *BlackoutPeriodUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdateOne) SetNillableUpdateTime(v *time.Time) *BlackoutPeriodUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *BlackoutPeriodUpdateOne) ClearUpdateTime() *BlackoutPeriodUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *BlackoutPeriodUpdateOne) SetDeleteTime(v time.Time) *BlackoutPeriodUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdateOne) SetNillableDeleteTime(v *time.Time) *BlackoutPeriodUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *BlackoutPeriodUpdateOne) ClearDeleteTime() *BlackoutPeriodUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BlackoutPeriodUpdateOne) SetName(v string) *BlackoutPeriodUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdateOne) SetNillableName(v *string) *BlackoutPeriodUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *BlackoutPeriodUpdateOne) SetStartDate(v time.Time) *BlackoutPeriodUpdateOne {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdateOne) SetNillableStartDate(v *time.Time) *BlackoutPeriodUpdateOne {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *BlackoutPeriodUpdateOne) SetEndDate(v time.Time) *BlackoutPeriodUpdateOne {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdateOne) SetNillableEndDate(v *time.Time) *BlackoutPeriodUpdateOne {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (_u *BlackoutPeriodUpdateOne) SetAbsenceTypeIds(v []string) *BlackoutPeriodUpdateOne {
+	_u.mutation.SetAbsenceTypeIds(v)
+	return _u
+}
+
+// AppendAbsenceTypeIds appends value to the "absence_type_ids" field.
+func (_u *BlackoutPeriodUpdateOne) AppendAbsenceTypeIds(v []string) *BlackoutPeriodUpdateOne {
+	_u.mutation.AppendAbsenceTypeIds(v)
+	return _u
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (_u *BlackoutPeriodUpdateOne) ClearAbsenceTypeIds() *BlackoutPeriodUpdateOne {
+	_u.mutation.ClearAbsenceTypeIds()
+	return _u
+}
+
+// SetOrgUnitNames sets the "org_unit_names" field.
+func (_u *BlackoutPeriodUpdateOne) SetOrgUnitNames(v []string) *BlackoutPeriodUpdateOne {
+	_u.mutation.SetOrgUnitNames(v)
+	return _u
+}
+
+// AppendOrgUnitNames appends value to the "org_unit_names" field.
+func (_u *BlackoutPeriodUpdateOne) AppendOrgUnitNames(v []string) *BlackoutPeriodUpdateOne {
+	_u.mutation.AppendOrgUnitNames(v)
+	return _u
+}
+
+// ClearOrgUnitNames clears the value of the "org_unit_names" field.
+func (_u *BlackoutPeriodUpdateOne) ClearOrgUnitNames() *BlackoutPeriodUpdateOne {
+	_u.mutation.ClearOrgUnitNames()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *BlackoutPeriodUpdateOne) SetNotes(v string) *BlackoutPeriodUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *BlackoutPeriodUpdateOne) SetNillableNotes(v *string) *BlackoutPeriodUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *BlackoutPeriodUpdateOne) ClearNotes() *BlackoutPeriodUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// Mutation returns the BlackoutPeriodMutation object of the builder.
+func (_u *BlackoutPeriodUpdateOne) Mutation() *BlackoutPeriodMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BlackoutPeriodUpdate builder.
+func (_u *BlackoutPeriodUpdateOne) Where(ps ...predicate.BlackoutPeriod) *BlackoutPeriodUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BlackoutPeriodUpdateOne) Select(field string, fields ...string) *BlackoutPeriodUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BlackoutPeriod entity.
+func (_u *BlackoutPeriodUpdateOne) Save(ctx context.Context) (*BlackoutPeriod, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlackoutPeriodUpdateOne) SaveX(ctx context.Context) *BlackoutPeriod {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BlackoutPeriodUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlackoutPeriodUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BlackoutPeriodUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := blackoutperiod.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BlackoutPeriod.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BlackoutPeriodUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlackoutPeriodUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BlackoutPeriodUpdateOne) sqlSave(ctx context.Context) (_node *BlackoutPeriod, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blackoutperiod.Table, blackoutperiod.Columns, sqlgraph.NewFieldSpec(blackoutperiod.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BlackoutPeriod.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blackoutperiod.FieldID)
+		for _, f := range fields {
+			if !blackoutperiod.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blackoutperiod.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(blackoutperiod.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(blackoutperiod.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(blackoutperiod.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(blackoutperiod.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(blackoutperiod.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(blackoutperiod.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(blackoutperiod.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(blackoutperiod.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(blackoutperiod.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(blackoutperiod.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(blackoutperiod.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(blackoutperiod.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(blackoutperiod.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(blackoutperiod.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(blackoutperiod.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AbsenceTypeIds(); ok {
+		_spec.SetField(blackoutperiod.FieldAbsenceTypeIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAbsenceTypeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, blackoutperiod.FieldAbsenceTypeIds, value)
+		})
+	}
+	if _u.mutation.AbsenceTypeIdsCleared() {
+		_spec.ClearField(blackoutperiod.FieldAbsenceTypeIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OrgUnitNames(); ok {
+		_spec.SetField(blackoutperiod.FieldOrgUnitNames, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOrgUnitNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, blackoutperiod.FieldOrgUnitNames, value)
+		})
+	}
+	if _u.mutation.OrgUnitNamesCleared() {
+		_spec.ClearField(blackoutperiod.FieldOrgUnitNames, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(blackoutperiod.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(blackoutperiod.FieldNotes, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &BlackoutPeriod{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blackoutperiod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
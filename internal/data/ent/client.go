@@ -17,9 +17,26 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancepool"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancetransaction"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/approvaldelegation"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/blackoutperiod"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/coveragerule"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/employment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holiday"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holidaycalendar"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveallowance"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveamendment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveattachment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavecomment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavepolicy"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/outboxmessage"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/processedevent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/webhookdelivery"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/webhooksubscription"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workschedule"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workscheduleassignment"
 )
 
 // Client is the client that holds all ent builders.
@@ -31,12 +48,46 @@ type Client struct {
 	AbsenceType *AbsenceTypeClient
 	// AllowancePool is the client for interacting with the AllowancePool builders.
 	AllowancePool *AllowancePoolClient
+	// AllowanceTransaction is the client for interacting with the AllowanceTransaction builders.
+	AllowanceTransaction *AllowanceTransactionClient
+	// ApprovalDelegation is the client for interacting with the ApprovalDelegation builders.
+	ApprovalDelegation *ApprovalDelegationClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// BlackoutPeriod is the client for interacting with the BlackoutPeriod builders.
+	BlackoutPeriod *BlackoutPeriodClient
+	// CoverageRule is the client for interacting with the CoverageRule builders.
+	CoverageRule *CoverageRuleClient
+	// Employment is the client for interacting with the Employment builders.
+	Employment *EmploymentClient
+	// Holiday is the client for interacting with the Holiday builders.
+	Holiday *HolidayClient
+	// HolidayCalendar is the client for interacting with the HolidayCalendar builders.
+	HolidayCalendar *HolidayCalendarClient
 	// LeaveAllowance is the client for interacting with the LeaveAllowance builders.
 	LeaveAllowance *LeaveAllowanceClient
+	// LeaveAmendment is the client for interacting with the LeaveAmendment builders.
+	LeaveAmendment *LeaveAmendmentClient
+	// LeaveAttachment is the client for interacting with the LeaveAttachment builders.
+	LeaveAttachment *LeaveAttachmentClient
+	// LeaveComment is the client for interacting with the LeaveComment builders.
+	LeaveComment *LeaveCommentClient
+	// LeavePolicy is the client for interacting with the LeavePolicy builders.
+	LeavePolicy *LeavePolicyClient
 	// LeaveRequest is the client for interacting with the LeaveRequest builders.
 	LeaveRequest *LeaveRequestClient
+	// OutboxMessage is the client for interacting with the OutboxMessage builders.
+	OutboxMessage *OutboxMessageClient
+	// ProcessedEvent is the client for interacting with the ProcessedEvent builders.
+	ProcessedEvent *ProcessedEventClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
+	// WorkSchedule is the client for interacting with the WorkSchedule builders.
+	WorkSchedule *WorkScheduleClient
+	// WorkScheduleAssignment is the client for interacting with the WorkScheduleAssignment builders.
+	WorkScheduleAssignment *WorkScheduleAssignmentClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -50,9 +101,26 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AbsenceType = NewAbsenceTypeClient(c.config)
 	c.AllowancePool = NewAllowancePoolClient(c.config)
+	c.AllowanceTransaction = NewAllowanceTransactionClient(c.config)
+	c.ApprovalDelegation = NewApprovalDelegationClient(c.config)
 	c.AuditLog = NewAuditLogClient(c.config)
+	c.BlackoutPeriod = NewBlackoutPeriodClient(c.config)
+	c.CoverageRule = NewCoverageRuleClient(c.config)
+	c.Employment = NewEmploymentClient(c.config)
+	c.Holiday = NewHolidayClient(c.config)
+	c.HolidayCalendar = NewHolidayCalendarClient(c.config)
 	c.LeaveAllowance = NewLeaveAllowanceClient(c.config)
+	c.LeaveAmendment = NewLeaveAmendmentClient(c.config)
+	c.LeaveAttachment = NewLeaveAttachmentClient(c.config)
+	c.LeaveComment = NewLeaveCommentClient(c.config)
+	c.LeavePolicy = NewLeavePolicyClient(c.config)
 	c.LeaveRequest = NewLeaveRequestClient(c.config)
+	c.OutboxMessage = NewOutboxMessageClient(c.config)
+	c.ProcessedEvent = NewProcessedEventClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookSubscription = NewWebhookSubscriptionClient(c.config)
+	c.WorkSchedule = NewWorkScheduleClient(c.config)
+	c.WorkScheduleAssignment = NewWorkScheduleAssignmentClient(c.config)
 }
 
 type (
@@ -143,13 +211,30 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		AbsenceType:    NewAbsenceTypeClient(cfg),
-		AllowancePool:  NewAllowancePoolClient(cfg),
-		AuditLog:       NewAuditLogClient(cfg),
-		LeaveAllowance: NewLeaveAllowanceClient(cfg),
-		LeaveRequest:   NewLeaveRequestClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AbsenceType:            NewAbsenceTypeClient(cfg),
+		AllowancePool:          NewAllowancePoolClient(cfg),
+		AllowanceTransaction:   NewAllowanceTransactionClient(cfg),
+		ApprovalDelegation:     NewApprovalDelegationClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
+		BlackoutPeriod:         NewBlackoutPeriodClient(cfg),
+		CoverageRule:           NewCoverageRuleClient(cfg),
+		Employment:             NewEmploymentClient(cfg),
+		Holiday:                NewHolidayClient(cfg),
+		HolidayCalendar:        NewHolidayCalendarClient(cfg),
+		LeaveAllowance:         NewLeaveAllowanceClient(cfg),
+		LeaveAmendment:         NewLeaveAmendmentClient(cfg),
+		LeaveAttachment:        NewLeaveAttachmentClient(cfg),
+		LeaveComment:           NewLeaveCommentClient(cfg),
+		LeavePolicy:            NewLeavePolicyClient(cfg),
+		LeaveRequest:           NewLeaveRequestClient(cfg),
+		OutboxMessage:          NewOutboxMessageClient(cfg),
+		ProcessedEvent:         NewProcessedEventClient(cfg),
+		WebhookDelivery:        NewWebhookDeliveryClient(cfg),
+		WebhookSubscription:    NewWebhookSubscriptionClient(cfg),
+		WorkSchedule:           NewWorkScheduleClient(cfg),
+		WorkScheduleAssignment: NewWorkScheduleAssignmentClient(cfg),
 	}, nil
 }
 
@@ -167,13 +252,30 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		AbsenceType:    NewAbsenceTypeClient(cfg),
-		AllowancePool:  NewAllowancePoolClient(cfg),
-		AuditLog:       NewAuditLogClient(cfg),
-		LeaveAllowance: NewLeaveAllowanceClient(cfg),
-		LeaveRequest:   NewLeaveRequestClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		AbsenceType:            NewAbsenceTypeClient(cfg),
+		AllowancePool:          NewAllowancePoolClient(cfg),
+		AllowanceTransaction:   NewAllowanceTransactionClient(cfg),
+		ApprovalDelegation:     NewApprovalDelegationClient(cfg),
+		AuditLog:               NewAuditLogClient(cfg),
+		BlackoutPeriod:         NewBlackoutPeriodClient(cfg),
+		CoverageRule:           NewCoverageRuleClient(cfg),
+		Employment:             NewEmploymentClient(cfg),
+		Holiday:                NewHolidayClient(cfg),
+		HolidayCalendar:        NewHolidayCalendarClient(cfg),
+		LeaveAllowance:         NewLeaveAllowanceClient(cfg),
+		LeaveAmendment:         NewLeaveAmendmentClient(cfg),
+		LeaveAttachment:        NewLeaveAttachmentClient(cfg),
+		LeaveComment:           NewLeaveCommentClient(cfg),
+		LeavePolicy:            NewLeavePolicyClient(cfg),
+		LeaveRequest:           NewLeaveRequestClient(cfg),
+		OutboxMessage:          NewOutboxMessageClient(cfg),
+		ProcessedEvent:         NewProcessedEventClient(cfg),
+		WebhookDelivery:        NewWebhookDeliveryClient(cfg),
+		WebhookSubscription:    NewWebhookSubscriptionClient(cfg),
+		WorkSchedule:           NewWorkScheduleClient(cfg),
+		WorkScheduleAssignment: NewWorkScheduleAssignmentClient(cfg),
 	}, nil
 }
 
@@ -202,21 +304,31 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.AbsenceType.Use(hooks...)
-	c.AllowancePool.Use(hooks...)
-	c.AuditLog.Use(hooks...)
-	c.LeaveAllowance.Use(hooks...)
-	c.LeaveRequest.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AbsenceType, c.AllowancePool, c.AllowanceTransaction, c.ApprovalDelegation,
+		c.AuditLog, c.BlackoutPeriod, c.CoverageRule, c.Employment, c.Holiday,
+		c.HolidayCalendar, c.LeaveAllowance, c.LeaveAmendment, c.LeaveAttachment,
+		c.LeaveComment, c.LeavePolicy, c.LeaveRequest, c.OutboxMessage,
+		c.ProcessedEvent, c.WebhookDelivery, c.WebhookSubscription, c.WorkSchedule,
+		c.WorkScheduleAssignment,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.AbsenceType.Intercept(interceptors...)
-	c.AllowancePool.Intercept(interceptors...)
-	c.AuditLog.Intercept(interceptors...)
-	c.LeaveAllowance.Intercept(interceptors...)
-	c.LeaveRequest.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AbsenceType, c.AllowancePool, c.AllowanceTransaction, c.ApprovalDelegation,
+		c.AuditLog, c.BlackoutPeriod, c.CoverageRule, c.Employment, c.Holiday,
+		c.HolidayCalendar, c.LeaveAllowance, c.LeaveAmendment, c.LeaveAttachment,
+		c.LeaveComment, c.LeavePolicy, c.LeaveRequest, c.OutboxMessage,
+		c.ProcessedEvent, c.WebhookDelivery, c.WebhookSubscription, c.WorkSchedule,
+		c.WorkScheduleAssignment,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -226,12 +338,46 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.AbsenceType.mutate(ctx, m)
 	case *AllowancePoolMutation:
 		return c.AllowancePool.mutate(ctx, m)
+	case *AllowanceTransactionMutation:
+		return c.AllowanceTransaction.mutate(ctx, m)
+	case *ApprovalDelegationMutation:
+		return c.ApprovalDelegation.mutate(ctx, m)
 	case *AuditLogMutation:
 		return c.AuditLog.mutate(ctx, m)
+	case *BlackoutPeriodMutation:
+		return c.BlackoutPeriod.mutate(ctx, m)
+	case *CoverageRuleMutation:
+		return c.CoverageRule.mutate(ctx, m)
+	case *EmploymentMutation:
+		return c.Employment.mutate(ctx, m)
+	case *HolidayMutation:
+		return c.Holiday.mutate(ctx, m)
+	case *HolidayCalendarMutation:
+		return c.HolidayCalendar.mutate(ctx, m)
 	case *LeaveAllowanceMutation:
 		return c.LeaveAllowance.mutate(ctx, m)
+	case *LeaveAmendmentMutation:
+		return c.LeaveAmendment.mutate(ctx, m)
+	case *LeaveAttachmentMutation:
+		return c.LeaveAttachment.mutate(ctx, m)
+	case *LeaveCommentMutation:
+		return c.LeaveComment.mutate(ctx, m)
+	case *LeavePolicyMutation:
+		return c.LeavePolicy.mutate(ctx, m)
 	case *LeaveRequestMutation:
 		return c.LeaveRequest.mutate(ctx, m)
+	case *OutboxMessageMutation:
+		return c.OutboxMessage.mutate(ctx, m)
+	case *ProcessedEventMutation:
+		return c.ProcessedEvent.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WebhookSubscriptionMutation:
+		return c.WebhookSubscription.mutate(ctx, m)
+	case *WorkScheduleMutation:
+		return c.WorkSchedule.mutate(ctx, m)
+	case *WorkScheduleAssignmentMutation:
+		return c.WorkScheduleAssignment.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -585,6 +731,274 @@ func (c *AllowancePoolClient) mutate(ctx context.Context, m *AllowancePoolMutati
 	}
 }
 
+// AllowanceTransactionClient is a client for the AllowanceTransaction schema.
+type AllowanceTransactionClient struct {
+	config
+}
+
+// NewAllowanceTransactionClient returns a client for the AllowanceTransaction from the given config.
+func NewAllowanceTransactionClient(c config) *AllowanceTransactionClient {
+	return &AllowanceTransactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `allowancetransaction.Hooks(f(g(h())))`.
+func (c *AllowanceTransactionClient) Use(hooks ...Hook) {
+	c.hooks.AllowanceTransaction = append(c.hooks.AllowanceTransaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `allowancetransaction.Intercept(f(g(h())))`.
+func (c *AllowanceTransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AllowanceTransaction = append(c.inters.AllowanceTransaction, interceptors...)
+}
+
+// Create returns a builder for creating a AllowanceTransaction entity.
+func (c *AllowanceTransactionClient) Create() *AllowanceTransactionCreate {
+	mutation := newAllowanceTransactionMutation(c.config, OpCreate)
+	return &AllowanceTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AllowanceTransaction entities.
+func (c *AllowanceTransactionClient) CreateBulk(builders ...*AllowanceTransactionCreate) *AllowanceTransactionCreateBulk {
+	return &AllowanceTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AllowanceTransactionClient) MapCreateBulk(slice any, setFunc func(*AllowanceTransactionCreate, int)) *AllowanceTransactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AllowanceTransactionCreateBulk{err: fmt.Errorf("calling to AllowanceTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AllowanceTransactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AllowanceTransactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AllowanceTransaction.
+func (c *AllowanceTransactionClient) Update() *AllowanceTransactionUpdate {
+	mutation := newAllowanceTransactionMutation(c.config, OpUpdate)
+	return &AllowanceTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AllowanceTransactionClient) UpdateOne(_m *AllowanceTransaction) *AllowanceTransactionUpdateOne {
+	mutation := newAllowanceTransactionMutation(c.config, OpUpdateOne, withAllowanceTransaction(_m))
+	return &AllowanceTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AllowanceTransactionClient) UpdateOneID(id string) *AllowanceTransactionUpdateOne {
+	mutation := newAllowanceTransactionMutation(c.config, OpUpdateOne, withAllowanceTransactionID(id))
+	return &AllowanceTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AllowanceTransaction.
+func (c *AllowanceTransactionClient) Delete() *AllowanceTransactionDelete {
+	mutation := newAllowanceTransactionMutation(c.config, OpDelete)
+	return &AllowanceTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AllowanceTransactionClient) DeleteOne(_m *AllowanceTransaction) *AllowanceTransactionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AllowanceTransactionClient) DeleteOneID(id string) *AllowanceTransactionDeleteOne {
+	builder := c.Delete().Where(allowancetransaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AllowanceTransactionDeleteOne{builder}
+}
+
+// Query returns a query builder for AllowanceTransaction.
+func (c *AllowanceTransactionClient) Query() *AllowanceTransactionQuery {
+	return &AllowanceTransactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAllowanceTransaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AllowanceTransaction entity by its id.
+func (c *AllowanceTransactionClient) Get(ctx context.Context, id string) (*AllowanceTransaction, error) {
+	return c.Query().Where(allowancetransaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AllowanceTransactionClient) GetX(ctx context.Context, id string) *AllowanceTransaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AllowanceTransactionClient) Hooks() []Hook {
+	hooks := c.hooks.AllowanceTransaction
+	return append(hooks[:len(hooks):len(hooks)], allowancetransaction.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AllowanceTransactionClient) Interceptors() []Interceptor {
+	return c.inters.AllowanceTransaction
+}
+
+func (c *AllowanceTransactionClient) mutate(ctx context.Context, m *AllowanceTransactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AllowanceTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AllowanceTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AllowanceTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AllowanceTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AllowanceTransaction mutation op: %q", m.Op())
+	}
+}
+
+// ApprovalDelegationClient is a client for the ApprovalDelegation schema.
+type ApprovalDelegationClient struct {
+	config
+}
+
+// NewApprovalDelegationClient returns a client for the ApprovalDelegation from the given config.
+func NewApprovalDelegationClient(c config) *ApprovalDelegationClient {
+	return &ApprovalDelegationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `approvaldelegation.Hooks(f(g(h())))`.
+func (c *ApprovalDelegationClient) Use(hooks ...Hook) {
+	c.hooks.ApprovalDelegation = append(c.hooks.ApprovalDelegation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `approvaldelegation.Intercept(f(g(h())))`.
+func (c *ApprovalDelegationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ApprovalDelegation = append(c.inters.ApprovalDelegation, interceptors...)
+}
+
+// Create returns a builder for creating a ApprovalDelegation entity.
+func (c *ApprovalDelegationClient) Create() *ApprovalDelegationCreate {
+	mutation := newApprovalDelegationMutation(c.config, OpCreate)
+	return &ApprovalDelegationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ApprovalDelegation entities.
+func (c *ApprovalDelegationClient) CreateBulk(builders ...*ApprovalDelegationCreate) *ApprovalDelegationCreateBulk {
+	return &ApprovalDelegationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ApprovalDelegationClient) MapCreateBulk(slice any, setFunc func(*ApprovalDelegationCreate, int)) *ApprovalDelegationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ApprovalDelegationCreateBulk{err: fmt.Errorf("calling to ApprovalDelegationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ApprovalDelegationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ApprovalDelegationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ApprovalDelegation.
+func (c *ApprovalDelegationClient) Update() *ApprovalDelegationUpdate {
+	mutation := newApprovalDelegationMutation(c.config, OpUpdate)
+	return &ApprovalDelegationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ApprovalDelegationClient) UpdateOne(_m *ApprovalDelegation) *ApprovalDelegationUpdateOne {
+	mutation := newApprovalDelegationMutation(c.config, OpUpdateOne, withApprovalDelegation(_m))
+	return &ApprovalDelegationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ApprovalDelegationClient) UpdateOneID(id string) *ApprovalDelegationUpdateOne {
+	mutation := newApprovalDelegationMutation(c.config, OpUpdateOne, withApprovalDelegationID(id))
+	return &ApprovalDelegationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ApprovalDelegation.
+func (c *ApprovalDelegationClient) Delete() *ApprovalDelegationDelete {
+	mutation := newApprovalDelegationMutation(c.config, OpDelete)
+	return &ApprovalDelegationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ApprovalDelegationClient) DeleteOne(_m *ApprovalDelegation) *ApprovalDelegationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ApprovalDelegationClient) DeleteOneID(id string) *ApprovalDelegationDeleteOne {
+	builder := c.Delete().Where(approvaldelegation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ApprovalDelegationDeleteOne{builder}
+}
+
+// Query returns a query builder for ApprovalDelegation.
+func (c *ApprovalDelegationClient) Query() *ApprovalDelegationQuery {
+	return &ApprovalDelegationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeApprovalDelegation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ApprovalDelegation entity by its id.
+func (c *ApprovalDelegationClient) Get(ctx context.Context, id string) (*ApprovalDelegation, error) {
+	return c.Query().Where(approvaldelegation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ApprovalDelegationClient) GetX(ctx context.Context, id string) *ApprovalDelegation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ApprovalDelegationClient) Hooks() []Hook {
+	hooks := c.hooks.ApprovalDelegation
+	return append(hooks[:len(hooks):len(hooks)], approvaldelegation.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ApprovalDelegationClient) Interceptors() []Interceptor {
+	return c.inters.ApprovalDelegation
+}
+
+func (c *ApprovalDelegationClient) mutate(ctx context.Context, m *ApprovalDelegationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ApprovalDelegationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ApprovalDelegationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ApprovalDelegationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ApprovalDelegationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ApprovalDelegation mutation op: %q", m.Op())
+	}
+}
+
 // AuditLogClient is a client for the AuditLog schema.
 type AuditLogClient struct {
 	config
@@ -719,107 +1133,107 @@ func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value
 	}
 }
 
-// LeaveAllowanceClient is a client for the LeaveAllowance schema.
-type LeaveAllowanceClient struct {
+// BlackoutPeriodClient is a client for the BlackoutPeriod schema.
+type BlackoutPeriodClient struct {
 	config
 }
 
-// NewLeaveAllowanceClient returns a client for the LeaveAllowance from the given config.
-func NewLeaveAllowanceClient(c config) *LeaveAllowanceClient {
-	return &LeaveAllowanceClient{config: c}
+// NewBlackoutPeriodClient returns a client for the BlackoutPeriod from the given config.
+func NewBlackoutPeriodClient(c config) *BlackoutPeriodClient {
+	return &BlackoutPeriodClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `leaveallowance.Hooks(f(g(h())))`.
-func (c *LeaveAllowanceClient) Use(hooks ...Hook) {
-	c.hooks.LeaveAllowance = append(c.hooks.LeaveAllowance, hooks...)
+// A call to `Use(f, g, h)` equals to `blackoutperiod.Hooks(f(g(h())))`.
+func (c *BlackoutPeriodClient) Use(hooks ...Hook) {
+	c.hooks.BlackoutPeriod = append(c.hooks.BlackoutPeriod, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `leaveallowance.Intercept(f(g(h())))`.
-func (c *LeaveAllowanceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LeaveAllowance = append(c.inters.LeaveAllowance, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `blackoutperiod.Intercept(f(g(h())))`.
+func (c *BlackoutPeriodClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BlackoutPeriod = append(c.inters.BlackoutPeriod, interceptors...)
 }
 
-// Create returns a builder for creating a LeaveAllowance entity.
-func (c *LeaveAllowanceClient) Create() *LeaveAllowanceCreate {
-	mutation := newLeaveAllowanceMutation(c.config, OpCreate)
-	return &LeaveAllowanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BlackoutPeriod entity.
+func (c *BlackoutPeriodClient) Create() *BlackoutPeriodCreate {
+	mutation := newBlackoutPeriodMutation(c.config, OpCreate)
+	return &BlackoutPeriodCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LeaveAllowance entities.
-func (c *LeaveAllowanceClient) CreateBulk(builders ...*LeaveAllowanceCreate) *LeaveAllowanceCreateBulk {
-	return &LeaveAllowanceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BlackoutPeriod entities.
+func (c *BlackoutPeriodClient) CreateBulk(builders ...*BlackoutPeriodCreate) *BlackoutPeriodCreateBulk {
+	return &BlackoutPeriodCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LeaveAllowanceClient) MapCreateBulk(slice any, setFunc func(*LeaveAllowanceCreate, int)) *LeaveAllowanceCreateBulk {
+func (c *BlackoutPeriodClient) MapCreateBulk(slice any, setFunc func(*BlackoutPeriodCreate, int)) *BlackoutPeriodCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LeaveAllowanceCreateBulk{err: fmt.Errorf("calling to LeaveAllowanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BlackoutPeriodCreateBulk{err: fmt.Errorf("calling to BlackoutPeriodClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LeaveAllowanceCreate, rv.Len())
+	builders := make([]*BlackoutPeriodCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LeaveAllowanceCreateBulk{config: c.config, builders: builders}
+	return &BlackoutPeriodCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LeaveAllowance.
-func (c *LeaveAllowanceClient) Update() *LeaveAllowanceUpdate {
-	mutation := newLeaveAllowanceMutation(c.config, OpUpdate)
-	return &LeaveAllowanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BlackoutPeriod.
+func (c *BlackoutPeriodClient) Update() *BlackoutPeriodUpdate {
+	mutation := newBlackoutPeriodMutation(c.config, OpUpdate)
+	return &BlackoutPeriodUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LeaveAllowanceClient) UpdateOne(_m *LeaveAllowance) *LeaveAllowanceUpdateOne {
-	mutation := newLeaveAllowanceMutation(c.config, OpUpdateOne, withLeaveAllowance(_m))
-	return &LeaveAllowanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BlackoutPeriodClient) UpdateOne(_m *BlackoutPeriod) *BlackoutPeriodUpdateOne {
+	mutation := newBlackoutPeriodMutation(c.config, OpUpdateOne, withBlackoutPeriod(_m))
+	return &BlackoutPeriodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LeaveAllowanceClient) UpdateOneID(id string) *LeaveAllowanceUpdateOne {
-	mutation := newLeaveAllowanceMutation(c.config, OpUpdateOne, withLeaveAllowanceID(id))
-	return &LeaveAllowanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BlackoutPeriodClient) UpdateOneID(id string) *BlackoutPeriodUpdateOne {
+	mutation := newBlackoutPeriodMutation(c.config, OpUpdateOne, withBlackoutPeriodID(id))
+	return &BlackoutPeriodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LeaveAllowance.
-func (c *LeaveAllowanceClient) Delete() *LeaveAllowanceDelete {
-	mutation := newLeaveAllowanceMutation(c.config, OpDelete)
-	return &LeaveAllowanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BlackoutPeriod.
+func (c *BlackoutPeriodClient) Delete() *BlackoutPeriodDelete {
+	mutation := newBlackoutPeriodMutation(c.config, OpDelete)
+	return &BlackoutPeriodDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LeaveAllowanceClient) DeleteOne(_m *LeaveAllowance) *LeaveAllowanceDeleteOne {
+func (c *BlackoutPeriodClient) DeleteOne(_m *BlackoutPeriod) *BlackoutPeriodDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LeaveAllowanceClient) DeleteOneID(id string) *LeaveAllowanceDeleteOne {
-	builder := c.Delete().Where(leaveallowance.ID(id))
+func (c *BlackoutPeriodClient) DeleteOneID(id string) *BlackoutPeriodDeleteOne {
+	builder := c.Delete().Where(blackoutperiod.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LeaveAllowanceDeleteOne{builder}
+	return &BlackoutPeriodDeleteOne{builder}
 }
 
-// Query returns a query builder for LeaveAllowance.
-func (c *LeaveAllowanceClient) Query() *LeaveAllowanceQuery {
-	return &LeaveAllowanceQuery{
+// Query returns a query builder for BlackoutPeriod.
+func (c *BlackoutPeriodClient) Query() *BlackoutPeriodQuery {
+	return &BlackoutPeriodQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLeaveAllowance},
+		ctx:    &QueryContext{Type: TypeBlackoutPeriod},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LeaveAllowance entity by its id.
-func (c *LeaveAllowanceClient) Get(ctx context.Context, id string) (*LeaveAllowance, error) {
-	return c.Query().Where(leaveallowance.ID(id)).Only(ctx)
+// Get returns a BlackoutPeriod entity by its id.
+func (c *BlackoutPeriodClient) Get(ctx context.Context, id string) (*BlackoutPeriod, error) {
+	return c.Query().Where(blackoutperiod.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LeaveAllowanceClient) GetX(ctx context.Context, id string) *LeaveAllowance {
+func (c *BlackoutPeriodClient) GetX(ctx context.Context, id string) *BlackoutPeriod {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -827,165 +1241,1955 @@ func (c *LeaveAllowanceClient) GetX(ctx context.Context, id string) *LeaveAllowa
 	return obj
 }
 
-// QueryAbsenceType queries the absence_type edge of a LeaveAllowance.
-func (c *LeaveAllowanceClient) QueryAbsenceType(_m *LeaveAllowance) *AbsenceTypeQuery {
-	query := (&AbsenceTypeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(leaveallowance.Table, leaveallowance.FieldID, id),
-			sqlgraph.To(absencetype.Table, absencetype.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leaveallowance.AbsenceTypeTable, leaveallowance.AbsenceTypeColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAllowancePool queries the allowance_pool edge of a LeaveAllowance.
-func (c *LeaveAllowanceClient) QueryAllowancePool(_m *LeaveAllowance) *AllowancePoolQuery {
-	query := (&AllowancePoolClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(leaveallowance.Table, leaveallowance.FieldID, id),
-			sqlgraph.To(allowancepool.Table, allowancepool.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leaveallowance.AllowancePoolTable, leaveallowance.AllowancePoolColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *LeaveAllowanceClient) Hooks() []Hook {
-	hooks := c.hooks.LeaveAllowance
-	return append(hooks[:len(hooks):len(hooks)], leaveallowance.Hooks[:]...)
+func (c *BlackoutPeriodClient) Hooks() []Hook {
+	hooks := c.hooks.BlackoutPeriod
+	return append(hooks[:len(hooks):len(hooks)], blackoutperiod.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *LeaveAllowanceClient) Interceptors() []Interceptor {
-	return c.inters.LeaveAllowance
+func (c *BlackoutPeriodClient) Interceptors() []Interceptor {
+	return c.inters.BlackoutPeriod
 }
 
-func (c *LeaveAllowanceClient) mutate(ctx context.Context, m *LeaveAllowanceMutation) (Value, error) {
+func (c *BlackoutPeriodClient) mutate(ctx context.Context, m *BlackoutPeriodMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LeaveAllowanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BlackoutPeriodCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LeaveAllowanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BlackoutPeriodUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LeaveAllowanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BlackoutPeriodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LeaveAllowanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BlackoutPeriodDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LeaveAllowance mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BlackoutPeriod mutation op: %q", m.Op())
 	}
 }
 
-// LeaveRequestClient is a client for the LeaveRequest schema.
-type LeaveRequestClient struct {
+// CoverageRuleClient is a client for the CoverageRule schema.
+type CoverageRuleClient struct {
 	config
 }
 
-// NewLeaveRequestClient returns a client for the LeaveRequest from the given config.
-func NewLeaveRequestClient(c config) *LeaveRequestClient {
-	return &LeaveRequestClient{config: c}
+// NewCoverageRuleClient returns a client for the CoverageRule from the given config.
+func NewCoverageRuleClient(c config) *CoverageRuleClient {
+	return &CoverageRuleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `leaverequest.Hooks(f(g(h())))`.
-func (c *LeaveRequestClient) Use(hooks ...Hook) {
-	c.hooks.LeaveRequest = append(c.hooks.LeaveRequest, hooks...)
+// A call to `Use(f, g, h)` equals to `coveragerule.Hooks(f(g(h())))`.
+func (c *CoverageRuleClient) Use(hooks ...Hook) {
+	c.hooks.CoverageRule = append(c.hooks.CoverageRule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `leaverequest.Intercept(f(g(h())))`.
-func (c *LeaveRequestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LeaveRequest = append(c.inters.LeaveRequest, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `coveragerule.Intercept(f(g(h())))`.
+func (c *CoverageRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CoverageRule = append(c.inters.CoverageRule, interceptors...)
 }
 
-// Create returns a builder for creating a LeaveRequest entity.
-func (c *LeaveRequestClient) Create() *LeaveRequestCreate {
+// Create returns a builder for creating a CoverageRule entity.
+func (c *CoverageRuleClient) Create() *CoverageRuleCreate {
+	mutation := newCoverageRuleMutation(c.config, OpCreate)
+	return &CoverageRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CoverageRule entities.
+func (c *CoverageRuleClient) CreateBulk(builders ...*CoverageRuleCreate) *CoverageRuleCreateBulk {
+	return &CoverageRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CoverageRuleClient) MapCreateBulk(slice any, setFunc func(*CoverageRuleCreate, int)) *CoverageRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CoverageRuleCreateBulk{err: fmt.Errorf("calling to CoverageRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CoverageRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CoverageRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CoverageRule.
+func (c *CoverageRuleClient) Update() *CoverageRuleUpdate {
+	mutation := newCoverageRuleMutation(c.config, OpUpdate)
+	return &CoverageRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CoverageRuleClient) UpdateOne(_m *CoverageRule) *CoverageRuleUpdateOne {
+	mutation := newCoverageRuleMutation(c.config, OpUpdateOne, withCoverageRule(_m))
+	return &CoverageRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CoverageRuleClient) UpdateOneID(id string) *CoverageRuleUpdateOne {
+	mutation := newCoverageRuleMutation(c.config, OpUpdateOne, withCoverageRuleID(id))
+	return &CoverageRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CoverageRule.
+func (c *CoverageRuleClient) Delete() *CoverageRuleDelete {
+	mutation := newCoverageRuleMutation(c.config, OpDelete)
+	return &CoverageRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CoverageRuleClient) DeleteOne(_m *CoverageRule) *CoverageRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CoverageRuleClient) DeleteOneID(id string) *CoverageRuleDeleteOne {
+	builder := c.Delete().Where(coveragerule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CoverageRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for CoverageRule.
+func (c *CoverageRuleClient) Query() *CoverageRuleQuery {
+	return &CoverageRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCoverageRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CoverageRule entity by its id.
+func (c *CoverageRuleClient) Get(ctx context.Context, id string) (*CoverageRule, error) {
+	return c.Query().Where(coveragerule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CoverageRuleClient) GetX(ctx context.Context, id string) *CoverageRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CoverageRuleClient) Hooks() []Hook {
+	hooks := c.hooks.CoverageRule
+	return append(hooks[:len(hooks):len(hooks)], coveragerule.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CoverageRuleClient) Interceptors() []Interceptor {
+	return c.inters.CoverageRule
+}
+
+func (c *CoverageRuleClient) mutate(ctx context.Context, m *CoverageRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CoverageRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CoverageRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CoverageRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CoverageRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CoverageRule mutation op: %q", m.Op())
+	}
+}
+
+// EmploymentClient is a client for the Employment schema.
+type EmploymentClient struct {
+	config
+}
+
+// NewEmploymentClient returns a client for the Employment from the given config.
+func NewEmploymentClient(c config) *EmploymentClient {
+	return &EmploymentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `employment.Hooks(f(g(h())))`.
+func (c *EmploymentClient) Use(hooks ...Hook) {
+	c.hooks.Employment = append(c.hooks.Employment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `employment.Intercept(f(g(h())))`.
+func (c *EmploymentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Employment = append(c.inters.Employment, interceptors...)
+}
+
+// Create returns a builder for creating a Employment entity.
+func (c *EmploymentClient) Create() *EmploymentCreate {
+	mutation := newEmploymentMutation(c.config, OpCreate)
+	return &EmploymentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Employment entities.
+func (c *EmploymentClient) CreateBulk(builders ...*EmploymentCreate) *EmploymentCreateBulk {
+	return &EmploymentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmploymentClient) MapCreateBulk(slice any, setFunc func(*EmploymentCreate, int)) *EmploymentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmploymentCreateBulk{err: fmt.Errorf("calling to EmploymentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmploymentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmploymentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Employment.
+func (c *EmploymentClient) Update() *EmploymentUpdate {
+	mutation := newEmploymentMutation(c.config, OpUpdate)
+	return &EmploymentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmploymentClient) UpdateOne(_m *Employment) *EmploymentUpdateOne {
+	mutation := newEmploymentMutation(c.config, OpUpdateOne, withEmployment(_m))
+	return &EmploymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmploymentClient) UpdateOneID(id string) *EmploymentUpdateOne {
+	mutation := newEmploymentMutation(c.config, OpUpdateOne, withEmploymentID(id))
+	return &EmploymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Employment.
+func (c *EmploymentClient) Delete() *EmploymentDelete {
+	mutation := newEmploymentMutation(c.config, OpDelete)
+	return &EmploymentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmploymentClient) DeleteOne(_m *Employment) *EmploymentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmploymentClient) DeleteOneID(id string) *EmploymentDeleteOne {
+	builder := c.Delete().Where(employment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmploymentDeleteOne{builder}
+}
+
+// Query returns a query builder for Employment.
+func (c *EmploymentClient) Query() *EmploymentQuery {
+	return &EmploymentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmployment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Employment entity by its id.
+func (c *EmploymentClient) Get(ctx context.Context, id string) (*Employment, error) {
+	return c.Query().Where(employment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmploymentClient) GetX(ctx context.Context, id string) *Employment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmploymentClient) Hooks() []Hook {
+	hooks := c.hooks.Employment
+	return append(hooks[:len(hooks):len(hooks)], employment.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmploymentClient) Interceptors() []Interceptor {
+	return c.inters.Employment
+}
+
+func (c *EmploymentClient) mutate(ctx context.Context, m *EmploymentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmploymentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmploymentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmploymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmploymentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Employment mutation op: %q", m.Op())
+	}
+}
+
+// HolidayClient is a client for the Holiday schema.
+type HolidayClient struct {
+	config
+}
+
+// NewHolidayClient returns a client for the Holiday from the given config.
+func NewHolidayClient(c config) *HolidayClient {
+	return &HolidayClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `holiday.Hooks(f(g(h())))`.
+func (c *HolidayClient) Use(hooks ...Hook) {
+	c.hooks.Holiday = append(c.hooks.Holiday, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `holiday.Intercept(f(g(h())))`.
+func (c *HolidayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Holiday = append(c.inters.Holiday, interceptors...)
+}
+
+// Create returns a builder for creating a Holiday entity.
+func (c *HolidayClient) Create() *HolidayCreate {
+	mutation := newHolidayMutation(c.config, OpCreate)
+	return &HolidayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Holiday entities.
+func (c *HolidayClient) CreateBulk(builders ...*HolidayCreate) *HolidayCreateBulk {
+	return &HolidayCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HolidayClient) MapCreateBulk(slice any, setFunc func(*HolidayCreate, int)) *HolidayCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HolidayCreateBulk{err: fmt.Errorf("calling to HolidayClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HolidayCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HolidayCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Holiday.
+func (c *HolidayClient) Update() *HolidayUpdate {
+	mutation := newHolidayMutation(c.config, OpUpdate)
+	return &HolidayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HolidayClient) UpdateOne(_m *Holiday) *HolidayUpdateOne {
+	mutation := newHolidayMutation(c.config, OpUpdateOne, withHoliday(_m))
+	return &HolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HolidayClient) UpdateOneID(id string) *HolidayUpdateOne {
+	mutation := newHolidayMutation(c.config, OpUpdateOne, withHolidayID(id))
+	return &HolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Holiday.
+func (c *HolidayClient) Delete() *HolidayDelete {
+	mutation := newHolidayMutation(c.config, OpDelete)
+	return &HolidayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HolidayClient) DeleteOne(_m *Holiday) *HolidayDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HolidayClient) DeleteOneID(id string) *HolidayDeleteOne {
+	builder := c.Delete().Where(holiday.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HolidayDeleteOne{builder}
+}
+
+// Query returns a query builder for Holiday.
+func (c *HolidayClient) Query() *HolidayQuery {
+	return &HolidayQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHoliday},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Holiday entity by its id.
+func (c *HolidayClient) Get(ctx context.Context, id string) (*Holiday, error) {
+	return c.Query().Where(holiday.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HolidayClient) GetX(ctx context.Context, id string) *Holiday {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCalendar queries the calendar edge of a Holiday.
+func (c *HolidayClient) QueryCalendar(_m *Holiday) *HolidayCalendarQuery {
+	query := (&HolidayCalendarClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(holiday.Table, holiday.FieldID, id),
+			sqlgraph.To(holidaycalendar.Table, holidaycalendar.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, holiday.CalendarTable, holiday.CalendarColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HolidayClient) Hooks() []Hook {
+	hooks := c.hooks.Holiday
+	return append(hooks[:len(hooks):len(hooks)], holiday.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *HolidayClient) Interceptors() []Interceptor {
+	return c.inters.Holiday
+}
+
+func (c *HolidayClient) mutate(ctx context.Context, m *HolidayMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HolidayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HolidayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HolidayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HolidayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Holiday mutation op: %q", m.Op())
+	}
+}
+
+// HolidayCalendarClient is a client for the HolidayCalendar schema.
+type HolidayCalendarClient struct {
+	config
+}
+
+// NewHolidayCalendarClient returns a client for the HolidayCalendar from the given config.
+func NewHolidayCalendarClient(c config) *HolidayCalendarClient {
+	return &HolidayCalendarClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `holidaycalendar.Hooks(f(g(h())))`.
+func (c *HolidayCalendarClient) Use(hooks ...Hook) {
+	c.hooks.HolidayCalendar = append(c.hooks.HolidayCalendar, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `holidaycalendar.Intercept(f(g(h())))`.
+func (c *HolidayCalendarClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HolidayCalendar = append(c.inters.HolidayCalendar, interceptors...)
+}
+
+// Create returns a builder for creating a HolidayCalendar entity.
+func (c *HolidayCalendarClient) Create() *HolidayCalendarCreate {
+	mutation := newHolidayCalendarMutation(c.config, OpCreate)
+	return &HolidayCalendarCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HolidayCalendar entities.
+func (c *HolidayCalendarClient) CreateBulk(builders ...*HolidayCalendarCreate) *HolidayCalendarCreateBulk {
+	return &HolidayCalendarCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HolidayCalendarClient) MapCreateBulk(slice any, setFunc func(*HolidayCalendarCreate, int)) *HolidayCalendarCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HolidayCalendarCreateBulk{err: fmt.Errorf("calling to HolidayCalendarClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HolidayCalendarCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HolidayCalendarCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HolidayCalendar.
+func (c *HolidayCalendarClient) Update() *HolidayCalendarUpdate {
+	mutation := newHolidayCalendarMutation(c.config, OpUpdate)
+	return &HolidayCalendarUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HolidayCalendarClient) UpdateOne(_m *HolidayCalendar) *HolidayCalendarUpdateOne {
+	mutation := newHolidayCalendarMutation(c.config, OpUpdateOne, withHolidayCalendar(_m))
+	return &HolidayCalendarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HolidayCalendarClient) UpdateOneID(id string) *HolidayCalendarUpdateOne {
+	mutation := newHolidayCalendarMutation(c.config, OpUpdateOne, withHolidayCalendarID(id))
+	return &HolidayCalendarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HolidayCalendar.
+func (c *HolidayCalendarClient) Delete() *HolidayCalendarDelete {
+	mutation := newHolidayCalendarMutation(c.config, OpDelete)
+	return &HolidayCalendarDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HolidayCalendarClient) DeleteOne(_m *HolidayCalendar) *HolidayCalendarDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HolidayCalendarClient) DeleteOneID(id string) *HolidayCalendarDeleteOne {
+	builder := c.Delete().Where(holidaycalendar.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HolidayCalendarDeleteOne{builder}
+}
+
+// Query returns a query builder for HolidayCalendar.
+func (c *HolidayCalendarClient) Query() *HolidayCalendarQuery {
+	return &HolidayCalendarQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHolidayCalendar},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HolidayCalendar entity by its id.
+func (c *HolidayCalendarClient) Get(ctx context.Context, id string) (*HolidayCalendar, error) {
+	return c.Query().Where(holidaycalendar.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HolidayCalendarClient) GetX(ctx context.Context, id string) *HolidayCalendar {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHolidays queries the holidays edge of a HolidayCalendar.
+func (c *HolidayCalendarClient) QueryHolidays(_m *HolidayCalendar) *HolidayQuery {
+	query := (&HolidayClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(holidaycalendar.Table, holidaycalendar.FieldID, id),
+			sqlgraph.To(holiday.Table, holiday.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, holidaycalendar.HolidaysTable, holidaycalendar.HolidaysColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HolidayCalendarClient) Hooks() []Hook {
+	hooks := c.hooks.HolidayCalendar
+	return append(hooks[:len(hooks):len(hooks)], holidaycalendar.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *HolidayCalendarClient) Interceptors() []Interceptor {
+	return c.inters.HolidayCalendar
+}
+
+func (c *HolidayCalendarClient) mutate(ctx context.Context, m *HolidayCalendarMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HolidayCalendarCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HolidayCalendarUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HolidayCalendarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HolidayCalendarDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HolidayCalendar mutation op: %q", m.Op())
+	}
+}
+
+// LeaveAllowanceClient is a client for the LeaveAllowance schema.
+type LeaveAllowanceClient struct {
+	config
+}
+
+// NewLeaveAllowanceClient returns a client for the LeaveAllowance from the given config.
+func NewLeaveAllowanceClient(c config) *LeaveAllowanceClient {
+	return &LeaveAllowanceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leaveallowance.Hooks(f(g(h())))`.
+func (c *LeaveAllowanceClient) Use(hooks ...Hook) {
+	c.hooks.LeaveAllowance = append(c.hooks.LeaveAllowance, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leaveallowance.Intercept(f(g(h())))`.
+func (c *LeaveAllowanceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeaveAllowance = append(c.inters.LeaveAllowance, interceptors...)
+}
+
+// Create returns a builder for creating a LeaveAllowance entity.
+func (c *LeaveAllowanceClient) Create() *LeaveAllowanceCreate {
+	mutation := newLeaveAllowanceMutation(c.config, OpCreate)
+	return &LeaveAllowanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeaveAllowance entities.
+func (c *LeaveAllowanceClient) CreateBulk(builders ...*LeaveAllowanceCreate) *LeaveAllowanceCreateBulk {
+	return &LeaveAllowanceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeaveAllowanceClient) MapCreateBulk(slice any, setFunc func(*LeaveAllowanceCreate, int)) *LeaveAllowanceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeaveAllowanceCreateBulk{err: fmt.Errorf("calling to LeaveAllowanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeaveAllowanceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeaveAllowanceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeaveAllowance.
+func (c *LeaveAllowanceClient) Update() *LeaveAllowanceUpdate {
+	mutation := newLeaveAllowanceMutation(c.config, OpUpdate)
+	return &LeaveAllowanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaveAllowanceClient) UpdateOne(_m *LeaveAllowance) *LeaveAllowanceUpdateOne {
+	mutation := newLeaveAllowanceMutation(c.config, OpUpdateOne, withLeaveAllowance(_m))
+	return &LeaveAllowanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaveAllowanceClient) UpdateOneID(id string) *LeaveAllowanceUpdateOne {
+	mutation := newLeaveAllowanceMutation(c.config, OpUpdateOne, withLeaveAllowanceID(id))
+	return &LeaveAllowanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeaveAllowance.
+func (c *LeaveAllowanceClient) Delete() *LeaveAllowanceDelete {
+	mutation := newLeaveAllowanceMutation(c.config, OpDelete)
+	return &LeaveAllowanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaveAllowanceClient) DeleteOne(_m *LeaveAllowance) *LeaveAllowanceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaveAllowanceClient) DeleteOneID(id string) *LeaveAllowanceDeleteOne {
+	builder := c.Delete().Where(leaveallowance.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaveAllowanceDeleteOne{builder}
+}
+
+// Query returns a query builder for LeaveAllowance.
+func (c *LeaveAllowanceClient) Query() *LeaveAllowanceQuery {
+	return &LeaveAllowanceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeaveAllowance},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeaveAllowance entity by its id.
+func (c *LeaveAllowanceClient) Get(ctx context.Context, id string) (*LeaveAllowance, error) {
+	return c.Query().Where(leaveallowance.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaveAllowanceClient) GetX(ctx context.Context, id string) *LeaveAllowance {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAbsenceType queries the absence_type edge of a LeaveAllowance.
+func (c *LeaveAllowanceClient) QueryAbsenceType(_m *LeaveAllowance) *AbsenceTypeQuery {
+	query := (&AbsenceTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaveallowance.Table, leaveallowance.FieldID, id),
+			sqlgraph.To(absencetype.Table, absencetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaveallowance.AbsenceTypeTable, leaveallowance.AbsenceTypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAllowancePool queries the allowance_pool edge of a LeaveAllowance.
+func (c *LeaveAllowanceClient) QueryAllowancePool(_m *LeaveAllowance) *AllowancePoolQuery {
+	query := (&AllowancePoolClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaveallowance.Table, leaveallowance.FieldID, id),
+			sqlgraph.To(allowancepool.Table, allowancepool.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaveallowance.AllowancePoolTable, leaveallowance.AllowancePoolColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeaveAllowanceClient) Hooks() []Hook {
+	hooks := c.hooks.LeaveAllowance
+	return append(hooks[:len(hooks):len(hooks)], leaveallowance.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaveAllowanceClient) Interceptors() []Interceptor {
+	return c.inters.LeaveAllowance
+}
+
+func (c *LeaveAllowanceClient) mutate(ctx context.Context, m *LeaveAllowanceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaveAllowanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaveAllowanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaveAllowanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaveAllowanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeaveAllowance mutation op: %q", m.Op())
+	}
+}
+
+// LeaveAmendmentClient is a client for the LeaveAmendment schema.
+type LeaveAmendmentClient struct {
+	config
+}
+
+// NewLeaveAmendmentClient returns a client for the LeaveAmendment from the given config.
+func NewLeaveAmendmentClient(c config) *LeaveAmendmentClient {
+	return &LeaveAmendmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leaveamendment.Hooks(f(g(h())))`.
+func (c *LeaveAmendmentClient) Use(hooks ...Hook) {
+	c.hooks.LeaveAmendment = append(c.hooks.LeaveAmendment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leaveamendment.Intercept(f(g(h())))`.
+func (c *LeaveAmendmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeaveAmendment = append(c.inters.LeaveAmendment, interceptors...)
+}
+
+// Create returns a builder for creating a LeaveAmendment entity.
+func (c *LeaveAmendmentClient) Create() *LeaveAmendmentCreate {
+	mutation := newLeaveAmendmentMutation(c.config, OpCreate)
+	return &LeaveAmendmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeaveAmendment entities.
+func (c *LeaveAmendmentClient) CreateBulk(builders ...*LeaveAmendmentCreate) *LeaveAmendmentCreateBulk {
+	return &LeaveAmendmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeaveAmendmentClient) MapCreateBulk(slice any, setFunc func(*LeaveAmendmentCreate, int)) *LeaveAmendmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeaveAmendmentCreateBulk{err: fmt.Errorf("calling to LeaveAmendmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeaveAmendmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeaveAmendmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeaveAmendment.
+func (c *LeaveAmendmentClient) Update() *LeaveAmendmentUpdate {
+	mutation := newLeaveAmendmentMutation(c.config, OpUpdate)
+	return &LeaveAmendmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaveAmendmentClient) UpdateOne(_m *LeaveAmendment) *LeaveAmendmentUpdateOne {
+	mutation := newLeaveAmendmentMutation(c.config, OpUpdateOne, withLeaveAmendment(_m))
+	return &LeaveAmendmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaveAmendmentClient) UpdateOneID(id string) *LeaveAmendmentUpdateOne {
+	mutation := newLeaveAmendmentMutation(c.config, OpUpdateOne, withLeaveAmendmentID(id))
+	return &LeaveAmendmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeaveAmendment.
+func (c *LeaveAmendmentClient) Delete() *LeaveAmendmentDelete {
+	mutation := newLeaveAmendmentMutation(c.config, OpDelete)
+	return &LeaveAmendmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaveAmendmentClient) DeleteOne(_m *LeaveAmendment) *LeaveAmendmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaveAmendmentClient) DeleteOneID(id string) *LeaveAmendmentDeleteOne {
+	builder := c.Delete().Where(leaveamendment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaveAmendmentDeleteOne{builder}
+}
+
+// Query returns a query builder for LeaveAmendment.
+func (c *LeaveAmendmentClient) Query() *LeaveAmendmentQuery {
+	return &LeaveAmendmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeaveAmendment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeaveAmendment entity by its id.
+func (c *LeaveAmendmentClient) Get(ctx context.Context, id string) (*LeaveAmendment, error) {
+	return c.Query().Where(leaveamendment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaveAmendmentClient) GetX(ctx context.Context, id string) *LeaveAmendment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LeaveAmendmentClient) Hooks() []Hook {
+	hooks := c.hooks.LeaveAmendment
+	return append(hooks[:len(hooks):len(hooks)], leaveamendment.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaveAmendmentClient) Interceptors() []Interceptor {
+	return c.inters.LeaveAmendment
+}
+
+func (c *LeaveAmendmentClient) mutate(ctx context.Context, m *LeaveAmendmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaveAmendmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaveAmendmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaveAmendmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaveAmendmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeaveAmendment mutation op: %q", m.Op())
+	}
+}
+
+// LeaveAttachmentClient is a client for the LeaveAttachment schema.
+type LeaveAttachmentClient struct {
+	config
+}
+
+// NewLeaveAttachmentClient returns a client for the LeaveAttachment from the given config.
+func NewLeaveAttachmentClient(c config) *LeaveAttachmentClient {
+	return &LeaveAttachmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leaveattachment.Hooks(f(g(h())))`.
+func (c *LeaveAttachmentClient) Use(hooks ...Hook) {
+	c.hooks.LeaveAttachment = append(c.hooks.LeaveAttachment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leaveattachment.Intercept(f(g(h())))`.
+func (c *LeaveAttachmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeaveAttachment = append(c.inters.LeaveAttachment, interceptors...)
+}
+
+// Create returns a builder for creating a LeaveAttachment entity.
+func (c *LeaveAttachmentClient) Create() *LeaveAttachmentCreate {
+	mutation := newLeaveAttachmentMutation(c.config, OpCreate)
+	return &LeaveAttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeaveAttachment entities.
+func (c *LeaveAttachmentClient) CreateBulk(builders ...*LeaveAttachmentCreate) *LeaveAttachmentCreateBulk {
+	return &LeaveAttachmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeaveAttachmentClient) MapCreateBulk(slice any, setFunc func(*LeaveAttachmentCreate, int)) *LeaveAttachmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeaveAttachmentCreateBulk{err: fmt.Errorf("calling to LeaveAttachmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeaveAttachmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeaveAttachmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeaveAttachment.
+func (c *LeaveAttachmentClient) Update() *LeaveAttachmentUpdate {
+	mutation := newLeaveAttachmentMutation(c.config, OpUpdate)
+	return &LeaveAttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaveAttachmentClient) UpdateOne(_m *LeaveAttachment) *LeaveAttachmentUpdateOne {
+	mutation := newLeaveAttachmentMutation(c.config, OpUpdateOne, withLeaveAttachment(_m))
+	return &LeaveAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaveAttachmentClient) UpdateOneID(id string) *LeaveAttachmentUpdateOne {
+	mutation := newLeaveAttachmentMutation(c.config, OpUpdateOne, withLeaveAttachmentID(id))
+	return &LeaveAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeaveAttachment.
+func (c *LeaveAttachmentClient) Delete() *LeaveAttachmentDelete {
+	mutation := newLeaveAttachmentMutation(c.config, OpDelete)
+	return &LeaveAttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaveAttachmentClient) DeleteOne(_m *LeaveAttachment) *LeaveAttachmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaveAttachmentClient) DeleteOneID(id string) *LeaveAttachmentDeleteOne {
+	builder := c.Delete().Where(leaveattachment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaveAttachmentDeleteOne{builder}
+}
+
+// Query returns a query builder for LeaveAttachment.
+func (c *LeaveAttachmentClient) Query() *LeaveAttachmentQuery {
+	return &LeaveAttachmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeaveAttachment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeaveAttachment entity by its id.
+func (c *LeaveAttachmentClient) Get(ctx context.Context, id string) (*LeaveAttachment, error) {
+	return c.Query().Where(leaveattachment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaveAttachmentClient) GetX(ctx context.Context, id string) *LeaveAttachment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LeaveAttachmentClient) Hooks() []Hook {
+	hooks := c.hooks.LeaveAttachment
+	return append(hooks[:len(hooks):len(hooks)], leaveattachment.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaveAttachmentClient) Interceptors() []Interceptor {
+	return c.inters.LeaveAttachment
+}
+
+func (c *LeaveAttachmentClient) mutate(ctx context.Context, m *LeaveAttachmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaveAttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaveAttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaveAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaveAttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeaveAttachment mutation op: %q", m.Op())
+	}
+}
+
+// LeaveCommentClient is a client for the LeaveComment schema.
+type LeaveCommentClient struct {
+	config
+}
+
+// NewLeaveCommentClient returns a client for the LeaveComment from the given config.
+func NewLeaveCommentClient(c config) *LeaveCommentClient {
+	return &LeaveCommentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leavecomment.Hooks(f(g(h())))`.
+func (c *LeaveCommentClient) Use(hooks ...Hook) {
+	c.hooks.LeaveComment = append(c.hooks.LeaveComment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leavecomment.Intercept(f(g(h())))`.
+func (c *LeaveCommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeaveComment = append(c.inters.LeaveComment, interceptors...)
+}
+
+// Create returns a builder for creating a LeaveComment entity.
+func (c *LeaveCommentClient) Create() *LeaveCommentCreate {
+	mutation := newLeaveCommentMutation(c.config, OpCreate)
+	return &LeaveCommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeaveComment entities.
+func (c *LeaveCommentClient) CreateBulk(builders ...*LeaveCommentCreate) *LeaveCommentCreateBulk {
+	return &LeaveCommentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeaveCommentClient) MapCreateBulk(slice any, setFunc func(*LeaveCommentCreate, int)) *LeaveCommentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeaveCommentCreateBulk{err: fmt.Errorf("calling to LeaveCommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeaveCommentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeaveCommentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeaveComment.
+func (c *LeaveCommentClient) Update() *LeaveCommentUpdate {
+	mutation := newLeaveCommentMutation(c.config, OpUpdate)
+	return &LeaveCommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaveCommentClient) UpdateOne(_m *LeaveComment) *LeaveCommentUpdateOne {
+	mutation := newLeaveCommentMutation(c.config, OpUpdateOne, withLeaveComment(_m))
+	return &LeaveCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaveCommentClient) UpdateOneID(id string) *LeaveCommentUpdateOne {
+	mutation := newLeaveCommentMutation(c.config, OpUpdateOne, withLeaveCommentID(id))
+	return &LeaveCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeaveComment.
+func (c *LeaveCommentClient) Delete() *LeaveCommentDelete {
+	mutation := newLeaveCommentMutation(c.config, OpDelete)
+	return &LeaveCommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaveCommentClient) DeleteOne(_m *LeaveComment) *LeaveCommentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaveCommentClient) DeleteOneID(id string) *LeaveCommentDeleteOne {
+	builder := c.Delete().Where(leavecomment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaveCommentDeleteOne{builder}
+}
+
+// Query returns a query builder for LeaveComment.
+func (c *LeaveCommentClient) Query() *LeaveCommentQuery {
+	return &LeaveCommentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeaveComment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeaveComment entity by its id.
+func (c *LeaveCommentClient) Get(ctx context.Context, id string) (*LeaveComment, error) {
+	return c.Query().Where(leavecomment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaveCommentClient) GetX(ctx context.Context, id string) *LeaveComment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LeaveCommentClient) Hooks() []Hook {
+	hooks := c.hooks.LeaveComment
+	return append(hooks[:len(hooks):len(hooks)], leavecomment.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaveCommentClient) Interceptors() []Interceptor {
+	return c.inters.LeaveComment
+}
+
+func (c *LeaveCommentClient) mutate(ctx context.Context, m *LeaveCommentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaveCommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaveCommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaveCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaveCommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeaveComment mutation op: %q", m.Op())
+	}
+}
+
+// LeavePolicyClient is a client for the LeavePolicy schema.
+type LeavePolicyClient struct {
+	config
+}
+
+// NewLeavePolicyClient returns a client for the LeavePolicy from the given config.
+func NewLeavePolicyClient(c config) *LeavePolicyClient {
+	return &LeavePolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leavepolicy.Hooks(f(g(h())))`.
+func (c *LeavePolicyClient) Use(hooks ...Hook) {
+	c.hooks.LeavePolicy = append(c.hooks.LeavePolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leavepolicy.Intercept(f(g(h())))`.
+func (c *LeavePolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeavePolicy = append(c.inters.LeavePolicy, interceptors...)
+}
+
+// Create returns a builder for creating a LeavePolicy entity.
+func (c *LeavePolicyClient) Create() *LeavePolicyCreate {
+	mutation := newLeavePolicyMutation(c.config, OpCreate)
+	return &LeavePolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LeavePolicy entities.
+func (c *LeavePolicyClient) CreateBulk(builders ...*LeavePolicyCreate) *LeavePolicyCreateBulk {
+	return &LeavePolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeavePolicyClient) MapCreateBulk(slice any, setFunc func(*LeavePolicyCreate, int)) *LeavePolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeavePolicyCreateBulk{err: fmt.Errorf("calling to LeavePolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeavePolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeavePolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeavePolicy.
+func (c *LeavePolicyClient) Update() *LeavePolicyUpdate {
+	mutation := newLeavePolicyMutation(c.config, OpUpdate)
+	return &LeavePolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeavePolicyClient) UpdateOne(_m *LeavePolicy) *LeavePolicyUpdateOne {
+	mutation := newLeavePolicyMutation(c.config, OpUpdateOne, withLeavePolicy(_m))
+	return &LeavePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeavePolicyClient) UpdateOneID(id string) *LeavePolicyUpdateOne {
+	mutation := newLeavePolicyMutation(c.config, OpUpdateOne, withLeavePolicyID(id))
+	return &LeavePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeavePolicy.
+func (c *LeavePolicyClient) Delete() *LeavePolicyDelete {
+	mutation := newLeavePolicyMutation(c.config, OpDelete)
+	return &LeavePolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeavePolicyClient) DeleteOne(_m *LeavePolicy) *LeavePolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeavePolicyClient) DeleteOneID(id string) *LeavePolicyDeleteOne {
+	builder := c.Delete().Where(leavepolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeavePolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for LeavePolicy.
+func (c *LeavePolicyClient) Query() *LeavePolicyQuery {
+	return &LeavePolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeavePolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeavePolicy entity by its id.
+func (c *LeavePolicyClient) Get(ctx context.Context, id string) (*LeavePolicy, error) {
+	return c.Query().Where(leavepolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeavePolicyClient) GetX(ctx context.Context, id string) *LeavePolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LeavePolicyClient) Hooks() []Hook {
+	hooks := c.hooks.LeavePolicy
+	return append(hooks[:len(hooks):len(hooks)], leavepolicy.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeavePolicyClient) Interceptors() []Interceptor {
+	return c.inters.LeavePolicy
+}
+
+func (c *LeavePolicyClient) mutate(ctx context.Context, m *LeavePolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeavePolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeavePolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeavePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeavePolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeavePolicy mutation op: %q", m.Op())
+	}
+}
+
+// LeaveRequestClient is a client for the LeaveRequest schema.
+type LeaveRequestClient struct {
+	config
+}
+
+// NewLeaveRequestClient returns a client for the LeaveRequest from the given config.
+func NewLeaveRequestClient(c config) *LeaveRequestClient {
+	return &LeaveRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `leaverequest.Hooks(f(g(h())))`.
+func (c *LeaveRequestClient) Use(hooks ...Hook) {
+	c.hooks.LeaveRequest = append(c.hooks.LeaveRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `leaverequest.Intercept(f(g(h())))`.
+func (c *LeaveRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LeaveRequest = append(c.inters.LeaveRequest, interceptors...)
+}
+
+// Create returns a builder for creating a LeaveRequest entity.
+func (c *LeaveRequestClient) Create() *LeaveRequestCreate {
 	mutation := newLeaveRequestMutation(c.config, OpCreate)
 	return &LeaveRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LeaveRequest entities.
-func (c *LeaveRequestClient) CreateBulk(builders ...*LeaveRequestCreate) *LeaveRequestCreateBulk {
-	return &LeaveRequestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LeaveRequest entities.
+func (c *LeaveRequestClient) CreateBulk(builders ...*LeaveRequestCreate) *LeaveRequestCreateBulk {
+	return &LeaveRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LeaveRequestClient) MapCreateBulk(slice any, setFunc func(*LeaveRequestCreate, int)) *LeaveRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LeaveRequestCreateBulk{err: fmt.Errorf("calling to LeaveRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LeaveRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LeaveRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LeaveRequest.
+func (c *LeaveRequestClient) Update() *LeaveRequestUpdate {
+	mutation := newLeaveRequestMutation(c.config, OpUpdate)
+	return &LeaveRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LeaveRequestClient) UpdateOne(_m *LeaveRequest) *LeaveRequestUpdateOne {
+	mutation := newLeaveRequestMutation(c.config, OpUpdateOne, withLeaveRequest(_m))
+	return &LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LeaveRequestClient) UpdateOneID(id string) *LeaveRequestUpdateOne {
+	mutation := newLeaveRequestMutation(c.config, OpUpdateOne, withLeaveRequestID(id))
+	return &LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LeaveRequest.
+func (c *LeaveRequestClient) Delete() *LeaveRequestDelete {
+	mutation := newLeaveRequestMutation(c.config, OpDelete)
+	return &LeaveRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LeaveRequestClient) DeleteOne(_m *LeaveRequest) *LeaveRequestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LeaveRequestClient) DeleteOneID(id string) *LeaveRequestDeleteOne {
+	builder := c.Delete().Where(leaverequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LeaveRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for LeaveRequest.
+func (c *LeaveRequestClient) Query() *LeaveRequestQuery {
+	return &LeaveRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLeaveRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LeaveRequest entity by its id.
+func (c *LeaveRequestClient) Get(ctx context.Context, id string) (*LeaveRequest, error) {
+	return c.Query().Where(leaverequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LeaveRequestClient) GetX(ctx context.Context, id string) *LeaveRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAbsenceType queries the absence_type edge of a LeaveRequest.
+func (c *LeaveRequestClient) QueryAbsenceType(_m *LeaveRequest) *AbsenceTypeQuery {
+	query := (&AbsenceTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(leaverequest.Table, leaverequest.FieldID, id),
+			sqlgraph.To(absencetype.Table, absencetype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, leaverequest.AbsenceTypeTable, leaverequest.AbsenceTypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LeaveRequestClient) Hooks() []Hook {
+	hooks := c.hooks.LeaveRequest
+	return append(hooks[:len(hooks):len(hooks)], leaverequest.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LeaveRequestClient) Interceptors() []Interceptor {
+	return c.inters.LeaveRequest
+}
+
+func (c *LeaveRequestClient) mutate(ctx context.Context, m *LeaveRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LeaveRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LeaveRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LeaveRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LeaveRequest mutation op: %q", m.Op())
+	}
+}
+
+// OutboxMessageClient is a client for the OutboxMessage schema.
+type OutboxMessageClient struct {
+	config
+}
+
+// NewOutboxMessageClient returns a client for the OutboxMessage from the given config.
+func NewOutboxMessageClient(c config) *OutboxMessageClient {
+	return &OutboxMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxmessage.Hooks(f(g(h())))`.
+func (c *OutboxMessageClient) Use(hooks ...Hook) {
+	c.hooks.OutboxMessage = append(c.hooks.OutboxMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxmessage.Intercept(f(g(h())))`.
+func (c *OutboxMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxMessage = append(c.inters.OutboxMessage, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxMessage entity.
+func (c *OutboxMessageClient) Create() *OutboxMessageCreate {
+	mutation := newOutboxMessageMutation(c.config, OpCreate)
+	return &OutboxMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxMessage entities.
+func (c *OutboxMessageClient) CreateBulk(builders ...*OutboxMessageCreate) *OutboxMessageCreateBulk {
+	return &OutboxMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxMessageClient) MapCreateBulk(slice any, setFunc func(*OutboxMessageCreate, int)) *OutboxMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxMessageCreateBulk{err: fmt.Errorf("calling to OutboxMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxMessage.
+func (c *OutboxMessageClient) Update() *OutboxMessageUpdate {
+	mutation := newOutboxMessageMutation(c.config, OpUpdate)
+	return &OutboxMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxMessageClient) UpdateOne(_m *OutboxMessage) *OutboxMessageUpdateOne {
+	mutation := newOutboxMessageMutation(c.config, OpUpdateOne, withOutboxMessage(_m))
+	return &OutboxMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxMessageClient) UpdateOneID(id string) *OutboxMessageUpdateOne {
+	mutation := newOutboxMessageMutation(c.config, OpUpdateOne, withOutboxMessageID(id))
+	return &OutboxMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxMessage.
+func (c *OutboxMessageClient) Delete() *OutboxMessageDelete {
+	mutation := newOutboxMessageMutation(c.config, OpDelete)
+	return &OutboxMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxMessageClient) DeleteOne(_m *OutboxMessage) *OutboxMessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxMessageClient) DeleteOneID(id string) *OutboxMessageDeleteOne {
+	builder := c.Delete().Where(outboxmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxMessage.
+func (c *OutboxMessageClient) Query() *OutboxMessageQuery {
+	return &OutboxMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxMessage entity by its id.
+func (c *OutboxMessageClient) Get(ctx context.Context, id string) (*OutboxMessage, error) {
+	return c.Query().Where(outboxmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxMessageClient) GetX(ctx context.Context, id string) *OutboxMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxMessageClient) Hooks() []Hook {
+	hooks := c.hooks.OutboxMessage
+	return append(hooks[:len(hooks):len(hooks)], outboxmessage.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxMessageClient) Interceptors() []Interceptor {
+	return c.inters.OutboxMessage
+}
+
+func (c *OutboxMessageClient) mutate(ctx context.Context, m *OutboxMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutboxMessage mutation op: %q", m.Op())
+	}
+}
+
+// ProcessedEventClient is a client for the ProcessedEvent schema.
+type ProcessedEventClient struct {
+	config
+}
+
+// NewProcessedEventClient returns a client for the ProcessedEvent from the given config.
+func NewProcessedEventClient(c config) *ProcessedEventClient {
+	return &ProcessedEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `processedevent.Hooks(f(g(h())))`.
+func (c *ProcessedEventClient) Use(hooks ...Hook) {
+	c.hooks.ProcessedEvent = append(c.hooks.ProcessedEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `processedevent.Intercept(f(g(h())))`.
+func (c *ProcessedEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProcessedEvent = append(c.inters.ProcessedEvent, interceptors...)
+}
+
+// Create returns a builder for creating a ProcessedEvent entity.
+func (c *ProcessedEventClient) Create() *ProcessedEventCreate {
+	mutation := newProcessedEventMutation(c.config, OpCreate)
+	return &ProcessedEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProcessedEvent entities.
+func (c *ProcessedEventClient) CreateBulk(builders ...*ProcessedEventCreate) *ProcessedEventCreateBulk {
+	return &ProcessedEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProcessedEventClient) MapCreateBulk(slice any, setFunc func(*ProcessedEventCreate, int)) *ProcessedEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProcessedEventCreateBulk{err: fmt.Errorf("calling to ProcessedEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProcessedEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProcessedEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProcessedEvent.
+func (c *ProcessedEventClient) Update() *ProcessedEventUpdate {
+	mutation := newProcessedEventMutation(c.config, OpUpdate)
+	return &ProcessedEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProcessedEventClient) UpdateOne(_m *ProcessedEvent) *ProcessedEventUpdateOne {
+	mutation := newProcessedEventMutation(c.config, OpUpdateOne, withProcessedEvent(_m))
+	return &ProcessedEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProcessedEventClient) UpdateOneID(id string) *ProcessedEventUpdateOne {
+	mutation := newProcessedEventMutation(c.config, OpUpdateOne, withProcessedEventID(id))
+	return &ProcessedEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProcessedEvent.
+func (c *ProcessedEventClient) Delete() *ProcessedEventDelete {
+	mutation := newProcessedEventMutation(c.config, OpDelete)
+	return &ProcessedEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProcessedEventClient) DeleteOne(_m *ProcessedEvent) *ProcessedEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProcessedEventClient) DeleteOneID(id string) *ProcessedEventDeleteOne {
+	builder := c.Delete().Where(processedevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProcessedEventDeleteOne{builder}
+}
+
+// Query returns a query builder for ProcessedEvent.
+func (c *ProcessedEventClient) Query() *ProcessedEventQuery {
+	return &ProcessedEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProcessedEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProcessedEvent entity by its id.
+func (c *ProcessedEventClient) Get(ctx context.Context, id string) (*ProcessedEvent, error) {
+	return c.Query().Where(processedevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProcessedEventClient) GetX(ctx context.Context, id string) *ProcessedEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProcessedEventClient) Hooks() []Hook {
+	hooks := c.hooks.ProcessedEvent
+	return append(hooks[:len(hooks):len(hooks)], processedevent.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProcessedEventClient) Interceptors() []Interceptor {
+	return c.inters.ProcessedEvent
+}
+
+func (c *ProcessedEventClient) mutate(ctx context.Context, m *ProcessedEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProcessedEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProcessedEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProcessedEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProcessedEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProcessedEvent mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id string) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id string) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id string) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id string) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	hooks := c.hooks.WebhookDelivery
+	return append(hooks[:len(hooks):len(hooks)], webhookdelivery.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
+// WebhookSubscriptionClient is a client for the WebhookSubscription schema.
+type WebhookSubscriptionClient struct {
+	config
+}
+
+// NewWebhookSubscriptionClient returns a client for the WebhookSubscription from the given config.
+func NewWebhookSubscriptionClient(c config) *WebhookSubscriptionClient {
+	return &WebhookSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhooksubscription.Hooks(f(g(h())))`.
+func (c *WebhookSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.WebhookSubscription = append(c.hooks.WebhookSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhooksubscription.Intercept(f(g(h())))`.
+func (c *WebhookSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookSubscription = append(c.inters.WebhookSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookSubscription entity.
+func (c *WebhookSubscriptionClient) Create() *WebhookSubscriptionCreate {
+	mutation := newWebhookSubscriptionMutation(c.config, OpCreate)
+	return &WebhookSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookSubscription entities.
+func (c *WebhookSubscriptionClient) CreateBulk(builders ...*WebhookSubscriptionCreate) *WebhookSubscriptionCreateBulk {
+	return &WebhookSubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LeaveRequestClient) MapCreateBulk(slice any, setFunc func(*LeaveRequestCreate, int)) *LeaveRequestCreateBulk {
+func (c *WebhookSubscriptionClient) MapCreateBulk(slice any, setFunc func(*WebhookSubscriptionCreate, int)) *WebhookSubscriptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LeaveRequestCreateBulk{err: fmt.Errorf("calling to LeaveRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WebhookSubscriptionCreateBulk{err: fmt.Errorf("calling to WebhookSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LeaveRequestCreate, rv.Len())
+	builders := make([]*WebhookSubscriptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LeaveRequestCreateBulk{config: c.config, builders: builders}
+	return &WebhookSubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LeaveRequest.
-func (c *LeaveRequestClient) Update() *LeaveRequestUpdate {
-	mutation := newLeaveRequestMutation(c.config, OpUpdate)
-	return &LeaveRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Update() *WebhookSubscriptionUpdate {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdate)
+	return &WebhookSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LeaveRequestClient) UpdateOne(_m *LeaveRequest) *LeaveRequestUpdateOne {
-	mutation := newLeaveRequestMutation(c.config, OpUpdateOne, withLeaveRequest(_m))
-	return &LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookSubscriptionClient) UpdateOne(_m *WebhookSubscription) *WebhookSubscriptionUpdateOne {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdateOne, withWebhookSubscription(_m))
+	return &WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LeaveRequestClient) UpdateOneID(id string) *LeaveRequestUpdateOne {
-	mutation := newLeaveRequestMutation(c.config, OpUpdateOne, withLeaveRequestID(id))
-	return &LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookSubscriptionClient) UpdateOneID(id string) *WebhookSubscriptionUpdateOne {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdateOne, withWebhookSubscriptionID(id))
+	return &WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LeaveRequest.
-func (c *LeaveRequestClient) Delete() *LeaveRequestDelete {
-	mutation := newLeaveRequestMutation(c.config, OpDelete)
-	return &LeaveRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Delete() *WebhookSubscriptionDelete {
+	mutation := newWebhookSubscriptionMutation(c.config, OpDelete)
+	return &WebhookSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LeaveRequestClient) DeleteOne(_m *LeaveRequest) *LeaveRequestDeleteOne {
+func (c *WebhookSubscriptionClient) DeleteOne(_m *WebhookSubscription) *WebhookSubscriptionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LeaveRequestClient) DeleteOneID(id string) *LeaveRequestDeleteOne {
-	builder := c.Delete().Where(leaverequest.ID(id))
+func (c *WebhookSubscriptionClient) DeleteOneID(id string) *WebhookSubscriptionDeleteOne {
+	builder := c.Delete().Where(webhooksubscription.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LeaveRequestDeleteOne{builder}
+	return &WebhookSubscriptionDeleteOne{builder}
 }
 
-// Query returns a query builder for LeaveRequest.
-func (c *LeaveRequestClient) Query() *LeaveRequestQuery {
-	return &LeaveRequestQuery{
+// Query returns a query builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Query() *WebhookSubscriptionQuery {
+	return &WebhookSubscriptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLeaveRequest},
+		ctx:    &QueryContext{Type: TypeWebhookSubscription},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LeaveRequest entity by its id.
-func (c *LeaveRequestClient) Get(ctx context.Context, id string) (*LeaveRequest, error) {
-	return c.Query().Where(leaverequest.ID(id)).Only(ctx)
+// Get returns a WebhookSubscription entity by its id.
+func (c *WebhookSubscriptionClient) Get(ctx context.Context, id string) (*WebhookSubscription, error) {
+	return c.Query().Where(webhooksubscription.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LeaveRequestClient) GetX(ctx context.Context, id string) *LeaveRequest {
+func (c *WebhookSubscriptionClient) GetX(ctx context.Context, id string) *WebhookSubscription {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -993,15 +3197,149 @@ func (c *LeaveRequestClient) GetX(ctx context.Context, id string) *LeaveRequest
 	return obj
 }
 
-// QueryAbsenceType queries the absence_type edge of a LeaveRequest.
-func (c *LeaveRequestClient) QueryAbsenceType(_m *LeaveRequest) *AbsenceTypeQuery {
-	query := (&AbsenceTypeClient{config: c.config}).Query()
+// Hooks returns the client hooks.
+func (c *WebhookSubscriptionClient) Hooks() []Hook {
+	hooks := c.hooks.WebhookSubscription
+	return append(hooks[:len(hooks):len(hooks)], webhooksubscription.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.WebhookSubscription
+}
+
+func (c *WebhookSubscriptionClient) mutate(ctx context.Context, m *WebhookSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookSubscription mutation op: %q", m.Op())
+	}
+}
+
+// WorkScheduleClient is a client for the WorkSchedule schema.
+type WorkScheduleClient struct {
+	config
+}
+
+// NewWorkScheduleClient returns a client for the WorkSchedule from the given config.
+func NewWorkScheduleClient(c config) *WorkScheduleClient {
+	return &WorkScheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `workschedule.Hooks(f(g(h())))`.
+func (c *WorkScheduleClient) Use(hooks ...Hook) {
+	c.hooks.WorkSchedule = append(c.hooks.WorkSchedule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `workschedule.Intercept(f(g(h())))`.
+func (c *WorkScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WorkSchedule = append(c.inters.WorkSchedule, interceptors...)
+}
+
+// Create returns a builder for creating a WorkSchedule entity.
+func (c *WorkScheduleClient) Create() *WorkScheduleCreate {
+	mutation := newWorkScheduleMutation(c.config, OpCreate)
+	return &WorkScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WorkSchedule entities.
+func (c *WorkScheduleClient) CreateBulk(builders ...*WorkScheduleCreate) *WorkScheduleCreateBulk {
+	return &WorkScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WorkScheduleClient) MapCreateBulk(slice any, setFunc func(*WorkScheduleCreate, int)) *WorkScheduleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WorkScheduleCreateBulk{err: fmt.Errorf("calling to WorkScheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WorkScheduleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WorkScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WorkSchedule.
+func (c *WorkScheduleClient) Update() *WorkScheduleUpdate {
+	mutation := newWorkScheduleMutation(c.config, OpUpdate)
+	return &WorkScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WorkScheduleClient) UpdateOne(_m *WorkSchedule) *WorkScheduleUpdateOne {
+	mutation := newWorkScheduleMutation(c.config, OpUpdateOne, withWorkSchedule(_m))
+	return &WorkScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WorkScheduleClient) UpdateOneID(id string) *WorkScheduleUpdateOne {
+	mutation := newWorkScheduleMutation(c.config, OpUpdateOne, withWorkScheduleID(id))
+	return &WorkScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WorkSchedule.
+func (c *WorkScheduleClient) Delete() *WorkScheduleDelete {
+	mutation := newWorkScheduleMutation(c.config, OpDelete)
+	return &WorkScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WorkScheduleClient) DeleteOne(_m *WorkSchedule) *WorkScheduleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WorkScheduleClient) DeleteOneID(id string) *WorkScheduleDeleteOne {
+	builder := c.Delete().Where(workschedule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WorkScheduleDeleteOne{builder}
+}
+
+// Query returns a query builder for WorkSchedule.
+func (c *WorkScheduleClient) Query() *WorkScheduleQuery {
+	return &WorkScheduleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWorkSchedule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WorkSchedule entity by its id.
+func (c *WorkScheduleClient) Get(ctx context.Context, id string) (*WorkSchedule, error) {
+	return c.Query().Where(workschedule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WorkScheduleClient) GetX(ctx context.Context, id string) *WorkSchedule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAssignments queries the assignments edge of a WorkSchedule.
+func (c *WorkScheduleClient) QueryAssignments(_m *WorkSchedule) *WorkScheduleAssignmentQuery {
+	query := (&WorkScheduleAssignmentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(leaverequest.Table, leaverequest.FieldID, id),
-			sqlgraph.To(absencetype.Table, absencetype.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, leaverequest.AbsenceTypeTable, leaverequest.AbsenceTypeColumn),
+			sqlgraph.From(workschedule.Table, workschedule.FieldID, id),
+			sqlgraph.To(workscheduleassignment.Table, workscheduleassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workschedule.AssignmentsTable, workschedule.AssignmentsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1010,38 +3348,195 @@ func (c *LeaveRequestClient) QueryAbsenceType(_m *LeaveRequest) *AbsenceTypeQuer
 }
 
 // Hooks returns the client hooks.
-func (c *LeaveRequestClient) Hooks() []Hook {
-	hooks := c.hooks.LeaveRequest
-	return append(hooks[:len(hooks):len(hooks)], leaverequest.Hooks[:]...)
+func (c *WorkScheduleClient) Hooks() []Hook {
+	hooks := c.hooks.WorkSchedule
+	return append(hooks[:len(hooks):len(hooks)], workschedule.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *LeaveRequestClient) Interceptors() []Interceptor {
-	return c.inters.LeaveRequest
+func (c *WorkScheduleClient) Interceptors() []Interceptor {
+	return c.inters.WorkSchedule
 }
 
-func (c *LeaveRequestClient) mutate(ctx context.Context, m *LeaveRequestMutation) (Value, error) {
+func (c *WorkScheduleClient) mutate(ctx context.Context, m *WorkScheduleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LeaveRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LeaveRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LeaveRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WorkScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LeaveRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WorkScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LeaveRequest mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WorkSchedule mutation op: %q", m.Op())
+	}
+}
+
+// WorkScheduleAssignmentClient is a client for the WorkScheduleAssignment schema.
+type WorkScheduleAssignmentClient struct {
+	config
+}
+
+// NewWorkScheduleAssignmentClient returns a client for the WorkScheduleAssignment from the given config.
+func NewWorkScheduleAssignmentClient(c config) *WorkScheduleAssignmentClient {
+	return &WorkScheduleAssignmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `workscheduleassignment.Hooks(f(g(h())))`.
+func (c *WorkScheduleAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.WorkScheduleAssignment = append(c.hooks.WorkScheduleAssignment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `workscheduleassignment.Intercept(f(g(h())))`.
+func (c *WorkScheduleAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WorkScheduleAssignment = append(c.inters.WorkScheduleAssignment, interceptors...)
+}
+
+// Create returns a builder for creating a WorkScheduleAssignment entity.
+func (c *WorkScheduleAssignmentClient) Create() *WorkScheduleAssignmentCreate {
+	mutation := newWorkScheduleAssignmentMutation(c.config, OpCreate)
+	return &WorkScheduleAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WorkScheduleAssignment entities.
+func (c *WorkScheduleAssignmentClient) CreateBulk(builders ...*WorkScheduleAssignmentCreate) *WorkScheduleAssignmentCreateBulk {
+	return &WorkScheduleAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WorkScheduleAssignmentClient) MapCreateBulk(slice any, setFunc func(*WorkScheduleAssignmentCreate, int)) *WorkScheduleAssignmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WorkScheduleAssignmentCreateBulk{err: fmt.Errorf("calling to WorkScheduleAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WorkScheduleAssignmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WorkScheduleAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WorkScheduleAssignment.
+func (c *WorkScheduleAssignmentClient) Update() *WorkScheduleAssignmentUpdate {
+	mutation := newWorkScheduleAssignmentMutation(c.config, OpUpdate)
+	return &WorkScheduleAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WorkScheduleAssignmentClient) UpdateOne(_m *WorkScheduleAssignment) *WorkScheduleAssignmentUpdateOne {
+	mutation := newWorkScheduleAssignmentMutation(c.config, OpUpdateOne, withWorkScheduleAssignment(_m))
+	return &WorkScheduleAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WorkScheduleAssignmentClient) UpdateOneID(id string) *WorkScheduleAssignmentUpdateOne {
+	mutation := newWorkScheduleAssignmentMutation(c.config, OpUpdateOne, withWorkScheduleAssignmentID(id))
+	return &WorkScheduleAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WorkScheduleAssignment.
+func (c *WorkScheduleAssignmentClient) Delete() *WorkScheduleAssignmentDelete {
+	mutation := newWorkScheduleAssignmentMutation(c.config, OpDelete)
+	return &WorkScheduleAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WorkScheduleAssignmentClient) DeleteOne(_m *WorkScheduleAssignment) *WorkScheduleAssignmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WorkScheduleAssignmentClient) DeleteOneID(id string) *WorkScheduleAssignmentDeleteOne {
+	builder := c.Delete().Where(workscheduleassignment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WorkScheduleAssignmentDeleteOne{builder}
+}
+
+// Query returns a query builder for WorkScheduleAssignment.
+func (c *WorkScheduleAssignmentClient) Query() *WorkScheduleAssignmentQuery {
+	return &WorkScheduleAssignmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWorkScheduleAssignment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WorkScheduleAssignment entity by its id.
+func (c *WorkScheduleAssignmentClient) Get(ctx context.Context, id string) (*WorkScheduleAssignment, error) {
+	return c.Query().Where(workscheduleassignment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WorkScheduleAssignmentClient) GetX(ctx context.Context, id string) *WorkScheduleAssignment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySchedule queries the schedule edge of a WorkScheduleAssignment.
+func (c *WorkScheduleAssignmentClient) QuerySchedule(_m *WorkScheduleAssignment) *WorkScheduleQuery {
+	query := (&WorkScheduleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workscheduleassignment.Table, workscheduleassignment.FieldID, id),
+			sqlgraph.To(workschedule.Table, workschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workscheduleassignment.ScheduleTable, workscheduleassignment.ScheduleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WorkScheduleAssignmentClient) Hooks() []Hook {
+	hooks := c.hooks.WorkScheduleAssignment
+	return append(hooks[:len(hooks):len(hooks)], workscheduleassignment.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WorkScheduleAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.WorkScheduleAssignment
+}
+
+func (c *WorkScheduleAssignmentClient) mutate(ctx context.Context, m *WorkScheduleAssignmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WorkScheduleAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WorkScheduleAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WorkScheduleAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WorkScheduleAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WorkScheduleAssignment mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AbsenceType, AllowancePool, AuditLog, LeaveAllowance, LeaveRequest []ent.Hook
+		AbsenceType, AllowancePool, AllowanceTransaction, ApprovalDelegation, AuditLog,
+		BlackoutPeriod, CoverageRule, Employment, Holiday, HolidayCalendar,
+		LeaveAllowance, LeaveAmendment, LeaveAttachment, LeaveComment, LeavePolicy,
+		LeaveRequest, OutboxMessage, ProcessedEvent, WebhookDelivery,
+		WebhookSubscription, WorkSchedule, WorkScheduleAssignment []ent.Hook
 	}
 	inters struct {
-		AbsenceType, AllowancePool, AuditLog, LeaveAllowance,
-		LeaveRequest []ent.Interceptor
+		AbsenceType, AllowancePool, AllowanceTransaction, ApprovalDelegation, AuditLog,
+		BlackoutPeriod, CoverageRule, Employment, Holiday, HolidayCalendar,
+		LeaveAllowance, LeaveAmendment, LeaveAttachment, LeaveComment, LeavePolicy,
+		LeaveRequest, OutboxMessage, ProcessedEvent, WebhookDelivery,
+		WebhookSubscription, WorkSchedule, WorkScheduleAssignment []ent.Interceptor
 	}
 )
@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coveragerule
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the coveragerule type in the database.
+	Label = "coverage_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateBy holds the string denoting the create_by field in the database.
+	FieldCreateBy = "create_by"
+	// FieldUpdateBy holds the string denoting the update_by field in the database.
+	FieldUpdateBy = "update_by"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldOrgUnitName holds the string denoting the org_unit_name field in the database.
+	FieldOrgUnitName = "org_unit_name"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldCount holds the string denoting the count field in the database.
+	FieldCount = "count"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldEnforcement holds the string denoting the enforcement field in the database.
+	FieldEnforcement = "enforcement"
+	// FieldAbsenceTypeIds holds the string denoting the absence_type_ids field in the database.
+	FieldAbsenceTypeIds = "absence_type_ids"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// Table holds the table name of the coveragerule in the database.
+	Table = "hr_coverage_rules"
+)
+
+// Columns holds all SQL columns for coveragerule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateBy,
+	FieldUpdateBy,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldName,
+	FieldDescription,
+	FieldOrgUnitName,
+	FieldKind,
+	FieldCount,
+	FieldPosition,
+	FieldEnforcement,
+	FieldAbsenceTypeIds,
+	FieldEnabled,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-hr/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// OrgUnitNameValidator is a validator for the "org_unit_name" field. It is called by the builders before save.
+	OrgUnitNameValidator func(string) error
+	// CountValidator is a validator for the "count" field. It is called by the builders before save.
+	CountValidator func(int) error
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition string
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindMaxAbsent  Kind = "max_absent"
+	KindMinPresent Kind = "min_present"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindMaxAbsent, KindMinPresent:
+		return nil
+	default:
+		return fmt.Errorf("coveragerule: invalid enum value for kind field: %q", k)
+	}
+}
+
+// Enforcement defines the type for the "enforcement" enum field.
+type Enforcement string
+
+// EnforcementWarn is the default value of the Enforcement enum.
+const DefaultEnforcement = EnforcementWarn
+
+// Enforcement values.
+const (
+	EnforcementWarn  Enforcement = "warn"
+	EnforcementBlock Enforcement = "block"
+)
+
+func (e Enforcement) String() string {
+	return string(e)
+}
+
+// EnforcementValidator is a validator for the "enforcement" field enum values. It is called by the builders before save.
+func EnforcementValidator(e Enforcement) error {
+	switch e {
+	case EnforcementWarn, EnforcementBlock:
+		return nil
+	default:
+		return fmt.Errorf("coveragerule: invalid enum value for enforcement field: %q", e)
+	}
+}
+
+// OrderOption defines the ordering options for the CoverageRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateBy orders the results by the create_by field.
+func ByCreateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateBy, opts...).ToFunc()
+}
+
+// ByUpdateBy orders the results by the update_by field.
+func ByUpdateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateBy, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByOrgUnitName orders the results by the org_unit_name field.
+func ByOrgUnitName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrgUnitName, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByCount orders the results by the count field.
+func ByCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCount, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByEnforcement orders the results by the enforcement field.
+func ByEnforcement(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnforcement, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
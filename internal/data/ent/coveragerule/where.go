@@ -0,0 +1,820 @@
+// Code generated by ent, DO NOT EDIT.
+
+package coveragerule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// UpdateBy applies equality check predicate on the "update_by" field. It's identical to UpdateByEQ.
+func UpdateBy(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// OrgUnitName applies equality check predicate on the "org_unit_name" field. It's identical to OrgUnitNameEQ.
+func OrgUnitName(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldOrgUnitName, v))
+}
+
+// Count applies equality check predicate on the "count" field. It's identical to CountEQ.
+func Count(v int) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldCount, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldPosition, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotNull(FieldCreateBy))
+}
+
+// UpdateByEQ applies the EQ predicate on the "update_by" field.
+func UpdateByEQ(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// UpdateByNEQ applies the NEQ predicate on the "update_by" field.
+func UpdateByNEQ(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldUpdateBy, v))
+}
+
+// UpdateByIn applies the In predicate on the "update_by" field.
+func UpdateByIn(vs ...uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByNotIn applies the NotIn predicate on the "update_by" field.
+func UpdateByNotIn(vs ...uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByGT applies the GT predicate on the "update_by" field.
+func UpdateByGT(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGT(FieldUpdateBy, v))
+}
+
+// UpdateByGTE applies the GTE predicate on the "update_by" field.
+func UpdateByGTE(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGTE(FieldUpdateBy, v))
+}
+
+// UpdateByLT applies the LT predicate on the "update_by" field.
+func UpdateByLT(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLT(FieldUpdateBy, v))
+}
+
+// UpdateByLTE applies the LTE predicate on the "update_by" field.
+func UpdateByLTE(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLTE(FieldUpdateBy, v))
+}
+
+// UpdateByIsNil applies the IsNil predicate on the "update_by" field.
+func UpdateByIsNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIsNull(FieldUpdateBy))
+}
+
+// UpdateByNotNil applies the NotNil predicate on the "update_by" field.
+func UpdateByNotNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotNull(FieldUpdateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotNull(FieldTenantID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// OrgUnitNameEQ applies the EQ predicate on the "org_unit_name" field.
+func OrgUnitNameEQ(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameNEQ applies the NEQ predicate on the "org_unit_name" field.
+func OrgUnitNameNEQ(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameIn applies the In predicate on the "org_unit_name" field.
+func OrgUnitNameIn(vs ...string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIn(FieldOrgUnitName, vs...))
+}
+
+// OrgUnitNameNotIn applies the NotIn predicate on the "org_unit_name" field.
+func OrgUnitNameNotIn(vs ...string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotIn(FieldOrgUnitName, vs...))
+}
+
+// OrgUnitNameGT applies the GT predicate on the "org_unit_name" field.
+func OrgUnitNameGT(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGT(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameGTE applies the GTE predicate on the "org_unit_name" field.
+func OrgUnitNameGTE(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGTE(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameLT applies the LT predicate on the "org_unit_name" field.
+func OrgUnitNameLT(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLT(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameLTE applies the LTE predicate on the "org_unit_name" field.
+func OrgUnitNameLTE(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLTE(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameContains applies the Contains predicate on the "org_unit_name" field.
+func OrgUnitNameContains(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldContains(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameHasPrefix applies the HasPrefix predicate on the "org_unit_name" field.
+func OrgUnitNameHasPrefix(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldHasPrefix(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameHasSuffix applies the HasSuffix predicate on the "org_unit_name" field.
+func OrgUnitNameHasSuffix(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldHasSuffix(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameEqualFold applies the EqualFold predicate on the "org_unit_name" field.
+func OrgUnitNameEqualFold(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEqualFold(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameContainsFold applies the ContainsFold predicate on the "org_unit_name" field.
+func OrgUnitNameContainsFold(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldContainsFold(FieldOrgUnitName, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// CountEQ applies the EQ predicate on the "count" field.
+func CountEQ(v int) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldCount, v))
+}
+
+// CountNEQ applies the NEQ predicate on the "count" field.
+func CountNEQ(v int) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldCount, v))
+}
+
+// CountIn applies the In predicate on the "count" field.
+func CountIn(vs ...int) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIn(FieldCount, vs...))
+}
+
+// CountNotIn applies the NotIn predicate on the "count" field.
+func CountNotIn(vs ...int) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotIn(FieldCount, vs...))
+}
+
+// CountGT applies the GT predicate on the "count" field.
+func CountGT(v int) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGT(FieldCount, v))
+}
+
+// CountGTE applies the GTE predicate on the "count" field.
+func CountGTE(v int) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGTE(FieldCount, v))
+}
+
+// CountLT applies the LT predicate on the "count" field.
+func CountLT(v int) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLT(FieldCount, v))
+}
+
+// CountLTE applies the LTE predicate on the "count" field.
+func CountLTE(v int) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLTE(FieldCount, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldLTE(FieldPosition, v))
+}
+
+// PositionContains applies the Contains predicate on the "position" field.
+func PositionContains(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldContains(FieldPosition, v))
+}
+
+// PositionHasPrefix applies the HasPrefix predicate on the "position" field.
+func PositionHasPrefix(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldHasPrefix(FieldPosition, v))
+}
+
+// PositionHasSuffix applies the HasSuffix predicate on the "position" field.
+func PositionHasSuffix(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldHasSuffix(FieldPosition, v))
+}
+
+// PositionIsNil applies the IsNil predicate on the "position" field.
+func PositionIsNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIsNull(FieldPosition))
+}
+
+// PositionNotNil applies the NotNil predicate on the "position" field.
+func PositionNotNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotNull(FieldPosition))
+}
+
+// PositionEqualFold applies the EqualFold predicate on the "position" field.
+func PositionEqualFold(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEqualFold(FieldPosition, v))
+}
+
+// PositionContainsFold applies the ContainsFold predicate on the "position" field.
+func PositionContainsFold(v string) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldContainsFold(FieldPosition, v))
+}
+
+// EnforcementEQ applies the EQ predicate on the "enforcement" field.
+func EnforcementEQ(v Enforcement) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldEnforcement, v))
+}
+
+// EnforcementNEQ applies the NEQ predicate on the "enforcement" field.
+func EnforcementNEQ(v Enforcement) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldEnforcement, v))
+}
+
+// EnforcementIn applies the In predicate on the "enforcement" field.
+func EnforcementIn(vs ...Enforcement) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIn(FieldEnforcement, vs...))
+}
+
+// EnforcementNotIn applies the NotIn predicate on the "enforcement" field.
+func EnforcementNotIn(vs ...Enforcement) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotIn(FieldEnforcement, vs...))
+}
+
+// AbsenceTypeIdsIsNil applies the IsNil predicate on the "absence_type_ids" field.
+func AbsenceTypeIdsIsNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldIsNull(FieldAbsenceTypeIds))
+}
+
+// AbsenceTypeIdsNotNil applies the NotNil predicate on the "absence_type_ids" field.
+func AbsenceTypeIdsNotNil() predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNotNull(FieldAbsenceTypeIds))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CoverageRule) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CoverageRule) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CoverageRule) predicate.CoverageRule {
+	return predicate.CoverageRule(sql.NotPredicates(p))
+}
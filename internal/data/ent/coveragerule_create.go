@@ -0,0 +1,1472 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/coveragerule"
+)
+
+// CoverageRuleCreate is the builder for creating a CoverageRule entity.
+type CoverageRuleCreate struct {
+	config
+	mutation *CoverageRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *CoverageRuleCreate) SetCreateBy(v uint32) *CoverageRuleCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *CoverageRuleCreate) SetNillableCreateBy(v *uint32) *CoverageRuleCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *CoverageRuleCreate) SetUpdateBy(v uint32) *CoverageRuleCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *CoverageRuleCreate) SetNillableUpdateBy(v *uint32) *CoverageRuleCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *CoverageRuleCreate) SetCreateTime(v time.Time) *CoverageRuleCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *CoverageRuleCreate) SetNillableCreateTime(v *time.Time) *CoverageRuleCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *CoverageRuleCreate) SetUpdateTime(v time.Time) *CoverageRuleCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *CoverageRuleCreate) SetNillableUpdateTime(v *time.Time) *CoverageRuleCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *CoverageRuleCreate) SetDeleteTime(v time.Time) *CoverageRuleCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *CoverageRuleCreate) SetNillableDeleteTime(v *time.Time) *CoverageRuleCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *CoverageRuleCreate) SetTenantID(v uint32) *CoverageRuleCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *CoverageRuleCreate) SetNillableTenantID(v *uint32) *CoverageRuleCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *CoverageRuleCreate) SetName(v string) *CoverageRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *CoverageRuleCreate) SetDescription(v string) *CoverageRuleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *CoverageRuleCreate) SetNillableDescription(v *string) *CoverageRuleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (_c *CoverageRuleCreate) SetOrgUnitName(v string) *CoverageRuleCreate {
+	_c.mutation.SetOrgUnitName(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *CoverageRuleCreate) SetKind(v coveragerule.Kind) *CoverageRuleCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetCount sets the "count" field.
+func (_c *CoverageRuleCreate) SetCount(v int) *CoverageRuleCreate {
+	_c.mutation.SetCount(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *CoverageRuleCreate) SetPosition(v string) *CoverageRuleCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *CoverageRuleCreate) SetNillablePosition(v *string) *CoverageRuleCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (_c *CoverageRuleCreate) SetEnforcement(v coveragerule.Enforcement) *CoverageRuleCreate {
+	_c.mutation.SetEnforcement(v)
+	return _c
+}
+
+// SetNillableEnforcement sets the "enforcement" field if the given value is not nil.
+func (_c *CoverageRuleCreate) SetNillableEnforcement(v *coveragerule.Enforcement) *CoverageRuleCreate {
+	if v != nil {
+		_c.SetEnforcement(*v)
+	}
+	return _c
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (_c *CoverageRuleCreate) SetAbsenceTypeIds(v []string) *CoverageRuleCreate {
+	_c.mutation.SetAbsenceTypeIds(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *CoverageRuleCreate) SetEnabled(v bool) *CoverageRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *CoverageRuleCreate) SetNillableEnabled(v *bool) *CoverageRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CoverageRuleCreate) SetID(v string) *CoverageRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the CoverageRuleMutation object of the builder.
+func (_c *CoverageRuleCreate) Mutation() *CoverageRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the CoverageRule in the database.
+func (_c *CoverageRuleCreate) Save(ctx context.Context) (*CoverageRule, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CoverageRuleCreate) SaveX(ctx context.Context) *CoverageRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoverageRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoverageRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CoverageRuleCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := coveragerule.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := coveragerule.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.Enforcement(); !ok {
+		v := coveragerule.DefaultEnforcement
+		_c.mutation.SetEnforcement(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := coveragerule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CoverageRuleCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CoverageRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := coveragerule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OrgUnitName(); !ok {
+		return &ValidationError{Name: "org_unit_name", err: errors.New(`ent: missing required field "CoverageRule.org_unit_name"`)}
+	}
+	if v, ok := _c.mutation.OrgUnitName(); ok {
+		if err := coveragerule.OrgUnitNameValidator(v); err != nil {
+			return &ValidationError{Name: "org_unit_name", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.org_unit_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "CoverageRule.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := coveragerule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "CoverageRule.count"`)}
+	}
+	if v, ok := _c.mutation.Count(); ok {
+		if err := coveragerule.CountValidator(v); err != nil {
+			return &ValidationError{Name: "count", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enforcement(); !ok {
+		return &ValidationError{Name: "enforcement", err: errors.New(`ent: missing required field "CoverageRule.enforcement"`)}
+	}
+	if v, ok := _c.mutation.Enforcement(); ok {
+		if err := coveragerule.EnforcementValidator(v); err != nil {
+			return &ValidationError{Name: "enforcement", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.enforcement": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "CoverageRule.enabled"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := coveragerule.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *CoverageRuleCreate) sqlSave(ctx context.Context) (*CoverageRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CoverageRule.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CoverageRuleCreate) createSpec() (*CoverageRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CoverageRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(coveragerule.Table, sqlgraph.NewFieldSpec(coveragerule.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(coveragerule.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(coveragerule.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(coveragerule.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(coveragerule.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(coveragerule.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(coveragerule.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(coveragerule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(coveragerule.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.OrgUnitName(); ok {
+		_spec.SetField(coveragerule.FieldOrgUnitName, field.TypeString, value)
+		_node.OrgUnitName = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(coveragerule.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Count(); ok {
+		_spec.SetField(coveragerule.FieldCount, field.TypeInt, value)
+		_node.Count = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(coveragerule.FieldPosition, field.TypeString, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.Enforcement(); ok {
+		_spec.SetField(coveragerule.FieldEnforcement, field.TypeEnum, value)
+		_node.Enforcement = value
+	}
+	if value, ok := _c.mutation.AbsenceTypeIds(); ok {
+		_spec.SetField(coveragerule.FieldAbsenceTypeIds, field.TypeJSON, value)
+		_node.AbsenceTypeIds = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(coveragerule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoverageRule.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoverageRuleUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoverageRuleCreate) OnConflict(opts ...sql.ConflictOption) *CoverageRuleUpsertOne {
+	_c.conflict = opts
+	return &CoverageRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoverageRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoverageRuleCreate) OnConflictColumns(columns ...string) *CoverageRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoverageRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CoverageRuleUpsertOne is the builder for "upsert"-ing
+	//  one CoverageRule node.
+	CoverageRuleUpsertOne struct {
+		create *CoverageRuleCreate
+	}
+
+	// CoverageRuleUpsert is the "OnConflict" setter.
+	CoverageRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *CoverageRuleUpsert) SetCreateBy(v uint32) *CoverageRuleUpsert {
+	u.Set(coveragerule.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *CoverageRuleUpsert) UpdateCreateBy() *CoverageRuleUpsert {
+	u.SetExcluded(coveragerule.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *CoverageRuleUpsert) AddCreateBy(v uint32) *CoverageRuleUpsert {
+	u.Add(coveragerule.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *CoverageRuleUpsert) ClearCreateBy() *CoverageRuleUpsert {
+	u.SetNull(coveragerule.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *CoverageRuleUpsert) SetUpdateBy(v uint32) *CoverageRuleUpsert {
+	u.Set(coveragerule.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *CoverageRuleUpsert) UpdateUpdateBy() *CoverageRuleUpsert {
+	u.SetExcluded(coveragerule.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *CoverageRuleUpsert) AddUpdateBy(v uint32) *CoverageRuleUpsert {
+	u.Add(coveragerule.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *CoverageRuleUpsert) ClearUpdateBy() *CoverageRuleUpsert {
+	u.SetNull(coveragerule.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CoverageRuleUpsert) SetUpdateTime(v time.Time) *CoverageRuleUpsert {
+	u.Set(coveragerule.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CoverageRuleUpsert) UpdateUpdateTime() *CoverageRuleUpsert {
+	u.SetExcluded(coveragerule.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *CoverageRuleUpsert) ClearUpdateTime() *CoverageRuleUpsert {
+	u.SetNull(coveragerule.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *CoverageRuleUpsert) SetDeleteTime(v time.Time) *CoverageRuleUpsert {
+	u.Set(coveragerule.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *CoverageRuleUpsert) UpdateDeleteTime() *CoverageRuleUpsert {
+	u.SetExcluded(coveragerule.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *CoverageRuleUpsert) ClearDeleteTime() *CoverageRuleUpsert {
+	u.SetNull(coveragerule.FieldDeleteTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *CoverageRuleUpsert) SetName(v string) *CoverageRuleUpsert {
+	u.Set(coveragerule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CoverageRuleUpsert) UpdateName() *CoverageRuleUpsert {
+	u.SetExcluded(coveragerule.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *CoverageRuleUpsert) SetDescription(v string) *CoverageRuleUpsert {
+	u.Set(coveragerule.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CoverageRuleUpsert) UpdateDescription() *CoverageRuleUpsert {
+	u.SetExcluded(coveragerule.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CoverageRuleUpsert) ClearDescription() *CoverageRuleUpsert {
+	u.SetNull(coveragerule.FieldDescription)
+	return u
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (u *CoverageRuleUpsert) SetOrgUnitName(v string) *CoverageRuleUpsert {
+	u.Set(coveragerule.FieldOrgUnitName, v)
+	return u
+}
+
+// UpdateOrgUnitName sets the "org_unit_name" field to the value that was provided on create.
+func (u *CoverageRuleUpsert) UpdateOrgUnitName() *CoverageRuleUpsert {
+	u.SetExcluded(coveragerule.FieldOrgUnitName)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *CoverageRuleUpsert) SetKind(v coveragerule.Kind) *CoverageRuleUpsert {
+	u.Set(coveragerule.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *CoverageRuleUpsert) UpdateKind() *CoverageRuleUpsert {
+	u.SetExcluded(coveragerule.FieldKind)
+	return u
+}
+
+// SetCount sets the "count" field.
+func (u *CoverageRuleUpsert) SetCount(v int) *CoverageRuleUpsert {
+	u.Set(coveragerule.FieldCount, v)
+	return u
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *CoverageRuleUpsert) UpdateCount() *CoverageRuleUpsert {
+	u.SetExcluded(coveragerule.FieldCount)
+	return u
+}
+
+// AddCount adds v to the "count" field.
+func (u *CoverageRuleUpsert) AddCount(v int) *CoverageRuleUpsert {
+	u.Add(coveragerule.FieldCount, v)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *CoverageRuleUpsert) SetPosition(v string) *CoverageRuleUpsert {
+	u.Set(coveragerule.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *CoverageRuleUpsert) UpdatePosition() *CoverageRuleUpsert {
+	u.SetExcluded(coveragerule.FieldPosition)
+	return u
+}
+
+// ClearPosition clears the value of the "position" field.
+func (u *CoverageRuleUpsert) ClearPosition() *CoverageRuleUpsert {
+	u.SetNull(coveragerule.FieldPosition)
+	return u
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (u *CoverageRuleUpsert) SetEnforcement(v coveragerule.Enforcement) *CoverageRuleUpsert {
+	u.Set(coveragerule.FieldEnforcement, v)
+	return u
+}
+
+// UpdateEnforcement sets the "enforcement" field to the value that was provided on create.
+func (u *CoverageRuleUpsert) UpdateEnforcement() *CoverageRuleUpsert {
+	u.SetExcluded(coveragerule.FieldEnforcement)
+	return u
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (u *CoverageRuleUpsert) SetAbsenceTypeIds(v []string) *CoverageRuleUpsert {
+	u.Set(coveragerule.FieldAbsenceTypeIds, v)
+	return u
+}
+
+// UpdateAbsenceTypeIds sets the "absence_type_ids" field to the value that was provided on create.
+func (u *CoverageRuleUpsert) UpdateAbsenceTypeIds() *CoverageRuleUpsert {
+	u.SetExcluded(coveragerule.FieldAbsenceTypeIds)
+	return u
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (u *CoverageRuleUpsert) ClearAbsenceTypeIds() *CoverageRuleUpsert {
+	u.SetNull(coveragerule.FieldAbsenceTypeIds)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CoverageRuleUpsert) SetEnabled(v bool) *CoverageRuleUpsert {
+	u.Set(coveragerule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CoverageRuleUpsert) UpdateEnabled() *CoverageRuleUpsert {
+	u.SetExcluded(coveragerule.FieldEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CoverageRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coveragerule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoverageRuleUpsertOne) UpdateNewValues() *CoverageRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(coveragerule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(coveragerule.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(coveragerule.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoverageRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CoverageRuleUpsertOne) Ignore() *CoverageRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoverageRuleUpsertOne) DoNothing() *CoverageRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoverageRuleCreate.OnConflict
+// documentation for more info.
+func (u *CoverageRuleUpsertOne) Update(set func(*CoverageRuleUpsert)) *CoverageRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoverageRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *CoverageRuleUpsertOne) SetCreateBy(v uint32) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *CoverageRuleUpsertOne) AddCreateBy(v uint32) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *CoverageRuleUpsertOne) UpdateCreateBy() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *CoverageRuleUpsertOne) ClearCreateBy() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *CoverageRuleUpsertOne) SetUpdateBy(v uint32) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *CoverageRuleUpsertOne) AddUpdateBy(v uint32) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *CoverageRuleUpsertOne) UpdateUpdateBy() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *CoverageRuleUpsertOne) ClearUpdateBy() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CoverageRuleUpsertOne) SetUpdateTime(v time.Time) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CoverageRuleUpsertOne) UpdateUpdateTime() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *CoverageRuleUpsertOne) ClearUpdateTime() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *CoverageRuleUpsertOne) SetDeleteTime(v time.Time) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *CoverageRuleUpsertOne) UpdateDeleteTime() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *CoverageRuleUpsertOne) ClearDeleteTime() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CoverageRuleUpsertOne) SetName(v string) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CoverageRuleUpsertOne) UpdateName() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CoverageRuleUpsertOne) SetDescription(v string) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CoverageRuleUpsertOne) UpdateDescription() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CoverageRuleUpsertOne) ClearDescription() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (u *CoverageRuleUpsertOne) SetOrgUnitName(v string) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetOrgUnitName(v)
+	})
+}
+
+// UpdateOrgUnitName sets the "org_unit_name" field to the value that was provided on create.
+func (u *CoverageRuleUpsertOne) UpdateOrgUnitName() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateOrgUnitName()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *CoverageRuleUpsertOne) SetKind(v coveragerule.Kind) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *CoverageRuleUpsertOne) UpdateKind() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetCount sets the "count" field.
+func (u *CoverageRuleUpsertOne) SetCount(v int) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetCount(v)
+	})
+}
+
+// AddCount adds v to the "count" field.
+func (u *CoverageRuleUpsertOne) AddCount(v int) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.AddCount(v)
+	})
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *CoverageRuleUpsertOne) UpdateCount() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateCount()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *CoverageRuleUpsertOne) SetPosition(v string) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *CoverageRuleUpsertOne) UpdatePosition() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// ClearPosition clears the value of the "position" field.
+func (u *CoverageRuleUpsertOne) ClearPosition() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.ClearPosition()
+	})
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (u *CoverageRuleUpsertOne) SetEnforcement(v coveragerule.Enforcement) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetEnforcement(v)
+	})
+}
+
+// UpdateEnforcement sets the "enforcement" field to the value that was provided on create.
+func (u *CoverageRuleUpsertOne) UpdateEnforcement() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateEnforcement()
+	})
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (u *CoverageRuleUpsertOne) SetAbsenceTypeIds(v []string) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetAbsenceTypeIds(v)
+	})
+}
+
+// UpdateAbsenceTypeIds sets the "absence_type_ids" field to the value that was provided on create.
+func (u *CoverageRuleUpsertOne) UpdateAbsenceTypeIds() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateAbsenceTypeIds()
+	})
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (u *CoverageRuleUpsertOne) ClearAbsenceTypeIds() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.ClearAbsenceTypeIds()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CoverageRuleUpsertOne) SetEnabled(v bool) *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CoverageRuleUpsertOne) UpdateEnabled() *CoverageRuleUpsertOne {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *CoverageRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoverageRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoverageRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CoverageRuleUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CoverageRuleUpsertOne.ID is not supported by MySQL driver. Use CoverageRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CoverageRuleUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CoverageRuleCreateBulk is the builder for creating many CoverageRule entities in bulk.
+type CoverageRuleCreateBulk struct {
+	config
+	err      error
+	builders []*CoverageRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CoverageRule entities in the database.
+func (_c *CoverageRuleCreateBulk) Save(ctx context.Context) ([]*CoverageRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CoverageRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CoverageRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CoverageRuleCreateBulk) SaveX(ctx context.Context) []*CoverageRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CoverageRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CoverageRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CoverageRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CoverageRuleUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CoverageRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *CoverageRuleUpsertBulk {
+	_c.conflict = opts
+	return &CoverageRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CoverageRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CoverageRuleCreateBulk) OnConflictColumns(columns ...string) *CoverageRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CoverageRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// CoverageRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of CoverageRule nodes.
+type CoverageRuleUpsertBulk struct {
+	create *CoverageRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CoverageRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(coveragerule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CoverageRuleUpsertBulk) UpdateNewValues() *CoverageRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(coveragerule.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(coveragerule.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(coveragerule.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CoverageRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CoverageRuleUpsertBulk) Ignore() *CoverageRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CoverageRuleUpsertBulk) DoNothing() *CoverageRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CoverageRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *CoverageRuleUpsertBulk) Update(set func(*CoverageRuleUpsert)) *CoverageRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CoverageRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *CoverageRuleUpsertBulk) SetCreateBy(v uint32) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *CoverageRuleUpsertBulk) AddCreateBy(v uint32) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *CoverageRuleUpsertBulk) UpdateCreateBy() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *CoverageRuleUpsertBulk) ClearCreateBy() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *CoverageRuleUpsertBulk) SetUpdateBy(v uint32) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *CoverageRuleUpsertBulk) AddUpdateBy(v uint32) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *CoverageRuleUpsertBulk) UpdateUpdateBy() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *CoverageRuleUpsertBulk) ClearUpdateBy() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CoverageRuleUpsertBulk) SetUpdateTime(v time.Time) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CoverageRuleUpsertBulk) UpdateUpdateTime() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *CoverageRuleUpsertBulk) ClearUpdateTime() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *CoverageRuleUpsertBulk) SetDeleteTime(v time.Time) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *CoverageRuleUpsertBulk) UpdateDeleteTime() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *CoverageRuleUpsertBulk) ClearDeleteTime() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *CoverageRuleUpsertBulk) SetName(v string) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *CoverageRuleUpsertBulk) UpdateName() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *CoverageRuleUpsertBulk) SetDescription(v string) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *CoverageRuleUpsertBulk) UpdateDescription() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *CoverageRuleUpsertBulk) ClearDescription() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (u *CoverageRuleUpsertBulk) SetOrgUnitName(v string) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetOrgUnitName(v)
+	})
+}
+
+// UpdateOrgUnitName sets the "org_unit_name" field to the value that was provided on create.
+func (u *CoverageRuleUpsertBulk) UpdateOrgUnitName() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateOrgUnitName()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *CoverageRuleUpsertBulk) SetKind(v coveragerule.Kind) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *CoverageRuleUpsertBulk) UpdateKind() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetCount sets the "count" field.
+func (u *CoverageRuleUpsertBulk) SetCount(v int) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetCount(v)
+	})
+}
+
+// AddCount adds v to the "count" field.
+func (u *CoverageRuleUpsertBulk) AddCount(v int) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.AddCount(v)
+	})
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *CoverageRuleUpsertBulk) UpdateCount() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateCount()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *CoverageRuleUpsertBulk) SetPosition(v string) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *CoverageRuleUpsertBulk) UpdatePosition() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// ClearPosition clears the value of the "position" field.
+func (u *CoverageRuleUpsertBulk) ClearPosition() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.ClearPosition()
+	})
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (u *CoverageRuleUpsertBulk) SetEnforcement(v coveragerule.Enforcement) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetEnforcement(v)
+	})
+}
+
+// UpdateEnforcement sets the "enforcement" field to the value that was provided on create.
+func (u *CoverageRuleUpsertBulk) UpdateEnforcement() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateEnforcement()
+	})
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (u *CoverageRuleUpsertBulk) SetAbsenceTypeIds(v []string) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetAbsenceTypeIds(v)
+	})
+}
+
+// UpdateAbsenceTypeIds sets the "absence_type_ids" field to the value that was provided on create.
+func (u *CoverageRuleUpsertBulk) UpdateAbsenceTypeIds() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateAbsenceTypeIds()
+	})
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (u *CoverageRuleUpsertBulk) ClearAbsenceTypeIds() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.ClearAbsenceTypeIds()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *CoverageRuleUpsertBulk) SetEnabled(v bool) *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *CoverageRuleUpsertBulk) UpdateEnabled() *CoverageRuleUpsertBulk {
+	return u.Update(func(s *CoverageRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *CoverageRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CoverageRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CoverageRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CoverageRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,905 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/coveragerule"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// CoverageRuleUpdate is the builder for updating CoverageRule entities.
+type CoverageRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CoverageRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CoverageRuleUpdate builder.
+func (_u *CoverageRuleUpdate) Where(ps ...predicate.CoverageRule) *CoverageRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *CoverageRuleUpdate) SetCreateBy(v uint32) *CoverageRuleUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *CoverageRuleUpdate) SetNillableCreateBy(v *uint32) *CoverageRuleUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *CoverageRuleUpdate) AddCreateBy(v int32) *CoverageRuleUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *CoverageRuleUpdate) ClearCreateBy() *CoverageRuleUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *CoverageRuleUpdate) SetUpdateBy(v uint32) *CoverageRuleUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *CoverageRuleUpdate) SetNillableUpdateBy(v *uint32) *CoverageRuleUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *CoverageRuleUpdate) AddUpdateBy(v int32) *CoverageRuleUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *CoverageRuleUpdate) ClearUpdateBy() *CoverageRuleUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *CoverageRuleUpdate) SetUpdateTime(v time.Time) *CoverageRuleUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *CoverageRuleUpdate) SetNillableUpdateTime(v *time.Time) *CoverageRuleUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *CoverageRuleUpdate) ClearUpdateTime() *CoverageRuleUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *CoverageRuleUpdate) SetDeleteTime(v time.Time) *CoverageRuleUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *CoverageRuleUpdate) SetNillableDeleteTime(v *time.Time) *CoverageRuleUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *CoverageRuleUpdate) ClearDeleteTime() *CoverageRuleUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CoverageRuleUpdate) SetName(v string) *CoverageRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CoverageRuleUpdate) SetNillableName(v *string) *CoverageRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CoverageRuleUpdate) SetDescription(v string) *CoverageRuleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CoverageRuleUpdate) SetNillableDescription(v *string) *CoverageRuleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CoverageRuleUpdate) ClearDescription() *CoverageRuleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (_u *CoverageRuleUpdate) SetOrgUnitName(v string) *CoverageRuleUpdate {
+	_u.mutation.SetOrgUnitName(v)
+	return _u
+}
+
+// SetNillableOrgUnitName sets the "org_unit_name" field if the given value is not nil.
+func (_u *CoverageRuleUpdate) SetNillableOrgUnitName(v *string) *CoverageRuleUpdate {
+	if v != nil {
+		_u.SetOrgUnitName(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *CoverageRuleUpdate) SetKind(v coveragerule.Kind) *CoverageRuleUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *CoverageRuleUpdate) SetNillableKind(v *coveragerule.Kind) *CoverageRuleUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetCount sets the "count" field.
+func (_u *CoverageRuleUpdate) SetCount(v int) *CoverageRuleUpdate {
+	_u.mutation.ResetCount()
+	_u.mutation.SetCount(v)
+	return _u
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_u *CoverageRuleUpdate) SetNillableCount(v *int) *CoverageRuleUpdate {
+	if v != nil {
+		_u.SetCount(*v)
+	}
+	return _u
+}
+
+// AddCount adds value to the "count" field.
+func (_u *CoverageRuleUpdate) AddCount(v int) *CoverageRuleUpdate {
+	_u.mutation.AddCount(v)
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *CoverageRuleUpdate) SetPosition(v string) *CoverageRuleUpdate {
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *CoverageRuleUpdate) SetNillablePosition(v *string) *CoverageRuleUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// ClearPosition clears the value of the "position" field.
+func (_u *CoverageRuleUpdate) ClearPosition() *CoverageRuleUpdate {
+	_u.mutation.ClearPosition()
+	return _u
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (_u *CoverageRuleUpdate) SetEnforcement(v coveragerule.Enforcement) *CoverageRuleUpdate {
+	_u.mutation.SetEnforcement(v)
+	return _u
+}
+
+// SetNillableEnforcement sets the "enforcement" field if the given value is not nil.
+func (_u *CoverageRuleUpdate) SetNillableEnforcement(v *coveragerule.Enforcement) *CoverageRuleUpdate {
+	if v != nil {
+		_u.SetEnforcement(*v)
+	}
+	return _u
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (_u *CoverageRuleUpdate) SetAbsenceTypeIds(v []string) *CoverageRuleUpdate {
+	_u.mutation.SetAbsenceTypeIds(v)
+	return _u
+}
+
+// AppendAbsenceTypeIds appends value to the "absence_type_ids" field.
+func (_u *CoverageRuleUpdate) AppendAbsenceTypeIds(v []string) *CoverageRuleUpdate {
+	_u.mutation.AppendAbsenceTypeIds(v)
+	return _u
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (_u *CoverageRuleUpdate) ClearAbsenceTypeIds() *CoverageRuleUpdate {
+	_u.mutation.ClearAbsenceTypeIds()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *CoverageRuleUpdate) SetEnabled(v bool) *CoverageRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *CoverageRuleUpdate) SetNillableEnabled(v *bool) *CoverageRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CoverageRuleMutation object of the builder.
+func (_u *CoverageRuleUpdate) Mutation() *CoverageRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CoverageRuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoverageRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CoverageRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoverageRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CoverageRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := coveragerule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OrgUnitName(); ok {
+		if err := coveragerule.OrgUnitNameValidator(v); err != nil {
+			return &ValidationError{Name: "org_unit_name", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.org_unit_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := coveragerule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Count(); ok {
+		if err := coveragerule.CountValidator(v); err != nil {
+			return &ValidationError{Name: "count", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Enforcement(); ok {
+		if err := coveragerule.EnforcementValidator(v); err != nil {
+			return &ValidationError{Name: "enforcement", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.enforcement": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoverageRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoverageRuleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoverageRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coveragerule.Table, coveragerule.Columns, sqlgraph.NewFieldSpec(coveragerule.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(coveragerule.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(coveragerule.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(coveragerule.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(coveragerule.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(coveragerule.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(coveragerule.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(coveragerule.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(coveragerule.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(coveragerule.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(coveragerule.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(coveragerule.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(coveragerule.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(coveragerule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(coveragerule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(coveragerule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.OrgUnitName(); ok {
+		_spec.SetField(coveragerule.FieldOrgUnitName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(coveragerule.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Count(); ok {
+		_spec.SetField(coveragerule.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCount(); ok {
+		_spec.AddField(coveragerule.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(coveragerule.FieldPosition, field.TypeString, value)
+	}
+	if _u.mutation.PositionCleared() {
+		_spec.ClearField(coveragerule.FieldPosition, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enforcement(); ok {
+		_spec.SetField(coveragerule.FieldEnforcement, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AbsenceTypeIds(); ok {
+		_spec.SetField(coveragerule.FieldAbsenceTypeIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAbsenceTypeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coveragerule.FieldAbsenceTypeIds, value)
+		})
+	}
+	if _u.mutation.AbsenceTypeIdsCleared() {
+		_spec.ClearField(coveragerule.FieldAbsenceTypeIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(coveragerule.FieldEnabled, field.TypeBool, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coveragerule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CoverageRuleUpdateOne is the builder for updating a single CoverageRule entity.
+type CoverageRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CoverageRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *CoverageRuleUpdateOne) SetCreateBy(v uint32) *CoverageRuleUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *CoverageRuleUpdateOne) SetNillableCreateBy(v *uint32) *CoverageRuleUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *CoverageRuleUpdateOne) AddCreateBy(v int32) *CoverageRuleUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *CoverageRuleUpdateOne) ClearCreateBy() *CoverageRuleUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *CoverageRuleUpdateOne) SetUpdateBy(v uint32) *CoverageRuleUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *CoverageRuleUpdateOne) SetNillableUpdateBy(v *uint32) *CoverageRuleUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *CoverageRuleUpdateOne) AddUpdateBy(v int32) *CoverageRuleUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *CoverageRuleUpdateOne) ClearUpdateBy() *CoverageRuleUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *CoverageRuleUpdateOne) SetUpdateTime(v time.Time) *CoverageRuleUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *CoverageRuleUpdateOne) SetNillableUpdateTime(v *time.Time) *CoverageRuleUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *CoverageRuleUpdateOne) ClearUpdateTime() *CoverageRuleUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *CoverageRuleUpdateOne) SetDeleteTime(v time.Time) *CoverageRuleUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *CoverageRuleUpdateOne) SetNillableDeleteTime(v *time.Time) *CoverageRuleUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *CoverageRuleUpdateOne) ClearDeleteTime() *CoverageRuleUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CoverageRuleUpdateOne) SetName(v string) *CoverageRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CoverageRuleUpdateOne) SetNillableName(v *string) *CoverageRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *CoverageRuleUpdateOne) SetDescription(v string) *CoverageRuleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *CoverageRuleUpdateOne) SetNillableDescription(v *string) *CoverageRuleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *CoverageRuleUpdateOne) ClearDescription() *CoverageRuleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (_u *CoverageRuleUpdateOne) SetOrgUnitName(v string) *CoverageRuleUpdateOne {
+	_u.mutation.SetOrgUnitName(v)
+	return _u
+}
+
+// SetNillableOrgUnitName sets the "org_unit_name" field if the given value is not nil.
+func (_u *CoverageRuleUpdateOne) SetNillableOrgUnitName(v *string) *CoverageRuleUpdateOne {
+	if v != nil {
+		_u.SetOrgUnitName(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *CoverageRuleUpdateOne) SetKind(v coveragerule.Kind) *CoverageRuleUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *CoverageRuleUpdateOne) SetNillableKind(v *coveragerule.Kind) *CoverageRuleUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetCount sets the "count" field.
+func (_u *CoverageRuleUpdateOne) SetCount(v int) *CoverageRuleUpdateOne {
+	_u.mutation.ResetCount()
+	_u.mutation.SetCount(v)
+	return _u
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (_u *CoverageRuleUpdateOne) SetNillableCount(v *int) *CoverageRuleUpdateOne {
+	if v != nil {
+		_u.SetCount(*v)
+	}
+	return _u
+}
+
+// AddCount adds value to the "count" field.
+func (_u *CoverageRuleUpdateOne) AddCount(v int) *CoverageRuleUpdateOne {
+	_u.mutation.AddCount(v)
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *CoverageRuleUpdateOne) SetPosition(v string) *CoverageRuleUpdateOne {
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *CoverageRuleUpdateOne) SetNillablePosition(v *string) *CoverageRuleUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// ClearPosition clears the value of the "position" field.
+func (_u *CoverageRuleUpdateOne) ClearPosition() *CoverageRuleUpdateOne {
+	_u.mutation.ClearPosition()
+	return _u
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (_u *CoverageRuleUpdateOne) SetEnforcement(v coveragerule.Enforcement) *CoverageRuleUpdateOne {
+	_u.mutation.SetEnforcement(v)
+	return _u
+}
+
+// SetNillableEnforcement sets the "enforcement" field if the given value is not nil.
+func (_u *CoverageRuleUpdateOne) SetNillableEnforcement(v *coveragerule.Enforcement) *CoverageRuleUpdateOne {
+	if v != nil {
+		_u.SetEnforcement(*v)
+	}
+	return _u
+}
+
+// SetAbsenceTypeIds sets the "absence_type_ids" field.
+func (_u *CoverageRuleUpdateOne) SetAbsenceTypeIds(v []string) *CoverageRuleUpdateOne {
+	_u.mutation.SetAbsenceTypeIds(v)
+	return _u
+}
+
+// AppendAbsenceTypeIds appends value to the "absence_type_ids" field.
+func (_u *CoverageRuleUpdateOne) AppendAbsenceTypeIds(v []string) *CoverageRuleUpdateOne {
+	_u.mutation.AppendAbsenceTypeIds(v)
+	return _u
+}
+
+// ClearAbsenceTypeIds clears the value of the "absence_type_ids" field.
+func (_u *CoverageRuleUpdateOne) ClearAbsenceTypeIds() *CoverageRuleUpdateOne {
+	_u.mutation.ClearAbsenceTypeIds()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *CoverageRuleUpdateOne) SetEnabled(v bool) *CoverageRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *CoverageRuleUpdateOne) SetNillableEnabled(v *bool) *CoverageRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the CoverageRuleMutation object of the builder.
+func (_u *CoverageRuleUpdateOne) Mutation() *CoverageRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CoverageRuleUpdate builder.
+func (_u *CoverageRuleUpdateOne) Where(ps ...predicate.CoverageRule) *CoverageRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CoverageRuleUpdateOne) Select(field string, fields ...string) *CoverageRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CoverageRule entity.
+func (_u *CoverageRuleUpdateOne) Save(ctx context.Context) (*CoverageRule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CoverageRuleUpdateOne) SaveX(ctx context.Context) *CoverageRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CoverageRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CoverageRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CoverageRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := coveragerule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OrgUnitName(); ok {
+		if err := coveragerule.OrgUnitNameValidator(v); err != nil {
+			return &ValidationError{Name: "org_unit_name", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.org_unit_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := coveragerule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Count(); ok {
+		if err := coveragerule.CountValidator(v); err != nil {
+			return &ValidationError{Name: "count", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Enforcement(); ok {
+		if err := coveragerule.EnforcementValidator(v); err != nil {
+			return &ValidationError{Name: "enforcement", err: fmt.Errorf(`ent: validator failed for field "CoverageRule.enforcement": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CoverageRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CoverageRuleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CoverageRuleUpdateOne) sqlSave(ctx context.Context) (_node *CoverageRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coveragerule.Table, coveragerule.Columns, sqlgraph.NewFieldSpec(coveragerule.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CoverageRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coveragerule.FieldID)
+		for _, f := range fields {
+			if !coveragerule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coveragerule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(coveragerule.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(coveragerule.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(coveragerule.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(coveragerule.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(coveragerule.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(coveragerule.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(coveragerule.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(coveragerule.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(coveragerule.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(coveragerule.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(coveragerule.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(coveragerule.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(coveragerule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(coveragerule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(coveragerule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.OrgUnitName(); ok {
+		_spec.SetField(coveragerule.FieldOrgUnitName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(coveragerule.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Count(); ok {
+		_spec.SetField(coveragerule.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCount(); ok {
+		_spec.AddField(coveragerule.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(coveragerule.FieldPosition, field.TypeString, value)
+	}
+	if _u.mutation.PositionCleared() {
+		_spec.ClearField(coveragerule.FieldPosition, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enforcement(); ok {
+		_spec.SetField(coveragerule.FieldEnforcement, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AbsenceTypeIds(); ok {
+		_spec.SetField(coveragerule.FieldAbsenceTypeIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAbsenceTypeIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coveragerule.FieldAbsenceTypeIds, value)
+		})
+	}
+	if _u.mutation.AbsenceTypeIdsCleared() {
+		_spec.ClearField(coveragerule.FieldAbsenceTypeIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(coveragerule.FieldEnabled, field.TypeBool, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CoverageRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coveragerule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,1219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/employment"
+)
+
+// EmploymentCreate is the builder for creating a Employment entity.
+type EmploymentCreate struct {
+	config
+	mutation *EmploymentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *EmploymentCreate) SetCreateBy(v uint32) *EmploymentCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *EmploymentCreate) SetNillableCreateBy(v *uint32) *EmploymentCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *EmploymentCreate) SetUpdateBy(v uint32) *EmploymentCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *EmploymentCreate) SetNillableUpdateBy(v *uint32) *EmploymentCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *EmploymentCreate) SetCreateTime(v time.Time) *EmploymentCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *EmploymentCreate) SetNillableCreateTime(v *time.Time) *EmploymentCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *EmploymentCreate) SetUpdateTime(v time.Time) *EmploymentCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *EmploymentCreate) SetNillableUpdateTime(v *time.Time) *EmploymentCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *EmploymentCreate) SetDeleteTime(v time.Time) *EmploymentCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *EmploymentCreate) SetNillableDeleteTime(v *time.Time) *EmploymentCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *EmploymentCreate) SetTenantID(v uint32) *EmploymentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *EmploymentCreate) SetNillableTenantID(v *uint32) *EmploymentCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *EmploymentCreate) SetUserID(v uint32) *EmploymentCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetUserName sets the "user_name" field.
+func (_c *EmploymentCreate) SetUserName(v string) *EmploymentCreate {
+	_c.mutation.SetUserName(v)
+	return _c
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_c *EmploymentCreate) SetNillableUserName(v *string) *EmploymentCreate {
+	if v != nil {
+		_c.SetUserName(*v)
+	}
+	return _c
+}
+
+// SetStartDate sets the "start_date" field.
+func (_c *EmploymentCreate) SetStartDate(v time.Time) *EmploymentCreate {
+	_c.mutation.SetStartDate(v)
+	return _c
+}
+
+// SetEndDate sets the "end_date" field.
+func (_c *EmploymentCreate) SetEndDate(v time.Time) *EmploymentCreate {
+	_c.mutation.SetEndDate(v)
+	return _c
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_c *EmploymentCreate) SetNillableEndDate(v *time.Time) *EmploymentCreate {
+	if v != nil {
+		_c.SetEndDate(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *EmploymentCreate) SetNotes(v string) *EmploymentCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *EmploymentCreate) SetNillableNotes(v *string) *EmploymentCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EmploymentCreate) SetID(v string) *EmploymentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the EmploymentMutation object of the builder.
+func (_c *EmploymentCreate) Mutation() *EmploymentMutation {
+	return _c.mutation
+}
+
+// Save creates the Employment in the database.
+func (_c *EmploymentCreate) Save(ctx context.Context) (*Employment, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmploymentCreate) SaveX(ctx context.Context) *Employment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmploymentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmploymentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmploymentCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := employment.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.UserName(); !ok {
+		v := employment.DefaultUserName
+		_c.mutation.SetUserName(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmploymentCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Employment.user_id"`)}
+	}
+	if _, ok := _c.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "Employment.start_date"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := employment.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Employment.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *EmploymentCreate) sqlSave(ctx context.Context) (*Employment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Employment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmploymentCreate) createSpec() (*Employment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Employment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(employment.Table, sqlgraph.NewFieldSpec(employment.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(employment.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(employment.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(employment.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(employment.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(employment.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(employment.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(employment.FieldUserID, field.TypeUint32, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.UserName(); ok {
+		_spec.SetField(employment.FieldUserName, field.TypeString, value)
+		_node.UserName = value
+	}
+	if value, ok := _c.mutation.StartDate(); ok {
+		_spec.SetField(employment.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = value
+	}
+	if value, ok := _c.mutation.EndDate(); ok {
+		_spec.SetField(employment.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = &value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(employment.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Employment.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmploymentUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmploymentCreate) OnConflict(opts ...sql.ConflictOption) *EmploymentUpsertOne {
+	_c.conflict = opts
+	return &EmploymentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Employment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmploymentCreate) OnConflictColumns(columns ...string) *EmploymentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmploymentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EmploymentUpsertOne is the builder for "upsert"-ing
+	//  one Employment node.
+	EmploymentUpsertOne struct {
+		create *EmploymentCreate
+	}
+
+	// EmploymentUpsert is the "OnConflict" setter.
+	EmploymentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *EmploymentUpsert) SetCreateBy(v uint32) *EmploymentUpsert {
+	u.Set(employment.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *EmploymentUpsert) UpdateCreateBy() *EmploymentUpsert {
+	u.SetExcluded(employment.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *EmploymentUpsert) AddCreateBy(v uint32) *EmploymentUpsert {
+	u.Add(employment.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *EmploymentUpsert) ClearCreateBy() *EmploymentUpsert {
+	u.SetNull(employment.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *EmploymentUpsert) SetUpdateBy(v uint32) *EmploymentUpsert {
+	u.Set(employment.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *EmploymentUpsert) UpdateUpdateBy() *EmploymentUpsert {
+	u.SetExcluded(employment.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *EmploymentUpsert) AddUpdateBy(v uint32) *EmploymentUpsert {
+	u.Add(employment.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *EmploymentUpsert) ClearUpdateBy() *EmploymentUpsert {
+	u.SetNull(employment.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EmploymentUpsert) SetUpdateTime(v time.Time) *EmploymentUpsert {
+	u.Set(employment.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EmploymentUpsert) UpdateUpdateTime() *EmploymentUpsert {
+	u.SetExcluded(employment.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *EmploymentUpsert) ClearUpdateTime() *EmploymentUpsert {
+	u.SetNull(employment.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *EmploymentUpsert) SetDeleteTime(v time.Time) *EmploymentUpsert {
+	u.Set(employment.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *EmploymentUpsert) UpdateDeleteTime() *EmploymentUpsert {
+	u.SetExcluded(employment.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *EmploymentUpsert) ClearDeleteTime() *EmploymentUpsert {
+	u.SetNull(employment.FieldDeleteTime)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmploymentUpsert) SetUserID(v uint32) *EmploymentUpsert {
+	u.Set(employment.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmploymentUpsert) UpdateUserID() *EmploymentUpsert {
+	u.SetExcluded(employment.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *EmploymentUpsert) AddUserID(v uint32) *EmploymentUpsert {
+	u.Add(employment.FieldUserID, v)
+	return u
+}
+
+// SetUserName sets the "user_name" field.
+func (u *EmploymentUpsert) SetUserName(v string) *EmploymentUpsert {
+	u.Set(employment.FieldUserName, v)
+	return u
+}
+
+// UpdateUserName sets the "user_name" field to the value that was provided on create.
+func (u *EmploymentUpsert) UpdateUserName() *EmploymentUpsert {
+	u.SetExcluded(employment.FieldUserName)
+	return u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (u *EmploymentUpsert) ClearUserName() *EmploymentUpsert {
+	u.SetNull(employment.FieldUserName)
+	return u
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *EmploymentUpsert) SetStartDate(v time.Time) *EmploymentUpsert {
+	u.Set(employment.FieldStartDate, v)
+	return u
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *EmploymentUpsert) UpdateStartDate() *EmploymentUpsert {
+	u.SetExcluded(employment.FieldStartDate)
+	return u
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *EmploymentUpsert) SetEndDate(v time.Time) *EmploymentUpsert {
+	u.Set(employment.FieldEndDate, v)
+	return u
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *EmploymentUpsert) UpdateEndDate() *EmploymentUpsert {
+	u.SetExcluded(employment.FieldEndDate)
+	return u
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *EmploymentUpsert) ClearEndDate() *EmploymentUpsert {
+	u.SetNull(employment.FieldEndDate)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *EmploymentUpsert) SetNotes(v string) *EmploymentUpsert {
+	u.Set(employment.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *EmploymentUpsert) UpdateNotes() *EmploymentUpsert {
+	u.SetExcluded(employment.FieldNotes)
+	return u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *EmploymentUpsert) ClearNotes() *EmploymentUpsert {
+	u.SetNull(employment.FieldNotes)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Employment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(employment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmploymentUpsertOne) UpdateNewValues() *EmploymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(employment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(employment.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(employment.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Employment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmploymentUpsertOne) Ignore() *EmploymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmploymentUpsertOne) DoNothing() *EmploymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmploymentCreate.OnConflict
+// documentation for more info.
+func (u *EmploymentUpsertOne) Update(set func(*EmploymentUpsert)) *EmploymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmploymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *EmploymentUpsertOne) SetCreateBy(v uint32) *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *EmploymentUpsertOne) AddCreateBy(v uint32) *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *EmploymentUpsertOne) UpdateCreateBy() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *EmploymentUpsertOne) ClearCreateBy() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *EmploymentUpsertOne) SetUpdateBy(v uint32) *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *EmploymentUpsertOne) AddUpdateBy(v uint32) *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *EmploymentUpsertOne) UpdateUpdateBy() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *EmploymentUpsertOne) ClearUpdateBy() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EmploymentUpsertOne) SetUpdateTime(v time.Time) *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EmploymentUpsertOne) UpdateUpdateTime() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *EmploymentUpsertOne) ClearUpdateTime() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *EmploymentUpsertOne) SetDeleteTime(v time.Time) *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *EmploymentUpsertOne) UpdateDeleteTime() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *EmploymentUpsertOne) ClearDeleteTime() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmploymentUpsertOne) SetUserID(v uint32) *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *EmploymentUpsertOne) AddUserID(v uint32) *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmploymentUpsertOne) UpdateUserID() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetUserName sets the "user_name" field.
+func (u *EmploymentUpsertOne) SetUserName(v string) *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetUserName(v)
+	})
+}
+
+// UpdateUserName sets the "user_name" field to the value that was provided on create.
+func (u *EmploymentUpsertOne) UpdateUserName() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateUserName()
+	})
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (u *EmploymentUpsertOne) ClearUserName() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.ClearUserName()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *EmploymentUpsertOne) SetStartDate(v time.Time) *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *EmploymentUpsertOne) UpdateStartDate() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *EmploymentUpsertOne) SetEndDate(v time.Time) *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *EmploymentUpsertOne) UpdateEndDate() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *EmploymentUpsertOne) ClearEndDate() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.ClearEndDate()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *EmploymentUpsertOne) SetNotes(v string) *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *EmploymentUpsertOne) UpdateNotes() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *EmploymentUpsertOne) ClearNotes() *EmploymentUpsertOne {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// Exec executes the query.
+func (u *EmploymentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmploymentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmploymentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmploymentUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmploymentUpsertOne.ID is not supported by MySQL driver. Use EmploymentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmploymentUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EmploymentCreateBulk is the builder for creating many Employment entities in bulk.
+type EmploymentCreateBulk struct {
+	config
+	err      error
+	builders []*EmploymentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Employment entities in the database.
+func (_c *EmploymentCreateBulk) Save(ctx context.Context) ([]*Employment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Employment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmploymentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmploymentCreateBulk) SaveX(ctx context.Context) []*Employment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmploymentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmploymentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Employment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmploymentUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmploymentCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmploymentUpsertBulk {
+	_c.conflict = opts
+	return &EmploymentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Employment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmploymentCreateBulk) OnConflictColumns(columns ...string) *EmploymentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmploymentUpsertBulk{
+		create: _c,
+	}
+}
+
+// EmploymentUpsertBulk is the builder for "upsert"-ing
+// a bulk of Employment nodes.
+type EmploymentUpsertBulk struct {
+	create *EmploymentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Employment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(employment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmploymentUpsertBulk) UpdateNewValues() *EmploymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(employment.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(employment.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(employment.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Employment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmploymentUpsertBulk) Ignore() *EmploymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmploymentUpsertBulk) DoNothing() *EmploymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmploymentCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmploymentUpsertBulk) Update(set func(*EmploymentUpsert)) *EmploymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmploymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *EmploymentUpsertBulk) SetCreateBy(v uint32) *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *EmploymentUpsertBulk) AddCreateBy(v uint32) *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *EmploymentUpsertBulk) UpdateCreateBy() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *EmploymentUpsertBulk) ClearCreateBy() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *EmploymentUpsertBulk) SetUpdateBy(v uint32) *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *EmploymentUpsertBulk) AddUpdateBy(v uint32) *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *EmploymentUpsertBulk) UpdateUpdateBy() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *EmploymentUpsertBulk) ClearUpdateBy() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EmploymentUpsertBulk) SetUpdateTime(v time.Time) *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EmploymentUpsertBulk) UpdateUpdateTime() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *EmploymentUpsertBulk) ClearUpdateTime() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *EmploymentUpsertBulk) SetDeleteTime(v time.Time) *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *EmploymentUpsertBulk) UpdateDeleteTime() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *EmploymentUpsertBulk) ClearDeleteTime() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *EmploymentUpsertBulk) SetUserID(v uint32) *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *EmploymentUpsertBulk) AddUserID(v uint32) *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *EmploymentUpsertBulk) UpdateUserID() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetUserName sets the "user_name" field.
+func (u *EmploymentUpsertBulk) SetUserName(v string) *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetUserName(v)
+	})
+}
+
+// UpdateUserName sets the "user_name" field to the value that was provided on create.
+func (u *EmploymentUpsertBulk) UpdateUserName() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateUserName()
+	})
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (u *EmploymentUpsertBulk) ClearUserName() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.ClearUserName()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *EmploymentUpsertBulk) SetStartDate(v time.Time) *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *EmploymentUpsertBulk) UpdateStartDate() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *EmploymentUpsertBulk) SetEndDate(v time.Time) *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *EmploymentUpsertBulk) UpdateEndDate() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (u *EmploymentUpsertBulk) ClearEndDate() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.ClearEndDate()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *EmploymentUpsertBulk) SetNotes(v string) *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *EmploymentUpsertBulk) UpdateNotes() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *EmploymentUpsertBulk) ClearNotes() *EmploymentUpsertBulk {
+	return u.Update(func(s *EmploymentUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// Exec executes the query.
+func (u *EmploymentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmploymentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmploymentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmploymentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,696 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/employment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// EmploymentUpdate is the builder for updating Employment entities.
+type EmploymentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EmploymentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EmploymentUpdate builder.
+func (_u *EmploymentUpdate) Where(ps ...predicate.Employment) *EmploymentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *EmploymentUpdate) SetCreateBy(v uint32) *EmploymentUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *EmploymentUpdate) SetNillableCreateBy(v *uint32) *EmploymentUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *EmploymentUpdate) AddCreateBy(v int32) *EmploymentUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *EmploymentUpdate) ClearCreateBy() *EmploymentUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *EmploymentUpdate) SetUpdateBy(v uint32) *EmploymentUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *EmploymentUpdate) SetNillableUpdateBy(v *uint32) *EmploymentUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *EmploymentUpdate) AddUpdateBy(v int32) *EmploymentUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *EmploymentUpdate) ClearUpdateBy() *EmploymentUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EmploymentUpdate) SetUpdateTime(v time.Time) *EmploymentUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *EmploymentUpdate) SetNillableUpdateTime(v *time.Time) *EmploymentUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *EmploymentUpdate) ClearUpdateTime() *EmploymentUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *EmploymentUpdate) SetDeleteTime(v time.Time) *EmploymentUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *EmploymentUpdate) SetNillableDeleteTime(v *time.Time) *EmploymentUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *EmploymentUpdate) ClearDeleteTime() *EmploymentUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EmploymentUpdate) SetUserID(v uint32) *EmploymentUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EmploymentUpdate) SetNillableUserID(v *uint32) *EmploymentUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *EmploymentUpdate) AddUserID(v int32) *EmploymentUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *EmploymentUpdate) SetUserName(v string) *EmploymentUpdate {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *EmploymentUpdate) SetNillableUserName(v *string) *EmploymentUpdate {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (_u *EmploymentUpdate) ClearUserName() *EmploymentUpdate {
+	_u.mutation.ClearUserName()
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *EmploymentUpdate) SetStartDate(v time.Time) *EmploymentUpdate {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *EmploymentUpdate) SetNillableStartDate(v *time.Time) *EmploymentUpdate {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *EmploymentUpdate) SetEndDate(v time.Time) *EmploymentUpdate {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *EmploymentUpdate) SetNillableEndDate(v *time.Time) *EmploymentUpdate {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (_u *EmploymentUpdate) ClearEndDate() *EmploymentUpdate {
+	_u.mutation.ClearEndDate()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *EmploymentUpdate) SetNotes(v string) *EmploymentUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *EmploymentUpdate) SetNillableNotes(v *string) *EmploymentUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *EmploymentUpdate) ClearNotes() *EmploymentUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// Mutation returns the EmploymentMutation object of the builder.
+func (_u *EmploymentUpdate) Mutation() *EmploymentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmploymentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmploymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmploymentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmploymentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmploymentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmploymentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EmploymentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(employment.Table, employment.Columns, sqlgraph.NewFieldSpec(employment.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(employment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(employment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(employment.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(employment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(employment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(employment.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(employment.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(employment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(employment.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(employment.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(employment.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(employment.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(employment.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(employment.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(employment.FieldUserName, field.TypeString, value)
+	}
+	if _u.mutation.UserNameCleared() {
+		_spec.ClearField(employment.FieldUserName, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(employment.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(employment.FieldEndDate, field.TypeTime, value)
+	}
+	if _u.mutation.EndDateCleared() {
+		_spec.ClearField(employment.FieldEndDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(employment.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(employment.FieldNotes, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{employment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmploymentUpdateOne is the builder for updating a single Employment entity.
+type EmploymentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EmploymentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *EmploymentUpdateOne) SetCreateBy(v uint32) *EmploymentUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *EmploymentUpdateOne) SetNillableCreateBy(v *uint32) *EmploymentUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *EmploymentUpdateOne) AddCreateBy(v int32) *EmploymentUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *EmploymentUpdateOne) ClearCreateBy() *EmploymentUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *EmploymentUpdateOne) SetUpdateBy(v uint32) *EmploymentUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *EmploymentUpdateOne) SetNillableUpdateBy(v *uint32) *EmploymentUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *EmploymentUpdateOne) AddUpdateBy(v int32) *EmploymentUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *EmploymentUpdateOne) ClearUpdateBy() *EmploymentUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EmploymentUpdateOne) SetUpdateTime(v time.Time) *EmploymentUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *EmploymentUpdateOne) SetNillableUpdateTime(v *time.Time) *EmploymentUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *EmploymentUpdateOne) ClearUpdateTime() *EmploymentUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *EmploymentUpdateOne) SetDeleteTime(v time.Time) *EmploymentUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *EmploymentUpdateOne) SetNillableDeleteTime(v *time.Time) *EmploymentUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *EmploymentUpdateOne) ClearDeleteTime() *EmploymentUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *EmploymentUpdateOne) SetUserID(v uint32) *EmploymentUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *EmploymentUpdateOne) SetNillableUserID(v *uint32) *EmploymentUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *EmploymentUpdateOne) AddUserID(v int32) *EmploymentUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *EmploymentUpdateOne) SetUserName(v string) *EmploymentUpdateOne {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *EmploymentUpdateOne) SetNillableUserName(v *string) *EmploymentUpdateOne {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (_u *EmploymentUpdateOne) ClearUserName() *EmploymentUpdateOne {
+	_u.mutation.ClearUserName()
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *EmploymentUpdateOne) SetStartDate(v time.Time) *EmploymentUpdateOne {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *EmploymentUpdateOne) SetNillableStartDate(v *time.Time) *EmploymentUpdateOne {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *EmploymentUpdateOne) SetEndDate(v time.Time) *EmploymentUpdateOne {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *EmploymentUpdateOne) SetNillableEndDate(v *time.Time) *EmploymentUpdateOne {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// ClearEndDate clears the value of the "end_date" field.
+func (_u *EmploymentUpdateOne) ClearEndDate() *EmploymentUpdateOne {
+	_u.mutation.ClearEndDate()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *EmploymentUpdateOne) SetNotes(v string) *EmploymentUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *EmploymentUpdateOne) SetNillableNotes(v *string) *EmploymentUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *EmploymentUpdateOne) ClearNotes() *EmploymentUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// Mutation returns the EmploymentMutation object of the builder.
+func (_u *EmploymentUpdateOne) Mutation() *EmploymentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EmploymentUpdate builder.
+func (_u *EmploymentUpdateOne) Where(ps ...predicate.Employment) *EmploymentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmploymentUpdateOne) Select(field string, fields ...string) *EmploymentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Employment entity.
+func (_u *EmploymentUpdateOne) Save(ctx context.Context) (*Employment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmploymentUpdateOne) SaveX(ctx context.Context) *Employment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmploymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmploymentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *EmploymentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmploymentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *EmploymentUpdateOne) sqlSave(ctx context.Context) (_node *Employment, err error) {
+	_spec := sqlgraph.NewUpdateSpec(employment.Table, employment.Columns, sqlgraph.NewFieldSpec(employment.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Employment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, employment.FieldID)
+		for _, f := range fields {
+			if !employment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != employment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(employment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(employment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(employment.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(employment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(employment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(employment.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(employment.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(employment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(employment.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(employment.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(employment.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(employment.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(employment.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(employment.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(employment.FieldUserName, field.TypeString, value)
+	}
+	if _u.mutation.UserNameCleared() {
+		_spec.ClearField(employment.FieldUserName, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(employment.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(employment.FieldEndDate, field.TypeTime, value)
+	}
+	if _u.mutation.EndDateCleared() {
+		_spec.ClearField(employment.FieldEndDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(employment.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(employment.FieldNotes, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Employment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{employment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
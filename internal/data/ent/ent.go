@@ -14,9 +14,26 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancepool"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancetransaction"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/approvaldelegation"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/blackoutperiod"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/coveragerule"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/employment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holiday"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holidaycalendar"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveallowance"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveamendment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveattachment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavecomment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavepolicy"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/outboxmessage"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/processedevent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/webhookdelivery"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/webhooksubscription"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workschedule"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workscheduleassignment"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -77,11 +94,28 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			absencetype.Table:    absencetype.ValidColumn,
-			allowancepool.Table:  allowancepool.ValidColumn,
-			auditlog.Table:       auditlog.ValidColumn,
-			leaveallowance.Table: leaveallowance.ValidColumn,
-			leaverequest.Table:   leaverequest.ValidColumn,
+			absencetype.Table:            absencetype.ValidColumn,
+			allowancepool.Table:          allowancepool.ValidColumn,
+			allowancetransaction.Table:   allowancetransaction.ValidColumn,
+			approvaldelegation.Table:     approvaldelegation.ValidColumn,
+			auditlog.Table:               auditlog.ValidColumn,
+			blackoutperiod.Table:         blackoutperiod.ValidColumn,
+			coveragerule.Table:           coveragerule.ValidColumn,
+			employment.Table:             employment.ValidColumn,
+			holiday.Table:                holiday.ValidColumn,
+			holidaycalendar.Table:        holidaycalendar.ValidColumn,
+			leaveallowance.Table:         leaveallowance.ValidColumn,
+			leaveamendment.Table:         leaveamendment.ValidColumn,
+			leaveattachment.Table:        leaveattachment.ValidColumn,
+			leavecomment.Table:           leavecomment.ValidColumn,
+			leavepolicy.Table:            leavepolicy.ValidColumn,
+			leaverequest.Table:           leaverequest.ValidColumn,
+			outboxmessage.Table:          outboxmessage.ValidColumn,
+			processedevent.Table:         processedevent.ValidColumn,
+			webhookdelivery.Table:        webhookdelivery.ValidColumn,
+			webhooksubscription.Table:    webhooksubscription.ValidColumn,
+			workschedule.Table:           workschedule.ValidColumn,
+			workscheduleassignment.Table: workscheduleassignment.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
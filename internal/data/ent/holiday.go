@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holiday"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holidaycalendar"
+)
+
+// Holiday is the model entity for the Holiday schema.
+type Holiday struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique identifier
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 更新者ID
+	UpdateBy *uint32 `json:"update_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// FK to HolidayCalendar
+	CalendarID string `json:"calendar_id,omitempty"`
+	// Holiday name (e.g. 'Christmas Day')
+	Name string `json:"name,omitempty"`
+	// Date of the holiday; only month and day are used for recurring holidays
+	Date time.Time `json:"date,omitempty"`
+	// Repeats every year on the same month and day
+	Recurring bool `json:"recurring,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HolidayQuery when eager-loading is set.
+	Edges        HolidayEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// HolidayEdges holds the relations/edges for other nodes in the graph.
+type HolidayEdges struct {
+	// Calendar holds the value of the calendar edge.
+	Calendar *HolidayCalendar `json:"calendar,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CalendarOrErr returns the Calendar value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HolidayEdges) CalendarOrErr() (*HolidayCalendar, error) {
+	if e.Calendar != nil {
+		return e.Calendar, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: holidaycalendar.Label}
+	}
+	return nil, &NotLoadedError{edge: "calendar"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Holiday) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case holiday.FieldRecurring:
+			values[i] = new(sql.NullBool)
+		case holiday.FieldCreateBy, holiday.FieldUpdateBy, holiday.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case holiday.FieldID, holiday.FieldCalendarID, holiday.FieldName:
+			values[i] = new(sql.NullString)
+		case holiday.FieldCreateTime, holiday.FieldUpdateTime, holiday.FieldDeleteTime, holiday.FieldDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Holiday fields.
+func (_m *Holiday) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case holiday.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case holiday.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case holiday.FieldUpdateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_by", values[i])
+			} else if value.Valid {
+				_m.UpdateBy = new(uint32)
+				*_m.UpdateBy = uint32(value.Int64)
+			}
+		case holiday.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case holiday.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case holiday.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case holiday.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case holiday.FieldCalendarID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field calendar_id", values[i])
+			} else if value.Valid {
+				_m.CalendarID = value.String
+			}
+		case holiday.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case holiday.FieldDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				_m.Date = value.Time
+			}
+		case holiday.FieldRecurring:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field recurring", values[i])
+			} else if value.Valid {
+				_m.Recurring = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Holiday.
+// This includes values selected through modifiers, order, etc.
+func (_m *Holiday) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCalendar queries the "calendar" edge of the Holiday entity.
+func (_m *Holiday) QueryCalendar() *HolidayCalendarQuery {
+	return NewHolidayClient(_m.config).QueryCalendar(_m)
+}
+
+// Update returns a builder for updating this Holiday.
+// Note that you need to call Holiday.Unwrap() before calling this method if this Holiday
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Holiday) Update() *HolidayUpdateOne {
+	return NewHolidayClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Holiday entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Holiday) Unwrap() *Holiday {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Holiday is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Holiday) String() string {
+	var builder strings.Builder
+	builder.WriteString("Holiday(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateBy; v != nil {
+		builder.WriteString("update_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("calendar_id=")
+	builder.WriteString(_m.CalendarID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(_m.Date.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("recurring=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Recurring))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Holidays is a parsable slice of Holiday.
+type Holidays []*Holiday
@@ -0,0 +1,1111 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holiday"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holidaycalendar"
+)
+
+// HolidayCreate is the builder for creating a Holiday entity.
+type HolidayCreate struct {
+	config
+	mutation *HolidayMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *HolidayCreate) SetCreateBy(v uint32) *HolidayCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *HolidayCreate) SetNillableCreateBy(v *uint32) *HolidayCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *HolidayCreate) SetUpdateBy(v uint32) *HolidayCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *HolidayCreate) SetNillableUpdateBy(v *uint32) *HolidayCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *HolidayCreate) SetCreateTime(v time.Time) *HolidayCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *HolidayCreate) SetNillableCreateTime(v *time.Time) *HolidayCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *HolidayCreate) SetUpdateTime(v time.Time) *HolidayCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *HolidayCreate) SetNillableUpdateTime(v *time.Time) *HolidayCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *HolidayCreate) SetDeleteTime(v time.Time) *HolidayCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *HolidayCreate) SetNillableDeleteTime(v *time.Time) *HolidayCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *HolidayCreate) SetTenantID(v uint32) *HolidayCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *HolidayCreate) SetNillableTenantID(v *uint32) *HolidayCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetCalendarID sets the "calendar_id" field.
+func (_c *HolidayCreate) SetCalendarID(v string) *HolidayCreate {
+	_c.mutation.SetCalendarID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *HolidayCreate) SetName(v string) *HolidayCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDate sets the "date" field.
+func (_c *HolidayCreate) SetDate(v time.Time) *HolidayCreate {
+	_c.mutation.SetDate(v)
+	return _c
+}
+
+// SetRecurring sets the "recurring" field.
+func (_c *HolidayCreate) SetRecurring(v bool) *HolidayCreate {
+	_c.mutation.SetRecurring(v)
+	return _c
+}
+
+// SetNillableRecurring sets the "recurring" field if the given value is not nil.
+func (_c *HolidayCreate) SetNillableRecurring(v *bool) *HolidayCreate {
+	if v != nil {
+		_c.SetRecurring(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *HolidayCreate) SetID(v string) *HolidayCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetCalendar sets the "calendar" edge to the HolidayCalendar entity.
+func (_c *HolidayCreate) SetCalendar(v *HolidayCalendar) *HolidayCreate {
+	return _c.SetCalendarID(v.ID)
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (_c *HolidayCreate) Mutation() *HolidayMutation {
+	return _c.mutation
+}
+
+// Save creates the Holiday in the database.
+func (_c *HolidayCreate) Save(ctx context.Context) (*Holiday, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HolidayCreate) SaveX(ctx context.Context) *Holiday {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HolidayCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HolidayCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HolidayCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := holiday.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Recurring(); !ok {
+		v := holiday.DefaultRecurring
+		_c.mutation.SetRecurring(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HolidayCreate) check() error {
+	if _, ok := _c.mutation.CalendarID(); !ok {
+		return &ValidationError{Name: "calendar_id", err: errors.New(`ent: missing required field "Holiday.calendar_id"`)}
+	}
+	if v, ok := _c.mutation.CalendarID(); ok {
+		if err := holiday.CalendarIDValidator(v); err != nil {
+			return &ValidationError{Name: "calendar_id", err: fmt.Errorf(`ent: validator failed for field "Holiday.calendar_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Holiday.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holiday.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "Holiday.date"`)}
+	}
+	if _, ok := _c.mutation.Recurring(); !ok {
+		return &ValidationError{Name: "recurring", err: errors.New(`ent: missing required field "Holiday.recurring"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := holiday.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Holiday.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.CalendarIDs()) == 0 {
+		return &ValidationError{Name: "calendar", err: errors.New(`ent: missing required edge "Holiday.calendar"`)}
+	}
+	return nil
+}
+
+func (_c *HolidayCreate) sqlSave(ctx context.Context) (*Holiday, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Holiday.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HolidayCreate) createSpec() (*Holiday, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Holiday{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(holiday.Table, sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(holiday.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(holiday.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(holiday.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(holiday.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(holiday.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(holiday.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(holiday.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Date(); ok {
+		_spec.SetField(holiday.FieldDate, field.TypeTime, value)
+		_node.Date = value
+	}
+	if value, ok := _c.mutation.Recurring(); ok {
+		_spec.SetField(holiday.FieldRecurring, field.TypeBool, value)
+		_node.Recurring = value
+	}
+	if nodes := _c.mutation.CalendarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holiday.CalendarTable,
+			Columns: []string{holiday.CalendarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holidaycalendar.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CalendarID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Holiday.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HolidayUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *HolidayCreate) OnConflict(opts ...sql.ConflictOption) *HolidayUpsertOne {
+	_c.conflict = opts
+	return &HolidayUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Holiday.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *HolidayCreate) OnConflictColumns(columns ...string) *HolidayUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &HolidayUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// HolidayUpsertOne is the builder for "upsert"-ing
+	//  one Holiday node.
+	HolidayUpsertOne struct {
+		create *HolidayCreate
+	}
+
+	// HolidayUpsert is the "OnConflict" setter.
+	HolidayUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *HolidayUpsert) SetCreateBy(v uint32) *HolidayUpsert {
+	u.Set(holiday.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *HolidayUpsert) UpdateCreateBy() *HolidayUpsert {
+	u.SetExcluded(holiday.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *HolidayUpsert) AddCreateBy(v uint32) *HolidayUpsert {
+	u.Add(holiday.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *HolidayUpsert) ClearCreateBy() *HolidayUpsert {
+	u.SetNull(holiday.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *HolidayUpsert) SetUpdateBy(v uint32) *HolidayUpsert {
+	u.Set(holiday.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *HolidayUpsert) UpdateUpdateBy() *HolidayUpsert {
+	u.SetExcluded(holiday.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *HolidayUpsert) AddUpdateBy(v uint32) *HolidayUpsert {
+	u.Add(holiday.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *HolidayUpsert) ClearUpdateBy() *HolidayUpsert {
+	u.SetNull(holiday.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *HolidayUpsert) SetUpdateTime(v time.Time) *HolidayUpsert {
+	u.Set(holiday.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *HolidayUpsert) UpdateUpdateTime() *HolidayUpsert {
+	u.SetExcluded(holiday.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *HolidayUpsert) ClearUpdateTime() *HolidayUpsert {
+	u.SetNull(holiday.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *HolidayUpsert) SetDeleteTime(v time.Time) *HolidayUpsert {
+	u.Set(holiday.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *HolidayUpsert) UpdateDeleteTime() *HolidayUpsert {
+	u.SetExcluded(holiday.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *HolidayUpsert) ClearDeleteTime() *HolidayUpsert {
+	u.SetNull(holiday.FieldDeleteTime)
+	return u
+}
+
+// SetCalendarID sets the "calendar_id" field.
+func (u *HolidayUpsert) SetCalendarID(v string) *HolidayUpsert {
+	u.Set(holiday.FieldCalendarID, v)
+	return u
+}
+
+// UpdateCalendarID sets the "calendar_id" field to the value that was provided on create.
+func (u *HolidayUpsert) UpdateCalendarID() *HolidayUpsert {
+	u.SetExcluded(holiday.FieldCalendarID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *HolidayUpsert) SetName(v string) *HolidayUpsert {
+	u.Set(holiday.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HolidayUpsert) UpdateName() *HolidayUpsert {
+	u.SetExcluded(holiday.FieldName)
+	return u
+}
+
+// SetDate sets the "date" field.
+func (u *HolidayUpsert) SetDate(v time.Time) *HolidayUpsert {
+	u.Set(holiday.FieldDate, v)
+	return u
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *HolidayUpsert) UpdateDate() *HolidayUpsert {
+	u.SetExcluded(holiday.FieldDate)
+	return u
+}
+
+// SetRecurring sets the "recurring" field.
+func (u *HolidayUpsert) SetRecurring(v bool) *HolidayUpsert {
+	u.Set(holiday.FieldRecurring, v)
+	return u
+}
+
+// UpdateRecurring sets the "recurring" field to the value that was provided on create.
+func (u *HolidayUpsert) UpdateRecurring() *HolidayUpsert {
+	u.SetExcluded(holiday.FieldRecurring)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Holiday.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(holiday.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HolidayUpsertOne) UpdateNewValues() *HolidayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(holiday.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(holiday.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(holiday.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Holiday.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HolidayUpsertOne) Ignore() *HolidayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HolidayUpsertOne) DoNothing() *HolidayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HolidayCreate.OnConflict
+// documentation for more info.
+func (u *HolidayUpsertOne) Update(set func(*HolidayUpsert)) *HolidayUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HolidayUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *HolidayUpsertOne) SetCreateBy(v uint32) *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *HolidayUpsertOne) AddCreateBy(v uint32) *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *HolidayUpsertOne) UpdateCreateBy() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *HolidayUpsertOne) ClearCreateBy() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *HolidayUpsertOne) SetUpdateBy(v uint32) *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *HolidayUpsertOne) AddUpdateBy(v uint32) *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *HolidayUpsertOne) UpdateUpdateBy() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *HolidayUpsertOne) ClearUpdateBy() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *HolidayUpsertOne) SetUpdateTime(v time.Time) *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *HolidayUpsertOne) UpdateUpdateTime() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *HolidayUpsertOne) ClearUpdateTime() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *HolidayUpsertOne) SetDeleteTime(v time.Time) *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *HolidayUpsertOne) UpdateDeleteTime() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *HolidayUpsertOne) ClearDeleteTime() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetCalendarID sets the "calendar_id" field.
+func (u *HolidayUpsertOne) SetCalendarID(v string) *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetCalendarID(v)
+	})
+}
+
+// UpdateCalendarID sets the "calendar_id" field to the value that was provided on create.
+func (u *HolidayUpsertOne) UpdateCalendarID() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateCalendarID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *HolidayUpsertOne) SetName(v string) *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HolidayUpsertOne) UpdateName() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *HolidayUpsertOne) SetDate(v time.Time) *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *HolidayUpsertOne) UpdateDate() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetRecurring sets the "recurring" field.
+func (u *HolidayUpsertOne) SetRecurring(v bool) *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetRecurring(v)
+	})
+}
+
+// UpdateRecurring sets the "recurring" field to the value that was provided on create.
+func (u *HolidayUpsertOne) UpdateRecurring() *HolidayUpsertOne {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateRecurring()
+	})
+}
+
+// Exec executes the query.
+func (u *HolidayUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HolidayCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HolidayUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HolidayUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: HolidayUpsertOne.ID is not supported by MySQL driver. Use HolidayUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HolidayUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// HolidayCreateBulk is the builder for creating many Holiday entities in bulk.
+type HolidayCreateBulk struct {
+	config
+	err      error
+	builders []*HolidayCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Holiday entities in the database.
+func (_c *HolidayCreateBulk) Save(ctx context.Context) ([]*Holiday, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Holiday, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HolidayMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HolidayCreateBulk) SaveX(ctx context.Context) []*Holiday {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HolidayCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HolidayCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Holiday.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HolidayUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *HolidayCreateBulk) OnConflict(opts ...sql.ConflictOption) *HolidayUpsertBulk {
+	_c.conflict = opts
+	return &HolidayUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Holiday.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *HolidayCreateBulk) OnConflictColumns(columns ...string) *HolidayUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &HolidayUpsertBulk{
+		create: _c,
+	}
+}
+
+// HolidayUpsertBulk is the builder for "upsert"-ing
+// a bulk of Holiday nodes.
+type HolidayUpsertBulk struct {
+	create *HolidayCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Holiday.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(holiday.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HolidayUpsertBulk) UpdateNewValues() *HolidayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(holiday.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(holiday.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(holiday.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Holiday.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HolidayUpsertBulk) Ignore() *HolidayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HolidayUpsertBulk) DoNothing() *HolidayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HolidayCreateBulk.OnConflict
+// documentation for more info.
+func (u *HolidayUpsertBulk) Update(set func(*HolidayUpsert)) *HolidayUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HolidayUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *HolidayUpsertBulk) SetCreateBy(v uint32) *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *HolidayUpsertBulk) AddCreateBy(v uint32) *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *HolidayUpsertBulk) UpdateCreateBy() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *HolidayUpsertBulk) ClearCreateBy() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *HolidayUpsertBulk) SetUpdateBy(v uint32) *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *HolidayUpsertBulk) AddUpdateBy(v uint32) *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *HolidayUpsertBulk) UpdateUpdateBy() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *HolidayUpsertBulk) ClearUpdateBy() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *HolidayUpsertBulk) SetUpdateTime(v time.Time) *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *HolidayUpsertBulk) UpdateUpdateTime() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *HolidayUpsertBulk) ClearUpdateTime() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *HolidayUpsertBulk) SetDeleteTime(v time.Time) *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *HolidayUpsertBulk) UpdateDeleteTime() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *HolidayUpsertBulk) ClearDeleteTime() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetCalendarID sets the "calendar_id" field.
+func (u *HolidayUpsertBulk) SetCalendarID(v string) *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetCalendarID(v)
+	})
+}
+
+// UpdateCalendarID sets the "calendar_id" field to the value that was provided on create.
+func (u *HolidayUpsertBulk) UpdateCalendarID() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateCalendarID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *HolidayUpsertBulk) SetName(v string) *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HolidayUpsertBulk) UpdateName() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *HolidayUpsertBulk) SetDate(v time.Time) *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *HolidayUpsertBulk) UpdateDate() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetRecurring sets the "recurring" field.
+func (u *HolidayUpsertBulk) SetRecurring(v bool) *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.SetRecurring(v)
+	})
+}
+
+// UpdateRecurring sets the "recurring" field to the value that was provided on create.
+func (u *HolidayUpsertBulk) UpdateRecurring() *HolidayUpsertBulk {
+	return u.Update(func(s *HolidayUpsert) {
+		s.UpdateRecurring()
+	})
+}
+
+// Exec executes the query.
+func (u *HolidayUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the HolidayCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HolidayCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HolidayUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holiday"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holidaycalendar"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// HolidayUpdate is the builder for updating Holiday entities.
+type HolidayUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *HolidayMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the HolidayUpdate builder.
+func (_u *HolidayUpdate) Where(ps ...predicate.Holiday) *HolidayUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *HolidayUpdate) SetCreateBy(v uint32) *HolidayUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *HolidayUpdate) SetNillableCreateBy(v *uint32) *HolidayUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *HolidayUpdate) AddCreateBy(v int32) *HolidayUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *HolidayUpdate) ClearCreateBy() *HolidayUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *HolidayUpdate) SetUpdateBy(v uint32) *HolidayUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *HolidayUpdate) SetNillableUpdateBy(v *uint32) *HolidayUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *HolidayUpdate) AddUpdateBy(v int32) *HolidayUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *HolidayUpdate) ClearUpdateBy() *HolidayUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *HolidayUpdate) SetUpdateTime(v time.Time) *HolidayUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *HolidayUpdate) SetNillableUpdateTime(v *time.Time) *HolidayUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *HolidayUpdate) ClearUpdateTime() *HolidayUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *HolidayUpdate) SetDeleteTime(v time.Time) *HolidayUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *HolidayUpdate) SetNillableDeleteTime(v *time.Time) *HolidayUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *HolidayUpdate) ClearDeleteTime() *HolidayUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetCalendarID sets the "calendar_id" field.
+func (_u *HolidayUpdate) SetCalendarID(v string) *HolidayUpdate {
+	_u.mutation.SetCalendarID(v)
+	return _u
+}
+
+// SetNillableCalendarID sets the "calendar_id" field if the given value is not nil.
+func (_u *HolidayUpdate) SetNillableCalendarID(v *string) *HolidayUpdate {
+	if v != nil {
+		_u.SetCalendarID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HolidayUpdate) SetName(v string) *HolidayUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HolidayUpdate) SetNillableName(v *string) *HolidayUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *HolidayUpdate) SetDate(v time.Time) *HolidayUpdate {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *HolidayUpdate) SetNillableDate(v *time.Time) *HolidayUpdate {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetRecurring sets the "recurring" field.
+func (_u *HolidayUpdate) SetRecurring(v bool) *HolidayUpdate {
+	_u.mutation.SetRecurring(v)
+	return _u
+}
+
+// SetNillableRecurring sets the "recurring" field if the given value is not nil.
+func (_u *HolidayUpdate) SetNillableRecurring(v *bool) *HolidayUpdate {
+	if v != nil {
+		_u.SetRecurring(*v)
+	}
+	return _u
+}
+
+// SetCalendar sets the "calendar" edge to the HolidayCalendar entity.
+func (_u *HolidayUpdate) SetCalendar(v *HolidayCalendar) *HolidayUpdate {
+	return _u.SetCalendarID(v.ID)
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (_u *HolidayUpdate) Mutation() *HolidayMutation {
+	return _u.mutation
+}
+
+// ClearCalendar clears the "calendar" edge to the HolidayCalendar entity.
+func (_u *HolidayUpdate) ClearCalendar() *HolidayUpdate {
+	_u.mutation.ClearCalendar()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HolidayUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HolidayUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HolidayUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HolidayUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HolidayUpdate) check() error {
+	if v, ok := _u.mutation.CalendarID(); ok {
+		if err := holiday.CalendarIDValidator(v); err != nil {
+			return &ValidationError{Name: "calendar_id", err: fmt.Errorf(`ent: validator failed for field "Holiday.calendar_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holiday.name": %w`, err)}
+		}
+	}
+	if _u.mutation.CalendarCleared() && len(_u.mutation.CalendarIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Holiday.calendar"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *HolidayUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HolidayUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *HolidayUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(holiday.Table, holiday.Columns, sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(holiday.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(holiday.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(holiday.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(holiday.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(holiday.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(holiday.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(holiday.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(holiday.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(holiday.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(holiday.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(holiday.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(holiday.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(holiday.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(holiday.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Recurring(); ok {
+		_spec.SetField(holiday.FieldRecurring, field.TypeBool, value)
+	}
+	if _u.mutation.CalendarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holiday.CalendarTable,
+			Columns: []string{holiday.CalendarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holidaycalendar.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CalendarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holiday.CalendarTable,
+			Columns: []string{holiday.CalendarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holidaycalendar.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holiday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HolidayUpdateOne is the builder for updating a single Holiday entity.
+type HolidayUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *HolidayMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *HolidayUpdateOne) SetCreateBy(v uint32) *HolidayUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *HolidayUpdateOne) SetNillableCreateBy(v *uint32) *HolidayUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *HolidayUpdateOne) AddCreateBy(v int32) *HolidayUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *HolidayUpdateOne) ClearCreateBy() *HolidayUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *HolidayUpdateOne) SetUpdateBy(v uint32) *HolidayUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *HolidayUpdateOne) SetNillableUpdateBy(v *uint32) *HolidayUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *HolidayUpdateOne) AddUpdateBy(v int32) *HolidayUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *HolidayUpdateOne) ClearUpdateBy() *HolidayUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *HolidayUpdateOne) SetUpdateTime(v time.Time) *HolidayUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *HolidayUpdateOne) SetNillableUpdateTime(v *time.Time) *HolidayUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *HolidayUpdateOne) ClearUpdateTime() *HolidayUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *HolidayUpdateOne) SetDeleteTime(v time.Time) *HolidayUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *HolidayUpdateOne) SetNillableDeleteTime(v *time.Time) *HolidayUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *HolidayUpdateOne) ClearDeleteTime() *HolidayUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetCalendarID sets the "calendar_id" field.
+func (_u *HolidayUpdateOne) SetCalendarID(v string) *HolidayUpdateOne {
+	_u.mutation.SetCalendarID(v)
+	return _u
+}
+
+// SetNillableCalendarID sets the "calendar_id" field if the given value is not nil.
+func (_u *HolidayUpdateOne) SetNillableCalendarID(v *string) *HolidayUpdateOne {
+	if v != nil {
+		_u.SetCalendarID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HolidayUpdateOne) SetName(v string) *HolidayUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HolidayUpdateOne) SetNillableName(v *string) *HolidayUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDate sets the "date" field.
+func (_u *HolidayUpdateOne) SetDate(v time.Time) *HolidayUpdateOne {
+	_u.mutation.SetDate(v)
+	return _u
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (_u *HolidayUpdateOne) SetNillableDate(v *time.Time) *HolidayUpdateOne {
+	if v != nil {
+		_u.SetDate(*v)
+	}
+	return _u
+}
+
+// SetRecurring sets the "recurring" field.
+func (_u *HolidayUpdateOne) SetRecurring(v bool) *HolidayUpdateOne {
+	_u.mutation.SetRecurring(v)
+	return _u
+}
+
+// SetNillableRecurring sets the "recurring" field if the given value is not nil.
+func (_u *HolidayUpdateOne) SetNillableRecurring(v *bool) *HolidayUpdateOne {
+	if v != nil {
+		_u.SetRecurring(*v)
+	}
+	return _u
+}
+
+// SetCalendar sets the "calendar" edge to the HolidayCalendar entity.
+func (_u *HolidayUpdateOne) SetCalendar(v *HolidayCalendar) *HolidayUpdateOne {
+	return _u.SetCalendarID(v.ID)
+}
+
+// Mutation returns the HolidayMutation object of the builder.
+func (_u *HolidayUpdateOne) Mutation() *HolidayMutation {
+	return _u.mutation
+}
+
+// ClearCalendar clears the "calendar" edge to the HolidayCalendar entity.
+func (_u *HolidayUpdateOne) ClearCalendar() *HolidayUpdateOne {
+	_u.mutation.ClearCalendar()
+	return _u
+}
+
+// Where appends a list predicates to the HolidayUpdate builder.
+func (_u *HolidayUpdateOne) Where(ps ...predicate.Holiday) *HolidayUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HolidayUpdateOne) Select(field string, fields ...string) *HolidayUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Holiday entity.
+func (_u *HolidayUpdateOne) Save(ctx context.Context) (*Holiday, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HolidayUpdateOne) SaveX(ctx context.Context) *Holiday {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HolidayUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HolidayUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HolidayUpdateOne) check() error {
+	if v, ok := _u.mutation.CalendarID(); ok {
+		if err := holiday.CalendarIDValidator(v); err != nil {
+			return &ValidationError{Name: "calendar_id", err: fmt.Errorf(`ent: validator failed for field "Holiday.calendar_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := holiday.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Holiday.name": %w`, err)}
+		}
+	}
+	if _u.mutation.CalendarCleared() && len(_u.mutation.CalendarIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Holiday.calendar"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *HolidayUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HolidayUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *HolidayUpdateOne) sqlSave(ctx context.Context) (_node *Holiday, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(holiday.Table, holiday.Columns, sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Holiday.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, holiday.FieldID)
+		for _, f := range fields {
+			if !holiday.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != holiday.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(holiday.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(holiday.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(holiday.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(holiday.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(holiday.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(holiday.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(holiday.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(holiday.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(holiday.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(holiday.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(holiday.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(holiday.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(holiday.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Date(); ok {
+		_spec.SetField(holiday.FieldDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Recurring(); ok {
+		_spec.SetField(holiday.FieldRecurring, field.TypeBool, value)
+	}
+	if _u.mutation.CalendarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holiday.CalendarTable,
+			Columns: []string{holiday.CalendarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holidaycalendar.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CalendarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   holiday.CalendarTable,
+			Columns: []string{holiday.CalendarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holidaycalendar.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Holiday{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holiday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holidaycalendar"
+)
+
+// HolidayCalendar is the model entity for the HolidayCalendar schema.
+type HolidayCalendar struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique identifier
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 更新者ID
+	UpdateBy *uint32 `json:"update_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Calendar name (e.g. 'Germany - Bavaria')
+	Name string `json:"name,omitempty"`
+	// Description
+	Description string `json:"description,omitempty"`
+	// ISO 3166-1 alpha-2 country code
+	CountryCode string `json:"country_code,omitempty"`
+	// ISO 3166-2 subdivision code (e.g. 'DE-BY')
+	Region string `json:"region,omitempty"`
+	// Used for employees whose org unit has no calendar assigned
+	IsDefault bool `json:"is_default,omitempty"`
+	// Org units whose employees observe this calendar
+	OrgUnitNames []string `json:"org_unit_names,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HolidayCalendarQuery when eager-loading is set.
+	Edges        HolidayCalendarEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// HolidayCalendarEdges holds the relations/edges for other nodes in the graph.
+type HolidayCalendarEdges struct {
+	// Holidays holds the value of the holidays edge.
+	Holidays []*Holiday `json:"holidays,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// HolidaysOrErr returns the Holidays value or an error if the edge
+// was not loaded in eager-loading.
+func (e HolidayCalendarEdges) HolidaysOrErr() ([]*Holiday, error) {
+	if e.loadedTypes[0] {
+		return e.Holidays, nil
+	}
+	return nil, &NotLoadedError{edge: "holidays"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HolidayCalendar) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case holidaycalendar.FieldOrgUnitNames:
+			values[i] = new([]byte)
+		case holidaycalendar.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case holidaycalendar.FieldCreateBy, holidaycalendar.FieldUpdateBy, holidaycalendar.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case holidaycalendar.FieldID, holidaycalendar.FieldName, holidaycalendar.FieldDescription, holidaycalendar.FieldCountryCode, holidaycalendar.FieldRegion:
+			values[i] = new(sql.NullString)
+		case holidaycalendar.FieldCreateTime, holidaycalendar.FieldUpdateTime, holidaycalendar.FieldDeleteTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HolidayCalendar fields.
+func (_m *HolidayCalendar) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case holidaycalendar.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case holidaycalendar.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case holidaycalendar.FieldUpdateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_by", values[i])
+			} else if value.Valid {
+				_m.UpdateBy = new(uint32)
+				*_m.UpdateBy = uint32(value.Int64)
+			}
+		case holidaycalendar.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case holidaycalendar.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case holidaycalendar.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case holidaycalendar.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case holidaycalendar.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case holidaycalendar.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case holidaycalendar.FieldCountryCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country_code", values[i])
+			} else if value.Valid {
+				_m.CountryCode = value.String
+			}
+		case holidaycalendar.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		case holidaycalendar.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				_m.IsDefault = value.Bool
+			}
+		case holidaycalendar.FieldOrgUnitNames:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field org_unit_names", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.OrgUnitNames); err != nil {
+					return fmt.Errorf("unmarshal field org_unit_names: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the HolidayCalendar.
+// This includes values selected through modifiers, order, etc.
+func (_m *HolidayCalendar) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryHolidays queries the "holidays" edge of the HolidayCalendar entity.
+func (_m *HolidayCalendar) QueryHolidays() *HolidayQuery {
+	return NewHolidayCalendarClient(_m.config).QueryHolidays(_m)
+}
+
+// Update returns a builder for updating this HolidayCalendar.
+// Note that you need to call HolidayCalendar.Unwrap() before calling this method if this HolidayCalendar
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *HolidayCalendar) Update() *HolidayCalendarUpdateOne {
+	return NewHolidayCalendarClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the HolidayCalendar entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *HolidayCalendar) Unwrap() *HolidayCalendar {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: HolidayCalendar is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *HolidayCalendar) String() string {
+	var builder strings.Builder
+	builder.WriteString("HolidayCalendar(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateBy; v != nil {
+		builder.WriteString("update_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("country_code=")
+	builder.WriteString(_m.CountryCode)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("org_unit_names=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrgUnitNames))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HolidayCalendars is a parsable slice of HolidayCalendar.
+type HolidayCalendars []*HolidayCalendar
@@ -0,0 +1,1332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holiday"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holidaycalendar"
+)
+
+// HolidayCalendarCreate is the builder for creating a HolidayCalendar entity.
+type HolidayCalendarCreate struct {
+	config
+	mutation *HolidayCalendarMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *HolidayCalendarCreate) SetCreateBy(v uint32) *HolidayCalendarCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *HolidayCalendarCreate) SetNillableCreateBy(v *uint32) *HolidayCalendarCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *HolidayCalendarCreate) SetUpdateBy(v uint32) *HolidayCalendarCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *HolidayCalendarCreate) SetNillableUpdateBy(v *uint32) *HolidayCalendarCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *HolidayCalendarCreate) SetCreateTime(v time.Time) *HolidayCalendarCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *HolidayCalendarCreate) SetNillableCreateTime(v *time.Time) *HolidayCalendarCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *HolidayCalendarCreate) SetUpdateTime(v time.Time) *HolidayCalendarCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *HolidayCalendarCreate) SetNillableUpdateTime(v *time.Time) *HolidayCalendarCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *HolidayCalendarCreate) SetDeleteTime(v time.Time) *HolidayCalendarCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *HolidayCalendarCreate) SetNillableDeleteTime(v *time.Time) *HolidayCalendarCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *HolidayCalendarCreate) SetTenantID(v uint32) *HolidayCalendarCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *HolidayCalendarCreate) SetNillableTenantID(v *uint32) *HolidayCalendarCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *HolidayCalendarCreate) SetName(v string) *HolidayCalendarCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *HolidayCalendarCreate) SetDescription(v string) *HolidayCalendarCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *HolidayCalendarCreate) SetNillableDescription(v *string) *HolidayCalendarCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetCountryCode sets the "country_code" field.
+func (_c *HolidayCalendarCreate) SetCountryCode(v string) *HolidayCalendarCreate {
+	_c.mutation.SetCountryCode(v)
+	return _c
+}
+
+// SetNillableCountryCode sets the "country_code" field if the given value is not nil.
+func (_c *HolidayCalendarCreate) SetNillableCountryCode(v *string) *HolidayCalendarCreate {
+	if v != nil {
+		_c.SetCountryCode(*v)
+	}
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *HolidayCalendarCreate) SetRegion(v string) *HolidayCalendarCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_c *HolidayCalendarCreate) SetNillableRegion(v *string) *HolidayCalendarCreate {
+	if v != nil {
+		_c.SetRegion(*v)
+	}
+	return _c
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_c *HolidayCalendarCreate) SetIsDefault(v bool) *HolidayCalendarCreate {
+	_c.mutation.SetIsDefault(v)
+	return _c
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_c *HolidayCalendarCreate) SetNillableIsDefault(v *bool) *HolidayCalendarCreate {
+	if v != nil {
+		_c.SetIsDefault(*v)
+	}
+	return _c
+}
+
+// SetOrgUnitNames sets the "org_unit_names" field.
+func (_c *HolidayCalendarCreate) SetOrgUnitNames(v []string) *HolidayCalendarCreate {
+	_c.mutation.SetOrgUnitNames(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *HolidayCalendarCreate) SetID(v string) *HolidayCalendarCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddHolidayIDs adds the "holidays" edge to the Holiday entity by IDs.
+func (_c *HolidayCalendarCreate) AddHolidayIDs(ids ...string) *HolidayCalendarCreate {
+	_c.mutation.AddHolidayIDs(ids...)
+	return _c
+}
+
+// AddHolidays adds the "holidays" edges to the Holiday entity.
+func (_c *HolidayCalendarCreate) AddHolidays(v ...*Holiday) *HolidayCalendarCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddHolidayIDs(ids...)
+}
+
+// Mutation returns the HolidayCalendarMutation object of the builder.
+func (_c *HolidayCalendarCreate) Mutation() *HolidayCalendarMutation {
+	return _c.mutation
+}
+
+// Save creates the HolidayCalendar in the database.
+func (_c *HolidayCalendarCreate) Save(ctx context.Context) (*HolidayCalendar, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HolidayCalendarCreate) SaveX(ctx context.Context) *HolidayCalendar {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HolidayCalendarCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HolidayCalendarCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HolidayCalendarCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := holidaycalendar.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.CountryCode(); !ok {
+		v := holidaycalendar.DefaultCountryCode
+		_c.mutation.SetCountryCode(v)
+	}
+	if _, ok := _c.mutation.Region(); !ok {
+		v := holidaycalendar.DefaultRegion
+		_c.mutation.SetRegion(v)
+	}
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		v := holidaycalendar.DefaultIsDefault
+		_c.mutation.SetIsDefault(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HolidayCalendarCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "HolidayCalendar.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := holidaycalendar.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HolidayCalendar.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CountryCode(); ok {
+		if err := holidaycalendar.CountryCodeValidator(v); err != nil {
+			return &ValidationError{Name: "country_code", err: fmt.Errorf(`ent: validator failed for field "HolidayCalendar.country_code": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := holidaycalendar.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ent: validator failed for field "HolidayCalendar.region": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "HolidayCalendar.is_default"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := holidaycalendar.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "HolidayCalendar.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *HolidayCalendarCreate) sqlSave(ctx context.Context) (*HolidayCalendar, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected HolidayCalendar.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HolidayCalendarCreate) createSpec() (*HolidayCalendar, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HolidayCalendar{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(holidaycalendar.Table, sqlgraph.NewFieldSpec(holidaycalendar.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(holidaycalendar.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(holidaycalendar.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(holidaycalendar.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(holidaycalendar.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(holidaycalendar.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(holidaycalendar.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(holidaycalendar.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(holidaycalendar.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.CountryCode(); ok {
+		_spec.SetField(holidaycalendar.FieldCountryCode, field.TypeString, value)
+		_node.CountryCode = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(holidaycalendar.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	if value, ok := _c.mutation.IsDefault(); ok {
+		_spec.SetField(holidaycalendar.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := _c.mutation.OrgUnitNames(); ok {
+		_spec.SetField(holidaycalendar.FieldOrgUnitNames, field.TypeJSON, value)
+		_node.OrgUnitNames = value
+	}
+	if nodes := _c.mutation.HolidaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   holidaycalendar.HolidaysTable,
+			Columns: []string{holidaycalendar.HolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HolidayCalendar.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HolidayCalendarUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *HolidayCalendarCreate) OnConflict(opts ...sql.ConflictOption) *HolidayCalendarUpsertOne {
+	_c.conflict = opts
+	return &HolidayCalendarUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HolidayCalendar.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *HolidayCalendarCreate) OnConflictColumns(columns ...string) *HolidayCalendarUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &HolidayCalendarUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// HolidayCalendarUpsertOne is the builder for "upsert"-ing
+	//  one HolidayCalendar node.
+	HolidayCalendarUpsertOne struct {
+		create *HolidayCalendarCreate
+	}
+
+	// HolidayCalendarUpsert is the "OnConflict" setter.
+	HolidayCalendarUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *HolidayCalendarUpsert) SetCreateBy(v uint32) *HolidayCalendarUpsert {
+	u.Set(holidaycalendar.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *HolidayCalendarUpsert) UpdateCreateBy() *HolidayCalendarUpsert {
+	u.SetExcluded(holidaycalendar.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *HolidayCalendarUpsert) AddCreateBy(v uint32) *HolidayCalendarUpsert {
+	u.Add(holidaycalendar.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *HolidayCalendarUpsert) ClearCreateBy() *HolidayCalendarUpsert {
+	u.SetNull(holidaycalendar.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *HolidayCalendarUpsert) SetUpdateBy(v uint32) *HolidayCalendarUpsert {
+	u.Set(holidaycalendar.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *HolidayCalendarUpsert) UpdateUpdateBy() *HolidayCalendarUpsert {
+	u.SetExcluded(holidaycalendar.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *HolidayCalendarUpsert) AddUpdateBy(v uint32) *HolidayCalendarUpsert {
+	u.Add(holidaycalendar.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *HolidayCalendarUpsert) ClearUpdateBy() *HolidayCalendarUpsert {
+	u.SetNull(holidaycalendar.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *HolidayCalendarUpsert) SetUpdateTime(v time.Time) *HolidayCalendarUpsert {
+	u.Set(holidaycalendar.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *HolidayCalendarUpsert) UpdateUpdateTime() *HolidayCalendarUpsert {
+	u.SetExcluded(holidaycalendar.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *HolidayCalendarUpsert) ClearUpdateTime() *HolidayCalendarUpsert {
+	u.SetNull(holidaycalendar.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *HolidayCalendarUpsert) SetDeleteTime(v time.Time) *HolidayCalendarUpsert {
+	u.Set(holidaycalendar.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *HolidayCalendarUpsert) UpdateDeleteTime() *HolidayCalendarUpsert {
+	u.SetExcluded(holidaycalendar.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *HolidayCalendarUpsert) ClearDeleteTime() *HolidayCalendarUpsert {
+	u.SetNull(holidaycalendar.FieldDeleteTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *HolidayCalendarUpsert) SetName(v string) *HolidayCalendarUpsert {
+	u.Set(holidaycalendar.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HolidayCalendarUpsert) UpdateName() *HolidayCalendarUpsert {
+	u.SetExcluded(holidaycalendar.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *HolidayCalendarUpsert) SetDescription(v string) *HolidayCalendarUpsert {
+	u.Set(holidaycalendar.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *HolidayCalendarUpsert) UpdateDescription() *HolidayCalendarUpsert {
+	u.SetExcluded(holidaycalendar.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *HolidayCalendarUpsert) ClearDescription() *HolidayCalendarUpsert {
+	u.SetNull(holidaycalendar.FieldDescription)
+	return u
+}
+
+// SetCountryCode sets the "country_code" field.
+func (u *HolidayCalendarUpsert) SetCountryCode(v string) *HolidayCalendarUpsert {
+	u.Set(holidaycalendar.FieldCountryCode, v)
+	return u
+}
+
+// UpdateCountryCode sets the "country_code" field to the value that was provided on create.
+func (u *HolidayCalendarUpsert) UpdateCountryCode() *HolidayCalendarUpsert {
+	u.SetExcluded(holidaycalendar.FieldCountryCode)
+	return u
+}
+
+// ClearCountryCode clears the value of the "country_code" field.
+func (u *HolidayCalendarUpsert) ClearCountryCode() *HolidayCalendarUpsert {
+	u.SetNull(holidaycalendar.FieldCountryCode)
+	return u
+}
+
+// SetRegion sets the "region" field.
+func (u *HolidayCalendarUpsert) SetRegion(v string) *HolidayCalendarUpsert {
+	u.Set(holidaycalendar.FieldRegion, v)
+	return u
+}
+
+// UpdateRegion sets the "region" field to the value that was provided on create.
+func (u *HolidayCalendarUpsert) UpdateRegion() *HolidayCalendarUpsert {
+	u.SetExcluded(holidaycalendar.FieldRegion)
+	return u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (u *HolidayCalendarUpsert) ClearRegion() *HolidayCalendarUpsert {
+	u.SetNull(holidaycalendar.FieldRegion)
+	return u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *HolidayCalendarUpsert) SetIsDefault(v bool) *HolidayCalendarUpsert {
+	u.Set(holidaycalendar.FieldIsDefault, v)
+	return u
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *HolidayCalendarUpsert) UpdateIsDefault() *HolidayCalendarUpsert {
+	u.SetExcluded(holidaycalendar.FieldIsDefault)
+	return u
+}
+
+// SetOrgUnitNames sets the "org_unit_names" field.
+func (u *HolidayCalendarUpsert) SetOrgUnitNames(v []string) *HolidayCalendarUpsert {
+	u.Set(holidaycalendar.FieldOrgUnitNames, v)
+	return u
+}
+
+// UpdateOrgUnitNames sets the "org_unit_names" field to the value that was provided on create.
+func (u *HolidayCalendarUpsert) UpdateOrgUnitNames() *HolidayCalendarUpsert {
+	u.SetExcluded(holidaycalendar.FieldOrgUnitNames)
+	return u
+}
+
+// ClearOrgUnitNames clears the value of the "org_unit_names" field.
+func (u *HolidayCalendarUpsert) ClearOrgUnitNames() *HolidayCalendarUpsert {
+	u.SetNull(holidaycalendar.FieldOrgUnitNames)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.HolidayCalendar.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(holidaycalendar.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HolidayCalendarUpsertOne) UpdateNewValues() *HolidayCalendarUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(holidaycalendar.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(holidaycalendar.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(holidaycalendar.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HolidayCalendar.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HolidayCalendarUpsertOne) Ignore() *HolidayCalendarUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HolidayCalendarUpsertOne) DoNothing() *HolidayCalendarUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HolidayCalendarCreate.OnConflict
+// documentation for more info.
+func (u *HolidayCalendarUpsertOne) Update(set func(*HolidayCalendarUpsert)) *HolidayCalendarUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HolidayCalendarUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *HolidayCalendarUpsertOne) SetCreateBy(v uint32) *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *HolidayCalendarUpsertOne) AddCreateBy(v uint32) *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertOne) UpdateCreateBy() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *HolidayCalendarUpsertOne) ClearCreateBy() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *HolidayCalendarUpsertOne) SetUpdateBy(v uint32) *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *HolidayCalendarUpsertOne) AddUpdateBy(v uint32) *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertOne) UpdateUpdateBy() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *HolidayCalendarUpsertOne) ClearUpdateBy() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *HolidayCalendarUpsertOne) SetUpdateTime(v time.Time) *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertOne) UpdateUpdateTime() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *HolidayCalendarUpsertOne) ClearUpdateTime() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *HolidayCalendarUpsertOne) SetDeleteTime(v time.Time) *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertOne) UpdateDeleteTime() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *HolidayCalendarUpsertOne) ClearDeleteTime() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *HolidayCalendarUpsertOne) SetName(v string) *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertOne) UpdateName() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *HolidayCalendarUpsertOne) SetDescription(v string) *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertOne) UpdateDescription() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *HolidayCalendarUpsertOne) ClearDescription() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetCountryCode sets the "country_code" field.
+func (u *HolidayCalendarUpsertOne) SetCountryCode(v string) *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetCountryCode(v)
+	})
+}
+
+// UpdateCountryCode sets the "country_code" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertOne) UpdateCountryCode() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateCountryCode()
+	})
+}
+
+// ClearCountryCode clears the value of the "country_code" field.
+func (u *HolidayCalendarUpsertOne) ClearCountryCode() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearCountryCode()
+	})
+}
+
+// SetRegion sets the "region" field.
+func (u *HolidayCalendarUpsertOne) SetRegion(v string) *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetRegion(v)
+	})
+}
+
+// UpdateRegion sets the "region" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertOne) UpdateRegion() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateRegion()
+	})
+}
+
+// ClearRegion clears the value of the "region" field.
+func (u *HolidayCalendarUpsertOne) ClearRegion() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearRegion()
+	})
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *HolidayCalendarUpsertOne) SetIsDefault(v bool) *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetIsDefault(v)
+	})
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertOne) UpdateIsDefault() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateIsDefault()
+	})
+}
+
+// SetOrgUnitNames sets the "org_unit_names" field.
+func (u *HolidayCalendarUpsertOne) SetOrgUnitNames(v []string) *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetOrgUnitNames(v)
+	})
+}
+
+// UpdateOrgUnitNames sets the "org_unit_names" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertOne) UpdateOrgUnitNames() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateOrgUnitNames()
+	})
+}
+
+// ClearOrgUnitNames clears the value of the "org_unit_names" field.
+func (u *HolidayCalendarUpsertOne) ClearOrgUnitNames() *HolidayCalendarUpsertOne {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearOrgUnitNames()
+	})
+}
+
+// Exec executes the query.
+func (u *HolidayCalendarUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HolidayCalendarCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HolidayCalendarUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HolidayCalendarUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: HolidayCalendarUpsertOne.ID is not supported by MySQL driver. Use HolidayCalendarUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HolidayCalendarUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// HolidayCalendarCreateBulk is the builder for creating many HolidayCalendar entities in bulk.
+type HolidayCalendarCreateBulk struct {
+	config
+	err      error
+	builders []*HolidayCalendarCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the HolidayCalendar entities in the database.
+func (_c *HolidayCalendarCreateBulk) Save(ctx context.Context) ([]*HolidayCalendar, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*HolidayCalendar, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HolidayCalendarMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HolidayCalendarCreateBulk) SaveX(ctx context.Context) []*HolidayCalendar {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HolidayCalendarCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HolidayCalendarCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HolidayCalendar.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HolidayCalendarUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *HolidayCalendarCreateBulk) OnConflict(opts ...sql.ConflictOption) *HolidayCalendarUpsertBulk {
+	_c.conflict = opts
+	return &HolidayCalendarUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HolidayCalendar.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *HolidayCalendarCreateBulk) OnConflictColumns(columns ...string) *HolidayCalendarUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &HolidayCalendarUpsertBulk{
+		create: _c,
+	}
+}
+
+// HolidayCalendarUpsertBulk is the builder for "upsert"-ing
+// a bulk of HolidayCalendar nodes.
+type HolidayCalendarUpsertBulk struct {
+	create *HolidayCalendarCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.HolidayCalendar.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(holidaycalendar.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HolidayCalendarUpsertBulk) UpdateNewValues() *HolidayCalendarUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(holidaycalendar.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(holidaycalendar.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(holidaycalendar.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HolidayCalendar.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HolidayCalendarUpsertBulk) Ignore() *HolidayCalendarUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HolidayCalendarUpsertBulk) DoNothing() *HolidayCalendarUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HolidayCalendarCreateBulk.OnConflict
+// documentation for more info.
+func (u *HolidayCalendarUpsertBulk) Update(set func(*HolidayCalendarUpsert)) *HolidayCalendarUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HolidayCalendarUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *HolidayCalendarUpsertBulk) SetCreateBy(v uint32) *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *HolidayCalendarUpsertBulk) AddCreateBy(v uint32) *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertBulk) UpdateCreateBy() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *HolidayCalendarUpsertBulk) ClearCreateBy() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *HolidayCalendarUpsertBulk) SetUpdateBy(v uint32) *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *HolidayCalendarUpsertBulk) AddUpdateBy(v uint32) *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertBulk) UpdateUpdateBy() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *HolidayCalendarUpsertBulk) ClearUpdateBy() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *HolidayCalendarUpsertBulk) SetUpdateTime(v time.Time) *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertBulk) UpdateUpdateTime() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *HolidayCalendarUpsertBulk) ClearUpdateTime() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *HolidayCalendarUpsertBulk) SetDeleteTime(v time.Time) *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertBulk) UpdateDeleteTime() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *HolidayCalendarUpsertBulk) ClearDeleteTime() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *HolidayCalendarUpsertBulk) SetName(v string) *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertBulk) UpdateName() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *HolidayCalendarUpsertBulk) SetDescription(v string) *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertBulk) UpdateDescription() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *HolidayCalendarUpsertBulk) ClearDescription() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetCountryCode sets the "country_code" field.
+func (u *HolidayCalendarUpsertBulk) SetCountryCode(v string) *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetCountryCode(v)
+	})
+}
+
+// UpdateCountryCode sets the "country_code" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertBulk) UpdateCountryCode() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateCountryCode()
+	})
+}
+
+// ClearCountryCode clears the value of the "country_code" field.
+func (u *HolidayCalendarUpsertBulk) ClearCountryCode() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearCountryCode()
+	})
+}
+
+// SetRegion sets the "region" field.
+func (u *HolidayCalendarUpsertBulk) SetRegion(v string) *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetRegion(v)
+	})
+}
+
+// UpdateRegion sets the "region" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertBulk) UpdateRegion() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateRegion()
+	})
+}
+
+// ClearRegion clears the value of the "region" field.
+func (u *HolidayCalendarUpsertBulk) ClearRegion() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearRegion()
+	})
+}
+
+// SetIsDefault sets the "is_default" field.
+func (u *HolidayCalendarUpsertBulk) SetIsDefault(v bool) *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetIsDefault(v)
+	})
+}
+
+// UpdateIsDefault sets the "is_default" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertBulk) UpdateIsDefault() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateIsDefault()
+	})
+}
+
+// SetOrgUnitNames sets the "org_unit_names" field.
+func (u *HolidayCalendarUpsertBulk) SetOrgUnitNames(v []string) *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.SetOrgUnitNames(v)
+	})
+}
+
+// UpdateOrgUnitNames sets the "org_unit_names" field to the value that was provided on create.
+func (u *HolidayCalendarUpsertBulk) UpdateOrgUnitNames() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.UpdateOrgUnitNames()
+	})
+}
+
+// ClearOrgUnitNames clears the value of the "org_unit_names" field.
+func (u *HolidayCalendarUpsertBulk) ClearOrgUnitNames() *HolidayCalendarUpsertBulk {
+	return u.Update(func(s *HolidayCalendarUpsert) {
+		s.ClearOrgUnitNames()
+	})
+}
+
+// Exec executes the query.
+func (u *HolidayCalendarUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the HolidayCalendarCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HolidayCalendarCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HolidayCalendarUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
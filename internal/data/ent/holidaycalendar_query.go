@@ -0,0 +1,663 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holiday"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holidaycalendar"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// HolidayCalendarQuery is the builder for querying HolidayCalendar entities.
+type HolidayCalendarQuery struct {
+	config
+	ctx          *QueryContext
+	order        []holidaycalendar.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.HolidayCalendar
+	withHolidays *HolidayQuery
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the HolidayCalendarQuery builder.
+func (_q *HolidayCalendarQuery) Where(ps ...predicate.HolidayCalendar) *HolidayCalendarQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *HolidayCalendarQuery) Limit(limit int) *HolidayCalendarQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *HolidayCalendarQuery) Offset(offset int) *HolidayCalendarQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *HolidayCalendarQuery) Unique(unique bool) *HolidayCalendarQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *HolidayCalendarQuery) Order(o ...holidaycalendar.OrderOption) *HolidayCalendarQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryHolidays chains the current query on the "holidays" edge.
+func (_q *HolidayCalendarQuery) QueryHolidays() *HolidayQuery {
+	query := (&HolidayClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(holidaycalendar.Table, holidaycalendar.FieldID, selector),
+			sqlgraph.To(holiday.Table, holiday.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, holidaycalendar.HolidaysTable, holidaycalendar.HolidaysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first HolidayCalendar entity from the query.
+// Returns a *NotFoundError when no HolidayCalendar was found.
+func (_q *HolidayCalendarQuery) First(ctx context.Context) (*HolidayCalendar, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{holidaycalendar.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *HolidayCalendarQuery) FirstX(ctx context.Context) *HolidayCalendar {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first HolidayCalendar ID from the query.
+// Returns a *NotFoundError when no HolidayCalendar ID was found.
+func (_q *HolidayCalendarQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{holidaycalendar.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *HolidayCalendarQuery) FirstIDX(ctx context.Context) string {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single HolidayCalendar entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one HolidayCalendar entity is found.
+// Returns a *NotFoundError when no HolidayCalendar entities are found.
+func (_q *HolidayCalendarQuery) Only(ctx context.Context) (*HolidayCalendar, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{holidaycalendar.Label}
+	default:
+		return nil, &NotSingularError{holidaycalendar.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *HolidayCalendarQuery) OnlyX(ctx context.Context) *HolidayCalendar {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only HolidayCalendar ID in the query.
+// Returns a *NotSingularError when more than one HolidayCalendar ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *HolidayCalendarQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{holidaycalendar.Label}
+	default:
+		err = &NotSingularError{holidaycalendar.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *HolidayCalendarQuery) OnlyIDX(ctx context.Context) string {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of HolidayCalendars.
+func (_q *HolidayCalendarQuery) All(ctx context.Context) ([]*HolidayCalendar, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*HolidayCalendar, *HolidayCalendarQuery]()
+	return withInterceptors[[]*HolidayCalendar](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *HolidayCalendarQuery) AllX(ctx context.Context) []*HolidayCalendar {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of HolidayCalendar IDs.
+func (_q *HolidayCalendarQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(holidaycalendar.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *HolidayCalendarQuery) IDsX(ctx context.Context) []string {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *HolidayCalendarQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*HolidayCalendarQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *HolidayCalendarQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *HolidayCalendarQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *HolidayCalendarQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the HolidayCalendarQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *HolidayCalendarQuery) Clone() *HolidayCalendarQuery {
+	if _q == nil {
+		return nil
+	}
+	return &HolidayCalendarQuery{
+		config:       _q.config,
+		ctx:          _q.ctx.Clone(),
+		order:        append([]holidaycalendar.OrderOption{}, _q.order...),
+		inters:       append([]Interceptor{}, _q.inters...),
+		predicates:   append([]predicate.HolidayCalendar{}, _q.predicates...),
+		withHolidays: _q.withHolidays.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithHolidays tells the query-builder to eager-load the nodes that are connected to
+// the "holidays" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *HolidayCalendarQuery) WithHolidays(opts ...func(*HolidayQuery)) *HolidayCalendarQuery {
+	query := (&HolidayClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHolidays = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateBy uint32 `json:"create_by,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.HolidayCalendar.Query().
+//		GroupBy(holidaycalendar.FieldCreateBy).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *HolidayCalendarQuery) GroupBy(field string, fields ...string) *HolidayCalendarGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &HolidayCalendarGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = holidaycalendar.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateBy uint32 `json:"create_by,omitempty"`
+//	}
+//
+//	client.HolidayCalendar.Query().
+//		Select(holidaycalendar.FieldCreateBy).
+//		Scan(ctx, &v)
+func (_q *HolidayCalendarQuery) Select(fields ...string) *HolidayCalendarSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &HolidayCalendarSelect{HolidayCalendarQuery: _q}
+	sbuild.label = holidaycalendar.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a HolidayCalendarSelect configured with the given aggregations.
+func (_q *HolidayCalendarQuery) Aggregate(fns ...AggregateFunc) *HolidayCalendarSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *HolidayCalendarQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !holidaycalendar.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	if holidaycalendar.Policy == nil {
+		return errors.New("ent: uninitialized holidaycalendar.Policy (forgotten import ent/runtime?)")
+	}
+	if err := holidaycalendar.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (_q *HolidayCalendarQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*HolidayCalendar, error) {
+	var (
+		nodes       = []*HolidayCalendar{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withHolidays != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*HolidayCalendar).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &HolidayCalendar{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withHolidays; query != nil {
+		if err := _q.loadHolidays(ctx, query, nodes,
+			func(n *HolidayCalendar) { n.Edges.Holidays = []*Holiday{} },
+			func(n *HolidayCalendar, e *Holiday) { n.Edges.Holidays = append(n.Edges.Holidays, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *HolidayCalendarQuery) loadHolidays(ctx context.Context, query *HolidayQuery, nodes []*HolidayCalendar, init func(*HolidayCalendar), assign func(*HolidayCalendar, *Holiday)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*HolidayCalendar)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(holiday.FieldCalendarID)
+	}
+	query.Where(predicate.Holiday(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(holidaycalendar.HolidaysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CalendarID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "calendar_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *HolidayCalendarQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *HolidayCalendarQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(holidaycalendar.Table, holidaycalendar.Columns, sqlgraph.NewFieldSpec(holidaycalendar.FieldID, field.TypeString))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, holidaycalendar.FieldID)
+		for i := range fields {
+			if fields[i] != holidaycalendar.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *HolidayCalendarQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(holidaycalendar.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = holidaycalendar.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *HolidayCalendarQuery) ForUpdate(opts ...sql.LockOption) *HolidayCalendarQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *HolidayCalendarQuery) ForShare(opts ...sql.LockOption) *HolidayCalendarQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *HolidayCalendarQuery) Modify(modifiers ...func(s *sql.Selector)) *HolidayCalendarSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// HolidayCalendarGroupBy is the group-by builder for HolidayCalendar entities.
+type HolidayCalendarGroupBy struct {
+	selector
+	build *HolidayCalendarQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *HolidayCalendarGroupBy) Aggregate(fns ...AggregateFunc) *HolidayCalendarGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *HolidayCalendarGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HolidayCalendarQuery, *HolidayCalendarGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *HolidayCalendarGroupBy) sqlScan(ctx context.Context, root *HolidayCalendarQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// HolidayCalendarSelect is the builder for selecting fields of HolidayCalendar entities.
+type HolidayCalendarSelect struct {
+	*HolidayCalendarQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *HolidayCalendarSelect) Aggregate(fns ...AggregateFunc) *HolidayCalendarSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *HolidayCalendarSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HolidayCalendarQuery, *HolidayCalendarSelect](ctx, _s.HolidayCalendarQuery, _s, _s.inters, v)
+}
+
+func (_s *HolidayCalendarSelect) sqlScan(ctx context.Context, root *HolidayCalendarQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *HolidayCalendarSelect) Modify(modifiers ...func(s *sql.Selector)) *HolidayCalendarSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
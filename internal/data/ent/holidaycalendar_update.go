@@ -0,0 +1,944 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holiday"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holidaycalendar"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// HolidayCalendarUpdate is the builder for updating HolidayCalendar entities.
+type HolidayCalendarUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *HolidayCalendarMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the HolidayCalendarUpdate builder.
+func (_u *HolidayCalendarUpdate) Where(ps ...predicate.HolidayCalendar) *HolidayCalendarUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *HolidayCalendarUpdate) SetCreateBy(v uint32) *HolidayCalendarUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *HolidayCalendarUpdate) SetNillableCreateBy(v *uint32) *HolidayCalendarUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *HolidayCalendarUpdate) AddCreateBy(v int32) *HolidayCalendarUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *HolidayCalendarUpdate) ClearCreateBy() *HolidayCalendarUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *HolidayCalendarUpdate) SetUpdateBy(v uint32) *HolidayCalendarUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *HolidayCalendarUpdate) SetNillableUpdateBy(v *uint32) *HolidayCalendarUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *HolidayCalendarUpdate) AddUpdateBy(v int32) *HolidayCalendarUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *HolidayCalendarUpdate) ClearUpdateBy() *HolidayCalendarUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *HolidayCalendarUpdate) SetUpdateTime(v time.Time) *HolidayCalendarUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *HolidayCalendarUpdate) SetNillableUpdateTime(v *time.Time) *HolidayCalendarUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *HolidayCalendarUpdate) ClearUpdateTime() *HolidayCalendarUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *HolidayCalendarUpdate) SetDeleteTime(v time.Time) *HolidayCalendarUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *HolidayCalendarUpdate) SetNillableDeleteTime(v *time.Time) *HolidayCalendarUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *HolidayCalendarUpdate) ClearDeleteTime() *HolidayCalendarUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HolidayCalendarUpdate) SetName(v string) *HolidayCalendarUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HolidayCalendarUpdate) SetNillableName(v *string) *HolidayCalendarUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *HolidayCalendarUpdate) SetDescription(v string) *HolidayCalendarUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *HolidayCalendarUpdate) SetNillableDescription(v *string) *HolidayCalendarUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *HolidayCalendarUpdate) ClearDescription() *HolidayCalendarUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetCountryCode sets the "country_code" field.
+func (_u *HolidayCalendarUpdate) SetCountryCode(v string) *HolidayCalendarUpdate {
+	_u.mutation.SetCountryCode(v)
+	return _u
+}
+
+// SetNillableCountryCode sets the "country_code" field if the given value is not nil.
+func (_u *HolidayCalendarUpdate) SetNillableCountryCode(v *string) *HolidayCalendarUpdate {
+	if v != nil {
+		_u.SetCountryCode(*v)
+	}
+	return _u
+}
+
+// ClearCountryCode clears the value of the "country_code" field.
+func (_u *HolidayCalendarUpdate) ClearCountryCode() *HolidayCalendarUpdate {
+	_u.mutation.ClearCountryCode()
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *HolidayCalendarUpdate) SetRegion(v string) *HolidayCalendarUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *HolidayCalendarUpdate) SetNillableRegion(v *string) *HolidayCalendarUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (_u *HolidayCalendarUpdate) ClearRegion() *HolidayCalendarUpdate {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *HolidayCalendarUpdate) SetIsDefault(v bool) *HolidayCalendarUpdate {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *HolidayCalendarUpdate) SetNillableIsDefault(v *bool) *HolidayCalendarUpdate {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetOrgUnitNames sets the "org_unit_names" field.
+func (_u *HolidayCalendarUpdate) SetOrgUnitNames(v []string) *HolidayCalendarUpdate {
+	_u.mutation.SetOrgUnitNames(v)
+	return _u
+}
+
+// AppendOrgUnitNames appends value to the "org_unit_names" field.
+func (_u *HolidayCalendarUpdate) AppendOrgUnitNames(v []string) *HolidayCalendarUpdate {
+	_u.mutation.AppendOrgUnitNames(v)
+	return _u
+}
+
+// ClearOrgUnitNames clears the value of the "org_unit_names" field.
+func (_u *HolidayCalendarUpdate) ClearOrgUnitNames() *HolidayCalendarUpdate {
+	_u.mutation.ClearOrgUnitNames()
+	return _u
+}
+
+// AddHolidayIDs adds the "holidays" edge to the Holiday entity by IDs.
+func (_u *HolidayCalendarUpdate) AddHolidayIDs(ids ...string) *HolidayCalendarUpdate {
+	_u.mutation.AddHolidayIDs(ids...)
+	return _u
+}
+
+// AddHolidays adds the "holidays" edges to the Holiday entity.
+func (_u *HolidayCalendarUpdate) AddHolidays(v ...*Holiday) *HolidayCalendarUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHolidayIDs(ids...)
+}
+
+// Mutation returns the HolidayCalendarMutation object of the builder.
+func (_u *HolidayCalendarUpdate) Mutation() *HolidayCalendarMutation {
+	return _u.mutation
+}
+
+// ClearHolidays clears all "holidays" edges to the Holiday entity.
+func (_u *HolidayCalendarUpdate) ClearHolidays() *HolidayCalendarUpdate {
+	_u.mutation.ClearHolidays()
+	return _u
+}
+
+// RemoveHolidayIDs removes the "holidays" edge to Holiday entities by IDs.
+func (_u *HolidayCalendarUpdate) RemoveHolidayIDs(ids ...string) *HolidayCalendarUpdate {
+	_u.mutation.RemoveHolidayIDs(ids...)
+	return _u
+}
+
+// RemoveHolidays removes "holidays" edges to Holiday entities.
+func (_u *HolidayCalendarUpdate) RemoveHolidays(v ...*Holiday) *HolidayCalendarUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHolidayIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *HolidayCalendarUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HolidayCalendarUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *HolidayCalendarUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HolidayCalendarUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HolidayCalendarUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := holidaycalendar.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HolidayCalendar.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CountryCode(); ok {
+		if err := holidaycalendar.CountryCodeValidator(v); err != nil {
+			return &ValidationError{Name: "country_code", err: fmt.Errorf(`ent: validator failed for field "HolidayCalendar.country_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := holidaycalendar.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ent: validator failed for field "HolidayCalendar.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *HolidayCalendarUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HolidayCalendarUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *HolidayCalendarUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(holidaycalendar.Table, holidaycalendar.Columns, sqlgraph.NewFieldSpec(holidaycalendar.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(holidaycalendar.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(holidaycalendar.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(holidaycalendar.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(holidaycalendar.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(holidaycalendar.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(holidaycalendar.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(holidaycalendar.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(holidaycalendar.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(holidaycalendar.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(holidaycalendar.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(holidaycalendar.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(holidaycalendar.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(holidaycalendar.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(holidaycalendar.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(holidaycalendar.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.CountryCode(); ok {
+		_spec.SetField(holidaycalendar.FieldCountryCode, field.TypeString, value)
+	}
+	if _u.mutation.CountryCodeCleared() {
+		_spec.ClearField(holidaycalendar.FieldCountryCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(holidaycalendar.FieldRegion, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		_spec.ClearField(holidaycalendar.FieldRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(holidaycalendar.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.OrgUnitNames(); ok {
+		_spec.SetField(holidaycalendar.FieldOrgUnitNames, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOrgUnitNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, holidaycalendar.FieldOrgUnitNames, value)
+		})
+	}
+	if _u.mutation.OrgUnitNamesCleared() {
+		_spec.ClearField(holidaycalendar.FieldOrgUnitNames, field.TypeJSON)
+	}
+	if _u.mutation.HolidaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   holidaycalendar.HolidaysTable,
+			Columns: []string{holidaycalendar.HolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHolidaysIDs(); len(nodes) > 0 && !_u.mutation.HolidaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   holidaycalendar.HolidaysTable,
+			Columns: []string{holidaycalendar.HolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HolidaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   holidaycalendar.HolidaysTable,
+			Columns: []string{holidaycalendar.HolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holidaycalendar.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// HolidayCalendarUpdateOne is the builder for updating a single HolidayCalendar entity.
+type HolidayCalendarUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *HolidayCalendarMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *HolidayCalendarUpdateOne) SetCreateBy(v uint32) *HolidayCalendarUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *HolidayCalendarUpdateOne) SetNillableCreateBy(v *uint32) *HolidayCalendarUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *HolidayCalendarUpdateOne) AddCreateBy(v int32) *HolidayCalendarUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *HolidayCalendarUpdateOne) ClearCreateBy() *HolidayCalendarUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *HolidayCalendarUpdateOne) SetUpdateBy(v uint32) *HolidayCalendarUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *HolidayCalendarUpdateOne) SetNillableUpdateBy(v *uint32) *HolidayCalendarUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *HolidayCalendarUpdateOne) AddUpdateBy(v int32) *HolidayCalendarUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *HolidayCalendarUpdateOne) ClearUpdateBy() *HolidayCalendarUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *HolidayCalendarUpdateOne) SetUpdateTime(v time.Time) *HolidayCalendarUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *HolidayCalendarUpdateOne) SetNillableUpdateTime(v *time.Time) *HolidayCalendarUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *HolidayCalendarUpdateOne) ClearUpdateTime() *HolidayCalendarUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *HolidayCalendarUpdateOne) SetDeleteTime(v time.Time) *HolidayCalendarUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *HolidayCalendarUpdateOne) SetNillableDeleteTime(v *time.Time) *HolidayCalendarUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *HolidayCalendarUpdateOne) ClearDeleteTime() *HolidayCalendarUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *HolidayCalendarUpdateOne) SetName(v string) *HolidayCalendarUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *HolidayCalendarUpdateOne) SetNillableName(v *string) *HolidayCalendarUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *HolidayCalendarUpdateOne) SetDescription(v string) *HolidayCalendarUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *HolidayCalendarUpdateOne) SetNillableDescription(v *string) *HolidayCalendarUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *HolidayCalendarUpdateOne) ClearDescription() *HolidayCalendarUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetCountryCode sets the "country_code" field.
+func (_u *HolidayCalendarUpdateOne) SetCountryCode(v string) *HolidayCalendarUpdateOne {
+	_u.mutation.SetCountryCode(v)
+	return _u
+}
+
+// SetNillableCountryCode sets the "country_code" field if the given value is not nil.
+func (_u *HolidayCalendarUpdateOne) SetNillableCountryCode(v *string) *HolidayCalendarUpdateOne {
+	if v != nil {
+		_u.SetCountryCode(*v)
+	}
+	return _u
+}
+
+// ClearCountryCode clears the value of the "country_code" field.
+func (_u *HolidayCalendarUpdateOne) ClearCountryCode() *HolidayCalendarUpdateOne {
+	_u.mutation.ClearCountryCode()
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *HolidayCalendarUpdateOne) SetRegion(v string) *HolidayCalendarUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *HolidayCalendarUpdateOne) SetNillableRegion(v *string) *HolidayCalendarUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (_u *HolidayCalendarUpdateOne) ClearRegion() *HolidayCalendarUpdateOne {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *HolidayCalendarUpdateOne) SetIsDefault(v bool) *HolidayCalendarUpdateOne {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *HolidayCalendarUpdateOne) SetNillableIsDefault(v *bool) *HolidayCalendarUpdateOne {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetOrgUnitNames sets the "org_unit_names" field.
+func (_u *HolidayCalendarUpdateOne) SetOrgUnitNames(v []string) *HolidayCalendarUpdateOne {
+	_u.mutation.SetOrgUnitNames(v)
+	return _u
+}
+
+// AppendOrgUnitNames appends value to the "org_unit_names" field.
+func (_u *HolidayCalendarUpdateOne) AppendOrgUnitNames(v []string) *HolidayCalendarUpdateOne {
+	_u.mutation.AppendOrgUnitNames(v)
+	return _u
+}
+
+// ClearOrgUnitNames clears the value of the "org_unit_names" field.
+func (_u *HolidayCalendarUpdateOne) ClearOrgUnitNames() *HolidayCalendarUpdateOne {
+	_u.mutation.ClearOrgUnitNames()
+	return _u
+}
+
+// AddHolidayIDs adds the "holidays" edge to the Holiday entity by IDs.
+func (_u *HolidayCalendarUpdateOne) AddHolidayIDs(ids ...string) *HolidayCalendarUpdateOne {
+	_u.mutation.AddHolidayIDs(ids...)
+	return _u
+}
+
+// AddHolidays adds the "holidays" edges to the Holiday entity.
+func (_u *HolidayCalendarUpdateOne) AddHolidays(v ...*Holiday) *HolidayCalendarUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHolidayIDs(ids...)
+}
+
+// Mutation returns the HolidayCalendarMutation object of the builder.
+func (_u *HolidayCalendarUpdateOne) Mutation() *HolidayCalendarMutation {
+	return _u.mutation
+}
+
+// ClearHolidays clears all "holidays" edges to the Holiday entity.
+func (_u *HolidayCalendarUpdateOne) ClearHolidays() *HolidayCalendarUpdateOne {
+	_u.mutation.ClearHolidays()
+	return _u
+}
+
+// RemoveHolidayIDs removes the "holidays" edge to Holiday entities by IDs.
+func (_u *HolidayCalendarUpdateOne) RemoveHolidayIDs(ids ...string) *HolidayCalendarUpdateOne {
+	_u.mutation.RemoveHolidayIDs(ids...)
+	return _u
+}
+
+// RemoveHolidays removes "holidays" edges to Holiday entities.
+func (_u *HolidayCalendarUpdateOne) RemoveHolidays(v ...*Holiday) *HolidayCalendarUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHolidayIDs(ids...)
+}
+
+// Where appends a list predicates to the HolidayCalendarUpdate builder.
+func (_u *HolidayCalendarUpdateOne) Where(ps ...predicate.HolidayCalendar) *HolidayCalendarUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *HolidayCalendarUpdateOne) Select(field string, fields ...string) *HolidayCalendarUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated HolidayCalendar entity.
+func (_u *HolidayCalendarUpdateOne) Save(ctx context.Context) (*HolidayCalendar, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *HolidayCalendarUpdateOne) SaveX(ctx context.Context) *HolidayCalendar {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *HolidayCalendarUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *HolidayCalendarUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *HolidayCalendarUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := holidaycalendar.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "HolidayCalendar.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CountryCode(); ok {
+		if err := holidaycalendar.CountryCodeValidator(v); err != nil {
+			return &ValidationError{Name: "country_code", err: fmt.Errorf(`ent: validator failed for field "HolidayCalendar.country_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := holidaycalendar.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`ent: validator failed for field "HolidayCalendar.region": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *HolidayCalendarUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *HolidayCalendarUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *HolidayCalendarUpdateOne) sqlSave(ctx context.Context) (_node *HolidayCalendar, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(holidaycalendar.Table, holidaycalendar.Columns, sqlgraph.NewFieldSpec(holidaycalendar.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "HolidayCalendar.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, holidaycalendar.FieldID)
+		for _, f := range fields {
+			if !holidaycalendar.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != holidaycalendar.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(holidaycalendar.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(holidaycalendar.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(holidaycalendar.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(holidaycalendar.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(holidaycalendar.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(holidaycalendar.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(holidaycalendar.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(holidaycalendar.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(holidaycalendar.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(holidaycalendar.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(holidaycalendar.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(holidaycalendar.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(holidaycalendar.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(holidaycalendar.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(holidaycalendar.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.CountryCode(); ok {
+		_spec.SetField(holidaycalendar.FieldCountryCode, field.TypeString, value)
+	}
+	if _u.mutation.CountryCodeCleared() {
+		_spec.ClearField(holidaycalendar.FieldCountryCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(holidaycalendar.FieldRegion, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		_spec.ClearField(holidaycalendar.FieldRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(holidaycalendar.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.OrgUnitNames(); ok {
+		_spec.SetField(holidaycalendar.FieldOrgUnitNames, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOrgUnitNames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, holidaycalendar.FieldOrgUnitNames, value)
+		})
+	}
+	if _u.mutation.OrgUnitNamesCleared() {
+		_spec.ClearField(holidaycalendar.FieldOrgUnitNames, field.TypeJSON)
+	}
+	if _u.mutation.HolidaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   holidaycalendar.HolidaysTable,
+			Columns: []string{holidaycalendar.HolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHolidaysIDs(); len(nodes) > 0 && !_u.mutation.HolidaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   holidaycalendar.HolidaysTable,
+			Columns: []string{holidaycalendar.HolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HolidaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   holidaycalendar.HolidaysTable,
+			Columns: []string{holidaycalendar.HolidaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(holiday.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &HolidayCalendar{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{holidaycalendar.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
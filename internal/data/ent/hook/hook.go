@@ -33,6 +33,30 @@ func (f AllowancePoolFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AllowancePoolMutation", m)
 }
 
+// The AllowanceTransactionFunc type is an adapter to allow the use of ordinary
+// function as AllowanceTransaction mutator.
+type AllowanceTransactionFunc func(context.Context, *ent.AllowanceTransactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AllowanceTransactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AllowanceTransactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AllowanceTransactionMutation", m)
+}
+
+// The ApprovalDelegationFunc type is an adapter to allow the use of ordinary
+// function as ApprovalDelegation mutator.
+type ApprovalDelegationFunc func(context.Context, *ent.ApprovalDelegationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ApprovalDelegationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ApprovalDelegationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ApprovalDelegationMutation", m)
+}
+
 // The AuditLogFunc type is an adapter to allow the use of ordinary
 // function as AuditLog mutator.
 type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
@@ -45,6 +69,66 @@ func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
 }
 
+// The BlackoutPeriodFunc type is an adapter to allow the use of ordinary
+// function as BlackoutPeriod mutator.
+type BlackoutPeriodFunc func(context.Context, *ent.BlackoutPeriodMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BlackoutPeriodFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BlackoutPeriodMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BlackoutPeriodMutation", m)
+}
+
+// The CoverageRuleFunc type is an adapter to allow the use of ordinary
+// function as CoverageRule mutator.
+type CoverageRuleFunc func(context.Context, *ent.CoverageRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CoverageRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CoverageRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CoverageRuleMutation", m)
+}
+
+// The EmploymentFunc type is an adapter to allow the use of ordinary
+// function as Employment mutator.
+type EmploymentFunc func(context.Context, *ent.EmploymentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmploymentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmploymentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmploymentMutation", m)
+}
+
+// The HolidayFunc type is an adapter to allow the use of ordinary
+// function as Holiday mutator.
+type HolidayFunc func(context.Context, *ent.HolidayMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HolidayFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HolidayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HolidayMutation", m)
+}
+
+// The HolidayCalendarFunc type is an adapter to allow the use of ordinary
+// function as HolidayCalendar mutator.
+type HolidayCalendarFunc func(context.Context, *ent.HolidayCalendarMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HolidayCalendarFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HolidayCalendarMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HolidayCalendarMutation", m)
+}
+
 // The LeaveAllowanceFunc type is an adapter to allow the use of ordinary
 // function as LeaveAllowance mutator.
 type LeaveAllowanceFunc func(context.Context, *ent.LeaveAllowanceMutation) (ent.Value, error)
@@ -57,6 +141,54 @@ func (f LeaveAllowanceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaveAllowanceMutation", m)
 }
 
+// The LeaveAmendmentFunc type is an adapter to allow the use of ordinary
+// function as LeaveAmendment mutator.
+type LeaveAmendmentFunc func(context.Context, *ent.LeaveAmendmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeaveAmendmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeaveAmendmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaveAmendmentMutation", m)
+}
+
+// The LeaveAttachmentFunc type is an adapter to allow the use of ordinary
+// function as LeaveAttachment mutator.
+type LeaveAttachmentFunc func(context.Context, *ent.LeaveAttachmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeaveAttachmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeaveAttachmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaveAttachmentMutation", m)
+}
+
+// The LeaveCommentFunc type is an adapter to allow the use of ordinary
+// function as LeaveComment mutator.
+type LeaveCommentFunc func(context.Context, *ent.LeaveCommentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeaveCommentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeaveCommentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaveCommentMutation", m)
+}
+
+// The LeavePolicyFunc type is an adapter to allow the use of ordinary
+// function as LeavePolicy mutator.
+type LeavePolicyFunc func(context.Context, *ent.LeavePolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LeavePolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LeavePolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeavePolicyMutation", m)
+}
+
 // The LeaveRequestFunc type is an adapter to allow the use of ordinary
 // function as LeaveRequest mutator.
 type LeaveRequestFunc func(context.Context, *ent.LeaveRequestMutation) (ent.Value, error)
@@ -69,6 +201,78 @@ func (f LeaveRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LeaveRequestMutation", m)
 }
 
+// The OutboxMessageFunc type is an adapter to allow the use of ordinary
+// function as OutboxMessage mutator.
+type OutboxMessageFunc func(context.Context, *ent.OutboxMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OutboxMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OutboxMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OutboxMessageMutation", m)
+}
+
+// The ProcessedEventFunc type is an adapter to allow the use of ordinary
+// function as ProcessedEvent mutator.
+type ProcessedEventFunc func(context.Context, *ent.ProcessedEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProcessedEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProcessedEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProcessedEventMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *ent.WebhookDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookDeliveryMutation", m)
+}
+
+// The WebhookSubscriptionFunc type is an adapter to allow the use of ordinary
+// function as WebhookSubscription mutator.
+type WebhookSubscriptionFunc func(context.Context, *ent.WebhookSubscriptionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookSubscriptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WebhookSubscriptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookSubscriptionMutation", m)
+}
+
+// The WorkScheduleFunc type is an adapter to allow the use of ordinary
+// function as WorkSchedule mutator.
+type WorkScheduleFunc func(context.Context, *ent.WorkScheduleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WorkScheduleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WorkScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WorkScheduleMutation", m)
+}
+
+// The WorkScheduleAssignmentFunc type is an adapter to allow the use of ordinary
+// function as WorkScheduleAssignment mutator.
+type WorkScheduleAssignmentFunc func(context.Context, *ent.WorkScheduleAssignmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WorkScheduleAssignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WorkScheduleAssignmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WorkScheduleAssignmentMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
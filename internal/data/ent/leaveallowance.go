@@ -44,10 +44,20 @@ type LeaveAllowance struct {
 	Year int `json:"year,omitempty"`
 	// Total allowed days (supports half-days)
 	TotalDays float64 `json:"total_days,omitempty"`
+	// Full-year entitlement total_days was pro-rated from; unset when not pro-rated
+	AnnualDays *float64 `json:"annual_days,omitempty"`
 	// Consumed days
 	UsedDays float64 `json:"used_days,omitempty"`
 	// Carried from previous year
 	CarriedOver float64 `json:"carried_over,omitempty"`
+	// Last day carried-over days can be used; unused carried-over days lapse afterwards
+	CarryOverExpiry *time.Time `json:"carry_over_expiry,omitempty"`
+	// Days a leaver used beyond their pro-rated entitlement, to be settled in final pay
+	OverconsumedDays float64 `json:"overconsumed_days,omitempty"`
+	// Days posted by accrual so far (included in total_days)
+	AccruedDays float64 `json:"accrued_days,omitempty"`
+	// Date the user starts accruing; defaults to 1 January of the year
+	AccrualStart *time.Time `json:"accrual_start,omitempty"`
 	// Notes
 	Notes string `json:"notes,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -94,13 +104,13 @@ func (*LeaveAllowance) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case leaveallowance.FieldTotalDays, leaveallowance.FieldUsedDays, leaveallowance.FieldCarriedOver:
+		case leaveallowance.FieldTotalDays, leaveallowance.FieldAnnualDays, leaveallowance.FieldUsedDays, leaveallowance.FieldCarriedOver, leaveallowance.FieldOverconsumedDays, leaveallowance.FieldAccruedDays:
 			values[i] = new(sql.NullFloat64)
 		case leaveallowance.FieldCreateBy, leaveallowance.FieldUpdateBy, leaveallowance.FieldTenantID, leaveallowance.FieldUserID, leaveallowance.FieldYear:
 			values[i] = new(sql.NullInt64)
 		case leaveallowance.FieldID, leaveallowance.FieldUserName, leaveallowance.FieldAbsenceTypeID, leaveallowance.FieldAllowancePoolID, leaveallowance.FieldNotes:
 			values[i] = new(sql.NullString)
-		case leaveallowance.FieldCreateTime, leaveallowance.FieldUpdateTime, leaveallowance.FieldDeleteTime:
+		case leaveallowance.FieldCreateTime, leaveallowance.FieldUpdateTime, leaveallowance.FieldDeleteTime, leaveallowance.FieldCarryOverExpiry, leaveallowance.FieldAccrualStart:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -203,6 +213,13 @@ func (_m *LeaveAllowance) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TotalDays = value.Float64
 			}
+		case leaveallowance.FieldAnnualDays:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field annual_days", values[i])
+			} else if value.Valid {
+				_m.AnnualDays = new(float64)
+				*_m.AnnualDays = value.Float64
+			}
 		case leaveallowance.FieldUsedDays:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field used_days", values[i])
@@ -215,6 +232,32 @@ func (_m *LeaveAllowance) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CarriedOver = value.Float64
 			}
+		case leaveallowance.FieldCarryOverExpiry:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field carry_over_expiry", values[i])
+			} else if value.Valid {
+				_m.CarryOverExpiry = new(time.Time)
+				*_m.CarryOverExpiry = value.Time
+			}
+		case leaveallowance.FieldOverconsumedDays:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field overconsumed_days", values[i])
+			} else if value.Valid {
+				_m.OverconsumedDays = value.Float64
+			}
+		case leaveallowance.FieldAccruedDays:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field accrued_days", values[i])
+			} else if value.Valid {
+				_m.AccruedDays = value.Float64
+			}
+		case leaveallowance.FieldAccrualStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accrual_start", values[i])
+			} else if value.Valid {
+				_m.AccrualStart = new(time.Time)
+				*_m.AccrualStart = value.Time
+			}
 		case leaveallowance.FieldNotes:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field notes", values[i])
@@ -319,12 +362,33 @@ func (_m *LeaveAllowance) String() string {
 	builder.WriteString("total_days=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TotalDays))
 	builder.WriteString(", ")
+	if v := _m.AnnualDays; v != nil {
+		builder.WriteString("annual_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("used_days=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UsedDays))
 	builder.WriteString(", ")
 	builder.WriteString("carried_over=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CarriedOver))
 	builder.WriteString(", ")
+	if v := _m.CarryOverExpiry; v != nil {
+		builder.WriteString("carry_over_expiry=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("overconsumed_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OverconsumedDays))
+	builder.WriteString(", ")
+	builder.WriteString("accrued_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccruedDays))
+	builder.WriteString(", ")
+	if v := _m.AccrualStart; v != nil {
+		builder.WriteString("accrual_start=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("notes=")
 	builder.WriteString(_m.Notes)
 	builder.WriteByte(')')
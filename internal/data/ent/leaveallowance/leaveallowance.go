@@ -37,10 +37,20 @@ const (
 	FieldYear = "year"
 	// FieldTotalDays holds the string denoting the total_days field in the database.
 	FieldTotalDays = "total_days"
+	// FieldAnnualDays holds the string denoting the annual_days field in the database.
+	FieldAnnualDays = "annual_days"
 	// FieldUsedDays holds the string denoting the used_days field in the database.
 	FieldUsedDays = "used_days"
 	// FieldCarriedOver holds the string denoting the carried_over field in the database.
 	FieldCarriedOver = "carried_over"
+	// FieldCarryOverExpiry holds the string denoting the carry_over_expiry field in the database.
+	FieldCarryOverExpiry = "carry_over_expiry"
+	// FieldOverconsumedDays holds the string denoting the overconsumed_days field in the database.
+	FieldOverconsumedDays = "overconsumed_days"
+	// FieldAccruedDays holds the string denoting the accrued_days field in the database.
+	FieldAccruedDays = "accrued_days"
+	// FieldAccrualStart holds the string denoting the accrual_start field in the database.
+	FieldAccrualStart = "accrual_start"
 	// FieldNotes holds the string denoting the notes field in the database.
 	FieldNotes = "notes"
 	// EdgeAbsenceType holds the string denoting the absence_type edge name in mutations.
@@ -80,8 +90,13 @@ var Columns = []string{
 	FieldAllowancePoolID,
 	FieldYear,
 	FieldTotalDays,
+	FieldAnnualDays,
 	FieldUsedDays,
 	FieldCarriedOver,
+	FieldCarryOverExpiry,
+	FieldOverconsumedDays,
+	FieldAccruedDays,
+	FieldAccrualStart,
 	FieldNotes,
 }
 
@@ -113,6 +128,10 @@ var (
 	DefaultUsedDays float64
 	// DefaultCarriedOver holds the default value on creation for the "carried_over" field.
 	DefaultCarriedOver float64
+	// DefaultOverconsumedDays holds the default value on creation for the "overconsumed_days" field.
+	DefaultOverconsumedDays float64
+	// DefaultAccruedDays holds the default value on creation for the "accrued_days" field.
+	DefaultAccruedDays float64
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -185,6 +204,11 @@ func ByTotalDays(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotalDays, opts...).ToFunc()
 }
 
+// ByAnnualDays orders the results by the annual_days field.
+func ByAnnualDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnnualDays, opts...).ToFunc()
+}
+
 // ByUsedDays orders the results by the used_days field.
 func ByUsedDays(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsedDays, opts...).ToFunc()
@@ -195,6 +219,26 @@ func ByCarriedOver(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCarriedOver, opts...).ToFunc()
 }
 
+// ByCarryOverExpiry orders the results by the carry_over_expiry field.
+func ByCarryOverExpiry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCarryOverExpiry, opts...).ToFunc()
+}
+
+// ByOverconsumedDays orders the results by the overconsumed_days field.
+func ByOverconsumedDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOverconsumedDays, opts...).ToFunc()
+}
+
+// ByAccruedDays orders the results by the accrued_days field.
+func ByAccruedDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccruedDays, opts...).ToFunc()
+}
+
+// ByAccrualStart orders the results by the accrual_start field.
+func ByAccrualStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccrualStart, opts...).ToFunc()
+}
+
 // ByNotes orders the results by the notes field.
 func ByNotes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNotes, opts...).ToFunc()
@@ -125,6 +125,11 @@ func TotalDays(v float64) predicate.LeaveAllowance {
 	return predicate.LeaveAllowance(sql.FieldEQ(FieldTotalDays, v))
 }
 
+// AnnualDays applies equality check predicate on the "annual_days" field. It's identical to AnnualDaysEQ.
+func AnnualDays(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldEQ(FieldAnnualDays, v))
+}
+
 // UsedDays applies equality check predicate on the "used_days" field. It's identical to UsedDaysEQ.
 func UsedDays(v float64) predicate.LeaveAllowance {
 	return predicate.LeaveAllowance(sql.FieldEQ(FieldUsedDays, v))
@@ -135,6 +140,26 @@ func CarriedOver(v float64) predicate.LeaveAllowance {
 	return predicate.LeaveAllowance(sql.FieldEQ(FieldCarriedOver, v))
 }
 
+// CarryOverExpiry applies equality check predicate on the "carry_over_expiry" field. It's identical to CarryOverExpiryEQ.
+func CarryOverExpiry(v time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldEQ(FieldCarryOverExpiry, v))
+}
+
+// OverconsumedDays applies equality check predicate on the "overconsumed_days" field. It's identical to OverconsumedDaysEQ.
+func OverconsumedDays(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldEQ(FieldOverconsumedDays, v))
+}
+
+// AccruedDays applies equality check predicate on the "accrued_days" field. It's identical to AccruedDaysEQ.
+func AccruedDays(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldEQ(FieldAccruedDays, v))
+}
+
+// AccrualStart applies equality check predicate on the "accrual_start" field. It's identical to AccrualStartEQ.
+func AccrualStart(v time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldEQ(FieldAccrualStart, v))
+}
+
 // Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
 func Notes(v string) predicate.LeaveAllowance {
 	return predicate.LeaveAllowance(sql.FieldEQ(FieldNotes, v))
@@ -785,6 +810,56 @@ func TotalDaysLTE(v float64) predicate.LeaveAllowance {
 	return predicate.LeaveAllowance(sql.FieldLTE(FieldTotalDays, v))
 }
 
+// AnnualDaysEQ applies the EQ predicate on the "annual_days" field.
+func AnnualDaysEQ(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldEQ(FieldAnnualDays, v))
+}
+
+// AnnualDaysNEQ applies the NEQ predicate on the "annual_days" field.
+func AnnualDaysNEQ(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldNEQ(FieldAnnualDays, v))
+}
+
+// AnnualDaysIn applies the In predicate on the "annual_days" field.
+func AnnualDaysIn(vs ...float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldIn(FieldAnnualDays, vs...))
+}
+
+// AnnualDaysNotIn applies the NotIn predicate on the "annual_days" field.
+func AnnualDaysNotIn(vs ...float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldNotIn(FieldAnnualDays, vs...))
+}
+
+// AnnualDaysGT applies the GT predicate on the "annual_days" field.
+func AnnualDaysGT(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldGT(FieldAnnualDays, v))
+}
+
+// AnnualDaysGTE applies the GTE predicate on the "annual_days" field.
+func AnnualDaysGTE(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldGTE(FieldAnnualDays, v))
+}
+
+// AnnualDaysLT applies the LT predicate on the "annual_days" field.
+func AnnualDaysLT(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldLT(FieldAnnualDays, v))
+}
+
+// AnnualDaysLTE applies the LTE predicate on the "annual_days" field.
+func AnnualDaysLTE(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldLTE(FieldAnnualDays, v))
+}
+
+// AnnualDaysIsNil applies the IsNil predicate on the "annual_days" field.
+func AnnualDaysIsNil() predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldIsNull(FieldAnnualDays))
+}
+
+// AnnualDaysNotNil applies the NotNil predicate on the "annual_days" field.
+func AnnualDaysNotNil() predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldNotNull(FieldAnnualDays))
+}
+
 // UsedDaysEQ applies the EQ predicate on the "used_days" field.
 func UsedDaysEQ(v float64) predicate.LeaveAllowance {
 	return predicate.LeaveAllowance(sql.FieldEQ(FieldUsedDays, v))
@@ -865,6 +940,186 @@ func CarriedOverLTE(v float64) predicate.LeaveAllowance {
 	return predicate.LeaveAllowance(sql.FieldLTE(FieldCarriedOver, v))
 }
 
+// CarryOverExpiryEQ applies the EQ predicate on the "carry_over_expiry" field.
+func CarryOverExpiryEQ(v time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldEQ(FieldCarryOverExpiry, v))
+}
+
+// CarryOverExpiryNEQ applies the NEQ predicate on the "carry_over_expiry" field.
+func CarryOverExpiryNEQ(v time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldNEQ(FieldCarryOverExpiry, v))
+}
+
+// CarryOverExpiryIn applies the In predicate on the "carry_over_expiry" field.
+func CarryOverExpiryIn(vs ...time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldIn(FieldCarryOverExpiry, vs...))
+}
+
+// CarryOverExpiryNotIn applies the NotIn predicate on the "carry_over_expiry" field.
+func CarryOverExpiryNotIn(vs ...time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldNotIn(FieldCarryOverExpiry, vs...))
+}
+
+// CarryOverExpiryGT applies the GT predicate on the "carry_over_expiry" field.
+func CarryOverExpiryGT(v time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldGT(FieldCarryOverExpiry, v))
+}
+
+// CarryOverExpiryGTE applies the GTE predicate on the "carry_over_expiry" field.
+func CarryOverExpiryGTE(v time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldGTE(FieldCarryOverExpiry, v))
+}
+
+// CarryOverExpiryLT applies the LT predicate on the "carry_over_expiry" field.
+func CarryOverExpiryLT(v time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldLT(FieldCarryOverExpiry, v))
+}
+
+// CarryOverExpiryLTE applies the LTE predicate on the "carry_over_expiry" field.
+func CarryOverExpiryLTE(v time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldLTE(FieldCarryOverExpiry, v))
+}
+
+// CarryOverExpiryIsNil applies the IsNil predicate on the "carry_over_expiry" field.
+func CarryOverExpiryIsNil() predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldIsNull(FieldCarryOverExpiry))
+}
+
+// CarryOverExpiryNotNil applies the NotNil predicate on the "carry_over_expiry" field.
+func CarryOverExpiryNotNil() predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldNotNull(FieldCarryOverExpiry))
+}
+
+// OverconsumedDaysEQ applies the EQ predicate on the "overconsumed_days" field.
+func OverconsumedDaysEQ(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldEQ(FieldOverconsumedDays, v))
+}
+
+// OverconsumedDaysNEQ applies the NEQ predicate on the "overconsumed_days" field.
+func OverconsumedDaysNEQ(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldNEQ(FieldOverconsumedDays, v))
+}
+
+// OverconsumedDaysIn applies the In predicate on the "overconsumed_days" field.
+func OverconsumedDaysIn(vs ...float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldIn(FieldOverconsumedDays, vs...))
+}
+
+// OverconsumedDaysNotIn applies the NotIn predicate on the "overconsumed_days" field.
+func OverconsumedDaysNotIn(vs ...float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldNotIn(FieldOverconsumedDays, vs...))
+}
+
+// OverconsumedDaysGT applies the GT predicate on the "overconsumed_days" field.
+func OverconsumedDaysGT(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldGT(FieldOverconsumedDays, v))
+}
+
+// OverconsumedDaysGTE applies the GTE predicate on the "overconsumed_days" field.
+func OverconsumedDaysGTE(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldGTE(FieldOverconsumedDays, v))
+}
+
+// OverconsumedDaysLT applies the LT predicate on the "overconsumed_days" field.
+func OverconsumedDaysLT(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldLT(FieldOverconsumedDays, v))
+}
+
+// OverconsumedDaysLTE applies the LTE predicate on the "overconsumed_days" field.
+func OverconsumedDaysLTE(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldLTE(FieldOverconsumedDays, v))
+}
+
+// AccruedDaysEQ applies the EQ predicate on the "accrued_days" field.
+func AccruedDaysEQ(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldEQ(FieldAccruedDays, v))
+}
+
+// AccruedDaysNEQ applies the NEQ predicate on the "accrued_days" field.
+func AccruedDaysNEQ(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldNEQ(FieldAccruedDays, v))
+}
+
+// AccruedDaysIn applies the In predicate on the "accrued_days" field.
+func AccruedDaysIn(vs ...float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldIn(FieldAccruedDays, vs...))
+}
+
+// AccruedDaysNotIn applies the NotIn predicate on the "accrued_days" field.
+func AccruedDaysNotIn(vs ...float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldNotIn(FieldAccruedDays, vs...))
+}
+
+// AccruedDaysGT applies the GT predicate on the "accrued_days" field.
+func AccruedDaysGT(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldGT(FieldAccruedDays, v))
+}
+
+// AccruedDaysGTE applies the GTE predicate on the "accrued_days" field.
+func AccruedDaysGTE(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldGTE(FieldAccruedDays, v))
+}
+
+// AccruedDaysLT applies the LT predicate on the "accrued_days" field.
+func AccruedDaysLT(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldLT(FieldAccruedDays, v))
+}
+
+// AccruedDaysLTE applies the LTE predicate on the "accrued_days" field.
+func AccruedDaysLTE(v float64) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldLTE(FieldAccruedDays, v))
+}
+
+// AccrualStartEQ applies the EQ predicate on the "accrual_start" field.
+func AccrualStartEQ(v time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldEQ(FieldAccrualStart, v))
+}
+
+// AccrualStartNEQ applies the NEQ predicate on the "accrual_start" field.
+func AccrualStartNEQ(v time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldNEQ(FieldAccrualStart, v))
+}
+
+// AccrualStartIn applies the In predicate on the "accrual_start" field.
+func AccrualStartIn(vs ...time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldIn(FieldAccrualStart, vs...))
+}
+
+// AccrualStartNotIn applies the NotIn predicate on the "accrual_start" field.
+func AccrualStartNotIn(vs ...time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldNotIn(FieldAccrualStart, vs...))
+}
+
+// AccrualStartGT applies the GT predicate on the "accrual_start" field.
+func AccrualStartGT(v time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldGT(FieldAccrualStart, v))
+}
+
+// AccrualStartGTE applies the GTE predicate on the "accrual_start" field.
+func AccrualStartGTE(v time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldGTE(FieldAccrualStart, v))
+}
+
+// AccrualStartLT applies the LT predicate on the "accrual_start" field.
+func AccrualStartLT(v time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldLT(FieldAccrualStart, v))
+}
+
+// AccrualStartLTE applies the LTE predicate on the "accrual_start" field.
+func AccrualStartLTE(v time.Time) predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldLTE(FieldAccrualStart, v))
+}
+
+// AccrualStartIsNil applies the IsNil predicate on the "accrual_start" field.
+func AccrualStartIsNil() predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldIsNull(FieldAccrualStart))
+}
+
+// AccrualStartNotNil applies the NotNil predicate on the "accrual_start" field.
+func AccrualStartNotNil() predicate.LeaveAllowance {
+	return predicate.LeaveAllowance(sql.FieldNotNull(FieldAccrualStart))
+}
+
 // NotesEQ applies the EQ predicate on the "notes" field.
 func NotesEQ(v string) predicate.LeaveAllowance {
 	return predicate.LeaveAllowance(sql.FieldEQ(FieldNotes, v))
@@ -169,6 +169,20 @@ func (_c *LeaveAllowanceCreate) SetTotalDays(v float64) *LeaveAllowanceCreate {
 	return _c
 }
 
+// SetAnnualDays sets the "annual_days" field.
+func (_c *LeaveAllowanceCreate) SetAnnualDays(v float64) *LeaveAllowanceCreate {
+	_c.mutation.SetAnnualDays(v)
+	return _c
+}
+
+// SetNillableAnnualDays sets the "annual_days" field if the given value is not nil.
+func (_c *LeaveAllowanceCreate) SetNillableAnnualDays(v *float64) *LeaveAllowanceCreate {
+	if v != nil {
+		_c.SetAnnualDays(*v)
+	}
+	return _c
+}
+
 // SetUsedDays sets the "used_days" field.
 func (_c *LeaveAllowanceCreate) SetUsedDays(v float64) *LeaveAllowanceCreate {
 	_c.mutation.SetUsedDays(v)
@@ -197,6 +211,62 @@ func (_c *LeaveAllowanceCreate) SetNillableCarriedOver(v *float64) *LeaveAllowan
 	return _c
 }
 
+// SetCarryOverExpiry sets the "carry_over_expiry" field.
+func (_c *LeaveAllowanceCreate) SetCarryOverExpiry(v time.Time) *LeaveAllowanceCreate {
+	_c.mutation.SetCarryOverExpiry(v)
+	return _c
+}
+
+// SetNillableCarryOverExpiry sets the "carry_over_expiry" field if the given value is not nil.
+func (_c *LeaveAllowanceCreate) SetNillableCarryOverExpiry(v *time.Time) *LeaveAllowanceCreate {
+	if v != nil {
+		_c.SetCarryOverExpiry(*v)
+	}
+	return _c
+}
+
+// SetOverconsumedDays sets the "overconsumed_days" field.
+func (_c *LeaveAllowanceCreate) SetOverconsumedDays(v float64) *LeaveAllowanceCreate {
+	_c.mutation.SetOverconsumedDays(v)
+	return _c
+}
+
+// SetNillableOverconsumedDays sets the "overconsumed_days" field if the given value is not nil.
+func (_c *LeaveAllowanceCreate) SetNillableOverconsumedDays(v *float64) *LeaveAllowanceCreate {
+	if v != nil {
+		_c.SetOverconsumedDays(*v)
+	}
+	return _c
+}
+
+// SetAccruedDays sets the "accrued_days" field.
+func (_c *LeaveAllowanceCreate) SetAccruedDays(v float64) *LeaveAllowanceCreate {
+	_c.mutation.SetAccruedDays(v)
+	return _c
+}
+
+// SetNillableAccruedDays sets the "accrued_days" field if the given value is not nil.
+func (_c *LeaveAllowanceCreate) SetNillableAccruedDays(v *float64) *LeaveAllowanceCreate {
+	if v != nil {
+		_c.SetAccruedDays(*v)
+	}
+	return _c
+}
+
+// SetAccrualStart sets the "accrual_start" field.
+func (_c *LeaveAllowanceCreate) SetAccrualStart(v time.Time) *LeaveAllowanceCreate {
+	_c.mutation.SetAccrualStart(v)
+	return _c
+}
+
+// SetNillableAccrualStart sets the "accrual_start" field if the given value is not nil.
+func (_c *LeaveAllowanceCreate) SetNillableAccrualStart(v *time.Time) *LeaveAllowanceCreate {
+	if v != nil {
+		_c.SetAccrualStart(*v)
+	}
+	return _c
+}
+
 // SetNotes sets the "notes" field.
 func (_c *LeaveAllowanceCreate) SetNotes(v string) *LeaveAllowanceCreate {
 	_c.mutation.SetNotes(v)
@@ -280,6 +350,14 @@ func (_c *LeaveAllowanceCreate) defaults() error {
 		v := leaveallowance.DefaultCarriedOver
 		_c.mutation.SetCarriedOver(v)
 	}
+	if _, ok := _c.mutation.OverconsumedDays(); !ok {
+		v := leaveallowance.DefaultOverconsumedDays
+		_c.mutation.SetOverconsumedDays(v)
+	}
+	if _, ok := _c.mutation.AccruedDays(); !ok {
+		v := leaveallowance.DefaultAccruedDays
+		_c.mutation.SetAccruedDays(v)
+	}
 	return nil
 }
 
@@ -305,6 +383,12 @@ func (_c *LeaveAllowanceCreate) check() error {
 	if _, ok := _c.mutation.CarriedOver(); !ok {
 		return &ValidationError{Name: "carried_over", err: errors.New(`ent: missing required field "LeaveAllowance.carried_over"`)}
 	}
+	if _, ok := _c.mutation.OverconsumedDays(); !ok {
+		return &ValidationError{Name: "overconsumed_days", err: errors.New(`ent: missing required field "LeaveAllowance.overconsumed_days"`)}
+	}
+	if _, ok := _c.mutation.AccruedDays(); !ok {
+		return &ValidationError{Name: "accrued_days", err: errors.New(`ent: missing required field "LeaveAllowance.accrued_days"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := leaveallowance.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LeaveAllowance.id": %w`, err)}
@@ -386,6 +470,10 @@ func (_c *LeaveAllowanceCreate) createSpec() (*LeaveAllowance, *sqlgraph.CreateS
 		_spec.SetField(leaveallowance.FieldTotalDays, field.TypeFloat64, value)
 		_node.TotalDays = value
 	}
+	if value, ok := _c.mutation.AnnualDays(); ok {
+		_spec.SetField(leaveallowance.FieldAnnualDays, field.TypeFloat64, value)
+		_node.AnnualDays = &value
+	}
 	if value, ok := _c.mutation.UsedDays(); ok {
 		_spec.SetField(leaveallowance.FieldUsedDays, field.TypeFloat64, value)
 		_node.UsedDays = value
@@ -394,6 +482,22 @@ func (_c *LeaveAllowanceCreate) createSpec() (*LeaveAllowance, *sqlgraph.CreateS
 		_spec.SetField(leaveallowance.FieldCarriedOver, field.TypeFloat64, value)
 		_node.CarriedOver = value
 	}
+	if value, ok := _c.mutation.CarryOverExpiry(); ok {
+		_spec.SetField(leaveallowance.FieldCarryOverExpiry, field.TypeTime, value)
+		_node.CarryOverExpiry = &value
+	}
+	if value, ok := _c.mutation.OverconsumedDays(); ok {
+		_spec.SetField(leaveallowance.FieldOverconsumedDays, field.TypeFloat64, value)
+		_node.OverconsumedDays = value
+	}
+	if value, ok := _c.mutation.AccruedDays(); ok {
+		_spec.SetField(leaveallowance.FieldAccruedDays, field.TypeFloat64, value)
+		_node.AccruedDays = value
+	}
+	if value, ok := _c.mutation.AccrualStart(); ok {
+		_spec.SetField(leaveallowance.FieldAccrualStart, field.TypeTime, value)
+		_node.AccrualStart = &value
+	}
 	if value, ok := _c.mutation.Notes(); ok {
 		_spec.SetField(leaveallowance.FieldNotes, field.TypeString, value)
 		_node.Notes = value
@@ -676,6 +780,30 @@ func (u *LeaveAllowanceUpsert) AddTotalDays(v float64) *LeaveAllowanceUpsert {
 	return u
 }
 
+// SetAnnualDays sets the "annual_days" field.
+func (u *LeaveAllowanceUpsert) SetAnnualDays(v float64) *LeaveAllowanceUpsert {
+	u.Set(leaveallowance.FieldAnnualDays, v)
+	return u
+}
+
+// UpdateAnnualDays sets the "annual_days" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsert) UpdateAnnualDays() *LeaveAllowanceUpsert {
+	u.SetExcluded(leaveallowance.FieldAnnualDays)
+	return u
+}
+
+// AddAnnualDays adds v to the "annual_days" field.
+func (u *LeaveAllowanceUpsert) AddAnnualDays(v float64) *LeaveAllowanceUpsert {
+	u.Add(leaveallowance.FieldAnnualDays, v)
+	return u
+}
+
+// ClearAnnualDays clears the value of the "annual_days" field.
+func (u *LeaveAllowanceUpsert) ClearAnnualDays() *LeaveAllowanceUpsert {
+	u.SetNull(leaveallowance.FieldAnnualDays)
+	return u
+}
+
 // SetUsedDays sets the "used_days" field.
 func (u *LeaveAllowanceUpsert) SetUsedDays(v float64) *LeaveAllowanceUpsert {
 	u.Set(leaveallowance.FieldUsedDays, v)
@@ -712,6 +840,78 @@ func (u *LeaveAllowanceUpsert) AddCarriedOver(v float64) *LeaveAllowanceUpsert {
 	return u
 }
 
+// SetCarryOverExpiry sets the "carry_over_expiry" field.
+func (u *LeaveAllowanceUpsert) SetCarryOverExpiry(v time.Time) *LeaveAllowanceUpsert {
+	u.Set(leaveallowance.FieldCarryOverExpiry, v)
+	return u
+}
+
+// UpdateCarryOverExpiry sets the "carry_over_expiry" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsert) UpdateCarryOverExpiry() *LeaveAllowanceUpsert {
+	u.SetExcluded(leaveallowance.FieldCarryOverExpiry)
+	return u
+}
+
+// ClearCarryOverExpiry clears the value of the "carry_over_expiry" field.
+func (u *LeaveAllowanceUpsert) ClearCarryOverExpiry() *LeaveAllowanceUpsert {
+	u.SetNull(leaveallowance.FieldCarryOverExpiry)
+	return u
+}
+
+// SetOverconsumedDays sets the "overconsumed_days" field.
+func (u *LeaveAllowanceUpsert) SetOverconsumedDays(v float64) *LeaveAllowanceUpsert {
+	u.Set(leaveallowance.FieldOverconsumedDays, v)
+	return u
+}
+
+// UpdateOverconsumedDays sets the "overconsumed_days" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsert) UpdateOverconsumedDays() *LeaveAllowanceUpsert {
+	u.SetExcluded(leaveallowance.FieldOverconsumedDays)
+	return u
+}
+
+// AddOverconsumedDays adds v to the "overconsumed_days" field.
+func (u *LeaveAllowanceUpsert) AddOverconsumedDays(v float64) *LeaveAllowanceUpsert {
+	u.Add(leaveallowance.FieldOverconsumedDays, v)
+	return u
+}
+
+// SetAccruedDays sets the "accrued_days" field.
+func (u *LeaveAllowanceUpsert) SetAccruedDays(v float64) *LeaveAllowanceUpsert {
+	u.Set(leaveallowance.FieldAccruedDays, v)
+	return u
+}
+
+// UpdateAccruedDays sets the "accrued_days" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsert) UpdateAccruedDays() *LeaveAllowanceUpsert {
+	u.SetExcluded(leaveallowance.FieldAccruedDays)
+	return u
+}
+
+// AddAccruedDays adds v to the "accrued_days" field.
+func (u *LeaveAllowanceUpsert) AddAccruedDays(v float64) *LeaveAllowanceUpsert {
+	u.Add(leaveallowance.FieldAccruedDays, v)
+	return u
+}
+
+// SetAccrualStart sets the "accrual_start" field.
+func (u *LeaveAllowanceUpsert) SetAccrualStart(v time.Time) *LeaveAllowanceUpsert {
+	u.Set(leaveallowance.FieldAccrualStart, v)
+	return u
+}
+
+// UpdateAccrualStart sets the "accrual_start" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsert) UpdateAccrualStart() *LeaveAllowanceUpsert {
+	u.SetExcluded(leaveallowance.FieldAccrualStart)
+	return u
+}
+
+// ClearAccrualStart clears the value of the "accrual_start" field.
+func (u *LeaveAllowanceUpsert) ClearAccrualStart() *LeaveAllowanceUpsert {
+	u.SetNull(leaveallowance.FieldAccrualStart)
+	return u
+}
+
 // SetNotes sets the "notes" field.
 func (u *LeaveAllowanceUpsert) SetNotes(v string) *LeaveAllowanceUpsert {
 	u.Set(leaveallowance.FieldNotes, v)
@@ -1008,6 +1208,34 @@ func (u *LeaveAllowanceUpsertOne) UpdateTotalDays() *LeaveAllowanceUpsertOne {
 	})
 }
 
+// SetAnnualDays sets the "annual_days" field.
+func (u *LeaveAllowanceUpsertOne) SetAnnualDays(v float64) *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.SetAnnualDays(v)
+	})
+}
+
+// AddAnnualDays adds v to the "annual_days" field.
+func (u *LeaveAllowanceUpsertOne) AddAnnualDays(v float64) *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.AddAnnualDays(v)
+	})
+}
+
+// UpdateAnnualDays sets the "annual_days" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsertOne) UpdateAnnualDays() *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.UpdateAnnualDays()
+	})
+}
+
+// ClearAnnualDays clears the value of the "annual_days" field.
+func (u *LeaveAllowanceUpsertOne) ClearAnnualDays() *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.ClearAnnualDays()
+	})
+}
+
 // SetUsedDays sets the "used_days" field.
 func (u *LeaveAllowanceUpsertOne) SetUsedDays(v float64) *LeaveAllowanceUpsertOne {
 	return u.Update(func(s *LeaveAllowanceUpsert) {
@@ -1050,6 +1278,90 @@ func (u *LeaveAllowanceUpsertOne) UpdateCarriedOver() *LeaveAllowanceUpsertOne {
 	})
 }
 
+// SetCarryOverExpiry sets the "carry_over_expiry" field.
+func (u *LeaveAllowanceUpsertOne) SetCarryOverExpiry(v time.Time) *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.SetCarryOverExpiry(v)
+	})
+}
+
+// UpdateCarryOverExpiry sets the "carry_over_expiry" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsertOne) UpdateCarryOverExpiry() *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.UpdateCarryOverExpiry()
+	})
+}
+
+// ClearCarryOverExpiry clears the value of the "carry_over_expiry" field.
+func (u *LeaveAllowanceUpsertOne) ClearCarryOverExpiry() *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.ClearCarryOverExpiry()
+	})
+}
+
+// SetOverconsumedDays sets the "overconsumed_days" field.
+func (u *LeaveAllowanceUpsertOne) SetOverconsumedDays(v float64) *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.SetOverconsumedDays(v)
+	})
+}
+
+// AddOverconsumedDays adds v to the "overconsumed_days" field.
+func (u *LeaveAllowanceUpsertOne) AddOverconsumedDays(v float64) *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.AddOverconsumedDays(v)
+	})
+}
+
+// UpdateOverconsumedDays sets the "overconsumed_days" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsertOne) UpdateOverconsumedDays() *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.UpdateOverconsumedDays()
+	})
+}
+
+// SetAccruedDays sets the "accrued_days" field.
+func (u *LeaveAllowanceUpsertOne) SetAccruedDays(v float64) *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.SetAccruedDays(v)
+	})
+}
+
+// AddAccruedDays adds v to the "accrued_days" field.
+func (u *LeaveAllowanceUpsertOne) AddAccruedDays(v float64) *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.AddAccruedDays(v)
+	})
+}
+
+// UpdateAccruedDays sets the "accrued_days" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsertOne) UpdateAccruedDays() *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.UpdateAccruedDays()
+	})
+}
+
+// SetAccrualStart sets the "accrual_start" field.
+func (u *LeaveAllowanceUpsertOne) SetAccrualStart(v time.Time) *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.SetAccrualStart(v)
+	})
+}
+
+// UpdateAccrualStart sets the "accrual_start" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsertOne) UpdateAccrualStart() *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.UpdateAccrualStart()
+	})
+}
+
+// ClearAccrualStart clears the value of the "accrual_start" field.
+func (u *LeaveAllowanceUpsertOne) ClearAccrualStart() *LeaveAllowanceUpsertOne {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.ClearAccrualStart()
+	})
+}
+
 // SetNotes sets the "notes" field.
 func (u *LeaveAllowanceUpsertOne) SetNotes(v string) *LeaveAllowanceUpsertOne {
 	return u.Update(func(s *LeaveAllowanceUpsert) {
@@ -1516,6 +1828,34 @@ func (u *LeaveAllowanceUpsertBulk) UpdateTotalDays() *LeaveAllowanceUpsertBulk {
 	})
 }
 
+// SetAnnualDays sets the "annual_days" field.
+func (u *LeaveAllowanceUpsertBulk) SetAnnualDays(v float64) *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.SetAnnualDays(v)
+	})
+}
+
+// AddAnnualDays adds v to the "annual_days" field.
+func (u *LeaveAllowanceUpsertBulk) AddAnnualDays(v float64) *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.AddAnnualDays(v)
+	})
+}
+
+// UpdateAnnualDays sets the "annual_days" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsertBulk) UpdateAnnualDays() *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.UpdateAnnualDays()
+	})
+}
+
+// ClearAnnualDays clears the value of the "annual_days" field.
+func (u *LeaveAllowanceUpsertBulk) ClearAnnualDays() *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.ClearAnnualDays()
+	})
+}
+
 // SetUsedDays sets the "used_days" field.
 func (u *LeaveAllowanceUpsertBulk) SetUsedDays(v float64) *LeaveAllowanceUpsertBulk {
 	return u.Update(func(s *LeaveAllowanceUpsert) {
@@ -1558,6 +1898,90 @@ func (u *LeaveAllowanceUpsertBulk) UpdateCarriedOver() *LeaveAllowanceUpsertBulk
 	})
 }
 
+// SetCarryOverExpiry sets the "carry_over_expiry" field.
+func (u *LeaveAllowanceUpsertBulk) SetCarryOverExpiry(v time.Time) *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.SetCarryOverExpiry(v)
+	})
+}
+
+// UpdateCarryOverExpiry sets the "carry_over_expiry" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsertBulk) UpdateCarryOverExpiry() *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.UpdateCarryOverExpiry()
+	})
+}
+
+// ClearCarryOverExpiry clears the value of the "carry_over_expiry" field.
+func (u *LeaveAllowanceUpsertBulk) ClearCarryOverExpiry() *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.ClearCarryOverExpiry()
+	})
+}
+
+// SetOverconsumedDays sets the "overconsumed_days" field.
+func (u *LeaveAllowanceUpsertBulk) SetOverconsumedDays(v float64) *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.SetOverconsumedDays(v)
+	})
+}
+
+// AddOverconsumedDays adds v to the "overconsumed_days" field.
+func (u *LeaveAllowanceUpsertBulk) AddOverconsumedDays(v float64) *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.AddOverconsumedDays(v)
+	})
+}
+
+// UpdateOverconsumedDays sets the "overconsumed_days" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsertBulk) UpdateOverconsumedDays() *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.UpdateOverconsumedDays()
+	})
+}
+
+// SetAccruedDays sets the "accrued_days" field.
+func (u *LeaveAllowanceUpsertBulk) SetAccruedDays(v float64) *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.SetAccruedDays(v)
+	})
+}
+
+// AddAccruedDays adds v to the "accrued_days" field.
+func (u *LeaveAllowanceUpsertBulk) AddAccruedDays(v float64) *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.AddAccruedDays(v)
+	})
+}
+
+// UpdateAccruedDays sets the "accrued_days" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsertBulk) UpdateAccruedDays() *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.UpdateAccruedDays()
+	})
+}
+
+// SetAccrualStart sets the "accrual_start" field.
+func (u *LeaveAllowanceUpsertBulk) SetAccrualStart(v time.Time) *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.SetAccrualStart(v)
+	})
+}
+
+// UpdateAccrualStart sets the "accrual_start" field to the value that was provided on create.
+func (u *LeaveAllowanceUpsertBulk) UpdateAccrualStart() *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.UpdateAccrualStart()
+	})
+}
+
+// ClearAccrualStart clears the value of the "accrual_start" field.
+func (u *LeaveAllowanceUpsertBulk) ClearAccrualStart() *LeaveAllowanceUpsertBulk {
+	return u.Update(func(s *LeaveAllowanceUpsert) {
+		s.ClearAccrualStart()
+	})
+}
+
 // SetNotes sets the "notes" field.
 func (u *LeaveAllowanceUpsertBulk) SetNotes(v string) *LeaveAllowanceUpsertBulk {
 	return u.Update(func(s *LeaveAllowanceUpsert) {
@@ -248,6 +248,33 @@ func (_u *LeaveAllowanceUpdate) AddTotalDays(v float64) *LeaveAllowanceUpdate {
 	return _u
 }
 
+// SetAnnualDays sets the "annual_days" field.
+func (_u *LeaveAllowanceUpdate) SetAnnualDays(v float64) *LeaveAllowanceUpdate {
+	_u.mutation.ResetAnnualDays()
+	_u.mutation.SetAnnualDays(v)
+	return _u
+}
+
+// SetNillableAnnualDays sets the "annual_days" field if the given value is not nil.
+func (_u *LeaveAllowanceUpdate) SetNillableAnnualDays(v *float64) *LeaveAllowanceUpdate {
+	if v != nil {
+		_u.SetAnnualDays(*v)
+	}
+	return _u
+}
+
+// AddAnnualDays adds value to the "annual_days" field.
+func (_u *LeaveAllowanceUpdate) AddAnnualDays(v float64) *LeaveAllowanceUpdate {
+	_u.mutation.AddAnnualDays(v)
+	return _u
+}
+
+// ClearAnnualDays clears the value of the "annual_days" field.
+func (_u *LeaveAllowanceUpdate) ClearAnnualDays() *LeaveAllowanceUpdate {
+	_u.mutation.ClearAnnualDays()
+	return _u
+}
+
 // SetUsedDays sets the "used_days" field.
 func (_u *LeaveAllowanceUpdate) SetUsedDays(v float64) *LeaveAllowanceUpdate {
 	_u.mutation.ResetUsedDays()
@@ -290,6 +317,88 @@ func (_u *LeaveAllowanceUpdate) AddCarriedOver(v float64) *LeaveAllowanceUpdate
 	return _u
 }
 
+// SetCarryOverExpiry sets the "carry_over_expiry" field.
+func (_u *LeaveAllowanceUpdate) SetCarryOverExpiry(v time.Time) *LeaveAllowanceUpdate {
+	_u.mutation.SetCarryOverExpiry(v)
+	return _u
+}
+
+// SetNillableCarryOverExpiry sets the "carry_over_expiry" field if the given value is not nil.
+func (_u *LeaveAllowanceUpdate) SetNillableCarryOverExpiry(v *time.Time) *LeaveAllowanceUpdate {
+	if v != nil {
+		_u.SetCarryOverExpiry(*v)
+	}
+	return _u
+}
+
+// ClearCarryOverExpiry clears the value of the "carry_over_expiry" field.
+func (_u *LeaveAllowanceUpdate) ClearCarryOverExpiry() *LeaveAllowanceUpdate {
+	_u.mutation.ClearCarryOverExpiry()
+	return _u
+}
+
+// SetOverconsumedDays sets the "overconsumed_days" field.
+func (_u *LeaveAllowanceUpdate) SetOverconsumedDays(v float64) *LeaveAllowanceUpdate {
+	_u.mutation.ResetOverconsumedDays()
+	_u.mutation.SetOverconsumedDays(v)
+	return _u
+}
+
+// SetNillableOverconsumedDays sets the "overconsumed_days" field if the given value is not nil.
+func (_u *LeaveAllowanceUpdate) SetNillableOverconsumedDays(v *float64) *LeaveAllowanceUpdate {
+	if v != nil {
+		_u.SetOverconsumedDays(*v)
+	}
+	return _u
+}
+
+// AddOverconsumedDays adds value to the "overconsumed_days" field.
+func (_u *LeaveAllowanceUpdate) AddOverconsumedDays(v float64) *LeaveAllowanceUpdate {
+	_u.mutation.AddOverconsumedDays(v)
+	return _u
+}
+
+// SetAccruedDays sets the "accrued_days" field.
+func (_u *LeaveAllowanceUpdate) SetAccruedDays(v float64) *LeaveAllowanceUpdate {
+	_u.mutation.ResetAccruedDays()
+	_u.mutation.SetAccruedDays(v)
+	return _u
+}
+
+// SetNillableAccruedDays sets the "accrued_days" field if the given value is not nil.
+func (_u *LeaveAllowanceUpdate) SetNillableAccruedDays(v *float64) *LeaveAllowanceUpdate {
+	if v != nil {
+		_u.SetAccruedDays(*v)
+	}
+	return _u
+}
+
+// AddAccruedDays adds value to the "accrued_days" field.
+func (_u *LeaveAllowanceUpdate) AddAccruedDays(v float64) *LeaveAllowanceUpdate {
+	_u.mutation.AddAccruedDays(v)
+	return _u
+}
+
+// SetAccrualStart sets the "accrual_start" field.
+func (_u *LeaveAllowanceUpdate) SetAccrualStart(v time.Time) *LeaveAllowanceUpdate {
+	_u.mutation.SetAccrualStart(v)
+	return _u
+}
+
+// SetNillableAccrualStart sets the "accrual_start" field if the given value is not nil.
+func (_u *LeaveAllowanceUpdate) SetNillableAccrualStart(v *time.Time) *LeaveAllowanceUpdate {
+	if v != nil {
+		_u.SetAccrualStart(*v)
+	}
+	return _u
+}
+
+// ClearAccrualStart clears the value of the "accrual_start" field.
+func (_u *LeaveAllowanceUpdate) ClearAccrualStart() *LeaveAllowanceUpdate {
+	_u.mutation.ClearAccrualStart()
+	return _u
+}
+
 // SetNotes sets the "notes" field.
 func (_u *LeaveAllowanceUpdate) SetNotes(v string) *LeaveAllowanceUpdate {
 	_u.mutation.SetNotes(v)
@@ -452,6 +561,15 @@ func (_u *LeaveAllowanceUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.AddedTotalDays(); ok {
 		_spec.AddField(leaveallowance.FieldTotalDays, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.AnnualDays(); ok {
+		_spec.SetField(leaveallowance.FieldAnnualDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAnnualDays(); ok {
+		_spec.AddField(leaveallowance.FieldAnnualDays, field.TypeFloat64, value)
+	}
+	if _u.mutation.AnnualDaysCleared() {
+		_spec.ClearField(leaveallowance.FieldAnnualDays, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.UsedDays(); ok {
 		_spec.SetField(leaveallowance.FieldUsedDays, field.TypeFloat64, value)
 	}
@@ -464,6 +582,30 @@ func (_u *LeaveAllowanceUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.AddedCarriedOver(); ok {
 		_spec.AddField(leaveallowance.FieldCarriedOver, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.CarryOverExpiry(); ok {
+		_spec.SetField(leaveallowance.FieldCarryOverExpiry, field.TypeTime, value)
+	}
+	if _u.mutation.CarryOverExpiryCleared() {
+		_spec.ClearField(leaveallowance.FieldCarryOverExpiry, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OverconsumedDays(); ok {
+		_spec.SetField(leaveallowance.FieldOverconsumedDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOverconsumedDays(); ok {
+		_spec.AddField(leaveallowance.FieldOverconsumedDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AccruedDays(); ok {
+		_spec.SetField(leaveallowance.FieldAccruedDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAccruedDays(); ok {
+		_spec.AddField(leaveallowance.FieldAccruedDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AccrualStart(); ok {
+		_spec.SetField(leaveallowance.FieldAccrualStart, field.TypeTime, value)
+	}
+	if _u.mutation.AccrualStartCleared() {
+		_spec.ClearField(leaveallowance.FieldAccrualStart, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Notes(); ok {
 		_spec.SetField(leaveallowance.FieldNotes, field.TypeString, value)
 	}
@@ -767,6 +909,33 @@ func (_u *LeaveAllowanceUpdateOne) AddTotalDays(v float64) *LeaveAllowanceUpdate
 	return _u
 }
 
+// SetAnnualDays sets the "annual_days" field.
+func (_u *LeaveAllowanceUpdateOne) SetAnnualDays(v float64) *LeaveAllowanceUpdateOne {
+	_u.mutation.ResetAnnualDays()
+	_u.mutation.SetAnnualDays(v)
+	return _u
+}
+
+// SetNillableAnnualDays sets the "annual_days" field if the given value is not nil.
+func (_u *LeaveAllowanceUpdateOne) SetNillableAnnualDays(v *float64) *LeaveAllowanceUpdateOne {
+	if v != nil {
+		_u.SetAnnualDays(*v)
+	}
+	return _u
+}
+
+// AddAnnualDays adds value to the "annual_days" field.
+func (_u *LeaveAllowanceUpdateOne) AddAnnualDays(v float64) *LeaveAllowanceUpdateOne {
+	_u.mutation.AddAnnualDays(v)
+	return _u
+}
+
+// ClearAnnualDays clears the value of the "annual_days" field.
+func (_u *LeaveAllowanceUpdateOne) ClearAnnualDays() *LeaveAllowanceUpdateOne {
+	_u.mutation.ClearAnnualDays()
+	return _u
+}
+
 // SetUsedDays sets the "used_days" field.
 func (_u *LeaveAllowanceUpdateOne) SetUsedDays(v float64) *LeaveAllowanceUpdateOne {
 	_u.mutation.ResetUsedDays()
@@ -809,6 +978,88 @@ func (_u *LeaveAllowanceUpdateOne) AddCarriedOver(v float64) *LeaveAllowanceUpda
 	return _u
 }
 
+// SetCarryOverExpiry sets the "carry_over_expiry" field.
+func (_u *LeaveAllowanceUpdateOne) SetCarryOverExpiry(v time.Time) *LeaveAllowanceUpdateOne {
+	_u.mutation.SetCarryOverExpiry(v)
+	return _u
+}
+
+// SetNillableCarryOverExpiry sets the "carry_over_expiry" field if the given value is not nil.
+func (_u *LeaveAllowanceUpdateOne) SetNillableCarryOverExpiry(v *time.Time) *LeaveAllowanceUpdateOne {
+	if v != nil {
+		_u.SetCarryOverExpiry(*v)
+	}
+	return _u
+}
+
+// ClearCarryOverExpiry clears the value of the "carry_over_expiry" field.
+func (_u *LeaveAllowanceUpdateOne) ClearCarryOverExpiry() *LeaveAllowanceUpdateOne {
+	_u.mutation.ClearCarryOverExpiry()
+	return _u
+}
+
+// SetOverconsumedDays sets the "overconsumed_days" field.
+func (_u *LeaveAllowanceUpdateOne) SetOverconsumedDays(v float64) *LeaveAllowanceUpdateOne {
+	_u.mutation.ResetOverconsumedDays()
+	_u.mutation.SetOverconsumedDays(v)
+	return _u
+}
+
+// SetNillableOverconsumedDays sets the "overconsumed_days" field if the given value is not nil.
+func (_u *LeaveAllowanceUpdateOne) SetNillableOverconsumedDays(v *float64) *LeaveAllowanceUpdateOne {
+	if v != nil {
+		_u.SetOverconsumedDays(*v)
+	}
+	return _u
+}
+
+// AddOverconsumedDays adds value to the "overconsumed_days" field.
+func (_u *LeaveAllowanceUpdateOne) AddOverconsumedDays(v float64) *LeaveAllowanceUpdateOne {
+	_u.mutation.AddOverconsumedDays(v)
+	return _u
+}
+
+// SetAccruedDays sets the "accrued_days" field.
+func (_u *LeaveAllowanceUpdateOne) SetAccruedDays(v float64) *LeaveAllowanceUpdateOne {
+	_u.mutation.ResetAccruedDays()
+	_u.mutation.SetAccruedDays(v)
+	return _u
+}
+
+// SetNillableAccruedDays sets the "accrued_days" field if the given value is not nil.
+func (_u *LeaveAllowanceUpdateOne) SetNillableAccruedDays(v *float64) *LeaveAllowanceUpdateOne {
+	if v != nil {
+		_u.SetAccruedDays(*v)
+	}
+	return _u
+}
+
+// AddAccruedDays adds value to the "accrued_days" field.
+func (_u *LeaveAllowanceUpdateOne) AddAccruedDays(v float64) *LeaveAllowanceUpdateOne {
+	_u.mutation.AddAccruedDays(v)
+	return _u
+}
+
+// SetAccrualStart sets the "accrual_start" field.
+func (_u *LeaveAllowanceUpdateOne) SetAccrualStart(v time.Time) *LeaveAllowanceUpdateOne {
+	_u.mutation.SetAccrualStart(v)
+	return _u
+}
+
+// SetNillableAccrualStart sets the "accrual_start" field if the given value is not nil.
+func (_u *LeaveAllowanceUpdateOne) SetNillableAccrualStart(v *time.Time) *LeaveAllowanceUpdateOne {
+	if v != nil {
+		_u.SetAccrualStart(*v)
+	}
+	return _u
+}
+
+// ClearAccrualStart clears the value of the "accrual_start" field.
+func (_u *LeaveAllowanceUpdateOne) ClearAccrualStart() *LeaveAllowanceUpdateOne {
+	_u.mutation.ClearAccrualStart()
+	return _u
+}
+
 // SetNotes sets the "notes" field.
 func (_u *LeaveAllowanceUpdateOne) SetNotes(v string) *LeaveAllowanceUpdateOne {
 	_u.mutation.SetNotes(v)
@@ -1001,6 +1252,15 @@ func (_u *LeaveAllowanceUpdateOne) sqlSave(ctx context.Context) (_node *LeaveAll
 	if value, ok := _u.mutation.AddedTotalDays(); ok {
 		_spec.AddField(leaveallowance.FieldTotalDays, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.AnnualDays(); ok {
+		_spec.SetField(leaveallowance.FieldAnnualDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAnnualDays(); ok {
+		_spec.AddField(leaveallowance.FieldAnnualDays, field.TypeFloat64, value)
+	}
+	if _u.mutation.AnnualDaysCleared() {
+		_spec.ClearField(leaveallowance.FieldAnnualDays, field.TypeFloat64)
+	}
 	if value, ok := _u.mutation.UsedDays(); ok {
 		_spec.SetField(leaveallowance.FieldUsedDays, field.TypeFloat64, value)
 	}
@@ -1013,6 +1273,30 @@ func (_u *LeaveAllowanceUpdateOne) sqlSave(ctx context.Context) (_node *LeaveAll
 	if value, ok := _u.mutation.AddedCarriedOver(); ok {
 		_spec.AddField(leaveallowance.FieldCarriedOver, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.CarryOverExpiry(); ok {
+		_spec.SetField(leaveallowance.FieldCarryOverExpiry, field.TypeTime, value)
+	}
+	if _u.mutation.CarryOverExpiryCleared() {
+		_spec.ClearField(leaveallowance.FieldCarryOverExpiry, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OverconsumedDays(); ok {
+		_spec.SetField(leaveallowance.FieldOverconsumedDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOverconsumedDays(); ok {
+		_spec.AddField(leaveallowance.FieldOverconsumedDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AccruedDays(); ok {
+		_spec.SetField(leaveallowance.FieldAccruedDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAccruedDays(); ok {
+		_spec.AddField(leaveallowance.FieldAccruedDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AccrualStart(); ok {
+		_spec.SetField(leaveallowance.FieldAccrualStart, field.TypeTime, value)
+	}
+	if _u.mutation.AccrualStartCleared() {
+		_spec.ClearField(leaveallowance.FieldAccrualStart, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Notes(); ok {
 		_spec.SetField(leaveallowance.FieldNotes, field.TypeString, value)
 	}
@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveamendment"
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+)
+
+// LeaveAmendment is the model entity for the LeaveAmendment schema.
+type LeaveAmendment struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique identifier
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 更新者ID
+	UpdateBy *uint32 `json:"update_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// Leave request the amendment changes
+	LeaveRequestID string `json:"leave_request_id,omitempty"`
+	// Denormalized requester user ID
+	UserID uint32 `json:"user_id,omitempty"`
+	// What the amendment changes
+	Kind leaveamendment.Kind `json:"kind,omitempty"`
+	// Amendment status
+	Status leaveamendment.Status `json:"status,omitempty"`
+	// Start date of the request before the amendment
+	PreviousStartDate time.Time `json:"previous_start_date,omitempty"`
+	// End date of the request before the amendment
+	PreviousEndDate time.Time `json:"previous_end_date,omitempty"`
+	// Start day part of the request before the amendment
+	PreviousStartDayPart leaveamendment.PreviousStartDayPart `json:"previous_start_day_part,omitempty"`
+	// End day part of the request before the amendment
+	PreviousEndDayPart leaveamendment.PreviousEndDayPart `json:"previous_end_day_part,omitempty"`
+	// Days of the request before the amendment
+	PreviousDays float64 `json:"previous_days,omitempty"`
+	// New start date
+	StartDate time.Time `json:"start_date,omitempty"`
+	// New end date
+	EndDate time.Time `json:"end_date,omitempty"`
+	// New start day part
+	StartDayPart leaveamendment.StartDayPart `json:"start_day_part,omitempty"`
+	// New end day part
+	EndDayPart leaveamendment.EndDayPart `json:"end_day_part,omitempty"`
+	// New hours for hour-based absence types
+	Hours float64 `json:"hours,omitempty"`
+	// New calculated business days
+	Days float64 `json:"days,omitempty"`
+	// Holiday calendar used to calculate the new days; empty when days were entered manually
+	HolidayCalendarID string `json:"holiday_calendar_id,omitempty"`
+	// Requester's reason for the amendment
+	Reason string `json:"reason,omitempty"`
+	// User ID of reviewer
+	ReviewedBy uint32 `json:"reviewed_by,omitempty"`
+	// Denormalized reviewer display name
+	ReviewerName string `json:"reviewer_name,omitempty"`
+	// When the amendment was reviewed
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// Reviewer's notes
+	ReviewNotes string `json:"review_notes,omitempty"`
+	// Signing submission ID when the new dates must be signed
+	SigningRequestID string `json:"signing_request_id,omitempty"`
+	// Leave policy violations an HR admin overrode, with the justification
+	PolicyOverride *policy.Override `json:"policy_override,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LeaveAmendment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case leaveamendment.FieldPolicyOverride:
+			values[i] = new([]byte)
+		case leaveamendment.FieldPreviousDays, leaveamendment.FieldHours, leaveamendment.FieldDays:
+			values[i] = new(sql.NullFloat64)
+		case leaveamendment.FieldCreateBy, leaveamendment.FieldUpdateBy, leaveamendment.FieldTenantID, leaveamendment.FieldUserID, leaveamendment.FieldReviewedBy:
+			values[i] = new(sql.NullInt64)
+		case leaveamendment.FieldID, leaveamendment.FieldLeaveRequestID, leaveamendment.FieldKind, leaveamendment.FieldStatus, leaveamendment.FieldPreviousStartDayPart, leaveamendment.FieldPreviousEndDayPart, leaveamendment.FieldStartDayPart, leaveamendment.FieldEndDayPart, leaveamendment.FieldHolidayCalendarID, leaveamendment.FieldReason, leaveamendment.FieldReviewerName, leaveamendment.FieldReviewNotes, leaveamendment.FieldSigningRequestID:
+			values[i] = new(sql.NullString)
+		case leaveamendment.FieldCreateTime, leaveamendment.FieldUpdateTime, leaveamendment.FieldDeleteTime, leaveamendment.FieldPreviousStartDate, leaveamendment.FieldPreviousEndDate, leaveamendment.FieldStartDate, leaveamendment.FieldEndDate, leaveamendment.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LeaveAmendment fields.
+func (_m *LeaveAmendment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case leaveamendment.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case leaveamendment.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case leaveamendment.FieldUpdateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_by", values[i])
+			} else if value.Valid {
+				_m.UpdateBy = new(uint32)
+				*_m.UpdateBy = uint32(value.Int64)
+			}
+		case leaveamendment.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case leaveamendment.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case leaveamendment.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case leaveamendment.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case leaveamendment.FieldLeaveRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field leave_request_id", values[i])
+			} else if value.Valid {
+				_m.LeaveRequestID = value.String
+			}
+		case leaveamendment.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = uint32(value.Int64)
+			}
+		case leaveamendment.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = leaveamendment.Kind(value.String)
+			}
+		case leaveamendment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = leaveamendment.Status(value.String)
+			}
+		case leaveamendment.FieldPreviousStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_start_date", values[i])
+			} else if value.Valid {
+				_m.PreviousStartDate = value.Time
+			}
+		case leaveamendment.FieldPreviousEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_end_date", values[i])
+			} else if value.Valid {
+				_m.PreviousEndDate = value.Time
+			}
+		case leaveamendment.FieldPreviousStartDayPart:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_start_day_part", values[i])
+			} else if value.Valid {
+				_m.PreviousStartDayPart = leaveamendment.PreviousStartDayPart(value.String)
+			}
+		case leaveamendment.FieldPreviousEndDayPart:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_end_day_part", values[i])
+			} else if value.Valid {
+				_m.PreviousEndDayPart = leaveamendment.PreviousEndDayPart(value.String)
+			}
+		case leaveamendment.FieldPreviousDays:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_days", values[i])
+			} else if value.Valid {
+				_m.PreviousDays = value.Float64
+			}
+		case leaveamendment.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				_m.StartDate = value.Time
+			}
+		case leaveamendment.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				_m.EndDate = value.Time
+			}
+		case leaveamendment.FieldStartDayPart:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field start_day_part", values[i])
+			} else if value.Valid {
+				_m.StartDayPart = leaveamendment.StartDayPart(value.String)
+			}
+		case leaveamendment.FieldEndDayPart:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_day_part", values[i])
+			} else if value.Valid {
+				_m.EndDayPart = leaveamendment.EndDayPart(value.String)
+			}
+		case leaveamendment.FieldHours:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field hours", values[i])
+			} else if value.Valid {
+				_m.Hours = value.Float64
+			}
+		case leaveamendment.FieldDays:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field days", values[i])
+			} else if value.Valid {
+				_m.Days = value.Float64
+			}
+		case leaveamendment.FieldHolidayCalendarID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field holiday_calendar_id", values[i])
+			} else if value.Valid {
+				_m.HolidayCalendarID = value.String
+			}
+		case leaveamendment.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case leaveamendment.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				_m.ReviewedBy = uint32(value.Int64)
+			}
+		case leaveamendment.FieldReviewerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_name", values[i])
+			} else if value.Valid {
+				_m.ReviewerName = value.String
+			}
+		case leaveamendment.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = new(time.Time)
+				*_m.ReviewedAt = value.Time
+			}
+		case leaveamendment.FieldReviewNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_notes", values[i])
+			} else if value.Valid {
+				_m.ReviewNotes = value.String
+			}
+		case leaveamendment.FieldSigningRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signing_request_id", values[i])
+			} else if value.Valid {
+				_m.SigningRequestID = value.String
+			}
+		case leaveamendment.FieldPolicyOverride:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_override", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PolicyOverride); err != nil {
+					return fmt.Errorf("unmarshal field policy_override: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LeaveAmendment.
+// This includes values selected through modifiers, order, etc.
+func (_m *LeaveAmendment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LeaveAmendment.
+// Note that you need to call LeaveAmendment.Unwrap() before calling this method if this LeaveAmendment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LeaveAmendment) Update() *LeaveAmendmentUpdateOne {
+	return NewLeaveAmendmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LeaveAmendment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LeaveAmendment) Unwrap() *LeaveAmendment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LeaveAmendment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LeaveAmendment) String() string {
+	var builder strings.Builder
+	builder.WriteString("LeaveAmendment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateBy; v != nil {
+		builder.WriteString("update_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("leave_request_id=")
+	builder.WriteString(_m.LeaveRequestID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("previous_start_date=")
+	builder.WriteString(_m.PreviousStartDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("previous_end_date=")
+	builder.WriteString(_m.PreviousEndDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("previous_start_day_part=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PreviousStartDayPart))
+	builder.WriteString(", ")
+	builder.WriteString("previous_end_day_part=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PreviousEndDayPart))
+	builder.WriteString(", ")
+	builder.WriteString("previous_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PreviousDays))
+	builder.WriteString(", ")
+	builder.WriteString("start_date=")
+	builder.WriteString(_m.StartDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_date=")
+	builder.WriteString(_m.EndDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("start_day_part=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartDayPart))
+	builder.WriteString(", ")
+	builder.WriteString("end_day_part=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndDayPart))
+	builder.WriteString(", ")
+	builder.WriteString("hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Hours))
+	builder.WriteString(", ")
+	builder.WriteString("days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Days))
+	builder.WriteString(", ")
+	builder.WriteString("holiday_calendar_id=")
+	builder.WriteString(_m.HolidayCalendarID)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("reviewed_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReviewedBy))
+	builder.WriteString(", ")
+	builder.WriteString("reviewer_name=")
+	builder.WriteString(_m.ReviewerName)
+	builder.WriteString(", ")
+	if v := _m.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("review_notes=")
+	builder.WriteString(_m.ReviewNotes)
+	builder.WriteString(", ")
+	builder.WriteString("signing_request_id=")
+	builder.WriteString(_m.SigningRequestID)
+	builder.WriteString(", ")
+	builder.WriteString("policy_override=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyOverride))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LeaveAmendments is a parsable slice of LeaveAmendment.
+type LeaveAmendments []*LeaveAmendment
@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leaveamendment
+
+import (
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the leaveamendment type in the database.
+	Label = "leave_amendment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateBy holds the string denoting the create_by field in the database.
+	FieldCreateBy = "create_by"
+	// FieldUpdateBy holds the string denoting the update_by field in the database.
+	FieldUpdateBy = "update_by"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldLeaveRequestID holds the string denoting the leave_request_id field in the database.
+	FieldLeaveRequestID = "leave_request_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldPreviousStartDate holds the string denoting the previous_start_date field in the database.
+	FieldPreviousStartDate = "previous_start_date"
+	// FieldPreviousEndDate holds the string denoting the previous_end_date field in the database.
+	FieldPreviousEndDate = "previous_end_date"
+	// FieldPreviousStartDayPart holds the string denoting the previous_start_day_part field in the database.
+	FieldPreviousStartDayPart = "previous_start_day_part"
+	// FieldPreviousEndDayPart holds the string denoting the previous_end_day_part field in the database.
+	FieldPreviousEndDayPart = "previous_end_day_part"
+	// FieldPreviousDays holds the string denoting the previous_days field in the database.
+	FieldPreviousDays = "previous_days"
+	// FieldStartDate holds the string denoting the start_date field in the database.
+	FieldStartDate = "start_date"
+	// FieldEndDate holds the string denoting the end_date field in the database.
+	FieldEndDate = "end_date"
+	// FieldStartDayPart holds the string denoting the start_day_part field in the database.
+	FieldStartDayPart = "start_day_part"
+	// FieldEndDayPart holds the string denoting the end_day_part field in the database.
+	FieldEndDayPart = "end_day_part"
+	// FieldHours holds the string denoting the hours field in the database.
+	FieldHours = "hours"
+	// FieldDays holds the string denoting the days field in the database.
+	FieldDays = "days"
+	// FieldHolidayCalendarID holds the string denoting the holiday_calendar_id field in the database.
+	FieldHolidayCalendarID = "holiday_calendar_id"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldReviewerName holds the string denoting the reviewer_name field in the database.
+	FieldReviewerName = "reviewer_name"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldReviewNotes holds the string denoting the review_notes field in the database.
+	FieldReviewNotes = "review_notes"
+	// FieldSigningRequestID holds the string denoting the signing_request_id field in the database.
+	FieldSigningRequestID = "signing_request_id"
+	// FieldPolicyOverride holds the string denoting the policy_override field in the database.
+	FieldPolicyOverride = "policy_override"
+	// Table holds the table name of the leaveamendment in the database.
+	Table = "hr_leave_amendments"
+)
+
+// Columns holds all SQL columns for leaveamendment fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateBy,
+	FieldUpdateBy,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeleteTime,
+	FieldTenantID,
+	FieldLeaveRequestID,
+	FieldUserID,
+	FieldKind,
+	FieldStatus,
+	FieldPreviousStartDate,
+	FieldPreviousEndDate,
+	FieldPreviousStartDayPart,
+	FieldPreviousEndDayPart,
+	FieldPreviousDays,
+	FieldStartDate,
+	FieldEndDate,
+	FieldStartDayPart,
+	FieldEndDayPart,
+	FieldHours,
+	FieldDays,
+	FieldHolidayCalendarID,
+	FieldReason,
+	FieldReviewedBy,
+	FieldReviewerName,
+	FieldReviewedAt,
+	FieldReviewNotes,
+	FieldSigningRequestID,
+	FieldPolicyOverride,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/go-tangra/go-tangra-hr/internal/data/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID uint32
+	// LeaveRequestIDValidator is a validator for the "leave_request_id" field. It is called by the builders before save.
+	LeaveRequestIDValidator func(string) error
+	// DefaultHours holds the default value on creation for the "hours" field.
+	DefaultHours float64
+	// DefaultHolidayCalendarID holds the default value on creation for the "holiday_calendar_id" field.
+	DefaultHolidayCalendarID string
+	// DefaultReviewedBy holds the default value on creation for the "reviewed_by" field.
+	DefaultReviewedBy uint32
+	// DefaultReviewerName holds the default value on creation for the "reviewer_name" field.
+	DefaultReviewerName string
+	// DefaultSigningRequestID holds the default value on creation for the "signing_request_id" field.
+	DefaultSigningRequestID string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// KindDateChange is the default value of the Kind enum.
+const DefaultKind = KindDateChange
+
+// Kind values.
+const (
+	KindDateChange Kind = "date_change"
+	KindShorten    Kind = "shorten"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindDateChange, KindShorten:
+		return nil
+	default:
+		return fmt.Errorf("leaveamendment: invalid enum value for kind field: %q", k)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending         Status = "pending"
+	StatusAwaitingSigning Status = "awaiting_signing"
+	StatusApplied         Status = "applied"
+	StatusRejected        Status = "rejected"
+	StatusCancelled       Status = "cancelled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusAwaitingSigning, StatusApplied, StatusRejected, StatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("leaveamendment: invalid enum value for status field: %q", s)
+	}
+}
+
+// PreviousStartDayPart defines the type for the "previous_start_day_part" enum field.
+type PreviousStartDayPart string
+
+// PreviousStartDayPartAm is the default value of the PreviousStartDayPart enum.
+const DefaultPreviousStartDayPart = PreviousStartDayPartAm
+
+// PreviousStartDayPart values.
+const (
+	PreviousStartDayPartAm PreviousStartDayPart = "am"
+	PreviousStartDayPartPm PreviousStartDayPart = "pm"
+)
+
+func (psdp PreviousStartDayPart) String() string {
+	return string(psdp)
+}
+
+// PreviousStartDayPartValidator is a validator for the "previous_start_day_part" field enum values. It is called by the builders before save.
+func PreviousStartDayPartValidator(psdp PreviousStartDayPart) error {
+	switch psdp {
+	case PreviousStartDayPartAm, PreviousStartDayPartPm:
+		return nil
+	default:
+		return fmt.Errorf("leaveamendment: invalid enum value for previous_start_day_part field: %q", psdp)
+	}
+}
+
+// PreviousEndDayPart defines the type for the "previous_end_day_part" enum field.
+type PreviousEndDayPart string
+
+// PreviousEndDayPartPm is the default value of the PreviousEndDayPart enum.
+const DefaultPreviousEndDayPart = PreviousEndDayPartPm
+
+// PreviousEndDayPart values.
+const (
+	PreviousEndDayPartAm PreviousEndDayPart = "am"
+	PreviousEndDayPartPm PreviousEndDayPart = "pm"
+)
+
+func (pedp PreviousEndDayPart) String() string {
+	return string(pedp)
+}
+
+// PreviousEndDayPartValidator is a validator for the "previous_end_day_part" field enum values. It is called by the builders before save.
+func PreviousEndDayPartValidator(pedp PreviousEndDayPart) error {
+	switch pedp {
+	case PreviousEndDayPartAm, PreviousEndDayPartPm:
+		return nil
+	default:
+		return fmt.Errorf("leaveamendment: invalid enum value for previous_end_day_part field: %q", pedp)
+	}
+}
+
+// StartDayPart defines the type for the "start_day_part" enum field.
+type StartDayPart string
+
+// StartDayPartAm is the default value of the StartDayPart enum.
+const DefaultStartDayPart = StartDayPartAm
+
+// StartDayPart values.
+const (
+	StartDayPartAm StartDayPart = "am"
+	StartDayPartPm StartDayPart = "pm"
+)
+
+func (sdp StartDayPart) String() string {
+	return string(sdp)
+}
+
+// StartDayPartValidator is a validator for the "start_day_part" field enum values. It is called by the builders before save.
+func StartDayPartValidator(sdp StartDayPart) error {
+	switch sdp {
+	case StartDayPartAm, StartDayPartPm:
+		return nil
+	default:
+		return fmt.Errorf("leaveamendment: invalid enum value for start_day_part field: %q", sdp)
+	}
+}
+
+// EndDayPart defines the type for the "end_day_part" enum field.
+type EndDayPart string
+
+// EndDayPartPm is the default value of the EndDayPart enum.
+const DefaultEndDayPart = EndDayPartPm
+
+// EndDayPart values.
+const (
+	EndDayPartAm EndDayPart = "am"
+	EndDayPartPm EndDayPart = "pm"
+)
+
+func (edp EndDayPart) String() string {
+	return string(edp)
+}
+
+// EndDayPartValidator is a validator for the "end_day_part" field enum values. It is called by the builders before save.
+func EndDayPartValidator(edp EndDayPart) error {
+	switch edp {
+	case EndDayPartAm, EndDayPartPm:
+		return nil
+	default:
+		return fmt.Errorf("leaveamendment: invalid enum value for end_day_part field: %q", edp)
+	}
+}
+
+// OrderOption defines the ordering options for the LeaveAmendment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateBy orders the results by the create_by field.
+func ByCreateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateBy, opts...).ToFunc()
+}
+
+// ByUpdateBy orders the results by the update_by field.
+func ByUpdateBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateBy, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByLeaveRequestID orders the results by the leave_request_id field.
+func ByLeaveRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeaveRequestID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByPreviousStartDate orders the results by the previous_start_date field.
+func ByPreviousStartDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousStartDate, opts...).ToFunc()
+}
+
+// ByPreviousEndDate orders the results by the previous_end_date field.
+func ByPreviousEndDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousEndDate, opts...).ToFunc()
+}
+
+// ByPreviousStartDayPart orders the results by the previous_start_day_part field.
+func ByPreviousStartDayPart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousStartDayPart, opts...).ToFunc()
+}
+
+// ByPreviousEndDayPart orders the results by the previous_end_day_part field.
+func ByPreviousEndDayPart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousEndDayPart, opts...).ToFunc()
+}
+
+// ByPreviousDays orders the results by the previous_days field.
+func ByPreviousDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousDays, opts...).ToFunc()
+}
+
+// ByStartDate orders the results by the start_date field.
+func ByStartDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartDate, opts...).ToFunc()
+}
+
+// ByEndDate orders the results by the end_date field.
+func ByEndDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndDate, opts...).ToFunc()
+}
+
+// ByStartDayPart orders the results by the start_day_part field.
+func ByStartDayPart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartDayPart, opts...).ToFunc()
+}
+
+// ByEndDayPart orders the results by the end_day_part field.
+func ByEndDayPart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndDayPart, opts...).ToFunc()
+}
+
+// ByHours orders the results by the hours field.
+func ByHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHours, opts...).ToFunc()
+}
+
+// ByDays orders the results by the days field.
+func ByDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDays, opts...).ToFunc()
+}
+
+// ByHolidayCalendarID orders the results by the holiday_calendar_id field.
+func ByHolidayCalendarID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHolidayCalendarID, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByReviewedBy orders the results by the reviewed_by field.
+func ByReviewedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedBy, opts...).ToFunc()
+}
+
+// ByReviewerName orders the results by the reviewer_name field.
+func ByReviewerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewerName, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByReviewNotes orders the results by the review_notes field.
+func ByReviewNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewNotes, opts...).ToFunc()
+}
+
+// BySigningRequestID orders the results by the signing_request_id field.
+func BySigningRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigningRequestID, opts...).ToFunc()
+}
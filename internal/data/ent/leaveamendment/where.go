@@ -0,0 +1,1480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leaveamendment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// UpdateBy applies equality check predicate on the "update_by" field. It's identical to UpdateByEQ.
+func UpdateBy(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// LeaveRequestID applies equality check predicate on the "leave_request_id" field. It's identical to LeaveRequestIDEQ.
+func LeaveRequestID(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldLeaveRequestID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldUserID, v))
+}
+
+// PreviousStartDate applies equality check predicate on the "previous_start_date" field. It's identical to PreviousStartDateEQ.
+func PreviousStartDate(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldPreviousStartDate, v))
+}
+
+// PreviousEndDate applies equality check predicate on the "previous_end_date" field. It's identical to PreviousEndDateEQ.
+func PreviousEndDate(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldPreviousEndDate, v))
+}
+
+// PreviousDays applies equality check predicate on the "previous_days" field. It's identical to PreviousDaysEQ.
+func PreviousDays(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldPreviousDays, v))
+}
+
+// StartDate applies equality check predicate on the "start_date" field. It's identical to StartDateEQ.
+func StartDate(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldStartDate, v))
+}
+
+// EndDate applies equality check predicate on the "end_date" field. It's identical to EndDateEQ.
+func EndDate(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldEndDate, v))
+}
+
+// Hours applies equality check predicate on the "hours" field. It's identical to HoursEQ.
+func Hours(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldHours, v))
+}
+
+// Days applies equality check predicate on the "days" field. It's identical to DaysEQ.
+func Days(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldDays, v))
+}
+
+// HolidayCalendarID applies equality check predicate on the "holiday_calendar_id" field. It's identical to HolidayCalendarIDEQ.
+func HolidayCalendarID(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldHolidayCalendarID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldReason, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewerName applies equality check predicate on the "reviewer_name" field. It's identical to ReviewerNameEQ.
+func ReviewerName(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldReviewerName, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewNotes applies equality check predicate on the "review_notes" field. It's identical to ReviewNotesEQ.
+func ReviewNotes(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldReviewNotes, v))
+}
+
+// SigningRequestID applies equality check predicate on the "signing_request_id" field. It's identical to SigningRequestIDEQ.
+func SigningRequestID(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldSigningRequestID, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotNull(FieldCreateBy))
+}
+
+// UpdateByEQ applies the EQ predicate on the "update_by" field.
+func UpdateByEQ(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// UpdateByNEQ applies the NEQ predicate on the "update_by" field.
+func UpdateByNEQ(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldUpdateBy, v))
+}
+
+// UpdateByIn applies the In predicate on the "update_by" field.
+func UpdateByIn(vs ...uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByNotIn applies the NotIn predicate on the "update_by" field.
+func UpdateByNotIn(vs ...uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByGT applies the GT predicate on the "update_by" field.
+func UpdateByGT(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldUpdateBy, v))
+}
+
+// UpdateByGTE applies the GTE predicate on the "update_by" field.
+func UpdateByGTE(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldUpdateBy, v))
+}
+
+// UpdateByLT applies the LT predicate on the "update_by" field.
+func UpdateByLT(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldUpdateBy, v))
+}
+
+// UpdateByLTE applies the LTE predicate on the "update_by" field.
+func UpdateByLTE(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldUpdateBy, v))
+}
+
+// UpdateByIsNil applies the IsNil predicate on the "update_by" field.
+func UpdateByIsNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIsNull(FieldUpdateBy))
+}
+
+// UpdateByNotNil applies the NotNil predicate on the "update_by" field.
+func UpdateByNotNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotNull(FieldUpdateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotNull(FieldTenantID))
+}
+
+// LeaveRequestIDEQ applies the EQ predicate on the "leave_request_id" field.
+func LeaveRequestIDEQ(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDNEQ applies the NEQ predicate on the "leave_request_id" field.
+func LeaveRequestIDNEQ(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDIn applies the In predicate on the "leave_request_id" field.
+func LeaveRequestIDIn(vs ...string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldLeaveRequestID, vs...))
+}
+
+// LeaveRequestIDNotIn applies the NotIn predicate on the "leave_request_id" field.
+func LeaveRequestIDNotIn(vs ...string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldLeaveRequestID, vs...))
+}
+
+// LeaveRequestIDGT applies the GT predicate on the "leave_request_id" field.
+func LeaveRequestIDGT(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDGTE applies the GTE predicate on the "leave_request_id" field.
+func LeaveRequestIDGTE(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDLT applies the LT predicate on the "leave_request_id" field.
+func LeaveRequestIDLT(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDLTE applies the LTE predicate on the "leave_request_id" field.
+func LeaveRequestIDLTE(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDContains applies the Contains predicate on the "leave_request_id" field.
+func LeaveRequestIDContains(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldContains(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDHasPrefix applies the HasPrefix predicate on the "leave_request_id" field.
+func LeaveRequestIDHasPrefix(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldHasPrefix(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDHasSuffix applies the HasSuffix predicate on the "leave_request_id" field.
+func LeaveRequestIDHasSuffix(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldHasSuffix(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDEqualFold applies the EqualFold predicate on the "leave_request_id" field.
+func LeaveRequestIDEqualFold(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEqualFold(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDContainsFold applies the ContainsFold predicate on the "leave_request_id" field.
+func LeaveRequestIDContainsFold(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldContainsFold(FieldLeaveRequestID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldUserID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// PreviousStartDateEQ applies the EQ predicate on the "previous_start_date" field.
+func PreviousStartDateEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldPreviousStartDate, v))
+}
+
+// PreviousStartDateNEQ applies the NEQ predicate on the "previous_start_date" field.
+func PreviousStartDateNEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldPreviousStartDate, v))
+}
+
+// PreviousStartDateIn applies the In predicate on the "previous_start_date" field.
+func PreviousStartDateIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldPreviousStartDate, vs...))
+}
+
+// PreviousStartDateNotIn applies the NotIn predicate on the "previous_start_date" field.
+func PreviousStartDateNotIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldPreviousStartDate, vs...))
+}
+
+// PreviousStartDateGT applies the GT predicate on the "previous_start_date" field.
+func PreviousStartDateGT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldPreviousStartDate, v))
+}
+
+// PreviousStartDateGTE applies the GTE predicate on the "previous_start_date" field.
+func PreviousStartDateGTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldPreviousStartDate, v))
+}
+
+// PreviousStartDateLT applies the LT predicate on the "previous_start_date" field.
+func PreviousStartDateLT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldPreviousStartDate, v))
+}
+
+// PreviousStartDateLTE applies the LTE predicate on the "previous_start_date" field.
+func PreviousStartDateLTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldPreviousStartDate, v))
+}
+
+// PreviousEndDateEQ applies the EQ predicate on the "previous_end_date" field.
+func PreviousEndDateEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldPreviousEndDate, v))
+}
+
+// PreviousEndDateNEQ applies the NEQ predicate on the "previous_end_date" field.
+func PreviousEndDateNEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldPreviousEndDate, v))
+}
+
+// PreviousEndDateIn applies the In predicate on the "previous_end_date" field.
+func PreviousEndDateIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldPreviousEndDate, vs...))
+}
+
+// PreviousEndDateNotIn applies the NotIn predicate on the "previous_end_date" field.
+func PreviousEndDateNotIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldPreviousEndDate, vs...))
+}
+
+// PreviousEndDateGT applies the GT predicate on the "previous_end_date" field.
+func PreviousEndDateGT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldPreviousEndDate, v))
+}
+
+// PreviousEndDateGTE applies the GTE predicate on the "previous_end_date" field.
+func PreviousEndDateGTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldPreviousEndDate, v))
+}
+
+// PreviousEndDateLT applies the LT predicate on the "previous_end_date" field.
+func PreviousEndDateLT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldPreviousEndDate, v))
+}
+
+// PreviousEndDateLTE applies the LTE predicate on the "previous_end_date" field.
+func PreviousEndDateLTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldPreviousEndDate, v))
+}
+
+// PreviousStartDayPartEQ applies the EQ predicate on the "previous_start_day_part" field.
+func PreviousStartDayPartEQ(v PreviousStartDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldPreviousStartDayPart, v))
+}
+
+// PreviousStartDayPartNEQ applies the NEQ predicate on the "previous_start_day_part" field.
+func PreviousStartDayPartNEQ(v PreviousStartDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldPreviousStartDayPart, v))
+}
+
+// PreviousStartDayPartIn applies the In predicate on the "previous_start_day_part" field.
+func PreviousStartDayPartIn(vs ...PreviousStartDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldPreviousStartDayPart, vs...))
+}
+
+// PreviousStartDayPartNotIn applies the NotIn predicate on the "previous_start_day_part" field.
+func PreviousStartDayPartNotIn(vs ...PreviousStartDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldPreviousStartDayPart, vs...))
+}
+
+// PreviousEndDayPartEQ applies the EQ predicate on the "previous_end_day_part" field.
+func PreviousEndDayPartEQ(v PreviousEndDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldPreviousEndDayPart, v))
+}
+
+// PreviousEndDayPartNEQ applies the NEQ predicate on the "previous_end_day_part" field.
+func PreviousEndDayPartNEQ(v PreviousEndDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldPreviousEndDayPart, v))
+}
+
+// PreviousEndDayPartIn applies the In predicate on the "previous_end_day_part" field.
+func PreviousEndDayPartIn(vs ...PreviousEndDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldPreviousEndDayPart, vs...))
+}
+
+// PreviousEndDayPartNotIn applies the NotIn predicate on the "previous_end_day_part" field.
+func PreviousEndDayPartNotIn(vs ...PreviousEndDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldPreviousEndDayPart, vs...))
+}
+
+// PreviousDaysEQ applies the EQ predicate on the "previous_days" field.
+func PreviousDaysEQ(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldPreviousDays, v))
+}
+
+// PreviousDaysNEQ applies the NEQ predicate on the "previous_days" field.
+func PreviousDaysNEQ(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldPreviousDays, v))
+}
+
+// PreviousDaysIn applies the In predicate on the "previous_days" field.
+func PreviousDaysIn(vs ...float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldPreviousDays, vs...))
+}
+
+// PreviousDaysNotIn applies the NotIn predicate on the "previous_days" field.
+func PreviousDaysNotIn(vs ...float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldPreviousDays, vs...))
+}
+
+// PreviousDaysGT applies the GT predicate on the "previous_days" field.
+func PreviousDaysGT(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldPreviousDays, v))
+}
+
+// PreviousDaysGTE applies the GTE predicate on the "previous_days" field.
+func PreviousDaysGTE(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldPreviousDays, v))
+}
+
+// PreviousDaysLT applies the LT predicate on the "previous_days" field.
+func PreviousDaysLT(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldPreviousDays, v))
+}
+
+// PreviousDaysLTE applies the LTE predicate on the "previous_days" field.
+func PreviousDaysLTE(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldPreviousDays, v))
+}
+
+// StartDateEQ applies the EQ predicate on the "start_date" field.
+func StartDateEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldStartDate, v))
+}
+
+// StartDateNEQ applies the NEQ predicate on the "start_date" field.
+func StartDateNEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldStartDate, v))
+}
+
+// StartDateIn applies the In predicate on the "start_date" field.
+func StartDateIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldStartDate, vs...))
+}
+
+// StartDateNotIn applies the NotIn predicate on the "start_date" field.
+func StartDateNotIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldStartDate, vs...))
+}
+
+// StartDateGT applies the GT predicate on the "start_date" field.
+func StartDateGT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldStartDate, v))
+}
+
+// StartDateGTE applies the GTE predicate on the "start_date" field.
+func StartDateGTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldStartDate, v))
+}
+
+// StartDateLT applies the LT predicate on the "start_date" field.
+func StartDateLT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldStartDate, v))
+}
+
+// StartDateLTE applies the LTE predicate on the "start_date" field.
+func StartDateLTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldStartDate, v))
+}
+
+// EndDateEQ applies the EQ predicate on the "end_date" field.
+func EndDateEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldEndDate, v))
+}
+
+// EndDateNEQ applies the NEQ predicate on the "end_date" field.
+func EndDateNEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldEndDate, v))
+}
+
+// EndDateIn applies the In predicate on the "end_date" field.
+func EndDateIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldEndDate, vs...))
+}
+
+// EndDateNotIn applies the NotIn predicate on the "end_date" field.
+func EndDateNotIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldEndDate, vs...))
+}
+
+// EndDateGT applies the GT predicate on the "end_date" field.
+func EndDateGT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldEndDate, v))
+}
+
+// EndDateGTE applies the GTE predicate on the "end_date" field.
+func EndDateGTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldEndDate, v))
+}
+
+// EndDateLT applies the LT predicate on the "end_date" field.
+func EndDateLT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldEndDate, v))
+}
+
+// EndDateLTE applies the LTE predicate on the "end_date" field.
+func EndDateLTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldEndDate, v))
+}
+
+// StartDayPartEQ applies the EQ predicate on the "start_day_part" field.
+func StartDayPartEQ(v StartDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldStartDayPart, v))
+}
+
+// StartDayPartNEQ applies the NEQ predicate on the "start_day_part" field.
+func StartDayPartNEQ(v StartDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldStartDayPart, v))
+}
+
+// StartDayPartIn applies the In predicate on the "start_day_part" field.
+func StartDayPartIn(vs ...StartDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldStartDayPart, vs...))
+}
+
+// StartDayPartNotIn applies the NotIn predicate on the "start_day_part" field.
+func StartDayPartNotIn(vs ...StartDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldStartDayPart, vs...))
+}
+
+// EndDayPartEQ applies the EQ predicate on the "end_day_part" field.
+func EndDayPartEQ(v EndDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldEndDayPart, v))
+}
+
+// EndDayPartNEQ applies the NEQ predicate on the "end_day_part" field.
+func EndDayPartNEQ(v EndDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldEndDayPart, v))
+}
+
+// EndDayPartIn applies the In predicate on the "end_day_part" field.
+func EndDayPartIn(vs ...EndDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldEndDayPart, vs...))
+}
+
+// EndDayPartNotIn applies the NotIn predicate on the "end_day_part" field.
+func EndDayPartNotIn(vs ...EndDayPart) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldEndDayPart, vs...))
+}
+
+// HoursEQ applies the EQ predicate on the "hours" field.
+func HoursEQ(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldHours, v))
+}
+
+// HoursNEQ applies the NEQ predicate on the "hours" field.
+func HoursNEQ(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldHours, v))
+}
+
+// HoursIn applies the In predicate on the "hours" field.
+func HoursIn(vs ...float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldHours, vs...))
+}
+
+// HoursNotIn applies the NotIn predicate on the "hours" field.
+func HoursNotIn(vs ...float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldHours, vs...))
+}
+
+// HoursGT applies the GT predicate on the "hours" field.
+func HoursGT(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldHours, v))
+}
+
+// HoursGTE applies the GTE predicate on the "hours" field.
+func HoursGTE(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldHours, v))
+}
+
+// HoursLT applies the LT predicate on the "hours" field.
+func HoursLT(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldHours, v))
+}
+
+// HoursLTE applies the LTE predicate on the "hours" field.
+func HoursLTE(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldHours, v))
+}
+
+// DaysEQ applies the EQ predicate on the "days" field.
+func DaysEQ(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldDays, v))
+}
+
+// DaysNEQ applies the NEQ predicate on the "days" field.
+func DaysNEQ(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldDays, v))
+}
+
+// DaysIn applies the In predicate on the "days" field.
+func DaysIn(vs ...float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldDays, vs...))
+}
+
+// DaysNotIn applies the NotIn predicate on the "days" field.
+func DaysNotIn(vs ...float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldDays, vs...))
+}
+
+// DaysGT applies the GT predicate on the "days" field.
+func DaysGT(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldDays, v))
+}
+
+// DaysGTE applies the GTE predicate on the "days" field.
+func DaysGTE(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldDays, v))
+}
+
+// DaysLT applies the LT predicate on the "days" field.
+func DaysLT(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldDays, v))
+}
+
+// DaysLTE applies the LTE predicate on the "days" field.
+func DaysLTE(v float64) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldDays, v))
+}
+
+// HolidayCalendarIDEQ applies the EQ predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDEQ(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDNEQ applies the NEQ predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDNEQ(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDIn applies the In predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDIn(vs ...string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldHolidayCalendarID, vs...))
+}
+
+// HolidayCalendarIDNotIn applies the NotIn predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDNotIn(vs ...string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldHolidayCalendarID, vs...))
+}
+
+// HolidayCalendarIDGT applies the GT predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDGT(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDGTE applies the GTE predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDGTE(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDLT applies the LT predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDLT(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDLTE applies the LTE predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDLTE(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDContains applies the Contains predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDContains(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldContains(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDHasPrefix applies the HasPrefix predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDHasPrefix(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldHasPrefix(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDHasSuffix applies the HasSuffix predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDHasSuffix(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldHasSuffix(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDIsNil applies the IsNil predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDIsNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIsNull(FieldHolidayCalendarID))
+}
+
+// HolidayCalendarIDNotNil applies the NotNil predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDNotNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotNull(FieldHolidayCalendarID))
+}
+
+// HolidayCalendarIDEqualFold applies the EqualFold predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDEqualFold(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEqualFold(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDContainsFold applies the ContainsFold predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDContainsFold(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldContainsFold(FieldHolidayCalendarID, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v uint32) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewerNameEQ applies the EQ predicate on the "reviewer_name" field.
+func ReviewerNameEQ(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldReviewerName, v))
+}
+
+// ReviewerNameNEQ applies the NEQ predicate on the "reviewer_name" field.
+func ReviewerNameNEQ(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldReviewerName, v))
+}
+
+// ReviewerNameIn applies the In predicate on the "reviewer_name" field.
+func ReviewerNameIn(vs ...string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldReviewerName, vs...))
+}
+
+// ReviewerNameNotIn applies the NotIn predicate on the "reviewer_name" field.
+func ReviewerNameNotIn(vs ...string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldReviewerName, vs...))
+}
+
+// ReviewerNameGT applies the GT predicate on the "reviewer_name" field.
+func ReviewerNameGT(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldReviewerName, v))
+}
+
+// ReviewerNameGTE applies the GTE predicate on the "reviewer_name" field.
+func ReviewerNameGTE(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldReviewerName, v))
+}
+
+// ReviewerNameLT applies the LT predicate on the "reviewer_name" field.
+func ReviewerNameLT(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldReviewerName, v))
+}
+
+// ReviewerNameLTE applies the LTE predicate on the "reviewer_name" field.
+func ReviewerNameLTE(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldReviewerName, v))
+}
+
+// ReviewerNameContains applies the Contains predicate on the "reviewer_name" field.
+func ReviewerNameContains(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldContains(FieldReviewerName, v))
+}
+
+// ReviewerNameHasPrefix applies the HasPrefix predicate on the "reviewer_name" field.
+func ReviewerNameHasPrefix(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldHasPrefix(FieldReviewerName, v))
+}
+
+// ReviewerNameHasSuffix applies the HasSuffix predicate on the "reviewer_name" field.
+func ReviewerNameHasSuffix(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldHasSuffix(FieldReviewerName, v))
+}
+
+// ReviewerNameIsNil applies the IsNil predicate on the "reviewer_name" field.
+func ReviewerNameIsNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIsNull(FieldReviewerName))
+}
+
+// ReviewerNameNotNil applies the NotNil predicate on the "reviewer_name" field.
+func ReviewerNameNotNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotNull(FieldReviewerName))
+}
+
+// ReviewerNameEqualFold applies the EqualFold predicate on the "reviewer_name" field.
+func ReviewerNameEqualFold(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEqualFold(FieldReviewerName, v))
+}
+
+// ReviewerNameContainsFold applies the ContainsFold predicate on the "reviewer_name" field.
+func ReviewerNameContainsFold(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldContainsFold(FieldReviewerName, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// ReviewNotesEQ applies the EQ predicate on the "review_notes" field.
+func ReviewNotesEQ(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldReviewNotes, v))
+}
+
+// ReviewNotesNEQ applies the NEQ predicate on the "review_notes" field.
+func ReviewNotesNEQ(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldReviewNotes, v))
+}
+
+// ReviewNotesIn applies the In predicate on the "review_notes" field.
+func ReviewNotesIn(vs ...string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldReviewNotes, vs...))
+}
+
+// ReviewNotesNotIn applies the NotIn predicate on the "review_notes" field.
+func ReviewNotesNotIn(vs ...string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldReviewNotes, vs...))
+}
+
+// ReviewNotesGT applies the GT predicate on the "review_notes" field.
+func ReviewNotesGT(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldReviewNotes, v))
+}
+
+// ReviewNotesGTE applies the GTE predicate on the "review_notes" field.
+func ReviewNotesGTE(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldReviewNotes, v))
+}
+
+// ReviewNotesLT applies the LT predicate on the "review_notes" field.
+func ReviewNotesLT(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldReviewNotes, v))
+}
+
+// ReviewNotesLTE applies the LTE predicate on the "review_notes" field.
+func ReviewNotesLTE(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldReviewNotes, v))
+}
+
+// ReviewNotesContains applies the Contains predicate on the "review_notes" field.
+func ReviewNotesContains(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldContains(FieldReviewNotes, v))
+}
+
+// ReviewNotesHasPrefix applies the HasPrefix predicate on the "review_notes" field.
+func ReviewNotesHasPrefix(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldHasPrefix(FieldReviewNotes, v))
+}
+
+// ReviewNotesHasSuffix applies the HasSuffix predicate on the "review_notes" field.
+func ReviewNotesHasSuffix(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldHasSuffix(FieldReviewNotes, v))
+}
+
+// ReviewNotesIsNil applies the IsNil predicate on the "review_notes" field.
+func ReviewNotesIsNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIsNull(FieldReviewNotes))
+}
+
+// ReviewNotesNotNil applies the NotNil predicate on the "review_notes" field.
+func ReviewNotesNotNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotNull(FieldReviewNotes))
+}
+
+// ReviewNotesEqualFold applies the EqualFold predicate on the "review_notes" field.
+func ReviewNotesEqualFold(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEqualFold(FieldReviewNotes, v))
+}
+
+// ReviewNotesContainsFold applies the ContainsFold predicate on the "review_notes" field.
+func ReviewNotesContainsFold(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldContainsFold(FieldReviewNotes, v))
+}
+
+// SigningRequestIDEQ applies the EQ predicate on the "signing_request_id" field.
+func SigningRequestIDEQ(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEQ(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDNEQ applies the NEQ predicate on the "signing_request_id" field.
+func SigningRequestIDNEQ(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNEQ(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDIn applies the In predicate on the "signing_request_id" field.
+func SigningRequestIDIn(vs ...string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIn(FieldSigningRequestID, vs...))
+}
+
+// SigningRequestIDNotIn applies the NotIn predicate on the "signing_request_id" field.
+func SigningRequestIDNotIn(vs ...string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotIn(FieldSigningRequestID, vs...))
+}
+
+// SigningRequestIDGT applies the GT predicate on the "signing_request_id" field.
+func SigningRequestIDGT(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGT(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDGTE applies the GTE predicate on the "signing_request_id" field.
+func SigningRequestIDGTE(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldGTE(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDLT applies the LT predicate on the "signing_request_id" field.
+func SigningRequestIDLT(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLT(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDLTE applies the LTE predicate on the "signing_request_id" field.
+func SigningRequestIDLTE(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldLTE(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDContains applies the Contains predicate on the "signing_request_id" field.
+func SigningRequestIDContains(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldContains(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDHasPrefix applies the HasPrefix predicate on the "signing_request_id" field.
+func SigningRequestIDHasPrefix(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldHasPrefix(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDHasSuffix applies the HasSuffix predicate on the "signing_request_id" field.
+func SigningRequestIDHasSuffix(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldHasSuffix(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDIsNil applies the IsNil predicate on the "signing_request_id" field.
+func SigningRequestIDIsNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIsNull(FieldSigningRequestID))
+}
+
+// SigningRequestIDNotNil applies the NotNil predicate on the "signing_request_id" field.
+func SigningRequestIDNotNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotNull(FieldSigningRequestID))
+}
+
+// SigningRequestIDEqualFold applies the EqualFold predicate on the "signing_request_id" field.
+func SigningRequestIDEqualFold(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldEqualFold(FieldSigningRequestID, v))
+}
+
+// SigningRequestIDContainsFold applies the ContainsFold predicate on the "signing_request_id" field.
+func SigningRequestIDContainsFold(v string) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldContainsFold(FieldSigningRequestID, v))
+}
+
+// PolicyOverrideIsNil applies the IsNil predicate on the "policy_override" field.
+func PolicyOverrideIsNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldIsNull(FieldPolicyOverride))
+}
+
+// PolicyOverrideNotNil applies the NotNil predicate on the "policy_override" field.
+func PolicyOverrideNotNil() predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.FieldNotNull(FieldPolicyOverride))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LeaveAmendment) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LeaveAmendment) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LeaveAmendment) predicate.LeaveAmendment {
+	return predicate.LeaveAmendment(sql.NotPredicates(p))
+}
@@ -0,0 +1,2502 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveamendment"
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+)
+
+// LeaveAmendmentCreate is the builder for creating a LeaveAmendment entity.
+type LeaveAmendmentCreate struct {
+	config
+	mutation *LeaveAmendmentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *LeaveAmendmentCreate) SetCreateBy(v uint32) *LeaveAmendmentCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableCreateBy(v *uint32) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *LeaveAmendmentCreate) SetUpdateBy(v uint32) *LeaveAmendmentCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableUpdateBy(v *uint32) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *LeaveAmendmentCreate) SetCreateTime(v time.Time) *LeaveAmendmentCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableCreateTime(v *time.Time) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *LeaveAmendmentCreate) SetUpdateTime(v time.Time) *LeaveAmendmentCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableUpdateTime(v *time.Time) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *LeaveAmendmentCreate) SetDeleteTime(v time.Time) *LeaveAmendmentCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableDeleteTime(v *time.Time) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *LeaveAmendmentCreate) SetTenantID(v uint32) *LeaveAmendmentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableTenantID(v *uint32) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (_c *LeaveAmendmentCreate) SetLeaveRequestID(v string) *LeaveAmendmentCreate {
+	_c.mutation.SetLeaveRequestID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LeaveAmendmentCreate) SetUserID(v uint32) *LeaveAmendmentCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *LeaveAmendmentCreate) SetKind(v leaveamendment.Kind) *LeaveAmendmentCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableKind(v *leaveamendment.Kind) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *LeaveAmendmentCreate) SetStatus(v leaveamendment.Status) *LeaveAmendmentCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableStatus(v *leaveamendment.Status) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetPreviousStartDate sets the "previous_start_date" field.
+func (_c *LeaveAmendmentCreate) SetPreviousStartDate(v time.Time) *LeaveAmendmentCreate {
+	_c.mutation.SetPreviousStartDate(v)
+	return _c
+}
+
+// SetPreviousEndDate sets the "previous_end_date" field.
+func (_c *LeaveAmendmentCreate) SetPreviousEndDate(v time.Time) *LeaveAmendmentCreate {
+	_c.mutation.SetPreviousEndDate(v)
+	return _c
+}
+
+// SetPreviousStartDayPart sets the "previous_start_day_part" field.
+func (_c *LeaveAmendmentCreate) SetPreviousStartDayPart(v leaveamendment.PreviousStartDayPart) *LeaveAmendmentCreate {
+	_c.mutation.SetPreviousStartDayPart(v)
+	return _c
+}
+
+// SetNillablePreviousStartDayPart sets the "previous_start_day_part" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillablePreviousStartDayPart(v *leaveamendment.PreviousStartDayPart) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetPreviousStartDayPart(*v)
+	}
+	return _c
+}
+
+// SetPreviousEndDayPart sets the "previous_end_day_part" field.
+func (_c *LeaveAmendmentCreate) SetPreviousEndDayPart(v leaveamendment.PreviousEndDayPart) *LeaveAmendmentCreate {
+	_c.mutation.SetPreviousEndDayPart(v)
+	return _c
+}
+
+// SetNillablePreviousEndDayPart sets the "previous_end_day_part" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillablePreviousEndDayPart(v *leaveamendment.PreviousEndDayPart) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetPreviousEndDayPart(*v)
+	}
+	return _c
+}
+
+// SetPreviousDays sets the "previous_days" field.
+func (_c *LeaveAmendmentCreate) SetPreviousDays(v float64) *LeaveAmendmentCreate {
+	_c.mutation.SetPreviousDays(v)
+	return _c
+}
+
+// SetStartDate sets the "start_date" field.
+func (_c *LeaveAmendmentCreate) SetStartDate(v time.Time) *LeaveAmendmentCreate {
+	_c.mutation.SetStartDate(v)
+	return _c
+}
+
+// SetEndDate sets the "end_date" field.
+func (_c *LeaveAmendmentCreate) SetEndDate(v time.Time) *LeaveAmendmentCreate {
+	_c.mutation.SetEndDate(v)
+	return _c
+}
+
+// SetStartDayPart sets the "start_day_part" field.
+func (_c *LeaveAmendmentCreate) SetStartDayPart(v leaveamendment.StartDayPart) *LeaveAmendmentCreate {
+	_c.mutation.SetStartDayPart(v)
+	return _c
+}
+
+// SetNillableStartDayPart sets the "start_day_part" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableStartDayPart(v *leaveamendment.StartDayPart) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetStartDayPart(*v)
+	}
+	return _c
+}
+
+// SetEndDayPart sets the "end_day_part" field.
+func (_c *LeaveAmendmentCreate) SetEndDayPart(v leaveamendment.EndDayPart) *LeaveAmendmentCreate {
+	_c.mutation.SetEndDayPart(v)
+	return _c
+}
+
+// SetNillableEndDayPart sets the "end_day_part" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableEndDayPart(v *leaveamendment.EndDayPart) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetEndDayPart(*v)
+	}
+	return _c
+}
+
+// SetHours sets the "hours" field.
+func (_c *LeaveAmendmentCreate) SetHours(v float64) *LeaveAmendmentCreate {
+	_c.mutation.SetHours(v)
+	return _c
+}
+
+// SetNillableHours sets the "hours" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableHours(v *float64) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetHours(*v)
+	}
+	return _c
+}
+
+// SetDays sets the "days" field.
+func (_c *LeaveAmendmentCreate) SetDays(v float64) *LeaveAmendmentCreate {
+	_c.mutation.SetDays(v)
+	return _c
+}
+
+// SetHolidayCalendarID sets the "holiday_calendar_id" field.
+func (_c *LeaveAmendmentCreate) SetHolidayCalendarID(v string) *LeaveAmendmentCreate {
+	_c.mutation.SetHolidayCalendarID(v)
+	return _c
+}
+
+// SetNillableHolidayCalendarID sets the "holiday_calendar_id" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableHolidayCalendarID(v *string) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetHolidayCalendarID(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *LeaveAmendmentCreate) SetReason(v string) *LeaveAmendmentCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableReason(v *string) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_c *LeaveAmendmentCreate) SetReviewedBy(v uint32) *LeaveAmendmentCreate {
+	_c.mutation.SetReviewedBy(v)
+	return _c
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableReviewedBy(v *uint32) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetReviewedBy(*v)
+	}
+	return _c
+}
+
+// SetReviewerName sets the "reviewer_name" field.
+func (_c *LeaveAmendmentCreate) SetReviewerName(v string) *LeaveAmendmentCreate {
+	_c.mutation.SetReviewerName(v)
+	return _c
+}
+
+// SetNillableReviewerName sets the "reviewer_name" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableReviewerName(v *string) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetReviewerName(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *LeaveAmendmentCreate) SetReviewedAt(v time.Time) *LeaveAmendmentCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableReviewedAt(v *time.Time) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (_c *LeaveAmendmentCreate) SetReviewNotes(v string) *LeaveAmendmentCreate {
+	_c.mutation.SetReviewNotes(v)
+	return _c
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableReviewNotes(v *string) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetReviewNotes(*v)
+	}
+	return _c
+}
+
+// SetSigningRequestID sets the "signing_request_id" field.
+func (_c *LeaveAmendmentCreate) SetSigningRequestID(v string) *LeaveAmendmentCreate {
+	_c.mutation.SetSigningRequestID(v)
+	return _c
+}
+
+// SetNillableSigningRequestID sets the "signing_request_id" field if the given value is not nil.
+func (_c *LeaveAmendmentCreate) SetNillableSigningRequestID(v *string) *LeaveAmendmentCreate {
+	if v != nil {
+		_c.SetSigningRequestID(*v)
+	}
+	return _c
+}
+
+// SetPolicyOverride sets the "policy_override" field.
+func (_c *LeaveAmendmentCreate) SetPolicyOverride(v *policy.Override) *LeaveAmendmentCreate {
+	_c.mutation.SetPolicyOverride(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LeaveAmendmentCreate) SetID(v string) *LeaveAmendmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the LeaveAmendmentMutation object of the builder.
+func (_c *LeaveAmendmentCreate) Mutation() *LeaveAmendmentMutation {
+	return _c.mutation
+}
+
+// Save creates the LeaveAmendment in the database.
+func (_c *LeaveAmendmentCreate) Save(ctx context.Context) (*LeaveAmendment, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LeaveAmendmentCreate) SaveX(ctx context.Context) *LeaveAmendment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeaveAmendmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeaveAmendmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LeaveAmendmentCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := leaveamendment.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := leaveamendment.DefaultKind
+		_c.mutation.SetKind(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := leaveamendment.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.PreviousStartDayPart(); !ok {
+		v := leaveamendment.DefaultPreviousStartDayPart
+		_c.mutation.SetPreviousStartDayPart(v)
+	}
+	if _, ok := _c.mutation.PreviousEndDayPart(); !ok {
+		v := leaveamendment.DefaultPreviousEndDayPart
+		_c.mutation.SetPreviousEndDayPart(v)
+	}
+	if _, ok := _c.mutation.StartDayPart(); !ok {
+		v := leaveamendment.DefaultStartDayPart
+		_c.mutation.SetStartDayPart(v)
+	}
+	if _, ok := _c.mutation.EndDayPart(); !ok {
+		v := leaveamendment.DefaultEndDayPart
+		_c.mutation.SetEndDayPart(v)
+	}
+	if _, ok := _c.mutation.Hours(); !ok {
+		v := leaveamendment.DefaultHours
+		_c.mutation.SetHours(v)
+	}
+	if _, ok := _c.mutation.HolidayCalendarID(); !ok {
+		v := leaveamendment.DefaultHolidayCalendarID
+		_c.mutation.SetHolidayCalendarID(v)
+	}
+	if _, ok := _c.mutation.ReviewedBy(); !ok {
+		v := leaveamendment.DefaultReviewedBy
+		_c.mutation.SetReviewedBy(v)
+	}
+	if _, ok := _c.mutation.ReviewerName(); !ok {
+		v := leaveamendment.DefaultReviewerName
+		_c.mutation.SetReviewerName(v)
+	}
+	if _, ok := _c.mutation.SigningRequestID(); !ok {
+		v := leaveamendment.DefaultSigningRequestID
+		_c.mutation.SetSigningRequestID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LeaveAmendmentCreate) check() error {
+	if _, ok := _c.mutation.LeaveRequestID(); !ok {
+		return &ValidationError{Name: "leave_request_id", err: errors.New(`ent: missing required field "LeaveAmendment.leave_request_id"`)}
+	}
+	if v, ok := _c.mutation.LeaveRequestID(); ok {
+		if err := leaveamendment.LeaveRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "leave_request_id", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.leave_request_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LeaveAmendment.user_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "LeaveAmendment.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := leaveamendment.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "LeaveAmendment.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := leaveamendment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PreviousStartDate(); !ok {
+		return &ValidationError{Name: "previous_start_date", err: errors.New(`ent: missing required field "LeaveAmendment.previous_start_date"`)}
+	}
+	if _, ok := _c.mutation.PreviousEndDate(); !ok {
+		return &ValidationError{Name: "previous_end_date", err: errors.New(`ent: missing required field "LeaveAmendment.previous_end_date"`)}
+	}
+	if _, ok := _c.mutation.PreviousStartDayPart(); !ok {
+		return &ValidationError{Name: "previous_start_day_part", err: errors.New(`ent: missing required field "LeaveAmendment.previous_start_day_part"`)}
+	}
+	if v, ok := _c.mutation.PreviousStartDayPart(); ok {
+		if err := leaveamendment.PreviousStartDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "previous_start_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.previous_start_day_part": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PreviousEndDayPart(); !ok {
+		return &ValidationError{Name: "previous_end_day_part", err: errors.New(`ent: missing required field "LeaveAmendment.previous_end_day_part"`)}
+	}
+	if v, ok := _c.mutation.PreviousEndDayPart(); ok {
+		if err := leaveamendment.PreviousEndDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "previous_end_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.previous_end_day_part": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PreviousDays(); !ok {
+		return &ValidationError{Name: "previous_days", err: errors.New(`ent: missing required field "LeaveAmendment.previous_days"`)}
+	}
+	if _, ok := _c.mutation.StartDate(); !ok {
+		return &ValidationError{Name: "start_date", err: errors.New(`ent: missing required field "LeaveAmendment.start_date"`)}
+	}
+	if _, ok := _c.mutation.EndDate(); !ok {
+		return &ValidationError{Name: "end_date", err: errors.New(`ent: missing required field "LeaveAmendment.end_date"`)}
+	}
+	if _, ok := _c.mutation.StartDayPart(); !ok {
+		return &ValidationError{Name: "start_day_part", err: errors.New(`ent: missing required field "LeaveAmendment.start_day_part"`)}
+	}
+	if v, ok := _c.mutation.StartDayPart(); ok {
+		if err := leaveamendment.StartDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "start_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.start_day_part": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EndDayPart(); !ok {
+		return &ValidationError{Name: "end_day_part", err: errors.New(`ent: missing required field "LeaveAmendment.end_day_part"`)}
+	}
+	if v, ok := _c.mutation.EndDayPart(); ok {
+		if err := leaveamendment.EndDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "end_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.end_day_part": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Hours(); !ok {
+		return &ValidationError{Name: "hours", err: errors.New(`ent: missing required field "LeaveAmendment.hours"`)}
+	}
+	if _, ok := _c.mutation.Days(); !ok {
+		return &ValidationError{Name: "days", err: errors.New(`ent: missing required field "LeaveAmendment.days"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := leaveamendment.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *LeaveAmendmentCreate) sqlSave(ctx context.Context) (*LeaveAmendment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LeaveAmendment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LeaveAmendmentCreate) createSpec() (*LeaveAmendment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeaveAmendment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(leaveamendment.Table, sqlgraph.NewFieldSpec(leaveamendment.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(leaveamendment.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(leaveamendment.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(leaveamendment.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(leaveamendment.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(leaveamendment.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(leaveamendment.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.LeaveRequestID(); ok {
+		_spec.SetField(leaveamendment.FieldLeaveRequestID, field.TypeString, value)
+		_node.LeaveRequestID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(leaveamendment.FieldUserID, field.TypeUint32, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(leaveamendment.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(leaveamendment.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.PreviousStartDate(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousStartDate, field.TypeTime, value)
+		_node.PreviousStartDate = value
+	}
+	if value, ok := _c.mutation.PreviousEndDate(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousEndDate, field.TypeTime, value)
+		_node.PreviousEndDate = value
+	}
+	if value, ok := _c.mutation.PreviousStartDayPart(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousStartDayPart, field.TypeEnum, value)
+		_node.PreviousStartDayPart = value
+	}
+	if value, ok := _c.mutation.PreviousEndDayPart(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousEndDayPart, field.TypeEnum, value)
+		_node.PreviousEndDayPart = value
+	}
+	if value, ok := _c.mutation.PreviousDays(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousDays, field.TypeFloat64, value)
+		_node.PreviousDays = value
+	}
+	if value, ok := _c.mutation.StartDate(); ok {
+		_spec.SetField(leaveamendment.FieldStartDate, field.TypeTime, value)
+		_node.StartDate = value
+	}
+	if value, ok := _c.mutation.EndDate(); ok {
+		_spec.SetField(leaveamendment.FieldEndDate, field.TypeTime, value)
+		_node.EndDate = value
+	}
+	if value, ok := _c.mutation.StartDayPart(); ok {
+		_spec.SetField(leaveamendment.FieldStartDayPart, field.TypeEnum, value)
+		_node.StartDayPart = value
+	}
+	if value, ok := _c.mutation.EndDayPart(); ok {
+		_spec.SetField(leaveamendment.FieldEndDayPart, field.TypeEnum, value)
+		_node.EndDayPart = value
+	}
+	if value, ok := _c.mutation.Hours(); ok {
+		_spec.SetField(leaveamendment.FieldHours, field.TypeFloat64, value)
+		_node.Hours = value
+	}
+	if value, ok := _c.mutation.Days(); ok {
+		_spec.SetField(leaveamendment.FieldDays, field.TypeFloat64, value)
+		_node.Days = value
+	}
+	if value, ok := _c.mutation.HolidayCalendarID(); ok {
+		_spec.SetField(leaveamendment.FieldHolidayCalendarID, field.TypeString, value)
+		_node.HolidayCalendarID = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(leaveamendment.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.ReviewedBy(); ok {
+		_spec.SetField(leaveamendment.FieldReviewedBy, field.TypeUint32, value)
+		_node.ReviewedBy = value
+	}
+	if value, ok := _c.mutation.ReviewerName(); ok {
+		_spec.SetField(leaveamendment.FieldReviewerName, field.TypeString, value)
+		_node.ReviewerName = value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(leaveamendment.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := _c.mutation.ReviewNotes(); ok {
+		_spec.SetField(leaveamendment.FieldReviewNotes, field.TypeString, value)
+		_node.ReviewNotes = value
+	}
+	if value, ok := _c.mutation.SigningRequestID(); ok {
+		_spec.SetField(leaveamendment.FieldSigningRequestID, field.TypeString, value)
+		_node.SigningRequestID = value
+	}
+	if value, ok := _c.mutation.PolicyOverride(); ok {
+		_spec.SetField(leaveamendment.FieldPolicyOverride, field.TypeJSON, value)
+		_node.PolicyOverride = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LeaveAmendment.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeaveAmendmentUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LeaveAmendmentCreate) OnConflict(opts ...sql.ConflictOption) *LeaveAmendmentUpsertOne {
+	_c.conflict = opts
+	return &LeaveAmendmentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LeaveAmendment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LeaveAmendmentCreate) OnConflictColumns(columns ...string) *LeaveAmendmentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LeaveAmendmentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LeaveAmendmentUpsertOne is the builder for "upsert"-ing
+	//  one LeaveAmendment node.
+	LeaveAmendmentUpsertOne struct {
+		create *LeaveAmendmentCreate
+	}
+
+	// LeaveAmendmentUpsert is the "OnConflict" setter.
+	LeaveAmendmentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *LeaveAmendmentUpsert) SetCreateBy(v uint32) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateCreateBy() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *LeaveAmendmentUpsert) AddCreateBy(v uint32) *LeaveAmendmentUpsert {
+	u.Add(leaveamendment.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *LeaveAmendmentUpsert) ClearCreateBy() *LeaveAmendmentUpsert {
+	u.SetNull(leaveamendment.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *LeaveAmendmentUpsert) SetUpdateBy(v uint32) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateUpdateBy() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *LeaveAmendmentUpsert) AddUpdateBy(v uint32) *LeaveAmendmentUpsert {
+	u.Add(leaveamendment.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *LeaveAmendmentUpsert) ClearUpdateBy() *LeaveAmendmentUpsert {
+	u.SetNull(leaveamendment.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LeaveAmendmentUpsert) SetUpdateTime(v time.Time) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateUpdateTime() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *LeaveAmendmentUpsert) ClearUpdateTime() *LeaveAmendmentUpsert {
+	u.SetNull(leaveamendment.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *LeaveAmendmentUpsert) SetDeleteTime(v time.Time) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateDeleteTime() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *LeaveAmendmentUpsert) ClearDeleteTime() *LeaveAmendmentUpsert {
+	u.SetNull(leaveamendment.FieldDeleteTime)
+	return u
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (u *LeaveAmendmentUpsert) SetLeaveRequestID(v string) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldLeaveRequestID, v)
+	return u
+}
+
+// UpdateLeaveRequestID sets the "leave_request_id" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateLeaveRequestID() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldLeaveRequestID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LeaveAmendmentUpsert) SetUserID(v uint32) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateUserID() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *LeaveAmendmentUpsert) AddUserID(v uint32) *LeaveAmendmentUpsert {
+	u.Add(leaveamendment.FieldUserID, v)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *LeaveAmendmentUpsert) SetKind(v leaveamendment.Kind) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateKind() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldKind)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *LeaveAmendmentUpsert) SetStatus(v leaveamendment.Status) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateStatus() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldStatus)
+	return u
+}
+
+// SetPreviousStartDate sets the "previous_start_date" field.
+func (u *LeaveAmendmentUpsert) SetPreviousStartDate(v time.Time) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldPreviousStartDate, v)
+	return u
+}
+
+// UpdatePreviousStartDate sets the "previous_start_date" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdatePreviousStartDate() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldPreviousStartDate)
+	return u
+}
+
+// SetPreviousEndDate sets the "previous_end_date" field.
+func (u *LeaveAmendmentUpsert) SetPreviousEndDate(v time.Time) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldPreviousEndDate, v)
+	return u
+}
+
+// UpdatePreviousEndDate sets the "previous_end_date" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdatePreviousEndDate() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldPreviousEndDate)
+	return u
+}
+
+// SetPreviousStartDayPart sets the "previous_start_day_part" field.
+func (u *LeaveAmendmentUpsert) SetPreviousStartDayPart(v leaveamendment.PreviousStartDayPart) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldPreviousStartDayPart, v)
+	return u
+}
+
+// UpdatePreviousStartDayPart sets the "previous_start_day_part" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdatePreviousStartDayPart() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldPreviousStartDayPart)
+	return u
+}
+
+// SetPreviousEndDayPart sets the "previous_end_day_part" field.
+func (u *LeaveAmendmentUpsert) SetPreviousEndDayPart(v leaveamendment.PreviousEndDayPart) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldPreviousEndDayPart, v)
+	return u
+}
+
+// UpdatePreviousEndDayPart sets the "previous_end_day_part" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdatePreviousEndDayPart() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldPreviousEndDayPart)
+	return u
+}
+
+// SetPreviousDays sets the "previous_days" field.
+func (u *LeaveAmendmentUpsert) SetPreviousDays(v float64) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldPreviousDays, v)
+	return u
+}
+
+// UpdatePreviousDays sets the "previous_days" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdatePreviousDays() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldPreviousDays)
+	return u
+}
+
+// AddPreviousDays adds v to the "previous_days" field.
+func (u *LeaveAmendmentUpsert) AddPreviousDays(v float64) *LeaveAmendmentUpsert {
+	u.Add(leaveamendment.FieldPreviousDays, v)
+	return u
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *LeaveAmendmentUpsert) SetStartDate(v time.Time) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldStartDate, v)
+	return u
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateStartDate() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldStartDate)
+	return u
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *LeaveAmendmentUpsert) SetEndDate(v time.Time) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldEndDate, v)
+	return u
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateEndDate() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldEndDate)
+	return u
+}
+
+// SetStartDayPart sets the "start_day_part" field.
+func (u *LeaveAmendmentUpsert) SetStartDayPart(v leaveamendment.StartDayPart) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldStartDayPart, v)
+	return u
+}
+
+// UpdateStartDayPart sets the "start_day_part" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateStartDayPart() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldStartDayPart)
+	return u
+}
+
+// SetEndDayPart sets the "end_day_part" field.
+func (u *LeaveAmendmentUpsert) SetEndDayPart(v leaveamendment.EndDayPart) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldEndDayPart, v)
+	return u
+}
+
+// UpdateEndDayPart sets the "end_day_part" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateEndDayPart() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldEndDayPart)
+	return u
+}
+
+// SetHours sets the "hours" field.
+func (u *LeaveAmendmentUpsert) SetHours(v float64) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldHours, v)
+	return u
+}
+
+// UpdateHours sets the "hours" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateHours() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldHours)
+	return u
+}
+
+// AddHours adds v to the "hours" field.
+func (u *LeaveAmendmentUpsert) AddHours(v float64) *LeaveAmendmentUpsert {
+	u.Add(leaveamendment.FieldHours, v)
+	return u
+}
+
+// SetDays sets the "days" field.
+func (u *LeaveAmendmentUpsert) SetDays(v float64) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldDays, v)
+	return u
+}
+
+// UpdateDays sets the "days" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateDays() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldDays)
+	return u
+}
+
+// AddDays adds v to the "days" field.
+func (u *LeaveAmendmentUpsert) AddDays(v float64) *LeaveAmendmentUpsert {
+	u.Add(leaveamendment.FieldDays, v)
+	return u
+}
+
+// SetHolidayCalendarID sets the "holiday_calendar_id" field.
+func (u *LeaveAmendmentUpsert) SetHolidayCalendarID(v string) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldHolidayCalendarID, v)
+	return u
+}
+
+// UpdateHolidayCalendarID sets the "holiday_calendar_id" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateHolidayCalendarID() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldHolidayCalendarID)
+	return u
+}
+
+// ClearHolidayCalendarID clears the value of the "holiday_calendar_id" field.
+func (u *LeaveAmendmentUpsert) ClearHolidayCalendarID() *LeaveAmendmentUpsert {
+	u.SetNull(leaveamendment.FieldHolidayCalendarID)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *LeaveAmendmentUpsert) SetReason(v string) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateReason() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *LeaveAmendmentUpsert) ClearReason() *LeaveAmendmentUpsert {
+	u.SetNull(leaveamendment.FieldReason)
+	return u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *LeaveAmendmentUpsert) SetReviewedBy(v uint32) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldReviewedBy, v)
+	return u
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateReviewedBy() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldReviewedBy)
+	return u
+}
+
+// AddReviewedBy adds v to the "reviewed_by" field.
+func (u *LeaveAmendmentUpsert) AddReviewedBy(v uint32) *LeaveAmendmentUpsert {
+	u.Add(leaveamendment.FieldReviewedBy, v)
+	return u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *LeaveAmendmentUpsert) ClearReviewedBy() *LeaveAmendmentUpsert {
+	u.SetNull(leaveamendment.FieldReviewedBy)
+	return u
+}
+
+// SetReviewerName sets the "reviewer_name" field.
+func (u *LeaveAmendmentUpsert) SetReviewerName(v string) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldReviewerName, v)
+	return u
+}
+
+// UpdateReviewerName sets the "reviewer_name" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateReviewerName() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldReviewerName)
+	return u
+}
+
+// ClearReviewerName clears the value of the "reviewer_name" field.
+func (u *LeaveAmendmentUpsert) ClearReviewerName() *LeaveAmendmentUpsert {
+	u.SetNull(leaveamendment.FieldReviewerName)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *LeaveAmendmentUpsert) SetReviewedAt(v time.Time) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateReviewedAt() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *LeaveAmendmentUpsert) ClearReviewedAt() *LeaveAmendmentUpsert {
+	u.SetNull(leaveamendment.FieldReviewedAt)
+	return u
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (u *LeaveAmendmentUpsert) SetReviewNotes(v string) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldReviewNotes, v)
+	return u
+}
+
+// UpdateReviewNotes sets the "review_notes" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateReviewNotes() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldReviewNotes)
+	return u
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (u *LeaveAmendmentUpsert) ClearReviewNotes() *LeaveAmendmentUpsert {
+	u.SetNull(leaveamendment.FieldReviewNotes)
+	return u
+}
+
+// SetSigningRequestID sets the "signing_request_id" field.
+func (u *LeaveAmendmentUpsert) SetSigningRequestID(v string) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldSigningRequestID, v)
+	return u
+}
+
+// UpdateSigningRequestID sets the "signing_request_id" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdateSigningRequestID() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldSigningRequestID)
+	return u
+}
+
+// ClearSigningRequestID clears the value of the "signing_request_id" field.
+func (u *LeaveAmendmentUpsert) ClearSigningRequestID() *LeaveAmendmentUpsert {
+	u.SetNull(leaveamendment.FieldSigningRequestID)
+	return u
+}
+
+// SetPolicyOverride sets the "policy_override" field.
+func (u *LeaveAmendmentUpsert) SetPolicyOverride(v *policy.Override) *LeaveAmendmentUpsert {
+	u.Set(leaveamendment.FieldPolicyOverride, v)
+	return u
+}
+
+// UpdatePolicyOverride sets the "policy_override" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsert) UpdatePolicyOverride() *LeaveAmendmentUpsert {
+	u.SetExcluded(leaveamendment.FieldPolicyOverride)
+	return u
+}
+
+// ClearPolicyOverride clears the value of the "policy_override" field.
+func (u *LeaveAmendmentUpsert) ClearPolicyOverride() *LeaveAmendmentUpsert {
+	u.SetNull(leaveamendment.FieldPolicyOverride)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LeaveAmendment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leaveamendment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeaveAmendmentUpsertOne) UpdateNewValues() *LeaveAmendmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(leaveamendment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(leaveamendment.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(leaveamendment.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LeaveAmendment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LeaveAmendmentUpsertOne) Ignore() *LeaveAmendmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaveAmendmentUpsertOne) DoNothing() *LeaveAmendmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaveAmendmentCreate.OnConflict
+// documentation for more info.
+func (u *LeaveAmendmentUpsertOne) Update(set func(*LeaveAmendmentUpsert)) *LeaveAmendmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaveAmendmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *LeaveAmendmentUpsertOne) SetCreateBy(v uint32) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *LeaveAmendmentUpsertOne) AddCreateBy(v uint32) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateCreateBy() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *LeaveAmendmentUpsertOne) ClearCreateBy() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *LeaveAmendmentUpsertOne) SetUpdateBy(v uint32) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *LeaveAmendmentUpsertOne) AddUpdateBy(v uint32) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateUpdateBy() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *LeaveAmendmentUpsertOne) ClearUpdateBy() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LeaveAmendmentUpsertOne) SetUpdateTime(v time.Time) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateUpdateTime() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *LeaveAmendmentUpsertOne) ClearUpdateTime() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *LeaveAmendmentUpsertOne) SetDeleteTime(v time.Time) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateDeleteTime() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *LeaveAmendmentUpsertOne) ClearDeleteTime() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (u *LeaveAmendmentUpsertOne) SetLeaveRequestID(v string) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetLeaveRequestID(v)
+	})
+}
+
+// UpdateLeaveRequestID sets the "leave_request_id" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateLeaveRequestID() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateLeaveRequestID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LeaveAmendmentUpsertOne) SetUserID(v uint32) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *LeaveAmendmentUpsertOne) AddUserID(v uint32) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateUserID() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *LeaveAmendmentUpsertOne) SetKind(v leaveamendment.Kind) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateKind() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *LeaveAmendmentUpsertOne) SetStatus(v leaveamendment.Status) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateStatus() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPreviousStartDate sets the "previous_start_date" field.
+func (u *LeaveAmendmentUpsertOne) SetPreviousStartDate(v time.Time) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetPreviousStartDate(v)
+	})
+}
+
+// UpdatePreviousStartDate sets the "previous_start_date" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdatePreviousStartDate() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdatePreviousStartDate()
+	})
+}
+
+// SetPreviousEndDate sets the "previous_end_date" field.
+func (u *LeaveAmendmentUpsertOne) SetPreviousEndDate(v time.Time) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetPreviousEndDate(v)
+	})
+}
+
+// UpdatePreviousEndDate sets the "previous_end_date" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdatePreviousEndDate() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdatePreviousEndDate()
+	})
+}
+
+// SetPreviousStartDayPart sets the "previous_start_day_part" field.
+func (u *LeaveAmendmentUpsertOne) SetPreviousStartDayPart(v leaveamendment.PreviousStartDayPart) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetPreviousStartDayPart(v)
+	})
+}
+
+// UpdatePreviousStartDayPart sets the "previous_start_day_part" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdatePreviousStartDayPart() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdatePreviousStartDayPart()
+	})
+}
+
+// SetPreviousEndDayPart sets the "previous_end_day_part" field.
+func (u *LeaveAmendmentUpsertOne) SetPreviousEndDayPart(v leaveamendment.PreviousEndDayPart) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetPreviousEndDayPart(v)
+	})
+}
+
+// UpdatePreviousEndDayPart sets the "previous_end_day_part" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdatePreviousEndDayPart() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdatePreviousEndDayPart()
+	})
+}
+
+// SetPreviousDays sets the "previous_days" field.
+func (u *LeaveAmendmentUpsertOne) SetPreviousDays(v float64) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetPreviousDays(v)
+	})
+}
+
+// AddPreviousDays adds v to the "previous_days" field.
+func (u *LeaveAmendmentUpsertOne) AddPreviousDays(v float64) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.AddPreviousDays(v)
+	})
+}
+
+// UpdatePreviousDays sets the "previous_days" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdatePreviousDays() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdatePreviousDays()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *LeaveAmendmentUpsertOne) SetStartDate(v time.Time) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateStartDate() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *LeaveAmendmentUpsertOne) SetEndDate(v time.Time) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateEndDate() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// SetStartDayPart sets the "start_day_part" field.
+func (u *LeaveAmendmentUpsertOne) SetStartDayPart(v leaveamendment.StartDayPart) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetStartDayPart(v)
+	})
+}
+
+// UpdateStartDayPart sets the "start_day_part" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateStartDayPart() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateStartDayPart()
+	})
+}
+
+// SetEndDayPart sets the "end_day_part" field.
+func (u *LeaveAmendmentUpsertOne) SetEndDayPart(v leaveamendment.EndDayPart) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetEndDayPart(v)
+	})
+}
+
+// UpdateEndDayPart sets the "end_day_part" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateEndDayPart() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateEndDayPart()
+	})
+}
+
+// SetHours sets the "hours" field.
+func (u *LeaveAmendmentUpsertOne) SetHours(v float64) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetHours(v)
+	})
+}
+
+// AddHours adds v to the "hours" field.
+func (u *LeaveAmendmentUpsertOne) AddHours(v float64) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.AddHours(v)
+	})
+}
+
+// UpdateHours sets the "hours" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateHours() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateHours()
+	})
+}
+
+// SetDays sets the "days" field.
+func (u *LeaveAmendmentUpsertOne) SetDays(v float64) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetDays(v)
+	})
+}
+
+// AddDays adds v to the "days" field.
+func (u *LeaveAmendmentUpsertOne) AddDays(v float64) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.AddDays(v)
+	})
+}
+
+// UpdateDays sets the "days" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateDays() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateDays()
+	})
+}
+
+// SetHolidayCalendarID sets the "holiday_calendar_id" field.
+func (u *LeaveAmendmentUpsertOne) SetHolidayCalendarID(v string) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetHolidayCalendarID(v)
+	})
+}
+
+// UpdateHolidayCalendarID sets the "holiday_calendar_id" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateHolidayCalendarID() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateHolidayCalendarID()
+	})
+}
+
+// ClearHolidayCalendarID clears the value of the "holiday_calendar_id" field.
+func (u *LeaveAmendmentUpsertOne) ClearHolidayCalendarID() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearHolidayCalendarID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *LeaveAmendmentUpsertOne) SetReason(v string) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateReason() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *LeaveAmendmentUpsertOne) ClearReason() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *LeaveAmendmentUpsertOne) SetReviewedBy(v uint32) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// AddReviewedBy adds v to the "reviewed_by" field.
+func (u *LeaveAmendmentUpsertOne) AddReviewedBy(v uint32) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.AddReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateReviewedBy() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *LeaveAmendmentUpsertOne) ClearReviewedBy() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewerName sets the "reviewer_name" field.
+func (u *LeaveAmendmentUpsertOne) SetReviewerName(v string) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetReviewerName(v)
+	})
+}
+
+// UpdateReviewerName sets the "reviewer_name" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateReviewerName() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateReviewerName()
+	})
+}
+
+// ClearReviewerName clears the value of the "reviewer_name" field.
+func (u *LeaveAmendmentUpsertOne) ClearReviewerName() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearReviewerName()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *LeaveAmendmentUpsertOne) SetReviewedAt(v time.Time) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateReviewedAt() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *LeaveAmendmentUpsertOne) ClearReviewedAt() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (u *LeaveAmendmentUpsertOne) SetReviewNotes(v string) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetReviewNotes(v)
+	})
+}
+
+// UpdateReviewNotes sets the "review_notes" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateReviewNotes() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateReviewNotes()
+	})
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (u *LeaveAmendmentUpsertOne) ClearReviewNotes() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearReviewNotes()
+	})
+}
+
+// SetSigningRequestID sets the "signing_request_id" field.
+func (u *LeaveAmendmentUpsertOne) SetSigningRequestID(v string) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetSigningRequestID(v)
+	})
+}
+
+// UpdateSigningRequestID sets the "signing_request_id" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdateSigningRequestID() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateSigningRequestID()
+	})
+}
+
+// ClearSigningRequestID clears the value of the "signing_request_id" field.
+func (u *LeaveAmendmentUpsertOne) ClearSigningRequestID() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearSigningRequestID()
+	})
+}
+
+// SetPolicyOverride sets the "policy_override" field.
+func (u *LeaveAmendmentUpsertOne) SetPolicyOverride(v *policy.Override) *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetPolicyOverride(v)
+	})
+}
+
+// UpdatePolicyOverride sets the "policy_override" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertOne) UpdatePolicyOverride() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdatePolicyOverride()
+	})
+}
+
+// ClearPolicyOverride clears the value of the "policy_override" field.
+func (u *LeaveAmendmentUpsertOne) ClearPolicyOverride() *LeaveAmendmentUpsertOne {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearPolicyOverride()
+	})
+}
+
+// Exec executes the query.
+func (u *LeaveAmendmentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LeaveAmendmentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeaveAmendmentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LeaveAmendmentUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LeaveAmendmentUpsertOne.ID is not supported by MySQL driver. Use LeaveAmendmentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LeaveAmendmentUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LeaveAmendmentCreateBulk is the builder for creating many LeaveAmendment entities in bulk.
+type LeaveAmendmentCreateBulk struct {
+	config
+	err      error
+	builders []*LeaveAmendmentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LeaveAmendment entities in the database.
+func (_c *LeaveAmendmentCreateBulk) Save(ctx context.Context) ([]*LeaveAmendment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LeaveAmendment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeaveAmendmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LeaveAmendmentCreateBulk) SaveX(ctx context.Context) []*LeaveAmendment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeaveAmendmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeaveAmendmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LeaveAmendment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeaveAmendmentUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LeaveAmendmentCreateBulk) OnConflict(opts ...sql.ConflictOption) *LeaveAmendmentUpsertBulk {
+	_c.conflict = opts
+	return &LeaveAmendmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LeaveAmendment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LeaveAmendmentCreateBulk) OnConflictColumns(columns ...string) *LeaveAmendmentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LeaveAmendmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// LeaveAmendmentUpsertBulk is the builder for "upsert"-ing
+// a bulk of LeaveAmendment nodes.
+type LeaveAmendmentUpsertBulk struct {
+	create *LeaveAmendmentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LeaveAmendment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leaveamendment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeaveAmendmentUpsertBulk) UpdateNewValues() *LeaveAmendmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(leaveamendment.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(leaveamendment.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(leaveamendment.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LeaveAmendment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LeaveAmendmentUpsertBulk) Ignore() *LeaveAmendmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaveAmendmentUpsertBulk) DoNothing() *LeaveAmendmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaveAmendmentCreateBulk.OnConflict
+// documentation for more info.
+func (u *LeaveAmendmentUpsertBulk) Update(set func(*LeaveAmendmentUpsert)) *LeaveAmendmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaveAmendmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *LeaveAmendmentUpsertBulk) SetCreateBy(v uint32) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *LeaveAmendmentUpsertBulk) AddCreateBy(v uint32) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateCreateBy() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *LeaveAmendmentUpsertBulk) ClearCreateBy() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *LeaveAmendmentUpsertBulk) SetUpdateBy(v uint32) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *LeaveAmendmentUpsertBulk) AddUpdateBy(v uint32) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateUpdateBy() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *LeaveAmendmentUpsertBulk) ClearUpdateBy() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LeaveAmendmentUpsertBulk) SetUpdateTime(v time.Time) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateUpdateTime() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *LeaveAmendmentUpsertBulk) ClearUpdateTime() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *LeaveAmendmentUpsertBulk) SetDeleteTime(v time.Time) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateDeleteTime() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *LeaveAmendmentUpsertBulk) ClearDeleteTime() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (u *LeaveAmendmentUpsertBulk) SetLeaveRequestID(v string) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetLeaveRequestID(v)
+	})
+}
+
+// UpdateLeaveRequestID sets the "leave_request_id" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateLeaveRequestID() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateLeaveRequestID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *LeaveAmendmentUpsertBulk) SetUserID(v uint32) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *LeaveAmendmentUpsertBulk) AddUserID(v uint32) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateUserID() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *LeaveAmendmentUpsertBulk) SetKind(v leaveamendment.Kind) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateKind() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *LeaveAmendmentUpsertBulk) SetStatus(v leaveamendment.Status) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateStatus() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPreviousStartDate sets the "previous_start_date" field.
+func (u *LeaveAmendmentUpsertBulk) SetPreviousStartDate(v time.Time) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetPreviousStartDate(v)
+	})
+}
+
+// UpdatePreviousStartDate sets the "previous_start_date" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdatePreviousStartDate() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdatePreviousStartDate()
+	})
+}
+
+// SetPreviousEndDate sets the "previous_end_date" field.
+func (u *LeaveAmendmentUpsertBulk) SetPreviousEndDate(v time.Time) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetPreviousEndDate(v)
+	})
+}
+
+// UpdatePreviousEndDate sets the "previous_end_date" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdatePreviousEndDate() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdatePreviousEndDate()
+	})
+}
+
+// SetPreviousStartDayPart sets the "previous_start_day_part" field.
+func (u *LeaveAmendmentUpsertBulk) SetPreviousStartDayPart(v leaveamendment.PreviousStartDayPart) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetPreviousStartDayPart(v)
+	})
+}
+
+// UpdatePreviousStartDayPart sets the "previous_start_day_part" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdatePreviousStartDayPart() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdatePreviousStartDayPart()
+	})
+}
+
+// SetPreviousEndDayPart sets the "previous_end_day_part" field.
+func (u *LeaveAmendmentUpsertBulk) SetPreviousEndDayPart(v leaveamendment.PreviousEndDayPart) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetPreviousEndDayPart(v)
+	})
+}
+
+// UpdatePreviousEndDayPart sets the "previous_end_day_part" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdatePreviousEndDayPart() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdatePreviousEndDayPart()
+	})
+}
+
+// SetPreviousDays sets the "previous_days" field.
+func (u *LeaveAmendmentUpsertBulk) SetPreviousDays(v float64) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetPreviousDays(v)
+	})
+}
+
+// AddPreviousDays adds v to the "previous_days" field.
+func (u *LeaveAmendmentUpsertBulk) AddPreviousDays(v float64) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.AddPreviousDays(v)
+	})
+}
+
+// UpdatePreviousDays sets the "previous_days" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdatePreviousDays() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdatePreviousDays()
+	})
+}
+
+// SetStartDate sets the "start_date" field.
+func (u *LeaveAmendmentUpsertBulk) SetStartDate(v time.Time) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetStartDate(v)
+	})
+}
+
+// UpdateStartDate sets the "start_date" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateStartDate() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateStartDate()
+	})
+}
+
+// SetEndDate sets the "end_date" field.
+func (u *LeaveAmendmentUpsertBulk) SetEndDate(v time.Time) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetEndDate(v)
+	})
+}
+
+// UpdateEndDate sets the "end_date" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateEndDate() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateEndDate()
+	})
+}
+
+// SetStartDayPart sets the "start_day_part" field.
+func (u *LeaveAmendmentUpsertBulk) SetStartDayPart(v leaveamendment.StartDayPart) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetStartDayPart(v)
+	})
+}
+
+// UpdateStartDayPart sets the "start_day_part" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateStartDayPart() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateStartDayPart()
+	})
+}
+
+// SetEndDayPart sets the "end_day_part" field.
+func (u *LeaveAmendmentUpsertBulk) SetEndDayPart(v leaveamendment.EndDayPart) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetEndDayPart(v)
+	})
+}
+
+// UpdateEndDayPart sets the "end_day_part" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateEndDayPart() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateEndDayPart()
+	})
+}
+
+// SetHours sets the "hours" field.
+func (u *LeaveAmendmentUpsertBulk) SetHours(v float64) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetHours(v)
+	})
+}
+
+// AddHours adds v to the "hours" field.
+func (u *LeaveAmendmentUpsertBulk) AddHours(v float64) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.AddHours(v)
+	})
+}
+
+// UpdateHours sets the "hours" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateHours() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateHours()
+	})
+}
+
+// SetDays sets the "days" field.
+func (u *LeaveAmendmentUpsertBulk) SetDays(v float64) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetDays(v)
+	})
+}
+
+// AddDays adds v to the "days" field.
+func (u *LeaveAmendmentUpsertBulk) AddDays(v float64) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.AddDays(v)
+	})
+}
+
+// UpdateDays sets the "days" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateDays() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateDays()
+	})
+}
+
+// SetHolidayCalendarID sets the "holiday_calendar_id" field.
+func (u *LeaveAmendmentUpsertBulk) SetHolidayCalendarID(v string) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetHolidayCalendarID(v)
+	})
+}
+
+// UpdateHolidayCalendarID sets the "holiday_calendar_id" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateHolidayCalendarID() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateHolidayCalendarID()
+	})
+}
+
+// ClearHolidayCalendarID clears the value of the "holiday_calendar_id" field.
+func (u *LeaveAmendmentUpsertBulk) ClearHolidayCalendarID() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearHolidayCalendarID()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *LeaveAmendmentUpsertBulk) SetReason(v string) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateReason() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *LeaveAmendmentUpsertBulk) ClearReason() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *LeaveAmendmentUpsertBulk) SetReviewedBy(v uint32) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// AddReviewedBy adds v to the "reviewed_by" field.
+func (u *LeaveAmendmentUpsertBulk) AddReviewedBy(v uint32) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.AddReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateReviewedBy() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *LeaveAmendmentUpsertBulk) ClearReviewedBy() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewerName sets the "reviewer_name" field.
+func (u *LeaveAmendmentUpsertBulk) SetReviewerName(v string) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetReviewerName(v)
+	})
+}
+
+// UpdateReviewerName sets the "reviewer_name" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateReviewerName() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateReviewerName()
+	})
+}
+
+// ClearReviewerName clears the value of the "reviewer_name" field.
+func (u *LeaveAmendmentUpsertBulk) ClearReviewerName() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearReviewerName()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *LeaveAmendmentUpsertBulk) SetReviewedAt(v time.Time) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateReviewedAt() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *LeaveAmendmentUpsertBulk) ClearReviewedAt() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (u *LeaveAmendmentUpsertBulk) SetReviewNotes(v string) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetReviewNotes(v)
+	})
+}
+
+// UpdateReviewNotes sets the "review_notes" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateReviewNotes() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateReviewNotes()
+	})
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (u *LeaveAmendmentUpsertBulk) ClearReviewNotes() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearReviewNotes()
+	})
+}
+
+// SetSigningRequestID sets the "signing_request_id" field.
+func (u *LeaveAmendmentUpsertBulk) SetSigningRequestID(v string) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetSigningRequestID(v)
+	})
+}
+
+// UpdateSigningRequestID sets the "signing_request_id" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdateSigningRequestID() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdateSigningRequestID()
+	})
+}
+
+// ClearSigningRequestID clears the value of the "signing_request_id" field.
+func (u *LeaveAmendmentUpsertBulk) ClearSigningRequestID() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearSigningRequestID()
+	})
+}
+
+// SetPolicyOverride sets the "policy_override" field.
+func (u *LeaveAmendmentUpsertBulk) SetPolicyOverride(v *policy.Override) *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.SetPolicyOverride(v)
+	})
+}
+
+// UpdatePolicyOverride sets the "policy_override" field to the value that was provided on create.
+func (u *LeaveAmendmentUpsertBulk) UpdatePolicyOverride() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.UpdatePolicyOverride()
+	})
+}
+
+// ClearPolicyOverride clears the value of the "policy_override" field.
+func (u *LeaveAmendmentUpsertBulk) ClearPolicyOverride() *LeaveAmendmentUpsertBulk {
+	return u.Update(func(s *LeaveAmendmentUpsert) {
+		s.ClearPolicyOverride()
+	})
+}
+
+// Exec executes the query.
+func (u *LeaveAmendmentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LeaveAmendmentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LeaveAmendmentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeaveAmendmentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveamendment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// LeaveAmendmentDelete is the builder for deleting a LeaveAmendment entity.
+type LeaveAmendmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *LeaveAmendmentMutation
+}
+
+// Where appends a list predicates to the LeaveAmendmentDelete builder.
+func (_d *LeaveAmendmentDelete) Where(ps ...predicate.LeaveAmendment) *LeaveAmendmentDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *LeaveAmendmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LeaveAmendmentDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *LeaveAmendmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(leaveamendment.Table, sqlgraph.NewFieldSpec(leaveamendment.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// LeaveAmendmentDeleteOne is the builder for deleting a single LeaveAmendment entity.
+type LeaveAmendmentDeleteOne struct {
+	_d *LeaveAmendmentDelete
+}
+
+// Where appends a list predicates to the LeaveAmendmentDelete builder.
+func (_d *LeaveAmendmentDeleteOne) Where(ps ...predicate.LeaveAmendment) *LeaveAmendmentDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *LeaveAmendmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{leaveamendment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LeaveAmendmentDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
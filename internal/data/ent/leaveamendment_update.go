@@ -0,0 +1,1549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveamendment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+)
+
+// LeaveAmendmentUpdate is the builder for updating LeaveAmendment entities.
+type LeaveAmendmentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeaveAmendmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeaveAmendmentUpdate builder.
+func (_u *LeaveAmendmentUpdate) Where(ps ...predicate.LeaveAmendment) *LeaveAmendmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *LeaveAmendmentUpdate) SetCreateBy(v uint32) *LeaveAmendmentUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableCreateBy(v *uint32) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *LeaveAmendmentUpdate) AddCreateBy(v int32) *LeaveAmendmentUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *LeaveAmendmentUpdate) ClearCreateBy() *LeaveAmendmentUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *LeaveAmendmentUpdate) SetUpdateBy(v uint32) *LeaveAmendmentUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableUpdateBy(v *uint32) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *LeaveAmendmentUpdate) AddUpdateBy(v int32) *LeaveAmendmentUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *LeaveAmendmentUpdate) ClearUpdateBy() *LeaveAmendmentUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *LeaveAmendmentUpdate) SetUpdateTime(v time.Time) *LeaveAmendmentUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableUpdateTime(v *time.Time) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *LeaveAmendmentUpdate) ClearUpdateTime() *LeaveAmendmentUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *LeaveAmendmentUpdate) SetDeleteTime(v time.Time) *LeaveAmendmentUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableDeleteTime(v *time.Time) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *LeaveAmendmentUpdate) ClearDeleteTime() *LeaveAmendmentUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (_u *LeaveAmendmentUpdate) SetLeaveRequestID(v string) *LeaveAmendmentUpdate {
+	_u.mutation.SetLeaveRequestID(v)
+	return _u
+}
+
+// SetNillableLeaveRequestID sets the "leave_request_id" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableLeaveRequestID(v *string) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetLeaveRequestID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LeaveAmendmentUpdate) SetUserID(v uint32) *LeaveAmendmentUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableUserID(v *uint32) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *LeaveAmendmentUpdate) AddUserID(v int32) *LeaveAmendmentUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *LeaveAmendmentUpdate) SetKind(v leaveamendment.Kind) *LeaveAmendmentUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableKind(v *leaveamendment.Kind) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LeaveAmendmentUpdate) SetStatus(v leaveamendment.Status) *LeaveAmendmentUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableStatus(v *leaveamendment.Status) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPreviousStartDate sets the "previous_start_date" field.
+func (_u *LeaveAmendmentUpdate) SetPreviousStartDate(v time.Time) *LeaveAmendmentUpdate {
+	_u.mutation.SetPreviousStartDate(v)
+	return _u
+}
+
+// SetNillablePreviousStartDate sets the "previous_start_date" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillablePreviousStartDate(v *time.Time) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetPreviousStartDate(*v)
+	}
+	return _u
+}
+
+// SetPreviousEndDate sets the "previous_end_date" field.
+func (_u *LeaveAmendmentUpdate) SetPreviousEndDate(v time.Time) *LeaveAmendmentUpdate {
+	_u.mutation.SetPreviousEndDate(v)
+	return _u
+}
+
+// SetNillablePreviousEndDate sets the "previous_end_date" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillablePreviousEndDate(v *time.Time) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetPreviousEndDate(*v)
+	}
+	return _u
+}
+
+// SetPreviousStartDayPart sets the "previous_start_day_part" field.
+func (_u *LeaveAmendmentUpdate) SetPreviousStartDayPart(v leaveamendment.PreviousStartDayPart) *LeaveAmendmentUpdate {
+	_u.mutation.SetPreviousStartDayPart(v)
+	return _u
+}
+
+// SetNillablePreviousStartDayPart sets the "previous_start_day_part" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillablePreviousStartDayPart(v *leaveamendment.PreviousStartDayPart) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetPreviousStartDayPart(*v)
+	}
+	return _u
+}
+
+// SetPreviousEndDayPart sets the "previous_end_day_part" field.
+func (_u *LeaveAmendmentUpdate) SetPreviousEndDayPart(v leaveamendment.PreviousEndDayPart) *LeaveAmendmentUpdate {
+	_u.mutation.SetPreviousEndDayPart(v)
+	return _u
+}
+
+// SetNillablePreviousEndDayPart sets the "previous_end_day_part" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillablePreviousEndDayPart(v *leaveamendment.PreviousEndDayPart) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetPreviousEndDayPart(*v)
+	}
+	return _u
+}
+
+// SetPreviousDays sets the "previous_days" field.
+func (_u *LeaveAmendmentUpdate) SetPreviousDays(v float64) *LeaveAmendmentUpdate {
+	_u.mutation.ResetPreviousDays()
+	_u.mutation.SetPreviousDays(v)
+	return _u
+}
+
+// SetNillablePreviousDays sets the "previous_days" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillablePreviousDays(v *float64) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetPreviousDays(*v)
+	}
+	return _u
+}
+
+// AddPreviousDays adds value to the "previous_days" field.
+func (_u *LeaveAmendmentUpdate) AddPreviousDays(v float64) *LeaveAmendmentUpdate {
+	_u.mutation.AddPreviousDays(v)
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *LeaveAmendmentUpdate) SetStartDate(v time.Time) *LeaveAmendmentUpdate {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableStartDate(v *time.Time) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *LeaveAmendmentUpdate) SetEndDate(v time.Time) *LeaveAmendmentUpdate {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableEndDate(v *time.Time) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// SetStartDayPart sets the "start_day_part" field.
+func (_u *LeaveAmendmentUpdate) SetStartDayPart(v leaveamendment.StartDayPart) *LeaveAmendmentUpdate {
+	_u.mutation.SetStartDayPart(v)
+	return _u
+}
+
+// SetNillableStartDayPart sets the "start_day_part" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableStartDayPart(v *leaveamendment.StartDayPart) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetStartDayPart(*v)
+	}
+	return _u
+}
+
+// SetEndDayPart sets the "end_day_part" field.
+func (_u *LeaveAmendmentUpdate) SetEndDayPart(v leaveamendment.EndDayPart) *LeaveAmendmentUpdate {
+	_u.mutation.SetEndDayPart(v)
+	return _u
+}
+
+// SetNillableEndDayPart sets the "end_day_part" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableEndDayPart(v *leaveamendment.EndDayPart) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetEndDayPart(*v)
+	}
+	return _u
+}
+
+// SetHours sets the "hours" field.
+func (_u *LeaveAmendmentUpdate) SetHours(v float64) *LeaveAmendmentUpdate {
+	_u.mutation.ResetHours()
+	_u.mutation.SetHours(v)
+	return _u
+}
+
+// SetNillableHours sets the "hours" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableHours(v *float64) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetHours(*v)
+	}
+	return _u
+}
+
+// AddHours adds value to the "hours" field.
+func (_u *LeaveAmendmentUpdate) AddHours(v float64) *LeaveAmendmentUpdate {
+	_u.mutation.AddHours(v)
+	return _u
+}
+
+// SetDays sets the "days" field.
+func (_u *LeaveAmendmentUpdate) SetDays(v float64) *LeaveAmendmentUpdate {
+	_u.mutation.ResetDays()
+	_u.mutation.SetDays(v)
+	return _u
+}
+
+// SetNillableDays sets the "days" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableDays(v *float64) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetDays(*v)
+	}
+	return _u
+}
+
+// AddDays adds value to the "days" field.
+func (_u *LeaveAmendmentUpdate) AddDays(v float64) *LeaveAmendmentUpdate {
+	_u.mutation.AddDays(v)
+	return _u
+}
+
+// SetHolidayCalendarID sets the "holiday_calendar_id" field.
+func (_u *LeaveAmendmentUpdate) SetHolidayCalendarID(v string) *LeaveAmendmentUpdate {
+	_u.mutation.SetHolidayCalendarID(v)
+	return _u
+}
+
+// SetNillableHolidayCalendarID sets the "holiday_calendar_id" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableHolidayCalendarID(v *string) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetHolidayCalendarID(*v)
+	}
+	return _u
+}
+
+// ClearHolidayCalendarID clears the value of the "holiday_calendar_id" field.
+func (_u *LeaveAmendmentUpdate) ClearHolidayCalendarID() *LeaveAmendmentUpdate {
+	_u.mutation.ClearHolidayCalendarID()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LeaveAmendmentUpdate) SetReason(v string) *LeaveAmendmentUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableReason(v *string) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *LeaveAmendmentUpdate) ClearReason() *LeaveAmendmentUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *LeaveAmendmentUpdate) SetReviewedBy(v uint32) *LeaveAmendmentUpdate {
+	_u.mutation.ResetReviewedBy()
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableReviewedBy(v *uint32) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// AddReviewedBy adds value to the "reviewed_by" field.
+func (_u *LeaveAmendmentUpdate) AddReviewedBy(v int32) *LeaveAmendmentUpdate {
+	_u.mutation.AddReviewedBy(v)
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *LeaveAmendmentUpdate) ClearReviewedBy() *LeaveAmendmentUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewerName sets the "reviewer_name" field.
+func (_u *LeaveAmendmentUpdate) SetReviewerName(v string) *LeaveAmendmentUpdate {
+	_u.mutation.SetReviewerName(v)
+	return _u
+}
+
+// SetNillableReviewerName sets the "reviewer_name" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableReviewerName(v *string) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetReviewerName(*v)
+	}
+	return _u
+}
+
+// ClearReviewerName clears the value of the "reviewer_name" field.
+func (_u *LeaveAmendmentUpdate) ClearReviewerName() *LeaveAmendmentUpdate {
+	_u.mutation.ClearReviewerName()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *LeaveAmendmentUpdate) SetReviewedAt(v time.Time) *LeaveAmendmentUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableReviewedAt(v *time.Time) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *LeaveAmendmentUpdate) ClearReviewedAt() *LeaveAmendmentUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (_u *LeaveAmendmentUpdate) SetReviewNotes(v string) *LeaveAmendmentUpdate {
+	_u.mutation.SetReviewNotes(v)
+	return _u
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableReviewNotes(v *string) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetReviewNotes(*v)
+	}
+	return _u
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (_u *LeaveAmendmentUpdate) ClearReviewNotes() *LeaveAmendmentUpdate {
+	_u.mutation.ClearReviewNotes()
+	return _u
+}
+
+// SetSigningRequestID sets the "signing_request_id" field.
+func (_u *LeaveAmendmentUpdate) SetSigningRequestID(v string) *LeaveAmendmentUpdate {
+	_u.mutation.SetSigningRequestID(v)
+	return _u
+}
+
+// SetNillableSigningRequestID sets the "signing_request_id" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdate) SetNillableSigningRequestID(v *string) *LeaveAmendmentUpdate {
+	if v != nil {
+		_u.SetSigningRequestID(*v)
+	}
+	return _u
+}
+
+// ClearSigningRequestID clears the value of the "signing_request_id" field.
+func (_u *LeaveAmendmentUpdate) ClearSigningRequestID() *LeaveAmendmentUpdate {
+	_u.mutation.ClearSigningRequestID()
+	return _u
+}
+
+// SetPolicyOverride sets the "policy_override" field.
+func (_u *LeaveAmendmentUpdate) SetPolicyOverride(v *policy.Override) *LeaveAmendmentUpdate {
+	_u.mutation.SetPolicyOverride(v)
+	return _u
+}
+
+// ClearPolicyOverride clears the value of the "policy_override" field.
+func (_u *LeaveAmendmentUpdate) ClearPolicyOverride() *LeaveAmendmentUpdate {
+	_u.mutation.ClearPolicyOverride()
+	return _u
+}
+
+// Mutation returns the LeaveAmendmentMutation object of the builder.
+func (_u *LeaveAmendmentUpdate) Mutation() *LeaveAmendmentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LeaveAmendmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeaveAmendmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LeaveAmendmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeaveAmendmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeaveAmendmentUpdate) check() error {
+	if v, ok := _u.mutation.LeaveRequestID(); ok {
+		if err := leaveamendment.LeaveRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "leave_request_id", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.leave_request_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := leaveamendment.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := leaveamendment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PreviousStartDayPart(); ok {
+		if err := leaveamendment.PreviousStartDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "previous_start_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.previous_start_day_part": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PreviousEndDayPart(); ok {
+		if err := leaveamendment.PreviousEndDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "previous_end_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.previous_end_day_part": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StartDayPart(); ok {
+		if err := leaveamendment.StartDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "start_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.start_day_part": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EndDayPart(); ok {
+		if err := leaveamendment.EndDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "end_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.end_day_part": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeaveAmendmentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaveAmendmentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeaveAmendmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leaveamendment.Table, leaveamendment.Columns, sqlgraph.NewFieldSpec(leaveamendment.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(leaveamendment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(leaveamendment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(leaveamendment.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(leaveamendment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(leaveamendment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(leaveamendment.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(leaveamendment.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(leaveamendment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(leaveamendment.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(leaveamendment.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(leaveamendment.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(leaveamendment.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LeaveRequestID(); ok {
+		_spec.SetField(leaveamendment.FieldLeaveRequestID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(leaveamendment.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(leaveamendment.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(leaveamendment.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(leaveamendment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PreviousStartDate(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PreviousEndDate(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PreviousStartDayPart(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousStartDayPart, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PreviousEndDayPart(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousEndDayPart, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PreviousDays(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPreviousDays(); ok {
+		_spec.AddField(leaveamendment.FieldPreviousDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(leaveamendment.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(leaveamendment.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StartDayPart(); ok {
+		_spec.SetField(leaveamendment.FieldStartDayPart, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EndDayPart(); ok {
+		_spec.SetField(leaveamendment.FieldEndDayPart, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Hours(); ok {
+		_spec.SetField(leaveamendment.FieldHours, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHours(); ok {
+		_spec.AddField(leaveamendment.FieldHours, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Days(); ok {
+		_spec.SetField(leaveamendment.FieldDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDays(); ok {
+		_spec.AddField(leaveamendment.FieldDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.HolidayCalendarID(); ok {
+		_spec.SetField(leaveamendment.FieldHolidayCalendarID, field.TypeString, value)
+	}
+	if _u.mutation.HolidayCalendarIDCleared() {
+		_spec.ClearField(leaveamendment.FieldHolidayCalendarID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(leaveamendment.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(leaveamendment.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(leaveamendment.FieldReviewedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedReviewedBy(); ok {
+		_spec.AddField(leaveamendment.FieldReviewedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(leaveamendment.FieldReviewedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ReviewerName(); ok {
+		_spec.SetField(leaveamendment.FieldReviewerName, field.TypeString, value)
+	}
+	if _u.mutation.ReviewerNameCleared() {
+		_spec.ClearField(leaveamendment.FieldReviewerName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(leaveamendment.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(leaveamendment.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewNotes(); ok {
+		_spec.SetField(leaveamendment.FieldReviewNotes, field.TypeString, value)
+	}
+	if _u.mutation.ReviewNotesCleared() {
+		_spec.ClearField(leaveamendment.FieldReviewNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.SigningRequestID(); ok {
+		_spec.SetField(leaveamendment.FieldSigningRequestID, field.TypeString, value)
+	}
+	if _u.mutation.SigningRequestIDCleared() {
+		_spec.ClearField(leaveamendment.FieldSigningRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PolicyOverride(); ok {
+		_spec.SetField(leaveamendment.FieldPolicyOverride, field.TypeJSON, value)
+	}
+	if _u.mutation.PolicyOverrideCleared() {
+		_spec.ClearField(leaveamendment.FieldPolicyOverride, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaveamendment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LeaveAmendmentUpdateOne is the builder for updating a single LeaveAmendment entity.
+type LeaveAmendmentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeaveAmendmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *LeaveAmendmentUpdateOne) SetCreateBy(v uint32) *LeaveAmendmentUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableCreateBy(v *uint32) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *LeaveAmendmentUpdateOne) AddCreateBy(v int32) *LeaveAmendmentUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *LeaveAmendmentUpdateOne) ClearCreateBy() *LeaveAmendmentUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *LeaveAmendmentUpdateOne) SetUpdateBy(v uint32) *LeaveAmendmentUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableUpdateBy(v *uint32) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *LeaveAmendmentUpdateOne) AddUpdateBy(v int32) *LeaveAmendmentUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *LeaveAmendmentUpdateOne) ClearUpdateBy() *LeaveAmendmentUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *LeaveAmendmentUpdateOne) SetUpdateTime(v time.Time) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableUpdateTime(v *time.Time) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *LeaveAmendmentUpdateOne) ClearUpdateTime() *LeaveAmendmentUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *LeaveAmendmentUpdateOne) SetDeleteTime(v time.Time) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableDeleteTime(v *time.Time) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *LeaveAmendmentUpdateOne) ClearDeleteTime() *LeaveAmendmentUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (_u *LeaveAmendmentUpdateOne) SetLeaveRequestID(v string) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetLeaveRequestID(v)
+	return _u
+}
+
+// SetNillableLeaveRequestID sets the "leave_request_id" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableLeaveRequestID(v *string) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetLeaveRequestID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LeaveAmendmentUpdateOne) SetUserID(v uint32) *LeaveAmendmentUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableUserID(v *uint32) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *LeaveAmendmentUpdateOne) AddUserID(v int32) *LeaveAmendmentUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *LeaveAmendmentUpdateOne) SetKind(v leaveamendment.Kind) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableKind(v *leaveamendment.Kind) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LeaveAmendmentUpdateOne) SetStatus(v leaveamendment.Status) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableStatus(v *leaveamendment.Status) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPreviousStartDate sets the "previous_start_date" field.
+func (_u *LeaveAmendmentUpdateOne) SetPreviousStartDate(v time.Time) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetPreviousStartDate(v)
+	return _u
+}
+
+// SetNillablePreviousStartDate sets the "previous_start_date" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillablePreviousStartDate(v *time.Time) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetPreviousStartDate(*v)
+	}
+	return _u
+}
+
+// SetPreviousEndDate sets the "previous_end_date" field.
+func (_u *LeaveAmendmentUpdateOne) SetPreviousEndDate(v time.Time) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetPreviousEndDate(v)
+	return _u
+}
+
+// SetNillablePreviousEndDate sets the "previous_end_date" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillablePreviousEndDate(v *time.Time) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetPreviousEndDate(*v)
+	}
+	return _u
+}
+
+// SetPreviousStartDayPart sets the "previous_start_day_part" field.
+func (_u *LeaveAmendmentUpdateOne) SetPreviousStartDayPart(v leaveamendment.PreviousStartDayPart) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetPreviousStartDayPart(v)
+	return _u
+}
+
+// SetNillablePreviousStartDayPart sets the "previous_start_day_part" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillablePreviousStartDayPart(v *leaveamendment.PreviousStartDayPart) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetPreviousStartDayPart(*v)
+	}
+	return _u
+}
+
+// SetPreviousEndDayPart sets the "previous_end_day_part" field.
+func (_u *LeaveAmendmentUpdateOne) SetPreviousEndDayPart(v leaveamendment.PreviousEndDayPart) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetPreviousEndDayPart(v)
+	return _u
+}
+
+// SetNillablePreviousEndDayPart sets the "previous_end_day_part" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillablePreviousEndDayPart(v *leaveamendment.PreviousEndDayPart) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetPreviousEndDayPart(*v)
+	}
+	return _u
+}
+
+// SetPreviousDays sets the "previous_days" field.
+func (_u *LeaveAmendmentUpdateOne) SetPreviousDays(v float64) *LeaveAmendmentUpdateOne {
+	_u.mutation.ResetPreviousDays()
+	_u.mutation.SetPreviousDays(v)
+	return _u
+}
+
+// SetNillablePreviousDays sets the "previous_days" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillablePreviousDays(v *float64) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetPreviousDays(*v)
+	}
+	return _u
+}
+
+// AddPreviousDays adds value to the "previous_days" field.
+func (_u *LeaveAmendmentUpdateOne) AddPreviousDays(v float64) *LeaveAmendmentUpdateOne {
+	_u.mutation.AddPreviousDays(v)
+	return _u
+}
+
+// SetStartDate sets the "start_date" field.
+func (_u *LeaveAmendmentUpdateOne) SetStartDate(v time.Time) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetStartDate(v)
+	return _u
+}
+
+// SetNillableStartDate sets the "start_date" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableStartDate(v *time.Time) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetStartDate(*v)
+	}
+	return _u
+}
+
+// SetEndDate sets the "end_date" field.
+func (_u *LeaveAmendmentUpdateOne) SetEndDate(v time.Time) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetEndDate(v)
+	return _u
+}
+
+// SetNillableEndDate sets the "end_date" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableEndDate(v *time.Time) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetEndDate(*v)
+	}
+	return _u
+}
+
+// SetStartDayPart sets the "start_day_part" field.
+func (_u *LeaveAmendmentUpdateOne) SetStartDayPart(v leaveamendment.StartDayPart) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetStartDayPart(v)
+	return _u
+}
+
+// SetNillableStartDayPart sets the "start_day_part" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableStartDayPart(v *leaveamendment.StartDayPart) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetStartDayPart(*v)
+	}
+	return _u
+}
+
+// SetEndDayPart sets the "end_day_part" field.
+func (_u *LeaveAmendmentUpdateOne) SetEndDayPart(v leaveamendment.EndDayPart) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetEndDayPart(v)
+	return _u
+}
+
+// SetNillableEndDayPart sets the "end_day_part" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableEndDayPart(v *leaveamendment.EndDayPart) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetEndDayPart(*v)
+	}
+	return _u
+}
+
+// SetHours sets the "hours" field.
+func (_u *LeaveAmendmentUpdateOne) SetHours(v float64) *LeaveAmendmentUpdateOne {
+	_u.mutation.ResetHours()
+	_u.mutation.SetHours(v)
+	return _u
+}
+
+// SetNillableHours sets the "hours" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableHours(v *float64) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetHours(*v)
+	}
+	return _u
+}
+
+// AddHours adds value to the "hours" field.
+func (_u *LeaveAmendmentUpdateOne) AddHours(v float64) *LeaveAmendmentUpdateOne {
+	_u.mutation.AddHours(v)
+	return _u
+}
+
+// SetDays sets the "days" field.
+func (_u *LeaveAmendmentUpdateOne) SetDays(v float64) *LeaveAmendmentUpdateOne {
+	_u.mutation.ResetDays()
+	_u.mutation.SetDays(v)
+	return _u
+}
+
+// SetNillableDays sets the "days" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableDays(v *float64) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetDays(*v)
+	}
+	return _u
+}
+
+// AddDays adds value to the "days" field.
+func (_u *LeaveAmendmentUpdateOne) AddDays(v float64) *LeaveAmendmentUpdateOne {
+	_u.mutation.AddDays(v)
+	return _u
+}
+
+// SetHolidayCalendarID sets the "holiday_calendar_id" field.
+func (_u *LeaveAmendmentUpdateOne) SetHolidayCalendarID(v string) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetHolidayCalendarID(v)
+	return _u
+}
+
+// SetNillableHolidayCalendarID sets the "holiday_calendar_id" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableHolidayCalendarID(v *string) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetHolidayCalendarID(*v)
+	}
+	return _u
+}
+
+// ClearHolidayCalendarID clears the value of the "holiday_calendar_id" field.
+func (_u *LeaveAmendmentUpdateOne) ClearHolidayCalendarID() *LeaveAmendmentUpdateOne {
+	_u.mutation.ClearHolidayCalendarID()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *LeaveAmendmentUpdateOne) SetReason(v string) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableReason(v *string) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *LeaveAmendmentUpdateOne) ClearReason() *LeaveAmendmentUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *LeaveAmendmentUpdateOne) SetReviewedBy(v uint32) *LeaveAmendmentUpdateOne {
+	_u.mutation.ResetReviewedBy()
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableReviewedBy(v *uint32) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// AddReviewedBy adds value to the "reviewed_by" field.
+func (_u *LeaveAmendmentUpdateOne) AddReviewedBy(v int32) *LeaveAmendmentUpdateOne {
+	_u.mutation.AddReviewedBy(v)
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *LeaveAmendmentUpdateOne) ClearReviewedBy() *LeaveAmendmentUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewerName sets the "reviewer_name" field.
+func (_u *LeaveAmendmentUpdateOne) SetReviewerName(v string) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetReviewerName(v)
+	return _u
+}
+
+// SetNillableReviewerName sets the "reviewer_name" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableReviewerName(v *string) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetReviewerName(*v)
+	}
+	return _u
+}
+
+// ClearReviewerName clears the value of the "reviewer_name" field.
+func (_u *LeaveAmendmentUpdateOne) ClearReviewerName() *LeaveAmendmentUpdateOne {
+	_u.mutation.ClearReviewerName()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *LeaveAmendmentUpdateOne) SetReviewedAt(v time.Time) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableReviewedAt(v *time.Time) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *LeaveAmendmentUpdateOne) ClearReviewedAt() *LeaveAmendmentUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetReviewNotes sets the "review_notes" field.
+func (_u *LeaveAmendmentUpdateOne) SetReviewNotes(v string) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetReviewNotes(v)
+	return _u
+}
+
+// SetNillableReviewNotes sets the "review_notes" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableReviewNotes(v *string) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetReviewNotes(*v)
+	}
+	return _u
+}
+
+// ClearReviewNotes clears the value of the "review_notes" field.
+func (_u *LeaveAmendmentUpdateOne) ClearReviewNotes() *LeaveAmendmentUpdateOne {
+	_u.mutation.ClearReviewNotes()
+	return _u
+}
+
+// SetSigningRequestID sets the "signing_request_id" field.
+func (_u *LeaveAmendmentUpdateOne) SetSigningRequestID(v string) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetSigningRequestID(v)
+	return _u
+}
+
+// SetNillableSigningRequestID sets the "signing_request_id" field if the given value is not nil.
+func (_u *LeaveAmendmentUpdateOne) SetNillableSigningRequestID(v *string) *LeaveAmendmentUpdateOne {
+	if v != nil {
+		_u.SetSigningRequestID(*v)
+	}
+	return _u
+}
+
+// ClearSigningRequestID clears the value of the "signing_request_id" field.
+func (_u *LeaveAmendmentUpdateOne) ClearSigningRequestID() *LeaveAmendmentUpdateOne {
+	_u.mutation.ClearSigningRequestID()
+	return _u
+}
+
+// SetPolicyOverride sets the "policy_override" field.
+func (_u *LeaveAmendmentUpdateOne) SetPolicyOverride(v *policy.Override) *LeaveAmendmentUpdateOne {
+	_u.mutation.SetPolicyOverride(v)
+	return _u
+}
+
+// ClearPolicyOverride clears the value of the "policy_override" field.
+func (_u *LeaveAmendmentUpdateOne) ClearPolicyOverride() *LeaveAmendmentUpdateOne {
+	_u.mutation.ClearPolicyOverride()
+	return _u
+}
+
+// Mutation returns the LeaveAmendmentMutation object of the builder.
+func (_u *LeaveAmendmentUpdateOne) Mutation() *LeaveAmendmentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LeaveAmendmentUpdate builder.
+func (_u *LeaveAmendmentUpdateOne) Where(ps ...predicate.LeaveAmendment) *LeaveAmendmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LeaveAmendmentUpdateOne) Select(field string, fields ...string) *LeaveAmendmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LeaveAmendment entity.
+func (_u *LeaveAmendmentUpdateOne) Save(ctx context.Context) (*LeaveAmendment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeaveAmendmentUpdateOne) SaveX(ctx context.Context) *LeaveAmendment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LeaveAmendmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeaveAmendmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeaveAmendmentUpdateOne) check() error {
+	if v, ok := _u.mutation.LeaveRequestID(); ok {
+		if err := leaveamendment.LeaveRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "leave_request_id", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.leave_request_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := leaveamendment.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := leaveamendment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PreviousStartDayPart(); ok {
+		if err := leaveamendment.PreviousStartDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "previous_start_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.previous_start_day_part": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PreviousEndDayPart(); ok {
+		if err := leaveamendment.PreviousEndDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "previous_end_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.previous_end_day_part": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StartDayPart(); ok {
+		if err := leaveamendment.StartDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "start_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.start_day_part": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EndDayPart(); ok {
+		if err := leaveamendment.EndDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "end_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveAmendment.end_day_part": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeaveAmendmentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaveAmendmentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeaveAmendmentUpdateOne) sqlSave(ctx context.Context) (_node *LeaveAmendment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leaveamendment.Table, leaveamendment.Columns, sqlgraph.NewFieldSpec(leaveamendment.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeaveAmendment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leaveamendment.FieldID)
+		for _, f := range fields {
+			if !leaveamendment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leaveamendment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(leaveamendment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(leaveamendment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(leaveamendment.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(leaveamendment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(leaveamendment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(leaveamendment.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(leaveamendment.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(leaveamendment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(leaveamendment.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(leaveamendment.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(leaveamendment.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(leaveamendment.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LeaveRequestID(); ok {
+		_spec.SetField(leaveamendment.FieldLeaveRequestID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(leaveamendment.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(leaveamendment.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(leaveamendment.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(leaveamendment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PreviousStartDate(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PreviousEndDate(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PreviousStartDayPart(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousStartDayPart, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PreviousEndDayPart(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousEndDayPart, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PreviousDays(); ok {
+		_spec.SetField(leaveamendment.FieldPreviousDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPreviousDays(); ok {
+		_spec.AddField(leaveamendment.FieldPreviousDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.StartDate(); ok {
+		_spec.SetField(leaveamendment.FieldStartDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndDate(); ok {
+		_spec.SetField(leaveamendment.FieldEndDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StartDayPart(); ok {
+		_spec.SetField(leaveamendment.FieldStartDayPart, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EndDayPart(); ok {
+		_spec.SetField(leaveamendment.FieldEndDayPart, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Hours(); ok {
+		_spec.SetField(leaveamendment.FieldHours, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHours(); ok {
+		_spec.AddField(leaveamendment.FieldHours, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Days(); ok {
+		_spec.SetField(leaveamendment.FieldDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDays(); ok {
+		_spec.AddField(leaveamendment.FieldDays, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.HolidayCalendarID(); ok {
+		_spec.SetField(leaveamendment.FieldHolidayCalendarID, field.TypeString, value)
+	}
+	if _u.mutation.HolidayCalendarIDCleared() {
+		_spec.ClearField(leaveamendment.FieldHolidayCalendarID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(leaveamendment.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(leaveamendment.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(leaveamendment.FieldReviewedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedReviewedBy(); ok {
+		_spec.AddField(leaveamendment.FieldReviewedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(leaveamendment.FieldReviewedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ReviewerName(); ok {
+		_spec.SetField(leaveamendment.FieldReviewerName, field.TypeString, value)
+	}
+	if _u.mutation.ReviewerNameCleared() {
+		_spec.ClearField(leaveamendment.FieldReviewerName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(leaveamendment.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(leaveamendment.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewNotes(); ok {
+		_spec.SetField(leaveamendment.FieldReviewNotes, field.TypeString, value)
+	}
+	if _u.mutation.ReviewNotesCleared() {
+		_spec.ClearField(leaveamendment.FieldReviewNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.SigningRequestID(); ok {
+		_spec.SetField(leaveamendment.FieldSigningRequestID, field.TypeString, value)
+	}
+	if _u.mutation.SigningRequestIDCleared() {
+		_spec.ClearField(leaveamendment.FieldSigningRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PolicyOverride(); ok {
+		_spec.SetField(leaveamendment.FieldPolicyOverride, field.TypeJSON, value)
+	}
+	if _u.mutation.PolicyOverrideCleared() {
+		_spec.ClearField(leaveamendment.FieldPolicyOverride, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LeaveAmendment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaveamendment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,805 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leaveattachment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// UpdateBy applies equality check predicate on the "update_by" field. It's identical to UpdateByEQ.
+func UpdateBy(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// LeaveRequestID applies equality check predicate on the "leave_request_id" field. It's identical to LeaveRequestIDEQ.
+func LeaveRequestID(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldLeaveRequestID, v))
+}
+
+// FileName applies equality check predicate on the "file_name" field. It's identical to FileNameEQ.
+func FileName(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldFileName, v))
+}
+
+// ContentType applies equality check predicate on the "content_type" field. It's identical to ContentTypeEQ.
+func ContentType(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldContentType, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldSize, v))
+}
+
+// Checksum applies equality check predicate on the "checksum" field. It's identical to ChecksumEQ.
+func Checksum(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldChecksum, v))
+}
+
+// StorageKey applies equality check predicate on the "storage_key" field. It's identical to StorageKeyEQ.
+func StorageKey(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotNull(FieldCreateBy))
+}
+
+// UpdateByEQ applies the EQ predicate on the "update_by" field.
+func UpdateByEQ(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// UpdateByNEQ applies the NEQ predicate on the "update_by" field.
+func UpdateByNEQ(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNEQ(FieldUpdateBy, v))
+}
+
+// UpdateByIn applies the In predicate on the "update_by" field.
+func UpdateByIn(vs ...uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByNotIn applies the NotIn predicate on the "update_by" field.
+func UpdateByNotIn(vs ...uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByGT applies the GT predicate on the "update_by" field.
+func UpdateByGT(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGT(FieldUpdateBy, v))
+}
+
+// UpdateByGTE applies the GTE predicate on the "update_by" field.
+func UpdateByGTE(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGTE(FieldUpdateBy, v))
+}
+
+// UpdateByLT applies the LT predicate on the "update_by" field.
+func UpdateByLT(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLT(FieldUpdateBy, v))
+}
+
+// UpdateByLTE applies the LTE predicate on the "update_by" field.
+func UpdateByLTE(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLTE(FieldUpdateBy, v))
+}
+
+// UpdateByIsNil applies the IsNil predicate on the "update_by" field.
+func UpdateByIsNil() predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIsNull(FieldUpdateBy))
+}
+
+// UpdateByNotNil applies the NotNil predicate on the "update_by" field.
+func UpdateByNotNil() predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotNull(FieldUpdateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotNull(FieldTenantID))
+}
+
+// LeaveRequestIDEQ applies the EQ predicate on the "leave_request_id" field.
+func LeaveRequestIDEQ(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDNEQ applies the NEQ predicate on the "leave_request_id" field.
+func LeaveRequestIDNEQ(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNEQ(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDIn applies the In predicate on the "leave_request_id" field.
+func LeaveRequestIDIn(vs ...string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIn(FieldLeaveRequestID, vs...))
+}
+
+// LeaveRequestIDNotIn applies the NotIn predicate on the "leave_request_id" field.
+func LeaveRequestIDNotIn(vs ...string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotIn(FieldLeaveRequestID, vs...))
+}
+
+// LeaveRequestIDGT applies the GT predicate on the "leave_request_id" field.
+func LeaveRequestIDGT(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGT(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDGTE applies the GTE predicate on the "leave_request_id" field.
+func LeaveRequestIDGTE(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGTE(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDLT applies the LT predicate on the "leave_request_id" field.
+func LeaveRequestIDLT(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLT(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDLTE applies the LTE predicate on the "leave_request_id" field.
+func LeaveRequestIDLTE(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLTE(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDContains applies the Contains predicate on the "leave_request_id" field.
+func LeaveRequestIDContains(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldContains(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDHasPrefix applies the HasPrefix predicate on the "leave_request_id" field.
+func LeaveRequestIDHasPrefix(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldHasPrefix(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDHasSuffix applies the HasSuffix predicate on the "leave_request_id" field.
+func LeaveRequestIDHasSuffix(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldHasSuffix(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDEqualFold applies the EqualFold predicate on the "leave_request_id" field.
+func LeaveRequestIDEqualFold(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEqualFold(FieldLeaveRequestID, v))
+}
+
+// LeaveRequestIDContainsFold applies the ContainsFold predicate on the "leave_request_id" field.
+func LeaveRequestIDContainsFold(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldContainsFold(FieldLeaveRequestID, v))
+}
+
+// FileNameEQ applies the EQ predicate on the "file_name" field.
+func FileNameEQ(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldFileName, v))
+}
+
+// FileNameNEQ applies the NEQ predicate on the "file_name" field.
+func FileNameNEQ(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNEQ(FieldFileName, v))
+}
+
+// FileNameIn applies the In predicate on the "file_name" field.
+func FileNameIn(vs ...string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIn(FieldFileName, vs...))
+}
+
+// FileNameNotIn applies the NotIn predicate on the "file_name" field.
+func FileNameNotIn(vs ...string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotIn(FieldFileName, vs...))
+}
+
+// FileNameGT applies the GT predicate on the "file_name" field.
+func FileNameGT(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGT(FieldFileName, v))
+}
+
+// FileNameGTE applies the GTE predicate on the "file_name" field.
+func FileNameGTE(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGTE(FieldFileName, v))
+}
+
+// FileNameLT applies the LT predicate on the "file_name" field.
+func FileNameLT(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLT(FieldFileName, v))
+}
+
+// FileNameLTE applies the LTE predicate on the "file_name" field.
+func FileNameLTE(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLTE(FieldFileName, v))
+}
+
+// FileNameContains applies the Contains predicate on the "file_name" field.
+func FileNameContains(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldContains(FieldFileName, v))
+}
+
+// FileNameHasPrefix applies the HasPrefix predicate on the "file_name" field.
+func FileNameHasPrefix(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldHasPrefix(FieldFileName, v))
+}
+
+// FileNameHasSuffix applies the HasSuffix predicate on the "file_name" field.
+func FileNameHasSuffix(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldHasSuffix(FieldFileName, v))
+}
+
+// FileNameEqualFold applies the EqualFold predicate on the "file_name" field.
+func FileNameEqualFold(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEqualFold(FieldFileName, v))
+}
+
+// FileNameContainsFold applies the ContainsFold predicate on the "file_name" field.
+func FileNameContainsFold(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldContainsFold(FieldFileName, v))
+}
+
+// ContentTypeEQ applies the EQ predicate on the "content_type" field.
+func ContentTypeEQ(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldContentType, v))
+}
+
+// ContentTypeNEQ applies the NEQ predicate on the "content_type" field.
+func ContentTypeNEQ(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNEQ(FieldContentType, v))
+}
+
+// ContentTypeIn applies the In predicate on the "content_type" field.
+func ContentTypeIn(vs ...string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIn(FieldContentType, vs...))
+}
+
+// ContentTypeNotIn applies the NotIn predicate on the "content_type" field.
+func ContentTypeNotIn(vs ...string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotIn(FieldContentType, vs...))
+}
+
+// ContentTypeGT applies the GT predicate on the "content_type" field.
+func ContentTypeGT(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGT(FieldContentType, v))
+}
+
+// ContentTypeGTE applies the GTE predicate on the "content_type" field.
+func ContentTypeGTE(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGTE(FieldContentType, v))
+}
+
+// ContentTypeLT applies the LT predicate on the "content_type" field.
+func ContentTypeLT(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLT(FieldContentType, v))
+}
+
+// ContentTypeLTE applies the LTE predicate on the "content_type" field.
+func ContentTypeLTE(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLTE(FieldContentType, v))
+}
+
+// ContentTypeContains applies the Contains predicate on the "content_type" field.
+func ContentTypeContains(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldContains(FieldContentType, v))
+}
+
+// ContentTypeHasPrefix applies the HasPrefix predicate on the "content_type" field.
+func ContentTypeHasPrefix(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldHasPrefix(FieldContentType, v))
+}
+
+// ContentTypeHasSuffix applies the HasSuffix predicate on the "content_type" field.
+func ContentTypeHasSuffix(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldHasSuffix(FieldContentType, v))
+}
+
+// ContentTypeEqualFold applies the EqualFold predicate on the "content_type" field.
+func ContentTypeEqualFold(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEqualFold(FieldContentType, v))
+}
+
+// ContentTypeContainsFold applies the ContainsFold predicate on the "content_type" field.
+func ContentTypeContainsFold(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldContainsFold(FieldContentType, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLTE(FieldSize, v))
+}
+
+// ChecksumEQ applies the EQ predicate on the "checksum" field.
+func ChecksumEQ(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldChecksum, v))
+}
+
+// ChecksumNEQ applies the NEQ predicate on the "checksum" field.
+func ChecksumNEQ(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNEQ(FieldChecksum, v))
+}
+
+// ChecksumIn applies the In predicate on the "checksum" field.
+func ChecksumIn(vs ...string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIn(FieldChecksum, vs...))
+}
+
+// ChecksumNotIn applies the NotIn predicate on the "checksum" field.
+func ChecksumNotIn(vs ...string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotIn(FieldChecksum, vs...))
+}
+
+// ChecksumGT applies the GT predicate on the "checksum" field.
+func ChecksumGT(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGT(FieldChecksum, v))
+}
+
+// ChecksumGTE applies the GTE predicate on the "checksum" field.
+func ChecksumGTE(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGTE(FieldChecksum, v))
+}
+
+// ChecksumLT applies the LT predicate on the "checksum" field.
+func ChecksumLT(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLT(FieldChecksum, v))
+}
+
+// ChecksumLTE applies the LTE predicate on the "checksum" field.
+func ChecksumLTE(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLTE(FieldChecksum, v))
+}
+
+// ChecksumContains applies the Contains predicate on the "checksum" field.
+func ChecksumContains(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldContains(FieldChecksum, v))
+}
+
+// ChecksumHasPrefix applies the HasPrefix predicate on the "checksum" field.
+func ChecksumHasPrefix(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldHasPrefix(FieldChecksum, v))
+}
+
+// ChecksumHasSuffix applies the HasSuffix predicate on the "checksum" field.
+func ChecksumHasSuffix(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldHasSuffix(FieldChecksum, v))
+}
+
+// ChecksumEqualFold applies the EqualFold predicate on the "checksum" field.
+func ChecksumEqualFold(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEqualFold(FieldChecksum, v))
+}
+
+// ChecksumContainsFold applies the ContainsFold predicate on the "checksum" field.
+func ChecksumContainsFold(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldContainsFold(FieldChecksum, v))
+}
+
+// StorageKeyEQ applies the EQ predicate on the "storage_key" field.
+func StorageKeyEQ(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEQ(FieldStorageKey, v))
+}
+
+// StorageKeyNEQ applies the NEQ predicate on the "storage_key" field.
+func StorageKeyNEQ(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNEQ(FieldStorageKey, v))
+}
+
+// StorageKeyIn applies the In predicate on the "storage_key" field.
+func StorageKeyIn(vs ...string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyNotIn applies the NotIn predicate on the "storage_key" field.
+func StorageKeyNotIn(vs ...string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldNotIn(FieldStorageKey, vs...))
+}
+
+// StorageKeyGT applies the GT predicate on the "storage_key" field.
+func StorageKeyGT(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGT(FieldStorageKey, v))
+}
+
+// StorageKeyGTE applies the GTE predicate on the "storage_key" field.
+func StorageKeyGTE(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldGTE(FieldStorageKey, v))
+}
+
+// StorageKeyLT applies the LT predicate on the "storage_key" field.
+func StorageKeyLT(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLT(FieldStorageKey, v))
+}
+
+// StorageKeyLTE applies the LTE predicate on the "storage_key" field.
+func StorageKeyLTE(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldLTE(FieldStorageKey, v))
+}
+
+// StorageKeyContains applies the Contains predicate on the "storage_key" field.
+func StorageKeyContains(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldContains(FieldStorageKey, v))
+}
+
+// StorageKeyHasPrefix applies the HasPrefix predicate on the "storage_key" field.
+func StorageKeyHasPrefix(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldHasPrefix(FieldStorageKey, v))
+}
+
+// StorageKeyHasSuffix applies the HasSuffix predicate on the "storage_key" field.
+func StorageKeyHasSuffix(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldHasSuffix(FieldStorageKey, v))
+}
+
+// StorageKeyEqualFold applies the EqualFold predicate on the "storage_key" field.
+func StorageKeyEqualFold(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldEqualFold(FieldStorageKey, v))
+}
+
+// StorageKeyContainsFold applies the ContainsFold predicate on the "storage_key" field.
+func StorageKeyContainsFold(v string) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.FieldContainsFold(FieldStorageKey, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LeaveAttachment) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LeaveAttachment) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LeaveAttachment) predicate.LeaveAttachment {
+	return predicate.LeaveAttachment(sql.NotPredicates(p))
+}
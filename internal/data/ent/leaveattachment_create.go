@@ -0,0 +1,1223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveattachment"
+)
+
+// LeaveAttachmentCreate is the builder for creating a LeaveAttachment entity.
+type LeaveAttachmentCreate struct {
+	config
+	mutation *LeaveAttachmentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *LeaveAttachmentCreate) SetCreateBy(v uint32) *LeaveAttachmentCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *LeaveAttachmentCreate) SetNillableCreateBy(v *uint32) *LeaveAttachmentCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *LeaveAttachmentCreate) SetUpdateBy(v uint32) *LeaveAttachmentCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *LeaveAttachmentCreate) SetNillableUpdateBy(v *uint32) *LeaveAttachmentCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *LeaveAttachmentCreate) SetCreateTime(v time.Time) *LeaveAttachmentCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *LeaveAttachmentCreate) SetNillableCreateTime(v *time.Time) *LeaveAttachmentCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *LeaveAttachmentCreate) SetUpdateTime(v time.Time) *LeaveAttachmentCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *LeaveAttachmentCreate) SetNillableUpdateTime(v *time.Time) *LeaveAttachmentCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *LeaveAttachmentCreate) SetDeleteTime(v time.Time) *LeaveAttachmentCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *LeaveAttachmentCreate) SetNillableDeleteTime(v *time.Time) *LeaveAttachmentCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *LeaveAttachmentCreate) SetTenantID(v uint32) *LeaveAttachmentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *LeaveAttachmentCreate) SetNillableTenantID(v *uint32) *LeaveAttachmentCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (_c *LeaveAttachmentCreate) SetLeaveRequestID(v string) *LeaveAttachmentCreate {
+	_c.mutation.SetLeaveRequestID(v)
+	return _c
+}
+
+// SetFileName sets the "file_name" field.
+func (_c *LeaveAttachmentCreate) SetFileName(v string) *LeaveAttachmentCreate {
+	_c.mutation.SetFileName(v)
+	return _c
+}
+
+// SetContentType sets the "content_type" field.
+func (_c *LeaveAttachmentCreate) SetContentType(v string) *LeaveAttachmentCreate {
+	_c.mutation.SetContentType(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *LeaveAttachmentCreate) SetSize(v int64) *LeaveAttachmentCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetChecksum sets the "checksum" field.
+func (_c *LeaveAttachmentCreate) SetChecksum(v string) *LeaveAttachmentCreate {
+	_c.mutation.SetChecksum(v)
+	return _c
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_c *LeaveAttachmentCreate) SetStorageKey(v string) *LeaveAttachmentCreate {
+	_c.mutation.SetStorageKey(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LeaveAttachmentCreate) SetID(v string) *LeaveAttachmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the LeaveAttachmentMutation object of the builder.
+func (_c *LeaveAttachmentCreate) Mutation() *LeaveAttachmentMutation {
+	return _c.mutation
+}
+
+// Save creates the LeaveAttachment in the database.
+func (_c *LeaveAttachmentCreate) Save(ctx context.Context) (*LeaveAttachment, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LeaveAttachmentCreate) SaveX(ctx context.Context) *LeaveAttachment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeaveAttachmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeaveAttachmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LeaveAttachmentCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := leaveattachment.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LeaveAttachmentCreate) check() error {
+	if _, ok := _c.mutation.LeaveRequestID(); !ok {
+		return &ValidationError{Name: "leave_request_id", err: errors.New(`ent: missing required field "LeaveAttachment.leave_request_id"`)}
+	}
+	if v, ok := _c.mutation.LeaveRequestID(); ok {
+		if err := leaveattachment.LeaveRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "leave_request_id", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.leave_request_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FileName(); !ok {
+		return &ValidationError{Name: "file_name", err: errors.New(`ent: missing required field "LeaveAttachment.file_name"`)}
+	}
+	if v, ok := _c.mutation.FileName(); ok {
+		if err := leaveattachment.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.file_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`ent: missing required field "LeaveAttachment.content_type"`)}
+	}
+	if v, ok := _c.mutation.ContentType(); ok {
+		if err := leaveattachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.content_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "LeaveAttachment.size"`)}
+	}
+	if v, ok := _c.mutation.Size(); ok {
+		if err := leaveattachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Checksum(); !ok {
+		return &ValidationError{Name: "checksum", err: errors.New(`ent: missing required field "LeaveAttachment.checksum"`)}
+	}
+	if v, ok := _c.mutation.Checksum(); ok {
+		if err := leaveattachment.ChecksumValidator(v); err != nil {
+			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.checksum": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "LeaveAttachment.storage_key"`)}
+	}
+	if v, ok := _c.mutation.StorageKey(); ok {
+		if err := leaveattachment.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := leaveattachment.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *LeaveAttachmentCreate) sqlSave(ctx context.Context) (*LeaveAttachment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LeaveAttachment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LeaveAttachmentCreate) createSpec() (*LeaveAttachment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeaveAttachment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(leaveattachment.Table, sqlgraph.NewFieldSpec(leaveattachment.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(leaveattachment.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(leaveattachment.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(leaveattachment.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(leaveattachment.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(leaveattachment.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(leaveattachment.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.LeaveRequestID(); ok {
+		_spec.SetField(leaveattachment.FieldLeaveRequestID, field.TypeString, value)
+		_node.LeaveRequestID = value
+	}
+	if value, ok := _c.mutation.FileName(); ok {
+		_spec.SetField(leaveattachment.FieldFileName, field.TypeString, value)
+		_node.FileName = value
+	}
+	if value, ok := _c.mutation.ContentType(); ok {
+		_spec.SetField(leaveattachment.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(leaveattachment.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.Checksum(); ok {
+		_spec.SetField(leaveattachment.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	if value, ok := _c.mutation.StorageKey(); ok {
+		_spec.SetField(leaveattachment.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LeaveAttachment.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeaveAttachmentUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LeaveAttachmentCreate) OnConflict(opts ...sql.ConflictOption) *LeaveAttachmentUpsertOne {
+	_c.conflict = opts
+	return &LeaveAttachmentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LeaveAttachment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LeaveAttachmentCreate) OnConflictColumns(columns ...string) *LeaveAttachmentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LeaveAttachmentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LeaveAttachmentUpsertOne is the builder for "upsert"-ing
+	//  one LeaveAttachment node.
+	LeaveAttachmentUpsertOne struct {
+		create *LeaveAttachmentCreate
+	}
+
+	// LeaveAttachmentUpsert is the "OnConflict" setter.
+	LeaveAttachmentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *LeaveAttachmentUpsert) SetCreateBy(v uint32) *LeaveAttachmentUpsert {
+	u.Set(leaveattachment.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsert) UpdateCreateBy() *LeaveAttachmentUpsert {
+	u.SetExcluded(leaveattachment.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *LeaveAttachmentUpsert) AddCreateBy(v uint32) *LeaveAttachmentUpsert {
+	u.Add(leaveattachment.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *LeaveAttachmentUpsert) ClearCreateBy() *LeaveAttachmentUpsert {
+	u.SetNull(leaveattachment.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *LeaveAttachmentUpsert) SetUpdateBy(v uint32) *LeaveAttachmentUpsert {
+	u.Set(leaveattachment.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsert) UpdateUpdateBy() *LeaveAttachmentUpsert {
+	u.SetExcluded(leaveattachment.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *LeaveAttachmentUpsert) AddUpdateBy(v uint32) *LeaveAttachmentUpsert {
+	u.Add(leaveattachment.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *LeaveAttachmentUpsert) ClearUpdateBy() *LeaveAttachmentUpsert {
+	u.SetNull(leaveattachment.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LeaveAttachmentUpsert) SetUpdateTime(v time.Time) *LeaveAttachmentUpsert {
+	u.Set(leaveattachment.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsert) UpdateUpdateTime() *LeaveAttachmentUpsert {
+	u.SetExcluded(leaveattachment.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *LeaveAttachmentUpsert) ClearUpdateTime() *LeaveAttachmentUpsert {
+	u.SetNull(leaveattachment.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *LeaveAttachmentUpsert) SetDeleteTime(v time.Time) *LeaveAttachmentUpsert {
+	u.Set(leaveattachment.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsert) UpdateDeleteTime() *LeaveAttachmentUpsert {
+	u.SetExcluded(leaveattachment.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *LeaveAttachmentUpsert) ClearDeleteTime() *LeaveAttachmentUpsert {
+	u.SetNull(leaveattachment.FieldDeleteTime)
+	return u
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (u *LeaveAttachmentUpsert) SetLeaveRequestID(v string) *LeaveAttachmentUpsert {
+	u.Set(leaveattachment.FieldLeaveRequestID, v)
+	return u
+}
+
+// UpdateLeaveRequestID sets the "leave_request_id" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsert) UpdateLeaveRequestID() *LeaveAttachmentUpsert {
+	u.SetExcluded(leaveattachment.FieldLeaveRequestID)
+	return u
+}
+
+// SetFileName sets the "file_name" field.
+func (u *LeaveAttachmentUpsert) SetFileName(v string) *LeaveAttachmentUpsert {
+	u.Set(leaveattachment.FieldFileName, v)
+	return u
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsert) UpdateFileName() *LeaveAttachmentUpsert {
+	u.SetExcluded(leaveattachment.FieldFileName)
+	return u
+}
+
+// SetContentType sets the "content_type" field.
+func (u *LeaveAttachmentUpsert) SetContentType(v string) *LeaveAttachmentUpsert {
+	u.Set(leaveattachment.FieldContentType, v)
+	return u
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsert) UpdateContentType() *LeaveAttachmentUpsert {
+	u.SetExcluded(leaveattachment.FieldContentType)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *LeaveAttachmentUpsert) SetSize(v int64) *LeaveAttachmentUpsert {
+	u.Set(leaveattachment.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsert) UpdateSize() *LeaveAttachmentUpsert {
+	u.SetExcluded(leaveattachment.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *LeaveAttachmentUpsert) AddSize(v int64) *LeaveAttachmentUpsert {
+	u.Add(leaveattachment.FieldSize, v)
+	return u
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *LeaveAttachmentUpsert) SetChecksum(v string) *LeaveAttachmentUpsert {
+	u.Set(leaveattachment.FieldChecksum, v)
+	return u
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsert) UpdateChecksum() *LeaveAttachmentUpsert {
+	u.SetExcluded(leaveattachment.FieldChecksum)
+	return u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *LeaveAttachmentUpsert) SetStorageKey(v string) *LeaveAttachmentUpsert {
+	u.Set(leaveattachment.FieldStorageKey, v)
+	return u
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsert) UpdateStorageKey() *LeaveAttachmentUpsert {
+	u.SetExcluded(leaveattachment.FieldStorageKey)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LeaveAttachment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leaveattachment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeaveAttachmentUpsertOne) UpdateNewValues() *LeaveAttachmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(leaveattachment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(leaveattachment.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(leaveattachment.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LeaveAttachment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LeaveAttachmentUpsertOne) Ignore() *LeaveAttachmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaveAttachmentUpsertOne) DoNothing() *LeaveAttachmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaveAttachmentCreate.OnConflict
+// documentation for more info.
+func (u *LeaveAttachmentUpsertOne) Update(set func(*LeaveAttachmentUpsert)) *LeaveAttachmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaveAttachmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *LeaveAttachmentUpsertOne) SetCreateBy(v uint32) *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *LeaveAttachmentUpsertOne) AddCreateBy(v uint32) *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertOne) UpdateCreateBy() *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *LeaveAttachmentUpsertOne) ClearCreateBy() *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *LeaveAttachmentUpsertOne) SetUpdateBy(v uint32) *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *LeaveAttachmentUpsertOne) AddUpdateBy(v uint32) *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertOne) UpdateUpdateBy() *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *LeaveAttachmentUpsertOne) ClearUpdateBy() *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LeaveAttachmentUpsertOne) SetUpdateTime(v time.Time) *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertOne) UpdateUpdateTime() *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *LeaveAttachmentUpsertOne) ClearUpdateTime() *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *LeaveAttachmentUpsertOne) SetDeleteTime(v time.Time) *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertOne) UpdateDeleteTime() *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *LeaveAttachmentUpsertOne) ClearDeleteTime() *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (u *LeaveAttachmentUpsertOne) SetLeaveRequestID(v string) *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetLeaveRequestID(v)
+	})
+}
+
+// UpdateLeaveRequestID sets the "leave_request_id" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertOne) UpdateLeaveRequestID() *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateLeaveRequestID()
+	})
+}
+
+// SetFileName sets the "file_name" field.
+func (u *LeaveAttachmentUpsertOne) SetFileName(v string) *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertOne) UpdateFileName() *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// SetContentType sets the "content_type" field.
+func (u *LeaveAttachmentUpsertOne) SetContentType(v string) *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetContentType(v)
+	})
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertOne) UpdateContentType() *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateContentType()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *LeaveAttachmentUpsertOne) SetSize(v int64) *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *LeaveAttachmentUpsertOne) AddSize(v int64) *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertOne) UpdateSize() *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *LeaveAttachmentUpsertOne) SetChecksum(v string) *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetChecksum(v)
+	})
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertOne) UpdateChecksum() *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateChecksum()
+	})
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *LeaveAttachmentUpsertOne) SetStorageKey(v string) *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetStorageKey(v)
+	})
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertOne) UpdateStorageKey() *LeaveAttachmentUpsertOne {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateStorageKey()
+	})
+}
+
+// Exec executes the query.
+func (u *LeaveAttachmentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LeaveAttachmentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeaveAttachmentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LeaveAttachmentUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LeaveAttachmentUpsertOne.ID is not supported by MySQL driver. Use LeaveAttachmentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LeaveAttachmentUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LeaveAttachmentCreateBulk is the builder for creating many LeaveAttachment entities in bulk.
+type LeaveAttachmentCreateBulk struct {
+	config
+	err      error
+	builders []*LeaveAttachmentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LeaveAttachment entities in the database.
+func (_c *LeaveAttachmentCreateBulk) Save(ctx context.Context) ([]*LeaveAttachment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LeaveAttachment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeaveAttachmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LeaveAttachmentCreateBulk) SaveX(ctx context.Context) []*LeaveAttachment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeaveAttachmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeaveAttachmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LeaveAttachment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeaveAttachmentUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LeaveAttachmentCreateBulk) OnConflict(opts ...sql.ConflictOption) *LeaveAttachmentUpsertBulk {
+	_c.conflict = opts
+	return &LeaveAttachmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LeaveAttachment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LeaveAttachmentCreateBulk) OnConflictColumns(columns ...string) *LeaveAttachmentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LeaveAttachmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// LeaveAttachmentUpsertBulk is the builder for "upsert"-ing
+// a bulk of LeaveAttachment nodes.
+type LeaveAttachmentUpsertBulk struct {
+	create *LeaveAttachmentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LeaveAttachment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leaveattachment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeaveAttachmentUpsertBulk) UpdateNewValues() *LeaveAttachmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(leaveattachment.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(leaveattachment.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(leaveattachment.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LeaveAttachment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LeaveAttachmentUpsertBulk) Ignore() *LeaveAttachmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaveAttachmentUpsertBulk) DoNothing() *LeaveAttachmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaveAttachmentCreateBulk.OnConflict
+// documentation for more info.
+func (u *LeaveAttachmentUpsertBulk) Update(set func(*LeaveAttachmentUpsert)) *LeaveAttachmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaveAttachmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *LeaveAttachmentUpsertBulk) SetCreateBy(v uint32) *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *LeaveAttachmentUpsertBulk) AddCreateBy(v uint32) *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertBulk) UpdateCreateBy() *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *LeaveAttachmentUpsertBulk) ClearCreateBy() *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *LeaveAttachmentUpsertBulk) SetUpdateBy(v uint32) *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *LeaveAttachmentUpsertBulk) AddUpdateBy(v uint32) *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertBulk) UpdateUpdateBy() *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *LeaveAttachmentUpsertBulk) ClearUpdateBy() *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LeaveAttachmentUpsertBulk) SetUpdateTime(v time.Time) *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertBulk) UpdateUpdateTime() *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *LeaveAttachmentUpsertBulk) ClearUpdateTime() *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *LeaveAttachmentUpsertBulk) SetDeleteTime(v time.Time) *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertBulk) UpdateDeleteTime() *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *LeaveAttachmentUpsertBulk) ClearDeleteTime() *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (u *LeaveAttachmentUpsertBulk) SetLeaveRequestID(v string) *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetLeaveRequestID(v)
+	})
+}
+
+// UpdateLeaveRequestID sets the "leave_request_id" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertBulk) UpdateLeaveRequestID() *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateLeaveRequestID()
+	})
+}
+
+// SetFileName sets the "file_name" field.
+func (u *LeaveAttachmentUpsertBulk) SetFileName(v string) *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetFileName(v)
+	})
+}
+
+// UpdateFileName sets the "file_name" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertBulk) UpdateFileName() *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateFileName()
+	})
+}
+
+// SetContentType sets the "content_type" field.
+func (u *LeaveAttachmentUpsertBulk) SetContentType(v string) *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetContentType(v)
+	})
+}
+
+// UpdateContentType sets the "content_type" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertBulk) UpdateContentType() *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateContentType()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *LeaveAttachmentUpsertBulk) SetSize(v int64) *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *LeaveAttachmentUpsertBulk) AddSize(v int64) *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertBulk) UpdateSize() *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *LeaveAttachmentUpsertBulk) SetChecksum(v string) *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetChecksum(v)
+	})
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertBulk) UpdateChecksum() *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateChecksum()
+	})
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *LeaveAttachmentUpsertBulk) SetStorageKey(v string) *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.SetStorageKey(v)
+	})
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *LeaveAttachmentUpsertBulk) UpdateStorageKey() *LeaveAttachmentUpsertBulk {
+	return u.Update(func(s *LeaveAttachmentUpsert) {
+		s.UpdateStorageKey()
+	})
+}
+
+// Exec executes the query.
+func (u *LeaveAttachmentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LeaveAttachmentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LeaveAttachmentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeaveAttachmentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,752 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveattachment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// LeaveAttachmentUpdate is the builder for updating LeaveAttachment entities.
+type LeaveAttachmentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeaveAttachmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeaveAttachmentUpdate builder.
+func (_u *LeaveAttachmentUpdate) Where(ps ...predicate.LeaveAttachment) *LeaveAttachmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *LeaveAttachmentUpdate) SetCreateBy(v uint32) *LeaveAttachmentUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdate) SetNillableCreateBy(v *uint32) *LeaveAttachmentUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *LeaveAttachmentUpdate) AddCreateBy(v int32) *LeaveAttachmentUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *LeaveAttachmentUpdate) ClearCreateBy() *LeaveAttachmentUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *LeaveAttachmentUpdate) SetUpdateBy(v uint32) *LeaveAttachmentUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdate) SetNillableUpdateBy(v *uint32) *LeaveAttachmentUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *LeaveAttachmentUpdate) AddUpdateBy(v int32) *LeaveAttachmentUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *LeaveAttachmentUpdate) ClearUpdateBy() *LeaveAttachmentUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *LeaveAttachmentUpdate) SetUpdateTime(v time.Time) *LeaveAttachmentUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdate) SetNillableUpdateTime(v *time.Time) *LeaveAttachmentUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *LeaveAttachmentUpdate) ClearUpdateTime() *LeaveAttachmentUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *LeaveAttachmentUpdate) SetDeleteTime(v time.Time) *LeaveAttachmentUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdate) SetNillableDeleteTime(v *time.Time) *LeaveAttachmentUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *LeaveAttachmentUpdate) ClearDeleteTime() *LeaveAttachmentUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (_u *LeaveAttachmentUpdate) SetLeaveRequestID(v string) *LeaveAttachmentUpdate {
+	_u.mutation.SetLeaveRequestID(v)
+	return _u
+}
+
+// SetNillableLeaveRequestID sets the "leave_request_id" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdate) SetNillableLeaveRequestID(v *string) *LeaveAttachmentUpdate {
+	if v != nil {
+		_u.SetLeaveRequestID(*v)
+	}
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *LeaveAttachmentUpdate) SetFileName(v string) *LeaveAttachmentUpdate {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdate) SetNillableFileName(v *string) *LeaveAttachmentUpdate {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *LeaveAttachmentUpdate) SetContentType(v string) *LeaveAttachmentUpdate {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdate) SetNillableContentType(v *string) *LeaveAttachmentUpdate {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *LeaveAttachmentUpdate) SetSize(v int64) *LeaveAttachmentUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdate) SetNillableSize(v *int64) *LeaveAttachmentUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *LeaveAttachmentUpdate) AddSize(v int64) *LeaveAttachmentUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetChecksum sets the "checksum" field.
+func (_u *LeaveAttachmentUpdate) SetChecksum(v string) *LeaveAttachmentUpdate {
+	_u.mutation.SetChecksum(v)
+	return _u
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdate) SetNillableChecksum(v *string) *LeaveAttachmentUpdate {
+	if v != nil {
+		_u.SetChecksum(*v)
+	}
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *LeaveAttachmentUpdate) SetStorageKey(v string) *LeaveAttachmentUpdate {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdate) SetNillableStorageKey(v *string) *LeaveAttachmentUpdate {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LeaveAttachmentMutation object of the builder.
+func (_u *LeaveAttachmentUpdate) Mutation() *LeaveAttachmentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LeaveAttachmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeaveAttachmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LeaveAttachmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeaveAttachmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeaveAttachmentUpdate) check() error {
+	if v, ok := _u.mutation.LeaveRequestID(); ok {
+		if err := leaveattachment.LeaveRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "leave_request_id", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.leave_request_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FileName(); ok {
+		if err := leaveattachment.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.file_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := leaveattachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.content_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Size(); ok {
+		if err := leaveattachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Checksum(); ok {
+		if err := leaveattachment.ChecksumValidator(v); err != nil {
+			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.checksum": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageKey(); ok {
+		if err := leaveattachment.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.storage_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeaveAttachmentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaveAttachmentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeaveAttachmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leaveattachment.Table, leaveattachment.Columns, sqlgraph.NewFieldSpec(leaveattachment.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(leaveattachment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(leaveattachment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(leaveattachment.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(leaveattachment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(leaveattachment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(leaveattachment.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(leaveattachment.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(leaveattachment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(leaveattachment.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(leaveattachment.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(leaveattachment.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(leaveattachment.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LeaveRequestID(); ok {
+		_spec.SetField(leaveattachment.FieldLeaveRequestID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(leaveattachment.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(leaveattachment.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(leaveattachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(leaveattachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Checksum(); ok {
+		_spec.SetField(leaveattachment.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(leaveattachment.FieldStorageKey, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaveattachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LeaveAttachmentUpdateOne is the builder for updating a single LeaveAttachment entity.
+type LeaveAttachmentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeaveAttachmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *LeaveAttachmentUpdateOne) SetCreateBy(v uint32) *LeaveAttachmentUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdateOne) SetNillableCreateBy(v *uint32) *LeaveAttachmentUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *LeaveAttachmentUpdateOne) AddCreateBy(v int32) *LeaveAttachmentUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *LeaveAttachmentUpdateOne) ClearCreateBy() *LeaveAttachmentUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *LeaveAttachmentUpdateOne) SetUpdateBy(v uint32) *LeaveAttachmentUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdateOne) SetNillableUpdateBy(v *uint32) *LeaveAttachmentUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *LeaveAttachmentUpdateOne) AddUpdateBy(v int32) *LeaveAttachmentUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *LeaveAttachmentUpdateOne) ClearUpdateBy() *LeaveAttachmentUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *LeaveAttachmentUpdateOne) SetUpdateTime(v time.Time) *LeaveAttachmentUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdateOne) SetNillableUpdateTime(v *time.Time) *LeaveAttachmentUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *LeaveAttachmentUpdateOne) ClearUpdateTime() *LeaveAttachmentUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *LeaveAttachmentUpdateOne) SetDeleteTime(v time.Time) *LeaveAttachmentUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdateOne) SetNillableDeleteTime(v *time.Time) *LeaveAttachmentUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *LeaveAttachmentUpdateOne) ClearDeleteTime() *LeaveAttachmentUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (_u *LeaveAttachmentUpdateOne) SetLeaveRequestID(v string) *LeaveAttachmentUpdateOne {
+	_u.mutation.SetLeaveRequestID(v)
+	return _u
+}
+
+// SetNillableLeaveRequestID sets the "leave_request_id" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdateOne) SetNillableLeaveRequestID(v *string) *LeaveAttachmentUpdateOne {
+	if v != nil {
+		_u.SetLeaveRequestID(*v)
+	}
+	return _u
+}
+
+// SetFileName sets the "file_name" field.
+func (_u *LeaveAttachmentUpdateOne) SetFileName(v string) *LeaveAttachmentUpdateOne {
+	_u.mutation.SetFileName(v)
+	return _u
+}
+
+// SetNillableFileName sets the "file_name" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdateOne) SetNillableFileName(v *string) *LeaveAttachmentUpdateOne {
+	if v != nil {
+		_u.SetFileName(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *LeaveAttachmentUpdateOne) SetContentType(v string) *LeaveAttachmentUpdateOne {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdateOne) SetNillableContentType(v *string) *LeaveAttachmentUpdateOne {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *LeaveAttachmentUpdateOne) SetSize(v int64) *LeaveAttachmentUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdateOne) SetNillableSize(v *int64) *LeaveAttachmentUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *LeaveAttachmentUpdateOne) AddSize(v int64) *LeaveAttachmentUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetChecksum sets the "checksum" field.
+func (_u *LeaveAttachmentUpdateOne) SetChecksum(v string) *LeaveAttachmentUpdateOne {
+	_u.mutation.SetChecksum(v)
+	return _u
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdateOne) SetNillableChecksum(v *string) *LeaveAttachmentUpdateOne {
+	if v != nil {
+		_u.SetChecksum(*v)
+	}
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *LeaveAttachmentUpdateOne) SetStorageKey(v string) *LeaveAttachmentUpdateOne {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *LeaveAttachmentUpdateOne) SetNillableStorageKey(v *string) *LeaveAttachmentUpdateOne {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LeaveAttachmentMutation object of the builder.
+func (_u *LeaveAttachmentUpdateOne) Mutation() *LeaveAttachmentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LeaveAttachmentUpdate builder.
+func (_u *LeaveAttachmentUpdateOne) Where(ps ...predicate.LeaveAttachment) *LeaveAttachmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LeaveAttachmentUpdateOne) Select(field string, fields ...string) *LeaveAttachmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LeaveAttachment entity.
+func (_u *LeaveAttachmentUpdateOne) Save(ctx context.Context) (*LeaveAttachment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeaveAttachmentUpdateOne) SaveX(ctx context.Context) *LeaveAttachment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LeaveAttachmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeaveAttachmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeaveAttachmentUpdateOne) check() error {
+	if v, ok := _u.mutation.LeaveRequestID(); ok {
+		if err := leaveattachment.LeaveRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "leave_request_id", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.leave_request_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FileName(); ok {
+		if err := leaveattachment.FileNameValidator(v); err != nil {
+			return &ValidationError{Name: "file_name", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.file_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := leaveattachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.content_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Size(); ok {
+		if err := leaveattachment.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Checksum(); ok {
+		if err := leaveattachment.ChecksumValidator(v); err != nil {
+			return &ValidationError{Name: "checksum", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.checksum": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageKey(); ok {
+		if err := leaveattachment.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "LeaveAttachment.storage_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeaveAttachmentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaveAttachmentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeaveAttachmentUpdateOne) sqlSave(ctx context.Context) (_node *LeaveAttachment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leaveattachment.Table, leaveattachment.Columns, sqlgraph.NewFieldSpec(leaveattachment.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeaveAttachment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leaveattachment.FieldID)
+		for _, f := range fields {
+			if !leaveattachment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leaveattachment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(leaveattachment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(leaveattachment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(leaveattachment.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(leaveattachment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(leaveattachment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(leaveattachment.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(leaveattachment.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(leaveattachment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(leaveattachment.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(leaveattachment.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(leaveattachment.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(leaveattachment.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LeaveRequestID(); ok {
+		_spec.SetField(leaveattachment.FieldLeaveRequestID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FileName(); ok {
+		_spec.SetField(leaveattachment.FieldFileName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(leaveattachment.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(leaveattachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(leaveattachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Checksum(); ok {
+		_spec.SetField(leaveattachment.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(leaveattachment.FieldStorageKey, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LeaveAttachment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leaveattachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
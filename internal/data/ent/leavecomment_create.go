@@ -0,0 +1,1263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavecomment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/schema"
+)
+
+// LeaveCommentCreate is the builder for creating a LeaveComment entity.
+type LeaveCommentCreate struct {
+	config
+	mutation *LeaveCommentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *LeaveCommentCreate) SetCreateBy(v uint32) *LeaveCommentCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *LeaveCommentCreate) SetNillableCreateBy(v *uint32) *LeaveCommentCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *LeaveCommentCreate) SetUpdateBy(v uint32) *LeaveCommentCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *LeaveCommentCreate) SetNillableUpdateBy(v *uint32) *LeaveCommentCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *LeaveCommentCreate) SetCreateTime(v time.Time) *LeaveCommentCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *LeaveCommentCreate) SetNillableCreateTime(v *time.Time) *LeaveCommentCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *LeaveCommentCreate) SetUpdateTime(v time.Time) *LeaveCommentCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *LeaveCommentCreate) SetNillableUpdateTime(v *time.Time) *LeaveCommentCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *LeaveCommentCreate) SetDeleteTime(v time.Time) *LeaveCommentCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *LeaveCommentCreate) SetNillableDeleteTime(v *time.Time) *LeaveCommentCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *LeaveCommentCreate) SetTenantID(v uint32) *LeaveCommentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *LeaveCommentCreate) SetNillableTenantID(v *uint32) *LeaveCommentCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (_c *LeaveCommentCreate) SetLeaveRequestID(v string) *LeaveCommentCreate {
+	_c.mutation.SetLeaveRequestID(v)
+	return _c
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_c *LeaveCommentCreate) SetAuthorID(v uint32) *LeaveCommentCreate {
+	_c.mutation.SetAuthorID(v)
+	return _c
+}
+
+// SetAuthorName sets the "author_name" field.
+func (_c *LeaveCommentCreate) SetAuthorName(v string) *LeaveCommentCreate {
+	_c.mutation.SetAuthorName(v)
+	return _c
+}
+
+// SetNillableAuthorName sets the "author_name" field if the given value is not nil.
+func (_c *LeaveCommentCreate) SetNillableAuthorName(v *string) *LeaveCommentCreate {
+	if v != nil {
+		_c.SetAuthorName(*v)
+	}
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *LeaveCommentCreate) SetBody(v string) *LeaveCommentCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetVisibility sets the "visibility" field.
+func (_c *LeaveCommentCreate) SetVisibility(v leavecomment.Visibility) *LeaveCommentCreate {
+	_c.mutation.SetVisibility(v)
+	return _c
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_c *LeaveCommentCreate) SetNillableVisibility(v *leavecomment.Visibility) *LeaveCommentCreate {
+	if v != nil {
+		_c.SetVisibility(*v)
+	}
+	return _c
+}
+
+// SetEdits sets the "edits" field.
+func (_c *LeaveCommentCreate) SetEdits(v []schema.CommentEdit) *LeaveCommentCreate {
+	_c.mutation.SetEdits(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LeaveCommentCreate) SetID(v string) *LeaveCommentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the LeaveCommentMutation object of the builder.
+func (_c *LeaveCommentCreate) Mutation() *LeaveCommentMutation {
+	return _c.mutation
+}
+
+// Save creates the LeaveComment in the database.
+func (_c *LeaveCommentCreate) Save(ctx context.Context) (*LeaveComment, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LeaveCommentCreate) SaveX(ctx context.Context) *LeaveComment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeaveCommentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeaveCommentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LeaveCommentCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := leavecomment.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		v := leavecomment.DefaultVisibility
+		_c.mutation.SetVisibility(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LeaveCommentCreate) check() error {
+	if _, ok := _c.mutation.LeaveRequestID(); !ok {
+		return &ValidationError{Name: "leave_request_id", err: errors.New(`ent: missing required field "LeaveComment.leave_request_id"`)}
+	}
+	if v, ok := _c.mutation.LeaveRequestID(); ok {
+		if err := leavecomment.LeaveRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "leave_request_id", err: fmt.Errorf(`ent: validator failed for field "LeaveComment.leave_request_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AuthorID(); !ok {
+		return &ValidationError{Name: "author_id", err: errors.New(`ent: missing required field "LeaveComment.author_id"`)}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "LeaveComment.body"`)}
+	}
+	if v, ok := _c.mutation.Body(); ok {
+		if err := leavecomment.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "LeaveComment.body": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "LeaveComment.visibility"`)}
+	}
+	if v, ok := _c.mutation.Visibility(); ok {
+		if err := leavecomment.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "LeaveComment.visibility": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := leavecomment.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LeaveComment.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *LeaveCommentCreate) sqlSave(ctx context.Context) (*LeaveComment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LeaveComment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LeaveCommentCreate) createSpec() (*LeaveComment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeaveComment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(leavecomment.Table, sqlgraph.NewFieldSpec(leavecomment.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(leavecomment.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(leavecomment.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(leavecomment.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(leavecomment.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(leavecomment.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(leavecomment.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.LeaveRequestID(); ok {
+		_spec.SetField(leavecomment.FieldLeaveRequestID, field.TypeString, value)
+		_node.LeaveRequestID = value
+	}
+	if value, ok := _c.mutation.AuthorID(); ok {
+		_spec.SetField(leavecomment.FieldAuthorID, field.TypeUint32, value)
+		_node.AuthorID = value
+	}
+	if value, ok := _c.mutation.AuthorName(); ok {
+		_spec.SetField(leavecomment.FieldAuthorName, field.TypeString, value)
+		_node.AuthorName = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(leavecomment.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.Visibility(); ok {
+		_spec.SetField(leavecomment.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := _c.mutation.Edits(); ok {
+		_spec.SetField(leavecomment.FieldEdits, field.TypeJSON, value)
+		_node.Edits = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LeaveComment.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeaveCommentUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LeaveCommentCreate) OnConflict(opts ...sql.ConflictOption) *LeaveCommentUpsertOne {
+	_c.conflict = opts
+	return &LeaveCommentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LeaveComment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LeaveCommentCreate) OnConflictColumns(columns ...string) *LeaveCommentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LeaveCommentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LeaveCommentUpsertOne is the builder for "upsert"-ing
+	//  one LeaveComment node.
+	LeaveCommentUpsertOne struct {
+		create *LeaveCommentCreate
+	}
+
+	// LeaveCommentUpsert is the "OnConflict" setter.
+	LeaveCommentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *LeaveCommentUpsert) SetCreateBy(v uint32) *LeaveCommentUpsert {
+	u.Set(leavecomment.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *LeaveCommentUpsert) UpdateCreateBy() *LeaveCommentUpsert {
+	u.SetExcluded(leavecomment.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *LeaveCommentUpsert) AddCreateBy(v uint32) *LeaveCommentUpsert {
+	u.Add(leavecomment.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *LeaveCommentUpsert) ClearCreateBy() *LeaveCommentUpsert {
+	u.SetNull(leavecomment.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *LeaveCommentUpsert) SetUpdateBy(v uint32) *LeaveCommentUpsert {
+	u.Set(leavecomment.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *LeaveCommentUpsert) UpdateUpdateBy() *LeaveCommentUpsert {
+	u.SetExcluded(leavecomment.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *LeaveCommentUpsert) AddUpdateBy(v uint32) *LeaveCommentUpsert {
+	u.Add(leavecomment.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *LeaveCommentUpsert) ClearUpdateBy() *LeaveCommentUpsert {
+	u.SetNull(leavecomment.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LeaveCommentUpsert) SetUpdateTime(v time.Time) *LeaveCommentUpsert {
+	u.Set(leavecomment.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LeaveCommentUpsert) UpdateUpdateTime() *LeaveCommentUpsert {
+	u.SetExcluded(leavecomment.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *LeaveCommentUpsert) ClearUpdateTime() *LeaveCommentUpsert {
+	u.SetNull(leavecomment.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *LeaveCommentUpsert) SetDeleteTime(v time.Time) *LeaveCommentUpsert {
+	u.Set(leavecomment.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *LeaveCommentUpsert) UpdateDeleteTime() *LeaveCommentUpsert {
+	u.SetExcluded(leavecomment.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *LeaveCommentUpsert) ClearDeleteTime() *LeaveCommentUpsert {
+	u.SetNull(leavecomment.FieldDeleteTime)
+	return u
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (u *LeaveCommentUpsert) SetLeaveRequestID(v string) *LeaveCommentUpsert {
+	u.Set(leavecomment.FieldLeaveRequestID, v)
+	return u
+}
+
+// UpdateLeaveRequestID sets the "leave_request_id" field to the value that was provided on create.
+func (u *LeaveCommentUpsert) UpdateLeaveRequestID() *LeaveCommentUpsert {
+	u.SetExcluded(leavecomment.FieldLeaveRequestID)
+	return u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *LeaveCommentUpsert) SetAuthorID(v uint32) *LeaveCommentUpsert {
+	u.Set(leavecomment.FieldAuthorID, v)
+	return u
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *LeaveCommentUpsert) UpdateAuthorID() *LeaveCommentUpsert {
+	u.SetExcluded(leavecomment.FieldAuthorID)
+	return u
+}
+
+// AddAuthorID adds v to the "author_id" field.
+func (u *LeaveCommentUpsert) AddAuthorID(v uint32) *LeaveCommentUpsert {
+	u.Add(leavecomment.FieldAuthorID, v)
+	return u
+}
+
+// SetAuthorName sets the "author_name" field.
+func (u *LeaveCommentUpsert) SetAuthorName(v string) *LeaveCommentUpsert {
+	u.Set(leavecomment.FieldAuthorName, v)
+	return u
+}
+
+// UpdateAuthorName sets the "author_name" field to the value that was provided on create.
+func (u *LeaveCommentUpsert) UpdateAuthorName() *LeaveCommentUpsert {
+	u.SetExcluded(leavecomment.FieldAuthorName)
+	return u
+}
+
+// ClearAuthorName clears the value of the "author_name" field.
+func (u *LeaveCommentUpsert) ClearAuthorName() *LeaveCommentUpsert {
+	u.SetNull(leavecomment.FieldAuthorName)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *LeaveCommentUpsert) SetBody(v string) *LeaveCommentUpsert {
+	u.Set(leavecomment.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *LeaveCommentUpsert) UpdateBody() *LeaveCommentUpsert {
+	u.SetExcluded(leavecomment.FieldBody)
+	return u
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *LeaveCommentUpsert) SetVisibility(v leavecomment.Visibility) *LeaveCommentUpsert {
+	u.Set(leavecomment.FieldVisibility, v)
+	return u
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *LeaveCommentUpsert) UpdateVisibility() *LeaveCommentUpsert {
+	u.SetExcluded(leavecomment.FieldVisibility)
+	return u
+}
+
+// SetEdits sets the "edits" field.
+func (u *LeaveCommentUpsert) SetEdits(v []schema.CommentEdit) *LeaveCommentUpsert {
+	u.Set(leavecomment.FieldEdits, v)
+	return u
+}
+
+// UpdateEdits sets the "edits" field to the value that was provided on create.
+func (u *LeaveCommentUpsert) UpdateEdits() *LeaveCommentUpsert {
+	u.SetExcluded(leavecomment.FieldEdits)
+	return u
+}
+
+// ClearEdits clears the value of the "edits" field.
+func (u *LeaveCommentUpsert) ClearEdits() *LeaveCommentUpsert {
+	u.SetNull(leavecomment.FieldEdits)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LeaveComment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leavecomment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeaveCommentUpsertOne) UpdateNewValues() *LeaveCommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(leavecomment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(leavecomment.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(leavecomment.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LeaveComment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LeaveCommentUpsertOne) Ignore() *LeaveCommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaveCommentUpsertOne) DoNothing() *LeaveCommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaveCommentCreate.OnConflict
+// documentation for more info.
+func (u *LeaveCommentUpsertOne) Update(set func(*LeaveCommentUpsert)) *LeaveCommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaveCommentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *LeaveCommentUpsertOne) SetCreateBy(v uint32) *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *LeaveCommentUpsertOne) AddCreateBy(v uint32) *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *LeaveCommentUpsertOne) UpdateCreateBy() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *LeaveCommentUpsertOne) ClearCreateBy() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *LeaveCommentUpsertOne) SetUpdateBy(v uint32) *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *LeaveCommentUpsertOne) AddUpdateBy(v uint32) *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *LeaveCommentUpsertOne) UpdateUpdateBy() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *LeaveCommentUpsertOne) ClearUpdateBy() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LeaveCommentUpsertOne) SetUpdateTime(v time.Time) *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LeaveCommentUpsertOne) UpdateUpdateTime() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *LeaveCommentUpsertOne) ClearUpdateTime() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *LeaveCommentUpsertOne) SetDeleteTime(v time.Time) *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *LeaveCommentUpsertOne) UpdateDeleteTime() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *LeaveCommentUpsertOne) ClearDeleteTime() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (u *LeaveCommentUpsertOne) SetLeaveRequestID(v string) *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetLeaveRequestID(v)
+	})
+}
+
+// UpdateLeaveRequestID sets the "leave_request_id" field to the value that was provided on create.
+func (u *LeaveCommentUpsertOne) UpdateLeaveRequestID() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateLeaveRequestID()
+	})
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *LeaveCommentUpsertOne) SetAuthorID(v uint32) *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetAuthorID(v)
+	})
+}
+
+// AddAuthorID adds v to the "author_id" field.
+func (u *LeaveCommentUpsertOne) AddAuthorID(v uint32) *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.AddAuthorID(v)
+	})
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *LeaveCommentUpsertOne) UpdateAuthorID() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateAuthorID()
+	})
+}
+
+// SetAuthorName sets the "author_name" field.
+func (u *LeaveCommentUpsertOne) SetAuthorName(v string) *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetAuthorName(v)
+	})
+}
+
+// UpdateAuthorName sets the "author_name" field to the value that was provided on create.
+func (u *LeaveCommentUpsertOne) UpdateAuthorName() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateAuthorName()
+	})
+}
+
+// ClearAuthorName clears the value of the "author_name" field.
+func (u *LeaveCommentUpsertOne) ClearAuthorName() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.ClearAuthorName()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *LeaveCommentUpsertOne) SetBody(v string) *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *LeaveCommentUpsertOne) UpdateBody() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *LeaveCommentUpsertOne) SetVisibility(v leavecomment.Visibility) *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetVisibility(v)
+	})
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *LeaveCommentUpsertOne) UpdateVisibility() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateVisibility()
+	})
+}
+
+// SetEdits sets the "edits" field.
+func (u *LeaveCommentUpsertOne) SetEdits(v []schema.CommentEdit) *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetEdits(v)
+	})
+}
+
+// UpdateEdits sets the "edits" field to the value that was provided on create.
+func (u *LeaveCommentUpsertOne) UpdateEdits() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateEdits()
+	})
+}
+
+// ClearEdits clears the value of the "edits" field.
+func (u *LeaveCommentUpsertOne) ClearEdits() *LeaveCommentUpsertOne {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.ClearEdits()
+	})
+}
+
+// Exec executes the query.
+func (u *LeaveCommentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LeaveCommentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeaveCommentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LeaveCommentUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LeaveCommentUpsertOne.ID is not supported by MySQL driver. Use LeaveCommentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LeaveCommentUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LeaveCommentCreateBulk is the builder for creating many LeaveComment entities in bulk.
+type LeaveCommentCreateBulk struct {
+	config
+	err      error
+	builders []*LeaveCommentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LeaveComment entities in the database.
+func (_c *LeaveCommentCreateBulk) Save(ctx context.Context) ([]*LeaveComment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LeaveComment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeaveCommentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LeaveCommentCreateBulk) SaveX(ctx context.Context) []*LeaveComment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeaveCommentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeaveCommentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LeaveComment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeaveCommentUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LeaveCommentCreateBulk) OnConflict(opts ...sql.ConflictOption) *LeaveCommentUpsertBulk {
+	_c.conflict = opts
+	return &LeaveCommentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LeaveComment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LeaveCommentCreateBulk) OnConflictColumns(columns ...string) *LeaveCommentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LeaveCommentUpsertBulk{
+		create: _c,
+	}
+}
+
+// LeaveCommentUpsertBulk is the builder for "upsert"-ing
+// a bulk of LeaveComment nodes.
+type LeaveCommentUpsertBulk struct {
+	create *LeaveCommentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LeaveComment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leavecomment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeaveCommentUpsertBulk) UpdateNewValues() *LeaveCommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(leavecomment.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(leavecomment.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(leavecomment.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LeaveComment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LeaveCommentUpsertBulk) Ignore() *LeaveCommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaveCommentUpsertBulk) DoNothing() *LeaveCommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaveCommentCreateBulk.OnConflict
+// documentation for more info.
+func (u *LeaveCommentUpsertBulk) Update(set func(*LeaveCommentUpsert)) *LeaveCommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaveCommentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *LeaveCommentUpsertBulk) SetCreateBy(v uint32) *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *LeaveCommentUpsertBulk) AddCreateBy(v uint32) *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *LeaveCommentUpsertBulk) UpdateCreateBy() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *LeaveCommentUpsertBulk) ClearCreateBy() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *LeaveCommentUpsertBulk) SetUpdateBy(v uint32) *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *LeaveCommentUpsertBulk) AddUpdateBy(v uint32) *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *LeaveCommentUpsertBulk) UpdateUpdateBy() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *LeaveCommentUpsertBulk) ClearUpdateBy() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LeaveCommentUpsertBulk) SetUpdateTime(v time.Time) *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LeaveCommentUpsertBulk) UpdateUpdateTime() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *LeaveCommentUpsertBulk) ClearUpdateTime() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *LeaveCommentUpsertBulk) SetDeleteTime(v time.Time) *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *LeaveCommentUpsertBulk) UpdateDeleteTime() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *LeaveCommentUpsertBulk) ClearDeleteTime() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (u *LeaveCommentUpsertBulk) SetLeaveRequestID(v string) *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetLeaveRequestID(v)
+	})
+}
+
+// UpdateLeaveRequestID sets the "leave_request_id" field to the value that was provided on create.
+func (u *LeaveCommentUpsertBulk) UpdateLeaveRequestID() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateLeaveRequestID()
+	})
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *LeaveCommentUpsertBulk) SetAuthorID(v uint32) *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetAuthorID(v)
+	})
+}
+
+// AddAuthorID adds v to the "author_id" field.
+func (u *LeaveCommentUpsertBulk) AddAuthorID(v uint32) *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.AddAuthorID(v)
+	})
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *LeaveCommentUpsertBulk) UpdateAuthorID() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateAuthorID()
+	})
+}
+
+// SetAuthorName sets the "author_name" field.
+func (u *LeaveCommentUpsertBulk) SetAuthorName(v string) *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetAuthorName(v)
+	})
+}
+
+// UpdateAuthorName sets the "author_name" field to the value that was provided on create.
+func (u *LeaveCommentUpsertBulk) UpdateAuthorName() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateAuthorName()
+	})
+}
+
+// ClearAuthorName clears the value of the "author_name" field.
+func (u *LeaveCommentUpsertBulk) ClearAuthorName() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.ClearAuthorName()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *LeaveCommentUpsertBulk) SetBody(v string) *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *LeaveCommentUpsertBulk) UpdateBody() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *LeaveCommentUpsertBulk) SetVisibility(v leavecomment.Visibility) *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetVisibility(v)
+	})
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *LeaveCommentUpsertBulk) UpdateVisibility() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateVisibility()
+	})
+}
+
+// SetEdits sets the "edits" field.
+func (u *LeaveCommentUpsertBulk) SetEdits(v []schema.CommentEdit) *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.SetEdits(v)
+	})
+}
+
+// UpdateEdits sets the "edits" field to the value that was provided on create.
+func (u *LeaveCommentUpsertBulk) UpdateEdits() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.UpdateEdits()
+	})
+}
+
+// ClearEdits clears the value of the "edits" field.
+func (u *LeaveCommentUpsertBulk) ClearEdits() *LeaveCommentUpsertBulk {
+	return u.Update(func(s *LeaveCommentUpsert) {
+		s.ClearEdits()
+	})
+}
+
+// Exec executes the query.
+func (u *LeaveCommentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LeaveCommentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LeaveCommentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeaveCommentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,766 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavecomment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/schema"
+)
+
+// LeaveCommentUpdate is the builder for updating LeaveComment entities.
+type LeaveCommentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeaveCommentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeaveCommentUpdate builder.
+func (_u *LeaveCommentUpdate) Where(ps ...predicate.LeaveComment) *LeaveCommentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *LeaveCommentUpdate) SetCreateBy(v uint32) *LeaveCommentUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *LeaveCommentUpdate) SetNillableCreateBy(v *uint32) *LeaveCommentUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *LeaveCommentUpdate) AddCreateBy(v int32) *LeaveCommentUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *LeaveCommentUpdate) ClearCreateBy() *LeaveCommentUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *LeaveCommentUpdate) SetUpdateBy(v uint32) *LeaveCommentUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *LeaveCommentUpdate) SetNillableUpdateBy(v *uint32) *LeaveCommentUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *LeaveCommentUpdate) AddUpdateBy(v int32) *LeaveCommentUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *LeaveCommentUpdate) ClearUpdateBy() *LeaveCommentUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *LeaveCommentUpdate) SetUpdateTime(v time.Time) *LeaveCommentUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *LeaveCommentUpdate) SetNillableUpdateTime(v *time.Time) *LeaveCommentUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *LeaveCommentUpdate) ClearUpdateTime() *LeaveCommentUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *LeaveCommentUpdate) SetDeleteTime(v time.Time) *LeaveCommentUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *LeaveCommentUpdate) SetNillableDeleteTime(v *time.Time) *LeaveCommentUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *LeaveCommentUpdate) ClearDeleteTime() *LeaveCommentUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (_u *LeaveCommentUpdate) SetLeaveRequestID(v string) *LeaveCommentUpdate {
+	_u.mutation.SetLeaveRequestID(v)
+	return _u
+}
+
+// SetNillableLeaveRequestID sets the "leave_request_id" field if the given value is not nil.
+func (_u *LeaveCommentUpdate) SetNillableLeaveRequestID(v *string) *LeaveCommentUpdate {
+	if v != nil {
+		_u.SetLeaveRequestID(*v)
+	}
+	return _u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_u *LeaveCommentUpdate) SetAuthorID(v uint32) *LeaveCommentUpdate {
+	_u.mutation.ResetAuthorID()
+	_u.mutation.SetAuthorID(v)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_u *LeaveCommentUpdate) SetNillableAuthorID(v *uint32) *LeaveCommentUpdate {
+	if v != nil {
+		_u.SetAuthorID(*v)
+	}
+	return _u
+}
+
+// AddAuthorID adds value to the "author_id" field.
+func (_u *LeaveCommentUpdate) AddAuthorID(v int32) *LeaveCommentUpdate {
+	_u.mutation.AddAuthorID(v)
+	return _u
+}
+
+// SetAuthorName sets the "author_name" field.
+func (_u *LeaveCommentUpdate) SetAuthorName(v string) *LeaveCommentUpdate {
+	_u.mutation.SetAuthorName(v)
+	return _u
+}
+
+// SetNillableAuthorName sets the "author_name" field if the given value is not nil.
+func (_u *LeaveCommentUpdate) SetNillableAuthorName(v *string) *LeaveCommentUpdate {
+	if v != nil {
+		_u.SetAuthorName(*v)
+	}
+	return _u
+}
+
+// ClearAuthorName clears the value of the "author_name" field.
+func (_u *LeaveCommentUpdate) ClearAuthorName() *LeaveCommentUpdate {
+	_u.mutation.ClearAuthorName()
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *LeaveCommentUpdate) SetBody(v string) *LeaveCommentUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *LeaveCommentUpdate) SetNillableBody(v *string) *LeaveCommentUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *LeaveCommentUpdate) SetVisibility(v leavecomment.Visibility) *LeaveCommentUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *LeaveCommentUpdate) SetNillableVisibility(v *leavecomment.Visibility) *LeaveCommentUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetEdits sets the "edits" field.
+func (_u *LeaveCommentUpdate) SetEdits(v []schema.CommentEdit) *LeaveCommentUpdate {
+	_u.mutation.SetEdits(v)
+	return _u
+}
+
+// AppendEdits appends value to the "edits" field.
+func (_u *LeaveCommentUpdate) AppendEdits(v []schema.CommentEdit) *LeaveCommentUpdate {
+	_u.mutation.AppendEdits(v)
+	return _u
+}
+
+// ClearEdits clears the value of the "edits" field.
+func (_u *LeaveCommentUpdate) ClearEdits() *LeaveCommentUpdate {
+	_u.mutation.ClearEdits()
+	return _u
+}
+
+// Mutation returns the LeaveCommentMutation object of the builder.
+func (_u *LeaveCommentUpdate) Mutation() *LeaveCommentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LeaveCommentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeaveCommentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LeaveCommentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeaveCommentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeaveCommentUpdate) check() error {
+	if v, ok := _u.mutation.LeaveRequestID(); ok {
+		if err := leavecomment.LeaveRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "leave_request_id", err: fmt.Errorf(`ent: validator failed for field "LeaveComment.leave_request_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Body(); ok {
+		if err := leavecomment.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "LeaveComment.body": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := leavecomment.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "LeaveComment.visibility": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeaveCommentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaveCommentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeaveCommentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leavecomment.Table, leavecomment.Columns, sqlgraph.NewFieldSpec(leavecomment.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(leavecomment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(leavecomment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(leavecomment.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(leavecomment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(leavecomment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(leavecomment.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(leavecomment.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(leavecomment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(leavecomment.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(leavecomment.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(leavecomment.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(leavecomment.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LeaveRequestID(); ok {
+		_spec.SetField(leavecomment.FieldLeaveRequestID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthorID(); ok {
+		_spec.SetField(leavecomment.FieldAuthorID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAuthorID(); ok {
+		_spec.AddField(leavecomment.FieldAuthorID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AuthorName(); ok {
+		_spec.SetField(leavecomment.FieldAuthorName, field.TypeString, value)
+	}
+	if _u.mutation.AuthorNameCleared() {
+		_spec.ClearField(leavecomment.FieldAuthorName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(leavecomment.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(leavecomment.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Edits(); ok {
+		_spec.SetField(leavecomment.FieldEdits, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEdits(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, leavecomment.FieldEdits, value)
+		})
+	}
+	if _u.mutation.EditsCleared() {
+		_spec.ClearField(leavecomment.FieldEdits, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leavecomment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LeaveCommentUpdateOne is the builder for updating a single LeaveComment entity.
+type LeaveCommentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeaveCommentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *LeaveCommentUpdateOne) SetCreateBy(v uint32) *LeaveCommentUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *LeaveCommentUpdateOne) SetNillableCreateBy(v *uint32) *LeaveCommentUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *LeaveCommentUpdateOne) AddCreateBy(v int32) *LeaveCommentUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *LeaveCommentUpdateOne) ClearCreateBy() *LeaveCommentUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *LeaveCommentUpdateOne) SetUpdateBy(v uint32) *LeaveCommentUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *LeaveCommentUpdateOne) SetNillableUpdateBy(v *uint32) *LeaveCommentUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *LeaveCommentUpdateOne) AddUpdateBy(v int32) *LeaveCommentUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *LeaveCommentUpdateOne) ClearUpdateBy() *LeaveCommentUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *LeaveCommentUpdateOne) SetUpdateTime(v time.Time) *LeaveCommentUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *LeaveCommentUpdateOne) SetNillableUpdateTime(v *time.Time) *LeaveCommentUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *LeaveCommentUpdateOne) ClearUpdateTime() *LeaveCommentUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *LeaveCommentUpdateOne) SetDeleteTime(v time.Time) *LeaveCommentUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *LeaveCommentUpdateOne) SetNillableDeleteTime(v *time.Time) *LeaveCommentUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *LeaveCommentUpdateOne) ClearDeleteTime() *LeaveCommentUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetLeaveRequestID sets the "leave_request_id" field.
+func (_u *LeaveCommentUpdateOne) SetLeaveRequestID(v string) *LeaveCommentUpdateOne {
+	_u.mutation.SetLeaveRequestID(v)
+	return _u
+}
+
+// SetNillableLeaveRequestID sets the "leave_request_id" field if the given value is not nil.
+func (_u *LeaveCommentUpdateOne) SetNillableLeaveRequestID(v *string) *LeaveCommentUpdateOne {
+	if v != nil {
+		_u.SetLeaveRequestID(*v)
+	}
+	return _u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_u *LeaveCommentUpdateOne) SetAuthorID(v uint32) *LeaveCommentUpdateOne {
+	_u.mutation.ResetAuthorID()
+	_u.mutation.SetAuthorID(v)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_u *LeaveCommentUpdateOne) SetNillableAuthorID(v *uint32) *LeaveCommentUpdateOne {
+	if v != nil {
+		_u.SetAuthorID(*v)
+	}
+	return _u
+}
+
+// AddAuthorID adds value to the "author_id" field.
+func (_u *LeaveCommentUpdateOne) AddAuthorID(v int32) *LeaveCommentUpdateOne {
+	_u.mutation.AddAuthorID(v)
+	return _u
+}
+
+// SetAuthorName sets the "author_name" field.
+func (_u *LeaveCommentUpdateOne) SetAuthorName(v string) *LeaveCommentUpdateOne {
+	_u.mutation.SetAuthorName(v)
+	return _u
+}
+
+// SetNillableAuthorName sets the "author_name" field if the given value is not nil.
+func (_u *LeaveCommentUpdateOne) SetNillableAuthorName(v *string) *LeaveCommentUpdateOne {
+	if v != nil {
+		_u.SetAuthorName(*v)
+	}
+	return _u
+}
+
+// ClearAuthorName clears the value of the "author_name" field.
+func (_u *LeaveCommentUpdateOne) ClearAuthorName() *LeaveCommentUpdateOne {
+	_u.mutation.ClearAuthorName()
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *LeaveCommentUpdateOne) SetBody(v string) *LeaveCommentUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *LeaveCommentUpdateOne) SetNillableBody(v *string) *LeaveCommentUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *LeaveCommentUpdateOne) SetVisibility(v leavecomment.Visibility) *LeaveCommentUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *LeaveCommentUpdateOne) SetNillableVisibility(v *leavecomment.Visibility) *LeaveCommentUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetEdits sets the "edits" field.
+func (_u *LeaveCommentUpdateOne) SetEdits(v []schema.CommentEdit) *LeaveCommentUpdateOne {
+	_u.mutation.SetEdits(v)
+	return _u
+}
+
+// AppendEdits appends value to the "edits" field.
+func (_u *LeaveCommentUpdateOne) AppendEdits(v []schema.CommentEdit) *LeaveCommentUpdateOne {
+	_u.mutation.AppendEdits(v)
+	return _u
+}
+
+// ClearEdits clears the value of the "edits" field.
+func (_u *LeaveCommentUpdateOne) ClearEdits() *LeaveCommentUpdateOne {
+	_u.mutation.ClearEdits()
+	return _u
+}
+
+// Mutation returns the LeaveCommentMutation object of the builder.
+func (_u *LeaveCommentUpdateOne) Mutation() *LeaveCommentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LeaveCommentUpdate builder.
+func (_u *LeaveCommentUpdateOne) Where(ps ...predicate.LeaveComment) *LeaveCommentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LeaveCommentUpdateOne) Select(field string, fields ...string) *LeaveCommentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LeaveComment entity.
+func (_u *LeaveCommentUpdateOne) Save(ctx context.Context) (*LeaveComment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeaveCommentUpdateOne) SaveX(ctx context.Context) *LeaveComment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LeaveCommentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeaveCommentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeaveCommentUpdateOne) check() error {
+	if v, ok := _u.mutation.LeaveRequestID(); ok {
+		if err := leavecomment.LeaveRequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "leave_request_id", err: fmt.Errorf(`ent: validator failed for field "LeaveComment.leave_request_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Body(); ok {
+		if err := leavecomment.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "LeaveComment.body": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := leavecomment.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "LeaveComment.visibility": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeaveCommentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeaveCommentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeaveCommentUpdateOne) sqlSave(ctx context.Context) (_node *LeaveComment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leavecomment.Table, leavecomment.Columns, sqlgraph.NewFieldSpec(leavecomment.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeaveComment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leavecomment.FieldID)
+		for _, f := range fields {
+			if !leavecomment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leavecomment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(leavecomment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(leavecomment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(leavecomment.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(leavecomment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(leavecomment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(leavecomment.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(leavecomment.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(leavecomment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(leavecomment.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(leavecomment.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(leavecomment.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(leavecomment.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LeaveRequestID(); ok {
+		_spec.SetField(leavecomment.FieldLeaveRequestID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthorID(); ok {
+		_spec.SetField(leavecomment.FieldAuthorID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAuthorID(); ok {
+		_spec.AddField(leavecomment.FieldAuthorID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AuthorName(); ok {
+		_spec.SetField(leavecomment.FieldAuthorName, field.TypeString, value)
+	}
+	if _u.mutation.AuthorNameCleared() {
+		_spec.ClearField(leavecomment.FieldAuthorName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(leavecomment.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(leavecomment.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Edits(); ok {
+		_spec.SetField(leavecomment.FieldEdits, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEdits(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, leavecomment.FieldEdits, value)
+		})
+	}
+	if _u.mutation.EditsCleared() {
+		_spec.ClearField(leavecomment.FieldEdits, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LeaveComment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leavecomment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
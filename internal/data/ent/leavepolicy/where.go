@@ -0,0 +1,735 @@
+// Code generated by ent, DO NOT EDIT.
+
+package leavepolicy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// UpdateBy applies equality check predicate on the "update_by" field. It's identical to UpdateByEQ.
+func UpdateBy(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// AbsenceTypeID applies equality check predicate on the "absence_type_id" field. It's identical to AbsenceTypeIDEQ.
+func AbsenceTypeID(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldAbsenceTypeID, v))
+}
+
+// OrgUnitName applies equality check predicate on the "org_unit_name" field. It's identical to OrgUnitNameEQ.
+func OrgUnitName(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldOrgUnitName, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotNull(FieldCreateBy))
+}
+
+// UpdateByEQ applies the EQ predicate on the "update_by" field.
+func UpdateByEQ(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// UpdateByNEQ applies the NEQ predicate on the "update_by" field.
+func UpdateByNEQ(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNEQ(FieldUpdateBy, v))
+}
+
+// UpdateByIn applies the In predicate on the "update_by" field.
+func UpdateByIn(vs ...uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByNotIn applies the NotIn predicate on the "update_by" field.
+func UpdateByNotIn(vs ...uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByGT applies the GT predicate on the "update_by" field.
+func UpdateByGT(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGT(FieldUpdateBy, v))
+}
+
+// UpdateByGTE applies the GTE predicate on the "update_by" field.
+func UpdateByGTE(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGTE(FieldUpdateBy, v))
+}
+
+// UpdateByLT applies the LT predicate on the "update_by" field.
+func UpdateByLT(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLT(FieldUpdateBy, v))
+}
+
+// UpdateByLTE applies the LTE predicate on the "update_by" field.
+func UpdateByLTE(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLTE(FieldUpdateBy, v))
+}
+
+// UpdateByIsNil applies the IsNil predicate on the "update_by" field.
+func UpdateByIsNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIsNull(FieldUpdateBy))
+}
+
+// UpdateByNotNil applies the NotNil predicate on the "update_by" field.
+func UpdateByNotNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotNull(FieldUpdateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotNull(FieldTenantID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// AbsenceTypeIDEQ applies the EQ predicate on the "absence_type_id" field.
+func AbsenceTypeIDEQ(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldAbsenceTypeID, v))
+}
+
+// AbsenceTypeIDNEQ applies the NEQ predicate on the "absence_type_id" field.
+func AbsenceTypeIDNEQ(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNEQ(FieldAbsenceTypeID, v))
+}
+
+// AbsenceTypeIDIn applies the In predicate on the "absence_type_id" field.
+func AbsenceTypeIDIn(vs ...string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIn(FieldAbsenceTypeID, vs...))
+}
+
+// AbsenceTypeIDNotIn applies the NotIn predicate on the "absence_type_id" field.
+func AbsenceTypeIDNotIn(vs ...string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotIn(FieldAbsenceTypeID, vs...))
+}
+
+// AbsenceTypeIDGT applies the GT predicate on the "absence_type_id" field.
+func AbsenceTypeIDGT(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGT(FieldAbsenceTypeID, v))
+}
+
+// AbsenceTypeIDGTE applies the GTE predicate on the "absence_type_id" field.
+func AbsenceTypeIDGTE(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGTE(FieldAbsenceTypeID, v))
+}
+
+// AbsenceTypeIDLT applies the LT predicate on the "absence_type_id" field.
+func AbsenceTypeIDLT(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLT(FieldAbsenceTypeID, v))
+}
+
+// AbsenceTypeIDLTE applies the LTE predicate on the "absence_type_id" field.
+func AbsenceTypeIDLTE(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLTE(FieldAbsenceTypeID, v))
+}
+
+// AbsenceTypeIDContains applies the Contains predicate on the "absence_type_id" field.
+func AbsenceTypeIDContains(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldContains(FieldAbsenceTypeID, v))
+}
+
+// AbsenceTypeIDHasPrefix applies the HasPrefix predicate on the "absence_type_id" field.
+func AbsenceTypeIDHasPrefix(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldHasPrefix(FieldAbsenceTypeID, v))
+}
+
+// AbsenceTypeIDHasSuffix applies the HasSuffix predicate on the "absence_type_id" field.
+func AbsenceTypeIDHasSuffix(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldHasSuffix(FieldAbsenceTypeID, v))
+}
+
+// AbsenceTypeIDIsNil applies the IsNil predicate on the "absence_type_id" field.
+func AbsenceTypeIDIsNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIsNull(FieldAbsenceTypeID))
+}
+
+// AbsenceTypeIDNotNil applies the NotNil predicate on the "absence_type_id" field.
+func AbsenceTypeIDNotNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotNull(FieldAbsenceTypeID))
+}
+
+// AbsenceTypeIDEqualFold applies the EqualFold predicate on the "absence_type_id" field.
+func AbsenceTypeIDEqualFold(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEqualFold(FieldAbsenceTypeID, v))
+}
+
+// AbsenceTypeIDContainsFold applies the ContainsFold predicate on the "absence_type_id" field.
+func AbsenceTypeIDContainsFold(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldContainsFold(FieldAbsenceTypeID, v))
+}
+
+// OrgUnitNameEQ applies the EQ predicate on the "org_unit_name" field.
+func OrgUnitNameEQ(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameNEQ applies the NEQ predicate on the "org_unit_name" field.
+func OrgUnitNameNEQ(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNEQ(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameIn applies the In predicate on the "org_unit_name" field.
+func OrgUnitNameIn(vs ...string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIn(FieldOrgUnitName, vs...))
+}
+
+// OrgUnitNameNotIn applies the NotIn predicate on the "org_unit_name" field.
+func OrgUnitNameNotIn(vs ...string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotIn(FieldOrgUnitName, vs...))
+}
+
+// OrgUnitNameGT applies the GT predicate on the "org_unit_name" field.
+func OrgUnitNameGT(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGT(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameGTE applies the GTE predicate on the "org_unit_name" field.
+func OrgUnitNameGTE(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldGTE(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameLT applies the LT predicate on the "org_unit_name" field.
+func OrgUnitNameLT(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLT(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameLTE applies the LTE predicate on the "org_unit_name" field.
+func OrgUnitNameLTE(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldLTE(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameContains applies the Contains predicate on the "org_unit_name" field.
+func OrgUnitNameContains(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldContains(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameHasPrefix applies the HasPrefix predicate on the "org_unit_name" field.
+func OrgUnitNameHasPrefix(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldHasPrefix(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameHasSuffix applies the HasSuffix predicate on the "org_unit_name" field.
+func OrgUnitNameHasSuffix(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldHasSuffix(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameIsNil applies the IsNil predicate on the "org_unit_name" field.
+func OrgUnitNameIsNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldIsNull(FieldOrgUnitName))
+}
+
+// OrgUnitNameNotNil applies the NotNil predicate on the "org_unit_name" field.
+func OrgUnitNameNotNil() predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNotNull(FieldOrgUnitName))
+}
+
+// OrgUnitNameEqualFold applies the EqualFold predicate on the "org_unit_name" field.
+func OrgUnitNameEqualFold(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEqualFold(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameContainsFold applies the ContainsFold predicate on the "org_unit_name" field.
+func OrgUnitNameContainsFold(v string) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldContainsFold(FieldOrgUnitName, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LeavePolicy) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LeavePolicy) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LeavePolicy) predicate.LeavePolicy {
+	return predicate.LeavePolicy(sql.NotPredicates(p))
+}
@@ -0,0 +1,1279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavepolicy"
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+)
+
+// LeavePolicyCreate is the builder for creating a LeavePolicy entity.
+type LeavePolicyCreate struct {
+	config
+	mutation *LeavePolicyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *LeavePolicyCreate) SetCreateBy(v uint32) *LeavePolicyCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *LeavePolicyCreate) SetNillableCreateBy(v *uint32) *LeavePolicyCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *LeavePolicyCreate) SetUpdateBy(v uint32) *LeavePolicyCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *LeavePolicyCreate) SetNillableUpdateBy(v *uint32) *LeavePolicyCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *LeavePolicyCreate) SetCreateTime(v time.Time) *LeavePolicyCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *LeavePolicyCreate) SetNillableCreateTime(v *time.Time) *LeavePolicyCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *LeavePolicyCreate) SetUpdateTime(v time.Time) *LeavePolicyCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *LeavePolicyCreate) SetNillableUpdateTime(v *time.Time) *LeavePolicyCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *LeavePolicyCreate) SetDeleteTime(v time.Time) *LeavePolicyCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *LeavePolicyCreate) SetNillableDeleteTime(v *time.Time) *LeavePolicyCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *LeavePolicyCreate) SetTenantID(v uint32) *LeavePolicyCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *LeavePolicyCreate) SetNillableTenantID(v *uint32) *LeavePolicyCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *LeavePolicyCreate) SetName(v string) *LeavePolicyCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *LeavePolicyCreate) SetDescription(v string) *LeavePolicyCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *LeavePolicyCreate) SetNillableDescription(v *string) *LeavePolicyCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetAbsenceTypeID sets the "absence_type_id" field.
+func (_c *LeavePolicyCreate) SetAbsenceTypeID(v string) *LeavePolicyCreate {
+	_c.mutation.SetAbsenceTypeID(v)
+	return _c
+}
+
+// SetNillableAbsenceTypeID sets the "absence_type_id" field if the given value is not nil.
+func (_c *LeavePolicyCreate) SetNillableAbsenceTypeID(v *string) *LeavePolicyCreate {
+	if v != nil {
+		_c.SetAbsenceTypeID(*v)
+	}
+	return _c
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (_c *LeavePolicyCreate) SetOrgUnitName(v string) *LeavePolicyCreate {
+	_c.mutation.SetOrgUnitName(v)
+	return _c
+}
+
+// SetNillableOrgUnitName sets the "org_unit_name" field if the given value is not nil.
+func (_c *LeavePolicyCreate) SetNillableOrgUnitName(v *string) *LeavePolicyCreate {
+	if v != nil {
+		_c.SetOrgUnitName(*v)
+	}
+	return _c
+}
+
+// SetRules sets the "rules" field.
+func (_c *LeavePolicyCreate) SetRules(v *policy.Rules) *LeavePolicyCreate {
+	_c.mutation.SetRules(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *LeavePolicyCreate) SetEnabled(v bool) *LeavePolicyCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *LeavePolicyCreate) SetNillableEnabled(v *bool) *LeavePolicyCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LeavePolicyCreate) SetID(v string) *LeavePolicyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the LeavePolicyMutation object of the builder.
+func (_c *LeavePolicyCreate) Mutation() *LeavePolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the LeavePolicy in the database.
+func (_c *LeavePolicyCreate) Save(ctx context.Context) (*LeavePolicy, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LeavePolicyCreate) SaveX(ctx context.Context) *LeavePolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeavePolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeavePolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LeavePolicyCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := leavepolicy.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.AbsenceTypeID(); !ok {
+		v := leavepolicy.DefaultAbsenceTypeID
+		_c.mutation.SetAbsenceTypeID(v)
+	}
+	if _, ok := _c.mutation.OrgUnitName(); !ok {
+		v := leavepolicy.DefaultOrgUnitName
+		_c.mutation.SetOrgUnitName(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := leavepolicy.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LeavePolicyCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "LeavePolicy.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := leavepolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LeavePolicy.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Rules(); !ok {
+		return &ValidationError{Name: "rules", err: errors.New(`ent: missing required field "LeavePolicy.rules"`)}
+	}
+	if v, ok := _c.mutation.Rules(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rules", err: fmt.Errorf(`ent: validator failed for field "LeavePolicy.rules": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "LeavePolicy.enabled"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := leavepolicy.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LeavePolicy.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *LeavePolicyCreate) sqlSave(ctx context.Context) (*LeavePolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LeavePolicy.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LeavePolicyCreate) createSpec() (*LeavePolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LeavePolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(leavepolicy.Table, sqlgraph.NewFieldSpec(leavepolicy.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(leavepolicy.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(leavepolicy.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(leavepolicy.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(leavepolicy.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(leavepolicy.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(leavepolicy.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(leavepolicy.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(leavepolicy.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.AbsenceTypeID(); ok {
+		_spec.SetField(leavepolicy.FieldAbsenceTypeID, field.TypeString, value)
+		_node.AbsenceTypeID = value
+	}
+	if value, ok := _c.mutation.OrgUnitName(); ok {
+		_spec.SetField(leavepolicy.FieldOrgUnitName, field.TypeString, value)
+		_node.OrgUnitName = value
+	}
+	if value, ok := _c.mutation.Rules(); ok {
+		_spec.SetField(leavepolicy.FieldRules, field.TypeJSON, value)
+		_node.Rules = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(leavepolicy.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LeavePolicy.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeavePolicyUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LeavePolicyCreate) OnConflict(opts ...sql.ConflictOption) *LeavePolicyUpsertOne {
+	_c.conflict = opts
+	return &LeavePolicyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LeavePolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LeavePolicyCreate) OnConflictColumns(columns ...string) *LeavePolicyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LeavePolicyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LeavePolicyUpsertOne is the builder for "upsert"-ing
+	//  one LeavePolicy node.
+	LeavePolicyUpsertOne struct {
+		create *LeavePolicyCreate
+	}
+
+	// LeavePolicyUpsert is the "OnConflict" setter.
+	LeavePolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *LeavePolicyUpsert) SetCreateBy(v uint32) *LeavePolicyUpsert {
+	u.Set(leavepolicy.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *LeavePolicyUpsert) UpdateCreateBy() *LeavePolicyUpsert {
+	u.SetExcluded(leavepolicy.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *LeavePolicyUpsert) AddCreateBy(v uint32) *LeavePolicyUpsert {
+	u.Add(leavepolicy.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *LeavePolicyUpsert) ClearCreateBy() *LeavePolicyUpsert {
+	u.SetNull(leavepolicy.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *LeavePolicyUpsert) SetUpdateBy(v uint32) *LeavePolicyUpsert {
+	u.Set(leavepolicy.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *LeavePolicyUpsert) UpdateUpdateBy() *LeavePolicyUpsert {
+	u.SetExcluded(leavepolicy.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *LeavePolicyUpsert) AddUpdateBy(v uint32) *LeavePolicyUpsert {
+	u.Add(leavepolicy.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *LeavePolicyUpsert) ClearUpdateBy() *LeavePolicyUpsert {
+	u.SetNull(leavepolicy.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LeavePolicyUpsert) SetUpdateTime(v time.Time) *LeavePolicyUpsert {
+	u.Set(leavepolicy.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LeavePolicyUpsert) UpdateUpdateTime() *LeavePolicyUpsert {
+	u.SetExcluded(leavepolicy.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *LeavePolicyUpsert) ClearUpdateTime() *LeavePolicyUpsert {
+	u.SetNull(leavepolicy.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *LeavePolicyUpsert) SetDeleteTime(v time.Time) *LeavePolicyUpsert {
+	u.Set(leavepolicy.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *LeavePolicyUpsert) UpdateDeleteTime() *LeavePolicyUpsert {
+	u.SetExcluded(leavepolicy.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *LeavePolicyUpsert) ClearDeleteTime() *LeavePolicyUpsert {
+	u.SetNull(leavepolicy.FieldDeleteTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *LeavePolicyUpsert) SetName(v string) *LeavePolicyUpsert {
+	u.Set(leavepolicy.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LeavePolicyUpsert) UpdateName() *LeavePolicyUpsert {
+	u.SetExcluded(leavepolicy.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *LeavePolicyUpsert) SetDescription(v string) *LeavePolicyUpsert {
+	u.Set(leavepolicy.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LeavePolicyUpsert) UpdateDescription() *LeavePolicyUpsert {
+	u.SetExcluded(leavepolicy.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *LeavePolicyUpsert) ClearDescription() *LeavePolicyUpsert {
+	u.SetNull(leavepolicy.FieldDescription)
+	return u
+}
+
+// SetAbsenceTypeID sets the "absence_type_id" field.
+func (u *LeavePolicyUpsert) SetAbsenceTypeID(v string) *LeavePolicyUpsert {
+	u.Set(leavepolicy.FieldAbsenceTypeID, v)
+	return u
+}
+
+// UpdateAbsenceTypeID sets the "absence_type_id" field to the value that was provided on create.
+func (u *LeavePolicyUpsert) UpdateAbsenceTypeID() *LeavePolicyUpsert {
+	u.SetExcluded(leavepolicy.FieldAbsenceTypeID)
+	return u
+}
+
+// ClearAbsenceTypeID clears the value of the "absence_type_id" field.
+func (u *LeavePolicyUpsert) ClearAbsenceTypeID() *LeavePolicyUpsert {
+	u.SetNull(leavepolicy.FieldAbsenceTypeID)
+	return u
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (u *LeavePolicyUpsert) SetOrgUnitName(v string) *LeavePolicyUpsert {
+	u.Set(leavepolicy.FieldOrgUnitName, v)
+	return u
+}
+
+// UpdateOrgUnitName sets the "org_unit_name" field to the value that was provided on create.
+func (u *LeavePolicyUpsert) UpdateOrgUnitName() *LeavePolicyUpsert {
+	u.SetExcluded(leavepolicy.FieldOrgUnitName)
+	return u
+}
+
+// ClearOrgUnitName clears the value of the "org_unit_name" field.
+func (u *LeavePolicyUpsert) ClearOrgUnitName() *LeavePolicyUpsert {
+	u.SetNull(leavepolicy.FieldOrgUnitName)
+	return u
+}
+
+// SetRules sets the "rules" field.
+func (u *LeavePolicyUpsert) SetRules(v *policy.Rules) *LeavePolicyUpsert {
+	u.Set(leavepolicy.FieldRules, v)
+	return u
+}
+
+// UpdateRules sets the "rules" field to the value that was provided on create.
+func (u *LeavePolicyUpsert) UpdateRules() *LeavePolicyUpsert {
+	u.SetExcluded(leavepolicy.FieldRules)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *LeavePolicyUpsert) SetEnabled(v bool) *LeavePolicyUpsert {
+	u.Set(leavepolicy.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *LeavePolicyUpsert) UpdateEnabled() *LeavePolicyUpsert {
+	u.SetExcluded(leavepolicy.FieldEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LeavePolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leavepolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeavePolicyUpsertOne) UpdateNewValues() *LeavePolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(leavepolicy.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(leavepolicy.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(leavepolicy.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LeavePolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LeavePolicyUpsertOne) Ignore() *LeavePolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeavePolicyUpsertOne) DoNothing() *LeavePolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeavePolicyCreate.OnConflict
+// documentation for more info.
+func (u *LeavePolicyUpsertOne) Update(set func(*LeavePolicyUpsert)) *LeavePolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeavePolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *LeavePolicyUpsertOne) SetCreateBy(v uint32) *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *LeavePolicyUpsertOne) AddCreateBy(v uint32) *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *LeavePolicyUpsertOne) UpdateCreateBy() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *LeavePolicyUpsertOne) ClearCreateBy() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *LeavePolicyUpsertOne) SetUpdateBy(v uint32) *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *LeavePolicyUpsertOne) AddUpdateBy(v uint32) *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *LeavePolicyUpsertOne) UpdateUpdateBy() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *LeavePolicyUpsertOne) ClearUpdateBy() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LeavePolicyUpsertOne) SetUpdateTime(v time.Time) *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LeavePolicyUpsertOne) UpdateUpdateTime() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *LeavePolicyUpsertOne) ClearUpdateTime() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *LeavePolicyUpsertOne) SetDeleteTime(v time.Time) *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *LeavePolicyUpsertOne) UpdateDeleteTime() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *LeavePolicyUpsertOne) ClearDeleteTime() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LeavePolicyUpsertOne) SetName(v string) *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LeavePolicyUpsertOne) UpdateName() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *LeavePolicyUpsertOne) SetDescription(v string) *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LeavePolicyUpsertOne) UpdateDescription() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *LeavePolicyUpsertOne) ClearDescription() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetAbsenceTypeID sets the "absence_type_id" field.
+func (u *LeavePolicyUpsertOne) SetAbsenceTypeID(v string) *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetAbsenceTypeID(v)
+	})
+}
+
+// UpdateAbsenceTypeID sets the "absence_type_id" field to the value that was provided on create.
+func (u *LeavePolicyUpsertOne) UpdateAbsenceTypeID() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateAbsenceTypeID()
+	})
+}
+
+// ClearAbsenceTypeID clears the value of the "absence_type_id" field.
+func (u *LeavePolicyUpsertOne) ClearAbsenceTypeID() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.ClearAbsenceTypeID()
+	})
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (u *LeavePolicyUpsertOne) SetOrgUnitName(v string) *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetOrgUnitName(v)
+	})
+}
+
+// UpdateOrgUnitName sets the "org_unit_name" field to the value that was provided on create.
+func (u *LeavePolicyUpsertOne) UpdateOrgUnitName() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateOrgUnitName()
+	})
+}
+
+// ClearOrgUnitName clears the value of the "org_unit_name" field.
+func (u *LeavePolicyUpsertOne) ClearOrgUnitName() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.ClearOrgUnitName()
+	})
+}
+
+// SetRules sets the "rules" field.
+func (u *LeavePolicyUpsertOne) SetRules(v *policy.Rules) *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetRules(v)
+	})
+}
+
+// UpdateRules sets the "rules" field to the value that was provided on create.
+func (u *LeavePolicyUpsertOne) UpdateRules() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateRules()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *LeavePolicyUpsertOne) SetEnabled(v bool) *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *LeavePolicyUpsertOne) UpdateEnabled() *LeavePolicyUpsertOne {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *LeavePolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LeavePolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeavePolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LeavePolicyUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LeavePolicyUpsertOne.ID is not supported by MySQL driver. Use LeavePolicyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LeavePolicyUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LeavePolicyCreateBulk is the builder for creating many LeavePolicy entities in bulk.
+type LeavePolicyCreateBulk struct {
+	config
+	err      error
+	builders []*LeavePolicyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LeavePolicy entities in the database.
+func (_c *LeavePolicyCreateBulk) Save(ctx context.Context) ([]*LeavePolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LeavePolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LeavePolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LeavePolicyCreateBulk) SaveX(ctx context.Context) []*LeavePolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LeavePolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LeavePolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LeavePolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LeavePolicyUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LeavePolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *LeavePolicyUpsertBulk {
+	_c.conflict = opts
+	return &LeavePolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LeavePolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LeavePolicyCreateBulk) OnConflictColumns(columns ...string) *LeavePolicyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LeavePolicyUpsertBulk{
+		create: _c,
+	}
+}
+
+// LeavePolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of LeavePolicy nodes.
+type LeavePolicyUpsertBulk struct {
+	create *LeavePolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LeavePolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leavepolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeavePolicyUpsertBulk) UpdateNewValues() *LeavePolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(leavepolicy.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(leavepolicy.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(leavepolicy.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LeavePolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LeavePolicyUpsertBulk) Ignore() *LeavePolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeavePolicyUpsertBulk) DoNothing() *LeavePolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeavePolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *LeavePolicyUpsertBulk) Update(set func(*LeavePolicyUpsert)) *LeavePolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeavePolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *LeavePolicyUpsertBulk) SetCreateBy(v uint32) *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *LeavePolicyUpsertBulk) AddCreateBy(v uint32) *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *LeavePolicyUpsertBulk) UpdateCreateBy() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *LeavePolicyUpsertBulk) ClearCreateBy() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *LeavePolicyUpsertBulk) SetUpdateBy(v uint32) *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *LeavePolicyUpsertBulk) AddUpdateBy(v uint32) *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *LeavePolicyUpsertBulk) UpdateUpdateBy() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *LeavePolicyUpsertBulk) ClearUpdateBy() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LeavePolicyUpsertBulk) SetUpdateTime(v time.Time) *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LeavePolicyUpsertBulk) UpdateUpdateTime() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *LeavePolicyUpsertBulk) ClearUpdateTime() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *LeavePolicyUpsertBulk) SetDeleteTime(v time.Time) *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *LeavePolicyUpsertBulk) UpdateDeleteTime() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *LeavePolicyUpsertBulk) ClearDeleteTime() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LeavePolicyUpsertBulk) SetName(v string) *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LeavePolicyUpsertBulk) UpdateName() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *LeavePolicyUpsertBulk) SetDescription(v string) *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *LeavePolicyUpsertBulk) UpdateDescription() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *LeavePolicyUpsertBulk) ClearDescription() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetAbsenceTypeID sets the "absence_type_id" field.
+func (u *LeavePolicyUpsertBulk) SetAbsenceTypeID(v string) *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetAbsenceTypeID(v)
+	})
+}
+
+// UpdateAbsenceTypeID sets the "absence_type_id" field to the value that was provided on create.
+func (u *LeavePolicyUpsertBulk) UpdateAbsenceTypeID() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateAbsenceTypeID()
+	})
+}
+
+// ClearAbsenceTypeID clears the value of the "absence_type_id" field.
+func (u *LeavePolicyUpsertBulk) ClearAbsenceTypeID() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.ClearAbsenceTypeID()
+	})
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (u *LeavePolicyUpsertBulk) SetOrgUnitName(v string) *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetOrgUnitName(v)
+	})
+}
+
+// UpdateOrgUnitName sets the "org_unit_name" field to the value that was provided on create.
+func (u *LeavePolicyUpsertBulk) UpdateOrgUnitName() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateOrgUnitName()
+	})
+}
+
+// ClearOrgUnitName clears the value of the "org_unit_name" field.
+func (u *LeavePolicyUpsertBulk) ClearOrgUnitName() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.ClearOrgUnitName()
+	})
+}
+
+// SetRules sets the "rules" field.
+func (u *LeavePolicyUpsertBulk) SetRules(v *policy.Rules) *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetRules(v)
+	})
+}
+
+// UpdateRules sets the "rules" field to the value that was provided on create.
+func (u *LeavePolicyUpsertBulk) UpdateRules() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateRules()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *LeavePolicyUpsertBulk) SetEnabled(v bool) *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *LeavePolicyUpsertBulk) UpdateEnabled() *LeavePolicyUpsertBulk {
+	return u.Update(func(s *LeavePolicyUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *LeavePolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LeavePolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LeavePolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LeavePolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
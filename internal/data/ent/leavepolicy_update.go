@@ -0,0 +1,731 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavepolicy"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+)
+
+// LeavePolicyUpdate is the builder for updating LeavePolicy entities.
+type LeavePolicyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LeavePolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LeavePolicyUpdate builder.
+func (_u *LeavePolicyUpdate) Where(ps ...predicate.LeavePolicy) *LeavePolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *LeavePolicyUpdate) SetCreateBy(v uint32) *LeavePolicyUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *LeavePolicyUpdate) SetNillableCreateBy(v *uint32) *LeavePolicyUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *LeavePolicyUpdate) AddCreateBy(v int32) *LeavePolicyUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *LeavePolicyUpdate) ClearCreateBy() *LeavePolicyUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *LeavePolicyUpdate) SetUpdateBy(v uint32) *LeavePolicyUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *LeavePolicyUpdate) SetNillableUpdateBy(v *uint32) *LeavePolicyUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *LeavePolicyUpdate) AddUpdateBy(v int32) *LeavePolicyUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *LeavePolicyUpdate) ClearUpdateBy() *LeavePolicyUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *LeavePolicyUpdate) SetUpdateTime(v time.Time) *LeavePolicyUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *LeavePolicyUpdate) SetNillableUpdateTime(v *time.Time) *LeavePolicyUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *LeavePolicyUpdate) ClearUpdateTime() *LeavePolicyUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *LeavePolicyUpdate) SetDeleteTime(v time.Time) *LeavePolicyUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *LeavePolicyUpdate) SetNillableDeleteTime(v *time.Time) *LeavePolicyUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *LeavePolicyUpdate) ClearDeleteTime() *LeavePolicyUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LeavePolicyUpdate) SetName(v string) *LeavePolicyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LeavePolicyUpdate) SetNillableName(v *string) *LeavePolicyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *LeavePolicyUpdate) SetDescription(v string) *LeavePolicyUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *LeavePolicyUpdate) SetNillableDescription(v *string) *LeavePolicyUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *LeavePolicyUpdate) ClearDescription() *LeavePolicyUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetAbsenceTypeID sets the "absence_type_id" field.
+func (_u *LeavePolicyUpdate) SetAbsenceTypeID(v string) *LeavePolicyUpdate {
+	_u.mutation.SetAbsenceTypeID(v)
+	return _u
+}
+
+// SetNillableAbsenceTypeID sets the "absence_type_id" field if the given value is not nil.
+func (_u *LeavePolicyUpdate) SetNillableAbsenceTypeID(v *string) *LeavePolicyUpdate {
+	if v != nil {
+		_u.SetAbsenceTypeID(*v)
+	}
+	return _u
+}
+
+// ClearAbsenceTypeID clears the value of the "absence_type_id" field.
+func (_u *LeavePolicyUpdate) ClearAbsenceTypeID() *LeavePolicyUpdate {
+	_u.mutation.ClearAbsenceTypeID()
+	return _u
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (_u *LeavePolicyUpdate) SetOrgUnitName(v string) *LeavePolicyUpdate {
+	_u.mutation.SetOrgUnitName(v)
+	return _u
+}
+
+// SetNillableOrgUnitName sets the "org_unit_name" field if the given value is not nil.
+func (_u *LeavePolicyUpdate) SetNillableOrgUnitName(v *string) *LeavePolicyUpdate {
+	if v != nil {
+		_u.SetOrgUnitName(*v)
+	}
+	return _u
+}
+
+// ClearOrgUnitName clears the value of the "org_unit_name" field.
+func (_u *LeavePolicyUpdate) ClearOrgUnitName() *LeavePolicyUpdate {
+	_u.mutation.ClearOrgUnitName()
+	return _u
+}
+
+// SetRules sets the "rules" field.
+func (_u *LeavePolicyUpdate) SetRules(v *policy.Rules) *LeavePolicyUpdate {
+	_u.mutation.SetRules(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *LeavePolicyUpdate) SetEnabled(v bool) *LeavePolicyUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *LeavePolicyUpdate) SetNillableEnabled(v *bool) *LeavePolicyUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LeavePolicyMutation object of the builder.
+func (_u *LeavePolicyUpdate) Mutation() *LeavePolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LeavePolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeavePolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LeavePolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeavePolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeavePolicyUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := leavepolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LeavePolicy.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Rules(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rules", err: fmt.Errorf(`ent: validator failed for field "LeavePolicy.rules": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeavePolicyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeavePolicyUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeavePolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leavepolicy.Table, leavepolicy.Columns, sqlgraph.NewFieldSpec(leavepolicy.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(leavepolicy.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(leavepolicy.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(leavepolicy.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(leavepolicy.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(leavepolicy.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(leavepolicy.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(leavepolicy.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(leavepolicy.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(leavepolicy.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(leavepolicy.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(leavepolicy.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(leavepolicy.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(leavepolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(leavepolicy.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(leavepolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.AbsenceTypeID(); ok {
+		_spec.SetField(leavepolicy.FieldAbsenceTypeID, field.TypeString, value)
+	}
+	if _u.mutation.AbsenceTypeIDCleared() {
+		_spec.ClearField(leavepolicy.FieldAbsenceTypeID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OrgUnitName(); ok {
+		_spec.SetField(leavepolicy.FieldOrgUnitName, field.TypeString, value)
+	}
+	if _u.mutation.OrgUnitNameCleared() {
+		_spec.ClearField(leavepolicy.FieldOrgUnitName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Rules(); ok {
+		_spec.SetField(leavepolicy.FieldRules, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(leavepolicy.FieldEnabled, field.TypeBool, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leavepolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LeavePolicyUpdateOne is the builder for updating a single LeavePolicy entity.
+type LeavePolicyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LeavePolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *LeavePolicyUpdateOne) SetCreateBy(v uint32) *LeavePolicyUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *LeavePolicyUpdateOne) SetNillableCreateBy(v *uint32) *LeavePolicyUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *LeavePolicyUpdateOne) AddCreateBy(v int32) *LeavePolicyUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *LeavePolicyUpdateOne) ClearCreateBy() *LeavePolicyUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *LeavePolicyUpdateOne) SetUpdateBy(v uint32) *LeavePolicyUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *LeavePolicyUpdateOne) SetNillableUpdateBy(v *uint32) *LeavePolicyUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *LeavePolicyUpdateOne) AddUpdateBy(v int32) *LeavePolicyUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *LeavePolicyUpdateOne) ClearUpdateBy() *LeavePolicyUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *LeavePolicyUpdateOne) SetUpdateTime(v time.Time) *LeavePolicyUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *LeavePolicyUpdateOne) SetNillableUpdateTime(v *time.Time) *LeavePolicyUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *LeavePolicyUpdateOne) ClearUpdateTime() *LeavePolicyUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *LeavePolicyUpdateOne) SetDeleteTime(v time.Time) *LeavePolicyUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *LeavePolicyUpdateOne) SetNillableDeleteTime(v *time.Time) *LeavePolicyUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *LeavePolicyUpdateOne) ClearDeleteTime() *LeavePolicyUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LeavePolicyUpdateOne) SetName(v string) *LeavePolicyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LeavePolicyUpdateOne) SetNillableName(v *string) *LeavePolicyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *LeavePolicyUpdateOne) SetDescription(v string) *LeavePolicyUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *LeavePolicyUpdateOne) SetNillableDescription(v *string) *LeavePolicyUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *LeavePolicyUpdateOne) ClearDescription() *LeavePolicyUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetAbsenceTypeID sets the "absence_type_id" field.
+func (_u *LeavePolicyUpdateOne) SetAbsenceTypeID(v string) *LeavePolicyUpdateOne {
+	_u.mutation.SetAbsenceTypeID(v)
+	return _u
+}
+
+// SetNillableAbsenceTypeID sets the "absence_type_id" field if the given value is not nil.
+func (_u *LeavePolicyUpdateOne) SetNillableAbsenceTypeID(v *string) *LeavePolicyUpdateOne {
+	if v != nil {
+		_u.SetAbsenceTypeID(*v)
+	}
+	return _u
+}
+
+// ClearAbsenceTypeID clears the value of the "absence_type_id" field.
+func (_u *LeavePolicyUpdateOne) ClearAbsenceTypeID() *LeavePolicyUpdateOne {
+	_u.mutation.ClearAbsenceTypeID()
+	return _u
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (_u *LeavePolicyUpdateOne) SetOrgUnitName(v string) *LeavePolicyUpdateOne {
+	_u.mutation.SetOrgUnitName(v)
+	return _u
+}
+
+// SetNillableOrgUnitName sets the "org_unit_name" field if the given value is not nil.
+func (_u *LeavePolicyUpdateOne) SetNillableOrgUnitName(v *string) *LeavePolicyUpdateOne {
+	if v != nil {
+		_u.SetOrgUnitName(*v)
+	}
+	return _u
+}
+
+// ClearOrgUnitName clears the value of the "org_unit_name" field.
+func (_u *LeavePolicyUpdateOne) ClearOrgUnitName() *LeavePolicyUpdateOne {
+	_u.mutation.ClearOrgUnitName()
+	return _u
+}
+
+// SetRules sets the "rules" field.
+func (_u *LeavePolicyUpdateOne) SetRules(v *policy.Rules) *LeavePolicyUpdateOne {
+	_u.mutation.SetRules(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *LeavePolicyUpdateOne) SetEnabled(v bool) *LeavePolicyUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *LeavePolicyUpdateOne) SetNillableEnabled(v *bool) *LeavePolicyUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LeavePolicyMutation object of the builder.
+func (_u *LeavePolicyUpdateOne) Mutation() *LeavePolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LeavePolicyUpdate builder.
+func (_u *LeavePolicyUpdateOne) Where(ps ...predicate.LeavePolicy) *LeavePolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LeavePolicyUpdateOne) Select(field string, fields ...string) *LeavePolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LeavePolicy entity.
+func (_u *LeavePolicyUpdateOne) Save(ctx context.Context) (*LeavePolicy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LeavePolicyUpdateOne) SaveX(ctx context.Context) *LeavePolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LeavePolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LeavePolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LeavePolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := leavepolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LeavePolicy.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Rules(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "rules", err: fmt.Errorf(`ent: validator failed for field "LeavePolicy.rules": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LeavePolicyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LeavePolicyUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LeavePolicyUpdateOne) sqlSave(ctx context.Context) (_node *LeavePolicy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(leavepolicy.Table, leavepolicy.Columns, sqlgraph.NewFieldSpec(leavepolicy.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LeavePolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, leavepolicy.FieldID)
+		for _, f := range fields {
+			if !leavepolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != leavepolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(leavepolicy.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(leavepolicy.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(leavepolicy.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(leavepolicy.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(leavepolicy.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(leavepolicy.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(leavepolicy.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(leavepolicy.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(leavepolicy.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(leavepolicy.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(leavepolicy.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(leavepolicy.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(leavepolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(leavepolicy.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(leavepolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.AbsenceTypeID(); ok {
+		_spec.SetField(leavepolicy.FieldAbsenceTypeID, field.TypeString, value)
+	}
+	if _u.mutation.AbsenceTypeIDCleared() {
+		_spec.ClearField(leavepolicy.FieldAbsenceTypeID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OrgUnitName(); ok {
+		_spec.SetField(leavepolicy.FieldOrgUnitName, field.TypeString, value)
+	}
+	if _u.mutation.OrgUnitNameCleared() {
+		_spec.ClearField(leavepolicy.FieldOrgUnitName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Rules(); ok {
+		_spec.SetField(leavepolicy.FieldRules, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(leavepolicy.FieldEnabled, field.TypeBool, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LeavePolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{leavepolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,6 +12,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+	"github.com/go-tangra/go-tangra-hr/internal/recurrence"
 )
 
 // LeaveRequest is the model entity for the LeaveRequest schema.
@@ -46,6 +49,12 @@ type LeaveRequest struct {
 	StartDate time.Time `json:"start_date,omitempty"`
 	// End date of absence
 	EndDate time.Time `json:"end_date,omitempty"`
+	// Half of the first day the absence starts in; pm means afternoon only
+	StartDayPart leaverequest.StartDayPart `json:"start_day_part,omitempty"`
+	// Half of the last day the absence ends in; am means morning only
+	EndDayPart leaverequest.EndDayPart `json:"end_day_part,omitempty"`
+	// Requested hours for hour-based absence types; start and end dates then carry the time of day
+	Hours float64 `json:"hours,omitempty"`
 	// Calculated business days
 	Days float64 `json:"days,omitempty"`
 	// Request status
@@ -68,6 +77,38 @@ type LeaveRequest struct {
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// ID of the allowance record that was deducted, for accurate refunds
 	DeductedAllowanceID string `json:"deducted_allowance_id,omitempty"`
+	// Allowances deducted for this request, one entry per allowance year
+	Deductions []schema.LeaveDeduction `json:"deductions,omitempty"`
+	// Holiday calendar used to calculate days; empty when days were entered manually
+	HolidayCalendarID string `json:"holiday_calendar_id,omitempty"`
+	// Approval chain steps of this request with their decisions; empty when a single approval suffices
+	ApprovalSteps []schema.LeaveApproval `json:"approval_steps,omitempty"`
+	// Index of the approval step awaiting a decision
+	ApprovalStep int `json:"approval_step,omitempty"`
+	// User ID of the approver the request is assigned to; 0 when any approver may decide it
+	ApproverID uint32 `json:"approver_id,omitempty"`
+	// User ID of the approver the reviewer decided for as their delegate
+	OnBehalfOf uint32 `json:"on_behalf_of,omitempty"`
+	// Denormalized display name of the approver the reviewer decided for
+	OnBehalfOfName string `json:"on_behalf_of_name,omitempty"`
+	// When the request started waiting for its current approval step or signatures
+	AwaitingSince *time.Time `json:"awaiting_since,omitempty"`
+	// How far the wait for the current decision has been followed up: 0 none, 1 reminded, 2 escalated
+	EscalationLevel int `json:"escalation_level,omitempty"`
+	// Leave policy violations an HR admin overrode, with the justification
+	PolicyOverride *policy.Override `json:"policy_override,omitempty"`
+	// Number of supporting documents attached
+	AttachmentCount int `json:"attachment_count,omitempty"`
+	// Series of recurring requests the request is an occurrence of
+	SeriesID string `json:"series_id,omitempty"`
+	// Recurrence rule of the series the request is an occurrence of
+	Recurrence *recurrence.Rule `json:"recurrence,omitempty"`
+	// How the last signing submission of the request ended
+	SigningOutcome *leaverequest.SigningOutcome `json:"signing_outcome,omitempty"`
+	// Reason the signing service gave for a submission that did not complete
+	SigningOutcomeReason string `json:"signing_outcome_reason,omitempty"`
+	// When the last signing submission of the request ended
+	SigningEndedAt *time.Time `json:"signing_ended_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LeaveRequestQuery when eager-loading is set.
 	Edges        LeaveRequestEdges `json:"edges"`
@@ -99,15 +140,15 @@ func (*LeaveRequest) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case leaverequest.FieldMetadata:
+		case leaverequest.FieldMetadata, leaverequest.FieldDeductions, leaverequest.FieldApprovalSteps, leaverequest.FieldPolicyOverride, leaverequest.FieldRecurrence:
 			values[i] = new([]byte)
-		case leaverequest.FieldDays:
+		case leaverequest.FieldHours, leaverequest.FieldDays:
 			values[i] = new(sql.NullFloat64)
-		case leaverequest.FieldCreateBy, leaverequest.FieldUpdateBy, leaverequest.FieldTenantID, leaverequest.FieldUserID, leaverequest.FieldReviewedBy:
+		case leaverequest.FieldCreateBy, leaverequest.FieldUpdateBy, leaverequest.FieldTenantID, leaverequest.FieldUserID, leaverequest.FieldReviewedBy, leaverequest.FieldApprovalStep, leaverequest.FieldApproverID, leaverequest.FieldOnBehalfOf, leaverequest.FieldEscalationLevel, leaverequest.FieldAttachmentCount:
 			values[i] = new(sql.NullInt64)
-		case leaverequest.FieldID, leaverequest.FieldUserName, leaverequest.FieldUserEmail, leaverequest.FieldOrgUnitName, leaverequest.FieldAbsenceTypeID, leaverequest.FieldStatus, leaverequest.FieldSigningRequestID, leaverequest.FieldReason, leaverequest.FieldReviewNotes, leaverequest.FieldReviewerName, leaverequest.FieldNotes, leaverequest.FieldDeductedAllowanceID:
+		case leaverequest.FieldID, leaverequest.FieldUserName, leaverequest.FieldUserEmail, leaverequest.FieldOrgUnitName, leaverequest.FieldAbsenceTypeID, leaverequest.FieldStartDayPart, leaverequest.FieldEndDayPart, leaverequest.FieldStatus, leaverequest.FieldSigningRequestID, leaverequest.FieldReason, leaverequest.FieldReviewNotes, leaverequest.FieldReviewerName, leaverequest.FieldNotes, leaverequest.FieldDeductedAllowanceID, leaverequest.FieldHolidayCalendarID, leaverequest.FieldOnBehalfOfName, leaverequest.FieldSeriesID, leaverequest.FieldSigningOutcome, leaverequest.FieldSigningOutcomeReason:
 			values[i] = new(sql.NullString)
-		case leaverequest.FieldCreateTime, leaverequest.FieldUpdateTime, leaverequest.FieldDeleteTime, leaverequest.FieldStartDate, leaverequest.FieldEndDate, leaverequest.FieldReviewedAt:
+		case leaverequest.FieldCreateTime, leaverequest.FieldUpdateTime, leaverequest.FieldDeleteTime, leaverequest.FieldStartDate, leaverequest.FieldEndDate, leaverequest.FieldReviewedAt, leaverequest.FieldAwaitingSince, leaverequest.FieldSigningEndedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -214,6 +255,24 @@ func (_m *LeaveRequest) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.EndDate = value.Time
 			}
+		case leaverequest.FieldStartDayPart:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field start_day_part", values[i])
+			} else if value.Valid {
+				_m.StartDayPart = leaverequest.StartDayPart(value.String)
+			}
+		case leaverequest.FieldEndDayPart:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_day_part", values[i])
+			} else if value.Valid {
+				_m.EndDayPart = leaverequest.EndDayPart(value.String)
+			}
+		case leaverequest.FieldHours:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field hours", values[i])
+			} else if value.Valid {
+				_m.Hours = value.Float64
+			}
 		case leaverequest.FieldDays:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field days", values[i])
@@ -283,6 +342,113 @@ func (_m *LeaveRequest) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.DeductedAllowanceID = value.String
 			}
+		case leaverequest.FieldDeductions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field deductions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Deductions); err != nil {
+					return fmt.Errorf("unmarshal field deductions: %w", err)
+				}
+			}
+		case leaverequest.FieldHolidayCalendarID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field holiday_calendar_id", values[i])
+			} else if value.Valid {
+				_m.HolidayCalendarID = value.String
+			}
+		case leaverequest.FieldApprovalSteps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field approval_steps", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ApprovalSteps); err != nil {
+					return fmt.Errorf("unmarshal field approval_steps: %w", err)
+				}
+			}
+		case leaverequest.FieldApprovalStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field approval_step", values[i])
+			} else if value.Valid {
+				_m.ApprovalStep = int(value.Int64)
+			}
+		case leaverequest.FieldApproverID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field approver_id", values[i])
+			} else if value.Valid {
+				_m.ApproverID = uint32(value.Int64)
+			}
+		case leaverequest.FieldOnBehalfOf:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field on_behalf_of", values[i])
+			} else if value.Valid {
+				_m.OnBehalfOf = uint32(value.Int64)
+			}
+		case leaverequest.FieldOnBehalfOfName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field on_behalf_of_name", values[i])
+			} else if value.Valid {
+				_m.OnBehalfOfName = value.String
+			}
+		case leaverequest.FieldAwaitingSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field awaiting_since", values[i])
+			} else if value.Valid {
+				_m.AwaitingSince = new(time.Time)
+				*_m.AwaitingSince = value.Time
+			}
+		case leaverequest.FieldEscalationLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field escalation_level", values[i])
+			} else if value.Valid {
+				_m.EscalationLevel = int(value.Int64)
+			}
+		case leaverequest.FieldPolicyOverride:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_override", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PolicyOverride); err != nil {
+					return fmt.Errorf("unmarshal field policy_override: %w", err)
+				}
+			}
+		case leaverequest.FieldAttachmentCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_count", values[i])
+			} else if value.Valid {
+				_m.AttachmentCount = int(value.Int64)
+			}
+		case leaverequest.FieldSeriesID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field series_id", values[i])
+			} else if value.Valid {
+				_m.SeriesID = value.String
+			}
+		case leaverequest.FieldRecurrence:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Recurrence); err != nil {
+					return fmt.Errorf("unmarshal field recurrence: %w", err)
+				}
+			}
+		case leaverequest.FieldSigningOutcome:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signing_outcome", values[i])
+			} else if value.Valid {
+				_m.SigningOutcome = new(leaverequest.SigningOutcome)
+				*_m.SigningOutcome = leaverequest.SigningOutcome(value.String)
+			}
+		case leaverequest.FieldSigningOutcomeReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signing_outcome_reason", values[i])
+			} else if value.Valid {
+				_m.SigningOutcomeReason = value.String
+			}
+		case leaverequest.FieldSigningEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field signing_ended_at", values[i])
+			} else if value.Valid {
+				_m.SigningEndedAt = new(time.Time)
+				*_m.SigningEndedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -375,6 +541,15 @@ func (_m *LeaveRequest) String() string {
 	builder.WriteString("end_date=")
 	builder.WriteString(_m.EndDate.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("start_day_part=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartDayPart))
+	builder.WriteString(", ")
+	builder.WriteString("end_day_part=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndDayPart))
+	builder.WriteString(", ")
+	builder.WriteString("hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Hours))
+	builder.WriteString(", ")
 	builder.WriteString("days=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Days))
 	builder.WriteString(", ")
@@ -409,6 +584,60 @@ func (_m *LeaveRequest) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("deducted_allowance_id=")
 	builder.WriteString(_m.DeductedAllowanceID)
+	builder.WriteString(", ")
+	builder.WriteString("deductions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Deductions))
+	builder.WriteString(", ")
+	builder.WriteString("holiday_calendar_id=")
+	builder.WriteString(_m.HolidayCalendarID)
+	builder.WriteString(", ")
+	builder.WriteString("approval_steps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ApprovalSteps))
+	builder.WriteString(", ")
+	builder.WriteString("approval_step=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ApprovalStep))
+	builder.WriteString(", ")
+	builder.WriteString("approver_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ApproverID))
+	builder.WriteString(", ")
+	builder.WriteString("on_behalf_of=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OnBehalfOf))
+	builder.WriteString(", ")
+	builder.WriteString("on_behalf_of_name=")
+	builder.WriteString(_m.OnBehalfOfName)
+	builder.WriteString(", ")
+	if v := _m.AwaitingSince; v != nil {
+		builder.WriteString("awaiting_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("escalation_level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EscalationLevel))
+	builder.WriteString(", ")
+	builder.WriteString("policy_override=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyOverride))
+	builder.WriteString(", ")
+	builder.WriteString("attachment_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttachmentCount))
+	builder.WriteString(", ")
+	builder.WriteString("series_id=")
+	builder.WriteString(_m.SeriesID)
+	builder.WriteString(", ")
+	builder.WriteString("recurrence=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Recurrence))
+	builder.WriteString(", ")
+	if v := _m.SigningOutcome; v != nil {
+		builder.WriteString("signing_outcome=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("signing_outcome_reason=")
+	builder.WriteString(_m.SigningOutcomeReason)
+	builder.WriteString(", ")
+	if v := _m.SigningEndedAt; v != nil {
+		builder.WriteString("signing_ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
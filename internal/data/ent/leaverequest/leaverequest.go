@@ -41,6 +41,12 @@ const (
 	FieldStartDate = "start_date"
 	// FieldEndDate holds the string denoting the end_date field in the database.
 	FieldEndDate = "end_date"
+	// FieldStartDayPart holds the string denoting the start_day_part field in the database.
+	FieldStartDayPart = "start_day_part"
+	// FieldEndDayPart holds the string denoting the end_day_part field in the database.
+	FieldEndDayPart = "end_day_part"
+	// FieldHours holds the string denoting the hours field in the database.
+	FieldHours = "hours"
 	// FieldDays holds the string denoting the days field in the database.
 	FieldDays = "days"
 	// FieldStatus holds the string denoting the status field in the database.
@@ -63,6 +69,38 @@ const (
 	FieldMetadata = "metadata"
 	// FieldDeductedAllowanceID holds the string denoting the deducted_allowance_id field in the database.
 	FieldDeductedAllowanceID = "deducted_allowance_id"
+	// FieldDeductions holds the string denoting the deductions field in the database.
+	FieldDeductions = "deductions"
+	// FieldHolidayCalendarID holds the string denoting the holiday_calendar_id field in the database.
+	FieldHolidayCalendarID = "holiday_calendar_id"
+	// FieldApprovalSteps holds the string denoting the approval_steps field in the database.
+	FieldApprovalSteps = "approval_steps"
+	// FieldApprovalStep holds the string denoting the approval_step field in the database.
+	FieldApprovalStep = "approval_step"
+	// FieldApproverID holds the string denoting the approver_id field in the database.
+	FieldApproverID = "approver_id"
+	// FieldOnBehalfOf holds the string denoting the on_behalf_of field in the database.
+	FieldOnBehalfOf = "on_behalf_of"
+	// FieldOnBehalfOfName holds the string denoting the on_behalf_of_name field in the database.
+	FieldOnBehalfOfName = "on_behalf_of_name"
+	// FieldAwaitingSince holds the string denoting the awaiting_since field in the database.
+	FieldAwaitingSince = "awaiting_since"
+	// FieldEscalationLevel holds the string denoting the escalation_level field in the database.
+	FieldEscalationLevel = "escalation_level"
+	// FieldPolicyOverride holds the string denoting the policy_override field in the database.
+	FieldPolicyOverride = "policy_override"
+	// FieldAttachmentCount holds the string denoting the attachment_count field in the database.
+	FieldAttachmentCount = "attachment_count"
+	// FieldSeriesID holds the string denoting the series_id field in the database.
+	FieldSeriesID = "series_id"
+	// FieldRecurrence holds the string denoting the recurrence field in the database.
+	FieldRecurrence = "recurrence"
+	// FieldSigningOutcome holds the string denoting the signing_outcome field in the database.
+	FieldSigningOutcome = "signing_outcome"
+	// FieldSigningOutcomeReason holds the string denoting the signing_outcome_reason field in the database.
+	FieldSigningOutcomeReason = "signing_outcome_reason"
+	// FieldSigningEndedAt holds the string denoting the signing_ended_at field in the database.
+	FieldSigningEndedAt = "signing_ended_at"
 	// EdgeAbsenceType holds the string denoting the absence_type edge name in mutations.
 	EdgeAbsenceType = "absence_type"
 	// Table holds the table name of the leaverequest in the database.
@@ -92,6 +130,9 @@ var Columns = []string{
 	FieldAbsenceTypeID,
 	FieldStartDate,
 	FieldEndDate,
+	FieldStartDayPart,
+	FieldEndDayPart,
+	FieldHours,
 	FieldDays,
 	FieldStatus,
 	FieldSigningRequestID,
@@ -103,6 +144,22 @@ var Columns = []string{
 	FieldNotes,
 	FieldMetadata,
 	FieldDeductedAllowanceID,
+	FieldDeductions,
+	FieldHolidayCalendarID,
+	FieldApprovalSteps,
+	FieldApprovalStep,
+	FieldApproverID,
+	FieldOnBehalfOf,
+	FieldOnBehalfOfName,
+	FieldAwaitingSince,
+	FieldEscalationLevel,
+	FieldPolicyOverride,
+	FieldAttachmentCount,
+	FieldSeriesID,
+	FieldRecurrence,
+	FieldSigningOutcome,
+	FieldSigningOutcomeReason,
+	FieldSigningEndedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -133,6 +190,8 @@ var (
 	DefaultOrgUnitName string
 	// AbsenceTypeIDValidator is a validator for the "absence_type_id" field. It is called by the builders before save.
 	AbsenceTypeIDValidator func(string) error
+	// DefaultHours holds the default value on creation for the "hours" field.
+	DefaultHours float64
 	// DefaultSigningRequestID holds the default value on creation for the "signing_request_id" field.
 	DefaultSigningRequestID string
 	// DefaultReviewedBy holds the default value on creation for the "reviewed_by" field.
@@ -141,10 +200,82 @@ var (
 	DefaultReviewerName string
 	// DefaultDeductedAllowanceID holds the default value on creation for the "deducted_allowance_id" field.
 	DefaultDeductedAllowanceID string
+	// DefaultHolidayCalendarID holds the default value on creation for the "holiday_calendar_id" field.
+	DefaultHolidayCalendarID string
+	// DefaultApprovalStep holds the default value on creation for the "approval_step" field.
+	DefaultApprovalStep int
+	// DefaultApproverID holds the default value on creation for the "approver_id" field.
+	DefaultApproverID uint32
+	// DefaultOnBehalfOf holds the default value on creation for the "on_behalf_of" field.
+	DefaultOnBehalfOf uint32
+	// DefaultOnBehalfOfName holds the default value on creation for the "on_behalf_of_name" field.
+	DefaultOnBehalfOfName string
+	// DefaultEscalationLevel holds the default value on creation for the "escalation_level" field.
+	DefaultEscalationLevel int
+	// DefaultAttachmentCount holds the default value on creation for the "attachment_count" field.
+	DefaultAttachmentCount int
+	// AttachmentCountValidator is a validator for the "attachment_count" field. It is called by the builders before save.
+	AttachmentCountValidator func(int) error
+	// DefaultSeriesID holds the default value on creation for the "series_id" field.
+	DefaultSeriesID string
+	// DefaultSigningOutcomeReason holds the default value on creation for the "signing_outcome_reason" field.
+	DefaultSigningOutcomeReason string
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
 
+// StartDayPart defines the type for the "start_day_part" enum field.
+type StartDayPart string
+
+// StartDayPartAm is the default value of the StartDayPart enum.
+const DefaultStartDayPart = StartDayPartAm
+
+// StartDayPart values.
+const (
+	StartDayPartAm StartDayPart = "am"
+	StartDayPartPm StartDayPart = "pm"
+)
+
+func (sdp StartDayPart) String() string {
+	return string(sdp)
+}
+
+// StartDayPartValidator is a validator for the "start_day_part" field enum values. It is called by the builders before save.
+func StartDayPartValidator(sdp StartDayPart) error {
+	switch sdp {
+	case StartDayPartAm, StartDayPartPm:
+		return nil
+	default:
+		return fmt.Errorf("leaverequest: invalid enum value for start_day_part field: %q", sdp)
+	}
+}
+
+// EndDayPart defines the type for the "end_day_part" enum field.
+type EndDayPart string
+
+// EndDayPartPm is the default value of the EndDayPart enum.
+const DefaultEndDayPart = EndDayPartPm
+
+// EndDayPart values.
+const (
+	EndDayPartAm EndDayPart = "am"
+	EndDayPartPm EndDayPart = "pm"
+)
+
+func (edp EndDayPart) String() string {
+	return string(edp)
+}
+
+// EndDayPartValidator is a validator for the "end_day_part" field enum values. It is called by the builders before save.
+func EndDayPartValidator(edp EndDayPart) error {
+	switch edp {
+	case EndDayPartAm, EndDayPartPm:
+		return nil
+	default:
+		return fmt.Errorf("leaverequest: invalid enum value for end_day_part field: %q", edp)
+	}
+}
+
 // Status defines the type for the "status" enum field.
 type Status string
 
@@ -175,6 +306,31 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// SigningOutcome defines the type for the "signing_outcome" enum field.
+type SigningOutcome string
+
+// SigningOutcome values.
+const (
+	SigningOutcomeCompleted SigningOutcome = "completed"
+	SigningOutcomeDeclined  SigningOutcome = "declined"
+	SigningOutcomeExpired   SigningOutcome = "expired"
+	SigningOutcomeCancelled SigningOutcome = "cancelled"
+)
+
+func (so SigningOutcome) String() string {
+	return string(so)
+}
+
+// SigningOutcomeValidator is a validator for the "signing_outcome" field enum values. It is called by the builders before save.
+func SigningOutcomeValidator(so SigningOutcome) error {
+	switch so {
+	case SigningOutcomeCompleted, SigningOutcomeDeclined, SigningOutcomeExpired, SigningOutcomeCancelled:
+		return nil
+	default:
+		return fmt.Errorf("leaverequest: invalid enum value for signing_outcome field: %q", so)
+	}
+}
+
 // OrderOption defines the ordering options for the LeaveRequest queries.
 type OrderOption func(*sql.Selector)
 
@@ -248,6 +404,21 @@ func ByEndDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEndDate, opts...).ToFunc()
 }
 
+// ByStartDayPart orders the results by the start_day_part field.
+func ByStartDayPart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartDayPart, opts...).ToFunc()
+}
+
+// ByEndDayPart orders the results by the end_day_part field.
+func ByEndDayPart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndDayPart, opts...).ToFunc()
+}
+
+// ByHours orders the results by the hours field.
+func ByHours(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHours, opts...).ToFunc()
+}
+
 // ByDays orders the results by the days field.
 func ByDays(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDays, opts...).ToFunc()
@@ -298,6 +469,66 @@ func ByDeductedAllowanceID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeductedAllowanceID, opts...).ToFunc()
 }
 
+// ByHolidayCalendarID orders the results by the holiday_calendar_id field.
+func ByHolidayCalendarID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHolidayCalendarID, opts...).ToFunc()
+}
+
+// ByApprovalStep orders the results by the approval_step field.
+func ByApprovalStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApprovalStep, opts...).ToFunc()
+}
+
+// ByApproverID orders the results by the approver_id field.
+func ByApproverID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApproverID, opts...).ToFunc()
+}
+
+// ByOnBehalfOf orders the results by the on_behalf_of field.
+func ByOnBehalfOf(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOnBehalfOf, opts...).ToFunc()
+}
+
+// ByOnBehalfOfName orders the results by the on_behalf_of_name field.
+func ByOnBehalfOfName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOnBehalfOfName, opts...).ToFunc()
+}
+
+// ByAwaitingSince orders the results by the awaiting_since field.
+func ByAwaitingSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAwaitingSince, opts...).ToFunc()
+}
+
+// ByEscalationLevel orders the results by the escalation_level field.
+func ByEscalationLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEscalationLevel, opts...).ToFunc()
+}
+
+// ByAttachmentCount orders the results by the attachment_count field.
+func ByAttachmentCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttachmentCount, opts...).ToFunc()
+}
+
+// BySeriesID orders the results by the series_id field.
+func BySeriesID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeriesID, opts...).ToFunc()
+}
+
+// BySigningOutcome orders the results by the signing_outcome field.
+func BySigningOutcome(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigningOutcome, opts...).ToFunc()
+}
+
+// BySigningOutcomeReason orders the results by the signing_outcome_reason field.
+func BySigningOutcomeReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigningOutcomeReason, opts...).ToFunc()
+}
+
+// BySigningEndedAt orders the results by the signing_ended_at field.
+func BySigningEndedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigningEndedAt, opts...).ToFunc()
+}
+
 // ByAbsenceTypeField orders the results by absence_type field.
 func ByAbsenceTypeField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -130,6 +130,11 @@ func EndDate(v time.Time) predicate.LeaveRequest {
 	return predicate.LeaveRequest(sql.FieldEQ(FieldEndDate, v))
 }
 
+// Hours applies equality check predicate on the "hours" field. It's identical to HoursEQ.
+func Hours(v float64) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldHours, v))
+}
+
 // Days applies equality check predicate on the "days" field. It's identical to DaysEQ.
 func Days(v float64) predicate.LeaveRequest {
 	return predicate.LeaveRequest(sql.FieldEQ(FieldDays, v))
@@ -175,6 +180,61 @@ func DeductedAllowanceID(v string) predicate.LeaveRequest {
 	return predicate.LeaveRequest(sql.FieldEQ(FieldDeductedAllowanceID, v))
 }
 
+// HolidayCalendarID applies equality check predicate on the "holiday_calendar_id" field. It's identical to HolidayCalendarIDEQ.
+func HolidayCalendarID(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldHolidayCalendarID, v))
+}
+
+// ApprovalStep applies equality check predicate on the "approval_step" field. It's identical to ApprovalStepEQ.
+func ApprovalStep(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldApprovalStep, v))
+}
+
+// ApproverID applies equality check predicate on the "approver_id" field. It's identical to ApproverIDEQ.
+func ApproverID(v uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldApproverID, v))
+}
+
+// OnBehalfOf applies equality check predicate on the "on_behalf_of" field. It's identical to OnBehalfOfEQ.
+func OnBehalfOf(v uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldOnBehalfOf, v))
+}
+
+// OnBehalfOfName applies equality check predicate on the "on_behalf_of_name" field. It's identical to OnBehalfOfNameEQ.
+func OnBehalfOfName(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldOnBehalfOfName, v))
+}
+
+// AwaitingSince applies equality check predicate on the "awaiting_since" field. It's identical to AwaitingSinceEQ.
+func AwaitingSince(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldAwaitingSince, v))
+}
+
+// EscalationLevel applies equality check predicate on the "escalation_level" field. It's identical to EscalationLevelEQ.
+func EscalationLevel(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldEscalationLevel, v))
+}
+
+// AttachmentCount applies equality check predicate on the "attachment_count" field. It's identical to AttachmentCountEQ.
+func AttachmentCount(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldAttachmentCount, v))
+}
+
+// SeriesID applies equality check predicate on the "series_id" field. It's identical to SeriesIDEQ.
+func SeriesID(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// SigningOutcomeReason applies equality check predicate on the "signing_outcome_reason" field. It's identical to SigningOutcomeReasonEQ.
+func SigningOutcomeReason(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldSigningOutcomeReason, v))
+}
+
+// SigningEndedAt applies equality check predicate on the "signing_ended_at" field. It's identical to SigningEndedAtEQ.
+func SigningEndedAt(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldSigningEndedAt, v))
+}
+
 // CreateByEQ applies the EQ predicate on the "create_by" field.
 func CreateByEQ(v uint32) predicate.LeaveRequest {
 	return predicate.LeaveRequest(sql.FieldEQ(FieldCreateBy, v))
@@ -885,6 +945,86 @@ func EndDateLTE(v time.Time) predicate.LeaveRequest {
 	return predicate.LeaveRequest(sql.FieldLTE(FieldEndDate, v))
 }
 
+// StartDayPartEQ applies the EQ predicate on the "start_day_part" field.
+func StartDayPartEQ(v StartDayPart) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldStartDayPart, v))
+}
+
+// StartDayPartNEQ applies the NEQ predicate on the "start_day_part" field.
+func StartDayPartNEQ(v StartDayPart) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldStartDayPart, v))
+}
+
+// StartDayPartIn applies the In predicate on the "start_day_part" field.
+func StartDayPartIn(vs ...StartDayPart) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldStartDayPart, vs...))
+}
+
+// StartDayPartNotIn applies the NotIn predicate on the "start_day_part" field.
+func StartDayPartNotIn(vs ...StartDayPart) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldStartDayPart, vs...))
+}
+
+// EndDayPartEQ applies the EQ predicate on the "end_day_part" field.
+func EndDayPartEQ(v EndDayPart) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldEndDayPart, v))
+}
+
+// EndDayPartNEQ applies the NEQ predicate on the "end_day_part" field.
+func EndDayPartNEQ(v EndDayPart) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldEndDayPart, v))
+}
+
+// EndDayPartIn applies the In predicate on the "end_day_part" field.
+func EndDayPartIn(vs ...EndDayPart) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldEndDayPart, vs...))
+}
+
+// EndDayPartNotIn applies the NotIn predicate on the "end_day_part" field.
+func EndDayPartNotIn(vs ...EndDayPart) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldEndDayPart, vs...))
+}
+
+// HoursEQ applies the EQ predicate on the "hours" field.
+func HoursEQ(v float64) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldHours, v))
+}
+
+// HoursNEQ applies the NEQ predicate on the "hours" field.
+func HoursNEQ(v float64) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldHours, v))
+}
+
+// HoursIn applies the In predicate on the "hours" field.
+func HoursIn(vs ...float64) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldHours, vs...))
+}
+
+// HoursNotIn applies the NotIn predicate on the "hours" field.
+func HoursNotIn(vs ...float64) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldHours, vs...))
+}
+
+// HoursGT applies the GT predicate on the "hours" field.
+func HoursGT(v float64) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldHours, v))
+}
+
+// HoursGTE applies the GTE predicate on the "hours" field.
+func HoursGTE(v float64) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldHours, v))
+}
+
+// HoursLT applies the LT predicate on the "hours" field.
+func HoursLT(v float64) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldHours, v))
+}
+
+// HoursLTE applies the LTE predicate on the "hours" field.
+func HoursLTE(v float64) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldHours, v))
+}
+
 // DaysEQ applies the EQ predicate on the "days" field.
 func DaysEQ(v float64) predicate.LeaveRequest {
 	return predicate.LeaveRequest(sql.FieldEQ(FieldDays, v))
@@ -1505,6 +1645,686 @@ func DeductedAllowanceIDContainsFold(v string) predicate.LeaveRequest {
 	return predicate.LeaveRequest(sql.FieldContainsFold(FieldDeductedAllowanceID, v))
 }
 
+// DeductionsIsNil applies the IsNil predicate on the "deductions" field.
+func DeductionsIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldDeductions))
+}
+
+// DeductionsNotNil applies the NotNil predicate on the "deductions" field.
+func DeductionsNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldDeductions))
+}
+
+// HolidayCalendarIDEQ applies the EQ predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDNEQ applies the NEQ predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDNEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDIn applies the In predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldHolidayCalendarID, vs...))
+}
+
+// HolidayCalendarIDNotIn applies the NotIn predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDNotIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldHolidayCalendarID, vs...))
+}
+
+// HolidayCalendarIDGT applies the GT predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDGT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDGTE applies the GTE predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDGTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDLT applies the LT predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDLT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDLTE applies the LTE predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDLTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDContains applies the Contains predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDContains(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContains(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDHasPrefix applies the HasPrefix predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDHasPrefix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasPrefix(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDHasSuffix applies the HasSuffix predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDHasSuffix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasSuffix(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDIsNil applies the IsNil predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldHolidayCalendarID))
+}
+
+// HolidayCalendarIDNotNil applies the NotNil predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldHolidayCalendarID))
+}
+
+// HolidayCalendarIDEqualFold applies the EqualFold predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDEqualFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEqualFold(FieldHolidayCalendarID, v))
+}
+
+// HolidayCalendarIDContainsFold applies the ContainsFold predicate on the "holiday_calendar_id" field.
+func HolidayCalendarIDContainsFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContainsFold(FieldHolidayCalendarID, v))
+}
+
+// ApprovalStepsIsNil applies the IsNil predicate on the "approval_steps" field.
+func ApprovalStepsIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldApprovalSteps))
+}
+
+// ApprovalStepsNotNil applies the NotNil predicate on the "approval_steps" field.
+func ApprovalStepsNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldApprovalSteps))
+}
+
+// ApprovalStepEQ applies the EQ predicate on the "approval_step" field.
+func ApprovalStepEQ(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldApprovalStep, v))
+}
+
+// ApprovalStepNEQ applies the NEQ predicate on the "approval_step" field.
+func ApprovalStepNEQ(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldApprovalStep, v))
+}
+
+// ApprovalStepIn applies the In predicate on the "approval_step" field.
+func ApprovalStepIn(vs ...int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldApprovalStep, vs...))
+}
+
+// ApprovalStepNotIn applies the NotIn predicate on the "approval_step" field.
+func ApprovalStepNotIn(vs ...int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldApprovalStep, vs...))
+}
+
+// ApprovalStepGT applies the GT predicate on the "approval_step" field.
+func ApprovalStepGT(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldApprovalStep, v))
+}
+
+// ApprovalStepGTE applies the GTE predicate on the "approval_step" field.
+func ApprovalStepGTE(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldApprovalStep, v))
+}
+
+// ApprovalStepLT applies the LT predicate on the "approval_step" field.
+func ApprovalStepLT(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldApprovalStep, v))
+}
+
+// ApprovalStepLTE applies the LTE predicate on the "approval_step" field.
+func ApprovalStepLTE(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldApprovalStep, v))
+}
+
+// ApproverIDEQ applies the EQ predicate on the "approver_id" field.
+func ApproverIDEQ(v uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldApproverID, v))
+}
+
+// ApproverIDNEQ applies the NEQ predicate on the "approver_id" field.
+func ApproverIDNEQ(v uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldApproverID, v))
+}
+
+// ApproverIDIn applies the In predicate on the "approver_id" field.
+func ApproverIDIn(vs ...uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldApproverID, vs...))
+}
+
+// ApproverIDNotIn applies the NotIn predicate on the "approver_id" field.
+func ApproverIDNotIn(vs ...uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldApproverID, vs...))
+}
+
+// ApproverIDGT applies the GT predicate on the "approver_id" field.
+func ApproverIDGT(v uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldApproverID, v))
+}
+
+// ApproverIDGTE applies the GTE predicate on the "approver_id" field.
+func ApproverIDGTE(v uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldApproverID, v))
+}
+
+// ApproverIDLT applies the LT predicate on the "approver_id" field.
+func ApproverIDLT(v uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldApproverID, v))
+}
+
+// ApproverIDLTE applies the LTE predicate on the "approver_id" field.
+func ApproverIDLTE(v uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldApproverID, v))
+}
+
+// OnBehalfOfEQ applies the EQ predicate on the "on_behalf_of" field.
+func OnBehalfOfEQ(v uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldOnBehalfOf, v))
+}
+
+// OnBehalfOfNEQ applies the NEQ predicate on the "on_behalf_of" field.
+func OnBehalfOfNEQ(v uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldOnBehalfOf, v))
+}
+
+// OnBehalfOfIn applies the In predicate on the "on_behalf_of" field.
+func OnBehalfOfIn(vs ...uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldOnBehalfOf, vs...))
+}
+
+// OnBehalfOfNotIn applies the NotIn predicate on the "on_behalf_of" field.
+func OnBehalfOfNotIn(vs ...uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldOnBehalfOf, vs...))
+}
+
+// OnBehalfOfGT applies the GT predicate on the "on_behalf_of" field.
+func OnBehalfOfGT(v uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldOnBehalfOf, v))
+}
+
+// OnBehalfOfGTE applies the GTE predicate on the "on_behalf_of" field.
+func OnBehalfOfGTE(v uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldOnBehalfOf, v))
+}
+
+// OnBehalfOfLT applies the LT predicate on the "on_behalf_of" field.
+func OnBehalfOfLT(v uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldOnBehalfOf, v))
+}
+
+// OnBehalfOfLTE applies the LTE predicate on the "on_behalf_of" field.
+func OnBehalfOfLTE(v uint32) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldOnBehalfOf, v))
+}
+
+// OnBehalfOfIsNil applies the IsNil predicate on the "on_behalf_of" field.
+func OnBehalfOfIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldOnBehalfOf))
+}
+
+// OnBehalfOfNotNil applies the NotNil predicate on the "on_behalf_of" field.
+func OnBehalfOfNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldOnBehalfOf))
+}
+
+// OnBehalfOfNameEQ applies the EQ predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldOnBehalfOfName, v))
+}
+
+// OnBehalfOfNameNEQ applies the NEQ predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameNEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldOnBehalfOfName, v))
+}
+
+// OnBehalfOfNameIn applies the In predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldOnBehalfOfName, vs...))
+}
+
+// OnBehalfOfNameNotIn applies the NotIn predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameNotIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldOnBehalfOfName, vs...))
+}
+
+// OnBehalfOfNameGT applies the GT predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameGT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldOnBehalfOfName, v))
+}
+
+// OnBehalfOfNameGTE applies the GTE predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameGTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldOnBehalfOfName, v))
+}
+
+// OnBehalfOfNameLT applies the LT predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameLT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldOnBehalfOfName, v))
+}
+
+// OnBehalfOfNameLTE applies the LTE predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameLTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldOnBehalfOfName, v))
+}
+
+// OnBehalfOfNameContains applies the Contains predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameContains(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContains(FieldOnBehalfOfName, v))
+}
+
+// OnBehalfOfNameHasPrefix applies the HasPrefix predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameHasPrefix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasPrefix(FieldOnBehalfOfName, v))
+}
+
+// OnBehalfOfNameHasSuffix applies the HasSuffix predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameHasSuffix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasSuffix(FieldOnBehalfOfName, v))
+}
+
+// OnBehalfOfNameIsNil applies the IsNil predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldOnBehalfOfName))
+}
+
+// OnBehalfOfNameNotNil applies the NotNil predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldOnBehalfOfName))
+}
+
+// OnBehalfOfNameEqualFold applies the EqualFold predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameEqualFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEqualFold(FieldOnBehalfOfName, v))
+}
+
+// OnBehalfOfNameContainsFold applies the ContainsFold predicate on the "on_behalf_of_name" field.
+func OnBehalfOfNameContainsFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContainsFold(FieldOnBehalfOfName, v))
+}
+
+// AwaitingSinceEQ applies the EQ predicate on the "awaiting_since" field.
+func AwaitingSinceEQ(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldAwaitingSince, v))
+}
+
+// AwaitingSinceNEQ applies the NEQ predicate on the "awaiting_since" field.
+func AwaitingSinceNEQ(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldAwaitingSince, v))
+}
+
+// AwaitingSinceIn applies the In predicate on the "awaiting_since" field.
+func AwaitingSinceIn(vs ...time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldAwaitingSince, vs...))
+}
+
+// AwaitingSinceNotIn applies the NotIn predicate on the "awaiting_since" field.
+func AwaitingSinceNotIn(vs ...time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldAwaitingSince, vs...))
+}
+
+// AwaitingSinceGT applies the GT predicate on the "awaiting_since" field.
+func AwaitingSinceGT(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldAwaitingSince, v))
+}
+
+// AwaitingSinceGTE applies the GTE predicate on the "awaiting_since" field.
+func AwaitingSinceGTE(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldAwaitingSince, v))
+}
+
+// AwaitingSinceLT applies the LT predicate on the "awaiting_since" field.
+func AwaitingSinceLT(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldAwaitingSince, v))
+}
+
+// AwaitingSinceLTE applies the LTE predicate on the "awaiting_since" field.
+func AwaitingSinceLTE(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldAwaitingSince, v))
+}
+
+// AwaitingSinceIsNil applies the IsNil predicate on the "awaiting_since" field.
+func AwaitingSinceIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldAwaitingSince))
+}
+
+// AwaitingSinceNotNil applies the NotNil predicate on the "awaiting_since" field.
+func AwaitingSinceNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldAwaitingSince))
+}
+
+// EscalationLevelEQ applies the EQ predicate on the "escalation_level" field.
+func EscalationLevelEQ(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldEscalationLevel, v))
+}
+
+// EscalationLevelNEQ applies the NEQ predicate on the "escalation_level" field.
+func EscalationLevelNEQ(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldEscalationLevel, v))
+}
+
+// EscalationLevelIn applies the In predicate on the "escalation_level" field.
+func EscalationLevelIn(vs ...int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldEscalationLevel, vs...))
+}
+
+// EscalationLevelNotIn applies the NotIn predicate on the "escalation_level" field.
+func EscalationLevelNotIn(vs ...int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldEscalationLevel, vs...))
+}
+
+// EscalationLevelGT applies the GT predicate on the "escalation_level" field.
+func EscalationLevelGT(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldEscalationLevel, v))
+}
+
+// EscalationLevelGTE applies the GTE predicate on the "escalation_level" field.
+func EscalationLevelGTE(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldEscalationLevel, v))
+}
+
+// EscalationLevelLT applies the LT predicate on the "escalation_level" field.
+func EscalationLevelLT(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldEscalationLevel, v))
+}
+
+// EscalationLevelLTE applies the LTE predicate on the "escalation_level" field.
+func EscalationLevelLTE(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldEscalationLevel, v))
+}
+
+// PolicyOverrideIsNil applies the IsNil predicate on the "policy_override" field.
+func PolicyOverrideIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldPolicyOverride))
+}
+
+// PolicyOverrideNotNil applies the NotNil predicate on the "policy_override" field.
+func PolicyOverrideNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldPolicyOverride))
+}
+
+// AttachmentCountEQ applies the EQ predicate on the "attachment_count" field.
+func AttachmentCountEQ(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldAttachmentCount, v))
+}
+
+// AttachmentCountNEQ applies the NEQ predicate on the "attachment_count" field.
+func AttachmentCountNEQ(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldAttachmentCount, v))
+}
+
+// AttachmentCountIn applies the In predicate on the "attachment_count" field.
+func AttachmentCountIn(vs ...int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldAttachmentCount, vs...))
+}
+
+// AttachmentCountNotIn applies the NotIn predicate on the "attachment_count" field.
+func AttachmentCountNotIn(vs ...int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldAttachmentCount, vs...))
+}
+
+// AttachmentCountGT applies the GT predicate on the "attachment_count" field.
+func AttachmentCountGT(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldAttachmentCount, v))
+}
+
+// AttachmentCountGTE applies the GTE predicate on the "attachment_count" field.
+func AttachmentCountGTE(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldAttachmentCount, v))
+}
+
+// AttachmentCountLT applies the LT predicate on the "attachment_count" field.
+func AttachmentCountLT(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldAttachmentCount, v))
+}
+
+// AttachmentCountLTE applies the LTE predicate on the "attachment_count" field.
+func AttachmentCountLTE(v int) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldAttachmentCount, v))
+}
+
+// SeriesIDEQ applies the EQ predicate on the "series_id" field.
+func SeriesIDEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// SeriesIDNEQ applies the NEQ predicate on the "series_id" field.
+func SeriesIDNEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldSeriesID, v))
+}
+
+// SeriesIDIn applies the In predicate on the "series_id" field.
+func SeriesIDIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDNotIn applies the NotIn predicate on the "series_id" field.
+func SeriesIDNotIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDGT applies the GT predicate on the "series_id" field.
+func SeriesIDGT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldSeriesID, v))
+}
+
+// SeriesIDGTE applies the GTE predicate on the "series_id" field.
+func SeriesIDGTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldSeriesID, v))
+}
+
+// SeriesIDLT applies the LT predicate on the "series_id" field.
+func SeriesIDLT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldSeriesID, v))
+}
+
+// SeriesIDLTE applies the LTE predicate on the "series_id" field.
+func SeriesIDLTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldSeriesID, v))
+}
+
+// SeriesIDContains applies the Contains predicate on the "series_id" field.
+func SeriesIDContains(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContains(FieldSeriesID, v))
+}
+
+// SeriesIDHasPrefix applies the HasPrefix predicate on the "series_id" field.
+func SeriesIDHasPrefix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasPrefix(FieldSeriesID, v))
+}
+
+// SeriesIDHasSuffix applies the HasSuffix predicate on the "series_id" field.
+func SeriesIDHasSuffix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasSuffix(FieldSeriesID, v))
+}
+
+// SeriesIDIsNil applies the IsNil predicate on the "series_id" field.
+func SeriesIDIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldSeriesID))
+}
+
+// SeriesIDNotNil applies the NotNil predicate on the "series_id" field.
+func SeriesIDNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldSeriesID))
+}
+
+// SeriesIDEqualFold applies the EqualFold predicate on the "series_id" field.
+func SeriesIDEqualFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEqualFold(FieldSeriesID, v))
+}
+
+// SeriesIDContainsFold applies the ContainsFold predicate on the "series_id" field.
+func SeriesIDContainsFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContainsFold(FieldSeriesID, v))
+}
+
+// RecurrenceIsNil applies the IsNil predicate on the "recurrence" field.
+func RecurrenceIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldRecurrence))
+}
+
+// RecurrenceNotNil applies the NotNil predicate on the "recurrence" field.
+func RecurrenceNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldRecurrence))
+}
+
+// SigningOutcomeEQ applies the EQ predicate on the "signing_outcome" field.
+func SigningOutcomeEQ(v SigningOutcome) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldSigningOutcome, v))
+}
+
+// SigningOutcomeNEQ applies the NEQ predicate on the "signing_outcome" field.
+func SigningOutcomeNEQ(v SigningOutcome) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldSigningOutcome, v))
+}
+
+// SigningOutcomeIn applies the In predicate on the "signing_outcome" field.
+func SigningOutcomeIn(vs ...SigningOutcome) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldSigningOutcome, vs...))
+}
+
+// SigningOutcomeNotIn applies the NotIn predicate on the "signing_outcome" field.
+func SigningOutcomeNotIn(vs ...SigningOutcome) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldSigningOutcome, vs...))
+}
+
+// SigningOutcomeIsNil applies the IsNil predicate on the "signing_outcome" field.
+func SigningOutcomeIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldSigningOutcome))
+}
+
+// SigningOutcomeNotNil applies the NotNil predicate on the "signing_outcome" field.
+func SigningOutcomeNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldSigningOutcome))
+}
+
+// SigningOutcomeReasonEQ applies the EQ predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldSigningOutcomeReason, v))
+}
+
+// SigningOutcomeReasonNEQ applies the NEQ predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonNEQ(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldSigningOutcomeReason, v))
+}
+
+// SigningOutcomeReasonIn applies the In predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldSigningOutcomeReason, vs...))
+}
+
+// SigningOutcomeReasonNotIn applies the NotIn predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonNotIn(vs ...string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldSigningOutcomeReason, vs...))
+}
+
+// SigningOutcomeReasonGT applies the GT predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonGT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldSigningOutcomeReason, v))
+}
+
+// SigningOutcomeReasonGTE applies the GTE predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonGTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldSigningOutcomeReason, v))
+}
+
+// SigningOutcomeReasonLT applies the LT predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonLT(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldSigningOutcomeReason, v))
+}
+
+// SigningOutcomeReasonLTE applies the LTE predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonLTE(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldSigningOutcomeReason, v))
+}
+
+// SigningOutcomeReasonContains applies the Contains predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonContains(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContains(FieldSigningOutcomeReason, v))
+}
+
+// SigningOutcomeReasonHasPrefix applies the HasPrefix predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonHasPrefix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasPrefix(FieldSigningOutcomeReason, v))
+}
+
+// SigningOutcomeReasonHasSuffix applies the HasSuffix predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonHasSuffix(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldHasSuffix(FieldSigningOutcomeReason, v))
+}
+
+// SigningOutcomeReasonIsNil applies the IsNil predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldSigningOutcomeReason))
+}
+
+// SigningOutcomeReasonNotNil applies the NotNil predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldSigningOutcomeReason))
+}
+
+// SigningOutcomeReasonEqualFold applies the EqualFold predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonEqualFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEqualFold(FieldSigningOutcomeReason, v))
+}
+
+// SigningOutcomeReasonContainsFold applies the ContainsFold predicate on the "signing_outcome_reason" field.
+func SigningOutcomeReasonContainsFold(v string) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldContainsFold(FieldSigningOutcomeReason, v))
+}
+
+// SigningEndedAtEQ applies the EQ predicate on the "signing_ended_at" field.
+func SigningEndedAtEQ(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldEQ(FieldSigningEndedAt, v))
+}
+
+// SigningEndedAtNEQ applies the NEQ predicate on the "signing_ended_at" field.
+func SigningEndedAtNEQ(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNEQ(FieldSigningEndedAt, v))
+}
+
+// SigningEndedAtIn applies the In predicate on the "signing_ended_at" field.
+func SigningEndedAtIn(vs ...time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIn(FieldSigningEndedAt, vs...))
+}
+
+// SigningEndedAtNotIn applies the NotIn predicate on the "signing_ended_at" field.
+func SigningEndedAtNotIn(vs ...time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotIn(FieldSigningEndedAt, vs...))
+}
+
+// SigningEndedAtGT applies the GT predicate on the "signing_ended_at" field.
+func SigningEndedAtGT(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGT(FieldSigningEndedAt, v))
+}
+
+// SigningEndedAtGTE applies the GTE predicate on the "signing_ended_at" field.
+func SigningEndedAtGTE(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldGTE(FieldSigningEndedAt, v))
+}
+
+// SigningEndedAtLT applies the LT predicate on the "signing_ended_at" field.
+func SigningEndedAtLT(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLT(FieldSigningEndedAt, v))
+}
+
+// SigningEndedAtLTE applies the LTE predicate on the "signing_ended_at" field.
+func SigningEndedAtLTE(v time.Time) predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldLTE(FieldSigningEndedAt, v))
+}
+
+// SigningEndedAtIsNil applies the IsNil predicate on the "signing_ended_at" field.
+func SigningEndedAtIsNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldIsNull(FieldSigningEndedAt))
+}
+
+// SigningEndedAtNotNil applies the NotNil predicate on the "signing_ended_at" field.
+func SigningEndedAtNotNil() predicate.LeaveRequest {
+	return predicate.LeaveRequest(sql.FieldNotNull(FieldSigningEndedAt))
+}
+
 // HasAbsenceType applies the HasEdge predicate on the "absence_type" edge.
 func HasAbsenceType() predicate.LeaveRequest {
 	return predicate.LeaveRequest(func(s *sql.Selector) {
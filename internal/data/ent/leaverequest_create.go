@@ -14,6 +14,9 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+	"github.com/go-tangra/go-tangra-hr/internal/recurrence"
 )
 
 // LeaveRequestCreate is the builder for creating a LeaveRequest entity.
@@ -174,6 +177,48 @@ func (_c *LeaveRequestCreate) SetEndDate(v time.Time) *LeaveRequestCreate {
 	return _c
 }
 
+// SetStartDayPart sets the "start_day_part" field.
+func (_c *LeaveRequestCreate) SetStartDayPart(v leaverequest.StartDayPart) *LeaveRequestCreate {
+	_c.mutation.SetStartDayPart(v)
+	return _c
+}
+
+// SetNillableStartDayPart sets the "start_day_part" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableStartDayPart(v *leaverequest.StartDayPart) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetStartDayPart(*v)
+	}
+	return _c
+}
+
+// SetEndDayPart sets the "end_day_part" field.
+func (_c *LeaveRequestCreate) SetEndDayPart(v leaverequest.EndDayPart) *LeaveRequestCreate {
+	_c.mutation.SetEndDayPart(v)
+	return _c
+}
+
+// SetNillableEndDayPart sets the "end_day_part" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableEndDayPart(v *leaverequest.EndDayPart) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetEndDayPart(*v)
+	}
+	return _c
+}
+
+// SetHours sets the "hours" field.
+func (_c *LeaveRequestCreate) SetHours(v float64) *LeaveRequestCreate {
+	_c.mutation.SetHours(v)
+	return _c
+}
+
+// SetNillableHours sets the "hours" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableHours(v *float64) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetHours(*v)
+	}
+	return _c
+}
+
 // SetDays sets the "days" field.
 func (_c *LeaveRequestCreate) SetDays(v float64) *LeaveRequestCreate {
 	_c.mutation.SetDays(v)
@@ -312,6 +357,198 @@ func (_c *LeaveRequestCreate) SetNillableDeductedAllowanceID(v *string) *LeaveRe
 	return _c
 }
 
+// SetDeductions sets the "deductions" field.
+func (_c *LeaveRequestCreate) SetDeductions(v []schema.LeaveDeduction) *LeaveRequestCreate {
+	_c.mutation.SetDeductions(v)
+	return _c
+}
+
+// SetHolidayCalendarID sets the "holiday_calendar_id" field.
+func (_c *LeaveRequestCreate) SetHolidayCalendarID(v string) *LeaveRequestCreate {
+	_c.mutation.SetHolidayCalendarID(v)
+	return _c
+}
+
+// SetNillableHolidayCalendarID sets the "holiday_calendar_id" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableHolidayCalendarID(v *string) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetHolidayCalendarID(*v)
+	}
+	return _c
+}
+
+// SetApprovalSteps sets the "approval_steps" field.
+func (_c *LeaveRequestCreate) SetApprovalSteps(v []schema.LeaveApproval) *LeaveRequestCreate {
+	_c.mutation.SetApprovalSteps(v)
+	return _c
+}
+
+// SetApprovalStep sets the "approval_step" field.
+func (_c *LeaveRequestCreate) SetApprovalStep(v int) *LeaveRequestCreate {
+	_c.mutation.SetApprovalStep(v)
+	return _c
+}
+
+// SetNillableApprovalStep sets the "approval_step" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableApprovalStep(v *int) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetApprovalStep(*v)
+	}
+	return _c
+}
+
+// SetApproverID sets the "approver_id" field.
+func (_c *LeaveRequestCreate) SetApproverID(v uint32) *LeaveRequestCreate {
+	_c.mutation.SetApproverID(v)
+	return _c
+}
+
+// SetNillableApproverID sets the "approver_id" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableApproverID(v *uint32) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetApproverID(*v)
+	}
+	return _c
+}
+
+// SetOnBehalfOf sets the "on_behalf_of" field.
+func (_c *LeaveRequestCreate) SetOnBehalfOf(v uint32) *LeaveRequestCreate {
+	_c.mutation.SetOnBehalfOf(v)
+	return _c
+}
+
+// SetNillableOnBehalfOf sets the "on_behalf_of" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableOnBehalfOf(v *uint32) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetOnBehalfOf(*v)
+	}
+	return _c
+}
+
+// SetOnBehalfOfName sets the "on_behalf_of_name" field.
+func (_c *LeaveRequestCreate) SetOnBehalfOfName(v string) *LeaveRequestCreate {
+	_c.mutation.SetOnBehalfOfName(v)
+	return _c
+}
+
+// SetNillableOnBehalfOfName sets the "on_behalf_of_name" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableOnBehalfOfName(v *string) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetOnBehalfOfName(*v)
+	}
+	return _c
+}
+
+// SetAwaitingSince sets the "awaiting_since" field.
+func (_c *LeaveRequestCreate) SetAwaitingSince(v time.Time) *LeaveRequestCreate {
+	_c.mutation.SetAwaitingSince(v)
+	return _c
+}
+
+// SetNillableAwaitingSince sets the "awaiting_since" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableAwaitingSince(v *time.Time) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetAwaitingSince(*v)
+	}
+	return _c
+}
+
+// SetEscalationLevel sets the "escalation_level" field.
+func (_c *LeaveRequestCreate) SetEscalationLevel(v int) *LeaveRequestCreate {
+	_c.mutation.SetEscalationLevel(v)
+	return _c
+}
+
+// SetNillableEscalationLevel sets the "escalation_level" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableEscalationLevel(v *int) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetEscalationLevel(*v)
+	}
+	return _c
+}
+
+// SetPolicyOverride sets the "policy_override" field.
+func (_c *LeaveRequestCreate) SetPolicyOverride(v *policy.Override) *LeaveRequestCreate {
+	_c.mutation.SetPolicyOverride(v)
+	return _c
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_c *LeaveRequestCreate) SetAttachmentCount(v int) *LeaveRequestCreate {
+	_c.mutation.SetAttachmentCount(v)
+	return _c
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableAttachmentCount(v *int) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetAttachmentCount(*v)
+	}
+	return _c
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_c *LeaveRequestCreate) SetSeriesID(v string) *LeaveRequestCreate {
+	_c.mutation.SetSeriesID(v)
+	return _c
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableSeriesID(v *string) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetSeriesID(*v)
+	}
+	return _c
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (_c *LeaveRequestCreate) SetRecurrence(v *recurrence.Rule) *LeaveRequestCreate {
+	_c.mutation.SetRecurrence(v)
+	return _c
+}
+
+// SetSigningOutcome sets the "signing_outcome" field.
+func (_c *LeaveRequestCreate) SetSigningOutcome(v leaverequest.SigningOutcome) *LeaveRequestCreate {
+	_c.mutation.SetSigningOutcome(v)
+	return _c
+}
+
+// SetNillableSigningOutcome sets the "signing_outcome" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableSigningOutcome(v *leaverequest.SigningOutcome) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetSigningOutcome(*v)
+	}
+	return _c
+}
+
+// SetSigningOutcomeReason sets the "signing_outcome_reason" field.
+func (_c *LeaveRequestCreate) SetSigningOutcomeReason(v string) *LeaveRequestCreate {
+	_c.mutation.SetSigningOutcomeReason(v)
+	return _c
+}
+
+// SetNillableSigningOutcomeReason sets the "signing_outcome_reason" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableSigningOutcomeReason(v *string) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetSigningOutcomeReason(*v)
+	}
+	return _c
+}
+
+// SetSigningEndedAt sets the "signing_ended_at" field.
+func (_c *LeaveRequestCreate) SetSigningEndedAt(v time.Time) *LeaveRequestCreate {
+	_c.mutation.SetSigningEndedAt(v)
+	return _c
+}
+
+// SetNillableSigningEndedAt sets the "signing_ended_at" field if the given value is not nil.
+func (_c *LeaveRequestCreate) SetNillableSigningEndedAt(v *time.Time) *LeaveRequestCreate {
+	if v != nil {
+		_c.SetSigningEndedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *LeaveRequestCreate) SetID(v string) *LeaveRequestCreate {
 	_c.mutation.SetID(v)
@@ -376,6 +613,18 @@ func (_c *LeaveRequestCreate) defaults() error {
 		v := leaverequest.DefaultOrgUnitName
 		_c.mutation.SetOrgUnitName(v)
 	}
+	if _, ok := _c.mutation.StartDayPart(); !ok {
+		v := leaverequest.DefaultStartDayPart
+		_c.mutation.SetStartDayPart(v)
+	}
+	if _, ok := _c.mutation.EndDayPart(); !ok {
+		v := leaverequest.DefaultEndDayPart
+		_c.mutation.SetEndDayPart(v)
+	}
+	if _, ok := _c.mutation.Hours(); !ok {
+		v := leaverequest.DefaultHours
+		_c.mutation.SetHours(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := leaverequest.DefaultStatus
 		_c.mutation.SetStatus(v)
@@ -396,6 +645,42 @@ func (_c *LeaveRequestCreate) defaults() error {
 		v := leaverequest.DefaultDeductedAllowanceID
 		_c.mutation.SetDeductedAllowanceID(v)
 	}
+	if _, ok := _c.mutation.HolidayCalendarID(); !ok {
+		v := leaverequest.DefaultHolidayCalendarID
+		_c.mutation.SetHolidayCalendarID(v)
+	}
+	if _, ok := _c.mutation.ApprovalStep(); !ok {
+		v := leaverequest.DefaultApprovalStep
+		_c.mutation.SetApprovalStep(v)
+	}
+	if _, ok := _c.mutation.ApproverID(); !ok {
+		v := leaverequest.DefaultApproverID
+		_c.mutation.SetApproverID(v)
+	}
+	if _, ok := _c.mutation.OnBehalfOf(); !ok {
+		v := leaverequest.DefaultOnBehalfOf
+		_c.mutation.SetOnBehalfOf(v)
+	}
+	if _, ok := _c.mutation.OnBehalfOfName(); !ok {
+		v := leaverequest.DefaultOnBehalfOfName
+		_c.mutation.SetOnBehalfOfName(v)
+	}
+	if _, ok := _c.mutation.EscalationLevel(); !ok {
+		v := leaverequest.DefaultEscalationLevel
+		_c.mutation.SetEscalationLevel(v)
+	}
+	if _, ok := _c.mutation.AttachmentCount(); !ok {
+		v := leaverequest.DefaultAttachmentCount
+		_c.mutation.SetAttachmentCount(v)
+	}
+	if _, ok := _c.mutation.SeriesID(); !ok {
+		v := leaverequest.DefaultSeriesID
+		_c.mutation.SetSeriesID(v)
+	}
+	if _, ok := _c.mutation.SigningOutcomeReason(); !ok {
+		v := leaverequest.DefaultSigningOutcomeReason
+		_c.mutation.SetSigningOutcomeReason(v)
+	}
 	return nil
 }
 
@@ -418,6 +703,25 @@ func (_c *LeaveRequestCreate) check() error {
 	if _, ok := _c.mutation.EndDate(); !ok {
 		return &ValidationError{Name: "end_date", err: errors.New(`ent: missing required field "LeaveRequest.end_date"`)}
 	}
+	if _, ok := _c.mutation.StartDayPart(); !ok {
+		return &ValidationError{Name: "start_day_part", err: errors.New(`ent: missing required field "LeaveRequest.start_day_part"`)}
+	}
+	if v, ok := _c.mutation.StartDayPart(); ok {
+		if err := leaverequest.StartDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "start_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.start_day_part": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EndDayPart(); !ok {
+		return &ValidationError{Name: "end_day_part", err: errors.New(`ent: missing required field "LeaveRequest.end_day_part"`)}
+	}
+	if v, ok := _c.mutation.EndDayPart(); ok {
+		if err := leaverequest.EndDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "end_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.end_day_part": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Hours(); !ok {
+		return &ValidationError{Name: "hours", err: errors.New(`ent: missing required field "LeaveRequest.hours"`)}
+	}
 	if _, ok := _c.mutation.Days(); !ok {
 		return &ValidationError{Name: "days", err: errors.New(`ent: missing required field "LeaveRequest.days"`)}
 	}
@@ -429,6 +733,33 @@ func (_c *LeaveRequestCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.ApprovalStep(); !ok {
+		return &ValidationError{Name: "approval_step", err: errors.New(`ent: missing required field "LeaveRequest.approval_step"`)}
+	}
+	if _, ok := _c.mutation.ApproverID(); !ok {
+		return &ValidationError{Name: "approver_id", err: errors.New(`ent: missing required field "LeaveRequest.approver_id"`)}
+	}
+	if _, ok := _c.mutation.EscalationLevel(); !ok {
+		return &ValidationError{Name: "escalation_level", err: errors.New(`ent: missing required field "LeaveRequest.escalation_level"`)}
+	}
+	if _, ok := _c.mutation.AttachmentCount(); !ok {
+		return &ValidationError{Name: "attachment_count", err: errors.New(`ent: missing required field "LeaveRequest.attachment_count"`)}
+	}
+	if v, ok := _c.mutation.AttachmentCount(); ok {
+		if err := leaverequest.AttachmentCountValidator(v); err != nil {
+			return &ValidationError{Name: "attachment_count", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.attachment_count": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Recurrence(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "recurrence", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.recurrence": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SigningOutcome(); ok {
+		if err := leaverequest.SigningOutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "signing_outcome", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.signing_outcome": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := leaverequest.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.id": %w`, err)}
@@ -521,6 +852,18 @@ func (_c *LeaveRequestCreate) createSpec() (*LeaveRequest, *sqlgraph.CreateSpec)
 		_spec.SetField(leaverequest.FieldEndDate, field.TypeTime, value)
 		_node.EndDate = value
 	}
+	if value, ok := _c.mutation.StartDayPart(); ok {
+		_spec.SetField(leaverequest.FieldStartDayPart, field.TypeEnum, value)
+		_node.StartDayPart = value
+	}
+	if value, ok := _c.mutation.EndDayPart(); ok {
+		_spec.SetField(leaverequest.FieldEndDayPart, field.TypeEnum, value)
+		_node.EndDayPart = value
+	}
+	if value, ok := _c.mutation.Hours(); ok {
+		_spec.SetField(leaverequest.FieldHours, field.TypeFloat64, value)
+		_node.Hours = value
+	}
 	if value, ok := _c.mutation.Days(); ok {
 		_spec.SetField(leaverequest.FieldDays, field.TypeFloat64, value)
 		_node.Days = value
@@ -565,6 +908,70 @@ func (_c *LeaveRequestCreate) createSpec() (*LeaveRequest, *sqlgraph.CreateSpec)
 		_spec.SetField(leaverequest.FieldDeductedAllowanceID, field.TypeString, value)
 		_node.DeductedAllowanceID = value
 	}
+	if value, ok := _c.mutation.Deductions(); ok {
+		_spec.SetField(leaverequest.FieldDeductions, field.TypeJSON, value)
+		_node.Deductions = value
+	}
+	if value, ok := _c.mutation.HolidayCalendarID(); ok {
+		_spec.SetField(leaverequest.FieldHolidayCalendarID, field.TypeString, value)
+		_node.HolidayCalendarID = value
+	}
+	if value, ok := _c.mutation.ApprovalSteps(); ok {
+		_spec.SetField(leaverequest.FieldApprovalSteps, field.TypeJSON, value)
+		_node.ApprovalSteps = value
+	}
+	if value, ok := _c.mutation.ApprovalStep(); ok {
+		_spec.SetField(leaverequest.FieldApprovalStep, field.TypeInt, value)
+		_node.ApprovalStep = value
+	}
+	if value, ok := _c.mutation.ApproverID(); ok {
+		_spec.SetField(leaverequest.FieldApproverID, field.TypeUint32, value)
+		_node.ApproverID = value
+	}
+	if value, ok := _c.mutation.OnBehalfOf(); ok {
+		_spec.SetField(leaverequest.FieldOnBehalfOf, field.TypeUint32, value)
+		_node.OnBehalfOf = value
+	}
+	if value, ok := _c.mutation.OnBehalfOfName(); ok {
+		_spec.SetField(leaverequest.FieldOnBehalfOfName, field.TypeString, value)
+		_node.OnBehalfOfName = value
+	}
+	if value, ok := _c.mutation.AwaitingSince(); ok {
+		_spec.SetField(leaverequest.FieldAwaitingSince, field.TypeTime, value)
+		_node.AwaitingSince = &value
+	}
+	if value, ok := _c.mutation.EscalationLevel(); ok {
+		_spec.SetField(leaverequest.FieldEscalationLevel, field.TypeInt, value)
+		_node.EscalationLevel = value
+	}
+	if value, ok := _c.mutation.PolicyOverride(); ok {
+		_spec.SetField(leaverequest.FieldPolicyOverride, field.TypeJSON, value)
+		_node.PolicyOverride = value
+	}
+	if value, ok := _c.mutation.AttachmentCount(); ok {
+		_spec.SetField(leaverequest.FieldAttachmentCount, field.TypeInt, value)
+		_node.AttachmentCount = value
+	}
+	if value, ok := _c.mutation.SeriesID(); ok {
+		_spec.SetField(leaverequest.FieldSeriesID, field.TypeString, value)
+		_node.SeriesID = value
+	}
+	if value, ok := _c.mutation.Recurrence(); ok {
+		_spec.SetField(leaverequest.FieldRecurrence, field.TypeJSON, value)
+		_node.Recurrence = value
+	}
+	if value, ok := _c.mutation.SigningOutcome(); ok {
+		_spec.SetField(leaverequest.FieldSigningOutcome, field.TypeEnum, value)
+		_node.SigningOutcome = &value
+	}
+	if value, ok := _c.mutation.SigningOutcomeReason(); ok {
+		_spec.SetField(leaverequest.FieldSigningOutcomeReason, field.TypeString, value)
+		_node.SigningOutcomeReason = value
+	}
+	if value, ok := _c.mutation.SigningEndedAt(); ok {
+		_spec.SetField(leaverequest.FieldSigningEndedAt, field.TypeTime, value)
+		_node.SigningEndedAt = &value
+	}
 	if nodes := _c.mutation.AbsenceTypeIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -826,6 +1233,48 @@ func (u *LeaveRequestUpsert) UpdateEndDate() *LeaveRequestUpsert {
 	return u
 }
 
+// SetStartDayPart sets the "start_day_part" field.
+func (u *LeaveRequestUpsert) SetStartDayPart(v leaverequest.StartDayPart) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldStartDayPart, v)
+	return u
+}
+
+// UpdateStartDayPart sets the "start_day_part" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateStartDayPart() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldStartDayPart)
+	return u
+}
+
+// SetEndDayPart sets the "end_day_part" field.
+func (u *LeaveRequestUpsert) SetEndDayPart(v leaverequest.EndDayPart) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldEndDayPart, v)
+	return u
+}
+
+// UpdateEndDayPart sets the "end_day_part" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateEndDayPart() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldEndDayPart)
+	return u
+}
+
+// SetHours sets the "hours" field.
+func (u *LeaveRequestUpsert) SetHours(v float64) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldHours, v)
+	return u
+}
+
+// UpdateHours sets the "hours" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateHours() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldHours)
+	return u
+}
+
+// AddHours adds v to the "hours" field.
+func (u *LeaveRequestUpsert) AddHours(v float64) *LeaveRequestUpsert {
+	u.Add(leaverequest.FieldHours, v)
+	return u
+}
+
 // SetDays sets the "days" field.
 func (u *LeaveRequestUpsert) SetDays(v float64) *LeaveRequestUpsert {
 	u.Set(leaverequest.FieldDays, v)
@@ -1024,75 +1473,369 @@ func (u *LeaveRequestUpsert) ClearDeductedAllowanceID() *LeaveRequestUpsert {
 	return u
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
-// Using this option is equivalent to using:
-//
-//	client.LeaveRequest.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//			sql.ResolveWith(func(u *sql.UpdateSet) {
-//				u.SetIgnore(leaverequest.FieldID)
-//			}),
-//		).
-//		Exec(ctx)
-func (u *LeaveRequestUpsertOne) UpdateNewValues() *LeaveRequestUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		if _, exists := u.create.mutation.ID(); exists {
-			s.SetIgnore(leaverequest.FieldID)
-		}
-		if _, exists := u.create.mutation.CreateTime(); exists {
-			s.SetIgnore(leaverequest.FieldCreateTime)
-		}
-		if _, exists := u.create.mutation.TenantID(); exists {
-			s.SetIgnore(leaverequest.FieldTenantID)
-		}
-	}))
+// SetDeductions sets the "deductions" field.
+func (u *LeaveRequestUpsert) SetDeductions(v []schema.LeaveDeduction) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldDeductions, v)
 	return u
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.LeaveRequest.Create().
-//	    OnConflict(sql.ResolveWithIgnore()).
-//	    Exec(ctx)
-func (u *LeaveRequestUpsertOne) Ignore() *LeaveRequestUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+// UpdateDeductions sets the "deductions" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateDeductions() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldDeductions)
 	return u
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *LeaveRequestUpsertOne) DoNothing() *LeaveRequestUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+// ClearDeductions clears the value of the "deductions" field.
+func (u *LeaveRequestUpsert) ClearDeductions() *LeaveRequestUpsert {
+	u.SetNull(leaverequest.FieldDeductions)
 	return u
 }
 
-// Update allows overriding fields `UPDATE` values. See the LeaveRequestCreate.OnConflict
-// documentation for more info.
-func (u *LeaveRequestUpsertOne) Update(set func(*LeaveRequestUpsert)) *LeaveRequestUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&LeaveRequestUpsert{UpdateSet: update})
-	}))
+// SetHolidayCalendarID sets the "holiday_calendar_id" field.
+func (u *LeaveRequestUpsert) SetHolidayCalendarID(v string) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldHolidayCalendarID, v)
 	return u
 }
 
-// SetCreateBy sets the "create_by" field.
-func (u *LeaveRequestUpsertOne) SetCreateBy(v uint32) *LeaveRequestUpsertOne {
-	return u.Update(func(s *LeaveRequestUpsert) {
-		s.SetCreateBy(v)
-	})
+// UpdateHolidayCalendarID sets the "holiday_calendar_id" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateHolidayCalendarID() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldHolidayCalendarID)
+	return u
 }
 
-// AddCreateBy adds v to the "create_by" field.
-func (u *LeaveRequestUpsertOne) AddCreateBy(v uint32) *LeaveRequestUpsertOne {
-	return u.Update(func(s *LeaveRequestUpsert) {
-		s.AddCreateBy(v)
-	})
+// ClearHolidayCalendarID clears the value of the "holiday_calendar_id" field.
+func (u *LeaveRequestUpsert) ClearHolidayCalendarID() *LeaveRequestUpsert {
+	u.SetNull(leaverequest.FieldHolidayCalendarID)
+	return u
 }
 
-// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+// SetApprovalSteps sets the "approval_steps" field.
+func (u *LeaveRequestUpsert) SetApprovalSteps(v []schema.LeaveApproval) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldApprovalSteps, v)
+	return u
+}
+
+// UpdateApprovalSteps sets the "approval_steps" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateApprovalSteps() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldApprovalSteps)
+	return u
+}
+
+// ClearApprovalSteps clears the value of the "approval_steps" field.
+func (u *LeaveRequestUpsert) ClearApprovalSteps() *LeaveRequestUpsert {
+	u.SetNull(leaverequest.FieldApprovalSteps)
+	return u
+}
+
+// SetApprovalStep sets the "approval_step" field.
+func (u *LeaveRequestUpsert) SetApprovalStep(v int) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldApprovalStep, v)
+	return u
+}
+
+// UpdateApprovalStep sets the "approval_step" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateApprovalStep() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldApprovalStep)
+	return u
+}
+
+// AddApprovalStep adds v to the "approval_step" field.
+func (u *LeaveRequestUpsert) AddApprovalStep(v int) *LeaveRequestUpsert {
+	u.Add(leaverequest.FieldApprovalStep, v)
+	return u
+}
+
+// SetApproverID sets the "approver_id" field.
+func (u *LeaveRequestUpsert) SetApproverID(v uint32) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldApproverID, v)
+	return u
+}
+
+// UpdateApproverID sets the "approver_id" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateApproverID() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldApproverID)
+	return u
+}
+
+// AddApproverID adds v to the "approver_id" field.
+func (u *LeaveRequestUpsert) AddApproverID(v uint32) *LeaveRequestUpsert {
+	u.Add(leaverequest.FieldApproverID, v)
+	return u
+}
+
+// SetOnBehalfOf sets the "on_behalf_of" field.
+func (u *LeaveRequestUpsert) SetOnBehalfOf(v uint32) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldOnBehalfOf, v)
+	return u
+}
+
+// UpdateOnBehalfOf sets the "on_behalf_of" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateOnBehalfOf() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldOnBehalfOf)
+	return u
+}
+
+// AddOnBehalfOf adds v to the "on_behalf_of" field.
+func (u *LeaveRequestUpsert) AddOnBehalfOf(v uint32) *LeaveRequestUpsert {
+	u.Add(leaverequest.FieldOnBehalfOf, v)
+	return u
+}
+
+// ClearOnBehalfOf clears the value of the "on_behalf_of" field.
+func (u *LeaveRequestUpsert) ClearOnBehalfOf() *LeaveRequestUpsert {
+	u.SetNull(leaverequest.FieldOnBehalfOf)
+	return u
+}
+
+// SetOnBehalfOfName sets the "on_behalf_of_name" field.
+func (u *LeaveRequestUpsert) SetOnBehalfOfName(v string) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldOnBehalfOfName, v)
+	return u
+}
+
+// UpdateOnBehalfOfName sets the "on_behalf_of_name" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateOnBehalfOfName() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldOnBehalfOfName)
+	return u
+}
+
+// ClearOnBehalfOfName clears the value of the "on_behalf_of_name" field.
+func (u *LeaveRequestUpsert) ClearOnBehalfOfName() *LeaveRequestUpsert {
+	u.SetNull(leaverequest.FieldOnBehalfOfName)
+	return u
+}
+
+// SetAwaitingSince sets the "awaiting_since" field.
+func (u *LeaveRequestUpsert) SetAwaitingSince(v time.Time) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldAwaitingSince, v)
+	return u
+}
+
+// UpdateAwaitingSince sets the "awaiting_since" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateAwaitingSince() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldAwaitingSince)
+	return u
+}
+
+// ClearAwaitingSince clears the value of the "awaiting_since" field.
+func (u *LeaveRequestUpsert) ClearAwaitingSince() *LeaveRequestUpsert {
+	u.SetNull(leaverequest.FieldAwaitingSince)
+	return u
+}
+
+// SetEscalationLevel sets the "escalation_level" field.
+func (u *LeaveRequestUpsert) SetEscalationLevel(v int) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldEscalationLevel, v)
+	return u
+}
+
+// UpdateEscalationLevel sets the "escalation_level" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateEscalationLevel() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldEscalationLevel)
+	return u
+}
+
+// AddEscalationLevel adds v to the "escalation_level" field.
+func (u *LeaveRequestUpsert) AddEscalationLevel(v int) *LeaveRequestUpsert {
+	u.Add(leaverequest.FieldEscalationLevel, v)
+	return u
+}
+
+// SetPolicyOverride sets the "policy_override" field.
+func (u *LeaveRequestUpsert) SetPolicyOverride(v *policy.Override) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldPolicyOverride, v)
+	return u
+}
+
+// UpdatePolicyOverride sets the "policy_override" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdatePolicyOverride() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldPolicyOverride)
+	return u
+}
+
+// ClearPolicyOverride clears the value of the "policy_override" field.
+func (u *LeaveRequestUpsert) ClearPolicyOverride() *LeaveRequestUpsert {
+	u.SetNull(leaverequest.FieldPolicyOverride)
+	return u
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (u *LeaveRequestUpsert) SetAttachmentCount(v int) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldAttachmentCount, v)
+	return u
+}
+
+// UpdateAttachmentCount sets the "attachment_count" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateAttachmentCount() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldAttachmentCount)
+	return u
+}
+
+// AddAttachmentCount adds v to the "attachment_count" field.
+func (u *LeaveRequestUpsert) AddAttachmentCount(v int) *LeaveRequestUpsert {
+	u.Add(leaverequest.FieldAttachmentCount, v)
+	return u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (u *LeaveRequestUpsert) SetSeriesID(v string) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldSeriesID, v)
+	return u
+}
+
+// UpdateSeriesID sets the "series_id" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateSeriesID() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldSeriesID)
+	return u
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (u *LeaveRequestUpsert) ClearSeriesID() *LeaveRequestUpsert {
+	u.SetNull(leaverequest.FieldSeriesID)
+	return u
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (u *LeaveRequestUpsert) SetRecurrence(v *recurrence.Rule) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldRecurrence, v)
+	return u
+}
+
+// UpdateRecurrence sets the "recurrence" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateRecurrence() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldRecurrence)
+	return u
+}
+
+// ClearRecurrence clears the value of the "recurrence" field.
+func (u *LeaveRequestUpsert) ClearRecurrence() *LeaveRequestUpsert {
+	u.SetNull(leaverequest.FieldRecurrence)
+	return u
+}
+
+// SetSigningOutcome sets the "signing_outcome" field.
+func (u *LeaveRequestUpsert) SetSigningOutcome(v leaverequest.SigningOutcome) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldSigningOutcome, v)
+	return u
+}
+
+// UpdateSigningOutcome sets the "signing_outcome" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateSigningOutcome() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldSigningOutcome)
+	return u
+}
+
+// ClearSigningOutcome clears the value of the "signing_outcome" field.
+func (u *LeaveRequestUpsert) ClearSigningOutcome() *LeaveRequestUpsert {
+	u.SetNull(leaverequest.FieldSigningOutcome)
+	return u
+}
+
+// SetSigningOutcomeReason sets the "signing_outcome_reason" field.
+func (u *LeaveRequestUpsert) SetSigningOutcomeReason(v string) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldSigningOutcomeReason, v)
+	return u
+}
+
+// UpdateSigningOutcomeReason sets the "signing_outcome_reason" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateSigningOutcomeReason() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldSigningOutcomeReason)
+	return u
+}
+
+// ClearSigningOutcomeReason clears the value of the "signing_outcome_reason" field.
+func (u *LeaveRequestUpsert) ClearSigningOutcomeReason() *LeaveRequestUpsert {
+	u.SetNull(leaverequest.FieldSigningOutcomeReason)
+	return u
+}
+
+// SetSigningEndedAt sets the "signing_ended_at" field.
+func (u *LeaveRequestUpsert) SetSigningEndedAt(v time.Time) *LeaveRequestUpsert {
+	u.Set(leaverequest.FieldSigningEndedAt, v)
+	return u
+}
+
+// UpdateSigningEndedAt sets the "signing_ended_at" field to the value that was provided on create.
+func (u *LeaveRequestUpsert) UpdateSigningEndedAt() *LeaveRequestUpsert {
+	u.SetExcluded(leaverequest.FieldSigningEndedAt)
+	return u
+}
+
+// ClearSigningEndedAt clears the value of the "signing_ended_at" field.
+func (u *LeaveRequestUpsert) ClearSigningEndedAt() *LeaveRequestUpsert {
+	u.SetNull(leaverequest.FieldSigningEndedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LeaveRequest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(leaverequest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LeaveRequestUpsertOne) UpdateNewValues() *LeaveRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(leaverequest.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(leaverequest.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(leaverequest.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LeaveRequest.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LeaveRequestUpsertOne) Ignore() *LeaveRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LeaveRequestUpsertOne) DoNothing() *LeaveRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LeaveRequestCreate.OnConflict
+// documentation for more info.
+func (u *LeaveRequestUpsertOne) Update(set func(*LeaveRequestUpsert)) *LeaveRequestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LeaveRequestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *LeaveRequestUpsertOne) SetCreateBy(v uint32) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *LeaveRequestUpsertOne) AddCreateBy(v uint32) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
 func (u *LeaveRequestUpsertOne) UpdateCreateBy() *LeaveRequestUpsertOne {
 	return u.Update(func(s *LeaveRequestUpsert) {
 		s.UpdateCreateBy()
@@ -1302,6 +2045,55 @@ func (u *LeaveRequestUpsertOne) UpdateEndDate() *LeaveRequestUpsertOne {
 	})
 }
 
+// SetStartDayPart sets the "start_day_part" field.
+func (u *LeaveRequestUpsertOne) SetStartDayPart(v leaverequest.StartDayPart) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetStartDayPart(v)
+	})
+}
+
+// UpdateStartDayPart sets the "start_day_part" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateStartDayPart() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateStartDayPart()
+	})
+}
+
+// SetEndDayPart sets the "end_day_part" field.
+func (u *LeaveRequestUpsertOne) SetEndDayPart(v leaverequest.EndDayPart) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetEndDayPart(v)
+	})
+}
+
+// UpdateEndDayPart sets the "end_day_part" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateEndDayPart() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateEndDayPart()
+	})
+}
+
+// SetHours sets the "hours" field.
+func (u *LeaveRequestUpsertOne) SetHours(v float64) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetHours(v)
+	})
+}
+
+// AddHours adds v to the "hours" field.
+func (u *LeaveRequestUpsertOne) AddHours(v float64) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.AddHours(v)
+	})
+}
+
+// UpdateHours sets the "hours" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateHours() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateHours()
+	})
+}
+
 // SetDays sets the "days" field.
 func (u *LeaveRequestUpsertOne) SetDays(v float64) *LeaveRequestUpsertOne {
 	return u.Update(func(s *LeaveRequestUpsert) {
@@ -1459,77 +2251,420 @@ func (u *LeaveRequestUpsertOne) SetReviewedAt(v time.Time) *LeaveRequestUpsertOn
 // UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
 func (u *LeaveRequestUpsertOne) UpdateReviewedAt() *LeaveRequestUpsertOne {
 	return u.Update(func(s *LeaveRequestUpsert) {
-		s.UpdateReviewedAt()
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *LeaveRequestUpsertOne) ClearReviewedAt() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *LeaveRequestUpsertOne) SetNotes(v string) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateNotes() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *LeaveRequestUpsertOne) ClearNotes() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *LeaveRequestUpsertOne) SetMetadata(v map[string]interface{}) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateMetadata() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (u *LeaveRequestUpsertOne) ClearMetadata() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearMetadata()
+	})
+}
+
+// SetDeductedAllowanceID sets the "deducted_allowance_id" field.
+func (u *LeaveRequestUpsertOne) SetDeductedAllowanceID(v string) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetDeductedAllowanceID(v)
+	})
+}
+
+// UpdateDeductedAllowanceID sets the "deducted_allowance_id" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateDeductedAllowanceID() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateDeductedAllowanceID()
+	})
+}
+
+// ClearDeductedAllowanceID clears the value of the "deducted_allowance_id" field.
+func (u *LeaveRequestUpsertOne) ClearDeductedAllowanceID() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearDeductedAllowanceID()
+	})
+}
+
+// SetDeductions sets the "deductions" field.
+func (u *LeaveRequestUpsertOne) SetDeductions(v []schema.LeaveDeduction) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetDeductions(v)
+	})
+}
+
+// UpdateDeductions sets the "deductions" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateDeductions() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateDeductions()
+	})
+}
+
+// ClearDeductions clears the value of the "deductions" field.
+func (u *LeaveRequestUpsertOne) ClearDeductions() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearDeductions()
+	})
+}
+
+// SetHolidayCalendarID sets the "holiday_calendar_id" field.
+func (u *LeaveRequestUpsertOne) SetHolidayCalendarID(v string) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetHolidayCalendarID(v)
+	})
+}
+
+// UpdateHolidayCalendarID sets the "holiday_calendar_id" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateHolidayCalendarID() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateHolidayCalendarID()
+	})
+}
+
+// ClearHolidayCalendarID clears the value of the "holiday_calendar_id" field.
+func (u *LeaveRequestUpsertOne) ClearHolidayCalendarID() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearHolidayCalendarID()
+	})
+}
+
+// SetApprovalSteps sets the "approval_steps" field.
+func (u *LeaveRequestUpsertOne) SetApprovalSteps(v []schema.LeaveApproval) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetApprovalSteps(v)
+	})
+}
+
+// UpdateApprovalSteps sets the "approval_steps" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateApprovalSteps() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateApprovalSteps()
+	})
+}
+
+// ClearApprovalSteps clears the value of the "approval_steps" field.
+func (u *LeaveRequestUpsertOne) ClearApprovalSteps() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearApprovalSteps()
+	})
+}
+
+// SetApprovalStep sets the "approval_step" field.
+func (u *LeaveRequestUpsertOne) SetApprovalStep(v int) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetApprovalStep(v)
+	})
+}
+
+// AddApprovalStep adds v to the "approval_step" field.
+func (u *LeaveRequestUpsertOne) AddApprovalStep(v int) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.AddApprovalStep(v)
+	})
+}
+
+// UpdateApprovalStep sets the "approval_step" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateApprovalStep() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateApprovalStep()
+	})
+}
+
+// SetApproverID sets the "approver_id" field.
+func (u *LeaveRequestUpsertOne) SetApproverID(v uint32) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetApproverID(v)
+	})
+}
+
+// AddApproverID adds v to the "approver_id" field.
+func (u *LeaveRequestUpsertOne) AddApproverID(v uint32) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.AddApproverID(v)
+	})
+}
+
+// UpdateApproverID sets the "approver_id" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateApproverID() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateApproverID()
+	})
+}
+
+// SetOnBehalfOf sets the "on_behalf_of" field.
+func (u *LeaveRequestUpsertOne) SetOnBehalfOf(v uint32) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetOnBehalfOf(v)
+	})
+}
+
+// AddOnBehalfOf adds v to the "on_behalf_of" field.
+func (u *LeaveRequestUpsertOne) AddOnBehalfOf(v uint32) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.AddOnBehalfOf(v)
+	})
+}
+
+// UpdateOnBehalfOf sets the "on_behalf_of" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateOnBehalfOf() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateOnBehalfOf()
+	})
+}
+
+// ClearOnBehalfOf clears the value of the "on_behalf_of" field.
+func (u *LeaveRequestUpsertOne) ClearOnBehalfOf() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearOnBehalfOf()
+	})
+}
+
+// SetOnBehalfOfName sets the "on_behalf_of_name" field.
+func (u *LeaveRequestUpsertOne) SetOnBehalfOfName(v string) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetOnBehalfOfName(v)
+	})
+}
+
+// UpdateOnBehalfOfName sets the "on_behalf_of_name" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateOnBehalfOfName() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateOnBehalfOfName()
+	})
+}
+
+// ClearOnBehalfOfName clears the value of the "on_behalf_of_name" field.
+func (u *LeaveRequestUpsertOne) ClearOnBehalfOfName() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearOnBehalfOfName()
+	})
+}
+
+// SetAwaitingSince sets the "awaiting_since" field.
+func (u *LeaveRequestUpsertOne) SetAwaitingSince(v time.Time) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetAwaitingSince(v)
+	})
+}
+
+// UpdateAwaitingSince sets the "awaiting_since" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateAwaitingSince() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateAwaitingSince()
+	})
+}
+
+// ClearAwaitingSince clears the value of the "awaiting_since" field.
+func (u *LeaveRequestUpsertOne) ClearAwaitingSince() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearAwaitingSince()
+	})
+}
+
+// SetEscalationLevel sets the "escalation_level" field.
+func (u *LeaveRequestUpsertOne) SetEscalationLevel(v int) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetEscalationLevel(v)
+	})
+}
+
+// AddEscalationLevel adds v to the "escalation_level" field.
+func (u *LeaveRequestUpsertOne) AddEscalationLevel(v int) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.AddEscalationLevel(v)
+	})
+}
+
+// UpdateEscalationLevel sets the "escalation_level" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateEscalationLevel() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateEscalationLevel()
+	})
+}
+
+// SetPolicyOverride sets the "policy_override" field.
+func (u *LeaveRequestUpsertOne) SetPolicyOverride(v *policy.Override) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetPolicyOverride(v)
+	})
+}
+
+// UpdatePolicyOverride sets the "policy_override" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdatePolicyOverride() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdatePolicyOverride()
+	})
+}
+
+// ClearPolicyOverride clears the value of the "policy_override" field.
+func (u *LeaveRequestUpsertOne) ClearPolicyOverride() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearPolicyOverride()
+	})
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (u *LeaveRequestUpsertOne) SetAttachmentCount(v int) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetAttachmentCount(v)
+	})
+}
+
+// AddAttachmentCount adds v to the "attachment_count" field.
+func (u *LeaveRequestUpsertOne) AddAttachmentCount(v int) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.AddAttachmentCount(v)
+	})
+}
+
+// UpdateAttachmentCount sets the "attachment_count" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateAttachmentCount() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateAttachmentCount()
+	})
+}
+
+// SetSeriesID sets the "series_id" field.
+func (u *LeaveRequestUpsertOne) SetSeriesID(v string) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetSeriesID(v)
+	})
+}
+
+// UpdateSeriesID sets the "series_id" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateSeriesID() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateSeriesID()
+	})
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (u *LeaveRequestUpsertOne) ClearSeriesID() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearSeriesID()
+	})
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (u *LeaveRequestUpsertOne) SetRecurrence(v *recurrence.Rule) *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetRecurrence(v)
+	})
+}
+
+// UpdateRecurrence sets the "recurrence" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateRecurrence() *LeaveRequestUpsertOne {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateRecurrence()
 	})
 }
 
-// ClearReviewedAt clears the value of the "reviewed_at" field.
-func (u *LeaveRequestUpsertOne) ClearReviewedAt() *LeaveRequestUpsertOne {
+// ClearRecurrence clears the value of the "recurrence" field.
+func (u *LeaveRequestUpsertOne) ClearRecurrence() *LeaveRequestUpsertOne {
 	return u.Update(func(s *LeaveRequestUpsert) {
-		s.ClearReviewedAt()
+		s.ClearRecurrence()
 	})
 }
 
-// SetNotes sets the "notes" field.
-func (u *LeaveRequestUpsertOne) SetNotes(v string) *LeaveRequestUpsertOne {
+// SetSigningOutcome sets the "signing_outcome" field.
+func (u *LeaveRequestUpsertOne) SetSigningOutcome(v leaverequest.SigningOutcome) *LeaveRequestUpsertOne {
 	return u.Update(func(s *LeaveRequestUpsert) {
-		s.SetNotes(v)
+		s.SetSigningOutcome(v)
 	})
 }
 
-// UpdateNotes sets the "notes" field to the value that was provided on create.
-func (u *LeaveRequestUpsertOne) UpdateNotes() *LeaveRequestUpsertOne {
+// UpdateSigningOutcome sets the "signing_outcome" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateSigningOutcome() *LeaveRequestUpsertOne {
 	return u.Update(func(s *LeaveRequestUpsert) {
-		s.UpdateNotes()
+		s.UpdateSigningOutcome()
 	})
 }
 
-// ClearNotes clears the value of the "notes" field.
-func (u *LeaveRequestUpsertOne) ClearNotes() *LeaveRequestUpsertOne {
+// ClearSigningOutcome clears the value of the "signing_outcome" field.
+func (u *LeaveRequestUpsertOne) ClearSigningOutcome() *LeaveRequestUpsertOne {
 	return u.Update(func(s *LeaveRequestUpsert) {
-		s.ClearNotes()
+		s.ClearSigningOutcome()
 	})
 }
 
-// SetMetadata sets the "metadata" field.
-func (u *LeaveRequestUpsertOne) SetMetadata(v map[string]interface{}) *LeaveRequestUpsertOne {
+// SetSigningOutcomeReason sets the "signing_outcome_reason" field.
+func (u *LeaveRequestUpsertOne) SetSigningOutcomeReason(v string) *LeaveRequestUpsertOne {
 	return u.Update(func(s *LeaveRequestUpsert) {
-		s.SetMetadata(v)
+		s.SetSigningOutcomeReason(v)
 	})
 }
 
-// UpdateMetadata sets the "metadata" field to the value that was provided on create.
-func (u *LeaveRequestUpsertOne) UpdateMetadata() *LeaveRequestUpsertOne {
+// UpdateSigningOutcomeReason sets the "signing_outcome_reason" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateSigningOutcomeReason() *LeaveRequestUpsertOne {
 	return u.Update(func(s *LeaveRequestUpsert) {
-		s.UpdateMetadata()
+		s.UpdateSigningOutcomeReason()
 	})
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (u *LeaveRequestUpsertOne) ClearMetadata() *LeaveRequestUpsertOne {
+// ClearSigningOutcomeReason clears the value of the "signing_outcome_reason" field.
+func (u *LeaveRequestUpsertOne) ClearSigningOutcomeReason() *LeaveRequestUpsertOne {
 	return u.Update(func(s *LeaveRequestUpsert) {
-		s.ClearMetadata()
+		s.ClearSigningOutcomeReason()
 	})
 }
 
-// SetDeductedAllowanceID sets the "deducted_allowance_id" field.
-func (u *LeaveRequestUpsertOne) SetDeductedAllowanceID(v string) *LeaveRequestUpsertOne {
+// SetSigningEndedAt sets the "signing_ended_at" field.
+func (u *LeaveRequestUpsertOne) SetSigningEndedAt(v time.Time) *LeaveRequestUpsertOne {
 	return u.Update(func(s *LeaveRequestUpsert) {
-		s.SetDeductedAllowanceID(v)
+		s.SetSigningEndedAt(v)
 	})
 }
 
-// UpdateDeductedAllowanceID sets the "deducted_allowance_id" field to the value that was provided on create.
-func (u *LeaveRequestUpsertOne) UpdateDeductedAllowanceID() *LeaveRequestUpsertOne {
+// UpdateSigningEndedAt sets the "signing_ended_at" field to the value that was provided on create.
+func (u *LeaveRequestUpsertOne) UpdateSigningEndedAt() *LeaveRequestUpsertOne {
 	return u.Update(func(s *LeaveRequestUpsert) {
-		s.UpdateDeductedAllowanceID()
+		s.UpdateSigningEndedAt()
 	})
 }
 
-// ClearDeductedAllowanceID clears the value of the "deducted_allowance_id" field.
-func (u *LeaveRequestUpsertOne) ClearDeductedAllowanceID() *LeaveRequestUpsertOne {
+// ClearSigningEndedAt clears the value of the "signing_ended_at" field.
+func (u *LeaveRequestUpsertOne) ClearSigningEndedAt() *LeaveRequestUpsertOne {
 	return u.Update(func(s *LeaveRequestUpsert) {
-		s.ClearDeductedAllowanceID()
+		s.ClearSigningEndedAt()
 	})
 }
 
@@ -1978,6 +3113,55 @@ func (u *LeaveRequestUpsertBulk) UpdateEndDate() *LeaveRequestUpsertBulk {
 	})
 }
 
+// SetStartDayPart sets the "start_day_part" field.
+func (u *LeaveRequestUpsertBulk) SetStartDayPart(v leaverequest.StartDayPart) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetStartDayPart(v)
+	})
+}
+
+// UpdateStartDayPart sets the "start_day_part" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateStartDayPart() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateStartDayPart()
+	})
+}
+
+// SetEndDayPart sets the "end_day_part" field.
+func (u *LeaveRequestUpsertBulk) SetEndDayPart(v leaverequest.EndDayPart) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetEndDayPart(v)
+	})
+}
+
+// UpdateEndDayPart sets the "end_day_part" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateEndDayPart() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateEndDayPart()
+	})
+}
+
+// SetHours sets the "hours" field.
+func (u *LeaveRequestUpsertBulk) SetHours(v float64) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetHours(v)
+	})
+}
+
+// AddHours adds v to the "hours" field.
+func (u *LeaveRequestUpsertBulk) AddHours(v float64) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.AddHours(v)
+	})
+}
+
+// UpdateHours sets the "hours" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateHours() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateHours()
+	})
+}
+
 // SetDays sets the "days" field.
 func (u *LeaveRequestUpsertBulk) SetDays(v float64) *LeaveRequestUpsertBulk {
 	return u.Update(func(s *LeaveRequestUpsert) {
@@ -2209,6 +3393,349 @@ func (u *LeaveRequestUpsertBulk) ClearDeductedAllowanceID() *LeaveRequestUpsertB
 	})
 }
 
+// SetDeductions sets the "deductions" field.
+func (u *LeaveRequestUpsertBulk) SetDeductions(v []schema.LeaveDeduction) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetDeductions(v)
+	})
+}
+
+// UpdateDeductions sets the "deductions" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateDeductions() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateDeductions()
+	})
+}
+
+// ClearDeductions clears the value of the "deductions" field.
+func (u *LeaveRequestUpsertBulk) ClearDeductions() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearDeductions()
+	})
+}
+
+// SetHolidayCalendarID sets the "holiday_calendar_id" field.
+func (u *LeaveRequestUpsertBulk) SetHolidayCalendarID(v string) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetHolidayCalendarID(v)
+	})
+}
+
+// UpdateHolidayCalendarID sets the "holiday_calendar_id" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateHolidayCalendarID() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateHolidayCalendarID()
+	})
+}
+
+// ClearHolidayCalendarID clears the value of the "holiday_calendar_id" field.
+func (u *LeaveRequestUpsertBulk) ClearHolidayCalendarID() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearHolidayCalendarID()
+	})
+}
+
+// SetApprovalSteps sets the "approval_steps" field.
+func (u *LeaveRequestUpsertBulk) SetApprovalSteps(v []schema.LeaveApproval) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetApprovalSteps(v)
+	})
+}
+
+// UpdateApprovalSteps sets the "approval_steps" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateApprovalSteps() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateApprovalSteps()
+	})
+}
+
+// ClearApprovalSteps clears the value of the "approval_steps" field.
+func (u *LeaveRequestUpsertBulk) ClearApprovalSteps() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearApprovalSteps()
+	})
+}
+
+// SetApprovalStep sets the "approval_step" field.
+func (u *LeaveRequestUpsertBulk) SetApprovalStep(v int) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetApprovalStep(v)
+	})
+}
+
+// AddApprovalStep adds v to the "approval_step" field.
+func (u *LeaveRequestUpsertBulk) AddApprovalStep(v int) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.AddApprovalStep(v)
+	})
+}
+
+// UpdateApprovalStep sets the "approval_step" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateApprovalStep() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateApprovalStep()
+	})
+}
+
+// SetApproverID sets the "approver_id" field.
+func (u *LeaveRequestUpsertBulk) SetApproverID(v uint32) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetApproverID(v)
+	})
+}
+
+// AddApproverID adds v to the "approver_id" field.
+func (u *LeaveRequestUpsertBulk) AddApproverID(v uint32) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.AddApproverID(v)
+	})
+}
+
+// UpdateApproverID sets the "approver_id" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateApproverID() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateApproverID()
+	})
+}
+
+// SetOnBehalfOf sets the "on_behalf_of" field.
+func (u *LeaveRequestUpsertBulk) SetOnBehalfOf(v uint32) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetOnBehalfOf(v)
+	})
+}
+
+// AddOnBehalfOf adds v to the "on_behalf_of" field.
+func (u *LeaveRequestUpsertBulk) AddOnBehalfOf(v uint32) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.AddOnBehalfOf(v)
+	})
+}
+
+// UpdateOnBehalfOf sets the "on_behalf_of" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateOnBehalfOf() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateOnBehalfOf()
+	})
+}
+
+// ClearOnBehalfOf clears the value of the "on_behalf_of" field.
+func (u *LeaveRequestUpsertBulk) ClearOnBehalfOf() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearOnBehalfOf()
+	})
+}
+
+// SetOnBehalfOfName sets the "on_behalf_of_name" field.
+func (u *LeaveRequestUpsertBulk) SetOnBehalfOfName(v string) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetOnBehalfOfName(v)
+	})
+}
+
+// UpdateOnBehalfOfName sets the "on_behalf_of_name" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateOnBehalfOfName() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateOnBehalfOfName()
+	})
+}
+
+// ClearOnBehalfOfName clears the value of the "on_behalf_of_name" field.
+func (u *LeaveRequestUpsertBulk) ClearOnBehalfOfName() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearOnBehalfOfName()
+	})
+}
+
+// SetAwaitingSince sets the "awaiting_since" field.
+func (u *LeaveRequestUpsertBulk) SetAwaitingSince(v time.Time) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetAwaitingSince(v)
+	})
+}
+
+// UpdateAwaitingSince sets the "awaiting_since" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateAwaitingSince() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateAwaitingSince()
+	})
+}
+
+// ClearAwaitingSince clears the value of the "awaiting_since" field.
+func (u *LeaveRequestUpsertBulk) ClearAwaitingSince() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearAwaitingSince()
+	})
+}
+
+// SetEscalationLevel sets the "escalation_level" field.
+func (u *LeaveRequestUpsertBulk) SetEscalationLevel(v int) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetEscalationLevel(v)
+	})
+}
+
+// AddEscalationLevel adds v to the "escalation_level" field.
+func (u *LeaveRequestUpsertBulk) AddEscalationLevel(v int) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.AddEscalationLevel(v)
+	})
+}
+
+// UpdateEscalationLevel sets the "escalation_level" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateEscalationLevel() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateEscalationLevel()
+	})
+}
+
+// SetPolicyOverride sets the "policy_override" field.
+func (u *LeaveRequestUpsertBulk) SetPolicyOverride(v *policy.Override) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetPolicyOverride(v)
+	})
+}
+
+// UpdatePolicyOverride sets the "policy_override" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdatePolicyOverride() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdatePolicyOverride()
+	})
+}
+
+// ClearPolicyOverride clears the value of the "policy_override" field.
+func (u *LeaveRequestUpsertBulk) ClearPolicyOverride() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearPolicyOverride()
+	})
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (u *LeaveRequestUpsertBulk) SetAttachmentCount(v int) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetAttachmentCount(v)
+	})
+}
+
+// AddAttachmentCount adds v to the "attachment_count" field.
+func (u *LeaveRequestUpsertBulk) AddAttachmentCount(v int) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.AddAttachmentCount(v)
+	})
+}
+
+// UpdateAttachmentCount sets the "attachment_count" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateAttachmentCount() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateAttachmentCount()
+	})
+}
+
+// SetSeriesID sets the "series_id" field.
+func (u *LeaveRequestUpsertBulk) SetSeriesID(v string) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetSeriesID(v)
+	})
+}
+
+// UpdateSeriesID sets the "series_id" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateSeriesID() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateSeriesID()
+	})
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (u *LeaveRequestUpsertBulk) ClearSeriesID() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearSeriesID()
+	})
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (u *LeaveRequestUpsertBulk) SetRecurrence(v *recurrence.Rule) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetRecurrence(v)
+	})
+}
+
+// UpdateRecurrence sets the "recurrence" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateRecurrence() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateRecurrence()
+	})
+}
+
+// ClearRecurrence clears the value of the "recurrence" field.
+func (u *LeaveRequestUpsertBulk) ClearRecurrence() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearRecurrence()
+	})
+}
+
+// SetSigningOutcome sets the "signing_outcome" field.
+func (u *LeaveRequestUpsertBulk) SetSigningOutcome(v leaverequest.SigningOutcome) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetSigningOutcome(v)
+	})
+}
+
+// UpdateSigningOutcome sets the "signing_outcome" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateSigningOutcome() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateSigningOutcome()
+	})
+}
+
+// ClearSigningOutcome clears the value of the "signing_outcome" field.
+func (u *LeaveRequestUpsertBulk) ClearSigningOutcome() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearSigningOutcome()
+	})
+}
+
+// SetSigningOutcomeReason sets the "signing_outcome_reason" field.
+func (u *LeaveRequestUpsertBulk) SetSigningOutcomeReason(v string) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetSigningOutcomeReason(v)
+	})
+}
+
+// UpdateSigningOutcomeReason sets the "signing_outcome_reason" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateSigningOutcomeReason() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateSigningOutcomeReason()
+	})
+}
+
+// ClearSigningOutcomeReason clears the value of the "signing_outcome_reason" field.
+func (u *LeaveRequestUpsertBulk) ClearSigningOutcomeReason() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearSigningOutcomeReason()
+	})
+}
+
+// SetSigningEndedAt sets the "signing_ended_at" field.
+func (u *LeaveRequestUpsertBulk) SetSigningEndedAt(v time.Time) *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.SetSigningEndedAt(v)
+	})
+}
+
+// UpdateSigningEndedAt sets the "signing_ended_at" field to the value that was provided on create.
+func (u *LeaveRequestUpsertBulk) UpdateSigningEndedAt() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.UpdateSigningEndedAt()
+	})
+}
+
+// ClearSigningEndedAt clears the value of the "signing_ended_at" field.
+func (u *LeaveRequestUpsertBulk) ClearSigningEndedAt() *LeaveRequestUpsertBulk {
+	return u.Update(func(s *LeaveRequestUpsert) {
+		s.ClearSigningEndedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *LeaveRequestUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
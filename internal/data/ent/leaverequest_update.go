@@ -10,10 +10,14 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+	"github.com/go-tangra/go-tangra-hr/internal/recurrence"
 )
 
 // LeaveRequestUpdate is the builder for updating LeaveRequest entities.
@@ -247,6 +251,55 @@ func (_u *LeaveRequestUpdate) SetNillableEndDate(v *time.Time) *LeaveRequestUpda
 	return _u
 }
 
+// SetStartDayPart sets the "start_day_part" field.
+func (_u *LeaveRequestUpdate) SetStartDayPart(v leaverequest.StartDayPart) *LeaveRequestUpdate {
+	_u.mutation.SetStartDayPart(v)
+	return _u
+}
+
+// SetNillableStartDayPart sets the "start_day_part" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableStartDayPart(v *leaverequest.StartDayPart) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetStartDayPart(*v)
+	}
+	return _u
+}
+
+// SetEndDayPart sets the "end_day_part" field.
+func (_u *LeaveRequestUpdate) SetEndDayPart(v leaverequest.EndDayPart) *LeaveRequestUpdate {
+	_u.mutation.SetEndDayPart(v)
+	return _u
+}
+
+// SetNillableEndDayPart sets the "end_day_part" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableEndDayPart(v *leaverequest.EndDayPart) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetEndDayPart(*v)
+	}
+	return _u
+}
+
+// SetHours sets the "hours" field.
+func (_u *LeaveRequestUpdate) SetHours(v float64) *LeaveRequestUpdate {
+	_u.mutation.ResetHours()
+	_u.mutation.SetHours(v)
+	return _u
+}
+
+// SetNillableHours sets the "hours" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableHours(v *float64) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetHours(*v)
+	}
+	return _u
+}
+
+// AddHours adds value to the "hours" field.
+func (_u *LeaveRequestUpdate) AddHours(v float64) *LeaveRequestUpdate {
+	_u.mutation.AddHours(v)
+	return _u
+}
+
 // SetDays sets the "days" field.
 func (_u *LeaveRequestUpdate) SetDays(v float64) *LeaveRequestUpdate {
 	_u.mutation.ResetDays()
@@ -461,6 +514,317 @@ func (_u *LeaveRequestUpdate) ClearDeductedAllowanceID() *LeaveRequestUpdate {
 	return _u
 }
 
+// SetDeductions sets the "deductions" field.
+func (_u *LeaveRequestUpdate) SetDeductions(v []schema.LeaveDeduction) *LeaveRequestUpdate {
+	_u.mutation.SetDeductions(v)
+	return _u
+}
+
+// AppendDeductions appends value to the "deductions" field.
+func (_u *LeaveRequestUpdate) AppendDeductions(v []schema.LeaveDeduction) *LeaveRequestUpdate {
+	_u.mutation.AppendDeductions(v)
+	return _u
+}
+
+// ClearDeductions clears the value of the "deductions" field.
+func (_u *LeaveRequestUpdate) ClearDeductions() *LeaveRequestUpdate {
+	_u.mutation.ClearDeductions()
+	return _u
+}
+
+// SetHolidayCalendarID sets the "holiday_calendar_id" field.
+func (_u *LeaveRequestUpdate) SetHolidayCalendarID(v string) *LeaveRequestUpdate {
+	_u.mutation.SetHolidayCalendarID(v)
+	return _u
+}
+
+// SetNillableHolidayCalendarID sets the "holiday_calendar_id" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableHolidayCalendarID(v *string) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetHolidayCalendarID(*v)
+	}
+	return _u
+}
+
+// ClearHolidayCalendarID clears the value of the "holiday_calendar_id" field.
+func (_u *LeaveRequestUpdate) ClearHolidayCalendarID() *LeaveRequestUpdate {
+	_u.mutation.ClearHolidayCalendarID()
+	return _u
+}
+
+// SetApprovalSteps sets the "approval_steps" field.
+func (_u *LeaveRequestUpdate) SetApprovalSteps(v []schema.LeaveApproval) *LeaveRequestUpdate {
+	_u.mutation.SetApprovalSteps(v)
+	return _u
+}
+
+// AppendApprovalSteps appends value to the "approval_steps" field.
+func (_u *LeaveRequestUpdate) AppendApprovalSteps(v []schema.LeaveApproval) *LeaveRequestUpdate {
+	_u.mutation.AppendApprovalSteps(v)
+	return _u
+}
+
+// ClearApprovalSteps clears the value of the "approval_steps" field.
+func (_u *LeaveRequestUpdate) ClearApprovalSteps() *LeaveRequestUpdate {
+	_u.mutation.ClearApprovalSteps()
+	return _u
+}
+
+// SetApprovalStep sets the "approval_step" field.
+func (_u *LeaveRequestUpdate) SetApprovalStep(v int) *LeaveRequestUpdate {
+	_u.mutation.ResetApprovalStep()
+	_u.mutation.SetApprovalStep(v)
+	return _u
+}
+
+// SetNillableApprovalStep sets the "approval_step" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableApprovalStep(v *int) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetApprovalStep(*v)
+	}
+	return _u
+}
+
+// AddApprovalStep adds value to the "approval_step" field.
+func (_u *LeaveRequestUpdate) AddApprovalStep(v int) *LeaveRequestUpdate {
+	_u.mutation.AddApprovalStep(v)
+	return _u
+}
+
+// SetApproverID sets the "approver_id" field.
+func (_u *LeaveRequestUpdate) SetApproverID(v uint32) *LeaveRequestUpdate {
+	_u.mutation.ResetApproverID()
+	_u.mutation.SetApproverID(v)
+	return _u
+}
+
+// SetNillableApproverID sets the "approver_id" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableApproverID(v *uint32) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetApproverID(*v)
+	}
+	return _u
+}
+
+// AddApproverID adds value to the "approver_id" field.
+func (_u *LeaveRequestUpdate) AddApproverID(v int32) *LeaveRequestUpdate {
+	_u.mutation.AddApproverID(v)
+	return _u
+}
+
+// SetOnBehalfOf sets the "on_behalf_of" field.
+func (_u *LeaveRequestUpdate) SetOnBehalfOf(v uint32) *LeaveRequestUpdate {
+	_u.mutation.ResetOnBehalfOf()
+	_u.mutation.SetOnBehalfOf(v)
+	return _u
+}
+
+// SetNillableOnBehalfOf sets the "on_behalf_of" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableOnBehalfOf(v *uint32) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetOnBehalfOf(*v)
+	}
+	return _u
+}
+
+// AddOnBehalfOf adds value to the "on_behalf_of" field.
+func (_u *LeaveRequestUpdate) AddOnBehalfOf(v int32) *LeaveRequestUpdate {
+	_u.mutation.AddOnBehalfOf(v)
+	return _u
+}
+
+// ClearOnBehalfOf clears the value of the "on_behalf_of" field.
+func (_u *LeaveRequestUpdate) ClearOnBehalfOf() *LeaveRequestUpdate {
+	_u.mutation.ClearOnBehalfOf()
+	return _u
+}
+
+// SetOnBehalfOfName sets the "on_behalf_of_name" field.
+func (_u *LeaveRequestUpdate) SetOnBehalfOfName(v string) *LeaveRequestUpdate {
+	_u.mutation.SetOnBehalfOfName(v)
+	return _u
+}
+
+// SetNillableOnBehalfOfName sets the "on_behalf_of_name" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableOnBehalfOfName(v *string) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetOnBehalfOfName(*v)
+	}
+	return _u
+}
+
+// ClearOnBehalfOfName clears the value of the "on_behalf_of_name" field.
+func (_u *LeaveRequestUpdate) ClearOnBehalfOfName() *LeaveRequestUpdate {
+	_u.mutation.ClearOnBehalfOfName()
+	return _u
+}
+
+// SetAwaitingSince sets the "awaiting_since" field.
+func (_u *LeaveRequestUpdate) SetAwaitingSince(v time.Time) *LeaveRequestUpdate {
+	_u.mutation.SetAwaitingSince(v)
+	return _u
+}
+
+// SetNillableAwaitingSince sets the "awaiting_since" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableAwaitingSince(v *time.Time) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetAwaitingSince(*v)
+	}
+	return _u
+}
+
+// ClearAwaitingSince clears the value of the "awaiting_since" field.
+func (_u *LeaveRequestUpdate) ClearAwaitingSince() *LeaveRequestUpdate {
+	_u.mutation.ClearAwaitingSince()
+	return _u
+}
+
+// SetEscalationLevel sets the "escalation_level" field.
+func (_u *LeaveRequestUpdate) SetEscalationLevel(v int) *LeaveRequestUpdate {
+	_u.mutation.ResetEscalationLevel()
+	_u.mutation.SetEscalationLevel(v)
+	return _u
+}
+
+// SetNillableEscalationLevel sets the "escalation_level" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableEscalationLevel(v *int) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetEscalationLevel(*v)
+	}
+	return _u
+}
+
+// AddEscalationLevel adds value to the "escalation_level" field.
+func (_u *LeaveRequestUpdate) AddEscalationLevel(v int) *LeaveRequestUpdate {
+	_u.mutation.AddEscalationLevel(v)
+	return _u
+}
+
+// SetPolicyOverride sets the "policy_override" field.
+func (_u *LeaveRequestUpdate) SetPolicyOverride(v *policy.Override) *LeaveRequestUpdate {
+	_u.mutation.SetPolicyOverride(v)
+	return _u
+}
+
+// ClearPolicyOverride clears the value of the "policy_override" field.
+func (_u *LeaveRequestUpdate) ClearPolicyOverride() *LeaveRequestUpdate {
+	_u.mutation.ClearPolicyOverride()
+	return _u
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_u *LeaveRequestUpdate) SetAttachmentCount(v int) *LeaveRequestUpdate {
+	_u.mutation.ResetAttachmentCount()
+	_u.mutation.SetAttachmentCount(v)
+	return _u
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableAttachmentCount(v *int) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetAttachmentCount(*v)
+	}
+	return _u
+}
+
+// AddAttachmentCount adds value to the "attachment_count" field.
+func (_u *LeaveRequestUpdate) AddAttachmentCount(v int) *LeaveRequestUpdate {
+	_u.mutation.AddAttachmentCount(v)
+	return _u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_u *LeaveRequestUpdate) SetSeriesID(v string) *LeaveRequestUpdate {
+	_u.mutation.SetSeriesID(v)
+	return _u
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableSeriesID(v *string) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetSeriesID(*v)
+	}
+	return _u
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (_u *LeaveRequestUpdate) ClearSeriesID() *LeaveRequestUpdate {
+	_u.mutation.ClearSeriesID()
+	return _u
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (_u *LeaveRequestUpdate) SetRecurrence(v *recurrence.Rule) *LeaveRequestUpdate {
+	_u.mutation.SetRecurrence(v)
+	return _u
+}
+
+// ClearRecurrence clears the value of the "recurrence" field.
+func (_u *LeaveRequestUpdate) ClearRecurrence() *LeaveRequestUpdate {
+	_u.mutation.ClearRecurrence()
+	return _u
+}
+
+// SetSigningOutcome sets the "signing_outcome" field.
+func (_u *LeaveRequestUpdate) SetSigningOutcome(v leaverequest.SigningOutcome) *LeaveRequestUpdate {
+	_u.mutation.SetSigningOutcome(v)
+	return _u
+}
+
+// SetNillableSigningOutcome sets the "signing_outcome" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableSigningOutcome(v *leaverequest.SigningOutcome) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetSigningOutcome(*v)
+	}
+	return _u
+}
+
+// ClearSigningOutcome clears the value of the "signing_outcome" field.
+func (_u *LeaveRequestUpdate) ClearSigningOutcome() *LeaveRequestUpdate {
+	_u.mutation.ClearSigningOutcome()
+	return _u
+}
+
+// SetSigningOutcomeReason sets the "signing_outcome_reason" field.
+func (_u *LeaveRequestUpdate) SetSigningOutcomeReason(v string) *LeaveRequestUpdate {
+	_u.mutation.SetSigningOutcomeReason(v)
+	return _u
+}
+
+// SetNillableSigningOutcomeReason sets the "signing_outcome_reason" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableSigningOutcomeReason(v *string) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetSigningOutcomeReason(*v)
+	}
+	return _u
+}
+
+// ClearSigningOutcomeReason clears the value of the "signing_outcome_reason" field.
+func (_u *LeaveRequestUpdate) ClearSigningOutcomeReason() *LeaveRequestUpdate {
+	_u.mutation.ClearSigningOutcomeReason()
+	return _u
+}
+
+// SetSigningEndedAt sets the "signing_ended_at" field.
+func (_u *LeaveRequestUpdate) SetSigningEndedAt(v time.Time) *LeaveRequestUpdate {
+	_u.mutation.SetSigningEndedAt(v)
+	return _u
+}
+
+// SetNillableSigningEndedAt sets the "signing_ended_at" field if the given value is not nil.
+func (_u *LeaveRequestUpdate) SetNillableSigningEndedAt(v *time.Time) *LeaveRequestUpdate {
+	if v != nil {
+		_u.SetSigningEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearSigningEndedAt clears the value of the "signing_ended_at" field.
+func (_u *LeaveRequestUpdate) ClearSigningEndedAt() *LeaveRequestUpdate {
+	_u.mutation.ClearSigningEndedAt()
+	return _u
+}
+
 // SetAbsenceType sets the "absence_type" edge to the AbsenceType entity.
 func (_u *LeaveRequestUpdate) SetAbsenceType(v *AbsenceType) *LeaveRequestUpdate {
 	return _u.SetAbsenceTypeID(v.ID)
@@ -511,11 +875,36 @@ func (_u *LeaveRequestUpdate) check() error {
 			return &ValidationError{Name: "absence_type_id", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.absence_type_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.StartDayPart(); ok {
+		if err := leaverequest.StartDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "start_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.start_day_part": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EndDayPart(); ok {
+		if err := leaverequest.EndDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "end_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.end_day_part": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := leaverequest.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AttachmentCount(); ok {
+		if err := leaverequest.AttachmentCountValidator(v); err != nil {
+			return &ValidationError{Name: "attachment_count", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.attachment_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Recurrence(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "recurrence", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.recurrence": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SigningOutcome(); ok {
+		if err := leaverequest.SigningOutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "signing_outcome", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.signing_outcome": %w`, err)}
+		}
+	}
 	if _u.mutation.AbsenceTypeCleared() && len(_u.mutation.AbsenceTypeIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "LeaveRequest.absence_type"`)
 	}
@@ -606,6 +995,18 @@ func (_u *LeaveRequestUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.EndDate(); ok {
 		_spec.SetField(leaverequest.FieldEndDate, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.StartDayPart(); ok {
+		_spec.SetField(leaverequest.FieldStartDayPart, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EndDayPart(); ok {
+		_spec.SetField(leaverequest.FieldEndDayPart, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Hours(); ok {
+		_spec.SetField(leaverequest.FieldHours, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHours(); ok {
+		_spec.AddField(leaverequest.FieldHours, field.TypeFloat64, value)
+	}
 	if value, ok := _u.mutation.Days(); ok {
 		_spec.SetField(leaverequest.FieldDays, field.TypeFloat64, value)
 	}
@@ -672,6 +1073,115 @@ func (_u *LeaveRequestUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.DeductedAllowanceIDCleared() {
 		_spec.ClearField(leaverequest.FieldDeductedAllowanceID, field.TypeString)
 	}
+	if value, ok := _u.mutation.Deductions(); ok {
+		_spec.SetField(leaverequest.FieldDeductions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDeductions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, leaverequest.FieldDeductions, value)
+		})
+	}
+	if _u.mutation.DeductionsCleared() {
+		_spec.ClearField(leaverequest.FieldDeductions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HolidayCalendarID(); ok {
+		_spec.SetField(leaverequest.FieldHolidayCalendarID, field.TypeString, value)
+	}
+	if _u.mutation.HolidayCalendarIDCleared() {
+		_spec.ClearField(leaverequest.FieldHolidayCalendarID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApprovalSteps(); ok {
+		_spec.SetField(leaverequest.FieldApprovalSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedApprovalSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, leaverequest.FieldApprovalSteps, value)
+		})
+	}
+	if _u.mutation.ApprovalStepsCleared() {
+		_spec.ClearField(leaverequest.FieldApprovalSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ApprovalStep(); ok {
+		_spec.SetField(leaverequest.FieldApprovalStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedApprovalStep(); ok {
+		_spec.AddField(leaverequest.FieldApprovalStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ApproverID(); ok {
+		_spec.SetField(leaverequest.FieldApproverID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedApproverID(); ok {
+		_spec.AddField(leaverequest.FieldApproverID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.OnBehalfOf(); ok {
+		_spec.SetField(leaverequest.FieldOnBehalfOf, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedOnBehalfOf(); ok {
+		_spec.AddField(leaverequest.FieldOnBehalfOf, field.TypeUint32, value)
+	}
+	if _u.mutation.OnBehalfOfCleared() {
+		_spec.ClearField(leaverequest.FieldOnBehalfOf, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.OnBehalfOfName(); ok {
+		_spec.SetField(leaverequest.FieldOnBehalfOfName, field.TypeString, value)
+	}
+	if _u.mutation.OnBehalfOfNameCleared() {
+		_spec.ClearField(leaverequest.FieldOnBehalfOfName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AwaitingSince(); ok {
+		_spec.SetField(leaverequest.FieldAwaitingSince, field.TypeTime, value)
+	}
+	if _u.mutation.AwaitingSinceCleared() {
+		_spec.ClearField(leaverequest.FieldAwaitingSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EscalationLevel(); ok {
+		_spec.SetField(leaverequest.FieldEscalationLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEscalationLevel(); ok {
+		_spec.AddField(leaverequest.FieldEscalationLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PolicyOverride(); ok {
+		_spec.SetField(leaverequest.FieldPolicyOverride, field.TypeJSON, value)
+	}
+	if _u.mutation.PolicyOverrideCleared() {
+		_spec.ClearField(leaverequest.FieldPolicyOverride, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AttachmentCount(); ok {
+		_spec.SetField(leaverequest.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttachmentCount(); ok {
+		_spec.AddField(leaverequest.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SeriesID(); ok {
+		_spec.SetField(leaverequest.FieldSeriesID, field.TypeString, value)
+	}
+	if _u.mutation.SeriesIDCleared() {
+		_spec.ClearField(leaverequest.FieldSeriesID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Recurrence(); ok {
+		_spec.SetField(leaverequest.FieldRecurrence, field.TypeJSON, value)
+	}
+	if _u.mutation.RecurrenceCleared() {
+		_spec.ClearField(leaverequest.FieldRecurrence, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SigningOutcome(); ok {
+		_spec.SetField(leaverequest.FieldSigningOutcome, field.TypeEnum, value)
+	}
+	if _u.mutation.SigningOutcomeCleared() {
+		_spec.ClearField(leaverequest.FieldSigningOutcome, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.SigningOutcomeReason(); ok {
+		_spec.SetField(leaverequest.FieldSigningOutcomeReason, field.TypeString, value)
+	}
+	if _u.mutation.SigningOutcomeReasonCleared() {
+		_spec.ClearField(leaverequest.FieldSigningOutcomeReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.SigningEndedAt(); ok {
+		_spec.SetField(leaverequest.FieldSigningEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SigningEndedAtCleared() {
+		_spec.ClearField(leaverequest.FieldSigningEndedAt, field.TypeTime)
+	}
 	if _u.mutation.AbsenceTypeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -940,6 +1450,55 @@ func (_u *LeaveRequestUpdateOne) SetNillableEndDate(v *time.Time) *LeaveRequestU
 	return _u
 }
 
+// SetStartDayPart sets the "start_day_part" field.
+func (_u *LeaveRequestUpdateOne) SetStartDayPart(v leaverequest.StartDayPart) *LeaveRequestUpdateOne {
+	_u.mutation.SetStartDayPart(v)
+	return _u
+}
+
+// SetNillableStartDayPart sets the "start_day_part" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableStartDayPart(v *leaverequest.StartDayPart) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetStartDayPart(*v)
+	}
+	return _u
+}
+
+// SetEndDayPart sets the "end_day_part" field.
+func (_u *LeaveRequestUpdateOne) SetEndDayPart(v leaverequest.EndDayPart) *LeaveRequestUpdateOne {
+	_u.mutation.SetEndDayPart(v)
+	return _u
+}
+
+// SetNillableEndDayPart sets the "end_day_part" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableEndDayPart(v *leaverequest.EndDayPart) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetEndDayPart(*v)
+	}
+	return _u
+}
+
+// SetHours sets the "hours" field.
+func (_u *LeaveRequestUpdateOne) SetHours(v float64) *LeaveRequestUpdateOne {
+	_u.mutation.ResetHours()
+	_u.mutation.SetHours(v)
+	return _u
+}
+
+// SetNillableHours sets the "hours" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableHours(v *float64) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetHours(*v)
+	}
+	return _u
+}
+
+// AddHours adds value to the "hours" field.
+func (_u *LeaveRequestUpdateOne) AddHours(v float64) *LeaveRequestUpdateOne {
+	_u.mutation.AddHours(v)
+	return _u
+}
+
 // SetDays sets the "days" field.
 func (_u *LeaveRequestUpdateOne) SetDays(v float64) *LeaveRequestUpdateOne {
 	_u.mutation.ResetDays()
@@ -1154,6 +1713,317 @@ func (_u *LeaveRequestUpdateOne) ClearDeductedAllowanceID() *LeaveRequestUpdateO
 	return _u
 }
 
+// SetDeductions sets the "deductions" field.
+func (_u *LeaveRequestUpdateOne) SetDeductions(v []schema.LeaveDeduction) *LeaveRequestUpdateOne {
+	_u.mutation.SetDeductions(v)
+	return _u
+}
+
+// AppendDeductions appends value to the "deductions" field.
+func (_u *LeaveRequestUpdateOne) AppendDeductions(v []schema.LeaveDeduction) *LeaveRequestUpdateOne {
+	_u.mutation.AppendDeductions(v)
+	return _u
+}
+
+// ClearDeductions clears the value of the "deductions" field.
+func (_u *LeaveRequestUpdateOne) ClearDeductions() *LeaveRequestUpdateOne {
+	_u.mutation.ClearDeductions()
+	return _u
+}
+
+// SetHolidayCalendarID sets the "holiday_calendar_id" field.
+func (_u *LeaveRequestUpdateOne) SetHolidayCalendarID(v string) *LeaveRequestUpdateOne {
+	_u.mutation.SetHolidayCalendarID(v)
+	return _u
+}
+
+// SetNillableHolidayCalendarID sets the "holiday_calendar_id" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableHolidayCalendarID(v *string) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetHolidayCalendarID(*v)
+	}
+	return _u
+}
+
+// ClearHolidayCalendarID clears the value of the "holiday_calendar_id" field.
+func (_u *LeaveRequestUpdateOne) ClearHolidayCalendarID() *LeaveRequestUpdateOne {
+	_u.mutation.ClearHolidayCalendarID()
+	return _u
+}
+
+// SetApprovalSteps sets the "approval_steps" field.
+func (_u *LeaveRequestUpdateOne) SetApprovalSteps(v []schema.LeaveApproval) *LeaveRequestUpdateOne {
+	_u.mutation.SetApprovalSteps(v)
+	return _u
+}
+
+// AppendApprovalSteps appends value to the "approval_steps" field.
+func (_u *LeaveRequestUpdateOne) AppendApprovalSteps(v []schema.LeaveApproval) *LeaveRequestUpdateOne {
+	_u.mutation.AppendApprovalSteps(v)
+	return _u
+}
+
+// ClearApprovalSteps clears the value of the "approval_steps" field.
+func (_u *LeaveRequestUpdateOne) ClearApprovalSteps() *LeaveRequestUpdateOne {
+	_u.mutation.ClearApprovalSteps()
+	return _u
+}
+
+// SetApprovalStep sets the "approval_step" field.
+func (_u *LeaveRequestUpdateOne) SetApprovalStep(v int) *LeaveRequestUpdateOne {
+	_u.mutation.ResetApprovalStep()
+	_u.mutation.SetApprovalStep(v)
+	return _u
+}
+
+// SetNillableApprovalStep sets the "approval_step" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableApprovalStep(v *int) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetApprovalStep(*v)
+	}
+	return _u
+}
+
+// AddApprovalStep adds value to the "approval_step" field.
+func (_u *LeaveRequestUpdateOne) AddApprovalStep(v int) *LeaveRequestUpdateOne {
+	_u.mutation.AddApprovalStep(v)
+	return _u
+}
+
+// SetApproverID sets the "approver_id" field.
+func (_u *LeaveRequestUpdateOne) SetApproverID(v uint32) *LeaveRequestUpdateOne {
+	_u.mutation.ResetApproverID()
+	_u.mutation.SetApproverID(v)
+	return _u
+}
+
+// SetNillableApproverID sets the "approver_id" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableApproverID(v *uint32) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetApproverID(*v)
+	}
+	return _u
+}
+
+// AddApproverID adds value to the "approver_id" field.
+func (_u *LeaveRequestUpdateOne) AddApproverID(v int32) *LeaveRequestUpdateOne {
+	_u.mutation.AddApproverID(v)
+	return _u
+}
+
+// SetOnBehalfOf sets the "on_behalf_of" field.
+func (_u *LeaveRequestUpdateOne) SetOnBehalfOf(v uint32) *LeaveRequestUpdateOne {
+	_u.mutation.ResetOnBehalfOf()
+	_u.mutation.SetOnBehalfOf(v)
+	return _u
+}
+
+// SetNillableOnBehalfOf sets the "on_behalf_of" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableOnBehalfOf(v *uint32) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetOnBehalfOf(*v)
+	}
+	return _u
+}
+
+// AddOnBehalfOf adds value to the "on_behalf_of" field.
+func (_u *LeaveRequestUpdateOne) AddOnBehalfOf(v int32) *LeaveRequestUpdateOne {
+	_u.mutation.AddOnBehalfOf(v)
+	return _u
+}
+
+// ClearOnBehalfOf clears the value of the "on_behalf_of" field.
+func (_u *LeaveRequestUpdateOne) ClearOnBehalfOf() *LeaveRequestUpdateOne {
+	_u.mutation.ClearOnBehalfOf()
+	return _u
+}
+
+// SetOnBehalfOfName sets the "on_behalf_of_name" field.
+func (_u *LeaveRequestUpdateOne) SetOnBehalfOfName(v string) *LeaveRequestUpdateOne {
+	_u.mutation.SetOnBehalfOfName(v)
+	return _u
+}
+
+// SetNillableOnBehalfOfName sets the "on_behalf_of_name" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableOnBehalfOfName(v *string) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetOnBehalfOfName(*v)
+	}
+	return _u
+}
+
+// ClearOnBehalfOfName clears the value of the "on_behalf_of_name" field.
+func (_u *LeaveRequestUpdateOne) ClearOnBehalfOfName() *LeaveRequestUpdateOne {
+	_u.mutation.ClearOnBehalfOfName()
+	return _u
+}
+
+// SetAwaitingSince sets the "awaiting_since" field.
+func (_u *LeaveRequestUpdateOne) SetAwaitingSince(v time.Time) *LeaveRequestUpdateOne {
+	_u.mutation.SetAwaitingSince(v)
+	return _u
+}
+
+// SetNillableAwaitingSince sets the "awaiting_since" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableAwaitingSince(v *time.Time) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetAwaitingSince(*v)
+	}
+	return _u
+}
+
+// ClearAwaitingSince clears the value of the "awaiting_since" field.
+func (_u *LeaveRequestUpdateOne) ClearAwaitingSince() *LeaveRequestUpdateOne {
+	_u.mutation.ClearAwaitingSince()
+	return _u
+}
+
+// SetEscalationLevel sets the "escalation_level" field.
+func (_u *LeaveRequestUpdateOne) SetEscalationLevel(v int) *LeaveRequestUpdateOne {
+	_u.mutation.ResetEscalationLevel()
+	_u.mutation.SetEscalationLevel(v)
+	return _u
+}
+
+// SetNillableEscalationLevel sets the "escalation_level" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableEscalationLevel(v *int) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetEscalationLevel(*v)
+	}
+	return _u
+}
+
+// AddEscalationLevel adds value to the "escalation_level" field.
+func (_u *LeaveRequestUpdateOne) AddEscalationLevel(v int) *LeaveRequestUpdateOne {
+	_u.mutation.AddEscalationLevel(v)
+	return _u
+}
+
+// SetPolicyOverride sets the "policy_override" field.
+func (_u *LeaveRequestUpdateOne) SetPolicyOverride(v *policy.Override) *LeaveRequestUpdateOne {
+	_u.mutation.SetPolicyOverride(v)
+	return _u
+}
+
+// ClearPolicyOverride clears the value of the "policy_override" field.
+func (_u *LeaveRequestUpdateOne) ClearPolicyOverride() *LeaveRequestUpdateOne {
+	_u.mutation.ClearPolicyOverride()
+	return _u
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_u *LeaveRequestUpdateOne) SetAttachmentCount(v int) *LeaveRequestUpdateOne {
+	_u.mutation.ResetAttachmentCount()
+	_u.mutation.SetAttachmentCount(v)
+	return _u
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableAttachmentCount(v *int) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetAttachmentCount(*v)
+	}
+	return _u
+}
+
+// AddAttachmentCount adds value to the "attachment_count" field.
+func (_u *LeaveRequestUpdateOne) AddAttachmentCount(v int) *LeaveRequestUpdateOne {
+	_u.mutation.AddAttachmentCount(v)
+	return _u
+}
+
+// SetSeriesID sets the "series_id" field.
+func (_u *LeaveRequestUpdateOne) SetSeriesID(v string) *LeaveRequestUpdateOne {
+	_u.mutation.SetSeriesID(v)
+	return _u
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableSeriesID(v *string) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetSeriesID(*v)
+	}
+	return _u
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (_u *LeaveRequestUpdateOne) ClearSeriesID() *LeaveRequestUpdateOne {
+	_u.mutation.ClearSeriesID()
+	return _u
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (_u *LeaveRequestUpdateOne) SetRecurrence(v *recurrence.Rule) *LeaveRequestUpdateOne {
+	_u.mutation.SetRecurrence(v)
+	return _u
+}
+
+// ClearRecurrence clears the value of the "recurrence" field.
+func (_u *LeaveRequestUpdateOne) ClearRecurrence() *LeaveRequestUpdateOne {
+	_u.mutation.ClearRecurrence()
+	return _u
+}
+
+// SetSigningOutcome sets the "signing_outcome" field.
+func (_u *LeaveRequestUpdateOne) SetSigningOutcome(v leaverequest.SigningOutcome) *LeaveRequestUpdateOne {
+	_u.mutation.SetSigningOutcome(v)
+	return _u
+}
+
+// SetNillableSigningOutcome sets the "signing_outcome" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableSigningOutcome(v *leaverequest.SigningOutcome) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetSigningOutcome(*v)
+	}
+	return _u
+}
+
+// ClearSigningOutcome clears the value of the "signing_outcome" field.
+func (_u *LeaveRequestUpdateOne) ClearSigningOutcome() *LeaveRequestUpdateOne {
+	_u.mutation.ClearSigningOutcome()
+	return _u
+}
+
+// SetSigningOutcomeReason sets the "signing_outcome_reason" field.
+func (_u *LeaveRequestUpdateOne) SetSigningOutcomeReason(v string) *LeaveRequestUpdateOne {
+	_u.mutation.SetSigningOutcomeReason(v)
+	return _u
+}
+
+// SetNillableSigningOutcomeReason sets the "signing_outcome_reason" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableSigningOutcomeReason(v *string) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetSigningOutcomeReason(*v)
+	}
+	return _u
+}
+
+// ClearSigningOutcomeReason clears the value of the "signing_outcome_reason" field.
+func (_u *LeaveRequestUpdateOne) ClearSigningOutcomeReason() *LeaveRequestUpdateOne {
+	_u.mutation.ClearSigningOutcomeReason()
+	return _u
+}
+
+// SetSigningEndedAt sets the "signing_ended_at" field.
+func (_u *LeaveRequestUpdateOne) SetSigningEndedAt(v time.Time) *LeaveRequestUpdateOne {
+	_u.mutation.SetSigningEndedAt(v)
+	return _u
+}
+
+// SetNillableSigningEndedAt sets the "signing_ended_at" field if the given value is not nil.
+func (_u *LeaveRequestUpdateOne) SetNillableSigningEndedAt(v *time.Time) *LeaveRequestUpdateOne {
+	if v != nil {
+		_u.SetSigningEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearSigningEndedAt clears the value of the "signing_ended_at" field.
+func (_u *LeaveRequestUpdateOne) ClearSigningEndedAt() *LeaveRequestUpdateOne {
+	_u.mutation.ClearSigningEndedAt()
+	return _u
+}
+
 // SetAbsenceType sets the "absence_type" edge to the AbsenceType entity.
 func (_u *LeaveRequestUpdateOne) SetAbsenceType(v *AbsenceType) *LeaveRequestUpdateOne {
 	return _u.SetAbsenceTypeID(v.ID)
@@ -1217,11 +2087,36 @@ func (_u *LeaveRequestUpdateOne) check() error {
 			return &ValidationError{Name: "absence_type_id", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.absence_type_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.StartDayPart(); ok {
+		if err := leaverequest.StartDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "start_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.start_day_part": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EndDayPart(); ok {
+		if err := leaverequest.EndDayPartValidator(v); err != nil {
+			return &ValidationError{Name: "end_day_part", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.end_day_part": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Status(); ok {
 		if err := leaverequest.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AttachmentCount(); ok {
+		if err := leaverequest.AttachmentCountValidator(v); err != nil {
+			return &ValidationError{Name: "attachment_count", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.attachment_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Recurrence(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "recurrence", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.recurrence": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SigningOutcome(); ok {
+		if err := leaverequest.SigningOutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "signing_outcome", err: fmt.Errorf(`ent: validator failed for field "LeaveRequest.signing_outcome": %w`, err)}
+		}
+	}
 	if _u.mutation.AbsenceTypeCleared() && len(_u.mutation.AbsenceTypeIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "LeaveRequest.absence_type"`)
 	}
@@ -1329,6 +2224,18 @@ func (_u *LeaveRequestUpdateOne) sqlSave(ctx context.Context) (_node *LeaveReque
 	if value, ok := _u.mutation.EndDate(); ok {
 		_spec.SetField(leaverequest.FieldEndDate, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.StartDayPart(); ok {
+		_spec.SetField(leaverequest.FieldStartDayPart, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.EndDayPart(); ok {
+		_spec.SetField(leaverequest.FieldEndDayPart, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Hours(); ok {
+		_spec.SetField(leaverequest.FieldHours, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHours(); ok {
+		_spec.AddField(leaverequest.FieldHours, field.TypeFloat64, value)
+	}
 	if value, ok := _u.mutation.Days(); ok {
 		_spec.SetField(leaverequest.FieldDays, field.TypeFloat64, value)
 	}
@@ -1395,6 +2302,115 @@ func (_u *LeaveRequestUpdateOne) sqlSave(ctx context.Context) (_node *LeaveReque
 	if _u.mutation.DeductedAllowanceIDCleared() {
 		_spec.ClearField(leaverequest.FieldDeductedAllowanceID, field.TypeString)
 	}
+	if value, ok := _u.mutation.Deductions(); ok {
+		_spec.SetField(leaverequest.FieldDeductions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDeductions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, leaverequest.FieldDeductions, value)
+		})
+	}
+	if _u.mutation.DeductionsCleared() {
+		_spec.ClearField(leaverequest.FieldDeductions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HolidayCalendarID(); ok {
+		_spec.SetField(leaverequest.FieldHolidayCalendarID, field.TypeString, value)
+	}
+	if _u.mutation.HolidayCalendarIDCleared() {
+		_spec.ClearField(leaverequest.FieldHolidayCalendarID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApprovalSteps(); ok {
+		_spec.SetField(leaverequest.FieldApprovalSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedApprovalSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, leaverequest.FieldApprovalSteps, value)
+		})
+	}
+	if _u.mutation.ApprovalStepsCleared() {
+		_spec.ClearField(leaverequest.FieldApprovalSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ApprovalStep(); ok {
+		_spec.SetField(leaverequest.FieldApprovalStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedApprovalStep(); ok {
+		_spec.AddField(leaverequest.FieldApprovalStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ApproverID(); ok {
+		_spec.SetField(leaverequest.FieldApproverID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedApproverID(); ok {
+		_spec.AddField(leaverequest.FieldApproverID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.OnBehalfOf(); ok {
+		_spec.SetField(leaverequest.FieldOnBehalfOf, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedOnBehalfOf(); ok {
+		_spec.AddField(leaverequest.FieldOnBehalfOf, field.TypeUint32, value)
+	}
+	if _u.mutation.OnBehalfOfCleared() {
+		_spec.ClearField(leaverequest.FieldOnBehalfOf, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.OnBehalfOfName(); ok {
+		_spec.SetField(leaverequest.FieldOnBehalfOfName, field.TypeString, value)
+	}
+	if _u.mutation.OnBehalfOfNameCleared() {
+		_spec.ClearField(leaverequest.FieldOnBehalfOfName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AwaitingSince(); ok {
+		_spec.SetField(leaverequest.FieldAwaitingSince, field.TypeTime, value)
+	}
+	if _u.mutation.AwaitingSinceCleared() {
+		_spec.ClearField(leaverequest.FieldAwaitingSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EscalationLevel(); ok {
+		_spec.SetField(leaverequest.FieldEscalationLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEscalationLevel(); ok {
+		_spec.AddField(leaverequest.FieldEscalationLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PolicyOverride(); ok {
+		_spec.SetField(leaverequest.FieldPolicyOverride, field.TypeJSON, value)
+	}
+	if _u.mutation.PolicyOverrideCleared() {
+		_spec.ClearField(leaverequest.FieldPolicyOverride, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AttachmentCount(); ok {
+		_spec.SetField(leaverequest.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttachmentCount(); ok {
+		_spec.AddField(leaverequest.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SeriesID(); ok {
+		_spec.SetField(leaverequest.FieldSeriesID, field.TypeString, value)
+	}
+	if _u.mutation.SeriesIDCleared() {
+		_spec.ClearField(leaverequest.FieldSeriesID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Recurrence(); ok {
+		_spec.SetField(leaverequest.FieldRecurrence, field.TypeJSON, value)
+	}
+	if _u.mutation.RecurrenceCleared() {
+		_spec.ClearField(leaverequest.FieldRecurrence, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SigningOutcome(); ok {
+		_spec.SetField(leaverequest.FieldSigningOutcome, field.TypeEnum, value)
+	}
+	if _u.mutation.SigningOutcomeCleared() {
+		_spec.ClearField(leaverequest.FieldSigningOutcome, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.SigningOutcomeReason(); ok {
+		_spec.SetField(leaverequest.FieldSigningOutcomeReason, field.TypeString, value)
+	}
+	if _u.mutation.SigningOutcomeReasonCleared() {
+		_spec.ClearField(leaverequest.FieldSigningOutcomeReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.SigningEndedAt(); ok {
+		_spec.SetField(leaverequest.FieldSigningEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SigningEndedAtCleared() {
+		_spec.ClearField(leaverequest.FieldSigningEndedAt, field.TypeTime)
+	}
 	if _u.mutation.AbsenceTypeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -29,6 +29,12 @@ var (
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, Comment: "Custom metadata (JSON)"},
 		{Name: "requires_signing", Type: field.TypeBool, Comment: "Whether this type requires document signing", Default: false},
 		{Name: "signing_template_id", Type: field.TypeString, Nullable: true, Comment: "Paperless signing template ID"},
+		{Name: "unit", Type: field.TypeEnum, Comment: "Whether requests are booked in (half) days or in hours", Enums: []string{"days", "hours"}, Default: "days"},
+		{Name: "accrual_policy", Type: field.TypeJSON, Nullable: true, Comment: "How allowance days are earned over the year; unset when granted up front"},
+		{Name: "rollover_policy", Type: field.TypeJSON, Nullable: true, Comment: "How allowances are renewed and unused days carried over at year end"},
+		{Name: "approval_chain", Type: field.TypeJSON, Nullable: true, Comment: "Approval steps requests pass through; unset when a single approval suffices"},
+		{Name: "escalation_policy", Type: field.TypeJSON, Nullable: true, Comment: "How requests awaiting a decision for too long are followed up; unset when they wait indefinitely"},
+		{Name: "requires_attachment", Type: field.TypeJSON, Nullable: true, Comment: "Supporting documents requests need, e.g. a doctor's certificate; unset when none are needed"},
 		{Name: "allowance_pool_id", Type: field.TypeString, Nullable: true, Comment: "FK to AllowancePool — types sharing a pool share one allowance budget"},
 	}
 	// HrAbsenceTypesTable holds the schema information for the "hr_absence_types" table.
@@ -39,7 +45,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "hr_absence_types_hr_allowance_pools_absence_types",
-				Columns:    []*schema.Column{HrAbsenceTypesColumns[18]},
+				Columns:    []*schema.Column{HrAbsenceTypesColumns[24]},
 				RefColumns: []*schema.Column{HrAllowancePoolsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -70,6 +76,8 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Description"},
 		{Name: "color", Type: field.TypeString, Nullable: true, Size: 7, Comment: "Hex color for display"},
 		{Name: "icon", Type: field.TypeString, Nullable: true, Size: 100, Comment: "Lucide icon name"},
+		{Name: "accrual_policy", Type: field.TypeJSON, Nullable: true, Comment: "How pool allowance days are earned over the year; unset when granted up front"},
+		{Name: "rollover_policy", Type: field.TypeJSON, Nullable: true, Comment: "How pool allowances are renewed and unused days carried over at year end"},
 	}
 	// HrAllowancePoolsTable holds the schema information for the "hr_allowance_pools" table.
 	HrAllowancePoolsTable = &schema.Table{
@@ -89,6 +97,89 @@ var (
 			},
 		},
 	}
+	// HrAllowanceTransactionsColumns holds the columns for the "hr_allowance_transactions" table.
+	HrAllowanceTransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "allowance_id", Type: field.TypeString, Comment: "LeaveAllowance the entry applies to (kept after the allowance is deleted)"},
+		{Name: "user_id", Type: field.TypeUint32, Comment: "Owner of the allowance"},
+		{Name: "year", Type: field.TypeInt, Comment: "Allowance year"},
+		{Name: "kind", Type: field.TypeEnum, Comment: "Type of change", Enums: []string{"grant", "deduction", "refund", "carry_over", "adjustment"}},
+		{Name: "days", Type: field.TypeFloat64, Comment: "Signed number of days changed"},
+		{Name: "balance_after", Type: field.TypeFloat64, Comment: "Remaining days after the entry was applied"},
+		{Name: "leave_request_id", Type: field.TypeString, Nullable: true, Comment: "Leave request that caused the entry"},
+		{Name: "actor_id", Type: field.TypeUint32, Nullable: true, Comment: "User who caused the entry; 0 for system actions", Default: 0},
+		{Name: "actor_name", Type: field.TypeString, Nullable: true, Comment: "Denormalized actor display name", Default: ""},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Reason for the entry"},
+	}
+	// HrAllowanceTransactionsTable holds the schema information for the "hr_allowance_transactions" table.
+	HrAllowanceTransactionsTable = &schema.Table{
+		Name:       "hr_allowance_transactions",
+		Columns:    HrAllowanceTransactionsColumns,
+		PrimaryKey: []*schema.Column{HrAllowanceTransactionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_alltx_allowance_time",
+				Unique:  false,
+				Columns: []*schema.Column{HrAllowanceTransactionsColumns[5], HrAllowanceTransactionsColumns[1]},
+			},
+			{
+				Name:    "idx_hr_alltx_tenant_user_year",
+				Unique:  false,
+				Columns: []*schema.Column{HrAllowanceTransactionsColumns[4], HrAllowanceTransactionsColumns[6], HrAllowanceTransactionsColumns[7]},
+			},
+			{
+				Name:    "idx_hr_alltx_leave_request",
+				Unique:  false,
+				Columns: []*schema.Column{HrAllowanceTransactionsColumns[11]},
+			},
+		},
+	}
+	// HrApprovalDelegationsColumns holds the columns for the "hr_approval_delegations" table.
+	HrApprovalDelegationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "delegator_id", Type: field.TypeUint32, Comment: "User ID of the approver who delegates"},
+		{Name: "delegator_name", Type: field.TypeString, Nullable: true, Comment: "Denormalized approver display name", Default: ""},
+		{Name: "delegate_id", Type: field.TypeUint32, Comment: "User ID of the user who decides in place of the approver"},
+		{Name: "delegate_name", Type: field.TypeString, Nullable: true, Comment: "Denormalized delegate display name", Default: ""},
+		{Name: "start_date", Type: field.TypeTime, Comment: "First day of the delegation"},
+		{Name: "end_date", Type: field.TypeTime, Comment: "Last day of the delegation"},
+		{Name: "absence_type_ids", Type: field.TypeJSON, Nullable: true, Comment: "Absence types the delegation covers; empty covers all types"},
+		{Name: "leave_request_id", Type: field.TypeString, Nullable: true, Comment: "Leave request of the approver the delegation was created for; empty when created manually", Default: ""},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Notes"},
+	}
+	// HrApprovalDelegationsTable holds the schema information for the "hr_approval_delegations" table.
+	HrApprovalDelegationsTable = &schema.Table{
+		Name:       "hr_approval_delegations",
+		Columns:    HrApprovalDelegationsColumns,
+		PrimaryKey: []*schema.Column{HrApprovalDelegationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_delegation_tenant_delegator_end",
+				Unique:  false,
+				Columns: []*schema.Column{HrApprovalDelegationsColumns[6], HrApprovalDelegationsColumns[7], HrApprovalDelegationsColumns[12]},
+			},
+			{
+				Name:    "idx_hr_delegation_tenant_delegate_end",
+				Unique:  false,
+				Columns: []*schema.Column{HrApprovalDelegationsColumns[6], HrApprovalDelegationsColumns[9], HrApprovalDelegationsColumns[12]},
+			},
+			{
+				Name:    "idx_hr_delegation_leave_request",
+				Unique:  false,
+				Columns: []*schema.Column{HrApprovalDelegationsColumns[14]},
+			},
+		},
+	}
 	// HrAuditLogsColumns holds the columns for the "hr_audit_logs" table.
 	HrAuditLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -163,6 +254,179 @@ var (
 			},
 		},
 	}
+	// HrBlackoutPeriodsColumns holds the columns for the "hr_blackout_periods" table.
+	HrBlackoutPeriodsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Blackout period name"},
+		{Name: "start_date", Type: field.TypeTime, Comment: "First day of the period"},
+		{Name: "end_date", Type: field.TypeTime, Comment: "Last day of the period"},
+		{Name: "absence_type_ids", Type: field.TypeJSON, Nullable: true, Comment: "Absence types the period applies to; empty for all absence types"},
+		{Name: "org_unit_names", Type: field.TypeJSON, Nullable: true, Comment: "Org units the period applies to; empty for all org units"},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Notes"},
+	}
+	// HrBlackoutPeriodsTable holds the schema information for the "hr_blackout_periods" table.
+	HrBlackoutPeriodsTable = &schema.Table{
+		Name:       "hr_blackout_periods",
+		Columns:    HrBlackoutPeriodsColumns,
+		PrimaryKey: []*schema.Column{HrBlackoutPeriodsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_blackout_tenant_dates",
+				Unique:  false,
+				Columns: []*schema.Column{HrBlackoutPeriodsColumns[6], HrBlackoutPeriodsColumns[8], HrBlackoutPeriodsColumns[9]},
+			},
+		},
+	}
+	// HrCoverageRulesColumns holds the columns for the "hr_coverage_rules" table.
+	HrCoverageRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Rule name"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Description"},
+		{Name: "org_unit_name", Type: field.TypeString, Comment: "Org unit whose members the rule covers"},
+		{Name: "kind", Type: field.TypeEnum, Comment: "max_absent caps the members absent at once; min_present requires members holding the position to be present", Enums: []string{"max_absent", "min_present"}},
+		{Name: "count", Type: field.TypeInt, Comment: "Most members absent, or fewest holders of the position present"},
+		{Name: "position", Type: field.TypeString, Nullable: true, Comment: "Position keyword matched case-insensitively, for min_present rules", Default: ""},
+		{Name: "enforcement", Type: field.TypeEnum, Comment: "Whether breaking the rule warns or refuses the request", Enums: []string{"warn", "block"}, Default: "warn"},
+		{Name: "absence_type_ids", Type: field.TypeJSON, Nullable: true, Comment: "Absence types whose requests are checked; empty for all absence types"},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Whether requests are checked against the rule", Default: true},
+	}
+	// HrCoverageRulesTable holds the schema information for the "hr_coverage_rules" table.
+	HrCoverageRulesTable = &schema.Table{
+		Name:       "hr_coverage_rules",
+		Columns:    HrCoverageRulesColumns,
+		PrimaryKey: []*schema.Column{HrCoverageRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_coverage_rule_tenant_name",
+				Unique:  true,
+				Columns: []*schema.Column{HrCoverageRulesColumns[6], HrCoverageRulesColumns[7]},
+			},
+			{
+				Name:    "idx_hr_coverage_rule_tenant_org",
+				Unique:  false,
+				Columns: []*schema.Column{HrCoverageRulesColumns[6], HrCoverageRulesColumns[9]},
+			},
+		},
+	}
+	// HrEmploymentsColumns holds the columns for the "hr_employments" table.
+	HrEmploymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "user_id", Type: field.TypeUint32, Comment: "FK to Portal User"},
+		{Name: "user_name", Type: field.TypeString, Nullable: true, Comment: "Denormalized user display name", Default: ""},
+		{Name: "start_date", Type: field.TypeTime, Comment: "First day of employment"},
+		{Name: "end_date", Type: field.TypeTime, Nullable: true, Comment: "Last day of employment; unset while employment is ongoing"},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Notes"},
+	}
+	// HrEmploymentsTable holds the schema information for the "hr_employments" table.
+	HrEmploymentsTable = &schema.Table{
+		Name:       "hr_employments",
+		Columns:    HrEmploymentsColumns,
+		PrimaryKey: []*schema.Column{HrEmploymentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_employment_tenant_user",
+				Unique:  true,
+				Columns: []*schema.Column{HrEmploymentsColumns[6], HrEmploymentsColumns[7]},
+			},
+			{
+				Name:    "idx_hr_employment_tenant_end",
+				Unique:  false,
+				Columns: []*schema.Column{HrEmploymentsColumns[6], HrEmploymentsColumns[10]},
+			},
+		},
+	}
+	// HrHolidaysColumns holds the columns for the "hr_holidays" table.
+	HrHolidaysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Holiday name (e.g. 'Christmas Day')"},
+		{Name: "date", Type: field.TypeTime, Comment: "Date of the holiday; only month and day are used for recurring holidays"},
+		{Name: "recurring", Type: field.TypeBool, Comment: "Repeats every year on the same month and day", Default: false},
+		{Name: "calendar_id", Type: field.TypeString, Comment: "FK to HolidayCalendar"},
+	}
+	// HrHolidaysTable holds the schema information for the "hr_holidays" table.
+	HrHolidaysTable = &schema.Table{
+		Name:       "hr_holidays",
+		Columns:    HrHolidaysColumns,
+		PrimaryKey: []*schema.Column{HrHolidaysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "hr_holidays_hr_holiday_calendars_holidays",
+				Columns:    []*schema.Column{HrHolidaysColumns[10]},
+				RefColumns: []*schema.Column{HrHolidayCalendarsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_holiday_calendar_date",
+				Unique:  false,
+				Columns: []*schema.Column{HrHolidaysColumns[10], HrHolidaysColumns[8]},
+			},
+			{
+				Name:    "idx_hr_holiday_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{HrHolidaysColumns[6]},
+			},
+		},
+	}
+	// HrHolidayCalendarsColumns holds the columns for the "hr_holiday_calendars" table.
+	HrHolidayCalendarsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Calendar name (e.g. 'Germany - Bavaria')"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Description"},
+		{Name: "country_code", Type: field.TypeString, Nullable: true, Size: 2, Comment: "ISO 3166-1 alpha-2 country code", Default: ""},
+		{Name: "region", Type: field.TypeString, Nullable: true, Size: 10, Comment: "ISO 3166-2 subdivision code (e.g. 'DE-BY')", Default: ""},
+		{Name: "is_default", Type: field.TypeBool, Comment: "Used for employees whose org unit has no calendar assigned", Default: false},
+		{Name: "org_unit_names", Type: field.TypeJSON, Nullable: true, Comment: "Org units whose employees observe this calendar"},
+	}
+	// HrHolidayCalendarsTable holds the schema information for the "hr_holiday_calendars" table.
+	HrHolidayCalendarsTable = &schema.Table{
+		Name:       "hr_holiday_calendars",
+		Columns:    HrHolidayCalendarsColumns,
+		PrimaryKey: []*schema.Column{HrHolidayCalendarsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_holcal_tenant_name",
+				Unique:  true,
+				Columns: []*schema.Column{HrHolidayCalendarsColumns[6], HrHolidayCalendarsColumns[7]},
+			},
+			{
+				Name:    "idx_hr_holcal_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{HrHolidayCalendarsColumns[6]},
+			},
+		},
+	}
 	// HrLeaveAllowancesColumns holds the columns for the "hr_leave_allowances" table.
 	HrLeaveAllowancesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
@@ -176,8 +440,13 @@ var (
 		{Name: "user_name", Type: field.TypeString, Nullable: true, Comment: "Denormalized user display name", Default: ""},
 		{Name: "year", Type: field.TypeInt, Comment: "Calendar year"},
 		{Name: "total_days", Type: field.TypeFloat64, Comment: "Total allowed days (supports half-days)"},
+		{Name: "annual_days", Type: field.TypeFloat64, Nullable: true, Comment: "Full-year entitlement total_days was pro-rated from; unset when not pro-rated"},
 		{Name: "used_days", Type: field.TypeFloat64, Comment: "Consumed days", Default: 0},
 		{Name: "carried_over", Type: field.TypeFloat64, Comment: "Carried from previous year", Default: 0},
+		{Name: "carry_over_expiry", Type: field.TypeTime, Nullable: true, Comment: "Last day carried-over days can be used; unused carried-over days lapse afterwards"},
+		{Name: "overconsumed_days", Type: field.TypeFloat64, Comment: "Days a leaver used beyond their pro-rated entitlement, to be settled in final pay", Default: 0},
+		{Name: "accrued_days", Type: field.TypeFloat64, Comment: "Days posted by accrual so far (included in total_days)", Default: 0},
+		{Name: "accrual_start", Type: field.TypeTime, Nullable: true, Comment: "Date the user starts accruing; defaults to 1 January of the year"},
 		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Notes"},
 		{Name: "absence_type_id", Type: field.TypeString, Nullable: true, Comment: "FK to AbsenceType (set when not using a pool)"},
 		{Name: "allowance_pool_id", Type: field.TypeString, Nullable: true, Comment: "FK to AllowancePool (set when pool-based allowance)"},
@@ -190,13 +459,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "hr_leave_allowances_hr_absence_types_leave_allowances",
-				Columns:    []*schema.Column{HrLeaveAllowancesColumns[14]},
+				Columns:    []*schema.Column{HrLeaveAllowancesColumns[19]},
 				RefColumns: []*schema.Column{HrAbsenceTypesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "hr_leave_allowances_hr_allowance_pools_leave_allowances",
-				Columns:    []*schema.Column{HrLeaveAllowancesColumns[15]},
+				Columns:    []*schema.Column{HrLeaveAllowancesColumns[20]},
 				RefColumns: []*schema.Column{HrAllowancePoolsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -205,12 +474,12 @@ var (
 			{
 				Name:    "idx_hr_allowance_tenant_user_type_year",
 				Unique:  true,
-				Columns: []*schema.Column{HrLeaveAllowancesColumns[6], HrLeaveAllowancesColumns[7], HrLeaveAllowancesColumns[14], HrLeaveAllowancesColumns[9]},
+				Columns: []*schema.Column{HrLeaveAllowancesColumns[6], HrLeaveAllowancesColumns[7], HrLeaveAllowancesColumns[19], HrLeaveAllowancesColumns[9]},
 			},
 			{
 				Name:    "idx_hr_allowance_tenant_user_pool_year",
 				Unique:  true,
-				Columns: []*schema.Column{HrLeaveAllowancesColumns[6], HrLeaveAllowancesColumns[7], HrLeaveAllowancesColumns[15], HrLeaveAllowancesColumns[9]},
+				Columns: []*schema.Column{HrLeaveAllowancesColumns[6], HrLeaveAllowancesColumns[7], HrLeaveAllowancesColumns[20], HrLeaveAllowancesColumns[9]},
 			},
 			{
 				Name:    "idx_hr_allowance_tenant",
@@ -219,6 +488,164 @@ var (
 			},
 		},
 	}
+	// HrLeaveAmendmentsColumns holds the columns for the "hr_leave_amendments" table.
+	HrLeaveAmendmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "leave_request_id", Type: field.TypeString, Comment: "Leave request the amendment changes"},
+		{Name: "user_id", Type: field.TypeUint32, Comment: "Denormalized requester user ID"},
+		{Name: "kind", Type: field.TypeEnum, Comment: "What the amendment changes", Enums: []string{"date_change", "shorten"}, Default: "date_change"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Amendment status", Enums: []string{"pending", "awaiting_signing", "applied", "rejected", "cancelled"}, Default: "pending"},
+		{Name: "previous_start_date", Type: field.TypeTime, Comment: "Start date of the request before the amendment"},
+		{Name: "previous_end_date", Type: field.TypeTime, Comment: "End date of the request before the amendment"},
+		{Name: "previous_start_day_part", Type: field.TypeEnum, Comment: "Start day part of the request before the amendment", Enums: []string{"am", "pm"}, Default: "am"},
+		{Name: "previous_end_day_part", Type: field.TypeEnum, Comment: "End day part of the request before the amendment", Enums: []string{"am", "pm"}, Default: "pm"},
+		{Name: "previous_days", Type: field.TypeFloat64, Comment: "Days of the request before the amendment"},
+		{Name: "start_date", Type: field.TypeTime, Comment: "New start date"},
+		{Name: "end_date", Type: field.TypeTime, Comment: "New end date"},
+		{Name: "start_day_part", Type: field.TypeEnum, Comment: "New start day part", Enums: []string{"am", "pm"}, Default: "am"},
+		{Name: "end_day_part", Type: field.TypeEnum, Comment: "New end day part", Enums: []string{"am", "pm"}, Default: "pm"},
+		{Name: "hours", Type: field.TypeFloat64, Comment: "New hours for hour-based absence types", Default: 0},
+		{Name: "days", Type: field.TypeFloat64, Comment: "New calculated business days"},
+		{Name: "holiday_calendar_id", Type: field.TypeString, Nullable: true, Comment: "Holiday calendar used to calculate the new days; empty when days were entered manually", Default: ""},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Requester's reason for the amendment"},
+		{Name: "reviewed_by", Type: field.TypeUint32, Nullable: true, Comment: "User ID of reviewer", Default: 0},
+		{Name: "reviewer_name", Type: field.TypeString, Nullable: true, Comment: "Denormalized reviewer display name", Default: ""},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true, Comment: "When the amendment was reviewed"},
+		{Name: "review_notes", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Reviewer's notes"},
+		{Name: "signing_request_id", Type: field.TypeString, Nullable: true, Comment: "Signing submission ID when the new dates must be signed", Default: ""},
+		{Name: "policy_override", Type: field.TypeJSON, Nullable: true, Comment: "Leave policy violations an HR admin overrode, with the justification"},
+	}
+	// HrLeaveAmendmentsTable holds the schema information for the "hr_leave_amendments" table.
+	HrLeaveAmendmentsTable = &schema.Table{
+		Name:       "hr_leave_amendments",
+		Columns:    HrLeaveAmendmentsColumns,
+		PrimaryKey: []*schema.Column{HrLeaveAmendmentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_leave_amendment_request_status",
+				Unique:  false,
+				Columns: []*schema.Column{HrLeaveAmendmentsColumns[7], HrLeaveAmendmentsColumns[10]},
+			},
+			{
+				Name:    "idx_hr_leave_amendment_tenant_status",
+				Unique:  false,
+				Columns: []*schema.Column{HrLeaveAmendmentsColumns[6], HrLeaveAmendmentsColumns[10]},
+			},
+			{
+				Name:    "idx_hr_leave_amendment_signing",
+				Unique:  false,
+				Columns: []*schema.Column{HrLeaveAmendmentsColumns[28]},
+			},
+		},
+	}
+	// HrLeaveAttachmentsColumns holds the columns for the "hr_leave_attachments" table.
+	HrLeaveAttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "leave_request_id", Type: field.TypeString, Comment: "Leave request the document supports"},
+		{Name: "file_name", Type: field.TypeString, Size: 255, Comment: "Name of the uploaded file"},
+		{Name: "content_type", Type: field.TypeString, Size: 100, Comment: "Content type detected from the file contents"},
+		{Name: "size", Type: field.TypeInt64, Comment: "Size in bytes"},
+		{Name: "checksum", Type: field.TypeString, Comment: "Hex-encoded SHA-256 of the contents"},
+		{Name: "storage_key", Type: field.TypeString, Comment: "Key of the contents in the blob storage backend"},
+	}
+	// HrLeaveAttachmentsTable holds the schema information for the "hr_leave_attachments" table.
+	HrLeaveAttachmentsTable = &schema.Table{
+		Name:       "hr_leave_attachments",
+		Columns:    HrLeaveAttachmentsColumns,
+		PrimaryKey: []*schema.Column{HrLeaveAttachmentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_leave_attachment_request",
+				Unique:  false,
+				Columns: []*schema.Column{HrLeaveAttachmentsColumns[7]},
+			},
+			{
+				Name:    "idx_hr_leave_attachment_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{HrLeaveAttachmentsColumns[6]},
+			},
+		},
+	}
+	// HrLeaveCommentsColumns holds the columns for the "hr_leave_comments" table.
+	HrLeaveCommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "leave_request_id", Type: field.TypeString, Comment: "Leave request the comment discusses"},
+		{Name: "author_id", Type: field.TypeUint32, Comment: "User who wrote the comment"},
+		{Name: "author_name", Type: field.TypeString, Nullable: true, Comment: "Denormalized author username"},
+		{Name: "body", Type: field.TypeString, Size: 2147483647, Comment: "Comment text"},
+		{Name: "visibility", Type: field.TypeEnum, Comment: "Who may read the comment: everyone on the request, or HR only", Enums: []string{"shared", "internal"}, Default: "shared"},
+		{Name: "edits", Type: field.TypeJSON, Nullable: true, Comment: "Earlier versions of the comment, oldest first"},
+	}
+	// HrLeaveCommentsTable holds the schema information for the "hr_leave_comments" table.
+	HrLeaveCommentsTable = &schema.Table{
+		Name:       "hr_leave_comments",
+		Columns:    HrLeaveCommentsColumns,
+		PrimaryKey: []*schema.Column{HrLeaveCommentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_leave_comment_request",
+				Unique:  false,
+				Columns: []*schema.Column{HrLeaveCommentsColumns[7], HrLeaveCommentsColumns[3]},
+			},
+			{
+				Name:    "idx_hr_leave_comment_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{HrLeaveCommentsColumns[6]},
+			},
+		},
+	}
+	// HrLeavePoliciesColumns holds the columns for the "hr_leave_policies" table.
+	HrLeavePoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Policy name"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Description"},
+		{Name: "absence_type_id", Type: field.TypeString, Nullable: true, Comment: "Absence type the policy applies to; empty for all absence types", Default: ""},
+		{Name: "org_unit_name", Type: field.TypeString, Nullable: true, Comment: "Org unit the policy applies to; empty for all org units", Default: ""},
+		{Name: "rules", Type: field.TypeJSON, Comment: "Booking rules"},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Whether requests are checked against the policy", Default: true},
+	}
+	// HrLeavePoliciesTable holds the schema information for the "hr_leave_policies" table.
+	HrLeavePoliciesTable = &schema.Table{
+		Name:       "hr_leave_policies",
+		Columns:    HrLeavePoliciesColumns,
+		PrimaryKey: []*schema.Column{HrLeavePoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_leave_policy_tenant_name",
+				Unique:  true,
+				Columns: []*schema.Column{HrLeavePoliciesColumns[6], HrLeavePoliciesColumns[7]},
+			},
+			{
+				Name:    "idx_hr_leave_policy_tenant_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{HrLeavePoliciesColumns[6], HrLeavePoliciesColumns[12]},
+			},
+		},
+	}
 	// HrLeaveRequestsColumns holds the columns for the "hr_leave_requests" table.
 	HrLeaveRequestsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
@@ -234,6 +661,9 @@ var (
 		{Name: "org_unit_name", Type: field.TypeString, Nullable: true, Comment: "Denormalized org unit name for grouping", Default: ""},
 		{Name: "start_date", Type: field.TypeTime, Comment: "Start date of absence"},
 		{Name: "end_date", Type: field.TypeTime, Comment: "End date of absence"},
+		{Name: "start_day_part", Type: field.TypeEnum, Comment: "Half of the first day the absence starts in; pm means afternoon only", Enums: []string{"am", "pm"}, Default: "am"},
+		{Name: "end_day_part", Type: field.TypeEnum, Comment: "Half of the last day the absence ends in; am means morning only", Enums: []string{"am", "pm"}, Default: "pm"},
+		{Name: "hours", Type: field.TypeFloat64, Comment: "Requested hours for hour-based absence types; start and end dates then carry the time of day", Default: 0},
 		{Name: "days", Type: field.TypeFloat64, Comment: "Calculated business days"},
 		{Name: "status", Type: field.TypeEnum, Comment: "Request status", Enums: []string{"pending", "approved", "rejected", "cancelled", "awaiting_signing", "revoked"}, Default: "pending"},
 		{Name: "signing_request_id", Type: field.TypeString, Nullable: true, Comment: "Paperless signing request ID", Default: ""},
@@ -245,6 +675,22 @@ var (
 		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Additional notes"},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true, Comment: "Custom metadata (JSON)"},
 		{Name: "deducted_allowance_id", Type: field.TypeString, Nullable: true, Comment: "ID of the allowance record that was deducted, for accurate refunds", Default: ""},
+		{Name: "deductions", Type: field.TypeJSON, Nullable: true, Comment: "Allowances deducted for this request, one entry per allowance year"},
+		{Name: "holiday_calendar_id", Type: field.TypeString, Nullable: true, Comment: "Holiday calendar used to calculate days; empty when days were entered manually", Default: ""},
+		{Name: "approval_steps", Type: field.TypeJSON, Nullable: true, Comment: "Approval chain steps of this request with their decisions; empty when a single approval suffices"},
+		{Name: "approval_step", Type: field.TypeInt, Comment: "Index of the approval step awaiting a decision", Default: 0},
+		{Name: "approver_id", Type: field.TypeUint32, Comment: "User ID of the approver the request is assigned to; 0 when any approver may decide it", Default: 0},
+		{Name: "on_behalf_of", Type: field.TypeUint32, Nullable: true, Comment: "User ID of the approver the reviewer decided for as their delegate", Default: 0},
+		{Name: "on_behalf_of_name", Type: field.TypeString, Nullable: true, Comment: "Denormalized display name of the approver the reviewer decided for", Default: ""},
+		{Name: "awaiting_since", Type: field.TypeTime, Nullable: true, Comment: "When the request started waiting for its current approval step or signatures"},
+		{Name: "escalation_level", Type: field.TypeInt, Comment: "How far the wait for the current decision has been followed up: 0 none, 1 reminded, 2 escalated", Default: 0},
+		{Name: "policy_override", Type: field.TypeJSON, Nullable: true, Comment: "Leave policy violations an HR admin overrode, with the justification"},
+		{Name: "attachment_count", Type: field.TypeInt, Comment: "Number of supporting documents attached", Default: 0},
+		{Name: "series_id", Type: field.TypeString, Nullable: true, Comment: "Series of recurring requests the request is an occurrence of", Default: ""},
+		{Name: "recurrence", Type: field.TypeJSON, Nullable: true, Comment: "Recurrence rule of the series the request is an occurrence of"},
+		{Name: "signing_outcome", Type: field.TypeEnum, Nullable: true, Comment: "How the last signing submission of the request ended", Enums: []string{"completed", "declined", "expired", "cancelled"}},
+		{Name: "signing_outcome_reason", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Reason the signing service gave for a submission that did not complete", Default: ""},
+		{Name: "signing_ended_at", Type: field.TypeTime, Nullable: true, Comment: "When the last signing submission of the request ended"},
 		{Name: "absence_type_id", Type: field.TypeString, Comment: "FK to AbsenceType"},
 	}
 	// HrLeaveRequestsTable holds the schema information for the "hr_leave_requests" table.
@@ -255,7 +701,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "hr_leave_requests_hr_absence_types_leave_requests",
-				Columns:    []*schema.Column{HrLeaveRequestsColumns[24]},
+				Columns:    []*schema.Column{HrLeaveRequestsColumns[43]},
 				RefColumns: []*schema.Column{HrAbsenceTypesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -269,22 +715,260 @@ var (
 			{
 				Name:    "idx_hr_leavereq_tenant_status",
 				Unique:  false,
-				Columns: []*schema.Column{HrLeaveRequestsColumns[6], HrLeaveRequestsColumns[14]},
+				Columns: []*schema.Column{HrLeaveRequestsColumns[6], HrLeaveRequestsColumns[17]},
+			},
+			{
+				Name:    "idx_hr_leavereq_tenant_approver_status",
+				Unique:  false,
+				Columns: []*schema.Column{HrLeaveRequestsColumns[6], HrLeaveRequestsColumns[31], HrLeaveRequestsColumns[17]},
 			},
 			{
 				Name:    "idx_hr_leavereq_tenant",
 				Unique:  false,
 				Columns: []*schema.Column{HrLeaveRequestsColumns[6]},
 			},
+			{
+				Name:    "idx_hr_leavereq_series_start",
+				Unique:  false,
+				Columns: []*schema.Column{HrLeaveRequestsColumns[38], HrLeaveRequestsColumns[11]},
+			},
+		},
+	}
+	// HrOutboxMessagesColumns holds the columns for the "hr_outbox_messages" table.
+	HrOutboxMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "kind", Type: field.TypeString, Comment: "What delivering the message does, e.g. event or rejection_email"},
+		{Name: "payload", Type: field.TypeJSON, Comment: "Data the message is delivered with, depending on its kind"},
+		{Name: "status", Type: field.TypeEnum, Comment: "Delivery status: pending until delivered, dead once out of attempts", Enums: []string{"pending", "delivered", "dead"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Comment: "Number of failed deliveries", Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Comment: "When the relay delivers the message next"},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Error of the last failed delivery", Default: ""},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true, Comment: "When the message was delivered"},
+	}
+	// HrOutboxMessagesTable holds the schema information for the "hr_outbox_messages" table.
+	HrOutboxMessagesTable = &schema.Table{
+		Name:       "hr_outbox_messages",
+		Columns:    HrOutboxMessagesColumns,
+		PrimaryKey: []*schema.Column{HrOutboxMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_outbox_status_next",
+				Unique:  false,
+				Columns: []*schema.Column{HrOutboxMessagesColumns[7], HrOutboxMessagesColumns[9]},
+			},
+			{
+				Name:    "idx_hr_outbox_tenant_status",
+				Unique:  false,
+				Columns: []*schema.Column{HrOutboxMessagesColumns[4], HrOutboxMessagesColumns[7]},
+			},
+		},
+	}
+	// HrProcessedEventsColumns holds the columns for the "hr_processed_events" table.
+	HrProcessedEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "ID of the event, from its envelope"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "event_type", Type: field.TypeString, Comment: "Type of the event, e.g. submission.completed"},
+		{Name: "source", Type: field.TypeString, Nullable: true, Comment: "Service that published the event", Default: ""},
+	}
+	// HrProcessedEventsTable holds the schema information for the "hr_processed_events" table.
+	HrProcessedEventsTable = &schema.Table{
+		Name:       "hr_processed_events",
+		Columns:    HrProcessedEventsColumns,
+		PrimaryKey: []*schema.Column{HrProcessedEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_processed_events_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{HrProcessedEventsColumns[1]},
+			},
+		},
+	}
+	// HrWebhookDeliveriesColumns holds the columns for the "hr_webhook_deliveries" table.
+	HrWebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "subscription_id", Type: field.TypeString, Comment: "Webhook subscription the event is delivered to"},
+		{Name: "event_id", Type: field.TypeString, Comment: "ID of the event delivered"},
+		{Name: "event_type", Type: field.TypeString, Comment: "Type of the event delivered, e.g. leave.approved"},
+		{Name: "payload", Type: field.TypeJSON, Comment: "Event envelope POSTed to the subscription"},
+		{Name: "attempt", Type: field.TypeInt, Comment: "Number of the attempt at delivering the event, from 1; a redelivery starts again from 1", Default: 1},
+		{Name: "status", Type: field.TypeEnum, Comment: "Outcome of the attempt: pending until made", Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "next_attempt_at", Type: field.TypeTime, Comment: "When the attempt is made"},
+		{Name: "attempted_at", Type: field.TypeTime, Nullable: true, Comment: "When the attempt was made"},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true, Comment: "HTTP status the subscriber answered with; 0 when it did not answer", Default: 0},
+		{Name: "response_body", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Start of the body the subscriber answered with", Default: ""},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Why the attempt failed", Default: ""},
+		{Name: "duration_ms", Type: field.TypeInt64, Nullable: true, Comment: "Time the attempt took in milliseconds", Default: 0},
+		{Name: "redelivered_by", Type: field.TypeUint32, Nullable: true, Comment: "Admin who redelivered the event, for the first attempt of a redelivery"},
+	}
+	// HrWebhookDeliveriesTable holds the schema information for the "hr_webhook_deliveries" table.
+	HrWebhookDeliveriesTable = &schema.Table{
+		Name:       "hr_webhook_deliveries",
+		Columns:    HrWebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{HrWebhookDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_webhook_delivery_status_next",
+				Unique:  false,
+				Columns: []*schema.Column{HrWebhookDeliveriesColumns[10], HrWebhookDeliveriesColumns[11]},
+			},
+			{
+				Name:    "idx_hr_webhook_delivery_subscription_event",
+				Unique:  false,
+				Columns: []*schema.Column{HrWebhookDeliveriesColumns[5], HrWebhookDeliveriesColumns[6]},
+			},
+			{
+				Name:    "idx_hr_webhook_delivery_tenant_created",
+				Unique:  false,
+				Columns: []*schema.Column{HrWebhookDeliveriesColumns[4], HrWebhookDeliveriesColumns[1]},
+			},
+		},
+	}
+	// HrWebhookSubscriptionsColumns holds the columns for the "hr_webhook_subscriptions" table.
+	HrWebhookSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Subscription name"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Description"},
+		{Name: "url", Type: field.TypeString, Size: 2048, Comment: "URL the events are POSTed to"},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true, Comment: "Types of the events delivered, e.g. leave.approved, or leave.* for all leave events; empty for all events"},
+		{Name: "secret", Type: field.TypeString, Comment: "Key the payloads are signed with, HMAC-SHA256"},
+		{Name: "enabled", Type: field.TypeBool, Comment: "Whether events are delivered", Default: true},
+	}
+	// HrWebhookSubscriptionsTable holds the schema information for the "hr_webhook_subscriptions" table.
+	HrWebhookSubscriptionsTable = &schema.Table{
+		Name:       "hr_webhook_subscriptions",
+		Columns:    HrWebhookSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{HrWebhookSubscriptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_webhook_subscription_tenant_name",
+				Unique:  true,
+				Columns: []*schema.Column{HrWebhookSubscriptionsColumns[6], HrWebhookSubscriptionsColumns[7]},
+			},
+			{
+				Name:    "idx_hr_webhook_subscription_tenant_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{HrWebhookSubscriptionsColumns[6], HrWebhookSubscriptionsColumns[12]},
+			},
+		},
+	}
+	// HrWorkSchedulesColumns holds the columns for the "hr_work_schedules" table.
+	HrWorkSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "name", Type: field.TypeString, Size: 255, Comment: "Schedule name"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647, Comment: "Description"},
+		{Name: "day_hours", Type: field.TypeJSON, Comment: "Hours worked on each weekday, indexed Sunday (0) to Saturday (6); 0 marks a non-working day"},
+	}
+	// HrWorkSchedulesTable holds the schema information for the "hr_work_schedules" table.
+	HrWorkSchedulesTable = &schema.Table{
+		Name:       "hr_work_schedules",
+		Columns:    HrWorkSchedulesColumns,
+		PrimaryKey: []*schema.Column{HrWorkSchedulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_worksched_tenant_name",
+				Unique:  true,
+				Columns: []*schema.Column{HrWorkSchedulesColumns[6], HrWorkSchedulesColumns[7]},
+			},
+			{
+				Name:    "idx_hr_worksched_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{HrWorkSchedulesColumns[6]},
+			},
+		},
+	}
+	// HrWorkScheduleAssignmentsColumns holds the columns for the "hr_work_schedule_assignments" table.
+	HrWorkScheduleAssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true, Comment: "Unique identifier"},
+		{Name: "create_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "update_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "create_time", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "update_time", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID", Default: 0},
+		{Name: "user_id", Type: field.TypeUint32, Nullable: true, Comment: "Assigned user; 0 for org unit assignments", Default: 0},
+		{Name: "user_name", Type: field.TypeString, Nullable: true, Comment: "Denormalized user display name", Default: ""},
+		{Name: "org_unit_name", Type: field.TypeString, Nullable: true, Comment: "Assigned org unit; empty for user assignments", Default: ""},
+		{Name: "effective_from", Type: field.TypeTime, Comment: "First day the schedule applies"},
+		{Name: "schedule_id", Type: field.TypeString, Comment: "FK to WorkSchedule"},
+	}
+	// HrWorkScheduleAssignmentsTable holds the schema information for the "hr_work_schedule_assignments" table.
+	HrWorkScheduleAssignmentsTable = &schema.Table{
+		Name:       "hr_work_schedule_assignments",
+		Columns:    HrWorkScheduleAssignmentsColumns,
+		PrimaryKey: []*schema.Column{HrWorkScheduleAssignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "hr_work_schedule_assignments_hr_work_schedules_assignments",
+				Columns:    []*schema.Column{HrWorkScheduleAssignmentsColumns[11]},
+				RefColumns: []*schema.Column{HrWorkSchedulesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_hr_wsa_tenant_user_from",
+				Unique:  false,
+				Columns: []*schema.Column{HrWorkScheduleAssignmentsColumns[6], HrWorkScheduleAssignmentsColumns[7], HrWorkScheduleAssignmentsColumns[10]},
+			},
+			{
+				Name:    "idx_hr_wsa_tenant_org_from",
+				Unique:  false,
+				Columns: []*schema.Column{HrWorkScheduleAssignmentsColumns[6], HrWorkScheduleAssignmentsColumns[9], HrWorkScheduleAssignmentsColumns[10]},
+			},
+			{
+				Name:    "idx_hr_wsa_schedule",
+				Unique:  false,
+				Columns: []*schema.Column{HrWorkScheduleAssignmentsColumns[11]},
+			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		HrAbsenceTypesTable,
 		HrAllowancePoolsTable,
+		HrAllowanceTransactionsTable,
+		HrApprovalDelegationsTable,
 		HrAuditLogsTable,
+		HrBlackoutPeriodsTable,
+		HrCoverageRulesTable,
+		HrEmploymentsTable,
+		HrHolidaysTable,
+		HrHolidayCalendarsTable,
 		HrLeaveAllowancesTable,
+		HrLeaveAmendmentsTable,
+		HrLeaveAttachmentsTable,
+		HrLeaveCommentsTable,
+		HrLeavePoliciesTable,
 		HrLeaveRequestsTable,
+		HrOutboxMessagesTable,
+		HrProcessedEventsTable,
+		HrWebhookDeliveriesTable,
+		HrWebhookSubscriptionsTable,
+		HrWorkSchedulesTable,
+		HrWorkScheduleAssignmentsTable,
 	}
 )
 
@@ -296,16 +980,69 @@ func init() {
 	HrAllowancePoolsTable.Annotation = &entsql.Annotation{
 		Table: "hr_allowance_pools",
 	}
+	HrAllowanceTransactionsTable.Annotation = &entsql.Annotation{
+		Table: "hr_allowance_transactions",
+	}
+	HrApprovalDelegationsTable.Annotation = &entsql.Annotation{
+		Table: "hr_approval_delegations",
+	}
 	HrAuditLogsTable.Annotation = &entsql.Annotation{
 		Table: "hr_audit_logs",
 	}
+	HrBlackoutPeriodsTable.Annotation = &entsql.Annotation{
+		Table: "hr_blackout_periods",
+	}
+	HrCoverageRulesTable.Annotation = &entsql.Annotation{
+		Table: "hr_coverage_rules",
+	}
+	HrEmploymentsTable.Annotation = &entsql.Annotation{
+		Table: "hr_employments",
+	}
+	HrHolidaysTable.ForeignKeys[0].RefTable = HrHolidayCalendarsTable
+	HrHolidaysTable.Annotation = &entsql.Annotation{
+		Table: "hr_holidays",
+	}
+	HrHolidayCalendarsTable.Annotation = &entsql.Annotation{
+		Table: "hr_holiday_calendars",
+	}
 	HrLeaveAllowancesTable.ForeignKeys[0].RefTable = HrAbsenceTypesTable
 	HrLeaveAllowancesTable.ForeignKeys[1].RefTable = HrAllowancePoolsTable
 	HrLeaveAllowancesTable.Annotation = &entsql.Annotation{
 		Table: "hr_leave_allowances",
 	}
+	HrLeaveAmendmentsTable.Annotation = &entsql.Annotation{
+		Table: "hr_leave_amendments",
+	}
+	HrLeaveAttachmentsTable.Annotation = &entsql.Annotation{
+		Table: "hr_leave_attachments",
+	}
+	HrLeaveCommentsTable.Annotation = &entsql.Annotation{
+		Table: "hr_leave_comments",
+	}
+	HrLeavePoliciesTable.Annotation = &entsql.Annotation{
+		Table: "hr_leave_policies",
+	}
 	HrLeaveRequestsTable.ForeignKeys[0].RefTable = HrAbsenceTypesTable
 	HrLeaveRequestsTable.Annotation = &entsql.Annotation{
 		Table: "hr_leave_requests",
 	}
+	HrOutboxMessagesTable.Annotation = &entsql.Annotation{
+		Table: "hr_outbox_messages",
+	}
+	HrProcessedEventsTable.Annotation = &entsql.Annotation{
+		Table: "hr_processed_events",
+	}
+	HrWebhookDeliveriesTable.Annotation = &entsql.Annotation{
+		Table: "hr_webhook_deliveries",
+	}
+	HrWebhookSubscriptionsTable.Annotation = &entsql.Annotation{
+		Table: "hr_webhook_subscriptions",
+	}
+	HrWorkSchedulesTable.Annotation = &entsql.Annotation{
+		Table: "hr_work_schedules",
+	}
+	HrWorkScheduleAssignmentsTable.ForeignKeys[0].RefTable = HrWorkSchedulesTable
+	HrWorkScheduleAssignmentsTable.Annotation = &entsql.Annotation{
+		Table: "hr_work_schedule_assignments",
+	}
 }
@@ -12,11 +12,62 @@ type AbsenceType func(*sql.Selector)
 // AllowancePool is the predicate function for allowancepool builders.
 type AllowancePool func(*sql.Selector)
 
+// AllowanceTransaction is the predicate function for allowancetransaction builders.
+type AllowanceTransaction func(*sql.Selector)
+
+// ApprovalDelegation is the predicate function for approvaldelegation builders.
+type ApprovalDelegation func(*sql.Selector)
+
 // AuditLog is the predicate function for auditlog builders.
 type AuditLog func(*sql.Selector)
 
+// BlackoutPeriod is the predicate function for blackoutperiod builders.
+type BlackoutPeriod func(*sql.Selector)
+
+// CoverageRule is the predicate function for coveragerule builders.
+type CoverageRule func(*sql.Selector)
+
+// Employment is the predicate function for employment builders.
+type Employment func(*sql.Selector)
+
+// Holiday is the predicate function for holiday builders.
+type Holiday func(*sql.Selector)
+
+// HolidayCalendar is the predicate function for holidaycalendar builders.
+type HolidayCalendar func(*sql.Selector)
+
 // LeaveAllowance is the predicate function for leaveallowance builders.
 type LeaveAllowance func(*sql.Selector)
 
+// LeaveAmendment is the predicate function for leaveamendment builders.
+type LeaveAmendment func(*sql.Selector)
+
+// LeaveAttachment is the predicate function for leaveattachment builders.
+type LeaveAttachment func(*sql.Selector)
+
+// LeaveComment is the predicate function for leavecomment builders.
+type LeaveComment func(*sql.Selector)
+
+// LeavePolicy is the predicate function for leavepolicy builders.
+type LeavePolicy func(*sql.Selector)
+
 // LeaveRequest is the predicate function for leaverequest builders.
 type LeaveRequest func(*sql.Selector)
+
+// OutboxMessage is the predicate function for outboxmessage builders.
+type OutboxMessage func(*sql.Selector)
+
+// ProcessedEvent is the predicate function for processedevent builders.
+type ProcessedEvent func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookSubscription is the predicate function for webhooksubscription builders.
+type WebhookSubscription func(*sql.Selector)
+
+// WorkSchedule is the predicate function for workschedule builders.
+type WorkSchedule func(*sql.Selector)
+
+// WorkScheduleAssignment is the predicate function for workscheduleassignment builders.
+type WorkScheduleAssignment func(*sql.Selector)
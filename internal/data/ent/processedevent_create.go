@@ -0,0 +1,799 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/processedevent"
+)
+
+// ProcessedEventCreate is the builder for creating a ProcessedEvent entity.
+type ProcessedEventCreate struct {
+	config
+	mutation *ProcessedEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ProcessedEventCreate) SetCreateTime(v time.Time) *ProcessedEventCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ProcessedEventCreate) SetNillableCreateTime(v *time.Time) *ProcessedEventCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ProcessedEventCreate) SetUpdateTime(v time.Time) *ProcessedEventCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ProcessedEventCreate) SetNillableUpdateTime(v *time.Time) *ProcessedEventCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *ProcessedEventCreate) SetDeleteTime(v time.Time) *ProcessedEventCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *ProcessedEventCreate) SetNillableDeleteTime(v *time.Time) *ProcessedEventCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ProcessedEventCreate) SetTenantID(v uint32) *ProcessedEventCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ProcessedEventCreate) SetNillableTenantID(v *uint32) *ProcessedEventCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *ProcessedEventCreate) SetEventType(v string) *ProcessedEventCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *ProcessedEventCreate) SetSource(v string) *ProcessedEventCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *ProcessedEventCreate) SetNillableSource(v *string) *ProcessedEventCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ProcessedEventCreate) SetID(v string) *ProcessedEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ProcessedEventMutation object of the builder.
+func (_c *ProcessedEventCreate) Mutation() *ProcessedEventMutation {
+	return _c.mutation
+}
+
+// Save creates the ProcessedEvent in the database.
+func (_c *ProcessedEventCreate) Save(ctx context.Context) (*ProcessedEvent, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProcessedEventCreate) SaveX(ctx context.Context) *ProcessedEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProcessedEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProcessedEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProcessedEventCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := processedevent.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		v := processedevent.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProcessedEventCreate) check() error {
+	if _, ok := _c.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "ProcessedEvent.event_type"`)}
+	}
+	if v, ok := _c.mutation.EventType(); ok {
+		if err := processedevent.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "ProcessedEvent.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := processedevent.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ProcessedEvent.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ProcessedEventCreate) sqlSave(ctx context.Context) (*ProcessedEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ProcessedEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProcessedEventCreate) createSpec() (*ProcessedEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProcessedEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(processedevent.Table, sqlgraph.NewFieldSpec(processedevent.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(processedevent.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(processedevent.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(processedevent.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(processedevent.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(processedevent.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(processedevent.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProcessedEvent.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProcessedEventUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProcessedEventCreate) OnConflict(opts ...sql.ConflictOption) *ProcessedEventUpsertOne {
+	_c.conflict = opts
+	return &ProcessedEventUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProcessedEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProcessedEventCreate) OnConflictColumns(columns ...string) *ProcessedEventUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProcessedEventUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ProcessedEventUpsertOne is the builder for "upsert"-ing
+	//  one ProcessedEvent node.
+	ProcessedEventUpsertOne struct {
+		create *ProcessedEventCreate
+	}
+
+	// ProcessedEventUpsert is the "OnConflict" setter.
+	ProcessedEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ProcessedEventUpsert) SetUpdateTime(v time.Time) *ProcessedEventUpsert {
+	u.Set(processedevent.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ProcessedEventUpsert) UpdateUpdateTime() *ProcessedEventUpsert {
+	u.SetExcluded(processedevent.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ProcessedEventUpsert) ClearUpdateTime() *ProcessedEventUpsert {
+	u.SetNull(processedevent.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ProcessedEventUpsert) SetDeleteTime(v time.Time) *ProcessedEventUpsert {
+	u.Set(processedevent.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ProcessedEventUpsert) UpdateDeleteTime() *ProcessedEventUpsert {
+	u.SetExcluded(processedevent.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ProcessedEventUpsert) ClearDeleteTime() *ProcessedEventUpsert {
+	u.SetNull(processedevent.FieldDeleteTime)
+	return u
+}
+
+// SetEventType sets the "event_type" field.
+func (u *ProcessedEventUpsert) SetEventType(v string) *ProcessedEventUpsert {
+	u.Set(processedevent.FieldEventType, v)
+	return u
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *ProcessedEventUpsert) UpdateEventType() *ProcessedEventUpsert {
+	u.SetExcluded(processedevent.FieldEventType)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *ProcessedEventUpsert) SetSource(v string) *ProcessedEventUpsert {
+	u.Set(processedevent.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ProcessedEventUpsert) UpdateSource() *ProcessedEventUpsert {
+	u.SetExcluded(processedevent.FieldSource)
+	return u
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *ProcessedEventUpsert) ClearSource() *ProcessedEventUpsert {
+	u.SetNull(processedevent.FieldSource)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ProcessedEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(processedevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProcessedEventUpsertOne) UpdateNewValues() *ProcessedEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(processedevent.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(processedevent.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(processedevent.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProcessedEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ProcessedEventUpsertOne) Ignore() *ProcessedEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProcessedEventUpsertOne) DoNothing() *ProcessedEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProcessedEventCreate.OnConflict
+// documentation for more info.
+func (u *ProcessedEventUpsertOne) Update(set func(*ProcessedEventUpsert)) *ProcessedEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProcessedEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ProcessedEventUpsertOne) SetUpdateTime(v time.Time) *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ProcessedEventUpsertOne) UpdateUpdateTime() *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ProcessedEventUpsertOne) ClearUpdateTime() *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ProcessedEventUpsertOne) SetDeleteTime(v time.Time) *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ProcessedEventUpsertOne) UpdateDeleteTime() *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ProcessedEventUpsertOne) ClearDeleteTime() *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetEventType sets the "event_type" field.
+func (u *ProcessedEventUpsertOne) SetEventType(v string) *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.SetEventType(v)
+	})
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *ProcessedEventUpsertOne) UpdateEventType() *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.UpdateEventType()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *ProcessedEventUpsertOne) SetSource(v string) *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ProcessedEventUpsertOne) UpdateSource() *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *ProcessedEventUpsertOne) ClearSource() *ProcessedEventUpsertOne {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.ClearSource()
+	})
+}
+
+// Exec executes the query.
+func (u *ProcessedEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProcessedEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProcessedEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ProcessedEventUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ProcessedEventUpsertOne.ID is not supported by MySQL driver. Use ProcessedEventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ProcessedEventUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ProcessedEventCreateBulk is the builder for creating many ProcessedEvent entities in bulk.
+type ProcessedEventCreateBulk struct {
+	config
+	err      error
+	builders []*ProcessedEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ProcessedEvent entities in the database.
+func (_c *ProcessedEventCreateBulk) Save(ctx context.Context) ([]*ProcessedEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProcessedEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProcessedEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProcessedEventCreateBulk) SaveX(ctx context.Context) []*ProcessedEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProcessedEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProcessedEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ProcessedEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ProcessedEventUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ProcessedEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *ProcessedEventUpsertBulk {
+	_c.conflict = opts
+	return &ProcessedEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ProcessedEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ProcessedEventCreateBulk) OnConflictColumns(columns ...string) *ProcessedEventUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ProcessedEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// ProcessedEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of ProcessedEvent nodes.
+type ProcessedEventUpsertBulk struct {
+	create *ProcessedEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ProcessedEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(processedevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ProcessedEventUpsertBulk) UpdateNewValues() *ProcessedEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(processedevent.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(processedevent.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(processedevent.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ProcessedEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ProcessedEventUpsertBulk) Ignore() *ProcessedEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ProcessedEventUpsertBulk) DoNothing() *ProcessedEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ProcessedEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *ProcessedEventUpsertBulk) Update(set func(*ProcessedEventUpsert)) *ProcessedEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ProcessedEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ProcessedEventUpsertBulk) SetUpdateTime(v time.Time) *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ProcessedEventUpsertBulk) UpdateUpdateTime() *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *ProcessedEventUpsertBulk) ClearUpdateTime() *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *ProcessedEventUpsertBulk) SetDeleteTime(v time.Time) *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *ProcessedEventUpsertBulk) UpdateDeleteTime() *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *ProcessedEventUpsertBulk) ClearDeleteTime() *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetEventType sets the "event_type" field.
+func (u *ProcessedEventUpsertBulk) SetEventType(v string) *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.SetEventType(v)
+	})
+}
+
+// UpdateEventType sets the "event_type" field to the value that was provided on create.
+func (u *ProcessedEventUpsertBulk) UpdateEventType() *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.UpdateEventType()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *ProcessedEventUpsertBulk) SetSource(v string) *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *ProcessedEventUpsertBulk) UpdateSource() *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// ClearSource clears the value of the "source" field.
+func (u *ProcessedEventUpsertBulk) ClearSource() *ProcessedEventUpsertBulk {
+	return u.Update(func(s *ProcessedEventUpsert) {
+		s.ClearSource()
+	})
+}
+
+// Exec executes the query.
+func (u *ProcessedEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ProcessedEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ProcessedEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ProcessedEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
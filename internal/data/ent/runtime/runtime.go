@@ -7,10 +7,27 @@ import (
 
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancepool"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancetransaction"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/approvaldelegation"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/auditlog"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/blackoutperiod"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/coveragerule"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/employment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holiday"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holidaycalendar"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveallowance"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveamendment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveattachment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavecomment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavepolicy"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/outboxmessage"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/processedevent"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/webhookdelivery"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/webhooksubscription"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workschedule"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workscheduleassignment"
 
 	"entgo.io/ent"
 	"entgo.io/ent/privacy"
@@ -136,6 +153,74 @@ func init() {
 	allowancepoolDescID := allowancepoolFields[0].Descriptor()
 	// allowancepool.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	allowancepool.IDValidator = allowancepoolDescID.Validators[0].(func(string) error)
+	allowancetransactionMixin := schema.AllowanceTransaction{}.Mixin()
+	allowancetransaction.Policy = privacy.NewPolicies(allowancetransactionMixin[1], schema.AllowanceTransaction{})
+	allowancetransaction.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := allowancetransaction.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	allowancetransactionMixinFields1 := allowancetransactionMixin[1].Fields()
+	_ = allowancetransactionMixinFields1
+	allowancetransactionFields := schema.AllowanceTransaction{}.Fields()
+	_ = allowancetransactionFields
+	// allowancetransactionDescTenantID is the schema descriptor for tenant_id field.
+	allowancetransactionDescTenantID := allowancetransactionMixinFields1[0].Descriptor()
+	// allowancetransaction.DefaultTenantID holds the default value on creation for the tenant_id field.
+	allowancetransaction.DefaultTenantID = allowancetransactionDescTenantID.Default.(uint32)
+	// allowancetransactionDescAllowanceID is the schema descriptor for allowance_id field.
+	allowancetransactionDescAllowanceID := allowancetransactionFields[1].Descriptor()
+	// allowancetransaction.AllowanceIDValidator is a validator for the "allowance_id" field. It is called by the builders before save.
+	allowancetransaction.AllowanceIDValidator = allowancetransactionDescAllowanceID.Validators[0].(func(string) error)
+	// allowancetransactionDescActorID is the schema descriptor for actor_id field.
+	allowancetransactionDescActorID := allowancetransactionFields[8].Descriptor()
+	// allowancetransaction.DefaultActorID holds the default value on creation for the actor_id field.
+	allowancetransaction.DefaultActorID = allowancetransactionDescActorID.Default.(uint32)
+	// allowancetransactionDescActorName is the schema descriptor for actor_name field.
+	allowancetransactionDescActorName := allowancetransactionFields[9].Descriptor()
+	// allowancetransaction.DefaultActorName holds the default value on creation for the actor_name field.
+	allowancetransaction.DefaultActorName = allowancetransactionDescActorName.Default.(string)
+	// allowancetransactionDescID is the schema descriptor for id field.
+	allowancetransactionDescID := allowancetransactionFields[0].Descriptor()
+	// allowancetransaction.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	allowancetransaction.IDValidator = allowancetransactionDescID.Validators[0].(func(string) error)
+	approvaldelegationMixin := schema.ApprovalDelegation{}.Mixin()
+	approvaldelegation.Policy = privacy.NewPolicies(approvaldelegationMixin[3], schema.ApprovalDelegation{})
+	approvaldelegation.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := approvaldelegation.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	approvaldelegationMixinFields3 := approvaldelegationMixin[3].Fields()
+	_ = approvaldelegationMixinFields3
+	approvaldelegationFields := schema.ApprovalDelegation{}.Fields()
+	_ = approvaldelegationFields
+	// approvaldelegationDescTenantID is the schema descriptor for tenant_id field.
+	approvaldelegationDescTenantID := approvaldelegationMixinFields3[0].Descriptor()
+	// approvaldelegation.DefaultTenantID holds the default value on creation for the tenant_id field.
+	approvaldelegation.DefaultTenantID = approvaldelegationDescTenantID.Default.(uint32)
+	// approvaldelegationDescDelegatorName is the schema descriptor for delegator_name field.
+	approvaldelegationDescDelegatorName := approvaldelegationFields[2].Descriptor()
+	// approvaldelegation.DefaultDelegatorName holds the default value on creation for the delegator_name field.
+	approvaldelegation.DefaultDelegatorName = approvaldelegationDescDelegatorName.Default.(string)
+	// approvaldelegationDescDelegateName is the schema descriptor for delegate_name field.
+	approvaldelegationDescDelegateName := approvaldelegationFields[4].Descriptor()
+	// approvaldelegation.DefaultDelegateName holds the default value on creation for the delegate_name field.
+	approvaldelegation.DefaultDelegateName = approvaldelegationDescDelegateName.Default.(string)
+	// approvaldelegationDescLeaveRequestID is the schema descriptor for leave_request_id field.
+	approvaldelegationDescLeaveRequestID := approvaldelegationFields[8].Descriptor()
+	// approvaldelegation.DefaultLeaveRequestID holds the default value on creation for the leave_request_id field.
+	approvaldelegation.DefaultLeaveRequestID = approvaldelegationDescLeaveRequestID.Default.(string)
+	// approvaldelegationDescID is the schema descriptor for id field.
+	approvaldelegationDescID := approvaldelegationFields[0].Descriptor()
+	// approvaldelegation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	approvaldelegation.IDValidator = approvaldelegationDescID.Validators[0].(func(string) error)
 	auditlogMixin := schema.AuditLog{}.Mixin()
 	auditlog.Policy = privacy.NewPolicies(auditlogMixin[2], schema.AuditLog{})
 	auditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -184,6 +269,232 @@ func init() {
 	auditlogDescID := auditlogMixinFields0[0].Descriptor()
 	// auditlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	auditlog.IDValidator = auditlogDescID.Validators[0].(func(uint32) error)
+	blackoutperiodMixin := schema.BlackoutPeriod{}.Mixin()
+	blackoutperiod.Policy = privacy.NewPolicies(blackoutperiodMixin[3], schema.BlackoutPeriod{})
+	blackoutperiod.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := blackoutperiod.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	blackoutperiodMixinFields3 := blackoutperiodMixin[3].Fields()
+	_ = blackoutperiodMixinFields3
+	blackoutperiodFields := schema.BlackoutPeriod{}.Fields()
+	_ = blackoutperiodFields
+	// blackoutperiodDescTenantID is the schema descriptor for tenant_id field.
+	blackoutperiodDescTenantID := blackoutperiodMixinFields3[0].Descriptor()
+	// blackoutperiod.DefaultTenantID holds the default value on creation for the tenant_id field.
+	blackoutperiod.DefaultTenantID = blackoutperiodDescTenantID.Default.(uint32)
+	// blackoutperiodDescName is the schema descriptor for name field.
+	blackoutperiodDescName := blackoutperiodFields[1].Descriptor()
+	// blackoutperiod.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	blackoutperiod.NameValidator = func() func(string) error {
+		validators := blackoutperiodDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// blackoutperiodDescID is the schema descriptor for id field.
+	blackoutperiodDescID := blackoutperiodFields[0].Descriptor()
+	// blackoutperiod.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	blackoutperiod.IDValidator = blackoutperiodDescID.Validators[0].(func(string) error)
+	coverageruleMixin := schema.CoverageRule{}.Mixin()
+	coveragerule.Policy = privacy.NewPolicies(coverageruleMixin[3], schema.CoverageRule{})
+	coveragerule.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := coveragerule.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	coverageruleMixinFields3 := coverageruleMixin[3].Fields()
+	_ = coverageruleMixinFields3
+	coverageruleFields := schema.CoverageRule{}.Fields()
+	_ = coverageruleFields
+	// coverageruleDescTenantID is the schema descriptor for tenant_id field.
+	coverageruleDescTenantID := coverageruleMixinFields3[0].Descriptor()
+	// coveragerule.DefaultTenantID holds the default value on creation for the tenant_id field.
+	coveragerule.DefaultTenantID = coverageruleDescTenantID.Default.(uint32)
+	// coverageruleDescName is the schema descriptor for name field.
+	coverageruleDescName := coverageruleFields[1].Descriptor()
+	// coveragerule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	coveragerule.NameValidator = func() func(string) error {
+		validators := coverageruleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// coverageruleDescOrgUnitName is the schema descriptor for org_unit_name field.
+	coverageruleDescOrgUnitName := coverageruleFields[3].Descriptor()
+	// coveragerule.OrgUnitNameValidator is a validator for the "org_unit_name" field. It is called by the builders before save.
+	coveragerule.OrgUnitNameValidator = coverageruleDescOrgUnitName.Validators[0].(func(string) error)
+	// coverageruleDescCount is the schema descriptor for count field.
+	coverageruleDescCount := coverageruleFields[5].Descriptor()
+	// coveragerule.CountValidator is a validator for the "count" field. It is called by the builders before save.
+	coveragerule.CountValidator = coverageruleDescCount.Validators[0].(func(int) error)
+	// coverageruleDescPosition is the schema descriptor for position field.
+	coverageruleDescPosition := coverageruleFields[6].Descriptor()
+	// coveragerule.DefaultPosition holds the default value on creation for the position field.
+	coveragerule.DefaultPosition = coverageruleDescPosition.Default.(string)
+	// coverageruleDescEnabled is the schema descriptor for enabled field.
+	coverageruleDescEnabled := coverageruleFields[9].Descriptor()
+	// coveragerule.DefaultEnabled holds the default value on creation for the enabled field.
+	coveragerule.DefaultEnabled = coverageruleDescEnabled.Default.(bool)
+	// coverageruleDescID is the schema descriptor for id field.
+	coverageruleDescID := coverageruleFields[0].Descriptor()
+	// coveragerule.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	coveragerule.IDValidator = coverageruleDescID.Validators[0].(func(string) error)
+	employmentMixin := schema.Employment{}.Mixin()
+	employment.Policy = privacy.NewPolicies(employmentMixin[3], schema.Employment{})
+	employment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := employment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	employmentMixinFields3 := employmentMixin[3].Fields()
+	_ = employmentMixinFields3
+	employmentFields := schema.Employment{}.Fields()
+	_ = employmentFields
+	// employmentDescTenantID is the schema descriptor for tenant_id field.
+	employmentDescTenantID := employmentMixinFields3[0].Descriptor()
+	// employment.DefaultTenantID holds the default value on creation for the tenant_id field.
+	employment.DefaultTenantID = employmentDescTenantID.Default.(uint32)
+	// employmentDescUserName is the schema descriptor for user_name field.
+	employmentDescUserName := employmentFields[2].Descriptor()
+	// employment.DefaultUserName holds the default value on creation for the user_name field.
+	employment.DefaultUserName = employmentDescUserName.Default.(string)
+	// employmentDescID is the schema descriptor for id field.
+	employmentDescID := employmentFields[0].Descriptor()
+	// employment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	employment.IDValidator = employmentDescID.Validators[0].(func(string) error)
+	holidayMixin := schema.Holiday{}.Mixin()
+	holiday.Policy = privacy.NewPolicies(holidayMixin[3], schema.Holiday{})
+	holiday.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := holiday.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	holidayMixinFields3 := holidayMixin[3].Fields()
+	_ = holidayMixinFields3
+	holidayFields := schema.Holiday{}.Fields()
+	_ = holidayFields
+	// holidayDescTenantID is the schema descriptor for tenant_id field.
+	holidayDescTenantID := holidayMixinFields3[0].Descriptor()
+	// holiday.DefaultTenantID holds the default value on creation for the tenant_id field.
+	holiday.DefaultTenantID = holidayDescTenantID.Default.(uint32)
+	// holidayDescCalendarID is the schema descriptor for calendar_id field.
+	holidayDescCalendarID := holidayFields[1].Descriptor()
+	// holiday.CalendarIDValidator is a validator for the "calendar_id" field. It is called by the builders before save.
+	holiday.CalendarIDValidator = holidayDescCalendarID.Validators[0].(func(string) error)
+	// holidayDescName is the schema descriptor for name field.
+	holidayDescName := holidayFields[2].Descriptor()
+	// holiday.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	holiday.NameValidator = func() func(string) error {
+		validators := holidayDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// holidayDescRecurring is the schema descriptor for recurring field.
+	holidayDescRecurring := holidayFields[4].Descriptor()
+	// holiday.DefaultRecurring holds the default value on creation for the recurring field.
+	holiday.DefaultRecurring = holidayDescRecurring.Default.(bool)
+	// holidayDescID is the schema descriptor for id field.
+	holidayDescID := holidayFields[0].Descriptor()
+	// holiday.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	holiday.IDValidator = holidayDescID.Validators[0].(func(string) error)
+	holidaycalendarMixin := schema.HolidayCalendar{}.Mixin()
+	holidaycalendar.Policy = privacy.NewPolicies(holidaycalendarMixin[3], schema.HolidayCalendar{})
+	holidaycalendar.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := holidaycalendar.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	holidaycalendarMixinFields3 := holidaycalendarMixin[3].Fields()
+	_ = holidaycalendarMixinFields3
+	holidaycalendarFields := schema.HolidayCalendar{}.Fields()
+	_ = holidaycalendarFields
+	// holidaycalendarDescTenantID is the schema descriptor for tenant_id field.
+	holidaycalendarDescTenantID := holidaycalendarMixinFields3[0].Descriptor()
+	// holidaycalendar.DefaultTenantID holds the default value on creation for the tenant_id field.
+	holidaycalendar.DefaultTenantID = holidaycalendarDescTenantID.Default.(uint32)
+	// holidaycalendarDescName is the schema descriptor for name field.
+	holidaycalendarDescName := holidaycalendarFields[1].Descriptor()
+	// holidaycalendar.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	holidaycalendar.NameValidator = func() func(string) error {
+		validators := holidaycalendarDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// holidaycalendarDescCountryCode is the schema descriptor for country_code field.
+	holidaycalendarDescCountryCode := holidaycalendarFields[3].Descriptor()
+	// holidaycalendar.DefaultCountryCode holds the default value on creation for the country_code field.
+	holidaycalendar.DefaultCountryCode = holidaycalendarDescCountryCode.Default.(string)
+	// holidaycalendar.CountryCodeValidator is a validator for the "country_code" field. It is called by the builders before save.
+	holidaycalendar.CountryCodeValidator = holidaycalendarDescCountryCode.Validators[0].(func(string) error)
+	// holidaycalendarDescRegion is the schema descriptor for region field.
+	holidaycalendarDescRegion := holidaycalendarFields[4].Descriptor()
+	// holidaycalendar.DefaultRegion holds the default value on creation for the region field.
+	holidaycalendar.DefaultRegion = holidaycalendarDescRegion.Default.(string)
+	// holidaycalendar.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	holidaycalendar.RegionValidator = holidaycalendarDescRegion.Validators[0].(func(string) error)
+	// holidaycalendarDescIsDefault is the schema descriptor for is_default field.
+	holidaycalendarDescIsDefault := holidaycalendarFields[5].Descriptor()
+	// holidaycalendar.DefaultIsDefault holds the default value on creation for the is_default field.
+	holidaycalendar.DefaultIsDefault = holidaycalendarDescIsDefault.Default.(bool)
+	// holidaycalendarDescID is the schema descriptor for id field.
+	holidaycalendarDescID := holidaycalendarFields[0].Descriptor()
+	// holidaycalendar.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	holidaycalendar.IDValidator = holidaycalendarDescID.Validators[0].(func(string) error)
 	leaveallowanceMixin := schema.LeaveAllowance{}.Mixin()
 	leaveallowance.Policy = privacy.NewPolicies(leaveallowanceMixin[3], schema.LeaveAllowance{})
 	leaveallowance.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -211,17 +522,227 @@ func init() {
 	// leaveallowance.YearValidator is a validator for the "year" field. It is called by the builders before save.
 	leaveallowance.YearValidator = leaveallowanceDescYear.Validators[0].(func(int) error)
 	// leaveallowanceDescUsedDays is the schema descriptor for used_days field.
-	leaveallowanceDescUsedDays := leaveallowanceFields[7].Descriptor()
+	leaveallowanceDescUsedDays := leaveallowanceFields[8].Descriptor()
 	// leaveallowance.DefaultUsedDays holds the default value on creation for the used_days field.
 	leaveallowance.DefaultUsedDays = leaveallowanceDescUsedDays.Default.(float64)
 	// leaveallowanceDescCarriedOver is the schema descriptor for carried_over field.
-	leaveallowanceDescCarriedOver := leaveallowanceFields[8].Descriptor()
+	leaveallowanceDescCarriedOver := leaveallowanceFields[9].Descriptor()
 	// leaveallowance.DefaultCarriedOver holds the default value on creation for the carried_over field.
 	leaveallowance.DefaultCarriedOver = leaveallowanceDescCarriedOver.Default.(float64)
+	// leaveallowanceDescOverconsumedDays is the schema descriptor for overconsumed_days field.
+	leaveallowanceDescOverconsumedDays := leaveallowanceFields[11].Descriptor()
+	// leaveallowance.DefaultOverconsumedDays holds the default value on creation for the overconsumed_days field.
+	leaveallowance.DefaultOverconsumedDays = leaveallowanceDescOverconsumedDays.Default.(float64)
+	// leaveallowanceDescAccruedDays is the schema descriptor for accrued_days field.
+	leaveallowanceDescAccruedDays := leaveallowanceFields[12].Descriptor()
+	// leaveallowance.DefaultAccruedDays holds the default value on creation for the accrued_days field.
+	leaveallowance.DefaultAccruedDays = leaveallowanceDescAccruedDays.Default.(float64)
 	// leaveallowanceDescID is the schema descriptor for id field.
 	leaveallowanceDescID := leaveallowanceFields[0].Descriptor()
 	// leaveallowance.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	leaveallowance.IDValidator = leaveallowanceDescID.Validators[0].(func(string) error)
+	leaveamendmentMixin := schema.LeaveAmendment{}.Mixin()
+	leaveamendment.Policy = privacy.NewPolicies(leaveamendmentMixin[3], schema.LeaveAmendment{})
+	leaveamendment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := leaveamendment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	leaveamendmentMixinFields3 := leaveamendmentMixin[3].Fields()
+	_ = leaveamendmentMixinFields3
+	leaveamendmentFields := schema.LeaveAmendment{}.Fields()
+	_ = leaveamendmentFields
+	// leaveamendmentDescTenantID is the schema descriptor for tenant_id field.
+	leaveamendmentDescTenantID := leaveamendmentMixinFields3[0].Descriptor()
+	// leaveamendment.DefaultTenantID holds the default value on creation for the tenant_id field.
+	leaveamendment.DefaultTenantID = leaveamendmentDescTenantID.Default.(uint32)
+	// leaveamendmentDescLeaveRequestID is the schema descriptor for leave_request_id field.
+	leaveamendmentDescLeaveRequestID := leaveamendmentFields[1].Descriptor()
+	// leaveamendment.LeaveRequestIDValidator is a validator for the "leave_request_id" field. It is called by the builders before save.
+	leaveamendment.LeaveRequestIDValidator = leaveamendmentDescLeaveRequestID.Validators[0].(func(string) error)
+	// leaveamendmentDescHours is the schema descriptor for hours field.
+	leaveamendmentDescHours := leaveamendmentFields[14].Descriptor()
+	// leaveamendment.DefaultHours holds the default value on creation for the hours field.
+	leaveamendment.DefaultHours = leaveamendmentDescHours.Default.(float64)
+	// leaveamendmentDescHolidayCalendarID is the schema descriptor for holiday_calendar_id field.
+	leaveamendmentDescHolidayCalendarID := leaveamendmentFields[16].Descriptor()
+	// leaveamendment.DefaultHolidayCalendarID holds the default value on creation for the holiday_calendar_id field.
+	leaveamendment.DefaultHolidayCalendarID = leaveamendmentDescHolidayCalendarID.Default.(string)
+	// leaveamendmentDescReviewedBy is the schema descriptor for reviewed_by field.
+	leaveamendmentDescReviewedBy := leaveamendmentFields[18].Descriptor()
+	// leaveamendment.DefaultReviewedBy holds the default value on creation for the reviewed_by field.
+	leaveamendment.DefaultReviewedBy = leaveamendmentDescReviewedBy.Default.(uint32)
+	// leaveamendmentDescReviewerName is the schema descriptor for reviewer_name field.
+	leaveamendmentDescReviewerName := leaveamendmentFields[19].Descriptor()
+	// leaveamendment.DefaultReviewerName holds the default value on creation for the reviewer_name field.
+	leaveamendment.DefaultReviewerName = leaveamendmentDescReviewerName.Default.(string)
+	// leaveamendmentDescSigningRequestID is the schema descriptor for signing_request_id field.
+	leaveamendmentDescSigningRequestID := leaveamendmentFields[22].Descriptor()
+	// leaveamendment.DefaultSigningRequestID holds the default value on creation for the signing_request_id field.
+	leaveamendment.DefaultSigningRequestID = leaveamendmentDescSigningRequestID.Default.(string)
+	// leaveamendmentDescID is the schema descriptor for id field.
+	leaveamendmentDescID := leaveamendmentFields[0].Descriptor()
+	// leaveamendment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	leaveamendment.IDValidator = leaveamendmentDescID.Validators[0].(func(string) error)
+	leaveattachmentMixin := schema.LeaveAttachment{}.Mixin()
+	leaveattachment.Policy = privacy.NewPolicies(leaveattachmentMixin[3], schema.LeaveAttachment{})
+	leaveattachment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := leaveattachment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	leaveattachmentMixinFields3 := leaveattachmentMixin[3].Fields()
+	_ = leaveattachmentMixinFields3
+	leaveattachmentFields := schema.LeaveAttachment{}.Fields()
+	_ = leaveattachmentFields
+	// leaveattachmentDescTenantID is the schema descriptor for tenant_id field.
+	leaveattachmentDescTenantID := leaveattachmentMixinFields3[0].Descriptor()
+	// leaveattachment.DefaultTenantID holds the default value on creation for the tenant_id field.
+	leaveattachment.DefaultTenantID = leaveattachmentDescTenantID.Default.(uint32)
+	// leaveattachmentDescLeaveRequestID is the schema descriptor for leave_request_id field.
+	leaveattachmentDescLeaveRequestID := leaveattachmentFields[1].Descriptor()
+	// leaveattachment.LeaveRequestIDValidator is a validator for the "leave_request_id" field. It is called by the builders before save.
+	leaveattachment.LeaveRequestIDValidator = leaveattachmentDescLeaveRequestID.Validators[0].(func(string) error)
+	// leaveattachmentDescFileName is the schema descriptor for file_name field.
+	leaveattachmentDescFileName := leaveattachmentFields[2].Descriptor()
+	// leaveattachment.FileNameValidator is a validator for the "file_name" field. It is called by the builders before save.
+	leaveattachment.FileNameValidator = func() func(string) error {
+		validators := leaveattachmentDescFileName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(file_name string) error {
+			for _, fn := range fns {
+				if err := fn(file_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// leaveattachmentDescContentType is the schema descriptor for content_type field.
+	leaveattachmentDescContentType := leaveattachmentFields[3].Descriptor()
+	// leaveattachment.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	leaveattachment.ContentTypeValidator = func() func(string) error {
+		validators := leaveattachmentDescContentType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(content_type string) error {
+			for _, fn := range fns {
+				if err := fn(content_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// leaveattachmentDescSize is the schema descriptor for size field.
+	leaveattachmentDescSize := leaveattachmentFields[4].Descriptor()
+	// leaveattachment.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	leaveattachment.SizeValidator = leaveattachmentDescSize.Validators[0].(func(int64) error)
+	// leaveattachmentDescChecksum is the schema descriptor for checksum field.
+	leaveattachmentDescChecksum := leaveattachmentFields[5].Descriptor()
+	// leaveattachment.ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
+	leaveattachment.ChecksumValidator = leaveattachmentDescChecksum.Validators[0].(func(string) error)
+	// leaveattachmentDescStorageKey is the schema descriptor for storage_key field.
+	leaveattachmentDescStorageKey := leaveattachmentFields[6].Descriptor()
+	// leaveattachment.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	leaveattachment.StorageKeyValidator = leaveattachmentDescStorageKey.Validators[0].(func(string) error)
+	// leaveattachmentDescID is the schema descriptor for id field.
+	leaveattachmentDescID := leaveattachmentFields[0].Descriptor()
+	// leaveattachment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	leaveattachment.IDValidator = leaveattachmentDescID.Validators[0].(func(string) error)
+	leavecommentMixin := schema.LeaveComment{}.Mixin()
+	leavecomment.Policy = privacy.NewPolicies(leavecommentMixin[3], schema.LeaveComment{})
+	leavecomment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := leavecomment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	leavecommentMixinFields3 := leavecommentMixin[3].Fields()
+	_ = leavecommentMixinFields3
+	leavecommentFields := schema.LeaveComment{}.Fields()
+	_ = leavecommentFields
+	// leavecommentDescTenantID is the schema descriptor for tenant_id field.
+	leavecommentDescTenantID := leavecommentMixinFields3[0].Descriptor()
+	// leavecomment.DefaultTenantID holds the default value on creation for the tenant_id field.
+	leavecomment.DefaultTenantID = leavecommentDescTenantID.Default.(uint32)
+	// leavecommentDescLeaveRequestID is the schema descriptor for leave_request_id field.
+	leavecommentDescLeaveRequestID := leavecommentFields[1].Descriptor()
+	// leavecomment.LeaveRequestIDValidator is a validator for the "leave_request_id" field. It is called by the builders before save.
+	leavecomment.LeaveRequestIDValidator = leavecommentDescLeaveRequestID.Validators[0].(func(string) error)
+	// leavecommentDescBody is the schema descriptor for body field.
+	leavecommentDescBody := leavecommentFields[4].Descriptor()
+	// leavecomment.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	leavecomment.BodyValidator = leavecommentDescBody.Validators[0].(func(string) error)
+	// leavecommentDescID is the schema descriptor for id field.
+	leavecommentDescID := leavecommentFields[0].Descriptor()
+	// leavecomment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	leavecomment.IDValidator = leavecommentDescID.Validators[0].(func(string) error)
+	leavepolicyMixin := schema.LeavePolicy{}.Mixin()
+	leavepolicy.Policy = privacy.NewPolicies(leavepolicyMixin[3], schema.LeavePolicy{})
+	leavepolicy.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := leavepolicy.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	leavepolicyMixinFields3 := leavepolicyMixin[3].Fields()
+	_ = leavepolicyMixinFields3
+	leavepolicyFields := schema.LeavePolicy{}.Fields()
+	_ = leavepolicyFields
+	// leavepolicyDescTenantID is the schema descriptor for tenant_id field.
+	leavepolicyDescTenantID := leavepolicyMixinFields3[0].Descriptor()
+	// leavepolicy.DefaultTenantID holds the default value on creation for the tenant_id field.
+	leavepolicy.DefaultTenantID = leavepolicyDescTenantID.Default.(uint32)
+	// leavepolicyDescName is the schema descriptor for name field.
+	leavepolicyDescName := leavepolicyFields[1].Descriptor()
+	// leavepolicy.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	leavepolicy.NameValidator = func() func(string) error {
+		validators := leavepolicyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// leavepolicyDescAbsenceTypeID is the schema descriptor for absence_type_id field.
+	leavepolicyDescAbsenceTypeID := leavepolicyFields[3].Descriptor()
+	// leavepolicy.DefaultAbsenceTypeID holds the default value on creation for the absence_type_id field.
+	leavepolicy.DefaultAbsenceTypeID = leavepolicyDescAbsenceTypeID.Default.(string)
+	// leavepolicyDescOrgUnitName is the schema descriptor for org_unit_name field.
+	leavepolicyDescOrgUnitName := leavepolicyFields[4].Descriptor()
+	// leavepolicy.DefaultOrgUnitName holds the default value on creation for the org_unit_name field.
+	leavepolicy.DefaultOrgUnitName = leavepolicyDescOrgUnitName.Default.(string)
+	// leavepolicyDescEnabled is the schema descriptor for enabled field.
+	leavepolicyDescEnabled := leavepolicyFields[6].Descriptor()
+	// leavepolicy.DefaultEnabled holds the default value on creation for the enabled field.
+	leavepolicy.DefaultEnabled = leavepolicyDescEnabled.Default.(bool)
+	// leavepolicyDescID is the schema descriptor for id field.
+	leavepolicyDescID := leavepolicyFields[0].Descriptor()
+	// leavepolicy.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	leavepolicy.IDValidator = leavepolicyDescID.Validators[0].(func(string) error)
 	leaverequestMixin := schema.LeaveRequest{}.Mixin()
 	leaverequest.Policy = privacy.NewPolicies(leaverequestMixin[3], schema.LeaveRequest{})
 	leaverequest.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -256,26 +777,334 @@ func init() {
 	leaverequestDescAbsenceTypeID := leaverequestFields[5].Descriptor()
 	// leaverequest.AbsenceTypeIDValidator is a validator for the "absence_type_id" field. It is called by the builders before save.
 	leaverequest.AbsenceTypeIDValidator = leaverequestDescAbsenceTypeID.Validators[0].(func(string) error)
+	// leaverequestDescHours is the schema descriptor for hours field.
+	leaverequestDescHours := leaverequestFields[10].Descriptor()
+	// leaverequest.DefaultHours holds the default value on creation for the hours field.
+	leaverequest.DefaultHours = leaverequestDescHours.Default.(float64)
 	// leaverequestDescSigningRequestID is the schema descriptor for signing_request_id field.
-	leaverequestDescSigningRequestID := leaverequestFields[10].Descriptor()
+	leaverequestDescSigningRequestID := leaverequestFields[13].Descriptor()
 	// leaverequest.DefaultSigningRequestID holds the default value on creation for the signing_request_id field.
 	leaverequest.DefaultSigningRequestID = leaverequestDescSigningRequestID.Default.(string)
 	// leaverequestDescReviewedBy is the schema descriptor for reviewed_by field.
-	leaverequestDescReviewedBy := leaverequestFields[13].Descriptor()
+	leaverequestDescReviewedBy := leaverequestFields[16].Descriptor()
 	// leaverequest.DefaultReviewedBy holds the default value on creation for the reviewed_by field.
 	leaverequest.DefaultReviewedBy = leaverequestDescReviewedBy.Default.(uint32)
 	// leaverequestDescReviewerName is the schema descriptor for reviewer_name field.
-	leaverequestDescReviewerName := leaverequestFields[14].Descriptor()
+	leaverequestDescReviewerName := leaverequestFields[17].Descriptor()
 	// leaverequest.DefaultReviewerName holds the default value on creation for the reviewer_name field.
 	leaverequest.DefaultReviewerName = leaverequestDescReviewerName.Default.(string)
 	// leaverequestDescDeductedAllowanceID is the schema descriptor for deducted_allowance_id field.
-	leaverequestDescDeductedAllowanceID := leaverequestFields[18].Descriptor()
+	leaverequestDescDeductedAllowanceID := leaverequestFields[21].Descriptor()
 	// leaverequest.DefaultDeductedAllowanceID holds the default value on creation for the deducted_allowance_id field.
 	leaverequest.DefaultDeductedAllowanceID = leaverequestDescDeductedAllowanceID.Default.(string)
+	// leaverequestDescHolidayCalendarID is the schema descriptor for holiday_calendar_id field.
+	leaverequestDescHolidayCalendarID := leaverequestFields[23].Descriptor()
+	// leaverequest.DefaultHolidayCalendarID holds the default value on creation for the holiday_calendar_id field.
+	leaverequest.DefaultHolidayCalendarID = leaverequestDescHolidayCalendarID.Default.(string)
+	// leaverequestDescApprovalStep is the schema descriptor for approval_step field.
+	leaverequestDescApprovalStep := leaverequestFields[25].Descriptor()
+	// leaverequest.DefaultApprovalStep holds the default value on creation for the approval_step field.
+	leaverequest.DefaultApprovalStep = leaverequestDescApprovalStep.Default.(int)
+	// leaverequestDescApproverID is the schema descriptor for approver_id field.
+	leaverequestDescApproverID := leaverequestFields[26].Descriptor()
+	// leaverequest.DefaultApproverID holds the default value on creation for the approver_id field.
+	leaverequest.DefaultApproverID = leaverequestDescApproverID.Default.(uint32)
+	// leaverequestDescOnBehalfOf is the schema descriptor for on_behalf_of field.
+	leaverequestDescOnBehalfOf := leaverequestFields[27].Descriptor()
+	// leaverequest.DefaultOnBehalfOf holds the default value on creation for the on_behalf_of field.
+	leaverequest.DefaultOnBehalfOf = leaverequestDescOnBehalfOf.Default.(uint32)
+	// leaverequestDescOnBehalfOfName is the schema descriptor for on_behalf_of_name field.
+	leaverequestDescOnBehalfOfName := leaverequestFields[28].Descriptor()
+	// leaverequest.DefaultOnBehalfOfName holds the default value on creation for the on_behalf_of_name field.
+	leaverequest.DefaultOnBehalfOfName = leaverequestDescOnBehalfOfName.Default.(string)
+	// leaverequestDescEscalationLevel is the schema descriptor for escalation_level field.
+	leaverequestDescEscalationLevel := leaverequestFields[30].Descriptor()
+	// leaverequest.DefaultEscalationLevel holds the default value on creation for the escalation_level field.
+	leaverequest.DefaultEscalationLevel = leaverequestDescEscalationLevel.Default.(int)
+	// leaverequestDescAttachmentCount is the schema descriptor for attachment_count field.
+	leaverequestDescAttachmentCount := leaverequestFields[32].Descriptor()
+	// leaverequest.DefaultAttachmentCount holds the default value on creation for the attachment_count field.
+	leaverequest.DefaultAttachmentCount = leaverequestDescAttachmentCount.Default.(int)
+	// leaverequest.AttachmentCountValidator is a validator for the "attachment_count" field. It is called by the builders before save.
+	leaverequest.AttachmentCountValidator = leaverequestDescAttachmentCount.Validators[0].(func(int) error)
+	// leaverequestDescSeriesID is the schema descriptor for series_id field.
+	leaverequestDescSeriesID := leaverequestFields[33].Descriptor()
+	// leaverequest.DefaultSeriesID holds the default value on creation for the series_id field.
+	leaverequest.DefaultSeriesID = leaverequestDescSeriesID.Default.(string)
+	// leaverequestDescSigningOutcomeReason is the schema descriptor for signing_outcome_reason field.
+	leaverequestDescSigningOutcomeReason := leaverequestFields[36].Descriptor()
+	// leaverequest.DefaultSigningOutcomeReason holds the default value on creation for the signing_outcome_reason field.
+	leaverequest.DefaultSigningOutcomeReason = leaverequestDescSigningOutcomeReason.Default.(string)
 	// leaverequestDescID is the schema descriptor for id field.
 	leaverequestDescID := leaverequestFields[0].Descriptor()
 	// leaverequest.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	leaverequest.IDValidator = leaverequestDescID.Validators[0].(func(string) error)
+	outboxmessageMixin := schema.OutboxMessage{}.Mixin()
+	outboxmessage.Policy = privacy.NewPolicies(outboxmessageMixin[1], schema.OutboxMessage{})
+	outboxmessage.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := outboxmessage.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	outboxmessageMixinFields1 := outboxmessageMixin[1].Fields()
+	_ = outboxmessageMixinFields1
+	outboxmessageFields := schema.OutboxMessage{}.Fields()
+	_ = outboxmessageFields
+	// outboxmessageDescTenantID is the schema descriptor for tenant_id field.
+	outboxmessageDescTenantID := outboxmessageMixinFields1[0].Descriptor()
+	// outboxmessage.DefaultTenantID holds the default value on creation for the tenant_id field.
+	outboxmessage.DefaultTenantID = outboxmessageDescTenantID.Default.(uint32)
+	// outboxmessageDescKind is the schema descriptor for kind field.
+	outboxmessageDescKind := outboxmessageFields[1].Descriptor()
+	// outboxmessage.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	outboxmessage.KindValidator = outboxmessageDescKind.Validators[0].(func(string) error)
+	// outboxmessageDescAttempts is the schema descriptor for attempts field.
+	outboxmessageDescAttempts := outboxmessageFields[4].Descriptor()
+	// outboxmessage.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxmessage.DefaultAttempts = outboxmessageDescAttempts.Default.(int)
+	// outboxmessage.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	outboxmessage.AttemptsValidator = outboxmessageDescAttempts.Validators[0].(func(int) error)
+	// outboxmessageDescLastError is the schema descriptor for last_error field.
+	outboxmessageDescLastError := outboxmessageFields[6].Descriptor()
+	// outboxmessage.DefaultLastError holds the default value on creation for the last_error field.
+	outboxmessage.DefaultLastError = outboxmessageDescLastError.Default.(string)
+	// outboxmessageDescID is the schema descriptor for id field.
+	outboxmessageDescID := outboxmessageFields[0].Descriptor()
+	// outboxmessage.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	outboxmessage.IDValidator = outboxmessageDescID.Validators[0].(func(string) error)
+	processedeventMixin := schema.ProcessedEvent{}.Mixin()
+	processedevent.Policy = privacy.NewPolicies(processedeventMixin[1], schema.ProcessedEvent{})
+	processedevent.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := processedevent.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	processedeventMixinFields1 := processedeventMixin[1].Fields()
+	_ = processedeventMixinFields1
+	processedeventFields := schema.ProcessedEvent{}.Fields()
+	_ = processedeventFields
+	// processedeventDescTenantID is the schema descriptor for tenant_id field.
+	processedeventDescTenantID := processedeventMixinFields1[0].Descriptor()
+	// processedevent.DefaultTenantID holds the default value on creation for the tenant_id field.
+	processedevent.DefaultTenantID = processedeventDescTenantID.Default.(uint32)
+	// processedeventDescEventType is the schema descriptor for event_type field.
+	processedeventDescEventType := processedeventFields[1].Descriptor()
+	// processedevent.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	processedevent.EventTypeValidator = processedeventDescEventType.Validators[0].(func(string) error)
+	// processedeventDescSource is the schema descriptor for source field.
+	processedeventDescSource := processedeventFields[2].Descriptor()
+	// processedevent.DefaultSource holds the default value on creation for the source field.
+	processedevent.DefaultSource = processedeventDescSource.Default.(string)
+	// processedeventDescID is the schema descriptor for id field.
+	processedeventDescID := processedeventFields[0].Descriptor()
+	// processedevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	processedevent.IDValidator = processedeventDescID.Validators[0].(func(string) error)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdelivery.Policy = privacy.NewPolicies(webhookdeliveryMixin[1], schema.WebhookDelivery{})
+	webhookdelivery.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhookdelivery.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookdeliveryMixinFields1 := webhookdeliveryMixin[1].Fields()
+	_ = webhookdeliveryMixinFields1
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescTenantID is the schema descriptor for tenant_id field.
+	webhookdeliveryDescTenantID := webhookdeliveryMixinFields1[0].Descriptor()
+	// webhookdelivery.DefaultTenantID holds the default value on creation for the tenant_id field.
+	webhookdelivery.DefaultTenantID = webhookdeliveryDescTenantID.Default.(uint32)
+	// webhookdeliveryDescSubscriptionID is the schema descriptor for subscription_id field.
+	webhookdeliveryDescSubscriptionID := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.SubscriptionIDValidator is a validator for the "subscription_id" field. It is called by the builders before save.
+	webhookdelivery.SubscriptionIDValidator = webhookdeliveryDescSubscriptionID.Validators[0].(func(string) error)
+	// webhookdeliveryDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryDescEventID := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookdelivery.EventIDValidator = webhookdeliveryDescEventID.Validators[0].(func(string) error)
+	// webhookdeliveryDescEventType is the schema descriptor for event_type field.
+	webhookdeliveryDescEventType := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookdelivery.EventTypeValidator = webhookdeliveryDescEventType.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempt is the schema descriptor for attempt field.
+	webhookdeliveryDescAttempt := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultAttempt holds the default value on creation for the attempt field.
+	webhookdelivery.DefaultAttempt = webhookdeliveryDescAttempt.Default.(int)
+	// webhookdelivery.AttemptValidator is a validator for the "attempt" field. It is called by the builders before save.
+	webhookdelivery.AttemptValidator = webhookdeliveryDescAttempt.Validators[0].(func(int) error)
+	// webhookdeliveryDescResponseStatus is the schema descriptor for response_status field.
+	webhookdeliveryDescResponseStatus := webhookdeliveryFields[9].Descriptor()
+	// webhookdelivery.DefaultResponseStatus holds the default value on creation for the response_status field.
+	webhookdelivery.DefaultResponseStatus = webhookdeliveryDescResponseStatus.Default.(int)
+	// webhookdeliveryDescResponseBody is the schema descriptor for response_body field.
+	webhookdeliveryDescResponseBody := webhookdeliveryFields[10].Descriptor()
+	// webhookdelivery.DefaultResponseBody holds the default value on creation for the response_body field.
+	webhookdelivery.DefaultResponseBody = webhookdeliveryDescResponseBody.Default.(string)
+	// webhookdeliveryDescError is the schema descriptor for error field.
+	webhookdeliveryDescError := webhookdeliveryFields[11].Descriptor()
+	// webhookdelivery.DefaultError holds the default value on creation for the error field.
+	webhookdelivery.DefaultError = webhookdeliveryDescError.Default.(string)
+	// webhookdeliveryDescDurationMs is the schema descriptor for duration_ms field.
+	webhookdeliveryDescDurationMs := webhookdeliveryFields[12].Descriptor()
+	// webhookdelivery.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	webhookdelivery.DefaultDurationMs = webhookdeliveryDescDurationMs.Default.(int64)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhookdelivery.IDValidator = webhookdeliveryDescID.Validators[0].(func(string) error)
+	webhooksubscriptionMixin := schema.WebhookSubscription{}.Mixin()
+	webhooksubscription.Policy = privacy.NewPolicies(webhooksubscriptionMixin[3], schema.WebhookSubscription{})
+	webhooksubscription.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhooksubscription.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhooksubscriptionMixinFields3 := webhooksubscriptionMixin[3].Fields()
+	_ = webhooksubscriptionMixinFields3
+	webhooksubscriptionFields := schema.WebhookSubscription{}.Fields()
+	_ = webhooksubscriptionFields
+	// webhooksubscriptionDescTenantID is the schema descriptor for tenant_id field.
+	webhooksubscriptionDescTenantID := webhooksubscriptionMixinFields3[0].Descriptor()
+	// webhooksubscription.DefaultTenantID holds the default value on creation for the tenant_id field.
+	webhooksubscription.DefaultTenantID = webhooksubscriptionDescTenantID.Default.(uint32)
+	// webhooksubscriptionDescName is the schema descriptor for name field.
+	webhooksubscriptionDescName := webhooksubscriptionFields[1].Descriptor()
+	// webhooksubscription.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webhooksubscription.NameValidator = func() func(string) error {
+		validators := webhooksubscriptionDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhooksubscriptionDescURL is the schema descriptor for url field.
+	webhooksubscriptionDescURL := webhooksubscriptionFields[3].Descriptor()
+	// webhooksubscription.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhooksubscription.URLValidator = func() func(string) error {
+		validators := webhooksubscriptionDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhooksubscriptionDescSecret is the schema descriptor for secret field.
+	webhooksubscriptionDescSecret := webhooksubscriptionFields[5].Descriptor()
+	// webhooksubscription.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhooksubscription.SecretValidator = webhooksubscriptionDescSecret.Validators[0].(func(string) error)
+	// webhooksubscriptionDescEnabled is the schema descriptor for enabled field.
+	webhooksubscriptionDescEnabled := webhooksubscriptionFields[6].Descriptor()
+	// webhooksubscription.DefaultEnabled holds the default value on creation for the enabled field.
+	webhooksubscription.DefaultEnabled = webhooksubscriptionDescEnabled.Default.(bool)
+	// webhooksubscriptionDescID is the schema descriptor for id field.
+	webhooksubscriptionDescID := webhooksubscriptionFields[0].Descriptor()
+	// webhooksubscription.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhooksubscription.IDValidator = webhooksubscriptionDescID.Validators[0].(func(string) error)
+	workscheduleMixin := schema.WorkSchedule{}.Mixin()
+	workschedule.Policy = privacy.NewPolicies(workscheduleMixin[3], schema.WorkSchedule{})
+	workschedule.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := workschedule.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	workscheduleMixinFields3 := workscheduleMixin[3].Fields()
+	_ = workscheduleMixinFields3
+	workscheduleFields := schema.WorkSchedule{}.Fields()
+	_ = workscheduleFields
+	// workscheduleDescTenantID is the schema descriptor for tenant_id field.
+	workscheduleDescTenantID := workscheduleMixinFields3[0].Descriptor()
+	// workschedule.DefaultTenantID holds the default value on creation for the tenant_id field.
+	workschedule.DefaultTenantID = workscheduleDescTenantID.Default.(uint32)
+	// workscheduleDescName is the schema descriptor for name field.
+	workscheduleDescName := workscheduleFields[1].Descriptor()
+	// workschedule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	workschedule.NameValidator = func() func(string) error {
+		validators := workscheduleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// workscheduleDescID is the schema descriptor for id field.
+	workscheduleDescID := workscheduleFields[0].Descriptor()
+	// workschedule.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	workschedule.IDValidator = workscheduleDescID.Validators[0].(func(string) error)
+	workscheduleassignmentMixin := schema.WorkScheduleAssignment{}.Mixin()
+	workscheduleassignment.Policy = privacy.NewPolicies(workscheduleassignmentMixin[3], schema.WorkScheduleAssignment{})
+	workscheduleassignment.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := workscheduleassignment.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	workscheduleassignmentMixinFields3 := workscheduleassignmentMixin[3].Fields()
+	_ = workscheduleassignmentMixinFields3
+	workscheduleassignmentFields := schema.WorkScheduleAssignment{}.Fields()
+	_ = workscheduleassignmentFields
+	// workscheduleassignmentDescTenantID is the schema descriptor for tenant_id field.
+	workscheduleassignmentDescTenantID := workscheduleassignmentMixinFields3[0].Descriptor()
+	// workscheduleassignment.DefaultTenantID holds the default value on creation for the tenant_id field.
+	workscheduleassignment.DefaultTenantID = workscheduleassignmentDescTenantID.Default.(uint32)
+	// workscheduleassignmentDescScheduleID is the schema descriptor for schedule_id field.
+	workscheduleassignmentDescScheduleID := workscheduleassignmentFields[1].Descriptor()
+	// workscheduleassignment.ScheduleIDValidator is a validator for the "schedule_id" field. It is called by the builders before save.
+	workscheduleassignment.ScheduleIDValidator = workscheduleassignmentDescScheduleID.Validators[0].(func(string) error)
+	// workscheduleassignmentDescUserID is the schema descriptor for user_id field.
+	workscheduleassignmentDescUserID := workscheduleassignmentFields[2].Descriptor()
+	// workscheduleassignment.DefaultUserID holds the default value on creation for the user_id field.
+	workscheduleassignment.DefaultUserID = workscheduleassignmentDescUserID.Default.(uint32)
+	// workscheduleassignmentDescUserName is the schema descriptor for user_name field.
+	workscheduleassignmentDescUserName := workscheduleassignmentFields[3].Descriptor()
+	// workscheduleassignment.DefaultUserName holds the default value on creation for the user_name field.
+	workscheduleassignment.DefaultUserName = workscheduleassignmentDescUserName.Default.(string)
+	// workscheduleassignmentDescOrgUnitName is the schema descriptor for org_unit_name field.
+	workscheduleassignmentDescOrgUnitName := workscheduleassignmentFields[4].Descriptor()
+	// workscheduleassignment.DefaultOrgUnitName holds the default value on creation for the org_unit_name field.
+	workscheduleassignment.DefaultOrgUnitName = workscheduleassignmentDescOrgUnitName.Default.(string)
+	// workscheduleassignmentDescID is the schema descriptor for id field.
+	workscheduleassignmentDescID := workscheduleassignmentFields[0].Descriptor()
+	// workscheduleassignment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	workscheduleassignment.IDValidator = workscheduleassignmentDescID.Validators[0].(func(string) error)
 }
 
 const (
@@ -9,6 +9,12 @@ import (
 	"entgo.io/ent/schema/index"
 
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"github.com/go-tangra/go-tangra-hr/internal/accrual"
+	"github.com/go-tangra/go-tangra-hr/internal/approval"
+	"github.com/go-tangra/go-tangra-hr/internal/attachment"
+	"github.com/go-tangra/go-tangra-hr/internal/escalation"
+	"github.com/go-tangra/go-tangra-hr/internal/rollover"
 )
 
 // AbsenceType represents a configurable type of absence (vacation, sick, etc.)
@@ -80,6 +86,31 @@ func (AbsenceType) Fields() []ent.Field {
 		field.String("allowance_pool_id").
 			Optional().
 			Comment("FK to AllowancePool — types sharing a pool share one allowance budget"),
+
+		field.Enum("unit").
+			Values("days", "hours").
+			Default("days").
+			Comment("Whether requests are booked in (half) days or in hours"),
+
+		field.JSON("accrual_policy", &accrual.Policy{}).
+			Optional().
+			Comment("How allowance days are earned over the year; unset when granted up front"),
+
+		field.JSON("rollover_policy", &rollover.Policy{}).
+			Optional().
+			Comment("How allowances are renewed and unused days carried over at year end"),
+
+		field.JSON("approval_chain", &approval.Chain{}).
+			Optional().
+			Comment("Approval steps requests pass through; unset when a single approval suffices"),
+
+		field.JSON("escalation_policy", &escalation.Policy{}).
+			Optional().
+			Comment("How requests awaiting a decision for too long are followed up; unset when they wait indefinitely"),
+
+		field.JSON("requires_attachment", &attachment.Requirement{}).
+			Optional().
+			Comment("Supporting documents requests need, e.g. a doctor's certificate; unset when none are needed"),
 	}
 }
 
@@ -9,6 +9,9 @@ import (
 	"entgo.io/ent/schema/index"
 
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"github.com/go-tangra/go-tangra-hr/internal/accrual"
+	"github.com/go-tangra/go-tangra-hr/internal/rollover"
 )
 
 // AllowancePool groups multiple absence types to share a single leave allowance budget.
@@ -48,6 +51,14 @@ func (AllowancePool) Fields() []ent.Field {
 			Optional().
 			MaxLen(100).
 			Comment("Lucide icon name"),
+
+		field.JSON("accrual_policy", &accrual.Policy{}).
+			Optional().
+			Comment("How pool allowance days are earned over the year; unset when granted up front"),
+
+		field.JSON("rollover_policy", &rollover.Policy{}).
+			Optional().
+			Comment("How pool allowances are renewed and unused days carried over at year end"),
 	}
 }
 
@@ -0,0 +1,99 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// AllowanceTransaction is an append-only ledger entry recording a change to a leave allowance.
+// The balance of an allowance is the sum of its entries: grants and adjustments change the
+// total days, carry-overs the carried-over days, deductions and refunds the used days.
+type AllowanceTransaction struct {
+	ent.Schema
+}
+
+func (AllowanceTransaction) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_allowance_transactions"},
+		entsql.WithComments(true),
+	}
+}
+
+func (AllowanceTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("Unique identifier"),
+
+		field.String("allowance_id").
+			NotEmpty().
+			Immutable().
+			Comment("LeaveAllowance the entry applies to (kept after the allowance is deleted)"),
+
+		field.Uint32("user_id").
+			Immutable().
+			Comment("Owner of the allowance"),
+
+		field.Int("year").
+			Immutable().
+			Comment("Allowance year"),
+
+		field.Enum("kind").
+			Values("grant", "deduction", "refund", "carry_over", "adjustment").
+			Immutable().
+			Comment("Type of change"),
+
+		field.Float("days").
+			Immutable().
+			Comment("Signed number of days changed"),
+
+		field.Float("balance_after").
+			Immutable().
+			Comment("Remaining days after the entry was applied"),
+
+		field.String("leave_request_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Leave request that caused the entry"),
+
+		field.Uint32("actor_id").
+			Optional().
+			Default(0).
+			Immutable().
+			Comment("User who caused the entry; 0 for system actions"),
+
+		field.String("actor_name").
+			Optional().
+			Default("").
+			Immutable().
+			Comment("Denormalized actor display name"),
+
+		field.Text("note").
+			Optional().
+			Immutable().
+			Comment("Reason for the entry"),
+	}
+}
+
+func (AllowanceTransaction) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (AllowanceTransaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("allowance_id", "create_time").StorageKey("idx_hr_alltx_allowance_time"),
+		index.Fields("tenant_id", "user_id", "year").StorageKey("idx_hr_alltx_tenant_user_year"),
+		index.Fields("leave_request_id").StorageKey("idx_hr_alltx_leave_request"),
+	}
+}
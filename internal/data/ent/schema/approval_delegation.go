@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ApprovalDelegation lets a delegate decide the leave requests assigned to an approver while the
+// approver is away.
+type ApprovalDelegation struct {
+	ent.Schema
+}
+
+func (ApprovalDelegation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_approval_delegations"},
+		entsql.WithComments(true),
+	}
+}
+
+func (ApprovalDelegation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.Uint32("delegator_id").
+			Comment("User ID of the approver who delegates"),
+
+		field.String("delegator_name").
+			Optional().
+			Default("").
+			Comment("Denormalized approver display name"),
+
+		field.Uint32("delegate_id").
+			Comment("User ID of the user who decides in place of the approver"),
+
+		field.String("delegate_name").
+			Optional().
+			Default("").
+			Comment("Denormalized delegate display name"),
+
+		field.Time("start_date").
+			Comment("First day of the delegation"),
+
+		field.Time("end_date").
+			Comment("Last day of the delegation"),
+
+		field.JSON("absence_type_ids", []string{}).
+			Optional().
+			Comment("Absence types the delegation covers; empty covers all types"),
+
+		field.String("leave_request_id").
+			Optional().
+			Default("").
+			Comment("Leave request of the approver the delegation was created for; empty when created manually"),
+
+		field.Text("notes").
+			Optional().
+			Comment("Notes"),
+	}
+}
+
+func (ApprovalDelegation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (ApprovalDelegation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "delegator_id", "end_date").StorageKey("idx_hr_delegation_tenant_delegator_end"),
+		index.Fields("tenant_id", "delegate_id", "end_date").StorageKey("idx_hr_delegation_tenant_delegate_end"),
+		index.Fields("leave_request_id").StorageKey("idx_hr_delegation_leave_request"),
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// BlackoutPeriod is a period in which no leave may be taken, e.g. a year-end close. Without
+// absence types or org units it applies to every request of the tenant.
+type BlackoutPeriod struct {
+	ent.Schema
+}
+
+func (BlackoutPeriod) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_blackout_periods"},
+		entsql.WithComments(true),
+	}
+}
+
+func (BlackoutPeriod) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Blackout period name"),
+
+		field.Time("start_date").
+			Comment("First day of the period"),
+
+		field.Time("end_date").
+			Comment("Last day of the period"),
+
+		field.JSON("absence_type_ids", []string{}).
+			Optional().
+			Comment("Absence types the period applies to; empty for all absence types"),
+
+		field.JSON("org_unit_names", []string{}).
+			Optional().
+			Comment("Org units the period applies to; empty for all org units"),
+
+		field.Text("notes").
+			Optional().
+			Comment("Notes"),
+	}
+}
+
+func (BlackoutPeriod) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (BlackoutPeriod) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "start_date", "end_date").StorageKey("idx_hr_blackout_tenant_dates"),
+	}
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// CoverageRule keeps enough of an org unit at work: at most count members absent at once, or at
+// least count members holding a position present. Requests that break a rule warn the requester
+// and approvers, or are refused when the rule blocks.
+type CoverageRule struct {
+	ent.Schema
+}
+
+func (CoverageRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_coverage_rules"},
+		entsql.WithComments(true),
+	}
+}
+
+func (CoverageRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Rule name"),
+
+		field.Text("description").
+			Optional().
+			Comment("Description"),
+
+		field.String("org_unit_name").
+			NotEmpty().
+			Comment("Org unit whose members the rule covers"),
+
+		field.Enum("kind").
+			Values("max_absent", "min_present").
+			Comment("max_absent caps the members absent at once; min_present requires members holding the position to be present"),
+
+		field.Int("count").
+			NonNegative().
+			Comment("Most members absent, or fewest holders of the position present"),
+
+		field.String("position").
+			Optional().
+			Default("").
+			Comment("Position keyword matched case-insensitively, for min_present rules"),
+
+		field.Enum("enforcement").
+			Values("warn", "block").
+			Default("warn").
+			Comment("Whether breaking the rule warns or refuses the request"),
+
+		field.JSON("absence_type_ids", []string{}).
+			Optional().
+			Comment("Absence types whose requests are checked; empty for all absence types"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether requests are checked against the rule"),
+	}
+}
+
+func (CoverageRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (CoverageRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique().StorageKey("idx_hr_coverage_rule_tenant_name"),
+		index.Fields("tenant_id", "org_unit_name").StorageKey("idx_hr_coverage_rule_tenant_org"),
+	}
+}
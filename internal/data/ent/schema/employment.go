@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// Employment records when a user joined and, once known, when they leave. Allowances of joiners
+// and leavers are pro-rated to the part of the year they are employed.
+type Employment struct {
+	ent.Schema
+}
+
+func (Employment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_employments"},
+		entsql.WithComments(true),
+	}
+}
+
+func (Employment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.Uint32("user_id").
+			Comment("FK to Portal User"),
+
+		field.String("user_name").
+			Optional().
+			Default("").
+			Comment("Denormalized user display name"),
+
+		field.Time("start_date").
+			Comment("First day of employment"),
+
+		field.Time("end_date").
+			Optional().
+			Nillable().
+			Comment("Last day of employment; unset while employment is ongoing"),
+
+		field.Text("notes").
+			Optional().
+			Comment("Notes"),
+	}
+}
+
+func (Employment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (Employment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id").Unique().StorageKey("idx_hr_employment_tenant_user"),
+		index.Fields("tenant_id", "end_date").StorageKey("idx_hr_employment_tenant_end"),
+	}
+}
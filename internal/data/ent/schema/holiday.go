@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// Holiday is a single public holiday within a holiday calendar.
+type Holiday struct {
+	ent.Schema
+}
+
+func (Holiday) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_holidays"},
+		entsql.WithComments(true),
+	}
+}
+
+func (Holiday) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.String("calendar_id").
+			NotEmpty().
+			Comment("FK to HolidayCalendar"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Holiday name (e.g. 'Christmas Day')"),
+
+		field.Time("date").
+			Comment("Date of the holiday; only month and day are used for recurring holidays"),
+
+		field.Bool("recurring").
+			Default(false).
+			Comment("Repeats every year on the same month and day"),
+	}
+}
+
+func (Holiday) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("calendar", HolidayCalendar.Type).
+			Ref("holidays").
+			Field("calendar_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (Holiday) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (Holiday) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("calendar_id", "date").StorageKey("idx_hr_holiday_calendar_date"),
+		index.Fields("tenant_id").StorageKey("idx_hr_holiday_tenant"),
+	}
+}
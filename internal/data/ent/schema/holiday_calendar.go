@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// HolidayCalendar is a set of public holidays observed in a country or region.
+type HolidayCalendar struct {
+	ent.Schema
+}
+
+func (HolidayCalendar) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_holiday_calendars"},
+		entsql.WithComments(true),
+	}
+}
+
+func (HolidayCalendar) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Calendar name (e.g. 'Germany - Bavaria')"),
+
+		field.Text("description").
+			Optional().
+			Comment("Description"),
+
+		field.String("country_code").
+			Optional().
+			Default("").
+			MaxLen(2).
+			Comment("ISO 3166-1 alpha-2 country code"),
+
+		field.String("region").
+			Optional().
+			Default("").
+			MaxLen(10).
+			Comment("ISO 3166-2 subdivision code (e.g. 'DE-BY')"),
+
+		field.Bool("is_default").
+			Default(false).
+			Comment("Used for employees whose org unit has no calendar assigned"),
+
+		field.JSON("org_unit_names", []string{}).
+			Optional().
+			Comment("Org units whose employees observe this calendar"),
+	}
+}
+
+func (HolidayCalendar) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("holidays", Holiday.Type),
+	}
+}
+
+func (HolidayCalendar) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (HolidayCalendar) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique().StorageKey("idx_hr_holcal_tenant_name"),
+		index.Fields("tenant_id").StorageKey("idx_hr_holcal_tenant"),
+	}
+}
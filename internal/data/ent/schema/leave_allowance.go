@@ -55,6 +55,11 @@ func (LeaveAllowance) Fields() []ent.Field {
 		field.Float("total_days").
 			Comment("Total allowed days (supports half-days)"),
 
+		field.Float("annual_days").
+			Optional().
+			Nillable().
+			Comment("Full-year entitlement total_days was pro-rated from; unset when not pro-rated"),
+
 		field.Float("used_days").
 			Default(0).
 			Comment("Consumed days"),
@@ -63,6 +68,24 @@ func (LeaveAllowance) Fields() []ent.Field {
 			Default(0).
 			Comment("Carried from previous year"),
 
+		field.Time("carry_over_expiry").
+			Optional().
+			Nillable().
+			Comment("Last day carried-over days can be used; unused carried-over days lapse afterwards"),
+
+		field.Float("overconsumed_days").
+			Default(0).
+			Comment("Days a leaver used beyond their pro-rated entitlement, to be settled in final pay"),
+
+		field.Float("accrued_days").
+			Default(0).
+			Comment("Days posted by accrual so far (included in total_days)"),
+
+		field.Time("accrual_start").
+			Optional().
+			Nillable().
+			Comment("Date the user starts accruing; defaults to 1 January of the year"),
+
 		field.Text("notes").
 			Optional().
 			Comment("Notes"),
@@ -0,0 +1,150 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+)
+
+// LeaveAmendment records a change to the dates of a leave request. Changes to pending requests
+// are applied at once; changes to approved requests await re-approval, and signatures when the
+// absence type requires signing, before they are applied. Approved requests are shortened at once,
+// e.g. on an early return.
+type LeaveAmendment struct {
+	ent.Schema
+}
+
+func (LeaveAmendment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_leave_amendments"},
+		entsql.WithComments(true),
+	}
+}
+
+func (LeaveAmendment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.String("leave_request_id").
+			NotEmpty().
+			Comment("Leave request the amendment changes"),
+
+		field.Uint32("user_id").
+			Comment("Denormalized requester user ID"),
+
+		field.Enum("kind").
+			Values("date_change", "shorten").
+			Default("date_change").
+			Comment("What the amendment changes"),
+
+		field.Enum("status").
+			Values("pending", "awaiting_signing", "applied", "rejected", "cancelled").
+			Default("pending").
+			Comment("Amendment status"),
+
+		field.Time("previous_start_date").
+			Comment("Start date of the request before the amendment"),
+
+		field.Time("previous_end_date").
+			Comment("End date of the request before the amendment"),
+
+		field.Enum("previous_start_day_part").
+			Values("am", "pm").
+			Default("am").
+			Comment("Start day part of the request before the amendment"),
+
+		field.Enum("previous_end_day_part").
+			Values("am", "pm").
+			Default("pm").
+			Comment("End day part of the request before the amendment"),
+
+		field.Float("previous_days").
+			Comment("Days of the request before the amendment"),
+
+		field.Time("start_date").
+			Comment("New start date"),
+
+		field.Time("end_date").
+			Comment("New end date"),
+
+		field.Enum("start_day_part").
+			Values("am", "pm").
+			Default("am").
+			Comment("New start day part"),
+
+		field.Enum("end_day_part").
+			Values("am", "pm").
+			Default("pm").
+			Comment("New end day part"),
+
+		field.Float("hours").
+			Default(0).
+			Comment("New hours for hour-based absence types"),
+
+		field.Float("days").
+			Comment("New calculated business days"),
+
+		field.String("holiday_calendar_id").
+			Optional().
+			Default("").
+			Comment("Holiday calendar used to calculate the new days; empty when days were entered manually"),
+
+		field.Text("reason").
+			Optional().
+			Comment("Requester's reason for the amendment"),
+
+		field.Uint32("reviewed_by").
+			Optional().
+			Default(0).
+			Comment("User ID of reviewer"),
+
+		field.String("reviewer_name").
+			Optional().
+			Default("").
+			Comment("Denormalized reviewer display name"),
+
+		field.Time("reviewed_at").
+			Optional().
+			Nillable().
+			Comment("When the amendment was reviewed"),
+
+		field.Text("review_notes").
+			Optional().
+			Comment("Reviewer's notes"),
+
+		field.String("signing_request_id").
+			Optional().
+			Default("").
+			Comment("Signing submission ID when the new dates must be signed"),
+
+		field.JSON("policy_override", &policy.Override{}).
+			Optional().
+			Comment("Leave policy violations an HR admin overrode, with the justification"),
+	}
+}
+
+func (LeaveAmendment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (LeaveAmendment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("leave_request_id", "status").StorageKey("idx_hr_leave_amendment_request_status"),
+		index.Fields("tenant_id", "status").StorageKey("idx_hr_leave_amendment_tenant_status"),
+		index.Fields("signing_request_id").StorageKey("idx_hr_leave_amendment_signing"),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// LeaveAttachment is a supporting document attached to a leave request, e.g. a doctor's
+// certificate. Only its metadata is kept here; the contents live in the blob storage backend.
+type LeaveAttachment struct {
+	ent.Schema
+}
+
+func (LeaveAttachment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_leave_attachments"},
+		entsql.WithComments(true),
+	}
+}
+
+func (LeaveAttachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.String("leave_request_id").
+			NotEmpty().
+			Comment("Leave request the document supports"),
+
+		field.String("file_name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Name of the uploaded file"),
+
+		field.String("content_type").
+			NotEmpty().
+			MaxLen(100).
+			Comment("Content type detected from the file contents"),
+
+		field.Int64("size").
+			NonNegative().
+			Comment("Size in bytes"),
+
+		field.String("checksum").
+			NotEmpty().
+			Comment("Hex-encoded SHA-256 of the contents"),
+
+		field.String("storage_key").
+			NotEmpty().
+			Comment("Key of the contents in the blob storage backend"),
+	}
+}
+
+func (LeaveAttachment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (LeaveAttachment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("leave_request_id").StorageKey("idx_hr_leave_attachment_request"),
+		index.Fields("tenant_id").StorageKey("idx_hr_leave_attachment_tenant"),
+	}
+}
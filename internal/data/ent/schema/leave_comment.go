@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// LeaveComment is a message in the discussion of a leave request between the requester, the
+// approvers and HR. Internal comments are only visible to HR.
+type LeaveComment struct {
+	ent.Schema
+}
+
+func (LeaveComment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_leave_comments"},
+		entsql.WithComments(true),
+	}
+}
+
+func (LeaveComment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.String("leave_request_id").
+			NotEmpty().
+			Comment("Leave request the comment discusses"),
+
+		field.Uint32("author_id").
+			Comment("User who wrote the comment"),
+
+		field.String("author_name").
+			Optional().
+			Comment("Denormalized author username"),
+
+		field.Text("body").
+			NotEmpty().
+			Comment("Comment text"),
+
+		field.Enum("visibility").
+			Values("shared", "internal").
+			Default("shared").
+			Comment("Who may read the comment: everyone on the request, or HR only"),
+
+		field.JSON("edits", []CommentEdit{}).
+			Optional().
+			Comment("Earlier versions of the comment, oldest first"),
+	}
+}
+
+func (LeaveComment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (LeaveComment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("leave_request_id", "create_time").StorageKey("idx_hr_leave_comment_request"),
+		index.Fields("tenant_id").StorageKey("idx_hr_leave_comment_tenant"),
+	}
+}
+
+// CommentEdit is a version of a comment replaced by an edit.
+type CommentEdit struct {
+	Body string `json:"body"`
+	// EditedAt is when this version was replaced.
+	EditedAt time.Time `json:"edited_at"`
+}
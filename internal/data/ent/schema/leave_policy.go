@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+)
+
+// LeavePolicy holds booking rules for leave requests, such as a notice period or a maximum
+// length. A policy applies to the requests of one absence type, one org unit, or both; empty
+// scopes match every request, so a policy without scopes applies tenant-wide.
+type LeavePolicy struct {
+	ent.Schema
+}
+
+func (LeavePolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_leave_policies"},
+		entsql.WithComments(true),
+	}
+}
+
+func (LeavePolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Policy name"),
+
+		field.Text("description").
+			Optional().
+			Comment("Description"),
+
+		field.String("absence_type_id").
+			Optional().
+			Default("").
+			Comment("Absence type the policy applies to; empty for all absence types"),
+
+		field.String("org_unit_name").
+			Optional().
+			Default("").
+			Comment("Org unit the policy applies to; empty for all org units"),
+
+		field.JSON("rules", &policy.Rules{}).
+			Comment("Booking rules"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether requests are checked against the policy"),
+	}
+}
+
+func (LeavePolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (LeavePolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique().StorageKey("idx_hr_leave_policy_tenant_name"),
+		index.Fields("tenant_id", "enabled").StorageKey("idx_hr_leave_policy_tenant_enabled"),
+	}
+}
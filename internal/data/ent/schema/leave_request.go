@@ -11,6 +11,9 @@ import (
 	"entgo.io/ent/schema/index"
 
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+	"github.com/go-tangra/go-tangra-hr/internal/recurrence"
 )
 
 // LeaveRequest represents a user's leave/absence request
@@ -60,6 +63,20 @@ func (LeaveRequest) Fields() []ent.Field {
 		field.Time("end_date").
 			Comment("End date of absence"),
 
+		field.Enum("start_day_part").
+			Values("am", "pm").
+			Default("am").
+			Comment("Half of the first day the absence starts in; pm means afternoon only"),
+
+		field.Enum("end_day_part").
+			Values("am", "pm").
+			Default("pm").
+			Comment("Half of the last day the absence ends in; am means morning only"),
+
+		field.Float("hours").
+			Default(0).
+			Comment("Requested hours for hour-based absence types; start and end dates then carry the time of day"),
+
 		field.Float("days").
 			Comment("Calculated business days"),
 
@@ -108,6 +125,80 @@ func (LeaveRequest) Fields() []ent.Field {
 			Optional().
 			Default("").
 			Comment("ID of the allowance record that was deducted, for accurate refunds"),
+
+		field.JSON("deductions", []LeaveDeduction{}).
+			Optional().
+			Comment("Allowances deducted for this request, one entry per allowance year"),
+
+		field.String("holiday_calendar_id").
+			Optional().
+			Default("").
+			Comment("Holiday calendar used to calculate days; empty when days were entered manually"),
+
+		field.JSON("approval_steps", []LeaveApproval{}).
+			Optional().
+			Comment("Approval chain steps of this request with their decisions; empty when a single approval suffices"),
+
+		field.Int("approval_step").
+			Default(0).
+			Comment("Index of the approval step awaiting a decision"),
+
+		field.Uint32("approver_id").
+			Default(0).
+			Comment("User ID of the approver the request is assigned to; 0 when any approver may decide it"),
+
+		field.Uint32("on_behalf_of").
+			Optional().
+			Default(0).
+			Comment("User ID of the approver the reviewer decided for as their delegate"),
+
+		field.String("on_behalf_of_name").
+			Optional().
+			Default("").
+			Comment("Denormalized display name of the approver the reviewer decided for"),
+
+		field.Time("awaiting_since").
+			Optional().
+			Nillable().
+			Comment("When the request started waiting for its current approval step or signatures"),
+
+		field.Int("escalation_level").
+			Default(0).
+			Comment("How far the wait for the current decision has been followed up: 0 none, 1 reminded, 2 escalated"),
+
+		field.JSON("policy_override", &policy.Override{}).
+			Optional().
+			Comment("Leave policy violations an HR admin overrode, with the justification"),
+
+		field.Int("attachment_count").
+			Default(0).
+			NonNegative().
+			Comment("Number of supporting documents attached"),
+
+		field.String("series_id").
+			Optional().
+			Default("").
+			Comment("Series of recurring requests the request is an occurrence of"),
+
+		field.JSON("recurrence", &recurrence.Rule{}).
+			Optional().
+			Comment("Recurrence rule of the series the request is an occurrence of"),
+
+		field.Enum("signing_outcome").
+			Values("completed", "declined", "expired", "cancelled").
+			Optional().
+			Nillable().
+			Comment("How the last signing submission of the request ended"),
+
+		field.Text("signing_outcome_reason").
+			Optional().
+			Default("").
+			Comment("Reason the signing service gave for a submission that did not complete"),
+
+		field.Time("signing_ended_at").
+			Optional().
+			Nillable().
+			Comment("When the last signing submission of the request ended"),
 	}
 }
 
@@ -134,9 +225,35 @@ func (LeaveRequest) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("tenant_id", "user_id", "start_date").StorageKey("idx_hr_leavereq_tenant_user_start"),
 		index.Fields("tenant_id", "status").StorageKey("idx_hr_leavereq_tenant_status"),
+		index.Fields("tenant_id", "approver_id", "status").StorageKey("idx_hr_leavereq_tenant_approver_status"),
 		index.Fields("tenant_id").StorageKey("idx_hr_leavereq_tenant"),
+		index.Fields("series_id", "start_date").StorageKey("idx_hr_leavereq_series_start"),
 	}
 }
 
 // Ensure time import is used
 var _ = time.Now
+
+// LeaveDeduction records the days a leave request took from one allowance.
+type LeaveDeduction struct {
+	AllowanceID string  `json:"allowance_id"`
+	Year        int     `json:"year"`
+	Days        float64 `json:"days"`
+}
+
+// LeaveApproval is one step of a leave request's approval chain and the decision taken on it.
+type LeaveApproval struct {
+	Name         string     `json:"name"`
+	Role         string     `json:"role,omitempty"`
+	ApproverIDs  []uint32   `json:"approver_ids,omitempty"`
+	Decision     string     `json:"decision"`
+	ApproverID   uint32     `json:"approver_id,omitempty"`
+	ApproverName string     `json:"approver_name,omitempty"`
+	DecidedAt    *time.Time `json:"decided_at,omitempty"`
+	Notes        string     `json:"notes,omitempty"`
+	// Override is set when an HR admin decided the step in place of the assigned approver
+	Override bool `json:"override,omitempty"`
+	// OnBehalfOf is the approver for whom a delegate decided the step
+	OnBehalfOf     uint32 `json:"on_behalf_of,omitempty"`
+	OnBehalfOfName string `json:"on_behalf_of_name,omitempty"`
+}
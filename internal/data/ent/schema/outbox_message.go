@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// OutboxMessage is a side effect of a change, e.g. a domain event or an email, written in the
+// same transaction as the change and delivered afterwards by the outbox relay, so that it is not
+// lost when the process dies or the delivery fails.
+type OutboxMessage struct {
+	ent.Schema
+}
+
+func (OutboxMessage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_outbox_messages"},
+		entsql.WithComments(true),
+	}
+}
+
+func (OutboxMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.String("kind").
+			NotEmpty().
+			Comment("What delivering the message does, e.g. event or rejection_email"),
+
+		field.JSON("payload", json.RawMessage{}).
+			Comment("Data the message is delivered with, depending on its kind"),
+
+		field.Enum("status").
+			Values("pending", "delivered", "dead").
+			Default("pending").
+			Comment("Delivery status: pending until delivered, dead once out of attempts"),
+
+		field.Int("attempts").
+			Default(0).
+			NonNegative().
+			Comment("Number of failed deliveries"),
+
+		field.Time("next_attempt_at").
+			Comment("When the relay delivers the message next"),
+
+		field.Text("last_error").
+			Optional().
+			Default("").
+			Comment("Error of the last failed delivery"),
+
+		field.Time("delivered_at").
+			Optional().
+			Nillable().
+			Comment("When the message was delivered"),
+	}
+}
+
+func (OutboxMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (OutboxMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at").StorageKey("idx_hr_outbox_status_next"),
+		index.Fields("tenant_id", "status").StorageKey("idx_hr_outbox_tenant_status"),
+	}
+}
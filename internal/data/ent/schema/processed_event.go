@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ProcessedEvent records an event from another service that has been handled, so that an event
+// delivered more than once, e.g. redelivered from a Redis stream, is handled only once.
+type ProcessedEvent struct {
+	ent.Schema
+}
+
+func (ProcessedEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_processed_events"},
+		entsql.WithComments(true),
+	}
+}
+
+func (ProcessedEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("ID of the event, from its envelope"),
+
+		field.String("event_type").
+			NotEmpty().
+			Comment("Type of the event, e.g. submission.completed"),
+
+		field.String("source").
+			Optional().
+			Default("").
+			Comment("Service that published the event"),
+	}
+}
+
+func (ProcessedEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (ProcessedEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("create_time").StorageKey("idx_hr_processed_events_create_time"),
+	}
+}
@@ -0,0 +1,109 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// WebhookDelivery is an attempt at delivering an event to a webhook subscription. An attempt is
+// pending until it is made; a failed attempt is followed by a pending one, after a backoff, until
+// the attempts run out. The attempts form the delivery log admins query and redeliver from.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_webhook_deliveries"},
+		entsql.WithComments(true),
+	}
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.String("subscription_id").
+			NotEmpty().
+			Comment("Webhook subscription the event is delivered to"),
+
+		field.String("event_id").
+			NotEmpty().
+			Comment("ID of the event delivered"),
+
+		field.String("event_type").
+			NotEmpty().
+			Comment("Type of the event delivered, e.g. leave.approved"),
+
+		field.JSON("payload", json.RawMessage{}).
+			Comment("Event envelope POSTed to the subscription"),
+
+		field.Int("attempt").
+			Positive().
+			Default(1).
+			Comment("Number of the attempt at delivering the event, from 1; a redelivery starts again from 1"),
+
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending").
+			Comment("Outcome of the attempt: pending until made"),
+
+		field.Time("next_attempt_at").
+			Comment("When the attempt is made"),
+
+		field.Time("attempted_at").
+			Optional().
+			Nillable().
+			Comment("When the attempt was made"),
+
+		field.Int("response_status").
+			Optional().
+			Default(0).
+			Comment("HTTP status the subscriber answered with; 0 when it did not answer"),
+
+		field.Text("response_body").
+			Optional().
+			Default("").
+			Comment("Start of the body the subscriber answered with"),
+
+		field.Text("error").
+			Optional().
+			Default("").
+			Comment("Why the attempt failed"),
+
+		field.Int64("duration_ms").
+			Optional().
+			Default(0).
+			Comment("Time the attempt took in milliseconds"),
+
+		field.Uint32("redelivered_by").
+			Optional().
+			Nillable().
+			Comment("Admin who redelivered the event, for the first attempt of a redelivery"),
+	}
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at").StorageKey("idx_hr_webhook_delivery_status_next"),
+		index.Fields("subscription_id", "event_id").StorageKey("idx_hr_webhook_delivery_subscription_event"),
+		index.Fields("tenant_id", "create_time").StorageKey("idx_hr_webhook_delivery_tenant_created"),
+	}
+}
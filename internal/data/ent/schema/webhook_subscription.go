@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// WebhookSubscription delivers the leave and allowance events of a tenant to a URL, as JSON POSTs
+// signed with the subscription's secret, for tools that cannot subscribe to Redis.
+type WebhookSubscription struct {
+	ent.Schema
+}
+
+func (WebhookSubscription) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_webhook_subscriptions"},
+		entsql.WithComments(true),
+	}
+}
+
+func (WebhookSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Subscription name"),
+
+		field.Text("description").
+			Optional().
+			Comment("Description"),
+
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048).
+			Comment("URL the events are POSTed to"),
+
+		field.JSON("event_types", []string{}).
+			Optional().
+			Comment("Types of the events delivered, e.g. leave.approved, or leave.* for all leave events; empty for all events"),
+
+		field.String("secret").
+			NotEmpty().
+			Sensitive().
+			Comment("Key the payloads are signed with, HMAC-SHA256"),
+
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether events are delivered"),
+	}
+}
+
+func (WebhookSubscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (WebhookSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique().StorageKey("idx_hr_webhook_subscription_tenant_name"),
+		index.Fields("tenant_id", "enabled").StorageKey("idx_hr_webhook_subscription_tenant_enabled"),
+	}
+}
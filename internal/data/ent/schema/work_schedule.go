@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// WorkSchedule is a weekly working pattern (e.g. 'Part-time Mon-Wed', 'Sun-Thu').
+type WorkSchedule struct {
+	ent.Schema
+}
+
+func (WorkSchedule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_work_schedules"},
+		entsql.WithComments(true),
+	}
+}
+
+func (WorkSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Schedule name"),
+
+		field.Text("description").
+			Optional().
+			Comment("Description"),
+
+		field.JSON("day_hours", []float64{}).
+			Comment("Hours worked on each weekday, indexed Sunday (0) to Saturday (6); 0 marks a non-working day"),
+	}
+}
+
+func (WorkSchedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("assignments", WorkScheduleAssignment.Type),
+	}
+}
+
+func (WorkSchedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (WorkSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique().StorageKey("idx_hr_worksched_tenant_name"),
+		index.Fields("tenant_id").StorageKey("idx_hr_worksched_tenant"),
+	}
+}
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// WorkScheduleAssignment puts a user or an org unit on a work schedule from a given date.
+// A user assignment takes precedence over the assignment of the user's org unit.
+type WorkScheduleAssignment struct {
+	ent.Schema
+}
+
+func (WorkScheduleAssignment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "hr_work_schedule_assignments"},
+		entsql.WithComments(true),
+	}
+}
+
+func (WorkScheduleAssignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Unique().
+			Comment("Unique identifier"),
+
+		field.String("schedule_id").
+			NotEmpty().
+			Comment("FK to WorkSchedule"),
+
+		field.Uint32("user_id").
+			Optional().
+			Default(0).
+			Comment("Assigned user; 0 for org unit assignments"),
+
+		field.String("user_name").
+			Optional().
+			Default("").
+			Comment("Denormalized user display name"),
+
+		field.String("org_unit_name").
+			Optional().
+			Default("").
+			Comment("Assigned org unit; empty for user assignments"),
+
+		field.Time("effective_from").
+			Comment("First day the schedule applies"),
+	}
+}
+
+func (WorkScheduleAssignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("schedule", WorkSchedule.Type).
+			Ref("assignments").
+			Field("schedule_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (WorkScheduleAssignment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateBy{},
+		mixin.UpdateBy{},
+		mixin.Time{},
+		mixin.TenantID[uint32]{},
+	}
+}
+
+func (WorkScheduleAssignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id", "effective_from").StorageKey("idx_hr_wsa_tenant_user_from"),
+		index.Fields("tenant_id", "org_unit_name", "effective_from").StorageKey("idx_hr_wsa_tenant_org_from"),
+		index.Fields("schedule_id").StorageKey("idx_hr_wsa_schedule"),
+	}
+}
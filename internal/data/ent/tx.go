@@ -16,12 +16,46 @@ type Tx struct {
 	AbsenceType *AbsenceTypeClient
 	// AllowancePool is the client for interacting with the AllowancePool builders.
 	AllowancePool *AllowancePoolClient
+	// AllowanceTransaction is the client for interacting with the AllowanceTransaction builders.
+	AllowanceTransaction *AllowanceTransactionClient
+	// ApprovalDelegation is the client for interacting with the ApprovalDelegation builders.
+	ApprovalDelegation *ApprovalDelegationClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
+	// BlackoutPeriod is the client for interacting with the BlackoutPeriod builders.
+	BlackoutPeriod *BlackoutPeriodClient
+	// CoverageRule is the client for interacting with the CoverageRule builders.
+	CoverageRule *CoverageRuleClient
+	// Employment is the client for interacting with the Employment builders.
+	Employment *EmploymentClient
+	// Holiday is the client for interacting with the Holiday builders.
+	Holiday *HolidayClient
+	// HolidayCalendar is the client for interacting with the HolidayCalendar builders.
+	HolidayCalendar *HolidayCalendarClient
 	// LeaveAllowance is the client for interacting with the LeaveAllowance builders.
 	LeaveAllowance *LeaveAllowanceClient
+	// LeaveAmendment is the client for interacting with the LeaveAmendment builders.
+	LeaveAmendment *LeaveAmendmentClient
+	// LeaveAttachment is the client for interacting with the LeaveAttachment builders.
+	LeaveAttachment *LeaveAttachmentClient
+	// LeaveComment is the client for interacting with the LeaveComment builders.
+	LeaveComment *LeaveCommentClient
+	// LeavePolicy is the client for interacting with the LeavePolicy builders.
+	LeavePolicy *LeavePolicyClient
 	// LeaveRequest is the client for interacting with the LeaveRequest builders.
 	LeaveRequest *LeaveRequestClient
+	// OutboxMessage is the client for interacting with the OutboxMessage builders.
+	OutboxMessage *OutboxMessageClient
+	// ProcessedEvent is the client for interacting with the ProcessedEvent builders.
+	ProcessedEvent *ProcessedEventClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
+	// WorkSchedule is the client for interacting with the WorkSchedule builders.
+	WorkSchedule *WorkScheduleClient
+	// WorkScheduleAssignment is the client for interacting with the WorkScheduleAssignment builders.
+	WorkScheduleAssignment *WorkScheduleAssignmentClient
 
 	// lazily loaded.
 	client     *Client
@@ -155,9 +189,26 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.AbsenceType = NewAbsenceTypeClient(tx.config)
 	tx.AllowancePool = NewAllowancePoolClient(tx.config)
+	tx.AllowanceTransaction = NewAllowanceTransactionClient(tx.config)
+	tx.ApprovalDelegation = NewApprovalDelegationClient(tx.config)
 	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.BlackoutPeriod = NewBlackoutPeriodClient(tx.config)
+	tx.CoverageRule = NewCoverageRuleClient(tx.config)
+	tx.Employment = NewEmploymentClient(tx.config)
+	tx.Holiday = NewHolidayClient(tx.config)
+	tx.HolidayCalendar = NewHolidayCalendarClient(tx.config)
 	tx.LeaveAllowance = NewLeaveAllowanceClient(tx.config)
+	tx.LeaveAmendment = NewLeaveAmendmentClient(tx.config)
+	tx.LeaveAttachment = NewLeaveAttachmentClient(tx.config)
+	tx.LeaveComment = NewLeaveCommentClient(tx.config)
+	tx.LeavePolicy = NewLeavePolicyClient(tx.config)
 	tx.LeaveRequest = NewLeaveRequestClient(tx.config)
+	tx.OutboxMessage = NewOutboxMessageClient(tx.config)
+	tx.ProcessedEvent = NewProcessedEventClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookSubscription = NewWebhookSubscriptionClient(tx.config)
+	tx.WorkSchedule = NewWorkScheduleClient(tx.config)
+	tx.WorkScheduleAssignment = NewWorkScheduleAssignmentClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,1242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/webhooksubscription"
+)
+
+// WebhookSubscriptionCreate is the builder for creating a WebhookSubscription entity.
+type WebhookSubscriptionCreate struct {
+	config
+	mutation *WebhookSubscriptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *WebhookSubscriptionCreate) SetCreateBy(v uint32) *WebhookSubscriptionCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *WebhookSubscriptionCreate) SetNillableCreateBy(v *uint32) *WebhookSubscriptionCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *WebhookSubscriptionCreate) SetUpdateBy(v uint32) *WebhookSubscriptionCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *WebhookSubscriptionCreate) SetNillableUpdateBy(v *uint32) *WebhookSubscriptionCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *WebhookSubscriptionCreate) SetCreateTime(v time.Time) *WebhookSubscriptionCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *WebhookSubscriptionCreate) SetNillableCreateTime(v *time.Time) *WebhookSubscriptionCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *WebhookSubscriptionCreate) SetUpdateTime(v time.Time) *WebhookSubscriptionCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *WebhookSubscriptionCreate) SetNillableUpdateTime(v *time.Time) *WebhookSubscriptionCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *WebhookSubscriptionCreate) SetDeleteTime(v time.Time) *WebhookSubscriptionCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *WebhookSubscriptionCreate) SetNillableDeleteTime(v *time.Time) *WebhookSubscriptionCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *WebhookSubscriptionCreate) SetTenantID(v uint32) *WebhookSubscriptionCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *WebhookSubscriptionCreate) SetNillableTenantID(v *uint32) *WebhookSubscriptionCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *WebhookSubscriptionCreate) SetName(v string) *WebhookSubscriptionCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *WebhookSubscriptionCreate) SetDescription(v string) *WebhookSubscriptionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *WebhookSubscriptionCreate) SetNillableDescription(v *string) *WebhookSubscriptionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *WebhookSubscriptionCreate) SetURL(v string) *WebhookSubscriptionCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetEventTypes sets the "event_types" field.
+func (_c *WebhookSubscriptionCreate) SetEventTypes(v []string) *WebhookSubscriptionCreate {
+	_c.mutation.SetEventTypes(v)
+	return _c
+}
+
+// SetSecret sets the "secret" field.
+func (_c *WebhookSubscriptionCreate) SetSecret(v string) *WebhookSubscriptionCreate {
+	_c.mutation.SetSecret(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *WebhookSubscriptionCreate) SetEnabled(v bool) *WebhookSubscriptionCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *WebhookSubscriptionCreate) SetNillableEnabled(v *bool) *WebhookSubscriptionCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WebhookSubscriptionCreate) SetID(v string) *WebhookSubscriptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the WebhookSubscriptionMutation object of the builder.
+func (_c *WebhookSubscriptionCreate) Mutation() *WebhookSubscriptionMutation {
+	return _c.mutation
+}
+
+// Save creates the WebhookSubscription in the database.
+func (_c *WebhookSubscriptionCreate) Save(ctx context.Context) (*WebhookSubscription, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WebhookSubscriptionCreate) SaveX(ctx context.Context) *WebhookSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebhookSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebhookSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WebhookSubscriptionCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := webhooksubscription.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := webhooksubscription.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WebhookSubscriptionCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WebhookSubscription.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := webhooksubscription.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WebhookSubscription.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "WebhookSubscription.url"`)}
+	}
+	if v, ok := _c.mutation.URL(); ok {
+		if err := webhooksubscription.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebhookSubscription.url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "WebhookSubscription.secret"`)}
+	}
+	if v, ok := _c.mutation.Secret(); ok {
+		if err := webhooksubscription.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "WebhookSubscription.secret": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "WebhookSubscription.enabled"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := webhooksubscription.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "WebhookSubscription.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *WebhookSubscriptionCreate) sqlSave(ctx context.Context) (*WebhookSubscription, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WebhookSubscription.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WebhookSubscriptionCreate) createSpec() (*WebhookSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookSubscription{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(webhooksubscription.Table, sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(webhooksubscription.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(webhooksubscription.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(webhooksubscription.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(webhooksubscription.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(webhooksubscription.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(webhooksubscription.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(webhooksubscription.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(webhooksubscription.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(webhooksubscription.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.EventTypes(); ok {
+		_spec.SetField(webhooksubscription.FieldEventTypes, field.TypeJSON, value)
+		_node.EventTypes = value
+	}
+	if value, ok := _c.mutation.Secret(); ok {
+		_spec.SetField(webhooksubscription.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(webhooksubscription.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookSubscription.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookSubscriptionUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WebhookSubscriptionCreate) OnConflict(opts ...sql.ConflictOption) *WebhookSubscriptionUpsertOne {
+	_c.conflict = opts
+	return &WebhookSubscriptionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WebhookSubscriptionCreate) OnConflictColumns(columns ...string) *WebhookSubscriptionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WebhookSubscriptionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WebhookSubscriptionUpsertOne is the builder for "upsert"-ing
+	//  one WebhookSubscription node.
+	WebhookSubscriptionUpsertOne struct {
+		create *WebhookSubscriptionCreate
+	}
+
+	// WebhookSubscriptionUpsert is the "OnConflict" setter.
+	WebhookSubscriptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *WebhookSubscriptionUpsert) SetCreateBy(v uint32) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateCreateBy() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *WebhookSubscriptionUpsert) AddCreateBy(v uint32) *WebhookSubscriptionUpsert {
+	u.Add(webhooksubscription.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *WebhookSubscriptionUpsert) ClearCreateBy() *WebhookSubscriptionUpsert {
+	u.SetNull(webhooksubscription.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *WebhookSubscriptionUpsert) SetUpdateBy(v uint32) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateUpdateBy() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *WebhookSubscriptionUpsert) AddUpdateBy(v uint32) *WebhookSubscriptionUpsert {
+	u.Add(webhooksubscription.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *WebhookSubscriptionUpsert) ClearUpdateBy() *WebhookSubscriptionUpsert {
+	u.SetNull(webhooksubscription.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WebhookSubscriptionUpsert) SetUpdateTime(v time.Time) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateUpdateTime() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *WebhookSubscriptionUpsert) ClearUpdateTime() *WebhookSubscriptionUpsert {
+	u.SetNull(webhooksubscription.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *WebhookSubscriptionUpsert) SetDeleteTime(v time.Time) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateDeleteTime() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *WebhookSubscriptionUpsert) ClearDeleteTime() *WebhookSubscriptionUpsert {
+	u.SetNull(webhooksubscription.FieldDeleteTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *WebhookSubscriptionUpsert) SetName(v string) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateName() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *WebhookSubscriptionUpsert) SetDescription(v string) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateDescription() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *WebhookSubscriptionUpsert) ClearDescription() *WebhookSubscriptionUpsert {
+	u.SetNull(webhooksubscription.FieldDescription)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *WebhookSubscriptionUpsert) SetURL(v string) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateURL() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldURL)
+	return u
+}
+
+// SetEventTypes sets the "event_types" field.
+func (u *WebhookSubscriptionUpsert) SetEventTypes(v []string) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldEventTypes, v)
+	return u
+}
+
+// UpdateEventTypes sets the "event_types" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateEventTypes() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldEventTypes)
+	return u
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (u *WebhookSubscriptionUpsert) ClearEventTypes() *WebhookSubscriptionUpsert {
+	u.SetNull(webhooksubscription.FieldEventTypes)
+	return u
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebhookSubscriptionUpsert) SetSecret(v string) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateSecret() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldSecret)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *WebhookSubscriptionUpsert) SetEnabled(v bool) *WebhookSubscriptionUpsert {
+	u.Set(webhooksubscription.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsert) UpdateEnabled() *WebhookSubscriptionUpsert {
+	u.SetExcluded(webhooksubscription.FieldEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WebhookSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhooksubscription.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookSubscriptionUpsertOne) UpdateNewValues() *WebhookSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(webhooksubscription.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(webhooksubscription.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(webhooksubscription.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookSubscription.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebhookSubscriptionUpsertOne) Ignore() *WebhookSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookSubscriptionUpsertOne) DoNothing() *WebhookSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookSubscriptionCreate.OnConflict
+// documentation for more info.
+func (u *WebhookSubscriptionUpsertOne) Update(set func(*WebhookSubscriptionUpsert)) *WebhookSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *WebhookSubscriptionUpsertOne) SetCreateBy(v uint32) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *WebhookSubscriptionUpsertOne) AddCreateBy(v uint32) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateCreateBy() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *WebhookSubscriptionUpsertOne) ClearCreateBy() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *WebhookSubscriptionUpsertOne) SetUpdateBy(v uint32) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *WebhookSubscriptionUpsertOne) AddUpdateBy(v uint32) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateUpdateBy() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *WebhookSubscriptionUpsertOne) ClearUpdateBy() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WebhookSubscriptionUpsertOne) SetUpdateTime(v time.Time) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateUpdateTime() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *WebhookSubscriptionUpsertOne) ClearUpdateTime() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *WebhookSubscriptionUpsertOne) SetDeleteTime(v time.Time) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateDeleteTime() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *WebhookSubscriptionUpsertOne) ClearDeleteTime() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *WebhookSubscriptionUpsertOne) SetName(v string) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateName() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *WebhookSubscriptionUpsertOne) SetDescription(v string) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateDescription() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *WebhookSubscriptionUpsertOne) ClearDescription() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *WebhookSubscriptionUpsertOne) SetURL(v string) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateURL() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetEventTypes sets the "event_types" field.
+func (u *WebhookSubscriptionUpsertOne) SetEventTypes(v []string) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetEventTypes(v)
+	})
+}
+
+// UpdateEventTypes sets the "event_types" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateEventTypes() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateEventTypes()
+	})
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (u *WebhookSubscriptionUpsertOne) ClearEventTypes() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.ClearEventTypes()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebhookSubscriptionUpsertOne) SetSecret(v string) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateSecret() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *WebhookSubscriptionUpsertOne) SetEnabled(v bool) *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertOne) UpdateEnabled() *WebhookSubscriptionUpsertOne {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookSubscriptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebhookSubscriptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookSubscriptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebhookSubscriptionUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WebhookSubscriptionUpsertOne.ID is not supported by MySQL driver. Use WebhookSubscriptionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebhookSubscriptionUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WebhookSubscriptionCreateBulk is the builder for creating many WebhookSubscription entities in bulk.
+type WebhookSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookSubscriptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WebhookSubscription entities in the database.
+func (_c *WebhookSubscriptionCreateBulk) Save(ctx context.Context) ([]*WebhookSubscription, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WebhookSubscription, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WebhookSubscriptionCreateBulk) SaveX(ctx context.Context) []*WebhookSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebhookSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebhookSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookSubscription.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookSubscriptionUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WebhookSubscriptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebhookSubscriptionUpsertBulk {
+	_c.conflict = opts
+	return &WebhookSubscriptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WebhookSubscriptionCreateBulk) OnConflictColumns(columns ...string) *WebhookSubscriptionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WebhookSubscriptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// WebhookSubscriptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of WebhookSubscription nodes.
+type WebhookSubscriptionUpsertBulk struct {
+	create *WebhookSubscriptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WebhookSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhooksubscription.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookSubscriptionUpsertBulk) UpdateNewValues() *WebhookSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(webhooksubscription.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(webhooksubscription.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(webhooksubscription.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookSubscription.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebhookSubscriptionUpsertBulk) Ignore() *WebhookSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookSubscriptionUpsertBulk) DoNothing() *WebhookSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookSubscriptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebhookSubscriptionUpsertBulk) Update(set func(*WebhookSubscriptionUpsert)) *WebhookSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *WebhookSubscriptionUpsertBulk) SetCreateBy(v uint32) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *WebhookSubscriptionUpsertBulk) AddCreateBy(v uint32) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateCreateBy() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *WebhookSubscriptionUpsertBulk) ClearCreateBy() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *WebhookSubscriptionUpsertBulk) SetUpdateBy(v uint32) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *WebhookSubscriptionUpsertBulk) AddUpdateBy(v uint32) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateUpdateBy() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *WebhookSubscriptionUpsertBulk) ClearUpdateBy() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WebhookSubscriptionUpsertBulk) SetUpdateTime(v time.Time) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateUpdateTime() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *WebhookSubscriptionUpsertBulk) ClearUpdateTime() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *WebhookSubscriptionUpsertBulk) SetDeleteTime(v time.Time) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateDeleteTime() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *WebhookSubscriptionUpsertBulk) ClearDeleteTime() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *WebhookSubscriptionUpsertBulk) SetName(v string) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateName() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *WebhookSubscriptionUpsertBulk) SetDescription(v string) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateDescription() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *WebhookSubscriptionUpsertBulk) ClearDescription() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *WebhookSubscriptionUpsertBulk) SetURL(v string) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateURL() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetEventTypes sets the "event_types" field.
+func (u *WebhookSubscriptionUpsertBulk) SetEventTypes(v []string) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetEventTypes(v)
+	})
+}
+
+// UpdateEventTypes sets the "event_types" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateEventTypes() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateEventTypes()
+	})
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (u *WebhookSubscriptionUpsertBulk) ClearEventTypes() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.ClearEventTypes()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebhookSubscriptionUpsertBulk) SetSecret(v string) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateSecret() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *WebhookSubscriptionUpsertBulk) SetEnabled(v bool) *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *WebhookSubscriptionUpsertBulk) UpdateEnabled() *WebhookSubscriptionUpsertBulk {
+	return u.Update(func(s *WebhookSubscriptionUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookSubscriptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WebhookSubscriptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebhookSubscriptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookSubscriptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
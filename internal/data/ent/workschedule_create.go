@@ -0,0 +1,1076 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workschedule"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workscheduleassignment"
+)
+
+// WorkScheduleCreate is the builder for creating a WorkSchedule entity.
+type WorkScheduleCreate struct {
+	config
+	mutation *WorkScheduleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *WorkScheduleCreate) SetCreateBy(v uint32) *WorkScheduleCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *WorkScheduleCreate) SetNillableCreateBy(v *uint32) *WorkScheduleCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *WorkScheduleCreate) SetUpdateBy(v uint32) *WorkScheduleCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *WorkScheduleCreate) SetNillableUpdateBy(v *uint32) *WorkScheduleCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *WorkScheduleCreate) SetCreateTime(v time.Time) *WorkScheduleCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *WorkScheduleCreate) SetNillableCreateTime(v *time.Time) *WorkScheduleCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *WorkScheduleCreate) SetUpdateTime(v time.Time) *WorkScheduleCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *WorkScheduleCreate) SetNillableUpdateTime(v *time.Time) *WorkScheduleCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *WorkScheduleCreate) SetDeleteTime(v time.Time) *WorkScheduleCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *WorkScheduleCreate) SetNillableDeleteTime(v *time.Time) *WorkScheduleCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *WorkScheduleCreate) SetTenantID(v uint32) *WorkScheduleCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *WorkScheduleCreate) SetNillableTenantID(v *uint32) *WorkScheduleCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *WorkScheduleCreate) SetName(v string) *WorkScheduleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *WorkScheduleCreate) SetDescription(v string) *WorkScheduleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *WorkScheduleCreate) SetNillableDescription(v *string) *WorkScheduleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetDayHours sets the "day_hours" field.
+func (_c *WorkScheduleCreate) SetDayHours(v []float64) *WorkScheduleCreate {
+	_c.mutation.SetDayHours(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WorkScheduleCreate) SetID(v string) *WorkScheduleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the WorkScheduleAssignment entity by IDs.
+func (_c *WorkScheduleCreate) AddAssignmentIDs(ids ...string) *WorkScheduleCreate {
+	_c.mutation.AddAssignmentIDs(ids...)
+	return _c
+}
+
+// AddAssignments adds the "assignments" edges to the WorkScheduleAssignment entity.
+func (_c *WorkScheduleCreate) AddAssignments(v ...*WorkScheduleAssignment) *WorkScheduleCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAssignmentIDs(ids...)
+}
+
+// Mutation returns the WorkScheduleMutation object of the builder.
+func (_c *WorkScheduleCreate) Mutation() *WorkScheduleMutation {
+	return _c.mutation
+}
+
+// Save creates the WorkSchedule in the database.
+func (_c *WorkScheduleCreate) Save(ctx context.Context) (*WorkSchedule, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WorkScheduleCreate) SaveX(ctx context.Context) *WorkSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkScheduleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkScheduleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WorkScheduleCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := workschedule.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WorkScheduleCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WorkSchedule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := workschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DayHours(); !ok {
+		return &ValidationError{Name: "day_hours", err: errors.New(`ent: missing required field "WorkSchedule.day_hours"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := workschedule.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "WorkSchedule.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *WorkScheduleCreate) sqlSave(ctx context.Context) (*WorkSchedule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WorkSchedule.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WorkScheduleCreate) createSpec() (*WorkSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WorkSchedule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(workschedule.Table, sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(workschedule.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(workschedule.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(workschedule.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(workschedule.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(workschedule.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(workschedule.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(workschedule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(workschedule.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.DayHours(); ok {
+		_spec.SetField(workschedule.FieldDayHours, field.TypeJSON, value)
+		_node.DayHours = value
+	}
+	if nodes := _c.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workschedule.AssignmentsTable,
+			Columns: []string{workschedule.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workscheduleassignment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WorkSchedule.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkScheduleUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WorkScheduleCreate) OnConflict(opts ...sql.ConflictOption) *WorkScheduleUpsertOne {
+	_c.conflict = opts
+	return &WorkScheduleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WorkSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WorkScheduleCreate) OnConflictColumns(columns ...string) *WorkScheduleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WorkScheduleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WorkScheduleUpsertOne is the builder for "upsert"-ing
+	//  one WorkSchedule node.
+	WorkScheduleUpsertOne struct {
+		create *WorkScheduleCreate
+	}
+
+	// WorkScheduleUpsert is the "OnConflict" setter.
+	WorkScheduleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *WorkScheduleUpsert) SetCreateBy(v uint32) *WorkScheduleUpsert {
+	u.Set(workschedule.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *WorkScheduleUpsert) UpdateCreateBy() *WorkScheduleUpsert {
+	u.SetExcluded(workschedule.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *WorkScheduleUpsert) AddCreateBy(v uint32) *WorkScheduleUpsert {
+	u.Add(workschedule.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *WorkScheduleUpsert) ClearCreateBy() *WorkScheduleUpsert {
+	u.SetNull(workschedule.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *WorkScheduleUpsert) SetUpdateBy(v uint32) *WorkScheduleUpsert {
+	u.Set(workschedule.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *WorkScheduleUpsert) UpdateUpdateBy() *WorkScheduleUpsert {
+	u.SetExcluded(workschedule.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *WorkScheduleUpsert) AddUpdateBy(v uint32) *WorkScheduleUpsert {
+	u.Add(workschedule.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *WorkScheduleUpsert) ClearUpdateBy() *WorkScheduleUpsert {
+	u.SetNull(workschedule.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WorkScheduleUpsert) SetUpdateTime(v time.Time) *WorkScheduleUpsert {
+	u.Set(workschedule.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WorkScheduleUpsert) UpdateUpdateTime() *WorkScheduleUpsert {
+	u.SetExcluded(workschedule.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *WorkScheduleUpsert) ClearUpdateTime() *WorkScheduleUpsert {
+	u.SetNull(workschedule.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *WorkScheduleUpsert) SetDeleteTime(v time.Time) *WorkScheduleUpsert {
+	u.Set(workschedule.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *WorkScheduleUpsert) UpdateDeleteTime() *WorkScheduleUpsert {
+	u.SetExcluded(workschedule.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *WorkScheduleUpsert) ClearDeleteTime() *WorkScheduleUpsert {
+	u.SetNull(workschedule.FieldDeleteTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *WorkScheduleUpsert) SetName(v string) *WorkScheduleUpsert {
+	u.Set(workschedule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WorkScheduleUpsert) UpdateName() *WorkScheduleUpsert {
+	u.SetExcluded(workschedule.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *WorkScheduleUpsert) SetDescription(v string) *WorkScheduleUpsert {
+	u.Set(workschedule.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WorkScheduleUpsert) UpdateDescription() *WorkScheduleUpsert {
+	u.SetExcluded(workschedule.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *WorkScheduleUpsert) ClearDescription() *WorkScheduleUpsert {
+	u.SetNull(workschedule.FieldDescription)
+	return u
+}
+
+// SetDayHours sets the "day_hours" field.
+func (u *WorkScheduleUpsert) SetDayHours(v []float64) *WorkScheduleUpsert {
+	u.Set(workschedule.FieldDayHours, v)
+	return u
+}
+
+// UpdateDayHours sets the "day_hours" field to the value that was provided on create.
+func (u *WorkScheduleUpsert) UpdateDayHours() *WorkScheduleUpsert {
+	u.SetExcluded(workschedule.FieldDayHours)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WorkSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(workschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WorkScheduleUpsertOne) UpdateNewValues() *WorkScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(workschedule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(workschedule.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(workschedule.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WorkSchedule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WorkScheduleUpsertOne) Ignore() *WorkScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkScheduleUpsertOne) DoNothing() *WorkScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkScheduleCreate.OnConflict
+// documentation for more info.
+func (u *WorkScheduleUpsertOne) Update(set func(*WorkScheduleUpsert)) *WorkScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *WorkScheduleUpsertOne) SetCreateBy(v uint32) *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *WorkScheduleUpsertOne) AddCreateBy(v uint32) *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *WorkScheduleUpsertOne) UpdateCreateBy() *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *WorkScheduleUpsertOne) ClearCreateBy() *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *WorkScheduleUpsertOne) SetUpdateBy(v uint32) *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *WorkScheduleUpsertOne) AddUpdateBy(v uint32) *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *WorkScheduleUpsertOne) UpdateUpdateBy() *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *WorkScheduleUpsertOne) ClearUpdateBy() *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WorkScheduleUpsertOne) SetUpdateTime(v time.Time) *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WorkScheduleUpsertOne) UpdateUpdateTime() *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *WorkScheduleUpsertOne) ClearUpdateTime() *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *WorkScheduleUpsertOne) SetDeleteTime(v time.Time) *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *WorkScheduleUpsertOne) UpdateDeleteTime() *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *WorkScheduleUpsertOne) ClearDeleteTime() *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *WorkScheduleUpsertOne) SetName(v string) *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WorkScheduleUpsertOne) UpdateName() *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *WorkScheduleUpsertOne) SetDescription(v string) *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WorkScheduleUpsertOne) UpdateDescription() *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *WorkScheduleUpsertOne) ClearDescription() *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetDayHours sets the "day_hours" field.
+func (u *WorkScheduleUpsertOne) SetDayHours(v []float64) *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.SetDayHours(v)
+	})
+}
+
+// UpdateDayHours sets the "day_hours" field to the value that was provided on create.
+func (u *WorkScheduleUpsertOne) UpdateDayHours() *WorkScheduleUpsertOne {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.UpdateDayHours()
+	})
+}
+
+// Exec executes the query.
+func (u *WorkScheduleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkScheduleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkScheduleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WorkScheduleUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WorkScheduleUpsertOne.ID is not supported by MySQL driver. Use WorkScheduleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WorkScheduleUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WorkScheduleCreateBulk is the builder for creating many WorkSchedule entities in bulk.
+type WorkScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*WorkScheduleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WorkSchedule entities in the database.
+func (_c *WorkScheduleCreateBulk) Save(ctx context.Context) ([]*WorkSchedule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WorkSchedule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WorkScheduleCreateBulk) SaveX(ctx context.Context) []*WorkSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WorkSchedule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkScheduleUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WorkScheduleCreateBulk) OnConflict(opts ...sql.ConflictOption) *WorkScheduleUpsertBulk {
+	_c.conflict = opts
+	return &WorkScheduleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WorkSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WorkScheduleCreateBulk) OnConflictColumns(columns ...string) *WorkScheduleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WorkScheduleUpsertBulk{
+		create: _c,
+	}
+}
+
+// WorkScheduleUpsertBulk is the builder for "upsert"-ing
+// a bulk of WorkSchedule nodes.
+type WorkScheduleUpsertBulk struct {
+	create *WorkScheduleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WorkSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(workschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WorkScheduleUpsertBulk) UpdateNewValues() *WorkScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(workschedule.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(workschedule.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(workschedule.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WorkSchedule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WorkScheduleUpsertBulk) Ignore() *WorkScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkScheduleUpsertBulk) DoNothing() *WorkScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkScheduleCreateBulk.OnConflict
+// documentation for more info.
+func (u *WorkScheduleUpsertBulk) Update(set func(*WorkScheduleUpsert)) *WorkScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *WorkScheduleUpsertBulk) SetCreateBy(v uint32) *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *WorkScheduleUpsertBulk) AddCreateBy(v uint32) *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *WorkScheduleUpsertBulk) UpdateCreateBy() *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *WorkScheduleUpsertBulk) ClearCreateBy() *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *WorkScheduleUpsertBulk) SetUpdateBy(v uint32) *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *WorkScheduleUpsertBulk) AddUpdateBy(v uint32) *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *WorkScheduleUpsertBulk) UpdateUpdateBy() *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *WorkScheduleUpsertBulk) ClearUpdateBy() *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WorkScheduleUpsertBulk) SetUpdateTime(v time.Time) *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WorkScheduleUpsertBulk) UpdateUpdateTime() *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *WorkScheduleUpsertBulk) ClearUpdateTime() *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *WorkScheduleUpsertBulk) SetDeleteTime(v time.Time) *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *WorkScheduleUpsertBulk) UpdateDeleteTime() *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *WorkScheduleUpsertBulk) ClearDeleteTime() *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *WorkScheduleUpsertBulk) SetName(v string) *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WorkScheduleUpsertBulk) UpdateName() *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *WorkScheduleUpsertBulk) SetDescription(v string) *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WorkScheduleUpsertBulk) UpdateDescription() *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *WorkScheduleUpsertBulk) ClearDescription() *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetDayHours sets the "day_hours" field.
+func (u *WorkScheduleUpsertBulk) SetDayHours(v []float64) *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.SetDayHours(v)
+	})
+}
+
+// UpdateDayHours sets the "day_hours" field to the value that was provided on create.
+func (u *WorkScheduleUpsertBulk) UpdateDayHours() *WorkScheduleUpsertBulk {
+	return u.Update(func(s *WorkScheduleUpsert) {
+		s.UpdateDayHours()
+	})
+}
+
+// Exec executes the query.
+func (u *WorkScheduleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WorkScheduleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkScheduleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkScheduleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workschedule"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workscheduleassignment"
+)
+
+// WorkScheduleAssignment is the model entity for the WorkScheduleAssignment schema.
+type WorkScheduleAssignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique identifier
+	ID string `json:"id,omitempty"`
+	// 创建者ID
+	CreateBy *uint32 `json:"create_by,omitempty"`
+	// 更新者ID
+	UpdateBy *uint32 `json:"update_by,omitempty"`
+	// 创建时间
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// FK to WorkSchedule
+	ScheduleID string `json:"schedule_id,omitempty"`
+	// Assigned user; 0 for org unit assignments
+	UserID uint32 `json:"user_id,omitempty"`
+	// Denormalized user display name
+	UserName string `json:"user_name,omitempty"`
+	// Assigned org unit; empty for user assignments
+	OrgUnitName string `json:"org_unit_name,omitempty"`
+	// First day the schedule applies
+	EffectiveFrom time.Time `json:"effective_from,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorkScheduleAssignmentQuery when eager-loading is set.
+	Edges        WorkScheduleAssignmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WorkScheduleAssignmentEdges holds the relations/edges for other nodes in the graph.
+type WorkScheduleAssignmentEdges struct {
+	// Schedule holds the value of the schedule edge.
+	Schedule *WorkSchedule `json:"schedule,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ScheduleOrErr returns the Schedule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkScheduleAssignmentEdges) ScheduleOrErr() (*WorkSchedule, error) {
+	if e.Schedule != nil {
+		return e.Schedule, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workschedule.Label}
+	}
+	return nil, &NotLoadedError{edge: "schedule"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WorkScheduleAssignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workscheduleassignment.FieldCreateBy, workscheduleassignment.FieldUpdateBy, workscheduleassignment.FieldTenantID, workscheduleassignment.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case workscheduleassignment.FieldID, workscheduleassignment.FieldScheduleID, workscheduleassignment.FieldUserName, workscheduleassignment.FieldOrgUnitName:
+			values[i] = new(sql.NullString)
+		case workscheduleassignment.FieldCreateTime, workscheduleassignment.FieldUpdateTime, workscheduleassignment.FieldDeleteTime, workscheduleassignment.FieldEffectiveFrom:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WorkScheduleAssignment fields.
+func (_m *WorkScheduleAssignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workscheduleassignment.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case workscheduleassignment.FieldCreateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field create_by", values[i])
+			} else if value.Valid {
+				_m.CreateBy = new(uint32)
+				*_m.CreateBy = uint32(value.Int64)
+			}
+		case workscheduleassignment.FieldUpdateBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_by", values[i])
+			} else if value.Valid {
+				_m.UpdateBy = new(uint32)
+				*_m.UpdateBy = uint32(value.Int64)
+			}
+		case workscheduleassignment.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = new(time.Time)
+				*_m.CreateTime = value.Time
+			}
+		case workscheduleassignment.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = new(time.Time)
+				*_m.UpdateTime = value.Time
+			}
+		case workscheduleassignment.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
+		case workscheduleassignment.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case workscheduleassignment.FieldScheduleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_id", values[i])
+			} else if value.Valid {
+				_m.ScheduleID = value.String
+			}
+		case workscheduleassignment.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = uint32(value.Int64)
+			}
+		case workscheduleassignment.FieldUserName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_name", values[i])
+			} else if value.Valid {
+				_m.UserName = value.String
+			}
+		case workscheduleassignment.FieldOrgUnitName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field org_unit_name", values[i])
+			} else if value.Valid {
+				_m.OrgUnitName = value.String
+			}
+		case workscheduleassignment.FieldEffectiveFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field effective_from", values[i])
+			} else if value.Valid {
+				_m.EffectiveFrom = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WorkScheduleAssignment.
+// This includes values selected through modifiers, order, etc.
+func (_m *WorkScheduleAssignment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySchedule queries the "schedule" edge of the WorkScheduleAssignment entity.
+func (_m *WorkScheduleAssignment) QuerySchedule() *WorkScheduleQuery {
+	return NewWorkScheduleAssignmentClient(_m.config).QuerySchedule(_m)
+}
+
+// Update returns a builder for updating this WorkScheduleAssignment.
+// Note that you need to call WorkScheduleAssignment.Unwrap() before calling this method if this WorkScheduleAssignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WorkScheduleAssignment) Update() *WorkScheduleAssignmentUpdateOne {
+	return NewWorkScheduleAssignmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WorkScheduleAssignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WorkScheduleAssignment) Unwrap() *WorkScheduleAssignment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WorkScheduleAssignment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WorkScheduleAssignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("WorkScheduleAssignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreateBy; v != nil {
+		builder.WriteString("create_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateBy; v != nil {
+		builder.WriteString("update_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("schedule_id=")
+	builder.WriteString(_m.ScheduleID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("user_name=")
+	builder.WriteString(_m.UserName)
+	builder.WriteString(", ")
+	builder.WriteString("org_unit_name=")
+	builder.WriteString(_m.OrgUnitName)
+	builder.WriteString(", ")
+	builder.WriteString("effective_from=")
+	builder.WriteString(_m.EffectiveFrom.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WorkScheduleAssignments is a parsable slice of WorkScheduleAssignment.
+type WorkScheduleAssignments []*WorkScheduleAssignment
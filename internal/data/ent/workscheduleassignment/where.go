@@ -0,0 +1,764 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workscheduleassignment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateBy applies equality check predicate on the "create_by" field. It's identical to CreateByEQ.
+func CreateBy(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// UpdateBy applies equality check predicate on the "update_by" field. It's identical to UpdateByEQ.
+func UpdateBy(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ScheduleID applies equality check predicate on the "schedule_id" field. It's identical to ScheduleIDEQ.
+func ScheduleID(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserName applies equality check predicate on the "user_name" field. It's identical to UserNameEQ.
+func UserName(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldUserName, v))
+}
+
+// OrgUnitName applies equality check predicate on the "org_unit_name" field. It's identical to OrgUnitNameEQ.
+func OrgUnitName(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldOrgUnitName, v))
+}
+
+// EffectiveFrom applies equality check predicate on the "effective_from" field. It's identical to EffectiveFromEQ.
+func EffectiveFrom(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// CreateByEQ applies the EQ predicate on the "create_by" field.
+func CreateByEQ(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldCreateBy, v))
+}
+
+// CreateByNEQ applies the NEQ predicate on the "create_by" field.
+func CreateByNEQ(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNEQ(FieldCreateBy, v))
+}
+
+// CreateByIn applies the In predicate on the "create_by" field.
+func CreateByIn(vs ...uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIn(FieldCreateBy, vs...))
+}
+
+// CreateByNotIn applies the NotIn predicate on the "create_by" field.
+func CreateByNotIn(vs ...uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotIn(FieldCreateBy, vs...))
+}
+
+// CreateByGT applies the GT predicate on the "create_by" field.
+func CreateByGT(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGT(FieldCreateBy, v))
+}
+
+// CreateByGTE applies the GTE predicate on the "create_by" field.
+func CreateByGTE(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGTE(FieldCreateBy, v))
+}
+
+// CreateByLT applies the LT predicate on the "create_by" field.
+func CreateByLT(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLT(FieldCreateBy, v))
+}
+
+// CreateByLTE applies the LTE predicate on the "create_by" field.
+func CreateByLTE(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLTE(FieldCreateBy, v))
+}
+
+// CreateByIsNil applies the IsNil predicate on the "create_by" field.
+func CreateByIsNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIsNull(FieldCreateBy))
+}
+
+// CreateByNotNil applies the NotNil predicate on the "create_by" field.
+func CreateByNotNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotNull(FieldCreateBy))
+}
+
+// UpdateByEQ applies the EQ predicate on the "update_by" field.
+func UpdateByEQ(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldUpdateBy, v))
+}
+
+// UpdateByNEQ applies the NEQ predicate on the "update_by" field.
+func UpdateByNEQ(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNEQ(FieldUpdateBy, v))
+}
+
+// UpdateByIn applies the In predicate on the "update_by" field.
+func UpdateByIn(vs ...uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByNotIn applies the NotIn predicate on the "update_by" field.
+func UpdateByNotIn(vs ...uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotIn(FieldUpdateBy, vs...))
+}
+
+// UpdateByGT applies the GT predicate on the "update_by" field.
+func UpdateByGT(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGT(FieldUpdateBy, v))
+}
+
+// UpdateByGTE applies the GTE predicate on the "update_by" field.
+func UpdateByGTE(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGTE(FieldUpdateBy, v))
+}
+
+// UpdateByLT applies the LT predicate on the "update_by" field.
+func UpdateByLT(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLT(FieldUpdateBy, v))
+}
+
+// UpdateByLTE applies the LTE predicate on the "update_by" field.
+func UpdateByLTE(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLTE(FieldUpdateBy, v))
+}
+
+// UpdateByIsNil applies the IsNil predicate on the "update_by" field.
+func UpdateByIsNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIsNull(FieldUpdateBy))
+}
+
+// UpdateByNotNil applies the NotNil predicate on the "update_by" field.
+func UpdateByNotNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotNull(FieldUpdateBy))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// CreateTimeIsNil applies the IsNil predicate on the "create_time" field.
+func CreateTimeIsNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIsNull(FieldCreateTime))
+}
+
+// CreateTimeNotNil applies the NotNil predicate on the "create_time" field.
+func CreateTimeNotNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotNull(FieldCreateTime))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeIsNil applies the IsNil predicate on the "update_time" field.
+func UpdateTimeIsNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIsNull(FieldUpdateTime))
+}
+
+// UpdateTimeNotNil applies the NotNil predicate on the "update_time" field.
+func UpdateTimeNotNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotNull(FieldUpdateTime))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotNull(FieldDeleteTime))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotNull(FieldTenantID))
+}
+
+// ScheduleIDEQ applies the EQ predicate on the "schedule_id" field.
+func ScheduleIDEQ(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDNEQ applies the NEQ predicate on the "schedule_id" field.
+func ScheduleIDNEQ(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDIn applies the In predicate on the "schedule_id" field.
+func ScheduleIDIn(vs ...string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIn(FieldScheduleID, vs...))
+}
+
+// ScheduleIDNotIn applies the NotIn predicate on the "schedule_id" field.
+func ScheduleIDNotIn(vs ...string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotIn(FieldScheduleID, vs...))
+}
+
+// ScheduleIDGT applies the GT predicate on the "schedule_id" field.
+func ScheduleIDGT(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGT(FieldScheduleID, v))
+}
+
+// ScheduleIDGTE applies the GTE predicate on the "schedule_id" field.
+func ScheduleIDGTE(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGTE(FieldScheduleID, v))
+}
+
+// ScheduleIDLT applies the LT predicate on the "schedule_id" field.
+func ScheduleIDLT(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLT(FieldScheduleID, v))
+}
+
+// ScheduleIDLTE applies the LTE predicate on the "schedule_id" field.
+func ScheduleIDLTE(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLTE(FieldScheduleID, v))
+}
+
+// ScheduleIDContains applies the Contains predicate on the "schedule_id" field.
+func ScheduleIDContains(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldContains(FieldScheduleID, v))
+}
+
+// ScheduleIDHasPrefix applies the HasPrefix predicate on the "schedule_id" field.
+func ScheduleIDHasPrefix(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldHasPrefix(FieldScheduleID, v))
+}
+
+// ScheduleIDHasSuffix applies the HasSuffix predicate on the "schedule_id" field.
+func ScheduleIDHasSuffix(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldHasSuffix(FieldScheduleID, v))
+}
+
+// ScheduleIDEqualFold applies the EqualFold predicate on the "schedule_id" field.
+func ScheduleIDEqualFold(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEqualFold(FieldScheduleID, v))
+}
+
+// ScheduleIDContainsFold applies the ContainsFold predicate on the "schedule_id" field.
+func ScheduleIDContainsFold(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldContainsFold(FieldScheduleID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uint32) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotNull(FieldUserID))
+}
+
+// UserNameEQ applies the EQ predicate on the "user_name" field.
+func UserNameEQ(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldUserName, v))
+}
+
+// UserNameNEQ applies the NEQ predicate on the "user_name" field.
+func UserNameNEQ(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNEQ(FieldUserName, v))
+}
+
+// UserNameIn applies the In predicate on the "user_name" field.
+func UserNameIn(vs ...string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIn(FieldUserName, vs...))
+}
+
+// UserNameNotIn applies the NotIn predicate on the "user_name" field.
+func UserNameNotIn(vs ...string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotIn(FieldUserName, vs...))
+}
+
+// UserNameGT applies the GT predicate on the "user_name" field.
+func UserNameGT(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGT(FieldUserName, v))
+}
+
+// UserNameGTE applies the GTE predicate on the "user_name" field.
+func UserNameGTE(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGTE(FieldUserName, v))
+}
+
+// UserNameLT applies the LT predicate on the "user_name" field.
+func UserNameLT(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLT(FieldUserName, v))
+}
+
+// UserNameLTE applies the LTE predicate on the "user_name" field.
+func UserNameLTE(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLTE(FieldUserName, v))
+}
+
+// UserNameContains applies the Contains predicate on the "user_name" field.
+func UserNameContains(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldContains(FieldUserName, v))
+}
+
+// UserNameHasPrefix applies the HasPrefix predicate on the "user_name" field.
+func UserNameHasPrefix(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldHasPrefix(FieldUserName, v))
+}
+
+// UserNameHasSuffix applies the HasSuffix predicate on the "user_name" field.
+func UserNameHasSuffix(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldHasSuffix(FieldUserName, v))
+}
+
+// UserNameIsNil applies the IsNil predicate on the "user_name" field.
+func UserNameIsNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIsNull(FieldUserName))
+}
+
+// UserNameNotNil applies the NotNil predicate on the "user_name" field.
+func UserNameNotNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotNull(FieldUserName))
+}
+
+// UserNameEqualFold applies the EqualFold predicate on the "user_name" field.
+func UserNameEqualFold(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEqualFold(FieldUserName, v))
+}
+
+// UserNameContainsFold applies the ContainsFold predicate on the "user_name" field.
+func UserNameContainsFold(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldContainsFold(FieldUserName, v))
+}
+
+// OrgUnitNameEQ applies the EQ predicate on the "org_unit_name" field.
+func OrgUnitNameEQ(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameNEQ applies the NEQ predicate on the "org_unit_name" field.
+func OrgUnitNameNEQ(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNEQ(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameIn applies the In predicate on the "org_unit_name" field.
+func OrgUnitNameIn(vs ...string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIn(FieldOrgUnitName, vs...))
+}
+
+// OrgUnitNameNotIn applies the NotIn predicate on the "org_unit_name" field.
+func OrgUnitNameNotIn(vs ...string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotIn(FieldOrgUnitName, vs...))
+}
+
+// OrgUnitNameGT applies the GT predicate on the "org_unit_name" field.
+func OrgUnitNameGT(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGT(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameGTE applies the GTE predicate on the "org_unit_name" field.
+func OrgUnitNameGTE(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGTE(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameLT applies the LT predicate on the "org_unit_name" field.
+func OrgUnitNameLT(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLT(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameLTE applies the LTE predicate on the "org_unit_name" field.
+func OrgUnitNameLTE(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLTE(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameContains applies the Contains predicate on the "org_unit_name" field.
+func OrgUnitNameContains(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldContains(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameHasPrefix applies the HasPrefix predicate on the "org_unit_name" field.
+func OrgUnitNameHasPrefix(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldHasPrefix(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameHasSuffix applies the HasSuffix predicate on the "org_unit_name" field.
+func OrgUnitNameHasSuffix(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldHasSuffix(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameIsNil applies the IsNil predicate on the "org_unit_name" field.
+func OrgUnitNameIsNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIsNull(FieldOrgUnitName))
+}
+
+// OrgUnitNameNotNil applies the NotNil predicate on the "org_unit_name" field.
+func OrgUnitNameNotNil() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotNull(FieldOrgUnitName))
+}
+
+// OrgUnitNameEqualFold applies the EqualFold predicate on the "org_unit_name" field.
+func OrgUnitNameEqualFold(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEqualFold(FieldOrgUnitName, v))
+}
+
+// OrgUnitNameContainsFold applies the ContainsFold predicate on the "org_unit_name" field.
+func OrgUnitNameContainsFold(v string) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldContainsFold(FieldOrgUnitName, v))
+}
+
+// EffectiveFromEQ applies the EQ predicate on the "effective_from" field.
+func EffectiveFromEQ(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromNEQ applies the NEQ predicate on the "effective_from" field.
+func EffectiveFromNEQ(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNEQ(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromIn applies the In predicate on the "effective_from" field.
+func EffectiveFromIn(vs ...time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromNotIn applies the NotIn predicate on the "effective_from" field.
+func EffectiveFromNotIn(vs ...time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldNotIn(FieldEffectiveFrom, vs...))
+}
+
+// EffectiveFromGT applies the GT predicate on the "effective_from" field.
+func EffectiveFromGT(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromGTE applies the GTE predicate on the "effective_from" field.
+func EffectiveFromGTE(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldGTE(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLT applies the LT predicate on the "effective_from" field.
+func EffectiveFromLT(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLT(FieldEffectiveFrom, v))
+}
+
+// EffectiveFromLTE applies the LTE predicate on the "effective_from" field.
+func EffectiveFromLTE(v time.Time) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.FieldLTE(FieldEffectiveFrom, v))
+}
+
+// HasSchedule applies the HasEdge predicate on the "schedule" edge.
+func HasSchedule() predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ScheduleTable, ScheduleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduleWith applies the HasEdge predicate on the "schedule" edge with a given conditions (other predicates).
+func HasScheduleWith(preds ...predicate.WorkSchedule) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(func(s *sql.Selector) {
+		step := newScheduleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WorkScheduleAssignment) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WorkScheduleAssignment) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WorkScheduleAssignment) predicate.WorkScheduleAssignment {
+	return predicate.WorkScheduleAssignment(sql.NotPredicates(p))
+}
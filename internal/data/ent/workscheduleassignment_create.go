@@ -0,0 +1,1254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workschedule"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workscheduleassignment"
+)
+
+// WorkScheduleAssignmentCreate is the builder for creating a WorkScheduleAssignment entity.
+type WorkScheduleAssignmentCreate struct {
+	config
+	mutation *WorkScheduleAssignmentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_c *WorkScheduleAssignmentCreate) SetCreateBy(v uint32) *WorkScheduleAssignmentCreate {
+	_c.mutation.SetCreateBy(v)
+	return _c
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_c *WorkScheduleAssignmentCreate) SetNillableCreateBy(v *uint32) *WorkScheduleAssignmentCreate {
+	if v != nil {
+		_c.SetCreateBy(*v)
+	}
+	return _c
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_c *WorkScheduleAssignmentCreate) SetUpdateBy(v uint32) *WorkScheduleAssignmentCreate {
+	_c.mutation.SetUpdateBy(v)
+	return _c
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_c *WorkScheduleAssignmentCreate) SetNillableUpdateBy(v *uint32) *WorkScheduleAssignmentCreate {
+	if v != nil {
+		_c.SetUpdateBy(*v)
+	}
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *WorkScheduleAssignmentCreate) SetCreateTime(v time.Time) *WorkScheduleAssignmentCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *WorkScheduleAssignmentCreate) SetNillableCreateTime(v *time.Time) *WorkScheduleAssignmentCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *WorkScheduleAssignmentCreate) SetUpdateTime(v time.Time) *WorkScheduleAssignmentCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *WorkScheduleAssignmentCreate) SetNillableUpdateTime(v *time.Time) *WorkScheduleAssignmentCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *WorkScheduleAssignmentCreate) SetDeleteTime(v time.Time) *WorkScheduleAssignmentCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *WorkScheduleAssignmentCreate) SetNillableDeleteTime(v *time.Time) *WorkScheduleAssignmentCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *WorkScheduleAssignmentCreate) SetTenantID(v uint32) *WorkScheduleAssignmentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *WorkScheduleAssignmentCreate) SetNillableTenantID(v *uint32) *WorkScheduleAssignmentCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_c *WorkScheduleAssignmentCreate) SetScheduleID(v string) *WorkScheduleAssignmentCreate {
+	_c.mutation.SetScheduleID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *WorkScheduleAssignmentCreate) SetUserID(v uint32) *WorkScheduleAssignmentCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *WorkScheduleAssignmentCreate) SetNillableUserID(v *uint32) *WorkScheduleAssignmentCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetUserName sets the "user_name" field.
+func (_c *WorkScheduleAssignmentCreate) SetUserName(v string) *WorkScheduleAssignmentCreate {
+	_c.mutation.SetUserName(v)
+	return _c
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_c *WorkScheduleAssignmentCreate) SetNillableUserName(v *string) *WorkScheduleAssignmentCreate {
+	if v != nil {
+		_c.SetUserName(*v)
+	}
+	return _c
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (_c *WorkScheduleAssignmentCreate) SetOrgUnitName(v string) *WorkScheduleAssignmentCreate {
+	_c.mutation.SetOrgUnitName(v)
+	return _c
+}
+
+// SetNillableOrgUnitName sets the "org_unit_name" field if the given value is not nil.
+func (_c *WorkScheduleAssignmentCreate) SetNillableOrgUnitName(v *string) *WorkScheduleAssignmentCreate {
+	if v != nil {
+		_c.SetOrgUnitName(*v)
+	}
+	return _c
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (_c *WorkScheduleAssignmentCreate) SetEffectiveFrom(v time.Time) *WorkScheduleAssignmentCreate {
+	_c.mutation.SetEffectiveFrom(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WorkScheduleAssignmentCreate) SetID(v string) *WorkScheduleAssignmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetSchedule sets the "schedule" edge to the WorkSchedule entity.
+func (_c *WorkScheduleAssignmentCreate) SetSchedule(v *WorkSchedule) *WorkScheduleAssignmentCreate {
+	return _c.SetScheduleID(v.ID)
+}
+
+// Mutation returns the WorkScheduleAssignmentMutation object of the builder.
+func (_c *WorkScheduleAssignmentCreate) Mutation() *WorkScheduleAssignmentMutation {
+	return _c.mutation
+}
+
+// Save creates the WorkScheduleAssignment in the database.
+func (_c *WorkScheduleAssignmentCreate) Save(ctx context.Context) (*WorkScheduleAssignment, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WorkScheduleAssignmentCreate) SaveX(ctx context.Context) *WorkScheduleAssignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkScheduleAssignmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkScheduleAssignmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WorkScheduleAssignmentCreate) defaults() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := workscheduleassignment.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		v := workscheduleassignment.DefaultUserID
+		_c.mutation.SetUserID(v)
+	}
+	if _, ok := _c.mutation.UserName(); !ok {
+		v := workscheduleassignment.DefaultUserName
+		_c.mutation.SetUserName(v)
+	}
+	if _, ok := _c.mutation.OrgUnitName(); !ok {
+		v := workscheduleassignment.DefaultOrgUnitName
+		_c.mutation.SetOrgUnitName(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WorkScheduleAssignmentCreate) check() error {
+	if _, ok := _c.mutation.ScheduleID(); !ok {
+		return &ValidationError{Name: "schedule_id", err: errors.New(`ent: missing required field "WorkScheduleAssignment.schedule_id"`)}
+	}
+	if v, ok := _c.mutation.ScheduleID(); ok {
+		if err := workscheduleassignment.ScheduleIDValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_id", err: fmt.Errorf(`ent: validator failed for field "WorkScheduleAssignment.schedule_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EffectiveFrom(); !ok {
+		return &ValidationError{Name: "effective_from", err: errors.New(`ent: missing required field "WorkScheduleAssignment.effective_from"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := workscheduleassignment.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "WorkScheduleAssignment.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ScheduleIDs()) == 0 {
+		return &ValidationError{Name: "schedule", err: errors.New(`ent: missing required edge "WorkScheduleAssignment.schedule"`)}
+	}
+	return nil
+}
+
+func (_c *WorkScheduleAssignmentCreate) sqlSave(ctx context.Context) (*WorkScheduleAssignment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WorkScheduleAssignment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WorkScheduleAssignmentCreate) createSpec() (*WorkScheduleAssignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WorkScheduleAssignment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(workscheduleassignment.Table, sqlgraph.NewFieldSpec(workscheduleassignment.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateBy(); ok {
+		_spec.SetField(workscheduleassignment.FieldCreateBy, field.TypeUint32, value)
+		_node.CreateBy = &value
+	}
+	if value, ok := _c.mutation.UpdateBy(); ok {
+		_spec.SetField(workscheduleassignment.FieldUpdateBy, field.TypeUint32, value)
+		_node.UpdateBy = &value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(workscheduleassignment.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(workscheduleassignment.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(workscheduleassignment.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(workscheduleassignment.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(workscheduleassignment.FieldUserID, field.TypeUint32, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.UserName(); ok {
+		_spec.SetField(workscheduleassignment.FieldUserName, field.TypeString, value)
+		_node.UserName = value
+	}
+	if value, ok := _c.mutation.OrgUnitName(); ok {
+		_spec.SetField(workscheduleassignment.FieldOrgUnitName, field.TypeString, value)
+		_node.OrgUnitName = value
+	}
+	if value, ok := _c.mutation.EffectiveFrom(); ok {
+		_spec.SetField(workscheduleassignment.FieldEffectiveFrom, field.TypeTime, value)
+		_node.EffectiveFrom = value
+	}
+	if nodes := _c.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workscheduleassignment.ScheduleTable,
+			Columns: []string{workscheduleassignment.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ScheduleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WorkScheduleAssignment.Create().
+//		SetCreateBy(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkScheduleAssignmentUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WorkScheduleAssignmentCreate) OnConflict(opts ...sql.ConflictOption) *WorkScheduleAssignmentUpsertOne {
+	_c.conflict = opts
+	return &WorkScheduleAssignmentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WorkScheduleAssignment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WorkScheduleAssignmentCreate) OnConflictColumns(columns ...string) *WorkScheduleAssignmentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WorkScheduleAssignmentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WorkScheduleAssignmentUpsertOne is the builder for "upsert"-ing
+	//  one WorkScheduleAssignment node.
+	WorkScheduleAssignmentUpsertOne struct {
+		create *WorkScheduleAssignmentCreate
+	}
+
+	// WorkScheduleAssignmentUpsert is the "OnConflict" setter.
+	WorkScheduleAssignmentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreateBy sets the "create_by" field.
+func (u *WorkScheduleAssignmentUpsert) SetCreateBy(v uint32) *WorkScheduleAssignmentUpsert {
+	u.Set(workscheduleassignment.FieldCreateBy, v)
+	return u
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsert) UpdateCreateBy() *WorkScheduleAssignmentUpsert {
+	u.SetExcluded(workscheduleassignment.FieldCreateBy)
+	return u
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *WorkScheduleAssignmentUpsert) AddCreateBy(v uint32) *WorkScheduleAssignmentUpsert {
+	u.Add(workscheduleassignment.FieldCreateBy, v)
+	return u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *WorkScheduleAssignmentUpsert) ClearCreateBy() *WorkScheduleAssignmentUpsert {
+	u.SetNull(workscheduleassignment.FieldCreateBy)
+	return u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *WorkScheduleAssignmentUpsert) SetUpdateBy(v uint32) *WorkScheduleAssignmentUpsert {
+	u.Set(workscheduleassignment.FieldUpdateBy, v)
+	return u
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsert) UpdateUpdateBy() *WorkScheduleAssignmentUpsert {
+	u.SetExcluded(workscheduleassignment.FieldUpdateBy)
+	return u
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *WorkScheduleAssignmentUpsert) AddUpdateBy(v uint32) *WorkScheduleAssignmentUpsert {
+	u.Add(workscheduleassignment.FieldUpdateBy, v)
+	return u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *WorkScheduleAssignmentUpsert) ClearUpdateBy() *WorkScheduleAssignmentUpsert {
+	u.SetNull(workscheduleassignment.FieldUpdateBy)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WorkScheduleAssignmentUpsert) SetUpdateTime(v time.Time) *WorkScheduleAssignmentUpsert {
+	u.Set(workscheduleassignment.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsert) UpdateUpdateTime() *WorkScheduleAssignmentUpsert {
+	u.SetExcluded(workscheduleassignment.FieldUpdateTime)
+	return u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *WorkScheduleAssignmentUpsert) ClearUpdateTime() *WorkScheduleAssignmentUpsert {
+	u.SetNull(workscheduleassignment.FieldUpdateTime)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *WorkScheduleAssignmentUpsert) SetDeleteTime(v time.Time) *WorkScheduleAssignmentUpsert {
+	u.Set(workscheduleassignment.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsert) UpdateDeleteTime() *WorkScheduleAssignmentUpsert {
+	u.SetExcluded(workscheduleassignment.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *WorkScheduleAssignmentUpsert) ClearDeleteTime() *WorkScheduleAssignmentUpsert {
+	u.SetNull(workscheduleassignment.FieldDeleteTime)
+	return u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *WorkScheduleAssignmentUpsert) SetScheduleID(v string) *WorkScheduleAssignmentUpsert {
+	u.Set(workscheduleassignment.FieldScheduleID, v)
+	return u
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsert) UpdateScheduleID() *WorkScheduleAssignmentUpsert {
+	u.SetExcluded(workscheduleassignment.FieldScheduleID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *WorkScheduleAssignmentUpsert) SetUserID(v uint32) *WorkScheduleAssignmentUpsert {
+	u.Set(workscheduleassignment.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsert) UpdateUserID() *WorkScheduleAssignmentUpsert {
+	u.SetExcluded(workscheduleassignment.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *WorkScheduleAssignmentUpsert) AddUserID(v uint32) *WorkScheduleAssignmentUpsert {
+	u.Add(workscheduleassignment.FieldUserID, v)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *WorkScheduleAssignmentUpsert) ClearUserID() *WorkScheduleAssignmentUpsert {
+	u.SetNull(workscheduleassignment.FieldUserID)
+	return u
+}
+
+// SetUserName sets the "user_name" field.
+func (u *WorkScheduleAssignmentUpsert) SetUserName(v string) *WorkScheduleAssignmentUpsert {
+	u.Set(workscheduleassignment.FieldUserName, v)
+	return u
+}
+
+// UpdateUserName sets the "user_name" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsert) UpdateUserName() *WorkScheduleAssignmentUpsert {
+	u.SetExcluded(workscheduleassignment.FieldUserName)
+	return u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (u *WorkScheduleAssignmentUpsert) ClearUserName() *WorkScheduleAssignmentUpsert {
+	u.SetNull(workscheduleassignment.FieldUserName)
+	return u
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (u *WorkScheduleAssignmentUpsert) SetOrgUnitName(v string) *WorkScheduleAssignmentUpsert {
+	u.Set(workscheduleassignment.FieldOrgUnitName, v)
+	return u
+}
+
+// UpdateOrgUnitName sets the "org_unit_name" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsert) UpdateOrgUnitName() *WorkScheduleAssignmentUpsert {
+	u.SetExcluded(workscheduleassignment.FieldOrgUnitName)
+	return u
+}
+
+// ClearOrgUnitName clears the value of the "org_unit_name" field.
+func (u *WorkScheduleAssignmentUpsert) ClearOrgUnitName() *WorkScheduleAssignmentUpsert {
+	u.SetNull(workscheduleassignment.FieldOrgUnitName)
+	return u
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (u *WorkScheduleAssignmentUpsert) SetEffectiveFrom(v time.Time) *WorkScheduleAssignmentUpsert {
+	u.Set(workscheduleassignment.FieldEffectiveFrom, v)
+	return u
+}
+
+// UpdateEffectiveFrom sets the "effective_from" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsert) UpdateEffectiveFrom() *WorkScheduleAssignmentUpsert {
+	u.SetExcluded(workscheduleassignment.FieldEffectiveFrom)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WorkScheduleAssignment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(workscheduleassignment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WorkScheduleAssignmentUpsertOne) UpdateNewValues() *WorkScheduleAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(workscheduleassignment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(workscheduleassignment.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(workscheduleassignment.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WorkScheduleAssignment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WorkScheduleAssignmentUpsertOne) Ignore() *WorkScheduleAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkScheduleAssignmentUpsertOne) DoNothing() *WorkScheduleAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkScheduleAssignmentCreate.OnConflict
+// documentation for more info.
+func (u *WorkScheduleAssignmentUpsertOne) Update(set func(*WorkScheduleAssignmentUpsert)) *WorkScheduleAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkScheduleAssignmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *WorkScheduleAssignmentUpsertOne) SetCreateBy(v uint32) *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *WorkScheduleAssignmentUpsertOne) AddCreateBy(v uint32) *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertOne) UpdateCreateBy() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *WorkScheduleAssignmentUpsertOne) ClearCreateBy() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *WorkScheduleAssignmentUpsertOne) SetUpdateBy(v uint32) *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *WorkScheduleAssignmentUpsertOne) AddUpdateBy(v uint32) *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertOne) UpdateUpdateBy() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *WorkScheduleAssignmentUpsertOne) ClearUpdateBy() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WorkScheduleAssignmentUpsertOne) SetUpdateTime(v time.Time) *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertOne) UpdateUpdateTime() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *WorkScheduleAssignmentUpsertOne) ClearUpdateTime() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *WorkScheduleAssignmentUpsertOne) SetDeleteTime(v time.Time) *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertOne) UpdateDeleteTime() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *WorkScheduleAssignmentUpsertOne) ClearDeleteTime() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *WorkScheduleAssignmentUpsertOne) SetScheduleID(v string) *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetScheduleID(v)
+	})
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertOne) UpdateScheduleID() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateScheduleID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *WorkScheduleAssignmentUpsertOne) SetUserID(v uint32) *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *WorkScheduleAssignmentUpsertOne) AddUserID(v uint32) *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertOne) UpdateUserID() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *WorkScheduleAssignmentUpsertOne) ClearUserID() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetUserName sets the "user_name" field.
+func (u *WorkScheduleAssignmentUpsertOne) SetUserName(v string) *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetUserName(v)
+	})
+}
+
+// UpdateUserName sets the "user_name" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertOne) UpdateUserName() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateUserName()
+	})
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (u *WorkScheduleAssignmentUpsertOne) ClearUserName() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.ClearUserName()
+	})
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (u *WorkScheduleAssignmentUpsertOne) SetOrgUnitName(v string) *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetOrgUnitName(v)
+	})
+}
+
+// UpdateOrgUnitName sets the "org_unit_name" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertOne) UpdateOrgUnitName() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateOrgUnitName()
+	})
+}
+
+// ClearOrgUnitName clears the value of the "org_unit_name" field.
+func (u *WorkScheduleAssignmentUpsertOne) ClearOrgUnitName() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.ClearOrgUnitName()
+	})
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (u *WorkScheduleAssignmentUpsertOne) SetEffectiveFrom(v time.Time) *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetEffectiveFrom(v)
+	})
+}
+
+// UpdateEffectiveFrom sets the "effective_from" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertOne) UpdateEffectiveFrom() *WorkScheduleAssignmentUpsertOne {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateEffectiveFrom()
+	})
+}
+
+// Exec executes the query.
+func (u *WorkScheduleAssignmentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkScheduleAssignmentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkScheduleAssignmentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WorkScheduleAssignmentUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WorkScheduleAssignmentUpsertOne.ID is not supported by MySQL driver. Use WorkScheduleAssignmentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WorkScheduleAssignmentUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WorkScheduleAssignmentCreateBulk is the builder for creating many WorkScheduleAssignment entities in bulk.
+type WorkScheduleAssignmentCreateBulk struct {
+	config
+	err      error
+	builders []*WorkScheduleAssignmentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WorkScheduleAssignment entities in the database.
+func (_c *WorkScheduleAssignmentCreateBulk) Save(ctx context.Context) ([]*WorkScheduleAssignment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WorkScheduleAssignment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkScheduleAssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WorkScheduleAssignmentCreateBulk) SaveX(ctx context.Context) []*WorkScheduleAssignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkScheduleAssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkScheduleAssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WorkScheduleAssignment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkScheduleAssignmentUpsert) {
+//			SetCreateBy(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WorkScheduleAssignmentCreateBulk) OnConflict(opts ...sql.ConflictOption) *WorkScheduleAssignmentUpsertBulk {
+	_c.conflict = opts
+	return &WorkScheduleAssignmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WorkScheduleAssignment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WorkScheduleAssignmentCreateBulk) OnConflictColumns(columns ...string) *WorkScheduleAssignmentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WorkScheduleAssignmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// WorkScheduleAssignmentUpsertBulk is the builder for "upsert"-ing
+// a bulk of WorkScheduleAssignment nodes.
+type WorkScheduleAssignmentUpsertBulk struct {
+	create *WorkScheduleAssignmentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WorkScheduleAssignment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(workscheduleassignment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WorkScheduleAssignmentUpsertBulk) UpdateNewValues() *WorkScheduleAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(workscheduleassignment.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(workscheduleassignment.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(workscheduleassignment.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WorkScheduleAssignment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WorkScheduleAssignmentUpsertBulk) Ignore() *WorkScheduleAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkScheduleAssignmentUpsertBulk) DoNothing() *WorkScheduleAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkScheduleAssignmentCreateBulk.OnConflict
+// documentation for more info.
+func (u *WorkScheduleAssignmentUpsertBulk) Update(set func(*WorkScheduleAssignmentUpsert)) *WorkScheduleAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkScheduleAssignmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (u *WorkScheduleAssignmentUpsertBulk) SetCreateBy(v uint32) *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetCreateBy(v)
+	})
+}
+
+// AddCreateBy adds v to the "create_by" field.
+func (u *WorkScheduleAssignmentUpsertBulk) AddCreateBy(v uint32) *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.AddCreateBy(v)
+	})
+}
+
+// UpdateCreateBy sets the "create_by" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertBulk) UpdateCreateBy() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateCreateBy()
+	})
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (u *WorkScheduleAssignmentUpsertBulk) ClearCreateBy() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.ClearCreateBy()
+	})
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (u *WorkScheduleAssignmentUpsertBulk) SetUpdateBy(v uint32) *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetUpdateBy(v)
+	})
+}
+
+// AddUpdateBy adds v to the "update_by" field.
+func (u *WorkScheduleAssignmentUpsertBulk) AddUpdateBy(v uint32) *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.AddUpdateBy(v)
+	})
+}
+
+// UpdateUpdateBy sets the "update_by" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertBulk) UpdateUpdateBy() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateUpdateBy()
+	})
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (u *WorkScheduleAssignmentUpsertBulk) ClearUpdateBy() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.ClearUpdateBy()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WorkScheduleAssignmentUpsertBulk) SetUpdateTime(v time.Time) *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertBulk) UpdateUpdateTime() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (u *WorkScheduleAssignmentUpsertBulk) ClearUpdateTime() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.ClearUpdateTime()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *WorkScheduleAssignmentUpsertBulk) SetDeleteTime(v time.Time) *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertBulk) UpdateDeleteTime() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *WorkScheduleAssignmentUpsertBulk) ClearDeleteTime() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *WorkScheduleAssignmentUpsertBulk) SetScheduleID(v string) *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetScheduleID(v)
+	})
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertBulk) UpdateScheduleID() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateScheduleID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *WorkScheduleAssignmentUpsertBulk) SetUserID(v uint32) *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *WorkScheduleAssignmentUpsertBulk) AddUserID(v uint32) *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertBulk) UpdateUserID() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *WorkScheduleAssignmentUpsertBulk) ClearUserID() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetUserName sets the "user_name" field.
+func (u *WorkScheduleAssignmentUpsertBulk) SetUserName(v string) *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetUserName(v)
+	})
+}
+
+// UpdateUserName sets the "user_name" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertBulk) UpdateUserName() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateUserName()
+	})
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (u *WorkScheduleAssignmentUpsertBulk) ClearUserName() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.ClearUserName()
+	})
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (u *WorkScheduleAssignmentUpsertBulk) SetOrgUnitName(v string) *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetOrgUnitName(v)
+	})
+}
+
+// UpdateOrgUnitName sets the "org_unit_name" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertBulk) UpdateOrgUnitName() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateOrgUnitName()
+	})
+}
+
+// ClearOrgUnitName clears the value of the "org_unit_name" field.
+func (u *WorkScheduleAssignmentUpsertBulk) ClearOrgUnitName() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.ClearOrgUnitName()
+	})
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (u *WorkScheduleAssignmentUpsertBulk) SetEffectiveFrom(v time.Time) *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.SetEffectiveFrom(v)
+	})
+}
+
+// UpdateEffectiveFrom sets the "effective_from" field to the value that was provided on create.
+func (u *WorkScheduleAssignmentUpsertBulk) UpdateEffectiveFrom() *WorkScheduleAssignmentUpsertBulk {
+	return u.Update(func(s *WorkScheduleAssignmentUpsert) {
+		s.UpdateEffectiveFrom()
+	})
+}
+
+// Exec executes the query.
+func (u *WorkScheduleAssignmentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WorkScheduleAssignmentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkScheduleAssignmentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkScheduleAssignmentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
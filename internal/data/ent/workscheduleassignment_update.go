@@ -0,0 +1,803 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workschedule"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workscheduleassignment"
+)
+
+// WorkScheduleAssignmentUpdate is the builder for updating WorkScheduleAssignment entities.
+type WorkScheduleAssignmentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WorkScheduleAssignmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WorkScheduleAssignmentUpdate builder.
+func (_u *WorkScheduleAssignmentUpdate) Where(ps ...predicate.WorkScheduleAssignment) *WorkScheduleAssignmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *WorkScheduleAssignmentUpdate) SetCreateBy(v uint32) *WorkScheduleAssignmentUpdate {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdate) SetNillableCreateBy(v *uint32) *WorkScheduleAssignmentUpdate {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *WorkScheduleAssignmentUpdate) AddCreateBy(v int32) *WorkScheduleAssignmentUpdate {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *WorkScheduleAssignmentUpdate) ClearCreateBy() *WorkScheduleAssignmentUpdate {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *WorkScheduleAssignmentUpdate) SetUpdateBy(v uint32) *WorkScheduleAssignmentUpdate {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdate) SetNillableUpdateBy(v *uint32) *WorkScheduleAssignmentUpdate {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *WorkScheduleAssignmentUpdate) AddUpdateBy(v int32) *WorkScheduleAssignmentUpdate {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *WorkScheduleAssignmentUpdate) ClearUpdateBy() *WorkScheduleAssignmentUpdate {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *WorkScheduleAssignmentUpdate) SetUpdateTime(v time.Time) *WorkScheduleAssignmentUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdate) SetNillableUpdateTime(v *time.Time) *WorkScheduleAssignmentUpdate {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *WorkScheduleAssignmentUpdate) ClearUpdateTime() *WorkScheduleAssignmentUpdate {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *WorkScheduleAssignmentUpdate) SetDeleteTime(v time.Time) *WorkScheduleAssignmentUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdate) SetNillableDeleteTime(v *time.Time) *WorkScheduleAssignmentUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *WorkScheduleAssignmentUpdate) ClearDeleteTime() *WorkScheduleAssignmentUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_u *WorkScheduleAssignmentUpdate) SetScheduleID(v string) *WorkScheduleAssignmentUpdate {
+	_u.mutation.SetScheduleID(v)
+	return _u
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdate) SetNillableScheduleID(v *string) *WorkScheduleAssignmentUpdate {
+	if v != nil {
+		_u.SetScheduleID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WorkScheduleAssignmentUpdate) SetUserID(v uint32) *WorkScheduleAssignmentUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdate) SetNillableUserID(v *uint32) *WorkScheduleAssignmentUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *WorkScheduleAssignmentUpdate) AddUserID(v int32) *WorkScheduleAssignmentUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *WorkScheduleAssignmentUpdate) ClearUserID() *WorkScheduleAssignmentUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *WorkScheduleAssignmentUpdate) SetUserName(v string) *WorkScheduleAssignmentUpdate {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdate) SetNillableUserName(v *string) *WorkScheduleAssignmentUpdate {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (_u *WorkScheduleAssignmentUpdate) ClearUserName() *WorkScheduleAssignmentUpdate {
+	_u.mutation.ClearUserName()
+	return _u
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (_u *WorkScheduleAssignmentUpdate) SetOrgUnitName(v string) *WorkScheduleAssignmentUpdate {
+	_u.mutation.SetOrgUnitName(v)
+	return _u
+}
+
+// SetNillableOrgUnitName sets the "org_unit_name" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdate) SetNillableOrgUnitName(v *string) *WorkScheduleAssignmentUpdate {
+	if v != nil {
+		_u.SetOrgUnitName(*v)
+	}
+	return _u
+}
+
+// ClearOrgUnitName clears the value of the "org_unit_name" field.
+func (_u *WorkScheduleAssignmentUpdate) ClearOrgUnitName() *WorkScheduleAssignmentUpdate {
+	_u.mutation.ClearOrgUnitName()
+	return _u
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (_u *WorkScheduleAssignmentUpdate) SetEffectiveFrom(v time.Time) *WorkScheduleAssignmentUpdate {
+	_u.mutation.SetEffectiveFrom(v)
+	return _u
+}
+
+// SetNillableEffectiveFrom sets the "effective_from" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdate) SetNillableEffectiveFrom(v *time.Time) *WorkScheduleAssignmentUpdate {
+	if v != nil {
+		_u.SetEffectiveFrom(*v)
+	}
+	return _u
+}
+
+// SetSchedule sets the "schedule" edge to the WorkSchedule entity.
+func (_u *WorkScheduleAssignmentUpdate) SetSchedule(v *WorkSchedule) *WorkScheduleAssignmentUpdate {
+	return _u.SetScheduleID(v.ID)
+}
+
+// Mutation returns the WorkScheduleAssignmentMutation object of the builder.
+func (_u *WorkScheduleAssignmentUpdate) Mutation() *WorkScheduleAssignmentMutation {
+	return _u.mutation
+}
+
+// ClearSchedule clears the "schedule" edge to the WorkSchedule entity.
+func (_u *WorkScheduleAssignmentUpdate) ClearSchedule() *WorkScheduleAssignmentUpdate {
+	_u.mutation.ClearSchedule()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WorkScheduleAssignmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkScheduleAssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WorkScheduleAssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkScheduleAssignmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WorkScheduleAssignmentUpdate) check() error {
+	if v, ok := _u.mutation.ScheduleID(); ok {
+		if err := workscheduleassignment.ScheduleIDValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_id", err: fmt.Errorf(`ent: validator failed for field "WorkScheduleAssignment.schedule_id": %w`, err)}
+		}
+	}
+	if _u.mutation.ScheduleCleared() && len(_u.mutation.ScheduleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkScheduleAssignment.schedule"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkScheduleAssignmentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkScheduleAssignmentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WorkScheduleAssignmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workscheduleassignment.Table, workscheduleassignment.Columns, sqlgraph.NewFieldSpec(workscheduleassignment.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(workscheduleassignment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(workscheduleassignment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(workscheduleassignment.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(workscheduleassignment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(workscheduleassignment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(workscheduleassignment.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(workscheduleassignment.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(workscheduleassignment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(workscheduleassignment.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(workscheduleassignment.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(workscheduleassignment.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(workscheduleassignment.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(workscheduleassignment.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(workscheduleassignment.FieldUserID, field.TypeUint32, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(workscheduleassignment.FieldUserID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(workscheduleassignment.FieldUserName, field.TypeString, value)
+	}
+	if _u.mutation.UserNameCleared() {
+		_spec.ClearField(workscheduleassignment.FieldUserName, field.TypeString)
+	}
+	if value, ok := _u.mutation.OrgUnitName(); ok {
+		_spec.SetField(workscheduleassignment.FieldOrgUnitName, field.TypeString, value)
+	}
+	if _u.mutation.OrgUnitNameCleared() {
+		_spec.ClearField(workscheduleassignment.FieldOrgUnitName, field.TypeString)
+	}
+	if value, ok := _u.mutation.EffectiveFrom(); ok {
+		_spec.SetField(workscheduleassignment.FieldEffectiveFrom, field.TypeTime, value)
+	}
+	if _u.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workscheduleassignment.ScheduleTable,
+			Columns: []string{workscheduleassignment.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workscheduleassignment.ScheduleTable,
+			Columns: []string{workscheduleassignment.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workscheduleassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WorkScheduleAssignmentUpdateOne is the builder for updating a single WorkScheduleAssignment entity.
+type WorkScheduleAssignmentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WorkScheduleAssignmentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreateBy sets the "create_by" field.
+func (_u *WorkScheduleAssignmentUpdateOne) SetCreateBy(v uint32) *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.ResetCreateBy()
+	_u.mutation.SetCreateBy(v)
+	return _u
+}
+
+// SetNillableCreateBy sets the "create_by" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdateOne) SetNillableCreateBy(v *uint32) *WorkScheduleAssignmentUpdateOne {
+	if v != nil {
+		_u.SetCreateBy(*v)
+	}
+	return _u
+}
+
+// AddCreateBy adds value to the "create_by" field.
+func (_u *WorkScheduleAssignmentUpdateOne) AddCreateBy(v int32) *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.AddCreateBy(v)
+	return _u
+}
+
+// ClearCreateBy clears the value of the "create_by" field.
+func (_u *WorkScheduleAssignmentUpdateOne) ClearCreateBy() *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.ClearCreateBy()
+	return _u
+}
+
+// SetUpdateBy sets the "update_by" field.
+func (_u *WorkScheduleAssignmentUpdateOne) SetUpdateBy(v uint32) *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.ResetUpdateBy()
+	_u.mutation.SetUpdateBy(v)
+	return _u
+}
+
+// SetNillableUpdateBy sets the "update_by" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdateOne) SetNillableUpdateBy(v *uint32) *WorkScheduleAssignmentUpdateOne {
+	if v != nil {
+		_u.SetUpdateBy(*v)
+	}
+	return _u
+}
+
+// AddUpdateBy adds value to the "update_by" field.
+func (_u *WorkScheduleAssignmentUpdateOne) AddUpdateBy(v int32) *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.AddUpdateBy(v)
+	return _u
+}
+
+// ClearUpdateBy clears the value of the "update_by" field.
+func (_u *WorkScheduleAssignmentUpdateOne) ClearUpdateBy() *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.ClearUpdateBy()
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *WorkScheduleAssignmentUpdateOne) SetUpdateTime(v time.Time) *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdateOne) SetNillableUpdateTime(v *time.Time) *WorkScheduleAssignmentUpdateOne {
+	if v != nil {
+		_u.SetUpdateTime(*v)
+	}
+	return _u
+}
+
+// ClearUpdateTime clears the value of the "update_time" field.
+func (_u *WorkScheduleAssignmentUpdateOne) ClearUpdateTime() *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.ClearUpdateTime()
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *WorkScheduleAssignmentUpdateOne) SetDeleteTime(v time.Time) *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdateOne) SetNillableDeleteTime(v *time.Time) *WorkScheduleAssignmentUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *WorkScheduleAssignmentUpdateOne) ClearDeleteTime() *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_u *WorkScheduleAssignmentUpdateOne) SetScheduleID(v string) *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.SetScheduleID(v)
+	return _u
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdateOne) SetNillableScheduleID(v *string) *WorkScheduleAssignmentUpdateOne {
+	if v != nil {
+		_u.SetScheduleID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WorkScheduleAssignmentUpdateOne) SetUserID(v uint32) *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdateOne) SetNillableUserID(v *uint32) *WorkScheduleAssignmentUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *WorkScheduleAssignmentUpdateOne) AddUserID(v int32) *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *WorkScheduleAssignmentUpdateOne) ClearUserID() *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetUserName sets the "user_name" field.
+func (_u *WorkScheduleAssignmentUpdateOne) SetUserName(v string) *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.SetUserName(v)
+	return _u
+}
+
+// SetNillableUserName sets the "user_name" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdateOne) SetNillableUserName(v *string) *WorkScheduleAssignmentUpdateOne {
+	if v != nil {
+		_u.SetUserName(*v)
+	}
+	return _u
+}
+
+// ClearUserName clears the value of the "user_name" field.
+func (_u *WorkScheduleAssignmentUpdateOne) ClearUserName() *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.ClearUserName()
+	return _u
+}
+
+// SetOrgUnitName sets the "org_unit_name" field.
+func (_u *WorkScheduleAssignmentUpdateOne) SetOrgUnitName(v string) *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.SetOrgUnitName(v)
+	return _u
+}
+
+// SetNillableOrgUnitName sets the "org_unit_name" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdateOne) SetNillableOrgUnitName(v *string) *WorkScheduleAssignmentUpdateOne {
+	if v != nil {
+		_u.SetOrgUnitName(*v)
+	}
+	return _u
+}
+
+// ClearOrgUnitName clears the value of the "org_unit_name" field.
+func (_u *WorkScheduleAssignmentUpdateOne) ClearOrgUnitName() *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.ClearOrgUnitName()
+	return _u
+}
+
+// SetEffectiveFrom sets the "effective_from" field.
+func (_u *WorkScheduleAssignmentUpdateOne) SetEffectiveFrom(v time.Time) *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.SetEffectiveFrom(v)
+	return _u
+}
+
+// SetNillableEffectiveFrom sets the "effective_from" field if the given value is not nil.
+func (_u *WorkScheduleAssignmentUpdateOne) SetNillableEffectiveFrom(v *time.Time) *WorkScheduleAssignmentUpdateOne {
+	if v != nil {
+		_u.SetEffectiveFrom(*v)
+	}
+	return _u
+}
+
+// SetSchedule sets the "schedule" edge to the WorkSchedule entity.
+func (_u *WorkScheduleAssignmentUpdateOne) SetSchedule(v *WorkSchedule) *WorkScheduleAssignmentUpdateOne {
+	return _u.SetScheduleID(v.ID)
+}
+
+// Mutation returns the WorkScheduleAssignmentMutation object of the builder.
+func (_u *WorkScheduleAssignmentUpdateOne) Mutation() *WorkScheduleAssignmentMutation {
+	return _u.mutation
+}
+
+// ClearSchedule clears the "schedule" edge to the WorkSchedule entity.
+func (_u *WorkScheduleAssignmentUpdateOne) ClearSchedule() *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.ClearSchedule()
+	return _u
+}
+
+// Where appends a list predicates to the WorkScheduleAssignmentUpdate builder.
+func (_u *WorkScheduleAssignmentUpdateOne) Where(ps ...predicate.WorkScheduleAssignment) *WorkScheduleAssignmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WorkScheduleAssignmentUpdateOne) Select(field string, fields ...string) *WorkScheduleAssignmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WorkScheduleAssignment entity.
+func (_u *WorkScheduleAssignmentUpdateOne) Save(ctx context.Context) (*WorkScheduleAssignment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkScheduleAssignmentUpdateOne) SaveX(ctx context.Context) *WorkScheduleAssignment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WorkScheduleAssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkScheduleAssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WorkScheduleAssignmentUpdateOne) check() error {
+	if v, ok := _u.mutation.ScheduleID(); ok {
+		if err := workscheduleassignment.ScheduleIDValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_id", err: fmt.Errorf(`ent: validator failed for field "WorkScheduleAssignment.schedule_id": %w`, err)}
+		}
+	}
+	if _u.mutation.ScheduleCleared() && len(_u.mutation.ScheduleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkScheduleAssignment.schedule"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkScheduleAssignmentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkScheduleAssignmentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WorkScheduleAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *WorkScheduleAssignment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workscheduleassignment.Table, workscheduleassignment.Columns, sqlgraph.NewFieldSpec(workscheduleassignment.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WorkScheduleAssignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workscheduleassignment.FieldID)
+		for _, f := range fields {
+			if !workscheduleassignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != workscheduleassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreateBy(); ok {
+		_spec.SetField(workscheduleassignment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreateBy(); ok {
+		_spec.AddField(workscheduleassignment.FieldCreateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreateByCleared() {
+		_spec.ClearField(workscheduleassignment.FieldCreateBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdateBy(); ok {
+		_spec.SetField(workscheduleassignment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateBy(); ok {
+		_spec.AddField(workscheduleassignment.FieldUpdateBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdateByCleared() {
+		_spec.ClearField(workscheduleassignment.FieldUpdateBy, field.TypeUint32)
+	}
+	if _u.mutation.CreateTimeCleared() {
+		_spec.ClearField(workscheduleassignment.FieldCreateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(workscheduleassignment.FieldUpdateTime, field.TypeTime, value)
+	}
+	if _u.mutation.UpdateTimeCleared() {
+		_spec.ClearField(workscheduleassignment.FieldUpdateTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(workscheduleassignment.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(workscheduleassignment.FieldDeleteTime, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(workscheduleassignment.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(workscheduleassignment.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(workscheduleassignment.FieldUserID, field.TypeUint32, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(workscheduleassignment.FieldUserID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UserName(); ok {
+		_spec.SetField(workscheduleassignment.FieldUserName, field.TypeString, value)
+	}
+	if _u.mutation.UserNameCleared() {
+		_spec.ClearField(workscheduleassignment.FieldUserName, field.TypeString)
+	}
+	if value, ok := _u.mutation.OrgUnitName(); ok {
+		_spec.SetField(workscheduleassignment.FieldOrgUnitName, field.TypeString, value)
+	}
+	if _u.mutation.OrgUnitNameCleared() {
+		_spec.ClearField(workscheduleassignment.FieldOrgUnitName, field.TypeString)
+	}
+	if value, ok := _u.mutation.EffectiveFrom(); ok {
+		_spec.SetField(workscheduleassignment.FieldEffectiveFrom, field.TypeTime, value)
+	}
+	if _u.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workscheduleassignment.ScheduleTable,
+			Columns: []string{workscheduleassignment.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workscheduleassignment.ScheduleTable,
+			Columns: []string{workscheduleassignment.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workschedule.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &WorkScheduleAssignment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workscheduleassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
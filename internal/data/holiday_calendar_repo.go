@@ -0,0 +1,216 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holiday"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holidaycalendar"
+)
+
+type HolidayCalendarRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewHolidayCalendarRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *HolidayCalendarRepo {
+	return &HolidayCalendarRepo{
+		log:       ctx.NewLoggerHelper("hr/holiday_calendar/repo"),
+		entClient: entClient,
+	}
+}
+
+func (r *HolidayCalendarRepo) Create(ctx context.Context, tenantID uint32, name string, opts ...func(*ent.HolidayCalendarCreate)) (*ent.HolidayCalendar, error) {
+	id := uuid.New().String()
+
+	create := r.entClient.Client().HolidayCalendar.Create().
+		SetID(id).
+		SetTenantID(tenantID).
+		SetName(name).
+		SetCreateTime(time.Now())
+
+	for _, opt := range opts {
+		opt(create)
+	}
+
+	entity, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, hrV1.ErrorAlreadyExists("holiday calendar already exists with this name")
+		}
+		r.log.Errorf("create holiday calendar failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create holiday calendar failed")
+	}
+	return entity, nil
+}
+
+func (r *HolidayCalendarRepo) GetByID(ctx context.Context, id string) (*ent.HolidayCalendar, error) {
+	entity, err := r.entClient.Client().HolidayCalendar.Query().
+		Where(holidaycalendar.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get holiday calendar failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get holiday calendar failed")
+	}
+	return entity, nil
+}
+
+func (r *HolidayCalendarRepo) List(ctx context.Context, tenantID uint32, page, pageSize int, filters map[string]interface{}) ([]*ent.HolidayCalendar, int, error) {
+	query := r.entClient.Client().HolidayCalendar.Query().
+		Where(holidaycalendar.TenantID(tenantID))
+
+	if q, ok := filters["query"].(string); ok && q != "" {
+		query = query.Where(holidaycalendar.NameContains(q))
+	}
+	if countryCode, ok := filters["country_code"].(string); ok && countryCode != "" {
+		query = query.Where(holidaycalendar.CountryCode(countryCode))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count holiday calendars failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list holiday calendars failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	entities, err := query.Order(ent.Asc(holidaycalendar.FieldName)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list holiday calendars failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list holiday calendars failed")
+	}
+
+	return entities, total, nil
+}
+
+// Resolve returns the calendar that applies to employees of the given org unit:
+// the calendar the org unit is assigned to, otherwise the tenant's default calendar.
+// Returns nil if the tenant has no matching calendar.
+func (r *HolidayCalendarRepo) Resolve(ctx context.Context, tenantID uint32, orgUnitName string) (*ent.HolidayCalendar, error) {
+	calendars, err := r.entClient.Client().HolidayCalendar.Query().
+		Where(holidaycalendar.TenantID(tenantID)).
+		Order(ent.Asc(holidaycalendar.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("resolve holiday calendar failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("resolve holiday calendar failed")
+	}
+
+	var fallback *ent.HolidayCalendar
+	for _, c := range calendars {
+		if orgUnitName != "" {
+			for _, name := range c.OrgUnitNames {
+				if name == orgUnitName {
+					return c, nil
+				}
+			}
+		}
+		if c.IsDefault && fallback == nil {
+			fallback = c
+		}
+	}
+	return fallback, nil
+}
+
+func (r *HolidayCalendarRepo) Update(ctx context.Context, id string, updates map[string]interface{}) (*ent.HolidayCalendar, error) {
+	update := r.entClient.Client().HolidayCalendar.UpdateOneID(id)
+
+	if name, ok := updates["name"].(string); ok {
+		update = update.SetName(name)
+	}
+	if description, ok := updates["description"].(string); ok {
+		update = update.SetDescription(description)
+	}
+	if countryCode, ok := updates["country_code"].(string); ok {
+		update = update.SetCountryCode(countryCode)
+	}
+	if region, ok := updates["region"].(string); ok {
+		update = update.SetRegion(region)
+	}
+	if isDefault, ok := updates["is_default"].(bool); ok {
+		update = update.SetIsDefault(isDefault)
+	}
+	if orgUnitNames, ok := updates["org_unit_names"].([]string); ok {
+		update = update.SetOrgUnitNames(orgUnitNames)
+	}
+
+	update = update.SetUpdateTime(time.Now())
+
+	entity, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorHolidayCalendarNotFound("holiday calendar not found")
+		}
+		if ent.IsConstraintError(err) {
+			return nil, hrV1.ErrorAlreadyExists("holiday calendar already exists with this name")
+		}
+		r.log.Errorf("update holiday calendar failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update holiday calendar failed")
+	}
+	return entity, nil
+}
+
+// ClearDefault unsets the default flag on every calendar of the tenant except keepID,
+// so that at most one calendar is the default.
+func (r *HolidayCalendarRepo) ClearDefault(ctx context.Context, tenantID uint32, keepID string) error {
+	err := r.entClient.Client().HolidayCalendar.Update().
+		Where(
+			holidaycalendar.TenantID(tenantID),
+			holidaycalendar.IsDefault(true),
+			holidaycalendar.IDNEQ(keepID),
+		).
+		SetIsDefault(false).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("clear default holiday calendar failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("update holiday calendar failed")
+	}
+	return nil
+}
+
+// Delete removes the calendar together with its holidays.
+func (r *HolidayCalendarRepo) Delete(ctx context.Context, id string) error {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete holiday calendar failed")
+	}
+
+	if _, err := tx.Holiday.Delete().Where(holiday.CalendarID(id)).Exec(ctx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		r.log.Errorf("delete holidays of calendar failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete holiday calendar failed")
+	}
+
+	if err := tx.HolidayCalendar.DeleteOneID(id).Exec(ctx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorHolidayCalendarNotFound("holiday calendar not found")
+		}
+		r.log.Errorf("delete holiday calendar failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete holiday calendar failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete holiday calendar failed")
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holiday"
+	"github.com/go-tangra/go-tangra-hr/internal/workday"
+)
+
+// HolidayOccurrence is a holiday placed on a concrete date. Recurring holidays
+// produce one occurrence per year.
+type HolidayOccurrence struct {
+	HolidayID  string
+	CalendarID string
+	Name       string
+	Date       time.Time
+}
+
+type HolidayRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewHolidayRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *HolidayRepo {
+	return &HolidayRepo{
+		log:       ctx.NewLoggerHelper("hr/holiday/repo"),
+		entClient: entClient,
+	}
+}
+
+func (r *HolidayRepo) Create(ctx context.Context, tenantID uint32, calendarID string, name string, date time.Time, opts ...func(*ent.HolidayCreate)) (*ent.Holiday, error) {
+	id := uuid.New().String()
+
+	create := r.entClient.Client().Holiday.Create().
+		SetID(id).
+		SetTenantID(tenantID).
+		SetCalendarID(calendarID).
+		SetName(name).
+		SetDate(date).
+		SetCreateTime(time.Now())
+
+	for _, opt := range opts {
+		opt(create)
+	}
+
+	entity, err := create.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create holiday failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create holiday failed")
+	}
+	return entity, nil
+}
+
+func (r *HolidayRepo) GetByID(ctx context.Context, id string) (*ent.Holiday, error) {
+	entity, err := r.entClient.Client().Holiday.Query().
+		Where(holiday.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get holiday failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get holiday failed")
+	}
+	return entity, nil
+}
+
+func (r *HolidayRepo) ListByCalendar(ctx context.Context, calendarID string) ([]*ent.Holiday, error) {
+	entities, err := r.entClient.Client().Holiday.Query().
+		Where(holiday.CalendarID(calendarID)).
+		Order(ent.Asc(holiday.FieldDate)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list holidays failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list holidays failed")
+	}
+	return entities, nil
+}
+
+// ListOccurrences returns every holiday of the calendar that falls between start and end
+// (inclusive, compared by date), ordered by date.
+func (r *HolidayRepo) ListOccurrences(ctx context.Context, calendarID string, start, end time.Time) ([]HolidayOccurrence, error) {
+	from := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	to := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location())
+
+	entities, err := r.entClient.Client().Holiday.Query().
+		Where(
+			holiday.CalendarID(calendarID),
+			holiday.Or(
+				holiday.Recurring(true),
+				holiday.And(
+					holiday.DateGTE(from),
+					holiday.DateLT(to.AddDate(0, 0, 1)),
+				),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list holiday occurrences failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list holidays failed")
+	}
+
+	var result []HolidayOccurrence
+	for _, e := range entities {
+		if !e.Recurring {
+			result = append(result, HolidayOccurrence{HolidayID: e.ID, CalendarID: e.CalendarID, Name: e.Name, Date: e.Date})
+			continue
+		}
+		for year := from.Year(); year <= to.Year(); year++ {
+			d := time.Date(year, e.Date.Month(), e.Date.Day(), 0, 0, 0, 0, from.Location())
+			if d.Month() != e.Date.Month() {
+				// 29 February outside a leap year
+				continue
+			}
+			if d.Before(from) || d.After(to) {
+				continue
+			}
+			result = append(result, HolidayOccurrence{HolidayID: e.ID, CalendarID: e.CalendarID, Name: e.Name, Date: d})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Date.Before(result[j].Date) })
+	return result, nil
+}
+
+// GetHolidays returns the holidays of the calendar between start and end, keyed by date.
+func (r *HolidayRepo) GetHolidays(ctx context.Context, calendarID string, start, end time.Time) (workday.Holidays, error) {
+	occurrences, err := r.ListOccurrences(ctx, calendarID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays := make(workday.Holidays, len(occurrences))
+	for _, o := range occurrences {
+		holidays[o.Date.Format(workday.DateLayout)] = o.Name
+	}
+	return holidays, nil
+}
+
+func (r *HolidayRepo) Update(ctx context.Context, id string, updates map[string]interface{}) (*ent.Holiday, error) {
+	update := r.entClient.Client().Holiday.UpdateOneID(id)
+
+	if name, ok := updates["name"].(string); ok {
+		update = update.SetName(name)
+	}
+	if date, ok := updates["date"].(time.Time); ok {
+		update = update.SetDate(date)
+	}
+	if recurring, ok := updates["recurring"].(bool); ok {
+		update = update.SetRecurring(recurring)
+	}
+
+	update = update.SetUpdateTime(time.Now())
+
+	entity, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorHolidayNotFound("holiday not found")
+		}
+		r.log.Errorf("update holiday failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update holiday failed")
+	}
+	return entity, nil
+}
+
+func (r *HolidayRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().Holiday.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorHolidayNotFound("holiday not found")
+		}
+		r.log.Errorf("delete holiday failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete holiday failed")
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"math"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -9,8 +10,16 @@ import (
 	entCrud "github.com/tx7do/go-crud/entgo"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
+	"github.com/go-tangra/go-tangra-hr/internal/accrual"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancetransaction"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/employment"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveallowance"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-hr/internal/proration"
+	"github.com/go-tangra/go-tangra-hr/internal/rollover"
+	"github.com/go-tangra/go-tangra-hr/internal/workday"
 	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
 )
 
@@ -26,10 +35,25 @@ func NewLeaveAllowanceRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[
 	}
 }
 
-func (r *LeaveAllowanceRepo) Create(ctx context.Context, tenantID uint32, userID uint32, absenceTypeID string, year int, totalDays float64, opts ...func(*ent.LeaveAllowanceCreate)) (*ent.LeaveAllowance, error) {
+// Create creates the allowance and records its total days, and any carried-over days, as the
+// first ledger entries. The outbox messages returned by outbox for the allowance are written in
+// the same transaction; outbox may be nil.
+func (r *LeaveAllowanceRepo) Create(ctx context.Context, tenantID uint32, userID uint32, absenceTypeID string, year int, totalDays float64, ref LedgerRef, outbox func(*ent.LeaveAllowance) []OutboxMessage, opts ...func(*ent.LeaveAllowanceCreate)) (*ent.LeaveAllowance, error) {
 	id := uuid.New().String()
 
-	create := r.entClient.Client().LeaveAllowance.Create().
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave allowance failed")
+	}
+
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+	}
+
+	create := tx.LeaveAllowance.Create().
 		SetID(id).
 		SetTenantID(tenantID).
 		SetUserID(userID).
@@ -47,13 +71,38 @@ func (r *LeaveAllowanceRepo) Create(ctx context.Context, tenantID uint32, userID
 
 	entity, err := create.Save(ctx)
 	if err != nil {
+		rollback()
 		if ent.IsConstraintError(err) {
 			return nil, hrV1.ErrorAlreadyExists("allowance already exists for this user and year")
 		}
 		r.log.Errorf("create leave allowance failed: %s", err.Error())
 		return nil, hrV1.ErrorInternalServerError("create leave allowance failed")
 	}
-	return entity, nil
+
+	var balance AllowanceBalance
+	balance.apply(allowancetransaction.KindGrant, entity.TotalDays)
+	err = createEntry(ctx, tx, entity, allowancetransaction.KindGrant, entity.TotalDays, balance, ref)
+	if err == nil && entity.CarriedOver != 0 {
+		balance.apply(allowancetransaction.KindCarryOver, entity.CarriedOver)
+		err = createEntry(ctx, tx, entity, allowancetransaction.KindCarryOver, entity.CarriedOver, balance, ref)
+	}
+	if err != nil {
+		rollback()
+		r.log.Errorf("record allowance grant failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave allowance failed")
+	}
+
+	if err := createAllowanceOutbox(ctx, tx, outbox, entity); err != nil {
+		rollback()
+		r.log.Errorf("create outbox messages failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave allowance failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave allowance failed")
+	}
+	return entity.Unwrap(), nil
 }
 
 func (r *LeaveAllowanceRepo) GetByID(ctx context.Context, id string) (*ent.LeaveAllowance, error) {
@@ -86,6 +135,9 @@ func (r *LeaveAllowanceRepo) List(ctx context.Context, tenantID uint32, page, pa
 	if absenceTypeID, ok := filters["absence_type_id"].(string); ok && absenceTypeID != "" {
 		query = query.Where(leaveallowance.AbsenceTypeID(absenceTypeID))
 	}
+	if overconsumed, ok := filters["overconsumed"].(bool); ok && overconsumed {
+		query = query.Where(leaveallowance.OverconsumedDaysGT(0))
+	}
 
 	total, err := query.Clone().Count(ctx)
 	if err != nil {
@@ -144,98 +196,326 @@ func (r *LeaveAllowanceRepo) GetByUserAndYear(ctx context.Context, tenantID uint
 	return entities, nil
 }
 
-func (r *LeaveAllowanceRepo) Update(ctx context.Context, id string, updates map[string]interface{}) (*ent.LeaveAllowance, error) {
-	update := r.entClient.Client().LeaveAllowance.UpdateOneID(id)
+// Update applies the updates to the allowance. Changes to total, carried-over or used days are
+// recorded in the ledger as adjustments, carry-overs, and deductions or refunds respectively. The
+// outbox messages returned by outbox for the allowance are written in the same transaction.
+func (r *LeaveAllowanceRepo) Update(ctx context.Context, id string, updates map[string]interface{}, ref LedgerRef, outbox func(*ent.LeaveAllowance) []OutboxMessage) (*ent.LeaveAllowance, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update leave allowance failed")
+	}
+
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+	}
+
+	allowance, err := tx.LeaveAllowance.Query().
+		Where(leaveallowance.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		rollback()
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorAllowanceNotFound("leave allowance not found")
+		}
+		r.log.Errorf("lock allowance for update failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update leave allowance failed")
+	}
+
+	balance, err := ledgerBalance(ctx, tx, allowance)
+	if err != nil {
+		rollback()
+		r.log.Errorf("read allowance ledger failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update leave allowance failed")
+	}
 
-	if totalDays, ok := updates["total_days"].(float64); ok {
-		update = update.SetTotalDays(totalDays)
+	var changes []ledgerChange
+	if totalDays, ok := updates["total_days"].(float64); ok && totalDays != balance.TotalDays {
+		changes = append(changes, ledgerChange{allowancetransaction.KindAdjustment, totalDays - balance.TotalDays})
 	}
-	if usedDays, ok := updates["used_days"].(float64); ok {
-		update = update.SetUsedDays(usedDays)
+	if carriedOver, ok := updates["carried_over"].(float64); ok && carriedOver != balance.CarriedOver {
+		changes = append(changes, ledgerChange{allowancetransaction.KindCarryOver, carriedOver - balance.CarriedOver})
 	}
-	if carriedOver, ok := updates["carried_over"].(float64); ok {
-		update = update.SetCarriedOver(carriedOver)
+	if usedDays, ok := updates["used_days"].(float64); ok && usedDays != balance.UsedDays {
+		if usedDays > balance.UsedDays {
+			changes = append(changes, ledgerChange{allowancetransaction.KindDeduction, usedDays - balance.UsedDays})
+		} else {
+			changes = append(changes, ledgerChange{allowancetransaction.KindRefund, balance.UsedDays - usedDays})
+		}
 	}
+
+	for _, c := range changes {
+		balance, err = appendEntry(ctx, tx, allowance, balance, c.kind, c.days, ref)
+		if err != nil {
+			rollback()
+			r.log.Errorf("record allowance change failed: %s", err.Error())
+			return nil, hrV1.ErrorInternalServerError("update leave allowance failed")
+		}
+	}
+
+	update := tx.LeaveAllowance.UpdateOneID(id)
+
 	if notes, ok := updates["notes"].(string); ok {
 		update = update.SetNotes(notes)
 	}
 	if userName, ok := updates["user_name"].(string); ok {
 		update = update.SetUserName(userName)
 	}
+	if accrualStart, ok := updates["accrual_start"].(time.Time); ok {
+		update = update.SetAccrualStart(accrualStart)
+	}
+	if carryOverExpiry, ok := updates["carry_over_expiry"].(time.Time); ok {
+		update = update.SetCarryOverExpiry(carryOverExpiry)
+	}
 
 	update = update.SetUpdateTime(time.Now())
 
 	entity, err := update.Save(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, hrV1.ErrorAllowanceNotFound("leave allowance not found")
-		}
+		rollback()
 		r.log.Errorf("update leave allowance failed: %s", err.Error())
 		return nil, hrV1.ErrorInternalServerError("update leave allowance failed")
 	}
-	return entity, nil
-}
 
-func (r *LeaveAllowanceRepo) AddUsedDays(ctx context.Context, id string, days float64) error {
-	_, err := r.entClient.Client().LeaveAllowance.UpdateOneID(id).
-		AddUsedDays(days).
-		SetUpdateTime(time.Now()).
-		Save(ctx)
-	if err != nil {
-		r.log.Errorf("add used days failed: %s", err.Error())
-		return hrV1.ErrorInternalServerError("update allowance failed")
+	if err := createAllowanceOutbox(ctx, tx, outbox, entity); err != nil {
+		rollback()
+		r.log.Errorf("create outbox messages failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update leave allowance failed")
 	}
-	return nil
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update leave allowance failed")
+	}
+	return entity.Unwrap(), nil
 }
 
-// RefundWithFloorCheck atomically refunds days, capping at 0 to prevent negative used_days.
-func (r *LeaveAllowanceRepo) RefundWithFloorCheck(ctx context.Context, allowanceID string, days float64) error {
-	if days <= 0 {
+// AddUsedDays records a deduction (positive days) or refund (negative days) against the allowance.
+func (r *LeaveAllowanceRepo) AddUsedDays(ctx context.Context, id string, days float64, ref LedgerRef) error {
+	if days == 0 {
 		return nil
 	}
 
 	tx, err := r.entClient.Client().Tx(ctx)
 	if err != nil {
-		r.log.Errorf("begin refund transaction failed: %s", err.Error())
-		return hrV1.ErrorInternalServerError("failed to refund allowance")
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("update allowance failed")
+	}
+
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
 	}
 
 	allowance, err := tx.LeaveAllowance.Query().
-		Where(leaveallowance.ID(allowanceID)).
+		Where(leaveallowance.ID(id)).
 		ForUpdate().
 		Only(ctx)
 	if err != nil {
-		if rbErr := tx.Rollback(); rbErr != nil {
-			r.log.Errorf("rollback failed: %s", rbErr.Error())
-		}
+		rollback()
+		r.log.Errorf("add used days failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("update allowance failed")
+	}
+
+	kind := allowancetransaction.KindDeduction
+	if days < 0 {
+		kind = allowancetransaction.KindRefund
+		days = -days
+	}
+
+	balance, err := ledgerBalance(ctx, tx, allowance)
+	if err == nil {
+		_, err = appendEntry(ctx, tx, allowance, balance, kind, days, ref)
+	}
+	if err != nil {
+		rollback()
+		r.log.Errorf("add used days failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("update allowance failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("update allowance failed")
+	}
+	return nil
+}
+
+// RefundWithFloorCheck atomically refunds days, capping at 0 to prevent negative used_days.
+func (r *LeaveAllowanceRepo) RefundWithFloorCheck(ctx context.Context, allowanceID string, days float64, ref LedgerRef) error {
+	return r.RefundDeductions(ctx, []schema.LeaveDeduction{{AllowanceID: allowanceID, Days: days}}, ref)
+}
+
+// DeductWithBalanceCheck atomically verifies sufficient balance and deducts each year's days from
+// the matching allowance in a single transaction. Every year with days to deduct must have an
+// allowance configured, otherwise nothing is deducted. Returns the deductions made.
+func (r *LeaveAllowanceRepo) DeductWithBalanceCheck(ctx context.Context, tenantID uint32, userID uint32, absenceTypeID string, portions []workday.YearDays, ref LedgerRef) ([]schema.LeaveDeduction, error) {
+	return r.deductPortions(ctx, portions, ref, "insufficient allowance", func(year int) predicate.LeaveAllowance {
+		return leaveallowance.And(
+			leaveallowance.TenantID(tenantID),
+			leaveallowance.UserID(userID),
+			leaveallowance.AbsenceTypeID(absenceTypeID),
+			leaveallowance.Year(year),
+		)
+	})
+}
+
+func (r *LeaveAllowanceRepo) GetByUserAndPoolAndYear(ctx context.Context, tenantID uint32, userID uint32, poolID string, year int) (*ent.LeaveAllowance, error) {
+	entity, err := r.entClient.Client().LeaveAllowance.Query().
+		Where(
+			leaveallowance.TenantID(tenantID),
+			leaveallowance.UserID(userID),
+			leaveallowance.AllowancePoolID(poolID),
+			leaveallowance.Year(year),
+		).
+		WithAbsenceType().
+		WithAllowancePool().
+		Only(ctx)
+	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil // allowance was deleted, nothing to refund
+			return nil, nil
 		}
-		r.log.Errorf("lock allowance for refund failed: %s", err.Error())
-		return hrV1.ErrorInternalServerError("failed to refund allowance")
+		r.log.Errorf("get leave allowance by user/pool/year failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get leave allowance failed")
 	}
+	return entity, nil
+}
 
-	refund := days
-	if allowance.UsedDays-refund < 0 {
-		refund = allowance.UsedDays
+// DeductPoolWithBalanceCheck atomically verifies sufficient balance and deducts each year's days
+// from the matching pool-based allowance. Every year with days to deduct must have an allowance
+// configured, otherwise nothing is deducted.
+func (r *LeaveAllowanceRepo) DeductPoolWithBalanceCheck(ctx context.Context, tenantID uint32, userID uint32, poolID string, portions []workday.YearDays, ref LedgerRef) ([]schema.LeaveDeduction, error) {
+	return r.deductPortions(ctx, portions, ref, "insufficient pool allowance", func(year int) predicate.LeaveAllowance {
+		return leaveallowance.And(
+			leaveallowance.TenantID(tenantID),
+			leaveallowance.UserID(userID),
+			leaveallowance.AllowancePoolID(poolID),
+			leaveallowance.Year(year),
+		)
+	})
+}
+
+// deductPortions locks the allowance of each year with ForUpdate, checks its ledger balance and
+// records a deduction of the year's days. A year without an allowance fails the deduction like an
+// insufficient balance does. Either every portion is deducted or none is.
+func (r *LeaveAllowanceRepo) deductPortions(ctx context.Context, portions []workday.YearDays, ref LedgerRef, insufficientMsg string, match func(year int) predicate.LeaveAllowance) ([]schema.LeaveDeduction, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("failed to check allowance")
 	}
-	if refund <= 0 {
+
+	rollback := func() {
 		if rbErr := tx.Rollback(); rbErr != nil {
 			r.log.Errorf("rollback failed: %s", rbErr.Error())
 		}
+	}
+
+	var deductions []schema.LeaveDeduction
+	for _, p := range portions {
+		if p.Days <= 0 {
+			continue
+		}
+
+		// Lock the row with ForUpdate to prevent concurrent modifications
+		allowance, err := tx.LeaveAllowance.Query().
+			Where(match(p.Year)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			rollback()
+			if ent.IsNotFound(err) {
+				return nil, hrV1.ErrorInsufficientAllowance("no leave allowance configured for this type and %d", p.Year)
+			}
+			r.log.Errorf("lock allowance row failed: %s", err.Error())
+			return nil, hrV1.ErrorInternalServerError("failed to check allowance")
+		}
+
+		balance, err := ledgerBalance(ctx, tx, allowance)
+		if err != nil {
+			rollback()
+			r.log.Errorf("read allowance ledger failed: %s", err.Error())
+			return nil, hrV1.ErrorInternalServerError("failed to check allowance")
+		}
+
+		if remaining := balance.Remaining(); p.Days > remaining {
+			rollback()
+			return nil, hrV1.ErrorInsufficientAllowance("%s for %d: %.1f days requested, %.1f days remaining", insufficientMsg, p.Year, p.Days, remaining)
+		}
+
+		if _, err := appendEntry(ctx, tx, allowance, balance, allowancetransaction.KindDeduction, p.Days, ref); err != nil {
+			rollback()
+			r.log.Errorf("deduct allowance failed: %s", err.Error())
+			return nil, hrV1.ErrorInternalServerError("failed to deduct allowance")
+		}
+
+		deductions = append(deductions, schema.LeaveDeduction{AllowanceID: allowance.ID, Year: p.Year, Days: p.Days})
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit allowance deduction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("failed to deduct allowance")
+	}
+
+	return deductions, nil
+}
+
+// RefundDeductions records a refund of each deduction's days to its allowance, capping at 0 to
+// prevent negative used_days. Deductions whose allowance was deleted are skipped.
+func (r *LeaveAllowanceRepo) RefundDeductions(ctx context.Context, deductions []schema.LeaveDeduction, ref LedgerRef) error {
+	if len(deductions) == 0 {
 		return nil
 	}
 
-	_, err = tx.LeaveAllowance.UpdateOneID(allowance.ID).
-		AddUsedDays(-refund).
-		SetUpdateTime(time.Now()).
-		Save(ctx)
+	tx, err := r.entClient.Client().Tx(ctx)
 	if err != nil {
+		r.log.Errorf("begin refund transaction failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("failed to refund allowance")
+	}
+
+	rollback := func() {
 		if rbErr := tx.Rollback(); rbErr != nil {
 			r.log.Errorf("rollback failed: %s", rbErr.Error())
 		}
-		r.log.Errorf("refund allowance failed: %s", err.Error())
-		return hrV1.ErrorInternalServerError("failed to refund allowance")
+	}
+
+	for _, d := range deductions {
+		allowance, err := tx.LeaveAllowance.Query().
+			Where(leaveallowance.ID(d.AllowanceID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				continue // allowance was deleted, nothing to refund
+			}
+			rollback()
+			r.log.Errorf("lock allowance for refund failed: %s", err.Error())
+			return hrV1.ErrorInternalServerError("failed to refund allowance")
+		}
+
+		balance, err := ledgerBalance(ctx, tx, allowance)
+		if err != nil {
+			rollback()
+			r.log.Errorf("read allowance ledger failed: %s", err.Error())
+			return hrV1.ErrorInternalServerError("failed to refund allowance")
+		}
+
+		refund := d.Days
+		if balance.UsedDays-refund < 0 {
+			refund = balance.UsedDays
+		}
+		if refund <= 0 {
+			continue
+		}
+
+		if _, err := appendEntry(ctx, tx, allowance, balance, allowancetransaction.KindRefund, refund, ref); err != nil {
+			rollback()
+			r.log.Errorf("refund allowance failed: %s", err.Error())
+			return hrV1.ErrorInternalServerError("failed to refund allowance")
+		}
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -246,143 +526,693 @@ func (r *LeaveAllowanceRepo) RefundWithFloorCheck(ctx context.Context, allowance
 	return nil
 }
 
-// DeductWithBalanceCheck atomically verifies sufficient balance and deducts days in a single transaction.
-// Returns the allowance ID on success, or an error if balance is insufficient or not found.
-func (r *LeaveAllowanceRepo) DeductWithBalanceCheck(ctx context.Context, tenantID uint32, userID uint32, absenceTypeID string, year int, days float64) (string, error) {
-	tx, err := r.entClient.Client().Tx(ctx)
-	if err != nil {
-		r.log.Errorf("begin transaction failed: %s", err.Error())
-		return "", hrV1.ErrorInternalServerError("failed to check allowance")
-	}
-
-	// Lock the row with ForUpdate to prevent concurrent modifications
-	allowance, err := tx.LeaveAllowance.Query().
-		Where(
+// RebookWithBalanceCheck moves the deductions of a leave request to the portions of its new
+// dates in a single transaction: each allowance is deducted or refunded only the difference
+// between its new and previous days, after checking its balance for any increase. Returns the
+// deductions for the new dates.
+func (r *LeaveAllowanceRepo) RebookWithBalanceCheck(ctx context.Context, tenantID uint32, userID uint32, absenceTypeID string, previous []schema.LeaveDeduction, portions []workday.YearDays, ref LedgerRef) ([]schema.LeaveDeduction, error) {
+	return r.rebookPortions(ctx, previous, portions, ref, "insufficient allowance", func(year int) predicate.LeaveAllowance {
+		return leaveallowance.And(
 			leaveallowance.TenantID(tenantID),
 			leaveallowance.UserID(userID),
 			leaveallowance.AbsenceTypeID(absenceTypeID),
 			leaveallowance.Year(year),
-		).
-		ForUpdate().
-		Only(ctx)
+		)
+	})
+}
+
+// RebookPoolWithBalanceCheck is RebookWithBalanceCheck for pool-based allowances.
+func (r *LeaveAllowanceRepo) RebookPoolWithBalanceCheck(ctx context.Context, tenantID uint32, userID uint32, poolID string, previous []schema.LeaveDeduction, portions []workday.YearDays, ref LedgerRef) ([]schema.LeaveDeduction, error) {
+	return r.rebookPortions(ctx, previous, portions, ref, "insufficient pool allowance", func(year int) predicate.LeaveAllowance {
+		return leaveallowance.And(
+			leaveallowance.TenantID(tenantID),
+			leaveallowance.UserID(userID),
+			leaveallowance.AllowancePoolID(poolID),
+			leaveallowance.Year(year),
+		)
+	})
+}
+
+// rebookPortions locks the allowance of each new portion, records a deduction or refund of the
+// difference to the previous deduction from it, then refunds the previous deductions from
+// allowances no longer used. Refunds are capped at the used days, as in RefundDeductions. A new
+// year without an allowance fails the rebooking. Either every change is recorded or none is.
+func (r *LeaveAllowanceRepo) rebookPortions(ctx context.Context, previous []schema.LeaveDeduction, portions []workday.YearDays, ref LedgerRef, insufficientMsg string, match func(year int) predicate.LeaveAllowance) ([]schema.LeaveDeduction, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
 	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("failed to rebook allowance")
+	}
+
+	rollback := func() {
 		if rbErr := tx.Rollback(); rbErr != nil {
 			r.log.Errorf("rollback failed: %s", rbErr.Error())
 		}
-		if ent.IsNotFound(err) {
-			return "", nil // no allowance configured
+	}
+
+	previousDays := make(map[string]float64, len(previous))
+	for _, d := range previous {
+		previousDays[d.AllowanceID] += d.Days
+	}
+
+	// record books the change of an allowance's days, from its previous to its new deduction
+	record := func(allowance *ent.LeaveAllowance, year int, days float64) error {
+		balance, err := ledgerBalance(ctx, tx, allowance)
+		if err != nil {
+			r.log.Errorf("read allowance ledger failed: %s", err.Error())
+			return hrV1.ErrorInternalServerError("failed to rebook allowance")
+		}
+
+		delta := days - previousDays[allowance.ID]
+		delete(previousDays, allowance.ID)
+
+		kind := allowancetransaction.KindDeduction
+		switch {
+		case delta > 0:
+			if remaining := balance.Remaining(); delta > remaining {
+				return hrV1.ErrorInsufficientAllowance("%s for %d: %.1f more days requested, %.1f days remaining", insufficientMsg, year, delta, remaining)
+			}
+		case delta < 0:
+			kind = allowancetransaction.KindRefund
+			delta = math.Min(-delta, balance.UsedDays)
 		}
-		r.log.Errorf("lock allowance row failed: %s", err.Error())
-		return "", hrV1.ErrorInternalServerError("failed to check allowance")
+		if delta <= 0 {
+			return nil
+		}
+
+		if _, err := appendEntry(ctx, tx, allowance, balance, kind, delta, ref); err != nil {
+			r.log.Errorf("rebook allowance failed: %s", err.Error())
+			return hrV1.ErrorInternalServerError("failed to rebook allowance")
+		}
+		return nil
 	}
 
-	remaining := allowance.TotalDays + allowance.CarriedOver - allowance.UsedDays
-	if days > remaining {
-		if rbErr := tx.Rollback(); rbErr != nil {
-			r.log.Errorf("rollback failed: %s", rbErr.Error())
+	var deductions []schema.LeaveDeduction
+	for _, p := range portions {
+		if p.Days <= 0 {
+			continue
+		}
+
+		allowance, err := tx.LeaveAllowance.Query().
+			Where(match(p.Year)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			rollback()
+			if ent.IsNotFound(err) {
+				return nil, hrV1.ErrorInsufficientAllowance("no leave allowance configured for this type and %d", p.Year)
+			}
+			r.log.Errorf("lock allowance row failed: %s", err.Error())
+			return nil, hrV1.ErrorInternalServerError("failed to rebook allowance")
+		}
+
+		if err := record(allowance, p.Year, p.Days); err != nil {
+			rollback()
+			return nil, err
+		}
+		deductions = append(deductions, schema.LeaveDeduction{AllowanceID: allowance.ID, Year: p.Year, Days: p.Days})
+	}
+
+	// Years the request no longer covers are refunded in full
+	for _, d := range previous {
+		if _, ok := previousDays[d.AllowanceID]; !ok {
+			continue
 		}
-		return "", hrV1.ErrorInsufficientAllowance("insufficient allowance: %.1f days requested, %.1f days remaining", days, remaining)
+
+		allowance, err := tx.LeaveAllowance.Query().
+			Where(leaveallowance.ID(d.AllowanceID)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				delete(previousDays, d.AllowanceID)
+				continue // allowance was deleted, nothing to refund
+			}
+			rollback()
+			r.log.Errorf("lock allowance for refund failed: %s", err.Error())
+			return nil, hrV1.ErrorInternalServerError("failed to rebook allowance")
+		}
+
+		if err := record(allowance, d.Year, 0); err != nil {
+			rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit allowance rebooking failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("failed to rebook allowance")
+	}
+
+	return deductions, nil
+}
+
+// ListByYears returns the allowances of every tenant for the given years, with their absence
+// type and pool loaded. Used by background jobs.
+func (r *LeaveAllowanceRepo) ListByYears(ctx context.Context, years ...int) ([]*ent.LeaveAllowance, error) {
+	entities, err := r.entClient.Client().LeaveAllowance.Query().
+		Where(leaveallowance.YearIn(years...)).
+		WithAbsenceType().
+		WithAllowancePool().
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list leave allowances by year failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list leave allowances failed")
 	}
+	return entities, nil
+}
 
-	_, err = tx.LeaveAllowance.UpdateOneID(allowance.ID).
-		AddUsedDays(days).
-		SetUpdateTime(time.Now()).
-		Save(ctx)
+// PostAccrual brings the days posted by accrual up to accrued, recording the difference as a
+// grant. Accrued days are never taken back. The outbox messages returned by outbox for the
+// allowance are written along with the days posted. Returns the days posted.
+func (r *LeaveAllowanceRepo) PostAccrual(ctx context.Context, id string, accrued float64, ref LedgerRef, outbox func(*ent.LeaveAllowance) []OutboxMessage) (float64, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
 	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return 0, hrV1.ErrorInternalServerError("post accrual failed")
+	}
+
+	rollback := func() {
 		if rbErr := tx.Rollback(); rbErr != nil {
 			r.log.Errorf("rollback failed: %s", rbErr.Error())
 		}
-		r.log.Errorf("deduct allowance failed: %s", err.Error())
-		return "", hrV1.ErrorInternalServerError("failed to deduct allowance")
+	}
+
+	allowance, err := tx.LeaveAllowance.Query().
+		Where(leaveallowance.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		rollback()
+		if ent.IsNotFound(err) {
+			return 0, nil // allowance was deleted
+		}
+		r.log.Errorf("lock allowance for accrual failed: %s", err.Error())
+		return 0, hrV1.ErrorInternalServerError("post accrual failed")
+	}
+
+	days := math.Round((accrued-allowance.AccruedDays)*100) / 100
+	if days <= 0 {
+		rollback()
+		return 0, nil
+	}
+
+	balance, err := ledgerBalance(ctx, tx, allowance)
+	if err == nil {
+		_, err = appendEntry(ctx, tx, allowance, balance, allowancetransaction.KindGrant, days, ref)
+	}
+	if err == nil {
+		_, err = tx.LeaveAllowance.UpdateOneID(id).
+			SetAccruedDays(accrued).
+			Save(ctx)
+	}
+	if err == nil {
+		err = createAllowanceOutbox(ctx, tx, outbox, allowance)
+	}
+	if err != nil {
+		rollback()
+		r.log.Errorf("post accrual failed: %s", err.Error())
+		return 0, hrV1.ErrorInternalServerError("post accrual failed")
 	}
 
 	if err := tx.Commit(); err != nil {
-		r.log.Errorf("commit allowance deduction failed: %s", err.Error())
-		return "", hrV1.ErrorInternalServerError("failed to deduct allowance")
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return 0, hrV1.ErrorInternalServerError("post accrual failed")
+	}
+	return days, nil
+}
+
+// AccrualFor returns the accrual policy that applies to the allowance, taken from its pool or
+// otherwise its absence type, and the date the user starts accruing. The absence type and pool
+// edges must be loaded. Returns a nil policy if days are granted up front.
+func AccrualFor(a *ent.LeaveAllowance) (*accrual.Policy, time.Time) {
+	start := time.Date(a.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if a.AccrualStart != nil {
+		start = *a.AccrualStart
 	}
 
-	return allowance.ID, nil
+	if a.Edges.AllowancePool != nil {
+		return a.Edges.AllowancePool.AccrualPolicy, start
+	}
+	if a.Edges.AbsenceType != nil {
+		return a.Edges.AbsenceType.AccrualPolicy, start
+	}
+	return nil, start
 }
 
-// GetByUserAndPoolAndYear returns the allowance for a specific user, pool, and year.
-func (r *LeaveAllowanceRepo) GetByUserAndPoolAndYear(ctx context.Context, tenantID uint32, userID uint32, poolID string, year int) (*ent.LeaveAllowance, error) {
-	entity, err := r.entClient.Client().LeaveAllowance.Query().
-		Where(
-			leaveallowance.TenantID(tenantID),
-			leaveallowance.UserID(userID),
-			leaveallowance.AllowancePoolID(poolID),
-			leaveallowance.Year(year),
-		).
+// RolloverItem is the planned rollover of one allowance into the next year.
+type RolloverItem struct {
+	Source *ent.LeaveAllowance
+	// Target is the next year's allowance; nil when it is still to be created.
+	Target    *ent.LeaveAllowance
+	Remaining float64
+	TotalDays float64
+	// AnnualDays is the full-year entitlement when TotalDays is pro-rated.
+	AnnualDays  *float64
+	CarriedOver float64
+	Forfeited   float64
+	Expiry      *time.Time
+	// SkipReason is set when the rollover leaves the next year untouched.
+	SkipReason string
+}
+
+// rolloverKey identifies the allowances of a user for one absence type or pool across years.
+type rolloverKey struct {
+	tenantID      uint32
+	userID        uint32
+	absenceTypeID string
+	poolID        string
+}
+
+func rolloverKeyOf(a *ent.LeaveAllowance) rolloverKey {
+	k := rolloverKey{userID: a.UserID}
+	if a.TenantID != nil {
+		k.tenantID = *a.TenantID
+	}
+	if a.AbsenceTypeID != nil {
+		k.absenceTypeID = *a.AbsenceTypeID
+	}
+	if a.AllowancePoolID != nil {
+		k.poolID = *a.AllowancePoolID
+	}
+	return k
+}
+
+// PlanRollover plans the rollover of the allowances of fromYear into the following year as of
+// the time at. Supported filters are tenant_id, user_id, absence_type_id and allowance_pool_id;
+// without a tenant_id filter the allowances of every tenant are planned.
+func (r *LeaveAllowanceRepo) PlanRollover(ctx context.Context, fromYear int, filters map[string]interface{}, at time.Time) ([]RolloverItem, error) {
+	var scope []predicate.LeaveAllowance
+	if tenantID, ok := filters["tenant_id"].(uint32); ok {
+		scope = append(scope, leaveallowance.TenantID(tenantID))
+	}
+	if userID, ok := filters["user_id"].(uint32); ok && userID > 0 {
+		scope = append(scope, leaveallowance.UserID(userID))
+	}
+	if absenceTypeID, ok := filters["absence_type_id"].(string); ok && absenceTypeID != "" {
+		scope = append(scope, leaveallowance.AbsenceTypeID(absenceTypeID))
+	}
+	if poolID, ok := filters["allowance_pool_id"].(string); ok && poolID != "" {
+		scope = append(scope, leaveallowance.AllowancePoolID(poolID))
+	}
+
+	sources, err := r.entClient.Client().LeaveAllowance.Query().
+		Where(leaveallowance.Year(fromYear)).
+		Where(scope...).
 		WithAbsenceType().
 		WithAllowancePool().
-		Only(ctx)
+		Order(ent.Asc(leaveallowance.FieldUserName), ent.Asc(leaveallowance.FieldUserID)).
+		All(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, nil
+		r.log.Errorf("list allowances for rollover failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("plan rollover failed")
+	}
+	if len(sources) == 0 {
+		return nil, nil
+	}
+
+	balances, err := r.Balances(ctx, sources)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := r.entClient.Client().LeaveAllowance.Query().
+		Where(leaveallowance.Year(fromYear + 1)).
+		Where(scope...).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list next year allowances failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("plan rollover failed")
+	}
+	targets := make(map[rolloverKey]*ent.LeaveAllowance, len(existing))
+	for _, t := range existing {
+		targets[rolloverKeyOf(t)] = t
+	}
+
+	employments, err := r.employmentsOf(ctx, sources)
+	if err != nil {
+		return nil, err
+	}
+	nextYear := time.Date(fromYear+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	items := make([]RolloverItem, 0, len(sources))
+	for _, a := range sources {
+		policy := RolloverFor(a)
+		balance := balances[a.ID]
+		key := rolloverKeyOf(a)
+		emp := employments[rolloverKey{tenantID: key.tenantID, userID: key.userID}]
+
+		// A pro-rated allowance renews at its full-year entitlement
+		annual := balance.TotalDays
+		if a.AnnualDays != nil {
+			annual = *a.AnnualDays
 		}
-		r.log.Errorf("get leave allowance by user/pool/year failed: %s", err.Error())
-		return nil, hrV1.ErrorInternalServerError("get leave allowance failed")
+
+		item := RolloverItem{
+			Source:    a,
+			Target:    targets[key],
+			Remaining: balance.Remaining(),
+			TotalDays: policy.TotalDays(annual),
+			Expiry:    policy.Expiry(fromYear + 1),
+		}
+		item.CarriedOver, item.Forfeited = policy.Carry(item.Remaining)
+
+		if policy != nil && policy.Prorate && emp != nil {
+			if fraction := proration.Fraction(fromYear+1, emp.StartDate, emp.EndDate); fraction < 1 {
+				annualDays := item.TotalDays
+				item.AnnualDays = &annualDays
+				item.TotalDays = proration.Days(annualDays, fraction, policy.Rounding)
+			}
+		}
+
+		// Accruing allowances start empty and are filled by the accrual job
+		if accrualPolicy, _ := AccrualFor(a); accrualPolicy.Enabled() {
+			item.TotalDays = 0
+		}
+
+		// Days carried over after the carry-over period has ended would lapse immediately
+		if rollover.Expired(item.Expiry, at) {
+			item.Forfeited += item.CarriedOver
+			item.CarriedOver = 0
+		}
+		if item.CarriedOver == 0 {
+			item.Expiry = nil
+		}
+
+		switch {
+		case emp != nil && emp.EndDate != nil && emp.EndDate.Before(nextYear):
+			item.SkipReason = "employment has ended"
+		case a.AllowancePoolID == nil && a.Edges.AbsenceType != nil && !a.Edges.AbsenceType.IsActive:
+			item.SkipReason = "absence type is inactive"
+		case item.Target != nil && item.Target.CarriedOver != 0:
+			item.SkipReason = "days already carried over"
+		case item.Target != nil && item.CarriedOver == 0:
+			item.SkipReason = "allowance already exists"
+		}
+		if item.Target != nil {
+			item.TotalDays = item.Target.TotalDays
+		}
+
+		items = append(items, item)
 	}
-	return entity, nil
+	return items, nil
 }
 
-// DeductPoolWithBalanceCheck atomically verifies sufficient balance and deducts days from a pool-based allowance.
-func (r *LeaveAllowanceRepo) DeductPoolWithBalanceCheck(ctx context.Context, tenantID uint32, userID uint32, poolID string, year int, days float64) (string, error) {
+// ApplyRollover carries out a planned rollover: it creates the next year's allowance, or records
+// the carried-over days on the existing one, along with the outbox messages returned by outbox
+// for it. Skipped items are left untouched. Returns the next year's allowance.
+func (r *LeaveAllowanceRepo) ApplyRollover(ctx context.Context, item RolloverItem, ref LedgerRef, outbox func(*ent.LeaveAllowance) []OutboxMessage) (*ent.LeaveAllowance, error) {
+	if item.SkipReason != "" {
+		return item.Target, nil
+	}
+	if item.Target != nil {
+		return r.carryOver(ctx, item.Target.ID, item.CarriedOver, item.Expiry, ref, outbox)
+	}
+
+	a := item.Source
+	opts := []func(*ent.LeaveAllowanceCreate){
+		func(c *ent.LeaveAllowanceCreate) { c.SetUserName(a.UserName) },
+	}
+	if a.AllowancePoolID != nil {
+		opts = append(opts, func(c *ent.LeaveAllowanceCreate) { c.SetAllowancePoolID(*a.AllowancePoolID) })
+	}
+	if item.AnnualDays != nil {
+		opts = append(opts, func(c *ent.LeaveAllowanceCreate) { c.SetAnnualDays(*item.AnnualDays) })
+	}
+	if item.CarriedOver > 0 {
+		opts = append(opts, func(c *ent.LeaveAllowanceCreate) {
+			c.SetCarriedOver(item.CarriedOver)
+			c.SetNillableCarryOverExpiry(item.Expiry)
+		})
+	}
+
+	key := rolloverKeyOf(a)
+	return r.Create(ctx, key.tenantID, a.UserID, key.absenceTypeID, a.Year+1, item.TotalDays, ref, outbox, opts...)
+}
+
+// employmentsOf returns the employments of the owners of the allowances, keyed by tenant and user.
+func (r *LeaveAllowanceRepo) employmentsOf(ctx context.Context, allowances []*ent.LeaveAllowance) (map[rolloverKey]*ent.Employment, error) {
+	userIDs := make([]uint32, 0, len(allowances))
+	for _, a := range allowances {
+		userIDs = append(userIDs, a.UserID)
+	}
+
+	entities, err := r.entClient.Client().Employment.Query().
+		Where(employment.UserIDIn(userIDs...)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list employments failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list employments failed")
+	}
+
+	employments := make(map[rolloverKey]*ent.Employment, len(entities))
+	for _, e := range entities {
+		k := rolloverKey{userID: e.UserID}
+		if e.TenantID != nil {
+			k.tenantID = *e.TenantID
+		}
+		employments[k] = e
+	}
+	return employments, nil
+}
+
+// carryOver records days carried over into an existing allowance. Fails if days have already
+// been carried over into it.
+func (r *LeaveAllowanceRepo) carryOver(ctx context.Context, id string, days float64, expiry *time.Time, ref LedgerRef, outbox func(*ent.LeaveAllowance) []OutboxMessage) (*ent.LeaveAllowance, error) {
 	tx, err := r.entClient.Client().Tx(ctx)
 	if err != nil {
 		r.log.Errorf("begin transaction failed: %s", err.Error())
-		return "", hrV1.ErrorInternalServerError("failed to check allowance")
+		return nil, hrV1.ErrorInternalServerError("carry over days failed")
+	}
+
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
 	}
 
-	// Lock the row with ForUpdate to prevent concurrent modifications
 	allowance, err := tx.LeaveAllowance.Query().
-		Where(
-			leaveallowance.TenantID(tenantID),
-			leaveallowance.UserID(userID),
-			leaveallowance.AllowancePoolID(poolID),
-			leaveallowance.Year(year),
-		).
+		Where(leaveallowance.ID(id)).
 		ForUpdate().
 		Only(ctx)
 	if err != nil {
-		if rbErr := tx.Rollback(); rbErr != nil {
-			r.log.Errorf("rollback failed: %s", rbErr.Error())
-		}
+		rollback()
 		if ent.IsNotFound(err) {
-			return "", nil // no allowance configured
+			return nil, hrV1.ErrorAllowanceNotFound("leave allowance not found")
 		}
-		r.log.Errorf("lock pool allowance row failed: %s", err.Error())
-		return "", hrV1.ErrorInternalServerError("failed to check allowance")
+		r.log.Errorf("lock allowance for carry-over failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("carry over days failed")
+	}
+	if allowance.CarriedOver != 0 {
+		rollback()
+		return nil, hrV1.ErrorAlreadyExists("days have already been carried over into this allowance")
+	}
+
+	balance, err := ledgerBalance(ctx, tx, allowance)
+	if err == nil {
+		_, err = appendEntry(ctx, tx, allowance, balance, allowancetransaction.KindCarryOver, days, ref)
+	}
+	var entity *ent.LeaveAllowance
+	if err == nil {
+		entity, err = tx.LeaveAllowance.UpdateOneID(id).
+			SetNillableCarryOverExpiry(expiry).
+			Save(ctx)
+	}
+	if err == nil {
+		err = createAllowanceOutbox(ctx, tx, outbox, entity)
+	}
+	if err != nil {
+		rollback()
+		r.log.Errorf("carry over days failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("carry over days failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("carry over days failed")
+	}
+	return entity.Unwrap(), nil
+}
+
+// ListExpiredCarryOver returns the allowances of every tenant holding carried-over days whose
+// last day of use has passed at the time at. Used by background jobs.
+func (r *LeaveAllowanceRepo) ListExpiredCarryOver(ctx context.Context, at time.Time) ([]*ent.LeaveAllowance, error) {
+	entities, err := r.entClient.Client().LeaveAllowance.Query().
+		Where(
+			leaveallowance.CarriedOverGT(0),
+			leaveallowance.CarryOverExpiryLTE(at.AddDate(0, 0, -1)),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list expired carry-over failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list leave allowances failed")
+	}
+	return entities, nil
+}
+
+// ExpireCarryOver lapses the unused carried-over days of the allowance once their last day of use
+// has passed, recording them as a negative carry-over. Days used are taken from carried-over days
+// first. The outbox messages returned by outbox for the allowance are written along with the
+// lapse. Returns the days lapsed.
+func (r *LeaveAllowanceRepo) ExpireCarryOver(ctx context.Context, id string, at time.Time, ref LedgerRef, outbox func(*ent.LeaveAllowance) []OutboxMessage) (float64, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return 0, hrV1.ErrorInternalServerError("expire carried-over days failed")
 	}
 
-	remaining := allowance.TotalDays + allowance.CarriedOver - allowance.UsedDays
-	if days > remaining {
+	rollback := func() {
 		if rbErr := tx.Rollback(); rbErr != nil {
 			r.log.Errorf("rollback failed: %s", rbErr.Error())
 		}
-		return "", hrV1.ErrorInsufficientAllowance("insufficient pool allowance: %.1f days requested, %.1f days remaining", days, remaining)
 	}
 
-	_, err = tx.LeaveAllowance.UpdateOneID(allowance.ID).
-		AddUsedDays(days).
-		SetUpdateTime(time.Now()).
-		Save(ctx)
+	allowance, err := tx.LeaveAllowance.Query().
+		Where(leaveallowance.ID(id)).
+		ForUpdate().
+		Only(ctx)
 	if err != nil {
-		if rbErr := tx.Rollback(); rbErr != nil {
-			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		rollback()
+		if ent.IsNotFound(err) {
+			return 0, nil // allowance was deleted
 		}
-		r.log.Errorf("deduct pool allowance failed: %s", err.Error())
-		return "", hrV1.ErrorInternalServerError("failed to deduct allowance")
+		r.log.Errorf("lock allowance for carry-over expiry failed: %s", err.Error())
+		return 0, hrV1.ErrorInternalServerError("expire carried-over days failed")
+	}
+	if !rollover.Expired(allowance.CarryOverExpiry, at) {
+		rollback()
+		return 0, nil
+	}
+
+	balance, err := ledgerBalance(ctx, tx, allowance)
+	if err != nil {
+		rollback()
+		r.log.Errorf("read allowance ledger failed: %s", err.Error())
+		return 0, hrV1.ErrorInternalServerError("expire carried-over days failed")
+	}
+
+	lapsed := math.Round(math.Min(balance.CarriedOver-balance.UsedDays, balance.CarriedOver)*100) / 100
+	if lapsed <= 0 {
+		rollback()
+		return 0, nil
+	}
+
+	if _, err = appendEntry(ctx, tx, allowance, balance, allowancetransaction.KindCarryOver, -lapsed, ref); err == nil {
+		err = createAllowanceOutbox(ctx, tx, outbox, allowance)
+	}
+	if err != nil {
+		rollback()
+		r.log.Errorf("expire carried-over days failed: %s", err.Error())
+		return 0, hrV1.ErrorInternalServerError("expire carried-over days failed")
 	}
 
 	if err := tx.Commit(); err != nil {
-		r.log.Errorf("commit pool allowance deduction failed: %s", err.Error())
-		return "", hrV1.ErrorInternalServerError("failed to deduct allowance")
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return 0, hrV1.ErrorInternalServerError("expire carried-over days failed")
+	}
+	return lapsed, nil
+}
+
+// FlagOverconsumption records on each allowance of the user the days used beyond the entitlement
+// pro-rated to their employment from start to end. Allowances of years before the end year, and
+// all allowances while end is nil, are cleared. Returns the allowances flagged as over-consumed.
+func (r *LeaveAllowanceRepo) FlagOverconsumption(ctx context.Context, tenantID uint32, userID uint32, start time.Time, end *time.Time, rounding string) ([]*ent.LeaveAllowance, error) {
+	allowances, err := r.entClient.Client().LeaveAllowance.Query().
+		Where(
+			leaveallowance.TenantID(tenantID),
+			leaveallowance.UserID(userID),
+		).
+		WithAbsenceType().
+		WithAllowancePool().
+		Order(ent.Asc(leaveallowance.FieldYear)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list user allowances failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("flag over-consumed allowances failed")
 	}
 
-	return allowance.ID, nil
+	balances, err := r.Balances(ctx, allowances)
+	if err != nil {
+		return nil, err
+	}
+
+	var flagged []*ent.LeaveAllowance
+	for _, a := range allowances {
+		overconsumed := 0.0
+		if end != nil && a.Year >= end.Year() {
+			balance := balances[a.ID]
+			overconsumed = math.Max(0, math.Round((balance.UsedDays-entitlement(a, balance, start, *end, rounding))*100)/100)
+		}
+
+		if overconsumed != a.OverconsumedDays {
+			if _, err := r.entClient.Client().LeaveAllowance.UpdateOneID(a.ID).
+				SetOverconsumedDays(overconsumed).
+				SetUpdateTime(time.Now()).
+				Save(ctx); err != nil {
+				r.log.Errorf("flag over-consumed allowance failed: %s", err.Error())
+				return nil, hrV1.ErrorInternalServerError("flag over-consumed allowances failed")
+			}
+			a.OverconsumedDays = overconsumed
+		}
+		if overconsumed > 0 {
+			flagged = append(flagged, a)
+		}
+	}
+	return flagged, nil
+}
+
+// entitlement returns the days of the allowance a user employed from start to end is entitled
+// to: the yearly days pro-rated to the employment, the days accrued by the end date, and any
+// carried-over days.
+func entitlement(a *ent.LeaveAllowance, balance AllowanceBalance, start, end time.Time, rounding string) float64 {
+	annual := balance.TotalDays - a.AccruedDays
+	if a.AnnualDays != nil {
+		annual = *a.AnnualDays
+	}
+	days := proration.Days(annual, proration.Fraction(a.Year, start, &end), rounding)
+
+	if policy, accrualStart := AccrualFor(a); policy.Enabled() {
+		days += policy.Accrued(a.Year, accrualStart, end.AddDate(0, 0, 1))
+	}
+	return days + balance.CarriedOver
+}
+
+// RolloverFor returns the rollover policy that applies to the allowance, taken from its pool or
+// otherwise its absence type. The absence type and pool edges must be loaded. Returns nil if
+// neither has a policy.
+func RolloverFor(a *ent.LeaveAllowance) *rollover.Policy {
+	if a.Edges.AllowancePool != nil {
+		return a.Edges.AllowancePool.RolloverPolicy
+	}
+	if a.Edges.AbsenceType != nil {
+		return a.Edges.AbsenceType.RolloverPolicy
+	}
+	return nil
+}
+
+// Balances returns the balance of each allowance, keyed by allowance ID, as derived from the
+// ledger. Allowances without ledger entries yet report their stored totals.
+func (r *LeaveAllowanceRepo) Balances(ctx context.Context, allowances []*ent.LeaveAllowance) (map[string]AllowanceBalance, error) {
+	ids := make([]string, len(allowances))
+	for i, a := range allowances {
+		ids[i] = a.ID
+	}
+
+	balances, err := sumLedger(ctx, r.entClient.Client(), ids...)
+	if err != nil {
+		r.log.Errorf("sum allowance ledger failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get allowance balance failed")
+	}
+
+	for _, a := range allowances {
+		if _, ok := balances[a.ID]; !ok {
+			balances[a.ID] = storedBalance(a)
+		}
+	}
+	return balances, nil
 }
 
+// Delete removes the allowance. Its ledger entries are kept as history.
 func (r *LeaveAllowanceRepo) Delete(ctx context.Context, id string) error {
 	err := r.entClient.Client().LeaveAllowance.DeleteOneID(id).Exec(ctx)
 	if err != nil {
@@ -0,0 +1,382 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveamendment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/schema"
+)
+
+type LeaveAmendmentRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewLeaveAmendmentRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *LeaveAmendmentRepo {
+	return &LeaveAmendmentRepo{
+		log:       ctx.NewLoggerHelper("hr/leave_amendment/repo"),
+		entClient: entClient,
+	}
+}
+
+// Create records an amendment of the leave request, keeping the request's current dates as the
+// previous dates.
+func (r *LeaveAmendmentRepo) Create(ctx context.Context, tenantID uint32, leaveReq *ent.LeaveRequest, startDate, endDate time.Time, days float64, status string, opts ...func(*ent.LeaveAmendmentCreate)) (*ent.LeaveAmendment, error) {
+	id := uuid.New().String()
+
+	create := r.entClient.Client().LeaveAmendment.Create().
+		SetID(id).
+		SetTenantID(tenantID).
+		SetLeaveRequestID(leaveReq.ID).
+		SetUserID(leaveReq.UserID).
+		SetStatus(leaveamendment.Status(status)).
+		SetPreviousStartDate(leaveReq.StartDate).
+		SetPreviousEndDate(leaveReq.EndDate).
+		SetPreviousStartDayPart(leaveamendment.PreviousStartDayPart(leaveReq.StartDayPart)).
+		SetPreviousEndDayPart(leaveamendment.PreviousEndDayPart(leaveReq.EndDayPart)).
+		SetPreviousDays(leaveReq.Days).
+		SetStartDate(startDate).
+		SetEndDate(endDate).
+		SetDays(days).
+		SetCreateTime(time.Now())
+
+	for _, opt := range opts {
+		opt(create)
+	}
+
+	entity, err := create.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create leave amendment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave amendment failed")
+	}
+	return entity, nil
+}
+
+func (r *LeaveAmendmentRepo) GetByID(ctx context.Context, id string) (*ent.LeaveAmendment, error) {
+	entity, err := r.entClient.Client().LeaveAmendment.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get leave amendment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get leave amendment failed")
+	}
+	return entity, nil
+}
+
+// ListByLeaveRequest returns the amendments of a leave request, newest first.
+func (r *LeaveAmendmentRepo) ListByLeaveRequest(ctx context.Context, leaveRequestID string) ([]*ent.LeaveAmendment, error) {
+	entities, err := r.entClient.Client().LeaveAmendment.Query().
+		Where(leaveamendment.LeaveRequestID(leaveRequestID)).
+		Order(ent.Desc(leaveamendment.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list leave amendments failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list leave amendments failed")
+	}
+	return entities, nil
+}
+
+// GetOpen returns the amendment of a leave request that awaits a decision or signatures, or nil
+// if there is none.
+func (r *LeaveAmendmentRepo) GetOpen(ctx context.Context, leaveRequestID string) (*ent.LeaveAmendment, error) {
+	entity, err := r.entClient.Client().LeaveAmendment.Query().
+		Where(
+			leaveamendment.LeaveRequestID(leaveRequestID),
+			leaveamendment.StatusIn(leaveamendment.StatusPending, leaveamendment.StatusAwaitingSigning),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get open leave amendment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get leave amendment failed")
+	}
+	return entity, nil
+}
+
+func (r *LeaveAmendmentRepo) GetBySigningRequestID(ctx context.Context, signingRequestID string) (*ent.LeaveAmendment, error) {
+	entity, err := r.entClient.Client().LeaveAmendment.Query().
+		Where(leaveamendment.SigningRequestID(signingRequestID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get leave amendment by signing_request_id failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get leave amendment by signing_request_id failed")
+	}
+	return entity, nil
+}
+
+// ListAwaitingSigning returns the amendments of all tenants that await the signatures of their
+// signing submission.
+func (r *LeaveAmendmentRepo) ListAwaitingSigning(ctx context.Context) ([]*ent.LeaveAmendment, error) {
+	entities, err := r.entClient.Client().LeaveAmendment.Query().
+		Where(
+			leaveamendment.StatusEQ(leaveamendment.StatusAwaitingSigning),
+			leaveamendment.SigningRequestIDNEQ(""),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list leave amendments awaiting signing failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list leave amendments failed")
+	}
+	return entities, nil
+}
+
+func (r *LeaveAmendmentRepo) UpdateStatus(ctx context.Context, id string, status string, reviewedBy uint32, reviewerName string, reviewNotes string) (*ent.LeaveAmendment, error) {
+	update := r.entClient.Client().LeaveAmendment.UpdateOneID(id).
+		SetStatus(leaveamendment.Status(status)).
+		SetUpdateTime(time.Now())
+
+	if reviewedBy > 0 {
+		update = update.SetReviewedBy(reviewedBy).
+			SetReviewerName(reviewerName).
+			SetReviewedAt(time.Now())
+	}
+	if reviewNotes != "" {
+		update = update.SetReviewNotes(reviewNotes)
+	}
+
+	entity, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorLeaveAmendmentNotFound("leave amendment not found")
+		}
+		r.log.Errorf("update leave amendment status failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update leave amendment status failed")
+	}
+	return entity, nil
+}
+
+func (r *LeaveAmendmentRepo) SetSigningRequestID(ctx context.Context, id string, signingRequestID string) error {
+	err := r.entClient.Client().LeaveAmendment.UpdateOneID(id).
+		SetSigningRequestID(signingRequestID).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorLeaveAmendmentNotFound("leave amendment not found")
+		}
+		r.log.Errorf("set amendment signing_request_id failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("set signing_request_id failed")
+	}
+	return nil
+}
+
+// CancelOpen cancels the amendments of a leave request that await a decision or signatures, as
+// when the request itself is cancelled or revoked. Returns the cancelled amendments.
+func (r *LeaveAmendmentRepo) CancelOpen(ctx context.Context, leaveRequestID string) ([]*ent.LeaveAmendment, error) {
+	open := []predicate.LeaveAmendment{
+		leaveamendment.LeaveRequestID(leaveRequestID),
+		leaveamendment.StatusIn(leaveamendment.StatusPending, leaveamendment.StatusAwaitingSigning),
+	}
+
+	entities, err := r.entClient.Client().LeaveAmendment.Query().
+		Where(open...).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list open leave amendments failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("cancel leave amendments failed")
+	}
+	if len(entities) == 0 {
+		return nil, nil
+	}
+
+	_, err = r.entClient.Client().LeaveAmendment.Update().
+		Where(open...).
+		SetStatus(leaveamendment.StatusCancelled).
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("cancel leave amendments failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("cancel leave amendments failed")
+	}
+	return entities, nil
+}
+
+// Apply moves the leave request to the dates of an amendment that awaits a decision or signatures
+// and marks the amendment applied, in one transaction. The options change the request further,
+// e.g. to store the deductions made for the new dates. An amendment is applied only once.
+func (r *LeaveAmendmentRepo) Apply(ctx context.Context, id string, opts ...func(*ent.LeaveRequestUpdateOne)) (*ent.LeaveAmendment, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("apply leave amendment failed")
+	}
+
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+	}
+
+	amendment, err := tx.LeaveAmendment.Query().
+		Where(leaveamendment.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		rollback()
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorLeaveAmendmentNotFound("leave amendment not found")
+		}
+		r.log.Errorf("lock leave amendment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("apply leave amendment failed")
+	}
+	if amendment.Status != leaveamendment.StatusPending && amendment.Status != leaveamendment.StatusAwaitingSigning {
+		rollback()
+		return nil, hrV1.ErrorBadRequest("leave amendment has already been %s", amendment.Status)
+	}
+
+	update := tx.LeaveRequest.UpdateOneID(amendment.LeaveRequestID).
+		SetStartDate(amendment.StartDate).
+		SetEndDate(amendment.EndDate).
+		SetStartDayPart(leaverequest.StartDayPart(amendment.StartDayPart)).
+		SetEndDayPart(leaverequest.EndDayPart(amendment.EndDayPart)).
+		SetHours(amendment.Hours).
+		SetDays(amendment.Days).
+		SetHolidayCalendarID(amendment.HolidayCalendarID).
+		SetUpdateTime(time.Now())
+	for _, opt := range opts {
+		opt(update)
+	}
+	if err := update.Exec(ctx); err != nil {
+		rollback()
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorLeaveRequestNotFound("leave request not found")
+		}
+		r.log.Errorf("apply leave amendment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("apply leave amendment failed")
+	}
+
+	amendment, err = tx.LeaveAmendment.UpdateOneID(id).
+		SetStatus(leaveamendment.StatusApplied).
+		SetUpdateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		rollback()
+		r.log.Errorf("mark leave amendment applied failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("apply leave amendment failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit leave amendment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("apply leave amendment failed")
+	}
+	return amendment.Unwrap(), nil
+}
+
+// ApplyWithRebooking applies an amendment of an approved leave request: the allowance deductions
+// are moved to the new dates first, then the request is moved to them. If the request cannot be
+// moved, the deductions are moved back. The options change the request further.
+func (r *LeaveAmendmentRepo) ApplyWithRebooking(ctx context.Context, allowanceRepo *LeaveAllowanceRepo, holidayRepo *HolidayRepo, scheduleRepo *WorkScheduleAssignmentRepo, e *ent.LeaveRequest, a *ent.LeaveAmendment, ref LedgerRef, opts ...func(*ent.LeaveRequestUpdateOne)) (*ent.LeaveAmendment, error) {
+	deductions, err := rebookAmendment(ctx, allowanceRepo, holidayRepo, scheduleRepo, e, a, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	deducts := e.Edges.AbsenceType != nil && e.Edges.AbsenceType.DeductsFromAllowance
+	if deducts {
+		// Store which allowances were deducted for accurate refunds later
+		opts = append(opts, func(u *ent.LeaveRequestUpdateOne) {
+			u.SetDeductions(deductions).SetDeductedAllowanceID("")
+		})
+	}
+
+	applied, err := r.Apply(ctx, a.ID, opts...)
+	if err != nil && deducts {
+		// Move the deductions back from the new dates to the previous ones
+		moved := AmendedLeave(e, a)
+		moved.Deductions = deductions
+		moved.DeductedAllowanceID = ""
+		back := &ent.LeaveAmendment{
+			StartDate:         a.PreviousStartDate,
+			EndDate:           a.PreviousEndDate,
+			StartDayPart:      leaveamendment.StartDayPart(a.PreviousStartDayPart),
+			EndDayPart:        leaveamendment.EndDayPart(a.PreviousEndDayPart),
+			Hours:             e.Hours,
+			Days:              a.PreviousDays,
+			HolidayCalendarID: e.HolidayCalendarID,
+		}
+		ref.Note = "leave date change failed"
+		if _, rebookErr := rebookAmendment(ctx, allowanceRepo, holidayRepo, scheduleRepo, moved, back, ref); rebookErr != nil {
+			r.log.Errorf("CRITICAL: failed to move deductions of leave %s back after failed date change: %s", e.ID, rebookErr.Error())
+		}
+	}
+	return applied, err
+}
+
+// AmendedLeave returns a copy of the leave request moved to the dates of the amendment, for
+// counting and splitting the new days.
+func AmendedLeave(e *ent.LeaveRequest, a *ent.LeaveAmendment) *ent.LeaveRequest {
+	amended := *e
+	amended.StartDate = a.StartDate
+	amended.EndDate = a.EndDate
+	amended.StartDayPart = leaverequest.StartDayPart(a.StartDayPart)
+	amended.EndDayPart = leaverequest.EndDayPart(a.EndDayPart)
+	amended.Hours = a.Hours
+	amended.Days = a.Days
+	amended.HolidayCalendarID = a.HolidayCalendarID
+	return &amended
+}
+
+// rebookAmendment moves the allowance deductions of an approved leave request to the dates of the
+// amendment and returns the deductions for the new dates. Requests approved before deductions
+// were recorded per year are taken to have been deducted from the allowance of their start year.
+// Returns nil if the absence type does not deduct from an allowance.
+func rebookAmendment(ctx context.Context, allowanceRepo *LeaveAllowanceRepo, holidayRepo *HolidayRepo, scheduleRepo *WorkScheduleAssignmentRepo, e *ent.LeaveRequest, a *ent.LeaveAmendment, ref LedgerRef) ([]schema.LeaveDeduction, error) {
+	absType := e.Edges.AbsenceType
+	if absType == nil || !absType.DeductsFromAllowance {
+		return nil, nil
+	}
+
+	var tenantID uint32
+	if e.TenantID != nil {
+		tenantID = *e.TenantID
+	}
+
+	previous := e.Deductions
+	if len(previous) == 0 && e.Days > 0 {
+		allowanceID := e.DeductedAllowanceID
+		if allowanceID == "" {
+			var allowance *ent.LeaveAllowance
+			var err error
+			if absType.AllowancePoolID != "" {
+				allowance, err = allowanceRepo.GetByUserAndPoolAndYear(ctx, tenantID, e.UserID, absType.AllowancePoolID, e.StartDate.Year())
+			} else {
+				allowance, err = allowanceRepo.GetByUserAndTypeAndYear(ctx, tenantID, e.UserID, e.AbsenceTypeID, e.StartDate.Year())
+			}
+			if err != nil {
+				return nil, err
+			}
+			if allowance != nil {
+				allowanceID = allowance.ID
+			}
+		}
+		if allowanceID != "" {
+			previous = []schema.LeaveDeduction{{AllowanceID: allowanceID, Year: e.StartDate.Year(), Days: e.Days}}
+		}
+	}
+
+	portions, err := LeaveDaysByYear(ctx, holidayRepo, scheduleRepo, AmendedLeave(e, a))
+	if err != nil {
+		return nil, err
+	}
+
+	if absType.AllowancePoolID != "" {
+		return allowanceRepo.RebookPoolWithBalanceCheck(ctx, tenantID, e.UserID, absType.AllowancePoolID, previous, portions, ref)
+	}
+	return allowanceRepo.RebookWithBalanceCheck(ctx, tenantID, e.UserID, e.AbsenceTypeID, previous, portions, ref)
+}
@@ -0,0 +1,147 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveattachment"
+)
+
+type LeaveAttachmentRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewLeaveAttachmentRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *LeaveAttachmentRepo {
+	return &LeaveAttachmentRepo{
+		log:       ctx.NewLoggerHelper("hr/leave_attachment/repo"),
+		entClient: entClient,
+	}
+}
+
+// Create records an attachment of a leave request and counts it on the request.
+func (r *LeaveAttachmentRepo) Create(ctx context.Context, tenantID uint32, leaveRequestID, fileName, contentType string, size int64, checksum, storageKey string, createdBy uint32) (*ent.LeaveAttachment, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave attachment failed")
+	}
+
+	entity, err := tx.LeaveAttachment.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetLeaveRequestID(leaveRequestID).
+		SetFileName(fileName).
+		SetContentType(contentType).
+		SetSize(size).
+		SetChecksum(checksum).
+		SetStorageKey(storageKey).
+		SetCreateBy(createdBy).
+		SetCreateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		r.log.Errorf("create leave attachment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave attachment failed")
+	}
+
+	if err := tx.LeaveRequest.UpdateOneID(leaveRequestID).AddAttachmentCount(1).Exec(ctx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorLeaveRequestNotFound("leave request not found")
+		}
+		r.log.Errorf("count leave attachment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave attachment failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave attachment failed")
+	}
+	return entity, nil
+}
+
+func (r *LeaveAttachmentRepo) GetByID(ctx context.Context, id string) (*ent.LeaveAttachment, error) {
+	entity, err := r.entClient.Client().LeaveAttachment.Query().
+		Where(leaveattachment.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get leave attachment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get leave attachment failed")
+	}
+	return entity, nil
+}
+
+// ListByLeaveRequest returns the attachments of a leave request, oldest first.
+func (r *LeaveAttachmentRepo) ListByLeaveRequest(ctx context.Context, leaveRequestID string) ([]*ent.LeaveAttachment, error) {
+	entities, err := r.entClient.Client().LeaveAttachment.Query().
+		Where(leaveattachment.LeaveRequestID(leaveRequestID)).
+		Order(ent.Asc(leaveattachment.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list leave attachments failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list leave attachments failed")
+	}
+	return entities, nil
+}
+
+// Delete removes an attachment and uncounts it on its leave request.
+func (r *LeaveAttachmentRepo) Delete(ctx context.Context, entity *ent.LeaveAttachment) error {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete leave attachment failed")
+	}
+
+	if err := tx.LeaveAttachment.DeleteOneID(entity.ID).Exec(ctx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorLeaveAttachmentNotFound("leave attachment not found")
+		}
+		r.log.Errorf("delete leave attachment failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete leave attachment failed")
+	}
+
+	err = tx.LeaveRequest.UpdateOneID(entity.LeaveRequestID).AddAttachmentCount(-1).Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+		r.log.Errorf("uncount leave attachment failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete leave attachment failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete leave attachment failed")
+	}
+	return nil
+}
+
+// DeleteByLeaveRequest removes the attachments of a leave request that is being deleted.
+func (r *LeaveAttachmentRepo) DeleteByLeaveRequest(ctx context.Context, leaveRequestID string) error {
+	_, err := r.entClient.Client().LeaveAttachment.Delete().
+		Where(leaveattachment.LeaveRequestID(leaveRequestID)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete leave attachments failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete leave attachments failed")
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavecomment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/schema"
+)
+
+type LeaveCommentRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewLeaveCommentRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *LeaveCommentRepo {
+	return &LeaveCommentRepo{
+		log:       ctx.NewLoggerHelper("hr/leave_comment/repo"),
+		entClient: entClient,
+	}
+}
+
+// CreateWithOutbox creates a comment and, in the same transaction, writes the outbox messages
+// returned by outbox for the created comment.
+func (r *LeaveCommentRepo) CreateWithOutbox(ctx context.Context, tenantID uint32, leaveRequestID string, authorID uint32, authorName, body, visibility string, outbox func(*ent.LeaveComment) []OutboxMessage) (*ent.LeaveComment, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave comment failed")
+	}
+
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+	}
+
+	entity, err := tx.LeaveComment.Create().
+		SetID(uuid.New().String()).
+		SetTenantID(tenantID).
+		SetLeaveRequestID(leaveRequestID).
+		SetAuthorID(authorID).
+		SetAuthorName(authorName).
+		SetBody(body).
+		SetVisibility(leavecomment.Visibility(visibility)).
+		SetCreateBy(authorID).
+		SetCreateTime(time.Now()).
+		Save(ctx)
+	if err != nil {
+		rollback()
+		r.log.Errorf("create leave comment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave comment failed")
+	}
+
+	if err := createOutboxMessages(ctx, tx.Client(), outbox(entity)); err != nil {
+		rollback()
+		r.log.Errorf("create outbox messages failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave comment failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave comment failed")
+	}
+	return entity.Unwrap(), nil
+}
+
+func (r *LeaveCommentRepo) GetByID(ctx context.Context, id string) (*ent.LeaveComment, error) {
+	entity, err := r.entClient.Client().LeaveComment.Query().
+		Where(leavecomment.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get leave comment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get leave comment failed")
+	}
+	return entity, nil
+}
+
+// ListByLeaveRequest returns the comments on a leave request, oldest first. Internal comments
+// are only included when asked for.
+func (r *LeaveCommentRepo) ListByLeaveRequest(ctx context.Context, leaveRequestID string, includeInternal bool) ([]*ent.LeaveComment, error) {
+	query := r.entClient.Client().LeaveComment.Query().
+		Where(leavecomment.LeaveRequestID(leaveRequestID))
+	if !includeInternal {
+		query = query.Where(leavecomment.VisibilityEQ(leavecomment.VisibilityShared))
+	}
+
+	entities, err := query.
+		Order(ent.Asc(leavecomment.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list leave comments failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list leave comments failed")
+	}
+	return entities, nil
+}
+
+// UpdateBody replaces the text of a comment, keeping the replaced text in its edit history.
+func (r *LeaveCommentRepo) UpdateBody(ctx context.Context, entity *ent.LeaveComment, body string, editorID uint32) (*ent.LeaveComment, error) {
+	now := time.Now()
+	edits := append(append([]schema.CommentEdit{}, entity.Edits...), schema.CommentEdit{
+		Body:     entity.Body,
+		EditedAt: now,
+	})
+
+	updated, err := r.entClient.Client().LeaveComment.UpdateOneID(entity.ID).
+		SetBody(body).
+		SetEdits(edits).
+		SetUpdateBy(editorID).
+		SetUpdateTime(now).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorLeaveCommentNotFound("leave comment not found")
+		}
+		r.log.Errorf("update leave comment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update leave comment failed")
+	}
+	return updated, nil
+}
+
+// DeleteByLeaveRequest removes the comments on a leave request that is being deleted.
+func (r *LeaveCommentRepo) DeleteByLeaveRequest(ctx context.Context, leaveRequestID string) error {
+	_, err := r.entClient.Client().LeaveComment.Delete().
+		Where(leavecomment.LeaveRequestID(leaveRequestID)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete leave comments failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete leave comments failed")
+	}
+	return nil
+}
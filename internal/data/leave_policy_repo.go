@@ -0,0 +1,178 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavepolicy"
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+)
+
+type LeavePolicyRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewLeavePolicyRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *LeavePolicyRepo {
+	return &LeavePolicyRepo{
+		log:       ctx.NewLoggerHelper("hr/leave_policy/repo"),
+		entClient: entClient,
+	}
+}
+
+func (r *LeavePolicyRepo) Create(ctx context.Context, tenantID uint32, name string, rules *policy.Rules, opts ...func(*ent.LeavePolicyCreate)) (*ent.LeavePolicy, error) {
+	id := uuid.New().String()
+
+	create := r.entClient.Client().LeavePolicy.Create().
+		SetID(id).
+		SetTenantID(tenantID).
+		SetName(name).
+		SetRules(rules).
+		SetCreateTime(time.Now())
+
+	for _, opt := range opts {
+		opt(create)
+	}
+
+	entity, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, hrV1.ErrorAlreadyExists("leave policy already exists with this name")
+		}
+		r.log.Errorf("create leave policy failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave policy failed")
+	}
+	return entity, nil
+}
+
+func (r *LeavePolicyRepo) GetByID(ctx context.Context, id string) (*ent.LeavePolicy, error) {
+	entity, err := r.entClient.Client().LeavePolicy.Query().
+		Where(leavepolicy.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get leave policy failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get leave policy failed")
+	}
+	return entity, nil
+}
+
+func (r *LeavePolicyRepo) List(ctx context.Context, tenantID uint32, page, pageSize int, filters map[string]interface{}) ([]*ent.LeavePolicy, int, error) {
+	query := r.entClient.Client().LeavePolicy.Query().
+		Where(leavepolicy.TenantID(tenantID))
+
+	if q, ok := filters["query"].(string); ok && q != "" {
+		query = query.Where(leavepolicy.NameContains(q))
+	}
+	if absenceTypeID, ok := filters["absence_type_id"].(string); ok && absenceTypeID != "" {
+		query = query.Where(leavepolicy.AbsenceTypeID(absenceTypeID))
+	}
+	if orgUnitName, ok := filters["org_unit_name"].(string); ok && orgUnitName != "" {
+		query = query.Where(leavepolicy.OrgUnitName(orgUnitName))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count leave policies failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list leave policies failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	entities, err := query.Order(ent.Asc(leavepolicy.FieldName)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list leave policies failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list leave policies failed")
+	}
+
+	return entities, total, nil
+}
+
+// ListApplicable returns the enabled policies that apply to requests of an absence type by a
+// member of an org unit, including those scoped to neither.
+func (r *LeavePolicyRepo) ListApplicable(ctx context.Context, tenantID uint32, absenceTypeID, orgUnitName string) ([]*ent.LeavePolicy, error) {
+	absenceTypes := []string{""}
+	if absenceTypeID != "" {
+		absenceTypes = append(absenceTypes, absenceTypeID)
+	}
+	orgUnits := []string{""}
+	if orgUnitName != "" {
+		orgUnits = append(orgUnits, orgUnitName)
+	}
+
+	entities, err := r.entClient.Client().LeavePolicy.Query().
+		Where(
+			leavepolicy.TenantID(tenantID),
+			leavepolicy.Enabled(true),
+			leavepolicy.AbsenceTypeIDIn(absenceTypes...),
+			leavepolicy.OrgUnitNameIn(orgUnits...),
+		).
+		Order(ent.Asc(leavepolicy.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list applicable leave policies failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list leave policies failed")
+	}
+	return entities, nil
+}
+
+func (r *LeavePolicyRepo) Update(ctx context.Context, id string, updates map[string]interface{}) (*ent.LeavePolicy, error) {
+	update := r.entClient.Client().LeavePolicy.UpdateOneID(id)
+
+	if name, ok := updates["name"].(string); ok {
+		update = update.SetName(name)
+	}
+	if description, ok := updates["description"].(string); ok {
+		update = update.SetDescription(description)
+	}
+	if absenceTypeID, ok := updates["absence_type_id"].(string); ok {
+		update = update.SetAbsenceTypeID(absenceTypeID)
+	}
+	if orgUnitName, ok := updates["org_unit_name"].(string); ok {
+		update = update.SetOrgUnitName(orgUnitName)
+	}
+	if rules, ok := updates["rules"].(*policy.Rules); ok {
+		update = update.SetRules(rules)
+	}
+	if enabled, ok := updates["enabled"].(bool); ok {
+		update = update.SetEnabled(enabled)
+	}
+
+	update = update.SetUpdateTime(time.Now())
+
+	entity, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorLeavePolicyNotFound("leave policy not found")
+		}
+		if ent.IsConstraintError(err) {
+			return nil, hrV1.ErrorAlreadyExists("leave policy already exists with this name")
+		}
+		r.log.Errorf("update leave policy failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update leave policy failed")
+	}
+	return entity, nil
+}
+
+func (r *LeavePolicyRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().LeavePolicy.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorLeavePolicyNotFound("leave policy not found")
+		}
+		r.log.Errorf("delete leave policy failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete leave policy failed")
+	}
+	return nil
+}
@@ -10,10 +10,23 @@ import (
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
 	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/approval"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/predicate"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-hr/internal/escalation"
+	"github.com/go-tangra/go-tangra-hr/internal/recurrence"
+	"github.com/go-tangra/go-tangra-hr/internal/workday"
 )
 
+// ApproverScope selects the requests assigned to an approver, limited to the given absence types
+// unless AbsenceTypeIDs is empty.
+type ApproverScope struct {
+	ApproverID     uint32
+	AbsenceTypeIDs []string
+}
+
 type LeaveRequestRepo struct {
 	entClient *entCrud.EntClient[*ent.Client]
 	log       *log.Helper
@@ -27,10 +40,52 @@ func NewLeaveRequestRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*e
 }
 
 func (r *LeaveRequestRepo) Create(ctx context.Context, tenantID uint32, userID uint32, absenceTypeID string, startDate, endDate time.Time, days float64, status string, opts ...func(*ent.LeaveRequestCreate)) (*ent.LeaveRequest, error) {
-	id := uuid.New().String()
+	entity, err := newLeaveRequestCreate(r.entClient.Client(), tenantID, userID, absenceTypeID, startDate, endDate, days, status, opts).Save(ctx)
+	if err != nil {
+		r.log.Errorf("create leave request failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave request failed")
+	}
+	return entity, nil
+}
+
+// CreateWithOutbox creates a leave request like Create and, in the same transaction, writes the
+// outbox messages returned by outbox for the created request.
+func (r *LeaveRequestRepo) CreateWithOutbox(ctx context.Context, tenantID uint32, userID uint32, absenceTypeID string, startDate, endDate time.Time, days float64, status string, outbox func(*ent.LeaveRequest) []OutboxMessage, opts ...func(*ent.LeaveRequestCreate)) (*ent.LeaveRequest, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave request failed")
+	}
+
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+	}
+
+	entity, err := newLeaveRequestCreate(tx.Client(), tenantID, userID, absenceTypeID, startDate, endDate, days, status, opts).Save(ctx)
+	if err != nil {
+		rollback()
+		r.log.Errorf("create leave request failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave request failed")
+	}
+
+	if err := createOutboxMessages(ctx, tx.Client(), outbox(entity)); err != nil {
+		rollback()
+		r.log.Errorf("create outbox messages failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave request failed")
+	}
 
-	create := r.entClient.Client().LeaveRequest.Create().
-		SetID(id).
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create leave request failed")
+	}
+	return entity.Unwrap(), nil
+}
+
+func newLeaveRequestCreate(client *ent.Client, tenantID uint32, userID uint32, absenceTypeID string, startDate, endDate time.Time, days float64, status string, opts []func(*ent.LeaveRequestCreate)) *ent.LeaveRequestCreate {
+	create := client.LeaveRequest.Create().
+		SetID(uuid.New().String()).
 		SetTenantID(tenantID).
 		SetUserID(userID).
 		SetAbsenceTypeID(absenceTypeID).
@@ -40,16 +95,14 @@ func (r *LeaveRequestRepo) Create(ctx context.Context, tenantID uint32, userID u
 		SetStatus(leaverequest.Status(status)).
 		SetCreateTime(time.Now())
 
-	for _, opt := range opts {
-		opt(create)
+	if awaitsDecision(status) {
+		create = create.SetAwaitingSince(time.Now())
 	}
 
-	entity, err := create.Save(ctx)
-	if err != nil {
-		r.log.Errorf("create leave request failed: %s", err.Error())
-		return nil, hrV1.ErrorInternalServerError("create leave request failed")
+	for _, opt := range opts {
+		opt(create)
 	}
-	return entity, nil
+	return create
 }
 
 func (r *LeaveRequestRepo) GetByID(ctx context.Context, id string) (*ent.LeaveRequest, error) {
@@ -81,12 +134,29 @@ func (r *LeaveRequestRepo) List(ctx context.Context, tenantID uint32, page, page
 	if status, ok := filters["status"].(string); ok && status != "" {
 		query = query.Where(leaverequest.StatusEQ(leaverequest.Status(status)))
 	}
+	if scopes, ok := filters["approver_scopes"].([]ApproverScope); ok && len(scopes) > 0 {
+		preds := make([]predicate.LeaveRequest, 0, len(scopes))
+		for _, sc := range scopes {
+			if len(sc.AbsenceTypeIDs) > 0 {
+				preds = append(preds, leaverequest.And(
+					leaverequest.ApproverID(sc.ApproverID),
+					leaverequest.AbsenceTypeIDIn(sc.AbsenceTypeIDs...),
+				))
+			} else {
+				preds = append(preds, leaverequest.ApproverID(sc.ApproverID))
+			}
+		}
+		query = query.Where(leaverequest.Or(preds...))
+	}
 	if startDate, ok := filters["start_date"].(time.Time); ok {
 		query = query.Where(leaverequest.StartDateGTE(startDate))
 	}
 	if endDate, ok := filters["end_date"].(time.Time); ok {
 		query = query.Where(leaverequest.EndDateLTE(endDate))
 	}
+	if seriesID, ok := filters["series_id"].(string); ok && seriesID != "" {
+		query = query.Where(leaverequest.SeriesID(seriesID))
+	}
 
 	total, err := query.Clone().Count(ctx)
 	if err != nil {
@@ -107,27 +177,55 @@ func (r *LeaveRequestRepo) List(ctx context.Context, tenantID uint32, page, page
 	return entities, total, nil
 }
 
-func (r *LeaveRequestRepo) CheckOverlap(ctx context.Context, tenantID uint32, userID uint32, startDate, endDate time.Time, excludeID string) (bool, error) {
+// ListBySeries returns the occurrences of a series of recurring requests starting on or after
+// from, in date order.
+func (r *LeaveRequestRepo) ListBySeries(ctx context.Context, seriesID string, from time.Time) ([]*ent.LeaveRequest, error) {
+	entities, err := r.entClient.Client().LeaveRequest.Query().
+		Where(
+			leaverequest.SeriesID(seriesID),
+			leaverequest.StartDateGTE(from),
+		).
+		WithAbsenceType().
+		Order(ent.Asc(leaverequest.FieldStartDate)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list leave series failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list leave series failed")
+	}
+	return entities, nil
+}
+
+// CheckOverlap reports whether the user has an active request covering any part of the span.
+// Requests for different halves of the same day, or for separate hours, do not overlap.
+func (r *LeaveRequestRepo) CheckOverlap(ctx context.Context, tenantID uint32, userID uint32, span workday.Span, excludeID string) (bool, error) {
+	from, to := span.Interval()
+
+	// Narrow down by date; stored end dates of day-based requests are the start of the last day
 	query := r.entClient.Client().LeaveRequest.Query().
 		Where(
 			leaverequest.TenantID(tenantID),
 			leaverequest.UserID(userID),
 			leaverequest.StatusIn(leaverequest.StatusPending, leaverequest.StatusApproved, leaverequest.StatusAwaitingSigning),
-			leaverequest.StartDateLTE(endDate),
-			leaverequest.EndDateGT(startDate),
+			leaverequest.StartDateLT(to),
+			leaverequest.EndDateGT(from.AddDate(0, 0, -1)),
 		)
 
 	if excludeID != "" {
 		query = query.Where(leaverequest.IDNEQ(excludeID))
 	}
 
-	count, err := query.Count(ctx)
+	candidates, err := query.All(ctx)
 	if err != nil {
 		r.log.Errorf("check overlap failed: %s", err.Error())
 		return false, hrV1.ErrorInternalServerError("check overlap failed")
 	}
 
-	return count > 0, nil
+	for _, c := range candidates {
+		if span.Overlaps(LeaveSpan(c)) {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 func (r *LeaveRequestRepo) GetCalendarEvents(ctx context.Context, tenantID uint32, startDate, endDate time.Time, orgUnitName string, userID uint32) ([]*ent.LeaveRequest, error) {
@@ -157,29 +255,82 @@ func (r *LeaveRequestRepo) GetCalendarEvents(ctx context.Context, tenantID uint3
 }
 
 func (r *LeaveRequestRepo) UpdateStatus(ctx context.Context, id string, status string, reviewedBy uint32, reviewerName string, reviewNotes string) (*ent.LeaveRequest, error) {
-	update := r.entClient.Client().LeaveRequest.UpdateOneID(id).
-		SetStatus(leaverequest.Status(status)).
-		SetUpdateTime(time.Now())
+	entity, err := newStatusUpdate(r.entClient.Client(), id, status, reviewedBy, reviewerName, reviewNotes).Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorLeaveRequestNotFound("leave request not found")
+		}
+		r.log.Errorf("update leave request status failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update leave request status failed")
+	}
+	return entity, nil
+}
 
-	if reviewedBy > 0 {
-		update = update.SetReviewedBy(reviewedBy)
-		update = update.SetReviewerName(reviewerName)
-		now := time.Now()
-		update = update.SetReviewedAt(now)
+// UpdateStatusWithOutbox updates the status of a leave request like UpdateStatus and, in the same
+// transaction, writes the outbox messages returned by outbox for the updated request, so that
+// the side effects of the transition are delivered if and only if it is committed. The options
+// set further fields of the request along with its status.
+func (r *LeaveRequestRepo) UpdateStatusWithOutbox(ctx context.Context, id string, status string, reviewedBy uint32, reviewerName string, reviewNotes string, outbox func(*ent.LeaveRequest) []OutboxMessage, opts ...func(*ent.LeaveRequestUpdateOne)) (*ent.LeaveRequest, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update leave request status failed")
 	}
-	if reviewNotes != "" {
-		update = update.SetReviewNotes(reviewNotes)
+
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+	}
+
+	update := newStatusUpdate(tx.Client(), id, status, reviewedBy, reviewerName, reviewNotes)
+	for _, opt := range opts {
+		opt(update)
 	}
 
 	entity, err := update.Save(ctx)
 	if err != nil {
+		rollback()
 		if ent.IsNotFound(err) {
 			return nil, hrV1.ErrorLeaveRequestNotFound("leave request not found")
 		}
 		r.log.Errorf("update leave request status failed: %s", err.Error())
 		return nil, hrV1.ErrorInternalServerError("update leave request status failed")
 	}
-	return entity, nil
+
+	if err := createOutboxMessages(ctx, tx.Client(), outbox(entity)); err != nil {
+		rollback()
+		r.log.Errorf("create outbox messages failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update leave request status failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update leave request status failed")
+	}
+	return entity.Unwrap(), nil
+}
+
+func newStatusUpdate(client *ent.Client, id string, status string, reviewedBy uint32, reviewerName string, reviewNotes string) *ent.LeaveRequestUpdateOne {
+	update := client.LeaveRequest.UpdateOneID(id).
+		SetStatus(leaverequest.Status(status)).
+		SetUpdateTime(time.Now())
+
+	// A request starts waiting afresh whenever it comes to await a decision or signatures
+	if awaitsDecision(status) {
+		update = update.SetAwaitingSince(time.Now()).SetEscalationLevel(escalation.LevelNone)
+	}
+
+	if reviewedBy > 0 {
+		update = update.SetReviewedBy(reviewedBy)
+		update = update.SetReviewerName(reviewerName)
+		now := time.Now()
+		update = update.SetReviewedAt(now)
+	}
+	if reviewNotes != "" {
+		update = update.SetReviewNotes(reviewNotes)
+	}
+	return update
 }
 
 func (r *LeaveRequestRepo) Update(ctx context.Context, id string, updates map[string]interface{}) (*ent.LeaveRequest, error) {
@@ -194,6 +345,12 @@ func (r *LeaveRequestRepo) Update(ctx context.Context, id string, updates map[st
 	if metadata, ok := updates["metadata"].(map[string]interface{}); ok {
 		update = update.SetMetadata(metadata)
 	}
+	if seriesID, ok := updates["series_id"].(string); ok {
+		update = update.SetSeriesID(seriesID)
+	}
+	if rule, ok := updates["recurrence"].(*recurrence.Rule); ok {
+		update = update.SetRecurrence(rule)
+	}
 
 	update = update.SetUpdateTime(time.Now())
 
@@ -250,21 +407,297 @@ func (r *LeaveRequestRepo) SetSigningRequestID(ctx context.Context, id string, s
 	return nil
 }
 
-func (r *LeaveRequestRepo) SetDeductedAllowanceID(ctx context.Context, id string, allowanceID string) error {
+func (r *LeaveRequestRepo) SetDeductions(ctx context.Context, id string, deductions []schema.LeaveDeduction) error {
+	err := r.entClient.Client().LeaveRequest.UpdateOneID(id).
+		SetDeductions(deductions).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorLeaveRequestNotFound("leave request not found")
+		}
+		r.log.Errorf("set deductions failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("set deductions failed")
+	}
+	return nil
+}
+
+func (r *LeaveRequestRepo) SetDays(ctx context.Context, id string, days float64) error {
 	err := r.entClient.Client().LeaveRequest.UpdateOneID(id).
-		SetDeductedAllowanceID(allowanceID).
+		SetDays(days).
 		SetUpdateTime(time.Now()).
 		Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return hrV1.ErrorLeaveRequestNotFound("leave request not found")
 		}
-		r.log.Errorf("set deducted_allowance_id failed: %s", err.Error())
-		return hrV1.ErrorInternalServerError("set deducted_allowance_id failed")
+		r.log.Errorf("set days failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("set days failed")
+	}
+	return nil
+}
+
+// DecideApprovalStep records a decision on the approval step of a pending request: the decision
+// itself, who took it and on whose behalf, and their notes. The step must still await a
+// decision, so that two approvers cannot decide the same step. An approval moves the request on
+// to the next step and assigns it to that step's approver; a rejection skips the remaining steps.
+func (r *LeaveRequestRepo) DecideApprovalStep(ctx context.Context, id string, step int, decision schema.LeaveApproval) (*ent.LeaveRequest, error) {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("record approval failed")
+	}
+
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+	}
+
+	entity, err := tx.LeaveRequest.Query().
+		Where(leaverequest.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		rollback()
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorLeaveRequestNotFound("leave request not found")
+		}
+		r.log.Errorf("lock leave request for approval failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("record approval failed")
+	}
+	if entity.Status != leaverequest.StatusPending || entity.ApprovalStep != step || step >= len(entity.ApprovalSteps) {
+		rollback()
+		return nil, hrV1.ErrorBadRequest("approval step has already been decided")
+	}
+
+	now := time.Now()
+	steps := append([]schema.LeaveApproval(nil), entity.ApprovalSteps...)
+	steps[step].Decision = decision.Decision
+	steps[step].ApproverID = decision.ApproverID
+	steps[step].ApproverName = decision.ApproverName
+	steps[step].DecidedAt = &now
+	steps[step].Notes = decision.Notes
+	steps[step].Override = decision.Override
+	steps[step].OnBehalfOf = decision.OnBehalfOf
+	steps[step].OnBehalfOfName = decision.OnBehalfOfName
+
+	next := step
+	if decision.Decision == approval.DecisionApproved {
+		next = step + 1
+	} else {
+		for i := step + 1; i < len(steps); i++ {
+			steps[i].Decision = approval.DecisionSkipped
+		}
+	}
+
+	update := tx.LeaveRequest.UpdateOneID(id).
+		SetApprovalSteps(steps).
+		SetApprovalStep(next).
+		SetApproverID(AssignedApprover(steps, next)).
+		SetUpdateTime(now)
+
+	// The next step starts waiting for its approver afresh
+	if next != step && next < len(steps) {
+		update = update.SetAwaitingSince(now).SetEscalationLevel(escalation.LevelNone)
+	}
+
+	entity, err = update.Save(ctx)
+	if err != nil {
+		rollback()
+		r.log.Errorf("record approval failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("record approval failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("record approval failed")
+	}
+	return entity.Unwrap(), nil
+}
+
+// ReopenApprovalStep clears the decision on an approval step and makes it the step awaiting a
+// decision again. Used when the approval that followed the decision failed.
+func (r *LeaveRequestRepo) ReopenApprovalStep(ctx context.Context, id string, step int) error {
+	entity, err := r.entClient.Client().LeaveRequest.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorLeaveRequestNotFound("leave request not found")
+		}
+		r.log.Errorf("get leave request failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("reopen approval step failed")
+	}
+	if step >= len(entity.ApprovalSteps) {
+		return nil
+	}
+
+	update := r.entClient.Client().LeaveRequest.UpdateOneID(id).
+		SetUpdateTime(time.Now())
+	ReopenStep(update, entity.ApprovalSteps, step)
+	err = update.Exec(ctx)
+	if err != nil {
+		r.log.Errorf("reopen approval step failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("reopen approval step failed")
 	}
 	return nil
 }
 
+// ReopenStep sets the update to clear the decisions on the approval steps from step on and make
+// step the one awaiting a decision again. Steps past the last are left alone.
+func ReopenStep(u *ent.LeaveRequestUpdateOne, steps []schema.LeaveApproval, step int) {
+	if step >= len(steps) {
+		return
+	}
+
+	reopened := append([]schema.LeaveApproval(nil), steps...)
+	for i := step; i < len(reopened); i++ {
+		reopened[i] = schema.LeaveApproval{
+			Name:        reopened[i].Name,
+			Role:        reopened[i].Role,
+			ApproverIDs: reopened[i].ApproverIDs,
+			Decision:    approval.DecisionPending,
+		}
+	}
+	u.SetApprovalSteps(reopened).
+		SetApprovalStep(step).
+		SetApproverID(AssignedApprover(reopened, step))
+}
+
+// SetOnBehalfOf records the approver for whom the reviewer decided the request as their delegate.
+// An approverID of 0 clears it.
+func (r *LeaveRequestRepo) SetOnBehalfOf(ctx context.Context, id string, approverID uint32, approverName string) error {
+	err := r.entClient.Client().LeaveRequest.UpdateOneID(id).
+		SetOnBehalfOf(approverID).
+		SetOnBehalfOfName(approverName).
+		SetUpdateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorLeaveRequestNotFound("leave request not found")
+		}
+		r.log.Errorf("set on_behalf_of failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("set on_behalf_of failed")
+	}
+	return nil
+}
+
+// ListAwaitingDecision returns the requests of all tenants with the given absence types that are
+// pending or awaiting signatures.
+func (r *LeaveRequestRepo) ListAwaitingDecision(ctx context.Context, absenceTypeIDs []string) ([]*ent.LeaveRequest, error) {
+	entities, err := r.entClient.Client().LeaveRequest.Query().
+		Where(
+			leaverequest.AbsenceTypeIDIn(absenceTypeIDs...),
+			leaverequest.StatusIn(leaverequest.StatusPending, leaverequest.StatusAwaitingSigning),
+		).
+		WithAbsenceType().
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list leave requests awaiting decision failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list leave requests failed")
+	}
+	return entities, nil
+}
+
+// ListAwaitingSigning returns the requests of all tenants that await the signatures of their
+// signing submission.
+func (r *LeaveRequestRepo) ListAwaitingSigning(ctx context.Context) ([]*ent.LeaveRequest, error) {
+	entities, err := r.entClient.Client().LeaveRequest.Query().
+		Where(
+			leaverequest.StatusEQ(leaverequest.StatusAwaitingSigning),
+			leaverequest.SigningRequestIDNEQ(""),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list leave requests awaiting signing failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list leave requests failed")
+	}
+	return entities, nil
+}
+
+// SetEscalationLevel records how far the wait for a decision on a request has been followed up.
+func (r *LeaveRequestRepo) SetEscalationLevel(ctx context.Context, id string, level int) error {
+	err := r.entClient.Client().LeaveRequest.UpdateOneID(id).
+		SetEscalationLevel(level).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorLeaveRequestNotFound("leave request not found")
+		}
+		r.log.Errorf("set escalation level failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("set escalation level failed")
+	}
+	return nil
+}
+
+// AwaitingSince returns when a request started waiting for its current decision. Requests
+// created before this was recorded fall back to their creation time.
+func AwaitingSince(e *ent.LeaveRequest) time.Time {
+	if e.AwaitingSince != nil {
+		return *e.AwaitingSince
+	}
+	if e.CreateTime != nil {
+		return *e.CreateTime
+	}
+	return time.Time{}
+}
+
+func awaitsDecision(status string) bool {
+	return status == string(leaverequest.StatusPending) || status == string(leaverequest.StatusAwaitingSigning)
+}
+
+// AssignedApprover returns the approver an approval step is assigned to: its only approver, or 0
+// when the step has several or none and anyone eligible may decide it.
+func AssignedApprover(steps []schema.LeaveApproval, step int) uint32 {
+	if step >= len(steps) || len(steps[step].ApproverIDs) != 1 {
+		return 0
+	}
+	return steps[step].ApproverIDs[0]
+}
+
+// LeaveSpan returns the part of the calendar covered by a leave request.
+func LeaveSpan(e *ent.LeaveRequest) workday.Span {
+	return workday.Span{
+		Start:           e.StartDate,
+		End:             e.EndDate,
+		StartsAfternoon: e.StartDayPart == leaverequest.StartDayPartPm,
+		EndsAtMidday:    e.EndDayPart == leaverequest.EndDayPartAm,
+		Hourly:          e.Hours > 0,
+	}
+}
+
+// SplitDaysByYear splits the days of a leave span across the calendar years it covers, counting
+// working days with the given holiday calendar and the user's work schedules.
+func SplitDaysByYear(ctx context.Context, holidayRepo *HolidayRepo, scheduleRepo *WorkScheduleAssignmentRepo, tenantID uint32, userID uint32, orgUnitName string, calendarID string, span workday.Span, days float64) ([]workday.YearDays, error) {
+	if span.Hourly || span.Start.Year() == span.End.Year() {
+		return []workday.YearDays{{Year: span.Start.Year(), Days: days}}, nil
+	}
+
+	var holidays workday.Holidays
+	if calendarID != "" {
+		var err error
+		holidays, err = holidayRepo.GetHolidays(ctx, calendarID, span.Start, span.End)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	schedules, err := scheduleRepo.GetSchedules(ctx, tenantID, userID, orgUnitName, span.End)
+	if err != nil {
+		return nil, err
+	}
+
+	return workday.SplitByYear(span, days, holidays, schedules), nil
+}
+
+// LeaveDaysByYear splits the days of a leave request across the calendar years it covers.
+func LeaveDaysByYear(ctx context.Context, holidayRepo *HolidayRepo, scheduleRepo *WorkScheduleAssignmentRepo, e *ent.LeaveRequest) ([]workday.YearDays, error) {
+	var tenantID uint32
+	if e.TenantID != nil {
+		tenantID = *e.TenantID
+	}
+	return SplitDaysByYear(ctx, holidayRepo, scheduleRepo, tenantID, e.UserID, e.OrgUnitName, e.HolidayCalendarID, LeaveSpan(e), e.Days)
+}
+
 func (r *LeaveRequestRepo) CountByStatus(ctx context.Context, tenantID uint32, status string) (int, error) {
 	return r.entClient.Client().LeaveRequest.Query().
 		Where(leaverequest.TenantID(tenantID), leaverequest.StatusEQ(leaverequest.Status(status))).
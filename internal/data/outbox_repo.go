@@ -0,0 +1,237 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/outboxmessage"
+)
+
+// Kinds of outbox messages.
+const (
+	// OutboxKindEvent publishes a domain event; the payload is the event envelope.
+	OutboxKindEvent = "event"
+	// OutboxKindAllowanceChanged publishes allowance.changed for allowances as they are when
+	// delivered.
+	OutboxKindAllowanceChanged = "allowance_changed"
+	// OutboxKindRejectionEmail emails the requester of a rejected leave request.
+	OutboxKindRejectionEmail = "rejection_email"
+	// OutboxKindSigningCancel cancels the signing submission of a revoked leave request.
+	OutboxKindSigningCancel = "signing_cancel"
+	// OutboxKindSigningEndedEmail emails the requester and the reviewer of a leave request whose
+	// signing was declined, expired or cancelled.
+	OutboxKindSigningEndedEmail = "signing_ended_email"
+	// OutboxKindCommentNotification emails a shared comment on a leave request to the other
+	// participants.
+	OutboxKindCommentNotification = "comment_notification"
+)
+
+// OutboxMessage is a side effect of a change to be delivered by the outbox relay once the change
+// is committed. The payload is encoded as JSON.
+type OutboxMessage struct {
+	TenantID uint32
+	Kind     string
+	Payload  any
+}
+
+type OutboxRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewOutboxRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *OutboxRepo {
+	return &OutboxRepo{
+		log:       ctx.NewLoggerHelper("hr/outbox/repo"),
+		entClient: entClient,
+	}
+}
+
+// Create writes messages to the outbox outside of a transaction, for side effects of changes
+// made by several transactions.
+func (r *OutboxRepo) Create(ctx context.Context, messages ...OutboxMessage) error {
+	if err := createOutboxMessages(ctx, r.entClient.Client(), messages); err != nil {
+		r.log.Errorf("create outbox messages failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("create outbox messages failed")
+	}
+	return nil
+}
+
+// createOutboxMessages writes messages to the outbox with the client, which may be that of the
+// transaction making the change.
+func createOutboxMessages(ctx context.Context, client *ent.Client, messages []OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	builders := make([]*ent.OutboxMessageCreate, 0, len(messages))
+	for _, m := range messages {
+		payload, err := json.Marshal(m.Payload)
+		if err != nil {
+			return err
+		}
+		tenantID := m.TenantID
+		builders = append(builders, client.OutboxMessage.Create().
+			SetID(uuid.New().String()).
+			SetNillableTenantID(&tenantID).
+			SetKind(m.Kind).
+			SetPayload(payload).
+			SetNextAttemptAt(now).
+			SetCreateTime(now))
+	}
+	return client.OutboxMessage.CreateBulk(builders...).Exec(ctx)
+}
+
+// createAllowanceOutbox writes the outbox messages returned by outbox for a changed allowance in
+// the transaction changing it. A nil outbox writes none.
+func createAllowanceOutbox(ctx context.Context, tx *ent.Tx, outbox func(*ent.LeaveAllowance) []OutboxMessage, allowance *ent.LeaveAllowance) error {
+	if outbox == nil {
+		return nil
+	}
+	return createOutboxMessages(ctx, tx.Client(), outbox(allowance))
+}
+
+func (r *OutboxRepo) GetByID(ctx context.Context, id string) (*ent.OutboxMessage, error) {
+	entity, err := r.entClient.Client().OutboxMessage.Query().
+		Where(outboxmessage.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get outbox message failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get outbox message failed")
+	}
+	return entity, nil
+}
+
+func (r *OutboxRepo) List(ctx context.Context, tenantID uint32, page, pageSize int, filters map[string]interface{}) ([]*ent.OutboxMessage, int, error) {
+	query := r.entClient.Client().OutboxMessage.Query().
+		Where(outboxmessage.TenantID(tenantID))
+
+	if status, ok := filters["status"].(string); ok && status != "" {
+		query = query.Where(outboxmessage.StatusEQ(outboxmessage.Status(status)))
+	}
+	if kind, ok := filters["kind"].(string); ok && kind != "" {
+		query = query.Where(outboxmessage.Kind(kind))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count outbox messages failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list outbox messages failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	entities, err := query.Order(ent.Desc(outboxmessage.FieldCreateTime)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list outbox messages failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list outbox messages failed")
+	}
+	return entities, total, nil
+}
+
+// Claim returns up to limit pending messages due for delivery at now, oldest first, and holds
+// them for the lease so that another relay does not deliver them at the same time. A message
+// whose relay dies is delivered again once the lease ends.
+func (r *OutboxRepo) Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*ent.OutboxMessage, error) {
+	due, err := r.entClient.Client().OutboxMessage.Query().
+		Where(
+			outboxmessage.StatusEQ(outboxmessage.StatusPending),
+			outboxmessage.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(outboxmessage.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list due outbox messages failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("claim outbox messages failed")
+	}
+
+	claimed := make([]*ent.OutboxMessage, 0, len(due))
+	for _, m := range due {
+		// Only the relay that still sees the message due takes it
+		n, err := r.entClient.Client().OutboxMessage.Update().
+			Where(
+				outboxmessage.ID(m.ID),
+				outboxmessage.StatusEQ(outboxmessage.StatusPending),
+				outboxmessage.NextAttemptAt(m.NextAttemptAt),
+			).
+			SetNextAttemptAt(now.Add(lease)).
+			Save(ctx)
+		if err != nil {
+			r.log.Errorf("claim outbox message failed: %s", err.Error())
+			continue
+		}
+		if n == 1 {
+			claimed = append(claimed, m)
+		}
+	}
+	return claimed, nil
+}
+
+// MarkDelivered records that a message was delivered.
+func (r *OutboxRepo) MarkDelivered(ctx context.Context, id string) error {
+	now := time.Now()
+	err := r.entClient.Client().OutboxMessage.UpdateOneID(id).
+		SetStatus(outboxmessage.StatusDelivered).
+		SetDeliveredAt(now).
+		SetLastError("").
+		SetUpdateTime(now).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("mark outbox message delivered failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("mark outbox message delivered failed")
+	}
+	return nil
+}
+
+// MarkFailed records a failed delivery of a message. The message is delivered again at next, or
+// becomes dead when next is nil.
+func (r *OutboxRepo) MarkFailed(ctx context.Context, id string, deliveryErr string, next *time.Time) error {
+	update := r.entClient.Client().OutboxMessage.UpdateOneID(id).
+		AddAttempts(1).
+		SetLastError(deliveryErr).
+		SetUpdateTime(time.Now())
+	if next != nil {
+		update = update.SetNextAttemptAt(*next)
+	} else {
+		update = update.SetStatus(outboxmessage.StatusDead)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		r.log.Errorf("mark outbox message failed failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("mark outbox message failed failed")
+	}
+	return nil
+}
+
+// Replay makes a dead message pending again with fresh attempts, to be delivered right away.
+func (r *OutboxRepo) Replay(ctx context.Context, id string) (*ent.OutboxMessage, error) {
+	now := time.Now()
+	entity, err := r.entClient.Client().OutboxMessage.UpdateOneID(id).
+		SetStatus(outboxmessage.StatusPending).
+		SetAttempts(0).
+		SetNextAttemptAt(now).
+		SetUpdateTime(now).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorOutboxMessageNotFound("outbox message not found")
+		}
+		r.log.Errorf("replay outbox message failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("replay outbox message failed")
+	}
+	return entity, nil
+}
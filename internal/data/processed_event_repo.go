@@ -0,0 +1,71 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/processedevent"
+)
+
+type ProcessedEventRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewProcessedEventRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *ProcessedEventRepo {
+	return &ProcessedEventRepo{
+		log:       ctx.NewLoggerHelper("hr/processed_event/repo"),
+		entClient: entClient,
+	}
+}
+
+// IsProcessed reports whether the event with the ID has been handled.
+func (r *ProcessedEventRepo) IsProcessed(ctx context.Context, id string) (bool, error) {
+	exists, err := r.entClient.Client().ProcessedEvent.Query().
+		Where(processedevent.ID(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("check processed event failed: %s", err.Error())
+		return false, hrV1.ErrorInternalServerError("check processed event failed")
+	}
+	return exists, nil
+}
+
+// MarkProcessed records that the event with the ID has been handled. Recording an event twice is
+// not an error.
+func (r *ProcessedEventRepo) MarkProcessed(ctx context.Context, id string, eventType string, source string, tenantID uint32) error {
+	err := r.entClient.Client().ProcessedEvent.Create().
+		SetID(id).
+		SetEventType(eventType).
+		SetSource(source).
+		SetTenantID(tenantID).
+		SetCreateTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil
+		}
+		r.log.Errorf("mark event processed failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("mark event processed failed")
+	}
+	return nil
+}
+
+// DeleteBefore forgets the events handled before the time, once they can no longer be
+// redelivered. Returns the number of events forgotten.
+func (r *ProcessedEventRepo) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	n, err := r.entClient.Client().ProcessedEvent.Delete().
+		Where(processedevent.CreateTimeLT(before)).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("delete processed events failed: %s", err.Error())
+		return 0, hrV1.ErrorInternalServerError("delete processed events failed")
+	}
+	return n, nil
+}
@@ -16,8 +16,26 @@ var ProviderSet = wire.NewSet(
 	data.NewEntClient,
 	data.NewAbsenceTypeRepo,
 	data.NewLeaveAllowanceRepo,
+	data.NewAllowanceTransactionRepo,
 	data.NewLeaveRequestRepo,
+	data.NewLeaveAmendmentRepo,
 	data.NewAllowancePoolRepo,
+	data.NewHolidayCalendarRepo,
+	data.NewHolidayRepo,
+	data.NewWorkScheduleRepo,
+	data.NewWorkScheduleAssignmentRepo,
+	data.NewEmploymentRepo,
+	data.NewApprovalDelegationRepo,
+	data.NewLeavePolicyRepo,
+	data.NewBlackoutPeriodRepo,
+	data.NewCoverageRuleRepo,
+	data.NewLeaveAttachmentRepo,
+	data.NewAttachmentStore,
+	data.NewLeaveCommentRepo,
+	data.NewOutboxRepo,
+	data.NewProcessedEventRepo,
+	data.NewWebhookSubscriptionRepo,
+	data.NewWebhookDeliveryRepo,
 	data.NewAuditLogRepo,
 	data.NewStatisticsRepo,
 )
@@ -0,0 +1,267 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/webhookdelivery"
+)
+
+// WebhookAttempt is the outcome of an attempt at delivering an event to a subscription.
+type WebhookAttempt struct {
+	ResponseStatus int
+	ResponseBody   string
+	Error          string
+	Duration       time.Duration
+}
+
+type WebhookDeliveryRepo struct {
+	entClient        *entCrud.EntClient[*ent.Client]
+	subscriptionRepo *WebhookSubscriptionRepo
+	log              *log.Helper
+}
+
+func NewWebhookDeliveryRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client], subscriptionRepo *WebhookSubscriptionRepo) *WebhookDeliveryRepo {
+	return &WebhookDeliveryRepo{
+		log:              ctx.NewLoggerHelper("hr/webhook_delivery/repo"),
+		entClient:        entClient,
+		subscriptionRepo: subscriptionRepo,
+	}
+}
+
+// Enqueue schedules the delivery of an event to each enabled subscription of the tenant that
+// receives events of its type. Subscriptions the event has been scheduled for before are skipped,
+// so that an event published again is not delivered twice. Returns the number of deliveries
+// scheduled.
+func (r *WebhookDeliveryRepo) Enqueue(ctx context.Context, tenantID uint32, eventID, eventType string, payload json.RawMessage) (int, error) {
+	subscriptions, err := r.subscriptionRepo.ListMatching(ctx, tenantID, eventType)
+	if err != nil || len(subscriptions) == 0 {
+		return 0, err
+	}
+
+	now := time.Now()
+	builders := make([]*ent.WebhookDeliveryCreate, 0, len(subscriptions))
+	for _, sub := range subscriptions {
+		exists, err := r.entClient.Client().WebhookDelivery.Query().
+			Where(
+				webhookdelivery.SubscriptionID(sub.ID),
+				webhookdelivery.EventID(eventID),
+			).
+			Exist(ctx)
+		if err != nil {
+			r.log.Errorf("check webhook delivery failed: %s", err.Error())
+			return 0, hrV1.ErrorInternalServerError("enqueue webhook deliveries failed")
+		}
+		if exists {
+			continue
+		}
+
+		tid := tenantID
+		builders = append(builders, r.entClient.Client().WebhookDelivery.Create().
+			SetID(uuid.New().String()).
+			SetNillableTenantID(&tid).
+			SetSubscriptionID(sub.ID).
+			SetEventID(eventID).
+			SetEventType(eventType).
+			SetPayload(payload).
+			SetNextAttemptAt(now).
+			SetCreateTime(now))
+	}
+	if len(builders) == 0 {
+		return 0, nil
+	}
+
+	if err := r.entClient.Client().WebhookDelivery.CreateBulk(builders...).Exec(ctx); err != nil {
+		r.log.Errorf("enqueue webhook deliveries failed: %s", err.Error())
+		return 0, hrV1.ErrorInternalServerError("enqueue webhook deliveries failed")
+	}
+	return len(builders), nil
+}
+
+func (r *WebhookDeliveryRepo) GetByID(ctx context.Context, id string) (*ent.WebhookDelivery, error) {
+	entity, err := r.entClient.Client().WebhookDelivery.Query().
+		Where(webhookdelivery.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get webhook delivery failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get webhook delivery failed")
+	}
+	return entity, nil
+}
+
+func (r *WebhookDeliveryRepo) List(ctx context.Context, tenantID uint32, page, pageSize int, filters map[string]interface{}) ([]*ent.WebhookDelivery, int, error) {
+	query := r.entClient.Client().WebhookDelivery.Query().
+		Where(webhookdelivery.TenantID(tenantID))
+
+	if subscriptionID, ok := filters["subscription_id"].(string); ok && subscriptionID != "" {
+		query = query.Where(webhookdelivery.SubscriptionID(subscriptionID))
+	}
+	if eventID, ok := filters["event_id"].(string); ok && eventID != "" {
+		query = query.Where(webhookdelivery.EventID(eventID))
+	}
+	if eventType, ok := filters["event_type"].(string); ok && eventType != "" {
+		query = query.Where(webhookdelivery.EventType(eventType))
+	}
+	if status, ok := filters["status"].(string); ok && status != "" {
+		query = query.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(status)))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count webhook deliveries failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list webhook deliveries failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	entities, err := query.Order(ent.Desc(webhookdelivery.FieldCreateTime)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list webhook deliveries failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list webhook deliveries failed")
+	}
+	return entities, total, nil
+}
+
+// Claim returns up to limit pending attempts due at now, oldest first, and holds them for the
+// lease so that another job does not make them at the same time. An attempt whose job dies is
+// made again once the lease ends.
+func (r *WebhookDeliveryRepo) Claim(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]*ent.WebhookDelivery, error) {
+	due, err := r.entClient.Client().WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list due webhook deliveries failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("claim webhook deliveries failed")
+	}
+
+	claimed := make([]*ent.WebhookDelivery, 0, len(due))
+	for _, d := range due {
+		// Only the job that still sees the attempt due takes it
+		n, err := r.entClient.Client().WebhookDelivery.Update().
+			Where(
+				webhookdelivery.ID(d.ID),
+				webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+				webhookdelivery.NextAttemptAt(d.NextAttemptAt),
+			).
+			SetNextAttemptAt(now.Add(lease)).
+			Save(ctx)
+		if err != nil {
+			r.log.Errorf("claim webhook delivery failed: %s", err.Error())
+			continue
+		}
+		if n == 1 {
+			claimed = append(claimed, d)
+		}
+	}
+	return claimed, nil
+}
+
+// MarkSucceeded records a successful attempt.
+func (r *WebhookDeliveryRepo) MarkSucceeded(ctx context.Context, id string, attempt WebhookAttempt) error {
+	err := r.attemptUpdate(r.entClient.Client(), id, webhookdelivery.StatusSucceeded, attempt).Exec(ctx)
+	if err != nil {
+		r.log.Errorf("mark webhook delivery succeeded failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("mark webhook delivery succeeded failed")
+	}
+	return nil
+}
+
+// MarkFailed records a failed attempt and, unless next is nil because the attempts ran out,
+// schedules the next attempt at next.
+func (r *WebhookDeliveryRepo) MarkFailed(ctx context.Context, d *ent.WebhookDelivery, attempt WebhookAttempt, next *time.Time) error {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("mark webhook delivery failed failed")
+	}
+
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+	}
+
+	if err := r.attemptUpdate(tx.Client(), d.ID, webhookdelivery.StatusFailed, attempt).Exec(ctx); err != nil {
+		rollback()
+		r.log.Errorf("mark webhook delivery failed failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("mark webhook delivery failed failed")
+	}
+
+	if next != nil {
+		err := tx.WebhookDelivery.Create().
+			SetID(uuid.New().String()).
+			SetNillableTenantID(d.TenantID).
+			SetSubscriptionID(d.SubscriptionID).
+			SetEventID(d.EventID).
+			SetEventType(d.EventType).
+			SetPayload(d.Payload).
+			SetAttempt(d.Attempt + 1).
+			SetNextAttemptAt(*next).
+			SetCreateTime(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			rollback()
+			r.log.Errorf("schedule next webhook delivery failed: %s", err.Error())
+			return hrV1.ErrorInternalServerError("mark webhook delivery failed failed")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("mark webhook delivery failed failed")
+	}
+	return nil
+}
+
+// Redeliver schedules the event of an attempt to be delivered again right away, with a fresh set
+// of attempts, on behalf of the admin.
+func (r *WebhookDeliveryRepo) Redeliver(ctx context.Context, d *ent.WebhookDelivery, userID uint32) (*ent.WebhookDelivery, error) {
+	now := time.Now()
+	entity, err := r.entClient.Client().WebhookDelivery.Create().
+		SetID(uuid.New().String()).
+		SetNillableTenantID(d.TenantID).
+		SetSubscriptionID(d.SubscriptionID).
+		SetEventID(d.EventID).
+		SetEventType(d.EventType).
+		SetPayload(d.Payload).
+		SetNextAttemptAt(now).
+		SetRedeliveredBy(userID).
+		SetCreateTime(now).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("redeliver webhook delivery failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("redeliver webhook delivery failed")
+	}
+	return entity, nil
+}
+
+// attemptUpdate returns the update recording the outcome of an attempt.
+func (r *WebhookDeliveryRepo) attemptUpdate(client *ent.Client, id string, status webhookdelivery.Status, attempt WebhookAttempt) *ent.WebhookDeliveryUpdateOne {
+	now := time.Now()
+	return client.WebhookDelivery.UpdateOneID(id).
+		SetStatus(status).
+		SetAttemptedAt(now).
+		SetResponseStatus(attempt.ResponseStatus).
+		SetResponseBody(attempt.ResponseBody).
+		SetError(attempt.Error).
+		SetDurationMs(attempt.Duration.Milliseconds()).
+		SetUpdateTime(now)
+}
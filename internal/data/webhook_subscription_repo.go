@@ -0,0 +1,196 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/webhookdelivery"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/webhooksubscription"
+	"github.com/go-tangra/go-tangra-hr/internal/webhook"
+)
+
+type WebhookSubscriptionRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewWebhookSubscriptionRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *WebhookSubscriptionRepo {
+	return &WebhookSubscriptionRepo{
+		log:       ctx.NewLoggerHelper("hr/webhook_subscription/repo"),
+		entClient: entClient,
+	}
+}
+
+func (r *WebhookSubscriptionRepo) Create(ctx context.Context, tenantID uint32, name, url, secret string, opts ...func(*ent.WebhookSubscriptionCreate)) (*ent.WebhookSubscription, error) {
+	id := uuid.New().String()
+
+	create := r.entClient.Client().WebhookSubscription.Create().
+		SetID(id).
+		SetTenantID(tenantID).
+		SetName(name).
+		SetURL(url).
+		SetSecret(secret).
+		SetCreateTime(time.Now())
+
+	for _, opt := range opts {
+		opt(create)
+	}
+
+	entity, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, hrV1.ErrorAlreadyExists("webhook subscription already exists with this name")
+		}
+		r.log.Errorf("create webhook subscription failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create webhook subscription failed")
+	}
+	return entity, nil
+}
+
+func (r *WebhookSubscriptionRepo) GetByID(ctx context.Context, id string) (*ent.WebhookSubscription, error) {
+	entity, err := r.entClient.Client().WebhookSubscription.Query().
+		Where(webhooksubscription.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get webhook subscription failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get webhook subscription failed")
+	}
+	return entity, nil
+}
+
+func (r *WebhookSubscriptionRepo) List(ctx context.Context, tenantID uint32, page, pageSize int, filters map[string]interface{}) ([]*ent.WebhookSubscription, int, error) {
+	query := r.entClient.Client().WebhookSubscription.Query().
+		Where(webhooksubscription.TenantID(tenantID))
+
+	if q, ok := filters["query"].(string); ok && q != "" {
+		query = query.Where(webhooksubscription.NameContains(q))
+	}
+	if enabled, ok := filters["enabled"].(bool); ok {
+		query = query.Where(webhooksubscription.Enabled(enabled))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count webhook subscriptions failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list webhook subscriptions failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	entities, err := query.Order(ent.Asc(webhooksubscription.FieldName)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list webhook subscriptions failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list webhook subscriptions failed")
+	}
+
+	return entities, total, nil
+}
+
+// ListMatching returns the enabled subscriptions of the tenant that receive events of the type.
+func (r *WebhookSubscriptionRepo) ListMatching(ctx context.Context, tenantID uint32, eventType string) ([]*ent.WebhookSubscription, error) {
+	entities, err := r.entClient.Client().WebhookSubscription.Query().
+		Where(
+			webhooksubscription.TenantID(tenantID),
+			webhooksubscription.Enabled(true),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("list matching webhook subscriptions failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("list webhook subscriptions failed")
+	}
+
+	// Event types are a JSON array, so they are matched here rather than in the query
+	var subscriptions []*ent.WebhookSubscription
+	for _, e := range entities {
+		if webhook.Matches(e.EventTypes, eventType) {
+			subscriptions = append(subscriptions, e)
+		}
+	}
+	return subscriptions, nil
+}
+
+func (r *WebhookSubscriptionRepo) Update(ctx context.Context, id string, updates map[string]interface{}) (*ent.WebhookSubscription, error) {
+	update := r.entClient.Client().WebhookSubscription.UpdateOneID(id)
+
+	if name, ok := updates["name"].(string); ok {
+		update = update.SetName(name)
+	}
+	if description, ok := updates["description"].(string); ok {
+		update = update.SetDescription(description)
+	}
+	if url, ok := updates["url"].(string); ok {
+		update = update.SetURL(url)
+	}
+	if eventTypes, ok := updates["event_types"].([]string); ok {
+		update = update.SetEventTypes(eventTypes)
+	}
+	if secret, ok := updates["secret"].(string); ok {
+		update = update.SetSecret(secret)
+	}
+	if enabled, ok := updates["enabled"].(bool); ok {
+		update = update.SetEnabled(enabled)
+	}
+
+	update = update.SetUpdateTime(time.Now())
+
+	entity, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorWebhookSubscriptionNotFound("webhook subscription not found")
+		}
+		if ent.IsConstraintError(err) {
+			return nil, hrV1.ErrorAlreadyExists("webhook subscription already exists with this name")
+		}
+		r.log.Errorf("update webhook subscription failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update webhook subscription failed")
+	}
+	return entity, nil
+}
+
+// Delete removes a subscription together with its delivery log.
+func (r *WebhookSubscriptionRepo) Delete(ctx context.Context, id string) error {
+	tx, err := r.entClient.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("begin transaction failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete webhook subscription failed")
+	}
+
+	rollback := func() {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			r.log.Errorf("rollback failed: %s", rbErr.Error())
+		}
+	}
+
+	if _, err := tx.WebhookDelivery.Delete().Where(webhookdelivery.SubscriptionID(id)).Exec(ctx); err != nil {
+		rollback()
+		r.log.Errorf("delete webhook deliveries failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete webhook subscription failed")
+	}
+
+	if err := tx.WebhookSubscription.DeleteOneID(id).Exec(ctx); err != nil {
+		rollback()
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorWebhookSubscriptionNotFound("webhook subscription not found")
+		}
+		r.log.Errorf("delete webhook subscription failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete webhook subscription failed")
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete webhook subscription failed")
+	}
+	return nil
+}
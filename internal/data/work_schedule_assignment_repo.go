@@ -0,0 +1,168 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workscheduleassignment"
+	"github.com/go-tangra/go-tangra-hr/internal/workday"
+)
+
+type WorkScheduleAssignmentRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewWorkScheduleAssignmentRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *WorkScheduleAssignmentRepo {
+	return &WorkScheduleAssignmentRepo{
+		log:       ctx.NewLoggerHelper("hr/work_schedule_assignment/repo"),
+		entClient: entClient,
+	}
+}
+
+func (r *WorkScheduleAssignmentRepo) Create(ctx context.Context, tenantID uint32, scheduleID string, effectiveFrom time.Time, opts ...func(*ent.WorkScheduleAssignmentCreate)) (*ent.WorkScheduleAssignment, error) {
+	id := uuid.New().String()
+
+	create := r.entClient.Client().WorkScheduleAssignment.Create().
+		SetID(id).
+		SetTenantID(tenantID).
+		SetScheduleID(scheduleID).
+		SetEffectiveFrom(effectiveFrom).
+		SetCreateTime(time.Now())
+
+	for _, opt := range opts {
+		opt(create)
+	}
+
+	entity, err := create.Save(ctx)
+	if err != nil {
+		r.log.Errorf("create work schedule assignment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create work schedule assignment failed")
+	}
+	return entity, nil
+}
+
+func (r *WorkScheduleAssignmentRepo) GetByID(ctx context.Context, id string) (*ent.WorkScheduleAssignment, error) {
+	entity, err := r.entClient.Client().WorkScheduleAssignment.Query().
+		Where(workscheduleassignment.ID(id)).
+		WithSchedule().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get work schedule assignment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get work schedule assignment failed")
+	}
+	return entity, nil
+}
+
+func (r *WorkScheduleAssignmentRepo) List(ctx context.Context, tenantID uint32, page, pageSize int, filters map[string]interface{}) ([]*ent.WorkScheduleAssignment, int, error) {
+	query := r.entClient.Client().WorkScheduleAssignment.Query().
+		Where(workscheduleassignment.TenantID(tenantID)).
+		WithSchedule()
+
+	if scheduleID, ok := filters["schedule_id"].(string); ok && scheduleID != "" {
+		query = query.Where(workscheduleassignment.ScheduleID(scheduleID))
+	}
+	if userID, ok := filters["user_id"].(uint32); ok && userID > 0 {
+		query = query.Where(workscheduleassignment.UserID(userID))
+	}
+	if orgUnitName, ok := filters["org_unit_name"].(string); ok && orgUnitName != "" {
+		query = query.Where(workscheduleassignment.OrgUnitName(orgUnitName))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count work schedule assignments failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list work schedule assignments failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	entities, err := query.Order(ent.Desc(workscheduleassignment.FieldEffectiveFrom)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list work schedule assignments failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list work schedule assignments failed")
+	}
+
+	return entities, total, nil
+}
+
+// GetSchedules returns the schedules that apply to the user up to the given end date,
+// from both the user's own assignments and those of the user's org unit.
+func (r *WorkScheduleAssignmentRepo) GetSchedules(ctx context.Context, tenantID uint32, userID uint32, orgUnitName string, end time.Time) (*workday.Schedules, error) {
+	schedules := &workday.Schedules{}
+
+	if userID > 0 {
+		userAssignments, err := r.entClient.Client().WorkScheduleAssignment.Query().
+			Where(
+				workscheduleassignment.TenantID(tenantID),
+				workscheduleassignment.UserID(userID),
+				workscheduleassignment.EffectiveFromLTE(end),
+			).
+			WithSchedule().
+			Order(ent.Asc(workscheduleassignment.FieldEffectiveFrom)).
+			All(ctx)
+		if err != nil {
+			r.log.Errorf("get user work schedules failed: %s", err.Error())
+			return nil, hrV1.ErrorInternalServerError("get work schedules failed")
+		}
+		schedules.User = assignmentTimeline(userAssignments)
+	}
+
+	if orgUnitName != "" {
+		orgAssignments, err := r.entClient.Client().WorkScheduleAssignment.Query().
+			Where(
+				workscheduleassignment.TenantID(tenantID),
+				workscheduleassignment.UserID(0),
+				workscheduleassignment.OrgUnitName(orgUnitName),
+				workscheduleassignment.EffectiveFromLTE(end),
+			).
+			WithSchedule().
+			Order(ent.Asc(workscheduleassignment.FieldEffectiveFrom)).
+			All(ctx)
+		if err != nil {
+			r.log.Errorf("get org unit work schedules failed: %s", err.Error())
+			return nil, hrV1.ErrorInternalServerError("get work schedules failed")
+		}
+		schedules.OrgUnit = assignmentTimeline(orgAssignments)
+	}
+
+	return schedules, nil
+}
+
+func (r *WorkScheduleAssignmentRepo) Delete(ctx context.Context, id string) error {
+	err := r.entClient.Client().WorkScheduleAssignment.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorWorkScheduleAssignmentNotFound("work schedule assignment not found")
+		}
+		r.log.Errorf("delete work schedule assignment failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete work schedule assignment failed")
+	}
+	return nil
+}
+
+func assignmentTimeline(assignments []*ent.WorkScheduleAssignment) workday.Timeline {
+	timeline := make(workday.Timeline, 0, len(assignments))
+	for _, a := range assignments {
+		if a.Edges.Schedule == nil {
+			continue
+		}
+		timeline = append(timeline, workday.Period{
+			From:     a.EffectiveFrom,
+			Schedule: workday.ScheduleFromHours(a.Edges.Schedule.DayHours),
+		})
+	}
+	return timeline
+}
@@ -0,0 +1,147 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	entCrud "github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workschedule"
+)
+
+type WorkScheduleRepo struct {
+	entClient *entCrud.EntClient[*ent.Client]
+	log       *log.Helper
+}
+
+func NewWorkScheduleRepo(ctx *bootstrap.Context, entClient *entCrud.EntClient[*ent.Client]) *WorkScheduleRepo {
+	return &WorkScheduleRepo{
+		log:       ctx.NewLoggerHelper("hr/work_schedule/repo"),
+		entClient: entClient,
+	}
+}
+
+func (r *WorkScheduleRepo) Create(ctx context.Context, tenantID uint32, name string, dayHours []float64, opts ...func(*ent.WorkScheduleCreate)) (*ent.WorkSchedule, error) {
+	id := uuid.New().String()
+
+	create := r.entClient.Client().WorkSchedule.Create().
+		SetID(id).
+		SetTenantID(tenantID).
+		SetName(name).
+		SetDayHours(dayHours).
+		SetCreateTime(time.Now())
+
+	for _, opt := range opts {
+		opt(create)
+	}
+
+	entity, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, hrV1.ErrorAlreadyExists("work schedule already exists with this name")
+		}
+		r.log.Errorf("create work schedule failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("create work schedule failed")
+	}
+	return entity, nil
+}
+
+func (r *WorkScheduleRepo) GetByID(ctx context.Context, id string) (*ent.WorkSchedule, error) {
+	entity, err := r.entClient.Client().WorkSchedule.Query().
+		Where(workschedule.ID(id)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		r.log.Errorf("get work schedule failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("get work schedule failed")
+	}
+	return entity, nil
+}
+
+func (r *WorkScheduleRepo) List(ctx context.Context, tenantID uint32, page, pageSize int, filters map[string]interface{}) ([]*ent.WorkSchedule, int, error) {
+	query := r.entClient.Client().WorkSchedule.Query().
+		Where(workschedule.TenantID(tenantID))
+
+	if q, ok := filters["query"].(string); ok && q != "" {
+		query = query.Where(workschedule.NameContains(q))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count work schedules failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list work schedules failed")
+	}
+
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	entities, err := query.Order(ent.Asc(workschedule.FieldName)).All(ctx)
+	if err != nil {
+		r.log.Errorf("list work schedules failed: %s", err.Error())
+		return nil, 0, hrV1.ErrorInternalServerError("list work schedules failed")
+	}
+
+	return entities, total, nil
+}
+
+func (r *WorkScheduleRepo) Update(ctx context.Context, id string, updates map[string]interface{}) (*ent.WorkSchedule, error) {
+	update := r.entClient.Client().WorkSchedule.UpdateOneID(id)
+
+	if name, ok := updates["name"].(string); ok {
+		update = update.SetName(name)
+	}
+	if description, ok := updates["description"].(string); ok {
+		update = update.SetDescription(description)
+	}
+	if dayHours, ok := updates["day_hours"].([]float64); ok {
+		update = update.SetDayHours(dayHours)
+	}
+
+	update = update.SetUpdateTime(time.Now())
+
+	entity, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, hrV1.ErrorWorkScheduleNotFound("work schedule not found")
+		}
+		if ent.IsConstraintError(err) {
+			return nil, hrV1.ErrorAlreadyExists("work schedule already exists with this name")
+		}
+		r.log.Errorf("update work schedule failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("update work schedule failed")
+	}
+	return entity, nil
+}
+
+func (r *WorkScheduleRepo) Delete(ctx context.Context, id string) error {
+	// Check if schedule is assigned
+	assignCount, err := r.entClient.Client().WorkSchedule.Query().
+		Where(workschedule.ID(id)).
+		QueryAssignments().
+		Count(ctx)
+	if err != nil {
+		r.log.Errorf("check work schedule usage failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete work schedule failed")
+	}
+	if assignCount > 0 {
+		return hrV1.ErrorWorkScheduleInUse("work schedule has %d assignments", assignCount)
+	}
+
+	err = r.entClient.Client().WorkSchedule.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return hrV1.ErrorWorkScheduleNotFound("work schedule not found")
+		}
+		r.log.Errorf("delete work schedule failed: %s", err.Error())
+		return hrV1.ErrorInternalServerError("delete work schedule failed")
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// Package escalation decides how leave requests that await a decision for too long are followed
+// up.
+package escalation
+
+import "time"
+
+// Automatic actions taken on a stale request.
+const (
+	ActionReject  = "reject"
+	ActionApprove = "approve"
+)
+
+// Levels record how far a request awaiting a decision has been followed up.
+const (
+	LevelNone      = 0
+	LevelReminded  = 1
+	LevelEscalated = 2
+)
+
+// Steps due on a stale request.
+const (
+	StepNone       = ""
+	StepRemind     = "remind"
+	StepEscalate   = "escalate"
+	StepAutoAction = "auto_action"
+)
+
+// Policy describes the follow-up of requests of an absence type that await a decision. Without
+// a policy requests wait indefinitely.
+type Policy struct {
+	// RemindAfterDays reminds the approver after the request has waited this many days; 0 never
+	// reminds.
+	RemindAfterDays int `json:"remind_after_days,omitempty"`
+	// EscalateAfterDays notifies EscalateTo after the request has waited this many days; 0 never
+	// escalates.
+	EscalateAfterDays int `json:"escalate_after_days,omitempty"`
+	// EscalateTo are the email addresses notified on escalation, e.g. the HR team.
+	EscalateTo []string `json:"escalate_to,omitempty"`
+	// AutoAction rejects or approves the request automatically; empty never acts. Requests
+	// awaiting signatures are only ever rejected.
+	AutoAction string `json:"auto_action,omitempty"`
+	// AutoActionAfterDays takes AutoAction after the request has waited this many days.
+	AutoActionAfterDays int `json:"auto_action_after_days,omitempty"`
+	// AutoActionOnStart takes AutoAction once the start date of the absence has been reached.
+	AutoActionOnStart bool `json:"auto_action_on_start,omitempty"`
+}
+
+// Enabled reports whether the policy follows requests up at all.
+func (p *Policy) Enabled() bool {
+	return p != nil && (p.RemindAfterDays > 0 || p.EscalateAfterDays > 0 || p.AutoAction != "")
+}
+
+// Due returns the step to take at the time now on a request that has awaited a decision since
+// the time since, has been followed up to level and starts on startDate. Only the most advanced
+// step is returned, so a request that missed its reminder is escalated straight away.
+func (p *Policy) Due(level int, since, startDate, now time.Time) string {
+	if !p.Enabled() {
+		return StepNone
+	}
+
+	waited := now.Sub(since)
+	if p.AutoAction != "" {
+		if p.AutoActionAfterDays > 0 && waited >= days(p.AutoActionAfterDays) {
+			return StepAutoAction
+		}
+		if p.AutoActionOnStart && !now.Before(dateOf(startDate)) {
+			return StepAutoAction
+		}
+	}
+	if level < LevelEscalated && p.EscalateAfterDays > 0 && waited >= days(p.EscalateAfterDays) {
+		return StepEscalate
+	}
+	if level < LevelReminded && p.RemindAfterDays > 0 && waited >= days(p.RemindAfterDays) {
+		return StepRemind
+	}
+	return StepNone
+}
+
+// ValidAction reports whether action is empty or a known automatic action.
+func ValidAction(action string) bool {
+	return action == "" || action == ActionReject || action == ActionApprove
+}
+
+func days(n int) time.Duration {
+	return time.Duration(n) * 24 * time.Hour
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
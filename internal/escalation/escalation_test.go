@@ -0,0 +1,112 @@
+package escalation
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPolicyEnabled(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *Policy
+		want   bool
+	}{
+		{"nil", nil, false},
+		{"empty", &Policy{}, false},
+		{"escalation recipients only", &Policy{EscalateTo: []string{"hr@example.com"}}, false},
+		{"reminder", &Policy{RemindAfterDays: 2}, true},
+		{"escalation", &Policy{EscalateAfterDays: 5}, true},
+		{"automatic action", &Policy{AutoAction: ActionReject, AutoActionOnStart: true}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Enabled(); got != tt.want {
+				t.Errorf("Enabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyDue(t *testing.T) {
+	since := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	startDate := time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC)
+	after := func(days int) time.Time { return since.AddDate(0, 0, days) }
+
+	full := &Policy{
+		RemindAfterDays:     2,
+		EscalateAfterDays:   5,
+		EscalateTo:          []string{"hr@example.com"},
+		AutoAction:          ActionReject,
+		AutoActionAfterDays: 10,
+	}
+
+	tests := []struct {
+		name   string
+		policy *Policy
+		level  int
+		now    time.Time
+		want   string
+	}{
+		{"no policy", nil, LevelNone, after(30), StepNone},
+		{"disabled policy", &Policy{}, LevelNone, after(30), StepNone},
+		{"not yet due", full, LevelNone, after(1), StepNone},
+		{"just before the reminder", full, LevelNone, after(2).Add(-time.Second), StepNone},
+		{"reminder", full, LevelNone, after(2), StepRemind},
+		{"already reminded", full, LevelReminded, after(3), StepNone},
+		{"escalation", full, LevelReminded, after(5), StepEscalate},
+		{"missed reminder is escalated", full, LevelNone, after(6), StepEscalate},
+		{"already escalated", full, LevelEscalated, after(7), StepNone},
+		{"automatic action", full, LevelEscalated, after(10), StepAutoAction},
+		{"automatic action whatever the level", full, LevelNone, after(12), StepAutoAction},
+		{"escalation without reminder", &Policy{EscalateAfterDays: 3}, LevelNone, after(2), StepNone},
+		{"escalation only", &Policy{EscalateAfterDays: 3}, LevelNone, after(3), StepEscalate},
+		{"days without action", &Policy{AutoActionAfterDays: 1}, LevelNone, after(30), StepNone},
+		{
+			"before the start date",
+			&Policy{AutoAction: ActionApprove, AutoActionOnStart: true}, LevelNone,
+			startDate.Add(-time.Minute), StepNone,
+		},
+		{
+			"on the start date",
+			&Policy{AutoAction: ActionApprove, AutoActionOnStart: true}, LevelNone,
+			startDate, StepAutoAction,
+		},
+		{
+			"start date with a time of day",
+			&Policy{AutoAction: ActionApprove, AutoActionOnStart: true}, LevelNone,
+			startDate.Add(time.Hour), StepAutoAction,
+		},
+		{
+			"start date reached before the reminder",
+			&Policy{RemindAfterDays: 30, AutoAction: ActionReject, AutoActionOnStart: true}, LevelNone,
+			startDate, StepAutoAction,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Due(tt.level, since, startDate.Add(8*time.Hour), tt.now); got != tt.want {
+				t.Errorf("Due() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidAction(t *testing.T) {
+	tests := []struct {
+		action string
+		want   bool
+	}{
+		{"", true},
+		{ActionReject, true},
+		{ActionApprove, true},
+		{"revoke", false},
+		{"Reject", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			if got := ValidAction(tt.action); got != tt.want {
+				t.Errorf("ValidAction(%q) = %v, want %v", tt.action, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,43 +2,58 @@ package event
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
 	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveamendment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-hr/internal/workday"
 )
 
 // Handler handles signing events from the signing service
 type Handler struct {
 	log              *log.Helper
 	leaveRequestRepo *data.LeaveRequestRepo
+	amendmentRepo    *data.LeaveAmendmentRepo
 	allowanceRepo    *data.LeaveAllowanceRepo
 	absenceTypeRepo  *data.AbsenceTypeRepo
+	holidayRepo      *data.HolidayRepo
+	scheduleRepo     *data.WorkScheduleAssignmentRepo
+	outboxRepo       *data.OutboxRepo
 }
 
 // NewHandler creates a new event handler
-func NewHandler(ctx *bootstrap.Context, leaveRequestRepo *data.LeaveRequestRepo, allowanceRepo *data.LeaveAllowanceRepo, absenceTypeRepo *data.AbsenceTypeRepo) *Handler {
+func NewHandler(ctx *bootstrap.Context, leaveRequestRepo *data.LeaveRequestRepo, amendmentRepo *data.LeaveAmendmentRepo, allowanceRepo *data.LeaveAllowanceRepo, absenceTypeRepo *data.AbsenceTypeRepo, holidayRepo *data.HolidayRepo, scheduleRepo *data.WorkScheduleAssignmentRepo, outboxRepo *data.OutboxRepo) *Handler {
 	return &Handler{
 		log:              ctx.NewLoggerHelper("hr/event/handler"),
 		leaveRequestRepo: leaveRequestRepo,
+		amendmentRepo:    amendmentRepo,
 		allowanceRepo:    allowanceRepo,
 		absenceTypeRepo:  absenceTypeRepo,
+		holidayRepo:      holidayRepo,
+		scheduleRepo:     scheduleRepo,
+		outboxRepo:       outboxRepo,
 	}
 }
 
 // HandleSigningCompleted handles a submission.completed event from the signing service
-func (h *Handler) HandleSigningCompleted(ctx context.Context, data *SubmissionCompletedData) error {
-	h.log.Infof("Handling signing completed: submission_id=%s, tenant_id=%d", data.SubmissionID, data.TenantID)
+func (h *Handler) HandleSigningCompleted(ctx context.Context, completed *SubmissionCompletedData) error {
+	h.log.Infof("Handling signing completed: submission_id=%s, tenant_id=%d", completed.SubmissionID, completed.TenantID)
 
 	// Look up the leave request by signing_request_id (stores submission ID)
-	leaveReq, err := h.leaveRequestRepo.GetBySigningRequestID(ctx, data.SubmissionID)
+	leaveReq, err := h.leaveRequestRepo.GetBySigningRequestID(ctx, completed.SubmissionID)
 	if err != nil {
 		return err
 	}
 	if leaveReq == nil {
-		h.log.Infof("No leave request found for submission_id=%s, ignoring", data.SubmissionID)
-		return nil
+		// The submission may be for new dates of an approved request
+		return h.handleAmendmentSigned(ctx, completed)
 	}
 
 	// Verify the leave request is in awaiting_signing status
@@ -47,40 +62,272 @@ func (h *Handler) HandleSigningCompleted(ctx context.Context, data *SubmissionCo
 		return nil
 	}
 
-	// Approve the leave request (reviewer info was already stored when the signing was initiated)
-	_, err = h.leaveRequestRepo.UpdateStatus(ctx, leaveReq.ID, "approved", 0, "", "")
+	var tid uint32
+	if leaveReq.TenantID != nil {
+		tid = *leaveReq.TenantID
+	}
+
+	// Re-count days against the holiday calendar and work schedule, which may have
+	// changed while the request was awaiting signatures; without a calendar only the
+	// schedule counts
+	var holidays workday.Holidays
+	if leaveReq.HolidayCalendarID != "" {
+		if holidays, err = h.holidayRepo.GetHolidays(ctx, leaveReq.HolidayCalendarID, leaveReq.StartDate, leaveReq.EndDate); err != nil {
+			return err
+		}
+	}
+	schedules, err := h.scheduleRepo.GetSchedules(ctx, tid, leaveReq.UserID, leaveReq.OrgUnitName, leaveReq.EndDate)
 	if err != nil {
-		h.log.Errorf("Failed to approve leave request %s after signing: %v", leaveReq.ID, err)
 		return err
 	}
+	if days := workday.CountSpan(data.LeaveSpan(leaveReq), holidays, schedules); days != leaveReq.Days {
+		if err := h.leaveRequestRepo.SetDays(ctx, leaveReq.ID, days); err != nil {
+			return err
+		}
+		h.log.Infof("Leave request %s days recalculated from %.1f to %.1f", leaveReq.ID, leaveReq.Days, days)
+		leaveReq.Days = days
+	}
+
+	// The reviewer who initiated the signing is recorded as the actor
+	ref := data.LedgerRef{
+		LeaveRequestID: leaveReq.ID,
+		ActorID:        leaveReq.ReviewedBy,
+		ActorName:      leaveReq.ReviewerName,
+		Note:           "leave approved after signing",
+	}
 
-	// Atomically deduct from allowance if the absence type requires it
+	// Atomically deduct from allowance BEFORE approving, as approvals do, so that a failed
+	// deduction leaves the request awaiting signing and the event is handled again
+	var deductions []schema.LeaveDeduction
 	if leaveReq.Edges.AbsenceType != nil && leaveReq.Edges.AbsenceType.DeductsFromAllowance {
-		var tid uint32
-		if leaveReq.TenantID != nil {
-			tid = *leaveReq.TenantID
+		// Split requests crossing a year boundary across each year's allowance
+		portions, err := data.LeaveDaysByYear(ctx, h.holidayRepo, h.scheduleRepo, leaveReq)
+		if err != nil {
+			return err
 		}
 
-		var allowanceID string
-		var deductErr error
 		if leaveReq.Edges.AbsenceType.AllowancePoolID != "" {
-			allowanceID, deductErr = h.allowanceRepo.DeductPoolWithBalanceCheck(ctx, tid, leaveReq.UserID, leaveReq.Edges.AbsenceType.AllowancePoolID, leaveReq.StartDate.Year(), leaveReq.Days)
+			deductions, err = h.allowanceRepo.DeductPoolWithBalanceCheck(ctx, tid, leaveReq.UserID, leaveReq.Edges.AbsenceType.AllowancePoolID, portions, ref)
 		} else {
-			allowanceID, deductErr = h.allowanceRepo.DeductWithBalanceCheck(ctx, tid, leaveReq.UserID, leaveReq.AbsenceTypeID, leaveReq.StartDate.Year(), leaveReq.Days)
+			deductions, err = h.allowanceRepo.DeductWithBalanceCheck(ctx, tid, leaveReq.UserID, leaveReq.AbsenceTypeID, portions, ref)
 		}
-		if deductErr != nil {
-			h.log.Errorf("Failed to deduct allowance for leave %s after signing: %v", leaveReq.ID, deductErr)
-			return deductErr
+		if err != nil {
+			h.log.Errorf("Failed to deduct allowance for leave %s after signing: %v", leaveReq.ID, err)
+			return err
 		}
+	}
 
-		// Store which allowance was deducted for accurate refunds
-		if allowanceID != "" {
-			if setErr := h.leaveRequestRepo.SetDeductedAllowanceID(ctx, leaveReq.ID, allowanceID); setErr != nil {
-				h.log.Errorf("Failed to store deducted_allowance_id on leave %s: %v", leaveReq.ID, setErr)
-			}
+	// Approve the leave request (reviewer info was already stored when the signing was initiated).
+	// Only a request still awaiting signing is approved, so that an event handled at the same time
+	// as its reconciliation approves the request once.
+	_, err = h.leaveRequestRepo.UpdateStatusWithOutbox(ctx, leaveReq.ID, "approved", 0, "", "", func(e *ent.LeaveRequest) []data.OutboxMessage {
+		return append([]data.OutboxMessage{LeaveMessage(LeaveApproved, e, e.ReviewedBy)}, AllowancesOf(e)...)
+	}, func(u *ent.LeaveRequestUpdateOne) {
+		u.Where(leaverequest.StatusEQ(leaverequest.StatusAwaitingSigning)).
+			SetSigningOutcome(leaverequest.SigningOutcomeCompleted).
+			SetSigningEndedAt(time.Now())
+		// Store which allowances were deducted for accurate refunds later
+		if len(deductions) > 0 {
+			u.SetDeductions(deductions)
+		}
+	})
+	if err != nil {
+		// Refund the deduction, which the request was not approved with
+		ref.Note = "leave approval after signing failed"
+		if refundErr := h.allowanceRepo.RefundDeductions(ctx, deductions, ref); refundErr != nil {
+			h.log.Errorf("Failed to refund allowances for leave %s: %v", leaveReq.ID, refundErr)
 		}
 	}
+	if hrV1.IsLeaveRequestNotFound(err) {
+		h.log.Infof("Leave request %s was approved concurrently, ignoring", leaveReq.ID)
+		return nil
+	}
+	if err != nil {
+		h.log.Errorf("Failed to approve leave request %s after signing: %v", leaveReq.ID, err)
+		return err
+	}
 
 	h.log.Infof("Leave request %s auto-approved after signing completed", leaveReq.ID)
 	return nil
 }
+
+// handleAmendmentSigned applies the change to the dates of an approved leave request once the new
+// dates have been signed, moving the allowance deductions by the difference in days.
+func (h *Handler) handleAmendmentSigned(ctx context.Context, completed *SubmissionCompletedData) error {
+	amendment, err := h.amendmentRepo.GetBySigningRequestID(ctx, completed.SubmissionID)
+	if err != nil {
+		return err
+	}
+	if amendment == nil {
+		h.log.Infof("No leave request found for submission_id=%s, ignoring", completed.SubmissionID)
+		return nil
+	}
+
+	if amendment.Status != leaveamendment.StatusAwaitingSigning {
+		h.log.Infof("Leave amendment %s is not awaiting_signing (status=%s), ignoring", amendment.ID, amendment.Status)
+		return nil
+	}
+
+	leaveReq, err := h.leaveRequestRepo.GetByID(ctx, amendment.LeaveRequestID)
+	if err != nil {
+		return err
+	}
+	if leaveReq == nil || leaveReq.Status != leaverequest.StatusApproved {
+		h.log.Infof("Leave request of amendment %s is no longer approved, ignoring", amendment.ID)
+		return nil
+	}
+
+	// The reviewer who initiated the signing is recorded as the actor
+	ref := data.LedgerRef{
+		LeaveRequestID: leaveReq.ID,
+		ActorID:        amendment.ReviewedBy,
+		ActorName:      amendment.ReviewerName,
+		Note:           "leave dates changed after signing",
+	}
+
+	// The signed document of the new dates replaces that of the previous ones
+	_, err = h.amendmentRepo.ApplyWithRebooking(ctx, h.allowanceRepo, h.holidayRepo, h.scheduleRepo, leaveReq, amendment, ref, func(u *ent.LeaveRequestUpdateOne) {
+		u.SetSigningRequestID(amendment.SigningRequestID)
+	})
+	if err != nil {
+		h.log.Errorf("Failed to apply amendment %s of leave %s after signing: %v", amendment.ID, leaveReq.ID, err)
+		return err
+	}
+
+	if moved, err := h.leaveRequestRepo.GetByID(ctx, leaveReq.ID); err == nil && moved != nil {
+		if err := h.outboxRepo.Create(ctx, AllowancesOf(leaveReq, moved)...); err != nil {
+			h.log.Errorf("Failed to enqueue allowance changes of leave %s: %v", leaveReq.ID, err)
+		}
+	}
+
+	h.log.Infof("Leave request %s moved to new dates after signing of amendment %s completed", leaveReq.ID, amendment.ID)
+	return nil
+}
+
+// HandleSigningEnded handles a submission.declined, submission.expired or submission.cancelled
+// event from the signing service. A declined signature rejects the leave request with the reason
+// given; an expired or cancelled submission puts the request back to pending on its last approval
+// step, for the approver to decide again. Either way the outcome is recorded on the request, and
+// the requester and the reviewer are notified.
+func (h *Handler) HandleSigningEnded(ctx context.Context, outcome leaverequest.SigningOutcome, ended *SubmissionEndedData) error {
+	h.log.Infof("Handling signing %s: submission_id=%s, tenant_id=%d", outcome, ended.SubmissionID, ended.TenantID)
+
+	leaveReq, err := h.leaveRequestRepo.GetBySigningRequestID(ctx, ended.SubmissionID)
+	if err != nil {
+		return err
+	}
+	if leaveReq == nil {
+		// The submission may be for new dates of an approved request
+		return h.handleAmendmentSigningEnded(ctx, outcome, ended)
+	}
+
+	if leaveReq.Status != leaverequest.StatusAwaitingSigning {
+		h.log.Infof("Leave request %s is not awaiting_signing (status=%s), ignoring", leaveReq.ID, leaveReq.Status)
+		return nil
+	}
+
+	status, reviewNotes := "pending", ""
+	if outcome == leaverequest.SigningOutcomeDeclined {
+		status = "rejected"
+		reviewNotes = "Signature declined"
+		if ended.Reason != "" {
+			reviewNotes += ": " + ended.Reason
+		}
+	}
+
+	// Only a request still awaiting signing is moved, so that an event handled at the same time
+	// as its reconciliation moves the request once
+	_, err = h.leaveRequestRepo.UpdateStatusWithOutbox(ctx, leaveReq.ID, status, 0, "", reviewNotes, func(e *ent.LeaveRequest) []data.OutboxMessage {
+		msgs := []data.OutboxMessage{signingEndedMessage(e.TenantID, SigningEndedNotice{
+			LeaveRequestID: e.ID,
+			Outcome:        string(outcome),
+			Reason:         ended.Reason,
+			Status:         status,
+			ReviewerID:     e.ReviewedBy,
+		})}
+		if status == "rejected" {
+			msgs = append(msgs, LeaveMessage(LeaveRejected, e, 0))
+		} else {
+			msgs = append(msgs, LeaveMessage(LeaveReopened, e, 0))
+		}
+		return msgs
+	}, func(u *ent.LeaveRequestUpdateOne) {
+		u.Where(leaverequest.StatusEQ(leaverequest.StatusAwaitingSigning)).
+			SetSigningOutcome(outcome).
+			SetSigningOutcomeReason(ended.Reason).
+			SetSigningEndedAt(time.Now())
+		// The final approval led to the signing, so it is that step which is decided again
+		if status == "pending" && len(leaveReq.ApprovalSteps) > 0 {
+			data.ReopenStep(u, leaveReq.ApprovalSteps, len(leaveReq.ApprovalSteps)-1)
+		}
+	})
+	if hrV1.IsLeaveRequestNotFound(err) {
+		h.log.Infof("Leave request %s was moved concurrently, ignoring", leaveReq.ID)
+		return nil
+	}
+	if err != nil {
+		h.log.Errorf("Failed to move leave request %s after signing %s: %v", leaveReq.ID, outcome, err)
+		return err
+	}
+
+	h.log.Infof("Leave request %s moved to %s after signing %s", leaveReq.ID, status, outcome)
+	return nil
+}
+
+// handleAmendmentSigningEnded handles the end without signatures of the signing of new dates of
+// an approved leave request: a declined signature rejects the change, and an expired or cancelled
+// submission puts it back to pending. The request keeps its dates either way.
+func (h *Handler) handleAmendmentSigningEnded(ctx context.Context, outcome leaverequest.SigningOutcome, ended *SubmissionEndedData) error {
+	amendment, err := h.amendmentRepo.GetBySigningRequestID(ctx, ended.SubmissionID)
+	if err != nil {
+		return err
+	}
+	if amendment == nil {
+		h.log.Infof("No leave request found for submission_id=%s, ignoring", ended.SubmissionID)
+		return nil
+	}
+
+	if amendment.Status != leaveamendment.StatusAwaitingSigning {
+		h.log.Infof("Leave amendment %s is not awaiting_signing (status=%s), ignoring", amendment.ID, amendment.Status)
+		return nil
+	}
+
+	status := "pending"
+	if outcome == leaverequest.SigningOutcomeDeclined {
+		status = "rejected"
+	}
+	reviewNotes := "Signing " + string(outcome)
+	if ended.Reason != "" {
+		reviewNotes += ": " + ended.Reason
+	}
+
+	if _, err := h.amendmentRepo.UpdateStatus(ctx, amendment.ID, status, 0, "", reviewNotes); err != nil {
+		h.log.Errorf("Failed to move amendment %s after signing %s: %v", amendment.ID, outcome, err)
+		return err
+	}
+
+	notice := SigningEndedNotice{
+		LeaveRequestID: amendment.LeaveRequestID,
+		AmendmentID:    amendment.ID,
+		Outcome:        string(outcome),
+		Reason:         ended.Reason,
+		Status:         status,
+		ReviewerID:     amendment.ReviewedBy,
+	}
+	if err := h.outboxRepo.Create(ctx, signingEndedMessage(amendment.TenantID, notice)); err != nil {
+		h.log.Errorf("Failed to enqueue signing notice of amendment %s: %v", amendment.ID, err)
+	}
+
+	h.log.Infof("Leave amendment %s moved to %s after signing %s", amendment.ID, status, outcome)
+	return nil
+}
+
+// signingEndedMessage returns the outbox message that sends the notice of a signing that ended
+// without all signatures.
+func signingEndedMessage(tenantID *uint32, notice SigningEndedNotice) data.OutboxMessage {
+	var tid uint32
+	if tenantID != nil {
+		tid = *tenantID
+	}
+	return data.OutboxMessage{TenantID: tid, Kind: data.OutboxKindSigningEndedEmail, Payload: notice}
+}
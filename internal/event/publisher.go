@@ -0,0 +1,257 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-hr/internal/conf"
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+)
+
+// eventSource is the source of the events the HR service publishes
+const eventSource = "hr"
+
+// allowanceEventNamespace is the namespace of the IDs of allowance.changed events, which are
+// derived from the outbox message publishing them and the allowance
+var allowanceEventNamespace = uuid.MustParse("94759a3b-306d-437d-9136-95dcfc7c1f2c")
+
+// Publisher publishes the domain events of the HR service via Redis pub/sub, so that other
+// services, e.g. payroll, learn of leave transitions without polling, and hands them to the
+// webhook subscriptions of their tenant for tools that cannot subscribe to Redis. Transitions
+// write their events to the outbox, from which the outbox relay publishes them.
+type Publisher struct {
+	log           *log.Helper
+	rdb           *redis.Client
+	allowanceRepo *data.LeaveAllowanceRepo
+	webhookRepo   *data.WebhookDeliveryRepo
+	config        *conf.PublishConfig
+}
+
+// NewPublisher creates a new event publisher
+func NewPublisher(ctx *bootstrap.Context, rdb *redis.Client, allowanceRepo *data.LeaveAllowanceRepo, webhookRepo *data.WebhookDeliveryRepo) *Publisher {
+	var publishCfg *conf.PublishConfig
+	if cfg, ok := ctx.GetCustomConfig("hr"); ok && cfg != nil {
+		if hrCfg, ok := cfg.(*conf.HR); ok && hrCfg.Publish != nil {
+			publishCfg = hrCfg.Publish
+		}
+	}
+
+	// Default config if not set
+	if publishCfg == nil {
+		publishCfg = &conf.PublishConfig{
+			Enabled:     true,
+			TopicPrefix: "hr",
+		}
+	}
+
+	return &Publisher{
+		log:           ctx.NewLoggerHelper("hr/event/publisher"),
+		rdb:           rdb,
+		allowanceRepo: allowanceRepo,
+		webhookRepo:   webhookRepo,
+		config:        publishCfg,
+	}
+}
+
+// NewEvent returns an event of the tenant with the data payload, stamped now. Its ID stays the
+// same however often it is published, so that subscribers can ignore redeliveries.
+func NewEvent(eventType string, tenantID uint32, payload any) (Event, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, fmt.Errorf("marshal %s event data: %w", eventType, err)
+	}
+	return Event{
+		ID:        uuid.New().String(),
+		Type:      eventType,
+		Version:   EventVersion,
+		Source:    eventSource,
+		Timestamp: time.Now().UTC(),
+		TenantID:  tenantID,
+		Data:      raw,
+	}, nil
+}
+
+// Publish publishes an event on the channel of its type, and schedules its delivery to the
+// webhook subscriptions of its tenant that receive it. Nothing is published on the channel, and
+// no error returned, while publishing is disabled; webhooks are delivered regardless.
+func (p *Publisher) Publish(ctx context.Context, e Event) error {
+	if p == nil {
+		return nil
+	}
+
+	msg, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("marshal %s event: %w", e.Type, err)
+	}
+
+	// Scheduled first, as deliveries are scheduled once per event however often it is published
+	if p.webhookRepo != nil {
+		if _, err := p.webhookRepo.Enqueue(ctx, e.TenantID, e.ID, e.Type, msg); err != nil {
+			return fmt.Errorf("schedule webhook deliveries of %s event: %w", e.Type, err)
+		}
+	}
+
+	if !p.config.Enabled || p.rdb == nil {
+		return nil
+	}
+
+	prefix := p.config.TopicPrefix
+	if prefix == "" {
+		prefix = "hr"
+	}
+	channel := fmt.Sprintf("%s.%s", prefix, e.Type)
+	if err := p.rdb.Publish(ctx, channel, msg).Err(); err != nil {
+		return fmt.Errorf("publish event on channel %s: %w", channel, err)
+	}
+	return nil
+}
+
+// PublishAllowancesChanged publishes allowance.changed for each allowance of the outbox message
+// with the ID, with the allowance as it is now. The events are built when published, so their IDs
+// are derived from the message ID and the allowance, to stay the same however often the message
+// is delivered. Allowances that no longer exist are skipped.
+func (p *Publisher) PublishAllowancesChanged(ctx context.Context, messageID string, m AllowancesChangedMessage) error {
+	if p == nil {
+		return nil
+	}
+
+	for _, id := range m.AllowanceIDs {
+		a, err := p.allowanceRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if a == nil {
+			p.log.Warnf("Allowance %s of leave %s not found, allowance.changed not published", id, m.LeaveRequestID)
+			continue
+		}
+
+		var tenantID uint32
+		if a.TenantID != nil {
+			tenantID = *a.TenantID
+		}
+		e, err := NewEvent(AllowanceChanged, tenantID, allowanceData(a, m.LeaveRequestID))
+		if err != nil {
+			return err
+		}
+		e.ID = uuid.NewSHA1(allowanceEventNamespace, []byte(messageID+"/"+id)).String()
+		if err := p.Publish(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LeaveMessage returns the outbox message that publishes a leave.* event for a leave request after
+// its transition by the actor.
+func LeaveMessage(eventType string, e *ent.LeaveRequest, actorID uint32) data.OutboxMessage {
+	var tenantID uint32
+	if e.TenantID != nil {
+		tenantID = *e.TenantID
+	}
+	// LeaveEventData always marshals, so the event cannot fail to build
+	ev, _ := NewEvent(eventType, tenantID, leaveData(e, actorID))
+	return data.OutboxMessage{TenantID: tenantID, Kind: data.OutboxKindEvent, Payload: ev}
+}
+
+// AllowancesOf returns the outbox messages that publish allowance.changed for each allowance the
+// leave requests were deducted from. Pass the request before and after a transition that moved
+// its deductions, so that the allowances of both are published.
+func AllowancesOf(requests ...*ent.LeaveRequest) []data.OutboxMessage {
+	var messages []data.OutboxMessage
+	seen := make(map[string]bool)
+	for _, e := range requests {
+		if e == nil {
+			continue
+		}
+		ids := make([]string, 0, len(e.Deductions)+1)
+		for _, d := range e.Deductions {
+			ids = append(ids, d.AllowanceID)
+		}
+		if e.DeductedAllowanceID != "" {
+			ids = append(ids, e.DeductedAllowanceID)
+		}
+
+		var tenantID uint32
+		if e.TenantID != nil {
+			tenantID = *e.TenantID
+		}
+		m := AllowancesChangedMessage{LeaveRequestID: e.ID}
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				m.AllowanceIDs = append(m.AllowanceIDs, id)
+			}
+		}
+		if len(m.AllowanceIDs) > 0 {
+			messages = append(messages, data.OutboxMessage{TenantID: tenantID, Kind: data.OutboxKindAllowanceChanged, Payload: m})
+		}
+	}
+	return messages
+}
+
+// AllowanceMessages returns the outbox messages that publish allowance.changed for an allowance
+// changed other than by a leave request, e.g. by an admin, accrual or rollover.
+func AllowanceMessages(a *ent.LeaveAllowance) []data.OutboxMessage {
+	var tenantID uint32
+	if a.TenantID != nil {
+		tenantID = *a.TenantID
+	}
+	return []data.OutboxMessage{{
+		TenantID: tenantID,
+		Kind:     data.OutboxKindAllowanceChanged,
+		Payload:  AllowancesChangedMessage{AllowanceIDs: []string{a.ID}},
+	}}
+}
+
+// allowanceData returns the data payload of allowance.changed for an allowance, changed by the
+// leave request when one is given
+func allowanceData(a *ent.LeaveAllowance, leaveRequestID string) AllowanceChangedData {
+	payload := AllowanceChangedData{
+		AllowanceID:    a.ID,
+		UserID:         a.UserID,
+		Year:           a.Year,
+		TotalDays:      a.TotalDays,
+		CarriedOver:    a.CarriedOver,
+		UsedDays:       a.UsedDays,
+		RemainingDays:  a.TotalDays + a.CarriedOver - a.UsedDays,
+		LeaveRequestID: leaveRequestID,
+	}
+	if a.AbsenceTypeID != nil {
+		payload.AbsenceTypeID = *a.AbsenceTypeID
+	}
+	if a.AllowancePoolID != nil {
+		payload.AllowancePoolID = *a.AllowancePoolID
+	}
+	return payload
+}
+
+// leaveData returns the data payload of the leave.* events for a leave request
+func leaveData(e *ent.LeaveRequest, actorID uint32) LeaveEventData {
+	return LeaveEventData{
+		LeaveRequestID: e.ID,
+		UserID:         e.UserID,
+		UserName:       e.UserName,
+		OrgUnitName:    e.OrgUnitName,
+		AbsenceTypeID:  e.AbsenceTypeID,
+		StartDate:      e.StartDate,
+		EndDate:        e.EndDate,
+		StartDayPart:   e.StartDayPart.String(),
+		EndDayPart:     e.EndDayPart.String(),
+		Days:           e.Days,
+		Hours:          e.Hours,
+		Status:         e.Status.String(),
+		SeriesID:       e.SeriesID,
+		ReviewedBy:     e.ReviewedBy,
+		ReviewNotes:    e.ReviewNotes,
+		ReviewedAt:     e.ReviewedAt,
+		ActorID:        actorID,
+	}
+}
@@ -3,33 +3,71 @@ package event
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/redis/go-redis/v9"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 
 	"github.com/go-tangra/go-tangra-hr/internal/conf"
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
 
 	appViewer "github.com/go-tangra/go-tangra-common/viewer"
 )
 
-// Subscriber handles Redis pub/sub event subscriptions for HR
+// Transports of the events the subscriber receives
+const (
+	TransportPubSub  = "pubsub"
+	TransportStreams = "streams"
+)
+
+const (
+	defaultTopicPrefix   = "signing"
+	defaultConsumerGroup = "hr-service"
+	defaultClaimIdle     = time.Minute
+	defaultMaxDeliveries = 10
+	deadLetterSuffix     = ".dead"
+
+	// streamPayloadField is the field of a stream entry that holds the event envelope
+	streamPayloadField = "payload"
+	// streamReadBlock is how long a read waits for new stream entries
+	streamReadBlock = 5 * time.Second
+	// streamReadCount is how many stream entries a read or reclaim takes at most
+	streamReadCount = 10
+)
+
+// HandledEventTypes are the types of the signing service events the subscriber handles, which
+// are listed in the subscribed events without the topic prefix
+var HandledEventTypes = []string{SubmissionCompleted, SubmissionDeclined, SubmissionExpired, SubmissionCancelled}
+
+// Subscriber handles the event subscriptions for HR, via Redis pub/sub or, for delivery that
+// survives restarts, Redis streams read as a consumer group. Events whose ID has been handled
+// before are skipped.
 type Subscriber struct {
-	log     *log.Helper
-	rdb     *redis.Client
-	handler *Handler
-	config  *conf.EventConfig
-	ctx     context.Context
-	cancel  context.CancelFunc
-	wg      sync.WaitGroup
-	running bool
-	mu      sync.Mutex
+	log           *log.Helper
+	rdb           *redis.Client
+	handler       *Handler
+	processedRepo *data.ProcessedEventRepo
+	config        *conf.EventConfig
+	consumer      string
+	claimIdle     time.Duration
+	maxDeliveries int64
+	ctx           context.Context
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+	running       bool
+	mu            sync.Mutex
 }
 
 // NewSubscriber creates a new event subscriber
-func NewSubscriber(ctx *bootstrap.Context, rdb *redis.Client, handler *Handler) *Subscriber {
+func NewSubscriber(ctx *bootstrap.Context, rdb *redis.Client, handler *Handler, processedRepo *data.ProcessedEventRepo) *Subscriber {
 	var eventCfg *conf.EventConfig
 	if cfg, ok := ctx.GetCustomConfig("hr"); ok && cfg != nil {
 		if hrCfg, ok := cfg.(*conf.HR); ok && hrCfg.Events != nil {
@@ -40,19 +78,47 @@ func NewSubscriber(ctx *bootstrap.Context, rdb *redis.Client, handler *Handler)
 	// Default config if not set
 	if eventCfg == nil {
 		eventCfg = &conf.EventConfig{
-			Enabled:     true,
-			TopicPrefix: "signing",
-			SubscribeEvents: []string{
-				"submission.completed",
-			},
+			Enabled:         true,
+			TopicPrefix:     defaultTopicPrefix,
+			SubscribeEvents: HandledEventTypes,
 		}
 	}
 
+	l := ctx.NewLoggerHelper("hr/event/subscriber")
+
+	consumer := eventCfg.ConsumerName
+	if consumer == "" {
+		if host, err := os.Hostname(); err == nil && host != "" {
+			consumer = host
+		} else {
+			consumer = "hr-service"
+		}
+	}
+
+	claimIdle := defaultClaimIdle
+	if eventCfg.ClaimIdle != "" {
+		d, err := time.ParseDuration(eventCfg.ClaimIdle)
+		if err != nil || d <= 0 {
+			l.Warnf("Invalid claim idle %q, using %s", eventCfg.ClaimIdle, defaultClaimIdle)
+		} else {
+			claimIdle = d
+		}
+	}
+
+	maxDeliveries := int64(defaultMaxDeliveries)
+	if eventCfg.MaxDeliveries > 0 {
+		maxDeliveries = int64(eventCfg.MaxDeliveries)
+	}
+
 	return &Subscriber{
-		log:     ctx.NewLoggerHelper("hr/event/subscriber"),
-		rdb:     rdb,
-		handler: handler,
-		config:  eventCfg,
+		log:           l,
+		rdb:           rdb,
+		handler:       handler,
+		processedRepo: processedRepo,
+		config:        eventCfg,
+		consumer:      consumer,
+		claimIdle:     claimIdle,
+		maxDeliveries: maxDeliveries,
 	}
 }
 
@@ -79,14 +145,12 @@ func (s *Subscriber) Start() error {
 	s.ctx, s.cancel = context.WithCancel(baseCtx)
 	s.running = true
 
-	prefix := s.config.TopicPrefix
-	if prefix == "" {
-		prefix = "signing"
-	}
-
 	channels := make([]string, len(s.config.SubscribeEvents))
 	for i, event := range s.config.SubscribeEvents {
-		channels[i] = fmt.Sprintf("%s.%s", prefix, event)
+		channels[i] = ChannelName(s.config.TopicPrefix, event)
+		if !slices.Contains(HandledEventTypes, EventTypeOf(s.config.TopicPrefix, channels[i])) {
+			s.log.Warnf("Subscribed event %q is not handled, expected one of %v", event, HandledEventTypes)
+		}
 	}
 
 	// Verify Redis connectivity before subscribing
@@ -96,6 +160,21 @@ func (s *Subscriber) Start() error {
 		s.log.Info("Redis connection verified for event subscriber")
 	}
 
+	if s.config.Transport == TransportStreams {
+		group := s.group()
+		for _, stream := range channels {
+			if err := s.rdb.XGroupCreateMkStream(s.ctx, stream, group, "0").Err(); err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+				s.log.Errorf("Failed to create consumer group %s on stream %s: %v", group, stream, err)
+			}
+		}
+
+		s.log.Infof("Starting event subscriber for streams %v as consumer %s of group %s", channels, s.consumer, group)
+
+		s.wg.Add(1)
+		go s.consume(channels)
+		return nil
+	}
+
 	s.log.Infof("Starting event subscriber for channels: %v", channels)
 
 	pubsub := s.rdb.Subscribe(s.ctx, channels...)
@@ -145,37 +224,272 @@ func (s *Subscriber) listen(pubsub *redis.PubSub) {
 	}
 }
 
+// consume reads the streams as a consumer of the group, and reclaims the entries other consumers
+// left unacknowledged for longer than the claim idle time, e.g. because they stopped while
+// handling them.
+func (s *Subscriber) consume(streams []string) {
+	defer s.wg.Done()
+
+	group := s.group()
+	args := &redis.XReadGroupArgs{
+		Group:    group,
+		Consumer: s.consumer,
+		Streams:  make([]string, 0, 2*len(streams)),
+		Count:    streamReadCount,
+		Block:    streamReadBlock,
+	}
+	args.Streams = append(args.Streams, streams...)
+	for range streams {
+		args.Streams = append(args.Streams, ">")
+	}
+
+	var lastReclaim time.Time
+	for {
+		if s.ctx.Err() != nil {
+			s.log.Info("Event subscriber stopped")
+			return
+		}
+
+		if time.Since(lastReclaim) >= s.claimIdle {
+			for _, stream := range streams {
+				s.reclaim(stream)
+			}
+			lastReclaim = time.Now()
+		}
+
+		res, err := s.rdb.XReadGroup(s.ctx, args).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) || s.ctx.Err() != nil {
+				continue
+			}
+			s.log.Errorf("Failed to read streams %v: %v", streams, err)
+			select {
+			case <-s.ctx.Done():
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+
+		for _, stream := range res {
+			for _, msg := range stream.Messages {
+				s.handleEntry(stream.Stream, msg)
+			}
+		}
+	}
+}
+
+// reclaim takes over and handles the entries of the stream that have been pending with another
+// consumer for longer than the claim idle time. Entries delivered more than max deliveries times
+// are moved to the dead-letter stream instead of being handled again.
+func (s *Subscriber) reclaim(stream string) {
+	start := "0-0"
+	for {
+		msgs, next, err := s.rdb.XAutoClaim(s.ctx, &redis.XAutoClaimArgs{
+			Stream:   stream,
+			Group:    s.group(),
+			Consumer: s.consumer,
+			MinIdle:  s.claimIdle,
+			Start:    start,
+			Count:    streamReadCount,
+		}).Result()
+		if err != nil {
+			if s.ctx.Err() == nil {
+				s.log.Errorf("Failed to reclaim pending entries of stream %s: %v", stream, err)
+			}
+			return
+		}
+
+		if len(msgs) > 0 {
+			s.log.Infof("Reclaimed %d pending entries of stream %s", len(msgs), stream)
+		}
+		deliveries := s.deliveryCounts(stream, msgs)
+		for _, msg := range msgs {
+			if deliveries[msg.ID] > s.maxDeliveries {
+				s.deadLetter(stream, msg, deliveries[msg.ID])
+				continue
+			}
+			s.handleEntry(stream, msg)
+		}
+
+		if next == "" || next == "0-0" {
+			return
+		}
+		start = next
+	}
+}
+
+// deliveryCounts returns how many times each of the claimed entries of the stream has been
+// delivered, including the claim. Entries whose count cannot be read are missing, and thus
+// handled again.
+func (s *Subscriber) deliveryCounts(stream string, msgs []redis.XMessage) map[string]int64 {
+	counts := make(map[string]int64, len(msgs))
+	if len(msgs) == 0 {
+		return counts
+	}
+
+	pending, err := s.rdb.XPendingExt(s.ctx, &redis.XPendingExtArgs{
+		Stream:   stream,
+		Group:    s.group(),
+		Start:    msgs[0].ID,
+		End:      msgs[len(msgs)-1].ID,
+		Count:    int64(len(msgs)),
+		Consumer: s.consumer,
+	}).Result()
+	if err != nil {
+		if s.ctx.Err() == nil {
+			s.log.Errorf("Failed to read delivery counts of stream %s: %v", stream, err)
+		}
+		return counts
+	}
+	for _, p := range pending {
+		counts[p.ID] = p.RetryCount
+	}
+	return counts
+}
+
+// deadLetter moves a stream entry whose handling kept failing to the dead-letter stream, with
+// where it came from and how many times it was delivered, and acknowledges it. The entry stays
+// pending if it cannot be moved.
+func (s *Subscriber) deadLetter(stream string, msg redis.XMessage, deliveries int64) {
+	payload, _ := msg.Values[streamPayloadField].(string)
+	if err := s.rdb.XAdd(s.ctx, &redis.XAddArgs{
+		Stream: stream + deadLetterSuffix,
+		Values: map[string]interface{}{
+			streamPayloadField: payload,
+			"stream":           stream,
+			"entry_id":         msg.ID,
+			"deliveries":       deliveries,
+		},
+	}).Err(); err != nil {
+		s.log.Errorf("Failed to move stream entry %s of %s to the dead-letter stream: %v", msg.ID, stream, err)
+		return
+	}
+
+	s.log.Errorf("Stream entry %s of %s failed %d deliveries, moved it to %s%s", msg.ID, stream, deliveries, stream, deadLetterSuffix)
+	if err := s.rdb.XAck(s.ctx, stream, s.group(), msg.ID).Err(); err != nil {
+		s.log.Errorf("Failed to acknowledge stream entry %s of %s: %v", msg.ID, stream, err)
+	}
+}
+
+// handleEntry handles a stream entry and acknowledges it once handled. Entries whose handling
+// failed stay pending, to be reclaimed and handled again up to max deliveries times.
+func (s *Subscriber) handleEntry(stream string, msg redis.XMessage) {
+	payload, _ := msg.Values[streamPayloadField].(string)
+	if payload == "" {
+		s.log.Errorf("Stream entry %s of %s has no %s field, dropping it", msg.ID, stream, streamPayloadField)
+	} else if err := s.handleEvent(stream, payload); err != nil {
+		s.log.Errorf("Failed to handle stream entry %s of %s, leaving it pending: %v", msg.ID, stream, err)
+		return
+	}
+
+	if err := s.rdb.XAck(s.ctx, stream, s.group(), msg.ID).Err(); err != nil {
+		s.log.Errorf("Failed to acknowledge stream entry %s of %s: %v", msg.ID, stream, err)
+	}
+}
+
 // handleMessage processes a pub/sub message
 func (s *Subscriber) handleMessage(msg *redis.Message) {
 	s.log.Infof("Received event on channel %s", msg.Channel)
 
+	if err := s.handleEvent(msg.Channel, msg.Payload); err != nil {
+		s.log.Errorf("Failed to handle event on channel %s: %v", msg.Channel, err)
+	}
+}
+
+// handleEvent handles an event received on the channel or stream of the name. Events that have
+// been handled before are skipped. An error means that handling the event may succeed when
+// retried; events that can never be handled, e.g. malformed ones, are logged and dropped.
+func (s *Subscriber) handleEvent(name string, payload string) error {
 	var signingEvent SigningEvent
-	if err := json.Unmarshal([]byte(msg.Payload), &signingEvent); err != nil {
+	if err := json.Unmarshal([]byte(payload), &signingEvent); err != nil {
 		s.log.Errorf("Failed to unmarshal signing event: %v", err)
-		return
+		return nil
 	}
 
-	// Extract event type from channel name
-	prefix := s.config.TopicPrefix
-	if prefix == "" {
-		prefix = "signing"
-	}
-	var eventType string
-	if len(msg.Channel) > len(prefix)+1 {
-		eventType = msg.Channel[len(prefix)+1:]
+	if signingEvent.ID != "" {
+		processed, err := s.processedRepo.IsProcessed(s.ctx, signingEvent.ID)
+		if err != nil {
+			return err
+		}
+		if processed {
+			s.log.Infof("Event %s on %s has already been handled, ignoring", signingEvent.ID, name)
+			return nil
+		}
 	}
 
+	eventType := EventTypeOf(s.config.TopicPrefix, name)
 	switch eventType {
-	case "submission.completed":
+	case SubmissionCompleted:
 		var data SubmissionCompletedData
 		if err := json.Unmarshal(signingEvent.Data, &data); err != nil {
 			s.log.Errorf("Failed to parse submission.completed data: %v", err)
-			return
+			return nil
 		}
 		if err := s.handler.HandleSigningCompleted(s.ctx, &data); err != nil {
-			s.log.Errorf("Failed to handle signing completed event: %v", err)
+			return fmt.Errorf("handle signing completed event: %w", err)
+		}
+	case SubmissionDeclined, SubmissionExpired, SubmissionCancelled:
+		var data SubmissionEndedData
+		if err := json.Unmarshal(signingEvent.Data, &data); err != nil {
+			s.log.Errorf("Failed to parse %s data: %v", eventType, err)
+			return nil
+		}
+		if err := s.handler.HandleSigningEnded(s.ctx, signingOutcomeOf(eventType), &data); err != nil {
+			return fmt.Errorf("handle %s event: %w", eventType, err)
 		}
 	default:
 		s.log.Infof("Ignoring unknown event type: %s", eventType)
+		return nil
+	}
+
+	if signingEvent.ID != "" {
+		// Handling is idempotent, so a failure to record it only risks handling the event again
+		if err := s.processedRepo.MarkProcessed(s.ctx, signingEvent.ID, eventType, signingEvent.Source, signingEvent.TenantID); err != nil {
+			s.log.Warnf("Failed to record event %s as handled: %v", signingEvent.ID, err)
+		}
+	}
+	return nil
+}
+
+// ChannelName returns the channel, or stream, the events of the type are received on, with the
+// topic prefix of the configuration, "signing" when empty.
+func ChannelName(prefix, eventType string) string {
+	if prefix == "" {
+		prefix = defaultTopicPrefix
+	}
+	return prefix + "." + eventType
+}
+
+// EventTypeOf returns the type of the events received on the channel or stream of the name, i.e.
+// the name without the topic prefix. Returns "" for names without the prefix.
+func EventTypeOf(prefix, name string) string {
+	if prefix == "" {
+		prefix = defaultTopicPrefix
+	}
+	eventType, ok := strings.CutPrefix(name, prefix+".")
+	if !ok {
+		return ""
+	}
+	return eventType
+}
+
+// signingOutcomeOf returns the signing outcome of an event about a submission that ended without
+// being completed
+func signingOutcomeOf(eventType string) leaverequest.SigningOutcome {
+	switch eventType {
+	case SubmissionDeclined:
+		return leaverequest.SigningOutcomeDeclined
+	case SubmissionExpired:
+		return leaverequest.SigningOutcomeExpired
+	default:
+		return leaverequest.SigningOutcomeCancelled
+	}
+}
+
+// group returns the consumer group of the streams transport
+func (s *Subscriber) group() string {
+	if s.config.ConsumerGroup != "" {
+		return s.config.ConsumerGroup
 	}
+	return defaultConsumerGroup
 }
@@ -0,0 +1,90 @@
+package event
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	_ "github.com/go-kratos/kratos/v2/encoding/yaml"
+
+	"github.com/go-tangra/go-tangra-hr/internal/conf"
+)
+
+func TestEventTypeOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		in     string
+		want   string
+	}{
+		{"completed", "signing", "signing.submission.completed", SubmissionCompleted},
+		{"declined", "signing", "signing.submission.declined", SubmissionDeclined},
+		{"default prefix", "", "signing.submission.expired", SubmissionExpired},
+		{"other prefix", "paperless", "paperless.submission.cancelled", SubmissionCancelled},
+		{"wrong prefix", "signing", "paperless.submission.completed", ""},
+		{"prefix only", "signing", "signing", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EventTypeOf(tt.prefix, tt.in); got != tt.want {
+				t.Errorf("EventTypeOf(%q, %q) = %q, want %q", tt.prefix, tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChannelNameRoundTrip(t *testing.T) {
+	for _, prefix := range []string{"", "signing", "paperless"} {
+		for _, eventType := range HandledEventTypes {
+			if got := EventTypeOf(prefix, ChannelName(prefix, eventType)); got != eventType {
+				t.Errorf("prefix %q: event type of the channel of %q is %q", prefix, eventType, got)
+			}
+		}
+	}
+}
+
+// TestShippedConfigEventsAreHandled checks that each event the shipped configuration subscribes
+// to reaches a handler
+func TestShippedConfigEventsAreHandled(t *testing.T) {
+	c := config.New(config.WithSource(file.NewSource("../../configs/server.yaml")))
+	defer c.Close()
+	if err := c.Load(); err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+
+	var cfg conf.EventConfig
+	if err := c.Value("hr.events").Scan(&cfg); err != nil {
+		t.Fatalf("scan hr.events: %v", err)
+	}
+	if len(cfg.SubscribeEvents) == 0 {
+		t.Fatal("no subscribed events")
+	}
+
+	var subscribed []string
+	for _, event := range cfg.SubscribeEvents {
+		eventType := EventTypeOf(cfg.TopicPrefix, ChannelName(cfg.TopicPrefix, event))
+		if !slices.Contains(HandledEventTypes, eventType) {
+			t.Errorf("subscribed event %q is received as %q, which is not handled", event, eventType)
+		}
+		subscribed = append(subscribed, eventType)
+	}
+	for _, eventType := range HandledEventTypes {
+		if !slices.Contains(subscribed, eventType) {
+			t.Errorf("handled event %q is not subscribed to", eventType)
+		}
+	}
+}
+
+func TestSigningOutcomeOf(t *testing.T) {
+	tests := map[string]string{
+		SubmissionDeclined:  "declined",
+		SubmissionExpired:   "expired",
+		SubmissionCancelled: "cancelled",
+	}
+	for eventType, want := range tests {
+		if got := signingOutcomeOf(eventType).String(); got != want {
+			t.Errorf("signingOutcomeOf(%q) = %q, want %q", eventType, got, want)
+		}
+	}
+}
@@ -22,3 +22,118 @@ type SubmissionCompletedData struct {
 	SignedDocumentKey string `json:"signed_document_key"`
 	TenantID         uint32 `json:"tenant_id"`
 }
+
+// SubmissionCompleted is the type of the signing service events about completed submissions
+const SubmissionCompleted = "submission.completed"
+
+// Types of the signing service events about submissions that ended without being completed
+const (
+	SubmissionDeclined  = "submission.declined"
+	SubmissionExpired   = "submission.expired"
+	SubmissionCancelled = "submission.cancelled"
+)
+
+// SubmissionEndedData is the data payload for submission.declined, submission.expired and
+// submission.cancelled events
+type SubmissionEndedData struct {
+	SubmissionID string `json:"submission_id"`
+	TemplateID   string `json:"template_id"`
+	TenantID     uint32 `json:"tenant_id"`
+	// Reason is the decline reason given by the signer, or why the submission was cancelled
+	Reason string `json:"reason"`
+}
+
+// SigningEndedNotice is the payload of the outbox messages that tell the requester and the
+// reviewer of a leave request, or of a change to its dates, that its signing ended without all
+// signatures
+type SigningEndedNotice struct {
+	LeaveRequestID string `json:"leave_request_id"`
+	// AmendmentID is set when the submission was for new dates of the request
+	AmendmentID string `json:"amendment_id,omitempty"`
+	// Outcome is declined, expired or cancelled
+	Outcome string `json:"outcome"`
+	Reason  string `json:"reason,omitempty"`
+	// Status is the status the request, or amendment, was moved to
+	Status     string `json:"status"`
+	ReviewerID uint32 `json:"reviewer_id,omitempty"`
+}
+
+// Types of the events the HR service publishes
+const (
+	LeaveCreated     = "leave.created"
+	LeaveApproved    = "leave.approved"
+	LeaveRejected    = "leave.rejected"
+	LeaveCancelled   = "leave.cancelled"
+	LeaveRevoked     = "leave.revoked"
+	// LeaveReopened is published when a request awaiting signatures awaits a decision again, as
+	// its signing expired or was cancelled
+	LeaveReopened    = "leave.reopened"
+	AllowanceChanged = "allowance.changed"
+)
+
+// PublishedTypes are the types of the events the HR service publishes
+var PublishedTypes = []string{LeaveCreated, LeaveApproved, LeaveRejected, LeaveCancelled, LeaveRevoked, LeaveReopened, AllowanceChanged}
+
+// EventVersion is the version of the data payloads of the events the HR service publishes; it is
+// raised when a payload changes incompatibly
+const EventVersion = 1
+
+// Event is the envelope of the events the HR service publishes: that of SigningEvent, with the
+// version of the data payload
+type Event struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	Version   int             `json:"version"`
+	Source    string          `json:"source"`
+	Timestamp time.Time       `json:"timestamp"`
+	TenantID  uint32          `json:"tenant_id"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// LeaveEventData is the data payload of the leave.* events, the request after the transition
+type LeaveEventData struct {
+	LeaveRequestID string     `json:"leave_request_id"`
+	UserID         uint32     `json:"user_id"`
+	UserName       string     `json:"user_name,omitempty"`
+	OrgUnitName    string     `json:"org_unit_name,omitempty"`
+	AbsenceTypeID  string     `json:"absence_type_id"`
+	StartDate      time.Time  `json:"start_date"`
+	EndDate        time.Time  `json:"end_date"`
+	StartDayPart   string     `json:"start_day_part"`
+	EndDayPart     string     `json:"end_day_part"`
+	Days           float64    `json:"days"`
+	Hours          float64    `json:"hours,omitempty"`
+	Status         string     `json:"status"`
+	SeriesID       string     `json:"series_id,omitempty"`
+	ReviewedBy     uint32     `json:"reviewed_by,omitempty"`
+	ReviewNotes    string     `json:"review_notes,omitempty"`
+	ReviewedAt     *time.Time `json:"reviewed_at,omitempty"`
+	// ActorID is the user whose action caused the transition; 0 for the system
+	ActorID uint32 `json:"actor_id"`
+}
+
+// AllowanceChangedData is the data payload of allowance.changed events, the allowance after the
+// change
+type AllowanceChangedData struct {
+	AllowanceID     string  `json:"allowance_id"`
+	UserID          uint32  `json:"user_id"`
+	AbsenceTypeID   string  `json:"absence_type_id,omitempty"`
+	AllowancePoolID string  `json:"allowance_pool_id,omitempty"`
+	Year            int     `json:"year"`
+	TotalDays       float64 `json:"total_days"`
+	CarriedOver     float64 `json:"carried_over"`
+	UsedDays        float64 `json:"used_days"`
+	RemainingDays   float64 `json:"remaining_days"`
+	// LeaveRequestID is the leave request whose transition changed the allowance
+	LeaveRequestID string `json:"leave_request_id,omitempty"`
+}
+
+// AllowancesChangedMessage is the payload of the outbox messages that publish allowance.changed
+// for allowances a leave request transition, or a change of the allowances themselves, changed.
+// The allowances are read when the message is delivered, so that the events carry their balances
+// as committed.
+type AllowancesChangedMessage struct {
+	// LeaveRequestID is empty for changes other than by a leave request
+	LeaveRequestID string   `json:"leave_request_id"`
+	AllowanceIDs   []string `json:"allowance_ids"`
+}
@@ -0,0 +1,162 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-hr/internal/conf"
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/event"
+	"github.com/go-tangra/go-tangra-hr/internal/workday"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+const defaultAccrualInterval = time.Hour
+
+// AccrualJob periodically posts the days users have earned under accrual policies to their
+// allowances. Each run tops allowances up to the days accrued so far, so runs are idempotent.
+type AccrualJob struct {
+	log           *log.Helper
+	allowanceRepo *data.LeaveAllowanceRepo
+	config        *conf.AccrualConfig
+	interval      time.Duration
+	ctx           context.Context
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+	running       bool
+	mu            sync.Mutex
+}
+
+// NewAccrualJob creates a new accrual job
+func NewAccrualJob(ctx *bootstrap.Context, allowanceRepo *data.LeaveAllowanceRepo) *AccrualJob {
+	var accrualCfg *conf.AccrualConfig
+	if cfg, ok := ctx.GetCustomConfig("hr"); ok && cfg != nil {
+		if hrCfg, ok := cfg.(*conf.HR); ok && hrCfg.Accrual != nil {
+			accrualCfg = hrCfg.Accrual
+		}
+	}
+
+	// Default config if not set
+	if accrualCfg == nil {
+		accrualCfg = &conf.AccrualConfig{Enabled: true}
+	}
+
+	l := ctx.NewLoggerHelper("hr/job/accrual")
+
+	interval := defaultAccrualInterval
+	if accrualCfg.Interval != "" {
+		d, err := time.ParseDuration(accrualCfg.Interval)
+		if err != nil || d <= 0 {
+			l.Warnf("Invalid accrual interval %q, using %s", accrualCfg.Interval, defaultAccrualInterval)
+		} else {
+			interval = d
+		}
+	}
+
+	return &AccrualJob{
+		log:           l,
+		allowanceRepo: allowanceRepo,
+		config:        accrualCfg,
+		interval:      interval,
+	}
+}
+
+// Start starts the accrual job
+func (j *AccrualJob) Start() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.running {
+		return nil
+	}
+
+	if !j.config.Enabled {
+		j.log.Info("Accrual job is disabled")
+		return nil
+	}
+
+	baseCtx := appViewer.NewSystemViewerContext(context.Background())
+	j.ctx, j.cancel = context.WithCancel(baseCtx)
+	j.running = true
+
+	j.log.Infof("Starting accrual job, running every %s", j.interval)
+
+	j.wg.Add(1)
+	go j.loop()
+
+	return nil
+}
+
+// Stop stops the accrual job
+func (j *AccrualJob) Stop() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.running {
+		return nil
+	}
+
+	j.log.Info("Stopping accrual job")
+	j.cancel()
+	j.wg.Wait()
+	j.running = false
+
+	return nil
+}
+
+func (j *AccrualJob) loop() {
+	defer j.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.Run(j.ctx, time.Now())
+
+		select {
+		case <-j.ctx.Done():
+			j.log.Info("Accrual job stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run posts the days accrued by now to every allowance with an accrual policy. The previous
+// year is included so that days credited at year end are posted after the turn of the year.
+func (j *AccrualJob) Run(ctx context.Context, now time.Time) {
+	allowances, err := j.allowanceRepo.ListByYears(ctx, now.Year()-1, now.Year())
+	if err != nil {
+		j.log.Errorf("Failed to list allowances for accrual: %v", err)
+		return
+	}
+
+	posted := 0
+	for _, a := range allowances {
+		policy, start := data.AccrualFor(a)
+		if !policy.Enabled() {
+			continue
+		}
+
+		accrued := policy.Accrued(a.Year, start, now)
+		ref := data.LedgerRef{Note: fmt.Sprintf("accrual to %s", now.Format(workday.DateLayout))}
+		days, err := j.allowanceRepo.PostAccrual(ctx, a.ID, accrued, ref, event.AllowanceMessages)
+		if err != nil {
+			j.log.Errorf("Failed to post accrual to allowance %s: %v", a.ID, err)
+			continue
+		}
+		if days > 0 {
+			posted++
+		}
+	}
+
+	if posted > 0 {
+		j.log.Infof("Posted accrued days to %d allowances", posted)
+	}
+}
@@ -0,0 +1,134 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-hr/internal/conf"
+	"github.com/go-tangra/go-tangra-hr/internal/service"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+const defaultEscalationInterval = time.Hour
+
+// EscalationJob periodically follows up leave requests that have awaited a decision or
+// signatures for longer than their absence type's escalation policy allows. Requests record how
+// far they have been followed up, so each reminder and escalation is sent once.
+type EscalationJob struct {
+	log          *log.Helper
+	leaveService *service.LeaveService
+	config       *conf.EscalationConfig
+	interval     time.Duration
+	ctx          context.Context
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	running      bool
+	mu           sync.Mutex
+}
+
+// NewEscalationJob creates a new escalation job
+func NewEscalationJob(ctx *bootstrap.Context, leaveService *service.LeaveService) *EscalationJob {
+	var escalationCfg *conf.EscalationConfig
+	if cfg, ok := ctx.GetCustomConfig("hr"); ok && cfg != nil {
+		if hrCfg, ok := cfg.(*conf.HR); ok && hrCfg.Escalation != nil {
+			escalationCfg = hrCfg.Escalation
+		}
+	}
+
+	// Default config if not set
+	if escalationCfg == nil {
+		escalationCfg = &conf.EscalationConfig{Enabled: true}
+	}
+
+	l := ctx.NewLoggerHelper("hr/job/escalation")
+
+	interval := defaultEscalationInterval
+	if escalationCfg.Interval != "" {
+		d, err := time.ParseDuration(escalationCfg.Interval)
+		if err != nil || d <= 0 {
+			l.Warnf("Invalid escalation interval %q, using %s", escalationCfg.Interval, defaultEscalationInterval)
+		} else {
+			interval = d
+		}
+	}
+
+	return &EscalationJob{
+		log:          l,
+		leaveService: leaveService,
+		config:       escalationCfg,
+		interval:     interval,
+	}
+}
+
+// Start starts the escalation job
+func (j *EscalationJob) Start() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.running {
+		return nil
+	}
+
+	if !j.config.Enabled {
+		j.log.Info("Escalation job is disabled")
+		return nil
+	}
+
+	baseCtx := appViewer.NewSystemViewerContext(context.Background())
+	j.ctx, j.cancel = context.WithCancel(baseCtx)
+	j.running = true
+
+	j.log.Infof("Starting escalation job, running every %s", j.interval)
+
+	j.wg.Add(1)
+	go j.loop()
+
+	return nil
+}
+
+// Stop stops the escalation job
+func (j *EscalationJob) Stop() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.running {
+		return nil
+	}
+
+	j.log.Info("Stopping escalation job")
+	j.cancel()
+	j.wg.Wait()
+	j.running = false
+
+	return nil
+}
+
+func (j *EscalationJob) loop() {
+	defer j.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.Run(j.ctx, time.Now())
+
+		select {
+		case <-j.ctx.Done():
+			j.log.Info("Escalation job stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run reminds, escalates and automatically decides the requests whose wait is due for it.
+func (j *EscalationJob) Run(ctx context.Context, now time.Time) {
+	if n := j.leaveService.EscalateStaleRequests(ctx, now); n > 0 {
+		j.log.Infof("Followed up %d stale leave requests", n)
+	}
+}
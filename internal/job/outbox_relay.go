@@ -0,0 +1,220 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-hr/internal/conf"
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/service"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+const (
+	defaultOutboxInterval    = 10 * time.Second
+	defaultOutboxBatchSize   = 50
+	defaultOutboxMaxAttempts = 8
+
+	// outboxLease is how long a relay holds the messages it claimed; a message whose delivery
+	// outlasts it may be delivered twice
+	outboxLease = 5 * time.Minute
+	// outboxBaseBackoff is the wait after the first failed delivery, doubled after each further
+	// one up to outboxMaxBackoff
+	outboxBaseBackoff = 30 * time.Second
+	outboxMaxBackoff  = time.Hour
+)
+
+// OutboxRelayJob periodically delivers the outbox: the events, emails and signing cancellations
+// written alongside leave request transitions. Failed deliveries are retried with exponential
+// backoff until the message runs out of attempts and becomes dead, from where an admin may
+// replay it.
+type OutboxRelayJob struct {
+	log          *log.Helper
+	outboxRepo   *data.OutboxRepo
+	leaveService *service.LeaveService
+	config       *conf.OutboxConfig
+	interval     time.Duration
+	batchSize    int
+	maxAttempts  int
+	ctx          context.Context
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+	running      bool
+	mu           sync.Mutex
+}
+
+// NewOutboxRelayJob creates a new outbox relay job
+func NewOutboxRelayJob(ctx *bootstrap.Context, outboxRepo *data.OutboxRepo, leaveService *service.LeaveService) *OutboxRelayJob {
+	var outboxCfg *conf.OutboxConfig
+	if cfg, ok := ctx.GetCustomConfig("hr"); ok && cfg != nil {
+		if hrCfg, ok := cfg.(*conf.HR); ok && hrCfg.Outbox != nil {
+			outboxCfg = hrCfg.Outbox
+		}
+	}
+
+	// Default config if not set
+	if outboxCfg == nil {
+		outboxCfg = &conf.OutboxConfig{Enabled: true}
+	}
+
+	l := ctx.NewLoggerHelper("hr/job/outbox")
+
+	interval := defaultOutboxInterval
+	if outboxCfg.Interval != "" {
+		d, err := time.ParseDuration(outboxCfg.Interval)
+		if err != nil || d <= 0 {
+			l.Warnf("Invalid outbox interval %q, using %s", outboxCfg.Interval, defaultOutboxInterval)
+		} else {
+			interval = d
+		}
+	}
+
+	batchSize := defaultOutboxBatchSize
+	if outboxCfg.BatchSize > 0 {
+		batchSize = int(outboxCfg.BatchSize)
+	}
+	maxAttempts := defaultOutboxMaxAttempts
+	if outboxCfg.MaxAttempts > 0 {
+		maxAttempts = int(outboxCfg.MaxAttempts)
+	}
+
+	return &OutboxRelayJob{
+		log:          l,
+		outboxRepo:   outboxRepo,
+		leaveService: leaveService,
+		config:       outboxCfg,
+		interval:     interval,
+		batchSize:    batchSize,
+		maxAttempts:  maxAttempts,
+	}
+}
+
+// Start starts the outbox relay job
+func (j *OutboxRelayJob) Start() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.running {
+		return nil
+	}
+
+	if !j.config.Enabled {
+		j.log.Info("Outbox relay job is disabled")
+		return nil
+	}
+
+	baseCtx := appViewer.NewSystemViewerContext(context.Background())
+	j.ctx, j.cancel = context.WithCancel(baseCtx)
+	j.running = true
+
+	j.log.Infof("Starting outbox relay job, running every %s", j.interval)
+
+	j.wg.Add(1)
+	go j.loop()
+
+	return nil
+}
+
+// Stop stops the outbox relay job
+func (j *OutboxRelayJob) Stop() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.running {
+		return nil
+	}
+
+	j.log.Info("Stopping outbox relay job")
+	j.cancel()
+	j.wg.Wait()
+	j.running = false
+
+	return nil
+}
+
+func (j *OutboxRelayJob) loop() {
+	defer j.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.Run(j.ctx, time.Now())
+
+		select {
+		case <-j.ctx.Done():
+			j.log.Info("Outbox relay job stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run delivers the outbox messages due by now. A full batch is followed by the next one right
+// away, so that a backlog drains without waiting for the next run.
+func (j *OutboxRelayJob) Run(ctx context.Context, now time.Time) {
+	delivered, failed := 0, 0
+	for ctx.Err() == nil {
+		messages, err := j.outboxRepo.Claim(ctx, now, j.batchSize, outboxLease)
+		if err != nil {
+			j.log.Errorf("Failed to claim outbox messages: %v", err)
+			break
+		}
+
+		for _, m := range messages {
+			if err := j.leaveService.DeliverOutboxMessage(ctx, m); err != nil {
+				failed++
+				j.fail(ctx, m.ID, m.Kind, m.Attempts+1, err)
+				continue
+			}
+			if err := j.outboxRepo.MarkDelivered(ctx, m.ID); err != nil {
+				j.log.Errorf("Failed to mark outbox message %s delivered: %v", m.ID, err)
+				continue
+			}
+			delivered++
+		}
+
+		if len(messages) < j.batchSize {
+			break
+		}
+	}
+
+	if delivered > 0 || failed > 0 {
+		j.log.Infof("Delivered %d outbox messages, %d failed", delivered, failed)
+	}
+}
+
+// fail records a failed delivery, scheduling the next attempt or giving the message up as dead
+// once it has failed maxAttempts times.
+func (j *OutboxRelayJob) fail(ctx context.Context, id, kind string, attempts int, deliveryErr error) {
+	var next *time.Time
+	if attempts < j.maxAttempts {
+		at := time.Now().Add(outboxBackoff(attempts))
+		next = &at
+		j.log.Warnf("Delivery %d of outbox message %s (%s) failed, retrying at %s: %v", attempts, id, kind, at.Format(time.RFC3339), deliveryErr)
+	} else {
+		j.log.Errorf("Outbox message %s (%s) is dead after %d failed deliveries: %v", id, kind, attempts, deliveryErr)
+	}
+
+	if err := j.outboxRepo.MarkFailed(ctx, id, deliveryErr.Error(), next); err != nil {
+		j.log.Errorf("Failed to record failed delivery of outbox message %s: %v", id, err)
+	}
+}
+
+// outboxBackoff returns the wait before the next delivery of a message that failed the given
+// number of times.
+func outboxBackoff(attempts int) time.Duration {
+	d := outboxBaseBackoff
+	for i := 1; i < attempts && d < outboxMaxBackoff; i++ {
+		d *= 2
+	}
+	if d > outboxMaxBackoff {
+		d = outboxMaxBackoff
+	}
+	return d
+}
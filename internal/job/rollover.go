@@ -0,0 +1,190 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-hr/internal/conf"
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/event"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+const defaultRolloverInterval = 6 * time.Hour
+
+// RolloverJob rolls the previous year's allowances over into the current year during January,
+// and lapses carried-over days once their last day of use has passed. Allowances that have
+// already been rolled over are skipped, so runs are idempotent.
+type RolloverJob struct {
+	log           *log.Helper
+	allowanceRepo *data.LeaveAllowanceRepo
+	config        *conf.RolloverConfig
+	interval      time.Duration
+	ctx           context.Context
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+	running       bool
+	mu            sync.Mutex
+}
+
+// NewRolloverJob creates a new rollover job
+func NewRolloverJob(ctx *bootstrap.Context, allowanceRepo *data.LeaveAllowanceRepo) *RolloverJob {
+	var rolloverCfg *conf.RolloverConfig
+	if cfg, ok := ctx.GetCustomConfig("hr"); ok && cfg != nil {
+		if hrCfg, ok := cfg.(*conf.HR); ok && hrCfg.Rollover != nil {
+			rolloverCfg = hrCfg.Rollover
+		}
+	}
+
+	// Default config if not set
+	if rolloverCfg == nil {
+		rolloverCfg = &conf.RolloverConfig{Enabled: true}
+	}
+
+	l := ctx.NewLoggerHelper("hr/job/rollover")
+
+	interval := defaultRolloverInterval
+	if rolloverCfg.Interval != "" {
+		d, err := time.ParseDuration(rolloverCfg.Interval)
+		if err != nil || d <= 0 {
+			l.Warnf("Invalid rollover interval %q, using %s", rolloverCfg.Interval, defaultRolloverInterval)
+		} else {
+			interval = d
+		}
+	}
+
+	return &RolloverJob{
+		log:           l,
+		allowanceRepo: allowanceRepo,
+		config:        rolloverCfg,
+		interval:      interval,
+	}
+}
+
+// Start starts the rollover job
+func (j *RolloverJob) Start() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.running {
+		return nil
+	}
+
+	if !j.config.Enabled {
+		j.log.Info("Rollover job is disabled")
+		return nil
+	}
+
+	baseCtx := appViewer.NewSystemViewerContext(context.Background())
+	j.ctx, j.cancel = context.WithCancel(baseCtx)
+	j.running = true
+
+	j.log.Infof("Starting rollover job, running every %s", j.interval)
+
+	j.wg.Add(1)
+	go j.loop()
+
+	return nil
+}
+
+// Stop stops the rollover job
+func (j *RolloverJob) Stop() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.running {
+		return nil
+	}
+
+	j.log.Info("Stopping rollover job")
+	j.cancel()
+	j.wg.Wait()
+	j.running = false
+
+	return nil
+}
+
+func (j *RolloverJob) loop() {
+	defer j.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.Run(j.ctx, time.Now())
+
+		select {
+		case <-j.ctx.Done():
+			j.log.Info("Rollover job stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run rolls the previous year over if it is January, then lapses expired carried-over days.
+// Outside January allowances are rolled over on request only, so that allowances removed from
+// the new year on purpose are not recreated.
+func (j *RolloverJob) Run(ctx context.Context, now time.Time) {
+	if now.Month() == time.January {
+		j.rollover(ctx, now)
+	}
+	j.expire(ctx, now)
+}
+
+func (j *RolloverJob) rollover(ctx context.Context, now time.Time) {
+	fromYear := now.Year() - 1
+	items, err := j.allowanceRepo.PlanRollover(ctx, fromYear, nil, now)
+	if err != nil {
+		j.log.Errorf("Failed to plan rollover from %d: %v", fromYear, err)
+		return
+	}
+
+	ref := data.LedgerRef{Note: fmt.Sprintf("rollover from %d", fromYear)}
+	rolled := 0
+	for _, item := range items {
+		if item.SkipReason != "" {
+			continue
+		}
+		if _, err := j.allowanceRepo.ApplyRollover(ctx, item, ref, event.AllowanceMessages); err != nil {
+			j.log.Errorf("Failed to roll over allowance %s: %v", item.Source.ID, err)
+			continue
+		}
+		rolled++
+	}
+
+	if rolled > 0 {
+		j.log.Infof("Rolled %d allowances over from %d", rolled, fromYear)
+	}
+}
+
+func (j *RolloverJob) expire(ctx context.Context, now time.Time) {
+	allowances, err := j.allowanceRepo.ListExpiredCarryOver(ctx, now)
+	if err != nil {
+		j.log.Errorf("Failed to list allowances with expired carry-over: %v", err)
+		return
+	}
+
+	ref := data.LedgerRef{Note: "carried-over days expired"}
+	expired := 0
+	for _, a := range allowances {
+		days, err := j.allowanceRepo.ExpireCarryOver(ctx, a.ID, now, ref, event.AllowanceMessages)
+		if err != nil {
+			j.log.Errorf("Failed to expire carried-over days of allowance %s: %v", a.ID, err)
+			continue
+		}
+		if days > 0 {
+			expired++
+		}
+	}
+
+	if expired > 0 {
+		j.log.Infof("Lapsed expired carried-over days of %d allowances", expired)
+	}
+}
@@ -0,0 +1,225 @@
+package job
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	grpcMD "google.golang.org/grpc/metadata"
+
+	"github.com/go-tangra/go-tangra-hr/internal/client"
+	"github.com/go-tangra/go-tangra-hr/internal/conf"
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/event"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+const (
+	defaultSigningReconcileInterval = 15 * time.Minute
+
+	// processedEventRetention is how long handled events are remembered; streams do not redeliver
+	// entries older than that in practice
+	processedEventRetention = 30 * 24 * time.Hour
+)
+
+// SigningReconcileJob periodically asks the signing service for the state of the submissions of
+// leave requests and amendments still awaiting signatures, and handles those that ended as if
+// their submission.completed, .declined, .expired or .cancelled event had been received. Events
+// lost while the service was down therefore delay the outcome by one interval at most.
+type SigningReconcileJob struct {
+	log              *log.Helper
+	leaveRequestRepo *data.LeaveRequestRepo
+	amendmentRepo    *data.LeaveAmendmentRepo
+	processedRepo    *data.ProcessedEventRepo
+	signingClient    *client.SigningClient
+	handler          *event.Handler
+	config           *conf.SigningReconcileConfig
+	interval         time.Duration
+	ctx              context.Context
+	cancel           context.CancelFunc
+	wg               sync.WaitGroup
+	running          bool
+	mu               sync.Mutex
+}
+
+// NewSigningReconcileJob creates a new signing reconciliation job
+func NewSigningReconcileJob(ctx *bootstrap.Context, leaveRequestRepo *data.LeaveRequestRepo, amendmentRepo *data.LeaveAmendmentRepo, processedRepo *data.ProcessedEventRepo, signingClient *client.SigningClient, handler *event.Handler) *SigningReconcileJob {
+	var reconcileCfg *conf.SigningReconcileConfig
+	if cfg, ok := ctx.GetCustomConfig("hr"); ok && cfg != nil {
+		if hrCfg, ok := cfg.(*conf.HR); ok && hrCfg.SigningReconcile != nil {
+			reconcileCfg = hrCfg.SigningReconcile
+		}
+	}
+
+	// Default config if not set
+	if reconcileCfg == nil {
+		reconcileCfg = &conf.SigningReconcileConfig{Enabled: true}
+	}
+
+	l := ctx.NewLoggerHelper("hr/job/signing-reconcile")
+
+	interval := defaultSigningReconcileInterval
+	if reconcileCfg.Interval != "" {
+		d, err := time.ParseDuration(reconcileCfg.Interval)
+		if err != nil || d <= 0 {
+			l.Warnf("Invalid signing reconcile interval %q, using %s", reconcileCfg.Interval, defaultSigningReconcileInterval)
+		} else {
+			interval = d
+		}
+	}
+
+	return &SigningReconcileJob{
+		log:              l,
+		leaveRequestRepo: leaveRequestRepo,
+		amendmentRepo:    amendmentRepo,
+		processedRepo:    processedRepo,
+		signingClient:    signingClient,
+		handler:          handler,
+		config:           reconcileCfg,
+		interval:         interval,
+	}
+}
+
+// Start starts the signing reconciliation job
+func (j *SigningReconcileJob) Start() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.running {
+		return nil
+	}
+
+	if !j.config.Enabled {
+		j.log.Info("Signing reconciliation job is disabled")
+		return nil
+	}
+
+	baseCtx := appViewer.NewSystemViewerContext(context.Background())
+	j.ctx, j.cancel = context.WithCancel(baseCtx)
+	j.running = true
+
+	j.log.Infof("Starting signing reconciliation job, running every %s", j.interval)
+
+	j.wg.Add(1)
+	go j.loop()
+
+	return nil
+}
+
+// Stop stops the signing reconciliation job
+func (j *SigningReconcileJob) Stop() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.running {
+		return nil
+	}
+
+	j.log.Info("Stopping signing reconciliation job")
+	j.cancel()
+	j.wg.Wait()
+	j.running = false
+
+	return nil
+}
+
+func (j *SigningReconcileJob) loop() {
+	defer j.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.Run(j.ctx, time.Now())
+
+		select {
+		case <-j.ctx.Done():
+			j.log.Info("Signing reconciliation job stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run handles the submissions awaiting signatures that the signing service reports ended, and
+// forgets the handled events old enough not to be redelivered.
+func (j *SigningReconcileJob) Run(ctx context.Context, now time.Time) {
+	// Submissions of both requests and amendments; the handler tells them apart
+	submissions := make(map[string]uint32)
+
+	requests, err := j.leaveRequestRepo.ListAwaitingSigning(ctx)
+	if err != nil {
+		j.log.Errorf("Failed to list leave requests awaiting signing: %v", err)
+		return
+	}
+	for _, e := range requests {
+		submissions[e.SigningRequestID] = tenantOf(e.TenantID)
+	}
+
+	amendments, err := j.amendmentRepo.ListAwaitingSigning(ctx)
+	if err != nil {
+		j.log.Errorf("Failed to list leave amendments awaiting signing: %v", err)
+		return
+	}
+	for _, a := range amendments {
+		submissions[a.SigningRequestID] = tenantOf(a.TenantID)
+	}
+
+	completed, endedCount := 0, 0
+	for submissionID, tenantID := range submissions {
+		if ctx.Err() != nil {
+			return
+		}
+
+		callCtx := grpcMD.NewOutgoingContext(ctx, grpcMD.Pairs("x-md-global-tenant-id", strconv.FormatUint(uint64(tenantID), 10)))
+		state, err := j.signingClient.GetSubmissionState(callCtx, submissionID)
+		if err != nil {
+			j.log.Errorf("Failed to get state of signing submission %s: %v", submissionID, err)
+			continue
+		}
+
+		switch state {
+		case client.SubmissionCompleted:
+			j.log.Infof("Signing submission %s completed without its event being handled, catching up", submissionID)
+			if err := j.handler.HandleSigningCompleted(ctx, &event.SubmissionCompletedData{SubmissionID: submissionID, TenantID: tenantID}); err != nil {
+				j.log.Errorf("Failed to handle completed signing submission %s: %v", submissionID, err)
+				continue
+			}
+			completed++
+		case client.SubmissionDeclined, client.SubmissionExpired, client.SubmissionCancelled:
+			j.log.Infof("Signing submission %s was %s without its event being handled, catching up", submissionID, state)
+			ended := &event.SubmissionEndedData{SubmissionID: submissionID, TenantID: tenantID}
+			if err := j.handler.HandleSigningEnded(ctx, leaverequest.SigningOutcome(state), ended); err != nil {
+				j.log.Errorf("Failed to handle %s signing submission %s: %v", state, submissionID, err)
+				continue
+			}
+			endedCount++
+		case client.SubmissionPending:
+		default:
+			j.log.Warnf("Signing submission %s is in unknown state %q, leaving it awaiting signing", submissionID, state)
+		}
+	}
+
+	if completed > 0 || endedCount > 0 {
+		j.log.Infof("Caught up on %d completed and %d otherwise ended signing submissions", completed, endedCount)
+	}
+
+	if n, err := j.processedRepo.DeleteBefore(ctx, now.Add(-processedEventRetention)); err != nil {
+		j.log.Errorf("Failed to forget old handled events: %v", err)
+	} else if n > 0 {
+		j.log.Infof("Forgot %d handled events older than %s", n, processedEventRetention)
+	}
+}
+
+// tenantOf returns the tenant of an entity, 0 for none
+func tenantOf(tenantID *uint32) uint32 {
+	if tenantID == nil {
+		return 0
+	}
+	return *tenantID
+}
@@ -0,0 +1,260 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+
+	"github.com/go-tangra/go-tangra-hr/internal/conf"
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/webhook"
+
+	appViewer "github.com/go-tangra/go-tangra-common/viewer"
+)
+
+const (
+	defaultWebhookInterval    = 10 * time.Second
+	defaultWebhookBatchSize   = 50
+	defaultWebhookMaxAttempts = 8
+	defaultWebhookTimeout     = 10 * time.Second
+
+	// webhookLeaseMargin is how much longer than the subscriber timeout a job holds the attempts it
+	// claimed
+	webhookLeaseMargin = time.Minute
+)
+
+// WebhookDeliveryJob periodically makes the attempts due at delivering events to webhook
+// subscriptions. Failed attempts are followed by another one with the backoff of the outbox,
+// until the event has been attempted max attempts times; admins may redeliver it from there.
+type WebhookDeliveryJob struct {
+	log              *log.Helper
+	deliveryRepo     *data.WebhookDeliveryRepo
+	subscriptionRepo *data.WebhookSubscriptionRepo
+	sender           *webhook.Sender
+	config           *conf.WebhookConfig
+	interval         time.Duration
+	timeout          time.Duration
+	batchSize        int
+	maxAttempts      int
+	ctx              context.Context
+	cancel           context.CancelFunc
+	wg               sync.WaitGroup
+	running          bool
+	mu               sync.Mutex
+}
+
+// NewWebhookDeliveryJob creates a new webhook delivery job
+func NewWebhookDeliveryJob(ctx *bootstrap.Context, deliveryRepo *data.WebhookDeliveryRepo, subscriptionRepo *data.WebhookSubscriptionRepo) *WebhookDeliveryJob {
+	var webhookCfg *conf.WebhookConfig
+	if cfg, ok := ctx.GetCustomConfig("hr"); ok && cfg != nil {
+		if hrCfg, ok := cfg.(*conf.HR); ok && hrCfg.Webhooks != nil {
+			webhookCfg = hrCfg.Webhooks
+		}
+	}
+
+	// Default config if not set
+	if webhookCfg == nil {
+		webhookCfg = &conf.WebhookConfig{Enabled: true}
+	}
+
+	l := ctx.NewLoggerHelper("hr/job/webhook")
+
+	interval := defaultWebhookInterval
+	if webhookCfg.Interval != "" {
+		d, err := time.ParseDuration(webhookCfg.Interval)
+		if err != nil || d <= 0 {
+			l.Warnf("Invalid webhook interval %q, using %s", webhookCfg.Interval, defaultWebhookInterval)
+		} else {
+			interval = d
+		}
+	}
+
+	timeout := defaultWebhookTimeout
+	if webhookCfg.Timeout != "" {
+		d, err := time.ParseDuration(webhookCfg.Timeout)
+		if err != nil || d <= 0 {
+			l.Warnf("Invalid webhook timeout %q, using %s", webhookCfg.Timeout, defaultWebhookTimeout)
+		} else {
+			timeout = d
+		}
+	}
+
+	batchSize := defaultWebhookBatchSize
+	if webhookCfg.BatchSize > 0 {
+		batchSize = int(webhookCfg.BatchSize)
+	}
+	maxAttempts := defaultWebhookMaxAttempts
+	if webhookCfg.MaxAttempts > 0 {
+		maxAttempts = int(webhookCfg.MaxAttempts)
+	}
+
+	guard, err := webhook.NewGuard(webhookCfg.AllowedNetworks)
+	if err != nil {
+		l.Warnf("Ignoring allowed webhook networks: %v", err)
+		guard, _ = webhook.NewGuard(nil)
+	}
+
+	return &WebhookDeliveryJob{
+		log:              l,
+		deliveryRepo:     deliveryRepo,
+		subscriptionRepo: subscriptionRepo,
+		sender:           webhook.NewSender(timeout, guard),
+		config:           webhookCfg,
+		interval:         interval,
+		timeout:          timeout,
+		batchSize:        batchSize,
+		maxAttempts:      maxAttempts,
+	}
+}
+
+// Start starts the webhook delivery job
+func (j *WebhookDeliveryJob) Start() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.running {
+		return nil
+	}
+
+	if !j.config.Enabled {
+		j.log.Info("Webhook delivery job is disabled")
+		return nil
+	}
+
+	baseCtx := appViewer.NewSystemViewerContext(context.Background())
+	j.ctx, j.cancel = context.WithCancel(baseCtx)
+	j.running = true
+
+	j.log.Infof("Starting webhook delivery job, running every %s", j.interval)
+
+	j.wg.Add(1)
+	go j.loop()
+
+	return nil
+}
+
+// Stop stops the webhook delivery job
+func (j *WebhookDeliveryJob) Stop() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.running {
+		return nil
+	}
+
+	j.log.Info("Stopping webhook delivery job")
+	j.cancel()
+	j.wg.Wait()
+	j.running = false
+
+	return nil
+}
+
+func (j *WebhookDeliveryJob) loop() {
+	defer j.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.Run(j.ctx, time.Now())
+
+		select {
+		case <-j.ctx.Done():
+			j.log.Info("Webhook delivery job stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Run makes the attempts due by now. A full batch is followed by the next one right away, so
+// that a backlog drains without waiting for the next run.
+func (j *WebhookDeliveryJob) Run(ctx context.Context, now time.Time) {
+	// Attempts are made one after the other, so the last of a batch may wait for all the others
+	lease := time.Duration(j.batchSize)*j.timeout + webhookLeaseMargin
+
+	succeeded, failed := 0, 0
+	for ctx.Err() == nil {
+		deliveries, err := j.deliveryRepo.Claim(ctx, now, j.batchSize, lease)
+		if err != nil {
+			j.log.Errorf("Failed to claim webhook deliveries: %v", err)
+			break
+		}
+
+		for _, d := range deliveries {
+			if j.attempt(ctx, d) {
+				succeeded++
+			} else {
+				failed++
+			}
+		}
+
+		if len(deliveries) < j.batchSize {
+			break
+		}
+	}
+
+	if succeeded > 0 || failed > 0 {
+		j.log.Infof("Delivered %d webhook events, %d attempts failed", succeeded, failed)
+	}
+}
+
+// attempt makes an attempt at delivering an event and records its outcome. Events of
+// subscriptions that were deleted or disabled meanwhile are not delivered, nor attempted again.
+func (j *WebhookDeliveryJob) attempt(ctx context.Context, d *ent.WebhookDelivery) bool {
+	sub, err := j.subscriptionRepo.GetByID(ctx, d.SubscriptionID)
+	if err != nil {
+		j.log.Errorf("Failed to get webhook subscription %s: %v", d.SubscriptionID, err)
+		return false
+	}
+	if sub == nil || !sub.Enabled {
+		j.fail(ctx, d, data.WebhookAttempt{Error: "subscription deleted or disabled"}, false)
+		return false
+	}
+
+	result, sendErr := j.sender.Send(ctx, webhook.Request{
+		URL:        sub.URL,
+		Secret:     sub.Secret,
+		DeliveryID: d.ID,
+		EventID:    d.EventID,
+		EventType:  d.EventType,
+		Body:       d.Payload,
+	})
+	attempt := data.WebhookAttempt{
+		ResponseStatus: result.StatusCode,
+		ResponseBody:   result.Body,
+		Duration:       result.Duration,
+	}
+	if sendErr != nil {
+		attempt.Error = sendErr.Error()
+		j.fail(ctx, d, attempt, true)
+		return false
+	}
+
+	if err := j.deliveryRepo.MarkSucceeded(ctx, d.ID, attempt); err != nil {
+		j.log.Errorf("Failed to mark webhook delivery %s succeeded: %v", d.ID, err)
+	}
+	return true
+}
+
+// fail records a failed attempt, scheduling the next one unless the event has been attempted
+// maxAttempts times or retry is false.
+func (j *WebhookDeliveryJob) fail(ctx context.Context, d *ent.WebhookDelivery, attempt data.WebhookAttempt, retry bool) {
+	var next *time.Time
+	if retry && d.Attempt < j.maxAttempts {
+		at := time.Now().Add(outboxBackoff(d.Attempt))
+		next = &at
+		j.log.Warnf("Attempt %d at delivering %s event %s to webhook %s failed, retrying at %s: %s", d.Attempt, d.EventType, d.EventID, d.SubscriptionID, at.Format(time.RFC3339), attempt.Error)
+	} else {
+		j.log.Errorf("Giving up delivering %s event %s to webhook %s after attempt %d: %s", d.EventType, d.EventID, d.SubscriptionID, d.Attempt, attempt.Error)
+	}
+
+	if err := j.deliveryRepo.MarkFailed(ctx, d, attempt, next); err != nil {
+		j.log.Errorf("Failed to record failed webhook delivery %s: %v", d.ID, err)
+	}
+}
@@ -0,0 +1,170 @@
+// Package policy checks leave requests against the booking rules of a tenant: notice periods,
+// request lengths, the weekdays a request may start on, and blackout periods.
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Rules a request may break.
+const (
+	RuleMinNotice      = "min_notice"
+	RuleMaxConsecutive = "max_consecutive_days"
+	RuleMinDays        = "min_days"
+	RuleStartWeekdays  = "start_weekdays"
+	RuleBlackout       = "blackout"
+)
+
+// Rules are the booking rules of a leave policy. Zero values impose no rule.
+type Rules struct {
+	// MinNoticeDays requires requests to be made this many calendar days before they start.
+	MinNoticeDays int `json:"min_notice_days,omitempty"`
+	// MaxConsecutiveDays limits the days of a single request.
+	MaxConsecutiveDays float64 `json:"max_consecutive_days,omitempty"`
+	// MinDays requires a single request to be at least this many days, e.g. a week of vacation.
+	MinDays float64 `json:"min_days,omitempty"`
+	// StartWeekdays are the weekdays, Sunday (0) to Saturday (6), a request may start on; empty
+	// allows any day.
+	StartWeekdays []int `json:"start_weekdays,omitempty"`
+}
+
+// Request is what the rules are checked against.
+type Request struct {
+	Start time.Time
+	End   time.Time
+	Days  float64
+	// CheckNotice applies the notice period; changes that keep the start date do not need notice
+	// again.
+	CheckNotice bool
+}
+
+// Violation is a rule a request breaks.
+type Violation struct {
+	Rule string `json:"rule"`
+	// PolicyID and PolicyName identify the policy or blackout period that sets the rule.
+	PolicyID   string `json:"policy_id,omitempty"`
+	PolicyName string `json:"policy_name,omitempty"`
+	Message    string `json:"message"`
+}
+
+// Override records an HR admin booking a request despite the rules it breaks.
+type Override struct {
+	Violations []Violation `json:"violations"`
+	Reason     string      `json:"reason"`
+	ActorID    uint32      `json:"actor_id"`
+	ActorName  string      `json:"actor_name,omitempty"`
+	At         time.Time   `json:"at"`
+}
+
+// Blackout is a period in which no leave may be taken.
+type Blackout struct {
+	ID        string
+	Name      string
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// Check returns every rule the request breaks at the time now.
+func (r *Rules) Check(req Request, now time.Time) []Violation {
+	if r == nil {
+		return nil
+	}
+
+	var violations []Violation
+	if r.MinNoticeDays > 0 && req.CheckNotice {
+		if notice := daysBetween(now, req.Start); notice < r.MinNoticeDays {
+			violations = append(violations, Violation{
+				Rule:    RuleMinNotice,
+				Message: fmt.Sprintf("requests must be made %d days in advance, this one %d days", r.MinNoticeDays, notice),
+			})
+		}
+	}
+	if r.MaxConsecutiveDays > 0 && req.Days > r.MaxConsecutiveDays {
+		violations = append(violations, Violation{
+			Rule:    RuleMaxConsecutive,
+			Message: fmt.Sprintf("requests may not exceed %g days, this one is %g days", r.MaxConsecutiveDays, req.Days),
+		})
+	}
+	if r.MinDays > 0 && req.Days < r.MinDays {
+		violations = append(violations, Violation{
+			Rule:    RuleMinDays,
+			Message: fmt.Sprintf("requests must be at least %g days, this one is %g days", r.MinDays, req.Days),
+		})
+	}
+	if len(r.StartWeekdays) > 0 && !containsWeekday(r.StartWeekdays, req.Start.Weekday()) {
+		violations = append(violations, Violation{
+			Rule:    RuleStartWeekdays,
+			Message: fmt.Sprintf("requests must start on %s, this one starts on %s", weekdayNames(r.StartWeekdays), req.Start.Weekday()),
+		})
+	}
+	return violations
+}
+
+// Validate returns an error if the rules are inconsistent.
+func (r *Rules) Validate() error {
+	if r == nil {
+		return nil
+	}
+	if r.MinNoticeDays < 0 || r.MaxConsecutiveDays < 0 || r.MinDays < 0 {
+		return fmt.Errorf("rule values must not be negative")
+	}
+	if r.MaxConsecutiveDays > 0 && r.MinDays > r.MaxConsecutiveDays {
+		return fmt.Errorf("min_days must not exceed max_consecutive_days")
+	}
+	for _, d := range r.StartWeekdays {
+		if d < 0 || d > 6 {
+			return fmt.Errorf("start_weekdays must be between 0 (Sunday) and 6 (Saturday)")
+		}
+	}
+	return nil
+}
+
+// CheckBlackout returns a violation if any day of the request falls within the blackout period.
+func (b Blackout) CheckBlackout(req Request) *Violation {
+	if dateOf(req.Start).After(dateOf(b.EndDate)) || dateOf(req.End).Before(dateOf(b.StartDate)) {
+		return nil
+	}
+	return &Violation{
+		Rule:       RuleBlackout,
+		PolicyID:   b.ID,
+		PolicyName: b.Name,
+		Message:    fmt.Sprintf("no leave may be taken from %s to %s (%s)", b.StartDate.Format("2006-01-02"), b.EndDate.Format("2006-01-02"), b.Name),
+	}
+}
+
+// Summary joins the messages of the violations into one line.
+func Summary(violations []Violation) string {
+	messages := make([]string, len(violations))
+	for i, v := range violations {
+		messages[i] = v.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// daysBetween returns the calendar days from the date of from to the date of to.
+func daysBetween(from, to time.Time) int {
+	return int(dateOf(to).Sub(dateOf(from)).Hours() / 24)
+}
+
+func containsWeekday(days []int, d time.Weekday) bool {
+	for _, day := range days {
+		if time.Weekday(day) == d {
+			return true
+		}
+	}
+	return false
+}
+
+func weekdayNames(days []int) string {
+	names := make([]string, len(days))
+	for i, d := range days {
+		names[i] = time.Weekday(d).String()
+	}
+	return strings.Join(names, ", ")
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
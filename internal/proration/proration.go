@@ -0,0 +1,58 @@
+// Package proration scales yearly leave entitlements to the part of a year a user is employed.
+package proration
+
+import (
+	"math"
+	"time"
+)
+
+// Rounding modes. Pro-rated days are rounded to a half day.
+const (
+	RoundNearest = "nearest"
+	RoundUp      = "up"
+	RoundDown    = "down"
+)
+
+// Fraction returns the share of the calendar days of year that fall within the employment from
+// start to end, both inclusive. A nil end means the employment is ongoing.
+func Fraction(year int, start time.Time, end *time.Time) float64 {
+	first := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	next := first.AddDate(1, 0, 0)
+
+	from := dateOf(start)
+	if from.Before(first) {
+		from = first
+	}
+	to := next
+	if end != nil {
+		if last := dateOf(*end).AddDate(0, 0, 1); last.Before(to) {
+			to = last
+		}
+	}
+
+	if !to.After(from) {
+		return 0
+	}
+	return to.Sub(from).Hours() / next.Sub(first).Hours()
+}
+
+// Days scales the yearly days by fraction and rounds the result to a half day. An empty
+// rounding mode rounds to the nearest half day.
+func Days(annual, fraction float64, rounding string) float64 {
+	// Drop floating point noise so that exact halves are not rounded up or down
+	halves := math.Round(annual*fraction*2*1e6) / 1e6
+
+	switch rounding {
+	case RoundUp:
+		halves = math.Ceil(halves)
+	case RoundDown:
+		halves = math.Floor(halves)
+	default:
+		halves = math.Round(halves)
+	}
+	return halves / 2
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
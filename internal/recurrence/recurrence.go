@@ -0,0 +1,159 @@
+// Package recurrence expands the recurrence rules of repeating leave, e.g. every other Friday
+// off, into the dates of its occurrences. Rules follow the RRULE of iCalendar (RFC 5545) in a
+// reduced form: a frequency, an interval, weekdays for weekly rules, and an end by date or count.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Frequencies a rule repeats at.
+const (
+	FrequencyDaily   = "daily"
+	FrequencyWeekly  = "weekly"
+	FrequencyMonthly = "monthly"
+)
+
+// MaxOccurrences is the most occurrences a rule may expand into.
+const MaxOccurrences = 100
+
+// Rule describes how a leave request repeats.
+type Rule struct {
+	// Frequency is FrequencyDaily, FrequencyWeekly or FrequencyMonthly.
+	Frequency string `json:"frequency"`
+	// Interval repeats the rule every this many days, weeks or months; 0 is taken as 1.
+	Interval int `json:"interval,omitempty"`
+	// Weekdays are the weekdays, Sunday (0) to Saturday (6), a weekly rule repeats on; empty
+	// repeats on the weekday of the first occurrence.
+	Weekdays []int `json:"weekdays,omitempty"`
+	// Until is the last day an occurrence may start on.
+	Until *time.Time `json:"until,omitempty"`
+	// Count is the number of occurrences. Either Until or Count ends the rule.
+	Count int `json:"count,omitempty"`
+}
+
+// Validate reports whether the rule is complete and expands into a bounded series.
+func (r *Rule) Validate() error {
+	switch r.Frequency {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+	default:
+		return fmt.Errorf("unknown recurrence frequency %q", r.Frequency)
+	}
+	if r.Interval < 0 {
+		return errors.New("recurrence interval must not be negative")
+	}
+	for _, d := range r.Weekdays {
+		if d < int(time.Sunday) || d > int(time.Saturday) {
+			return fmt.Errorf("invalid weekday %d, expected Sunday (0) to Saturday (6)", d)
+		}
+	}
+	if len(r.Weekdays) > 0 && r.Frequency != FrequencyWeekly {
+		return errors.New("weekdays only apply to weekly recurrences")
+	}
+	if r.Count < 0 {
+		return errors.New("recurrence count must not be negative")
+	}
+	if (r.Until == nil) == (r.Count == 0) {
+		return errors.New("a recurrence must end either by an until date or by a count")
+	}
+	if r.Count > MaxOccurrences {
+		return fmt.Errorf("a recurrence may have at most %d occurrences", MaxOccurrences)
+	}
+	return nil
+}
+
+// Expand returns the start dates of the occurrences of the rule for a first request starting on
+// start, in order. Occurrences keep the time of day of start. The first occurrence is the first
+// date from start on that matches the rule; monthly rules skip months without the day of start.
+func (r *Rule) Expand(start time.Time) ([]time.Time, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	interval := max(r.Interval, 1)
+
+	var dates []time.Time
+	// add appends an occurrence and reports whether the rule continues after it
+	add := func(t time.Time) (bool, error) {
+		if r.Until != nil && dateOf(t).After(dateOf(*r.Until)) {
+			return false, nil
+		}
+		if len(dates) == MaxOccurrences {
+			return false, fmt.Errorf("the recurrence expands into more than %d occurrences", MaxOccurrences)
+		}
+		dates = append(dates, t)
+		return r.Count == 0 || len(dates) < r.Count, nil
+	}
+
+	switch r.Frequency {
+	case FrequencyDaily:
+		for i := 0; ; i++ {
+			more, err := add(start.AddDate(0, 0, i*interval))
+			if err != nil || !more {
+				return dates, err
+			}
+		}
+
+	case FrequencyWeekly:
+		weekdays := r.weekdays(start)
+		// Weeks run from Monday, so that every other Friday counts from the week of the first
+		weekStart := start.AddDate(0, 0, -mondayOffset(start.Weekday()))
+		for week := 0; ; week += interval {
+			for _, d := range weekdays {
+				t := weekStart.AddDate(0, 0, week*7+mondayOffset(d))
+				if t.Before(start) {
+					continue
+				}
+				more, err := add(t)
+				if err != nil || !more {
+					return dates, err
+				}
+			}
+		}
+
+	default:
+		for month := 0; ; month += interval {
+			t := time.Date(start.Year(), start.Month()+time.Month(month), start.Day(),
+				start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+			if t.Day() != start.Day() {
+				// The month is too short; stop once it is past the end of the rule
+				if r.Until != nil && dateOf(t).After(dateOf(*r.Until)) {
+					return dates, nil
+				}
+				continue
+			}
+			more, err := add(t)
+			if err != nil || !more {
+				return dates, err
+			}
+		}
+	}
+}
+
+// weekdays returns the weekdays of a weekly rule in the order they fall in a week from Monday.
+func (r *Rule) weekdays(start time.Time) []time.Weekday {
+	if len(r.Weekdays) == 0 {
+		return []time.Weekday{start.Weekday()}
+	}
+	var days []time.Weekday
+	for _, d := range r.Weekdays {
+		if wd := time.Weekday(d); !slices.Contains(days, wd) {
+			days = append(days, wd)
+		}
+	}
+	slices.SortFunc(days, func(a, b time.Weekday) int {
+		return mondayOffset(a) - mondayOffset(b)
+	})
+	return days
+}
+
+// mondayOffset returns the number of days from Monday to the weekday.
+func mondayOffset(d time.Weekday) int {
+	return (int(d) + 6) % 7
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,167 @@
+package recurrence
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
+
+func TestValidate(t *testing.T) {
+	until := ptrTime(date(2026, time.June, 30))
+
+	tests := []struct {
+		name    string
+		rule    Rule
+		wantErr bool
+	}{
+		{"daily by count", Rule{Frequency: FrequencyDaily, Count: 5}, false},
+		{"weekly by until", Rule{Frequency: FrequencyWeekly, Interval: 2, Weekdays: []int{1, 5}, Until: until}, false},
+		{"monthly", Rule{Frequency: FrequencyMonthly, Count: MaxOccurrences}, false},
+		{"unknown frequency", Rule{Frequency: "yearly", Count: 5}, true},
+		{"no frequency", Rule{Count: 5}, true},
+		{"negative interval", Rule{Frequency: FrequencyDaily, Interval: -1, Count: 5}, true},
+		{"weekday out of range", Rule{Frequency: FrequencyWeekly, Weekdays: []int{7}, Count: 5}, true},
+		{"negative weekday", Rule{Frequency: FrequencyWeekly, Weekdays: []int{-1}, Count: 5}, true},
+		{"weekdays of a daily rule", Rule{Frequency: FrequencyDaily, Weekdays: []int{1}, Count: 5}, true},
+		{"negative count", Rule{Frequency: FrequencyDaily, Count: -1}, true},
+		{"no end", Rule{Frequency: FrequencyDaily}, true},
+		{"until and count", Rule{Frequency: FrequencyDaily, Until: until, Count: 5}, true},
+		{"too many occurrences", Rule{Frequency: FrequencyDaily, Count: MaxOccurrences + 1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.rule.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	// 6 March 2026 is a Friday
+	friday := date(2026, time.March, 6)
+
+	tests := []struct {
+		name  string
+		rule  Rule
+		start time.Time
+		want  []time.Time
+	}{
+		{
+			"daily",
+			Rule{Frequency: FrequencyDaily, Count: 3},
+			friday,
+			[]time.Time{friday, date(2026, time.March, 7), date(2026, time.March, 8)},
+		},
+		{
+			"every other day until a date",
+			Rule{Frequency: FrequencyDaily, Interval: 2, Until: ptrTime(date(2026, time.March, 12))},
+			friday,
+			[]time.Time{friday, date(2026, time.March, 8), date(2026, time.March, 10), date(2026, time.March, 12)},
+		},
+		{
+			"weekly on the weekday of the start",
+			Rule{Frequency: FrequencyWeekly, Count: 3},
+			friday,
+			[]time.Time{friday, date(2026, time.March, 13), date(2026, time.March, 20)},
+		},
+		{
+			"every other Friday",
+			Rule{Frequency: FrequencyWeekly, Interval: 2, Count: 3},
+			friday,
+			[]time.Time{friday, date(2026, time.March, 20), date(2026, time.April, 3)},
+		},
+		{
+			"weekly on several weekdays",
+			Rule{Frequency: FrequencyWeekly, Weekdays: []int{5, 1}, Count: 4},
+			friday,
+			[]time.Time{friday, date(2026, time.March, 9), date(2026, time.March, 13), date(2026, time.March, 16)},
+		},
+		{
+			"weeks run from Monday",
+			Rule{Frequency: FrequencyWeekly, Weekdays: []int{0, 1}, Count: 3},
+			date(2026, time.March, 2),
+			[]time.Time{date(2026, time.March, 2), date(2026, time.March, 8), date(2026, time.March, 9)},
+		},
+		{
+			"weekdays before the start are skipped in the first week",
+			Rule{Frequency: FrequencyWeekly, Interval: 2, Weekdays: []int{1, 3}, Count: 3},
+			date(2026, time.March, 4),
+			[]time.Time{date(2026, time.March, 4), date(2026, time.March, 16), date(2026, time.March, 18)},
+		},
+		{
+			"duplicate weekdays",
+			Rule{Frequency: FrequencyWeekly, Weekdays: []int{5, 5}, Count: 2},
+			friday,
+			[]time.Time{friday, date(2026, time.March, 13)},
+		},
+		{
+			"monthly",
+			Rule{Frequency: FrequencyMonthly, Interval: 3, Count: 3},
+			date(2026, time.January, 15),
+			[]time.Time{date(2026, time.January, 15), date(2026, time.April, 15), date(2026, time.July, 15)},
+		},
+		{
+			"monthly skips short months",
+			Rule{Frequency: FrequencyMonthly, Count: 3},
+			date(2026, time.January, 31),
+			[]time.Time{date(2026, time.January, 31), date(2026, time.March, 31), date(2026, time.May, 31)},
+		},
+		{
+			"monthly until a date in a skipped month",
+			Rule{Frequency: FrequencyMonthly, Until: ptrTime(date(2026, time.April, 15))},
+			date(2026, time.January, 31),
+			[]time.Time{date(2026, time.January, 31), date(2026, time.March, 31)},
+		},
+		{
+			"time of day is kept",
+			Rule{Frequency: FrequencyDaily, Until: ptrTime(date(2026, time.March, 7))},
+			friday.Add(9*time.Hour + 30*time.Minute),
+			[]time.Time{friday.Add(9*time.Hour + 30*time.Minute), date(2026, time.March, 7).Add(9*time.Hour + 30*time.Minute)},
+		},
+		{
+			"until before the start",
+			Rule{Frequency: FrequencyWeekly, Until: ptrTime(date(2026, time.March, 1))},
+			friday,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.rule.Expand(tt.start)
+			if err != nil {
+				t.Fatalf("Expand() error = %v", err)
+			}
+			if !slices.EqualFunc(got, tt.want, time.Time.Equal) {
+				t.Errorf("Expand() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandErrors(t *testing.T) {
+	start := date(2026, time.March, 6)
+
+	tests := []struct {
+		name string
+		rule Rule
+	}{
+		{"invalid rule", Rule{Frequency: FrequencyDaily}},
+		{"too many occurrences until a date", Rule{Frequency: FrequencyDaily, Until: ptrTime(date(2027, time.March, 6))}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.rule.Expand(start); err == nil {
+				t.Error("Expand() error = nil, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Package rollover decides how leave allowances roll over into the next year.
+package rollover
+
+import (
+	"math"
+	"time"
+)
+
+// Policy describes how an allowance is renewed at year end. Without a policy the next year's
+// allowance keeps the previous total and no days are carried over.
+type Policy struct {
+	// AnnualDays is the total days of each new allowance; 0 keeps the previous year's total.
+	AnnualDays float64 `json:"annual_days,omitempty"`
+	// CarryOver moves unused days into the next year's allowance.
+	CarryOver bool `json:"carry_over"`
+	// CarryOverCap limits the days carried over; 0 means no cap.
+	CarryOverCap float64 `json:"carry_over_cap,omitempty"`
+	// ExpiryMonth and ExpiryDay give the last day in the new year on which carried-over days
+	// can be used. Carried-over days never lapse when ExpiryMonth is 0.
+	ExpiryMonth int `json:"expiry_month,omitempty"`
+	ExpiryDay   int `json:"expiry_day,omitempty"`
+	// Prorate scales the new allowance to the part of the year the user is employed.
+	Prorate bool `json:"prorate,omitempty"`
+	// Rounding is the proration rounding mode; empty rounds to the nearest half day.
+	Rounding string `json:"rounding,omitempty"`
+}
+
+// TotalDays returns the total days of the next year's allowance given the previous year's
+// total. A nil policy keeps the previous total.
+func (p *Policy) TotalDays(previous float64) float64 {
+	if p != nil && p.AnnualDays > 0 {
+		return p.AnnualDays
+	}
+	return previous
+}
+
+// Carry splits the unused days of an allowance into the days carried over and the days
+// forfeited. A nil policy forfeits everything.
+func (p *Policy) Carry(remaining float64) (carried, forfeited float64) {
+	if remaining <= 0 {
+		return 0, 0
+	}
+	if p == nil || !p.CarryOver {
+		return 0, round(remaining)
+	}
+
+	carried = remaining
+	if p.CarryOverCap > 0 && carried > p.CarryOverCap {
+		carried = p.CarryOverCap
+	}
+	return round(carried), round(remaining - carried)
+}
+
+// Expiry returns the last day on which days carried into the given year can be used, or nil if
+// they never lapse.
+func (p *Policy) Expiry(year int) *time.Time {
+	if p == nil || p.ExpiryMonth == 0 {
+		return nil
+	}
+	t := time.Date(year, time.Month(p.ExpiryMonth), p.ExpiryDay, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+// Expired reports whether carried-over days with the given last day have lapsed at the time at.
+func Expired(expiry *time.Time, at time.Time) bool {
+	return expiry != nil && !at.Before(expiry.AddDate(0, 0, 1))
+}
+
+// ValidExpiry reports whether month and day form a date that exists in every year.
+func ValidExpiry(month, day int) bool {
+	if month < 1 || month > 12 || day < 1 {
+		return false
+	}
+	// 2001 is not a leap year, so 29 February is rejected
+	return time.Date(2001, time.Month(month), day, 0, 0, 0, 0, time.UTC).Day() == day
+}
+
+func round(days float64) float64 {
+	return math.Round(days*100) / 100
+}
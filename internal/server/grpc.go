@@ -57,8 +57,17 @@ func NewGRPCServer(
 	leaveSvc *service.LeaveService,
 	allowanceSvc *service.AllowanceService,
 	allowancePoolSvc *service.AllowancePoolService,
+	holidaySvc *service.HolidayService,
+	workScheduleSvc *service.WorkScheduleService,
+	employmentSvc *service.EmploymentService,
+	delegationSvc *service.ApprovalDelegationService,
+	leavePolicySvc *service.LeavePolicyService,
+	coverageSvc *service.CoverageService,
+	attachmentSvc *service.LeaveAttachmentService,
 	userSvc *service.UserService,
 	backupSvc *service.BackupService,
+	outboxSvc *service.OutboxService,
+	webhookSvc *service.WebhookService,
 ) *grpc.Server {
 	cfg := ctx.GetConfig()
 	logger := ctx.GetLogger()
@@ -134,8 +143,17 @@ func NewGRPCServer(
 	hrV1.RegisterRedactedHrLeaveServiceServer(srv, leaveSvc, nil)
 	hrV1.RegisterRedactedHrAllowanceServiceServer(srv, allowanceSvc, nil)
 	hrV1.RegisterRedactedHrAllowancePoolServiceServer(srv, allowancePoolSvc, nil)
+	hrV1.RegisterRedactedHrHolidayServiceServer(srv, holidaySvc, nil)
+	hrV1.RegisterRedactedHrWorkScheduleServiceServer(srv, workScheduleSvc, nil)
+	hrV1.RegisterRedactedHrEmploymentServiceServer(srv, employmentSvc, nil)
+	hrV1.RegisterRedactedHrApprovalDelegationServiceServer(srv, delegationSvc, nil)
+	hrV1.RegisterRedactedHrLeavePolicyServiceServer(srv, leavePolicySvc, nil)
+	hrV1.RegisterRedactedHrCoverageServiceServer(srv, coverageSvc, nil)
+	hrV1.RegisterRedactedHrLeaveAttachmentServiceServer(srv, attachmentSvc, nil)
 	hrV1.RegisterRedactedHrUserServiceServer(srv, userSvc, nil)
 	hrV1.RegisterRedactedBackupServiceServer(srv, backupSvc, nil)
+	hrV1.RegisterRedactedHrOutboxServiceServer(srv, outboxSvc, nil)
+	hrV1.RegisterRedactedHrWebhookServiceServer(srv, webhookSvc, nil)
 
 	return srv
 }
@@ -8,8 +8,12 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/go-tangra/go-tangra-hr/internal/approval"
+	"github.com/go-tangra/go-tangra-hr/internal/attachment"
 	"github.com/go-tangra/go-tangra-hr/internal/data"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
+	"github.com/go-tangra/go-tangra-hr/internal/escalation"
 	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
 )
 
@@ -67,6 +71,54 @@ func (s *AbsenceTypeService) CreateAbsenceType(ctx context.Context, req *hrV1.Cr
 	if req.AllowancePoolId != nil {
 		opts = append(opts, func(c *ent.AbsenceTypeCreate) { c.SetAllowancePoolID(*req.AllowancePoolId) })
 	}
+	if unit := absenceUnitToString(req.GetUnit()); unit != "" {
+		opts = append(opts, func(c *ent.AbsenceTypeCreate) { c.SetUnit(absencetype.Unit(unit)) })
+	}
+	if req.AccrualPolicy != nil {
+		policy, err := accrualPolicyFromProto(req.AccrualPolicy)
+		if err != nil {
+			return nil, err
+		}
+		if policy.Enabled() {
+			opts = append(opts, func(c *ent.AbsenceTypeCreate) { c.SetAccrualPolicy(policy) })
+		}
+	}
+	if req.RolloverPolicy != nil {
+		policy, err := rolloverPolicyFromProto(req.RolloverPolicy)
+		if err != nil {
+			return nil, err
+		}
+		if policy != nil {
+			opts = append(opts, func(c *ent.AbsenceTypeCreate) { c.SetRolloverPolicy(policy) })
+		}
+	}
+	if req.ApprovalChain != nil {
+		chain, err := approvalChainFromProto(req.ApprovalChain)
+		if err != nil {
+			return nil, err
+		}
+		if chain != nil {
+			opts = append(opts, func(c *ent.AbsenceTypeCreate) { c.SetApprovalChain(chain) })
+		}
+	}
+	if req.EscalationPolicy != nil {
+		policy, err := escalationPolicyFromProto(req.EscalationPolicy)
+		if err != nil {
+			return nil, err
+		}
+		if policy != nil {
+			opts = append(opts, func(c *ent.AbsenceTypeCreate) { c.SetEscalationPolicy(policy) })
+		}
+	}
+	if req.RequiresAttachment != nil {
+		requirement, err := attachmentRequirementFromProto(req.RequiresAttachment)
+		if err != nil {
+			return nil, err
+		}
+		if requirement != nil {
+			opts = append(opts, func(c *ent.AbsenceTypeCreate) { c.SetRequiresAttachment(requirement) })
+		}
+	}
 
 	entity, err := s.absenceTypeRepo.Create(ctx, getTenantID(ctx), req.GetName(), opts...)
 	if err != nil {
@@ -188,6 +240,44 @@ func (s *AbsenceTypeService) UpdateAbsenceType(ctx context.Context, req *hrV1.Up
 		if req.Data.AllowancePoolId != nil {
 			updates["allowance_pool_id"] = *req.Data.AllowancePoolId
 		}
+		if unit := absenceUnitToString(req.Data.GetUnit()); unit != "" {
+			updates["unit"] = unit
+		}
+		if req.Data.AccrualPolicy != nil {
+			policy, err := accrualPolicyFromProto(req.Data.AccrualPolicy)
+			if err != nil {
+				return nil, err
+			}
+			updates["accrual_policy"] = policy
+		}
+		if req.Data.RolloverPolicy != nil {
+			policy, err := rolloverPolicyFromProto(req.Data.RolloverPolicy)
+			if err != nil {
+				return nil, err
+			}
+			updates["rollover_policy"] = policy
+		}
+		if req.Data.ApprovalChain != nil {
+			chain, err := approvalChainFromProto(req.Data.ApprovalChain)
+			if err != nil {
+				return nil, err
+			}
+			updates["approval_chain"] = chain
+		}
+		if req.Data.EscalationPolicy != nil {
+			policy, err := escalationPolicyFromProto(req.Data.EscalationPolicy)
+			if err != nil {
+				return nil, err
+			}
+			updates["escalation_policy"] = policy
+		}
+		if req.Data.RequiresAttachment != nil {
+			requirement, err := attachmentRequirementFromProto(req.Data.RequiresAttachment)
+			if err != nil {
+				return nil, err
+			}
+			updates["requires_attachment"] = requirement
+		}
 	}
 
 	entity, err := s.absenceTypeRepo.Update(ctx, req.GetId(), updates)
@@ -245,6 +335,12 @@ func absenceTypeToProto(e *ent.AbsenceType) *hrV1.AbsenceType {
 		RequiresSigning:       ptrBool(e.RequiresSigning),
 		SigningTemplateId:     ptrString(e.SigningTemplateID),
 		AllowancePoolId:      ptrString(e.AllowancePoolID),
+		Unit:                  absenceUnitToProtoPtr(e.Unit.String()),
+		AccrualPolicy:         accrualPolicyToProto(e.AccrualPolicy),
+		RolloverPolicy:        rolloverPolicyToProto(e.RolloverPolicy),
+		ApprovalChain:         approvalChainToProto(e.ApprovalChain),
+		EscalationPolicy:      escalationPolicyToProto(e.EscalationPolicy),
+		RequiresAttachment:    attachmentRequirementToProto(e.RequiresAttachment),
 		CreatedBy:             e.CreateBy,
 		UpdatedBy:             e.UpdateBy,
 	}
@@ -258,3 +354,167 @@ func absenceTypeToProto(e *ent.AbsenceType) *hrV1.AbsenceType {
 
 	return result
 }
+
+func absenceUnitToProtoPtr(unit string) *hrV1.AbsenceUnit {
+	var u hrV1.AbsenceUnit
+	switch unit {
+	case "days":
+		u = hrV1.AbsenceUnit_ABSENCE_UNIT_DAYS
+	case "hours":
+		u = hrV1.AbsenceUnit_ABSENCE_UNIT_HOURS
+	default:
+		u = hrV1.AbsenceUnit_ABSENCE_UNIT_UNSPECIFIED
+	}
+	return &u
+}
+
+func absenceUnitToString(unit hrV1.AbsenceUnit) string {
+	switch unit {
+	case hrV1.AbsenceUnit_ABSENCE_UNIT_DAYS:
+		return "days"
+	case hrV1.AbsenceUnit_ABSENCE_UNIT_HOURS:
+		return "hours"
+	default:
+		return ""
+	}
+}
+
+// approvalChainFromProto validates an approval chain. A chain without steps returns nil, so that
+// a single approval suffices.
+func approvalChainFromProto(c *hrV1.ApprovalChain) (*approval.Chain, error) {
+	if len(c.GetSteps()) == 0 {
+		return nil, nil
+	}
+
+	chain := &approval.Chain{}
+	for i, st := range c.GetSteps() {
+		if st.GetName() == "" {
+			return nil, hrV1.ErrorBadRequest("approval step %d needs a name", i+1)
+		}
+		if st.GetMinDays() < 0 {
+			return nil, hrV1.ErrorBadRequest("approval step %d: minimum days must not be negative", i+1)
+		}
+		chain.Steps = append(chain.Steps, approval.Step{
+			Name:        st.GetName(),
+			Role:        st.GetRole(),
+			ApproverIDs: st.GetApproverIds(),
+			MinDays:     st.GetMinDays(),
+			Manager:     st.GetManager(),
+		})
+	}
+	return chain, nil
+}
+
+func approvalChainToProto(c *approval.Chain) *hrV1.ApprovalChain {
+	if c == nil || len(c.Steps) == 0 {
+		return nil
+	}
+
+	result := &hrV1.ApprovalChain{}
+	for _, st := range c.Steps {
+		result.Steps = append(result.Steps, &hrV1.ApprovalChainStep{
+			Name:        st.Name,
+			Role:        st.Role,
+			ApproverIds: st.ApproverIDs,
+			MinDays:     st.MinDays,
+			Manager:     st.Manager,
+		})
+	}
+	return result
+}
+
+// escalationPolicyFromProto validates an escalation policy. A policy that neither reminds,
+// escalates nor acts returns nil, so that requests wait indefinitely.
+func escalationPolicyFromProto(p *hrV1.EscalationPolicy) (*escalation.Policy, error) {
+	if p.GetRemindAfterDays() < 0 || p.GetEscalateAfterDays() < 0 || p.GetAutoActionAfterDays() < 0 {
+		return nil, hrV1.ErrorBadRequest("escalation days must not be negative")
+	}
+	if p.GetEscalateAfterDays() > 0 && len(p.GetEscalateTo()) == 0 {
+		return nil, hrV1.ErrorBadRequest("escalation needs at least one recipient")
+	}
+
+	policy := &escalation.Policy{
+		RemindAfterDays:     int(p.GetRemindAfterDays()),
+		EscalateAfterDays:   int(p.GetEscalateAfterDays()),
+		EscalateTo:          p.GetEscalateTo(),
+		AutoActionAfterDays: int(p.GetAutoActionAfterDays()),
+		AutoActionOnStart:   p.GetAutoActionOnStart(),
+	}
+	switch p.GetAutoAction() {
+	case hrV1.EscalationAction_ESCALATION_ACTION_REJECT:
+		policy.AutoAction = escalation.ActionReject
+	case hrV1.EscalationAction_ESCALATION_ACTION_APPROVE:
+		policy.AutoAction = escalation.ActionApprove
+	}
+	if policy.AutoAction != "" && policy.AutoActionAfterDays == 0 && !policy.AutoActionOnStart {
+		return nil, hrV1.ErrorBadRequest("an automatic action needs a delay or to be taken on the start date")
+	}
+
+	if !policy.Enabled() {
+		return nil, nil
+	}
+	return policy, nil
+}
+
+func escalationPolicyToProto(p *escalation.Policy) *hrV1.EscalationPolicy {
+	if !p.Enabled() {
+		return nil
+	}
+
+	result := &hrV1.EscalationPolicy{
+		RemindAfterDays:     int32(p.RemindAfterDays),
+		EscalateAfterDays:   int32(p.EscalateAfterDays),
+		EscalateTo:          p.EscalateTo,
+		AutoActionAfterDays: int32(p.AutoActionAfterDays),
+		AutoActionOnStart:   p.AutoActionOnStart,
+	}
+	switch p.AutoAction {
+	case escalation.ActionReject:
+		result.AutoAction = hrV1.EscalationAction_ESCALATION_ACTION_REJECT
+	case escalation.ActionApprove:
+		result.AutoAction = hrV1.EscalationAction_ESCALATION_ACTION_APPROVE
+	}
+	return result
+}
+
+// attachmentRequirementFromProto validates an attachment requirement. A requirement without
+// enforcement returns nil, so that no documents are required.
+func attachmentRequirementFromProto(p *hrV1.AttachmentRequirement) (*attachment.Requirement, error) {
+	if p.GetMinDays() < 0 || p.GetGraceDays() < 0 {
+		return nil, hrV1.ErrorBadRequest("attachment min_days and grace_days must not be negative")
+	}
+
+	requirement := &attachment.Requirement{
+		MinDays:   p.GetMinDays(),
+		GraceDays: int(p.GetGraceDays()),
+	}
+	switch p.GetEnforcement() {
+	case hrV1.AttachmentEnforcement_ATTACHMENT_ENFORCEMENT_FLAG:
+		requirement.Enforcement = attachment.EnforcementFlag
+	case hrV1.AttachmentEnforcement_ATTACHMENT_ENFORCEMENT_BLOCK:
+		requirement.Enforcement = attachment.EnforcementBlock
+	}
+
+	if !requirement.Enabled() {
+		return nil, nil
+	}
+	return requirement, nil
+}
+
+func attachmentRequirementToProto(r *attachment.Requirement) *hrV1.AttachmentRequirement {
+	if !r.Enabled() {
+		return nil
+	}
+
+	result := &hrV1.AttachmentRequirement{
+		MinDays:   r.MinDays,
+		GraceDays: int32(r.GraceDays),
+	}
+	switch r.Enforcement {
+	case attachment.EnforcementFlag:
+		result.Enforcement = hrV1.AttachmentEnforcement_ATTACHMENT_ENFORCEMENT_FLAG
+	case attachment.EnforcementBlock:
+		result.Enforcement = hrV1.AttachmentEnforcement_ATTACHMENT_ENFORCEMENT_BLOCK
+	}
+	return result
+}
@@ -46,6 +46,24 @@ func (s *AllowancePoolService) CreateAllowancePool(ctx context.Context, req *hrV
 	if req.Icon != nil {
 		opts = append(opts, func(c *ent.AllowancePoolCreate) { c.SetIcon(*req.Icon) })
 	}
+	if req.AccrualPolicy != nil {
+		policy, err := accrualPolicyFromProto(req.AccrualPolicy)
+		if err != nil {
+			return nil, err
+		}
+		if policy.Enabled() {
+			opts = append(opts, func(c *ent.AllowancePoolCreate) { c.SetAccrualPolicy(policy) })
+		}
+	}
+	if req.RolloverPolicy != nil {
+		policy, err := rolloverPolicyFromProto(req.RolloverPolicy)
+		if err != nil {
+			return nil, err
+		}
+		if policy != nil {
+			opts = append(opts, func(c *ent.AllowancePoolCreate) { c.SetRolloverPolicy(policy) })
+		}
+	}
 
 	// If absence type IDs are provided, link them via the edge
 	if len(req.AbsenceTypeIds) > 0 {
@@ -163,6 +181,20 @@ func (s *AllowancePoolService) UpdateAllowancePool(ctx context.Context, req *hrV
 		if req.Data.Icon != nil {
 			updates["icon"] = *req.Data.Icon
 		}
+		if req.Data.AccrualPolicy != nil {
+			policy, err := accrualPolicyFromProto(req.Data.AccrualPolicy)
+			if err != nil {
+				return nil, err
+			}
+			updates["accrual_policy"] = policy
+		}
+		if req.Data.RolloverPolicy != nil {
+			policy, err := rolloverPolicyFromProto(req.Data.RolloverPolicy)
+			if err != nil {
+				return nil, err
+			}
+			updates["rollover_policy"] = policy
+		}
 	}
 
 	entity, err := s.poolRepo.Update(ctx, req.GetId(), updates)
@@ -227,6 +259,9 @@ func allowancePoolToProto(e *ent.AllowancePool) *hrV1.AllowancePool {
 		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
 	}
 
+	result.AccrualPolicy = accrualPolicyToProto(e.AccrualPolicy)
+	result.RolloverPolicy = rolloverPolicyToProto(e.RolloverPolicy)
+
 	// Populate member absence type IDs from edge
 	for _, at := range e.Edges.AbsenceTypes {
 		result.AbsenceTypeIds = append(result.AbsenceTypeIds, at.ID)
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -9,8 +10,12 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/go-tangra/go-tangra-hr/internal/accrual"
 	"github.com/go-tangra/go-tangra-hr/internal/data"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/event"
+	"github.com/go-tangra/go-tangra-hr/internal/proration"
+	"github.com/go-tangra/go-tangra-hr/internal/rollover"
 	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
 )
 
@@ -21,14 +26,18 @@ type AllowanceService struct {
 	allowanceRepo   *data.LeaveAllowanceRepo
 	absenceTypeRepo *data.AbsenceTypeRepo
 	poolRepo        *data.AllowancePoolRepo
+	transactionRepo *data.AllowanceTransactionRepo
+	employmentRepo  *data.EmploymentRepo
 }
 
-func NewAllowanceService(ctx *bootstrap.Context, allowanceRepo *data.LeaveAllowanceRepo, absenceTypeRepo *data.AbsenceTypeRepo, poolRepo *data.AllowancePoolRepo) *AllowanceService {
+func NewAllowanceService(ctx *bootstrap.Context, allowanceRepo *data.LeaveAllowanceRepo, absenceTypeRepo *data.AbsenceTypeRepo, poolRepo *data.AllowancePoolRepo, transactionRepo *data.AllowanceTransactionRepo, employmentRepo *data.EmploymentRepo) *AllowanceService {
 	return &AllowanceService{
 		log:             ctx.NewLoggerHelper("hr/service/allowance"),
 		allowanceRepo:   allowanceRepo,
 		absenceTypeRepo: absenceTypeRepo,
 		poolRepo:        poolRepo,
+		transactionRepo: transactionRepo,
+		employmentRepo:  employmentRepo,
 	}
 }
 
@@ -76,6 +85,12 @@ func (s *AllowanceService) CreateAllowance(ctx context.Context, req *hrV1.Create
 	if req.UserName != nil {
 		opts = append(opts, func(c *ent.LeaveAllowanceCreate) { c.SetUserName(*req.UserName) })
 	}
+	if req.AccrualStart != nil {
+		opts = append(opts, func(c *ent.LeaveAllowanceCreate) { c.SetAccrualStart(req.AccrualStart.AsTime()) })
+	}
+	if req.CarryOverExpiry != nil {
+		opts = append(opts, func(c *ent.LeaveAllowanceCreate) { c.SetCarryOverExpiry(req.CarryOverExpiry.AsTime()) })
+	}
 	if isPoolBased {
 		poolID := *req.AllowancePoolId
 		pool, err := s.poolRepo.GetByID(ctx, poolID)
@@ -91,7 +106,31 @@ func (s *AllowanceService) CreateAllowance(ctx context.Context, req *hrV1.Create
 		opts = append(opts, func(c *ent.LeaveAllowanceCreate) { c.SetAllowancePoolID(poolID) })
 	}
 
-	entity, err := s.allowanceRepo.Create(ctx, getTenantID(ctx), req.GetUserId(), req.GetAbsenceTypeId(), int(req.GetYear()), req.GetTotalDays(), opts...)
+	// Pro-rate the full-year entitlement to the part of the year the user is employed
+	totalDays := req.GetTotalDays()
+	if req.GetProrate() {
+		year := int(req.GetYear())
+		employment, err := s.employmentRepo.GetByUser(ctx, getTenantID(ctx), req.GetUserId())
+		if err != nil {
+			return nil, err
+		}
+		if employment == nil {
+			return nil, hrV1.ErrorBadRequest("no employment dates are recorded for this user")
+		}
+
+		fraction := proration.Fraction(year, employment.StartDate, employment.EndDate)
+		if fraction == 0 {
+			return nil, hrV1.ErrorBadRequest("user is not employed in %d", year)
+		}
+		totalDays = proration.Days(req.GetTotalDays(), fraction, prorationRoundingToString(req.GetRounding()))
+
+		opts = append(opts, func(c *ent.LeaveAllowanceCreate) { c.SetAnnualDays(req.GetTotalDays()) })
+		if req.AccrualStart == nil && employment.StartDate.Year() == year {
+			opts = append(opts, func(c *ent.LeaveAllowanceCreate) { c.SetAccrualStart(employment.StartDate) })
+		}
+	}
+
+	entity, err := s.allowanceRepo.Create(ctx, getTenantID(ctx), req.GetUserId(), req.GetAbsenceTypeId(), int(req.GetYear()), totalDays, ledgerRef(ctx, "", "allowance created"), event.AllowanceMessages, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -144,6 +183,9 @@ func (s *AllowanceService) ListAllowances(ctx context.Context, req *hrV1.ListAll
 	if req.AbsenceTypeId != nil {
 		filters["absence_type_id"] = *req.AbsenceTypeId
 	}
+	if req.Overconsumed != nil {
+		filters["overconsumed"] = *req.Overconsumed
+	}
 
 	page := int(req.GetPage())
 	pageSize := int(req.GetPageSize())
@@ -200,9 +242,20 @@ func (s *AllowanceService) UpdateAllowance(ctx context.Context, req *hrV1.Update
 		if req.Data.UserName != nil {
 			updates["user_name"] = *req.Data.UserName
 		}
+		if req.Data.AccrualStart != nil {
+			updates["accrual_start"] = req.Data.AccrualStart.AsTime()
+		}
+		if req.Data.CarryOverExpiry != nil {
+			updates["carry_over_expiry"] = req.Data.CarryOverExpiry.AsTime()
+		}
+	}
+
+	note := req.GetReason()
+	if note == "" {
+		note = "allowance updated"
 	}
 
-	entity, err := s.allowanceRepo.Update(ctx, req.GetId(), updates)
+	entity, err := s.allowanceRepo.Update(ctx, req.GetId(), updates, ledgerRef(ctx, "", note), event.AllowanceMessages)
 	if err != nil {
 		return nil, err
 	}
@@ -264,14 +317,40 @@ func (s *AllowanceService) GetUserBalance(ctx context.Context, req *hrV1.GetUser
 		return nil, err
 	}
 
+	// Balances are derived from the allowance ledger
+	balances, err := s.allowanceRepo.Balances(ctx, allowances)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
 	var entries []*hrV1.BalanceEntry
 	for _, a := range allowances {
+		balance := balances[a.ID]
 		entry := &hrV1.BalanceEntry{
 			AbsenceTypeId: derefString(a.AbsenceTypeID),
-			TotalDays:     a.TotalDays,
-			UsedDays:      a.UsedDays,
-			CarriedOver:   a.CarriedOver,
-			RemainingDays: a.TotalDays + a.CarriedOver - a.UsedDays,
+			TotalDays:     balance.TotalDays,
+			UsedDays:      balance.UsedDays,
+			CarriedOver:   balance.CarriedOver,
+			RemainingDays: balance.Remaining(),
+		}
+		if a.CarryOverExpiry != nil && balance.CarriedOver > 0 {
+			entry.CarryOverExpiry = timestamppb.New(*a.CarryOverExpiry)
+		}
+		if a.OverconsumedDays > 0 {
+			entry.OverconsumedDays = ptrFloat64(a.OverconsumedDays)
+		}
+
+		// Accruing allowances: days earned so far, and the balance once the rest of the year
+		// has been earned (days not yet posted to the allowance are added to the remaining days)
+		if policy, start := data.AccrualFor(a); policy.Enabled() {
+			entry.AccruedToDate = ptrFloat64(policy.Accrued(year, start, now))
+			unposted := policy.ProjectedYearEnd(year, start) - a.AccruedDays
+			if unposted < 0 {
+				unposted = 0
+			}
+			entry.ProjectedYearEnd = ptrFloat64(balance.Remaining() + unposted)
 		}
 
 		if a.Edges.AbsenceType != nil {
@@ -306,6 +385,116 @@ func (s *AllowanceService) GetUserBalance(ctx context.Context, req *hrV1.GetUser
 	}, nil
 }
 
+func (s *AllowanceService) ListAllowanceTransactions(ctx context.Context, req *hrV1.ListAllowanceTransactionsRequest) (*hrV1.ListAllowanceTransactionsResponse, error) {
+	if err := checkPermission(ctx, "hr.allowance.view"); err != nil {
+		return nil, err
+	}
+
+	filters := make(map[string]interface{})
+
+	// Non-admin users can only see their own transactions
+	if !hasPermission(ctx, "hr.allowance.manage") {
+		filters["user_id"] = getUserID(ctx)
+	} else if req.UserId != nil {
+		filters["user_id"] = *req.UserId
+	}
+	if req.AllowanceId != nil {
+		filters["allowance_id"] = *req.AllowanceId
+	}
+	if req.Year != nil {
+		filters["year"] = int(*req.Year)
+	}
+	if req.LeaveRequestId != nil {
+		filters["leave_request_id"] = *req.LeaveRequestId
+	}
+	if req.Kind != nil {
+		filters["kind"] = allowanceTransactionKindToString(*req.Kind)
+	}
+
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	if req.GetNoPaging() {
+		page = 0
+		pageSize = 0
+	}
+
+	entities, total, err := s.transactionRepo.List(ctx, getTenantID(ctx), page, pageSize, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.AllowanceTransaction, len(entities))
+	for i, e := range entities {
+		items[i] = allowanceTransactionToProto(e)
+	}
+
+	return &hrV1.ListAllowanceTransactionsResponse{
+		Items: items,
+		Total: ptrInt32(int32(total)),
+	}, nil
+}
+
+// RolloverAllowances rolls the allowances of a year over into the next year. In dry-run mode the
+// planned rollover is returned without changing anything.
+func (s *AllowanceService) RolloverAllowances(ctx context.Context, req *hrV1.RolloverAllowancesRequest) (*hrV1.RolloverAllowancesResponse, error) {
+	if err := checkPermission(ctx, "hr.allowance.manage"); err != nil {
+		return nil, err
+	}
+
+	fromYear := int(req.GetFromYear())
+	if fromYear < 2000 || fromYear > 2098 {
+		return nil, hrV1.ErrorBadRequest("from year must be between 2000 and 2098")
+	}
+
+	filters := map[string]interface{}{
+		"tenant_id": getTenantID(ctx),
+	}
+	if req.UserId != nil {
+		filters["user_id"] = *req.UserId
+	}
+	if req.AbsenceTypeId != nil {
+		filters["absence_type_id"] = *req.AbsenceTypeId
+	}
+	if req.AllowancePoolId != nil {
+		filters["allowance_pool_id"] = *req.AllowancePoolId
+	}
+
+	items, err := s.allowanceRepo.PlanRollover(ctx, fromYear, filters, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &hrV1.RolloverAllowancesResponse{DryRun: req.GetDryRun()}
+	ref := ledgerRef(ctx, "", fmt.Sprintf("rollover from %d", fromYear))
+
+	for _, item := range items {
+		result := rolloverItemToProto(item)
+
+		if !req.GetDryRun() && item.SkipReason == "" {
+			target, err := s.allowanceRepo.ApplyRollover(ctx, item, ref, event.AllowanceMessages)
+			if err != nil {
+				s.log.Errorf("Failed to roll over allowance %s: %v", item.Source.ID, err)
+				result.Action = hrV1.RolloverAction_ROLLOVER_ACTION_SKIP
+				result.SkipReason = ptrString("rollover failed")
+			} else {
+				result.TargetAllowanceId = ptrString(target.ID)
+			}
+		}
+
+		switch result.Action {
+		case hrV1.RolloverAction_ROLLOVER_ACTION_CREATE:
+			resp.Created++
+		case hrV1.RolloverAction_ROLLOVER_ACTION_UPDATE:
+			resp.Updated++
+		default:
+			resp.Skipped++
+		}
+		resp.Items = append(resp.Items, result)
+	}
+
+	return resp, nil
+}
+
 func allowanceToProto(e *ent.LeaveAllowance) *hrV1.LeaveAllowance {
 	if e == nil {
 		return nil
@@ -313,19 +502,22 @@ func allowanceToProto(e *ent.LeaveAllowance) *hrV1.LeaveAllowance {
 
 	year := int32(e.Year)
 	result := &hrV1.LeaveAllowance{
-		Id:              &e.ID,
-		TenantId:        e.TenantID,
-		UserId:          &e.UserID,
-		AbsenceTypeId:   e.AbsenceTypeID,
-		AllowancePoolId: e.AllowancePoolID,
-		Year:            &year,
-		TotalDays:       ptrFloat64(e.TotalDays),
-		UsedDays:        ptrFloat64(e.UsedDays),
-		CarriedOver:     ptrFloat64(e.CarriedOver),
-		Notes:           ptrString(e.Notes),
-		UserName:        ptrString(e.UserName),
-		CreatedBy:       e.CreateBy,
-		UpdatedBy:       e.UpdateBy,
+		Id:               &e.ID,
+		TenantId:         e.TenantID,
+		UserId:           &e.UserID,
+		AbsenceTypeId:    e.AbsenceTypeID,
+		AllowancePoolId:  e.AllowancePoolID,
+		Year:             &year,
+		TotalDays:        ptrFloat64(e.TotalDays),
+		UsedDays:         ptrFloat64(e.UsedDays),
+		CarriedOver:      ptrFloat64(e.CarriedOver),
+		AccruedDays:      ptrFloat64(e.AccruedDays),
+		AnnualDays:       e.AnnualDays,
+		OverconsumedDays: ptrFloat64(e.OverconsumedDays),
+		Notes:            ptrString(e.Notes),
+		UserName:         ptrString(e.UserName),
+		CreatedBy:        e.CreateBy,
+		UpdatedBy:        e.UpdateBy,
 	}
 
 	if e.CreateTime != nil {
@@ -334,6 +526,12 @@ func allowanceToProto(e *ent.LeaveAllowance) *hrV1.LeaveAllowance {
 	if e.UpdateTime != nil {
 		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
 	}
+	if e.AccrualStart != nil {
+		result.AccrualStart = timestamppb.New(*e.AccrualStart)
+	}
+	if e.CarryOverExpiry != nil {
+		result.CarryOverExpiry = timestamppb.New(*e.CarryOverExpiry)
+	}
 
 	// Denormalized fields from edges
 	if e.Edges.AbsenceType != nil {
@@ -345,3 +543,211 @@ func allowanceToProto(e *ent.LeaveAllowance) *hrV1.LeaveAllowance {
 
 	return result
 }
+
+func allowanceTransactionToProto(e *ent.AllowanceTransaction) *hrV1.AllowanceTransaction {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.AllowanceTransaction{
+		Id:             e.ID,
+		TenantId:       e.TenantID,
+		AllowanceId:    e.AllowanceID,
+		UserId:         e.UserID,
+		Year:           int32(e.Year),
+		Kind:           allowanceTransactionKindToProto(e.Kind.String()),
+		Days:           e.Days,
+		BalanceAfter:   e.BalanceAfter,
+		LeaveRequestId: e.LeaveRequestID,
+		ActorId:        e.ActorID,
+		ActorName:      e.ActorName,
+	}
+
+	if e.Note != "" {
+		result.Note = ptrString(e.Note)
+	}
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+
+	return result
+}
+
+func allowanceTransactionKindToProto(kind string) hrV1.AllowanceTransactionKind {
+	switch kind {
+	case "grant":
+		return hrV1.AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_GRANT
+	case "deduction":
+		return hrV1.AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_DEDUCTION
+	case "refund":
+		return hrV1.AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_REFUND
+	case "carry_over":
+		return hrV1.AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_CARRY_OVER
+	case "adjustment":
+		return hrV1.AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_ADJUSTMENT
+	default:
+		return hrV1.AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_UNSPECIFIED
+	}
+}
+
+func allowanceTransactionKindToString(kind hrV1.AllowanceTransactionKind) string {
+	switch kind {
+	case hrV1.AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_GRANT:
+		return "grant"
+	case hrV1.AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_DEDUCTION:
+		return "deduction"
+	case hrV1.AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_REFUND:
+		return "refund"
+	case hrV1.AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_CARRY_OVER:
+		return "carry_over"
+	case hrV1.AllowanceTransactionKind_ALLOWANCE_TRANSACTION_KIND_ADJUSTMENT:
+		return "adjustment"
+	default:
+		return ""
+	}
+}
+
+// accrualPolicyFromProto validates and converts an accrual policy. A zero rate yields a disabled
+// policy, which removes the policy when stored.
+func accrualPolicyFromProto(p *hrV1.AccrualPolicy) (*accrual.Policy, error) {
+	if p.GetRate() < 0 || p.GetRate() > 366 {
+		return nil, hrV1.ErrorBadRequest("accrual rate must be between 0 and 366 days")
+	}
+	if p.GetCap() < 0 {
+		return nil, hrV1.ErrorBadRequest("accrual cap must not be negative")
+	}
+	if p.GetWaitingPeriods() < 0 {
+		return nil, hrV1.ErrorBadRequest("accrual waiting periods must not be negative")
+	}
+
+	policy := &accrual.Policy{
+		Rate:           p.GetRate(),
+		Period:         accrual.PeriodMonthly,
+		Cap:            p.GetCap(),
+		Timing:         accrual.TimingEnd,
+		WaitingPeriods: int(p.GetWaitingPeriods()),
+	}
+	if p.GetPeriod() == hrV1.AccrualPeriod_ACCRUAL_PERIOD_QUARTERLY {
+		policy.Period = accrual.PeriodQuarterly
+	}
+	if p.GetTiming() == hrV1.AccrualTiming_ACCRUAL_TIMING_PERIOD_START {
+		policy.Timing = accrual.TimingStart
+	}
+	return policy, nil
+}
+
+func accrualPolicyToProto(p *accrual.Policy) *hrV1.AccrualPolicy {
+	if !p.Enabled() {
+		return nil
+	}
+
+	result := &hrV1.AccrualPolicy{
+		Rate:           p.Rate,
+		Period:         hrV1.AccrualPeriod_ACCRUAL_PERIOD_MONTHLY,
+		Timing:         hrV1.AccrualTiming_ACCRUAL_TIMING_PERIOD_END,
+		WaitingPeriods: int32(p.WaitingPeriods),
+	}
+	if p.Period == accrual.PeriodQuarterly {
+		result.Period = hrV1.AccrualPeriod_ACCRUAL_PERIOD_QUARTERLY
+	}
+	if p.Timing == accrual.TimingStart {
+		result.Timing = hrV1.AccrualTiming_ACCRUAL_TIMING_PERIOD_START
+	}
+	if p.Cap > 0 {
+		result.Cap = ptrFloat64(p.Cap)
+	}
+	return result
+}
+
+func rolloverItemToProto(item data.RolloverItem) *hrV1.RolloverItem {
+	a := item.Source
+	result := &hrV1.RolloverItem{
+		UserId:            a.UserID,
+		UserName:          a.UserName,
+		AbsenceTypeId:     a.AbsenceTypeID,
+		AllowancePoolId:   a.AllowancePoolID,
+		SourceAllowanceId: a.ID,
+		RemainingDays:     item.Remaining,
+		TotalDays:         item.TotalDays,
+		CarriedOver:       item.CarriedOver,
+		ForfeitedDays:     item.Forfeited,
+		AnnualDays:        item.AnnualDays,
+	}
+
+	if a.Edges.AllowancePool != nil {
+		result.Name = a.Edges.AllowancePool.Name
+	} else if a.Edges.AbsenceType != nil {
+		result.Name = a.Edges.AbsenceType.Name
+	}
+	if item.Expiry != nil {
+		result.CarryOverExpiry = timestamppb.New(*item.Expiry)
+	}
+
+	switch {
+	case item.SkipReason != "":
+		result.Action = hrV1.RolloverAction_ROLLOVER_ACTION_SKIP
+		result.SkipReason = ptrString(item.SkipReason)
+	case item.Target != nil:
+		result.Action = hrV1.RolloverAction_ROLLOVER_ACTION_UPDATE
+	default:
+		result.Action = hrV1.RolloverAction_ROLLOVER_ACTION_CREATE
+	}
+	if item.Target != nil {
+		result.TargetAllowanceId = ptrString(item.Target.ID)
+	}
+
+	return result
+}
+
+// rolloverPolicyFromProto validates and converts a rollover policy. A policy without any setting
+// yields nil, which removes the policy when stored.
+func rolloverPolicyFromProto(p *hrV1.RolloverPolicy) (*rollover.Policy, error) {
+	if p.GetAnnualDays() < 0 || p.GetAnnualDays() > 366 {
+		return nil, hrV1.ErrorBadRequest("annual days must be between 0 and 366")
+	}
+	if p.GetCarryOverCap() < 0 {
+		return nil, hrV1.ErrorBadRequest("carry-over cap must not be negative")
+	}
+	if (p.GetExpiryMonth() != 0 || p.GetExpiryDay() != 0) && !rollover.ValidExpiry(int(p.GetExpiryMonth()), int(p.GetExpiryDay())) {
+		return nil, hrV1.ErrorBadRequest("carry-over expiry must be a day that exists in every year")
+	}
+
+	policy := &rollover.Policy{
+		AnnualDays:   p.GetAnnualDays(),
+		CarryOver:    p.GetCarryOver(),
+		CarryOverCap: p.GetCarryOverCap(),
+		ExpiryMonth:  int(p.GetExpiryMonth()),
+		ExpiryDay:    int(p.GetExpiryDay()),
+		Prorate:      p.GetProrate(),
+	}
+	if policy.Prorate {
+		policy.Rounding = prorationRoundingToString(p.GetRounding())
+	}
+	if *policy == (rollover.Policy{}) {
+		return nil, nil
+	}
+	return policy, nil
+}
+
+func rolloverPolicyToProto(p *rollover.Policy) *hrV1.RolloverPolicy {
+	if p == nil {
+		return nil
+	}
+
+	result := &hrV1.RolloverPolicy{
+		AnnualDays: p.AnnualDays,
+		CarryOver:  p.CarryOver,
+		Prorate:    p.Prorate,
+	}
+	if p.Prorate {
+		result.Rounding = prorationRoundingToProto(p.Rounding)
+	}
+	if p.CarryOverCap > 0 {
+		result.CarryOverCap = ptrFloat64(p.CarryOverCap)
+	}
+	if p.ExpiryMonth != 0 {
+		result.ExpiryMonth = ptrInt32(int32(p.ExpiryMonth))
+		result.ExpiryDay = ptrInt32(int32(p.ExpiryDay))
+	}
+	return result
+}
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+type ApprovalDelegationService struct {
+	hrV1.UnimplementedHrApprovalDelegationServiceServer
+
+	log             *log.Helper
+	delegationRepo  *data.ApprovalDelegationRepo
+	absenceTypeRepo *data.AbsenceTypeRepo
+}
+
+func NewApprovalDelegationService(ctx *bootstrap.Context, delegationRepo *data.ApprovalDelegationRepo, absenceTypeRepo *data.AbsenceTypeRepo) *ApprovalDelegationService {
+	return &ApprovalDelegationService{
+		log:             ctx.NewLoggerHelper("hr/service/approval-delegation"),
+		delegationRepo:  delegationRepo,
+		absenceTypeRepo: absenceTypeRepo,
+	}
+}
+
+// CreateApprovalDelegation lets a delegate decide the requests assigned to the delegator between
+// the start and end date. Approvers may delegate on behalf of others.
+func (s *ApprovalDelegationService) CreateApprovalDelegation(ctx context.Context, req *hrV1.CreateApprovalDelegationRequest) (*hrV1.CreateApprovalDelegationResponse, error) {
+	if err := checkPermission(ctx, "hr.request.manage"); err != nil {
+		return nil, err
+	}
+
+	delegatorID := getUserID(ctx)
+	if req.DelegatorId != nil && req.GetDelegatorId() != delegatorID {
+		if !hasPermission(ctx, "hr.request.approve") {
+			return nil, hrV1.ErrorBadRequest("you can only delegate your own approvals")
+		}
+		delegatorID = req.GetDelegatorId()
+	}
+	if delegatorID == 0 {
+		return nil, hrV1.ErrorValidationFailed("delegator_id is required")
+	}
+	if req.GetDelegateId() == 0 {
+		return nil, hrV1.ErrorValidationFailed("delegate_id is required")
+	}
+	if req.GetDelegateId() == delegatorID {
+		return nil, hrV1.ErrorValidationFailed("an approver cannot delegate to themselves")
+	}
+	if req.GetStartDate() == nil || req.GetEndDate() == nil {
+		return nil, hrV1.ErrorValidationFailed("start_date and end_date are required")
+	}
+
+	startDate := dateOnly(req.GetStartDate().AsTime())
+	endDate := dateOnly(req.GetEndDate().AsTime())
+	if endDate.Before(startDate) {
+		return nil, hrV1.ErrorInvalidDateRange("end date must not be before start date")
+	}
+
+	tenantID := getTenantID(ctx)
+
+	for _, id := range req.GetAbsenceTypeIds() {
+		absType, err := s.absenceTypeRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if absType == nil {
+			return nil, hrV1.ErrorAbsenceTypeNotFound("absence type %s not found", id)
+		}
+		if err := checkTenantAccess(ctx, absType.TenantID, hrV1.ErrorAbsenceTypeNotFound("absence type %s not found", id)); err != nil {
+			return nil, err
+		}
+	}
+
+	entity, err := s.delegationRepo.Create(ctx, tenantID, delegatorID, req.GetDelegateId(), startDate, endDate, func(c *ent.ApprovalDelegationCreate) {
+		c.SetDelegatorName(req.GetDelegatorName())
+		c.SetDelegateName(req.GetDelegateName())
+		c.SetAbsenceTypeIds(req.GetAbsenceTypeIds())
+		c.SetNotes(req.GetNotes())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.CreateApprovalDelegationResponse{
+		Delegation: approvalDelegationToProto(entity),
+	}, nil
+}
+
+func (s *ApprovalDelegationService) GetApprovalDelegation(ctx context.Context, req *hrV1.GetApprovalDelegationRequest) (*hrV1.GetApprovalDelegationResponse, error) {
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.delegationRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, hrV1.ErrorApprovalDelegationNotFound("approval delegation not found")
+	}
+	if err := checkTenantAccess(ctx, entity.TenantID, hrV1.ErrorApprovalDelegationNotFound("approval delegation not found")); err != nil {
+		return nil, err
+	}
+
+	// Non-approvers can only view the delegations they take part in
+	if !hasPermission(ctx, "hr.request.approve") && !isParticipant(ctx, entity) {
+		return nil, hrV1.ErrorApprovalDelegationNotFound("approval delegation not found")
+	}
+
+	return &hrV1.GetApprovalDelegationResponse{
+		Delegation: approvalDelegationToProto(entity),
+	}, nil
+}
+
+func (s *ApprovalDelegationService) ListApprovalDelegations(ctx context.Context, req *hrV1.ListApprovalDelegationsRequest) (*hrV1.ListApprovalDelegationsResponse, error) {
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
+		return nil, err
+	}
+
+	filters := make(map[string]interface{})
+	if req.DelegatorId != nil {
+		filters["delegator_id"] = *req.DelegatorId
+	}
+	if req.DelegateId != nil {
+		filters["delegate_id"] = *req.DelegateId
+	}
+	if req.ActiveOn != nil {
+		at, err := time.Parse(time.RFC3339, *req.ActiveOn)
+		if err != nil {
+			return nil, hrV1.ErrorValidationFailed("invalid active_on format, expected RFC3339")
+		}
+		filters["active_on"] = at
+	}
+
+	// Non-approvers can only list the delegations they take part in
+	if !hasPermission(ctx, "hr.request.approve") {
+		filters["participant_id"] = getUserID(ctx)
+	}
+
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	if req.GetNoPaging() {
+		page = 0
+		pageSize = 0
+	}
+
+	entities, total, err := s.delegationRepo.List(ctx, getTenantID(ctx), page, pageSize, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.ApprovalDelegation, len(entities))
+	for i, e := range entities {
+		items[i] = approvalDelegationToProto(e)
+	}
+
+	return &hrV1.ListApprovalDelegationsResponse{
+		Items: items,
+		Total: ptrInt32(int32(total)),
+	}, nil
+}
+
+func (s *ApprovalDelegationService) DeleteApprovalDelegation(ctx context.Context, req *hrV1.DeleteApprovalDelegationRequest) (*emptypb.Empty, error) {
+	if err := checkPermission(ctx, "hr.request.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.delegationRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorApprovalDelegationNotFound("approval delegation not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorApprovalDelegationNotFound("approval delegation not found")); err != nil {
+		return nil, err
+	}
+
+	// Non-approvers can only remove their own delegations
+	if !hasPermission(ctx, "hr.request.approve") && existing.DelegatorID != getUserID(ctx) {
+		return nil, hrV1.ErrorBadRequest("you can only delete your own delegations")
+	}
+
+	if err := s.delegationRepo.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// isParticipant reports whether the caller is the delegator or the delegate of the delegation.
+func isParticipant(ctx context.Context, e *ent.ApprovalDelegation) bool {
+	userID := getUserID(ctx)
+	return e.DelegatorID == userID || e.DelegateID == userID
+}
+
+func approvalDelegationToProto(e *ent.ApprovalDelegation) *hrV1.ApprovalDelegation {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.ApprovalDelegation{
+		Id:             &e.ID,
+		TenantId:       e.TenantID,
+		DelegatorId:    &e.DelegatorID,
+		DelegatorName:  ptrString(e.DelegatorName),
+		DelegateId:     &e.DelegateID,
+		DelegateName:   ptrString(e.DelegateName),
+		StartDate:      timestamppb.New(e.StartDate),
+		EndDate:        timestamppb.New(e.EndDate),
+		AbsenceTypeIds: e.AbsenceTypeIds,
+		LeaveRequestId: ptrString(e.LeaveRequestID),
+		Notes:          ptrString(e.Notes),
+		CreatedBy:      e.CreateBy,
+		UpdatedBy:      e.UpdateBy,
+	}
+
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	if e.UpdateTime != nil {
+		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
+	}
+
+	return result
+}
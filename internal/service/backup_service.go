@@ -17,8 +17,21 @@ import (
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancepool"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/allowancetransaction"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/approvaldelegation"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/blackoutperiod"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/coveragerule"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/employment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holiday"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/holidaycalendar"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveallowance"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveamendment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveattachment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavecomment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavepolicy"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workschedule"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/workscheduleassignment"
 )
 
 const (
@@ -82,6 +95,162 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *hrV1.ExportBackup
 		return nil, fmt.Errorf("set allowance pools: %w", err)
 	}
 
+	// Export holiday calendars
+	hcQuery := client.HolidayCalendar.Query()
+	if !full {
+		hcQuery = hcQuery.Where(holidaycalendar.TenantIDEQ(tenantID))
+	}
+	calendars, err := hcQuery.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export holiday calendars: %w", err)
+	}
+	if err := backup.SetEntities(a, "holidayCalendars", calendars); err != nil {
+		return nil, fmt.Errorf("set holiday calendars: %w", err)
+	}
+
+	// Export holidays
+	hQuery := client.Holiday.Query()
+	if !full {
+		hQuery = hQuery.Where(holiday.TenantIDEQ(tenantID))
+	}
+	holidays, err := hQuery.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export holidays: %w", err)
+	}
+	if err := backup.SetEntities(a, "holidays", holidays); err != nil {
+		return nil, fmt.Errorf("set holidays: %w", err)
+	}
+
+	// Export work schedules
+	workSchedulesQuery := client.WorkSchedule.Query()
+	if !full {
+		workSchedulesQuery = workSchedulesQuery.Where(workschedule.TenantIDEQ(tenantID))
+	}
+	workSchedules, err := workSchedulesQuery.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export work schedules: %w", err)
+	}
+	if err := backup.SetEntities(a, "workSchedules", workSchedules); err != nil {
+		return nil, fmt.Errorf("set work schedules: %w", err)
+	}
+
+	// Export work schedule assignments
+	workScheduleAssignmentsQuery := client.WorkScheduleAssignment.Query()
+	if !full {
+		workScheduleAssignmentsQuery = workScheduleAssignmentsQuery.Where(workscheduleassignment.TenantIDEQ(tenantID))
+	}
+	workScheduleAssignments, err := workScheduleAssignmentsQuery.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export work schedule assignments: %w", err)
+	}
+	if err := backup.SetEntities(a, "workScheduleAssignments", workScheduleAssignments); err != nil {
+		return nil, fmt.Errorf("set work schedule assignments: %w", err)
+	}
+
+	// Export employments
+	employmentsQuery := client.Employment.Query()
+	if !full {
+		employmentsQuery = employmentsQuery.Where(employment.TenantIDEQ(tenantID))
+	}
+	employments, err := employmentsQuery.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export employments: %w", err)
+	}
+	if err := backup.SetEntities(a, "employments", employments); err != nil {
+		return nil, fmt.Errorf("set employments: %w", err)
+	}
+
+	// Export approval delegations
+	approvalDelegationsQuery := client.ApprovalDelegation.Query()
+	if !full {
+		approvalDelegationsQuery = approvalDelegationsQuery.Where(approvaldelegation.TenantIDEQ(tenantID))
+	}
+	approvalDelegations, err := approvalDelegationsQuery.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export approval delegations: %w", err)
+	}
+	if err := backup.SetEntities(a, "approvalDelegations", approvalDelegations); err != nil {
+		return nil, fmt.Errorf("set approval delegations: %w", err)
+	}
+
+	// Export leave amendments
+	leaveAmendmentsQuery := client.LeaveAmendment.Query()
+	if !full {
+		leaveAmendmentsQuery = leaveAmendmentsQuery.Where(leaveamendment.TenantIDEQ(tenantID))
+	}
+	leaveAmendments, err := leaveAmendmentsQuery.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export leave amendments: %w", err)
+	}
+	if err := backup.SetEntities(a, "leaveAmendments", leaveAmendments); err != nil {
+		return nil, fmt.Errorf("set leave amendments: %w", err)
+	}
+
+	// Export leave attachments; only their metadata, the contents stay in the attachment store
+	leaveAttachmentsQuery := client.LeaveAttachment.Query()
+	if !full {
+		leaveAttachmentsQuery = leaveAttachmentsQuery.Where(leaveattachment.TenantIDEQ(tenantID))
+	}
+	leaveAttachments, err := leaveAttachmentsQuery.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export leave attachments: %w", err)
+	}
+	if err := backup.SetEntities(a, "leaveAttachments", leaveAttachments); err != nil {
+		return nil, fmt.Errorf("set leave attachments: %w", err)
+	}
+
+	// Export leave comments
+	leaveCommentsQuery := client.LeaveComment.Query()
+	if !full {
+		leaveCommentsQuery = leaveCommentsQuery.Where(leavecomment.TenantIDEQ(tenantID))
+	}
+	leaveComments, err := leaveCommentsQuery.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export leave comments: %w", err)
+	}
+	if err := backup.SetEntities(a, "leaveComments", leaveComments); err != nil {
+		return nil, fmt.Errorf("set leave comments: %w", err)
+	}
+
+	// Export leave policies
+	leavePoliciesQuery := client.LeavePolicy.Query()
+	if !full {
+		leavePoliciesQuery = leavePoliciesQuery.Where(leavepolicy.TenantIDEQ(tenantID))
+	}
+	leavePolicies, err := leavePoliciesQuery.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export leave policies: %w", err)
+	}
+	if err := backup.SetEntities(a, "leavePolicies", leavePolicies); err != nil {
+		return nil, fmt.Errorf("set leave policies: %w", err)
+	}
+
+	// Export blackout periods
+	blackoutPeriodsQuery := client.BlackoutPeriod.Query()
+	if !full {
+		blackoutPeriodsQuery = blackoutPeriodsQuery.Where(blackoutperiod.TenantIDEQ(tenantID))
+	}
+	blackoutPeriods, err := blackoutPeriodsQuery.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export blackout periods: %w", err)
+	}
+	if err := backup.SetEntities(a, "blackoutPeriods", blackoutPeriods); err != nil {
+		return nil, fmt.Errorf("set blackout periods: %w", err)
+	}
+
+	// Export coverage rules
+	coverageRulesQuery := client.CoverageRule.Query()
+	if !full {
+		coverageRulesQuery = coverageRulesQuery.Where(coveragerule.TenantIDEQ(tenantID))
+	}
+	coverageRules, err := coverageRulesQuery.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export coverage rules: %w", err)
+	}
+	if err := backup.SetEntities(a, "coverageRules", coverageRules); err != nil {
+		return nil, fmt.Errorf("set coverage rules: %w", err)
+	}
+
 	// Export leave allowances
 	laQuery := client.LeaveAllowance.Query()
 	if !full {
@@ -108,6 +277,19 @@ func (s *BackupService) ExportBackup(ctx context.Context, req *hrV1.ExportBackup
 		return nil, fmt.Errorf("set leave requests: %w", err)
 	}
 
+	// Export allowance transactions
+	trQuery := client.AllowanceTransaction.Query()
+	if !full {
+		trQuery = trQuery.Where(allowancetransaction.TenantIDEQ(tenantID))
+	}
+	transactions, err := trQuery.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("export allowance transactions: %w", err)
+	}
+	if err := backup.SetEntities(a, "allowanceTransactions", transactions); err != nil {
+		return nil, fmt.Errorf("set allowance transactions: %w", err)
+	}
+
 	data, err := backup.Pack(a)
 	if err != nil {
 		return nil, fmt.Errorf("pack backup: %w", err)
@@ -159,11 +341,24 @@ func (s *BackupService) ImportBackup(ctx context.Context, req *hrV1.ImportBackup
 	client := s.entClient.Client()
 	result := backup.NewRestoreResult(sourceVersion, backupSchemaVersion, applied)
 
-	// Import in FK dependency order: pools → absenceTypes → allowances → requests
+	// Import in FK dependency order: pools → absenceTypes → holidayCalendars → holidays → workSchedules → workScheduleAssignments → employments → allowances → requests → approvalDelegations → leaveAmendments → leaveAttachments → leaveComments → leavePolicies → blackoutPeriods → coverageRules
 	s.importAllowancePools(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
 	s.importAbsenceTypes(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
+	s.importHolidayCalendars(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
+	s.importHolidays(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
+	s.importWorkSchedules(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
+	s.importWorkScheduleAssignments(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
+	s.importEmployments(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
 	s.importLeaveAllowances(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
 	s.importLeaveRequests(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
+	s.importApprovalDelegations(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
+	s.importLeaveAmendments(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
+	s.importLeaveAttachments(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
+	s.importLeaveComments(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
+	s.importLeavePolicies(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
+	s.importBlackoutPeriods(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
+	s.importCoverageRules(ctx, client, a, tenantID, a.Manifest.FullBackup, mode, result)
+	s.importAllowanceTransactions(ctx, client, a, tenantID, a.Manifest.FullBackup, result)
 
 	s.log.Infof("imported backup: module=%s tenant=%d migrations=%d results=%d",
 		backupModule, tenantID, applied, len(result.Results))
@@ -234,6 +429,8 @@ func (s *BackupService) importAllowancePools(ctx context.Context, client *ent.Cl
 				SetDescription(e.Description).
 				SetColor(e.Color).
 				SetIcon(e.Icon).
+				SetAccrualPolicy(e.AccrualPolicy).
+				SetRolloverPolicy(e.RolloverPolicy).
 				SetNillableCreateBy(e.CreateBy).
 				Save(ctx)
 			if err != nil {
@@ -250,6 +447,8 @@ func (s *BackupService) importAllowancePools(ctx context.Context, client *ent.Cl
 				SetDescription(e.Description).
 				SetColor(e.Color).
 				SetIcon(e.Icon).
+				SetAccrualPolicy(e.AccrualPolicy).
+				SetRolloverPolicy(e.RolloverPolicy).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableCreateTime(e.CreateTime).
 				Save(ctx)
@@ -308,6 +507,12 @@ func (s *BackupService) importAbsenceTypes(ctx context.Context, client *ent.Clie
 				SetRequiresSigning(e.RequiresSigning).
 				SetSigningTemplateID(e.SigningTemplateID).
 				SetAllowancePoolID(e.AllowancePoolID).
+				SetUnit(e.Unit).
+				SetAccrualPolicy(e.AccrualPolicy).
+				SetRolloverPolicy(e.RolloverPolicy).
+				SetApprovalChain(e.ApprovalChain).
+				SetEscalationPolicy(e.EscalationPolicy).
+				SetRequiresAttachment(e.RequiresAttachment).
 				SetNillableCreateBy(e.CreateBy).
 				Save(ctx)
 			if err != nil {
@@ -332,6 +537,12 @@ func (s *BackupService) importAbsenceTypes(ctx context.Context, client *ent.Clie
 				SetRequiresSigning(e.RequiresSigning).
 				SetSigningTemplateID(e.SigningTemplateID).
 				SetAllowancePoolID(e.AllowancePoolID).
+				SetUnit(e.Unit).
+				SetAccrualPolicy(e.AccrualPolicy).
+				SetRolloverPolicy(e.RolloverPolicy).
+				SetApprovalChain(e.ApprovalChain).
+				SetEscalationPolicy(e.EscalationPolicy).
+				SetRequiresAttachment(e.RequiresAttachment).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableCreateTime(e.CreateTime).
 				Save(ctx)
@@ -347,27 +558,27 @@ func (s *BackupService) importAbsenceTypes(ctx context.Context, client *ent.Clie
 	result.AddResult(er)
 }
 
-func (s *BackupService) importLeaveAllowances(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
-	allowances, err := backup.GetEntities[ent.LeaveAllowance](a, "leaveAllowances")
+func (s *BackupService) importHolidayCalendars(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+	calendars, err := backup.GetEntities[ent.HolidayCalendar](a, "holidayCalendars")
 	if err != nil {
-		result.AddWarning(fmt.Sprintf("leaveAllowances: unmarshal error: %v", err))
+		result.AddWarning(fmt.Sprintf("holidayCalendars: unmarshal error: %v", err))
 		return
 	}
-	if len(allowances) == 0 {
+	if len(calendars) == 0 {
 		return
 	}
 
-	er := backup.EntityResult{EntityType: "leaveAllowances", Total: int64(len(allowances))}
+	er := backup.EntityResult{EntityType: "holidayCalendars", Total: int64(len(calendars))}
 
-	for _, e := range allowances {
+	for _, e := range calendars {
 		tid := tenantID
 		if full && e.TenantID != nil {
 			tid = *e.TenantID
 		}
 
-		existing, getErr := client.LeaveAllowance.Get(ctx, e.ID)
+		existing, getErr := client.HolidayCalendar.Get(ctx, e.ID)
 		if getErr != nil && !ent.IsNotFound(getErr) {
-			result.AddWarning(fmt.Sprintf("leaveAllowances: lookup %s: %v", e.ID, getErr))
+			result.AddWarning(fmt.Sprintf("holidayCalendars: lookup %s: %v", e.ID, getErr))
 			er.Failed++
 			continue
 		}
@@ -377,42 +588,36 @@ func (s *BackupService) importLeaveAllowances(ctx context.Context, client *ent.C
 				er.Skipped++
 				continue
 			}
-			_, err := client.LeaveAllowance.UpdateOneID(e.ID).
-				SetUserID(e.UserID).
-				SetUserName(e.UserName).
-				SetNillableAbsenceTypeID(e.AbsenceTypeID).
-				SetNillableAllowancePoolID(e.AllowancePoolID).
-				SetYear(e.Year).
-				SetTotalDays(e.TotalDays).
-				SetUsedDays(e.UsedDays).
-				SetCarriedOver(e.CarriedOver).
-				SetNotes(e.Notes).
+			_, err := client.HolidayCalendar.UpdateOneID(e.ID).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetCountryCode(e.CountryCode).
+				SetRegion(e.Region).
+				SetIsDefault(e.IsDefault).
+				SetOrgUnitNames(e.OrgUnitNames).
 				SetNillableCreateBy(e.CreateBy).
 				Save(ctx)
 			if err != nil {
-				result.AddWarning(fmt.Sprintf("leaveAllowances: update %s: %v", e.ID, err))
+				result.AddWarning(fmt.Sprintf("holidayCalendars: update %s: %v", e.ID, err))
 				er.Failed++
 				continue
 			}
 			er.Updated++
 		} else {
-			_, err := client.LeaveAllowance.Create().
+			_, err := client.HolidayCalendar.Create().
 				SetID(e.ID).
 				SetNillableTenantID(&tid).
-				SetUserID(e.UserID).
-				SetUserName(e.UserName).
-				SetNillableAbsenceTypeID(e.AbsenceTypeID).
-				SetNillableAllowancePoolID(e.AllowancePoolID).
-				SetYear(e.Year).
-				SetTotalDays(e.TotalDays).
-				SetUsedDays(e.UsedDays).
-				SetCarriedOver(e.CarriedOver).
-				SetNotes(e.Notes).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetCountryCode(e.CountryCode).
+				SetRegion(e.Region).
+				SetIsDefault(e.IsDefault).
+				SetOrgUnitNames(e.OrgUnitNames).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableCreateTime(e.CreateTime).
 				Save(ctx)
 			if err != nil {
-				result.AddWarning(fmt.Sprintf("leaveAllowances: create %s: %v", e.ID, err))
+				result.AddWarning(fmt.Sprintf("holidayCalendars: create %s: %v", e.ID, err))
 				er.Failed++
 				continue
 			}
@@ -423,27 +628,27 @@ func (s *BackupService) importLeaveAllowances(ctx context.Context, client *ent.C
 	result.AddResult(er)
 }
 
-func (s *BackupService) importLeaveRequests(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
-	requests, err := backup.GetEntities[ent.LeaveRequest](a, "leaveRequests")
+func (s *BackupService) importHolidays(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+	holidays, err := backup.GetEntities[ent.Holiday](a, "holidays")
 	if err != nil {
-		result.AddWarning(fmt.Sprintf("leaveRequests: unmarshal error: %v", err))
+		result.AddWarning(fmt.Sprintf("holidays: unmarshal error: %v", err))
 		return
 	}
-	if len(requests) == 0 {
+	if len(holidays) == 0 {
 		return
 	}
 
-	er := backup.EntityResult{EntityType: "leaveRequests", Total: int64(len(requests))}
+	er := backup.EntityResult{EntityType: "holidays", Total: int64(len(holidays))}
 
-	for _, e := range requests {
+	for _, e := range holidays {
 		tid := tenantID
 		if full && e.TenantID != nil {
 			tid = *e.TenantID
 		}
 
-		existing, getErr := client.LeaveRequest.Get(ctx, e.ID)
+		existing, getErr := client.Holiday.Get(ctx, e.ID)
 		if getErr != nil && !ent.IsNotFound(getErr) {
-			result.AddWarning(fmt.Sprintf("leaveRequests: lookup %s: %v", e.ID, getErr))
+			result.AddWarning(fmt.Sprintf("holidays: lookup %s: %v", e.ID, getErr))
 			er.Failed++
 			continue
 		}
@@ -453,60 +658,164 @@ func (s *BackupService) importLeaveRequests(ctx context.Context, client *ent.Cli
 				er.Skipped++
 				continue
 			}
-			_, err := client.LeaveRequest.UpdateOneID(e.ID).
+			_, err := client.Holiday.UpdateOneID(e.ID).
+				SetCalendarID(e.CalendarID).
+				SetName(e.Name).
+				SetDate(e.Date).
+				SetRecurring(e.Recurring).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("holidays: update %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Updated++
+		} else {
+			_, err := client.Holiday.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetCalendarID(e.CalendarID).
+				SetName(e.Name).
+				SetDate(e.Date).
+				SetRecurring(e.Recurring).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("holidays: create %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Created++
+		}
+	}
+
+	result.AddResult(er)
+}
+
+func (s *BackupService) importWorkSchedules(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+	workSchedules, err := backup.GetEntities[ent.WorkSchedule](a, "workSchedules")
+	if err != nil {
+		result.AddWarning(fmt.Sprintf("workSchedules: unmarshal error: %v", err))
+		return
+	}
+	if len(workSchedules) == 0 {
+		return
+	}
+
+	er := backup.EntityResult{EntityType: "workSchedules", Total: int64(len(workSchedules))}
+
+	for _, e := range workSchedules {
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, getErr := client.WorkSchedule.Get(ctx, e.ID)
+		if getErr != nil && !ent.IsNotFound(getErr) {
+			result.AddWarning(fmt.Sprintf("workSchedules: lookup %s: %v", e.ID, getErr))
+			er.Failed++
+			continue
+		}
+
+		if existing != nil {
+			if mode == backup.RestoreModeSkip {
+				er.Skipped++
+				continue
+			}
+			_, err := client.WorkSchedule.UpdateOneID(e.ID).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetDayHours(e.DayHours).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("workSchedules: update %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Updated++
+		} else {
+			_, err := client.WorkSchedule.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetDayHours(e.DayHours).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("workSchedules: create %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Created++
+		}
+	}
+
+	result.AddResult(er)
+}
+
+func (s *BackupService) importWorkScheduleAssignments(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+	workScheduleAssignments, err := backup.GetEntities[ent.WorkScheduleAssignment](a, "workScheduleAssignments")
+	if err != nil {
+		result.AddWarning(fmt.Sprintf("workScheduleAssignments: unmarshal error: %v", err))
+		return
+	}
+	if len(workScheduleAssignments) == 0 {
+		return
+	}
+
+	er := backup.EntityResult{EntityType: "workScheduleAssignments", Total: int64(len(workScheduleAssignments))}
+
+	for _, e := range workScheduleAssignments {
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, getErr := client.WorkScheduleAssignment.Get(ctx, e.ID)
+		if getErr != nil && !ent.IsNotFound(getErr) {
+			result.AddWarning(fmt.Sprintf("workScheduleAssignments: lookup %s: %v", e.ID, getErr))
+			er.Failed++
+			continue
+		}
+
+		if existing != nil {
+			if mode == backup.RestoreModeSkip {
+				er.Skipped++
+				continue
+			}
+			_, err := client.WorkScheduleAssignment.UpdateOneID(e.ID).
+				SetScheduleID(e.ScheduleID).
 				SetUserID(e.UserID).
 				SetUserName(e.UserName).
-				SetUserEmail(e.UserEmail).
 				SetOrgUnitName(e.OrgUnitName).
-				SetAbsenceTypeID(e.AbsenceTypeID).
-				SetStartDate(e.StartDate).
-				SetEndDate(e.EndDate).
-				SetDays(e.Days).
-				SetStatus(e.Status).
-				SetSigningRequestID(e.SigningRequestID).
-				SetReason(e.Reason).
-				SetReviewNotes(e.ReviewNotes).
-				SetReviewedBy(e.ReviewedBy).
-				SetReviewerName(e.ReviewerName).
-				SetNillableReviewedAt(e.ReviewedAt).
-				SetNotes(e.Notes).
-				SetMetadata(e.Metadata).
-				SetDeductedAllowanceID(e.DeductedAllowanceID).
+				SetEffectiveFrom(e.EffectiveFrom).
 				SetNillableCreateBy(e.CreateBy).
 				Save(ctx)
 			if err != nil {
-				result.AddWarning(fmt.Sprintf("leaveRequests: update %s: %v", e.ID, err))
+				result.AddWarning(fmt.Sprintf("workScheduleAssignments: update %s: %v", e.ID, err))
 				er.Failed++
 				continue
 			}
 			er.Updated++
 		} else {
-			_, err := client.LeaveRequest.Create().
+			_, err := client.WorkScheduleAssignment.Create().
 				SetID(e.ID).
 				SetNillableTenantID(&tid).
+				SetScheduleID(e.ScheduleID).
 				SetUserID(e.UserID).
 				SetUserName(e.UserName).
-				SetUserEmail(e.UserEmail).
 				SetOrgUnitName(e.OrgUnitName).
-				SetAbsenceTypeID(e.AbsenceTypeID).
-				SetStartDate(e.StartDate).
-				SetEndDate(e.EndDate).
-				SetDays(e.Days).
-				SetStatus(e.Status).
-				SetSigningRequestID(e.SigningRequestID).
-				SetReason(e.Reason).
-				SetReviewNotes(e.ReviewNotes).
-				SetReviewedBy(e.ReviewedBy).
-				SetReviewerName(e.ReviewerName).
-				SetNillableReviewedAt(e.ReviewedAt).
-				SetNotes(e.Notes).
-				SetMetadata(e.Metadata).
-				SetDeductedAllowanceID(e.DeductedAllowanceID).
+				SetEffectiveFrom(e.EffectiveFrom).
 				SetNillableCreateBy(e.CreateBy).
 				SetNillableCreateTime(e.CreateTime).
 				Save(ctx)
 			if err != nil {
-				result.AddWarning(fmt.Sprintf("leaveRequests: create %s: %v", e.ID, err))
+				result.AddWarning(fmt.Sprintf("workScheduleAssignments: create %s: %v", e.ID, err))
 				er.Failed++
 				continue
 			}
@@ -516,3 +825,882 @@ func (s *BackupService) importLeaveRequests(ctx context.Context, client *ent.Cli
 
 	result.AddResult(er)
 }
+
+func (s *BackupService) importEmployments(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+	employments, err := backup.GetEntities[ent.Employment](a, "employments")
+	if err != nil {
+		result.AddWarning(fmt.Sprintf("employments: unmarshal error: %v", err))
+		return
+	}
+	if len(employments) == 0 {
+		return
+	}
+
+	er := backup.EntityResult{EntityType: "employments", Total: int64(len(employments))}
+
+	for _, e := range employments {
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, getErr := client.Employment.Get(ctx, e.ID)
+		if getErr != nil && !ent.IsNotFound(getErr) {
+			result.AddWarning(fmt.Sprintf("employments: lookup %s: %v", e.ID, getErr))
+			er.Failed++
+			continue
+		}
+
+		if existing != nil {
+			if mode == backup.RestoreModeSkip {
+				er.Skipped++
+				continue
+			}
+			_, err := client.Employment.UpdateOneID(e.ID).
+				SetUserID(e.UserID).
+				SetUserName(e.UserName).
+				SetStartDate(e.StartDate).
+				SetNillableEndDate(e.EndDate).
+				SetNotes(e.Notes).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("employments: update %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Updated++
+		} else {
+			_, err := client.Employment.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetUserID(e.UserID).
+				SetUserName(e.UserName).
+				SetStartDate(e.StartDate).
+				SetNillableEndDate(e.EndDate).
+				SetNotes(e.Notes).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("employments: create %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Created++
+		}
+	}
+
+	result.AddResult(er)
+}
+
+func (s *BackupService) importLeaveAllowances(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+	allowances, err := backup.GetEntities[ent.LeaveAllowance](a, "leaveAllowances")
+	if err != nil {
+		result.AddWarning(fmt.Sprintf("leaveAllowances: unmarshal error: %v", err))
+		return
+	}
+	if len(allowances) == 0 {
+		return
+	}
+
+	er := backup.EntityResult{EntityType: "leaveAllowances", Total: int64(len(allowances))}
+
+	for _, e := range allowances {
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, getErr := client.LeaveAllowance.Get(ctx, e.ID)
+		if getErr != nil && !ent.IsNotFound(getErr) {
+			result.AddWarning(fmt.Sprintf("leaveAllowances: lookup %s: %v", e.ID, getErr))
+			er.Failed++
+			continue
+		}
+
+		if existing != nil {
+			if mode == backup.RestoreModeSkip {
+				er.Skipped++
+				continue
+			}
+			_, err := client.LeaveAllowance.UpdateOneID(e.ID).
+				SetUserID(e.UserID).
+				SetUserName(e.UserName).
+				SetNillableAbsenceTypeID(e.AbsenceTypeID).
+				SetNillableAllowancePoolID(e.AllowancePoolID).
+				SetYear(e.Year).
+				SetTotalDays(e.TotalDays).
+				SetNillableAnnualDays(e.AnnualDays).
+				SetUsedDays(e.UsedDays).
+				SetCarriedOver(e.CarriedOver).
+				SetNillableCarryOverExpiry(e.CarryOverExpiry).
+				SetOverconsumedDays(e.OverconsumedDays).
+				SetAccruedDays(e.AccruedDays).
+				SetNillableAccrualStart(e.AccrualStart).
+				SetNotes(e.Notes).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("leaveAllowances: update %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Updated++
+		} else {
+			_, err := client.LeaveAllowance.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetUserID(e.UserID).
+				SetUserName(e.UserName).
+				SetNillableAbsenceTypeID(e.AbsenceTypeID).
+				SetNillableAllowancePoolID(e.AllowancePoolID).
+				SetYear(e.Year).
+				SetTotalDays(e.TotalDays).
+				SetNillableAnnualDays(e.AnnualDays).
+				SetUsedDays(e.UsedDays).
+				SetCarriedOver(e.CarriedOver).
+				SetNillableCarryOverExpiry(e.CarryOverExpiry).
+				SetOverconsumedDays(e.OverconsumedDays).
+				SetAccruedDays(e.AccruedDays).
+				SetNillableAccrualStart(e.AccrualStart).
+				SetNotes(e.Notes).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("leaveAllowances: create %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Created++
+		}
+	}
+
+	result.AddResult(er)
+}
+
+func (s *BackupService) importLeaveRequests(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+	requests, err := backup.GetEntities[ent.LeaveRequest](a, "leaveRequests")
+	if err != nil {
+		result.AddWarning(fmt.Sprintf("leaveRequests: unmarshal error: %v", err))
+		return
+	}
+	if len(requests) == 0 {
+		return
+	}
+
+	er := backup.EntityResult{EntityType: "leaveRequests", Total: int64(len(requests))}
+
+	for _, e := range requests {
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, getErr := client.LeaveRequest.Get(ctx, e.ID)
+		if getErr != nil && !ent.IsNotFound(getErr) {
+			result.AddWarning(fmt.Sprintf("leaveRequests: lookup %s: %v", e.ID, getErr))
+			er.Failed++
+			continue
+		}
+
+		if existing != nil {
+			if mode == backup.RestoreModeSkip {
+				er.Skipped++
+				continue
+			}
+			_, err := client.LeaveRequest.UpdateOneID(e.ID).
+				SetUserID(e.UserID).
+				SetUserName(e.UserName).
+				SetUserEmail(e.UserEmail).
+				SetOrgUnitName(e.OrgUnitName).
+				SetAbsenceTypeID(e.AbsenceTypeID).
+				SetStartDate(e.StartDate).
+				SetEndDate(e.EndDate).
+				SetDays(e.Days).
+				SetStatus(e.Status).
+				SetSigningRequestID(e.SigningRequestID).
+				SetReason(e.Reason).
+				SetReviewNotes(e.ReviewNotes).
+				SetReviewedBy(e.ReviewedBy).
+				SetReviewerName(e.ReviewerName).
+				SetNillableReviewedAt(e.ReviewedAt).
+				SetNotes(e.Notes).
+				SetMetadata(e.Metadata).
+				SetDeductedAllowanceID(e.DeductedAllowanceID).
+				SetDeductions(e.Deductions).
+				SetApprovalSteps(e.ApprovalSteps).
+				SetApprovalStep(e.ApprovalStep).
+				SetApproverID(e.ApproverID).
+				SetOnBehalfOf(e.OnBehalfOf).
+				SetOnBehalfOfName(e.OnBehalfOfName).
+				SetNillableAwaitingSince(e.AwaitingSince).
+				SetEscalationLevel(e.EscalationLevel).
+				SetPolicyOverride(e.PolicyOverride).
+				SetAttachmentCount(e.AttachmentCount).
+				SetSeriesID(e.SeriesID).
+				SetRecurrence(e.Recurrence).
+				SetNillableSigningOutcome(e.SigningOutcome).
+				SetSigningOutcomeReason(e.SigningOutcomeReason).
+				SetNillableSigningEndedAt(e.SigningEndedAt).
+				SetStartDayPart(e.StartDayPart).
+				SetEndDayPart(e.EndDayPart).
+				SetHours(e.Hours).
+				SetHolidayCalendarID(e.HolidayCalendarID).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("leaveRequests: update %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Updated++
+		} else {
+			_, err := client.LeaveRequest.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetUserID(e.UserID).
+				SetUserName(e.UserName).
+				SetUserEmail(e.UserEmail).
+				SetOrgUnitName(e.OrgUnitName).
+				SetAbsenceTypeID(e.AbsenceTypeID).
+				SetStartDate(e.StartDate).
+				SetEndDate(e.EndDate).
+				SetDays(e.Days).
+				SetStatus(e.Status).
+				SetSigningRequestID(e.SigningRequestID).
+				SetReason(e.Reason).
+				SetReviewNotes(e.ReviewNotes).
+				SetReviewedBy(e.ReviewedBy).
+				SetReviewerName(e.ReviewerName).
+				SetNillableReviewedAt(e.ReviewedAt).
+				SetNotes(e.Notes).
+				SetMetadata(e.Metadata).
+				SetDeductedAllowanceID(e.DeductedAllowanceID).
+				SetDeductions(e.Deductions).
+				SetApprovalSteps(e.ApprovalSteps).
+				SetApprovalStep(e.ApprovalStep).
+				SetApproverID(e.ApproverID).
+				SetOnBehalfOf(e.OnBehalfOf).
+				SetOnBehalfOfName(e.OnBehalfOfName).
+				SetNillableAwaitingSince(e.AwaitingSince).
+				SetEscalationLevel(e.EscalationLevel).
+				SetPolicyOverride(e.PolicyOverride).
+				SetAttachmentCount(e.AttachmentCount).
+				SetSeriesID(e.SeriesID).
+				SetRecurrence(e.Recurrence).
+				SetNillableSigningOutcome(e.SigningOutcome).
+				SetSigningOutcomeReason(e.SigningOutcomeReason).
+				SetNillableSigningEndedAt(e.SigningEndedAt).
+				SetStartDayPart(e.StartDayPart).
+				SetEndDayPart(e.EndDayPart).
+				SetHours(e.Hours).
+				SetHolidayCalendarID(e.HolidayCalendarID).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("leaveRequests: create %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Created++
+		}
+	}
+
+	result.AddResult(er)
+}
+
+// importAllowanceTransactions restores ledger entries. Entries are immutable, so entries that
+// already exist are skipped regardless of the restore mode.
+func (s *BackupService) importApprovalDelegations(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+	approvalDelegations, err := backup.GetEntities[ent.ApprovalDelegation](a, "approvalDelegations")
+	if err != nil {
+		result.AddWarning(fmt.Sprintf("approvalDelegations: unmarshal error: %v", err))
+		return
+	}
+	if len(approvalDelegations) == 0 {
+		return
+	}
+
+	er := backup.EntityResult{EntityType: "approvalDelegations", Total: int64(len(approvalDelegations))}
+
+	for _, e := range approvalDelegations {
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, getErr := client.ApprovalDelegation.Get(ctx, e.ID)
+		if getErr != nil && !ent.IsNotFound(getErr) {
+			result.AddWarning(fmt.Sprintf("approvalDelegations: lookup %s: %v", e.ID, getErr))
+			er.Failed++
+			continue
+		}
+
+		if existing != nil {
+			if mode == backup.RestoreModeSkip {
+				er.Skipped++
+				continue
+			}
+			_, err := client.ApprovalDelegation.UpdateOneID(e.ID).
+				SetDelegatorID(e.DelegatorID).
+				SetDelegatorName(e.DelegatorName).
+				SetDelegateID(e.DelegateID).
+				SetDelegateName(e.DelegateName).
+				SetStartDate(e.StartDate).
+				SetEndDate(e.EndDate).
+				SetAbsenceTypeIds(e.AbsenceTypeIds).
+				SetLeaveRequestID(e.LeaveRequestID).
+				SetNotes(e.Notes).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("approvalDelegations: update %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Updated++
+		} else {
+			_, err := client.ApprovalDelegation.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetDelegatorID(e.DelegatorID).
+				SetDelegatorName(e.DelegatorName).
+				SetDelegateID(e.DelegateID).
+				SetDelegateName(e.DelegateName).
+				SetStartDate(e.StartDate).
+				SetEndDate(e.EndDate).
+				SetAbsenceTypeIds(e.AbsenceTypeIds).
+				SetLeaveRequestID(e.LeaveRequestID).
+				SetNotes(e.Notes).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("approvalDelegations: create %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Created++
+		}
+	}
+
+	result.AddResult(er)
+}
+
+func (s *BackupService) importLeaveAmendments(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+	leaveAmendments, err := backup.GetEntities[ent.LeaveAmendment](a, "leaveAmendments")
+	if err != nil {
+		result.AddWarning(fmt.Sprintf("leaveAmendments: unmarshal error: %v", err))
+		return
+	}
+	if len(leaveAmendments) == 0 {
+		return
+	}
+
+	er := backup.EntityResult{EntityType: "leaveAmendments", Total: int64(len(leaveAmendments))}
+
+	for _, e := range leaveAmendments {
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, getErr := client.LeaveAmendment.Get(ctx, e.ID)
+		if getErr != nil && !ent.IsNotFound(getErr) {
+			result.AddWarning(fmt.Sprintf("leaveAmendments: lookup %s: %v", e.ID, getErr))
+			er.Failed++
+			continue
+		}
+
+		if existing != nil {
+			if mode == backup.RestoreModeSkip {
+				er.Skipped++
+				continue
+			}
+			_, err := client.LeaveAmendment.UpdateOneID(e.ID).
+				SetLeaveRequestID(e.LeaveRequestID).
+				SetUserID(e.UserID).
+				SetKind(e.Kind).
+				SetStatus(e.Status).
+				SetPreviousStartDate(e.PreviousStartDate).
+				SetPreviousEndDate(e.PreviousEndDate).
+				SetPreviousStartDayPart(e.PreviousStartDayPart).
+				SetPreviousEndDayPart(e.PreviousEndDayPart).
+				SetPreviousDays(e.PreviousDays).
+				SetStartDate(e.StartDate).
+				SetEndDate(e.EndDate).
+				SetStartDayPart(e.StartDayPart).
+				SetEndDayPart(e.EndDayPart).
+				SetHours(e.Hours).
+				SetDays(e.Days).
+				SetHolidayCalendarID(e.HolidayCalendarID).
+				SetReason(e.Reason).
+				SetReviewedBy(e.ReviewedBy).
+				SetReviewerName(e.ReviewerName).
+				SetNillableReviewedAt(e.ReviewedAt).
+				SetReviewNotes(e.ReviewNotes).
+				SetSigningRequestID(e.SigningRequestID).
+				SetPolicyOverride(e.PolicyOverride).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("leaveAmendments: update %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Updated++
+		} else {
+			_, err := client.LeaveAmendment.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetLeaveRequestID(e.LeaveRequestID).
+				SetUserID(e.UserID).
+				SetKind(e.Kind).
+				SetStatus(e.Status).
+				SetPreviousStartDate(e.PreviousStartDate).
+				SetPreviousEndDate(e.PreviousEndDate).
+				SetPreviousStartDayPart(e.PreviousStartDayPart).
+				SetPreviousEndDayPart(e.PreviousEndDayPart).
+				SetPreviousDays(e.PreviousDays).
+				SetStartDate(e.StartDate).
+				SetEndDate(e.EndDate).
+				SetStartDayPart(e.StartDayPart).
+				SetEndDayPart(e.EndDayPart).
+				SetHours(e.Hours).
+				SetDays(e.Days).
+				SetHolidayCalendarID(e.HolidayCalendarID).
+				SetReason(e.Reason).
+				SetReviewedBy(e.ReviewedBy).
+				SetReviewerName(e.ReviewerName).
+				SetNillableReviewedAt(e.ReviewedAt).
+				SetReviewNotes(e.ReviewNotes).
+				SetSigningRequestID(e.SigningRequestID).
+				SetPolicyOverride(e.PolicyOverride).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("leaveAmendments: create %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Created++
+		}
+	}
+
+	result.AddResult(er)
+}
+
+func (s *BackupService) importLeaveAttachments(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+	leaveAttachments, err := backup.GetEntities[ent.LeaveAttachment](a, "leaveAttachments")
+	if err != nil {
+		result.AddWarning(fmt.Sprintf("leaveAttachments: unmarshal error: %v", err))
+		return
+	}
+	if len(leaveAttachments) == 0 {
+		return
+	}
+
+	er := backup.EntityResult{EntityType: "leaveAttachments", Total: int64(len(leaveAttachments))}
+
+	for _, e := range leaveAttachments {
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, getErr := client.LeaveAttachment.Get(ctx, e.ID)
+		if getErr != nil && !ent.IsNotFound(getErr) {
+			result.AddWarning(fmt.Sprintf("leaveAttachments: lookup %s: %v", e.ID, getErr))
+			er.Failed++
+			continue
+		}
+
+		if existing != nil {
+			if mode == backup.RestoreModeSkip {
+				er.Skipped++
+				continue
+			}
+			_, err := client.LeaveAttachment.UpdateOneID(e.ID).
+				SetLeaveRequestID(e.LeaveRequestID).
+				SetFileName(e.FileName).
+				SetContentType(e.ContentType).
+				SetSize(e.Size).
+				SetChecksum(e.Checksum).
+				SetStorageKey(e.StorageKey).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("leaveAttachments: update %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Updated++
+		} else {
+			_, err := client.LeaveAttachment.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetLeaveRequestID(e.LeaveRequestID).
+				SetFileName(e.FileName).
+				SetContentType(e.ContentType).
+				SetSize(e.Size).
+				SetChecksum(e.Checksum).
+				SetStorageKey(e.StorageKey).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("leaveAttachments: create %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Created++
+		}
+	}
+
+	result.AddResult(er)
+}
+
+func (s *BackupService) importLeaveComments(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+	leaveComments, err := backup.GetEntities[ent.LeaveComment](a, "leaveComments")
+	if err != nil {
+		result.AddWarning(fmt.Sprintf("leaveComments: unmarshal error: %v", err))
+		return
+	}
+	if len(leaveComments) == 0 {
+		return
+	}
+
+	er := backup.EntityResult{EntityType: "leaveComments", Total: int64(len(leaveComments))}
+
+	for _, e := range leaveComments {
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, getErr := client.LeaveComment.Get(ctx, e.ID)
+		if getErr != nil && !ent.IsNotFound(getErr) {
+			result.AddWarning(fmt.Sprintf("leaveComments: lookup %s: %v", e.ID, getErr))
+			er.Failed++
+			continue
+		}
+
+		if existing != nil {
+			if mode == backup.RestoreModeSkip {
+				er.Skipped++
+				continue
+			}
+			_, err := client.LeaveComment.UpdateOneID(e.ID).
+				SetLeaveRequestID(e.LeaveRequestID).
+				SetAuthorID(e.AuthorID).
+				SetAuthorName(e.AuthorName).
+				SetBody(e.Body).
+				SetVisibility(e.Visibility).
+				SetEdits(e.Edits).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("leaveComments: update %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Updated++
+		} else {
+			_, err := client.LeaveComment.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetLeaveRequestID(e.LeaveRequestID).
+				SetAuthorID(e.AuthorID).
+				SetAuthorName(e.AuthorName).
+				SetBody(e.Body).
+				SetVisibility(e.Visibility).
+				SetEdits(e.Edits).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("leaveComments: create %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Created++
+		}
+	}
+
+	result.AddResult(er)
+}
+
+func (s *BackupService) importLeavePolicies(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+	leavePolicies, err := backup.GetEntities[ent.LeavePolicy](a, "leavePolicies")
+	if err != nil {
+		result.AddWarning(fmt.Sprintf("leavePolicies: unmarshal error: %v", err))
+		return
+	}
+	if len(leavePolicies) == 0 {
+		return
+	}
+
+	er := backup.EntityResult{EntityType: "leavePolicies", Total: int64(len(leavePolicies))}
+
+	for _, e := range leavePolicies {
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, getErr := client.LeavePolicy.Get(ctx, e.ID)
+		if getErr != nil && !ent.IsNotFound(getErr) {
+			result.AddWarning(fmt.Sprintf("leavePolicies: lookup %s: %v", e.ID, getErr))
+			er.Failed++
+			continue
+		}
+
+		if existing != nil {
+			if mode == backup.RestoreModeSkip {
+				er.Skipped++
+				continue
+			}
+			_, err := client.LeavePolicy.UpdateOneID(e.ID).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetAbsenceTypeID(e.AbsenceTypeID).
+				SetOrgUnitName(e.OrgUnitName).
+				SetRules(e.Rules).
+				SetEnabled(e.Enabled).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("leavePolicies: update %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Updated++
+		} else {
+			_, err := client.LeavePolicy.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetAbsenceTypeID(e.AbsenceTypeID).
+				SetOrgUnitName(e.OrgUnitName).
+				SetRules(e.Rules).
+				SetEnabled(e.Enabled).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("leavePolicies: create %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Created++
+		}
+	}
+
+	result.AddResult(er)
+}
+
+func (s *BackupService) importBlackoutPeriods(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+	blackoutPeriods, err := backup.GetEntities[ent.BlackoutPeriod](a, "blackoutPeriods")
+	if err != nil {
+		result.AddWarning(fmt.Sprintf("blackoutPeriods: unmarshal error: %v", err))
+		return
+	}
+	if len(blackoutPeriods) == 0 {
+		return
+	}
+
+	er := backup.EntityResult{EntityType: "blackoutPeriods", Total: int64(len(blackoutPeriods))}
+
+	for _, e := range blackoutPeriods {
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, getErr := client.BlackoutPeriod.Get(ctx, e.ID)
+		if getErr != nil && !ent.IsNotFound(getErr) {
+			result.AddWarning(fmt.Sprintf("blackoutPeriods: lookup %s: %v", e.ID, getErr))
+			er.Failed++
+			continue
+		}
+
+		if existing != nil {
+			if mode == backup.RestoreModeSkip {
+				er.Skipped++
+				continue
+			}
+			_, err := client.BlackoutPeriod.UpdateOneID(e.ID).
+				SetName(e.Name).
+				SetStartDate(e.StartDate).
+				SetEndDate(e.EndDate).
+				SetAbsenceTypeIds(e.AbsenceTypeIds).
+				SetOrgUnitNames(e.OrgUnitNames).
+				SetNotes(e.Notes).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("blackoutPeriods: update %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Updated++
+		} else {
+			_, err := client.BlackoutPeriod.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetName(e.Name).
+				SetStartDate(e.StartDate).
+				SetEndDate(e.EndDate).
+				SetAbsenceTypeIds(e.AbsenceTypeIds).
+				SetOrgUnitNames(e.OrgUnitNames).
+				SetNotes(e.Notes).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("blackoutPeriods: create %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Created++
+		}
+	}
+
+	result.AddResult(er)
+}
+
+func (s *BackupService) importCoverageRules(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, mode backup.RestoreMode, result *backup.RestoreResult) {
+	coverageRules, err := backup.GetEntities[ent.CoverageRule](a, "coverageRules")
+	if err != nil {
+		result.AddWarning(fmt.Sprintf("coverageRules: unmarshal error: %v", err))
+		return
+	}
+	if len(coverageRules) == 0 {
+		return
+	}
+
+	er := backup.EntityResult{EntityType: "coverageRules", Total: int64(len(coverageRules))}
+
+	for _, e := range coverageRules {
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		existing, getErr := client.CoverageRule.Get(ctx, e.ID)
+		if getErr != nil && !ent.IsNotFound(getErr) {
+			result.AddWarning(fmt.Sprintf("coverageRules: lookup %s: %v", e.ID, getErr))
+			er.Failed++
+			continue
+		}
+
+		if existing != nil {
+			if mode == backup.RestoreModeSkip {
+				er.Skipped++
+				continue
+			}
+			_, err := client.CoverageRule.UpdateOneID(e.ID).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetOrgUnitName(e.OrgUnitName).
+				SetKind(e.Kind).
+				SetCount(e.Count).
+				SetPosition(e.Position).
+				SetEnforcement(e.Enforcement).
+				SetAbsenceTypeIds(e.AbsenceTypeIds).
+				SetEnabled(e.Enabled).
+				SetNillableCreateBy(e.CreateBy).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("coverageRules: update %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Updated++
+		} else {
+			_, err := client.CoverageRule.Create().
+				SetID(e.ID).
+				SetNillableTenantID(&tid).
+				SetName(e.Name).
+				SetDescription(e.Description).
+				SetOrgUnitName(e.OrgUnitName).
+				SetKind(e.Kind).
+				SetCount(e.Count).
+				SetPosition(e.Position).
+				SetEnforcement(e.Enforcement).
+				SetAbsenceTypeIds(e.AbsenceTypeIds).
+				SetEnabled(e.Enabled).
+				SetNillableCreateBy(e.CreateBy).
+				SetNillableCreateTime(e.CreateTime).
+				Save(ctx)
+			if err != nil {
+				result.AddWarning(fmt.Sprintf("coverageRules: create %s: %v", e.ID, err))
+				er.Failed++
+				continue
+			}
+			er.Created++
+		}
+	}
+
+	result.AddResult(er)
+}
+
+func (s *BackupService) importAllowanceTransactions(ctx context.Context, client *ent.Client, a *backup.Archive, tenantID uint32, full bool, result *backup.RestoreResult) {
+	transactions, err := backup.GetEntities[ent.AllowanceTransaction](a, "allowanceTransactions")
+	if err != nil {
+		result.AddWarning(fmt.Sprintf("allowanceTransactions: unmarshal error: %v", err))
+		return
+	}
+	if len(transactions) == 0 {
+		return
+	}
+
+	er := backup.EntityResult{EntityType: "allowanceTransactions", Total: int64(len(transactions))}
+
+	for _, e := range transactions {
+		tid := tenantID
+		if full && e.TenantID != nil {
+			tid = *e.TenantID
+		}
+
+		exists, err := client.AllowanceTransaction.Query().Where(allowancetransaction.ID(e.ID)).Exist(ctx)
+		if err != nil {
+			result.AddWarning(fmt.Sprintf("allowanceTransactions: lookup %s: %v", e.ID, err))
+			er.Failed++
+			continue
+		}
+		if exists {
+			er.Skipped++
+			continue
+		}
+
+		_, err = client.AllowanceTransaction.Create().
+			SetID(e.ID).
+			SetNillableTenantID(&tid).
+			SetAllowanceID(e.AllowanceID).
+			SetUserID(e.UserID).
+			SetYear(e.Year).
+			SetKind(e.Kind).
+			SetDays(e.Days).
+			SetBalanceAfter(e.BalanceAfter).
+			SetNillableLeaveRequestID(e.LeaveRequestID).
+			SetActorID(e.ActorID).
+			SetActorName(e.ActorName).
+			SetNote(e.Note).
+			SetNillableCreateTime(e.CreateTime).
+			Save(ctx)
+		if err != nil {
+			result.AddWarning(fmt.Sprintf("allowanceTransactions: create %s: %v", e.ID, err))
+			er.Failed++
+			continue
+		}
+		er.Created++
+	}
+
+	result.AddResult(er)
+}
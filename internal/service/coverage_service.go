@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-hr/internal/coverage"
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/coveragerule"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+type CoverageService struct {
+	hrV1.UnimplementedHrCoverageServiceServer
+
+	log             *log.Helper
+	coverageRepo    *data.CoverageRuleRepo
+	absenceTypeRepo *data.AbsenceTypeRepo
+}
+
+func NewCoverageService(ctx *bootstrap.Context, coverageRepo *data.CoverageRuleRepo, absenceTypeRepo *data.AbsenceTypeRepo) *CoverageService {
+	return &CoverageService{
+		log:             ctx.NewLoggerHelper("hr/service/coverage"),
+		coverageRepo:    coverageRepo,
+		absenceTypeRepo: absenceTypeRepo,
+	}
+}
+
+func (s *CoverageService) CreateCoverageRule(ctx context.Context, req *hrV1.CreateCoverageRuleRequest) (*hrV1.CreateCoverageRuleResponse, error) {
+	if err := checkPermission(ctx, "hr.policy.manage"); err != nil {
+		return nil, err
+	}
+
+	kind := coverageRuleKindToString(req.GetKind())
+	if err := validateCoverageRule(kind, int(req.GetCount()), req.GetPosition()); err != nil {
+		return nil, err
+	}
+	if err := s.checkAbsenceTypes(ctx, req.GetAbsenceTypeIds()); err != nil {
+		return nil, err
+	}
+
+	opts := []func(*ent.CoverageRuleCreate){}
+	if req.Description != nil {
+		opts = append(opts, func(c *ent.CoverageRuleCreate) { c.SetDescription(*req.Description) })
+	}
+	if req.Position != nil {
+		opts = append(opts, func(c *ent.CoverageRuleCreate) { c.SetPosition(*req.Position) })
+	}
+	if enforcement := coverageEnforcementToString(req.GetEnforcement()); enforcement != "" {
+		opts = append(opts, func(c *ent.CoverageRuleCreate) { c.SetEnforcement(coveragerule.Enforcement(enforcement)) })
+	}
+	if len(req.GetAbsenceTypeIds()) > 0 {
+		opts = append(opts, func(c *ent.CoverageRuleCreate) { c.SetAbsenceTypeIds(req.GetAbsenceTypeIds()) })
+	}
+	if req.Enabled != nil {
+		opts = append(opts, func(c *ent.CoverageRuleCreate) { c.SetEnabled(*req.Enabled) })
+	}
+
+	entity, err := s.coverageRepo.Create(ctx, getTenantID(ctx), req.GetName(), req.GetOrgUnitName(), kind, int(req.GetCount()), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.CreateCoverageRuleResponse{
+		Rule: coverageRuleToProto(entity),
+	}, nil
+}
+
+func (s *CoverageService) GetCoverageRule(ctx context.Context, req *hrV1.GetCoverageRuleRequest) (*hrV1.GetCoverageRuleResponse, error) {
+	if err := checkPermission(ctx, "hr.calendar.view"); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.coverageRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, hrV1.ErrorCoverageRuleNotFound("coverage rule not found")
+	}
+	if err := checkTenantAccess(ctx, entity.TenantID, hrV1.ErrorCoverageRuleNotFound("coverage rule not found")); err != nil {
+		return nil, err
+	}
+
+	return &hrV1.GetCoverageRuleResponse{
+		Rule: coverageRuleToProto(entity),
+	}, nil
+}
+
+func (s *CoverageService) ListCoverageRules(ctx context.Context, req *hrV1.ListCoverageRulesRequest) (*hrV1.ListCoverageRulesResponse, error) {
+	if err := checkPermission(ctx, "hr.calendar.view"); err != nil {
+		return nil, err
+	}
+
+	filters := make(map[string]interface{})
+	if req.Query != nil {
+		filters["query"] = *req.Query
+	}
+	if req.OrgUnitName != nil {
+		filters["org_unit_name"] = *req.OrgUnitName
+	}
+
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	if req.GetNoPaging() {
+		page = 0
+		pageSize = 0
+	}
+
+	entities, total, err := s.coverageRepo.List(ctx, getTenantID(ctx), page, pageSize, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.CoverageRule, len(entities))
+	for i, e := range entities {
+		items[i] = coverageRuleToProto(e)
+	}
+
+	return &hrV1.ListCoverageRulesResponse{
+		Items: items,
+		Total: ptrInt32(int32(total)),
+	}, nil
+}
+
+func (s *CoverageService) UpdateCoverageRule(ctx context.Context, req *hrV1.UpdateCoverageRuleRequest) (*hrV1.UpdateCoverageRuleResponse, error) {
+	if err := checkPermission(ctx, "hr.policy.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.coverageRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorCoverageRuleNotFound("coverage rule not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorCoverageRuleNotFound("coverage rule not found")); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+
+	if req.Data != nil {
+		kind, count, position := existing.Kind.String(), existing.Count, existing.Position
+		if req.Data.Name != nil {
+			updates["name"] = *req.Data.Name
+		}
+		if req.Data.Description != nil {
+			updates["description"] = *req.Data.Description
+		}
+		if req.Data.OrgUnitName != nil {
+			if *req.Data.OrgUnitName == "" {
+				return nil, hrV1.ErrorValidationFailed("org_unit_name must not be empty")
+			}
+			updates["org_unit_name"] = *req.Data.OrgUnitName
+		}
+		if req.Data.Kind != nil {
+			kind = coverageRuleKindToString(*req.Data.Kind)
+			updates["kind"] = kind
+		}
+		if req.Data.Count != nil {
+			count = int(*req.Data.Count)
+			updates["count"] = count
+		}
+		if req.Data.Position != nil {
+			position = *req.Data.Position
+			updates["position"] = position
+		}
+		if err := validateCoverageRule(kind, count, position); err != nil {
+			return nil, err
+		}
+		if req.Data.Enforcement != nil {
+			if enforcement := coverageEnforcementToString(*req.Data.Enforcement); enforcement != "" {
+				updates["enforcement"] = enforcement
+			}
+		}
+		if req.Data.AbsenceTypeIds != nil {
+			if err := s.checkAbsenceTypes(ctx, req.Data.AbsenceTypeIds); err != nil {
+				return nil, err
+			}
+			updates["absence_type_ids"] = req.Data.AbsenceTypeIds
+		}
+		if req.Data.Enabled != nil {
+			updates["enabled"] = *req.Data.Enabled
+		}
+	}
+
+	entity, err := s.coverageRepo.Update(ctx, req.GetId(), updates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.UpdateCoverageRuleResponse{
+		Rule: coverageRuleToProto(entity),
+	}, nil
+}
+
+func (s *CoverageService) DeleteCoverageRule(ctx context.Context, req *hrV1.DeleteCoverageRuleRequest) (*emptypb.Empty, error) {
+	if err := checkPermission(ctx, "hr.policy.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.coverageRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorCoverageRuleNotFound("coverage rule not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorCoverageRuleNotFound("coverage rule not found")); err != nil {
+		return nil, err
+	}
+
+	if err := s.coverageRepo.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkAbsenceTypes verifies that the absence types a rule checks exist in the caller's tenant.
+func (s *CoverageService) checkAbsenceTypes(ctx context.Context, ids []string) error {
+	for _, id := range ids {
+		absType, err := s.absenceTypeRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if absType == nil {
+			return hrV1.ErrorAbsenceTypeNotFound("absence type %s not found", id)
+		}
+		if err := checkTenantAccess(ctx, absType.TenantID, hrV1.ErrorAbsenceTypeNotFound("absence type %s not found", id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateCoverageRule(kind string, count int, position string) error {
+	switch kind {
+	case coverage.KindMaxAbsent:
+		if count < 1 {
+			return hrV1.ErrorValidationFailed("a max_absent rule must allow at least one member to be absent")
+		}
+	case coverage.KindMinPresent:
+		if position == "" {
+			return hrV1.ErrorValidationFailed("a min_present rule needs a position")
+		}
+		if count < 1 {
+			return hrV1.ErrorValidationFailed("a min_present rule must require at least one member to be present")
+		}
+	default:
+		return hrV1.ErrorValidationFailed("kind is required")
+	}
+	return nil
+}
+
+func coverageRuleKindToString(kind hrV1.CoverageRuleKind) string {
+	switch kind {
+	case hrV1.CoverageRuleKind_COVERAGE_RULE_KIND_MAX_ABSENT:
+		return coverage.KindMaxAbsent
+	case hrV1.CoverageRuleKind_COVERAGE_RULE_KIND_MIN_PRESENT:
+		return coverage.KindMinPresent
+	default:
+		return ""
+	}
+}
+
+func coverageRuleKindToProto(kind string) hrV1.CoverageRuleKind {
+	switch kind {
+	case coverage.KindMaxAbsent:
+		return hrV1.CoverageRuleKind_COVERAGE_RULE_KIND_MAX_ABSENT
+	case coverage.KindMinPresent:
+		return hrV1.CoverageRuleKind_COVERAGE_RULE_KIND_MIN_PRESENT
+	default:
+		return hrV1.CoverageRuleKind_COVERAGE_RULE_KIND_UNSPECIFIED
+	}
+}
+
+func coverageEnforcementToString(enforcement hrV1.CoverageEnforcement) string {
+	switch enforcement {
+	case hrV1.CoverageEnforcement_COVERAGE_ENFORCEMENT_WARN:
+		return coverage.EnforcementWarn
+	case hrV1.CoverageEnforcement_COVERAGE_ENFORCEMENT_BLOCK:
+		return coverage.EnforcementBlock
+	default:
+		return ""
+	}
+}
+
+func coverageEnforcementToProto(enforcement string) hrV1.CoverageEnforcement {
+	switch enforcement {
+	case coverage.EnforcementWarn:
+		return hrV1.CoverageEnforcement_COVERAGE_ENFORCEMENT_WARN
+	case coverage.EnforcementBlock:
+		return hrV1.CoverageEnforcement_COVERAGE_ENFORCEMENT_BLOCK
+	default:
+		return hrV1.CoverageEnforcement_COVERAGE_ENFORCEMENT_UNSPECIFIED
+	}
+}
+
+func coverageRuleToProto(e *ent.CoverageRule) *hrV1.CoverageRule {
+	if e == nil {
+		return nil
+	}
+
+	kind := coverageRuleKindToProto(e.Kind.String())
+	enforcement := coverageEnforcementToProto(e.Enforcement.String())
+	result := &hrV1.CoverageRule{
+		Id:             &e.ID,
+		TenantId:       e.TenantID,
+		Name:           ptrString(e.Name),
+		Description:    ptrString(e.Description),
+		OrgUnitName:    ptrString(e.OrgUnitName),
+		Kind:           &kind,
+		Count:          ptrInt32(int32(e.Count)),
+		Position:       ptrString(e.Position),
+		Enforcement:    &enforcement,
+		AbsenceTypeIds: e.AbsenceTypeIds,
+		Enabled:        ptrBool(e.Enabled),
+		CreatedBy:      e.CreateBy,
+		UpdatedBy:      e.UpdateBy,
+	}
+
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	if e.UpdateTime != nil {
+		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
+	}
+
+	return result
+}
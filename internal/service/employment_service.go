@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/proration"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+type EmploymentService struct {
+	hrV1.UnimplementedHrEmploymentServiceServer
+
+	log            *log.Helper
+	employmentRepo *data.EmploymentRepo
+	allowanceRepo  *data.LeaveAllowanceRepo
+}
+
+func NewEmploymentService(ctx *bootstrap.Context, employmentRepo *data.EmploymentRepo, allowanceRepo *data.LeaveAllowanceRepo) *EmploymentService {
+	return &EmploymentService{
+		log:            ctx.NewLoggerHelper("hr/service/employment"),
+		employmentRepo: employmentRepo,
+		allowanceRepo:  allowanceRepo,
+	}
+}
+
+// SetEmployment records the employment dates of a user and flags the allowances on which a
+// leaver has used more days than their pro-rated entitlement.
+func (s *EmploymentService) SetEmployment(ctx context.Context, req *hrV1.SetEmploymentRequest) (*hrV1.SetEmploymentResponse, error) {
+	if err := checkPermission(ctx, "hr.employment.manage"); err != nil {
+		return nil, err
+	}
+
+	if req.GetUserId() == 0 {
+		return nil, hrV1.ErrorValidationFailed("user_id is required")
+	}
+	if req.GetStartDate() == nil {
+		return nil, hrV1.ErrorValidationFailed("start_date is required")
+	}
+
+	startDate := dateOnly(req.GetStartDate().AsTime())
+	var endDate *time.Time
+	if req.EndDate != nil {
+		end := dateOnly(req.EndDate.AsTime())
+		if end.Before(startDate) {
+			return nil, hrV1.ErrorInvalidDateRange("end date must not be before start date")
+		}
+		endDate = &end
+	}
+
+	tenantID := getTenantID(ctx)
+
+	existing, err := s.employmentRepo.GetByUser(ctx, tenantID, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	var entity *ent.Employment
+	if existing == nil {
+		entity, err = s.employmentRepo.Create(ctx, tenantID, req.GetUserId(), startDate, func(c *ent.EmploymentCreate) {
+			c.SetUserName(req.GetUserName())
+			c.SetNillableEndDate(endDate)
+			c.SetNotes(req.GetNotes())
+		})
+	} else {
+		updates := map[string]interface{}{
+			"start_date": startDate,
+			"end_date":   endDate,
+			"notes":      req.GetNotes(),
+		}
+		if req.UserName != nil {
+			updates["user_name"] = *req.UserName
+		}
+		entity, err = s.employmentRepo.Update(ctx, existing.ID, updates)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	flagged, err := s.allowanceRepo.FlagOverconsumption(ctx, tenantID, entity.UserID, entity.StartDate, entity.EndDate, prorationRoundingToString(req.GetRounding()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &hrV1.SetEmploymentResponse{
+		Employment: employmentToProto(entity),
+	}
+	for _, a := range flagged {
+		resp.OverconsumedAllowances = append(resp.OverconsumedAllowances, allowanceToProto(a))
+	}
+	return resp, nil
+}
+
+func (s *EmploymentService) GetEmployment(ctx context.Context, req *hrV1.GetEmploymentRequest) (*hrV1.GetEmploymentResponse, error) {
+	if err := checkPermission(ctx, "hr.allowance.view"); err != nil {
+		return nil, err
+	}
+
+	// Non-admin users can only view their own employment
+	if !hasPermission(ctx, "hr.employment.manage") && req.GetUserId() != getUserID(ctx) {
+		return nil, hrV1.ErrorBadRequest("you can only view your own employment")
+	}
+
+	entity, err := s.employmentRepo.GetByUser(ctx, getTenantID(ctx), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, hrV1.ErrorEmploymentNotFound("employment not found")
+	}
+
+	return &hrV1.GetEmploymentResponse{
+		Employment: employmentToProto(entity),
+	}, nil
+}
+
+func (s *EmploymentService) ListEmployments(ctx context.Context, req *hrV1.ListEmploymentsRequest) (*hrV1.ListEmploymentsResponse, error) {
+	if err := checkPermission(ctx, "hr.employment.manage"); err != nil {
+		return nil, err
+	}
+
+	filters := make(map[string]interface{})
+	if req.UserId != nil {
+		filters["user_id"] = *req.UserId
+	}
+	if req.LeavingYear != nil {
+		filters["leaving_year"] = int(*req.LeavingYear)
+	}
+
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	if req.GetNoPaging() {
+		page = 0
+		pageSize = 0
+	}
+
+	entities, total, err := s.employmentRepo.List(ctx, getTenantID(ctx), page, pageSize, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.Employment, len(entities))
+	for i, e := range entities {
+		items[i] = employmentToProto(e)
+	}
+
+	return &hrV1.ListEmploymentsResponse{
+		Items: items,
+		Total: ptrInt32(int32(total)),
+	}, nil
+}
+
+func (s *EmploymentService) DeleteEmployment(ctx context.Context, req *hrV1.DeleteEmploymentRequest) (*emptypb.Empty, error) {
+	if err := checkPermission(ctx, "hr.employment.manage"); err != nil {
+		return nil, err
+	}
+
+	tenantID := getTenantID(ctx)
+
+	existing, err := s.employmentRepo.GetByUser(ctx, tenantID, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorEmploymentNotFound("employment not found")
+	}
+
+	if err := s.employmentRepo.Delete(ctx, existing.ID); err != nil {
+		return nil, err
+	}
+
+	// Without an end date no allowance can be over-consumed
+	if _, err := s.allowanceRepo.FlagOverconsumption(ctx, tenantID, existing.UserID, existing.StartDate, nil, ""); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// dateOnly truncates a timestamp to midnight UTC of its calendar day.
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func prorationRoundingToString(r hrV1.ProrationRounding) string {
+	switch r {
+	case hrV1.ProrationRounding_PRORATION_ROUNDING_UP:
+		return proration.RoundUp
+	case hrV1.ProrationRounding_PRORATION_ROUNDING_DOWN:
+		return proration.RoundDown
+	default:
+		return proration.RoundNearest
+	}
+}
+
+func prorationRoundingToProto(r string) hrV1.ProrationRounding {
+	switch r {
+	case proration.RoundUp:
+		return hrV1.ProrationRounding_PRORATION_ROUNDING_UP
+	case proration.RoundDown:
+		return hrV1.ProrationRounding_PRORATION_ROUNDING_DOWN
+	default:
+		return hrV1.ProrationRounding_PRORATION_ROUNDING_NEAREST
+	}
+}
+
+func employmentToProto(e *ent.Employment) *hrV1.Employment {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.Employment{
+		Id:        &e.ID,
+		TenantId:  e.TenantID,
+		UserId:    &e.UserID,
+		UserName:  ptrString(e.UserName),
+		StartDate: timestamppb.New(e.StartDate),
+		Notes:     ptrString(e.Notes),
+		CreatedBy: e.CreateBy,
+		UpdatedBy: e.UpdateBy,
+	}
+
+	if e.EndDate != nil {
+		result.EndDate = timestamppb.New(*e.EndDate)
+	}
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	if e.UpdateTime != nil {
+		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
+	}
+
+	return result
+}
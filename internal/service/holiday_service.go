@@ -0,0 +1,409 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+type HolidayService struct {
+	hrV1.UnimplementedHrHolidayServiceServer
+
+	log          *log.Helper
+	calendarRepo *data.HolidayCalendarRepo
+	holidayRepo  *data.HolidayRepo
+}
+
+func NewHolidayService(ctx *bootstrap.Context, calendarRepo *data.HolidayCalendarRepo, holidayRepo *data.HolidayRepo) *HolidayService {
+	return &HolidayService{
+		log:          ctx.NewLoggerHelper("hr/service/holiday"),
+		calendarRepo: calendarRepo,
+		holidayRepo:  holidayRepo,
+	}
+}
+
+func (s *HolidayService) CreateHolidayCalendar(ctx context.Context, req *hrV1.CreateHolidayCalendarRequest) (*hrV1.CreateHolidayCalendarResponse, error) {
+	if err := checkPermission(ctx, "hr.holiday.manage"); err != nil {
+		return nil, err
+	}
+
+	tenantID := getTenantID(ctx)
+
+	opts := []func(*ent.HolidayCalendarCreate){}
+	if req.Description != nil {
+		opts = append(opts, func(c *ent.HolidayCalendarCreate) { c.SetDescription(*req.Description) })
+	}
+	if req.CountryCode != nil {
+		opts = append(opts, func(c *ent.HolidayCalendarCreate) { c.SetCountryCode(*req.CountryCode) })
+	}
+	if req.Region != nil {
+		opts = append(opts, func(c *ent.HolidayCalendarCreate) { c.SetRegion(*req.Region) })
+	}
+	if req.IsDefault != nil {
+		opts = append(opts, func(c *ent.HolidayCalendarCreate) { c.SetIsDefault(*req.IsDefault) })
+	}
+	if len(req.OrgUnitNames) > 0 {
+		opts = append(opts, func(c *ent.HolidayCalendarCreate) { c.SetOrgUnitNames(req.OrgUnitNames) })
+	}
+
+	entity, err := s.calendarRepo.Create(ctx, tenantID, req.GetName(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only one calendar per tenant can be the default
+	if entity.IsDefault {
+		if err := s.calendarRepo.ClearDefault(ctx, tenantID, entity.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &hrV1.CreateHolidayCalendarResponse{
+		Calendar: holidayCalendarToProto(entity),
+	}, nil
+}
+
+func (s *HolidayService) GetHolidayCalendar(ctx context.Context, req *hrV1.GetHolidayCalendarRequest) (*hrV1.GetHolidayCalendarResponse, error) {
+	if err := checkPermission(ctx, "hr.calendar.view"); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.calendarRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, hrV1.ErrorHolidayCalendarNotFound("holiday calendar not found")
+	}
+	if err := checkTenantAccess(ctx, entity.TenantID, hrV1.ErrorHolidayCalendarNotFound("holiday calendar not found")); err != nil {
+		return nil, err
+	}
+
+	return &hrV1.GetHolidayCalendarResponse{
+		Calendar: holidayCalendarToProto(entity),
+	}, nil
+}
+
+func (s *HolidayService) ListHolidayCalendars(ctx context.Context, req *hrV1.ListHolidayCalendarsRequest) (*hrV1.ListHolidayCalendarsResponse, error) {
+	if err := checkPermission(ctx, "hr.calendar.view"); err != nil {
+		return nil, err
+	}
+
+	filters := make(map[string]interface{})
+	if req.Query != nil {
+		filters["query"] = *req.Query
+	}
+	if req.CountryCode != nil {
+		filters["country_code"] = *req.CountryCode
+	}
+
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	if req.GetNoPaging() {
+		page = 0
+		pageSize = 0
+	}
+
+	entities, total, err := s.calendarRepo.List(ctx, getTenantID(ctx), page, pageSize, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.HolidayCalendar, len(entities))
+	for i, e := range entities {
+		items[i] = holidayCalendarToProto(e)
+	}
+
+	return &hrV1.ListHolidayCalendarsResponse{
+		Items: items,
+		Total: ptrInt32(int32(total)),
+	}, nil
+}
+
+func (s *HolidayService) UpdateHolidayCalendar(ctx context.Context, req *hrV1.UpdateHolidayCalendarRequest) (*hrV1.UpdateHolidayCalendarResponse, error) {
+	if err := checkPermission(ctx, "hr.holiday.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.calendarRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorHolidayCalendarNotFound("holiday calendar not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorHolidayCalendarNotFound("holiday calendar not found")); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+
+	if req.Data != nil {
+		if req.Data.Name != nil {
+			updates["name"] = *req.Data.Name
+		}
+		if req.Data.Description != nil {
+			updates["description"] = *req.Data.Description
+		}
+		if req.Data.CountryCode != nil {
+			updates["country_code"] = *req.Data.CountryCode
+		}
+		if req.Data.Region != nil {
+			updates["region"] = *req.Data.Region
+		}
+		if req.Data.IsDefault != nil {
+			updates["is_default"] = *req.Data.IsDefault
+		}
+		if req.Data.OrgUnitNames != nil {
+			updates["org_unit_names"] = req.Data.OrgUnitNames
+		}
+	}
+
+	entity, err := s.calendarRepo.Update(ctx, req.GetId(), updates)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only one calendar per tenant can be the default
+	if entity.IsDefault {
+		if err := s.calendarRepo.ClearDefault(ctx, getTenantID(ctx), entity.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &hrV1.UpdateHolidayCalendarResponse{
+		Calendar: holidayCalendarToProto(entity),
+	}, nil
+}
+
+func (s *HolidayService) DeleteHolidayCalendar(ctx context.Context, req *hrV1.DeleteHolidayCalendarRequest) (*emptypb.Empty, error) {
+	if err := checkPermission(ctx, "hr.holiday.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.calendarRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorHolidayCalendarNotFound("holiday calendar not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorHolidayCalendarNotFound("holiday calendar not found")); err != nil {
+		return nil, err
+	}
+
+	if err := s.calendarRepo.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *HolidayService) CreateHoliday(ctx context.Context, req *hrV1.CreateHolidayRequest) (*hrV1.CreateHolidayResponse, error) {
+	if err := checkPermission(ctx, "hr.holiday.manage"); err != nil {
+		return nil, err
+	}
+
+	calendar, err := s.calendarRepo.GetByID(ctx, req.GetCalendarId())
+	if err != nil {
+		return nil, err
+	}
+	if calendar == nil {
+		return nil, hrV1.ErrorHolidayCalendarNotFound("holiday calendar not found")
+	}
+	if err := checkTenantAccess(ctx, calendar.TenantID, hrV1.ErrorHolidayCalendarNotFound("holiday calendar not found")); err != nil {
+		return nil, err
+	}
+
+	if req.GetDate() == nil {
+		return nil, hrV1.ErrorValidationFailed("date is required")
+	}
+
+	opts := []func(*ent.HolidayCreate){}
+	if req.Recurring != nil {
+		opts = append(opts, func(c *ent.HolidayCreate) { c.SetRecurring(*req.Recurring) })
+	}
+
+	entity, err := s.holidayRepo.Create(ctx, getTenantID(ctx), calendar.ID, req.GetName(), req.GetDate().AsTime(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.CreateHolidayResponse{
+		Holiday: holidayToProto(entity),
+	}, nil
+}
+
+func (s *HolidayService) ListHolidays(ctx context.Context, req *hrV1.ListHolidaysRequest) (*hrV1.ListHolidaysResponse, error) {
+	if err := checkPermission(ctx, "hr.calendar.view"); err != nil {
+		return nil, err
+	}
+
+	calendar, err := s.calendarRepo.GetByID(ctx, req.GetCalendarId())
+	if err != nil {
+		return nil, err
+	}
+	if calendar == nil {
+		return nil, hrV1.ErrorHolidayCalendarNotFound("holiday calendar not found")
+	}
+	if err := checkTenantAccess(ctx, calendar.TenantID, hrV1.ErrorHolidayCalendarNotFound("holiday calendar not found")); err != nil {
+		return nil, err
+	}
+
+	entities, err := s.holidayRepo.ListByCalendar(ctx, calendar.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.Holiday, 0, len(entities))
+	for _, e := range entities {
+		item := holidayToProto(e)
+		if req.Year != nil {
+			date, ok := holidayDateInYear(e, int(*req.Year))
+			if !ok {
+				continue
+			}
+			item.Date = timestamppb.New(date)
+		}
+		items = append(items, item)
+	}
+
+	return &hrV1.ListHolidaysResponse{
+		Items: items,
+		Total: ptrInt32(int32(len(items))),
+	}, nil
+}
+
+func (s *HolidayService) UpdateHoliday(ctx context.Context, req *hrV1.UpdateHolidayRequest) (*hrV1.UpdateHolidayResponse, error) {
+	if err := checkPermission(ctx, "hr.holiday.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.holidayRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorHolidayNotFound("holiday not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorHolidayNotFound("holiday not found")); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+
+	if req.Data != nil {
+		if req.Data.Name != nil {
+			updates["name"] = *req.Data.Name
+		}
+		if req.Data.Date != nil {
+			updates["date"] = req.Data.Date.AsTime()
+		}
+		if req.Data.Recurring != nil {
+			updates["recurring"] = *req.Data.Recurring
+		}
+	}
+
+	entity, err := s.holidayRepo.Update(ctx, req.GetId(), updates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.UpdateHolidayResponse{
+		Holiday: holidayToProto(entity),
+	}, nil
+}
+
+func (s *HolidayService) DeleteHoliday(ctx context.Context, req *hrV1.DeleteHolidayRequest) (*emptypb.Empty, error) {
+	if err := checkPermission(ctx, "hr.holiday.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.holidayRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorHolidayNotFound("holiday not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorHolidayNotFound("holiday not found")); err != nil {
+		return nil, err
+	}
+
+	if err := s.holidayRepo.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// holidayDateInYear returns the date a holiday falls on in the given year.
+// Returns false if the holiday does not occur that year.
+func holidayDateInYear(e *ent.Holiday, year int) (time.Time, bool) {
+	if !e.Recurring {
+		return e.Date, e.Date.Year() == year
+	}
+	d := time.Date(year, e.Date.Month(), e.Date.Day(), 0, 0, 0, 0, e.Date.Location())
+	// 29 February outside a leap year
+	return d, d.Month() == e.Date.Month()
+}
+
+func holidayCalendarToProto(e *ent.HolidayCalendar) *hrV1.HolidayCalendar {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.HolidayCalendar{
+		Id:           &e.ID,
+		TenantId:     e.TenantID,
+		Name:         ptrString(e.Name),
+		Description:  ptrString(e.Description),
+		CountryCode:  ptrString(e.CountryCode),
+		Region:       ptrString(e.Region),
+		IsDefault:    ptrBool(e.IsDefault),
+		OrgUnitNames: e.OrgUnitNames,
+		CreatedBy:    e.CreateBy,
+		UpdatedBy:    e.UpdateBy,
+	}
+
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	if e.UpdateTime != nil {
+		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
+	}
+
+	return result
+}
+
+func holidayToProto(e *ent.Holiday) *hrV1.Holiday {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.Holiday{
+		Id:         &e.ID,
+		TenantId:   e.TenantID,
+		CalendarId: ptrString(e.CalendarID),
+		Name:       ptrString(e.Name),
+		Date:       timestamppb.New(e.Date),
+		Recurring:  ptrBool(e.Recurring),
+		CreatedBy:  e.CreateBy,
+		UpdatedBy:  e.UpdateBy,
+	}
+
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	if e.UpdateTime != nil {
+		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
+	}
+
+	return result
+}
@@ -0,0 +1,584 @@
+package service
+
+import (
+	"context"
+	"math"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaveamendment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/event"
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+	"github.com/go-tangra/go-tangra-hr/internal/workday"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+// ChangeLeaveDates moves a pending or approved leave request to new dates without cancelling it.
+// The days are recalculated and overlap and balance are checked again. A pending request is
+// moved at once and keeps its approval history. An approved request keeps its dates and
+// allowance deductions until the change is approved, and signed when the absence type requires
+// signing.
+func (s *LeaveService) ChangeLeaveDates(ctx context.Context, req *hrV1.ChangeLeaveDatesRequest) (*hrV1.ChangeLeaveDatesResponse, error) {
+	if err := checkPermission(ctx, "hr.request.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.leaveRequestRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorLeaveRequestNotFound("leave request not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorLeaveRequestNotFound("leave request not found")); err != nil {
+		return nil, err
+	}
+
+	// Non-admin users can only change their own requests
+	if !hasPermission(ctx, "hr.request.approve") && existing.UserID != getUserID(ctx) {
+		return nil, hrV1.ErrorBadRequest("you can only change your own leave requests")
+	}
+	if existing.Status != leaverequest.StatusPending && existing.Status != leaverequest.StatusApproved {
+		return nil, hrV1.ErrorBadRequest("only pending or approved leave requests can be changed")
+	}
+
+	open, err := s.amendmentRepo.GetOpen(ctx, existing.ID)
+	if err != nil {
+		return nil, err
+	}
+	if open != nil {
+		return nil, hrV1.ErrorBadRequest("a change to this leave request already awaits a decision")
+	}
+
+	absType := existing.Edges.AbsenceType
+	if absType == nil {
+		return nil, hrV1.ErrorAbsenceTypeNotFound("absence type not found")
+	}
+
+	if req.GetStartDate() == nil || req.GetEndDate() == nil {
+		return nil, hrV1.ErrorValidationFailed("start_date and end_date are required")
+	}
+	startDate := req.GetStartDate().AsTime()
+	endDate := req.GetEndDate().AsTime()
+
+	if endDate.Before(startDate) {
+		return nil, hrV1.ErrorInvalidDateRange("end date must be after start date")
+	}
+
+	span, err := requestedSpan(absType, startDate, endDate, req.GetStartDayPart(), req.GetEndDayPart())
+	if err != nil {
+		return nil, err
+	}
+
+	tenantID := entityTenantID(existing)
+
+	// Count the new days with the calendar the request was counted with, if any
+	days := req.GetDays()
+	holidayCalendarID := ""
+	if days <= 0 {
+		days, holidayCalendarID, err = calculateBusinessDays(ctx, s.calendarRepo, s.holidayRepo, s.scheduleRepo, tenantID, existing.HolidayCalendarID, existing.UserID, existing.OrgUnitName, span)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	overlap, err := s.leaveRequestRepo.CheckOverlap(ctx, tenantID, existing.UserID, span, existing.ID)
+	if err != nil {
+		return nil, err
+	}
+	if overlap {
+		return nil, hrV1.ErrorOverlapExists("overlapping leave request exists for this period")
+	}
+
+	// Keeping the start date needs no further notice, e.g. when extending an absence
+	override, err := s.checkLeavePolicies(ctx, tenantID, existing.AbsenceTypeID, existing.OrgUnitName, policy.Request{
+		Start:       startDate,
+		End:         endDate,
+		Days:        days,
+		CheckNotice: !dateOnly(startDate).Equal(dateOnly(existing.StartDate)),
+	}, req.GetPolicyOverrideReason())
+	if err != nil {
+		return nil, err
+	}
+
+	// The days already deducted for an approved request are available to its new dates
+	if absType.DeductsFromAllowance {
+		portions, err := data.SplitDaysByYear(ctx, s.holidayRepo, s.scheduleRepo, tenantID, existing.UserID, existing.OrgUnitName, holidayCalendarID, span, days)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkBalance(ctx, absType, tenantID, existing.UserID, portions, existing.Deductions); err != nil {
+			return nil, err
+		}
+	}
+
+	opts := []func(*ent.LeaveAmendmentCreate){
+		func(c *ent.LeaveAmendmentCreate) { c.SetHolidayCalendarID(holidayCalendarID) },
+	}
+	if span.Hourly {
+		opts = append(opts, func(c *ent.LeaveAmendmentCreate) { c.SetHours(endDate.Sub(startDate).Hours()) })
+	}
+	if span.StartsAfternoon {
+		opts = append(opts, func(c *ent.LeaveAmendmentCreate) { c.SetStartDayPart(leaveamendment.StartDayPartPm) })
+	}
+	if span.EndsAtMidday {
+		opts = append(opts, func(c *ent.LeaveAmendmentCreate) { c.SetEndDayPart(leaveamendment.EndDayPartAm) })
+	}
+	if req.Reason != nil {
+		opts = append(opts, func(c *ent.LeaveAmendmentCreate) { c.SetReason(*req.Reason) })
+	}
+	if override != nil {
+		opts = append(opts, func(c *ent.LeaveAmendmentCreate) { c.SetPolicyOverride(override) })
+	}
+
+	amendment, err := s.amendmentRepo.Create(ctx, tenantID, existing, startDate, endDate, days, "pending", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pending requests have no deductions yet, so they are moved straight away
+	if existing.Status == leaverequest.StatusPending {
+		applied, err := s.amendmentRepo.Apply(ctx, amendment.ID)
+		if err != nil {
+			if _, cancelErr := s.amendmentRepo.UpdateStatus(ctx, amendment.ID, "cancelled", 0, "", ""); cancelErr != nil {
+				s.log.Errorf("Failed to cancel unapplied amendment of leave %s: %v", existing.ID, cancelErr)
+			}
+			return nil, err
+		}
+		amendment = applied
+	}
+
+	// Re-fetch with edges
+	entity, _ := s.leaveRequestRepo.GetByID(ctx, existing.ID)
+	if entity == nil {
+		entity = existing
+	}
+
+	return &hrV1.ChangeLeaveDatesResponse{
+		LeaveRequest: leaveRequestToProto(entity),
+		Amendment:    leaveAmendmentToProto(amendment),
+	}, nil
+}
+
+// ShortenLeaveRequest ends an approved leave request early, e.g. when the employee returns
+// early from sick leave. The days are recounted and only the days no longer taken are refunded
+// to the allowances they were deducted from. The original end date is kept on an amendment.
+func (s *LeaveService) ShortenLeaveRequest(ctx context.Context, req *hrV1.ShortenLeaveRequestRequest) (*hrV1.ShortenLeaveRequestResponse, error) {
+	if err := checkPermission(ctx, "hr.request.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.leaveRequestRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorLeaveRequestNotFound("leave request not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorLeaveRequestNotFound("leave request not found")); err != nil {
+		return nil, err
+	}
+
+	// Non-admin users can only shorten their own requests
+	if !hasPermission(ctx, "hr.request.approve") && existing.UserID != getUserID(ctx) {
+		return nil, hrV1.ErrorBadRequest("you can only shorten your own leave requests")
+	}
+	if existing.Status != leaverequest.StatusApproved {
+		return nil, hrV1.ErrorBadRequest("only approved leave requests can be shortened")
+	}
+
+	open, err := s.amendmentRepo.GetOpen(ctx, existing.ID)
+	if err != nil {
+		return nil, err
+	}
+	if open != nil {
+		return nil, hrV1.ErrorBadRequest("a change to this leave request awaits a decision")
+	}
+
+	absType := existing.Edges.AbsenceType
+	if absType == nil {
+		return nil, hrV1.ErrorAbsenceTypeNotFound("absence type not found")
+	}
+
+	if req.GetEndDate() == nil {
+		return nil, hrV1.ErrorValidationFailed("end_date is required")
+	}
+	endDate := req.GetEndDate().AsTime()
+	if endDate.Before(existing.StartDate) {
+		return nil, hrV1.ErrorInvalidDateRange("end date must not be before the start date")
+	}
+
+	// The absence keeps its start and must end before it did
+	span, err := requestedSpan(absType, existing.StartDate, endDate, dayPartToProto(existing.StartDayPart.String()), req.GetEndDayPart())
+	if err != nil {
+		return nil, err
+	}
+	if !endsEarlier(span, data.LeaveSpan(existing)) {
+		return nil, hrV1.ErrorInvalidDateRange("the new end must be before the current end of the absence")
+	}
+
+	tenantID := entityTenantID(existing)
+
+	// Recount with the calendar the request was counted with. The days taken never exceed the
+	// days deducted, whatever changed in the holiday calendar since.
+	days, holidayCalendarID, err := calculateBusinessDays(ctx, s.calendarRepo, s.holidayRepo, s.scheduleRepo, tenantID, existing.HolidayCalendarID, existing.UserID, existing.OrgUnitName, span)
+	if err != nil {
+		return nil, err
+	}
+	days = math.Min(days, existing.Days)
+
+	opts := []func(*ent.LeaveAmendmentCreate){
+		func(c *ent.LeaveAmendmentCreate) { c.SetKind(leaveamendment.KindShorten) },
+		func(c *ent.LeaveAmendmentCreate) { c.SetHolidayCalendarID(holidayCalendarID) },
+		func(c *ent.LeaveAmendmentCreate) { c.SetStartDayPart(leaveamendment.StartDayPart(existing.StartDayPart)) },
+	}
+	if span.Hourly {
+		opts = append(opts, func(c *ent.LeaveAmendmentCreate) { c.SetHours(endDate.Sub(existing.StartDate).Hours()) })
+	}
+	if span.EndsAtMidday {
+		opts = append(opts, func(c *ent.LeaveAmendmentCreate) { c.SetEndDayPart(leaveamendment.EndDayPartAm) })
+	}
+	if req.Reason != nil {
+		opts = append(opts, func(c *ent.LeaveAmendmentCreate) { c.SetReason(*req.Reason) })
+	}
+
+	amendment, err := s.amendmentRepo.Create(ctx, tenantID, existing, existing.StartDate, endDate, days, "pending", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := s.amendmentRepo.ApplyWithRebooking(ctx, s.allowanceRepo, s.holidayRepo, s.scheduleRepo, existing, amendment, ledgerRef(ctx, existing.ID, "leave shortened"))
+	if err != nil {
+		if _, cancelErr := s.amendmentRepo.UpdateStatus(ctx, amendment.ID, "cancelled", 0, "", ""); cancelErr != nil {
+			s.log.Errorf("Failed to cancel unapplied amendment of leave %s: %v", existing.ID, cancelErr)
+		}
+		return nil, err
+	}
+
+	// Re-fetch with edges
+	entity, _ := s.leaveRequestRepo.GetByID(ctx, existing.ID)
+	if entity == nil {
+		entity = existing
+	}
+
+	// Delegations made for the approver's leave end with it
+	s.removeLeaveDelegations(ctx, entity.ID)
+	s.delegateWhileAway(ctx, entity)
+
+	s.enqueueOutbox(ctx, event.AllowancesOf(existing, entity)...)
+
+	return &hrV1.ShortenLeaveRequestResponse{
+		LeaveRequest: leaveRequestToProto(entity),
+		Amendment:    leaveAmendmentToProto(applied),
+	}, nil
+}
+
+// endsEarlier reports whether the span ends before the current span does, counting the midday
+// of a last day as earlier than its end.
+func endsEarlier(span, current workday.Span) bool {
+	if span.Hourly {
+		return span.End.Before(current.End)
+	}
+
+	end, currentEnd := dateOnly(span.End), dateOnly(current.End)
+	if !end.Equal(currentEnd) {
+		return end.Before(currentEnd)
+	}
+	return span.EndsAtMidday && !current.EndsAtMidday
+}
+
+func (s *LeaveService) ListLeaveAmendments(ctx context.Context, req *hrV1.ListLeaveAmendmentsRequest) (*hrV1.ListLeaveAmendmentsResponse, error) {
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
+		return nil, err
+	}
+
+	leaveReq, err := s.leaveRequestRepo.GetByID(ctx, req.GetLeaveRequestId())
+	if err != nil {
+		return nil, err
+	}
+	if leaveReq == nil {
+		return nil, hrV1.ErrorLeaveRequestNotFound("leave request not found")
+	}
+	if err := checkTenantAccess(ctx, leaveReq.TenantID, hrV1.ErrorLeaveRequestNotFound("leave request not found")); err != nil {
+		return nil, err
+	}
+
+	entities, err := s.amendmentRepo.ListByLeaveRequest(ctx, leaveReq.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.LeaveAmendment, len(entities))
+	for i, e := range entities {
+		items[i] = leaveAmendmentToProto(e)
+	}
+
+	return &hrV1.ListLeaveAmendmentsResponse{
+		Items: items,
+	}, nil
+}
+
+// ApproveLeaveAmendment approves a change to the dates of an approved leave request. The change
+// is applied at once, moving the allowance deductions by the difference in days, unless the
+// absence type requires signing; then the new dates are sent for signing and applied when the
+// signing completes.
+func (s *LeaveService) ApproveLeaveAmendment(ctx context.Context, req *hrV1.ApproveLeaveAmendmentRequest) (*hrV1.ApproveLeaveAmendmentResponse, error) {
+	amendment, leaveReq, err := s.openAmendment(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// The new dates may overlap requests made since the change was requested
+	span := data.LeaveSpan(data.AmendedLeave(leaveReq, amendment))
+	overlap, err := s.leaveRequestRepo.CheckOverlap(ctx, entityTenantID(leaveReq), leaveReq.UserID, span, leaveReq.ID)
+	if err != nil {
+		return nil, err
+	}
+	if overlap {
+		return nil, hrV1.ErrorOverlapExists("overlapping leave request exists for this period")
+	}
+
+	coverageWarnings, err := s.checkCoverage(ctx, entityTenantID(leaveReq), leaveReq.UserID, leaveReq.OrgUnitName, leaveReq.AbsenceTypeID, span, leaveReq.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewNotes := req.GetReviewNotes()
+
+	absType := leaveReq.Edges.AbsenceType
+	if absType != nil && absType.RequiresSigning && absType.SigningTemplateID != "" {
+		amendment, err = s.approveAmendmentWithSigning(ctx, leaveReq, amendment, absType, reviewNotes)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		amendment, err = s.amendmentRepo.ApplyWithRebooking(ctx, s.allowanceRepo, s.holidayRepo, s.scheduleRepo, leaveReq, amendment, ledgerRef(ctx, leaveReq.ID, "leave dates changed"))
+		if err != nil {
+			return nil, err
+		}
+		if reviewed, err := s.amendmentRepo.UpdateStatus(ctx, amendment.ID, "applied", getUserID(ctx), getUsername(ctx), reviewNotes); err != nil {
+			s.log.Errorf("Failed to record the review of amendment %s: %v", amendment.ID, err)
+		} else {
+			amendment = reviewed
+		}
+	}
+
+	// Re-fetch with edges
+	entity, _ := s.leaveRequestRepo.GetByID(ctx, leaveReq.ID)
+	if entity == nil {
+		entity = leaveReq
+	}
+
+	// Delegations made for the approver's leave follow it to the new dates
+	if amendment.Status == leaveamendment.StatusApplied {
+		s.removeLeaveDelegations(ctx, entity.ID)
+		s.delegateWhileAway(ctx, entity)
+		s.enqueueOutbox(ctx, event.AllowancesOf(leaveReq, entity)...)
+	}
+
+	return &hrV1.ApproveLeaveAmendmentResponse{
+		LeaveRequest:     leaveRequestToProto(entity),
+		Amendment:        leaveAmendmentToProto(amendment),
+		CoverageWarnings: coverageWarnings,
+	}, nil
+}
+
+// approveAmendmentWithSigning sends the new dates for signing and sets the amendment to
+// awaiting_signing.
+func (s *LeaveService) approveAmendmentWithSigning(ctx context.Context, leaveReq *ent.LeaveRequest, amendment *ent.LeaveAmendment, absType *ent.AbsenceType, reviewNotes string) (*ent.LeaveAmendment, error) {
+	entity, err := s.amendmentRepo.UpdateStatus(ctx, amendment.ID, "awaiting_signing", getUserID(ctx), getUsername(ctx), reviewNotes)
+	if err != nil {
+		return nil, err
+	}
+
+	rollback := func() {
+		if _, rollbackErr := s.amendmentRepo.UpdateStatus(ctx, amendment.ID, "pending", 0, "", ""); rollbackErr != nil {
+			s.log.Errorf("CRITICAL: Failed to roll back amendment %s from awaiting_signing to pending: %v", amendment.ID, rollbackErr)
+		}
+	}
+
+	submissionID, err := s.createSigningSubmission(ctx, absType, leaveReq, amendment.StartDate, amendment.EndDate, amendment.Days)
+	if err != nil {
+		s.log.Errorf("Failed to create signing submission for amendment %s, rolling back to pending: %v", amendment.ID, err)
+		rollback()
+		return nil, hrV1.ErrorInternalServerError("failed to create signing request")
+	}
+
+	if err := s.amendmentRepo.SetSigningRequestID(ctx, amendment.ID, submissionID); err != nil {
+		s.log.Errorf("Failed to store signing_request_id for amendment %s: %v", amendment.ID, err)
+		rollback()
+		return nil, hrV1.ErrorInternalServerError("failed to initiate signing workflow")
+	}
+
+	entity.SigningRequestID = submissionID
+	return entity, nil
+}
+
+// RejectLeaveAmendment rejects a change to the dates of an approved leave request, which keeps
+// its dates.
+func (s *LeaveService) RejectLeaveAmendment(ctx context.Context, req *hrV1.RejectLeaveAmendmentRequest) (*hrV1.RejectLeaveAmendmentResponse, error) {
+	amendment, _, err := s.openAmendment(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := s.amendmentRepo.UpdateStatus(ctx, amendment.ID, "rejected", getUserID(ctx), getUsername(ctx), req.GetReviewNotes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.RejectLeaveAmendmentResponse{
+		Amendment: leaveAmendmentToProto(entity),
+	}, nil
+}
+
+// openAmendment returns the pending amendment with its approved leave request, once the caller
+// is found to be allowed to decide it: approvers, and the approver the request was assigned to
+// or approved by. Only HR admins decide changes to their own requests.
+func (s *LeaveService) openAmendment(ctx context.Context, id string) (*ent.LeaveAmendment, *ent.LeaveRequest, error) {
+	// Assigned approvers need not hold hr.request.approve
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
+		return nil, nil, err
+	}
+
+	amendment, err := s.amendmentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if amendment == nil {
+		return nil, nil, hrV1.ErrorLeaveAmendmentNotFound("leave amendment not found")
+	}
+	if err := checkTenantAccess(ctx, amendment.TenantID, hrV1.ErrorLeaveAmendmentNotFound("leave amendment not found")); err != nil {
+		return nil, nil, err
+	}
+	if amendment.Status != leaveamendment.StatusPending {
+		return nil, nil, hrV1.ErrorBadRequest("only pending amendments can be decided")
+	}
+
+	leaveReq, err := s.leaveRequestRepo.GetByID(ctx, amendment.LeaveRequestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if leaveReq == nil {
+		return nil, nil, hrV1.ErrorLeaveRequestNotFound("leave request not found")
+	}
+	if leaveReq.Status != leaverequest.StatusApproved {
+		return nil, nil, hrV1.ErrorBadRequest("only changes to approved leave requests can be decided")
+	}
+
+	callerID := getUserID(ctx)
+	if leaveReq.UserID == callerID && !hasRole(ctx, "hr.admin") {
+		return nil, nil, hrV1.ErrorBadRequest("you cannot decide changes to your own leave requests")
+	}
+	if callerID != leaveReq.ApproverID && callerID != leaveReq.ReviewedBy {
+		if err := checkPermission(ctx, "hr.request.approve"); err != nil {
+			return nil, nil, err
+		}
+	}
+	return amendment, leaveReq, nil
+}
+
+// cancelLeaveAmendments cancels the changes awaiting a decision or signatures on a leave request
+// that no longer takes place, with their signing submissions. Failures are only logged.
+func (s *LeaveService) cancelLeaveAmendments(ctx context.Context, leaveRequestID string) {
+	cancelled, err := s.amendmentRepo.CancelOpen(ctx, leaveRequestID)
+	if err != nil {
+		s.log.Errorf("Failed to cancel amendments of leave %s: %v", leaveRequestID, err)
+		return
+	}
+
+	for _, a := range cancelled {
+		if a.SigningRequestID == "" {
+			continue
+		}
+		if err := s.signingClient.CancelSubmission(ctx, a.SigningRequestID, "Leave request withdrawn"); err != nil {
+			s.log.Errorf("Failed to cancel signing submission %s for amendment %s: %v", a.SigningRequestID, a.ID, err)
+		}
+	}
+}
+
+func leaveAmendmentToProto(e *ent.LeaveAmendment) *hrV1.LeaveAmendment {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.LeaveAmendment{
+		Id:                   &e.ID,
+		TenantId:             e.TenantID,
+		LeaveRequestId:       &e.LeaveRequestID,
+		UserId:               &e.UserID,
+		Status:               leaveAmendmentStatusToProtoPtr(e.Status.String()),
+		Kind:                 leaveAmendmentKindToProtoPtr(e.Kind.String()),
+		PreviousStartDate:    timestamppb.New(e.PreviousStartDate),
+		PreviousEndDate:      timestamppb.New(e.PreviousEndDate),
+		PreviousStartDayPart: dayPartToProtoPtr(e.PreviousStartDayPart.String()),
+		PreviousEndDayPart:   dayPartToProtoPtr(e.PreviousEndDayPart.String()),
+		PreviousDays:         &e.PreviousDays,
+		StartDate:            timestamppb.New(e.StartDate),
+		EndDate:              timestamppb.New(e.EndDate),
+		StartDayPart:         dayPartToProtoPtr(e.StartDayPart.String()),
+		EndDayPart:           dayPartToProtoPtr(e.EndDayPart.String()),
+		Days:                 &e.Days,
+		HolidayCalendarId:    ptrString(e.HolidayCalendarID),
+		Reason:               ptrString(e.Reason),
+		ReviewerName:         ptrString(e.ReviewerName),
+		ReviewNotes:          ptrString(e.ReviewNotes),
+		SigningRequestId:     ptrString(e.SigningRequestID),
+		PolicyOverride:       policyOverrideToProto(e.PolicyOverride),
+		CreatedBy:            e.CreateBy,
+		UpdatedBy:            e.UpdateBy,
+	}
+
+	if e.Hours > 0 {
+		result.Hours = &e.Hours
+	}
+	if e.ReviewedBy > 0 {
+		result.ReviewedBy = &e.ReviewedBy
+	}
+	if e.ReviewedAt != nil {
+		result.ReviewedAt = timestamppb.New(*e.ReviewedAt)
+	}
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	if e.UpdateTime != nil {
+		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
+	}
+
+	return result
+}
+
+func leaveAmendmentStatusToProtoPtr(status string) *hrV1.LeaveAmendmentStatus {
+	var v hrV1.LeaveAmendmentStatus
+	switch status {
+	case "pending":
+		v = hrV1.LeaveAmendmentStatus_LEAVE_AMENDMENT_STATUS_PENDING
+	case "awaiting_signing":
+		v = hrV1.LeaveAmendmentStatus_LEAVE_AMENDMENT_STATUS_AWAITING_SIGNING
+	case "applied":
+		v = hrV1.LeaveAmendmentStatus_LEAVE_AMENDMENT_STATUS_APPLIED
+	case "rejected":
+		v = hrV1.LeaveAmendmentStatus_LEAVE_AMENDMENT_STATUS_REJECTED
+	case "cancelled":
+		v = hrV1.LeaveAmendmentStatus_LEAVE_AMENDMENT_STATUS_CANCELLED
+	default:
+		v = hrV1.LeaveAmendmentStatus_LEAVE_AMENDMENT_STATUS_UNSPECIFIED
+	}
+	return &v
+}
+
+func leaveAmendmentKindToProtoPtr(kind string) *hrV1.LeaveAmendmentKind {
+	var v hrV1.LeaveAmendmentKind
+	switch kind {
+	case "date_change":
+		v = hrV1.LeaveAmendmentKind_LEAVE_AMENDMENT_KIND_DATE_CHANGE
+	case "shorten":
+		v = hrV1.LeaveAmendmentKind_LEAVE_AMENDMENT_KIND_SHORTEN
+	default:
+		v = hrV1.LeaveAmendmentKind_LEAVE_AMENDMENT_KIND_UNSPECIFIED
+	}
+	return &v
+}
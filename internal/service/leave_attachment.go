@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-tangra/go-tangra-hr/internal/attachment"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+// attachmentStatus returns where the request stands with the supporting documents its absence
+// type requires. The absence type edge must be loaded.
+func attachmentStatus(e *ent.LeaveRequest, now time.Time) attachment.Status {
+	if e.Edges.AbsenceType == nil {
+		return attachment.Status{}
+	}
+	return e.Edges.AbsenceType.RequiresAttachment.Check(e.Days, e.StartDate, e.AttachmentCount, now)
+}
+
+// checkAttachment refuses to approve a request whose absence type blocks approval until a
+// required document is attached.
+func checkAttachment(e *ent.LeaveRequest) error {
+	status := attachmentStatus(e, time.Now())
+	if !status.Blocking {
+		return nil
+	}
+	if status.DueDate.IsZero() {
+		return hrV1.ErrorAttachmentRequired("a supporting document must be attached before the request is approved")
+	}
+	return hrV1.ErrorAttachmentRequired("a supporting document was due by %s", status.DueDate.Format("2006-01-02"))
+}
+
+// removeLeaveAttachments removes the attachments of a leave request that is being deleted,
+// together with their contents.
+func (s *LeaveService) removeLeaveAttachments(ctx context.Context, leaveRequestID string) {
+	entities, err := s.attachmentRepo.ListByLeaveRequest(ctx, leaveRequestID)
+	if err != nil {
+		s.log.Errorf("Failed to list attachments of leave %s: %v", leaveRequestID, err)
+		return
+	}
+	if err := s.attachmentRepo.DeleteByLeaveRequest(ctx, leaveRequestID); err != nil {
+		s.log.Errorf("Failed to remove attachments of leave %s: %v", leaveRequestID, err)
+		return
+	}
+	for _, e := range entities {
+		if err := s.attachmentStore.Delete(ctx, e.StorageKey); err != nil {
+			s.log.Warnf("Failed to remove stored attachment %s: %v", e.StorageKey, err)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-hr/internal/conf"
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/storage"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+const defaultAttachmentMaxSize = 10 << 20
+
+var defaultAttachmentContentTypes = []string{"application/pdf", "image/png", "image/jpeg"}
+
+type LeaveAttachmentService struct {
+	hrV1.UnimplementedHrLeaveAttachmentServiceServer
+
+	log              *log.Helper
+	attachmentRepo   *data.LeaveAttachmentRepo
+	leaveRequestRepo *data.LeaveRequestRepo
+	store            storage.Backend
+	maxSize          int64
+	contentTypes     []string
+}
+
+func NewLeaveAttachmentService(ctx *bootstrap.Context, attachmentRepo *data.LeaveAttachmentRepo, leaveRequestRepo *data.LeaveRequestRepo, store storage.Backend) *LeaveAttachmentService {
+	maxSize := int64(defaultAttachmentMaxSize)
+	contentTypes := defaultAttachmentContentTypes
+	if cfg, ok := ctx.GetCustomConfig("hr"); ok && cfg != nil {
+		if hrCfg, ok := cfg.(*conf.HR); ok && hrCfg.Attachments != nil {
+			if hrCfg.Attachments.MaxSizeBytes > 0 {
+				maxSize = hrCfg.Attachments.MaxSizeBytes
+			}
+			if len(hrCfg.Attachments.ContentTypes) > 0 {
+				contentTypes = hrCfg.Attachments.ContentTypes
+			}
+		}
+	}
+
+	return &LeaveAttachmentService{
+		log:              ctx.NewLoggerHelper("hr/service/leave_attachment"),
+		attachmentRepo:   attachmentRepo,
+		leaveRequestRepo: leaveRequestRepo,
+		store:            store,
+		maxSize:          maxSize,
+		contentTypes:     contentTypes,
+	}
+}
+
+func (s *LeaveAttachmentService) UploadLeaveAttachment(ctx context.Context, req *hrV1.UploadLeaveAttachmentRequest) (*hrV1.UploadLeaveAttachmentResponse, error) {
+	if err := checkPermission(ctx, "hr.request.manage"); err != nil {
+		return nil, err
+	}
+
+	leaveReq, err := s.openLeaveRequest(ctx, req.GetLeaveRequestId())
+	if err != nil {
+		return nil, err
+	}
+	// Documents support the requester's own request; HR may attach those handed in on paper
+	if leaveReq.UserID != getUserID(ctx) && !hasPermission(ctx, "hr.request.approve") {
+		return nil, hrV1.ErrorBadRequest("you can only attach documents to your own leave requests")
+	}
+	switch leaveReq.Status {
+	case leaverequest.StatusRejected, leaverequest.StatusCancelled, leaverequest.StatusRevoked:
+		return nil, hrV1.ErrorBadRequest("documents cannot be attached to %s leave requests", leaveReq.Status)
+	}
+
+	fileName := path.Base(strings.ReplaceAll(strings.TrimSpace(req.GetFileName()), "\\", "/"))
+	if fileName == "" || fileName == "." || fileName == "/" {
+		return nil, hrV1.ErrorBadRequest("invalid file name")
+	}
+	content := req.GetContent()
+	if int64(len(content)) > s.maxSize {
+		return nil, hrV1.ErrorBadRequest("attachments must not exceed %d bytes", s.maxSize)
+	}
+	// The content type is detected from the contents rather than trusted from the client
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(content))
+	if !slices.Contains(s.contentTypes, contentType) {
+		return nil, hrV1.ErrorBadRequest("attachments of type %s are not accepted", contentType)
+	}
+
+	sum := sha256.Sum256(content)
+	key := fmt.Sprintf("%d/%s/%s", entityTenantID(leaveReq), leaveReq.ID, uuid.New().String())
+	if err := s.store.Put(ctx, key, content); err != nil {
+		s.log.Errorf("store leave attachment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("store leave attachment failed")
+	}
+
+	entity, err := s.attachmentRepo.Create(ctx, entityTenantID(leaveReq), leaveReq.ID, fileName, contentType, int64(len(content)), hex.EncodeToString(sum[:]), key, getUserID(ctx))
+	if err != nil {
+		if delErr := s.store.Delete(ctx, key); delErr != nil {
+			s.log.Warnf("failed to remove stored attachment %s: %v", key, delErr)
+		}
+		return nil, err
+	}
+
+	return &hrV1.UploadLeaveAttachmentResponse{
+		Attachment: leaveAttachmentToProto(entity),
+	}, nil
+}
+
+func (s *LeaveAttachmentService) ListLeaveAttachments(ctx context.Context, req *hrV1.ListLeaveAttachmentsRequest) (*hrV1.ListLeaveAttachmentsResponse, error) {
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
+		return nil, err
+	}
+
+	leaveReq, err := s.openLeaveRequest(ctx, req.GetLeaveRequestId())
+	if err != nil {
+		return nil, err
+	}
+	if !isLeaveParticipant(ctx, leaveReq) {
+		return nil, hrV1.ErrorBadRequest("you are not a participant of this leave request")
+	}
+
+	entities, err := s.attachmentRepo.ListByLeaveRequest(ctx, leaveReq.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.LeaveAttachment, 0, len(entities))
+	for _, e := range entities {
+		items = append(items, leaveAttachmentToProto(e))
+	}
+	total := int32(len(items))
+	return &hrV1.ListLeaveAttachmentsResponse{
+		Items: items,
+		Total: &total,
+	}, nil
+}
+
+func (s *LeaveAttachmentService) DownloadLeaveAttachment(ctx context.Context, req *hrV1.DownloadLeaveAttachmentRequest) (*hrV1.DownloadLeaveAttachmentResponse, error) {
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
+		return nil, err
+	}
+
+	leaveReq, entity, err := s.openAttachment(ctx, req.GetLeaveRequestId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if !isLeaveParticipant(ctx, leaveReq) {
+		return nil, hrV1.ErrorBadRequest("you are not a participant of this leave request")
+	}
+
+	content, err := s.store.Get(ctx, entity.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, hrV1.ErrorLeaveAttachmentNotFound("attachment contents not found")
+		}
+		s.log.Errorf("read leave attachment failed: %s", err.Error())
+		return nil, hrV1.ErrorInternalServerError("read leave attachment failed")
+	}
+
+	return &hrV1.DownloadLeaveAttachmentResponse{
+		Attachment: leaveAttachmentToProto(entity),
+		Content:    content,
+	}, nil
+}
+
+func (s *LeaveAttachmentService) DeleteLeaveAttachment(ctx context.Context, req *hrV1.DeleteLeaveAttachmentRequest) (*emptypb.Empty, error) {
+	if err := checkPermission(ctx, "hr.request.manage"); err != nil {
+		return nil, err
+	}
+
+	leaveReq, entity, err := s.openAttachment(ctx, req.GetLeaveRequestId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	// Uploaders may withdraw a document while the request awaits a decision; HR at any time
+	if !hasPermission(ctx, "hr.request.approve") {
+		if entity.CreateBy == nil || *entity.CreateBy != getUserID(ctx) {
+			return nil, hrV1.ErrorBadRequest("you can only delete documents you attached")
+		}
+		if leaveReq.Status != leaverequest.StatusPending {
+			return nil, hrV1.ErrorBadRequest("documents can only be deleted while the request is pending")
+		}
+	}
+
+	if err := s.attachmentRepo.Delete(ctx, entity); err != nil {
+		return nil, err
+	}
+	if err := s.store.Delete(ctx, entity.StorageKey); err != nil {
+		s.log.Warnf("failed to remove stored attachment %s: %v", entity.StorageKey, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// openLeaveRequest returns the leave request of the caller's tenant.
+func (s *LeaveAttachmentService) openLeaveRequest(ctx context.Context, id string) (*ent.LeaveRequest, error) {
+	leaveReq, err := s.leaveRequestRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if leaveReq == nil {
+		return nil, hrV1.ErrorLeaveRequestNotFound("leave request not found")
+	}
+	if err := checkTenantAccess(ctx, leaveReq.TenantID, hrV1.ErrorLeaveRequestNotFound("leave request not found")); err != nil {
+		return nil, err
+	}
+	return leaveReq, nil
+}
+
+// openAttachment returns the attachment with its leave request of the caller's tenant.
+func (s *LeaveAttachmentService) openAttachment(ctx context.Context, leaveRequestID, id string) (*ent.LeaveRequest, *ent.LeaveAttachment, error) {
+	leaveReq, err := s.openLeaveRequest(ctx, leaveRequestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	entity, err := s.attachmentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if entity == nil || entity.LeaveRequestID != leaveReq.ID {
+		return nil, nil, hrV1.ErrorLeaveAttachmentNotFound("leave attachment not found")
+	}
+	return leaveReq, entity, nil
+}
+
+func leaveAttachmentToProto(e *ent.LeaveAttachment) *hrV1.LeaveAttachment {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.LeaveAttachment{
+		Id:             &e.ID,
+		TenantId:       e.TenantID,
+		LeaveRequestId: ptrString(e.LeaveRequestID),
+		FileName:       ptrString(e.FileName),
+		ContentType:    ptrString(e.ContentType),
+		Size:           &e.Size,
+		Checksum:       ptrString(e.Checksum),
+		CreatedBy:      e.CreateBy,
+	}
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	return result
+}
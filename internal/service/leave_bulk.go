@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+// maxBulkLeaveRequests is the most requests a bulk operation processes at once.
+const maxBulkLeaveRequests = 200
+
+// BulkApproveLeaveRequests approves each selected request as ApproveLeaveRequest does, including
+// approval chains, allowance deduction and signing.
+func (s *LeaveService) BulkApproveLeaveRequests(ctx context.Context, req *hrV1.BulkApproveLeaveRequestsRequest) (*hrV1.BulkLeaveRequestsResponse, error) {
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
+		return nil, err
+	}
+
+	ids, err := s.selectLeaveRequests(ctx, req.GetSelection(), "pending")
+	if err != nil {
+		return nil, err
+	}
+
+	return bulkLeaveRequests(ids, func(id string) (*hrV1.BulkLeaveRequestResult, error) {
+		resp, err := s.ApproveLeaveRequest(ctx, &hrV1.ApproveLeaveRequestRequest{Id: id, ReviewNotes: req.ReviewNotes})
+		if err != nil {
+			return nil, err
+		}
+		return &hrV1.BulkLeaveRequestResult{
+			LeaveRequest:     resp.GetLeaveRequest(),
+			CoverageWarnings: resp.GetCoverageWarnings(),
+		}, nil
+	}), nil
+}
+
+// BulkRejectLeaveRequests rejects each selected request as RejectLeaveRequest does, including the
+// rejection email.
+func (s *LeaveService) BulkRejectLeaveRequests(ctx context.Context, req *hrV1.BulkRejectLeaveRequestsRequest) (*hrV1.BulkLeaveRequestsResponse, error) {
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
+		return nil, err
+	}
+
+	ids, err := s.selectLeaveRequests(ctx, req.GetSelection(), "pending")
+	if err != nil {
+		return nil, err
+	}
+
+	return bulkLeaveRequests(ids, func(id string) (*hrV1.BulkLeaveRequestResult, error) {
+		resp, err := s.RejectLeaveRequest(ctx, &hrV1.RejectLeaveRequestRequest{Id: id, ReviewNotes: req.ReviewNotes})
+		if err != nil {
+			return nil, err
+		}
+		return &hrV1.BulkLeaveRequestResult{LeaveRequest: resp.GetLeaveRequest()}, nil
+	}), nil
+}
+
+// BulkRevokeLeaveRequests revokes each selected request as RevokeLeaveRequest does, including the
+// allowance refund.
+func (s *LeaveService) BulkRevokeLeaveRequests(ctx context.Context, req *hrV1.BulkRevokeLeaveRequestsRequest) (*hrV1.BulkLeaveRequestsResponse, error) {
+	if err := checkPermission(ctx, "hr.request.approve"); err != nil {
+		return nil, err
+	}
+
+	ids, err := s.selectLeaveRequests(ctx, req.GetSelection(), "approved")
+	if err != nil {
+		return nil, err
+	}
+
+	return bulkLeaveRequests(ids, func(id string) (*hrV1.BulkLeaveRequestResult, error) {
+		resp, err := s.RevokeLeaveRequest(ctx, &hrV1.RevokeLeaveRequestRequest{Id: id, Reason: req.Reason})
+		if err != nil {
+			return nil, err
+		}
+		return &hrV1.BulkLeaveRequestResult{LeaveRequest: resp.GetLeaveRequest()}, nil
+	}), nil
+}
+
+// selectLeaveRequests returns the IDs of the requests a bulk operation applies to: the given IDs,
+// or the requests of the caller's tenant with the given status that match the filter. Only HR may
+// select requests by filter.
+func (s *LeaveService) selectLeaveRequests(ctx context.Context, sel *hrV1.LeaveRequestSelection, status string) ([]string, error) {
+	if sel == nil {
+		return nil, hrV1.ErrorBadRequest("select requests by ID or by filter")
+	}
+	filtered := sel.UserId != nil || sel.AbsenceTypeId != nil || sel.StartDate != nil || sel.EndDate != nil
+	if len(sel.GetIds()) > 0 {
+		if filtered {
+			return nil, hrV1.ErrorBadRequest("select requests either by ID or by filter")
+		}
+		// Checked here too, as the validation of the request may not be enabled
+		if len(sel.GetIds()) > maxBulkLeaveRequests {
+			return nil, hrV1.ErrorBadRequest("%d requests were selected, at most %d can be processed at once", len(sel.GetIds()), maxBulkLeaveRequests)
+		}
+		seen := make(map[string]bool, len(sel.GetIds()))
+		var ids []string
+		for _, id := range sel.GetIds() {
+			if id != "" && !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		return ids, nil
+	}
+	if !filtered {
+		return nil, hrV1.ErrorBadRequest("select requests by ID or by filter")
+	}
+	if err := checkPermission(ctx, "hr.request.approve"); err != nil {
+		return nil, err
+	}
+
+	filters := map[string]interface{}{"status": status}
+	if sel.UserId != nil {
+		filters["user_id"] = *sel.UserId
+	}
+	if sel.AbsenceTypeId != nil {
+		filters["absence_type_id"] = *sel.AbsenceTypeId
+	}
+	if sel.StartDate != nil {
+		t, err := time.Parse(time.RFC3339, *sel.StartDate)
+		if err != nil {
+			return nil, hrV1.ErrorBadRequest("invalid start_date: %v", err)
+		}
+		filters["start_date"] = t
+	}
+	if sel.EndDate != nil {
+		t, err := time.Parse(time.RFC3339, *sel.EndDate)
+		if err != nil {
+			return nil, hrV1.ErrorBadRequest("invalid end_date: %v", err)
+		}
+		filters["end_date"] = t
+	}
+
+	entities, total, err := s.leaveRequestRepo.List(ctx, getTenantID(ctx), 0, 0, filters)
+	if err != nil {
+		return nil, err
+	}
+	if total > maxBulkLeaveRequests {
+		return nil, hrV1.ErrorBadRequest("the filter matches %d requests, at most %d can be processed at once", total, maxBulkLeaveRequests)
+	}
+
+	ids := make([]string, len(entities))
+	for i, e := range entities {
+		ids[i] = e.ID
+	}
+	return ids, nil
+}
+
+// bulkLeaveRequests applies op to each request in turn and reports the outcome per request. A
+// failed request does not stop the others.
+func bulkLeaveRequests(ids []string, op func(id string) (*hrV1.BulkLeaveRequestResult, error)) *hrV1.BulkLeaveRequestsResponse {
+	resp := &hrV1.BulkLeaveRequestsResponse{
+		Results: make([]*hrV1.BulkLeaveRequestResult, 0, len(ids)),
+	}
+	for _, id := range ids {
+		result, err := op(id)
+		if err != nil {
+			se := errors.FromError(err)
+			resp.Results = append(resp.Results, &hrV1.BulkLeaveRequestResult{
+				Id:           id,
+				ErrorReason:  se.Reason,
+				ErrorMessage: se.Message,
+			})
+			resp.Failed++
+			continue
+		}
+		result.Id = id
+		result.Success = true
+		resp.Results = append(resp.Results, result)
+		resp.Succeeded++
+	}
+	return resp
+}
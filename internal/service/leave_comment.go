@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leavecomment"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+const (
+	commentTemplateName        = "hr-leave-comment"
+	defaultCommentSubject      = `New comment on the leave request of {{.RequesterName}}`
+	commentTemplateVariables   = "RecipientName,AuthorName,RequesterName,AbsenceTypeName,StartDate,EndDate,Comment"
+	defaultCommentBodyTemplate = `<!DOCTYPE html>
+<html>
+<head><meta charset="UTF-8"></head>
+<body style="font-family: Arial, sans-serif; max-width: 600px; margin: 0 auto; padding: 20px;">
+  <div style="background: #f8f9fa; border-radius: 8px; padding: 30px;">
+    <h2 style="color: #1677ff; margin-top: 0;">New Comment on a Leave Request</h2>
+    <p>Hello {{.RecipientName}},</p>
+    <p><strong>{{.AuthorName}}</strong> commented on the leave request of <strong>{{.RequesterName}}</strong> for <strong>{{.AbsenceTypeName}}</strong> from <strong>{{.StartDate}}</strong> to <strong>{{.EndDate}}</strong>:</p>
+    <div style="background: #fff; border-left: 3px solid #1677ff; padding: 10px 15px; margin: 15px 0;">
+      <p style="margin: 0; color: #555; white-space: pre-wrap;">{{.Comment}}</p>
+    </div>
+    <p style="color: #666; font-size: 13px;">Reply by commenting on the request in the HR module.</p>
+    <hr style="border: none; border-top: 1px solid #e8e8e8; margin: 20px 0;">
+    <p style="color: #999; font-size: 12px;">
+      This is an automated message. Please do not reply to this email.
+    </p>
+  </div>
+</body>
+</html>`
+)
+
+// PostLeaveComment adds a comment to the discussion of a leave request. Anyone taking part in the
+// request may post shared comments; only HR may post internal ones. Shared comments of the
+// requester are sent to the approvers, and those of anyone else to the requester.
+func (s *LeaveService) PostLeaveComment(ctx context.Context, req *hrV1.PostLeaveCommentRequest) (*hrV1.PostLeaveCommentResponse, error) {
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
+		return nil, err
+	}
+
+	leaveReq, err := s.openCommentedRequest(ctx, req.GetLeaveRequestId())
+	if err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(req.GetBody())
+	if body == "" {
+		return nil, hrV1.ErrorBadRequest("comment must not be empty")
+	}
+	visibility := leavecomment.VisibilityShared
+	if req.GetVisibility() == hrV1.LeaveCommentVisibility_LEAVE_COMMENT_VISIBILITY_INTERNAL {
+		if !hasPermission(ctx, "hr.request.approve") {
+			return nil, hrV1.ErrorBadRequest("only HR may post internal comments")
+		}
+		visibility = leavecomment.VisibilityInternal
+	}
+
+	// Internal comments are not for the requester, and HR reads them in the thread
+	authorID := getUserID(ctx)
+	var recipients []string
+	var recipientName string
+	if visibility == leavecomment.VisibilityShared {
+		recipients, recipientName = s.commentRecipients(ctx, leaveReq, authorID)
+	}
+
+	entity, err := s.commentRepo.CreateWithOutbox(ctx, entityTenantID(leaveReq), leaveReq.ID, authorID, getUsername(ctx), body, visibility.String(), func(c *ent.LeaveComment) []data.OutboxMessage {
+		if len(recipients) == 0 {
+			return nil
+		}
+		return []data.OutboxMessage{commentNotificationMessage(c, recipients, recipientName)}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.PostLeaveCommentResponse{
+		Comment: leaveCommentToProto(entity),
+	}, nil
+}
+
+// ListLeaveComments returns the discussion of a leave request, oldest first. Internal comments
+// are only listed for HR.
+func (s *LeaveService) ListLeaveComments(ctx context.Context, req *hrV1.ListLeaveCommentsRequest) (*hrV1.ListLeaveCommentsResponse, error) {
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
+		return nil, err
+	}
+
+	leaveReq, err := s.openCommentedRequest(ctx, req.GetLeaveRequestId())
+	if err != nil {
+		return nil, err
+	}
+
+	entities, err := s.commentRepo.ListByLeaveRequest(ctx, leaveReq.ID, hasPermission(ctx, "hr.request.approve"))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.LeaveComment, 0, len(entities))
+	for _, e := range entities {
+		items = append(items, leaveCommentToProto(e))
+	}
+	total := int32(len(items))
+	return &hrV1.ListLeaveCommentsResponse{
+		Items: items,
+		Total: &total,
+	}, nil
+}
+
+// UpdateLeaveComment edits the text of a comment. Only its author may edit it; the replaced text
+// is kept in the comment's edit history.
+func (s *LeaveService) UpdateLeaveComment(ctx context.Context, req *hrV1.UpdateLeaveCommentRequest) (*hrV1.UpdateLeaveCommentResponse, error) {
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
+		return nil, err
+	}
+
+	leaveReq, err := s.openCommentedRequest(ctx, req.GetLeaveRequestId())
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.commentRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil || existing.LeaveRequestID != leaveReq.ID {
+		return nil, hrV1.ErrorLeaveCommentNotFound("leave comment not found")
+	}
+	if existing.AuthorID != getUserID(ctx) {
+		return nil, hrV1.ErrorBadRequest("you can only edit your own comments")
+	}
+
+	body := strings.TrimSpace(req.GetBody())
+	if body == "" {
+		return nil, hrV1.ErrorBadRequest("comment must not be empty")
+	}
+	if body == existing.Body {
+		return &hrV1.UpdateLeaveCommentResponse{Comment: leaveCommentToProto(existing)}, nil
+	}
+
+	entity, err := s.commentRepo.UpdateBody(ctx, existing, body, getUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &hrV1.UpdateLeaveCommentResponse{
+		Comment: leaveCommentToProto(entity),
+	}, nil
+}
+
+// openCommentedRequest returns the leave request of the caller's tenant whose discussion the
+// caller takes part in.
+func (s *LeaveService) openCommentedRequest(ctx context.Context, id string) (*ent.LeaveRequest, error) {
+	leaveReq, err := s.leaveRequestRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if leaveReq == nil {
+		return nil, hrV1.ErrorLeaveRequestNotFound("leave request not found")
+	}
+	if err := checkTenantAccess(ctx, leaveReq.TenantID, hrV1.ErrorLeaveRequestNotFound("leave request not found")); err != nil {
+		return nil, err
+	}
+	if !isLeaveParticipant(ctx, leaveReq) {
+		return nil, hrV1.ErrorBadRequest("you are not a participant of this leave request")
+	}
+	return leaveReq, nil
+}
+
+// commentRecipients returns the email addresses a shared comment by authorID is sent to: the
+// approvers awaited, or the last reviewer, when the requester comments, and the requester
+// otherwise. The recipient name is set when there is a single named recipient.
+func (s *LeaveService) commentRecipients(ctx context.Context, e *ent.LeaveRequest, authorID uint32) ([]string, string) {
+	if authorID != e.UserID {
+		email := e.UserEmail
+		if email == "" {
+			email = s.resolveUserEmail(ctx, e.UserID)
+		}
+		if email == "" {
+			return nil, ""
+		}
+		return []string{email}, e.UserName
+	}
+
+	var approverIDs []uint32
+	if e.ApproverID != 0 {
+		approverIDs = []uint32{e.ApproverID}
+	} else if e.ApprovalStep < len(e.ApprovalSteps) {
+		approverIDs = e.ApprovalSteps[e.ApprovalStep].ApproverIDs
+	}
+	if len(approverIDs) == 0 && e.ReviewedBy != 0 {
+		approverIDs = []uint32{e.ReviewedBy}
+	}
+
+	var recipients []string
+	for _, id := range approverIDs {
+		if id == authorID {
+			continue
+		}
+		if email := s.resolveUserEmail(ctx, id); email != "" {
+			recipients = append(recipients, email)
+		}
+	}
+	return recipients, ""
+}
+
+// sendCommentNotification sends a new comment to each recipient. An error means the delivery
+// is to be retried.
+func (s *LeaveService) sendCommentNotification(ctx context.Context, notification commentNotification) error {
+	comment, err := s.commentRepo.GetByID(ctx, notification.CommentID)
+	if err != nil {
+		return err
+	}
+	if comment == nil {
+		s.log.Warnf("Leave comment %s no longer exists, skipping comment notification", notification.CommentID)
+		return nil
+	}
+	e, err := s.leaveRequestRepo.GetByID(ctx, comment.LeaveRequestID)
+	if err != nil {
+		return err
+	}
+	if e == nil {
+		s.log.Warnf("Leave request %s no longer exists, skipping comment notification", comment.LeaveRequestID)
+		return nil
+	}
+
+	templateID, err := s.ensureTemplate(ctx, commentTemplateName, defaultCommentSubject, defaultCommentBodyTemplate, commentTemplateVariables)
+	if err != nil {
+		return fmt.Errorf("ensure comment template: %w", err)
+	}
+
+	absenceTypeName := ""
+	if e.Edges.AbsenceType != nil {
+		absenceTypeName = e.Edges.AbsenceType.Name
+	}
+
+	variables := map[string]string{
+		"RecipientName":   html.EscapeString(notification.RecipientName),
+		"AuthorName":      html.EscapeString(comment.AuthorName),
+		"RequesterName":   html.EscapeString(e.UserName),
+		"AbsenceTypeName": html.EscapeString(absenceTypeName),
+		"StartDate":       e.StartDate.Format("2006-01-02"),
+		"EndDate":         e.EndDate.Format("2006-01-02"),
+		"Comment":         html.EscapeString(comment.Body),
+	}
+
+	platformCtx := detachedPlatformContext(ctx)
+	for _, recipient := range notification.Recipients {
+		if _, err := s.notificationClient.SendNotification(platformCtx, templateID, recipient, variables); err != nil {
+			return fmt.Errorf("send comment on leave %s to %s: %w", e.ID, recipient, err)
+		}
+	}
+	s.log.Infof("Comment on leave request %s sent to %d recipients", e.ID, len(notification.Recipients))
+	return nil
+}
+
+// removeLeaveComments removes the discussion of a leave request that is being deleted.
+func (s *LeaveService) removeLeaveComments(ctx context.Context, leaveRequestID string) {
+	if err := s.commentRepo.DeleteByLeaveRequest(ctx, leaveRequestID); err != nil {
+		s.log.Errorf("Failed to remove comments of leave %s: %v", leaveRequestID, err)
+	}
+}
+
+func leaveCommentToProto(e *ent.LeaveComment) *hrV1.LeaveComment {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.LeaveComment{
+		Id:             &e.ID,
+		TenantId:       e.TenantID,
+		LeaveRequestId: ptrString(e.LeaveRequestID),
+		AuthorId:       &e.AuthorID,
+		AuthorName:     ptrString(e.AuthorName),
+		Body:           ptrString(e.Body),
+	}
+	switch e.Visibility {
+	case leavecomment.VisibilityShared:
+		v := hrV1.LeaveCommentVisibility_LEAVE_COMMENT_VISIBILITY_SHARED
+		result.Visibility = &v
+	case leavecomment.VisibilityInternal:
+		v := hrV1.LeaveCommentVisibility_LEAVE_COMMENT_VISIBILITY_INTERNAL
+		result.Visibility = &v
+	}
+	for _, edit := range e.Edits {
+		result.Edits = append(result.Edits, &hrV1.LeaveCommentEdit{
+			Body:     edit.Body,
+			EditedAt: timestamppb.New(edit.EditedAt),
+		})
+	}
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	if e.UpdateTime != nil {
+		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
+	}
+	return result
+}
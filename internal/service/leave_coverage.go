@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-hr/internal/coverage"
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/workday"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+// GetLeaveCoverage checks a leave request against the coverage rules of the requester's org
+// units, so that approvers see which colleagues are away at the same time before deciding it.
+func (s *LeaveService) GetLeaveCoverage(ctx context.Context, req *hrV1.GetLeaveCoverageRequest) (*hrV1.GetLeaveCoverageResponse, error) {
+	if err := checkPermission(ctx, "hr.calendar.view"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.leaveRequestRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorLeaveRequestNotFound("leave request not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorLeaveRequestNotFound("leave request not found")); err != nil {
+		return nil, err
+	}
+
+	conflicts, err := s.evaluateCoverage(ctx, entityTenantID(existing), existing.UserID, existing.OrgUnitName, existing.AbsenceTypeID, data.LeaveSpan(existing), existing.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.GetLeaveCoverageResponse{
+		Conflicts: coverageConflictsToProto(conflicts),
+	}, nil
+}
+
+// checkCoverage checks an absence against the coverage rules of the requester's org units. A
+// broken blocking rule fails with a COVERAGE_CONFLICT error listing the blocking conflicts;
+// otherwise the broken warning rules are returned.
+func (s *LeaveService) checkCoverage(ctx context.Context, tenantID, userID uint32, orgUnitName, absenceTypeID string, span workday.Span, leaveRequestID string) ([]*hrV1.CoverageConflict, error) {
+	conflicts, err := s.evaluateCoverage(ctx, tenantID, userID, orgUnitName, absenceTypeID, span, leaveRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocking, warnings []coverage.Conflict
+	for _, c := range conflicts {
+		if c.Blocking() {
+			blocking = append(blocking, c)
+		} else {
+			warnings = append(warnings, c)
+		}
+	}
+	if len(blocking) > 0 {
+		encoded, err := json.Marshal(blocking)
+		if err != nil {
+			return nil, hrV1.ErrorInternalServerError("encode coverage conflicts failed")
+		}
+		return nil, hrV1.ErrorCoverageConflict("%s", coverage.Summary(blocking)).
+			WithMetadata(map[string]string{"conflicts": string(encoded)})
+	}
+	return coverageConflictsToProto(warnings), nil
+}
+
+// evaluateCoverage returns the coverage rules an absence of the user over the span breaks. The
+// user's own leave request, if any, is left out of the colleagues' absences. Colleagues count as
+// absent once their leave is approved or awaits signatures. Without the admin service the
+// members of org units are unknown, so no rule is checked.
+func (s *LeaveService) evaluateCoverage(ctx context.Context, tenantID, userID uint32, orgUnitName, absenceTypeID string, span workday.Span, leaveRequestID string) ([]coverage.Conflict, error) {
+	members, err := s.listMembers(ctx, userID)
+	if err != nil {
+		s.log.Warnf("Failed to list users for coverage check: %v", err)
+		return nil, nil
+	}
+	if members == nil {
+		return nil, nil
+	}
+
+	var orgUnits []string
+	if orgUnitName != "" {
+		orgUnits = append(orgUnits, orgUnitName)
+	}
+	for _, m := range members {
+		if m.ID != userID {
+			continue
+		}
+		for _, unit := range m.OrgUnits {
+			if unit != orgUnitName {
+				orgUnits = append(orgUnits, unit)
+			}
+		}
+	}
+
+	entities, err := s.coverageRepo.ListApplicable(ctx, tenantID, orgUnits, absenceTypeID)
+	if err != nil {
+		return nil, err
+	}
+	if len(entities) == 0 {
+		return nil, nil
+	}
+	rules := make([]coverage.Rule, len(entities))
+	for i, e := range entities {
+		rules[i] = coverage.Rule{
+			ID:          e.ID,
+			Name:        e.Name,
+			OrgUnit:     e.OrgUnitName,
+			Kind:        e.Kind.String(),
+			Count:       e.Count,
+			Position:    e.Position,
+			Enforcement: e.Enforcement.String(),
+		}
+	}
+
+	// Requests of a single whole day end on the day they start, so the window reaches back a day
+	from, to := span.Interval()
+	events, err := s.leaveRequestRepo.GetCalendarEvents(ctx, tenantID, from.AddDate(0, 0, -1), to, "", 0)
+	if err != nil {
+		return nil, err
+	}
+	var absences []coverage.Absence
+	for _, e := range events {
+		if e.ID == leaveRequestID || e.Status == leaverequest.StatusPending {
+			continue
+		}
+		absences = append(absences, coverage.Absence{
+			UserID:         e.UserID,
+			UserName:       e.UserName,
+			LeaveRequestID: e.ID,
+			Span:           data.LeaveSpan(e),
+		})
+	}
+
+	return coverage.Evaluate(rules, members, userID, span, absences), nil
+}
+
+func coverageConflictsToProto(conflicts []coverage.Conflict) []*hrV1.CoverageConflict {
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	result := make([]*hrV1.CoverageConflict, len(conflicts))
+	for i, c := range conflicts {
+		conflict := &hrV1.CoverageConflict{
+			RuleId:      c.RuleID,
+			RuleName:    c.RuleName,
+			Enforcement: coverageEnforcementToProto(c.Enforcement),
+			FirstDate:   timestamppb.New(c.FirstDate),
+			LastDate:    timestamppb.New(c.LastDate),
+			Message:     c.Message,
+		}
+		for _, colleague := range c.Colleagues {
+			conflict.Colleagues = append(conflict.Colleagues, &hrV1.CoverageColleague{
+				UserId:         colleague.UserID,
+				UserName:       colleague.UserName,
+				LeaveRequestId: colleague.LeaveRequestID,
+				StartDate:      timestamppb.New(colleague.StartDate),
+				EndDate:        timestamppb.New(colleague.EndDate),
+			})
+		}
+		result[i] = conflict
+	}
+	return result
+}
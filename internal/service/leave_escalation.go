@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"math"
+	"time"
+
+	"github.com/go-tangra/go-tangra-hr/internal/approval"
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-hr/internal/escalation"
+	"github.com/go-tangra/go-tangra-hr/internal/event"
+)
+
+const (
+	reminderTemplateName        = "hr-leave-reminder"
+	defaultReminderSubject      = `Reminder: leave request awaiting your decision`
+	escalationTemplateName      = "hr-leave-escalation"
+	defaultEscalationSubject    = `Escalation: leave request of {{.RequesterName}} awaiting a decision`
+	staleTemplateVariables      = "RecipientName,RequesterName,AbsenceTypeName,StartDate,EndDate,Days,Status,WaitingDays"
+	defaultReminderBodyTemplate = `<!DOCTYPE html>
+<html>
+<head><meta charset="UTF-8"></head>
+<body style="font-family: Arial, sans-serif; max-width: 600px; margin: 0 auto; padding: 20px;">
+  <div style="background: #f8f9fa; border-radius: 8px; padding: 30px;">
+    <h2 style="color: #d48806; margin-top: 0;">Leave Request Awaiting Your Decision</h2>
+    <p>Hello {{.RecipientName}},</p>
+    <p>The leave request of <strong>{{.RequesterName}}</strong> for <strong>{{.AbsenceTypeName}}</strong> from <strong>{{.StartDate}}</strong> to <strong>{{.EndDate}}</strong> ({{.Days}} days) has been {{.Status}} for {{.WaitingDays}} days.</p>
+    <p>Please review the request as soon as possible.</p>
+    <hr style="border: none; border-top: 1px solid #e8e8e8; margin: 20px 0;">
+    <p style="color: #999; font-size: 12px;">
+      This is an automated message. Please do not reply to this email.
+    </p>
+  </div>
+</body>
+</html>`
+	defaultEscalationBodyTemplate = `<!DOCTYPE html>
+<html>
+<head><meta charset="UTF-8"></head>
+<body style="font-family: Arial, sans-serif; max-width: 600px; margin: 0 auto; padding: 20px;">
+  <div style="background: #f8f9fa; border-radius: 8px; padding: 30px;">
+    <h2 style="color: #c00; margin-top: 0;">Leave Request Escalated</h2>
+    <p>Hello,</p>
+    <p>The leave request of <strong>{{.RequesterName}}</strong> for <strong>{{.AbsenceTypeName}}</strong> from <strong>{{.StartDate}}</strong> to <strong>{{.EndDate}}</strong> ({{.Days}} days) has been {{.Status}} for {{.WaitingDays}} days without a decision.</p>
+    <p>Please follow up with the approver or decide the request in their place.</p>
+    <hr style="border: none; border-top: 1px solid #e8e8e8; margin: 20px 0;">
+    <p style="color: #999; font-size: 12px;">
+      This is an automated message. Please do not reply to this email.
+    </p>
+  </div>
+</body>
+</html>`
+)
+
+// EscalateStaleRequests follows up the requests that await a decision or signatures under the
+// escalation policies of their absence types: approvers are reminded, the policy's recipients
+// are notified on escalation, and requests are rejected or approved automatically. Automatic
+// decisions go through the same allowance deduction as manual ones. Returns the number of
+// requests followed up.
+func (s *LeaveService) EscalateStaleRequests(ctx context.Context, now time.Time) int {
+	absTypes, err := s.absenceTypeRepo.ListWithEscalation(ctx)
+	if err != nil || len(absTypes) == 0 {
+		return 0
+	}
+
+	policies := make(map[string]*escalation.Policy, len(absTypes))
+	ids := make([]string, 0, len(absTypes))
+	for _, t := range absTypes {
+		if t.EscalationPolicy.Enabled() {
+			policies[t.ID] = t.EscalationPolicy
+			ids = append(ids, t.ID)
+		}
+	}
+
+	requests, err := s.leaveRequestRepo.ListAwaitingDecision(ctx, ids)
+	if err != nil {
+		return 0
+	}
+
+	followedUp := 0
+	for _, e := range requests {
+		policy := policies[e.AbsenceTypeID]
+		since := data.AwaitingSince(e)
+
+		// Requests that cannot be approved automatically are still reminded and escalated
+		if policy.AutoAction == escalation.ActionApprove && !canAutoApprove(e) {
+			p := *policy
+			p.AutoAction = ""
+			policy = &p
+		}
+
+		var err error
+		switch policy.Due(e.EscalationLevel, since, e.StartDate, now) {
+		case escalation.StepRemind:
+			err = s.remindApprovers(ctx, e, now.Sub(since))
+			if err == nil {
+				err = s.leaveRequestRepo.SetEscalationLevel(ctx, e.ID, escalation.LevelReminded)
+			}
+		case escalation.StepEscalate:
+			err = s.notifyStale(ctx, e, escalationTemplateName, defaultEscalationSubject, defaultEscalationBodyTemplate, policy.EscalateTo, "", now.Sub(since))
+			if err == nil {
+				err = s.leaveRequestRepo.SetEscalationLevel(ctx, e.ID, escalation.LevelEscalated)
+			}
+		case escalation.StepAutoAction:
+			err = s.autoDecide(ctx, e, policy.AutoAction, now.Sub(since))
+		default:
+			continue
+		}
+		if err != nil {
+			s.log.Errorf("Failed to follow up leave request %s: %v", e.ID, err)
+			continue
+		}
+		followedUp++
+	}
+	return followedUp
+}
+
+// remindApprovers reminds the approvers of a pending request, or the requester of a request
+// awaiting signatures, who signs first.
+func (s *LeaveService) remindApprovers(ctx context.Context, e *ent.LeaveRequest, waited time.Duration) error {
+	if e.Status == leaverequest.StatusAwaitingSigning {
+		if e.UserEmail == "" {
+			return nil
+		}
+		return s.notifyStale(ctx, e, reminderTemplateName, defaultReminderSubject, defaultReminderBodyTemplate, []string{e.UserEmail}, e.UserName, waited)
+	}
+
+	var approverIDs []uint32
+	if e.ApproverID != 0 {
+		approverIDs = []uint32{e.ApproverID}
+	} else if e.ApprovalStep < len(e.ApprovalSteps) {
+		approverIDs = e.ApprovalSteps[e.ApprovalStep].ApproverIDs
+	}
+
+	// Requests any approver may decide have no one in particular to remind
+	var recipients []string
+	for _, id := range approverIDs {
+		if email := s.resolveUserEmail(ctx, id); email != "" {
+			recipients = append(recipients, email)
+		}
+	}
+	if len(recipients) == 0 {
+		s.log.Infof("No approver to remind of leave request %s", e.ID)
+		return nil
+	}
+	return s.notifyStale(ctx, e, reminderTemplateName, defaultReminderSubject, defaultReminderBodyTemplate, recipients, "", waited)
+}
+
+// notifyStale sends a notification about a request awaiting a decision to each recipient.
+func (s *LeaveService) notifyStale(ctx context.Context, e *ent.LeaveRequest, templateName, subject, body string, recipients []string, recipientName string, waited time.Duration) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	templateID, err := s.ensureTemplate(ctx, templateName, subject, body, staleTemplateVariables)
+	if err != nil {
+		return err
+	}
+
+	absenceTypeName := ""
+	if e.Edges.AbsenceType != nil {
+		absenceTypeName = e.Edges.AbsenceType.Name
+	}
+	status := "pending"
+	if e.Status == leaverequest.StatusAwaitingSigning {
+		status = "awaiting signatures"
+	}
+
+	variables := map[string]string{
+		"RecipientName":   html.EscapeString(recipientName),
+		"RequesterName":   html.EscapeString(e.UserName),
+		"AbsenceTypeName": html.EscapeString(absenceTypeName),
+		"StartDate":       e.StartDate.Format("2006-01-02"),
+		"EndDate":         e.EndDate.Format("2006-01-02"),
+		"Days":            fmt.Sprintf("%.1f", e.Days),
+		"Status":          status,
+		"WaitingDays":     fmt.Sprintf("%d", int(math.Floor(waited.Hours()/24))),
+	}
+
+	platformCtx := detachedPlatformContext(ctx)
+	for _, recipient := range recipients {
+		if _, err := s.notificationClient.SendNotification(platformCtx, templateID, recipient, variables); err != nil {
+			return fmt.Errorf("send %s to %s: %w", templateName, recipient, err)
+		}
+	}
+	s.log.Infof("Sent %s for leave request %s to %d recipients", templateName, e.ID, len(recipients))
+	return nil
+}
+
+// autoDecide rejects or approves a stale request on behalf of the system, the way the reject and
+// approve RPCs do. Requests awaiting signatures and those of absence types that require signing
+// are never approved automatically.
+func (s *LeaveService) autoDecide(ctx context.Context, e *ent.LeaveRequest, action string, waited time.Duration) error {
+	waitedDays := int(math.Floor(waited.Hours() / 24))
+
+	switch action {
+	case escalation.ActionReject:
+		notes := fmt.Sprintf("Automatically rejected after waiting %d days for a decision", waitedDays)
+		if e.Status == leaverequest.StatusPending && e.ApprovalStep < len(e.ApprovalSteps) {
+			decision := schema.LeaveApproval{Decision: approval.DecisionRejected, Notes: notes}
+			if _, err := s.leaveRequestRepo.DecideApprovalStep(ctx, e.ID, e.ApprovalStep, decision); err != nil {
+				return err
+			}
+		}
+		_, err := s.leaveRequestRepo.UpdateStatusWithOutbox(ctx, e.ID, "rejected", 0, "", notes, func(entity *ent.LeaveRequest) []data.OutboxMessage {
+			messages := []data.OutboxMessage{
+				event.LeaveMessage(event.LeaveRejected, entity, 0),
+				rejectionEmailMessage(entity, "", notes),
+			}
+			// The employee must no longer be able to sign a rejected request
+			if e.Status == leaverequest.StatusAwaitingSigning && e.SigningRequestID != "" {
+				messages = append(messages, signingCancelMessage(ctx, entity, notes))
+			}
+			return messages
+		})
+		if err != nil {
+			return err
+		}
+		s.log.Infof("Leave request %s rejected automatically", e.ID)
+		return nil
+
+	case escalation.ActionApprove:
+		if !canAutoApprove(e) {
+			return nil
+		}
+
+		notes := fmt.Sprintf("Automatically approved after waiting %d days for a decision", waitedDays)
+		first := e.ApprovalStep
+		for step := first; step < len(e.ApprovalSteps); step++ {
+			decision := schema.LeaveApproval{Decision: approval.DecisionApproved, Notes: notes}
+			if _, err := s.leaveRequestRepo.DecideApprovalStep(ctx, e.ID, step, decision); err != nil {
+				return err
+			}
+		}
+
+		if _, err := s.approveImmediate(ctx, e, e.ID, notes); err != nil {
+			// The request was not approved after all, so its remaining steps await a decision again
+			if first < len(e.ApprovalSteps) {
+				if reopenErr := s.leaveRequestRepo.ReopenApprovalStep(ctx, e.ID, first); reopenErr != nil {
+					s.log.Errorf("Failed to reopen approval step %d of leave %s: %v", first, e.ID, reopenErr)
+				}
+			}
+			return err
+		}
+		s.log.Infof("Leave request %s approved automatically", e.ID)
+		return nil
+	}
+	return nil
+}
+
+// canAutoApprove reports whether a request may be approved without an approver: it must be
+// pending, its absence type must not require signing and it must not lack a document that blocks
+// its approval.
+func canAutoApprove(e *ent.LeaveRequest) bool {
+	absType := e.Edges.AbsenceType
+	return e.Status == leaverequest.StatusPending && (absType == nil || !absType.RequiresSigning || absType.SigningTemplateID == "") &&
+		!attachmentStatus(e, time.Now()).Blocking
+}
@@ -2,12 +2,18 @@ package service
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 
+	"github.com/go-tangra/go-tangra-hr/internal/approval"
 	"github.com/go-tangra/go-tangra-hr/internal/data"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/absencetype"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-hr/internal/workday"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
 )
 
 // entityTenantID extracts the tenant ID value from an entity's *uint32 TenantID field.
@@ -23,26 +29,143 @@ func isPoolBased(absType *ent.AbsenceType) bool {
 	return absType != nil && absType.AllowancePoolID != ""
 }
 
+// ledgerRef attributes an allowance change to the calling user.
+func ledgerRef(ctx context.Context, leaveRequestID string, note string) data.LedgerRef {
+	return data.LedgerRef{
+		LeaveRequestID: leaveRequestID,
+		ActorID:        getUserID(ctx),
+		ActorName:      getUsername(ctx),
+		Note:           note,
+	}
+}
+
+// approvalSteps copies the steps of the approval chain that apply to a request of the given
+// length onto the request, so that later changes to the chain leave submitted requests alone.
+// Steps for the line manager are assigned to the requester's manager when one is known.
+func approvalSteps(chain *approval.Chain, days float64, manager *approval.Member) []schema.LeaveApproval {
+	var steps []schema.LeaveApproval
+	for _, st := range chain.StepsFor(days) {
+		approverIDs := st.ApproverIDs
+		if st.Manager && manager != nil {
+			approverIDs = []uint32{manager.ID}
+		}
+		steps = append(steps, schema.LeaveApproval{
+			Name:        st.Name,
+			Role:        st.Role,
+			ApproverIDs: approverIDs,
+			Decision:    approval.DecisionPending,
+		})
+	}
+	return steps
+}
+
+// approverCheck tells on what authority the caller decides a leave request.
+type approverCheck struct {
+	// override is set when an HR admin decides in place of the assigned approver
+	override bool
+	// delegation is set when the caller decides as the delegate of the assigned approver
+	delegation *ent.ApprovalDelegation
+}
+
+// decision returns the record of the caller's decision on an approval step.
+func (c approverCheck) decision(ctx context.Context, decision string, notes string) schema.LeaveApproval {
+	d := schema.LeaveApproval{
+		Decision:     decision,
+		ApproverID:   getUserID(ctx),
+		ApproverName: getUsername(ctx),
+		Notes:        notes,
+		Override:     c.override,
+	}
+	if c.delegation != nil {
+		d.OnBehalfOf = c.delegation.DelegatorID
+		d.OnBehalfOfName = c.delegation.DelegatorName
+	}
+	return d
+}
+
+// checkApprover returns an error unless the caller may decide the request now. A request, or the
+// approval step it awaits, that is assigned to approvers may only be decided by those approvers
+// or their delegates, unless an HR admin overrides the assignment. Unassigned requests may be
+// decided by anyone who may approve requests and, for chain steps, holds the step's role.
+func checkApprover(ctx context.Context, delegationRepo *data.ApprovalDelegationRepo, leaveReq *ent.LeaveRequest) (approverCheck, error) {
+	var step *schema.LeaveApproval
+	if len(leaveReq.ApprovalSteps) > 0 {
+		if leaveReq.ApprovalStep >= len(leaveReq.ApprovalSteps) {
+			return approverCheck{}, hrV1.ErrorBadRequest("approval step has already been decided")
+		}
+		step = &leaveReq.ApprovalSteps[leaveReq.ApprovalStep]
+	}
+	callerID := getUserID(ctx)
+
+	var approvers []uint32
+	if leaveReq.ApproverID != 0 {
+		approvers = []uint32{leaveReq.ApproverID}
+	} else if step != nil {
+		approvers = step.ApproverIDs
+	}
+
+	if len(approvers) > 0 {
+		for _, id := range approvers {
+			if id == callerID && (step == nil || step.Role == "" || hasRole(ctx, step.Role)) {
+				return approverCheck{}, nil
+			}
+		}
+		// Delegates decide for an approver who is away, but never on their own requests
+		if leaveReq.UserID != callerID {
+			delegation, err := delegationRepo.Find(ctx, entityTenantID(leaveReq), approvers, callerID, leaveReq.AbsenceTypeID, time.Now())
+			if err != nil {
+				return approverCheck{}, err
+			}
+			if delegation != nil {
+				return approverCheck{delegation: delegation}, nil
+			}
+		}
+		if hasRole(ctx, "hr.admin") {
+			return approverCheck{override: true}, nil
+		}
+		return approverCheck{}, hrV1.ErrorBadRequest("this leave request is assigned to another approver")
+	}
+
+	if err := checkPermission(ctx, "hr.request.approve"); err != nil {
+		return approverCheck{}, err
+	}
+	if step != nil && step.Role != "" && !hasRole(ctx, step.Role) {
+		if hasRole(ctx, "hr.admin") {
+			return approverCheck{override: true}, nil
+		}
+		return approverCheck{}, hrV1.ErrorBadRequest("you are not an approver of the %q step", step.Name)
+	}
+	return approverCheck{}, nil
+}
+
 // deductAllowance atomically checks balance and deducts days for a leave request's absence type.
-// Returns the allowance ID that was deducted (for storing on the request), or "" if no deduction.
-func deductAllowance(ctx context.Context, allowanceRepo *data.LeaveAllowanceRepo, leaveReq *ent.LeaveRequest) (string, error) {
+// Requests crossing a year boundary are split, each year's days taken from that year's allowance.
+// Returns the deductions made (for storing on the request), or nil if no deduction.
+func deductAllowance(ctx context.Context, allowanceRepo *data.LeaveAllowanceRepo, holidayRepo *data.HolidayRepo, scheduleRepo *data.WorkScheduleAssignmentRepo, leaveReq *ent.LeaveRequest) ([]schema.LeaveDeduction, error) {
 	if leaveReq.Edges.AbsenceType == nil || !leaveReq.Edges.AbsenceType.DeductsFromAllowance {
-		return "", nil
+		return nil, nil
+	}
+
+	portions, err := data.LeaveDaysByYear(ctx, holidayRepo, scheduleRepo, leaveReq)
+	if err != nil {
+		return nil, err
 	}
 
 	tid := entityTenantID(leaveReq)
+	ref := ledgerRef(ctx, leaveReq.ID, "leave approved")
 
 	if isPoolBased(leaveReq.Edges.AbsenceType) {
-		return allowanceRepo.DeductPoolWithBalanceCheck(ctx, tid, leaveReq.UserID, leaveReq.Edges.AbsenceType.AllowancePoolID, leaveReq.StartDate.Year(), leaveReq.Days)
+		return allowanceRepo.DeductPoolWithBalanceCheck(ctx, tid, leaveReq.UserID, leaveReq.Edges.AbsenceType.AllowancePoolID, portions, ref)
 	}
 
-	return allowanceRepo.DeductWithBalanceCheck(ctx, tid, leaveReq.UserID, leaveReq.AbsenceTypeID, leaveReq.StartDate.Year(), leaveReq.Days)
+	return allowanceRepo.DeductWithBalanceCheck(ctx, tid, leaveReq.UserID, leaveReq.AbsenceTypeID, portions, ref)
 }
 
-// refundAllowance returns previously deducted days to the correct allowance.
-// Uses the stored deducted_allowance_id when available for accuracy.
-// Falls back to lookup by type/pool if the ID is not stored (legacy requests).
-func refundAllowance(ctx context.Context, log *log.Helper, allowanceRepo *data.LeaveAllowanceRepo, leaveReq *ent.LeaveRequest) {
+// refundAllowance returns previously deducted days to the correct allowances.
+// Uses the stored deductions when available for accuracy, then the single
+// deducted_allowance_id of older requests. Falls back to lookup by type/pool
+// if neither is stored (legacy requests). The note is recorded on the refund ledger entries.
+func refundAllowance(ctx context.Context, log *log.Helper, allowanceRepo *data.LeaveAllowanceRepo, leaveReq *ent.LeaveRequest, note string) {
 	if leaveReq.Edges.AbsenceType == nil || !leaveReq.Edges.AbsenceType.DeductsFromAllowance {
 		return
 	}
@@ -51,9 +174,19 @@ func refundAllowance(ctx context.Context, log *log.Helper, allowanceRepo *data.L
 		return
 	}
 
-	// Preferred path: use the stored allowance ID for exact refund
+	ref := ledgerRef(ctx, leaveReq.ID, note)
+
+	// Preferred path: refund exactly what each allowance was deducted
+	if len(leaveReq.Deductions) > 0 {
+		if err := allowanceRepo.RefundDeductions(ctx, leaveReq.Deductions, ref); err != nil {
+			log.Errorf("Failed to refund allowances for leave %s: %v", leaveReq.ID, err)
+		}
+		return
+	}
+
+	// Requests approved before deductions were recorded per year store a single allowance ID
 	if leaveReq.DeductedAllowanceID != "" {
-		if err := allowanceRepo.RefundWithFloorCheck(ctx, leaveReq.DeductedAllowanceID, leaveReq.Days); err != nil {
+		if err := allowanceRepo.RefundWithFloorCheck(ctx, leaveReq.DeductedAllowanceID, leaveReq.Days, ref); err != nil {
 			log.Errorf("Failed to refund allowance %s for leave %s: %v", leaveReq.DeductedAllowanceID, leaveReq.ID, err)
 		}
 		return
@@ -88,28 +221,96 @@ func refundAllowance(ctx context.Context, log *log.Helper, allowanceRepo *data.L
 		return
 	}
 
-	if err := allowanceRepo.RefundWithFloorCheck(ctx, allowanceID, leaveReq.Days); err != nil {
+	if err := allowanceRepo.RefundWithFloorCheck(ctx, allowanceID, leaveReq.Days, ref); err != nil {
 		log.Errorf("Failed to refund allowance for leave %s: %v", leaveReq.ID, err)
 	}
 }
 
-// calculateBusinessDays calculates the number of business days (weekdays) between two dates, inclusive
-func calculateBusinessDays(start, end time.Time) float64 {
-	// Normalize to start of day
-	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
-	end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location())
+// requestedSpan returns the part of the calendar a request for the absence type covers from the
+// start to the end date. Hour-based types book a time range within one day; day-based types may
+// start in the afternoon of the first day or end at midday of the last day.
+func requestedSpan(absType *ent.AbsenceType, startDate, endDate time.Time, startDayPart, endDayPart hrV1.DayPart) (workday.Span, error) {
+	span := workday.Span{Start: startDate, End: endDate}
+	if absType.Unit == absencetype.UnitHours {
+		if !workday.SameDay(startDate, endDate) {
+			return span, hrV1.ErrorInvalidDateRange("hour-based absences must start and end on the same day")
+		}
+		if !endDate.After(startDate) {
+			return span, hrV1.ErrorInvalidDateRange("end time must be after start time")
+		}
+		span.Hourly = true
+		return span, nil
+	}
 
-	if end.Before(start) {
-		return 0
+	span.StartsAfternoon = startDayPart == hrV1.DayPart_DAY_PART_PM
+	span.EndsAtMidday = endDayPart == hrV1.DayPart_DAY_PART_AM
+	if span.StartsAfternoon && span.EndsAtMidday && workday.SameDay(startDate, endDate) {
+		return span, hrV1.ErrorInvalidDateRange("a single-day absence cannot start in the afternoon and end at midday")
 	}
+	return span, nil
+}
 
-	days := 0.0
-	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
-		wd := d.Weekday()
-		if wd != time.Saturday && wd != time.Sunday {
-			days++
-		}
+// resolveHolidayCalendar returns the holiday calendar that applies to a leave request:
+// the explicitly requested calendar, otherwise the calendar assigned to the org unit,
+// otherwise the tenant's default calendar. Returns nil if none applies.
+func resolveHolidayCalendar(ctx context.Context, calendarRepo *data.HolidayCalendarRepo, tenantID uint32, calendarID string, orgUnitName string) (*ent.HolidayCalendar, error) {
+	if calendarID == "" {
+		return calendarRepo.Resolve(ctx, tenantID, orgUnitName)
 	}
 
-	return days
+	calendar, err := calendarRepo.GetByID(ctx, calendarID)
+	if err != nil {
+		return nil, err
+	}
+	if calendar == nil {
+		return nil, hrV1.ErrorHolidayCalendarNotFound("holiday calendar not found")
+	}
+	if err := checkTenantAccess(ctx, calendar.TenantID, hrV1.ErrorHolidayCalendarNotFound("holiday calendar not found")); err != nil {
+		return nil, err
+	}
+	return calendar, nil
+}
+
+// calculateBusinessDays calculates the number of days the user works within the span, under the
+// work schedule in effect on each date, skipping the public holidays of the applicable holiday
+// calendar. Half days count as 0.5 and hours as a fraction of the scheduled working day.
+// Returns the ID of the calendar used, or "" if no calendar applies.
+func calculateBusinessDays(ctx context.Context, calendarRepo *data.HolidayCalendarRepo, holidayRepo *data.HolidayRepo, scheduleAssignmentRepo *data.WorkScheduleAssignmentRepo, tenantID uint32, calendarID string, userID uint32, orgUnitName string, span workday.Span) (float64, string, error) {
+	schedules, err := scheduleAssignmentRepo.GetSchedules(ctx, tenantID, userID, orgUnitName, span.End)
+	if err != nil {
+		return 0, "", err
+	}
+
+	calendar, err := resolveHolidayCalendar(ctx, calendarRepo, tenantID, calendarID, orgUnitName)
+	if err != nil {
+		return 0, "", err
+	}
+	if calendar == nil {
+		return workday.CountSpan(span, nil, schedules), "", nil
+	}
+
+	holidays, err := holidayRepo.GetHolidays(ctx, calendar.ID, span.Start, span.End)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return workday.CountSpan(span, holidays, schedules), calendar.ID, nil
+}
+
+// isLeaveParticipant reports whether the caller is the requester, an approver or the reviewer of
+// the leave request, or may approve requests of others.
+func isLeaveParticipant(ctx context.Context, e *ent.LeaveRequest) bool {
+	callerID := getUserID(ctx)
+	if callerID == 0 {
+		return hasPermission(ctx, "hr.request.approve")
+	}
+	if e.UserID == callerID || e.ReviewedBy == callerID || e.ApproverID == callerID {
+		return true
+	}
+	for _, step := range e.ApprovalSteps {
+		if slices.Contains(step.ApproverIDs, callerID) {
+			return true
+		}
+	}
+	return hasPermission(ctx, "hr.request.approve")
 }
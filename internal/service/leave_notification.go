@@ -15,26 +15,32 @@ import (
 
 // ensureRejectionTemplate resolves (or creates) the leave rejection notification template.
 func (s *LeaveService) ensureRejectionTemplate(ctx context.Context) (string, error) {
-	s.rejectTemplateMu.Lock()
-	defer s.rejectTemplateMu.Unlock()
+	return s.ensureTemplate(ctx, rejectionTemplateName, defaultRejectionSubject, defaultRejectionBodyTemplate,
+		"RecipientName,AbsenceTypeName,StartDate,EndDate,Days,ReviewNotes,ReviewerName")
+}
+
+// ensureTemplate resolves the notification template with the given name, creating it with the
+// given subject, body and variables if it does not exist yet.
+func (s *LeaveService) ensureTemplate(ctx context.Context, name, subject, body, variables string) (string, error) {
+	s.templateMu.Lock()
+	defer s.templateMu.Unlock()
 
-	if s.rejectTemplateDone {
-		return s.rejectTemplateID, nil
+	if id, ok := s.templateIDs[name]; ok {
+		return id, nil
 	}
 
-	s.log.Info("Resolving notification template for leave rejection...")
+	s.log.Infof("Resolving notification template %s...", name)
 
 	platformCtx := detachedPlatformContext(ctx)
 
-	tmpl, err := s.notificationClient.FindTemplateByName(platformCtx, rejectionTemplateName)
+	tmpl, err := s.notificationClient.FindTemplateByName(platformCtx, name)
 	if err != nil {
-		return "", fmt.Errorf("search rejection template: %w", err)
+		return "", fmt.Errorf("search template %s: %w", name, err)
 	}
 	if tmpl != nil {
-		s.rejectTemplateID = tmpl.GetId()
-		s.rejectTemplateDone = true
-		s.log.Infof("Found existing rejection template: %s", s.rejectTemplateID)
-		return s.rejectTemplateID, nil
+		s.storeTemplateID(name, tmpl.GetId())
+		s.log.Infof("Found existing template %s: %s", name, tmpl.GetId())
+		return tmpl.GetId(), nil
 	}
 
 	channelID, err := s.notificationClient.FindChannelByName(platformCtx, notificationChannelName)
@@ -43,48 +49,53 @@ func (s *LeaveService) ensureRejectionTemplate(ctx context.Context) (string, err
 	}
 
 	createReq := &notificationv1.CreateTemplateRequest{
-		Name:      rejectionTemplateName,
+		Name:      name,
 		ChannelId: channelID,
-		Subject:   defaultRejectionSubject,
-		Body:      defaultRejectionBodyTemplate,
-		Variables: "RecipientName,AbsenceTypeName,StartDate,EndDate,Days,ReviewNotes,ReviewerName",
+		Subject:   subject,
+		Body:      body,
+		Variables: variables,
 		IsDefault: false,
 	}
 	created, err := s.notificationClient.CreateTemplate(platformCtx, createReq)
 	if err != nil {
 		if strings.Contains(err.Error(), "already exists") {
-			s.log.Info("Rejection template already exists, retrying lookup...")
-			tmpl2, findErr := s.notificationClient.FindTemplateByName(platformCtx, rejectionTemplateName)
+			s.log.Infof("Template %s already exists, retrying lookup...", name)
+			tmpl2, findErr := s.notificationClient.FindTemplateByName(platformCtx, name)
 			if findErr != nil {
-				return "", fmt.Errorf("retry find rejection template: %w", findErr)
+				return "", fmt.Errorf("retry find template %s: %w", name, findErr)
 			}
 			if tmpl2 != nil {
-				s.rejectTemplateID = tmpl2.GetId()
-				s.rejectTemplateDone = true
-				s.log.Infof("Found rejection template on retry: %s", s.rejectTemplateID)
-				return s.rejectTemplateID, nil
+				s.storeTemplateID(name, tmpl2.GetId())
+				s.log.Infof("Found template %s on retry: %s", name, tmpl2.GetId())
+				return tmpl2.GetId(), nil
 			}
 		}
-		return "", fmt.Errorf("create rejection template: %w", err)
+		return "", fmt.Errorf("create template %s: %w", name, err)
+	}
+	s.storeTemplateID(name, created.GetId())
+	s.log.Infof("Created template %s: %s", name, created.GetId())
+	return created.GetId(), nil
+}
+
+// storeTemplateID caches a resolved template ID. The caller holds templateMu.
+func (s *LeaveService) storeTemplateID(name, id string) {
+	if s.templateIDs == nil {
+		s.templateIDs = make(map[string]string)
 	}
-	s.rejectTemplateID = created.GetId()
-	s.rejectTemplateDone = true
-	s.log.Infof("Created rejection template: %s", s.rejectTemplateID)
-	return s.rejectTemplateID, nil
+	s.templateIDs[name] = id
 }
 
-// sendRejectionEmail sends a rejection notification email to the leave requester.
-// Designed to run in a goroutine — uses detached context.
-func (s *LeaveService) sendRejectionEmail(ctx context.Context, entity *ent.LeaveRequest, reviewerName, reviewNotes string) {
+// sendRejectionEmail sends a rejection notification email to the leave requester. Delivered from
+// the outbox — uses detached context.
+func (s *LeaveService) sendRejectionEmail(ctx context.Context, entity *ent.LeaveRequest, reviewerName, reviewNotes string) error {
 	if entity.UserEmail == "" {
 		s.log.Warnf("No email for leave request %s, skipping rejection notification", entity.ID)
-		return
+		return nil
 	}
 
 	templateID, err := s.ensureRejectionTemplate(ctx)
 	if err != nil {
-		s.log.Errorf("Failed to ensure rejection template: %v", err)
-		return
+		return fmt.Errorf("ensure rejection template: %w", err)
 	}
 
 	absenceTypeName := ""
@@ -104,10 +115,10 @@ func (s *LeaveService) sendRejectionEmail(ctx context.Context, entity *ent.Leave
 
 	platformCtx := detachedPlatformContext(ctx)
 	if _, err := s.notificationClient.SendNotification(platformCtx, templateID, entity.UserEmail, variables); err != nil {
-		s.log.Errorf("Failed to send rejection email for leave %s: %v", entity.ID, err)
-		return
+		return fmt.Errorf("send rejection email for leave %s: %w", entity.ID, err)
 	}
 	s.log.Infof("Rejection email sent to %s for leave request %s", entity.UserEmail, entity.ID)
+	return nil
 }
 
 // detachedPlatformContext creates a detached gRPC outgoing context with platform tenant (0)
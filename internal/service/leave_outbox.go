@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	grpcMD "google.golang.org/grpc/metadata"
+
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/event"
+)
+
+// rejectionEmail is the payload of the outbox messages that email the requester of a rejected
+// leave request
+type rejectionEmail struct {
+	LeaveRequestID string `json:"leave_request_id"`
+	ReviewerName   string `json:"reviewer_name,omitempty"`
+	ReviewNotes    string `json:"review_notes,omitempty"`
+}
+
+// signingCancel is the payload of the outbox messages that cancel the signing submission of a
+// revoked leave request
+type signingCancel struct {
+	LeaveRequestID string `json:"leave_request_id"`
+	SubmissionID   string `json:"submission_id"`
+	Reason         string `json:"reason"`
+	// UserID is the user who revoked the request, on whose behalf the submission is cancelled
+	UserID uint32 `json:"user_id,omitempty"`
+}
+
+// commentNotification is the payload of the outbox messages that email a shared comment on a
+// leave request. The recipients are resolved when the comment is posted, from the request as it
+// was then.
+type commentNotification struct {
+	CommentID     string   `json:"comment_id"`
+	Recipients    []string `json:"recipients"`
+	RecipientName string   `json:"recipient_name,omitempty"`
+}
+
+// rejectionEmailMessage returns the outbox message that emails the requester of a rejected leave
+// request.
+func rejectionEmailMessage(e *ent.LeaveRequest, reviewerName, reviewNotes string) data.OutboxMessage {
+	var tenantID uint32
+	if e.TenantID != nil {
+		tenantID = *e.TenantID
+	}
+	return data.OutboxMessage{
+		TenantID: tenantID,
+		Kind:     data.OutboxKindRejectionEmail,
+		Payload:  rejectionEmail{LeaveRequestID: e.ID, ReviewerName: reviewerName, ReviewNotes: reviewNotes},
+	}
+}
+
+// signingCancelMessage returns the outbox message that cancels the signing submission of a leave
+// request, on behalf of the caller. Only the caller's ID is kept, as outbox messages are visible
+// to admins; the metadata of the call to the signing service is rebuilt from it and the tenant.
+func signingCancelMessage(ctx context.Context, e *ent.LeaveRequest, reason string) data.OutboxMessage {
+	var tenantID uint32
+	if e.TenantID != nil {
+		tenantID = *e.TenantID
+	}
+
+	payload := signingCancel{LeaveRequestID: e.ID, SubmissionID: e.SigningRequestID, Reason: reason, UserID: getUserID(ctx)}
+	return data.OutboxMessage{TenantID: tenantID, Kind: data.OutboxKindSigningCancel, Payload: payload}
+}
+
+// commentNotificationMessage returns the outbox message that emails a shared comment to the
+// recipients.
+func commentNotificationMessage(c *ent.LeaveComment, recipients []string, recipientName string) data.OutboxMessage {
+	var tenantID uint32
+	if c.TenantID != nil {
+		tenantID = *c.TenantID
+	}
+	return data.OutboxMessage{
+		TenantID: tenantID,
+		Kind:     data.OutboxKindCommentNotification,
+		Payload:  commentNotification{CommentID: c.ID, Recipients: recipients, RecipientName: recipientName},
+	}
+}
+
+// enqueueOutbox writes messages for changes made outside of a single transaction to the outbox.
+// Failures are only logged.
+func (s *LeaveService) enqueueOutbox(ctx context.Context, messages ...data.OutboxMessage) {
+	if err := s.outboxRepo.Create(ctx, messages...); err != nil {
+		s.log.Errorf("Failed to enqueue %d outbox messages: %v", len(messages), err)
+	}
+}
+
+// DeliverOutboxMessage performs the side effect an outbox message stands for. An error means the
+// delivery is to be retried.
+func (s *LeaveService) DeliverOutboxMessage(ctx context.Context, m *ent.OutboxMessage) error {
+	switch m.Kind {
+	case data.OutboxKindEvent:
+		var e event.Event
+		if err := json.Unmarshal(m.Payload, &e); err != nil {
+			return fmt.Errorf("decode event: %w", err)
+		}
+		return s.publisher.Publish(ctx, e)
+
+	case data.OutboxKindAllowanceChanged:
+		var changed event.AllowancesChangedMessage
+		if err := json.Unmarshal(m.Payload, &changed); err != nil {
+			return fmt.Errorf("decode allowance change: %w", err)
+		}
+		return s.publisher.PublishAllowancesChanged(ctx, m.ID, changed)
+
+	case data.OutboxKindRejectionEmail:
+		var email rejectionEmail
+		if err := json.Unmarshal(m.Payload, &email); err != nil {
+			return fmt.Errorf("decode rejection email: %w", err)
+		}
+		leaveReq, err := s.leaveRequestRepo.GetByID(ctx, email.LeaveRequestID)
+		if err != nil {
+			return err
+		}
+		if leaveReq == nil {
+			s.log.Warnf("Leave request %s no longer exists, skipping rejection notification", email.LeaveRequestID)
+			return nil
+		}
+		return s.sendRejectionEmail(ctx, leaveReq, email.ReviewerName, email.ReviewNotes)
+
+	case data.OutboxKindSigningCancel:
+		var cancel signingCancel
+		if err := json.Unmarshal(m.Payload, &cancel); err != nil {
+			return fmt.Errorf("decode signing cancellation: %w", err)
+		}
+		var tenantID uint32
+		if m.TenantID != nil {
+			tenantID = *m.TenantID
+		}
+		callCtx := grpcMD.NewOutgoingContext(ctx, grpcMD.Pairs(
+			"x-md-global-tenant-id", strconv.FormatUint(uint64(tenantID), 10),
+			"x-md-global-user-id", strconv.FormatUint(uint64(cancel.UserID), 10),
+		))
+		if err := s.signingClient.CancelSubmission(callCtx, cancel.SubmissionID, cancel.Reason); err != nil {
+			return fmt.Errorf("cancel signing submission %s for leave %s: %w", cancel.SubmissionID, cancel.LeaveRequestID, err)
+		}
+		return nil
+
+	case data.OutboxKindSigningEndedEmail:
+		var notice event.SigningEndedNotice
+		if err := json.Unmarshal(m.Payload, &notice); err != nil {
+			return fmt.Errorf("decode signing notice: %w", err)
+		}
+		return s.sendSigningEndedEmail(ctx, notice)
+
+	case data.OutboxKindCommentNotification:
+		var notification commentNotification
+		if err := json.Unmarshal(m.Payload, &notification); err != nil {
+			return fmt.Errorf("decode comment notification: %w", err)
+		}
+		return s.sendCommentNotification(ctx, notification)
+
+	default:
+		return fmt.Errorf("unknown outbox message kind %q", m.Kind)
+	}
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+// checkLeavePolicies checks a request of an absence type by a member of an org unit against the
+// tenant's leave policies and blackout periods. A request that breaks rules fails with a
+// POLICY_VIOLATION error listing every violation, unless an HR admin overrides them with a
+// justification; the override is returned to be recorded on the request. Returns nil when no rule
+// is broken.
+func (s *LeaveService) checkLeavePolicies(ctx context.Context, tenantID uint32, absenceTypeID, orgUnitName string, req policy.Request, overrideReason string) (*policy.Override, error) {
+	if overrideReason != "" && !hasRole(ctx, "hr.admin") {
+		return nil, hrV1.ErrorBadRequest("only HR admins may override leave policies")
+	}
+
+	policies, err := s.policyRepo.ListApplicable(ctx, tenantID, absenceTypeID, orgUnitName)
+	if err != nil {
+		return nil, err
+	}
+	blackouts, err := s.blackoutRepo.ListOverlapping(ctx, tenantID, absenceTypeID, orgUnitName, dateOnly(req.Start), dateOnly(req.End))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var violations []policy.Violation
+	for _, p := range policies {
+		for _, v := range p.Rules.Check(req, now) {
+			v.PolicyID = p.ID
+			v.PolicyName = p.Name
+			violations = append(violations, v)
+		}
+	}
+	for _, b := range blackouts {
+		blackout := policy.Blackout{ID: b.ID, Name: b.Name, StartDate: b.StartDate, EndDate: b.EndDate}
+		if v := blackout.CheckBlackout(req); v != nil {
+			violations = append(violations, *v)
+		}
+	}
+	if len(violations) == 0 {
+		return nil, nil
+	}
+
+	if overrideReason == "" {
+		encoded, err := json.Marshal(violations)
+		if err != nil {
+			return nil, hrV1.ErrorInternalServerError("encode policy violations failed")
+		}
+		return nil, hrV1.ErrorPolicyViolation("%s", policy.Summary(violations)).
+			WithMetadata(map[string]string{"violations": string(encoded)})
+	}
+
+	s.log.Infof("Leave policy violations overridden by user %d: %s", getUserID(ctx), policy.Summary(violations))
+	return &policy.Override{
+		Violations: violations,
+		Reason:     overrideReason,
+		ActorID:    getUserID(ctx),
+		ActorName:  getUsername(ctx),
+		At:         now,
+	}, nil
+}
@@ -0,0 +1,517 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+type LeavePolicyService struct {
+	hrV1.UnimplementedHrLeavePolicyServiceServer
+
+	log             *log.Helper
+	policyRepo      *data.LeavePolicyRepo
+	blackoutRepo    *data.BlackoutPeriodRepo
+	absenceTypeRepo *data.AbsenceTypeRepo
+}
+
+func NewLeavePolicyService(ctx *bootstrap.Context, policyRepo *data.LeavePolicyRepo, blackoutRepo *data.BlackoutPeriodRepo, absenceTypeRepo *data.AbsenceTypeRepo) *LeavePolicyService {
+	return &LeavePolicyService{
+		log:             ctx.NewLoggerHelper("hr/service/leave_policy"),
+		policyRepo:      policyRepo,
+		blackoutRepo:    blackoutRepo,
+		absenceTypeRepo: absenceTypeRepo,
+	}
+}
+
+func (s *LeavePolicyService) CreateLeavePolicy(ctx context.Context, req *hrV1.CreateLeavePolicyRequest) (*hrV1.CreateLeavePolicyResponse, error) {
+	if err := checkPermission(ctx, "hr.policy.manage"); err != nil {
+		return nil, err
+	}
+
+	rules, err := policyRulesFromProto(req.GetRules())
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []func(*ent.LeavePolicyCreate){}
+	if req.Description != nil {
+		opts = append(opts, func(c *ent.LeavePolicyCreate) { c.SetDescription(*req.Description) })
+	}
+	if req.GetAbsenceTypeId() != "" {
+		if err := s.checkAbsenceTypes(ctx, []string{req.GetAbsenceTypeId()}); err != nil {
+			return nil, err
+		}
+		opts = append(opts, func(c *ent.LeavePolicyCreate) { c.SetAbsenceTypeID(req.GetAbsenceTypeId()) })
+	}
+	if req.OrgUnitName != nil {
+		opts = append(opts, func(c *ent.LeavePolicyCreate) { c.SetOrgUnitName(*req.OrgUnitName) })
+	}
+	if req.Enabled != nil {
+		opts = append(opts, func(c *ent.LeavePolicyCreate) { c.SetEnabled(*req.Enabled) })
+	}
+
+	entity, err := s.policyRepo.Create(ctx, getTenantID(ctx), req.GetName(), rules, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.CreateLeavePolicyResponse{
+		Policy: leavePolicyToProto(entity),
+	}, nil
+}
+
+func (s *LeavePolicyService) GetLeavePolicy(ctx context.Context, req *hrV1.GetLeavePolicyRequest) (*hrV1.GetLeavePolicyResponse, error) {
+	if err := checkPermission(ctx, "hr.calendar.view"); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.policyRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, hrV1.ErrorLeavePolicyNotFound("leave policy not found")
+	}
+	if err := checkTenantAccess(ctx, entity.TenantID, hrV1.ErrorLeavePolicyNotFound("leave policy not found")); err != nil {
+		return nil, err
+	}
+
+	return &hrV1.GetLeavePolicyResponse{
+		Policy: leavePolicyToProto(entity),
+	}, nil
+}
+
+func (s *LeavePolicyService) ListLeavePolicies(ctx context.Context, req *hrV1.ListLeavePoliciesRequest) (*hrV1.ListLeavePoliciesResponse, error) {
+	if err := checkPermission(ctx, "hr.calendar.view"); err != nil {
+		return nil, err
+	}
+
+	filters := make(map[string]interface{})
+	if req.Query != nil {
+		filters["query"] = *req.Query
+	}
+	if req.AbsenceTypeId != nil {
+		filters["absence_type_id"] = *req.AbsenceTypeId
+	}
+	if req.OrgUnitName != nil {
+		filters["org_unit_name"] = *req.OrgUnitName
+	}
+
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	if req.GetNoPaging() {
+		page = 0
+		pageSize = 0
+	}
+
+	entities, total, err := s.policyRepo.List(ctx, getTenantID(ctx), page, pageSize, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.LeavePolicy, len(entities))
+	for i, e := range entities {
+		items[i] = leavePolicyToProto(e)
+	}
+
+	return &hrV1.ListLeavePoliciesResponse{
+		Items: items,
+		Total: ptrInt32(int32(total)),
+	}, nil
+}
+
+func (s *LeavePolicyService) UpdateLeavePolicy(ctx context.Context, req *hrV1.UpdateLeavePolicyRequest) (*hrV1.UpdateLeavePolicyResponse, error) {
+	if err := checkPermission(ctx, "hr.policy.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.policyRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorLeavePolicyNotFound("leave policy not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorLeavePolicyNotFound("leave policy not found")); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+
+	if req.Data != nil {
+		if req.Data.Name != nil {
+			updates["name"] = *req.Data.Name
+		}
+		if req.Data.Description != nil {
+			updates["description"] = *req.Data.Description
+		}
+		if req.Data.AbsenceTypeId != nil {
+			if *req.Data.AbsenceTypeId != "" {
+				if err := s.checkAbsenceTypes(ctx, []string{*req.Data.AbsenceTypeId}); err != nil {
+					return nil, err
+				}
+			}
+			updates["absence_type_id"] = *req.Data.AbsenceTypeId
+		}
+		if req.Data.OrgUnitName != nil {
+			updates["org_unit_name"] = *req.Data.OrgUnitName
+		}
+		if req.Data.Rules != nil {
+			rules, err := policyRulesFromProto(req.Data.Rules)
+			if err != nil {
+				return nil, err
+			}
+			updates["rules"] = rules
+		}
+		if req.Data.Enabled != nil {
+			updates["enabled"] = *req.Data.Enabled
+		}
+	}
+
+	entity, err := s.policyRepo.Update(ctx, req.GetId(), updates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.UpdateLeavePolicyResponse{
+		Policy: leavePolicyToProto(entity),
+	}, nil
+}
+
+func (s *LeavePolicyService) DeleteLeavePolicy(ctx context.Context, req *hrV1.DeleteLeavePolicyRequest) (*emptypb.Empty, error) {
+	if err := checkPermission(ctx, "hr.policy.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.policyRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorLeavePolicyNotFound("leave policy not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorLeavePolicyNotFound("leave policy not found")); err != nil {
+		return nil, err
+	}
+
+	if err := s.policyRepo.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *LeavePolicyService) CreateBlackoutPeriod(ctx context.Context, req *hrV1.CreateBlackoutPeriodRequest) (*hrV1.CreateBlackoutPeriodResponse, error) {
+	if err := checkPermission(ctx, "hr.policy.manage"); err != nil {
+		return nil, err
+	}
+
+	if req.GetStartDate() == nil || req.GetEndDate() == nil {
+		return nil, hrV1.ErrorValidationFailed("start_date and end_date are required")
+	}
+	startDate := dateOnly(req.GetStartDate().AsTime())
+	endDate := dateOnly(req.GetEndDate().AsTime())
+	if endDate.Before(startDate) {
+		return nil, hrV1.ErrorInvalidDateRange("end date must not be before start date")
+	}
+	if err := s.checkAbsenceTypes(ctx, req.GetAbsenceTypeIds()); err != nil {
+		return nil, err
+	}
+
+	opts := []func(*ent.BlackoutPeriodCreate){}
+	if len(req.GetAbsenceTypeIds()) > 0 {
+		opts = append(opts, func(c *ent.BlackoutPeriodCreate) { c.SetAbsenceTypeIds(req.GetAbsenceTypeIds()) })
+	}
+	if len(req.GetOrgUnitNames()) > 0 {
+		opts = append(opts, func(c *ent.BlackoutPeriodCreate) { c.SetOrgUnitNames(req.GetOrgUnitNames()) })
+	}
+	if req.Notes != nil {
+		opts = append(opts, func(c *ent.BlackoutPeriodCreate) { c.SetNotes(*req.Notes) })
+	}
+
+	entity, err := s.blackoutRepo.Create(ctx, getTenantID(ctx), req.GetName(), startDate, endDate, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.CreateBlackoutPeriodResponse{
+		Period: blackoutPeriodToProto(entity),
+	}, nil
+}
+
+func (s *LeavePolicyService) GetBlackoutPeriod(ctx context.Context, req *hrV1.GetBlackoutPeriodRequest) (*hrV1.GetBlackoutPeriodResponse, error) {
+	if err := checkPermission(ctx, "hr.calendar.view"); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.blackoutRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, hrV1.ErrorBlackoutPeriodNotFound("blackout period not found")
+	}
+	if err := checkTenantAccess(ctx, entity.TenantID, hrV1.ErrorBlackoutPeriodNotFound("blackout period not found")); err != nil {
+		return nil, err
+	}
+
+	return &hrV1.GetBlackoutPeriodResponse{
+		Period: blackoutPeriodToProto(entity),
+	}, nil
+}
+
+func (s *LeavePolicyService) ListBlackoutPeriods(ctx context.Context, req *hrV1.ListBlackoutPeriodsRequest) (*hrV1.ListBlackoutPeriodsResponse, error) {
+	if err := checkPermission(ctx, "hr.calendar.view"); err != nil {
+		return nil, err
+	}
+
+	filters := make(map[string]interface{})
+	if req.FromDate != nil {
+		filters["from_date"] = dateOnly(req.FromDate.AsTime())
+	}
+	if req.ToDate != nil {
+		filters["to_date"] = dateOnly(req.ToDate.AsTime())
+	}
+
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	if req.GetNoPaging() {
+		page = 0
+		pageSize = 0
+	}
+
+	entities, total, err := s.blackoutRepo.List(ctx, getTenantID(ctx), page, pageSize, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.BlackoutPeriod, len(entities))
+	for i, e := range entities {
+		items[i] = blackoutPeriodToProto(e)
+	}
+
+	return &hrV1.ListBlackoutPeriodsResponse{
+		Items: items,
+		Total: ptrInt32(int32(total)),
+	}, nil
+}
+
+func (s *LeavePolicyService) UpdateBlackoutPeriod(ctx context.Context, req *hrV1.UpdateBlackoutPeriodRequest) (*hrV1.UpdateBlackoutPeriodResponse, error) {
+	if err := checkPermission(ctx, "hr.policy.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.blackoutRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorBlackoutPeriodNotFound("blackout period not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorBlackoutPeriodNotFound("blackout period not found")); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+
+	if req.Data != nil {
+		startDate, endDate := existing.StartDate, existing.EndDate
+		if req.Data.Name != nil {
+			updates["name"] = *req.Data.Name
+		}
+		if req.Data.StartDate != nil {
+			startDate = dateOnly(req.Data.StartDate.AsTime())
+			updates["start_date"] = startDate
+		}
+		if req.Data.EndDate != nil {
+			endDate = dateOnly(req.Data.EndDate.AsTime())
+			updates["end_date"] = endDate
+		}
+		if endDate.Before(startDate) {
+			return nil, hrV1.ErrorInvalidDateRange("end date must not be before start date")
+		}
+		if req.Data.AbsenceTypeIds != nil {
+			if err := s.checkAbsenceTypes(ctx, req.Data.AbsenceTypeIds); err != nil {
+				return nil, err
+			}
+			updates["absence_type_ids"] = req.Data.AbsenceTypeIds
+		}
+		if req.Data.OrgUnitNames != nil {
+			updates["org_unit_names"] = req.Data.OrgUnitNames
+		}
+		if req.Data.Notes != nil {
+			updates["notes"] = *req.Data.Notes
+		}
+	}
+
+	entity, err := s.blackoutRepo.Update(ctx, req.GetId(), updates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.UpdateBlackoutPeriodResponse{
+		Period: blackoutPeriodToProto(entity),
+	}, nil
+}
+
+func (s *LeavePolicyService) DeleteBlackoutPeriod(ctx context.Context, req *hrV1.DeleteBlackoutPeriodRequest) (*emptypb.Empty, error) {
+	if err := checkPermission(ctx, "hr.policy.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.blackoutRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorBlackoutPeriodNotFound("blackout period not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorBlackoutPeriodNotFound("blackout period not found")); err != nil {
+		return nil, err
+	}
+
+	if err := s.blackoutRepo.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkAbsenceTypes verifies that the absence types a policy or blackout period is scoped to
+// exist in the caller's tenant.
+func (s *LeavePolicyService) checkAbsenceTypes(ctx context.Context, ids []string) error {
+	for _, id := range ids {
+		absType, err := s.absenceTypeRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if absType == nil {
+			return hrV1.ErrorAbsenceTypeNotFound("absence type %s not found", id)
+		}
+		if err := checkTenantAccess(ctx, absType.TenantID, hrV1.ErrorAbsenceTypeNotFound("absence type %s not found", id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func policyRulesFromProto(p *hrV1.LeavePolicyRules) (*policy.Rules, error) {
+	rules := &policy.Rules{
+		MinNoticeDays:      int(p.GetMinNoticeDays()),
+		MaxConsecutiveDays: p.GetMaxConsecutiveDays(),
+		MinDays:            p.GetMinDays(),
+	}
+	for _, d := range p.GetStartWeekdays() {
+		rules.StartWeekdays = append(rules.StartWeekdays, int(d))
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, hrV1.ErrorBadRequest("%s", err.Error())
+	}
+	return rules, nil
+}
+
+func policyRulesToProto(r *policy.Rules) *hrV1.LeavePolicyRules {
+	if r == nil {
+		return nil
+	}
+
+	result := &hrV1.LeavePolicyRules{
+		MinNoticeDays:      int32(r.MinNoticeDays),
+		MaxConsecutiveDays: r.MaxConsecutiveDays,
+		MinDays:            r.MinDays,
+	}
+	for _, d := range r.StartWeekdays {
+		result.StartWeekdays = append(result.StartWeekdays, int32(d))
+	}
+	return result
+}
+
+func policyViolationsToProto(violations []policy.Violation) []*hrV1.PolicyViolation {
+	result := make([]*hrV1.PolicyViolation, len(violations))
+	for i, v := range violations {
+		result[i] = &hrV1.PolicyViolation{
+			Rule:       v.Rule,
+			PolicyId:   v.PolicyID,
+			PolicyName: v.PolicyName,
+			Message:    v.Message,
+		}
+	}
+	return result
+}
+
+func policyOverrideToProto(o *policy.Override) *hrV1.PolicyOverride {
+	if o == nil {
+		return nil
+	}
+
+	return &hrV1.PolicyOverride{
+		Violations: policyViolationsToProto(o.Violations),
+		Reason:     o.Reason,
+		ActorId:    o.ActorID,
+		ActorName:  o.ActorName,
+		At:         timestamppb.New(o.At),
+	}
+}
+
+func leavePolicyToProto(e *ent.LeavePolicy) *hrV1.LeavePolicy {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.LeavePolicy{
+		Id:            &e.ID,
+		TenantId:      e.TenantID,
+		Name:          ptrString(e.Name),
+		Description:   ptrString(e.Description),
+		AbsenceTypeId: ptrString(e.AbsenceTypeID),
+		OrgUnitName:   ptrString(e.OrgUnitName),
+		Rules:         policyRulesToProto(e.Rules),
+		Enabled:       ptrBool(e.Enabled),
+		CreatedBy:     e.CreateBy,
+		UpdatedBy:     e.UpdateBy,
+	}
+
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	if e.UpdateTime != nil {
+		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
+	}
+
+	return result
+}
+
+func blackoutPeriodToProto(e *ent.BlackoutPeriod) *hrV1.BlackoutPeriod {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.BlackoutPeriod{
+		Id:             &e.ID,
+		TenantId:       e.TenantID,
+		Name:           ptrString(e.Name),
+		StartDate:      timestamppb.New(e.StartDate),
+		EndDate:        timestamppb.New(e.EndDate),
+		AbsenceTypeIds: e.AbsenceTypeIds,
+		OrgUnitNames:   e.OrgUnitNames,
+		Notes:          ptrString(e.Notes),
+		CreatedBy:      e.CreateBy,
+		UpdatedBy:      e.UpdateBy,
+	}
+
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	if e.UpdateTime != nil {
+		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
+	}
+
+	return result
+}
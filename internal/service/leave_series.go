@@ -0,0 +1,477 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/recurrence"
+	"github.com/go-tangra/go-tangra-hr/internal/workday"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+// leaveSeries is a series of recurring requests being booked.
+type leaveSeries struct {
+	id   string
+	rule *recurrence.Rule
+	// booked holds the days per year of the pending occurrences booked so far, which are not
+	// deducted from the allowance yet
+	booked []workday.YearDays
+}
+
+// withBooked returns the portions of an occurrence together with the days already booked for
+// the series, to check the balance against.
+func (ls *leaveSeries) withBooked(portions []workday.YearDays) []workday.YearDays {
+	if ls == nil || len(ls.booked) == 0 {
+		return portions
+	}
+	combined := make([]workday.YearDays, len(portions))
+	for i, p := range portions {
+		combined[i] = p
+		for _, b := range ls.booked {
+			if b.Year == p.Year {
+				combined[i].Days += b.Days
+			}
+		}
+	}
+	return combined
+}
+
+// book adds the portions of a pending occurrence to the days booked for the series.
+func (ls *leaveSeries) book(portions []workday.YearDays) {
+	if ls == nil {
+		return
+	}
+	for _, p := range portions {
+		found := false
+		for i := range ls.booked {
+			if ls.booked[i].Year == p.Year {
+				ls.booked[i].Days += p.Days
+				found = true
+			}
+		}
+		if !found {
+			ls.booked = append(ls.booked, p)
+		}
+	}
+}
+
+// occurrenceOutcome is the outcome of booking one occurrence of a series.
+type occurrenceOutcome struct {
+	start time.Time
+	resp  *hrV1.CreateLeaveRequestResponse
+	err   error
+}
+
+// createLeaveSeries books each occurrence of a recurring request as a request of its own. The
+// occurrences failing their checks are reported and skipped; the series fails only when none
+// can be booked.
+func (s *LeaveService) createLeaveSeries(ctx context.Context, req *hrV1.CreateLeaveRequestRequest) (*hrV1.CreateLeaveRequestResponse, error) {
+	outcomes, err := s.bookLeaveSeries(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &hrV1.CreateLeaveRequestResponse{
+		Occurrences: occurrenceResults(outcomes),
+	}
+	for _, o := range outcomes {
+		if o.err == nil {
+			resp.LeaveRequest = o.resp.GetLeaveRequest()
+			resp.CoverageWarnings = o.resp.GetCoverageWarnings()
+			return resp, nil
+		}
+	}
+	return nil, outcomes[0].err
+}
+
+// bookLeaveSeries expands the recurrence rule of the request and books its occurrences, each
+// as long as the first and with its own overlap, policy and balance checks. Occurrences starting
+// on the date of a kept request, keyed by its start date, are not booked: the kept request is
+// moved to the new series instead.
+func (s *LeaveService) bookLeaveSeries(ctx context.Context, req *hrV1.CreateLeaveRequestRequest, kept map[string]*ent.LeaveRequest) ([]occurrenceOutcome, error) {
+	if req.GetStartDate() == nil || req.GetEndDate() == nil {
+		return nil, hrV1.ErrorValidationFailed("start_date and end_date are required")
+	}
+	startDate := req.GetStartDate().AsTime()
+	endDate := req.GetEndDate().AsTime()
+	if endDate.Before(startDate) {
+		return nil, hrV1.ErrorInvalidDateRange("end date must be after start date")
+	}
+
+	rule := recurrenceRuleFromProto(req.GetRecurrence())
+	starts, err := rule.Expand(startDate)
+	if err != nil {
+		return nil, hrV1.ErrorValidationFailed("%s", err.Error())
+	}
+	if len(starts) == 0 {
+		return nil, hrV1.ErrorValidationFailed("the recurrence has no occurrences")
+	}
+
+	series := &leaveSeries{id: uuid.New().String(), rule: rule}
+	outcomes := make([]occurrenceOutcome, 0, len(starts))
+	for _, start := range starts {
+		if k, ok := kept[dateOnly(start).Format(workday.DateLayout)]; ok {
+			moved, err := s.leaveRequestRepo.Update(ctx, k.ID, map[string]interface{}{"series_id": series.id, "recurrence": rule})
+			var resp *hrV1.CreateLeaveRequestResponse
+			if err == nil {
+				resp = &hrV1.CreateLeaveRequestResponse{LeaveRequest: leaveRequestToProto(moved)}
+			}
+			outcomes = append(outcomes, occurrenceOutcome{start: start, resp: resp, err: err})
+			continue
+		}
+
+		offset := int(dateOnly(start).Sub(dateOnly(startDate)).Hours() / 24)
+		occurrence := proto.Clone(req).(*hrV1.CreateLeaveRequestRequest)
+		occurrence.Recurrence = nil
+		occurrence.StartDate = timestamppb.New(start)
+		occurrence.EndDate = timestamppb.New(endDate.AddDate(0, 0, offset))
+
+		resp, err := s.createLeaveRequest(ctx, occurrence, series)
+		outcomes = append(outcomes, occurrenceOutcome{start: start, resp: resp, err: err})
+	}
+	return outcomes, nil
+}
+
+// CancelFollowingOccurrences cancels an occurrence of a series and the occurrences after it, each
+// as CancelLeaveRequest does. Occurrences no longer pending or approved are left alone.
+func (s *LeaveService) CancelFollowingOccurrences(ctx context.Context, req *hrV1.CancelFollowingOccurrencesRequest) (*hrV1.BulkLeaveRequestsResponse, error) {
+	if err := checkPermission(ctx, "hr.request.manage"); err != nil {
+		return nil, err
+	}
+
+	_, occurrences, err := s.followingOccurrences(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return bulkLeaveRequests(openOccurrenceIDs(occurrences), func(id string) (*hrV1.BulkLeaveRequestResult, error) {
+		resp, err := s.CancelLeaveRequest(ctx, &hrV1.CancelLeaveRequestRequest{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		return &hrV1.BulkLeaveRequestResult{LeaveRequest: resp.GetLeaveRequest()}, nil
+	}), nil
+}
+
+// UpdateFollowingOccurrences edits an occurrence of a series and the occurrences after it. The
+// reason, notes and metadata are updated on each. A new recurrence rule instead splits the
+// series: the occurrences from this one on are cancelled and rebooked as a new series. Approved
+// occurrences, and those awaiting signatures, on dates the new rule keeps are moved to the new
+// series as they are; only approvers may change a rule so that it drops such occurrences. Nothing
+// is rebooked unless every cancellation succeeded.
+func (s *LeaveService) UpdateFollowingOccurrences(ctx context.Context, req *hrV1.UpdateFollowingOccurrencesRequest) (*hrV1.UpdateFollowingOccurrencesResponse, error) {
+	if err := checkPermission(ctx, "hr.request.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, occurrences, err := s.followingOccurrences(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	ids := openOccurrenceIDs(occurrences)
+
+	if req.Recurrence == nil {
+		updated := bulkLeaveRequests(ids, func(id string) (*hrV1.BulkLeaveRequestResult, error) {
+			resp, err := s.UpdateLeaveRequest(ctx, &hrV1.UpdateLeaveRequestRequest{
+				Id: id,
+				Data: &hrV1.LeaveRequest{
+					Reason:   req.Reason,
+					Notes:    req.Notes,
+					Metadata: req.Metadata,
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			return &hrV1.BulkLeaveRequestResult{LeaveRequest: resp.GetLeaveRequest()}, nil
+		})
+		return &hrV1.UpdateFollowingOccurrencesResponse{Results: updated.GetResults()}, nil
+	}
+
+	// Check the new rule before cancelling anything
+	starts, err := recurrenceRuleFromProto(req.Recurrence).Expand(existing.StartDate)
+	if err != nil {
+		return nil, hrV1.ErrorValidationFailed("%s", err.Error())
+	}
+
+	kept, dropped := decidedOccurrences(existing, occurrences, starts)
+	if len(dropped) > 0 && !hasPermission(ctx, "hr.request.approve") {
+		return nil, hrV1.ErrorBadRequest("the new recurrence drops %d approved occurrences; only approvers may change them", len(dropped))
+	}
+
+	var cancelIDs []string
+	for _, id := range ids {
+		if !slices.ContainsFunc(kept, func(e *ent.LeaveRequest) bool { return e.ID == id }) {
+			cancelIDs = append(cancelIDs, id)
+		}
+	}
+	cancelled := bulkLeaveRequests(cancelIDs, func(id string) (*hrV1.BulkLeaveRequestResult, error) {
+		resp, err := s.CancelLeaveRequest(ctx, &hrV1.CancelLeaveRequestRequest{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		return &hrV1.BulkLeaveRequestResult{LeaveRequest: resp.GetLeaveRequest()}, nil
+	})
+	if cancelled.GetFailed() > 0 {
+		// Rebooking now would overlap the occurrences that could not be cancelled
+		s.log.Warnf("Not rebooking series %s from %s: %d of its occurrences could not be cancelled", existing.SeriesID, existing.ID, cancelled.GetFailed())
+		return &hrV1.UpdateFollowingOccurrencesResponse{Results: cancelled.GetResults()}, nil
+	}
+
+	keptByDate := make(map[string]*ent.LeaveRequest, len(kept))
+	for _, e := range kept {
+		keptByDate[dateOnly(e.StartDate).Format(workday.DateLayout)] = e
+	}
+	outcomes, err := s.bookLeaveSeries(ctx, splitSeriesRequest(existing, req), keptByDate)
+	if err != nil {
+		return nil, err
+	}
+	return &hrV1.UpdateFollowingOccurrencesResponse{
+		Results:     cancelled.GetResults(),
+		Occurrences: occurrenceResults(outcomes),
+	}, nil
+}
+
+// ApproveLeaveSeries approves the pending occurrences of a series, each as ApproveLeaveRequest
+// does.
+func (s *LeaveService) ApproveLeaveSeries(ctx context.Context, req *hrV1.ApproveLeaveSeriesRequest) (*hrV1.BulkLeaveRequestsResponse, error) {
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
+		return nil, err
+	}
+
+	ids, err := s.pendingOccurrenceIDs(ctx, req.GetSeriesId())
+	if err != nil {
+		return nil, err
+	}
+
+	return bulkLeaveRequests(ids, func(id string) (*hrV1.BulkLeaveRequestResult, error) {
+		resp, err := s.ApproveLeaveRequest(ctx, &hrV1.ApproveLeaveRequestRequest{Id: id, ReviewNotes: req.ReviewNotes})
+		if err != nil {
+			return nil, err
+		}
+		return &hrV1.BulkLeaveRequestResult{
+			LeaveRequest:     resp.GetLeaveRequest(),
+			CoverageWarnings: resp.GetCoverageWarnings(),
+		}, nil
+	}), nil
+}
+
+// RejectLeaveSeries rejects the pending occurrences of a series, each as RejectLeaveRequest does.
+func (s *LeaveService) RejectLeaveSeries(ctx context.Context, req *hrV1.RejectLeaveSeriesRequest) (*hrV1.BulkLeaveRequestsResponse, error) {
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
+		return nil, err
+	}
+
+	ids, err := s.pendingOccurrenceIDs(ctx, req.GetSeriesId())
+	if err != nil {
+		return nil, err
+	}
+
+	return bulkLeaveRequests(ids, func(id string) (*hrV1.BulkLeaveRequestResult, error) {
+		resp, err := s.RejectLeaveRequest(ctx, &hrV1.RejectLeaveRequestRequest{Id: id, ReviewNotes: req.ReviewNotes})
+		if err != nil {
+			return nil, err
+		}
+		return &hrV1.BulkLeaveRequestResult{LeaveRequest: resp.GetLeaveRequest()}, nil
+	}), nil
+}
+
+// followingOccurrences returns the occurrence of a series of the caller's tenant with the
+// occurrences from it on.
+func (s *LeaveService) followingOccurrences(ctx context.Context, id string) (*ent.LeaveRequest, []*ent.LeaveRequest, error) {
+	existing, err := s.leaveRequestRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if existing == nil {
+		return nil, nil, hrV1.ErrorLeaveRequestNotFound("leave request not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorLeaveRequestNotFound("leave request not found")); err != nil {
+		return nil, nil, err
+	}
+	if existing.SeriesID == "" {
+		return nil, nil, hrV1.ErrorBadRequest("the leave request is not part of a series")
+	}
+
+	// Non-admin users can only change their own requests
+	if !hasPermission(ctx, "hr.request.approve") && existing.UserID != getUserID(ctx) {
+		return nil, nil, hrV1.ErrorBadRequest("you can only change your own leave requests")
+	}
+
+	occurrences, err := s.leaveRequestRepo.ListBySeries(ctx, existing.SeriesID, existing.StartDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	return existing, occurrences, nil
+}
+
+// pendingOccurrenceIDs returns the IDs of the pending occurrences of a series of the caller's
+// tenant.
+func (s *LeaveService) pendingOccurrenceIDs(ctx context.Context, seriesID string) ([]string, error) {
+	occurrences, err := s.leaveRequestRepo.ListBySeries(ctx, seriesID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	if len(occurrences) == 0 {
+		return nil, hrV1.ErrorLeaveRequestNotFound("leave series not found")
+	}
+	if err := checkTenantAccess(ctx, occurrences[0].TenantID, hrV1.ErrorLeaveRequestNotFound("leave series not found")); err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, e := range occurrences {
+		if e.Status == leaverequest.StatusPending {
+			ids = append(ids, e.ID)
+		}
+	}
+	return ids, nil
+}
+
+// decidedOccurrences sorts the approved occurrences of a series, and those awaiting signatures,
+// into those the new series starting on the starts keeps, as they fall on one of its dates with
+// the length of existing, and those it drops.
+func decidedOccurrences(existing *ent.LeaveRequest, occurrences []*ent.LeaveRequest, starts []time.Time) (kept, dropped []*ent.LeaveRequest) {
+	length := dateOnly(existing.EndDate).Sub(dateOnly(existing.StartDate))
+	for _, e := range occurrences {
+		if e.Status != leaverequest.StatusApproved && e.Status != leaverequest.StatusAwaitingSigning {
+			continue
+		}
+		unchanged := dateOnly(e.EndDate).Sub(dateOnly(e.StartDate)) == length &&
+			e.StartDayPart == existing.StartDayPart && e.EndDayPart == existing.EndDayPart &&
+			slices.ContainsFunc(starts, func(start time.Time) bool { return dateOnly(start).Equal(dateOnly(e.StartDate)) })
+		if unchanged {
+			kept = append(kept, e)
+		} else {
+			dropped = append(dropped, e)
+		}
+	}
+	return kept, dropped
+}
+
+// openOccurrenceIDs returns the IDs of the occurrences that are still pending or approved.
+func openOccurrenceIDs(occurrences []*ent.LeaveRequest) []string {
+	var ids []string
+	for _, e := range occurrences {
+		switch e.Status {
+		case leaverequest.StatusPending, leaverequest.StatusAwaitingSigning, leaverequest.StatusApproved:
+			ids = append(ids, e.ID)
+		}
+	}
+	return ids
+}
+
+// splitSeriesRequest returns the request rebooking a series from the occurrence existing on, with
+// the changes of req.
+func splitSeriesRequest(existing *ent.LeaveRequest, req *hrV1.UpdateFollowingOccurrencesRequest) *hrV1.CreateLeaveRequestRequest {
+	create := &hrV1.CreateLeaveRequestRequest{
+		UserId:               &existing.UserID,
+		AbsenceTypeId:        ptrString(existing.AbsenceTypeID),
+		StartDate:            timestamppb.New(existing.StartDate),
+		EndDate:              timestamppb.New(existing.EndDate),
+		Reason:               ptrString(existing.Reason),
+		Notes:                ptrString(existing.Notes),
+		Metadata:             mapToStruct(existing.Metadata),
+		UserName:             ptrString(existing.UserName),
+		UserEmail:            ptrString(existing.UserEmail),
+		OrgUnitName:          ptrString(existing.OrgUnitName),
+		HolidayCalendarId:    ptrString(existing.HolidayCalendarID),
+		StartDayPart:         dayPartToProtoPtr(existing.StartDayPart.String()),
+		EndDayPart:           dayPartToProtoPtr(existing.EndDayPart.String()),
+		PolicyOverrideReason: req.PolicyOverrideReason,
+		Recurrence:           req.Recurrence,
+	}
+	if req.Reason != nil {
+		create.Reason = req.Reason
+	}
+	if req.Notes != nil {
+		create.Notes = req.Notes
+	}
+	if req.Metadata != nil {
+		create.Metadata = req.Metadata
+	}
+	return create
+}
+
+// occurrenceResults reports the outcome of booking each occurrence of a series.
+func occurrenceResults(outcomes []occurrenceOutcome) []*hrV1.LeaveOccurrenceResult {
+	results := make([]*hrV1.LeaveOccurrenceResult, 0, len(outcomes))
+	for _, o := range outcomes {
+		result := &hrV1.LeaveOccurrenceResult{StartDate: timestamppb.New(o.start)}
+		if o.err != nil {
+			se := errors.FromError(o.err)
+			result.ErrorReason = se.Reason
+			result.ErrorMessage = se.Message
+		} else {
+			result.Success = true
+			result.LeaveRequest = o.resp.GetLeaveRequest()
+			result.CoverageWarnings = o.resp.GetCoverageWarnings()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func recurrenceRuleFromProto(r *hrV1.RecurrenceRule) *recurrence.Rule {
+	if r == nil {
+		return nil
+	}
+
+	rule := &recurrence.Rule{
+		Interval: int(r.GetInterval()),
+		Count:    int(r.GetCount()),
+	}
+	switch r.GetFrequency() {
+	case hrV1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY:
+		rule.Frequency = recurrence.FrequencyDaily
+	case hrV1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY:
+		rule.Frequency = recurrence.FrequencyWeekly
+	case hrV1.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY:
+		rule.Frequency = recurrence.FrequencyMonthly
+	}
+	for _, d := range r.GetWeekdays() {
+		rule.Weekdays = append(rule.Weekdays, int(d))
+	}
+	if r.Until != nil {
+		until := r.Until.AsTime()
+		rule.Until = &until
+	}
+	return rule
+}
+
+func recurrenceRuleToProto(r *recurrence.Rule) *hrV1.RecurrenceRule {
+	if r == nil {
+		return nil
+	}
+
+	result := &hrV1.RecurrenceRule{}
+	switch r.Frequency {
+	case recurrence.FrequencyDaily:
+		result.Frequency = hrV1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY
+	case recurrence.FrequencyWeekly:
+		result.Frequency = hrV1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY
+	case recurrence.FrequencyMonthly:
+		result.Frequency = hrV1.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY
+	}
+	if r.Interval > 0 {
+		result.Interval = ptrInt32(int32(r.Interval))
+	}
+	for _, d := range r.Weekdays {
+		result.Weekdays = append(result.Weekdays, int32(d))
+	}
+	if r.Until != nil {
+		result.Until = timestamppb.New(*r.Until)
+	}
+	if r.Count > 0 {
+		result.Count = ptrInt32(int32(r.Count))
+	}
+	return result
+}
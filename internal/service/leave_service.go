@@ -8,14 +8,23 @@ import (
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
-	grpcMD "google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	adminstubpb "github.com/go-tangra/go-tangra-common/gen/go/common/admin_stub/v1"
+	"github.com/go-tangra/go-tangra-hr/internal/approval"
 	"github.com/go-tangra/go-tangra-hr/internal/client"
+	"github.com/go-tangra/go-tangra-hr/internal/conf"
 	"github.com/go-tangra/go-tangra-hr/internal/data"
 	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/leaverequest"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/schema"
+	"github.com/go-tangra/go-tangra-hr/internal/event"
+	"github.com/go-tangra/go-tangra-hr/internal/policy"
+	"github.com/go-tangra/go-tangra-hr/internal/storage"
+	"github.com/go-tangra/go-tangra-hr/internal/workday"
 	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
 )
 
@@ -52,26 +61,61 @@ type LeaveService struct {
 
 	log                *log.Helper
 	leaveRequestRepo   *data.LeaveRequestRepo
+	amendmentRepo      *data.LeaveAmendmentRepo
 	allowanceRepo      *data.LeaveAllowanceRepo
 	absenceTypeRepo    *data.AbsenceTypeRepo
+	calendarRepo       *data.HolidayCalendarRepo
+	holidayRepo        *data.HolidayRepo
+	scheduleRepo       *data.WorkScheduleAssignmentRepo
+	delegationRepo     *data.ApprovalDelegationRepo
+	policyRepo         *data.LeavePolicyRepo
+	blackoutRepo       *data.BlackoutPeriodRepo
+	coverageRepo       *data.CoverageRuleRepo
+	attachmentRepo     *data.LeaveAttachmentRepo
+	attachmentStore    storage.Backend
+	commentRepo        *data.LeaveCommentRepo
+	publisher          *event.Publisher
+	outboxRepo         *data.OutboxRepo
 	signingClient      *client.SigningClient
 	adminClient        *client.AdminClient
 	notificationClient *client.NotificationClient
+	managerPositions   []string
 
-	rejectTemplateMu   sync.Mutex
-	rejectTemplateID   string
-	rejectTemplateDone bool
+	// Notification template IDs by template name, resolved on first use
+	templateMu  sync.Mutex
+	templateIDs map[string]string
 }
 
-func NewLeaveService(ctx *bootstrap.Context, leaveRequestRepo *data.LeaveRequestRepo, allowanceRepo *data.LeaveAllowanceRepo, absenceTypeRepo *data.AbsenceTypeRepo, signingClient *client.SigningClient, adminClient *client.AdminClient, notificationClient *client.NotificationClient) *LeaveService {
+func NewLeaveService(ctx *bootstrap.Context, leaveRequestRepo *data.LeaveRequestRepo, amendmentRepo *data.LeaveAmendmentRepo, allowanceRepo *data.LeaveAllowanceRepo, absenceTypeRepo *data.AbsenceTypeRepo, calendarRepo *data.HolidayCalendarRepo, holidayRepo *data.HolidayRepo, scheduleRepo *data.WorkScheduleAssignmentRepo, delegationRepo *data.ApprovalDelegationRepo, policyRepo *data.LeavePolicyRepo, blackoutRepo *data.BlackoutPeriodRepo, coverageRepo *data.CoverageRuleRepo, attachmentRepo *data.LeaveAttachmentRepo, attachmentStore storage.Backend, commentRepo *data.LeaveCommentRepo, publisher *event.Publisher, outboxRepo *data.OutboxRepo, signingClient *client.SigningClient, adminClient *client.AdminClient, notificationClient *client.NotificationClient) *LeaveService {
+	var managerPositions []string
+	if cfg, ok := ctx.GetCustomConfig("hr"); ok && cfg != nil {
+		if hrCfg, ok := cfg.(*conf.HR); ok && hrCfg.Approval != nil {
+			managerPositions = hrCfg.Approval.ManagerPositions
+		}
+	}
+
 	return &LeaveService{
 		log:                ctx.NewLoggerHelper("hr/service/leave"),
 		leaveRequestRepo:   leaveRequestRepo,
+		amendmentRepo:      amendmentRepo,
 		allowanceRepo:      allowanceRepo,
 		absenceTypeRepo:    absenceTypeRepo,
+		calendarRepo:       calendarRepo,
+		holidayRepo:        holidayRepo,
+		scheduleRepo:       scheduleRepo,
+		delegationRepo:     delegationRepo,
+		policyRepo:         policyRepo,
+		blackoutRepo:       blackoutRepo,
+		coverageRepo:       coverageRepo,
+		attachmentRepo:     attachmentRepo,
+		attachmentStore:    attachmentStore,
+		commentRepo:        commentRepo,
+		publisher:          publisher,
+		outboxRepo:         outboxRepo,
 		signingClient:      signingClient,
 		adminClient:        adminClient,
 		notificationClient: notificationClient,
+		managerPositions:   managerPositions,
 	}
 }
 
@@ -80,6 +124,14 @@ func (s *LeaveService) CreateLeaveRequest(ctx context.Context, req *hrV1.CreateL
 		return nil, err
 	}
 
+	if req.Recurrence != nil {
+		return s.createLeaveSeries(ctx, req)
+	}
+	return s.createLeaveRequest(ctx, req, nil)
+}
+
+// createLeaveRequest books a leave request, or an occurrence of the series when one is given.
+func (s *LeaveService) createLeaveRequest(ctx context.Context, req *hrV1.CreateLeaveRequestRequest, series *leaveSeries) (*hrV1.CreateLeaveRequestResponse, error) {
 	tenantID := getTenantID(ctx)
 	userID := req.GetUserId()
 
@@ -110,14 +162,24 @@ func (s *LeaveService) CreateLeaveRequest(ctx context.Context, req *hrV1.CreateL
 		return nil, hrV1.ErrorInvalidDateRange("end date must be after start date")
 	}
 
-	// Calculate business days
+	span, err := requestedSpan(absType, startDate, endDate, req.GetStartDayPart(), req.GetEndDayPart())
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate the days the employee works under their schedule, skipping the public
+	// holidays of the employee's calendar
 	days := req.GetDays()
+	holidayCalendarID := ""
 	if days <= 0 {
-		days = calculateBusinessDays(startDate, endDate)
+		days, holidayCalendarID, err = calculateBusinessDays(ctx, s.calendarRepo, s.holidayRepo, s.scheduleRepo, tenantID, req.GetHolidayCalendarId(), userID, req.GetOrgUnitName(), span)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Check for overlapping requests
-	overlap, err := s.leaveRequestRepo.CheckOverlap(ctx, tenantID, userID, startDate, endDate, "")
+	overlap, err := s.leaveRequestRepo.CheckOverlap(ctx, tenantID, userID, span, "")
 	if err != nil {
 		return nil, err
 	}
@@ -125,23 +187,32 @@ func (s *LeaveService) CreateLeaveRequest(ctx context.Context, req *hrV1.CreateL
 		return nil, hrV1.ErrorOverlapExists("overlapping leave request exists for this period")
 	}
 
-	// Check allowance balance if type deducts from allowance (pre-check, non-atomic)
+	override, err := s.checkLeavePolicies(ctx, tenantID, req.GetAbsenceTypeId(), req.GetOrgUnitName(), policy.Request{
+		Start:       startDate,
+		End:         endDate,
+		Days:        days,
+		CheckNotice: true,
+	}, req.GetPolicyOverrideReason())
+	if err != nil {
+		return nil, err
+	}
+
+	coverageWarnings, err := s.checkCoverage(ctx, tenantID, userID, req.GetOrgUnitName(), req.GetAbsenceTypeId(), span, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// Check allowance balance if type deducts from allowance (pre-check, non-atomic).
+	// Requests crossing a year boundary are checked against each year's allowance.
+	var portions []workday.YearDays
 	if absType.DeductsFromAllowance {
-		var allowance *ent.LeaveAllowance
-		if absType.AllowancePoolID != "" {
-			allowance, err = s.allowanceRepo.GetByUserAndPoolAndYear(ctx, tenantID, userID, absType.AllowancePoolID, startDate.Year())
-		} else {
-			allowance, err = s.allowanceRepo.GetByUserAndTypeAndYear(ctx, tenantID, userID, req.GetAbsenceTypeId(), startDate.Year())
-		}
+		portions, err = data.SplitDaysByYear(ctx, s.holidayRepo, s.scheduleRepo, tenantID, userID, req.GetOrgUnitName(), holidayCalendarID, span, days)
 		if err != nil {
 			return nil, err
 		}
-		if allowance == nil {
-			return nil, hrV1.ErrorInsufficientAllowance("no leave allowance configured for this type and year")
-		}
-		remaining := allowance.TotalDays + allowance.CarriedOver - allowance.UsedDays
-		if days > remaining {
-			return nil, hrV1.ErrorInsufficientAllowance("insufficient allowance: %.1f days requested, %.1f days remaining", days, remaining)
+		// Pending occurrences booked before this one of a series count against the balance too
+		if err := s.checkBalance(ctx, absType, tenantID, userID, series.withBooked(portions), nil); err != nil {
+			return nil, err
 		}
 	}
 
@@ -151,25 +222,44 @@ func (s *LeaveService) CreateLeaveRequest(ctx context.Context, req *hrV1.CreateL
 		status = "approved"
 	}
 
-	// If auto-approved and deducts from allowance, atomically deduct before creating the request
-	var deductedAllowanceID string
+	// If auto-approved and deducts from allowance, atomically deduct before creating the request.
+	// The request ID is chosen up front so the ledger entries can reference the request.
+	leaveID := uuid.New().String()
+	var deductions []schema.LeaveDeduction
 	if status == "approved" && absType.DeductsFromAllowance {
-		var aid string
+		ref := ledgerRef(ctx, leaveID, "leave auto-approved")
 		if absType.AllowancePoolID != "" {
-			aid, err = s.allowanceRepo.DeductPoolWithBalanceCheck(ctx, tenantID, userID, absType.AllowancePoolID, startDate.Year(), days)
+			deductions, err = s.allowanceRepo.DeductPoolWithBalanceCheck(ctx, tenantID, userID, absType.AllowancePoolID, portions, ref)
 		} else {
-			aid, err = s.allowanceRepo.DeductWithBalanceCheck(ctx, tenantID, userID, req.GetAbsenceTypeId(), startDate.Year(), days)
+			deductions, err = s.allowanceRepo.DeductWithBalanceCheck(ctx, tenantID, userID, req.GetAbsenceTypeId(), portions, ref)
 		}
 		if err != nil {
 			return nil, err
 		}
-		if aid == "" {
+		if len(deductions) == 0 {
 			return nil, hrV1.ErrorInsufficientAllowance("no leave allowance configured for this type and year")
 		}
-		deductedAllowanceID = aid
 	}
 
-	opts := []func(*ent.LeaveRequestCreate){}
+	opts := []func(*ent.LeaveRequestCreate){
+		func(c *ent.LeaveRequestCreate) { c.SetID(leaveID) },
+	}
+	if status == "pending" {
+		// Route the request to the requester's line manager, or to the approver of the first step
+		// of the approval chain
+		manager := s.resolveManager(ctx, userID, req.GetOrgUnitName())
+		steps := approvalSteps(absType.ApprovalChain, days, manager)
+		approverID := data.AssignedApprover(steps, 0)
+		if len(steps) == 0 && manager != nil {
+			approverID = manager.ID
+		}
+		if len(steps) > 0 {
+			opts = append(opts, func(c *ent.LeaveRequestCreate) { c.SetApprovalSteps(steps) })
+		}
+		if approverID != 0 {
+			opts = append(opts, func(c *ent.LeaveRequestCreate) { c.SetApproverID(approverID) })
+		}
+	}
 	if req.Reason != nil {
 		opts = append(opts, func(c *ent.LeaveRequestCreate) { c.SetReason(*req.Reason) })
 	}
@@ -188,30 +278,64 @@ func (s *LeaveService) CreateLeaveRequest(ctx context.Context, req *hrV1.CreateL
 	if req.OrgUnitName != nil {
 		opts = append(opts, func(c *ent.LeaveRequestCreate) { c.SetOrgUnitName(*req.OrgUnitName) })
 	}
+	if holidayCalendarID != "" {
+		opts = append(opts, func(c *ent.LeaveRequestCreate) { c.SetHolidayCalendarID(holidayCalendarID) })
+	}
+	if span.Hourly {
+		opts = append(opts, func(c *ent.LeaveRequestCreate) { c.SetHours(endDate.Sub(startDate).Hours()) })
+	}
+	if len(deductions) > 0 {
+		// Store which allowances were deducted for accurate refunds later
+		opts = append(opts, func(c *ent.LeaveRequestCreate) { c.SetDeductions(deductions) })
+	}
+	if span.StartsAfternoon {
+		opts = append(opts, func(c *ent.LeaveRequestCreate) { c.SetStartDayPart(leaverequest.StartDayPartPm) })
+	}
+	if span.EndsAtMidday {
+		opts = append(opts, func(c *ent.LeaveRequestCreate) { c.SetEndDayPart(leaverequest.EndDayPartAm) })
+	}
+	if override != nil {
+		opts = append(opts, func(c *ent.LeaveRequestCreate) { c.SetPolicyOverride(override) })
+	}
+	if series != nil {
+		opts = append(opts, func(c *ent.LeaveRequestCreate) { c.SetSeriesID(series.id).SetRecurrence(series.rule) })
+	}
 
-	entity, err := s.leaveRequestRepo.Create(ctx, tenantID, userID, req.GetAbsenceTypeId(), startDate, endDate, days, status, opts...)
+	actorID := getUserID(ctx)
+	entity, err := s.leaveRequestRepo.CreateWithOutbox(ctx, tenantID, userID, req.GetAbsenceTypeId(), startDate, endDate, days, status, func(e *ent.LeaveRequest) []data.OutboxMessage {
+		messages := []data.OutboxMessage{event.LeaveMessage(event.LeaveCreated, e, actorID)}
+		if status == "approved" {
+			messages = append(messages, event.LeaveMessage(event.LeaveApproved, e, actorID))
+			messages = append(messages, event.AllowancesOf(e)...)
+		}
+		return messages
+	}, opts...)
 	if err != nil {
 		// If we already deducted allowance, refund it
-		if deductedAllowanceID != "" {
-			if refundErr := s.allowanceRepo.RefundWithFloorCheck(ctx, deductedAllowanceID, days); refundErr != nil {
-				s.log.Errorf("Failed to refund allowance %s after create failure: %v", deductedAllowanceID, refundErr)
+		if len(deductions) > 0 {
+			if refundErr := s.allowanceRepo.RefundDeductions(ctx, deductions, ledgerRef(ctx, leaveID, "leave request could not be created")); refundErr != nil {
+				s.log.Errorf("Failed to refund allowances after create failure: %v", refundErr)
 			}
 		}
 		return nil, err
 	}
+	if status == "pending" {
+		series.book(portions)
+	}
 
-	// Store which allowance was deducted for accurate refunds later
-	if deductedAllowanceID != "" {
-		if setErr := s.leaveRequestRepo.SetDeductedAllowanceID(ctx, entity.ID, deductedAllowanceID); setErr != nil {
-			s.log.Errorf("Failed to store deducted_allowance_id on leave %s: %v", entity.ID, setErr)
-		}
+	// Approvers on leave hand their approvals to their own manager
+	if status == "approved" {
+		s.delegateWhileAway(ctx, entity)
 	}
 
 	// Re-fetch with edges
-	entity, _ = s.leaveRequestRepo.GetByID(ctx, entity.ID)
+	if refetched, _ := s.leaveRequestRepo.GetByID(ctx, entity.ID); refetched != nil {
+		entity = refetched
+	}
 
 	return &hrV1.CreateLeaveRequestResponse{
-		LeaveRequest: leaveRequestToProto(entity),
+		LeaveRequest:     leaveRequestToProto(entity),
+		CoverageWarnings: coverageWarnings,
 	}, nil
 }
 
@@ -261,6 +385,9 @@ func (s *LeaveService) ListLeaveRequests(ctx context.Context, req *hrV1.ListLeav
 			filters["end_date"] = t
 		}
 	}
+	if req.SeriesId != nil {
+		filters["series_id"] = *req.SeriesId
+	}
 
 	page := int(req.GetPage())
 	pageSize := int(req.GetPageSize())
@@ -285,6 +412,55 @@ func (s *LeaveService) ListLeaveRequests(ctx context.Context, req *hrV1.ListLeav
 	}, nil
 }
 
+// ListAssignedApprovals lists the pending requests, or approval steps, assigned to the caller.
+func (s *LeaveService) ListAssignedApprovals(ctx context.Context, req *hrV1.ListAssignedApprovalsRequest) (*hrV1.ListAssignedApprovalsResponse, error) {
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
+		return nil, err
+	}
+
+	userID := getUserID(ctx)
+	if userID == 0 {
+		return &hrV1.ListAssignedApprovalsResponse{Total: ptrInt32(0)}, nil
+	}
+
+	// Requests assigned to the caller and to the approvers the caller is a delegate for
+	scopes := []data.ApproverScope{{ApproverID: userID}}
+	delegations, err := s.delegationRepo.ListActive(ctx, getTenantID(ctx), userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range delegations {
+		scopes = append(scopes, data.ApproverScope{ApproverID: d.DelegatorID, AbsenceTypeIDs: d.AbsenceTypeIds})
+	}
+
+	filters := map[string]interface{}{
+		"approver_scopes": scopes,
+		"status":          "pending",
+	}
+
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	if req.GetNoPaging() {
+		page = 0
+		pageSize = 0
+	}
+
+	entities, total, err := s.leaveRequestRepo.List(ctx, getTenantID(ctx), page, pageSize, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.LeaveRequest, len(entities))
+	for i, e := range entities {
+		items[i] = leaveRequestToProto(e)
+	}
+
+	return &hrV1.ListAssignedApprovalsResponse{
+		Items: items,
+		Total: ptrInt32(int32(total)),
+	}, nil
+}
+
 func (s *LeaveService) UpdateLeaveRequest(ctx context.Context, req *hrV1.UpdateLeaveRequestRequest) (*hrV1.UpdateLeaveRequestResponse, error) {
 	if err := checkPermission(ctx, "hr.request.manage"); err != nil {
 		return nil, err
@@ -368,11 +544,14 @@ func (s *LeaveService) DeleteLeaveRequest(ctx context.Context, req *hrV1.DeleteL
 	if err != nil {
 		return nil, err
 	}
+	s.removeLeaveAttachments(ctx, existing.ID)
+	s.removeLeaveComments(ctx, existing.ID)
 	return &emptypb.Empty{}, nil
 }
 
 func (s *LeaveService) ApproveLeaveRequest(ctx context.Context, req *hrV1.ApproveLeaveRequestRequest) (*hrV1.ApproveLeaveRequestResponse, error) {
-	if err := checkPermission(ctx, "hr.request.approve"); err != nil {
+	// Assigned approvers need not hold hr.request.approve; checkApprover decides who may approve
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
 		return nil, err
 	}
 
@@ -389,20 +568,80 @@ func (s *LeaveService) ApproveLeaveRequest(ctx context.Context, req *hrV1.Approv
 	if existing.Status.String() != "pending" {
 		return nil, hrV1.ErrorBadRequest("only pending requests can be approved")
 	}
+	check, err := checkApprover(ctx, s.delegationRepo, existing)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAttachment(existing); err != nil {
+		return nil, err
+	}
+
+	// Approvers are warned of colleagues away at the same time, or refused by blocking rules
+	coverageWarnings, err := s.checkCoverage(ctx, entityTenantID(existing), existing.UserID, existing.OrgUnitName, existing.AbsenceTypeID, data.LeaveSpan(existing), existing.ID)
+	if err != nil {
+		return nil, err
+	}
 
 	reviewNotes := ""
 	if req.ReviewNotes != nil {
 		reviewNotes = *req.ReviewNotes
 	}
 
-	// Check if absence type requires signing
-	absType := existing.Edges.AbsenceType
-	if absType != nil && absType.RequiresSigning && absType.SigningTemplateID != "" {
-		return s.approveWithSigning(ctx, existing, absType, req, reviewNotes)
+	// Requests with an approval chain are approved one step at a time; only the approval of
+	// the last step approves the request
+	step := existing.ApprovalStep
+	chained := len(existing.ApprovalSteps) > 0
+	if chained {
+		entity, err := s.leaveRequestRepo.DecideApprovalStep(ctx, existing.ID, step, check.decision(ctx, approval.DecisionApproved, reviewNotes))
+		if err != nil {
+			return nil, err
+		}
+		if entity.ApprovalStep < len(entity.ApprovalSteps) {
+			// Re-fetch with edges
+			entity2, _ := s.leaveRequestRepo.GetByID(ctx, entity.ID)
+			if entity2 != nil {
+				entity = entity2
+			}
+			return &hrV1.ApproveLeaveRequestResponse{
+				LeaveRequest:     leaveRequestToProto(entity),
+				CoverageWarnings: coverageWarnings,
+			}, nil
+		}
 	}
 
-	// Standard approval flow (no signing required)
-	return s.approveImmediate(ctx, existing, req.GetId(), reviewNotes)
+	// A delegate's approval records the approver they decided for alongside the reviewer
+	if check.delegation != nil {
+		err = s.leaveRequestRepo.SetOnBehalfOf(ctx, existing.ID, check.delegation.DelegatorID, check.delegation.DelegatorName)
+	}
+
+	var resp *hrV1.ApproveLeaveRequestResponse
+	if err == nil {
+		// Check if absence type requires signing
+		absType := existing.Edges.AbsenceType
+		if absType != nil && absType.RequiresSigning && absType.SigningTemplateID != "" {
+			resp, err = s.approveWithSigning(ctx, existing, absType, req, reviewNotes)
+		} else {
+			// Standard approval flow (no signing required)
+			resp, err = s.approveImmediate(ctx, existing, req.GetId(), reviewNotes)
+		}
+	}
+
+	// The request was not approved after all, so the last step awaits a decision again
+	if err != nil {
+		if chained {
+			if reopenErr := s.leaveRequestRepo.ReopenApprovalStep(ctx, existing.ID, step); reopenErr != nil {
+				s.log.Errorf("Failed to reopen approval step %d of leave %s: %v", step, existing.ID, reopenErr)
+			}
+		}
+		if check.delegation != nil {
+			if clearErr := s.leaveRequestRepo.SetOnBehalfOf(ctx, existing.ID, 0, ""); clearErr != nil {
+				s.log.Errorf("Failed to clear on_behalf_of of leave %s: %v", existing.ID, clearErr)
+			}
+		}
+		return nil, err
+	}
+	resp.CoverageWarnings = coverageWarnings
+	return resp, nil
 }
 
 // approveWithSigning creates a signing submission and sets status to awaiting_signing.
@@ -413,6 +652,75 @@ func (s *LeaveService) approveWithSigning(ctx context.Context, existing *ent.Lea
 		return nil, err
 	}
 
+	submissionID, err := s.createSigningSubmission(ctx, absType, existing, existing.StartDate, existing.EndDate, existing.Days)
+	if err != nil {
+		// Roll back to pending on failure
+		s.log.Errorf("Failed to create signing submission, rolling back to pending: %v", err)
+		if _, rollbackErr := s.leaveRequestRepo.UpdateStatus(ctx, existing.ID, "pending", 0, "", ""); rollbackErr != nil {
+			s.log.Errorf("CRITICAL: Failed to roll back leave %s from awaiting_signing to pending: %v", existing.ID, rollbackErr)
+		}
+		return nil, hrV1.ErrorInternalServerError("failed to create signing request")
+	}
+
+	// Store the submission ID on the leave request (reuses signing_request_id field)
+	if err := s.leaveRequestRepo.SetSigningRequestID(ctx, existing.ID, submissionID); err != nil {
+		s.log.Errorf("Failed to store signing_request_id for leave %s: %v", existing.ID, err)
+		if _, rollbackErr := s.leaveRequestRepo.UpdateStatus(ctx, existing.ID, "pending", 0, "", ""); rollbackErr != nil {
+			s.log.Errorf("CRITICAL: Failed to roll back leave %s after signing_request_id storage failure: %v", existing.ID, rollbackErr)
+		}
+		return nil, hrV1.ErrorInternalServerError("failed to initiate signing workflow")
+	}
+
+	// Re-fetch with edges
+	entity2, _ := s.leaveRequestRepo.GetByID(ctx, entity.ID)
+	if entity2 != nil {
+		entity = entity2
+	}
+
+	return &hrV1.ApproveLeaveRequestResponse{
+		LeaveRequest: leaveRequestToProto(entity),
+	}, nil
+}
+
+// checkBalance checks that the allowance of each year has the days of the portion remaining
+// (pre-check, non-atomic). The days already deducted for the request being changed count as
+// remaining. Returns an error if a year with days has no allowance configured.
+func (s *LeaveService) checkBalance(ctx context.Context, absType *ent.AbsenceType, tenantID uint32, userID uint32, portions []workday.YearDays, deducted []schema.LeaveDeduction) error {
+	for _, p := range portions {
+		if p.Days <= 0 {
+			continue
+		}
+
+		var allowance *ent.LeaveAllowance
+		var err error
+		if absType.AllowancePoolID != "" {
+			allowance, err = s.allowanceRepo.GetByUserAndPoolAndYear(ctx, tenantID, userID, absType.AllowancePoolID, p.Year)
+		} else {
+			allowance, err = s.allowanceRepo.GetByUserAndTypeAndYear(ctx, tenantID, userID, absType.ID, p.Year)
+		}
+		if err != nil {
+			return err
+		}
+		if allowance == nil {
+			return hrV1.ErrorInsufficientAllowance("no leave allowance configured for this type and %d", p.Year)
+		}
+		remaining := allowance.TotalDays + allowance.CarriedOver - allowance.UsedDays
+		for _, d := range deducted {
+			if d.AllowanceID == allowance.ID {
+				remaining += d.Days
+			}
+		}
+		if p.Days > remaining {
+			return hrV1.ErrorInsufficientAllowance("insufficient allowance for %d: %.1f days requested, %.1f days remaining", p.Year, p.Days, remaining)
+		}
+	}
+	return nil
+}
+
+// createSigningSubmission sends the signing template of the absence type to the requester and
+// then the calling approver, prefilled with the given dates and days of the leave request.
+// Returns the submission ID.
+func (s *LeaveService) createSigningSubmission(ctx context.Context, absType *ent.AbsenceType, leaveReq *ent.LeaveRequest, startDate, endDate time.Time, days float64) (string, error) {
 	// Resolve approver email from admin service
 	approverName := getUsername(ctx)
 	approverEmail := s.resolveUserEmail(ctx, getUserID(ctx))
@@ -420,8 +728,8 @@ func (s *LeaveService) approveWithSigning(ctx context.Context, existing *ent.Lea
 	// Build submitters: employee signs first (order 0), then approver (order 1)
 	submitters := []client.SubmitterInput{
 		{
-			Name:  existing.UserName,
-			Email: existing.UserEmail,
+			Name:  leaveReq.UserName,
+			Email: leaveReq.UserEmail,
 			Role:  "Employee",
 		},
 		{
@@ -433,14 +741,14 @@ func (s *LeaveService) approveWithSigning(ctx context.Context, existing *ent.Lea
 
 	// Prefill template fields with leave request data
 	prefillValues := map[string]string{
-		"Name2":     existing.UserName,
-		"TotalDays": fmt.Sprintf("%d", int(existing.Days)),
-		"StartDate": existing.StartDate.Format("2006-01-02"),
-		"EndDate":   existing.EndDate.Format("2006-01-02"),
+		"Name2":     leaveReq.UserName,
+		"TotalDays": fmt.Sprintf("%.1f", days),
+		"StartDate": startDate.Format("2006-01-02"),
+		"EndDate":   endDate.Format("2006-01-02"),
 		"Today":     time.Now().Format("02.01.2006"),
 	}
 
-	submissionID, err := s.signingClient.CreateAndSendSubmission(
+	return s.signingClient.CreateAndSendSubmission(
 		ctx,
 		absType.SigningTemplateID,
 		"SIGNING_MODE_SEQUENTIAL",
@@ -448,33 +756,6 @@ func (s *LeaveService) approveWithSigning(ctx context.Context, existing *ent.Lea
 		submitters,
 		prefillValues,
 	)
-	if err != nil {
-		// Roll back to pending on failure
-		s.log.Errorf("Failed to create signing submission, rolling back to pending: %v", err)
-		if _, rollbackErr := s.leaveRequestRepo.UpdateStatus(ctx, existing.ID, "pending", 0, "", ""); rollbackErr != nil {
-			s.log.Errorf("CRITICAL: Failed to roll back leave %s from awaiting_signing to pending: %v", existing.ID, rollbackErr)
-		}
-		return nil, hrV1.ErrorInternalServerError("failed to create signing request")
-	}
-
-	// Store the submission ID on the leave request (reuses signing_request_id field)
-	if err := s.leaveRequestRepo.SetSigningRequestID(ctx, existing.ID, submissionID); err != nil {
-		s.log.Errorf("Failed to store signing_request_id for leave %s: %v", existing.ID, err)
-		if _, rollbackErr := s.leaveRequestRepo.UpdateStatus(ctx, existing.ID, "pending", 0, "", ""); rollbackErr != nil {
-			s.log.Errorf("CRITICAL: Failed to roll back leave %s after signing_request_id storage failure: %v", existing.ID, rollbackErr)
-		}
-		return nil, hrV1.ErrorInternalServerError("failed to initiate signing workflow")
-	}
-
-	// Re-fetch with edges
-	entity2, _ := s.leaveRequestRepo.GetByID(ctx, entity.ID)
-	if entity2 != nil {
-		entity = entity2
-	}
-
-	return &hrV1.ApproveLeaveRequestResponse{
-		LeaveRequest: leaveRequestToProto(entity),
-	}, nil
 }
 
 // resolveUserEmail looks up a user's email by user ID via the admin service.
@@ -497,27 +778,127 @@ func (s *LeaveService) resolveUserEmail(ctx context.Context, userID uint32) stri
 	return ""
 }
 
+// listMembers returns the active users of the admin service with their org units and positions,
+// plus the given user whatever their status. Returns nil if the admin service is unavailable.
+func (s *LeaveService) listMembers(ctx context.Context, userID uint32) ([]approval.Member, error) {
+	if s.adminClient == nil {
+		return nil, nil
+	}
+
+	resp, err := s.adminClient.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]approval.Member, 0, len(resp.GetItems()))
+	for _, user := range resp.GetItems() {
+		// Only active users can approve; the given user is kept for their org units
+		if user.GetId() != userID && user.Status != nil && user.GetStatus() != adminstubpb.AdminUser_NORMAL {
+			continue
+		}
+		name := user.GetRealname()
+		if name == "" {
+			name = user.GetUsername()
+		}
+		members = append(members, approval.Member{
+			ID:        user.GetId(),
+			Name:      name,
+			OrgUnits:  user.GetOrgUnitNames(),
+			Positions: user.GetPositionNames(),
+		})
+	}
+	return members, nil
+}
+
+// resolveManager returns the line manager of a user from the org units and positions held in the
+// admin service, or nil if the user has no manager or the admin service is unavailable.
+func (s *LeaveService) resolveManager(ctx context.Context, userID uint32, orgUnitName string) *approval.Member {
+	members, err := s.listMembers(ctx, userID)
+	if err != nil {
+		s.log.Warnf("Failed to list users for manager resolution: %v", err)
+		return nil
+	}
+	return approval.FindManager(members, userID, orgUnitName, s.managerPositions)
+}
+
+// delegateWhileAway hands the approvals of a line manager to their own manager for the days of
+// the manager's approved leave, unless the manager has delegated any of those days already.
+// Failures are only logged, as the leave itself is approved.
+func (s *LeaveService) delegateWhileAway(ctx context.Context, leaveReq *ent.LeaveRequest) {
+	members, err := s.listMembers(ctx, leaveReq.UserID)
+	if err != nil {
+		s.log.Warnf("Failed to list users for delegation of leave %s: %v", leaveReq.ID, err)
+		return
+	}
+	if !approval.IsManager(members, leaveReq.UserID, s.managerPositions) {
+		return
+	}
+
+	delegate := approval.FindManager(members, leaveReq.UserID, leaveReq.OrgUnitName, s.managerPositions)
+	if delegate == nil {
+		s.log.Infof("No delegate found for approver %d during leave %s", leaveReq.UserID, leaveReq.ID)
+		return
+	}
+
+	tenantID := entityTenantID(leaveReq)
+	startDate := dateOnly(leaveReq.StartDate)
+	endDate := dateOnly(leaveReq.EndDate)
+
+	overlap, err := s.delegationRepo.HasOverlap(ctx, tenantID, leaveReq.UserID, startDate, endDate)
+	if err != nil {
+		s.log.Errorf("Failed to check delegations for leave %s: %v", leaveReq.ID, err)
+		return
+	}
+	if overlap {
+		return
+	}
+
+	_, err = s.delegationRepo.Create(ctx, tenantID, leaveReq.UserID, delegate.ID, startDate, endDate, func(c *ent.ApprovalDelegationCreate) {
+		c.SetDelegatorName(leaveReq.UserName)
+		c.SetDelegateName(delegate.Name)
+		c.SetLeaveRequestID(leaveReq.ID)
+		c.SetNotes("created when the approver's leave was approved")
+	})
+	if err != nil {
+		s.log.Errorf("Failed to create delegation for leave %s: %v", leaveReq.ID, err)
+	}
+}
+
+// removeLeaveDelegations removes the delegations created for an approver's leave that no longer
+// takes place.
+func (s *LeaveService) removeLeaveDelegations(ctx context.Context, leaveRequestID string) {
+	if err := s.delegationRepo.DeleteByLeaveRequest(ctx, leaveRequestID); err != nil {
+		s.log.Errorf("Failed to remove delegations of leave %s: %v", leaveRequestID, err)
+	}
+}
+
 // approveImmediate performs standard approval without signing
 func (s *LeaveService) approveImmediate(ctx context.Context, existing *ent.LeaveRequest, id string, reviewNotes string) (*hrV1.ApproveLeaveRequestResponse, error) {
 	// Atomically deduct from allowance BEFORE approving, to prevent race conditions
-	allowanceID, err := deductAllowance(ctx, s.allowanceRepo, existing)
+	deductions, err := deductAllowance(ctx, s.allowanceRepo, s.holidayRepo, s.scheduleRepo, existing)
 	if err != nil {
 		return nil, err
 	}
 
-	entity, err := s.leaveRequestRepo.UpdateStatus(ctx, id, "approved", getUserID(ctx), getUsername(ctx), reviewNotes)
+	actorID := getUserID(ctx)
+	entity, err := s.leaveRequestRepo.UpdateStatusWithOutbox(ctx, id, "approved", actorID, getUsername(ctx), reviewNotes, func(e *ent.LeaveRequest) []data.OutboxMessage {
+		return append([]data.OutboxMessage{event.LeaveMessage(event.LeaveApproved, e, actorID)}, event.AllowancesOf(e)...)
+	}, func(u *ent.LeaveRequestUpdateOne) {
+		// Store which allowances were deducted for accurate refunds later
+		if len(deductions) > 0 {
+			u.SetDeductions(deductions)
+		}
+	})
 	if err != nil {
 		// Refund allowance if status update fails
-		refundAllowance(ctx, s.log, s.allowanceRepo, existing)
+		if refundErr := s.allowanceRepo.RefundDeductions(ctx, deductions, ledgerRef(ctx, id, "leave approval failed")); refundErr != nil {
+			s.log.Errorf("Failed to refund allowances for leave %s: %v", id, refundErr)
+		}
 		return nil, err
 	}
 
-	// Store which allowance was deducted for accurate refunds later
-	if allowanceID != "" {
-		if setErr := s.leaveRequestRepo.SetDeductedAllowanceID(ctx, id, allowanceID); setErr != nil {
-			s.log.Errorf("Failed to store deducted_allowance_id on leave %s: %v", id, setErr)
-		}
-	}
+	// Approvers on leave hand their approvals to their own manager
+	s.delegateWhileAway(ctx, entity)
 
 	// Re-fetch with edges
 	entity2, _ := s.leaveRequestRepo.GetByID(ctx, entity.ID)
@@ -531,7 +912,8 @@ func (s *LeaveService) approveImmediate(ctx context.Context, existing *ent.Leave
 }
 
 func (s *LeaveService) RejectLeaveRequest(ctx context.Context, req *hrV1.RejectLeaveRequestRequest) (*hrV1.RejectLeaveRequestResponse, error) {
-	if err := checkPermission(ctx, "hr.request.approve"); err != nil {
+	// Assigned approvers need not hold hr.request.approve; checkApprover decides who may reject
+	if err := checkPermission(ctx, "hr.request.view"); err != nil {
 		return nil, err
 	}
 
@@ -548,6 +930,10 @@ func (s *LeaveService) RejectLeaveRequest(ctx context.Context, req *hrV1.RejectL
 	if existing.Status.String() != "pending" {
 		return nil, hrV1.ErrorBadRequest("only pending requests can be rejected")
 	}
+	check, err := checkApprover(ctx, s.delegationRepo, existing)
+	if err != nil {
+		return nil, err
+	}
 
 	reviewNotes := ""
 	if req.ReviewNotes != nil {
@@ -555,7 +941,28 @@ func (s *LeaveService) RejectLeaveRequest(ctx context.Context, req *hrV1.RejectL
 	}
 
 	reviewerName := getUsername(ctx)
-	entity, err := s.leaveRequestRepo.UpdateStatus(ctx, req.GetId(), "rejected", getUserID(ctx), reviewerName, reviewNotes)
+
+	// A rejection at any step of an approval chain rejects the request
+	if len(existing.ApprovalSteps) > 0 {
+		if _, err := s.leaveRequestRepo.DecideApprovalStep(ctx, existing.ID, existing.ApprovalStep, check.decision(ctx, approval.DecisionRejected, reviewNotes)); err != nil {
+			return nil, err
+		}
+	}
+
+	// A delegate's rejection records the approver they decided for alongside the reviewer
+	if check.delegation != nil {
+		if err := s.leaveRequestRepo.SetOnBehalfOf(ctx, existing.ID, check.delegation.DelegatorID, check.delegation.DelegatorName); err != nil {
+			return nil, err
+		}
+	}
+
+	actorID := getUserID(ctx)
+	entity, err := s.leaveRequestRepo.UpdateStatusWithOutbox(ctx, req.GetId(), "rejected", actorID, reviewerName, reviewNotes, func(e *ent.LeaveRequest) []data.OutboxMessage {
+		return []data.OutboxMessage{
+			event.LeaveMessage(event.LeaveRejected, e, actorID),
+			rejectionEmailMessage(e, reviewerName, reviewNotes),
+		}
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -566,9 +973,6 @@ func (s *LeaveService) RejectLeaveRequest(ctx context.Context, req *hrV1.RejectL
 		entity = entity2
 	}
 
-	// Send rejection email asynchronously
-	go s.sendRejectionEmail(ctx, entity, reviewerName, reviewNotes)
-
 	return &hrV1.RejectLeaveRequestResponse{
 		LeaveRequest: leaveRequestToProto(entity),
 	}, nil
@@ -599,10 +1003,19 @@ func (s *LeaveService) CancelLeaveRequest(ctx context.Context, req *hrV1.CancelL
 
 	// Refund allowance BEFORE changing status to ensure consistency
 	if wasApproved {
-		refundAllowance(ctx, s.log, s.allowanceRepo, existing)
+		refundAllowance(ctx, s.log, s.allowanceRepo, existing, "leave cancelled")
+		s.removeLeaveDelegations(ctx, existing.ID)
 	}
+	s.cancelLeaveAmendments(ctx, existing.ID)
 
-	entity, err := s.leaveRequestRepo.UpdateStatus(ctx, req.GetId(), "cancelled", 0, "", "")
+	actorID := getUserID(ctx)
+	entity, err := s.leaveRequestRepo.UpdateStatusWithOutbox(ctx, req.GetId(), "cancelled", 0, "", "", func(e *ent.LeaveRequest) []data.OutboxMessage {
+		messages := []data.OutboxMessage{event.LeaveMessage(event.LeaveCancelled, e, actorID)}
+		if wasApproved {
+			messages = append(messages, event.AllowancesOf(existing)...)
+		}
+		return messages
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -639,33 +1052,29 @@ func (s *LeaveService) RevokeLeaveRequest(ctx context.Context, req *hrV1.RevokeL
 	}
 
 	// Refund allowance BEFORE changing status to ensure consistency
-	refundAllowance(ctx, s.log, s.allowanceRepo, existing)
-
-	// Update status to revoked
-	entity, err := s.leaveRequestRepo.UpdateStatus(ctx, req.GetId(), "revoked", 0, "", req.GetReason())
+	refundAllowance(ctx, s.log, s.allowanceRepo, existing, "leave revoked")
+	s.removeLeaveDelegations(ctx, existing.ID)
+	s.cancelLeaveAmendments(ctx, existing.ID)
+
+	// Update status to revoked, cancelling the signing submission if the absence type requires
+	// signing and we have one
+	actorID := getUserID(ctx)
+	entity, err := s.leaveRequestRepo.UpdateStatusWithOutbox(ctx, req.GetId(), "revoked", 0, "", req.GetReason(), func(e *ent.LeaveRequest) []data.OutboxMessage {
+		messages := []data.OutboxMessage{event.LeaveMessage(event.LeaveRevoked, e, actorID)}
+		messages = append(messages, event.AllowancesOf(existing)...)
+		if existing.SigningRequestID != "" && existing.Edges.AbsenceType != nil && existing.Edges.AbsenceType.RequiresSigning {
+			reason := req.GetReason()
+			if reason == "" {
+				reason = "Leave request revoked"
+			}
+			messages = append(messages, signingCancelMessage(ctx, existing, reason))
+		}
+		return messages
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// If the absence type requires signing and we have a signing request, cancel it
-	if existing.SigningRequestID != "" && existing.Edges.AbsenceType != nil && existing.Edges.AbsenceType.RequiresSigning {
-		reason := req.GetReason()
-		if reason == "" {
-			reason = "Leave request revoked"
-		}
-		signingReqID := existing.SigningRequestID
-		leaveID := existing.ID
-		bgCtx := context.Background()
-		if inMD, ok := grpcMD.FromIncomingContext(ctx); ok {
-			bgCtx = grpcMD.NewOutgoingContext(bgCtx, inMD)
-		}
-		go func() {
-			if cancelErr := s.signingClient.CancelSubmission(bgCtx, signingReqID, reason); cancelErr != nil {
-				s.log.Errorf("Failed to cancel signing submission %s for leave %s: %v", signingReqID, leaveID, cancelErr)
-			}
-		}()
-	}
-
 	// Re-fetch with edges
 	entity2, _ := s.leaveRequestRepo.GetByID(ctx, entity.ID)
 	if entity2 != nil {
@@ -702,7 +1111,9 @@ func (s *LeaveService) GetCalendarEvents(ctx context.Context, req *hrV1.GetCalen
 		userID = *req.UserId
 	}
 
-	entities, err := s.leaveRequestRepo.GetCalendarEvents(ctx, getTenantID(ctx), startDate, endDate, orgUnitName, userID)
+	tenantID := getTenantID(ctx)
+
+	entities, err := s.leaveRequestRepo.GetCalendarEvents(ctx, tenantID, startDate, endDate, orgUnitName, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -719,6 +1130,9 @@ func (s *LeaveService) GetCalendarEvents(ctx context.Context, req *hrV1.GetCalen
 			EndDate:       timestamppb.New(e.EndDate),
 			Days:          e.Days,
 			Status:        leaveStatusToProto(e.Status.String()),
+			StartDayPart:  dayPartToProto(e.StartDayPart.String()),
+			EndDayPart:    dayPartToProto(e.EndDayPart.String()),
+			Hours:         e.Hours,
 		}
 
 		if e.Edges.AbsenceType != nil {
@@ -729,8 +1143,30 @@ func (s *LeaveService) GetCalendarEvents(ctx context.Context, req *hrV1.GetCalen
 		events[i] = event
 	}
 
+	// Public holidays of the calendar that applies to the viewed org unit
+	var holidays []*hrV1.CalendarHoliday
+	calendar, err := resolveHolidayCalendar(ctx, s.calendarRepo, tenantID, req.GetHolidayCalendarId(), orgUnitName)
+	if err != nil {
+		return nil, err
+	}
+	if calendar != nil {
+		occurrences, err := s.holidayRepo.ListOccurrences(ctx, calendar.ID, startDate, endDate)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range occurrences {
+			holidays = append(holidays, &hrV1.CalendarHoliday{
+				HolidayId:  o.HolidayID,
+				CalendarId: o.CalendarID,
+				Name:       o.Name,
+				Date:       timestamppb.New(o.Date),
+			})
+		}
+	}
+
 	return &hrV1.GetCalendarEventsResponse{
-		Events: events,
+		Events:   events,
+		Holidays: holidays,
 	}, nil
 }
 
@@ -790,6 +1226,8 @@ func leaveRequestToProto(e *ent.LeaveRequest) *hrV1.LeaveRequest {
 		EndDate:       timestamppb.New(e.EndDate),
 		Days:          ptrFloat64(e.Days),
 		Status:        leaveStatusToProtoPtr(e.Status.String()),
+		StartDayPart:  dayPartToProtoPtr(e.StartDayPart.String()),
+		EndDayPart:    dayPartToProtoPtr(e.EndDayPart.String()),
 		Reason:        ptrString(e.Reason),
 		ReviewNotes:   ptrString(e.ReviewNotes),
 		ReviewedBy:    &e.ReviewedBy,
@@ -816,16 +1254,129 @@ func leaveRequestToProto(e *ent.LeaveRequest) *hrV1.LeaveRequest {
 	if e.SigningRequestID != "" {
 		result.SigningRequestId = ptrString(e.SigningRequestID)
 	}
+	if e.HolidayCalendarID != "" {
+		result.HolidayCalendarId = ptrString(e.HolidayCalendarID)
+	}
+	if e.Hours > 0 {
+		result.Hours = ptrFloat64(e.Hours)
+	}
+	for _, d := range e.Deductions {
+		result.Deductions = append(result.Deductions, &hrV1.LeaveDeduction{
+			AllowanceId: d.AllowanceID,
+			Year:        int32(d.Year),
+			Days:        d.Days,
+		})
+	}
+
+	for i, a := range e.ApprovalSteps {
+		result.Approvals = append(result.Approvals, leaveApprovalToProto(i, a))
+	}
+	if len(e.ApprovalSteps) > 0 {
+		result.ApprovalStep = ptrInt32(int32(e.ApprovalStep))
+	}
+	if e.ApproverID > 0 {
+		result.ApproverId = &e.ApproverID
+	}
+	if e.OnBehalfOf > 0 {
+		result.OnBehalfOf = &e.OnBehalfOf
+		result.OnBehalfOfName = ptrString(e.OnBehalfOfName)
+	}
+	if e.AwaitingSince != nil && (e.Status == leaverequest.StatusPending || e.Status == leaverequest.StatusAwaitingSigning) {
+		result.AwaitingSince = timestamppb.New(*e.AwaitingSince)
+		result.EscalationLevel = ptrInt32(int32(e.EscalationLevel))
+	}
+	if e.PolicyOverride != nil {
+		result.PolicyOverride = policyOverrideToProto(e.PolicyOverride)
+	}
+	result.AttachmentCount = ptrInt32(int32(e.AttachmentCount))
+	if e.SeriesID != "" {
+		result.SeriesId = ptrString(e.SeriesID)
+		result.Recurrence = recurrenceRuleToProto(e.Recurrence)
+	}
+	if e.SigningOutcome != nil {
+		result.SigningOutcome = signingOutcomeToProto(*e.SigningOutcome)
+		result.SigningOutcomeReason = ptrString(e.SigningOutcomeReason)
+		if e.SigningEndedAt != nil {
+			result.SigningEndedAt = timestamppb.New(*e.SigningEndedAt)
+		}
+	}
 
 	// Denormalized fields from edges
 	if e.Edges.AbsenceType != nil {
 		result.AbsenceTypeName = &e.Edges.AbsenceType.Name
 		result.AbsenceTypeColor = ptrString(e.Edges.AbsenceType.Color)
+
+		// Only requests still to be taken or taken need their documents
+		if e.Status == leaverequest.StatusPending || e.Status == leaverequest.StatusAwaitingSigning || e.Status == leaverequest.StatusApproved {
+			if status := attachmentStatus(e, time.Now()); status.Required {
+				result.AttachmentRequired = ptrBool(true)
+				if !status.DueDate.IsZero() {
+					result.AttachmentDueDate = timestamppb.New(status.DueDate)
+				}
+				result.AttachmentMissing = ptrBool(status.Missing)
+			}
+		}
 	}
 
 	return result
 }
 
+func signingOutcomeToProto(o leaverequest.SigningOutcome) *hrV1.SigningOutcome {
+	var v hrV1.SigningOutcome
+	switch o {
+	case leaverequest.SigningOutcomeCompleted:
+		v = hrV1.SigningOutcome_SIGNING_OUTCOME_COMPLETED
+	case leaverequest.SigningOutcomeDeclined:
+		v = hrV1.SigningOutcome_SIGNING_OUTCOME_DECLINED
+	case leaverequest.SigningOutcomeExpired:
+		v = hrV1.SigningOutcome_SIGNING_OUTCOME_EXPIRED
+	case leaverequest.SigningOutcomeCancelled:
+		v = hrV1.SigningOutcome_SIGNING_OUTCOME_CANCELLED
+	default:
+		return nil
+	}
+	return &v
+}
+
+func leaveApprovalToProto(step int, a schema.LeaveApproval) *hrV1.LeaveApproval {
+	result := &hrV1.LeaveApproval{
+		Step:        int32(step),
+		Name:        a.Name,
+		Role:        a.Role,
+		ApproverIds: a.ApproverIDs,
+		Decision:    approvalDecisionToProto(a.Decision),
+		Notes:       ptrString(a.Notes),
+		Override:    a.Override,
+	}
+	if a.ApproverID > 0 {
+		result.ApproverId = &a.ApproverID
+		result.ApproverName = ptrString(a.ApproverName)
+	}
+	if a.OnBehalfOf > 0 {
+		result.OnBehalfOf = &a.OnBehalfOf
+		result.OnBehalfOfName = ptrString(a.OnBehalfOfName)
+	}
+	if a.DecidedAt != nil {
+		result.DecidedAt = timestamppb.New(*a.DecidedAt)
+	}
+	return result
+}
+
+func approvalDecisionToProto(decision string) hrV1.ApprovalDecision {
+	switch decision {
+	case approval.DecisionPending:
+		return hrV1.ApprovalDecision_APPROVAL_DECISION_PENDING
+	case approval.DecisionApproved:
+		return hrV1.ApprovalDecision_APPROVAL_DECISION_APPROVED
+	case approval.DecisionRejected:
+		return hrV1.ApprovalDecision_APPROVAL_DECISION_REJECTED
+	case approval.DecisionSkipped:
+		return hrV1.ApprovalDecision_APPROVAL_DECISION_SKIPPED
+	default:
+		return hrV1.ApprovalDecision_APPROVAL_DECISION_UNSPECIFIED
+	}
+}
+
 func leaveStatusToProto(status string) hrV1.LeaveRequestStatus {
 	switch status {
 	case "pending":
@@ -868,3 +1419,19 @@ func leaveStatusToString(status hrV1.LeaveRequestStatus) string {
 		return ""
 	}
 }
+
+func dayPartToProto(part string) hrV1.DayPart {
+	switch part {
+	case "am":
+		return hrV1.DayPart_DAY_PART_AM
+	case "pm":
+		return hrV1.DayPart_DAY_PART_PM
+	default:
+		return hrV1.DayPart_DAY_PART_UNSPECIFIED
+	}
+}
+
+func dayPartToProtoPtr(part string) *hrV1.DayPart {
+	p := dayPartToProto(part)
+	return &p
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+
+	"github.com/go-tangra/go-tangra-hr/internal/event"
+)
+
+const (
+	signingEndedTemplateName        = "hr-leave-signing-ended"
+	defaultSigningEndedSubject      = `Signing of the leave of {{.RequesterName}} {{.Outcome}}`
+	signingEndedTemplateVariables   = "RequesterName,Subject,AbsenceTypeName,StartDate,EndDate,Days,Outcome,Reason,NextStep"
+	defaultSigningEndedBodyTemplate = `<!DOCTYPE html>
+<html>
+<head><meta charset="UTF-8"></head>
+<body style="font-family: Arial, sans-serif; max-width: 600px; margin: 0 auto; padding: 20px;">
+  <div style="background: #f8f9fa; border-radius: 8px; padding: 30px;">
+    <h2 style="color: #d48806; margin-top: 0;">Leave Signing {{.Outcome}}</h2>
+    <p>Hello,</p>
+    <p>The signing of {{.Subject}} of <strong>{{.RequesterName}}</strong> for <strong>{{.AbsenceTypeName}}</strong> from <strong>{{.StartDate}}</strong> to <strong>{{.EndDate}}</strong> ({{.Days}} days) was <strong>{{.Outcome}}</strong>.</p>
+    <div style="background: #fff; border-left: 3px solid #d48806; padding: 10px 15px; margin: 15px 0;">
+      <p style="margin: 0; color: #555; white-space: pre-wrap;">{{.Reason}}</p>
+    </div>
+    <p>{{.NextStep}}</p>
+    <hr style="border: none; border-top: 1px solid #e8e8e8; margin: 20px 0;">
+    <p style="color: #999; font-size: 12px;">
+      This is an automated message. Please do not reply to this email.
+    </p>
+  </div>
+</body>
+</html>`
+)
+
+// sendSigningEndedEmail tells the requester and the reviewer of a leave request, or of a change to
+// its dates, that its signing ended without all signatures, and what happens next. Delivered
+// from the outbox — uses detached context.
+func (s *LeaveService) sendSigningEndedEmail(ctx context.Context, notice event.SigningEndedNotice) error {
+	leaveReq, err := s.leaveRequestRepo.GetByID(ctx, notice.LeaveRequestID)
+	if err != nil {
+		return err
+	}
+	if leaveReq == nil {
+		s.log.Warnf("Leave request %s no longer exists, skipping signing notification", notice.LeaveRequestID)
+		return nil
+	}
+
+	subject := "the leave request"
+	startDate, endDate, days := leaveReq.StartDate, leaveReq.EndDate, leaveReq.Days
+	nextStep := "The request has been rejected."
+	if notice.Status == "pending" {
+		nextStep = "The request is pending again and awaits a new decision by the approver."
+	}
+	if notice.AmendmentID != "" {
+		amendment, err := s.amendmentRepo.GetByID(ctx, notice.AmendmentID)
+		if err != nil {
+			return err
+		}
+		if amendment == nil {
+			s.log.Warnf("Leave amendment %s no longer exists, skipping signing notification", notice.AmendmentID)
+			return nil
+		}
+		subject = "the new dates"
+		startDate, endDate, days = amendment.StartDate, amendment.EndDate, amendment.Days
+		nextStep = "The change has been rejected; the leave keeps its previous dates."
+		if notice.Status == "pending" {
+			nextStep = "The change is pending again and awaits a new decision; until then the leave keeps its previous dates."
+		}
+	}
+
+	var recipients []string
+	requesterEmail := leaveReq.UserEmail
+	if requesterEmail == "" {
+		requesterEmail = s.resolveUserEmail(ctx, leaveReq.UserID)
+	}
+	if requesterEmail != "" {
+		recipients = append(recipients, requesterEmail)
+	}
+	if notice.ReviewerID != 0 && notice.ReviewerID != leaveReq.UserID {
+		if email := s.resolveUserEmail(ctx, notice.ReviewerID); email != "" {
+			recipients = append(recipients, email)
+		}
+	}
+	if len(recipients) == 0 {
+		s.log.Warnf("No one to notify of the signing of leave request %s", leaveReq.ID)
+		return nil
+	}
+
+	templateID, err := s.ensureTemplate(ctx, signingEndedTemplateName, defaultSigningEndedSubject, defaultSigningEndedBodyTemplate, signingEndedTemplateVariables)
+	if err != nil {
+		return fmt.Errorf("ensure signing ended template: %w", err)
+	}
+
+	absenceTypeName := ""
+	if leaveReq.Edges.AbsenceType != nil {
+		absenceTypeName = leaveReq.Edges.AbsenceType.Name
+	}
+	reason := notice.Reason
+	if reason == "" {
+		reason = "No reason was given."
+	}
+
+	variables := map[string]string{
+		"RequesterName":   html.EscapeString(leaveReq.UserName),
+		"Subject":         subject,
+		"AbsenceTypeName": html.EscapeString(absenceTypeName),
+		"StartDate":       startDate.Format("2006-01-02"),
+		"EndDate":         endDate.Format("2006-01-02"),
+		"Days":            fmt.Sprintf("%.1f", days),
+		"Outcome":         notice.Outcome,
+		"Reason":          html.EscapeString(reason),
+		"NextStep":        nextStep,
+	}
+
+	platformCtx := detachedPlatformContext(ctx)
+	for _, recipient := range recipients {
+		if _, err := s.notificationClient.SendNotification(platformCtx, templateID, recipient, variables); err != nil {
+			return fmt.Errorf("send signing notification for leave %s to %s: %w", leaveReq.ID, recipient, err)
+		}
+	}
+	s.log.Infof("Signing %s notification for leave request %s sent to %d recipients", notice.Outcome, leaveReq.ID, len(recipients))
+	return nil
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/outboxmessage"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+type OutboxService struct {
+	hrV1.UnimplementedHrOutboxServiceServer
+
+	log        *log.Helper
+	outboxRepo *data.OutboxRepo
+}
+
+func NewOutboxService(ctx *bootstrap.Context, outboxRepo *data.OutboxRepo) *OutboxService {
+	return &OutboxService{
+		log:        ctx.NewLoggerHelper("hr/service/outbox"),
+		outboxRepo: outboxRepo,
+	}
+}
+
+func (s *OutboxService) ListOutboxMessages(ctx context.Context, req *hrV1.ListOutboxMessagesRequest) (*hrV1.ListOutboxMessagesResponse, error) {
+	if err := checkPermission(ctx, "hr.outbox.manage"); err != nil {
+		return nil, err
+	}
+
+	filters := make(map[string]interface{})
+	if req.Status != nil {
+		if status := outboxStatusFromProto(req.GetStatus()); status != "" {
+			filters["status"] = string(status)
+		}
+	}
+	if req.Kind != nil {
+		filters["kind"] = req.GetKind()
+	}
+
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	if req.GetNoPaging() {
+		page = 0
+		pageSize = 0
+	}
+
+	entities, total, err := s.outboxRepo.List(ctx, getTenantID(ctx), page, pageSize, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.OutboxMessage, len(entities))
+	for i, e := range entities {
+		items[i] = outboxMessageToProto(e)
+	}
+
+	return &hrV1.ListOutboxMessagesResponse{
+		Items: items,
+		Total: ptrInt32(int32(total)),
+	}, nil
+}
+
+func (s *OutboxService) GetOutboxMessage(ctx context.Context, req *hrV1.GetOutboxMessageRequest) (*hrV1.GetOutboxMessageResponse, error) {
+	if err := checkPermission(ctx, "hr.outbox.manage"); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.outboxRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, hrV1.ErrorOutboxMessageNotFound("outbox message not found")
+	}
+	if err := checkTenantAccess(ctx, entity.TenantID, hrV1.ErrorOutboxMessageNotFound("outbox message not found")); err != nil {
+		return nil, err
+	}
+
+	return &hrV1.GetOutboxMessageResponse{
+		Message: outboxMessageToProto(entity),
+	}, nil
+}
+
+// ReplayOutboxMessage hands a dead message back to the outbox relay with a fresh set of attempts.
+// Messages still pending or already delivered cannot be replayed.
+func (s *OutboxService) ReplayOutboxMessage(ctx context.Context, req *hrV1.ReplayOutboxMessageRequest) (*hrV1.ReplayOutboxMessageResponse, error) {
+	if err := checkPermission(ctx, "hr.outbox.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.outboxRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorOutboxMessageNotFound("outbox message not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorOutboxMessageNotFound("outbox message not found")); err != nil {
+		return nil, err
+	}
+	if existing.Status != outboxmessage.StatusDead {
+		return nil, hrV1.ErrorBadRequest("only dead outbox messages can be replayed")
+	}
+
+	entity, err := s.outboxRepo.Replay(ctx, existing.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Infof("Outbox message %s (%s) replayed by user %d", entity.ID, entity.Kind, getUserID(ctx))
+
+	return &hrV1.ReplayOutboxMessageResponse{
+		Message: outboxMessageToProto(entity),
+	}, nil
+}
+
+func outboxStatusFromProto(s hrV1.OutboxMessageStatus) outboxmessage.Status {
+	switch s {
+	case hrV1.OutboxMessageStatus_OUTBOX_MESSAGE_STATUS_PENDING:
+		return outboxmessage.StatusPending
+	case hrV1.OutboxMessageStatus_OUTBOX_MESSAGE_STATUS_DELIVERED:
+		return outboxmessage.StatusDelivered
+	case hrV1.OutboxMessageStatus_OUTBOX_MESSAGE_STATUS_DEAD:
+		return outboxmessage.StatusDead
+	default:
+		return ""
+	}
+}
+
+func outboxMessageToProto(e *ent.OutboxMessage) *hrV1.OutboxMessage {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.OutboxMessage{
+		Id:            &e.ID,
+		TenantId:      e.TenantID,
+		Kind:          &e.Kind,
+		Payload:       ptrString(string(e.Payload)),
+		Attempts:      ptrInt32(int32(e.Attempts)),
+		NextAttemptAt: timestamppb.New(e.NextAttemptAt),
+		LastError:     ptrString(e.LastError),
+	}
+
+	var status hrV1.OutboxMessageStatus
+	switch e.Status {
+	case outboxmessage.StatusPending:
+		status = hrV1.OutboxMessageStatus_OUTBOX_MESSAGE_STATUS_PENDING
+	case outboxmessage.StatusDelivered:
+		status = hrV1.OutboxMessageStatus_OUTBOX_MESSAGE_STATUS_DELIVERED
+	case outboxmessage.StatusDead:
+		status = hrV1.OutboxMessageStatus_OUTBOX_MESSAGE_STATUS_DEAD
+	}
+	result.Status = &status
+
+	if e.DeliveredAt != nil {
+		result.DeliveredAt = timestamppb.New(*e.DeliveredAt)
+	}
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	if e.UpdateTime != nil {
+		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
+	}
+
+	return result
+}
@@ -23,6 +23,12 @@ var rolePermissions = map[string][]string{
 		"hr.allowance.view",
 		"hr.allowance.manage",
 		"hr.allowance_pool.manage",
+		"hr.holiday.manage",
+		"hr.schedule.manage",
+		"hr.policy.manage",
+		"hr.employment.manage",
+		"hr.outbox.manage",
+		"hr.webhook.manage",
 		"hr.users.list",
 	},
 	"hr.employee": {
@@ -61,6 +67,22 @@ func hasPermission(ctx context.Context, code string) bool {
 	return false
 }
 
+// hasRole checks if the current user holds the given role code, or a role that grants every
+// permission.
+func hasRole(ctx context.Context, code string) bool {
+	for _, role := range getRoles(ctx) {
+		if role == code {
+			return true
+		}
+		for _, p := range rolePermissions[role] {
+			if p == "*" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // checkPermission returns a PermissionDenied error if the user lacks the required permission.
 func checkPermission(ctx context.Context, required string) error {
 	if hasPermission(ctx, required) {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/go-tangra/go-tangra-hr/internal/client"
 	"github.com/go-tangra/go-tangra-hr/internal/event"
+	"github.com/go-tangra/go-tangra-hr/internal/job"
 	"github.com/go-tangra/go-tangra-hr/internal/metrics"
 	"github.com/go-tangra/go-tangra-hr/internal/service"
 )
@@ -20,14 +21,30 @@ var ProviderSet = wire.NewSet(
 	service.NewLeaveService,
 	service.NewAllowanceService,
 	service.NewAllowancePoolService,
+	service.NewHolidayService,
+	service.NewWorkScheduleService,
+	service.NewEmploymentService,
+	service.NewApprovalDelegationService,
+	service.NewLeavePolicyService,
+	service.NewCoverageService,
+	service.NewLeaveAttachmentService,
 	service.NewUserService,
 	service.NewBackupService,
+	service.NewOutboxService,
+	service.NewWebhookService,
 	client.NewRegistrationClient,
 	client.NewModuleDialer,
 	client.NewSigningClient,
 	client.NewNotificationClient,
 	client.NewAdminClient,
 	event.NewHandler,
+	event.NewPublisher,
 	event.NewSubscriber,
+	job.NewAccrualJob,
+	job.NewRolloverJob,
+	job.NewEscalationJob,
+	job.NewOutboxRelayJob,
+	job.NewSigningReconcileJob,
+	job.NewWebhookDeliveryJob,
 	metrics.NewCollector,
 )
@@ -0,0 +1,488 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-hr/internal/conf"
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent/webhookdelivery"
+	"github.com/go-tangra/go-tangra-hr/internal/event"
+	"github.com/go-tangra/go-tangra-hr/internal/webhook"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+// minWebhookSecretLength is the length secrets given by callers must have at least
+const minWebhookSecretLength = 16
+
+type WebhookService struct {
+	hrV1.UnimplementedHrWebhookServiceServer
+
+	log              *log.Helper
+	subscriptionRepo *data.WebhookSubscriptionRepo
+	deliveryRepo     *data.WebhookDeliveryRepo
+	guard            *webhook.Guard
+}
+
+func NewWebhookService(ctx *bootstrap.Context, subscriptionRepo *data.WebhookSubscriptionRepo, deliveryRepo *data.WebhookDeliveryRepo) *WebhookService {
+	l := ctx.NewLoggerHelper("hr/service/webhook")
+
+	var allowedNetworks []string
+	if cfg, ok := ctx.GetCustomConfig("hr"); ok && cfg != nil {
+		if hrCfg, ok := cfg.(*conf.HR); ok && hrCfg.Webhooks != nil {
+			allowedNetworks = hrCfg.Webhooks.AllowedNetworks
+		}
+	}
+	guard, err := webhook.NewGuard(allowedNetworks)
+	if err != nil {
+		l.Warnf("Ignoring allowed webhook networks: %v", err)
+		guard, _ = webhook.NewGuard(nil)
+	}
+
+	return &WebhookService{
+		log:              l,
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		guard:            guard,
+	}
+}
+
+func (s *WebhookService) CreateWebhookSubscription(ctx context.Context, req *hrV1.CreateWebhookSubscriptionRequest) (*hrV1.CreateWebhookSubscriptionResponse, error) {
+	if err := checkPermission(ctx, "hr.webhook.manage"); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateWebhookURL(ctx, req.GetUrl()); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookEventTypes(req.GetEventTypes()); err != nil {
+		return nil, err
+	}
+
+	secret := req.GetSecret()
+	if req.Secret != nil {
+		if err := validateWebhookSecret(secret); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if secret, err = webhook.GenerateSecret(); err != nil {
+			s.log.Errorf("Failed to generate webhook secret: %v", err)
+			return nil, hrV1.ErrorInternalServerError("create webhook subscription failed")
+		}
+	}
+
+	opts := []func(*ent.WebhookSubscriptionCreate){}
+	if req.Description != nil {
+		opts = append(opts, func(c *ent.WebhookSubscriptionCreate) { c.SetDescription(*req.Description) })
+	}
+	if len(req.GetEventTypes()) > 0 {
+		opts = append(opts, func(c *ent.WebhookSubscriptionCreate) { c.SetEventTypes(req.GetEventTypes()) })
+	}
+	if req.Enabled != nil {
+		opts = append(opts, func(c *ent.WebhookSubscriptionCreate) { c.SetEnabled(*req.Enabled) })
+	}
+
+	entity, err := s.subscriptionRepo.Create(ctx, getTenantID(ctx), req.GetName(), req.GetUrl(), secret, opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Infof("Webhook subscription %s to %s created by user %d", entity.ID, entity.URL, getUserID(ctx))
+
+	// The secret is only ever shown here and on rotation
+	result := webhookSubscriptionToProto(entity)
+	result.Secret = ptrString(entity.Secret)
+	return &hrV1.CreateWebhookSubscriptionResponse{
+		Subscription: result,
+	}, nil
+}
+
+func (s *WebhookService) GetWebhookSubscription(ctx context.Context, req *hrV1.GetWebhookSubscriptionRequest) (*hrV1.GetWebhookSubscriptionResponse, error) {
+	if err := checkPermission(ctx, "hr.webhook.manage"); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.subscriptionRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, hrV1.ErrorWebhookSubscriptionNotFound("webhook subscription not found")
+	}
+	if err := checkTenantAccess(ctx, entity.TenantID, hrV1.ErrorWebhookSubscriptionNotFound("webhook subscription not found")); err != nil {
+		return nil, err
+	}
+
+	return &hrV1.GetWebhookSubscriptionResponse{
+		Subscription: webhookSubscriptionToProto(entity),
+	}, nil
+}
+
+func (s *WebhookService) ListWebhookSubscriptions(ctx context.Context, req *hrV1.ListWebhookSubscriptionsRequest) (*hrV1.ListWebhookSubscriptionsResponse, error) {
+	if err := checkPermission(ctx, "hr.webhook.manage"); err != nil {
+		return nil, err
+	}
+
+	filters := make(map[string]interface{})
+	if req.Query != nil {
+		filters["query"] = *req.Query
+	}
+	if req.Enabled != nil {
+		filters["enabled"] = *req.Enabled
+	}
+
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	if req.GetNoPaging() {
+		page = 0
+		pageSize = 0
+	}
+
+	entities, total, err := s.subscriptionRepo.List(ctx, getTenantID(ctx), page, pageSize, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.WebhookSubscription, len(entities))
+	for i, e := range entities {
+		items[i] = webhookSubscriptionToProto(e)
+	}
+
+	return &hrV1.ListWebhookSubscriptionsResponse{
+		Items: items,
+		Total: ptrInt32(int32(total)),
+	}, nil
+}
+
+func (s *WebhookService) UpdateWebhookSubscription(ctx context.Context, req *hrV1.UpdateWebhookSubscriptionRequest) (*hrV1.UpdateWebhookSubscriptionResponse, error) {
+	if err := checkPermission(ctx, "hr.webhook.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.subscriptionRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorWebhookSubscriptionNotFound("webhook subscription not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorWebhookSubscriptionNotFound("webhook subscription not found")); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+
+	if req.Data != nil {
+		if req.Data.Name != nil {
+			if *req.Data.Name == "" {
+				return nil, hrV1.ErrorValidationFailed("name must not be empty")
+			}
+			updates["name"] = *req.Data.Name
+		}
+		if req.Data.Description != nil {
+			updates["description"] = *req.Data.Description
+		}
+		if req.Data.Url != nil {
+			if err := s.validateWebhookURL(ctx, *req.Data.Url); err != nil {
+				return nil, err
+			}
+			updates["url"] = *req.Data.Url
+		}
+		if req.Data.EventTypes != nil {
+			if err := validateWebhookEventTypes(req.Data.EventTypes); err != nil {
+				return nil, err
+			}
+			updates["event_types"] = req.Data.EventTypes
+		}
+		if req.Data.Enabled != nil {
+			updates["enabled"] = *req.Data.Enabled
+		}
+		if req.Data.Secret != nil {
+			if err := validateWebhookSecret(*req.Data.Secret); err != nil {
+				return nil, err
+			}
+			updates["secret"] = *req.Data.Secret
+		}
+	}
+	if req.GetRotateSecret() {
+		secret, err := webhook.GenerateSecret()
+		if err != nil {
+			s.log.Errorf("Failed to generate webhook secret: %v", err)
+			return nil, hrV1.ErrorInternalServerError("update webhook subscription failed")
+		}
+		updates["secret"] = secret
+	}
+
+	entity, err := s.subscriptionRepo.Update(ctx, req.GetId(), updates)
+	if err != nil {
+		return nil, err
+	}
+
+	result := webhookSubscriptionToProto(entity)
+	if req.GetRotateSecret() {
+		s.log.Infof("Secret of webhook subscription %s rotated by user %d", entity.ID, getUserID(ctx))
+		result.Secret = ptrString(entity.Secret)
+	}
+	return &hrV1.UpdateWebhookSubscriptionResponse{
+		Subscription: result,
+	}, nil
+}
+
+func (s *WebhookService) DeleteWebhookSubscription(ctx context.Context, req *hrV1.DeleteWebhookSubscriptionRequest) (*emptypb.Empty, error) {
+	if err := checkPermission(ctx, "hr.webhook.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.subscriptionRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorWebhookSubscriptionNotFound("webhook subscription not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorWebhookSubscriptionNotFound("webhook subscription not found")); err != nil {
+		return nil, err
+	}
+
+	if err := s.subscriptionRepo.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *hrV1.ListWebhookDeliveriesRequest) (*hrV1.ListWebhookDeliveriesResponse, error) {
+	if err := checkPermission(ctx, "hr.webhook.manage"); err != nil {
+		return nil, err
+	}
+
+	filters := make(map[string]interface{})
+	if req.SubscriptionId != nil {
+		filters["subscription_id"] = req.GetSubscriptionId()
+	}
+	if req.EventId != nil {
+		filters["event_id"] = req.GetEventId()
+	}
+	if req.EventType != nil {
+		filters["event_type"] = req.GetEventType()
+	}
+	if req.Status != nil {
+		if status := webhookDeliveryStatusFromProto(req.GetStatus()); status != "" {
+			filters["status"] = string(status)
+		}
+	}
+
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	if req.GetNoPaging() {
+		page = 0
+		pageSize = 0
+	}
+
+	entities, total, err := s.deliveryRepo.List(ctx, getTenantID(ctx), page, pageSize, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.WebhookDelivery, len(entities))
+	for i, e := range entities {
+		items[i] = webhookDeliveryToProto(e)
+	}
+
+	return &hrV1.ListWebhookDeliveriesResponse{
+		Items: items,
+		Total: ptrInt32(int32(total)),
+	}, nil
+}
+
+func (s *WebhookService) GetWebhookDelivery(ctx context.Context, req *hrV1.GetWebhookDeliveryRequest) (*hrV1.GetWebhookDeliveryResponse, error) {
+	if err := checkPermission(ctx, "hr.webhook.manage"); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.deliveryRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, hrV1.ErrorWebhookDeliveryNotFound("webhook delivery not found")
+	}
+	if err := checkTenantAccess(ctx, entity.TenantID, hrV1.ErrorWebhookDeliveryNotFound("webhook delivery not found")); err != nil {
+		return nil, err
+	}
+
+	return &hrV1.GetWebhookDeliveryResponse{
+		Delivery: webhookDeliveryToProto(entity),
+	}, nil
+}
+
+// RedeliverWebhookDelivery delivers the event of an attempt again, right away and with a fresh
+// set of attempts. Attempts still pending cannot be redelivered, nor events of disabled
+// subscriptions.
+func (s *WebhookService) RedeliverWebhookDelivery(ctx context.Context, req *hrV1.RedeliverWebhookDeliveryRequest) (*hrV1.RedeliverWebhookDeliveryResponse, error) {
+	if err := checkPermission(ctx, "hr.webhook.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.deliveryRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorWebhookDeliveryNotFound("webhook delivery not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorWebhookDeliveryNotFound("webhook delivery not found")); err != nil {
+		return nil, err
+	}
+	if existing.Status == webhookdelivery.StatusPending {
+		return nil, hrV1.ErrorBadRequest("the delivery has not been attempted yet")
+	}
+
+	sub, err := s.subscriptionRepo.GetByID(ctx, existing.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if sub == nil {
+		return nil, hrV1.ErrorWebhookSubscriptionNotFound("webhook subscription not found")
+	}
+	if !sub.Enabled {
+		return nil, hrV1.ErrorBadRequest("webhook subscription is disabled")
+	}
+
+	entity, err := s.deliveryRepo.Redeliver(ctx, existing, getUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	s.log.Infof("%s event %s redelivered to webhook %s by user %d", entity.EventType, entity.EventID, entity.SubscriptionID, getUserID(ctx))
+
+	return &hrV1.RedeliverWebhookDeliveryResponse{
+		Delivery: webhookDeliveryToProto(entity),
+	}, nil
+}
+
+// validateWebhookURL checks that events can be POSTed to the URL, and that its host does not
+// resolve to an address of the service's own network. The delivery job checks the address again
+// when it connects, as the host may resolve differently by then.
+func (s *WebhookService) validateWebhookURL(ctx context.Context, raw string) error {
+	if err := s.guard.CheckURL(ctx, raw); err != nil {
+		return hrV1.ErrorValidationFailed("invalid webhook url: %s", err.Error())
+	}
+	return nil
+}
+
+// validateWebhookSecret checks that a secret given by the caller is long enough to sign with.
+func validateWebhookSecret(secret string) error {
+	if len(secret) < minWebhookSecretLength {
+		return hrV1.ErrorValidationFailed("secret must be at least %d characters long", minWebhookSecretLength)
+	}
+	return nil
+}
+
+// validateWebhookEventTypes checks that each entry of an event filter is a type of event the HR
+// service publishes, or a prefix of such types followed by *.
+func validateWebhookEventTypes(eventTypes []string) error {
+	for _, t := range eventTypes {
+		if slices.Contains(event.PublishedTypes, t) {
+			continue
+		}
+		if prefix, ok := strings.CutSuffix(t, "*"); ok && slices.ContainsFunc(event.PublishedTypes, func(p string) bool {
+			return strings.HasPrefix(p, prefix)
+		}) {
+			continue
+		}
+		return hrV1.ErrorValidationFailed("unknown event type %q, expected one of %s or a prefix followed by *", t, strings.Join(event.PublishedTypes, ", "))
+	}
+	return nil
+}
+
+func webhookDeliveryStatusFromProto(s hrV1.WebhookDeliveryStatus) webhookdelivery.Status {
+	switch s {
+	case hrV1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:
+		return webhookdelivery.StatusPending
+	case hrV1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED:
+		return webhookdelivery.StatusSucceeded
+	case hrV1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED:
+		return webhookdelivery.StatusFailed
+	default:
+		return ""
+	}
+}
+
+// webhookSubscriptionToProto converts a subscription, without its secret
+func webhookSubscriptionToProto(e *ent.WebhookSubscription) *hrV1.WebhookSubscription {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.WebhookSubscription{
+		Id:          &e.ID,
+		TenantId:    e.TenantID,
+		Name:        ptrString(e.Name),
+		Description: ptrString(e.Description),
+		Url:         ptrString(e.URL),
+		EventTypes:  e.EventTypes,
+		Enabled:     ptrBool(e.Enabled),
+		CreatedBy:   e.CreateBy,
+		UpdatedBy:   e.UpdateBy,
+	}
+
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	if e.UpdateTime != nil {
+		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
+	}
+
+	return result
+}
+
+func webhookDeliveryToProto(e *ent.WebhookDelivery) *hrV1.WebhookDelivery {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.WebhookDelivery{
+		Id:             &e.ID,
+		TenantId:       e.TenantID,
+		SubscriptionId: ptrString(e.SubscriptionID),
+		EventId:        ptrString(e.EventID),
+		EventType:      ptrString(e.EventType),
+		Payload:        ptrString(string(e.Payload)),
+		Attempt:        ptrInt32(int32(e.Attempt)),
+		NextAttemptAt:  timestamppb.New(e.NextAttemptAt),
+		ResponseStatus: ptrInt32(int32(e.ResponseStatus)),
+		ResponseBody:   ptrString(e.ResponseBody),
+		Error:          ptrString(e.Error),
+		DurationMs:     &e.DurationMs,
+		RedeliveredBy:  e.RedeliveredBy,
+	}
+
+	var status hrV1.WebhookDeliveryStatus
+	switch e.Status {
+	case webhookdelivery.StatusPending:
+		status = hrV1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case webhookdelivery.StatusSucceeded:
+		status = hrV1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case webhookdelivery.StatusFailed:
+		status = hrV1.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	}
+	result.Status = &status
+
+	if e.AttemptedAt != nil {
+		result.AttemptedAt = timestamppb.New(*e.AttemptedAt)
+	}
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	if e.UpdateTime != nil {
+		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
+	}
+
+	return result
+}
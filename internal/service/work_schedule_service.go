@@ -0,0 +1,363 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/kratos-bootstrap/bootstrap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-tangra/go-tangra-hr/internal/data"
+	"github.com/go-tangra/go-tangra-hr/internal/data/ent"
+	hrV1 "github.com/go-tangra/go-tangra-hr/gen/go/hr/service/v1"
+)
+
+type WorkScheduleService struct {
+	hrV1.UnimplementedHrWorkScheduleServiceServer
+
+	log            *log.Helper
+	scheduleRepo   *data.WorkScheduleRepo
+	assignmentRepo *data.WorkScheduleAssignmentRepo
+}
+
+func NewWorkScheduleService(ctx *bootstrap.Context, scheduleRepo *data.WorkScheduleRepo, assignmentRepo *data.WorkScheduleAssignmentRepo) *WorkScheduleService {
+	return &WorkScheduleService{
+		log:            ctx.NewLoggerHelper("hr/service/work_schedule"),
+		scheduleRepo:   scheduleRepo,
+		assignmentRepo: assignmentRepo,
+	}
+}
+
+func (s *WorkScheduleService) CreateWorkSchedule(ctx context.Context, req *hrV1.CreateWorkScheduleRequest) (*hrV1.CreateWorkScheduleResponse, error) {
+	if err := checkPermission(ctx, "hr.schedule.manage"); err != nil {
+		return nil, err
+	}
+
+	if err := validateDayHours(req.GetDayHours()); err != nil {
+		return nil, err
+	}
+
+	opts := []func(*ent.WorkScheduleCreate){}
+	if req.Description != nil {
+		opts = append(opts, func(c *ent.WorkScheduleCreate) { c.SetDescription(*req.Description) })
+	}
+
+	entity, err := s.scheduleRepo.Create(ctx, getTenantID(ctx), req.GetName(), req.GetDayHours(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.CreateWorkScheduleResponse{
+		Schedule: workScheduleToProto(entity),
+	}, nil
+}
+
+func (s *WorkScheduleService) GetWorkSchedule(ctx context.Context, req *hrV1.GetWorkScheduleRequest) (*hrV1.GetWorkScheduleResponse, error) {
+	if err := checkPermission(ctx, "hr.calendar.view"); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.scheduleRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, hrV1.ErrorWorkScheduleNotFound("work schedule not found")
+	}
+	if err := checkTenantAccess(ctx, entity.TenantID, hrV1.ErrorWorkScheduleNotFound("work schedule not found")); err != nil {
+		return nil, err
+	}
+
+	return &hrV1.GetWorkScheduleResponse{
+		Schedule: workScheduleToProto(entity),
+	}, nil
+}
+
+func (s *WorkScheduleService) ListWorkSchedules(ctx context.Context, req *hrV1.ListWorkSchedulesRequest) (*hrV1.ListWorkSchedulesResponse, error) {
+	if err := checkPermission(ctx, "hr.calendar.view"); err != nil {
+		return nil, err
+	}
+
+	filters := make(map[string]interface{})
+	if req.Query != nil {
+		filters["query"] = *req.Query
+	}
+
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	if req.GetNoPaging() {
+		page = 0
+		pageSize = 0
+	}
+
+	entities, total, err := s.scheduleRepo.List(ctx, getTenantID(ctx), page, pageSize, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.WorkSchedule, len(entities))
+	for i, e := range entities {
+		items[i] = workScheduleToProto(e)
+	}
+
+	return &hrV1.ListWorkSchedulesResponse{
+		Items: items,
+		Total: ptrInt32(int32(total)),
+	}, nil
+}
+
+func (s *WorkScheduleService) UpdateWorkSchedule(ctx context.Context, req *hrV1.UpdateWorkScheduleRequest) (*hrV1.UpdateWorkScheduleResponse, error) {
+	if err := checkPermission(ctx, "hr.schedule.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.scheduleRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorWorkScheduleNotFound("work schedule not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorWorkScheduleNotFound("work schedule not found")); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+
+	if req.Data != nil {
+		if req.Data.Name != nil {
+			updates["name"] = *req.Data.Name
+		}
+		if req.Data.Description != nil {
+			updates["description"] = *req.Data.Description
+		}
+		if req.Data.DayHours != nil {
+			if err := validateDayHours(req.Data.DayHours); err != nil {
+				return nil, err
+			}
+			updates["day_hours"] = req.Data.DayHours
+		}
+	}
+
+	entity, err := s.scheduleRepo.Update(ctx, req.GetId(), updates)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.UpdateWorkScheduleResponse{
+		Schedule: workScheduleToProto(entity),
+	}, nil
+}
+
+func (s *WorkScheduleService) DeleteWorkSchedule(ctx context.Context, req *hrV1.DeleteWorkScheduleRequest) (*emptypb.Empty, error) {
+	if err := checkPermission(ctx, "hr.schedule.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.scheduleRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorWorkScheduleNotFound("work schedule not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorWorkScheduleNotFound("work schedule not found")); err != nil {
+		return nil, err
+	}
+
+	if err := s.scheduleRepo.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *WorkScheduleService) AssignWorkSchedule(ctx context.Context, req *hrV1.AssignWorkScheduleRequest) (*hrV1.AssignWorkScheduleResponse, error) {
+	if err := checkPermission(ctx, "hr.schedule.manage"); err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.scheduleRepo.GetByID(ctx, req.GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
+	if schedule == nil {
+		return nil, hrV1.ErrorWorkScheduleNotFound("work schedule not found")
+	}
+	if err := checkTenantAccess(ctx, schedule.TenantID, hrV1.ErrorWorkScheduleNotFound("work schedule not found")); err != nil {
+		return nil, err
+	}
+
+	if (req.GetUserId() > 0) == (req.GetOrgUnitName() != "") {
+		return nil, hrV1.ErrorValidationFailed("exactly one of user_id and org_unit_name must be set")
+	}
+	if req.GetEffectiveFrom() == nil {
+		return nil, hrV1.ErrorValidationFailed("effective_from is required")
+	}
+
+	effectiveFrom := req.GetEffectiveFrom().AsTime()
+	effectiveFrom = time.Date(effectiveFrom.Year(), effectiveFrom.Month(), effectiveFrom.Day(), 0, 0, 0, 0, effectiveFrom.Location())
+
+	opts := []func(*ent.WorkScheduleAssignmentCreate){}
+	if req.GetUserId() > 0 {
+		opts = append(opts, func(c *ent.WorkScheduleAssignmentCreate) {
+			c.SetUserID(req.GetUserId())
+			c.SetUserName(req.GetUserName())
+		})
+	} else {
+		opts = append(opts, func(c *ent.WorkScheduleAssignmentCreate) { c.SetOrgUnitName(req.GetOrgUnitName()) })
+	}
+
+	created, err := s.assignmentRepo.Create(ctx, getTenantID(ctx), schedule.ID, effectiveFrom, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Re-fetch with schedule edge
+	entity, err := s.assignmentRepo.GetByID(ctx, created.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hrV1.AssignWorkScheduleResponse{
+		Assignment: workScheduleAssignmentToProto(entity),
+	}, nil
+}
+
+func (s *WorkScheduleService) ListWorkScheduleAssignments(ctx context.Context, req *hrV1.ListWorkScheduleAssignmentsRequest) (*hrV1.ListWorkScheduleAssignmentsResponse, error) {
+	if err := checkPermission(ctx, "hr.calendar.view"); err != nil {
+		return nil, err
+	}
+
+	filters := make(map[string]interface{})
+	if req.ScheduleId != nil {
+		filters["schedule_id"] = *req.ScheduleId
+	}
+	if req.UserId != nil {
+		filters["user_id"] = *req.UserId
+	}
+	if req.OrgUnitName != nil {
+		filters["org_unit_name"] = *req.OrgUnitName
+	}
+
+	page := int(req.GetPage())
+	pageSize := int(req.GetPageSize())
+	if req.GetNoPaging() {
+		page = 0
+		pageSize = 0
+	}
+
+	entities, total, err := s.assignmentRepo.List(ctx, getTenantID(ctx), page, pageSize, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*hrV1.WorkScheduleAssignment, len(entities))
+	for i, e := range entities {
+		items[i] = workScheduleAssignmentToProto(e)
+	}
+
+	return &hrV1.ListWorkScheduleAssignmentsResponse{
+		Items: items,
+		Total: ptrInt32(int32(total)),
+	}, nil
+}
+
+func (s *WorkScheduleService) DeleteWorkScheduleAssignment(ctx context.Context, req *hrV1.DeleteWorkScheduleAssignmentRequest) (*emptypb.Empty, error) {
+	if err := checkPermission(ctx, "hr.schedule.manage"); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.assignmentRepo.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, hrV1.ErrorWorkScheduleAssignmentNotFound("work schedule assignment not found")
+	}
+	if err := checkTenantAccess(ctx, existing.TenantID, hrV1.ErrorWorkScheduleAssignmentNotFound("work schedule assignment not found")); err != nil {
+		return nil, err
+	}
+
+	if err := s.assignmentRepo.Delete(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// validateDayHours checks that a weekly pattern has one entry per weekday,
+// each between 0 and 24 hours, with at least one working day.
+func validateDayHours(dayHours []float64) error {
+	if len(dayHours) != 7 {
+		return hrV1.ErrorValidationFailed("day_hours must have 7 entries, Sunday to Saturday")
+	}
+	working := false
+	for _, h := range dayHours {
+		if h < 0 || h > 24 {
+			return hrV1.ErrorValidationFailed("day_hours entries must be between 0 and 24")
+		}
+		if h > 0 {
+			working = true
+		}
+	}
+	if !working {
+		return hrV1.ErrorValidationFailed("work schedule must have at least one working day")
+	}
+	return nil
+}
+
+func workScheduleToProto(e *ent.WorkSchedule) *hrV1.WorkSchedule {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.WorkSchedule{
+		Id:          &e.ID,
+		TenantId:    e.TenantID,
+		Name:        ptrString(e.Name),
+		Description: ptrString(e.Description),
+		DayHours:    e.DayHours,
+		CreatedBy:   e.CreateBy,
+		UpdatedBy:   e.UpdateBy,
+	}
+
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	if e.UpdateTime != nil {
+		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
+	}
+
+	return result
+}
+
+func workScheduleAssignmentToProto(e *ent.WorkScheduleAssignment) *hrV1.WorkScheduleAssignment {
+	if e == nil {
+		return nil
+	}
+
+	result := &hrV1.WorkScheduleAssignment{
+		Id:            &e.ID,
+		TenantId:      e.TenantID,
+		ScheduleId:    ptrString(e.ScheduleID),
+		UserId:        &e.UserID,
+		OrgUnitName:   ptrString(e.OrgUnitName),
+		EffectiveFrom: timestamppb.New(e.EffectiveFrom),
+		UserName:      ptrString(e.UserName),
+		CreatedBy:     e.CreateBy,
+		UpdatedBy:     e.UpdateBy,
+	}
+
+	if e.Edges.Schedule != nil {
+		result.ScheduleName = ptrString(e.Edges.Schedule.Name)
+	}
+	if e.CreateTime != nil {
+		result.CreatedAt = timestamppb.New(*e.CreateTime)
+	}
+	if e.UpdateTime != nil {
+		result.UpdatedAt = timestamppb.New(*e.UpdateTime)
+	}
+
+	return result
+}
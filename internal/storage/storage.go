@@ -0,0 +1,127 @@
+// Package storage keeps the contents of uploaded files in a blob backend. The backend is chosen
+// by configuration; the local filesystem is used by default.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-tangra/go-tangra-hr/internal/conf"
+)
+
+// Backends a store can be configured with.
+const (
+	BackendLocal = "local"
+)
+
+const defaultLocalDir = "./data/attachments"
+
+// ErrNotFound is returned when no blob is stored under a key.
+var ErrNotFound = errors.New("blob not found")
+
+// Backend stores blobs under slash-separated keys.
+type Backend interface {
+	// Put stores data under key, replacing any blob stored there before.
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns the blob stored under key, or ErrNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete removes the blob stored under key. Deleting a missing blob is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// New returns the backend the configuration selects.
+func New(cfg *conf.AttachmentConfig) (Backend, error) {
+	backend := cfg.GetBackend()
+	if backend == "" {
+		backend = BackendLocal
+	}
+
+	switch backend {
+	case BackendLocal:
+		dir := cfg.GetLocalDir()
+		if dir == "" {
+			dir = defaultLocalDir
+		}
+		return NewLocalBackend(dir)
+	default:
+		return nil, fmt.Errorf("unknown attachment storage backend %q", backend)
+	}
+}
+
+// LocalBackend stores blobs as files below a directory.
+type LocalBackend struct {
+	dir string
+}
+
+// NewLocalBackend creates the directory if needed and returns a backend storing blobs in it.
+func NewLocalBackend(dir string) (*LocalBackend, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("create attachment directory: %w", err)
+	}
+	return &LocalBackend{dir: dir}, nil
+}
+
+func (b *LocalBackend) Put(_ context.Context, key string, data []byte) error {
+	path, err := b.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so that a failed write never leaves a partial blob
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (b *LocalBackend) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := b.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+func (b *LocalBackend) Delete(_ context.Context, key string) error {
+	path, err := b.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path maps a key to a file below the directory, refusing keys that would escape it.
+func (b *LocalBackend) path(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return "", fmt.Errorf("invalid blob key %q", key)
+		}
+	}
+	return filepath.Join(b.dir, filepath.FromSlash(key)), nil
+}
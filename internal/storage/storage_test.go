@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-tangra/go-tangra-hr/internal/conf"
+)
+
+func TestLocalBackendPath(t *testing.T) {
+	dir := t.TempDir()
+	b, err := NewLocalBackend(dir)
+	if err != nil {
+		t.Fatalf("NewLocalBackend() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		key     string
+		want    string
+		wantErr bool
+	}{
+		{"single part", "blob", filepath.Join(dir, "blob"), false},
+		{"nested", "tenant-1/leave-2/attachment-3", filepath.Join(dir, "tenant-1", "leave-2", "attachment-3"), false},
+		{"dots within a name", "a/..b/c..", filepath.Join(dir, "a", "..b", "c.."), false},
+		{"empty", "", "", true},
+		{"absolute", "/etc/passwd", "", true},
+		{"parent", "../secret", "", true},
+		{"parent within", "a/../../secret", "", true},
+		{"parent at the end", "a/..", "", true},
+		{"current", "./blob", "", true},
+		{"empty part", "a//b", "", true},
+		{"trailing slash", "a/", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.path(tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("path(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("path(%q) = %q, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalBackend(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	b, err := NewLocalBackend(filepath.Join(dir, "attachments"))
+	if err != nil {
+		t.Fatalf("NewLocalBackend() error = %v", err)
+	}
+
+	const key = "tenant-1/leave-2/attachment-3"
+	if _, err := b.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get() of a missing blob error = %v, want ErrNotFound", err)
+	}
+
+	if err := b.Put(ctx, key, []byte("first")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if err := b.Put(ctx, key, []byte("second")); err != nil {
+		t.Fatalf("Put() replacing a blob error = %v", err)
+	}
+	data, err := b.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if string(data) != "second" {
+		t.Errorf("Get() = %q, want %q", data, "second")
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dir, "attachments", "tenant-1", "leave-2"))
+	if err != nil {
+		t.Fatalf("read blob directory: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("blob directory holds %d files, want only the blob", len(entries))
+	}
+
+	if err := b.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := b.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() of a deleted blob error = %v, want ErrNotFound", err)
+	}
+	if err := b.Delete(ctx, key); err != nil {
+		t.Errorf("Delete() of a missing blob error = %v", err)
+	}
+
+	for _, key := range []string{"../escaped", "/escaped"} {
+		if err := b.Put(ctx, key, []byte("data")); err == nil {
+			t.Errorf("Put(%q) stored a blob outside the directory", key)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "escaped")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("a blob was written outside the directory: %v", err)
+	}
+}
+
+func TestNew(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		cfg     *conf.AttachmentConfig
+		wantErr bool
+	}{
+		{"local", &conf.AttachmentConfig{Backend: BackendLocal, LocalDir: dir}, false},
+		{"local by default", &conf.AttachmentConfig{LocalDir: dir}, false},
+		{"unknown backend", &conf.AttachmentConfig{Backend: "s3"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.cfg); (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
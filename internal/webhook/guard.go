@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"syscall"
+)
+
+// ErrForbiddenAddress is returned for URLs whose host is, or resolves to, an address webhooks are
+// not delivered to
+var ErrForbiddenAddress = errors.New("webhook address is not allowed")
+
+// sharedAddressSpace is the carrier-grade NAT range, internal to providers like private networks
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// Guard keeps webhooks from being delivered to the service's own network: loopback, private,
+// link-local, multicast and unspecified addresses are refused unless in an allowed network.
+// Checking URLs when subscriptions are saved gives early feedback; checking each connection as it
+// is dialled is what holds, as a host may resolve differently by then.
+type Guard struct {
+	allowed []*net.IPNet
+}
+
+// NewGuard creates a guard that lets the networks, in CIDR notation, through. An invalid network
+// is an error.
+func NewGuard(allowedNetworks []string) (*Guard, error) {
+	g := &Guard{}
+	for _, cidr := range allowedNetworks {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed webhook network %q: %w", cidr, err)
+		}
+		g.allowed = append(g.allowed, network)
+	}
+	return g, nil
+}
+
+// CheckURL checks that the URL is an absolute http or https URL whose host resolves to allowed
+// addresses only.
+func (g *Guard) CheckURL(ctx context.Context, raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return errors.New("url must be an absolute http or https URL")
+	}
+
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		return g.checkIP(ip)
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("resolve %s: %w", host, err)
+	}
+	for _, addr := range addrs {
+		if err := g.checkIP(addr.IP); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Control checks the address a connection is about to be made to; it is meant for
+// net.Dialer.Control, which sees the address after the host was resolved.
+func (g *Guard) Control(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("%w: %s is not an IP address", ErrForbiddenAddress, host)
+	}
+	return g.checkIP(ip)
+}
+
+func (g *Guard) checkIP(ip net.IP) error {
+	for _, network := range g.allowed {
+		if network.Contains(ip) {
+			return nil
+		}
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() ||
+		sharedAddressSpace.Contains(ip) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, ip)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestNewGuard(t *testing.T) {
+	tests := []struct {
+		name     string
+		networks []string
+		wantErr  bool
+	}{
+		{"none", nil, false},
+		{"IPv4 and IPv6", []string{"10.1.0.0/16", "fd00::/8"}, false},
+		{"address without mask", []string{"10.1.0.1"}, true},
+		{"not an address", []string{"intranet"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewGuard(tt.networks); (err != nil) != tt.wantErr {
+				t.Errorf("NewGuard() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGuardCheckURL(t *testing.T) {
+	strict, err := NewGuard(nil)
+	if err != nil {
+		t.Fatalf("NewGuard() error = %v", err)
+	}
+	allowing, err := NewGuard([]string{"10.1.0.0/16", "fd00:1::/32"})
+	if err != nil {
+		t.Fatalf("NewGuard() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		guard     *Guard
+		url       string
+		wantErr   bool
+		forbidden bool
+	}{
+		{"public IPv4", strict, "https://93.184.216.34/hooks", false, false},
+		{"public IPv4 with port", strict, "http://93.184.216.34:8080/hooks", false, false},
+		{"public IPv6", strict, "https://[2606:2800:220:1:248:1893:25c8:1946]/hooks", false, false},
+		{"loopback", strict, "http://127.0.0.1/hooks", true, true},
+		{"loopback range", strict, "http://127.8.9.10/hooks", true, true},
+		{"IPv6 loopback", strict, "http://[::1]/hooks", true, true},
+		{"private 10/8", strict, "http://10.1.2.3/hooks", true, true},
+		{"private 172.16/12", strict, "http://172.20.0.1/hooks", true, true},
+		{"private 192.168/16", strict, "http://192.168.1.1/hooks", true, true},
+		{"IPv6 unique local", strict, "http://[fd00:1::1]/hooks", true, true},
+		{"link-local metadata address", strict, "http://169.254.169.254/latest/meta-data", true, true},
+		{"IPv6 link-local", strict, "http://[fe80::1]/hooks", true, true},
+		{"unspecified", strict, "http://0.0.0.0/hooks", true, true},
+		{"multicast", strict, "http://224.0.0.1/hooks", true, true},
+		{"shared address space", strict, "http://100.64.0.1/hooks", true, true},
+		{"end of shared address space", strict, "http://100.127.255.254/hooks", true, true},
+		{"after shared address space", strict, "http://100.128.0.1/hooks", false, false},
+		{"IPv4-mapped loopback", strict, "http://[::ffff:127.0.0.1]/hooks", true, true},
+		{"allowed network", allowing, "http://10.1.2.3/hooks", false, false},
+		{"allowed IPv6 network", allowing, "http://[fd00:1::1]/hooks", false, false},
+		{"outside the allowed network", allowing, "http://10.2.0.1/hooks", true, true},
+		{"loopback outside the allowed network", allowing, "http://127.0.0.1/hooks", true, true},
+		{"unsupported scheme", strict, "ftp://93.184.216.34/hooks", true, false},
+		{"relative URL", strict, "/hooks", true, false},
+		{"no host", strict, "https:///hooks", true, false},
+		{"malformed URL", strict, "http://[::1/hooks", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.guard.CheckURL(context.Background(), tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckURL(%q) error = %v, wantErr %v", tt.url, err, tt.wantErr)
+			}
+			if got := errors.Is(err, ErrForbiddenAddress); got != tt.forbidden {
+				t.Errorf("CheckURL(%q) error = %v, forbidden address %v", tt.url, err, tt.forbidden)
+			}
+		})
+	}
+}
+
+func TestGuardControl(t *testing.T) {
+	guard, err := NewGuard([]string{"10.1.0.0/16"})
+	if err != nil {
+		t.Fatalf("NewGuard() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		address   string
+		wantErr   bool
+		forbidden bool
+	}{
+		{"public address", "93.184.216.34:443", false, false},
+		{"public IPv6 address", "[2606:2800:220:1:248:1893:25c8:1946]:443", false, false},
+		{"allowed network", "10.1.0.5:80", false, false},
+		{"loopback", "127.0.0.1:80", true, true},
+		{"private", "192.168.0.10:443", true, true},
+		{"IPv6 loopback", "[::1]:443", true, true},
+		{"host name", "example.com:443", true, true},
+		{"no port", "93.184.216.34", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := guard.Control("tcp", tt.address, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Control(%q) error = %v, wantErr %v", tt.address, err, tt.wantErr)
+			}
+			if got := errors.Is(err, ErrForbiddenAddress); got != tt.forbidden {
+				t.Errorf("Control(%q) error = %v, forbidden address %v", tt.address, err, tt.forbidden)
+			}
+		})
+	}
+}
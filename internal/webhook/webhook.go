@@ -0,0 +1,154 @@
+// Package webhook delivers events to the URLs of webhook subscriptions as signed JSON POSTs.
+//
+// Each request carries the event in its body and the headers below. The signature header holds
+// "t=<unix seconds>,v1=<hex HMAC-SHA256>", where the HMAC is computed with the subscription's
+// secret over "<unix seconds>.<body>"; receivers recompute it and reject requests whose
+// timestamp is too old to guard against replays.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers of the requests delivering events.
+const (
+	HeaderSignature = "X-Hr-Signature"
+	HeaderEventType = "X-Hr-Event"
+	HeaderEventID   = "X-Hr-Event-Id"
+	HeaderDelivery  = "X-Hr-Delivery"
+)
+
+// maxResponseBody is how much of a subscriber's answer is kept in the delivery log
+const maxResponseBody = 4096
+
+// secretBytes is the length of generated secrets before encoding
+const secretBytes = 32
+
+// Matches reports whether an event of the type is delivered to a subscription with the filter.
+// Filter entries are event types, e.g. leave.approved, or a prefix followed by *, e.g. leave.*;
+// an empty filter matches all events.
+func Matches(filter []string, eventType string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, f := range filter {
+		if f == eventType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(f, "*"); ok && strings.HasPrefix(eventType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign returns the signature header of a body sent at the time.
+func Sign(secret string, at time.Time, body []byte) string {
+	ts := strconv.FormatInt(at.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// GenerateSecret returns a new random secret for a subscription.
+func GenerateSecret() (string, error) {
+	b := make([]byte, secretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// Request is an event to deliver to a subscription.
+type Request struct {
+	URL        string
+	Secret     string
+	DeliveryID string
+	EventID    string
+	EventType  string
+	Body       []byte
+}
+
+// Result is what the subscriber answered. StatusCode is 0 when it did not answer.
+type Result struct {
+	StatusCode int
+	Body       string
+	Duration   time.Duration
+}
+
+// Sender POSTs events to subscriptions.
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender creates a sender whose requests time out after the timeout, and which connects only
+// to the addresses the guard allows. Requests bypass any proxy, so that the guard sees the
+// subscriber's address, and redirects are not followed, so that a subscriber cannot send them on
+// to an address the guard refuses.
+func NewSender(timeout time.Duration, guard *Guard) *Sender {
+	dialer := &net.Dialer{Timeout: timeout, Control: guard.Control}
+	return &Sender{client: &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// Send POSTs the event of the request, signed now. An error means the delivery failed, either
+// because the subscriber could not be reached or because it answered with a status other than
+// 2xx; the result holds what it answered in the latter case.
+func (s *Sender) Send(ctx context.Context, req Request) (Result, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return Result{}, fmt.Errorf("build request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "go-tangra-hr-webhooks")
+	httpReq.Header.Set(HeaderSignature, Sign(req.Secret, time.Now(), req.Body))
+	httpReq.Header.Set(HeaderEventType, req.EventType)
+	httpReq.Header.Set(HeaderEventID, req.EventID)
+	httpReq.Header.Set(HeaderDelivery, req.DeliveryID)
+
+	start := time.Now()
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return Result{Duration: time.Since(start)}, fmt.Errorf("post to %s: %w", req.URL, err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	result := Result{
+		StatusCode: resp.StatusCode,
+		Body:       strings.ToValidUTF8(string(body), ""),
+		Duration:   time.Since(start),
+	}
+	if resp.StatusCode >= 300 && resp.StatusCode <= 399 {
+		return result, fmt.Errorf("subscriber answered %s; redirects are not followed", resp.Status)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return result, fmt.Errorf("subscriber answered %s", resp.Status)
+	}
+	return result, nil
+}
@@ -0,0 +1,191 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    []string
+		eventType string
+		want      bool
+	}{
+		{"empty filter", nil, "leave.approved", true},
+		{"exact type", []string{"leave.approved"}, "leave.approved", true},
+		{"other type", []string{"leave.approved"}, "leave.rejected", false},
+		{"one of several", []string{"leave.rejected", "leave.approved"}, "leave.approved", true},
+		{"prefix", []string{"leave.*"}, "leave.cancelled", true},
+		{"prefix of another family", []string{"leave.*"}, "allowance.changed", false},
+		{"wildcard", []string{"*"}, "allowance.changed", true},
+		{"prefix needs the dot", []string{"leave.*"}, "leave", false},
+		{"wildcard only at the end", []string{"*.approved"}, "leave.approved", false},
+		{"type is not a prefix", []string{"leave"}, "leave.approved", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Matches(tt.filter, tt.eventType); got != tt.want {
+				t.Errorf("Matches(%v, %q) = %v, want %v", tt.filter, tt.eventType, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	at := time.Unix(1767225600, 0)
+
+	tests := []struct {
+		name   string
+		secret string
+		at     time.Time
+		body   string
+		want   string
+	}{
+		{"body", "whsec_test", at, `{"id":"1"}`, "t=1767225600,v1=c9c43053c587d9a132baf3e87a7f5cd6b5e5e5acf4ecb038f8c897644f666304"},
+		{"empty body", "whsec_test", at, "", "t=1767225600,v1=bc5f22c68024f86d3be1b4ddd6417a119940e00ea9c5f409f8e48b2b4c7c771f"},
+		{"other secret and time", "other", at.Add(time.Second), `{"id":"1"}`, "t=1767225601,v1=d5d86de89cfb332aa91fef61a103f720f736d79a4c45dd5b01b8a7fd3d42a077"},
+		{"sub-second times are truncated", "whsec_test", at.Add(999 * time.Millisecond), `{"id":"1"}`, "t=1767225600,v1=c9c43053c587d9a132baf3e87a7f5cd6b5e5e5acf4ecb038f8c897644f666304"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sign(tt.secret, tt.at, []byte(tt.body)); got != tt.want {
+				t.Errorf("Sign() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	a, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+	b, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+
+	encoded, ok := strings.CutPrefix(a, "whsec_")
+	if !ok {
+		t.Fatalf("secret %q lacks the whsec_ prefix", a)
+	}
+	if raw, err := hex.DecodeString(encoded); err != nil || len(raw) != secretBytes {
+		t.Errorf("secret %q does not encode %d bytes", a, secretBytes)
+	}
+	if a == b {
+		t.Error("two generated secrets are equal")
+	}
+}
+
+func TestSenderSend(t *testing.T) {
+	const secret = "whsec_test"
+	body := []byte(`{"type":"leave.approved"}`)
+
+	var got *http.Request
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		gotBody, _ = io.ReadAll(r.Body)
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "/", http.StatusFound)
+			return
+		}
+		if r.URL.Path == "/fail" {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	loopback, err := NewGuard([]string{"127.0.0.0/8", "::1/128"})
+	if err != nil {
+		t.Fatalf("NewGuard() error = %v", err)
+	}
+	strict, err := NewGuard(nil)
+	if err != nil {
+		t.Fatalf("NewGuard() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		guard      *Guard
+		path       string
+		wantStatus int
+		wantErr    bool
+	}{
+		{"delivered", loopback, "/", http.StatusOK, false},
+		{"error status", loopback, "/fail", http.StatusServiceUnavailable, true},
+		{"redirect is not followed", loopback, "/redirect", http.StatusFound, true},
+		{"address refused by the guard", strict, "/", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = nil
+			result, err := NewSender(5*time.Second, tt.guard).Send(context.Background(), Request{
+				URL:        server.URL + tt.path,
+				Secret:     secret,
+				DeliveryID: "delivery-1",
+				EventID:    "event-1",
+				EventType:  "leave.approved",
+				Body:       body,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if result.StatusCode != tt.wantStatus {
+				t.Errorf("Send() status = %d, want %d", result.StatusCode, tt.wantStatus)
+			}
+			if tt.wantStatus == 0 {
+				if got != nil {
+					t.Error("the request reached the subscriber")
+				}
+				return
+			}
+
+			if got.Header.Get(HeaderEventType) != "leave.approved" || got.Header.Get(HeaderEventID) != "event-1" || got.Header.Get(HeaderDelivery) != "delivery-1" {
+				t.Errorf("unexpected headers %v", got.Header)
+			}
+			if string(gotBody) != string(body) {
+				t.Errorf("body = %q, want %q", gotBody, body)
+			}
+			assertSignature(t, secret, got.Header.Get(HeaderSignature), gotBody)
+		})
+	}
+}
+
+// assertSignature checks the signature header as a receiver would
+func assertSignature(t *testing.T, secret, header string, body []byte) {
+	t.Helper()
+
+	ts, mac, ok := strings.Cut(header, ",")
+	ts, tsOK := strings.CutPrefix(ts, "t=")
+	mac, macOK := strings.CutPrefix(mac, "v1=")
+	if !ok || !tsOK || !macOK {
+		t.Fatalf("malformed signature header %q", header)
+	}
+	sec, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		t.Fatalf("malformed signature timestamp %q", ts)
+	}
+	if age := time.Since(time.Unix(sec, 0)); age < 0 || age > time.Minute {
+		t.Errorf("signature timestamp is %v old", age)
+	}
+
+	expected := hmac.New(sha256.New, []byte(secret))
+	expected.Write([]byte(ts + "."))
+	expected.Write(body)
+	if !hmac.Equal([]byte(mac), []byte(hex.EncodeToString(expected.Sum(nil)))) {
+		t.Errorf("signature %q does not match the body", header)
+	}
+}
@@ -0,0 +1,248 @@
+// Package workday counts the working days covered by a leave request.
+package workday
+
+import (
+	"math"
+	"time"
+)
+
+// DateLayout is the key format used to look up holidays by date.
+const DateLayout = "2006-01-02"
+
+// Holidays maps a date (formatted with DateLayout) to the name of the public
+// holiday observed on that date.
+type Holidays map[string]string
+
+// Contains reports whether the given date is a holiday.
+func (h Holidays) Contains(t time.Time) bool {
+	if h == nil {
+		return false
+	}
+	_, ok := h[t.Format(DateLayout)]
+	return ok
+}
+
+// Schedule is a weekly working pattern: the hours worked on each weekday,
+// indexed by time.Weekday. A weekday with zero hours is not a working day.
+type Schedule [7]float64
+
+// StandardSchedule is used when no work schedule applies: Monday to Friday, 8 hours a day.
+var StandardSchedule = Schedule{0, 8, 8, 8, 8, 8, 0}
+
+// ScheduleFromHours builds a schedule from hours indexed Sunday (0) to Saturday (6).
+// Missing weekdays are treated as non-working days.
+func ScheduleFromHours(hours []float64) Schedule {
+	var s Schedule
+	copy(s[:], hours)
+	return s
+}
+
+// Hours returns the hours worked on the given date's weekday.
+func (s Schedule) Hours(t time.Time) float64 {
+	return s[t.Weekday()]
+}
+
+// Works reports whether the given date's weekday is a working day.
+func (s Schedule) Works(t time.Time) bool {
+	return s.Hours(t) > 0
+}
+
+// Period is a schedule that applies from a given date until the next period starts.
+type Period struct {
+	From     time.Time
+	Schedule Schedule
+}
+
+// Timeline is a list of periods ordered by From.
+type Timeline []Period
+
+// At returns the schedule in effect on the given date, if any.
+func (tl Timeline) At(t time.Time) (Schedule, bool) {
+	for i := len(tl) - 1; i >= 0; i-- {
+		if !tl[i].From.After(t) {
+			return tl[i].Schedule, true
+		}
+	}
+	return Schedule{}, false
+}
+
+// Schedules resolves the schedule a user works on a given date. The user's own
+// schedule takes precedence over the schedule of the user's org unit; when
+// neither applies, StandardSchedule is used.
+type Schedules struct {
+	User    Timeline
+	OrgUnit Timeline
+}
+
+// At returns the schedule in effect on the given date. A nil receiver yields StandardSchedule.
+func (s *Schedules) At(t time.Time) Schedule {
+	if s == nil {
+		return StandardSchedule
+	}
+	if sched, ok := s.User.At(t); ok {
+		return sched
+	}
+	if sched, ok := s.OrgUnit.At(t); ok {
+		return sched
+	}
+	return StandardSchedule
+}
+
+// Count returns the number of working days between start and end, inclusive.
+// Days the user does not work under the schedule in effect on that date, and
+// holidays, are not counted.
+func Count(start, end time.Time, holidays Holidays, schedules *Schedules) float64 {
+	// Normalize to start of day
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location())
+
+	if end.Before(start) {
+		return 0
+	}
+
+	days := 0.0
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if !schedules.At(d).Works(d) {
+			continue
+		}
+		if holidays.Contains(d) {
+			continue
+		}
+		days++
+	}
+
+	return days
+}
+
+// Span is the part of the calendar covered by a leave request. Day-based spans
+// run from Start to End (dates) and may begin in the afternoon of the first day
+// or end at midday of the last day. Hourly spans run from Start to End (times
+// of day on the same date).
+type Span struct {
+	Start           time.Time
+	End             time.Time
+	StartsAfternoon bool
+	EndsAtMidday    bool
+	Hourly          bool
+}
+
+// Interval returns the half-open time range [from, to) covered by the span.
+func (s Span) Interval() (time.Time, time.Time) {
+	if s.Hourly {
+		return s.Start, s.End
+	}
+
+	from := time.Date(s.Start.Year(), s.Start.Month(), s.Start.Day(), 0, 0, 0, 0, s.Start.Location())
+	if s.StartsAfternoon {
+		from = from.Add(12 * time.Hour)
+	}
+	to := time.Date(s.End.Year(), s.End.Month(), s.End.Day(), 0, 0, 0, 0, s.End.Location())
+	if s.EndsAtMidday {
+		to = to.Add(12 * time.Hour)
+	} else {
+		to = to.AddDate(0, 0, 1)
+	}
+	return from, to
+}
+
+// Overlaps reports whether two spans cover any common time. Morning and
+// afternoon halves of the same day do not overlap.
+func (s Span) Overlaps(o Span) bool {
+	from, to := s.Interval()
+	oFrom, oTo := o.Interval()
+	return from.Before(oTo) && oFrom.Before(to)
+}
+
+// CountSpan returns the number of working days covered by the span. Half days
+// count as 0.5; hours count as the fraction of the working hours scheduled on
+// that date.
+func CountSpan(s Span, holidays Holidays, schedules *Schedules) float64 {
+	if s.Hourly {
+		if holidays.Contains(s.Start) {
+			return 0
+		}
+		scheduled := schedules.At(s.Start).Hours(s.Start)
+		if scheduled <= 0 {
+			return 0
+		}
+		return s.End.Sub(s.Start).Hours() / scheduled
+	}
+
+	days := Count(s.Start, s.End, holidays, schedules)
+	if s.StartsAfternoon && isWorkingDay(s.Start, holidays, schedules) {
+		days -= 0.5
+	}
+	if s.EndsAtMidday && isWorkingDay(s.End, holidays, schedules) {
+		days -= 0.5
+	}
+	if days < 0 {
+		return 0
+	}
+	return days
+}
+
+func isWorkingDay(t time.Time, holidays Holidays, schedules *Schedules) bool {
+	return schedules.At(t).Works(t) && !holidays.Contains(t)
+}
+
+// SameDay reports whether two times fall on the same calendar date.
+func SameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
+
+// YearDays is the part of a leave request's days that falls into one calendar year.
+type YearDays struct {
+	Year int
+	Days float64
+}
+
+// SplitByYear distributes days across the calendar years covered by the span,
+// in proportion to the working days counted in each year. Years without working
+// days are omitted; a span within a single year yields one entry.
+func SplitByYear(s Span, days float64, holidays Holidays, schedules *Schedules) []YearDays {
+	if s.Hourly || s.Start.Year() == s.End.Year() {
+		return []YearDays{{Year: s.Start.Year(), Days: days}}
+	}
+
+	var counts []YearDays
+	total := 0.0
+	for year := s.Start.Year(); year <= s.End.Year(); year++ {
+		part := Span{
+			Start: time.Date(year, time.January, 1, 0, 0, 0, 0, s.Start.Location()),
+			End:   time.Date(year, time.December, 31, 0, 0, 0, 0, s.End.Location()),
+		}
+		if year == s.Start.Year() {
+			part.Start = s.Start
+			part.StartsAfternoon = s.StartsAfternoon
+		}
+		if year == s.End.Year() {
+			part.End = s.End
+			part.EndsAtMidday = s.EndsAtMidday
+		}
+		if n := CountSpan(part, holidays, schedules); n > 0 {
+			counts = append(counts, YearDays{Year: year, Days: n})
+			total += n
+		}
+	}
+
+	if total == 0 {
+		return []YearDays{{Year: s.Start.Year(), Days: days}}
+	}
+	if total == days {
+		return counts
+	}
+
+	// Days were entered manually: scale each year's share, giving the rounding
+	// remainder to the last year
+	remaining := days
+	for i := range counts {
+		if i == len(counts)-1 {
+			counts[i].Days = remaining
+			break
+		}
+		share := math.Round(days*counts[i].Days/total*100) / 100
+		counts[i].Days = share
+		remaining -= share
+	}
+	return counts
+}
@@ -0,0 +1,205 @@
+package workday
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func at(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+// 2 March 2026 is a Monday
+var (
+	monday    = date(2026, time.March, 2)
+	wednesday = date(2026, time.March, 4)
+	friday    = date(2026, time.March, 6)
+	saturday  = date(2026, time.March, 7)
+	sunday    = date(2026, time.March, 8)
+)
+
+func TestCount(t *testing.T) {
+	fourDayWeek := &Schedules{User: Timeline{{From: date(2026, time.January, 1), Schedule: Schedule{0, 8, 8, 8, 8, 0, 0}}}}
+
+	tests := []struct {
+		name      string
+		start     time.Time
+		end       time.Time
+		holidays  Holidays
+		schedules *Schedules
+		want      float64
+	}{
+		{"single working day", monday, monday, nil, nil, 1},
+		{"working week", monday, friday, nil, nil, 5},
+		{"across a weekend", friday, date(2026, time.March, 9), nil, nil, 2},
+		{"weekend only", saturday, sunday, nil, nil, 0},
+		{"end before start", friday, monday, nil, nil, 0},
+		{"holiday", monday, friday, Holidays{"2026-03-04": "Holiday"}, nil, 4},
+		{"holiday on a weekend", monday, sunday, Holidays{"2026-03-07": "Holiday"}, nil, 5},
+		{"user schedule", monday, friday, nil, fourDayWeek, 4},
+		{
+			"org unit schedule",
+			monday, friday, nil,
+			&Schedules{OrgUnit: Timeline{{From: date(2026, time.January, 1), Schedule: Schedule{0, 8, 0, 8, 0, 8, 0}}}},
+			3,
+		},
+		{
+			"user schedule takes precedence",
+			monday, friday, nil,
+			&Schedules{
+				User:    fourDayWeek.User,
+				OrgUnit: Timeline{{From: date(2026, time.January, 1), Schedule: Schedule{0, 8, 0, 0, 0, 0, 0}}},
+			},
+			4,
+		},
+		{
+			"schedule starting mid-span",
+			monday, friday, nil,
+			&Schedules{User: Timeline{{From: wednesday, Schedule: Schedule{0, 8, 0, 0, 0, 0, 0}}}},
+			2,
+		},
+		{"times of day are ignored", monday.Add(15 * time.Hour), friday.Add(9 * time.Hour), nil, nil, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Count(tt.start, tt.end, tt.holidays, tt.schedules); got != tt.want {
+				t.Errorf("Count() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCountSpan(t *testing.T) {
+	partTime := &Schedules{User: Timeline{{From: date(2026, time.January, 1), Schedule: Schedule{0, 4, 8, 8, 8, 8, 0}}}}
+	holiday := Holidays{"2026-03-04": "Holiday"}
+
+	tests := []struct {
+		name      string
+		span      Span
+		holidays  Holidays
+		schedules *Schedules
+		want      float64
+	}{
+		{"full days", Span{Start: monday, End: friday}, nil, nil, 5},
+		{"starts in the afternoon", Span{Start: monday, End: friday, StartsAfternoon: true}, nil, nil, 4.5},
+		{"ends at midday", Span{Start: monday, End: friday, EndsAtMidday: true}, nil, nil, 4.5},
+		{"both halves", Span{Start: monday, End: friday, StartsAfternoon: true, EndsAtMidday: true}, nil, nil, 4},
+		{"afternoon of a single day", Span{Start: monday, End: monday, StartsAfternoon: true}, nil, nil, 0.5},
+		{"morning of a single day", Span{Start: monday, End: monday, EndsAtMidday: true}, nil, nil, 0.5},
+		{"half day on a holiday", Span{Start: wednesday, End: friday, StartsAfternoon: true}, holiday, nil, 2},
+		{"half day on a weekend", Span{Start: saturday, End: date(2026, time.March, 9), StartsAfternoon: true}, nil, nil, 1},
+		{"both halves of one day", Span{Start: monday, End: monday, StartsAfternoon: true, EndsAtMidday: true}, nil, nil, 0},
+		{"hours", Span{Start: at(2026, time.March, 2, 9, 0), End: at(2026, time.March, 2, 13, 0), Hourly: true}, nil, nil, 0.5},
+		{"hours on a short day", Span{Start: at(2026, time.March, 2, 9, 0), End: at(2026, time.March, 2, 11, 0), Hourly: true}, nil, partTime, 0.5},
+		{"hours on a full day", Span{Start: at(2026, time.March, 3, 9, 0), End: at(2026, time.March, 3, 15, 0), Hourly: true}, nil, partTime, 0.75},
+		{"hours on a holiday", Span{Start: at(2026, time.March, 4, 9, 0), End: at(2026, time.March, 4, 13, 0), Hourly: true}, holiday, nil, 0},
+		{"hours on a weekend", Span{Start: at(2026, time.March, 7, 9, 0), End: at(2026, time.March, 7, 13, 0), Hourly: true}, nil, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountSpan(tt.span, tt.holidays, tt.schedules); got != tt.want {
+				t.Errorf("CountSpan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpanOverlaps(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Span
+		want bool
+	}{
+		{"same day", Span{Start: monday, End: monday}, Span{Start: monday, End: monday}, true},
+		{"adjacent days", Span{Start: monday, End: monday}, Span{Start: date(2026, time.March, 3), End: friday}, false},
+		{"morning and afternoon", Span{Start: monday, End: monday, EndsAtMidday: true}, Span{Start: monday, End: monday, StartsAfternoon: true}, false},
+		{"afternoon and afternoon", Span{Start: monday, End: wednesday, StartsAfternoon: true}, Span{Start: monday, End: monday, StartsAfternoon: true}, true},
+		{"ends at midday into an afternoon", Span{Start: monday, End: wednesday, EndsAtMidday: true}, Span{Start: wednesday, End: friday, StartsAfternoon: true}, false},
+		{"ends at midday into a full day", Span{Start: monday, End: wednesday, EndsAtMidday: true}, Span{Start: wednesday, End: friday}, true},
+		{
+			"hours within a day",
+			Span{Start: at(2026, time.March, 2, 9, 0), End: at(2026, time.March, 2, 11, 0), Hourly: true},
+			Span{Start: monday, End: monday},
+			true,
+		},
+		{
+			"hours in the morning and an afternoon",
+			Span{Start: at(2026, time.March, 2, 9, 0), End: at(2026, time.March, 2, 11, 0), Hourly: true},
+			Span{Start: monday, End: monday, StartsAfternoon: true},
+			false,
+		},
+		{
+			"consecutive hours",
+			Span{Start: at(2026, time.March, 2, 9, 0), End: at(2026, time.March, 2, 11, 0), Hourly: true},
+			Span{Start: at(2026, time.March, 2, 11, 0), End: at(2026, time.March, 2, 12, 0), Hourly: true},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Overlaps(tt.b); got != tt.want {
+				t.Errorf("Overlaps() = %v, want %v", got, tt.want)
+			}
+			if got := tt.b.Overlaps(tt.a); got != tt.want {
+				t.Errorf("reversed Overlaps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitByYear(t *testing.T) {
+	// 28 December 2026 is a Monday; the span has 4 working days in 2026 and 6 in 2027
+	start, end := date(2026, time.December, 28), date(2027, time.January, 8)
+
+	tests := []struct {
+		name     string
+		span     Span
+		days     float64
+		holidays Holidays
+		want     []YearDays
+	}{
+		{"single year", Span{Start: monday, End: friday}, 5, nil, []YearDays{{2026, 5}}},
+		{"single year, days entered", Span{Start: monday, End: friday}, 3, nil, []YearDays{{2026, 3}}},
+		{"hours", Span{Start: at(2026, time.December, 31, 9, 0), End: at(2026, time.December, 31, 13, 0), Hourly: true}, 0.5, nil, []YearDays{{2026, 0.5}}},
+		{"across the year boundary", Span{Start: start, End: end}, 10, nil, []YearDays{{2026, 4}, {2027, 6}}},
+		{"holiday in the second year", Span{Start: start, End: end}, 9, Holidays{"2027-01-01": "New Year"}, []YearDays{{2026, 4}, {2027, 5}}},
+		{"starts in the afternoon", Span{Start: start, End: end, StartsAfternoon: true}, 9.5, nil, []YearDays{{2026, 3.5}, {2027, 6}}},
+		{"ends at midday", Span{Start: start, End: end, EndsAtMidday: true}, 9.5, nil, []YearDays{{2026, 4}, {2027, 5.5}}},
+		{"days entered are shared", Span{Start: start, End: end}, 5, nil, []YearDays{{2026, 2}, {2027, 3}}},
+		{
+			"year without working days is omitted",
+			Span{Start: date(2026, time.December, 31), End: end}, 6,
+			Holidays{"2026-12-31": "New Year's Eve"},
+			[]YearDays{{2027, 6}},
+		},
+		{"no working days", Span{Start: date(2022, time.December, 31), End: date(2023, time.January, 1)}, 0, nil, []YearDays{{2022, 0}}},
+		{
+			"three years",
+			Span{Start: date(2026, time.December, 31), End: date(2028, time.January, 3)}, 263, nil,
+			[]YearDays{{2026, 1}, {2027, 261}, {2028, 1}},
+		},
+		{
+			"rounding goes to the last year",
+			Span{Start: date(2026, time.December, 31), End: date(2028, time.January, 3)}, 10, nil,
+			[]YearDays{{2026, 0.04}, {2027, 9.92}, {2028, 0.04}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitByYear(tt.span, tt.days, tt.holidays, nil)
+			if len(got) != len(tt.want) {
+				t.Fatalf("SplitByYear() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].Year != tt.want[i].Year || math.Abs(got[i].Days-tt.want[i].Days) > 1e-9 {
+					t.Fatalf("SplitByYear() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}